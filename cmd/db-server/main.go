@@ -40,6 +40,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/rpcserver"
 	"github.com/matrixorigin/matrixone/pkg/sql/compile"
 	"github.com/matrixorigin/matrixone/pkg/sql/handler"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
 	"github.com/matrixorigin/matrixone/pkg/vm/driver"
 	aoeDriver "github.com/matrixorigin/matrixone/pkg/vm/driver/aoe"
 	dConfig "github.com/matrixorigin/matrixone/pkg/vm/driver/config"
@@ -72,6 +73,7 @@ const (
 	WaitCubeStartExit       = 11
 	StartMOExit             = 12
 	CreateTpeExit           = 13
+	CreatePrivilegeExit     = 14
 )
 
 var (
@@ -91,6 +93,17 @@ var (
 func createMOServer(callback *frontend.PDCallbackImpl) {
 	address := fmt.Sprintf("%s:%d", config.GlobalSystemVariables.GetHost(), config.GlobalSystemVariables.GetPort())
 	pu := config.NewParameterUnit(&config.GlobalSystemVariables, config.HostMmu, config.Mempool, config.StorageEngine, config.ClusterNodes, config.ClusterCatalog)
+	pm, err := privilege.New(config.ClusterCatalog)
+	if err != nil {
+		logutil.Infof("Load accounts failed, %v", err)
+		os.Exit(CreatePrivilegeExit)
+	}
+	// the dump user is the super user who has all privileges
+	if err = pm.Bootstrap(config.GlobalSystemVariables.GetDumpuser(), config.GlobalSystemVariables.GetDumppassword()); err != nil {
+		logutil.Infof("Create super user failed, %v", err)
+		os.Exit(CreatePrivilegeExit)
+	}
+	pu.Privilege = pm
 	mo = frontend.NewMOServer(address, pu, callback)
 	frontend.InitServerVersion(MoVersion)
 }
@@ -316,6 +329,7 @@ func main() {
 	proc := process.New(mheap.New(gm))
 	hp := handler.New(eng, proc)
 	srv.Register(hp.Process)
+	srv.RegisterClosed(hp.Closed)
 
 	err = waitClusterStartup(a, 300*time.Second, int(cfg.CubeConfig.Prophet.Replication.MaxReplicas), int(cfg.ClusterConfig.PreAllocatedGroupNum))
 
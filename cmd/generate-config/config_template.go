@@ -863,8 +863,19 @@ func (ap * {{$Params.ParameterStructName}} ) Set{{.CapitalName}}(value {{.DataTy
 Set the value of the parameter {{.Name}}
 */
 func (ap * {{$Params.ParameterStructName}} ) set{{.CapitalName}}(value {{.DataType}})error {
+	if err := ap.check{{.CapitalName}}(value); err != nil {
+		return err
+	}
 	ap.rwlock.Lock()
 	defer ap.rwlock.Unlock()
+	ap.{{.Name}} = value
+	return nil
+}
+
+/**
+Check the value of the parameter {{.Name}}
+*/
+func (ap * {{$Params.ParameterStructName}} ) check{{.CapitalName}}(value {{.DataType}})error {
 	{{if eq .DataType "bool" -}}
 	{{if eq .DomainType "set" -}}
 		choices :=[]{{.DataType}} {
@@ -944,12 +955,118 @@ func (ap * {{$Params.ParameterStructName}} ) set{{.CapitalName}}(value {{.DataTy
 			return fmt.Errorf("the float64 type does not support domainType %s",{{printf "\"%s\"" .DomainType}})
 		{{end}}
 	{{- end}}
-	ap.{{.Name}} = value
 	return nil
 }
 {{end}}
 {{end}}
 
+/**
+get the names of all parameters.
+*/
+func (ap *{{.ParameterStructName}}) GetParameterNames()[]string{
+	return []string{
+	{{- range .Parameter}}
+		{{printf "\"%s\"" .Name}},
+	{{- end}}
+	}
+}
+
+/**
+get the scope of the parameter.
+*/
+func (ap *{{.ParameterStructName}}) GetParameterScope(name string)([]string,error){
+	switch name {
+	{{- range .Parameter}}
+	case {{printf "\"%s\"" .Name}}:
+		return []string{ {{- range .Scope}}{{printf "\"%s\"" .}},{{end -}} }, nil
+	{{- end}}
+	}
+	return nil, fmt.Errorf("there is no parameter %s",name)
+}
+
+/**
+get the data type of the parameter.
+*/
+func (ap *{{.ParameterStructName}}) GetParameterDataType(name string)(string,error){
+	switch name {
+	{{- range .Parameter}}
+	case {{printf "\"%s\"" .Name}}:
+		return {{printf "\"%s\"" .DataType}}, nil
+	{{- end}}
+	}
+	return "", fmt.Errorf("there is no parameter %s",name)
+}
+
+/**
+get the update mode of the parameter.
+*/
+func (ap *{{.ParameterStructName}}) GetParameterUpdateMode(name string)(string,error){
+	switch name {
+	{{- range .Parameter}}
+	case {{printf "\"%s\"" .Name}}:
+		return {{printf "\"%s\"" .UpdateMode}}, nil
+	{{- end}}
+	}
+	return "", fmt.Errorf("there is no parameter %s",name)
+}
+
+/**
+get the value of the parameter by its name.
+*/
+func (ap *{{.ParameterStructName}}) GetParameter(name string)(interface{},error){
+	switch name {
+	{{- range .Parameter}}
+	case {{printf "\"%s\"" .Name}}:
+		return ap.Get{{.CapitalName}}(), nil
+	{{- end}}
+	}
+	return nil, fmt.Errorf("there is no parameter %s",name)
+}
+
+/**
+check the value of the parameter by its name.
+the parameter with the update mode fix can not be updated.
+*/
+func (ap *{{.ParameterStructName}}) CheckParameter(name string,value interface{})error{
+	switch name {
+	{{- range .Parameter}}
+	case {{printf "\"%s\"" .Name}}:
+		{{- if eq .UpdateMode "fix"}}
+		return fmt.Errorf("the parameter %s can not be updated",name)
+		{{- else}}
+		v, ok := value.({{.DataType}})
+		if !ok {
+			return fmt.Errorf("the value of the parameter %s should be %s",name,{{printf "\"%s\"" .DataType}})
+		}
+		return ap.check{{.CapitalName}}(v)
+		{{- end}}
+	{{- end}}
+	}
+	return fmt.Errorf("there is no parameter %s",name)
+}
+
+/**
+set the value of the parameter by its name.
+the parameter with the update mode fix can not be updated.
+*/
+func (ap *{{.ParameterStructName}}) SetParameter(name string,value interface{})error{
+	switch name {
+	{{- range .Parameter}}
+	case {{printf "\"%s\"" .Name}}:
+		{{- if eq .UpdateMode "fix"}}
+		return fmt.Errorf("the parameter %s can not be updated",name)
+		{{- else}}
+		v, ok := value.({{.DataType}})
+		if !ok {
+			return fmt.Errorf("the value of the parameter %s should be %s",name,{{printf "\"%s\"" .DataType}})
+		}
+		return ap.Set{{.CapitalName}}(v)
+		{{- end}}
+	{{- end}}
+	}
+	return fmt.Errorf("there is no parameter %s",name)
+}
+
 /**
 prepare something before anything else.
 it is unsafe in multi-thread environment.
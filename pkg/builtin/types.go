@@ -49,4 +49,6 @@ const (
 	Weekday
 	EndsWith
 	Date
+	IsNull
+	IsNotNull
 )
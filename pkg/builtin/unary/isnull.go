@@ -0,0 +1,79 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+var nullableTypes = []types.T{
+	types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+	types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+	types.T_float32, types.T_float64, types.T_decimal,
+	types.T_date, types.T_datetime,
+	types.T_char, types.T_varchar,
+}
+
+func init() {
+	registerNullCheck("isnull", "%s is null", builtin.IsNull, true)
+	registerNullCheck("isnotnull", "%s is not null", builtin.IsNotNull, false)
+}
+
+// registerNullCheck registers the function which selects the rows whose value is null
+// if flg is true, and selects the others if it is false.
+func registerNullCheck(name, format string, op int, flg bool) {
+	extend.FunctionRegistry[name] = op
+	extend.UnaryReturnTypes[op] = func(_ extend.Extend) types.T {
+		return types.T_sel
+	}
+	extend.UnaryStrings[op] = func(e extend.Extend) string {
+		return fmt.Sprintf(format, e)
+	}
+	overload.OpTypes[op] = overload.Unary
+	for _, typ := range nullableTypes {
+		overload.UnaryOps[op] = append(overload.UnaryOps[op], &overload.UnaryOp{
+			Typ:        typ,
+			ReturnType: types.T_sel,
+			Fn: func(lv *vector.Vector, proc *process.Process, _ bool) (*vector.Vector, error) {
+				n := vector.Length(lv)
+				vec, err := process.Get(proc, 8*int64(n), overload.SelsType)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeInt64Slice(vec.Data)
+				rs = rs[:0]
+				for i := 0; i < n; i++ {
+					if nulls.Contains(lv.Nsp, uint64(i)) == flg {
+						rs = append(rs, int64(i))
+					}
+				}
+				vector.SetCol(vec, rs)
+				if lv.Ref == 0 {
+					process.Put(proc, lv)
+				}
+				return vec, nil
+			},
+		})
+	}
+}
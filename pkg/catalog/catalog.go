@@ -26,6 +26,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
 	"github.com/matrixorigin/matrixone/pkg/vm/driver"
 	"github.com/matrixorigin/matrixone/pkg/vm/driver/pb"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
@@ -48,6 +49,7 @@ const (
 	cDeletedTablePrefix   = "DeletedTableQueue"
 	cRuleName             = "RuleTable"
 	cLabelName            = "LabelTable"
+	cUserPrefix           = "User"
 	timeout               = 2000 * time.Millisecond
 	idPoolSize            = 20
 )
@@ -305,6 +307,34 @@ func (c *Catalog) GetDatabase(dbName string) (*aoe.SchemaInfo, error) {
 	}
 	return db, nil
 }
+// ListUsers returns all accounts.
+func (c *Catalog) ListUsers() ([]*privilege.User, error) {
+	values, err := c.Driver.PrefixScan(c.userPrefix(), 0)
+	if err != nil {
+		return nil, err
+	}
+	var users []*privilege.User
+	for i := 1; i < len(values); i = i + 2 {
+		u := &privilege.User{}
+		if err = json.Unmarshal(values[i], u); err != nil {
+			return nil, err
+		}
+		users = append(users, u)
+	}
+	return users, nil
+}
+
+// SetUser creates or replaces the account.
+func (c *Catalog) SetUser(u *privilege.User) error {
+	value, _ := json.Marshal(u)
+	return c.Driver.Set(c.userKey(u.Name, u.Host), value)
+}
+
+// DeleteUser deletes the account 'name'@'host'.
+func (c *Catalog) DeleteUser(name, host string) error {
+	return c.Driver.Delete(c.userKey(name, host))
+}
+
 func (c *Catalog) GetPrimaryKey(dbId uint64, tableName string) (pk *aoe.ColumnInfo, err error) {
 	tbl, err := c.GetTable(dbId, tableName)
 	if err != nil {
@@ -356,6 +386,17 @@ func (c *Catalog) SetPrimaryKey(epoch, dbId uint64, tableName, columnName string
 	}
 	return ErrColumnNotExist
 }
+// SetStatistics replaces the statistics of the table, stats is the encoded
+// statistics collected by ANALYZE TABLE.
+func (c *Catalog) SetStatistics(epoch, dbId uint64, tableName string, stats []byte) error {
+	tbl, err := c.GetTable(dbId, tableName)
+	if err != nil {
+		return err
+	}
+	tbl.Statistics = stats
+	return c.updateTableInfo(dbId, tbl)
+}
+
 func (c *Catalog) updateTableInfo(dbId uint64, tbl *aoe.TableInfo) (err error) {
 	meta, err := EncodeTable(*tbl)
 	if err != nil {
@@ -503,6 +544,53 @@ func (c *Catalog) DropTable(epoch, dbId uint64, tableName string) (tid uint64, e
 	return tb.Id, err
 }
 
+// AlterTable replaces the schema of the table oldName with tbl, it renames the
+// table if the name of tbl is not oldName.
+func (c *Catalog) AlterTable(epoch, dbId uint64, oldName string, tbl *aoe.TableInfo) (err error) {
+	t0 := time.Now()
+	defer func() {
+		logutil.Debugf("AlterTable cost %d ms", time.Since(t0).Milliseconds())
+	}()
+	if _, err = c.checkDBExists(dbId); err != nil {
+		return err
+	}
+	if _, err = c.checkTableExists(dbId, tbl.Id); err != nil {
+		return err
+	}
+	if tbl.Name != oldName {
+		if err = c.Driver.SetIfNotExist(c.tableIDKey(dbId, tbl.Name), Uint642Bytes(tbl.Id)); err != nil {
+			return ErrTableCreateExists
+		}
+		defer func() {
+			key := c.tableIDKey(dbId, oldName)
+			if err != nil {
+				key = c.tableIDKey(dbId, tbl.Name)
+			}
+			if derr := c.Driver.Delete(key); derr != nil {
+				logutil.Errorf("delete meta of table name %v, %v", string(key), derr)
+			}
+		}()
+	}
+	shardIds, err := c.Driver.PrefixKeys(c.routePrefix(tbl.Id), 0)
+	if err != nil {
+		return err
+	}
+	for _, shardId := range shardIds {
+		sid, err := Bytes2Uint64(shardId[len(c.routePrefix(tbl.Id)):])
+		if err != nil {
+			logutil.Errorf("convert shardid failed, %v", err)
+			return err
+		}
+		aoeTableName := c.encodeTabletName(sid, tbl.Id)
+		if err = c.Driver.AlterTablet(aoeTableName, sid, tbl); err != nil {
+			logutil.Errorf("call local alter table failed %d, %d, %v", sid, tbl.Id, err)
+			return err
+		}
+	}
+	tbl.Epoch = epoch
+	return c.updateTableInfo(dbId, tbl)
+}
+
 // ListTablesByName returns all tables meta in database.
 func (c *Catalog) ListTablesByName(dbName string) ([]aoe.TableInfo, error) {
 	if value, err := c.Driver.Get(c.dbIDKey(dbName)); err != nil || value == nil {
@@ -543,7 +631,8 @@ func (c *Catalog) CreateIndex(epoch uint64, idxInfo aoe.IndexInfo) error {
 				columnExist = true
 				idxInfo.Columns = append(idxInfo.Columns, col.Id)
 				if idxInfo.Type == aoe.Bsi {
-					if col.Type.Oid == types.T_char || col.Type.Oid == types.T_varchar {
+					// the bit-sliced index holds 64-bit numbers
+					if col.Type.Oid == types.T_char || col.Type.Oid == types.T_varchar || col.Type.Oid == types.T_decimal {
 						return ErrInvalidIndexType
 					}
 				}
@@ -1071,6 +1160,16 @@ func (c *Catalog) deletedPrefix() []byte {
 }
 
 //getAvailableShard get a shard from the shard pool and returns its id.
+//userKey returns the encoded account with prefix "meta1User"
+func (c *Catalog) userKey(name, host string) []byte {
+	return EncodeKey(cPrefix, defaultCatalogId, cUserPrefix, privilege.Key(name, host))
+}
+
+//userPrefix returns the prefix "meta1User"
+func (c *Catalog) userPrefix() []byte {
+	return EncodeKey(cPrefix, defaultCatalogId, cUserPrefix)
+}
+
 func (c *Catalog) getAvailableShard(tid uint64) (shardid uint64, err error) {
 	t0 := time.Now()
 	defer func() {
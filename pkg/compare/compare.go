@@ -17,6 +17,7 @@ package compare
 import (
 	adates "github.com/matrixorigin/matrixone/pkg/compare/asc/dates"
 	adatetimes "github.com/matrixorigin/matrixone/pkg/compare/asc/datetimes"
+	adecimals "github.com/matrixorigin/matrixone/pkg/compare/asc/decimals"
 	afloat32s "github.com/matrixorigin/matrixone/pkg/compare/asc/float32s"
 	afloat64s "github.com/matrixorigin/matrixone/pkg/compare/asc/float64s"
 	aint16s "github.com/matrixorigin/matrixone/pkg/compare/asc/int16s"
@@ -30,6 +31,7 @@ import (
 	avarchar "github.com/matrixorigin/matrixone/pkg/compare/asc/varchar"
 	ddates "github.com/matrixorigin/matrixone/pkg/compare/desc/dates"
 	ddatetimes "github.com/matrixorigin/matrixone/pkg/compare/desc/datetimes"
+	ddecimals "github.com/matrixorigin/matrixone/pkg/compare/desc/decimals"
 	dfloat32s "github.com/matrixorigin/matrixone/pkg/compare/desc/float32s"
 	dfloat64s "github.com/matrixorigin/matrixone/pkg/compare/desc/float64s"
 	dint16s "github.com/matrixorigin/matrixone/pkg/compare/desc/int16s"
@@ -111,6 +113,11 @@ func New(typ types.T, desc bool) Compare {
 			return ddatetimes.New()
 		}
 		return adatetimes.New()
+	case types.T_decimal:
+		if desc {
+			return ddecimals.New()
+		}
+		return adecimals.New()
 	}
 	return nil
 }
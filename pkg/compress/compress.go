@@ -38,7 +38,7 @@ func Compress(src, dst []byte, typ int) ([]byte, error) {
 func Decompress(src, dst []byte, typ int) ([]byte, error) {
 	switch typ {
 	case Lz4:
-		n, err := lz4.UncompressBlock(src, dst)
+		n, err := uncompressLz4Block(src, dst)
 		if err != nil {
 			return nil, err
 		}
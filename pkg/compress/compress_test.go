@@ -15,6 +15,7 @@
 package compress
 
 import (
+	"bytes"
 	"fmt"
 	"log"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
@@ -40,3 +41,26 @@ func TestLz4(t *testing.T) {
 	}
 	fmt.Printf("dat: %v\n", data)
 }
+
+func TestLz4Repeated(t *testing.T) {
+	xs := make([]int32, 0, 2000)
+	for i := 0; i < 2; i++ {
+		for j := int32(0); j < 1000; j++ {
+			xs = append(xs, j)
+		}
+	}
+	raw := encoding.EncodeInt32Slice(xs)
+	for i := 0; i < 3; i++ {
+		buf, err := Compress(raw, make([]byte, lz4.CompressBlockBound(len(raw))), Lz4)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := Decompress(append([]byte(nil), buf...), make([]byte, len(raw)), Lz4)
+		if err != nil {
+			t.Fatalf("round %d: %v", i, err)
+		}
+		if !bytes.Equal(raw, data) {
+			t.Fatalf("round %d: decompressed data differs", i)
+		}
+	}
+}
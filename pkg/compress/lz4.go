@@ -0,0 +1,89 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compress
+
+import (
+	"github.com/pierrec/lz4"
+)
+
+// uncompressLz4Block decodes a lz4 block into dst and returns the number
+// of bytes decoded. The amd64 decoder of lz4 loses the token of a match
+// that follows more than 16 bytes of literals, and then fails or decodes
+// a wrong length, so blocks are decoded here.
+func uncompressLz4Block(src, dst []byte) (int, error) {
+	si, di := 0, 0
+	for si < len(src) {
+		token := src[si]
+		si++
+
+		n := int(token >> 4)
+		if n == 0xF {
+			for {
+				if si >= len(src) {
+					return 0, lz4.ErrInvalidSourceShortBuffer
+				}
+				b := src[si]
+				si++
+				n += int(b)
+				if b != 0xFF {
+					break
+				}
+			}
+		}
+		if si+n > len(src) || di+n > len(dst) {
+			return 0, lz4.ErrInvalidSourceShortBuffer
+		}
+		di += copy(dst[di:], src[si:si+n])
+		si += n
+		// the last sequence has literals only
+		if si == len(src) {
+			break
+		}
+
+		if si+2 > len(src) {
+			return 0, lz4.ErrInvalidSourceShortBuffer
+		}
+		offset := int(src[si]) | int(src[si+1])<<8
+		si += 2
+		if offset == 0 || offset > di {
+			return 0, lz4.ErrInvalidSourceShortBuffer
+		}
+		n = int(token & 0xF)
+		if n == 0xF {
+			for {
+				if si >= len(src) {
+					return 0, lz4.ErrInvalidSourceShortBuffer
+				}
+				b := src[si]
+				si++
+				n += int(b)
+				if b != 0xFF {
+					break
+				}
+			}
+		}
+		n += 4
+		if di+n > len(dst) {
+			return 0, lz4.ErrInvalidSourceShortBuffer
+		}
+		// the match may overlap the bytes it writes
+		for match := di - offset; n > 0; {
+			c := copy(dst[di:di+n], dst[match:di])
+			di += c
+			n -= c
+		}
+	}
+	return di, nil
+}
@@ -0,0 +1,98 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compress
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/pierrec/lz4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUncompressLz4Block(t *testing.T) {
+	kases := []struct {
+		src []byte
+		dst []byte
+	}{
+		// literals only
+		{[]byte{0x30, 'a', 'b', 'c'}, []byte("abc")},
+		// a match repeating the literals, and the last literals
+		{[]byte{0x35, 'a', 'b', 'c', 0x03, 0x00, 0x10, 'x'}, []byte("abcabcabcabcx")},
+		// a match overlapping the bytes it writes
+		{[]byte{0x14, 'a', 0x01, 0x00, 0x10, 'b'}, []byte("aaaaaaaaab")},
+		// the length of literals is extended by one more byte
+		{append([]byte{0xF0, 0x05}, bytes.Repeat([]byte{'l'}, 20)...), bytes.Repeat([]byte{'l'}, 20)},
+		// the length of match is extended by one more byte
+		{[]byte{0x1F, 'z', 0x01, 0x00, 0x0A}, bytes.Repeat([]byte{'z'}, 30)},
+	}
+	for i, kase := range kases {
+		dst := make([]byte, len(kase.dst))
+		n, err := uncompressLz4Block(kase.src, dst)
+		require.NoError(t, err, "case %d", i)
+		require.Equal(t, kase.dst, dst[:n], "case %d", i)
+	}
+}
+
+func TestUncompressLz4BlockCorrupted(t *testing.T) {
+	kases := [][]byte{
+		// literals are truncated
+		{0x50, 'a', 'b'},
+		// the extended length of literals is truncated
+		{0xF0},
+		// the offset of match is truncated
+		{0x31, 'a', 'b', 'c', 0x03},
+		// the offset of match is 0
+		{0x11, 'a', 0x00, 0x00, 0x10, 'b'},
+		// the match is before the start of dst
+		{0x11, 'a', 0x05, 0x00, 0x10, 'b'},
+		// the extended length of match is truncated
+		{0x1F, 'a', 0x01, 0x00},
+	}
+	for i, src := range kases {
+		_, err := uncompressLz4Block(src, make([]byte, 64))
+		require.Error(t, err, "case %d", i)
+	}
+	// dst is too short for the match
+	_, err := uncompressLz4Block([]byte{0x35, 'a', 'b', 'c', 0x03, 0x00, 0x10, 'x'}, make([]byte, 5))
+	require.Error(t, err)
+}
+
+// TestUncompressLz4BlockCompressed checks the blocks compressed by lz4, the
+// repeated values are the ones which the amd64 decoder of lz4 fails to decode.
+func TestUncompressLz4BlockCompressed(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	random := make([]byte, 1<<16)
+	for i := range random {
+		random[i] = 'a' + byte(r.Intn(4))
+	}
+	repeated := make([]byte, 0, 8000)
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 1000; j++ {
+			repeated = append(repeated, byte(j), byte(j>>8), 0, 0)
+		}
+	}
+	for i, raw := range [][]byte{random, repeated, bytes.Repeat([]byte("matrixone"), 1000)} {
+		buf := make([]byte, lz4.CompressBlockBound(len(raw)))
+		n, err := lz4.CompressBlock(raw, buf, nil)
+		require.NoError(t, err, "case %d", i)
+		require.NotEqual(t, 0, n, "case %d", i)
+		dst := make([]byte, len(raw))
+		m, err := uncompressLz4Block(buf[:n], dst)
+		require.NoError(t, err, "case %d", i)
+		require.Equal(t, raw, dst[:m], "case %d", i)
+	}
+}
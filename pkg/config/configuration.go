@@ -16,6 +16,7 @@ package config
 
 import (
 	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
@@ -91,6 +92,9 @@ type ParameterUnit struct {
 
 	//Cube Catalog
 	ClusterCatalog *catalog.Catalog
+
+	//Accounts and privileges, privilege checking is disabled if it is nil
+	Privilege *privilege.Manager
 }
 
 func NewParameterUnit(sv *SystemVariables, hostMmu *host.Mmu, mempool *mempool.Mempool, storageEngine engine.Engine, clusterNodes engine.Nodes, catalogRef *catalog.Catalog) *ParameterUnit {
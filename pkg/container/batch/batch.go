@@ -17,6 +17,7 @@ package batch
 import (
 	"bytes"
 	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/errno"
@@ -58,7 +59,11 @@ func SetLength(bat *Batch, n int) {
 }
 
 func Shrink(bat *Batch, sels []int64) {
-	for _, vec := range bat.Vecs {
+	for i, vec := range bat.Vecs {
+		if j := sharedVector(bat.Vecs[:i], vec); j >= 0 {
+			vec.Col, vec.Nsp = bat.Vecs[j].Col, bat.Vecs[j].Nsp
+			continue
+		}
 		vector.Shrink(vec, sels)
 	}
 	for _, r := range bat.Rs {
@@ -73,7 +78,11 @@ func Shrink(bat *Batch, sels []int64) {
 
 func Shuffle(bat *Batch, m *mheap.Mheap) error {
 	if bat.SelsData != nil {
-		for _, vec := range bat.Vecs {
+		for i, vec := range bat.Vecs {
+			if j := sharedVector(bat.Vecs[:i], vec); j >= 0 {
+				vec.Col, vec.Nsp = bat.Vecs[j].Col, bat.Vecs[j].Nsp
+				continue
+			}
 			if err := vector.Shuffle(vec, bat.Sels, m); err != nil {
 				return err
 			}
@@ -95,6 +104,21 @@ func Shuffle(bat *Batch, m *mheap.Mheap) error {
 	return nil
 }
 
+// sharedVector returns the index of the vector in vecs which shares the data
+// with vec, the projection reuses the vector for the renamed attributes, so
+// the data must be shrunk or shuffled only once.
+func sharedVector(vecs []*vector.Vector, vec *vector.Vector) int {
+	for i, v := range vecs {
+		if v == vec || (len(v.Data) > 0 && len(vec.Data) > 0 && &v.Data[0] == &vec.Data[0]) {
+			return i
+		}
+		if bs, ok := v.Col.(*types.Bytes); ok && bs == vec.Col {
+			return i
+		}
+	}
+	return -1
+}
+
 func Length(bat *Batch) int {
 	return len(bat.Zs)
 }
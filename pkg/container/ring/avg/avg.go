@@ -153,6 +153,8 @@ func (r *AvgRing) Fill(i int64, sel, z int64, vec *vector.Vector) {
 		r.Vs[i] += float64(vec.Col.([]float32)[sel]) * float64(z)
 	case types.T_float64:
 		r.Vs[i] += float64(vec.Col.([]float64)[sel]) * float64(z)
+	case types.T_decimal:
+		r.Vs[i] += vec.Col.([]types.Decimal)[sel].ToFloat64(types.DecimalScale(vec.Typ)) * float64(z)
 	}
 	if nulls.Contains(vec.Nsp, uint64(sel)) {
 		r.Ns[i] += z
@@ -211,6 +213,11 @@ func (r *AvgRing) BatchFill(start int64, os []uint8, vps []uint64, zs []int64, v
 		for i := range os {
 			r.Vs[vps[i]-1] += float64(vs[int64(i)+start]) * float64(zs[int64(i)+start])
 		}
+	case types.T_decimal:
+		vs, scale := vec.Col.([]types.Decimal), types.DecimalScale(vec.Typ)
+		for i := range os {
+			r.Vs[vps[i]-1] += vs[int64(i)+start].ToFloat64(scale) * float64(zs[int64(i)+start])
+		}
 	}
 	if nulls.Any(vec.Nsp) {
 		for i := range os {
@@ -343,6 +350,18 @@ func (r *AvgRing) BulkFill(i int64, zs []int64, vec *vector.Vector) {
 				}
 			}
 		}
+	case types.T_decimal:
+		vs, scale := vec.Col.([]types.Decimal), types.DecimalScale(vec.Typ)
+		for j, v := range vs {
+			r.Vs[i] += v.ToFloat64(scale) * float64(zs[j])
+		}
+		if nulls.Any(vec.Nsp) {
+			for j := range vs {
+				if nulls.Contains(vec.Nsp, uint64(j)) {
+					r.Ns[i] += zs[j]
+				}
+			}
+		}
 	}
 }
 
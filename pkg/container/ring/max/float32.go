@@ -141,53 +141,48 @@ func (r *Float32Ring) Grows(size int, m *mheap.Mheap) error {
 }
 
 func (r *Float32Ring) Fill(i int64, sel, z int64, vec *vector.Vector) {
+	if nulls.Contains(vec.Nsp, uint64(sel)) {
+		r.Ns[i] += z
+		return
+	}
 	if v := vec.Col.([]float32)[sel]; r.Es[i] || v > r.Vs[i] {
 		r.Vs[i] = v
 		r.Es[i] = false
 	}
-	if nulls.Contains(vec.Nsp, uint64(sel)) {
-		r.Ns[i] += z
-	}
 }
 
 func (r *Float32Ring) BatchFill(start int64, os []uint8, vps []uint64, zs []int64, vec *vector.Vector) {
 	vs := vec.Col.([]float32)
 	for i := range os {
+		if nulls.Contains(vec.Nsp, uint64(start)+uint64(i)) {
+			r.Ns[vps[i]-1] += zs[int64(i)+start]
+			continue
+		}
 		j := vps[i] - 1
 		if r.Es[j] || vs[int64(i)+start] > r.Vs[j] {
 			r.Vs[j] = vs[int64(i)+start]
 			r.Es[j] = false
 		}
 	}
-	if nulls.Any(vec.Nsp) {
-		for i := range os {
-			if nulls.Contains(vec.Nsp, uint64(start)+uint64(i)) {
-				r.Ns[vps[i]-1] += zs[int64(i)+start]
-			}
-		}
-	}
 }
 
 func (r *Float32Ring) BulkFill(i int64, zs []int64, vec *vector.Vector) {
 	vs := vec.Col.([]float32)
-	for _, v := range vs {
+	for j, v := range vs {
+		if nulls.Contains(vec.Nsp, uint64(j)) {
+			r.Ns[i] += zs[j]
+			continue
+		}
 		if r.Es[i] || v > r.Vs[i] {
 			r.Vs[i] = v
 			r.Es[i] = false
 		}
 	}
-	if nulls.Any(vec.Nsp) {
-		for j := range vs {
-			if nulls.Contains(vec.Nsp, uint64(j)) {
-				r.Ns[i] += zs[j]
-			}
-		}
-	}
 }
 
 func (r *Float32Ring) Add(a interface{}, x, y int64) {
 	ar := a.(*Float32Ring)
-	if r.Es[x] || ar.Vs[y] > r.Vs[x] {
+	if !ar.Es[y] && (r.Es[x] || ar.Vs[y] > r.Vs[x]) {
 		r.Es[x] = false
 		r.Vs[x] = ar.Vs[y]
 	}
@@ -198,7 +193,7 @@ func (r *Float32Ring) BatchAdd(a interface{}, start int64, os []uint8, vps []uin
 	ar := a.(*Float32Ring)
 	for i := range os {
 		j := vps[i] - 1
-		if r.Es[j] || ar.Vs[int64(i)+start] > r.Vs[j] {
+		if !ar.Es[int64(i)+start] && (r.Es[j] || ar.Vs[int64(i)+start] > r.Vs[j]) {
 			r.Es[j] = false
 			r.Vs[j] = ar.Vs[int64(i)+start]
 		}
@@ -208,7 +203,7 @@ func (r *Float32Ring) BatchAdd(a interface{}, start int64, os []uint8, vps []uin
 
 func (r *Float32Ring) Mul(a interface{}, x, y, z int64) {
 	ar := a.(*Float32Ring)
-	if r.Es[x] || ar.Vs[y] > r.Vs[x] {
+	if !ar.Es[y] && (r.Es[x] || ar.Vs[y] > r.Vs[x]) {
 		r.Es[x] = false
 		r.Vs[x] = ar.Vs[y]
 	}
@@ -109,3 +109,10 @@ type DatetimeRing struct {
 	Ns  []int64
 	Typ types.Type
 }
+
+type DecimalRing struct {
+	Da  []byte
+	Vs  []types.Decimal
+	Ns  []int64
+	Typ types.Type
+}
@@ -36,3 +36,10 @@ type FloatRing struct {
 	Vs  []float64
 	Typ types.Type
 }
+
+type DecimalRing struct {
+	Da  []byte
+	Ns  []int64
+	Vs  []types.Decimal
+	Typ types.Type
+}
@@ -14,6 +14,455 @@
 
 package types
 
+import (
+	"math"
+	"math/big"
+	"math/bits"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+const (
+	// MaxDecimalPrecision is the max number of digits a decimal can hold.
+	MaxDecimalPrecision = 38
+	// DefaultDecimalPrecision is the precision of DECIMAL declared without (M, D).
+	DefaultDecimalPrecision = 10
+	// DecimalDivIncrement is the number of digits added to the scale of a division result.
+	DecimalDivIncrement = 4
+)
+
+var (
+	errIncorrectDecimalValue = errors.New(errno.DataException, "Incorrect decimal value")
+	errDecimalOutOfRange     = errors.New(errno.DataException, "Decimal value is out of range")
+	errDecimalDivByZero      = errors.New(errno.SyntaxErrororAccessRuleViolation, "division by zero")
+
+	// MaxDecimal and MinDecimal are the largest and smallest 128-bit integers,
+	// they are the initial values of min and max aggregations.
+	MaxDecimal = Decimal{Lo: math.MaxUint64, Hi: math.MaxInt64}
+	MinDecimal = Decimal{Hi: math.MinInt64}
+
+	// pow10[i] is 10^i
+	pow10 [MaxDecimalPrecision + 1]Decimal
+)
+
+func init() {
+	pow10[0] = NewDecimal(1)
+	for i := 1; i < len(pow10); i++ {
+		pow10[i] = pow10[i-1].mulSmall(10)
+	}
+}
+
+// NewDecimal returns the decimal whose scaled integer is v.
+func NewDecimal(v int64) Decimal {
+	return Decimal{Lo: uint64(v), Hi: v >> 63}
+}
+
+// DecimalScale returns the scale of a decimal whose type is t.
+func DecimalScale(t Type) int32 {
+	if t.Precision < 0 {
+		return 0
+	}
+	return t.Precision
+}
+
+// DecimalPrecision returns the precision of a decimal whose type is t.
+func DecimalPrecision(t Type) int32 {
+	if t.Width <= 0 || t.Width > MaxDecimalPrecision {
+		return MaxDecimalPrecision
+	}
+	return t.Width
+}
+
+// DecimalType returns the type of DECIMAL(precision, scale).
+func DecimalType(precision, scale int32) Type {
+	return Type{Oid: T_decimal, Size: 16, Width: precision, Precision: scale}
+}
+
+// ParseDecimal will parse a string to be a Decimal of DECIMAL(precision, scale),
+// the fractional digits beyond scale are rounded half away from zero.
+// Support Format:
+// `[+-]ddd.ddd`
+// `[+-]ddd.ddde[+-]dd`
+func ParseDecimal(s string, precision, scale int32) (Decimal, error) {
+	s = strings.TrimSpace(s)
+	neg := false
+	if len(s) > 0 && (s[0] == '-' || s[0] == '+') {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+	exp := 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		v, err := strconv.Atoi(s[i+1:])
+		if err != nil {
+			return Decimal{}, errIncorrectDecimalValue
+		}
+		exp, s = v, s[:i]
+	}
+	intPart, fracPart := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		intPart, fracPart = s[:i], s[i+1:]
+	}
+	if len(intPart) == 0 && len(fracPart) == 0 {
+		return Decimal{}, errIncorrectDecimalValue
+	}
+	digits := intPart + fracPart
+	for i := 0; i < len(digits); i++ {
+		if digits[i] < '0' || digits[i] > '9' {
+			return Decimal{}, errIncorrectDecimalValue
+		}
+	}
+	// move the decimal point by exponent
+	point := len(intPart) + exp
+	switch {
+	case point < 0:
+		if -point > len(digits)+int(scale) { // too small, it is zero after rounding
+			return Decimal{}, nil
+		}
+		digits = strings.Repeat("0", -point) + digits
+		point = 0
+	case point > len(digits):
+		if point > len(digits)+MaxDecimalPrecision {
+			return Decimal{}, errDecimalOutOfRange
+		}
+		digits = digits + strings.Repeat("0", point-len(digits))
+	}
+	intPart = strings.TrimLeft(digits[:point], "0")
+	fracPart = digits[point:]
+	if len(intPart) > int(precision-scale) {
+		return Decimal{}, errDecimalOutOfRange
+	}
+	var v Decimal
+	for i := 0; i < len(intPart); i++ {
+		v = v.mulSmall(10).Add(NewDecimal(int64(intPart[i] - '0')))
+	}
+	for i := 0; i < int(scale); i++ {
+		v = v.mulSmall(10)
+		if i < len(fracPart) {
+			v = v.Add(NewDecimal(int64(fracPart[i] - '0')))
+		}
+	}
+	if int(scale) < len(fracPart) && fracPart[scale] >= '5' {
+		v = v.Add(NewDecimal(1))
+	}
+	if !v.inRange(precision) {
+		return Decimal{}, errDecimalOutOfRange
+	}
+	if neg {
+		return v.Neg(), nil
+	}
+	return v, nil
+}
+
+// DecimalFromInt64 converts an integer to be a Decimal of DECIMAL(precision, scale).
+func DecimalFromInt64(v int64, precision, scale int32) (Decimal, error) {
+	r := NewDecimal(v)
+	if !r.inRange(precision - scale) {
+		return Decimal{}, errDecimalOutOfRange
+	}
+	r, _ = mulDiv(r, pow10[scale], pow10[0])
+	return r, nil
+}
+
+// DecimalFromUint64 converts an unsigned integer to be a Decimal of DECIMAL(precision, scale).
+func DecimalFromUint64(v uint64, precision, scale int32) (Decimal, error) {
+	r := Decimal{Lo: v}
+	if !r.inRange(precision - scale) {
+		return Decimal{}, errDecimalOutOfRange
+	}
+	r, _ = mulDiv(r, pow10[scale], pow10[0])
+	return r, nil
+}
+
+// DecimalFromFloat64 converts a float to be a Decimal of DECIMAL(precision, scale).
+func DecimalFromFloat64(v float64, precision, scale int32) (Decimal, error) {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return Decimal{}, errDecimalOutOfRange
+	}
+	return ParseDecimal(strconv.FormatFloat(v, 'f', -1, 64), precision, scale)
+}
+
+// Format returns the string representation of a decimal with scale.
+func (a Decimal) Format(scale int32) string {
+	s := a.Abs().digits()
+	if scale > 0 {
+		if len(s) <= int(scale) {
+			s = strings.Repeat("0", int(scale)-len(s)+1) + s
+		}
+		s = s[:len(s)-int(scale)] + "." + s[len(s)-int(scale):]
+	}
+	if a.Hi < 0 {
+		return "-" + s
+	}
+	return s
+}
+
 func (a Decimal) String() string {
-	return ""
+	return a.Format(0)
+}
+
+// MarshalJSON encodes the scaled integer of a decimal as a string, a number
+// loses the low digits when it is decoded as a float.
+func (a Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(a.String())), nil
+}
+
+func (a *Decimal) UnmarshalJSON(data []byte) error {
+	s, err := strconv.Unquote(string(data))
+	if err != nil {
+		return errIncorrectDecimalValue
+	}
+	v, err := ParseDecimal(s, MaxDecimalPrecision, 0)
+	if err != nil {
+		return err
+	}
+	*a = v
+	return nil
+}
+
+// ToFloat64 converts a decimal with scale to be a float.
+func (a Decimal) ToFloat64(scale int32) float64 {
+	v := a.Abs()
+	f := float64(uint64(v.Hi))*(1<<64) + float64(v.Lo)
+	if a.Hi < 0 {
+		f = -f
+	}
+	return f / math.Pow10(int(scale))
+}
+
+// ToInt64 converts a decimal with scale to be an integer, the fractional part is rounded
+// half away from zero.
+func (a Decimal) ToInt64(scale int32) (int64, error) {
+	v, ok := mulDiv(a, pow10[0], pow10[scale])
+	if !ok || !v.isInt64() {
+		return 0, errDecimalOutOfRange
+	}
+	return int64(v.Lo), nil
+}
+
+// Rescale converts a decimal of scale from to be a Decimal of DECIMAL(precision, to).
+func (a Decimal) Rescale(from, precision, to int32) (Decimal, error) {
+	v, ok := a, true
+	switch {
+	case to > from:
+		v, ok = mulDiv(a, pow10[to-from], pow10[0])
+	case to < from:
+		v, ok = mulDiv(a, pow10[0], pow10[from-to])
+	}
+	if !ok || !v.inRange(precision) {
+		return Decimal{}, errDecimalOutOfRange
+	}
+	return v, nil
+}
+
+// Add returns a + b, it wraps around on overflow like the native integers.
+func (a Decimal) Add(b Decimal) Decimal {
+	lo, carry := bits.Add64(a.Lo, b.Lo, 0)
+	return Decimal{Lo: lo, Hi: a.Hi + b.Hi + int64(carry)}
+}
+
+// Sub returns a - b, it wraps around on overflow like the native integers.
+func (a Decimal) Sub(b Decimal) Decimal {
+	lo, borrow := bits.Sub64(a.Lo, b.Lo, 0)
+	return Decimal{Lo: lo, Hi: a.Hi - b.Hi - int64(borrow)}
+}
+
+// MulInt64 returns a * z, it wraps around on overflow like the native integers.
+func (a Decimal) MulInt64(z int64) Decimal {
+	hi, lo := bits.Mul64(a.Lo, uint64(z))
+	hi += uint64(a.Hi) * uint64(z)
+	if z < 0 {
+		hi -= a.Lo
+	}
+	return Decimal{Lo: lo, Hi: int64(hi)}
+}
+
+// Neg returns -a.
+func (a Decimal) Neg() Decimal {
+	return Decimal{}.Sub(a)
+}
+
+// Abs returns |a|.
+func (a Decimal) Abs() Decimal {
+	if a.Hi < 0 {
+		return a.Neg()
+	}
+	return a
+}
+
+// Compare returns -1, 0 or 1 when a is less than, equal to or greater than b.
+func (a Decimal) Compare(b Decimal) int {
+	switch {
+	case a.Hi < b.Hi:
+		return -1
+	case a.Hi > b.Hi:
+		return 1
+	case a.Lo < b.Lo:
+		return -1
+	case a.Lo > b.Lo:
+		return 1
+	}
+	return 0
+}
+
+// Lt returns a < b.
+func (a Decimal) Lt(b Decimal) bool {
+	return a.Compare(b) < 0
+}
+
+// IsZero returns a == 0.
+func (a Decimal) IsZero() bool {
+	return a.Lo == 0 && a.Hi == 0
+}
+
+// DecimalAdd returns a + b, the error is returned if the sum is out of DECIMAL(38).
+func DecimalAdd(a, b Decimal) (Decimal, error) {
+	r := a.Add(b)
+	if (a.Hi < 0) == (b.Hi < 0) && (r.Hi < 0) != (a.Hi < 0) || !r.inRange(MaxDecimalPrecision) {
+		return Decimal{}, errDecimalOutOfRange
+	}
+	return r, nil
+}
+
+// DecimalSub returns a - b, the error is returned if the difference is out of DECIMAL(38).
+func DecimalSub(a, b Decimal) (Decimal, error) {
+	r := a.Sub(b)
+	if (a.Hi < 0) != (b.Hi < 0) && (r.Hi < 0) != (a.Hi < 0) || !r.inRange(MaxDecimalPrecision) {
+		return Decimal{}, errDecimalOutOfRange
+	}
+	return r, nil
+}
+
+// DecimalMul returns a * b, the scale of a * b is reduced by shift digits
+// and the fractional digits beyond are rounded half away from zero.
+func DecimalMul(a, b Decimal, shift int32) (Decimal, error) {
+	v, ok := mulDiv(a, b, pow10[shift])
+	if !ok || !v.inRange(MaxDecimalPrecision) {
+		return Decimal{}, errDecimalOutOfRange
+	}
+	return v, nil
+}
+
+// DecimalDiv returns a * 10^shift / b, the fractional digits beyond are
+// rounded half away from zero.
+func DecimalDiv(a, b Decimal, shift int32) (Decimal, error) {
+	if b.IsZero() {
+		return Decimal{}, errDecimalDivByZero
+	}
+	var v Decimal
+	var ok bool
+	if shift <= MaxDecimalPrecision {
+		v, ok = mulDiv(a, pow10[shift], b)
+	} else {
+		y := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(shift)), nil)
+		v, ok = bigMulDiv(a.toBig(), y, b.toBig())
+	}
+	if !ok || !v.inRange(MaxDecimalPrecision) {
+		return Decimal{}, errDecimalOutOfRange
+	}
+	return v, nil
+}
+
+// mulDiv returns x * y / z which is rounded half away from zero, and
+// false if the result overflows 128 bits.
+func mulDiv(x, y, z Decimal) (Decimal, bool) {
+	if x.isInt64() && y.isInt64() && z.isInt64() {
+		ux, uy, uz := x.Abs().Lo, y.Abs().Lo, z.Abs().Lo
+		if hi, lo := bits.Mul64(ux, uy); hi < uz {
+			if q, r := bits.Div64(hi, lo, uz); q < math.MaxInt64 {
+				if r >= uz-r {
+					q++
+				}
+				v := NewDecimal(int64(q))
+				if (x.Hi < 0) != (y.Hi < 0) != (z.Hi < 0) {
+					v = v.Neg()
+				}
+				return v, true
+			}
+		}
+	}
+	return bigMulDiv(x.toBig(), y.toBig(), z.toBig())
+}
+
+func bigMulDiv(x, y, z *big.Int) (Decimal, bool) {
+	neg := (x.Sign() < 0) != (y.Sign() < 0) != (z.Sign() < 0)
+	n := new(big.Int).Mul(x.Abs(x), y.Abs(y))
+	q, r := n.QuoRem(n, z.Abs(z), new(big.Int))
+	if r.Lsh(r, 1).Cmp(z) >= 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	if neg {
+		q.Neg(q)
+	}
+	return decimalFromBig(q)
+}
+
+func (a Decimal) toBig() *big.Int {
+	v := new(big.Int).SetInt64(a.Hi)
+	v.Lsh(v, 64)
+	return v.Or(v, new(big.Int).SetUint64(a.Lo))
+}
+
+func decimalFromBig(v *big.Int) (Decimal, bool) {
+	if v.BitLen() > 127 {
+		return Decimal{}, false
+	}
+	u := new(big.Int).Abs(v)
+	r := Decimal{Lo: u.Uint64(), Hi: int64(u.Rsh(u, 64).Uint64())}
+	if v.Sign() < 0 {
+		r = r.Neg()
+	}
+	return r, true
+}
+
+// isInt64 returns true if a fits in 64 bits.
+func (a Decimal) isInt64() bool {
+	return a.Hi == int64(a.Lo)>>63
+}
+
+// inRange returns true if |a| < 10^precision.
+func (a Decimal) inRange(precision int32) bool {
+	if precision > MaxDecimalPrecision {
+		precision = MaxDecimalPrecision
+	}
+	if precision < 0 {
+		return false
+	}
+	if a.Hi < 0 {
+		return pow10[precision].Neg().Lt(a)
+	}
+	return a.Lt(pow10[precision])
+}
+
+// mulSmall returns a * m for a non-negative a, the overflow is ignored.
+func (a Decimal) mulSmall(m uint64) Decimal {
+	hi, lo := bits.Mul64(a.Lo, m)
+	return Decimal{Lo: lo, Hi: int64(uint64(a.Hi)*m + hi)}
+}
+
+// digits returns the decimal digits of a non-negative a.
+func (a Decimal) digits() string {
+	const base = 10000000000000000000 // 10^19
+	if a.Hi == 0 {
+		return strconv.FormatUint(a.Lo, 10)
+	}
+	hi, lo := uint64(a.Hi), a.Lo
+	var parts []uint64
+	for hi != 0 {
+		var r uint64
+		q := hi / base
+		lo, r = bits.Div64(hi%base, lo, base)
+		hi = q
+		parts = append(parts, r)
+	}
+	var b strings.Builder
+	b.WriteString(strconv.FormatUint(lo, 10))
+	for i := len(parts) - 1; i >= 0; i-- {
+		s := strconv.FormatUint(parts[i], 10)
+		b.WriteString(strings.Repeat("0", 19-len(s)))
+		b.WriteString(s)
+	}
+	return b.String()
 }
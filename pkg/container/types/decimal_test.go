@@ -0,0 +1,229 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"math"
+	"testing"
+)
+
+func TestParseDecimal(t *testing.T) {
+	type args struct {
+		s         string
+		precision int32
+		scale     int32
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		// 1. normal format
+		{
+			name: "integer",
+			args: args{s: "123", precision: 10, scale: 2},
+			want: "123.00",
+		},
+		{
+			name: "fraction",
+			args: args{s: "-0.5", precision: 10, scale: 3},
+			want: "-0.500",
+		},
+		{
+			name: "no_integer_part",
+			args: args{s: ".25", precision: 4, scale: 2},
+			want: "0.25",
+		},
+		// 2. rounding
+		{
+			name: "round_up",
+			args: args{s: "1.005", precision: 4, scale: 2},
+			want: "1.01",
+		},
+		{
+			name: "round_down",
+			args: args{s: "-1.004", precision: 4, scale: 2},
+			want: "-1.00",
+		},
+		// 3. exponent
+		{
+			name: "positive_exponent",
+			args: args{s: "1.5e2", precision: 6, scale: 1},
+			want: "150.0",
+		},
+		{
+			name: "negative_exponent",
+			args: args{s: "125e-2", precision: 6, scale: 2},
+			want: "1.25",
+		},
+		// 4. range test
+		{
+			name:    "integer_overflow",
+			args:    args{s: "1000", precision: 5, scale: 2},
+			wantErr: true,
+		},
+		{
+			name:    "rounding_overflow",
+			args:    args{s: "99.999", precision: 4, scale: 2},
+			wantErr: true,
+		},
+		// 5. beyond 64 bits
+		{
+			name: "decimal_30_4",
+			args: args{s: "-12345678901234567890123456.78905", precision: 30, scale: 4},
+			want: "-12345678901234567890123456.7891",
+		},
+		{
+			name: "max_precision",
+			args: args{s: "99999999999999999999999999999999999999", precision: 38, scale: 0},
+			want: "99999999999999999999999999999999999999",
+		},
+		{
+			name:    "max_precision_overflow",
+			args:    args{s: "1e38", precision: 38, scale: 0},
+			wantErr: true,
+		},
+		// 6. wrong format
+		{
+			name:    "not_a_number",
+			args:    args{s: "1.2a", precision: 10, scale: 2},
+			wantErr: true,
+		},
+		{
+			name:    "empty",
+			args:    args{s: "", precision: 10, scale: 2},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDecimal(tt.args.s, tt.args.precision, tt.args.scale)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseDecimal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil && tt.wantErr {
+				return
+			}
+			if got.Format(tt.args.scale) != tt.want {
+				t.Errorf("ParseDecimal() got = %v, want %v", got.Format(tt.args.scale), tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimalRescale(t *testing.T) {
+	tests := []struct {
+		name      string
+		v         Decimal
+		from      int32
+		precision int32
+		to        int32
+		want      string
+		wantErr   bool
+	}{
+		{name: "scale_up", v: NewDecimal(125), from: 2, precision: 10, to: 4, want: "1.2500"},
+		{name: "scale_down", v: NewDecimal(-1255), from: 3, precision: 10, to: 2, want: "-1.26"},
+		{name: "same_scale", v: NewDecimal(42), from: 1, precision: 3, to: 1, want: "4.2"},
+		{name: "overflow", v: NewDecimal(12345), from: 0, precision: 6, to: 2, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.v.Rescale(tt.from, tt.precision, tt.to)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Rescale() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil && tt.wantErr {
+				return
+			}
+			if got.Format(tt.to) != tt.want {
+				t.Errorf("Rescale() got = %v, want %v", got.Format(tt.to), tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimalMulDiv(t *testing.T) {
+	// 1.25 * -0.5 = -0.625 with scale 3
+	if got, err := DecimalMul(NewDecimal(125), NewDecimal(-5), 0); err != nil || got.Format(3) != "-0.625" {
+		t.Errorf("DecimalMul() got = %v, err = %v", got.Format(3), err)
+	}
+	// reduce the scale of 1.25 * 0.5 from 3 to 2
+	if got, err := DecimalMul(NewDecimal(125), NewDecimal(5), 1); err != nil || got.Format(2) != "0.63" {
+		t.Errorf("DecimalMul() got = %v, err = %v", got.Format(2), err)
+	}
+	// 10^12 * 10^12 is beyond 64 bits
+	x := NewDecimal(1000000000000)
+	if got, err := DecimalMul(x, x.Neg(), 0); err != nil || got.String() != "-1000000000000000000000000" {
+		t.Errorf("DecimalMul() got = %v, err = %v", got, err)
+	}
+	if got, err := DecimalMul(x, x, 0); err != nil {
+		t.Errorf("DecimalMul() err = %v", err)
+	} else if _, err = DecimalMul(got, got, 0); err == nil {
+		t.Errorf("DecimalMul() expect out of range error")
+	}
+	// 1.00 / 3 = 0.333333 with scale 2 + 4
+	if got, err := DecimalDiv(NewDecimal(100), NewDecimal(3), 4); err != nil || got.Format(6) != "0.333333" {
+		t.Errorf("DecimalDiv() got = %v, err = %v", got.Format(6), err)
+	}
+	if _, err := DecimalDiv(NewDecimal(100), NewDecimal(0), 4); err == nil {
+		t.Errorf("DecimalDiv() expect division by zero error")
+	}
+}
+
+func TestDecimalAddSub(t *testing.T) {
+	x, _ := ParseDecimal("12345678901234567890123456.7890", 30, 4)
+	y, _ := ParseDecimal("0.0110", 30, 4)
+	if got, err := DecimalAdd(x, y); err != nil || got.Format(4) != "12345678901234567890123456.8000" {
+		t.Errorf("DecimalAdd() got = %v, err = %v", got.Format(4), err)
+	}
+	if got, err := DecimalSub(y, x); err != nil || got.Format(4) != "-12345678901234567890123456.7780" {
+		t.Errorf("DecimalSub() got = %v, err = %v", got.Format(4), err)
+	}
+	max, _ := ParseDecimal("99999999999999999999999999999999999999", 38, 0)
+	if _, err := DecimalAdd(max, NewDecimal(1)); err == nil {
+		t.Errorf("DecimalAdd() expect out of range error")
+	}
+	if _, err := DecimalSub(max.Neg(), max); err == nil {
+		t.Errorf("DecimalSub() expect out of range error")
+	}
+	if x.Compare(y) <= 0 || x.Neg().Compare(y) >= 0 || x.Compare(x) != 0 {
+		t.Errorf("Compare() got wrong order")
+	}
+}
+
+func TestDecimalConvert(t *testing.T) {
+	x, _ := ParseDecimal("-12345678901234567890.5", 30, 4)
+	if f := x.ToFloat64(4); math.Abs(f/-12345678901234567890.5-1) > 1e-15 {
+		t.Errorf("ToFloat64() got = %v", f)
+	}
+	if _, err := x.ToInt64(4); err == nil {
+		t.Errorf("ToInt64() expect out of range error")
+	}
+	if v, err := NewDecimal(-25).ToInt64(1); err != nil || v != -3 {
+		t.Errorf("ToInt64() got = %v, err = %v", v, err)
+	}
+	if v, err := DecimalFromUint64(math.MaxUint64, 30, 4); err != nil || v.Format(4) != "18446744073709551615.0000" {
+		t.Errorf("DecimalFromUint64() got = %v, err = %v", v.Format(4), err)
+	}
+	if v, err := DecimalFromInt64(math.MinInt64, 30, 10); err != nil || v.Format(10) != "-9223372036854775808.0000000000" {
+		t.Errorf("DecimalFromInt64() got = %v, err = %v", v.Format(10), err)
+	}
+	if _, err := DecimalFromInt64(math.MinInt64, 30, 12); err == nil {
+		t.Errorf("DecimalFromInt64() expect out of range error")
+	}
+}
@@ -81,7 +81,11 @@ type Date int32
 
 type Datetime int64
 
+// Decimal is a fixed-point number stored as a scaled 128-bit two's complement
+// integer, the precision and scale are kept by Type.Width and Type.Precision.
 type Decimal struct {
+	Lo uint64
+	Hi int64
 }
 
 var Types map[string]T = map[string]T{
@@ -132,6 +136,8 @@ func (t T) ToType() Type {
 		typ.Size = 4
 	case T_int64, T_datetime:
 		typ.Size = 8
+	case T_decimal:
+		typ.Size = 16
 	case T_uint8:
 		typ.Size = 1
 	case T_uint16:
@@ -231,6 +237,8 @@ func (t T) OidString() string {
 		return "T_date"
 	case T_datetime:
 		return "T_datetime"
+	case T_decimal:
+		return "T_decimal"
 	}
 	return "unknown_type"
 }
@@ -268,6 +276,8 @@ func (t T) GoType() string {
 		return "date"
 	case T_datetime:
 		return "datetime"
+	case T_decimal:
+		return "decimal"
 	}
 	return "unknown type"
 }
@@ -292,6 +302,8 @@ func (t T) TypeLen() int {
 		return 4
 	case T_int64, T_datetime:
 		return 8
+	case T_decimal:
+		return 16
 	case T_uint8:
 		return 1
 	case T_uint16:
@@ -249,6 +249,13 @@ func PreAlloc(v, w *Vector, rows int, m *mheap.Mheap) {
 		}
 		v.Data = data
 		v.Col = encoding.DecodeDatetimeSlice(v.Data)[:0]
+	case types.T_decimal:
+		data, err := mheap.Alloc(m, int64(rows*16))
+		if err != nil {
+			return
+		}
+		v.Data = data
+		v.Col = encoding.DecodeDecimalSlice(v.Data)[:0]
 	case types.T_char, types.T_varchar:
 		vs, ws := v.Col.(*types.Bytes), w.Col.(*types.Bytes)
 		data, err := mheap.Alloc(m, int64(rows*len(ws.Data)/len(ws.Offsets)))
@@ -350,6 +357,11 @@ func SetLength(v *Vector, n int) {
 		m := len(vs)
 		v.Col = vs[:n]
 		nulls.RemoveRange(v.Nsp, uint64(n), uint64(m))
+	case types.T_decimal:
+		vs := v.Col.([]types.Decimal)
+		m := len(vs)
+		v.Col = vs[:n]
+		nulls.RemoveRange(v.Nsp, uint64(n), uint64(m))
 	default:
 		panic(fmt.Sprintf("unexpect type %s for function vector.SetLength", v.Typ))
 	}
@@ -577,6 +589,22 @@ func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 			Ref:  v.Ref,
 			Link: v.Link,
 		}, nil
+	case types.T_decimal:
+		vs := v.Col.([]types.Decimal)
+		data, err := mheap.Alloc(m, int64(len(vs)*16))
+		if err != nil {
+			return nil, err
+		}
+		ws := encoding.DecodeDecimalSlice(data)
+		copy(ws, vs)
+		return &Vector{
+			Col:  ws,
+			Data: data,
+			Typ:  v.Typ,
+			Nsp:  v.Nsp,
+			Ref:  v.Ref,
+			Link: v.Link,
+		}, nil
 	}
 	return nil, fmt.Errorf("unsupport type %v", v.Typ)
 }
@@ -629,6 +657,9 @@ func Window(v *Vector, start, end int, w *Vector) *Vector {
 	case types.T_datetime:
 		w.Col = v.Col.([]types.Datetime)[start:end]
 		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
+	case types.T_decimal:
+		w.Col = v.Col.([]types.Decimal)[start:end]
+		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
 	default:
 		panic(fmt.Sprintf("unexpect type %s for function vector.Window", v.Typ))
 	}
@@ -784,6 +815,13 @@ func Shrink(v *Vector, sels []int64) {
 		}
 		v.Col = vs[:len(sels)]
 		v.Nsp = nulls.Filter(v.Nsp, sels)
+	case types.T_decimal:
+		vs := v.Col.([]types.Decimal)
+		for i, sel := range sels {
+			vs[i] = vs[sel]
+		}
+		v.Col = vs[:len(sels)]
+		v.Nsp = nulls.Filter(v.Nsp, sels)
 	}
 }
 
@@ -941,6 +979,16 @@ func Shuffle(v *Vector, sels []int64, m *mheap.Mheap) error {
 		v.Col = shuffle.DatetimeShuffle(vs, ws, sels)
 		v.Nsp = nulls.Filter(v.Nsp, sels)
 		mheap.Free(m, data)
+	case types.T_decimal:
+		vs := v.Col.([]types.Decimal)
+		data, err := mheap.Alloc(m, int64(len(vs)*16))
+		if err != nil {
+			return err
+		}
+		ws := encoding.DecodeDecimalSlice(data)
+		v.Col = shuffle.DecimalShuffle(vs, ws, sels)
+		v.Nsp = nulls.Filter(v.Nsp, sels)
+		mheap.Free(m, data)
 	default:
 		panic(fmt.Sprintf("unexpect type %s for function vector.Shuffle", v.Typ))
 	}
@@ -1341,6 +1389,33 @@ func UnionOne(v, w *Vector, sel int64, m *mheap.Mheap) error {
 			vs = append(vs, w.Col.([]types.Datetime)[sel])
 			v.Col = vs
 		}
+	case types.T_decimal:
+		if len(v.Data) == 0 {
+			data, err := mheap.Alloc(m, 8*16)
+			if err != nil {
+				return err
+			}
+			v.Ref = w.Ref
+			vs := encoding.DecodeDecimalSlice(data)
+			vs[0] = w.Col.([]types.Decimal)[sel]
+			v.Col = vs[:1]
+			v.Data = data
+		} else {
+			vs := v.Col.([]types.Decimal)
+			if n := len(vs); n+1 >= cap(vs) {
+				data, err := mheap.Grow(m, v.Data[:n*16], int64(n+1)*16)
+				if err != nil {
+					return err
+				}
+				mheap.Free(m, v.Data)
+				vs = encoding.DecodeDecimalSlice(data)
+				vs = vs[:n]
+				v.Col = vs
+				v.Data = data
+			}
+			vs = append(vs, w.Col.([]types.Decimal)[sel])
+			v.Col = vs
+		}
 	}
 	if nulls.Any(w.Nsp) && nulls.Contains(w.Nsp, uint64(sel)) {
 		nulls.Add(v.Nsp, uint64(Length(v)-1))
@@ -1370,8 +1445,8 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 		}
 		vs = vs[:n+cnt]
 		j := n
-		for i, sel := range sels {
-			vs[i] = ws[sel]
+		for _, sel := range sels {
+			vs[j] = ws[sel]
 			j++
 		}
 		v.Col = vs
@@ -1381,7 +1456,7 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 		vs := v.Col.([]int16)
 		n := len(vs)
 		if n+cnt >= cap(vs) {
-			data, err := mheap.Grow(m, v.Data[:n], int64(n+cnt)*2)
+			data, err := mheap.Grow(m, v.Data[:n*2], int64(n+cnt)*2)
 			if err != nil {
 				return err
 			}
@@ -1391,8 +1466,8 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 		}
 		vs = vs[:n+cnt]
 		j := n
-		for i, sel := range sels {
-			vs[i] = ws[sel]
+		for _, sel := range sels {
+			vs[j] = ws[sel]
 			j++
 		}
 		v.Col = vs
@@ -1402,7 +1477,7 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 		vs := v.Col.([]int32)
 		n := len(vs)
 		if n+cnt >= cap(vs) {
-			data, err := mheap.Grow(m, v.Data[:n], int64(n+cnt)*4)
+			data, err := mheap.Grow(m, v.Data[:n*4], int64(n+cnt)*4)
 			if err != nil {
 				return err
 			}
@@ -1412,8 +1487,8 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 		}
 		vs = vs[:n+cnt]
 		j := n
-		for i, sel := range sels {
-			vs[i] = ws[sel]
+		for _, sel := range sels {
+			vs[j] = ws[sel]
 			j++
 		}
 		v.Col = vs
@@ -1423,7 +1498,7 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 		vs := v.Col.([]int64)
 		n := len(vs)
 		if n+cnt >= cap(vs) {
-			data, err := mheap.Grow(m, v.Data[:n], int64(n+cnt)*8)
+			data, err := mheap.Grow(m, v.Data[:n*8], int64(n+cnt)*8)
 			if err != nil {
 				return err
 			}
@@ -1433,8 +1508,8 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 		}
 		vs = vs[:n+cnt]
 		j := n
-		for i, sel := range sels {
-			vs[i] = ws[sel]
+		for _, sel := range sels {
+			vs[j] = ws[sel]
 			j++
 		}
 		v.Col = vs
@@ -1454,8 +1529,8 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 		}
 		vs = vs[:n+cnt]
 		j := n
-		for i, sel := range sels {
-			vs[i] = ws[sel]
+		for _, sel := range sels {
+			vs[j] = ws[sel]
 			j++
 		}
 		v.Col = vs
@@ -1465,7 +1540,7 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 		vs := v.Col.([]uint16)
 		n := len(vs)
 		if n+cnt >= cap(vs) {
-			data, err := mheap.Grow(m, v.Data[:n], int64(n+cnt)*2)
+			data, err := mheap.Grow(m, v.Data[:n*2], int64(n+cnt)*2)
 			if err != nil {
 				return err
 			}
@@ -1475,8 +1550,8 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 		}
 		vs = vs[:n+cnt]
 		j := n
-		for i, sel := range sels {
-			vs[i] = ws[sel]
+		for _, sel := range sels {
+			vs[j] = ws[sel]
 			j++
 		}
 		v.Col = vs
@@ -1486,7 +1561,7 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 		vs := v.Col.([]uint32)
 		n := len(vs)
 		if n+cnt >= cap(vs) {
-			data, err := mheap.Grow(m, v.Data[:n], int64(n+cnt)*4)
+			data, err := mheap.Grow(m, v.Data[:n*4], int64(n+cnt)*4)
 			if err != nil {
 				return err
 			}
@@ -1496,8 +1571,8 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 		}
 		vs = vs[:n+cnt]
 		j := n
-		for i, sel := range sels {
-			vs[i] = ws[sel]
+		for _, sel := range sels {
+			vs[j] = ws[sel]
 			j++
 		}
 		v.Col = vs
@@ -1507,7 +1582,7 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 		vs := v.Col.([]uint64)
 		n := len(vs)
 		if n+cnt >= cap(vs) {
-			data, err := mheap.Grow(m, v.Data[:n], int64(n+cnt)*8)
+			data, err := mheap.Grow(m, v.Data[:n*8], int64(n+cnt)*8)
 			if err != nil {
 				return err
 			}
@@ -1517,8 +1592,8 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 		}
 		vs = vs[:n+cnt]
 		j := n
-		for i, sel := range sels {
-			vs[i] = ws[sel]
+		for _, sel := range sels {
+			vs[j] = ws[sel]
 			j++
 		}
 		v.Col = vs
@@ -1528,7 +1603,7 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 		vs := v.Col.([]float32)
 		n := len(vs)
 		if n+cnt >= cap(vs) {
-			data, err := mheap.Grow(m, v.Data[:n], int64(n+cnt)*4)
+			data, err := mheap.Grow(m, v.Data[:n*4], int64(n+cnt)*4)
 			if err != nil {
 				return err
 			}
@@ -1538,8 +1613,8 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 		}
 		vs = vs[:n+cnt]
 		j := n
-		for i, sel := range sels {
-			vs[i] = ws[sel]
+		for _, sel := range sels {
+			vs[j] = ws[sel]
 			j++
 		}
 		v.Col = vs
@@ -1549,7 +1624,7 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 		vs := v.Col.([]float64)
 		n := len(vs)
 		if n+cnt >= cap(vs) {
-			data, err := mheap.Grow(m, v.Data[:n], int64(n+cnt)*8)
+			data, err := mheap.Grow(m, v.Data[:n*8], int64(n+cnt)*8)
 			if err != nil {
 				return err
 			}
@@ -1559,8 +1634,8 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 		}
 		vs = vs[:n+cnt]
 		j := n
-		for i, sel := range sels {
-			vs[i] = ws[sel]
+		for _, sel := range sels {
+			vs[j] = ws[sel]
 			j++
 		}
 		v.Col = vs
@@ -1592,7 +1667,7 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 		vs := v.Col.([]types.Date)
 		n := len(vs)
 		if n+cnt >= cap(vs) {
-			data, err := mheap.Grow(m, v.Data[:n], int64(n+cnt)*4)
+			data, err := mheap.Grow(m, v.Data[:n*4], int64(n+cnt)*4)
 			if err != nil {
 				return err
 			}
@@ -1602,8 +1677,8 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 		}
 		vs = vs[:n+cnt]
 		j := n
-		for i, sel := range sels {
-			vs[i] = ws[sel]
+		for _, sel := range sels {
+			vs[j] = ws[sel]
 			j++
 		}
 		v.Col = vs
@@ -1613,7 +1688,7 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 		vs := v.Col.([]types.Datetime)
 		n := len(vs)
 		if n+cnt >= cap(vs) {
-			data, err := mheap.Grow(m, v.Data[:n], int64(n+cnt)*8)
+			data, err := mheap.Grow(m, v.Data[:n*8], int64(n+cnt)*8)
 			if err != nil {
 				return err
 			}
@@ -1623,8 +1698,29 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 		}
 		vs = vs[:n+cnt]
 		j := n
-		for i, sel := range sels {
-			vs[i] = ws[sel]
+		for _, sel := range sels {
+			vs[j] = ws[sel]
+			j++
+		}
+		v.Col = vs
+	case types.T_decimal:
+		cnt := len(sels)
+		ws := w.Col.([]types.Decimal)
+		vs := v.Col.([]types.Decimal)
+		n := len(vs)
+		if n+cnt >= cap(vs) {
+			data, err := mheap.Grow(m, v.Data[:n*16], int64(n+cnt)*16)
+			if err != nil {
+				return err
+			}
+			mheap.Free(m, v.Data)
+			vs = encoding.DecodeDecimalSlice(data)
+			v.Data = data
+		}
+		vs = vs[:n+cnt]
+		j := n
+		for _, sel := range sels {
+			vs[j] = ws[sel]
 			j++
 		}
 		v.Col = vs
@@ -1634,8 +1730,8 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 		for _, sel := range sels {
 			if nulls.Contains(w.Nsp, uint64(sel)) {
 				nulls.Add(v.Nsp, j)
-				j++
 			}
+			j++
 		}
 	}
 	return nil
@@ -2217,6 +2313,49 @@ func UnionBatch(v, w *Vector, offset int64, cnt int, flags []uint8, m *mheap.Mhe
 			v.Col = vs
 		}
 
+	case types.T_decimal:
+		col := w.Col.([]types.Decimal)
+		if len(v.Data) == 0 {
+			newSize := 8
+			for newSize < cnt {
+				newSize <<= 1
+			}
+			data, err := mheap.Alloc(m, int64(newSize)*16)
+			if err != nil {
+				return err
+			}
+			v.Ref = w.Ref
+			vs := encoding.DecodeDecimalSlice(data)[:cnt]
+			for i, j := 0, 0; i < len(flags); i++ {
+				if flags[i] > 0 {
+					vs[j] = col[int(offset)+i]
+					j++
+				}
+			}
+			v.Col = vs
+			v.Data = data
+		} else {
+			vs := v.Col.([]types.Decimal)
+			n := len(vs)
+			if n+cnt > cap(vs) {
+				data, err := mheap.Grow(m, v.Data[:n*16], int64(n+cnt)*16)
+				if err != nil {
+					return err
+				}
+				mheap.Free(m, v.Data)
+				vs = encoding.DecodeDecimalSlice(data)
+				v.Data = data
+			}
+			vs = vs[:n+cnt]
+			for i, j := 0, n; i < len(flags); i++ {
+				if flags[i] > 0 {
+					vs[j] = col[int(offset)+i]
+					j++
+				}
+			}
+			v.Col = vs
+		}
+
 	}
 
 	for i, j := 0, uint64(oldLen); i < len(flags); i++ {
@@ -2419,7 +2558,9 @@ func (v *Vector) Show() ([]byte, error) {
 			return buf.Bytes(), nil
 		}
 		buf.Write(encoding.EncodeUint32Slice(Col.Lengths))
-		buf.Write(Col.Data)
+		for i, o := range Col.Offsets { // the data may be not continuous after shrink
+			buf.Write(Col.Data[o : o+Col.Lengths[i]])
+		}
 		return buf.Bytes(), nil
 	case types.T_tuple:
 		buf.Write(encoding.EncodeType(v.Typ))
@@ -2720,7 +2861,7 @@ func (v *Vector) String() string {
 			if nulls.Contains(v.Nsp, 0) {
 				return "null"
 			} else {
-				return fmt.Sprintf("%v", col[0])
+				return col[0].Format(v.Typ.Precision)
 			}
 		}
 	case types.T_float32:
@@ -3151,6 +3292,33 @@ func (v *Vector) GetColumnData(selectIndexs []int64, occurCounts []int64, rs []s
 				rs[i] = rs[i-1]
 			}
 		}
+	case types.T_decimal:
+		vs := v.Col.([]types.Decimal)
+		for i := 0; i < rows; i++ {
+			index := i
+			count := occurCounts[i]
+			if count <= 0 {
+				i--
+				continue
+			}
+			if ifSel {
+				index = int(selectIndexs[i])
+			}
+			if allData {
+				rs[i] = vs[index].Format(v.Typ.Precision)
+			} else {
+				if nulls.Contains(v.Nsp, uint64(index)) {
+					rs[i] = nullStr
+				} else {
+					rs[i] = vs[index].Format(v.Typ.Precision)
+				}
+			}
+			for count > 1 {
+				count--
+				i++
+				rs[i] = rs[i-1]
+			}
+		}
 	default:
 		return errors.New(fmt.Sprintf("unexpect type %v for function vector.GetColumnData", typ))
 	}
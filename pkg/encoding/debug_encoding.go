@@ -185,6 +185,14 @@ func DecodeDatetime(v []byte) types.Datetime {
 	return types.Datetime(DecodeInt64(v))
 }
 
+func EncodeDecimal(v types.Decimal) []byte {
+	return append(EncodeUint64(v.Lo), EncodeInt64(v.Hi)...)
+}
+
+func DecodeDecimal(v []byte) types.Decimal {
+	return types.Decimal{Lo: DecodeUint64(v), Hi: DecodeInt64(v[8:])}
+}
+
 func EncodeInt8Slice(v []int8) []byte {
 	hp := *(*reflect.SliceHeader)(unsafe.Pointer(&v))
 	return *(*[]byte)(unsafe.Pointer(&hp))
@@ -154,6 +154,14 @@ func DecodeDatetime(v []byte) types.Datetime {
 	return *(*types.Datetime)(unsafe.Pointer(&v[0]))
 }
 
+func EncodeDecimal(v types.Decimal) []byte {
+	return unsafe.Slice((*byte)(unsafe.Pointer(&v)), DecimalSize)
+}
+
+func DecodeDecimal(v []byte) types.Decimal {
+	return *(*types.Decimal)(unsafe.Pointer(&v[0]))
+}
+
 func EncodeInt8Slice(v []int8) []byte {
 	return *(*[]byte)(unsafe.Pointer(&v))
 }
@@ -21,6 +21,7 @@ const (
 	NoData                                  = "02000"
 	SQLStatementNotYetComplete              = "03000"
 	ConnectionException                     = "08000"
+	ProtocolViolation                       = "08P01"
 	TriggeredActionException                = "09000"
 	FeatureNotSupported                     = "0A000"
 	InvalidTransactionInitiation            = "0B000"
@@ -31,12 +32,15 @@ const (
 	CaseNotFound                            = "20000"
 	CardinalityViolation                    = "21000"
 	DataException                           = "22000"
+	InvalidTextRepresentation               = "22P02"
+	InvalidBinaryRepresentation             = "22P03"
 	IntegrityConstraintViolation            = "23000"
 	InvalidCursorState                      = "24000"
 	InvalidTransactionState                 = "25000"
 	InvalidSQLStatementName                 = "26000"
 	TriggeredDataChangeViolation            = "27000"
 	InvalidAuthorizationSpecification       = "28000"
+	InvalidPassword                         = "28P01"
 	DependentPrivilegeDescriptorsStillExist = "2B000"
 	InvalidTransactionTermination           = "2D000"
 	SQLRoutineException                     = "2F000"
@@ -65,7 +65,7 @@ var openNewFile = func(ep *tree.ExportParam, mrs *MysqlResultSet) error {
 		return err
 	}
 	ep.Writer = bufio.NewWriterSize(ep.File, int(ep.DefaultBufSize))
-	if ep.Header {
+	if ep.Header && ep.DataFormat.IsCSV() {
 		var header string
 		n := len(mrs.Columns)
 		if n == 0 {
@@ -0,0 +1,350 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/parquet"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+/*
+formatExporter exports the result of SELECT ... INTO OUTFILE with FORMAT PARQUET or
+FORMAT JSONLINES. The batches are written from the vectors directly instead of
+the rows of the resultset.
+*/
+type formatExporter struct {
+	//the pipeline delivers the batches in multiple goroutines
+	sync.Mutex
+	oq   *outputQueue
+	cols []parquet.Column
+	pw   *parquet.Writer
+	line []byte
+}
+
+func newFormatExporter(ep *tree.ExportParam, mrs *MysqlResultSet) *formatExporter {
+	return &formatExporter{
+		oq: NewOuputQueue(nil, mrs, 0, ep),
+	}
+}
+
+/*
+write exports the rows of the batch.
+*/
+func (fe *formatExporter) write(bat *batch.Batch) error {
+	if len(bat.Vecs) == 0 {
+		return nil
+	}
+	sels := batchSels(bat)
+	if sels != nil && len(sels) == 0 {
+		return nil
+	}
+	fe.Lock()
+	defer fe.Unlock()
+	switch fe.oq.ep.DataFormat {
+	case tree.DataFormatParquet:
+		if fe.pw == nil {
+			fe.cols = make([]parquet.Column, len(bat.Vecs))
+			for i, vec := range bat.Vecs {
+				fe.cols[i] = parquet.Column{Name: fe.oq.mrs.Columns[i].Name(), Type: vec.Typ}
+			}
+			if err := fe.newParquetWriter(); err != nil {
+				return err
+			}
+		}
+		return fe.writeParquet(bat.Vecs, sels)
+	case tree.DataFormatJSONLines:
+		return fe.writeJSONLines(bat.Vecs, sels)
+	}
+	return fmt.Errorf("unsupported export format %s", fe.oq.ep.DataFormat)
+}
+
+/*
+close finishes the current file. The file and its writer are closed by the caller.
+*/
+func (fe *formatExporter) close() error {
+	if fe.oq.ep.DataFormat != tree.DataFormatParquet {
+		return nil
+	}
+	if fe.pw == nil {
+		//no batch has been exported, the schema comes from the columns of the resultset
+		for _, col := range fe.oq.mrs.Columns {
+			mysqlColumn, ok := col.(*MysqlColumn)
+			if !ok {
+				return fmt.Errorf("export parquet need MysqlColumn")
+			}
+			typ, err := convertMysqlTypeToEngineType(mysqlColumn)
+			if err != nil {
+				return err
+			}
+			fe.cols = append(fe.cols, parquet.Column{Name: col.Name(), Type: typ})
+		}
+		if err := fe.newParquetWriter(); err != nil {
+			return err
+		}
+	}
+	if err := fe.pw.Close(); err != nil {
+		return err
+	}
+	fe.oq.ep.CurFileSize = uint64(fe.pw.Size(nil))
+	return nil
+}
+
+func (fe *formatExporter) newParquetWriter() error {
+	pw, err := parquet.NewWriter(fe.oq.ep.Writer, fe.cols)
+	if err != nil {
+		return err
+	}
+	fe.pw = pw
+	fe.oq.ep.CurFileSize = uint64(pw.Size(nil))
+	return nil
+}
+
+/*
+writeParquet writes the rows as a row group. When the file would be larger
+than the MaxFileSize, the row group goes into the next file or is split.
+*/
+func (fe *formatExporter) writeParquet(vecs []*vector.Vector, sels []int64) error {
+	ep := fe.oq.ep
+	rg, err := fe.pw.Encode(vecs, sels)
+	if err != nil {
+		return err
+	}
+	if ep.MaxFileSize != 0 && uint64(fe.pw.Size(rg)) > ep.MaxFileSize {
+		if fe.pw.Rows() > 0 {
+			if err = fe.nextParquetFile(); err != nil {
+				return err
+			}
+			return fe.writeParquet(vecs, sels)
+		}
+		if rg.Rows() <= 1 {
+			return errors.New("the OneLine size is over the maxFileSize")
+		}
+		if sels == nil {
+			sels = make([]int64, rg.Rows())
+			for i := range sels {
+				sels[i] = int64(i)
+			}
+		}
+		half := len(sels) / 2
+		if err = fe.writeParquet(vecs, sels[:half]); err != nil {
+			return err
+		}
+		return fe.writeParquet(vecs, sels[half:])
+	}
+	if err = fe.pw.Write(rg); err != nil {
+		return err
+	}
+	ep.Rows += uint64(rg.Rows())
+	ep.CurFileSize = uint64(fe.pw.Size(nil))
+	return nil
+}
+
+func (fe *formatExporter) nextParquetFile() error {
+	ep := fe.oq.ep
+	if err := fe.pw.Close(); err != nil {
+		return err
+	}
+	if err := Flush(ep); err != nil {
+		return err
+	}
+	if err := Close(ep); err != nil {
+		return err
+	}
+	ep.FileCnt++
+	ep.LineSize = 0
+	if err := openNewFile(ep, fe.oq.mrs); err != nil {
+		return err
+	}
+	ep.Rows = 0
+	return fe.newParquetWriter()
+}
+
+/*
+writeJSONLines writes every row as a json object in a line.
+*/
+func (fe *formatExporter) writeJSONLines(vecs []*vector.Vector, sels []int64) error {
+	rows := len(sels)
+	if sels == nil {
+		rows = vector.Length(vecs[0])
+	}
+	for j := 0; j < rows; j++ {
+		row := int64(j)
+		if sels != nil {
+			row = sels[j]
+		}
+		line := append(fe.line[:0], '{')
+		for i, vec := range vecs {
+			if i > 0 {
+				line = append(line, ',')
+			}
+			name, err := json.Marshal(fe.oq.mrs.Columns[i].Name())
+			if err != nil {
+				return err
+			}
+			line = append(line, name...)
+			line = append(line, ':')
+			if line, err = appendJSONValue(line, vec, row); err != nil {
+				return err
+			}
+		}
+		line = append(line, '}', '\n')
+		fe.line = line
+		fe.oq.ep.LineSize = 0
+		if err := writeToCSVFile(fe.oq, line); err != nil {
+			return err
+		}
+		fe.oq.ep.Rows++
+	}
+	return nil
+}
+
+func appendJSONValue(buf []byte, vec *vector.Vector, row int64) ([]byte, error) {
+	if nulls.Contains(vec.Nsp, uint64(row)) {
+		return append(buf, "null"...), nil
+	}
+	switch vec.Typ.Oid {
+	case types.T_int8:
+		return strconv.AppendInt(buf, int64(vec.Col.([]int8)[row]), 10), nil
+	case types.T_int16:
+		return strconv.AppendInt(buf, int64(vec.Col.([]int16)[row]), 10), nil
+	case types.T_int32:
+		return strconv.AppendInt(buf, int64(vec.Col.([]int32)[row]), 10), nil
+	case types.T_int64:
+		return strconv.AppendInt(buf, vec.Col.([]int64)[row], 10), nil
+	case types.T_uint8:
+		return strconv.AppendUint(buf, uint64(vec.Col.([]uint8)[row]), 10), nil
+	case types.T_uint16:
+		return strconv.AppendUint(buf, uint64(vec.Col.([]uint16)[row]), 10), nil
+	case types.T_uint32:
+		return strconv.AppendUint(buf, uint64(vec.Col.([]uint32)[row]), 10), nil
+	case types.T_uint64:
+		return strconv.AppendUint(buf, vec.Col.([]uint64)[row], 10), nil
+	case types.T_float32:
+		return appendJSONFloat(buf, float64(vec.Col.([]float32)[row]), 32), nil
+	case types.T_float64:
+		return appendJSONFloat(buf, vec.Col.([]float64)[row], 64), nil
+	case types.T_decimal:
+		return append(buf, vec.Col.([]types.Decimal)[row].Format(vec.Typ.Precision)...), nil
+	case types.T_date:
+		return strconv.AppendQuote(buf, vec.Col.([]types.Date)[row].String()), nil
+	case types.T_datetime:
+		return strconv.AppendQuote(buf, vec.Col.([]types.Datetime)[row].String()), nil
+	case types.T_char, types.T_varchar:
+		s, err := json.Marshal(string(vec.Col.(*types.Bytes).Get(row)))
+		if err != nil {
+			return nil, err
+		}
+		return append(buf, s...), nil
+	case types.T_json:
+		//the json value is written as it is
+		return append(buf, vec.Col.(*types.Bytes).Get(row)...), nil
+	}
+	return nil, fmt.Errorf("unsupported type %s in jsonlines", vec.Typ)
+}
+
+func appendJSONFloat(buf []byte, f float64, bitSize int) []byte {
+	//NaN and Inf are not json numbers
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return append(buf, "null"...)
+	}
+	return strconv.AppendFloat(buf, f, 'g', -1, bitSize)
+}
+
+/*
+batchSels returns the rows of the batch to be exported. nil means all rows.
+*/
+func batchSels(bat *batch.Batch) []int64 {
+	n := vector.Length(bat.Vecs[0])
+	if len(bat.Sels) == 0 {
+		all := true
+		for j := 0; j < n && all; j++ {
+			all = bat.Zs[j] > 0
+		}
+		if all {
+			return nil
+		}
+	}
+	sels := make([]int64, 0, n)
+	for j := 0; j < n; j++ {
+		if bat.Zs[j] <= 0 {
+			continue
+		}
+		if len(bat.Sels) != 0 {
+			sels = append(sels, bat.Sels[j])
+		} else {
+			sels = append(sels, int64(j))
+		}
+	}
+	return sels
+}
+
+/*
+convertMysqlTypeToEngineType is the inverse of convertEngineTypeToMysqlType.
+*/
+func convertMysqlTypeToEngineType(col *MysqlColumn) (types.Type, error) {
+	unsigned := !col.IsSigned()
+	var oid types.T
+	switch col.ColumnType() {
+	case defines.MYSQL_TYPE_TINY:
+		oid = types.T_int8
+		if unsigned {
+			oid = types.T_uint8
+		}
+	case defines.MYSQL_TYPE_SHORT:
+		oid = types.T_int16
+		if unsigned {
+			oid = types.T_uint16
+		}
+	case defines.MYSQL_TYPE_LONG:
+		oid = types.T_int32
+		if unsigned {
+			oid = types.T_uint32
+		}
+	case defines.MYSQL_TYPE_LONGLONG:
+		oid = types.T_int64
+		if unsigned {
+			oid = types.T_uint64
+		}
+	case defines.MYSQL_TYPE_FLOAT:
+		oid = types.T_float32
+	case defines.MYSQL_TYPE_DOUBLE:
+		oid = types.T_float64
+	case defines.MYSQL_TYPE_STRING:
+		oid = types.T_char
+	case defines.MYSQL_TYPE_VARCHAR:
+		oid = types.T_varchar
+	case defines.MYSQL_TYPE_DATE:
+		oid = types.T_date
+	case defines.MYSQL_TYPE_DATETIME:
+		oid = types.T_datetime
+	case defines.MYSQL_TYPE_NEWDECIMAL:
+		return types.Type{Oid: types.T_decimal, Size: 16, Width: types.MaxDecimalPrecision}, nil
+	default:
+		return types.Type{}, fmt.Errorf("unsupported column type %d", col.ColumnType())
+	}
+	return types.Type{Oid: oid, Size: int32(oid.TypeLen())}, nil
+}
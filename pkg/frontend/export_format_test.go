@@ -0,0 +1,215 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/parquet"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func newExportBatch(ids []int64, names []string, nameNulls ...uint64) *batch.Batch {
+	bat := batch.New(true, []string{"id", "name"})
+	bat.Vecs[0] = vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	bat.Vecs[0].Col = ids
+	bat.Vecs[1] = vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+	vs := &types.Bytes{}
+	for _, name := range names {
+		vs.Offsets = append(vs.Offsets, uint32(len(vs.Data)))
+		vs.Lengths = append(vs.Lengths, uint32(len(name)))
+		vs.Data = append(vs.Data, name...)
+	}
+	bat.Vecs[1].Col = vs
+	nulls.Add(bat.Vecs[1].Nsp, nameNulls...)
+	bat.Zs = make([]int64, len(ids))
+	for i := range bat.Zs {
+		bat.Zs[i] = 1
+	}
+	return bat
+}
+
+func newExportParam(dir string, format tree.DataFormat, maxFileSize uint64) (*tree.ExportParam, *MysqlResultSet) {
+	mrs := &MysqlResultSet{}
+	for _, name := range []string{"id", "name"} {
+		col := new(MysqlColumn)
+		col.SetName(name)
+		mrs.AddColumn(col)
+	}
+	mrs.Columns[0].(*MysqlColumn).SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+	mrs.Columns[1].(*MysqlColumn).SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	ep := &tree.ExportParam{
+		Outfile:        true,
+		FilePath:       filepath.Join(dir, "out"),
+		DataFormat:     format,
+		Header:         true,
+		MaxFileSize:    maxFileSize,
+		DefaultBufSize: 1024,
+		Fields:         &tree.Fields{Terminated: ","},
+		Lines:          &tree.Lines{TerminatedBy: "\n"},
+	}
+	return ep, mrs
+}
+
+func exportBatches(ep *tree.ExportParam, mrs *MysqlResultSet, bats ...*batch.Batch) error {
+	initExportFileParam(ep, mrs)
+	if err := openNewFile(ep, mrs); err != nil {
+		return err
+	}
+	fe := newFormatExporter(ep, mrs)
+	for _, bat := range bats {
+		if err := fe.write(bat); err != nil {
+			return err
+		}
+	}
+	if err := fe.close(); err != nil {
+		return err
+	}
+	if err := ep.Writer.Flush(); err != nil {
+		return err
+	}
+	return ep.File.Close()
+}
+
+func Test_exportParquet(t *testing.T) {
+	convey.Convey("export parquet succ", t, func() {
+		dir := t.TempDir()
+		ep, mrs := newExportParam(dir, tree.DataFormatParquet, 0)
+		bat := newExportBatch([]int64{1, 2, 3}, []string{"a", "", "c"}, 1)
+		bat.Zs[2] = 0
+		convey.So(exportBatches(ep, mrs, bat), convey.ShouldBeNil)
+
+		f, err := os.Open(ep.FilePath)
+		convey.So(err, convey.ShouldBeNil)
+		defer f.Close()
+		stat, err := f.Stat()
+		convey.So(err, convey.ShouldBeNil)
+		r, err := parquet.NewReader(f, stat.Size())
+		convey.So(err, convey.ShouldBeNil)
+		cols, err := r.Columns()
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(cols[0].Name, convey.ShouldEqual, "id")
+		convey.So(cols[1].Name, convey.ShouldEqual, "name")
+		convey.So(r.RowGroups(), convey.ShouldEqual, 1)
+		ids, err := r.Read(0, 0, cols[0].Type)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ids.Col, convey.ShouldResemble, []int64{1, 2})
+		names, err := r.Read(0, 1, cols[1].Type)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(string(names.Col.(*types.Bytes).Get(0)), convey.ShouldEqual, "a")
+		convey.So(nulls.Contains(names.Nsp, 1), convey.ShouldBeTrue)
+	})
+
+	convey.Convey("export parquet with MaxFileSize succ", t, func() {
+		dir := t.TempDir()
+		ep, mrs := newExportParam(dir, tree.DataFormatParquet, 400)
+		var ids []int64
+		var names []string
+		for i := 0; i < 100; i++ {
+			ids = append(ids, int64(i))
+			names = append(names, "name")
+		}
+		convey.So(exportBatches(ep, mrs, newExportBatch(ids, names), newExportBatch(ids, names)), convey.ShouldBeNil)
+		convey.So(ep.FileCnt, convey.ShouldBeGreaterThan, 0)
+
+		var got []int64
+		for i := uint(0); i <= ep.FileCnt; i++ {
+			f, err := os.Open(getExportFilePath(ep.FilePath, i))
+			convey.So(err, convey.ShouldBeNil)
+			stat, err := f.Stat()
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(stat.Size(), convey.ShouldBeLessThanOrEqualTo, 400)
+			r, err := parquet.NewReader(f, stat.Size())
+			convey.So(err, convey.ShouldBeNil)
+			for rg := 0; rg < r.RowGroups(); rg++ {
+				vec, err := r.Read(rg, 0, types.Type{Oid: types.T_int64, Size: 8})
+				convey.So(err, convey.ShouldBeNil)
+				got = append(got, vec.Col.([]int64)...)
+			}
+			f.Close()
+		}
+		convey.So(got, convey.ShouldResemble, append(ids, ids...))
+
+		ep, mrs = newExportParam(t.TempDir(), tree.DataFormatParquet, 10)
+		convey.So(exportBatches(ep, mrs, newExportBatch(ids, names)), convey.ShouldBeError)
+	})
+
+	convey.Convey("export empty parquet succ", t, func() {
+		ep, mrs := newExportParam(t.TempDir(), tree.DataFormatParquet, 0)
+		convey.So(exportBatches(ep, mrs), convey.ShouldBeNil)
+		f, err := os.Open(ep.FilePath)
+		convey.So(err, convey.ShouldBeNil)
+		defer f.Close()
+		stat, err := f.Stat()
+		convey.So(err, convey.ShouldBeNil)
+		r, err := parquet.NewReader(f, stat.Size())
+		convey.So(err, convey.ShouldBeNil)
+		cols, err := r.Columns()
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(cols, convey.ShouldHaveLength, 2)
+		convey.So(cols[0].Type.Oid, convey.ShouldEqual, types.T_int64)
+		convey.So(r.RowGroups(), convey.ShouldEqual, 0)
+	})
+}
+
+func Test_exportJSONLines(t *testing.T) {
+	convey.Convey("export jsonlines succ", t, func() {
+		ep, mrs := newExportParam(t.TempDir(), tree.DataFormatJSONLines, 0)
+		bat := newExportBatch([]int64{1, 2}, []string{"a\"b", ""}, 1)
+		convey.So(exportBatches(ep, mrs, bat), convey.ShouldBeNil)
+		data, err := os.ReadFile(ep.FilePath)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(string(data), convey.ShouldEqual, "{\"id\":1,\"name\":\"a\\\"b\"}\n{\"id\":2,\"name\":null}\n")
+
+		ep, mrs = newExportParam(t.TempDir(), tree.DataFormatJSONLines, 50)
+		convey.So(exportBatches(ep, mrs, bat, bat), convey.ShouldBeNil)
+		convey.So(ep.FileCnt, convey.ShouldEqual, 1)
+		data, err = os.ReadFile(getExportFilePath(ep.FilePath, 1))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(string(data), convey.ShouldEqual, "{\"id\":1,\"name\":\"a\\\"b\"}\n{\"id\":2,\"name\":null}\n")
+	})
+
+	convey.Convey("appendJSONValue succ", t, func() {
+		vec := vector.New(types.Type{Oid: types.T_float64, Size: 8})
+		vec.Col = []float64{1.5, math.NaN()}
+		buf, err := appendJSONValue(nil, vec, 0)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(string(buf), convey.ShouldEqual, "1.5")
+		buf, err = appendJSONValue(nil, vec, 1)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(string(buf), convey.ShouldEqual, "null")
+
+		vec = vector.New(types.Type{Oid: types.T_decimal, Size: 16, Width: 10, Precision: 2})
+		vec.Col = []types.Decimal{types.NewDecimal(-1234)}
+		buf, err = appendJSONValue(nil, vec, 0)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(string(buf), convey.ShouldEqual, "-12.34")
+
+		vec = vector.New(types.Type{Oid: types.T_date, Size: 4})
+		vec.Col = []types.Date{types.FromCalendar(2021, 3, 4)}
+		buf, err = appendJSONValue(nil, vec, 0)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(string(buf), convey.ShouldEqual, "\"2021-03-04\"")
+	})
+}
@@ -19,6 +19,7 @@ import (
 	"encoding/csv"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"runtime"
 	"strconv"
@@ -32,8 +33,12 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
 
 	"unicode/utf8"
 
@@ -101,6 +106,14 @@ type SharePart struct {
 	attrName  []string
 	timestamp uint64
 
+	//SET clause, the values of assignAttrs are computed by assignments
+	assignAttrs []string
+	assignments []extend.Extend
+	//user variables in the column list, they are kept for the SET clause
+	varNames []string
+	//memory for evaluating the SET clause
+	mp *mheap.Mheap
+
 	//simd csv
 	simdCsvLineArray [][]string
 
@@ -170,7 +183,7 @@ type ParseLineHandler struct {
 	DebugTime
 
 	threadInfo                  map[int]*ThreadInfo
-	simdCsvReader               *simdcsv.Reader
+	simdCsvReader               lineReader
 	closeOnceGetParsedLinesChan sync.Once
 	//csv read put lines into the channel
 	simdCsvGetParsedLinesChan chan simdcsv.LineOut
@@ -321,15 +334,25 @@ func (plh *ParseLineHandler) close() {
 alloc space for the batch
 */
 func makeBatch(handler *ParseLineHandler, id int) *PoolElement {
-	batchData := batch.New(true, handler.attrName)
+	attrName := handler.attrName
+	for _, name := range handler.varNames {
+		attrName = append(attrName[:len(attrName):len(attrName)], plan.LoadVariable(name))
+	}
+	batchData := batch.New(true, attrName)
 
 	//logutil.Infof("----- batchSize %d attrName %v",batchSize,handler.attrName)
 
 	batchSize := handler.batchSize
 
 	//alloc space for vector
-	for i := 0; i < len(handler.attrName); i++ {
-		vec := vector.New(handler.cols[i].Attr.Type)
+	for i := 0; i < len(attrName); i++ {
+		var vec *vector.Vector
+		if i < len(handler.cols) {
+			vec = vector.New(handler.cols[i].Attr.Type)
+		} else {
+			//user variable
+			vec = vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+		}
 		switch vec.Typ.Oid {
 		case types.T_int8:
 			vec.Col = make([]int8, batchSize)
@@ -362,6 +385,8 @@ func makeBatch(handler *ParseLineHandler, id int) *PoolElement {
 			vec.Col = make([]types.Date, batchSize)
 		case types.T_datetime:
 			vec.Col = make([]types.Datetime, batchSize)
+		case types.T_decimal:
+			vec.Col = make([]types.Decimal, batchSize)
 		default:
 			panic("unsupported vector type")
 		}
@@ -419,8 +444,13 @@ func initParseLineHandler(handler *ParseLineHandler) error {
 				}
 				dataColumnId2TableColumnId[i] = tid
 			case *tree.VarExpr:
-				//NOTE:variable like '@abc' will be passed by.
+				//NOTE:variable like '@abc' will be passed by, unless the SET clause refers it.
 				dataColumnId2TableColumnId[i] = -1
+				for k, name := range handler.varNames {
+					if name == realCol.Name {
+						dataColumnId2TableColumnId[i] = len(cols) + k
+					}
+				}
 			default:
 				return fmt.Errorf("unsupported column type %v", realCol)
 			}
@@ -479,6 +509,10 @@ func initWriteBatchHandler(handler *ParseLineHandler, wHandler *WriteBatchHandle
 	wHandler.lineCount = handler.lineCount
 	wHandler.maxEntryBytesForCube = handler.maxEntryBytesForCube
 	wHandler.skipWriteBatch = handler.skipWriteBatch
+	wHandler.assignAttrs = handler.assignAttrs
+	wHandler.assignments = handler.assignments
+	wHandler.varNames = handler.varNames
+	wHandler.mp = handler.mp
 
 	wHandler.pl = allocBatch(handler)
 	wHandler.ThreadInfo = handler.threadInfo[wHandler.pl.id]
@@ -870,6 +904,23 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool,
 						}
 						cols[rowIdx] = d
 					}
+				case types.T_decimal:
+					cols := vec.Col.([]types.Decimal)
+					if isNullOrEmpty {
+						nulls.Add(vec.Nsp, uint64(rowIdx))
+					} else {
+						fs := field
+						d, err := types.ParseDecimal(fs, types.DecimalPrecision(vec.Typ), types.DecimalScale(vec.Typ))
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return makeParsedFailedError(vec.Typ.String(), field, vecAttr, base, offset)
+							}
+							result.Warnings++
+							d = types.Decimal{}
+						}
+						cols[rowIdx] = d
+					}
 				default:
 					panic("unsupported oid")
 				}
@@ -919,7 +970,7 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool,
 			//put it into batch
 			vec := batchData.Vecs[colIdx]
 
-			columnFLags[j] = 1
+			columnFLags[colIdx] = 1
 
 			switch vec.Typ.Oid {
 			case types.T_int8:
@@ -1204,6 +1255,28 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool,
 						cols[i] = d
 					}
 				}
+			case types.T_decimal:
+				cols := vec.Col.([]types.Decimal)
+				for i := 0; i < countOfLineArray; i++ {
+					line := fetchLines[i]
+					if j >= len(line) || len(line[j]) == 0 {
+						nulls.Add(vec.Nsp, uint64(i))
+					} else {
+						field := line[j]
+						//logutil.Infof("==== > field string [%s] ",fs)
+						d, err := types.ParseDecimal(field, types.DecimalPrecision(vec.Typ), types.DecimalScale(vec.Typ))
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return err
+							}
+							result.Warnings++
+							d = types.Decimal{}
+							//break
+						}
+						cols[i] = d
+					}
+				}
 			default:
 				panic("unsupported oid")
 			}
@@ -1249,6 +1322,21 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool,
 	//}
 
 	wait_c := time.Now()
+	/*
+		evaluate SET clause
+	*/
+	if len(handler.assignments) != 0 && handler.batchFilled != 0 {
+		proc := process.New(handler.mp)
+		vecs, err := evalLoadAssignments(handler, proc)
+		defer func() {
+			for _, vec := range vecs {
+				vector.Clean(vec, proc.Mp)
+			}
+		}()
+		if err != nil {
+			return err
+		}
+	}
 	/*
 		write batch into the engine
 	*/
@@ -1278,6 +1366,55 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool,
 	return nil
 }
 
+/*
+evaluate SET clause on the filled rows of the batch.
+the batch of the handler is replaced by the columns of the table whose assigned columns
+are the values of SET clause. It returns the vectors allocated for the values.
+*/
+func evalLoadAssignments(handler *WriteBatchHandler, proc *process.Process) ([]*vector.Vector, error) {
+	var vecs []*vector.Vector
+	rows := handler.batchFilled
+	bat := batch.New(true, handler.batchData.Attrs)
+	for i, vec := range handler.batchData.Vecs {
+		bat.Vecs[i] = vector.Window(vec, 0, rows, vector.New(vec.Typ))
+		//the loaded values can not be overwritten by the evaluation
+		bat.Vecs[i].Ref = 2
+	}
+	wbat := batch.New(true, handler.attrName)
+	copy(wbat.Vecs, handler.batchData.Vecs)
+	for i, e := range handler.assignments {
+		vec, _, err := e.Eval(bat, proc)
+		if err != nil {
+			return vecs, err
+		}
+		if e.IsConstant() {
+			cvec := vector.New(vec.Typ)
+			for j := 0; j < rows; j++ {
+				if err := vector.UnionOne(cvec, vec, 0, proc.Mp); err != nil {
+					return vecs, err
+				}
+			}
+			vec = cvec
+		}
+		owned := true
+		for _, v := range bat.Vecs {
+			if v == vec {
+				owned = false
+			}
+		}
+		if owned {
+			vecs = append(vecs, vec)
+		}
+		for j, attr := range wbat.Attrs {
+			if attr == handler.assignAttrs[i] {
+				wbat.Vecs[j] = vec
+			}
+		}
+	}
+	handler.batchData = wbat
+	return vecs, nil
+}
+
 /*
 save batch to storage.
 when force is true, batchsize will be changed.
@@ -1392,6 +1529,9 @@ func writeBatchToStorage(handler *WriteBatchHandler, force bool) error {
 					case types.T_datetime:
 						cols := vec.Col.([]types.Datetime)
 						vec.Col = cols[:needLen]
+					case types.T_decimal:
+						cols := vec.Col.([]types.Decimal)
+						vec.Col = cols[:needLen]
 					}
 				}
 
@@ -1423,6 +1563,40 @@ func writeBatchToStorage(handler *WriteBatchHandler, force bool) error {
 	return err
 }
 
+/*
+build the SET clause of load data, the user variables of the column list are kept for it.
+*/
+func (mce *MysqlCmdExecutor) buildLoadAssignments(handler *ParseLineHandler) error {
+	ses := mce.GetSession()
+	var attrs []engine.Attribute
+	for _, def := range handler.tableHandler.TableDefs() {
+		if attr, ok := def.(*engine.AttributeDef); ok {
+			attrs = append(attrs, attr.Attr)
+		}
+	}
+	for _, col := range handler.load.ColumnList {
+		if v, ok := col.(*tree.VarExpr); ok {
+			found := false
+			for _, name := range handler.varNames {
+				found = found || name == v.Name
+			}
+			if !found {
+				handler.varNames = append(handler.varNames, v.Name)
+			}
+		}
+	}
+	b := plan.New(ses.protocol.GetDatabaseName(), "", ses.GetStorage(), nil)
+	b.SetVariables(ses.GetSessionVars().Resolve)
+	names, es, err := b.BuildLoadAssignments(attrs, handler.varNames, handler.load.Assignments)
+	if err != nil {
+		return err
+	}
+	handler.assignAttrs = names
+	handler.assignments = es
+	handler.mp = mheap.New(ses.GuestMmu)
+	return nil
+}
+
 //row2col algorithm
 var row2colChoose bool = true
 
@@ -1504,7 +1678,14 @@ func (mce *MysqlCmdExecutor) LoadLoop(load *tree.Load, dbHandler engine.Database
 	/*
 		step1 : read block from file
 	*/
-	dataFile, err := os.Open(load.File)
+	var dataFile io.ReadCloser
+	var err error
+	if load.Local {
+		//the file is sent by the client
+		dataFile, err = ses.GetMysqlProtocol().RequestLocalInfile(load.File)
+	} else {
+		dataFile, err = os.Open(load.File)
+	}
 	if err != nil {
 		logutil.Errorf("open file failed. err:%v", err)
 		return nil, err
@@ -1516,10 +1697,14 @@ func (mce *MysqlCmdExecutor) LoadLoop(load *tree.Load, dbHandler engine.Database
 		}
 	}()
 
+	if load.DataFormat == tree.DataFormatParquet {
+		return mce.loadParquet(load, dataFile, tableHandler)
+	}
+
 	//processTime := time.Now()
 	process_block := time.Duration(0)
 
-	curBatchSize := int(ses.Pu.SV.GetBatchSizeInLoadData())
+	curBatchSize := int(ses.GetSessionVars().GetBatchSizeInLoadData())
 	channelSize := 100
 	//simdcsv
 	handler := &ParseLineHandler{
@@ -1533,14 +1718,14 @@ func (mce *MysqlCmdExecutor) LoadLoop(load *tree.Load, dbHandler engine.Database
 			batchSize:            curBatchSize,
 			result:               result,
 			maxEntryBytesForCube: ses.Pu.SV.GetCubeMaxEntriesBytes(),
-			skipWriteBatch:       ses.Pu.SV.GetLoadDataSkipWritingBatch(),
+			skipWriteBatch:       ses.GetSessionVars().GetLoadDataSkipWritingBatch(),
 		},
 		threadInfo:                    make(map[int]*ThreadInfo),
 		simdCsvGetParsedLinesChan:     make(chan simdcsv.LineOut, channelSize),
 		simdCsvWaitWriteRoutineToQuit: &sync.WaitGroup{},
 	}
 
-	handler.simdCsvConcurrencyCountOfWriteBatch = Min(int(ses.Pu.SV.GetLoadDataConcurrencyCount()), runtime.NumCPU())
+	handler.simdCsvConcurrencyCountOfWriteBatch = Min(int(ses.GetSessionVars().GetLoadDataConcurrencyCount()), runtime.NumCPU())
 	handler.simdCsvConcurrencyCountOfWriteBatch = Max(1, handler.simdCsvConcurrencyCountOfWriteBatch)
 	handler.simdCsvBatchPool = make(chan *PoolElement, handler.simdCsvConcurrencyCountOfWriteBatch)
 	for i := 0; i < handler.simdCsvConcurrencyCountOfWriteBatch; i++ {
@@ -1571,11 +1756,21 @@ func (mce *MysqlCmdExecutor) LoadLoop(load *tree.Load, dbHandler engine.Database
 	//put closeRef into the executor
 	mce.loadDataClose = handler.closeRef
 
-	handler.simdCsvReader = simdcsv.NewReaderWithOptions(dataFile,
-		rune(load.Fields.Terminated[0]),
-		'#',
-		false,
-		false)
+	if load.DataFormat == tree.DataFormatJSONLines {
+		names, err := loadDataColumnNames(load, tableHandler)
+		if err != nil {
+			return nil, err
+		}
+		handler.simdCsvReader = newJSONLinesReader(dataFile, names)
+	} else if load.Fields.EscapedBy != 0 {
+		handler.simdCsvReader = newEscapedReader(dataFile, load)
+	} else {
+		handler.simdCsvReader = simdcsv.NewReaderWithOptions(dataFile,
+			rune(load.Fields.Terminated[0]),
+			'#',
+			false,
+			false)
+	}
 
 	/*
 		error channel
@@ -1585,6 +1780,12 @@ func (mce *MysqlCmdExecutor) LoadLoop(load *tree.Load, dbHandler engine.Database
 	//release resources of handler
 	defer handler.close()
 
+	if len(load.Assignments) != 0 {
+		if err = mce.buildLoadAssignments(handler); err != nil {
+			return nil, err
+		}
+	}
+
 	err = initParseLineHandler(handler)
 	if err != nil {
 		return nil, err
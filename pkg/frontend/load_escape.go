@@ -0,0 +1,225 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"sync/atomic"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/simdcsv"
+)
+
+/*
+lineReader splits the data of LOAD DATA into lines and delivers them into the channel.
+A LineOut with nil Line is delivered at the end.
+*/
+type lineReader interface {
+	ReadLoop(lineOutChan chan simdcsv.LineOut) error
+	Close()
+}
+
+var _ lineReader = &simdcsv.Reader{}
+var _ lineReader = &escapedReader{}
+
+/*
+escapedReader is the line reader for the data with ESCAPED BY.
+The simdcsv does not know the escape character, the escaped field
+terminators and line terminators would split the fields.
+*/
+type escapedReader struct {
+	r *bufio.Reader
+	//FIELDS TERMINATED BY
+	fieldTerminator []byte
+	//FIELDS ENCLOSED BY
+	enclosed byte
+	//FIELDS ESCAPED BY
+	escaped byte
+	//LINES TERMINATED BY
+	lineTerminator []byte
+	closed         int32
+}
+
+func newEscapedReader(r io.Reader, load *tree.Load) *escapedReader {
+	er := &escapedReader{
+		r:               bufio.NewReader(r),
+		fieldTerminator: []byte(load.Fields.Terminated),
+		enclosed:        load.Fields.EnclosedBy,
+		escaped:         load.Fields.EscapedBy,
+		lineTerminator:  []byte{'\n'},
+	}
+	if load.Lines != nil && len(load.Lines.TerminatedBy) != 0 {
+		er.lineTerminator = []byte(load.Lines.TerminatedBy)
+	}
+	return er
+}
+
+func (er *escapedReader) ReadLoop(lineOutChan chan simdcsv.LineOut) error {
+	defer func() {
+		//the channel is closed when the load data is stopped
+		if e := recover(); e != nil {
+			logutil.Infof("escaped reader exits. %v", e)
+		}
+	}()
+	for atomic.LoadInt32(&er.closed) == 0 {
+		line, err := er.readLine()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		lineOutChan <- simdcsv.LineOut{Lines: nil, Line: line}
+	}
+	lineOutChan <- simdcsv.LineOut{Lines: nil, Line: nil}
+	return nil
+}
+
+func (er *escapedReader) Close() {
+	atomic.StoreInt32(&er.closed, 1)
+}
+
+/*
+readLine returns the fields of the next non-empty line.
+The character following the escape character is unescaped, the field
+which is the escape character followed by N is NULL.
+The terminators in the enclosed field are a part of the field,
+the enclosing character in the enclosed field is doubled.
+*/
+func (er *escapedReader) readLine() ([]string, error) {
+	var line []string
+	var field []byte
+	var empty = true   //nothing read of the line
+	var begin = true   //at the beginning of the field
+	var quoted = false //in the enclosed field
+	var null = false   //the field begins with escaped N
+
+	endField := func() {
+		if null && len(field) == 1 {
+			line = append(line, NULL_FLAG)
+		} else {
+			line = append(line, string(field))
+		}
+		field = field[:0]
+		begin, quoted, null = true, false, false
+	}
+	for {
+		c, err := er.r.ReadByte()
+		if err == io.EOF {
+			if empty {
+				return nil, io.EOF
+			}
+			endField()
+			return line, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case er.escaped != 0 && c == er.escaped:
+			d, err := er.r.ReadByte()
+			if err == io.EOF {
+				field = append(field, c)
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			null = begin && d == 'N'
+			field = append(field, unescape(d))
+		case quoted:
+			if c != er.enclosed {
+				field = append(field, c)
+			} else if next, _ := er.r.Peek(1); len(next) == 1 && next[0] == er.enclosed {
+				field = append(field, c)
+				er.r.Discard(1)
+			} else {
+				quoted = false
+			}
+		case begin && er.enclosed != 0 && c == er.enclosed:
+			quoted = true
+		case er.skip(c, er.fieldTerminator):
+			endField()
+			empty = false
+			continue
+		case er.skip(c, er.lineTerminator):
+			if empty {
+				continue
+			}
+			endField()
+			return line, nil
+		case c == '\r' && er.crlf():
+			//CRLF ends the line terminated by LF
+			if empty {
+				continue
+			}
+			endField()
+			return line, nil
+		default:
+			field = append(field, c)
+		}
+		begin, empty = false, false
+	}
+}
+
+/*
+skip checks whether the terminator begins with c and the rest of it follows,
+the rest is skipped if so.
+*/
+func (er *escapedReader) skip(c byte, terminator []byte) bool {
+	if len(terminator) == 0 || c != terminator[0] {
+		return false
+	}
+	rest := terminator[1:]
+	if next, _ := er.r.Peek(len(rest)); !bytes.Equal(next, rest) {
+		return false
+	}
+	er.r.Discard(len(rest))
+	return true
+}
+
+// crlf checks whether LF follows and the line is terminated by LF, LF is skipped if so.
+func (er *escapedReader) crlf() bool {
+	if !bytes.Equal(er.lineTerminator, []byte{'\n'}) {
+		return false
+	}
+	if next, _ := er.r.Peek(1); len(next) != 1 || next[0] != '\n' {
+		return false
+	}
+	er.r.Discard(1)
+	return true
+}
+
+// unescape returns the character represented by the escape sequence.
+func unescape(c byte) byte {
+	switch c {
+	case '0':
+		return 0
+	case 'b':
+		return '\b'
+	case 'n':
+		return '\n'
+	case 'r':
+		return '\r'
+	case 't':
+		return '\t'
+	case 'Z':
+		return 0x1a
+	}
+	return c
+}
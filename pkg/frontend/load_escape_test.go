@@ -0,0 +1,67 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/simdcsv"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func readEscapedLines(data string, load *tree.Load) ([][]string, error) {
+	ch := make(chan simdcsv.LineOut, 100)
+	er := newEscapedReader(strings.NewReader(data), load)
+	if err := er.ReadLoop(ch); err != nil {
+		return nil, err
+	}
+	var lines [][]string
+	for out := range ch {
+		if out.Line == nil {
+			break
+		}
+		lines = append(lines, out.Line)
+	}
+	return lines, nil
+}
+
+func Test_escapedReader(t *testing.T) {
+	convey.Convey("escapedReader succ", t, func() {
+		load := &tree.Load{
+			Fields: &tree.Fields{Terminated: ",", EnclosedBy: '"', EscapedBy: '\\'},
+		}
+		lines, err := readEscapedLines("a\\,b,c\\nd\n\\N,\\\\x\r\n\n\"x,\"\"y\"\"\",\\t\n1,", load)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(lines, convey.ShouldResemble, [][]string{
+			{"a,b", "c\nd"},
+			{NULL_FLAG, "\\x"},
+			{"x,\"y\"", "\t"},
+			{"1", ""},
+		})
+
+		load = &tree.Load{
+			Fields: &tree.Fields{Terminated: "||", EscapedBy: '$'},
+			Lines:  &tree.Lines{TerminatedBy: ";"},
+		}
+		lines, err = readEscapedLines("a|b||$N;$;||c$", load)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(lines, convey.ShouldResemble, [][]string{
+			{"a|b", NULL_FLAG},
+			{";", "c$"},
+		})
+	})
+}
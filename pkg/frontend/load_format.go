@@ -0,0 +1,390 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync/atomic"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/parquet"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/simdcsv"
+)
+
+var _ lineReader = &jsonLinesReader{}
+
+/*
+jsonLinesReader is the line reader for LOAD DATA ... FORMAT JSONLINES.
+Every line is a json object, the fields of the line are the values of the
+keys named by the data columns.
+*/
+type jsonLinesReader struct {
+	r *bufio.Reader
+	//the keys of the data columns
+	names  []string
+	closed int32
+}
+
+func newJSONLinesReader(r io.Reader, names []string) *jsonLinesReader {
+	return &jsonLinesReader{
+		r:     bufio.NewReader(r),
+		names: names,
+	}
+}
+
+func (jr *jsonLinesReader) ReadLoop(lineOutChan chan simdcsv.LineOut) error {
+	defer func() {
+		//the channel is closed when the load data is stopped
+		if e := recover(); e != nil {
+			logutil.Infof("jsonlines reader exits. %v", e)
+		}
+	}()
+	lineNo := 0
+	for atomic.LoadInt32(&jr.closed) == 0 {
+		data, err := jr.r.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return err
+		}
+		lineNo++
+		if len(bytes.TrimSpace(data)) != 0 {
+			line, err2 := jr.parseLine(data)
+			if err2 != nil {
+				return fmt.Errorf("invalid json object in line %d: %v", lineNo, err2)
+			}
+			lineOutChan <- simdcsv.LineOut{Lines: nil, Line: line}
+		}
+		if err == io.EOF {
+			break
+		}
+	}
+	lineOutChan <- simdcsv.LineOut{Lines: nil, Line: nil}
+	return nil
+}
+
+func (jr *jsonLinesReader) Close() {
+	atomic.StoreInt32(&jr.closed, 1)
+}
+
+/*
+parseLine converts the json object into the fields. The missing key and the
+json null are NULL, the objects and the arrays are kept as json text.
+*/
+func (jr *jsonLinesReader) parseLine(data []byte) ([]string, error) {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+	line := make([]string, len(jr.names))
+	for i, name := range jr.names {
+		raw, ok := obj[name]
+		if !ok {
+			//the column names are case-insensitive
+			for key, value := range obj {
+				if strings.EqualFold(key, name) {
+					raw, ok = value, true
+					break
+				}
+			}
+		}
+		if !ok {
+			line[i] = NULL_FLAG
+			continue
+		}
+		raw = bytes.TrimSpace(raw)
+		switch {
+		case bytes.Equal(raw, []byte("null")):
+			line[i] = NULL_FLAG
+		case bytes.Equal(raw, []byte("true")):
+			line[i] = "1"
+		case bytes.Equal(raw, []byte("false")):
+			line[i] = "0"
+		case raw[0] == '"':
+			var s string
+			if err := json.Unmarshal(raw, &s); err != nil {
+				return nil, err
+			}
+			line[i] = s
+		default:
+			//numbers, objects and arrays
+			line[i] = string(raw)
+		}
+	}
+	return line, nil
+}
+
+/*
+loadDataColumnNames returns the names of the data columns of LOAD DATA.
+*/
+func loadDataColumnNames(load *tree.Load, relation engine.Relation) ([]string, error) {
+	var names []string
+	if len(load.ColumnList) == 0 {
+		for _, def := range relation.TableDefs() {
+			if attr, ok := def.(*engine.AttributeDef); ok {
+				names = append(names, attr.Attr.Name)
+			}
+		}
+		return names, nil
+	}
+	for _, col := range load.ColumnList {
+		switch realCol := col.(type) {
+		case *tree.UnresolvedName:
+			names = append(names, realCol.Parts[0])
+		case *tree.VarExpr:
+			names = append(names, realCol.Name)
+		default:
+			return nil, fmt.Errorf("unsupported column type %v", realCol)
+		}
+	}
+	return names, nil
+}
+
+/*
+loadParquet loads the row groups of the parquet file into the table.
+The columns of the file are matched with the column list of LOAD DATA by
+position, or with the columns of the table by name.
+*/
+func (mce *MysqlCmdExecutor) loadParquet(load *tree.Load, dataFile io.Reader, tableHandler engine.Relation) (*LoadResult, error) {
+	ses := mce.GetSession()
+	if len(load.Assignments) != 0 {
+		return nil, fmt.Errorf("SET is not supported in loading parquet")
+	}
+
+	//the parquet file is read randomly
+	file, ok := dataFile.(*os.File)
+	if !ok {
+		tmp, err := os.CreateTemp("", "load-*.parquet")
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			tmp.Close()
+			os.Remove(tmp.Name())
+		}()
+		if _, err = io.Copy(tmp, dataFile); err != nil {
+			return nil, err
+		}
+		file = tmp
+	}
+	stat, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	r, err := parquet.NewReader(file, stat.Size())
+	if err != nil {
+		return nil, err
+	}
+	fileCols, err := r.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	var cols []*engine.AttributeDef
+	var attrName []string
+	for _, def := range tableHandler.TableDefs() {
+		if attr, ok := def.(*engine.AttributeDef); ok {
+			cols = append(cols, attr)
+			attrName = append(attrName, attr.Attr.Name)
+		}
+	}
+
+	//the column of the file for every column of the table, -1 means NULL
+	tableColumnId2FileColumnId := make([]int, len(cols))
+	for i := range tableColumnId2FileColumnId {
+		tableColumnId2FileColumnId[i] = -1
+	}
+	if len(load.ColumnList) != 0 {
+		if len(load.ColumnList) > len(fileCols) {
+			return nil, fmt.Errorf("the parquet file has %d columns, less than the column list", len(fileCols))
+		}
+		for i, col := range load.ColumnList {
+			name, ok := col.(*tree.UnresolvedName)
+			if !ok {
+				return nil, fmt.Errorf("variable is not supported in loading parquet")
+			}
+			tid := -1
+			for j, attr := range attrName {
+				if attr == name.Parts[0] {
+					tid = j
+				}
+			}
+			if tid < 0 {
+				return nil, fmt.Errorf("no such column %s", name.Parts[0])
+			}
+			tableColumnId2FileColumnId[tid] = i
+		}
+	} else {
+		for i, attr := range attrName {
+			for j, fileCol := range fileCols {
+				if strings.EqualFold(attr, fileCol.Name) {
+					tableColumnId2FileColumnId[i] = j
+					break
+				}
+			}
+		}
+	}
+
+	closeRef := NewCloseLoadData()
+	mce.loadDataClose = closeRef
+
+	result := &LoadResult{}
+	batchSize := int(ses.GetSessionVars().GetBatchSizeInLoadData())
+	maxEntryBytes := ses.Pu.SV.GetCubeMaxEntriesBytes()
+	skipWriteBatch := ses.GetSessionVars().GetLoadDataSkipWritingBatch()
+	ignored := int64(load.IgnoredLines)
+	for rg := 0; rg < r.RowGroups(); rg++ {
+		rows := r.Rows(rg)
+		if ignored >= rows {
+			ignored -= rows
+			continue
+		}
+		vecs := make([]*vector.Vector, len(cols))
+		for i, col := range cols {
+			if fid := tableColumnId2FileColumnId[i]; fid >= 0 {
+				if vecs[i], err = r.Read(rg, fid, col.Attr.Type); err != nil {
+					return nil, fmt.Errorf("column %s: %v", col.Attr.Name, err)
+				}
+			} else {
+				vecs[i] = newNullVector(col.Attr.Type, int(rows))
+			}
+		}
+		for start := ignored; start < rows; {
+			select {
+			case <-closeRef.stopLoadData:
+				return nil, NewMysqlError(ER_QUERY_INTERRUPTED)
+			default:
+			}
+			end := start + int64(batchSize)
+			if end > rows {
+				end = rows
+			}
+			//the entry of the cube is limited
+			for end-start > 1 && windowBytes(vecs, start, end) > maxEntryBytes {
+				end = start + (end-start)/2
+			}
+			bat := batch.New(true, attrName)
+			for i, vec := range vecs {
+				bat.Vecs[i] = windowVector(vec, start, end)
+			}
+			if !skipWriteBatch {
+				err = tableHandler.Write(0, bat)
+			}
+			if err == nil {
+				result.Records += uint64(end - start)
+			} else if isWriteBatchTimeoutError(err) {
+				logutil.Errorf("write failed. err: %v", err)
+				result.WriteTimeout += uint64(end - start)
+				err = nil
+			} else {
+				logutil.Errorf("write failed. err: %v", err)
+				result.Skipped += uint64(end - start)
+				err = nil
+			}
+			start = end
+		}
+		ignored = 0
+	}
+	return result, nil
+}
+
+/*
+windowVector returns the rows [start,end) of the vector.
+*/
+func windowVector(v *vector.Vector, start, end int64) *vector.Vector {
+	w := vector.Window(v, int(start), int(end), vector.New(v.Typ))
+	//the nulls of the window begin from zero
+	w.Nsp = &nulls.Nulls{}
+	if nulls.Any(v.Nsp) {
+		for row := start; row < end; row++ {
+			if nulls.Contains(v.Nsp, uint64(row)) {
+				nulls.Add(w.Nsp, uint64(row-start))
+			}
+		}
+	}
+	return w
+}
+
+/*
+windowBytes estimates the bytes of the rows [start,end) of the vectors.
+*/
+func windowBytes(vecs []*vector.Vector, start, end int64) int64 {
+	var size int64
+	for _, vec := range vecs {
+		switch vec.Typ.Oid {
+		case types.T_char, types.T_varchar, types.T_json:
+			for _, n := range vec.Col.(*types.Bytes).Lengths[start:end] {
+				size += int64(n)
+			}
+		default:
+			size += (end - start) * int64(vec.Typ.Size)
+		}
+	}
+	return size
+}
+
+/*
+newNullVector returns a vector of rows NULL.
+*/
+func newNullVector(typ types.Type, rows int) *vector.Vector {
+	vec := vector.New(typ)
+	switch typ.Oid {
+	case types.T_int8:
+		vec.Col = make([]int8, rows)
+	case types.T_int16:
+		vec.Col = make([]int16, rows)
+	case types.T_int32:
+		vec.Col = make([]int32, rows)
+	case types.T_int64:
+		vec.Col = make([]int64, rows)
+	case types.T_uint8:
+		vec.Col = make([]uint8, rows)
+	case types.T_uint16:
+		vec.Col = make([]uint16, rows)
+	case types.T_uint32:
+		vec.Col = make([]uint32, rows)
+	case types.T_uint64:
+		vec.Col = make([]uint64, rows)
+	case types.T_float32:
+		vec.Col = make([]float32, rows)
+	case types.T_float64:
+		vec.Col = make([]float64, rows)
+	case types.T_char, types.T_varchar, types.T_json:
+		vec.Col = &types.Bytes{
+			Offsets: make([]uint32, rows),
+			Lengths: make([]uint32, rows),
+		}
+	case types.T_date:
+		vec.Col = make([]types.Date, rows)
+	case types.T_datetime:
+		vec.Col = make([]types.Datetime, rows)
+	case types.T_decimal:
+		vec.Col = make([]types.Decimal, rows)
+	}
+	for row := 0; row < rows; row++ {
+		nulls.Add(vec.Nsp, uint64(row))
+	}
+	return vec
+}
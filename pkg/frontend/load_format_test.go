@@ -0,0 +1,187 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/fagongzi/goetty/buf"
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/parquet"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/simdcsv"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func Test_jsonLinesReader(t *testing.T) {
+	convey.Convey("jsonLinesReader succ", t, func() {
+		data := "{\"a\":1,\"B\":\"x\\ny\",\"c\":null}\n\n" +
+			"{\"a\":2.5,\"b\":true,\"c\":{\"k\":[1,2]}}\r\n" +
+			"{\"b\":false}"
+		ch := make(chan simdcsv.LineOut, 10)
+		jr := newJSONLinesReader(strings.NewReader(data), []string{"a", "b", "c"})
+		convey.So(jr.ReadLoop(ch), convey.ShouldBeNil)
+		var lines [][]string
+		for out := range ch {
+			if out.Line == nil {
+				break
+			}
+			lines = append(lines, out.Line)
+		}
+		convey.So(lines, convey.ShouldResemble, [][]string{
+			{"1", "x\ny", NULL_FLAG},
+			{"2.5", "1", "{\"k\":[1,2]}"},
+			{NULL_FLAG, "0", NULL_FLAG},
+		})
+
+		jr = newJSONLinesReader(strings.NewReader("{\"a\":1}\n[1]\n"), []string{"a"})
+		convey.So(jr.ReadLoop(make(chan simdcsv.LineOut, 10)), convey.ShouldBeError)
+	})
+}
+
+func Test_loadFormat(t *testing.T) {
+	convey.Convey("load jsonlines and parquet succ", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		eng := mock_frontend.NewMockEngine(ctrl)
+		db := mock_frontend.NewMockDatabase(ctrl)
+		rel := mock_frontend.NewMockRelation(ctrl)
+		tableDefs := []engine.TableDef{
+			&engine.AttributeDef{
+				Attr: engine.Attribute{
+					Type: types.Type{Oid: types.T_int64, Size: 8},
+					Name: "id"}},
+			&engine.AttributeDef{
+				Attr: engine.Attribute{
+					Type: types.Type{Oid: types.T_varchar, Size: 24},
+					Name: "name"}},
+			&engine.AttributeDef{
+				Attr: engine.Attribute{
+					Type: types.Type{Oid: types.T_int32, Size: 4},
+					Name: "age"}},
+		}
+		rel.EXPECT().TableDefs().Return(tableDefs).AnyTimes()
+		var ids []int64
+		var names []string
+		var ages []int32
+		var mu sync.Mutex
+		rel.EXPECT().Write(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ uint64, bat *batch.Batch) error {
+				mu.Lock()
+				defer mu.Unlock()
+				n := vector.Length(bat.Vecs[0])
+				for i := 0; i < n; i++ {
+					ids = append(ids, bat.Vecs[0].Col.([]int64)[i])
+					if nulls.Contains(bat.Vecs[1].Nsp, uint64(i)) {
+						names = append(names, "NULL")
+					} else {
+						names = append(names, string(bat.Vecs[1].Col.(*types.Bytes).Get(int64(i))))
+					}
+					if nulls.Contains(bat.Vecs[2].Nsp, uint64(i)) {
+						ages = append(ages, -1)
+					} else {
+						ages = append(ages, bat.Vecs[2].Col.([]int32)[i])
+					}
+				}
+				return nil
+			},
+		).AnyTimes()
+
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().WriteAndFlush(gomock.Any()).Return(nil).AnyTimes()
+
+		pu, err := getParameterUnit("test/system_vars_config.toml", eng)
+		convey.So(err, convey.ShouldBeNil)
+		proto := NewMysqlClientProtocol(0, ioses, 1024, pu.SV)
+		guestMmu := guest.New(pu.SV.GetGuestMmuLimitation(), pu.HostMmu)
+		ses := NewSession(proto, getPCI(), guestMmu, pu.Mempool, pu)
+		mce := NewMysqlCmdExecutor()
+		mce.PrepareSessionBeforeExecRequest(ses)
+
+		parseLoad := func(sql string) *tree.Load {
+			stmts, err := parsers.Parse(dialect.MYSQL, sql)
+			convey.So(err, convey.ShouldBeNil)
+			return stmts[0].(*tree.Load)
+		}
+
+		//jsonlines
+		f, err := os.CreateTemp("", "loadjson")
+		convey.So(err, convey.ShouldBeNil)
+		defer os.Remove(f.Name())
+		_, err = f.WriteString("{\"id\":1,\"name\":\"a\",\"age\":10}\n{\"id\":2,\"name\":null}\n")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(f.Close(), convey.ShouldBeNil)
+
+		load := parseLoad("load data infile '" + f.Name() + "' format jsonlines into table T.A")
+		_, err = mce.LoadLoop(load, db, rel)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ids, convey.ShouldResemble, []int64{1, 2})
+		convey.So(names, convey.ShouldResemble, []string{"a", "NULL"})
+		convey.So(ages, convey.ShouldResemble, []int32{10, -1})
+
+		//parquet with the columns by name, the column age is missing
+		f, err = os.CreateTemp("", "loadparquet")
+		convey.So(err, convey.ShouldBeNil)
+		defer os.Remove(f.Name())
+		pw, err := parquet.NewWriter(f, []parquet.Column{
+			{Name: "NAME", Type: types.Type{Oid: types.T_varchar, Size: 24}},
+			{Name: "ID", Type: types.Type{Oid: types.T_int32, Size: 4}},
+		})
+		convey.So(err, convey.ShouldBeNil)
+		bat := newExportBatch([]int64{0}, []string{"x", "y", "z"})
+		idVec := vector.New(types.Type{Oid: types.T_int32, Size: 4})
+		idVec.Col = []int32{3, 4, 5}
+		rg, err := pw.Encode([]*vector.Vector{bat.Vecs[1], idVec}, nil)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(pw.Write(rg), convey.ShouldBeNil)
+		convey.So(pw.Close(), convey.ShouldBeNil)
+		convey.So(f.Close(), convey.ShouldBeNil)
+
+		ids, names, ages = nil, nil, nil
+		load = parseLoad("load data infile '" + f.Name() + "' format parquet into table T.A ignore 1 lines")
+		result, err := mce.LoadLoop(load, db, rel)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(result.Records, convey.ShouldEqual, 2)
+		convey.So(ids, convey.ShouldResemble, []int64{4, 5})
+		convey.So(names, convey.ShouldResemble, []string{"y", "z"})
+		convey.So(ages, convey.ShouldResemble, []int32{-1, -1})
+
+		//parquet with the column list
+		ids, names, ages = nil, nil, nil
+		load = parseLoad("load data infile '" + f.Name() + "' format parquet into table T.A (name, age)")
+		_, err = mce.LoadLoop(load, db, rel)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(names, convey.ShouldResemble, []string{"x", "y", "z"})
+		convey.So(ages, convey.ShouldResemble, []int32{3, 4, 5})
+
+		load = parseLoad("load data infile '" + f.Name() + "' format parquet into table T.A (name, @v) set age = @v")
+		_, err = mce.LoadLoop(load, db, rel)
+		convey.So(err, convey.ShouldBeError)
+	})
+}
@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"sync"
 	"testing"
 	"time"
 
@@ -388,16 +389,16 @@ func Test_getLineOutFromSimdCsvRoutine(t *testing.T) {
 				simdCsvLineArray: make([][]string, 100)},
 		}
 		handler.closeRef.stopLoadData <- 1
-		gostub.StubFunc(&saveLinesToStorage, nil)
+		stubs := gostub.StubFunc(&saveLinesToStorage, nil)
+		defer stubs.Reset()
 		convey.So(handler.getLineOutFromSimdCsvRoutine(), convey.ShouldBeNil)
 
 		handler.closeRef.stopLoadData <- 1
-		gostub.StubFunc(&saveLinesToStorage, errors.New("1"))
+		stubs.StubFunc(&saveLinesToStorage, errors.New("1"))
 		convey.So(handler.getLineOutFromSimdCsvRoutine(), convey.ShouldNotBeNil)
 
 		getParsedLinesChan(handler.simdCsvGetParsedLinesChan)
-		stubs := gostub.StubFunc(&saveLinesToStorage, nil)
-		defer stubs.Reset()
+		stubs.StubFunc(&saveLinesToStorage, nil)
 		convey.So(handler.getLineOutFromSimdCsvRoutine(), convey.ShouldNotBeNil)
 
 		handler.maxEntryBytesForCube = 5
@@ -505,3 +506,90 @@ func Test_PrintThreadInfo(t *testing.T) {
 
 	})
 }
+
+func Test_loadAssignments(t *testing.T) {
+	convey.Convey("load with user variables and SET clause succ", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		eng := mock_frontend.NewMockEngine(ctrl)
+		db := mock_frontend.NewMockDatabase(ctrl)
+		rel := mock_frontend.NewMockRelation(ctrl)
+		tableDefs := []engine.TableDef{
+			&engine.AttributeDef{
+				Attr: engine.Attribute{
+					Type: types.Type{Oid: types.T_int32, Size: 4},
+					Name: "a"}},
+			&engine.AttributeDef{
+				Attr: engine.Attribute{
+					Type: types.Type{Oid: types.T_varchar, Size: 24},
+					Name: "b"}},
+			&engine.AttributeDef{
+				Attr: engine.Attribute{
+					Type: types.Type{Oid: types.T_int64, Size: 8},
+					Name: "c"}},
+		}
+		rel.EXPECT().TableDefs().Return(tableDefs).AnyTimes()
+		var a []int32
+		var b []string
+		var c []int64
+		var attrs []string
+		var mu sync.Mutex
+		rel.EXPECT().Write(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ uint64, bat *batch.Batch) error {
+				mu.Lock()
+				defer mu.Unlock()
+				attrs = bat.Attrs
+				a = append(a, bat.Vecs[0].Col.([]int32)...)
+				vs := bat.Vecs[1].Col.(*types.Bytes)
+				for i := range vs.Offsets {
+					b = append(b, string(vs.Get(int64(i))))
+				}
+				c = append(c, bat.Vecs[2].Col.([]int64)...)
+				return nil
+			},
+		).AnyTimes()
+
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().WriteAndFlush(gomock.Any()).Return(nil).AnyTimes()
+
+		f, err := os.CreateTemp("", "loadassign")
+		convey.So(err, convey.ShouldBeNil)
+		defer os.Remove(f.Name())
+		_, err = f.WriteString("1,x\\,y,5\n2,z,6\n")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(f.Close(), convey.ShouldBeNil)
+
+		sql := "load data infile '" + f.Name() + "' into table T.A " +
+			"fields terminated by ',' escaped by '\\\\' " +
+			"(a, @v, @w) set b = @v, c = cast(@w as signed) * 2"
+		stmts, err := parsers.Parse(dialect.MYSQL, sql)
+		convey.So(err, convey.ShouldBeNil)
+		load := stmts[0].(*tree.Load)
+
+		pu, err := getParameterUnit("test/system_vars_config.toml", eng)
+		convey.So(err, convey.ShouldBeNil)
+		proto := NewMysqlClientProtocol(0, ioses, 1024, pu.SV)
+		guestMmu := guest.New(pu.SV.GetGuestMmuLimitation(), pu.HostMmu)
+		ses := NewSession(proto, getPCI(), guestMmu, pu.Mempool, pu)
+		mce := NewMysqlCmdExecutor()
+		mce.PrepareSessionBeforeExecRequest(ses)
+
+		for _, choose := range []bool{true, false} {
+			a, b, c, attrs = nil, nil, nil, nil
+			row2col := gostub.Stub(&row2colChoose, choose)
+			_, err = mce.LoadLoop(load, db, rel)
+			row2col.Reset()
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(attrs, convey.ShouldResemble, []string{"a", "b", "c"})
+			convey.So(a, convey.ShouldResemble, []int32{1, 2})
+			convey.So(b, convey.ShouldResemble, []string{"x,y", "z"})
+			convey.So(c, convey.ShouldResemble, []int64{10, 12})
+		}
+
+		load.Assignments[0].Names[0].Parts[0] = "d"
+		_, err = mce.LoadLoop(load, db, rel)
+		convey.So(err, convey.ShouldBeError)
+	})
+}
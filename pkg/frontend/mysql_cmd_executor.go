@@ -15,8 +15,11 @@
 package frontend
 
 import (
+	"encoding/binary"
 	"fmt"
+	"go/constant"
 	"os"
+	"path/filepath"
 	"runtime/pprof"
 	"strconv"
 	"strings"
@@ -29,12 +32,16 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/compile"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/txn"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/like"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -100,6 +107,8 @@ type outputQueue struct {
 	rowIdx uint64
 	length uint64
 	ep     *tree.ExportParam
+	//the rows are sent in the binary protocol for COM_STMT_EXECUTE
+	binary bool
 
 	getEmptyRowTime time.Duration
 	flushTime       time.Duration
@@ -157,6 +166,12 @@ func (o *outputQueue) flush() error {
 			logutil.Errorf("export to csv file error %v \n", err)
 			return err
 		}
+	} else if o.binary {
+		//send group of row in the binary protocol
+		if err := o.proto.SendResultSetBinaryBatchRow(o.mrs, o.rowIdx); err != nil {
+			logutil.Errorf("flush error %v \n", err)
+			return err
+		}
 	} else {
 		//send group of row
 		if err := o.proto.SendResultSetTextBatchRowSpeedup(o.mrs, o.rowIdx); err != nil {
@@ -190,6 +205,15 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 		return nil
 	}
 
+	if ses.exporter != nil {
+		select {
+		case <-ses.closeRef.stopExportData:
+			return nil
+		default:
+		}
+		return ses.exporter.write(bat)
+	}
+
 	goID := GetRoutineId()
 
 	logutil.Infof("goid %d \n", goID)
@@ -213,7 +237,7 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 	mrs.Name2Index = ses.Mrs.Name2Index
 
 	begin3 := time.Now()
-	countOfResultSet := 1
+	countOfResultSet := int(ses.GetSessionVars().GetCountOfRowsPerSendingToClient())
 	//group row
 	mrs.Data = make([][]interface{}, countOfResultSet)
 	for i := 0; i < countOfResultSet; i++ {
@@ -222,6 +246,7 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 	allocateOutBufferTime := time.Since(begin3)
 
 	oq := NewOuputQueue(proto, mrs, uint64(countOfResultSet), ses.ep)
+	oq.binary = uint8(ses.Cmd) == COM_STMT_EXECUTE
 	oq.reset()
 
 	row2colTime := time.Duration(0)
@@ -429,6 +454,18 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 						row[i] = vs[rowIndex]
 					}
 				}
+			case types.T_decimal:
+				if !nulls.Any(vec.Nsp) { //all data in this column are not null
+					vs := vec.Col.([]types.Decimal)
+					row[i] = vs[rowIndex].Format(types.DecimalScale(vec.Typ))
+				} else {
+					if nulls.Contains(vec.Nsp, uint64(rowIndex)) { //is null
+						row[i] = nil
+					} else {
+						vs := vec.Col.([]types.Decimal)
+						row[i] = vs[rowIndex].Format(types.DecimalScale(vec.Typ))
+					}
+				}
 			default:
 				logutil.Errorf("getDataFromPipeline : unsupported type %d \n", vec.Typ.Oid)
 				return fmt.Errorf("getDataFromPipeline : unsupported type %d \n", vec.Typ.Oid)
@@ -484,6 +521,9 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 
 func (mce *MysqlCmdExecutor) handleChangeDB(db string) error {
 	ses := mce.GetSession()
+	if err := ses.GetMysqlProtocol().GetPrivilegeChecker().CheckAny(db); err != nil {
+		return err
+	}
 	//TODO: check meta data
 	if _, err := ses.Pu.StorageEngine.Database(db); err != nil {
 		//echo client. no such database
@@ -514,7 +554,7 @@ func (mce *MysqlCmdExecutor) handleSelectDatabase(sel *tree.Select) error {
 	ses.Mrs.AddRow([]interface{}{val})
 
 	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
-	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
+	resp := NewResponse(ResultResponse, int(ses.ServerStatus()), ses.Cmd, mer)
 
 	if err = proto.SendResponse(resp); err != nil {
 		return fmt.Errorf("routine send response failed. error:%v ", err)
@@ -523,112 +563,59 @@ func (mce *MysqlCmdExecutor) handleSelectDatabase(sel *tree.Select) error {
 }
 
 /*
-handle "SELECT @@max_allowed_packet"
-*/
-func (mce *MysqlCmdExecutor) handleMaxAllowedPacket() error {
-	var err error = nil
-	ses := mce.GetSession()
-	proto := ses.protocol
-
-	col := new(MysqlColumn)
-	col.SetColumnType(defines.MYSQL_TYPE_LONG)
-	col.SetName("@@max_allowed_packet")
-	ses.Mrs.AddColumn(col)
-
-	var data = make([]interface{}, 1)
-	//16MB
-	data[0] = 16777216
-	ses.Mrs.AddRow(data)
-
-	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
-	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
-
-	if err := proto.SendResponse(resp); err != nil {
-		return fmt.Errorf("routine send response failed. error:%v ", err)
-	}
-	return err
-}
-
-/*
-handle "SELECT @@version_comment"
+handle "SELECT @@xxx.yyyy, @zzz"
 */
-func (mce *MysqlCmdExecutor) handleVersionComment() error {
+func (mce *MysqlCmdExecutor) handleSelectVariables(exprs tree.SelectExprs) error {
 	var err error = nil
 	ses := mce.GetSession()
 	proto := ses.protocol
 
-	col := new(MysqlColumn)
-	col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
-	col.SetName("@@version_comment")
-	ses.Mrs.AddColumn(col)
-
-	var data = make([]interface{}, 1)
-	data[0] = "MatrixOne"
-	ses.Mrs.AddRow(data)
-
-	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
-	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
-
-	if err := proto.SendResponse(resp); err != nil {
-		return fmt.Errorf("routine send response failed. error:%v ", err)
-	}
-	return err
-}
-
-/*
-handle "SELECT @@session.tx_isolation"
-*/
-func (mce *MysqlCmdExecutor) handleTxIsolation() error {
-	var err error = nil
-	ses := mce.GetSession()
-	proto := ses.protocol
-
-	col := new(MysqlColumn)
-	col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
-	col.SetName("@@session.tx_isolation")
-	ses.Mrs.AddColumn(col)
-
-	var data = make([]interface{}, 1)
-	data[0] = "REPEATABLE-READ"
-	ses.Mrs.AddRow(data)
-
-	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
-	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
-
-	if err := proto.SendResponse(resp); err != nil {
-		return fmt.Errorf("routine send response failed. error:%v ", err)
-	}
-	return err
-}
-
-/*
-handle "SELECT @@xxx.yyyy"
-*/
-func (mce *MysqlCmdExecutor) handleSelectVariables(v string) error {
-	var err error = nil
-	ses := mce.GetSession()
-	proto := ses.protocol
+	var data = make([]interface{}, len(exprs))
+	for i, expr := range exprs {
+		ve, ok := expr.Expr.(*tree.VarExpr)
+		if !ok {
+			return fmt.Errorf("unsupported expression %s", tree.String(expr.Expr, dialect.MYSQL))
+		}
+		value, err := ses.GetSessionVars().Get(ve)
+		if err != nil {
+			return err
+		}
 
-	if v == "tx_isolation" || v == "transaction_isolation" {
 		col := new(MysqlColumn)
-		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
-		col.SetName("@@tx_isolation")
+		switch v := value.(type) {
+		case bool:
+			col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+			data[i] = boolToInt64(v)
+		case int64:
+			col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+			data[i] = v
+		case uint64:
+			col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+			col.SetSigned(false)
+			data[i] = v
+		case float64:
+			col.SetColumnType(defines.MYSQL_TYPE_DOUBLE)
+			data[i] = v
+		default:
+			col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+			data[i] = v
+		}
+		if expr.As != "" {
+			col.SetName(string(expr.As))
+		} else {
+			col.SetName(tree.String(ve, dialect.MYSQL))
+		}
 		ses.Mrs.AddColumn(col)
-
-		var data = make([]interface{}, 1)
-		data[0] = "REPEATABLE-READ"
-		ses.Mrs.AddRow(data)
-	} else {
-		return fmt.Errorf("unsupported system variable %s", v)
 	}
+	ses.Mrs.AddRow(data)
 
 	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
-	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
+	resp := NewResponse(ResultResponse, int(ses.ServerStatus()), ses.Cmd, mer)
 
-	if err := proto.SendResponse(resp); err != nil {
+	if err = proto.SendResponse(resp); err != nil {
 		return fmt.Errorf("routine send response failed. error:%v ", err)
 	}
-	return err
+	return nil
 }
 
 /*
@@ -640,31 +627,26 @@ func (mce *MysqlCmdExecutor) handleLoadData(load *tree.Load) error {
 	proto := ses.protocol
 
 	logutil.Infof("+++++load data")
-	/*
-		TODO:support LOCAL
-	*/
-	if load.Local {
-		return fmt.Errorf("LOCAL is unsupported now")
-	}
-
 	if load.Fields == nil || len(load.Fields.Terminated) == 0 {
 		return fmt.Errorf("load need FIELDS TERMINATED BY ")
 	}
 
-	if load.Fields != nil && load.Fields.EscapedBy != 0 {
-		return fmt.Errorf("EscapedBy field is unsupported now")
-	}
-
 	/*
-		check file
+		check file. the file of LOCAL is on the client, it is requested in the LoadLoop.
 	*/
-	exist, isfile, err := PathExists(load.File)
-	if err != nil || !exist {
-		return fmt.Errorf("file %s does exist. err:%v", load.File, err)
-	}
+	if !load.Local {
+		//the file on the server can be read by the accounts with FILE only
+		if err = ses.GetMysqlProtocol().GetPrivilegeChecker().CheckGlobal(privilege.File); err != nil {
+			return err
+		}
+		exist, isfile, err := PathExists(load.File)
+		if err != nil || !exist {
+			return fmt.Errorf("file %s does exist. err:%v", load.File, err)
+		}
 
-	if !isfile {
-		return fmt.Errorf("file %s is a directory.", load.File)
+		if !isfile {
+			return fmt.Errorf("file %s is a directory.", load.File)
+		}
 	}
 
 	/*
@@ -680,8 +662,11 @@ func (mce *MysqlCmdExecutor) handleLoadData(load *tree.Load) error {
 		//then, it uses the database name in the session
 		loadDb = ses.protocol.GetDatabaseName()
 	}
+	if err = ses.GetMysqlProtocol().GetPrivilegeChecker().CheckTable(privilege.Insert, loadDb, loadTable); err != nil {
+		return err
+	}
 
-	dbHandler, err := ses.Pu.StorageEngine.Database(loadDb)
+	dbHandler, err := ses.GetStorage().Database(loadDb)
 	if err != nil {
 		//echo client. no such database
 		return NewMysqlError(ER_BAD_DB_ERROR, loadDb)
@@ -715,7 +700,7 @@ func (mce *MysqlCmdExecutor) handleLoadData(load *tree.Load) error {
 		response
 	*/
 	info := NewMysqlError(ER_LOAD_INFO, result.Records, result.Deleted, result.Skipped, result.Warnings, result.WriteTimeout).Error()
-	resp := NewOkResponse(result.Records, 0, uint16(result.Warnings), 0, int(COM_QUERY), info)
+	resp := NewOkResponse(result.Records, 0, uint16(result.Warnings), int(ses.ServerStatus()), int(COM_QUERY), info)
 	if err = proto.SendResponse(resp); err != nil {
 		return fmt.Errorf("routine send response failed. error:%v ", err)
 	}
@@ -734,6 +719,9 @@ func (mce *MysqlCmdExecutor) handleCmdFieldList(tableName string) error {
 	if db == "" {
 		return NewMysqlError(ER_NO_DB_ERROR)
 	}
+	if err = proto.GetPrivilegeChecker().CheckTable(privilege.Select, db, tableName); err != nil {
+		return err
+	}
 
 	//Get table infos for the database from the cube
 	//case 1: there are no table infos for the db
@@ -786,7 +774,7 @@ func (mce *MysqlCmdExecutor) handleCmdFieldList(tableName string) error {
 		mysql CMD_FIELD_LIST response: End after the column has been sent.
 		send EOF packet
 	*/
-	err = proto.SendEOFPacketIf(0, 0)
+	err = proto.SendEOFPacketIf(0, ses.ServerStatus())
 	if err != nil {
 		return err
 	}
@@ -797,11 +785,54 @@ func (mce *MysqlCmdExecutor) handleCmdFieldList(tableName string) error {
 /*
 handle setvar
 */
-func (mce *MysqlCmdExecutor) handleSetVar(_ *tree.SetVar) error {
+func (mce *MysqlCmdExecutor) handleSetVar(epoch uint64, sv *tree.SetVar) error {
 	var err error = nil
-	proto := mce.GetSession().protocol
+	ses := mce.GetSession()
+	proto := ses.protocol
+	vars := ses.GetSessionVars()
+	autocommit := vars.Autocommit()
 
-	resp := NewOkResponse(0, 0, 0, 0, int(COM_QUERY), "")
+	for _, assign := range sv.Assignments {
+		if assign.System && isCharsetAssignment(assign.Name) {
+			continue
+		}
+		if assign.System && assign.Global {
+			//SET GLOBAL changes the variables of all sessions
+			if err = ses.GetMysqlProtocol().GetPrivilegeChecker().CheckGlobal(privilege.Super); err != nil {
+				return err
+			}
+		}
+		if _, ok := assign.Value.(*tree.DefaultVal); ok {
+			if !assign.System {
+				return fmt.Errorf("user variable %s can not be set to DEFAULT", assign.Name)
+			}
+			if err = vars.ResetSystem(assign.Name, assign.Global); err != nil {
+				return err
+			}
+			continue
+		}
+		var value interface{}
+		if name, ok := assign.Value.(*tree.UnresolvedName); ok && assign.System && name.NumParts == 1 {
+			//SET var = ON, the identifier is the string value
+			value = name.Parts[0]
+		} else if value, err = vars.Eval(assign.Value); err != nil {
+			return err
+		}
+		if !assign.System {
+			vars.SetUser(assign.Name, value)
+		} else if err = vars.SetSystem(assign.Name, value, assign.Global); err != nil {
+			return err
+		}
+	}
+
+	//turning autocommit on commits the transaction in progress
+	if !autocommit && vars.Autocommit() {
+		if err = mce.commitTxn(epoch); err != nil {
+			return err
+		}
+	}
+
+	resp := NewOkResponse(0, 0, 0, int(ses.ServerStatus()), int(COM_QUERY), "")
 	if err = proto.SendResponse(resp); err != nil {
 		return fmt.Errorf("routine send response failed. error:%v ", err)
 	}
@@ -811,10 +842,15 @@ func (mce *MysqlCmdExecutor) handleSetVar(_ *tree.SetVar) error {
 /*
 handle show variables
 */
-func (mce *MysqlCmdExecutor) handleShowVariables(_ *tree.ShowVariables) error {
+func (mce *MysqlCmdExecutor) handleShowVariables(sv *tree.ShowVariables) error {
 	var err error = nil
 	ses := mce.GetSession()
 	proto := mce.GetSession().protocol
+	vars := ses.GetSessionVars()
+
+	if sv.Where != nil {
+		return fmt.Errorf("show variables with where clause is unsupported now")
+	}
 
 	col1 := new(MysqlColumn)
 	col1.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
@@ -827,34 +863,88 @@ func (mce *MysqlCmdExecutor) handleShowVariables(_ *tree.ShowVariables) error {
 	ses.Mrs.AddColumn(col1)
 	ses.Mrs.AddColumn(col2)
 
+	var pattern []byte
+	if sv.Like != nil {
+		value, err := vars.Eval(sv.Like.Right)
+		if err != nil {
+			return err
+		}
+		//the names of variables are case insensitive
+		pattern = []byte(strings.ToLower(variableString(value)))
+	}
+
+	tempSlice := make([]int64, 1)
+	for _, name := range vars.SystemNames() {
+		if pattern != nil {
+			if k, _ := like.BtConstAndConst([]byte(strings.ToLower(name)), pattern, tempSlice); k == nil {
+				continue
+			}
+		}
+		value, err := vars.GetSystem(name, sv.Global)
+		if err != nil {
+			return err
+		}
+		ses.Mrs.AddRow([]interface{}{name, variableString(value)})
+	}
+
 	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
-	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
+	resp := NewResponse(ResultResponse, int(ses.ServerStatus()), ses.Cmd, mer)
 
-	if err := proto.SendResponse(resp); err != nil {
+	if err = proto.SendResponse(resp); err != nil {
 		return fmt.Errorf("routine send response failed. error:%v ", err)
 	}
-	return err
+	return nil
 }
 
-func (mce *MysqlCmdExecutor) handleAnalyzeStmt(stmt *tree.AnalyzeStmt) error {
-	// rewrite analyzeStmt to `select approx_count_distinct(col), .. from tbl`
-	// IMO, this approach is simple and future-proof
-	// Although this rewriting processing could have been handled in rewrite module,
-	// `handleAnalyzeStmt` can be easily managed by cron jobs in the future
-	ctx := tree.NewFmtCtx(dialect.MYSQL)
-	ctx.WriteString("select ")
-	for i, ident := range stmt.Cols {
-		if i > 0 {
-			ctx.WriteByte(',')
-		}
-		ctx.WriteString("approx_count_distinct(")
-		ctx.WriteString(string(ident))
-		ctx.WriteByte(')')
-	}
-	ctx.WriteString(" from ")
-	stmt.Table.Format(ctx)
-	sql := ctx.String()
-	return mce.doComQuery(sql)
+/*
+handle BEGIN, the transaction in progress is committed implicitly
+*/
+func (mce *MysqlCmdExecutor) handleBegin(epoch uint64) error {
+	ses := mce.GetSession()
+	if err := mce.commitTxn(epoch); err != nil {
+		return err
+	}
+	ses.txn = ses.txns.Begin(ses.Pu.StorageEngine,
+		engine.Node{Id: compile.Address, Addr: compile.Address})
+	return ses.GetMysqlProtocol().SendResponse(NewOkResponse(0, 0, 0, int(ses.ServerStatus()), int(COM_QUERY), ""))
+}
+
+/*
+handle COMMIT
+*/
+func (mce *MysqlCmdExecutor) handleCommit(epoch uint64) error {
+	ses := mce.GetSession()
+	if err := mce.commitTxn(epoch); err != nil {
+		return err
+	}
+	return ses.GetMysqlProtocol().SendResponse(NewOkResponse(0, 0, 0, int(ses.ServerStatus()), int(COM_QUERY), ""))
+}
+
+/*
+handle ROLLBACK
+*/
+func (mce *MysqlCmdExecutor) handleRollback() error {
+	ses := mce.GetSession()
+	if ses.txn != nil {
+		ses.txn.Rollback()
+		ses.txn = nil
+	}
+	return ses.GetMysqlProtocol().SendResponse(NewOkResponse(0, 0, 0, int(ses.ServerStatus()), int(COM_QUERY), ""))
+}
+
+// commitTxn commits the transaction of session if it exists, the session
+// is in autocommit mode after it whether the commit succeeds or not.
+func (mce *MysqlCmdExecutor) commitTxn(epoch uint64) error {
+	ses := mce.GetSession()
+	if ses.txn == nil {
+		return nil
+	}
+	err := ses.txn.Commit(epoch)
+	ses.txn = nil
+	if err == txn.ErrConflict {
+		return NewMysqlError(ER_LOCK_DEADLOCK)
+	}
+	return err
 }
 
 type ComputationWrapperImpl struct {
@@ -895,6 +985,12 @@ func (cw *ComputationWrapperImpl) GetAffectedRows() uint64 {
 	return cw.exec.GetAffectedRows()
 }
 
+//Bind does the semantic analysis of the statement, the columns of result are got by GetColumns
+func (cw *ComputationWrapperImpl) Bind() error {
+	_, err := cw.exec.Bind()
+	return err
+}
+
 func (cw *ComputationWrapperImpl) Compile(u interface{},
 	fill func(interface{}, *batch.Batch) error) error {
 	return cw.exec.Compile(u, fill)
@@ -905,10 +1001,14 @@ func (cw *ComputationWrapperImpl) Run(ts uint64) error {
 }
 
 /*
-GetComputationWrapper gets the execs from the computation engine
+GetComputationWrapper gets the execs from the computation engine,
+sql is parsed if stmts which are the parsed statements of it are nil
 */
-var GetComputationWrapper = func(db, sql, user string, eng engine.Engine, proc *process.Process) ([]ComputationWrapper, error) {
-	comp := compile.New(db, sql, user, eng, proc)
+var GetComputationWrapper = func(dt dialect.DialectType, db, sql, user string, eng engine.Engine, proc *process.Process, pc *privilege.Checker,
+	stmts []tree.Statement, params []tree.Expr, vars func(*tree.VarExpr) (tree.Expr, error)) ([]ComputationWrapper, error) {
+	comp := compile.New(db, sql, user, eng, proc, pc)
+	comp.SetDialect(dt)
+	comp.SetStatements(stmts)
 	execs, err := comp.Build()
 	if err != nil {
 		return nil, err
@@ -916,13 +1016,61 @@ var GetComputationWrapper = func(db, sql, user string, eng engine.Engine, proc *
 
 	var cw []ComputationWrapper = nil
 	for _, e := range execs {
+		e.SetParams(params)
+		e.SetVariables(vars)
 		cw = append(cw, NewComputationWrapperImpl(e))
 	}
 	return cw, err
 }
 
-//execute query
-func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
+//newProcess makes the process to execute the statements of the session
+func (mce *MysqlCmdExecutor) newProcess() *process.Process {
+	ses := mce.GetSession()
+	proc := process.New(mheap.New(ses.GuestMmu))
+	proc.Id = mce.getNextProcessId()
+	proc.Lim.Size = ses.Pu.SV.GetProcessLimitationSize()
+	proc.Lim.BatchRows = ses.Pu.SV.GetProcessLimitationBatchRows()
+	proc.Lim.PartitionRows = ses.Pu.SV.GetProcessLimitationPartitionRows()
+	proc.Spill.Dir = filepath.Join(ses.Pu.SV.GetStorePath(), "spill")
+	return proc
+}
+
+//getComputationWrappers parses sql in the dialect of the session if stmts are nil
+func (mce *MysqlCmdExecutor) getComputationWrappers(sql string, stmts []tree.Statement, params []tree.Expr) ([]ComputationWrapper, error) {
+	ses := mce.GetSession()
+	proto := ses.GetMysqlProtocol()
+	cws, err := GetComputationWrapper(ses.dialect,
+		proto.GetDatabaseName(),
+		sql,
+		proto.GetUserName(),
+		ses.GetStorage(),
+		mce.newProcess(),
+		proto.GetPrivilegeChecker(),
+		stmts,
+		params,
+		ses.GetSessionVars().Resolve)
+	if err != nil {
+		return nil, NewMysqlError(ER_PARSE_ERROR, err,
+			"You have an error in your SQL syntax; check the manual that corresponds to your MatrixOne server version for the right syntax to use")
+	}
+	return cws, nil
+}
+
+//isSelectDatabase returns true if the statement is SELECT DATABASE()
+func isSelectDatabase(sc *tree.SelectClause) bool {
+	if len(sc.Exprs) == 1 {
+		if fe, ok := sc.Exprs[0].Expr.(*tree.FuncExpr); ok {
+			if un, ok := fe.Func.FunctionReference.(*tree.UnresolvedName); ok {
+				return strings.ToUpper(un.Parts[0]) == "DATABASE"
+			}
+		}
+	}
+	return false
+}
+
+//execute query, stmts are the parsed statements of sql, sql is parsed if they are nil.
+//params are the values of the placeholders in sql
+func (mce *MysqlCmdExecutor) doComQuery(sql string, stmts []tree.Statement, params []tree.Expr) (retErr error) {
 	ses := mce.GetSession()
 	proto := ses.GetMysqlProtocol()
 	pdHook := ses.GetEpochgc()
@@ -934,81 +1082,70 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 		pdHook.DecQueryCountAtEpoch(epoch, statementCount)
 	}()
 
-	proc := process.New(mheap.New(ses.GuestMmu))
-	proc.Id = mce.getNextProcessId()
-	proc.Lim.Size = ses.Pu.SV.GetProcessLimitationSize()
-	proc.Lim.BatchRows = ses.Pu.SV.GetProcessLimitationBatchRows()
-	proc.Lim.PartitionRows = ses.Pu.SV.GetProcessLimitationPartitionRows()
-
-	cws, err := GetComputationWrapper(proto.GetDatabaseName(),
-		sql,
-		proto.GetUserName(),
-		ses.Pu.StorageEngine,
-		proc)
+	cws, err := mce.getComputationWrappers(sql, stmts, params)
 	if err != nil {
-		return NewMysqlError(ER_PARSE_ERROR, err,
-			"You have an error in your SQL syntax; check the manual that corresponds to your MatrixOne server version for the right syntax to use")
+		return err
 	}
 
 	defer func() {
 		ses.Mrs = nil
 	}()
 
+	//the writes of the failed statement are discarded, but the transaction goes on
+	savepoint := -1
+	defer func() {
+		if retErr != nil && savepoint >= 0 && ses.txn != nil {
+			ses.txn.RollbackTo(savepoint)
+		}
+	}()
+
 	for _, cw := range cws {
 		ses.Mrs = &MysqlResultSet{}
 		stmt := cw.GetAst()
+		savepoint = -1
+		if ses.txn != nil {
+			savepoint = ses.txn.Savepoint()
+		}
 		//temp try 0 epoch
 		pdHook.IncQueryCountAtEpoch(epoch, 1)
 		statementCount++
 
+		//the postgresql protocol makes the command tag from the statement
+		if pgProto, ok := proto.(*PgProtocolImpl); ok {
+			pgProto.setStatement(stmt)
+		}
+
 		switch st := stmt.(type) {
 		case *tree.Select:
 			if st.Ep != nil {
+				//the file is written on the server, it needs FILE like LOAD DATA
+				if st.Ep.Outfile {
+					if err = ses.GetMysqlProtocol().GetPrivilegeChecker().CheckGlobal(privilege.File); err != nil {
+						return err
+					}
+				}
 				mce.exportDataClose = NewCloseExportData()
 				ses.ep = st.Ep
 				ses.closeRef = mce.exportDataClose
 			}
 			if sc, ok := st.Select.(*tree.SelectClause); ok {
-				if len(sc.Exprs) == 1 {
-					if fe, ok := sc.Exprs[0].Expr.(*tree.FuncExpr); ok {
-						if un, ok := fe.Func.FunctionReference.(*tree.UnresolvedName); ok {
-							if strings.ToUpper(un.Parts[0]) == "DATABASE" {
-								err = mce.handleSelectDatabase(st)
-								if err != nil {
-									return err
-								}
-
-								//next statement
-								continue
-							}
-						}
-					} else if ve, ok := sc.Exprs[0].Expr.(*tree.VarExpr); ok {
-						if strings.ToLower(ve.Name) == "max_allowed_packet" {
-							err = mce.handleMaxAllowedPacket()
-							if err != nil {
-								return err
-							}
-
-							//next statement
-							continue
-						} else if strings.ToLower(ve.Name) == "version_comment" {
-							err = mce.handleVersionComment()
-							if err != nil {
-								return err
-							}
-
-							//next statement
-							continue
-						} else if strings.ToLower(ve.Name) == "tx_isolation" {
-							err = mce.handleTxIsolation()
-							if err != nil {
-								return err
-							}
-
-							//next statement
-							continue
-						}
+				if isSelectDatabase(sc) {
+					err = mce.handleSelectDatabase(st)
+					if err != nil {
+						return err
 					}
+
+					//next statement
+					continue
+				}
+				if isSelectVariables(sc) {
+					err = mce.handleSelectVariables(sc.Exprs)
+					if err != nil {
+						return err
+					}
+
+					//next statement
+					continue
 				}
 			}
 		}
@@ -1019,7 +1156,9 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 			switch t := stmt.(type) {
 			case *tree.ShowDatabases, *tree.CreateDatabase, *tree.ShowCreateDatabase, *tree.ShowWarnings, *tree.ShowErrors,
 				*tree.ShowStatus, *tree.DropDatabase, *tree.Load,
-				*tree.Use, *tree.SetVar:
+				*tree.Use, *tree.SetVar,
+				*tree.CreateUser, *tree.DropUser, *tree.AlterUser, *tree.Grant, *tree.Revoke,
+				*tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction:
 			case *tree.ShowColumns:
 				if t.Table.ToTableName().SchemaName == "" {
 					return NewMysqlError(ER_NO_DB_ERROR)
@@ -1033,16 +1172,52 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 			}
 		}
 
+		//the schema changes are not transactional, they commit the transaction implicitly
+		switch stmt.(type) {
+		case *tree.CreateDatabase, *tree.DropDatabase, *tree.CreateTable, *tree.DropTable,
+			*tree.AlterTable, *tree.CreateIndex, *tree.DropIndex, *tree.AnalyzeStmt,
+			*tree.CreateUser, *tree.DropUser, *tree.AlterUser, *tree.Grant, *tree.Revoke:
+			savepoint = -1
+			if err = mce.commitTxn(epoch); err != nil {
+				return err
+			}
+		}
+
+		//the statements start a transaction implicitly if autocommit is off
+		if ses.txn == nil && !ses.GetSessionVars().Autocommit() {
+			switch stmt.(type) {
+			case *tree.Select, *tree.Insert, *tree.Update, *tree.Delete:
+				ses.txn = ses.txns.Begin(ses.Pu.StorageEngine,
+					engine.Node{Id: compile.Address, Addr: compile.Address})
+				savepoint = ses.txn.Savepoint()
+			}
+		}
+
 		var selfHandle = false
 
 		switch st := stmt.(type) {
+		case *tree.BeginTransaction:
+			selfHandle = true
+			if err = mce.handleBegin(epoch); err != nil {
+				return err
+			}
+		case *tree.CommitTransaction:
+			selfHandle = true
+			if err = mce.handleCommit(epoch); err != nil {
+				return err
+			}
+		case *tree.RollbackTransaction:
+			selfHandle = true
+			if err = mce.handleRollback(); err != nil {
+				return err
+			}
 		case *tree.Use:
 			selfHandle = true
 			err := mce.handleChangeDB(st.Name)
 			if err != nil {
 				return err
 			}
-			err = proto.sendOKPacket(0, 0, 0, 0, "")
+			err = proto.sendOKPacket(0, 0, ses.ServerStatus(), 0, "")
 			if err != nil {
 				return err
 			}
@@ -1059,7 +1234,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 			}
 		case *tree.SetVar:
 			selfHandle = true
-			err = mce.handleSetVar(st)
+			err = mce.handleSetVar(epoch, st)
 			if err != nil {
 				return err
 			}
@@ -1069,12 +1244,6 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 			if err != nil {
 				return err
 			}
-		case *tree.AnalyzeStmt:
-			selfHandle = true
-			if err = mce.handleAnalyzeStmt(st); err != nil {
-				return err
-			}
-
 		}
 
 		if selfHandle {
@@ -1135,7 +1304,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 				mysql COM_QUERY response: End after the column has been sent.
 				send EOF packet
 			*/
-			err = proto.SendEOFPacketIf(0, 0)
+			err = proto.SendEOFPacketIf(0, ses.ServerStatus())
 			if err != nil {
 				return err
 			}
@@ -1151,10 +1320,21 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 				if err := openNewFile(ses.ep, ses.Mrs); err != nil {
 					return err
 				}
+				if !ses.ep.DataFormat.IsCSV() {
+					ses.exporter = newFormatExporter(ses.ep, ses.Mrs)
+				}
 			}
-			if er := cw.Run(epoch); er != nil {
+			er := cw.Run(epoch)
+			exporter := ses.exporter
+			ses.exporter = nil
+			if er != nil {
 				return er
 			}
+			if exporter != nil {
+				if err = exporter.close(); err != nil {
+					return err
+				}
+			}
 			if ses.ep.Outfile {
 				if err = ses.ep.Writer.Flush(); err != nil {
 					return err
@@ -1172,13 +1352,13 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 				mysql COM_QUERY response: End after the data row has been sent.
 				After all row data has been sent, it sends the EOF or OK packet.
 			*/
-			err = proto.sendEOFOrOkPacket(0, 0)
+			err = proto.sendEOFOrOkPacket(0, ses.ServerStatus())
 			if err != nil {
 				return err
 			}
 		//just status, no result set
 		case *tree.CreateTable, *tree.DropTable, *tree.CreateDatabase, *tree.DropDatabase,
-			*tree.CreateIndex, *tree.DropIndex,
+			*tree.AlterTable, *tree.CreateIndex, *tree.DropIndex, *tree.AnalyzeStmt,
 			*tree.Insert, *tree.Update,
 			*tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction,
 			*tree.SetVar,
@@ -1214,7 +1394,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 				cw.GetAffectedRows(),
 				0,
 				0,
-				0,
+				int(ses.ServerStatus()),
 				int(COM_QUERY),
 				nil,
 			)
@@ -1231,6 +1411,108 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 	return nil
 }
 
+//handle COM_STMT_PREPARE, the statement is checked and saved in the session.
+func (mce *MysqlCmdExecutor) handleStmtPrepare(sql string) error {
+	ses := mce.GetSession()
+	stmts, err := parsers.Parse(dialect.MYSQL, sql)
+	if err != nil {
+		return NewMysqlError(ER_PARSE_ERROR, err,
+			"You have an error in your SQL syntax; check the manual that corresponds to your MatrixOne server version for the right syntax to use")
+	}
+	if len(stmts) != 1 {
+		return NewMysqlError(ER_UNSUPPORTED_PS)
+	}
+	numParams := countParams(sql)
+	columns, err := mce.bindPrepareStmt(sql, stmts[0], numParams)
+	if err != nil {
+		return err
+	}
+	stmt := ses.GetPrepareStmts().add(sql, stmts[0], numParams, columns)
+	return ses.GetMysqlProtocol().SendPrepareResponse(stmt)
+}
+
+//bindPrepareStmt does the semantic analysis of the prepared statement with the placeholders
+//bound to NULL, it returns the columns of the result.
+func (mce *MysqlCmdExecutor) bindPrepareStmt(sql string, stmt tree.Statement, numParams int) ([]interface{}, error) {
+	switch st := stmt.(type) {
+	case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction,
+		*tree.Use, *tree.Load, *tree.SetVar, *tree.ShowVariables:
+		//they are handled by the executor without the computation engine
+		return nil, nil
+	case *tree.Select:
+		if sc, ok := st.Select.(*tree.SelectClause); ok && (isSelectDatabase(sc) || isSelectVariables(sc)) {
+			return nil, nil
+		}
+	}
+	params := make([]tree.Expr, numParams)
+	for i := range params {
+		params[i] = tree.NewNumVal(constant.MakeUnknown(), "NULL", false)
+	}
+	cws, err := mce.getComputationWrappers(sql, []tree.Statement{copyStatement(stmt)}, params)
+	if err != nil {
+		return nil, err
+	}
+	if err = cws[0].Bind(); err != nil {
+		return nil, err
+	}
+	return cws[0].GetColumns()
+}
+
+//get the prepared statement by the statement id at the beginning of data
+func (mce *MysqlCmdExecutor) getPrepareStmt(data []byte, cmdName string) (*PrepareStmt, error) {
+	if len(data) < 4 {
+		return nil, NewMysqlError(ER_MALFORMED_PACKET)
+	}
+	id := binary.LittleEndian.Uint32(data)
+	stmt, ok := mce.GetSession().GetPrepareStmts().get(id)
+	if !ok {
+		idStr := strconv.FormatUint(uint64(id), 10)
+		return nil, NewMysqlError(ER_UNKNOWN_STMT_HANDLER, len(idStr), idStr, cmdName)
+	}
+	return stmt, nil
+}
+
+//handle COM_STMT_EXECUTE, the result set is sent in the binary protocol.
+//the routine follows the article: https://dev.mysql.com/doc/internals/en/com-stmt-execute.html
+func (mce *MysqlCmdExecutor) handleStmtExecute(data []byte) error {
+	stmt, err := mce.getPrepareStmt(data, "mysqld_stmt_execute")
+	if err != nil {
+		return err
+	}
+	defer stmt.resetLongData()
+	//stmt id 4 bytes, flags 1 byte, iteration count 4 bytes
+	if len(data) < 9 {
+		return NewMysqlError(ER_MALFORMED_PACKET)
+	}
+	params, err := mce.GetSession().GetMysqlProtocol().ParseExecuteData(stmt, data[9:])
+	if err != nil {
+		return err
+	}
+	mce.addSqlCount(1)
+	return mce.doComQuery(stmt.sql, []tree.Statement{copyStatement(stmt.stmt)}, params)
+}
+
+//handle COM_STMT_SEND_LONG_DATA, the data is appended to the parameter.
+func (mce *MysqlCmdExecutor) handleStmtSendLongData(data []byte) {
+	stmt, err := mce.getPrepareStmt(data, "mysqld_stmt_send_long_data")
+	if err != nil || len(data) < 6 {
+		logutil.Errorf("invalid COM_STMT_SEND_LONG_DATA. error:%v", err)
+		return
+	}
+	paramId := int(binary.LittleEndian.Uint16(data[4:]))
+	stmt.longData[paramId] = append(stmt.longData[paramId], data[6:]...)
+}
+
+//handle COM_STMT_RESET, the data sent by COM_STMT_SEND_LONG_DATA is discarded.
+func (mce *MysqlCmdExecutor) handleStmtReset(data []byte) error {
+	stmt, err := mce.getPrepareStmt(data, "mysqld_stmt_reset")
+	if err != nil {
+		return err
+	}
+	stmt.resetLongData()
+	return nil
+}
+
 // ExecRequest the server execute the commands from the client following the mysql's routine
 func (mce *MysqlCmdExecutor) ExecRequest(req *Request) (*Response, error) {
 	var resp *Response = nil
@@ -1245,6 +1527,7 @@ func (mce *MysqlCmdExecutor) ExecRequest(req *Request) (*Response, error) {
 		}
 	}
 
+	ses.Cmd = req.GetCmd()
 	switch uint8(req.GetCmd()) {
 	case COM_QUIT:
 		/*resp = NewResponse(
@@ -1281,7 +1564,7 @@ func (mce *MysqlCmdExecutor) ExecRequest(req *Request) (*Response, error) {
 			return resp, nil
 		}
 
-		err := mce.doComQuery(query)
+		err := mce.doComQuery(query, nil, nil)
 		if err != nil {
 			resp = NewGeneralErrorResponse(COM_QUERY, err)
 		}
@@ -1318,6 +1601,40 @@ func (mce *MysqlCmdExecutor) ExecRequest(req *Request) (*Response, error) {
 	case COM_PING:
 		resp = NewGeneralOkResponse(COM_PING)
 
+		return resp, nil
+	case COM_STMT_PREPARE:
+		var sql = string(req.GetData().([]byte))
+		mce.addSqlCount(1)
+		logutil.Infof("prepare:%s", SubStringFromBegin(sql, int(ses.Pu.SV.GetLengthOfQueryPrinted())))
+		err := mce.handleStmtPrepare(sql)
+		if err != nil {
+			resp = NewGeneralErrorResponse(COM_STMT_PREPARE, err)
+		}
+		return resp, nil
+	case COM_STMT_EXECUTE:
+		err := mce.handleStmtExecute(req.GetData().([]byte))
+		if err != nil {
+			resp = NewGeneralErrorResponse(COM_STMT_EXECUTE, err)
+		}
+		return resp, nil
+	case COM_STMT_SEND_LONG_DATA:
+		//no response for COM_STMT_SEND_LONG_DATA
+		mce.handleStmtSendLongData(req.GetData().([]byte))
+		return resp, nil
+	case COM_STMT_RESET:
+		err := mce.handleStmtReset(req.GetData().([]byte))
+		if err != nil {
+			resp = NewGeneralErrorResponse(COM_STMT_RESET, err)
+		} else {
+			resp = NewGeneralOkResponse(COM_STMT_RESET)
+		}
+		return resp, nil
+	case COM_STMT_CLOSE:
+		//no response for COM_STMT_CLOSE
+		data := req.GetData().([]byte)
+		if len(data) >= 4 {
+			ses.GetPrepareStmts().remove(binary.LittleEndian.Uint32(data))
+		}
 		return resp, nil
 	default:
 		err := fmt.Errorf("unsupported command. 0x%x \n", req.GetCmd())
@@ -1378,6 +1695,8 @@ func convertEngineTypeToMysqlType(engineType types.T, col *MysqlColumn) error {
 		col.SetColumnType(defines.MYSQL_TYPE_DATE)
 	case types.T_datetime:
 		col.SetColumnType(defines.MYSQL_TYPE_DATETIME)
+	case types.T_decimal:
+		col.SetColumnType(defines.MYSQL_TYPE_NEWDECIMAL)
 	default:
 		return fmt.Errorf("RunWhileSend : unsupported type %d \n", engineType)
 	}
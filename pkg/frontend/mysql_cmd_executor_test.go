@@ -2,6 +2,7 @@ package frontend
 
 import (
 	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/fagongzi/goetty/buf"
@@ -15,8 +16,11 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/compile"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"github.com/prashantv/gostub"
@@ -439,7 +443,7 @@ func Test_mce(t *testing.T) {
 			"SELECT @@max_allowed_packet",
 			"SELECT @@version_comment",
 			"SELECT @@tx_isolation",
-			"set a=b",
+			"set autocommit=1",
 			"drop database T",
 		}
 
@@ -578,7 +582,7 @@ func Test_mce_selfhandle(t *testing.T) {
 		convey.So(err, convey.ShouldBeError)
 	})
 
-	convey.Convey("handleSelectDatabase/handleMaxAllowedPacket/handleVersionComment/handleCmdFieldList/handleSetVar", t, func() {
+	convey.Convey("handleSelectDatabase/handleSelectVariables/handleCmdFieldList/handleSetVar", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
@@ -610,11 +614,11 @@ func Test_mce_selfhandle(t *testing.T) {
 		convey.So(err, convey.ShouldBeNil)
 
 		ses.Mrs = &MysqlResultSet{}
-		err = mce.handleMaxAllowedPacket()
+		err = mce.handleSelectVariables(tree.SelectExprs{{Expr: tree.NewVarExpr("max_allowed_packet", true, false, nil)}})
 		convey.So(err, convey.ShouldBeNil)
 
 		ses.Mrs = &MysqlResultSet{}
-		err = mce.handleVersionComment()
+		err = mce.handleSelectVariables(tree.SelectExprs{{Expr: tree.NewVarExpr("version_comment", true, false, nil)}})
 		convey.So(err, convey.ShouldBeNil)
 
 		ses.Mrs = &MysqlResultSet{}
@@ -636,7 +640,7 @@ func Test_mce_selfhandle(t *testing.T) {
 		err = mce.handleCmdFieldList("A")
 		convey.So(err, convey.ShouldBeNil)
 
-		err = mce.handleSetVar(nil)
+		err = mce.handleSetVar(0, &tree.SetVar{})
 		convey.So(err, convey.ShouldBeNil)
 
 		req := &Request{
@@ -924,17 +928,26 @@ func Test_handleSelectVariables(t *testing.T) {
 			t.Error(err)
 		}
 
-		v := "tx_isolation"
 		proto := NewMysqlClientProtocol(0, ioses, 1024, pu.SV)
-		ses := &Session{Mrs: &MysqlResultSet{}, protocol: proto}
+		ses := &Session{Mrs: &MysqlResultSet{}, protocol: proto, Pu: pu}
 		mce := &MysqlCmdExecutor{}
 		mce.PrepareSessionBeforeExecRequest(ses)
 
-		convey.So(mce.handleSelectVariables(v), convey.ShouldBeNil)
-
-		v = ""
-		convey.So(mce.handleSelectVariables(v), convey.ShouldNotBeNil)
+		stmt, err := parsers.ParseOne(dialect.MYSQL, "select @@session.tx_isolation, @@countOfRowsPerSendingToClient, @a as a")
+		convey.So(err, convey.ShouldBeNil)
+		sc := stmt.(*tree.Select).Select.(*tree.SelectClause)
+		convey.So(isSelectVariables(sc), convey.ShouldBeTrue)
+		convey.So(mce.handleSelectVariables(sc.Exprs), convey.ShouldBeNil)
+		row, err := ses.Mrs.GetRow(0)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(row, convey.ShouldResemble, []interface{}{"REPEATABLE-READ", pu.SV.GetCountOfRowsPerSendingToClient(), nil})
+		col, err := ses.Mrs.GetColumn(2)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(col.Name(), convey.ShouldEqual, "a")
 
+		ses.Mrs = &MysqlResultSet{}
+		exprs := tree.SelectExprs{{Expr: tree.NewVarExpr("", true, false, nil)}}
+		convey.So(mce.handleSelectVariables(exprs), convey.ShouldNotBeNil)
 	})
 }
 
@@ -956,11 +969,205 @@ func Test_handleShowVariables(t *testing.T) {
 		}
 
 		proto := NewMysqlClientProtocol(0, ioses, 1024, pu.SV)
-		ses := &Session{Mrs: &MysqlResultSet{}, protocol: proto}
+		ses := &Session{Mrs: &MysqlResultSet{}, protocol: proto, Pu: pu}
 		mce := &MysqlCmdExecutor{}
 		mce.PrepareSessionBeforeExecRequest(ses)
 
-		convey.So(mce.handleShowVariables(nil), convey.ShouldBeNil)
+		convey.So(mce.handleShowVariables(&tree.ShowVariables{}), convey.ShouldBeNil)
+		convey.So(ses.Mrs.GetRowCount(), convey.ShouldEqual, len(ses.GetSessionVars().SystemNames()))
+		convey.So(ses.GetSessionVars().SystemNames(), convey.ShouldNotContain, "dumppassword")
+		_, err = ses.GetSessionVars().GetSystem("DumpPassword", true)
+		convey.So(err.(*MysqlError).ErrorCode, convey.ShouldEqual, ER_UNKNOWN_SYSTEM_VARIABLE)
+
+		convey.So(ses.GetSessionVars().SetSystem("batchSizeInLoadData", int64(100), false), convey.ShouldBeNil)
+
+		kases := []struct {
+			sql  string
+			rows [][]interface{}
+		}{
+			{"show variables like 'batchsize%'", [][]interface{}{{"batchSizeInLoadData", "100"}}},
+			{"show global variables like 'batchSizeInLoadData'", [][]interface{}{{"batchSizeInLoadData", strconv.FormatInt(pu.SV.GetBatchSizeInLoadData(), 10)}}},
+			{"show variables like 'loadDataSkip%'", [][]interface{}{{"loadDataSkipWritingBatch", "OFF"}}},
+			{"show variables like 'tx_isolation'", [][]interface{}{{"tx_isolation", "REPEATABLE-READ"}}},
+			{"show global variables like 'dump%'", [][]interface{}{{"dumpdatabase", "default"}, {"dumpEnv", "OFF"}}},
+		}
+		for _, kase := range kases {
+			stmt, err := parsers.ParseOne(dialect.MYSQL, kase.sql)
+			convey.So(err, convey.ShouldBeNil)
+			ses.Mrs = &MysqlResultSet{}
+			convey.So(mce.handleShowVariables(stmt.(*tree.ShowVariables)), convey.ShouldBeNil)
+			convey.So(ses.Mrs.Data, convey.ShouldResemble, kase.rows)
+		}
+	})
+}
+
+func Test_handleSetVar(t *testing.T) {
+	convey.Convey("handleSetVar succ", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		eng := mock_frontend.NewMockEngine(ctrl)
+		eng.EXPECT().Database(gomock.Any()).Return(nil, nil).AnyTimes()
+
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().WriteAndFlush(gomock.Any()).Return(nil).AnyTimes()
+
+		pu, err := getParameterUnit("test/system_vars_config.toml", eng)
+		if err != nil {
+			t.Error(err)
+		}
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, pu.SV)
+		ses := &Session{Mrs: &MysqlResultSet{}, protocol: proto, Pu: pu}
+		mce := &MysqlCmdExecutor{}
+		mce.PrepareSessionBeforeExecRequest(ses)
+		vars := ses.GetSessionVars()
+
+		setVar := func(sql string) error {
+			stmt, err := parsers.ParseOne(dialect.MYSQL, sql)
+			convey.So(err, convey.ShouldBeNil)
+			return mce.handleSetVar(0, stmt.(*tree.SetVar))
+		}
+
+		global := pu.SV.GetCountOfRowsPerSendingToClient()
+		convey.So(setVar("set countOfRowsPerSendingToClient = 10, @@session.batchSizeInLoadData = 200"), convey.ShouldBeNil)
+		convey.So(vars.GetCountOfRowsPerSendingToClient(), convey.ShouldEqual, int64(10))
+		convey.So(vars.GetBatchSizeInLoadData(), convey.ShouldEqual, int64(200))
+		convey.So(pu.SV.GetCountOfRowsPerSendingToClient(), convey.ShouldEqual, global)
+
+		convey.So(setVar("set global countOfRowsPerSendingToClient = 20"), convey.ShouldBeNil)
+		convey.So(pu.SV.GetCountOfRowsPerSendingToClient(), convey.ShouldEqual, int64(20))
+		convey.So(vars.GetCountOfRowsPerSendingToClient(), convey.ShouldEqual, int64(10))
+
+		convey.So(setVar("set countOfRowsPerSendingToClient = default"), convey.ShouldBeNil)
+		convey.So(vars.GetCountOfRowsPerSendingToClient(), convey.ShouldEqual, int64(20))
+		convey.So(setVar("set global countOfRowsPerSendingToClient = default"), convey.ShouldBeNil)
+		convey.So(pu.SV.GetCountOfRowsPerSendingToClient(), convey.ShouldEqual, int64(1000))
+
+		convey.So(setVar("set loadDataSkipWritingBatch = on"), convey.ShouldBeNil)
+		convey.So(vars.GetLoadDataSkipWritingBatch(), convey.ShouldBeTrue)
+		convey.So(setVar("set loadDataSkipWritingBatch = 0"), convey.ShouldBeNil)
+		convey.So(vars.GetLoadDataSkipWritingBatch(), convey.ShouldBeFalse)
+
+		convey.So(setVar("set @a = 1, @b = -2.5, @c = 'x', @d = @a"), convey.ShouldBeNil)
+		convey.So(vars.GetUser("A"), convey.ShouldEqual, int64(1))
+		convey.So(vars.GetUser("b"), convey.ShouldEqual, -2.5)
+		convey.So(vars.GetUser("c"), convey.ShouldEqual, "x")
+		convey.So(vars.GetUser("d"), convey.ShouldEqual, int64(1))
+		convey.So(setVar("set @@batchSizeInLoadData = @a + 1"), convey.ShouldNotBeNil)
+
+		convey.So(setVar("set names utf8mb4"), convey.ShouldBeNil)
+		convey.So(setVar("set autocommit = 1, sql_mode = ''"), convey.ShouldBeNil)
+
+		//the global values of the MySQL variables are shared by the sessions
+		convey.So(setVar("set global sql_mode = 'ANSI'"), convey.ShouldBeNil)
+		v, err := NewSessionVariables(pu.SV).GetSystem("sql_mode", false)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(v, convey.ShouldEqual, "ANSI")
+		v, err = vars.GetSystem("sql_mode", false)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(v, convey.ShouldEqual, "")
+		convey.So(setVar("set global sql_mode = default"), convey.ShouldBeNil)
+		v, err = vars.GetSystem("sql_mode", true)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(v, convey.ShouldEqual, mysqlVariables["sql_mode"])
+
+		kases := []struct {
+			sql  string
+			code uint16
+		}{
+			{"set noSuchVariable = 1", ER_UNKNOWN_SYSTEM_VARIABLE},
+			{"set countOfRowsPerSendingToClient = 0", ER_WRONG_VALUE_FOR_VAR},
+			{"set global batchSizeInLoadData = 1000000", ER_WRONG_VALUE_FOR_VAR},
+			{"set countOfRowsPerSendingToClient = 'a'", ER_WRONG_TYPE_FOR_VAR},
+			{"set loadDataSkipWritingBatch = 2", ER_WRONG_TYPE_FOR_VAR},
+			{"set lengthOfQueryPrinted = 10", ER_GLOBAL_VARIABLE},
+			{"set global rootname = 'a'", ER_INCORRECT_GLOBAL_LOCAL_VAR},
+			{"set global rootpassword = 'a'", ER_UNKNOWN_SYSTEM_VARIABLE},
+		}
+		for _, kase := range kases {
+			err := setVar(kase.sql)
+			convey.So(err, convey.ShouldNotBeNil)
+			convey.So(err.(*MysqlError).ErrorCode, convey.ShouldEqual, kase.code)
+		}
+		convey.So(vars.GetCountOfRowsPerSendingToClient(), convey.ShouldEqual, int64(1000))
+		convey.So(pu.SV.GetBatchSizeInLoadData(), convey.ShouldNotEqual, int64(1000000))
+
+		//SET GLOBAL needs the SUPER privilege
+		pm, err := privilege.New(nil)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(pm.Bootstrap("root", ""), convey.ShouldBeNil)
+		convey.So(pm.CreateUsers([]privilege.Account{{Name: "u1", Host: "%"}}, false), convey.ShouldBeNil)
+		proto.checker = pm.NewChecker("u1", "%")
+		convey.So(setVar("set countOfRowsPerSendingToClient = 30"), convey.ShouldBeNil)
+		err = setVar("set global countOfRowsPerSendingToClient = 30")
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.(*privilege.Error).Code, convey.ShouldEqual, privilege.ErrSpecificAccessDenied)
+		convey.So(pu.SV.GetCountOfRowsPerSendingToClient(), convey.ShouldEqual, int64(1000))
+		proto.checker = pm.NewChecker("root", "%")
+		convey.So(setVar("set global countOfRowsPerSendingToClient = 30"), convey.ShouldBeNil)
+	})
+}
+
+func Test_privilegeOfSelfHandle(t *testing.T) {
+	convey.Convey("privileges of USE, LOAD DATA and COM_FIELD_LIST", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		eng := mock_frontend.NewMockEngine(ctrl)
+		eng.EXPECT().Database(gomock.Any()).Return(nil, nil).AnyTimes()
+
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().WriteAndFlush(gomock.Any()).Return(nil).AnyTimes()
+
+		pu, err := getParameterUnit("test/system_vars_config.toml", eng)
+		if err != nil {
+			t.Error(err)
+		}
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, pu.SV)
+		ses := &Session{Mrs: &MysqlResultSet{}, protocol: proto, Pu: pu}
+		mce := &MysqlCmdExecutor{}
+		mce.PrepareSessionBeforeExecRequest(ses)
+
+		pm, err := privilege.New(nil)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(pm.CreateUsers([]privilege.Account{{Name: "u1", Host: "%"}}, false), convey.ShouldBeNil)
+		proto.checker = pm.NewChecker("u1", "%")
+
+		load := func(sql string) error {
+			stmt, err := parsers.ParseOne(dialect.MYSQL, sql)
+			convey.So(err, convey.ShouldBeNil)
+			return mce.handleLoadData(stmt.(*tree.Load))
+		}
+
+		err = mce.handleChangeDB("db1")
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.(*privilege.Error).Code, convey.ShouldEqual, privilege.ErrDBAccessDenied)
+		convey.So(proto.GetDatabaseName(), convey.ShouldBeEmpty)
+
+		err = load("load data infile '/etc/passwd' into table db1.t1 fields terminated by ','")
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.(*privilege.Error).Code, convey.ShouldEqual, privilege.ErrSpecificAccessDenied)
+		err = load("load data local infile 'a.csv' into table db1.t1 fields terminated by ','")
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.(*privilege.Error).Code, convey.ShouldEqual, privilege.ErrTableAccessDenied)
+
+		proto.SetDatabaseName("db1")
+		err = mce.handleCmdFieldList("t1")
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.(*privilege.Error).Code, convey.ShouldEqual, privilege.ErrTableAccessDenied)
+		proto.SetDatabaseName("")
+
+		convey.So(pm.Grant([]privilege.Account{{Name: "u1", Host: "%"}},
+			privilege.Grant{Level: privilege.Database, Db: "db1", Privs: privilege.Select}), convey.ShouldBeNil)
+		convey.So(mce.handleChangeDB("db1"), convey.ShouldBeNil)
+		convey.So(proto.GetDatabaseName(), convey.ShouldEqual, "db1")
+		err = load("load data local infile 'a.csv' into table t1 fields terminated by ','")
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.(*privilege.Error).Code, convey.ShouldEqual, privilege.ErrTableAccessDenied)
 	})
 }
 
@@ -978,8 +1185,76 @@ func Test_GetComputationWrapper(t *testing.T) {
 		db, sql, user := "T", "SHOW TABLES", "root"
 		var eng engine.Engine
 		proc := &process.Process{}
-		cw, err := GetComputationWrapper(db, sql, user, eng, proc)
+		cw, err := GetComputationWrapper(dialect.MYSQL, db, sql, user, eng, proc, nil, nil, nil, nil)
 		convey.So(cw, convey.ShouldNotBeEmpty)
 		convey.So(err, convey.ShouldBeNil)
 	})
 }
+
+func Test_mce_transaction(t *testing.T) {
+	convey.Convey("handleBegin/handleCommit/handleRollback", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().WriteAndFlush(gomock.Any()).Return(nil).AnyTimes()
+
+		pu, err := getParameterUnit("test/system_vars_config.toml", memEngine.NewTestEngine())
+		if err != nil {
+			t.Error(err)
+		}
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, pu.SV)
+
+		epochgc := getPCI()
+
+		guestMmu := guest.New(pu.SV.GetGuestMmuLimitation(), pu.HostMmu)
+
+		ses := NewSession(proto, epochgc, guestMmu, pu.Mempool, pu)
+
+		mce := NewMysqlCmdExecutor()
+		mce.SetRoutineManager(NewRoutineManager(pu, epochgc))
+		mce.PrepareSessionBeforeExecRequest(ses)
+		convey.So(ses.ServerStatus(), convey.ShouldEqual, 0)
+
+		err = mce.handleBegin(0)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ses.InActiveTransaction(), convey.ShouldBeTrue)
+		convey.So(ses.ServerStatus()&SERVER_STATUS_IN_TRANS, convey.ShouldNotEqual, 0)
+
+		// the schema changes are not allowed in transaction
+		db, err := ses.GetStorage().Database("test")
+		convey.So(err, convey.ShouldBeNil)
+		err = db.Create(0, "t", nil)
+		convey.So(err, convey.ShouldBeError)
+
+		err = mce.handleCommit(0)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ses.InActiveTransaction(), convey.ShouldBeFalse)
+
+		err = mce.handleBegin(0)
+		convey.So(err, convey.ShouldBeNil)
+		err = mce.handleRollback()
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ses.ServerStatus(), convey.ShouldEqual, 0)
+
+		// commit or rollback without transaction does nothing
+		err = mce.handleCommit(0)
+		convey.So(err, convey.ShouldBeNil)
+		err = mce.handleRollback()
+		convey.So(err, convey.ShouldBeNil)
+
+		// the statements run in a transaction started implicitly if autocommit is off,
+		// and the transaction is committed when autocommit is turned on
+		proto.SetDatabaseName("test")
+		convey.So(mce.doComQuery("create table tx (a int)", nil, nil), convey.ShouldBeNil)
+		convey.So(mce.doComQuery("set autocommit = off", nil, nil), convey.ShouldBeNil)
+		convey.So(ses.InActiveTransaction(), convey.ShouldBeFalse)
+		convey.So(mce.doComQuery("insert into tx values (1)", nil, nil), convey.ShouldBeNil)
+		convey.So(ses.InActiveTransaction(), convey.ShouldBeTrue)
+		convey.So(mce.doComQuery("set autocommit = 1", nil, nil), convey.ShouldBeNil)
+		convey.So(ses.InActiveTransaction(), convey.ShouldBeFalse)
+		convey.So(mce.doComQuery("set autocommit = 2", nil, nil), convey.ShouldNotBeNil)
+	})
+}
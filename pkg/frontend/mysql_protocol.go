@@ -19,8 +19,11 @@ import (
 	"crypto/sha1"
 	"encoding/binary"
 	"fmt"
+	"io"
+	"math"
 	"math/rand"
 	"strconv"
+	"sync"
 	"time"
 	"unicode"
 
@@ -29,6 +32,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
 )
 
 // DefaultCapability means default capabilities of the server
@@ -148,6 +153,15 @@ type MysqlProtocol interface {
 
 	SendResultSetTextBatchRowSpeedup(mrs *MysqlResultSet, cnt uint64) error
 
+	//the server send group row of the result set of COM_STMT_EXECUTE thread safe
+	SendResultSetBinaryBatchRow(mrs *MysqlResultSet, cnt uint64) error
+
+	//SendPrepareResponse the server send the response of COM_STMT_PREPARE to the client
+	SendPrepareResponse(stmt *PrepareStmt) error
+
+	//ParseExecuteData decodes the parameters of COM_STMT_EXECUTE
+	ParseExecuteData(stmt *PrepareStmt, data []byte) ([]tree.Expr, error)
+
 	//SendColumnDefinitionPacket the server send the column definition to the client
 	SendColumnDefinitionPacket(column Column, cmd int) error
 
@@ -167,6 +181,12 @@ type MysqlProtocol interface {
 	PrepareBeforeProcessingResultSet()
 
 	GetStats() string
+
+	//the privilege checker of the user, it is nil if privilege checking is disabled
+	GetPrivilegeChecker() *privilege.Checker
+
+	//RequestLocalInfile asks the client to send the file of LOAD DATA LOCAL INFILE
+	RequestLocalInfile(name string) (io.ReadCloser, error)
 }
 
 var _ MysqlProtocol = &MysqlProtocolImpl{}
@@ -282,7 +302,17 @@ type MysqlProtocolImpl struct {
 
 	rowHandler
 
+	//the file being sent by the client for LOAD DATA LOCAL INFILE
+	infileLock sync.Mutex
+	infile     *localInfile
+
 	SV *config.SystemVariables
+
+	//accounts and privileges, only the dump user can connect if it is nil
+	pm *privilege.Manager
+
+	//the privilege checker of the user
+	checker *privilege.Checker
 }
 
 func (mp *MysqlProtocolImpl) GetDatabaseName() string {
@@ -301,6 +331,14 @@ func (mp *MysqlProtocolImpl) SetUserName(s string) {
 	mp.username = s
 }
 
+func (mp *MysqlProtocolImpl) SetPrivilegeManager(pm *privilege.Manager) {
+	mp.pm = pm
+}
+
+func (mp *MysqlProtocolImpl) GetPrivilegeChecker() *privilege.Checker {
+	return mp.checker
+}
+
 func (mp *MysqlProtocolImpl) GetStats() string {
 	return fmt.Sprintf("flushCount %d %s",
 		mp.flushCount,
@@ -314,6 +352,95 @@ func (mp *MysqlProtocolImpl) PrepareBeforeProcessingResultSet() {
 
 func (mp *MysqlProtocolImpl) Quit() {
 	mp.ProtocolImpl.Quit()
+	mp.finishLocalInfile(io.ErrUnexpectedEOF)
+}
+
+/*
+localInfile is the file of LOAD DATA LOCAL INFILE.
+The client sends the content of the file in packets after the server requested it,
+and an empty packet at the end.
+*/
+type localInfile struct {
+	reader *io.PipeReader
+	writer *io.PipeWriter
+	done   chan struct{}
+	once   sync.Once
+}
+
+func (li *localInfile) Read(p []byte) (int, error) {
+	return li.reader.Read(p)
+}
+
+//Close discards the rest of the file and waits for the client to finish sending it.
+//The response of LOAD DATA can be sent after that.
+func (li *localInfile) Close() error {
+	li.reader.Close()
+	<-li.done
+	return nil
+}
+
+func (li *localInfile) finish(err error) {
+	li.once.Do(func() {
+		li.writer.CloseWithError(err)
+		close(li.done)
+	})
+}
+
+func (mp *MysqlProtocolImpl) RequestLocalInfile(name string) (io.ReadCloser, error) {
+	if mp.capability&CLIENT_LOCAL_FILES == 0 {
+		return nil, NewMysqlError(ER_NOT_ALLOWED_COMMAND)
+	}
+	reader, writer := io.Pipe()
+	li := &localInfile{
+		reader: reader,
+		writer: writer,
+		done:   make(chan struct{}),
+	}
+	mp.infileLock.Lock()
+	mp.infile = li
+	mp.infileLock.Unlock()
+
+	//int<1> 0xFB, string<EOF> the name of the file
+	data := make([]byte, HeaderOffset, HeaderOffset+1+len(name))
+	data = append(data, defines.LocalInFileHeader)
+	data = append(data, name...)
+	if err := mp.writePackets(data); err != nil {
+		mp.finishLocalInfile(err)
+		return nil, err
+	}
+	return li, nil
+}
+
+//receiveLocalInfile delivers the payload to the file requested by RequestLocalInfile.
+//It returns false when there is no such file, then the payload is a request.
+func (mp *MysqlProtocolImpl) receiveLocalInfile(payload []byte) bool {
+	mp.infileLock.Lock()
+	li := mp.infile
+	mp.infileLock.Unlock()
+	if li == nil {
+		return false
+	}
+	if len(payload) == 0 {
+		mp.finishLocalInfile(nil)
+		return true
+	}
+	//the loader may stop reading on error, the rest of the file is discarded then
+	if _, err := li.writer.Write(payload); err != nil && err != io.ErrClosedPipe {
+		logutil.Errorf("receive local infile failed. error:%v", err)
+	}
+	return true
+}
+
+//finishLocalInfile ends the file requested by RequestLocalInfile, the reader of
+//the file gets the err after the data received.
+func (mp *MysqlProtocolImpl) finishLocalInfile(err error) {
+	mp.infileLock.Lock()
+	li := mp.infile
+	mp.infile = nil
+	mp.infileLock.Unlock()
+	if li != nil {
+		li.finish(err)
+	}
 }
 
 //handshake response 41
@@ -613,10 +740,20 @@ func (mp *MysqlProtocolImpl) checkPassword(password, salt, auth []byte) bool {
 
 //the server authenticate that the client can connect and use the database
 func (mp *MysqlProtocolImpl) authenticateUser(authResponse []byte) error {
-	//TODO:check the user and the connection
-	//TODO:get the user's password
+	if mp.pm != nil {
+		host, _ := mp.Peer()
+		u, ok := mp.pm.Lookup(mp.username, host)
+		if !ok || !privilege.CheckAuth(u.AuthString, mp.salt, authResponse) {
+			return privilege.NewAccessDeniedError(mp.username, host, len(authResponse) > 0)
+		}
+		logutil.Infof("check password succeeded\n")
+		mp.checker = mp.pm.NewChecker(u.Name, u.Host)
+		return nil
+	}
+
+	//the user dump for test
 	var psw []byte
-	if mp.username == mp.SV.GetDumpuser() { //the user dump for test
+	if mp.username == mp.SV.GetDumpuser() {
 		psw = []byte(mp.SV.GetDumppassword())
 	}
 
@@ -683,6 +820,10 @@ func (mp *MysqlProtocolImpl) handleHandshake(payload []byte) error {
 	}
 
 	if err := mp.authenticateUser(authResponse); err != nil {
+		if perr, ok := err.(*privilege.Error); ok {
+			_ = mp.sendErrPacket(perr.Code, perr.State, perr.Msg)
+			return err
+		}
 		fail := errorMsgRefer[ER_ACCESS_DENIED_ERROR]
 		_ = mp.sendErrPacket(fail.errorCode, fail.sqlStates[0], "Access denied for user")
 		return err
@@ -1264,6 +1405,12 @@ func (mp *MysqlProtocolImpl) makeResultSetTextRow(data []byte, mrs *MysqlResultS
 		switch mysqlColumn.ColumnType() {
 		case defines.MYSQL_TYPE_DECIMAL:
 			return nil, fmt.Errorf("unsupported Decimal")
+		case defines.MYSQL_TYPE_NEWDECIMAL:
+			if value, err2 := mrs.GetString(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				data = mp.appendStringLenEnc(data, value)
+			}
 		case defines.MYSQL_TYPE_TINY, defines.MYSQL_TYPE_SHORT, defines.MYSQL_TYPE_INT24, defines.MYSQL_TYPE_LONG, defines.MYSQL_TYPE_YEAR:
 			if value, err2 := mrs.GetInt64(r, i); err2 != nil {
 				return nil, err2
@@ -1388,6 +1535,192 @@ func (mp *MysqlProtocolImpl) SendResultSetTextBatchRowSpeedup(mrs *MysqlResultSe
 	return err
 }
 
+//make the row of the binary result set
+//the routine follows the article: https://dev.mysql.com/doc/internals/en/binary-protocol-resultset-row.html
+func (mp *MysqlProtocolImpl) makeResultSetBinaryRow(data []byte, mrs *MysqlResultSet, r uint64) ([]byte, error) {
+	data = mp.appendUint8(data, defines.OKHeader)
+
+	//NULL-bitmap, the offset of bits is 2
+	columnCount := mrs.GetColumnCount()
+	bitmap := make([]byte, (columnCount+7+2)/8)
+	for i := uint64(0); i < columnCount; i++ {
+		if isNil, err := mrs.ColumnIsNull(r, i); err != nil {
+			return nil, err
+		} else if isNil {
+			bitmap[(i+2)/8] |= 1 << ((i + 2) % 8)
+		}
+	}
+	data = mp.appendCountOfBytes(data, bitmap)
+
+	var buf [12]byte
+	for i := uint64(0); i < columnCount; i++ {
+		if bitmap[(i+2)/8]&(1<<((i+2)%8)) != 0 {
+			continue
+		}
+		column, err := mrs.GetColumn(i)
+		if err != nil {
+			return nil, err
+		}
+		mysqlColumn, ok := column.(*MysqlColumn)
+		if !ok {
+			return nil, fmt.Errorf("sendColumn need MysqlColumn")
+		}
+
+		switch mysqlColumn.ColumnType() {
+		case defines.MYSQL_TYPE_NEWDECIMAL, defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING:
+			if value, err2 := mrs.GetString(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				data = mp.appendStringLenEnc(data, value)
+			}
+		case defines.MYSQL_TYPE_TINY, defines.MYSQL_TYPE_SHORT, defines.MYSQL_TYPE_YEAR, defines.MYSQL_TYPE_INT24, defines.MYSQL_TYPE_LONG, defines.MYSQL_TYPE_LONGLONG:
+			var value uint64
+			if uint32(mysqlColumn.Flag())&defines.UNSIGNED_FLAG != 0 {
+				if value, err = mrs.GetUint64(r, i); err != nil {
+					return nil, err
+				}
+			} else {
+				var v int64
+				if v, err = mrs.GetInt64(r, i); err != nil {
+					return nil, err
+				}
+				value = uint64(v)
+			}
+			switch mysqlColumn.ColumnType() {
+			case defines.MYSQL_TYPE_TINY:
+				data = mp.appendUint8(data, uint8(value))
+			case defines.MYSQL_TYPE_SHORT, defines.MYSQL_TYPE_YEAR:
+				mp.io.WriteUint16(buf[:], 0, uint16(value))
+				data = mp.appendCountOfBytes(data, buf[:2])
+			case defines.MYSQL_TYPE_INT24, defines.MYSQL_TYPE_LONG:
+				mp.io.WriteUint32(buf[:], 0, uint32(value))
+				data = mp.appendCountOfBytes(data, buf[:4])
+			default:
+				mp.io.WriteUint64(buf[:], 0, value)
+				data = mp.appendCountOfBytes(data, buf[:8])
+			}
+		case defines.MYSQL_TYPE_FLOAT:
+			if value, err2 := mrs.GetFloat64(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				mp.io.WriteUint32(buf[:], 0, math.Float32bits(float32(value)))
+				data = mp.appendCountOfBytes(data, buf[:4])
+			}
+		case defines.MYSQL_TYPE_DOUBLE:
+			if value, err2 := mrs.GetFloat64(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				mp.io.WriteUint64(buf[:], 0, math.Float64bits(value))
+				data = mp.appendCountOfBytes(data, buf[:8])
+			}
+		case defines.MYSQL_TYPE_DATE:
+			if value, err2 := mrs.GetValue(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				year, month, day, _ := value.(types.Date).Calendar(true)
+				pos := mp.io.WriteUint8(buf[:], 0, 4)
+				pos = mp.io.WriteUint16(buf[:], pos, uint16(year))
+				pos = mp.io.WriteUint8(buf[:], pos, month)
+				pos = mp.io.WriteUint8(buf[:], pos, day)
+				data = mp.appendCountOfBytes(data, buf[:pos])
+			}
+		case defines.MYSQL_TYPE_DATETIME:
+			if value, err2 := mrs.GetValue(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				dt := value.(types.Datetime)
+				year, month, day, _ := dt.ToDate().Calendar(true)
+				hour, minute, second := dt.Clock()
+				micro := uint32(int64(dt) & (1<<20 - 1))
+				pos := mp.io.WriteUint8(buf[:], 0, 7)
+				if micro != 0 {
+					pos = mp.io.WriteUint8(buf[:], 0, 11)
+				}
+				pos = mp.io.WriteUint16(buf[:], pos, uint16(year))
+				pos = mp.io.WriteUint8(buf[:], pos, month)
+				pos = mp.io.WriteUint8(buf[:], pos, day)
+				pos = mp.io.WriteUint8(buf[:], pos, uint8(hour))
+				pos = mp.io.WriteUint8(buf[:], pos, uint8(minute))
+				pos = mp.io.WriteUint8(buf[:], pos, uint8(second))
+				if micro != 0 {
+					pos = mp.io.WriteUint32(buf[:], pos, micro)
+				}
+				data = mp.appendCountOfBytes(data, buf[:pos])
+			}
+		default:
+			return nil, fmt.Errorf("unsupported column type %d ", mysqlColumn.ColumnType())
+		}
+	}
+	return data, nil
+}
+
+//the server send group row of the binary result set as an independent packet
+//thread safe
+func (mp *MysqlProtocolImpl) SendResultSetBinaryBatchRow(mrs *MysqlResultSet, cnt uint64) error {
+	if cnt == 0 {
+		return nil
+	}
+
+	mp.GetLock().Lock()
+	defer mp.GetLock().Unlock()
+
+	for i := uint64(0); i < cnt; i++ {
+		if err := mp.sendResultSetRow(mrs, i, mp.makeResultSetBinaryRow); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//the server send the response of COM_STMT_PREPARE, the definitions of the parameters
+//are followed by the definitions of the columns of result.
+//the routine follows the article: https://dev.mysql.com/doc/internals/en/com-stmt-prepare-response.html
+func (mp *MysqlProtocolImpl) SendPrepareResponse(stmt *PrepareStmt) error {
+	mp.GetLock().Lock()
+	defer mp.GetLock().Unlock()
+
+	data := make([]byte, HeaderOffset+12)
+	pos := HeaderOffset
+	pos = mp.io.WriteUint8(data, pos, defines.OKHeader)
+	pos = mp.io.WriteUint32(data, pos, stmt.id)
+	//number of columns
+	pos = mp.io.WriteUint16(data, pos, uint16(len(stmt.columns)))
+	pos = mp.io.WriteUint16(data, pos, uint16(stmt.numParams))
+	//reserved
+	pos = mp.io.WriteUint8(data, pos, 0)
+	//warning count
+	pos = mp.io.WriteUint16(data, pos, 0)
+	if err := mp.writePackets(data[:pos]); err != nil {
+		return err
+	}
+
+	if stmt.numParams > 0 {
+		for i := 0; i < stmt.numParams; i++ {
+			col := new(MysqlColumn)
+			col.SetName("?")
+			col.SetColumnType(defines.MYSQL_TYPE_VAR_STRING)
+			if err := mp.SendColumnDefinitionPacket(col, int(COM_STMT_PREPARE)); err != nil {
+				return err
+			}
+		}
+		if err := mp.SendEOFPacketIf(0, 0); err != nil {
+			return err
+		}
+	}
+
+	if len(stmt.columns) > 0 {
+		for _, c := range stmt.columns {
+			if err := mp.SendColumnDefinitionPacket(c.(Column), int(COM_STMT_PREPARE)); err != nil {
+				return err
+			}
+		}
+		if err := mp.SendEOFPacketIf(0, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 //open a new row of the resultset
 func (mp *MysqlProtocolImpl) openRow(_ []byte) error {
 	if mp.enableLog {
@@ -1573,12 +1906,18 @@ func (mp *MysqlProtocolImpl) SendResultSetTextRow(mrs *MysqlResultSet, r uint64)
 
 //the server send every row of the result set as an independent packet
 func (mp *MysqlProtocolImpl) sendResultSetTextRow(mrs *MysqlResultSet, r uint64) error {
+	return mp.sendResultSetRow(mrs, r, mp.makeResultSetTextRow)
+}
+
+//the server send the row made by makeRow as an independent packet
+func (mp *MysqlProtocolImpl) sendResultSetRow(mrs *MysqlResultSet, r uint64,
+	makeRow func([]byte, *MysqlResultSet, uint64) ([]byte, error)) error {
 	var err error
 	err = mp.openRow(nil)
 	if err != nil {
 		return err
 	}
-	if _, err = mp.makeResultSetTextRow(nil, mrs, r); err != nil {
+	if _, err = makeRow(nil, mrs, r); err != nil {
 		//ERR_Packet in case of error
 		err1 := mp.sendErrPacket(ER_UNKNOWN_ERROR, DefaultMySQLState, err.Error())
 		if err1 != nil {
@@ -1621,8 +1960,13 @@ func (mp *MysqlProtocolImpl) sendResultSet(set ResultSet, cmd int, warnings, sta
 	}
 
 	//One or more ProtocolText::ResultsetRow packets, each containing column_count values
+	//The rows are ProtocolBinary::ResultsetRow for COM_STMT_EXECUTE
+	makeRow := mp.makeResultSetTextRow
+	if uint8(cmd) == COM_STMT_EXECUTE {
+		makeRow = mp.makeResultSetBinaryRow
+	}
 	for i := uint64(0); i < mysqlRS.GetRowCount(); i++ {
-		if err = mp.sendResultSetTextRow(mysqlRS, i); err != nil {
+		if err = mp.sendResultSetRow(mysqlRS, i, makeRow); err != nil {
 			return err
 		}
 	}
@@ -19,6 +19,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 	"reflect"
 	"strconv"
@@ -1653,6 +1654,73 @@ func Test_writePackets(t *testing.T) {
 	})
 }
 
+func Test_localInfile(t *testing.T) {
+	cvey.Convey("local infile succ", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		var sent []byte
+		ioses.EXPECT().WriteAndFlush(gomock.Any()).DoAndReturn(func(msg interface{}) error {
+			sent = append([]byte{}, msg.([]byte)...)
+			return nil
+		}).AnyTimes()
+
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		if err != nil {
+			t.Error(err)
+		}
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+		cvey.So(proto.receiveLocalInfile([]byte("select 1")), cvey.ShouldBeFalse)
+
+		proto.capability &^= CLIENT_LOCAL_FILES
+		_, err = proto.RequestLocalInfile("a.csv")
+		cvey.So(err, cvey.ShouldBeError)
+
+		proto.capability |= CLIENT_LOCAL_FILES
+		file, err := proto.RequestLocalInfile("a.csv")
+		cvey.So(err, cvey.ShouldBeNil)
+		cvey.So(sent[4], cvey.ShouldEqual, defines.LocalInFileHeader)
+		cvey.So(string(sent[5:]), cvey.ShouldEqual, "a.csv")
+
+		go func() {
+			proto.receiveLocalInfile([]byte("1,2\n"))
+			proto.receiveLocalInfile([]byte("3,4\n"))
+			proto.receiveLocalInfile(nil)
+		}()
+		data, err := io.ReadAll(file)
+		cvey.So(err, cvey.ShouldBeNil)
+		cvey.So(string(data), cvey.ShouldEqual, "1,2\n3,4\n")
+		cvey.So(file.Close(), cvey.ShouldBeNil)
+		cvey.So(proto.receiveLocalInfile([]byte("select 1")), cvey.ShouldBeFalse)
+	})
+
+	cvey.Convey("local infile quit", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().WriteAndFlush(gomock.Any()).Return(nil).AnyTimes()
+		ioses.EXPECT().Close().Return(nil).AnyTimes()
+
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		if err != nil {
+			t.Error(err)
+		}
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+		proto.capability |= CLIENT_LOCAL_FILES
+		file, err := proto.RequestLocalInfile("a.csv")
+		cvey.So(err, cvey.ShouldBeNil)
+		go func() {
+			proto.receiveLocalInfile([]byte("1,2\n"))
+			proto.Quit()
+		}()
+		_, err = io.ReadAll(file)
+		cvey.So(err, cvey.ShouldEqual, io.ErrUnexpectedEOF)
+		cvey.So(file.Close(), cvey.ShouldBeNil)
+	})
+}
+
 func Test_openpacket(t *testing.T) {
 	cvey.Convey("openpacket succ", t, func() {
 		ctrl := gomock.NewController(t)
@@ -1890,6 +1958,69 @@ func Test_resultset(t *testing.T) {
 		err = proto.SendResultSetTextRow(res, 0)
 		cvey.So(err, cvey.ShouldBeNil)
 	})
+
+	cvey.Convey("send binary result set succ", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().WriteAndFlush(gomock.Any()).Return(nil).AnyTimes()
+
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		if err != nil {
+			t.Error(err)
+		}
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+
+		res := make8ColumnsResultSet()
+
+		err = proto.sendResultSet(res, int(COM_STMT_EXECUTE), 0, 0)
+		cvey.So(err, cvey.ShouldBeNil)
+
+		err = proto.SendResultSetBinaryBatchRow(res, uint64(len(res.Data)))
+		cvey.So(err, cvey.ShouldBeNil)
+	})
+
+	cvey.Convey("make binary result set row", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+
+		outBuf := buf.NewByteBuf(1024)
+		ioses.EXPECT().OutBuf().Return(outBuf).AnyTimes()
+
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		if err != nil {
+			t.Error(err)
+		}
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+
+		res := &MysqlResultSet{}
+		for _, ct := range []uint8{defines.MYSQL_TYPE_LONG, defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_LONGLONG, defines.MYSQL_TYPE_DATE} {
+			col := new(MysqlColumn)
+			col.SetColumnType(ct)
+			res.AddColumn(col)
+		}
+		d, _ := types.ParseDate("2021-12-31")
+		res.AddRow([]interface{}{int32(-2), "ab", nil, d})
+
+		begin := outBuf.GetWriteIndex()
+		_, err = proto.makeResultSetBinaryRow(nil, res, 0)
+		cvey.So(err, cvey.ShouldBeNil)
+		want := []byte{
+			0x00,
+			//NULL-bitmap, the third column is null
+			0x10,
+			0xfe, 0xff, 0xff, 0xff,
+			0x02, 'a', 'b',
+			0x04, 0xe5, 0x07, 12, 31,
+		}
+		//skip the header of the packet
+		cvey.So(outBuf.RawBuf()[begin+HeaderLengthOfTheProtocol:outBuf.GetWriteIndex()], cvey.ShouldResemble, want)
+	})
 }
 
 func Test_send_packet(t *testing.T) {
@@ -0,0 +1,493 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/scanner"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// the messages sent by the client after the connection is established
+const (
+	pgMsgQuery     byte = 'Q'
+	pgMsgParse     byte = 'P'
+	pgMsgBind      byte = 'B'
+	pgMsgDescribe  byte = 'D'
+	pgMsgExecute   byte = 'E'
+	pgMsgClose     byte = 'C'
+	pgMsgSync      byte = 'S'
+	pgMsgFlush     byte = 'H'
+	pgMsgTerminate byte = 'X'
+)
+
+// pgPreparedStatement is the statement made by Parse.
+type pgPreparedStatement struct {
+	sql string
+	//the statement parsed, it is nil for the empty query
+	stmt tree.Statement
+	//the types of the parameters, 0 means it is unspecified
+	paramTypes []uint32
+}
+
+// pgPortal is the statement with the parameters made by Bind.
+type pgPortal struct {
+	ps            *pgPreparedStatement
+	params        []tree.Expr
+	resultFormats []int16
+
+	//the rows not fetched after the portal is executed with the maximum
+	//number of rows
+	result *pgPortalResult
+}
+
+/*
+PgCmdExecutor executes the messages of the postgresql protocol.
+The statements are executed by the MysqlCmdExecutor, the prepared statements
+and the portals of the extended query last across the requests.
+*/
+type PgCmdExecutor struct {
+	*MysqlCmdExecutor
+
+	stmts   map[string]*pgPreparedStatement
+	portals map[string]*pgPortal
+
+	//after an error of the extended query, the messages are discarded until Sync
+	skipTillSync bool
+}
+
+func (pce *PgCmdExecutor) PrepareSessionBeforeExecRequest(ses *Session) {
+	ses.dialect = dialect.POSTGRESQL
+	pce.MysqlCmdExecutor.PrepareSessionBeforeExecRequest(ses)
+}
+
+func (pce *PgCmdExecutor) getProtocol() *PgProtocolImpl {
+	return pce.GetSession().protocol.(*PgProtocolImpl)
+}
+
+func (pce *PgCmdExecutor) sendReadyForQuery() error {
+	return pce.getProtocol().sendReadyForQuery(pce.GetSession().InActiveTransaction())
+}
+
+// ExecRequest the server execute the message from the client following the postgresql's routine
+func (pce *PgCmdExecutor) ExecRequest(req *Request) (*Response, error) {
+	ses := pce.GetSession()
+	proto := pce.getProtocol()
+	typ := byte(req.GetCmd())
+	data := req.GetData().([]byte)
+	logutil.Infof("cmd %c", typ)
+
+	ses.Cmd = req.GetCmd()
+	switch typ {
+	case pgMsgTerminate:
+		return nil, nil
+	case pgMsgSync:
+		pce.skipTillSync = false
+		return nil, pce.sendReadyForQuery()
+	}
+	if pce.skipTillSync {
+		return nil, nil
+	}
+
+	var err error
+	if ses.Pu.SV.GetRejectWhenHeartbeatFromPDLeaderIsTimeout() && !ses.GetEpochgc().CanAcceptSomething() {
+		err = errors.New(errno.ConnectionException, fmt.Sprintf("heartbeat from pdleader is timeout. the server reject sql request. cmd %c", typ))
+	} else {
+		switch typ {
+		case pgMsgQuery:
+			err = pce.handleQuery(readPgString(data))
+		case pgMsgParse:
+			err = pce.handleParse(data)
+		case pgMsgBind:
+			err = pce.handleBind(data)
+		case pgMsgDescribe:
+			err = pce.handleDescribe(data)
+		case pgMsgExecute:
+			err = pce.handleExecute(data)
+		case pgMsgClose:
+			err = pce.handleClose(data)
+		case pgMsgFlush:
+			err = proto.flush()
+		default:
+			err = errors.New(errno.ProtocolViolation, fmt.Sprintf("invalid frontend message type %d", typ))
+		}
+	}
+
+	if err != nil {
+		if err = proto.sendError(err); err != nil {
+			return nil, err
+		}
+		//the extended query goes on after Sync
+		if typ != pgMsgQuery {
+			pce.skipTillSync = true
+		}
+	}
+	//the simple query is always followed by ReadyForQuery
+	if typ == pgMsgQuery {
+		return nil, pce.sendReadyForQuery()
+	}
+	return nil, nil
+}
+
+// handle Query, the statements in the sql are executed one by one
+func (pce *PgCmdExecutor) handleQuery(sql string) error {
+	ses := pce.GetSession()
+	pce.addSqlCount(1)
+	logutil.Infof("query:%s", SubStringFromBegin(sql, int(ses.Pu.SV.GetLengthOfQueryPrinted())))
+	if strings.TrimSpace(sql) == "" {
+		return pce.getProtocol().sendEmptyQueryResponse()
+	}
+	return pce.doComQuery(sql, nil, nil)
+}
+
+// handle Parse: the name, the query and the types of the parameters
+func (pce *PgCmdExecutor) handleParse(data []byte) error {
+	name, pos := readPgStringAt(data, 0)
+	sql, pos := readPgStringAt(data, pos)
+	if pos+2 > len(data) {
+		return errors.New(errno.ProtocolViolation, "invalid Parse message")
+	}
+	n := int(binary.BigEndian.Uint16(data[pos:]))
+	pos += 2
+	if pos+4*n > len(data) {
+		return errors.New(errno.ProtocolViolation, "invalid Parse message")
+	}
+	paramTypes := make([]uint32, n)
+	for i := range paramTypes {
+		paramTypes[i] = binary.BigEndian.Uint32(data[pos+4*i:])
+	}
+
+	if _, ok := pce.stmts[name]; ok && name != "" {
+		return errors.New(errno.DuplicatePreparedStatement, fmt.Sprintf("prepared statement \"%s\" already exists", name))
+	}
+	pce.addSqlCount(1)
+	logutil.Infof("prepare:%s", SubStringFromBegin(sql, int(pce.GetSession().Pu.SV.GetLengthOfQueryPrinted())))
+	stmts, err := parsers.Parse(dialect.POSTGRESQL, sql)
+	if err != nil {
+		return NewMysqlError(ER_PARSE_ERROR, err,
+			"You have an error in your SQL syntax; check the manual that corresponds to your MatrixOne server version for the right syntax to use")
+	}
+	if len(stmts) > 1 {
+		return errors.New(errno.SyntaxError, "cannot insert multiple commands into a prepared statement")
+	}
+
+	ps := &pgPreparedStatement{sql: sql}
+	if len(stmts) == 1 {
+		ps.stmt = stmts[0]
+	}
+	if cnt := countPgParams(sql); cnt > len(paramTypes) {
+		paramTypes = append(paramTypes, make([]uint32, cnt-len(paramTypes))...)
+	}
+	ps.paramTypes = paramTypes
+	pce.stmts[name] = ps
+	return pce.getProtocol().writeMessage(pgMsgParseComplete)
+}
+
+// handle Bind: the portal, the statement, the parameters and the formats of the result
+func (pce *PgCmdExecutor) handleBind(data []byte) error {
+	invalid := errors.New(errno.ProtocolViolation, "invalid Bind message")
+	portalName, pos := readPgStringAt(data, 0)
+	stmtName, pos := readPgStringAt(data, pos)
+	ps, ok := pce.stmts[stmtName]
+	if !ok {
+		return errors.New(errno.InvalidSQLStatementName, fmt.Sprintf("prepared statement \"%s\" does not exist", stmtName))
+	}
+
+	paramFormats, pos, ok := readPgInt16s(data, pos)
+	if !ok {
+		return invalid
+	}
+	if pos+2 > len(data) {
+		return invalid
+	}
+	n := int(binary.BigEndian.Uint16(data[pos:]))
+	pos += 2
+	if n != len(ps.paramTypes) {
+		return errors.New(errno.ProtocolViolation, fmt.Sprintf("bind message supplies %d parameters, but prepared statement \"%s\" requires %d", n, stmtName, len(ps.paramTypes)))
+	}
+	if len(paramFormats) > 1 && len(paramFormats) != n {
+		return errors.New(errno.ProtocolViolation, fmt.Sprintf("bind message has %d parameter formats but %d parameters", len(paramFormats), n))
+	}
+
+	params := make([]tree.Expr, n)
+	for i := range params {
+		if pos+4 > len(data) {
+			return invalid
+		}
+		length := int(int32(binary.BigEndian.Uint32(data[pos:])))
+		pos += 4
+		var v []byte
+		if length >= 0 {
+			if pos+length > len(data) {
+				return invalid
+			}
+			v = data[pos : pos+length]
+			pos += length
+		}
+		var err error
+		if params[i], err = decodePgParam(ps.paramTypes[i], pgResultFormat(paramFormats, i), v); err != nil {
+			return err
+		}
+	}
+
+	resultFormats, _, ok := readPgInt16s(data, pos)
+	if !ok {
+		return invalid
+	}
+	pce.portals[portalName] = &pgPortal{
+		ps:            ps,
+		params:        params,
+		resultFormats: resultFormats,
+	}
+	return pce.getProtocol().writeMessage(pgMsgBindComplete)
+}
+
+// handle Describe of the statement or the portal
+func (pce *PgCmdExecutor) handleDescribe(data []byte) error {
+	if len(data) < 1 {
+		return errors.New(errno.ProtocolViolation, "invalid Describe message")
+	}
+	proto := pce.getProtocol()
+	name := readPgString(data[1:])
+	switch data[0] {
+	case 'S':
+		ps, ok := pce.stmts[name]
+		if !ok {
+			return errors.New(errno.InvalidSQLStatementName, fmt.Sprintf("prepared statement \"%s\" does not exist", name))
+		}
+		oids := make([]uint32, len(ps.paramTypes))
+		params := make([]tree.Expr, len(ps.paramTypes))
+		for i, oid := range ps.paramTypes {
+			//the parameters of unspecified types are sent as text
+			if oid == 0 {
+				oid = pgTypeText
+			}
+			oids[i] = oid
+			params[i], _ = decodePgParam(oid, pgFormatText, nil)
+		}
+		if err := proto.sendParameterDescription(oids); err != nil {
+			return err
+		}
+		columns, err := pce.describe(ps, params)
+		if err != nil {
+			return err
+		}
+		return pce.sendRowDescription(columns, nil)
+	case 'P':
+		portal, ok := pce.portals[name]
+		if !ok {
+			return errors.New(errno.InvalidCursorName, fmt.Sprintf("portal \"%s\" does not exist", name))
+		}
+		columns, err := pce.describe(portal.ps, portal.params)
+		if err != nil {
+			return err
+		}
+		return pce.sendRowDescription(columns, portal.resultFormats)
+	}
+	return errors.New(errno.ProtocolViolation, fmt.Sprintf("invalid DESCRIBE message subtype %d", data[0]))
+}
+
+// the statement without the result set is described by NoData
+func (pce *PgCmdExecutor) sendRowDescription(columns []pgColumn, formats []int16) error {
+	proto := pce.getProtocol()
+	if columns == nil {
+		return proto.writeMessage(pgMsgNoData)
+	}
+	return proto.sendRowDescription(columns, formats)
+}
+
+/*
+describe returns the columns of the result set of the statement, it is nil if
+the statement produces no result set.
+The statements handled by the frontend itself are executed without sending anything,
+the others are compiled with the parameters.
+*/
+func (pce *PgCmdExecutor) describe(ps *pgPreparedStatement, params []tree.Expr) ([]pgColumn, error) {
+	switch st := ps.stmt.(type) {
+	case *tree.Select:
+		if st.Ep != nil && (st.Ep.Outfile || st.Ep.Stdout) {
+			return nil, nil
+		}
+		if sc, ok := st.Select.(*tree.SelectClause); ok && (isSelectDatabase(sc) || isSelectVariables(sc)) {
+			return pce.describeByExecution(ps.sql, params)
+		}
+	case *tree.ShowVariables:
+		return pce.describeByExecution(ps.sql, params)
+	case *tree.ShowCreateTable, *tree.ShowCreateDatabase, *tree.ShowTables, *tree.ShowDatabases, *tree.ShowColumns,
+		*tree.ShowProcessList, *tree.ShowErrors, *tree.ShowWarnings, *tree.ShowStatus, *tree.ShowIndex,
+		*tree.ExplainFor, *tree.ExplainAnalyze, *tree.ExplainStmt:
+	default:
+		return nil, nil
+	}
+
+	ses := pce.GetSession()
+	cws, err := pce.getComputationWrappers(ps.sql, nil, params)
+	if err != nil {
+		return nil, err
+	}
+	if len(cws) != 1 {
+		return nil, errors.New(errno.SyntaxError, "cannot insert multiple commands into a prepared statement")
+	}
+	cw := cws[0]
+	if err = cw.SetDatabaseName(ses.protocol.GetDatabaseName()); err != nil {
+		return nil, err
+	}
+	if err = cw.Compile(ses, getDataFromPipeline); err != nil {
+		return nil, err
+	}
+	cols, err := cw.GetColumns()
+	if err != nil {
+		return nil, err
+	}
+	columns := make([]pgColumn, 0, len(cols))
+	for _, c := range cols {
+		col, err := makePgColumn(c.(*MysqlColumn))
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, col)
+	}
+	return columns, nil
+}
+
+func (pce *PgCmdExecutor) describeByExecution(sql string, params []tree.Expr) ([]pgColumn, error) {
+	proto := pce.getProtocol()
+	proto.startDescribe()
+	err := pce.doComQuery(sql, nil, params)
+	columns := proto.finishDescribe()
+	if err != nil {
+		return nil, err
+	}
+	return columns, nil
+}
+
+// handle Execute, the rows are sent all at once, so the limit of the rows is ignored
+func (pce *PgCmdExecutor) handleExecute(data []byte) error {
+	name, pos := readPgStringAt(data, 0)
+	//zero means no limit
+	var maxRows int32
+	if pos+4 <= len(data) {
+		maxRows = int32(binary.BigEndian.Uint32(data[pos:]))
+	}
+	portal, ok := pce.portals[name]
+	if !ok {
+		return errors.New(errno.InvalidCursorName, fmt.Sprintf("portal \"%s\" does not exist", name))
+	}
+	proto := pce.getProtocol()
+	//the suspended portal goes on with the rows left
+	if portal.result != nil {
+		return proto.fetchPortal(portal.result, maxRows)
+	}
+	if portal.ps.stmt == nil {
+		return proto.sendEmptyQueryResponse()
+	}
+	pce.addSqlCount(1)
+	proto.startPortal(portal.resultFormats, maxRows > 0)
+	err := pce.doComQuery(portal.ps.sql, nil, portal.params)
+	res := proto.finishPortal()
+	if err != nil || res == nil {
+		return err
+	}
+	portal.result = res
+	return proto.fetchPortal(res, maxRows)
+}
+
+// handle Close of the statement or the portal
+func (pce *PgCmdExecutor) handleClose(data []byte) error {
+	if len(data) < 1 {
+		return errors.New(errno.ProtocolViolation, "invalid Close message")
+	}
+	name := readPgString(data[1:])
+	switch data[0] {
+	case 'S':
+		delete(pce.stmts, name)
+	case 'P':
+		delete(pce.portals, name)
+	default:
+		return errors.New(errno.ProtocolViolation, fmt.Sprintf("invalid CLOSE message subtype %d", data[0]))
+	}
+	return pce.getProtocol().writeMessage(pgMsgCloseComplete)
+}
+
+// countPgParams returns the number of the parameters $1, $2, ... in sql.
+func countPgParams(sql string) int {
+	cnt := 0
+	s := scanner.NewScanner(dialect.POSTGRESQL, sql)
+	for {
+		typ, val := s.Scan()
+		if typ == 0 || typ == scanner.LEX_ERROR {
+			break
+		}
+		if typ == scanner.VALUE_ARG {
+			//the scanner names $n as :vn
+			if n, err := strconv.Atoi(strings.TrimPrefix(val, ":v")); err == nil && n > cnt {
+				cnt = n
+			}
+		}
+	}
+	return cnt
+}
+
+// readPgString reads the string terminated by zero
+func readPgString(data []byte) string {
+	s, _ := readPgStringAt(data, 0)
+	return s
+}
+
+func readPgStringAt(data []byte, pos int) (string, int) {
+	if pos >= len(data) {
+		return "", len(data)
+	}
+	end := bytes.IndexByte(data[pos:], 0)
+	if end < 0 {
+		return string(data[pos:]), len(data)
+	}
+	return string(data[pos : pos+end]), pos + end + 1
+}
+
+// readPgInt16s reads the int16 count and the int16 values
+func readPgInt16s(data []byte, pos int) ([]int16, int, bool) {
+	if pos+2 > len(data) {
+		return nil, pos, false
+	}
+	n := int(binary.BigEndian.Uint16(data[pos:]))
+	pos += 2
+	if pos+2*n > len(data) {
+		return nil, pos, false
+	}
+	values := make([]int16, n)
+	for i := range values {
+		values[i] = int16(binary.BigEndian.Uint16(data[pos+2*i:]))
+	}
+	return values, pos + 2*n, true
+}
+
+func NewPgCmdExecutor() *PgCmdExecutor {
+	return &PgCmdExecutor{
+		MysqlCmdExecutor: NewMysqlCmdExecutor(),
+		stmts:            make(map[string]*pgPreparedStatement),
+		portals:          make(map[string]*pgPortal),
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/fagongzi/goetty/buf"
+	"github.com/fagongzi/goetty/codec"
+)
+
+// pgMaxMessageLength is the limit of the length of the message from the client.
+const pgMaxMessageLength = 1 << 30
+
+func NewPgCodec() (codec.Encoder, codec.Decoder) {
+	c := &pgCodec{}
+	return c, c
+}
+
+/*
+pgCodec decodes the messages of the postgresql protocol v3.
+The regular message is the type byte, the int32 length and the payload.
+The StartupMessage, SSLRequest and CancelRequest have no type byte, they are
+distinguished by the first byte of the length which is always zero.
+The encoder writes the bytes as the sqlCodec does.
+*/
+type pgCodec struct {
+	sqlCodec
+}
+
+// PgMessage is a message from the client, Type is 0 for the message without the type byte.
+type PgMessage struct {
+	Type    byte
+	Payload []byte
+}
+
+func (c *pgCodec) Decode(in *buf.ByteBuf) (bool, interface{}, error) {
+	first, err := in.PeekN(0, 1)
+	if err != nil {
+		return false, nil, nil
+	}
+
+	var typ byte
+	headerLength := 4
+	if first[0] != 0 {
+		typ = first[0]
+		headerLength = 5
+	}
+
+	header, err := in.PeekN(0, headerLength)
+	if err != nil {
+		return false, nil, nil
+	}
+
+	length := int(binary.BigEndian.Uint32(header[headerLength-4:]))
+	if length < 4 || length > pgMaxMessageLength {
+		return false, nil, fmt.Errorf("invalid message length %d", length)
+	}
+
+	if in.Readable() < headerLength+length-4 {
+		return false, nil, nil
+	}
+
+	if err = in.Skip(headerLength); err != nil {
+		return false, nil, err
+	}
+
+	_, payload, err := in.ReadBytes(length - 4)
+	if err != nil {
+		return false, nil, err
+	}
+
+	return true, &PgMessage{Type: typ, Payload: payload}, nil
+}
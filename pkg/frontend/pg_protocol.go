@@ -0,0 +1,1034 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/fagongzi/goetty"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
+)
+
+// the codes of the messages without the type byte
+const (
+	pgProtocolVersion   uint32 = 3 << 16
+	pgCancelRequestCode uint32 = 80877102
+	pgSSLRequestCode    uint32 = 80877103
+	pgGSSENCRequestCode uint32 = 80877104
+)
+
+// the messages sent by the server
+const (
+	pgMsgAuthentication       byte = 'R'
+	pgMsgParameterStatus      byte = 'S'
+	pgMsgBackendKeyData       byte = 'K'
+	pgMsgReadyForQuery        byte = 'Z'
+	pgMsgRowDescription       byte = 'T'
+	pgMsgDataRow              byte = 'D'
+	pgMsgCommandComplete      byte = 'C'
+	pgMsgEmptyQueryResponse   byte = 'I'
+	pgMsgErrorResponse        byte = 'E'
+	pgMsgParseComplete        byte = '1'
+	pgMsgBindComplete         byte = '2'
+	pgMsgCloseComplete        byte = '3'
+	pgMsgParameterDescription byte = 't'
+	pgMsgNoData               byte = 'n'
+	pgMsgCopyOutResponse      byte = 'H'
+	pgMsgCopyData             byte = 'd'
+	pgMsgCopyDone             byte = 'c'
+	pgMsgPortalSuspended      byte = 's'
+)
+
+// the password message is the only message of the client during the authentication
+const pgMsgPasswordMessage byte = 'p'
+
+// the codes of the authentication request
+const (
+	pgAuthOk                int32 = 0
+	pgAuthCleartextPassword int32 = 3
+	pgAuthMD5Password       int32 = 5
+	pgAuthSASL              int32 = 10
+	pgAuthSASLContinue      int32 = 11
+	pgAuthSASLFinal         int32 = 12
+)
+
+const (
+	//the version of postgresql whose protocol and behaviors are followed
+	pgCompatibleVersion = "13.0"
+	pgScramMechanism    = "SCRAM-SHA-256"
+	pgScramIterations   = 4096
+)
+
+// the values of the config pgAuthMethod
+const (
+	pgAuthMethodPassword = "password"
+	pgAuthMethodMD5      = "md5"
+	pgAuthMethodScram    = "scram-sha-256"
+)
+
+// scramState is the state of SCRAM-SHA-256 authentication in progress.
+type scramState struct {
+	clientFirstBare string
+	serverFirst     string
+	nonce           string
+	storedKey       []byte
+	serverKey       []byte
+	//the account of the user, it is nil if the user cannot be authenticated
+	user *privilege.User
+	ok   bool
+}
+
+/*
+PgProtocolImpl speaks the postgresql protocol v3 with the client.
+It implements the MysqlProtocol, so the statements are executed by the
+same routine as the mysql clients, the columns, rows and OK packets are
+translated into RowDescription, DataRow and CommandComplete.
+*/
+type PgProtocolImpl struct {
+	ProtocolImpl
+
+	//the user of the client
+	username string
+
+	//the default database for the client
+	database string
+
+	//the secret key of BackendKeyData, CancelRequest must carry it
+	secretKey int32
+
+	//the authentication method asked to the client
+	authMethod string
+	scram      *scramState
+
+	SV *config.SystemVariables
+
+	//accounts and privileges, only the dump user can connect if it is nil
+	pm *privilege.Manager
+
+	//the privilege checker of the user
+	checker *privilege.Checker
+
+	//the message being made
+	msg []byte
+
+	//the outbuf is flushed when the bytes in it exceed it
+	untilBytesInOutbufToFlush int
+
+	//the statement being executed, the command tag is made from it
+	stmt tree.Statement
+
+	//the columns of the result set being sent
+	columns []pgColumn
+
+	//the count of the rows sent
+	rows uint64
+
+	//executing a portal of the extended query, the RowDescription is sent by Describe
+	extended bool
+
+	//the formats of the columns of the result set
+	resultFormats []int16
+
+	//only the columns are recorded for Describe, nothing is sent
+	describing bool
+
+	//the rows of the portal executed with the maximum number of rows are kept
+	//in it, they are fetched by the Executes of the portal
+	portal *pgPortalResult
+}
+
+// pgPortalResult is the result of the portal which is not fetched yet
+type pgPortalResult struct {
+	//the statement of the portal, the command tag is made from it
+	stmt tree.Statement
+
+	//the DataRow messages not fetched
+	rows [][]byte
+
+	//the statement returns rows, the rows fetched by the last Execute are
+	//counted in the command tag, otherwise it is the affected rows
+	resultSet bool
+
+	affectedRows uint64
+}
+
+var _ MysqlProtocol = &PgProtocolImpl{}
+
+func (pp *PgProtocolImpl) GetDatabaseName() string {
+	return pp.database
+}
+
+func (pp *PgProtocolImpl) SetDatabaseName(s string) {
+	pp.database = s
+}
+
+func (pp *PgProtocolImpl) GetUserName() string {
+	return pp.username
+}
+
+func (pp *PgProtocolImpl) SetUserName(s string) {
+	pp.username = s
+}
+
+func (pp *PgProtocolImpl) SetPrivilegeManager(pm *privilege.Manager) {
+	pp.pm = pm
+}
+
+func (pp *PgProtocolImpl) GetPrivilegeChecker() *privilege.Checker {
+	return pp.checker
+}
+
+func (pp *PgProtocolImpl) GetStats() string {
+	return ""
+}
+
+func (pp *PgProtocolImpl) PrepareBeforeProcessingResultSet() {
+}
+
+func (pp *PgProtocolImpl) GetRequest(payload []byte) *Request {
+	req := &Request{
+		cmd:  int(payload[0]),
+		data: payload[1:],
+	}
+
+	return req
+}
+
+// the message is the type byte, the int32 length and the payload
+func (pp *PgProtocolImpl) beginMessage(typ byte) {
+	pp.msg = append(pp.msg[:0], typ, 0, 0, 0, 0)
+}
+
+// write the message made into the outbuf
+func (pp *PgProtocolImpl) endMessage() error {
+	binary.BigEndian.PutUint32(pp.msg[1:], uint32(len(pp.msg)-1))
+	return pp.write(pp.msg)
+}
+
+func (pp *PgProtocolImpl) write(msg []byte) error {
+	if err := pp.tcpConn.Write(msg); err != nil {
+		return err
+	}
+	if pp.tcpConn.OutBuf().Readable() >= pp.untilBytesInOutbufToFlush {
+		return pp.tcpConn.Flush()
+	}
+	return nil
+}
+
+func (pp *PgProtocolImpl) writeMessage(typ byte, payload ...[]byte) error {
+	pp.beginMessage(typ)
+	for _, p := range payload {
+		pp.msg = append(pp.msg, p...)
+	}
+	return pp.endMessage()
+}
+
+func (pp *PgProtocolImpl) flush() error {
+	return pp.tcpConn.Flush()
+}
+
+func (pp *PgProtocolImpl) sendAuthentication(code int32, data []byte) error {
+	return pp.writeMessage(pgMsgAuthentication, appendPgInt32(nil, code), data)
+}
+
+func (pp *PgProtocolImpl) sendParameterStatus(name, value string) error {
+	return pp.writeMessage(pgMsgParameterStatus, appendPgString(appendPgString(nil, name), value))
+}
+
+func (pp *PgProtocolImpl) sendReadyForQuery(inTxn bool) error {
+	status := byte('I')
+	if inTxn {
+		status = 'T'
+	}
+	if err := pp.writeMessage(pgMsgReadyForQuery, []byte{status}); err != nil {
+		return err
+	}
+	return pp.flush()
+}
+
+// ErrorResponse carries the severity, the sqlstate and the message
+func (pp *PgProtocolImpl) sendErrorResponse(severity, code, message string) error {
+	pp.beginMessage(pgMsgErrorResponse)
+	pp.msg = appendPgString(append(pp.msg, 'S'), severity)
+	pp.msg = appendPgString(append(pp.msg, 'V'), severity)
+	pp.msg = appendPgString(append(pp.msg, 'C'), code)
+	pp.msg = appendPgString(append(pp.msg, 'M'), message)
+	pp.msg = append(pp.msg, 0)
+	return pp.endMessage()
+}
+
+// sendError sends the error of the statement, the sqlstate comes from the error
+func (pp *PgProtocolImpl) sendError(err error) error {
+	pp.columns = pp.columns[:0]
+	if pp.describing {
+		return nil
+	}
+	switch e := err.(type) {
+	case *MysqlError:
+		return pp.sendErrorResponse("ERROR", e.SqlState, e.Error())
+	case *privilege.Error:
+		return pp.sendErrorResponse("ERROR", e.State, e.Msg)
+	case *errors.SqlError:
+		return pp.sendErrorResponse("ERROR", e.Code(), e.Error())
+	}
+	return pp.sendErrorResponse("ERROR", errno.InternalError, err.Error())
+}
+
+func (pp *PgProtocolImpl) sendRowDescription(columns []pgColumn, formats []int16) error {
+	pp.beginMessage(pgMsgRowDescription)
+	pp.msg = appendPgInt16(pp.msg, int16(len(columns)))
+	for i, c := range columns {
+		pp.msg = appendPgString(pp.msg, c.name)
+		//the oid of the table and the attribute number
+		pp.msg = appendPgInt32(pp.msg, 0)
+		pp.msg = appendPgInt16(pp.msg, 0)
+		pp.msg = appendPgInt32(pp.msg, int32(c.oid))
+		pp.msg = appendPgInt16(pp.msg, c.size)
+		//type modifier
+		pp.msg = appendPgInt32(pp.msg, -1)
+		pp.msg = appendPgInt16(pp.msg, pgResultFormat(formats, i))
+	}
+	return pp.endMessage()
+}
+
+func (pp *PgProtocolImpl) sendParameterDescription(oids []uint32) error {
+	pp.beginMessage(pgMsgParameterDescription)
+	pp.msg = appendPgInt16(pp.msg, int16(len(oids)))
+	for _, oid := range oids {
+		pp.msg = appendPgInt32(pp.msg, int32(oid))
+	}
+	return pp.endMessage()
+}
+
+func (pp *PgProtocolImpl) sendDataRows(mrs *MysqlResultSet, cnt uint64) error {
+	var err error
+	if ep := pgCopyOut(pp.stmt); ep != nil {
+		return pp.sendCopyData(mrs, cnt, ep)
+	}
+	for r := uint64(0); r < cnt; r++ {
+		pp.beginMessage(pgMsgDataRow)
+		pp.msg = appendPgInt16(pp.msg, int16(len(pp.columns)))
+		for c, col := range pp.columns {
+			if pp.msg, err = appendPgValue(pp.msg, mrs, r, uint64(c), col.oid, pgResultFormat(pp.resultFormats, c)); err != nil {
+				return err
+			}
+		}
+		if pp.portal != nil {
+			binary.BigEndian.PutUint32(pp.msg[1:], uint32(len(pp.msg)-1))
+			pp.portal.rows = append(pp.portal.rows, append([]byte{}, pp.msg...))
+			continue
+		}
+		if err = pp.endMessage(); err != nil {
+			return err
+		}
+	}
+	pp.rows += cnt
+	return nil
+}
+
+// the rows of COPY TO STDOUT are sent in the CopyData messages
+func (pp *PgProtocolImpl) sendCopyData(mrs *MysqlResultSet, cnt uint64, ep *tree.ExportParam) error {
+	var err error
+	for r := uint64(0); r < cnt; r++ {
+		pp.beginMessage(pgMsgCopyData)
+		if pp.msg, err = appendPgCopyRow(pp.msg, mrs, r, pp.columns, ep); err != nil {
+			return err
+		}
+		if err = pp.endMessage(); err != nil {
+			return err
+		}
+	}
+	pp.rows += cnt
+	return nil
+}
+
+// the CopyOutResponse takes the place of the RowDescription, the header line
+// of the csv format follows it
+func (pp *PgProtocolImpl) sendCopyOutResponse(ep *tree.ExportParam) error {
+	pp.beginMessage(pgMsgCopyOutResponse)
+	pp.msg = append(pp.msg, 0)
+	pp.msg = appendPgInt16(pp.msg, int16(len(pp.columns)))
+	for range pp.columns {
+		pp.msg = appendPgInt16(pp.msg, pgFormatText)
+	}
+	if err := pp.endMessage(); err != nil {
+		return err
+	}
+	if !ep.Header || ep.DataFormat != tree.DataFormatCSV {
+		return nil
+	}
+	pp.beginMessage(pgMsgCopyData)
+	for i, c := range pp.columns {
+		if i > 0 {
+			pp.msg = append(pp.msg, ep.Fields.Terminated...)
+		}
+		pp.msg = appendPgCopyField(pp.msg, c.name, ep)
+	}
+	pp.msg = append(pp.msg, '\n')
+	return pp.endMessage()
+}
+
+func (pp *PgProtocolImpl) sendCommandComplete(rows uint64) error {
+	pp.columns = pp.columns[:0]
+	//the CommandComplete of the portal is sent after all of its rows are fetched
+	if pp.portal != nil && pgCopyOut(pp.stmt) == nil {
+		pp.portal.affectedRows = rows
+		return nil
+	}
+	if pgCopyOut(pp.stmt) != nil {
+		if err := pp.writeMessage(pgMsgCopyDone); err != nil {
+			return err
+		}
+	}
+	return pp.writeMessage(pgMsgCommandComplete, appendPgString(nil, pgCommandTag(pp.stmt, rows)))
+}
+
+func (pp *PgProtocolImpl) sendEmptyQueryResponse() error {
+	return pp.writeMessage(pgMsgEmptyQueryResponse)
+}
+
+// pgCopyOut returns the export parameter of COPY TO STDOUT, it is nil for the other statements
+func pgCopyOut(stmt tree.Statement) *tree.ExportParam {
+	if st, ok := stmt.(*tree.Select); ok && st.Ep != nil && st.Ep.Stdout {
+		return st.Ep
+	}
+	return nil
+}
+
+// pgResultFormat returns the format of column i, one format is for all columns
+func pgResultFormat(formats []int16, i int) int16 {
+	switch len(formats) {
+	case 0:
+		return pgFormatText
+	case 1:
+		return formats[0]
+	}
+	return formats[i]
+}
+
+/*
+pgCommandTag makes the tag of CommandComplete, rows is the count of rows
+produced or affected by the statement.
+*/
+func pgCommandTag(stmt tree.Statement, rows uint64) string {
+	n := strconv.FormatUint(rows, 10)
+	switch st := stmt.(type) {
+	case *tree.Select:
+		if st.Ep != nil && st.Ep.Outfile {
+			return "COPY " + strconv.FormatUint(st.Ep.Rows, 10)
+		}
+		if st.Ep != nil && st.Ep.Stdout {
+			return "COPY " + n
+		}
+		return "SELECT " + n
+	case *tree.Insert:
+		return "INSERT 0 " + n
+	case *tree.Update:
+		return "UPDATE " + n
+	case *tree.Delete:
+		return "DELETE " + n
+	case *tree.Load:
+		return "COPY " + n
+	case *tree.CreateDatabase:
+		return "CREATE DATABASE"
+	case *tree.DropDatabase:
+		return "DROP DATABASE"
+	case *tree.CreateTable:
+		return "CREATE TABLE"
+	case *tree.DropTable:
+		return "DROP TABLE"
+	case *tree.AlterTable:
+		return "ALTER TABLE"
+	case *tree.CreateIndex:
+		return "CREATE INDEX"
+	case *tree.DropIndex:
+		return "DROP INDEX"
+	case *tree.AnalyzeStmt:
+		return "ANALYZE"
+	case *tree.BeginTransaction:
+		return "BEGIN"
+	case *tree.CommitTransaction:
+		return "COMMIT"
+	case *tree.RollbackTransaction:
+		return "ROLLBACK"
+	case *tree.CreateUser, *tree.CreateRole:
+		return "CREATE ROLE"
+	case *tree.DropUser, *tree.DropRole:
+		return "DROP ROLE"
+	case *tree.AlterUser:
+		return "ALTER ROLE"
+	case *tree.Grant:
+		return "GRANT"
+	case *tree.Revoke:
+		return "REVOKE"
+	case *tree.SetVar, *tree.Use, *tree.SetDefaultRole, *tree.SetRole, *tree.SetPassword:
+		return "SET"
+	case *tree.ShowVariables:
+		return "SHOW"
+	case *tree.ExplainFor, *tree.ExplainAnalyze, *tree.ExplainStmt:
+		return "EXPLAIN"
+	}
+	return "SELECT " + n
+}
+
+// setStatement is called before the statement is executed
+func (pp *PgProtocolImpl) setStatement(stmt tree.Statement) {
+	pp.stmt = stmt
+	pp.rows = 0
+	pp.columns = pp.columns[:0]
+}
+
+// startPortal is called before the portal of the extended query is executed,
+// the rows are kept for the fetches if the portal may be suspended
+func (pp *PgProtocolImpl) startPortal(resultFormats []int16, suspendable bool) {
+	pp.extended = true
+	pp.resultFormats = resultFormats
+	if suspendable {
+		pp.portal = &pgPortalResult{}
+	}
+}
+
+// finishPortal is called after the portal of the extended query is executed,
+// it returns the rows kept, the rows of COPY TO STDOUT are never kept
+func (pp *PgProtocolImpl) finishPortal() *pgPortalResult {
+	pp.extended = false
+	pp.resultFormats = nil
+	res := pp.portal
+	pp.portal = nil
+	if res == nil || pgCopyOut(pp.stmt) != nil {
+		return nil
+	}
+	res.stmt = pp.stmt
+	return res
+}
+
+// fetchPortal sends at most maxRows rows of the portal, zero means all the rows.
+// The PortalSuspended follows the rows if there are rows left, otherwise the
+// CommandComplete does.
+func (pp *PgProtocolImpl) fetchPortal(res *pgPortalResult, maxRows int32) error {
+	n := len(res.rows)
+	if maxRows > 0 && int(maxRows) < n {
+		n = int(maxRows)
+	}
+	for _, msg := range res.rows[:n] {
+		if err := pp.write(msg); err != nil {
+			return err
+		}
+	}
+	res.rows = res.rows[n:]
+	if len(res.rows) > 0 {
+		return pp.writeMessage(pgMsgPortalSuspended)
+	}
+	rows := res.affectedRows
+	if res.resultSet {
+		rows = uint64(n)
+	}
+	return pp.writeMessage(pgMsgCommandComplete, appendPgString(nil, pgCommandTag(res.stmt, rows)))
+}
+
+// startDescribe begins to record the columns of the statement instead of sending them
+func (pp *PgProtocolImpl) startDescribe() {
+	pp.describing = true
+	pp.columns = pp.columns[:0]
+}
+
+// finishDescribe returns the columns recorded
+func (pp *PgProtocolImpl) finishDescribe() []pgColumn {
+	pp.describing = false
+	columns := append([]pgColumn{}, pp.columns...)
+	pp.columns = pp.columns[:0]
+	return columns
+}
+
+func (pp *PgProtocolImpl) SendColumnCountPacket(count uint64) error {
+	pp.GetLock().Lock()
+	defer pp.GetLock().Unlock()
+	pp.columns = pp.columns[:0]
+	return nil
+}
+
+func (pp *PgProtocolImpl) SendColumnDefinitionPacket(column Column, cmd int) error {
+	pp.GetLock().Lock()
+	defer pp.GetLock().Unlock()
+	mysqlColumn, ok := column.(*MysqlColumn)
+	if !ok {
+		return fmt.Errorf("sendColumn need MysqlColumn")
+	}
+	col, err := makePgColumn(mysqlColumn)
+	if err != nil {
+		return err
+	}
+	pp.columns = append(pp.columns, col)
+	return nil
+}
+
+// the columns are sent in the RowDescription after all of them are made,
+// the portal of the extended query has sent it by Describe
+func (pp *PgProtocolImpl) SendEOFPacketIf(warnings, status uint16) error {
+	pp.GetLock().Lock()
+	defer pp.GetLock().Unlock()
+	if pp.describing {
+		return nil
+	}
+	if ep := pgCopyOut(pp.stmt); ep != nil {
+		return pp.sendCopyOutResponse(ep)
+	}
+	if pp.extended {
+		if pp.portal != nil {
+			pp.portal.resultSet = true
+		}
+		return nil
+	}
+	//the rows of COPY TO and SELECT INTO OUTFILE go to the file
+	if st, ok := pp.stmt.(*tree.Select); ok && st.Ep != nil && st.Ep.Outfile {
+		return nil
+	}
+	return pp.sendRowDescription(pp.columns, nil)
+}
+
+func (pp *PgProtocolImpl) SendResultSetTextBatchRow(mrs *MysqlResultSet, cnt uint64) error {
+	return pp.SendResultSetTextBatchRowSpeedup(mrs, cnt)
+}
+
+func (pp *PgProtocolImpl) SendResultSetTextBatchRowSpeedup(mrs *MysqlResultSet, cnt uint64) error {
+	pp.GetLock().Lock()
+	defer pp.GetLock().Unlock()
+	if pp.describing {
+		return nil
+	}
+	return pp.sendDataRows(mrs, cnt)
+}
+
+// the formats of the columns are decided by Bind rather than the command
+func (pp *PgProtocolImpl) SendResultSetBinaryBatchRow(mrs *MysqlResultSet, cnt uint64) error {
+	return pp.SendResultSetTextBatchRowSpeedup(mrs, cnt)
+}
+
+func (pp *PgProtocolImpl) sendEOFOrOkPacket(warnings, status uint16) error {
+	pp.GetLock().Lock()
+	defer pp.GetLock().Unlock()
+	if pp.describing {
+		return nil
+	}
+	return pp.sendCommandComplete(pp.rows)
+}
+
+func (pp *PgProtocolImpl) sendOKPacket(affectedRows, lastInsertId uint64, status, warnings uint16, message string) error {
+	pp.GetLock().Lock()
+	defer pp.GetLock().Unlock()
+	if pp.describing {
+		return nil
+	}
+	return pp.sendCommandComplete(affectedRows)
+}
+
+func (pp *PgProtocolImpl) SendResponse(resp *Response) error {
+	pp.GetLock().Lock()
+	defer pp.GetLock().Unlock()
+
+	switch resp.category {
+	case OkResponse, EoFResponse:
+		if pp.describing {
+			return nil
+		}
+		return pp.sendCommandComplete(resp.affectedRows)
+	case ErrorResponse:
+		err, _ := resp.data.(error)
+		if err == nil {
+			return pp.sendCommandComplete(0)
+		}
+		return pp.sendError(err)
+	case ResultResponse:
+		mer := resp.data.(*MysqlExecutionResult)
+		if mer == nil || mer.Mrs() == nil {
+			if pp.describing {
+				return nil
+			}
+			if mer == nil {
+				return pp.sendCommandComplete(0)
+			}
+			return pp.sendCommandComplete(mer.AffectedRows())
+		}
+		return pp.sendResultSet(mer.Mrs())
+	default:
+		return fmt.Errorf("unsupported response:%d ", resp.category)
+	}
+}
+
+// the result set made by the frontend itself, e.g. SHOW VARIABLES
+func (pp *PgProtocolImpl) sendResultSet(mrs *MysqlResultSet) error {
+	pp.columns = pp.columns[:0]
+	for i := uint64(0); i < mrs.GetColumnCount(); i++ {
+		column, err := mrs.GetColumn(i)
+		if err != nil {
+			return err
+		}
+		mysqlColumn, ok := column.(*MysqlColumn)
+		if !ok {
+			return fmt.Errorf("sendColumn need MysqlColumn")
+		}
+		col, err := makePgColumn(mysqlColumn)
+		if err != nil {
+			return err
+		}
+		pp.columns = append(pp.columns, col)
+	}
+	if pp.describing {
+		return nil
+	}
+	if !pp.extended {
+		if err := pp.sendRowDescription(pp.columns, nil); err != nil {
+			return err
+		}
+	} else if pp.portal != nil {
+		pp.portal.resultSet = true
+	}
+	if err := pp.sendDataRows(mrs, mrs.GetRowCount()); err != nil {
+		return err
+	}
+	return pp.sendCommandComplete(pp.rows)
+}
+
+func (pp *PgProtocolImpl) SendPrepareResponse(stmt *PrepareStmt) error {
+	return errors.New(errno.FeatureNotSupported, "COM_STMT_PREPARE is not supported by the postgresql protocol")
+}
+
+func (pp *PgProtocolImpl) ParseExecuteData(stmt *PrepareStmt, data []byte) ([]tree.Expr, error) {
+	return nil, errors.New(errno.FeatureNotSupported, "COM_STMT_EXECUTE is not supported by the postgresql protocol")
+}
+
+func (pp *PgProtocolImpl) RequestLocalInfile(name string) (io.ReadCloser, error) {
+	return nil, errors.New(errno.FeatureNotSupported, "LOAD DATA LOCAL is not supported by the postgresql protocol")
+}
+
+/*
+handleStartup handles the messages before the connection is established:
+SSLRequest, StartupMessage and the password messages of the authentication.
+The connection is established after AuthenticationOk has been sent.
+*/
+func (pp *PgProtocolImpl) handleStartup(msg *PgMessage) error {
+	if msg.Type == pgMsgPasswordMessage && pp.authMethod != "" {
+		return pp.handlePassword(msg.Payload)
+	}
+	if msg.Type != 0 || len(msg.Payload) < 4 {
+		return pp.sendFatal(errno.ProtocolViolation, "expected startup message")
+	}
+
+	code := binary.BigEndian.Uint32(msg.Payload)
+	switch code {
+	case pgSSLRequestCode, pgGSSENCRequestCode:
+		//neither SSL nor GSSAPI is supported, the client goes on with the StartupMessage
+		return pp.tcpConn.WriteAndFlush([]byte{'N'})
+	case pgProtocolVersion:
+	default:
+		return pp.sendFatal(errno.FeatureNotSupported, fmt.Sprintf("unsupported frontend protocol %d.%d", code>>16, code&0xffff))
+	}
+
+	params := strings.Split(string(msg.Payload[4:]), "\x00")
+	for i := 0; i+1 < len(params); i += 2 {
+		switch params[i] {
+		case "user":
+			pp.username = params[i+1]
+		case "database":
+			pp.database = params[i+1]
+		}
+	}
+	if pp.username == "" {
+		return pp.sendFatal(errno.InvalidAuthorizationSpecification, "no PostgreSQL user name specified in startup packet")
+	}
+
+	//the accounts keep the SCRAM verifier of their passwords, which is the only
+	//credential of them checkable without receiving the password in plain text.
+	pp.authMethod = pp.SV.GetPgAuthMethod()
+	if pp.pm != nil {
+		pp.authMethod = pgAuthMethodScram
+	}
+	var err error
+	switch pp.authMethod {
+	case pgAuthMethodMD5:
+		err = pp.sendAuthentication(pgAuthMD5Password, pp.salt[:4])
+	case pgAuthMethodScram:
+		err = pp.sendAuthentication(pgAuthSASL, appendPgString(appendPgString(nil, pgScramMechanism), ""))
+	default:
+		pp.authMethod = pgAuthMethodPassword
+		err = pp.sendAuthentication(pgAuthCleartextPassword, nil)
+	}
+	if err != nil {
+		return err
+	}
+	return pp.flush()
+}
+
+// sendFatal sends the error and the connection is closed by the returned error
+func (pp *PgProtocolImpl) sendFatal(code, message string) error {
+	if err := pp.sendErrorResponse("FATAL", code, message); err != nil {
+		return err
+	}
+	if err := pp.flush(); err != nil {
+		return err
+	}
+	return errors.New(code, message)
+}
+
+func (pp *PgProtocolImpl) authenticationFailed() error {
+	return pp.sendFatal(errno.InvalidPassword, fmt.Sprintf("password authentication failed for user \"%s\"", pp.username))
+}
+
+// the password of the user, only the dump user can connect without the privilege manager
+func (pp *PgProtocolImpl) lookupPassword() (string, bool) {
+	if pp.username != pp.SV.GetDumpuser() {
+		return "", false
+	}
+	return pp.SV.GetDumppassword(), true
+}
+
+func (pp *PgProtocolImpl) handlePassword(payload []byte) error {
+	switch pp.authMethod {
+	case pgAuthMethodScram:
+		return pp.handleScram(payload)
+	case pgAuthMethodMD5:
+		password, ok := pp.lookupPassword()
+		if !ok || !checkPgMD5Password(pp.username, password, pp.salt[:4], string(bytes.TrimRight(payload, "\x00"))) {
+			return pp.authenticationFailed()
+		}
+	default:
+		password := string(bytes.TrimRight(payload, "\x00"))
+		if expected, ok := pp.lookupPassword(); !ok ||
+			subtle.ConstantTimeCompare([]byte(expected), []byte(password)) != 1 {
+			return pp.authenticationFailed()
+		}
+	}
+	return pp.sendAuthenticationOk()
+}
+
+// checkPgMD5Password checks the response of the client, which is 'md5' followed by md5(md5(password + user) + salt)
+func checkPgMD5Password(user, password string, salt []byte, response string) bool {
+	inner := md5.Sum([]byte(password + user))
+	outer := md5.Sum(append([]byte(hex.EncodeToString(inner[:])), salt...))
+	expected := "md5" + hex.EncodeToString(outer[:])
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(response)) == 1
+}
+
+/*
+handleScram handles SASLInitialResponse and SASLResponse of SCRAM-SHA-256.
+The routine follows RFC 5802 without the channel binding.
+*/
+func (pp *PgProtocolImpl) handleScram(payload []byte) error {
+	if pp.scram == nil {
+		//SASLInitialResponse: the mechanism, the int32 length and client-first-message
+		i := bytes.IndexByte(payload, 0)
+		if i < 0 || string(payload[:i]) != pgScramMechanism || len(payload) < i+5 {
+			return pp.sendFatal(errno.ProtocolViolation, "invalid SASL initial response")
+		}
+		clientFirst := string(payload[i+5:])
+		if !strings.HasPrefix(clientFirst, "n,,") && !strings.HasPrefix(clientFirst, "y,,") {
+			return pp.sendFatal(errno.ProtocolViolation, "channel binding is not supported")
+		}
+		clientFirstBare := clientFirst[3:]
+		clientNonce := scramAttribute(clientFirstBare, 'r')
+		if clientNonce == "" {
+			return pp.sendFatal(errno.ProtocolViolation, "invalid SCRAM client-first-message")
+		}
+
+		serverNonce := make([]byte, 18)
+		if _, err := rand.Read(serverNonce); err != nil {
+			return err
+		}
+		v, err := pp.lookupScramVerifier()
+		if err != nil {
+			return err
+		}
+		pp.scram.clientFirstBare = clientFirstBare
+		pp.scram.nonce = clientNonce + base64.StdEncoding.EncodeToString(serverNonce)
+		pp.scram.serverFirst = fmt.Sprintf("r=%s,s=%s,i=%d", pp.scram.nonce, base64.StdEncoding.EncodeToString(v.Salt), v.Iterations)
+		if err := pp.sendAuthentication(pgAuthSASLContinue, []byte(pp.scram.serverFirst)); err != nil {
+			return err
+		}
+		return pp.flush()
+	}
+
+	//SASLResponse: client-final-message
+	clientFinal := string(payload)
+	i := strings.LastIndex(clientFinal, ",p=")
+	if i < 0 || scramAttribute(clientFinal, 'r') != pp.scram.nonce {
+		return pp.sendFatal(errno.ProtocolViolation, "invalid SCRAM client-final-message")
+	}
+	proof, err := base64.StdEncoding.DecodeString(clientFinal[i+3:])
+	if err != nil {
+		return pp.sendFatal(errno.ProtocolViolation, "invalid SCRAM client proof")
+	}
+	authMessage := pp.scram.clientFirstBare + "," + pp.scram.serverFirst + "," + clientFinal[:i]
+	serverSignature, ok := checkScramProof(pp.scram.storedKey, pp.scram.serverKey, authMessage, proof)
+	if !ok || !pp.scram.ok {
+		return pp.authenticationFailed()
+	}
+	if err = pp.sendAuthentication(pgAuthSASLFinal, []byte("v="+base64.StdEncoding.EncodeToString(serverSignature))); err != nil {
+		return err
+	}
+	if u := pp.scram.user; u != nil {
+		pp.checker = pp.pm.NewChecker(u.Name, u.Host)
+	}
+	return pp.sendAuthenticationOk()
+}
+
+/*
+lookupScramVerifier returns the SCRAM verifier of the user and keeps its keys in
+pp.scram. The accounts keep the verifier made by CREATE USER or ALTER USER, and
+the dump user has the verifier of its password. A random verifier is made for
+the unknown user, so the client cannot tell whether the user exists till the
+proof is checked.
+*/
+func (pp *PgProtocolImpl) lookupScramVerifier() (*privilege.ScramVerifier, error) {
+	pp.scram = &scramState{}
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	v := &privilege.ScramVerifier{Iterations: pgScramIterations, Salt: salt}
+	password, ok := "", false
+	if pp.pm != nil {
+		host, _ := pp.Peer()
+		if u, found := pp.pm.Lookup(pp.username, host); found {
+			switch pv, parsed := privilege.ParseScramVerifier(u.ScramVerifier); {
+			case parsed:
+				v, ok = pv, true
+			case len(u.AuthString) == 0: //the user has no password
+				ok = true
+			default:
+				logutil.Infof("user %s has no SCRAM verifier, its password must be set again by ALTER USER\n", pp.username)
+			}
+			pp.scram.user = u
+		}
+	} else {
+		password, ok = pp.lookupPassword()
+	}
+	if len(v.StoredKey) == 0 {
+		v.StoredKey, v.ServerKey = privilege.ScramKeys(password, v.Salt, v.Iterations)
+	}
+	pp.scram.storedKey, pp.scram.serverKey, pp.scram.ok = v.StoredKey, v.ServerKey, ok
+	return v, nil
+}
+
+// scramAttribute returns the value of the attribute in the SCRAM message
+func scramAttribute(msg string, name byte) string {
+	for _, attr := range strings.Split(msg, ",") {
+		if len(attr) >= 2 && attr[0] == name && attr[1] == '=' {
+			return attr[2:]
+		}
+	}
+	return ""
+}
+
+func scramHMAC(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+/*
+checkScramProof checks the proof of the client and returns the signature of the server.
+ClientKey is proof XOR HMAC(StoredKey, AuthMessage), it is right if H(ClientKey) is StoredKey.
+*/
+func checkScramProof(storedKey, serverKey []byte, authMessage string, proof []byte) ([]byte, bool) {
+	clientSignature := scramHMAC(storedKey, authMessage)
+	if len(proof) != len(clientSignature) {
+		return nil, false
+	}
+	for i := range proof {
+		clientSignature[i] ^= proof[i]
+	}
+	recovered := sha256.Sum256(clientSignature)
+	if subtle.ConstantTimeCompare(recovered[:], storedKey) != 1 {
+		return nil, false
+	}
+	return scramHMAC(serverKey, authMessage), true
+}
+
+// after AuthenticationOk, the server reports the parameters and the key for canceling, then it is ready
+func (pp *PgProtocolImpl) sendAuthenticationOk() error {
+	logutil.Infof("check password succeeded\n")
+	if err := pp.sendAuthentication(pgAuthOk, nil); err != nil {
+		return err
+	}
+	status := [][2]string{
+		{"server_version", fmt.Sprintf("%s (MatrixOne %s)", pgCompatibleVersion, serverVersion)},
+		{"server_encoding", "UTF8"},
+		{"client_encoding", "UTF8"},
+		{"DateStyle", "ISO, MDY"},
+		{"integer_datetimes", "on"},
+		{"standard_conforming_strings", "on"},
+	}
+	for _, s := range status {
+		if err := pp.sendParameterStatus(s[0], s[1]); err != nil {
+			return err
+		}
+	}
+	if err := pp.writeMessage(pgMsgBackendKeyData, appendPgInt32(appendPgInt32(nil, int32(pp.connectionID)), pp.secretKey)); err != nil {
+		return err
+	}
+	pp.authMethod = ""
+	pp.scram = nil
+	pp.SetEstablished()
+	return pp.sendReadyForQuery(false)
+}
+
+// parsePgCancelRequest returns the process id and the secret key of CancelRequest
+func parsePgCancelRequest(msg *PgMessage) (uint32, int32, bool) {
+	if msg.Type != 0 || len(msg.Payload) != 12 || binary.BigEndian.Uint32(msg.Payload) != pgCancelRequestCode {
+		return 0, 0, false
+	}
+	return binary.BigEndian.Uint32(msg.Payload[4:]), int32(binary.BigEndian.Uint32(msg.Payload[8:])), true
+}
+
+func NewPgProtocol(connectionID uint32, tcp goetty.IOSession, maxBytesToFlush int, SV *config.SystemVariables) *PgProtocolImpl {
+	salt := make([]byte, 4)
+	key := make([]byte, 4)
+	if _, err := rand.Read(salt); err != nil {
+		logutil.Errorf("generate salt failed. error:%v", err)
+	}
+	if _, err := rand.Read(key); err != nil {
+		logutil.Errorf("generate secret key failed. error:%v", err)
+	}
+
+	return &PgProtocolImpl{
+		ProtocolImpl: ProtocolImpl{
+			io:           NewIOPackage(false),
+			tcpConn:      tcp,
+			salt:         salt,
+			connectionID: connectionID,
+			established:  false,
+		},
+		secretKey:                 int32(binary.BigEndian.Uint32(key)),
+		SV:                        SV,
+		untilBytesInOutbufToFlush: maxBytesToFlush * 1024,
+	}
+}
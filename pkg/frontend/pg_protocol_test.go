@@ -0,0 +1,591 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bufio"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fagongzi/goetty/buf"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/postgresql"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
+	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_pgCodec(t *testing.T) {
+	_, decoder := NewPgCodec()
+	in := buf.NewByteBuf(1024)
+
+	//the startup message without the type byte
+	payload := appendPgInt32(nil, int32(pgProtocolVersion))
+	payload = appendPgString(appendPgString(payload, "user"), "dump")
+	in.Write(appendPgInt32(nil, int32(len(payload)+4)))
+	in.Write(payload[:3])
+	ok, msg, err := decoder.Decode(in)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Nil(t, msg)
+
+	in.Write(payload[3:])
+	ok, msg, err = decoder.Decode(in)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, &PgMessage{Type: 0, Payload: payload}, msg)
+
+	//the regular message
+	query := appendPgString(nil, "select 1")
+	in.Write([]byte{pgMsgQuery})
+	in.Write(appendPgInt32(nil, int32(len(query)+4)))
+	in.Write(query)
+	ok, msg, err = decoder.Decode(in)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, &PgMessage{Type: pgMsgQuery, Payload: query}, msg)
+	require.Equal(t, 0, in.Readable())
+
+	//the invalid length
+	in.Write([]byte{pgMsgSync, 0, 0, 0, 2})
+	_, _, err = decoder.Decode(in)
+	require.Error(t, err)
+}
+
+func Test_checkPgMD5Password(t *testing.T) {
+	salt := []byte{1, 2, 3, 4}
+	inner := md5.Sum([]byte("111dump"))
+	outer := md5.Sum(append([]byte(hex.EncodeToString(inner[:])), salt...))
+	response := "md5" + hex.EncodeToString(outer[:])
+
+	require.True(t, checkPgMD5Password("dump", "111", salt, response))
+	require.False(t, checkPgMD5Password("dump", "112", salt, response))
+	require.False(t, checkPgMD5Password("dump", "111", []byte{4, 3, 2, 1}, response))
+}
+
+// scramClientProof computes the proof of the client by RFC 5802
+func scramClientProof(password string, salt []byte, iterations int, authMessage string) []byte {
+	saltedPassword := privilege.ScramSaltedPassword(password, salt, iterations)
+	clientKey := scramHMAC(saltedPassword, "Client Key")
+	storedKey := sha256.Sum256(clientKey)
+	clientSignature := scramHMAC(storedKey[:], authMessage)
+	for i := range clientKey {
+		clientKey[i] ^= clientSignature[i]
+	}
+	return clientKey
+}
+
+func Test_checkScramProof(t *testing.T) {
+	//the test vector of RFC 7677
+	salt, err := base64.StdEncoding.DecodeString("W22ZaJ0SNY7soEsUEjb6gQ==")
+	require.NoError(t, err)
+	authMessage := "n=user,r=rOprNGfwEbeRWgbNEkqO," +
+		"r=rOprNGfwEbeRWgbNEkqO%hvYDpWUa2RaTCAfuxFIlj)hNlF$k0,s=W22ZaJ0SNY7soEsUEjb6gQ==,i=4096," +
+		"c=biws,r=rOprNGfwEbeRWgbNEkqO%hvYDpWUa2RaTCAfuxFIlj)hNlF$k0"
+
+	proof := scramClientProof("pencil", salt, 4096, authMessage)
+	require.Equal(t, "dHzbZapWIk4jUhN+Ute9ytag9zjfMHgsqmmiz7AndVQ=", base64.StdEncoding.EncodeToString(proof))
+
+	storedKey, serverKey := privilege.ScramKeys("pencil", salt, 4096)
+	signature, ok := checkScramProof(storedKey, serverKey, authMessage, proof)
+	require.True(t, ok)
+	require.Equal(t, "6rriTRBi23WpRR/wtup+mMhUZUn/dB5nLTJRsjl95G4=", base64.StdEncoding.EncodeToString(signature))
+
+	storedKey, serverKey = privilege.ScramKeys("pen", salt, 4096)
+	_, ok = checkScramProof(storedKey, serverKey, authMessage, proof)
+	require.False(t, ok)
+	storedKey, serverKey = privilege.ScramKeys("pencil", salt, 4096)
+	_, ok = checkScramProof(storedKey, serverKey, authMessage, proof[1:])
+	require.False(t, ok)
+
+	//the proof is checked by the verifier kept for the account as well
+	verifier, err := privilege.EncodeScramVerifier("pencil")
+	require.NoError(t, err)
+	v, ok := privilege.ParseScramVerifier(verifier)
+	require.True(t, ok)
+	authMessage = "n=user,r=nonce,r=nonce1,s=" + base64.StdEncoding.EncodeToString(v.Salt) + ",i=4096,c=biws,r=nonce1"
+	_, ok = checkScramProof(v.StoredKey, v.ServerKey, authMessage, scramClientProof("pencil", v.Salt, v.Iterations, authMessage))
+	require.True(t, ok)
+}
+
+func Test_pgNumeric(t *testing.T) {
+	cases := []struct {
+		in, out string
+	}{
+		{"0", "0"},
+		{"123", "123"},
+		{"-123.45", "-123.45"},
+		{"12345678.000900", "12345678.000900"},
+		{"0.00001", "0.00001"},
+		{"+10000", "10000"},
+		{"-0.0", "0.0"},
+	}
+	for _, c := range cases {
+		data, err := appendPgNumeric(nil, c.in)
+		require.NoError(t, err, c.in)
+		s, err := decodePgNumeric(data)
+		require.NoError(t, err, c.in)
+		require.Equal(t, c.out, s, c.in)
+	}
+
+	//12345.678 is the digits 1, 2345, 6780 with weight 1 and dscale 3
+	data, err := appendPgNumeric(nil, "12345.678")
+	require.NoError(t, err)
+	require.Equal(t, []byte{0, 3, 0, 1, 0, 0, 0, 3, 0, 1, 0x09, 0x29, 0x1a, 0x7c}, data)
+
+	_, err = appendPgNumeric(nil, "1e5")
+	require.Error(t, err)
+	_, err = decodePgNumeric([]byte{0, 1, 0, 0, 0, 0, 0, 0})
+	require.Error(t, err)
+}
+
+func Test_decodePgParam(t *testing.T) {
+	be := binary.BigEndian
+	cases := []struct {
+		oid    uint32
+		format int16
+		v      []byte
+		want   interface{}
+	}{
+		{pgTypeInt4, pgFormatText, []byte(" 42"), makeIntParam(42)},
+		{pgTypeFloat8, pgFormatText, []byte("1.5"), makeFloatParam(1.5)},
+		{pgTypeBool, pgFormatText, []byte("true"), makeIntParam(1)},
+		{pgTypeUnknown, pgFormatText, []byte("abc"), makeStringParam("abc")},
+		{pgTypeInt2, pgFormatBinary, be.AppendUint16(nil, math.MaxUint16), makeIntParam(-1)},
+		{pgTypeInt8, pgFormatBinary, be.AppendUint64(nil, 1<<40), makeIntParam(1 << 40)},
+		{pgTypeFloat4, pgFormatBinary, be.AppendUint32(nil, math.Float32bits(0.5)), makeFloatParam(0.5)},
+		{pgTypeDate, pgFormatBinary, be.AppendUint32(nil, 31), makeStringParam("2000-02-01")},
+		{pgTypeTimestamp, pgFormatBinary, be.AppendUint64(nil, 86400*1000000+1), makeStringParam("2000-01-02 00:00:00.000001")},
+		{pgTypeText, pgFormatBinary, []byte("abc"), makeStringParam("abc")},
+	}
+	for _, c := range cases {
+		expr, err := decodePgParam(c.oid, c.format, c.v)
+		require.NoError(t, err)
+		require.Equal(t, c.want, expr, "oid %d", c.oid)
+	}
+
+	expr, err := decodePgParam(pgTypeInt4, pgFormatText, nil)
+	require.NoError(t, err)
+	require.Equal(t, "NULL", fmt.Sprint(expr))
+
+	_, err = decodePgParam(pgTypeInt4, pgFormatText, []byte("x"))
+	require.Error(t, err)
+	_, err = decodePgParam(pgTypeInt4, pgFormatBinary, []byte{0, 1})
+	require.Error(t, err)
+	_, err = decodePgParam(pgTypeBool+1, pgFormatBinary, []byte{0})
+	require.Error(t, err)
+}
+
+func Test_convertEngineTypeToPgType(t *testing.T) {
+	col := new(MysqlColumn)
+	col.SetColumnType(defines.MYSQL_TYPE_LONG)
+	c, err := makePgColumn(col)
+	require.NoError(t, err)
+	require.Equal(t, pgColumn{oid: pgTypeInt4, size: 4}, c)
+
+	col.SetSigned(false)
+	c, err = makePgColumn(col)
+	require.NoError(t, err)
+	require.Equal(t, pgColumn{oid: pgTypeInt8, size: 8}, c)
+
+	col.SetColumnType(defines.MYSQL_TYPE_VAR_STRING)
+	c, err = makePgColumn(col)
+	require.NoError(t, err)
+	require.Equal(t, pgColumn{oid: pgTypeVarchar, size: -1}, c)
+}
+
+func Test_pgCommandTag(t *testing.T) {
+	cases := []struct {
+		sql  string
+		rows uint64
+		tag  string
+	}{
+		{"select a from t", 3, "SELECT 3"},
+		{"insert into t values (1), (2)", 2, "INSERT 0 2"},
+		{"update t set a = 1", 4, "UPDATE 4"},
+		{"delete from t where a = $1", 1, "DELETE 1"},
+		{"create table t (a int)", 0, "CREATE TABLE"},
+		{"drop table t", 0, "DROP TABLE"},
+		{"begin", 0, "BEGIN"},
+		{"commit", 0, "COMMIT"},
+		{"copy t to stdout", 5, "COPY 5"},
+	}
+	for _, c := range cases {
+		stmt, err := postgresql.ParseOne(c.sql)
+		require.NoError(t, err, c.sql)
+		require.Equal(t, c.tag, pgCommandTag(stmt, c.rows), c.sql)
+	}
+}
+
+func Test_appendPgCopyRow(t *testing.T) {
+	mrs := &MysqlResultSet{}
+	for _, name := range []string{"a", "b"} {
+		col := new(MysqlColumn)
+		col.SetName(name)
+		mrs.AddColumn(col)
+	}
+	mrs.AddRow([]interface{}{"x\ty", nil})
+	mrs.AddRow([]interface{}{"a\\b,\"c\"", ""})
+	columns := []pgColumn{{name: "a", oid: pgTypeText}, {name: "b", oid: pgTypeText}}
+
+	stmt, err := postgresql.ParseOne("copy t to stdout")
+	require.NoError(t, err)
+	ep := pgCopyOut(stmt)
+	require.NotNil(t, ep)
+	data, err := appendPgCopyRow(nil, mrs, 0, columns, ep)
+	require.NoError(t, err)
+	require.Equal(t, "x\\ty\t\\N\n", string(data))
+	data, err = appendPgCopyRow(nil, mrs, 1, columns, ep)
+	require.NoError(t, err)
+	require.Equal(t, "a\\\\b,\"c\"\t\n", string(data))
+
+	stmt, err = postgresql.ParseOne("copy t to stdout (format csv)")
+	require.NoError(t, err)
+	ep = pgCopyOut(stmt)
+	data, err = appendPgCopyRow(nil, mrs, 0, columns, ep)
+	require.NoError(t, err)
+	require.Equal(t, "x\ty,\n", string(data))
+	data, err = appendPgCopyRow(nil, mrs, 1, columns, ep)
+	require.NoError(t, err)
+	require.Equal(t, "\"a\\b,\"\"c\"\"\",\"\"\n", string(data))
+
+	stmt, err = postgresql.ParseOne("copy t to '/tmp/t.txt'")
+	require.NoError(t, err)
+	require.Nil(t, pgCopyOut(stmt))
+}
+
+func Test_countPgParams(t *testing.T) {
+	require.Equal(t, 0, countPgParams("select 1"))
+	require.Equal(t, 2, countPgParams("select a from t where a = $2 and b = $1"))
+	require.Equal(t, 3, countPgParams("insert into t values ($1, $3, '$4')"))
+}
+
+func Test_parsePgCancelRequest(t *testing.T) {
+	payload := appendPgInt32(appendPgInt32(appendPgInt32(nil, int32(pgCancelRequestCode)), 1001), -2)
+	id, key, ok := parsePgCancelRequest(&PgMessage{Payload: payload})
+	require.True(t, ok)
+	require.Equal(t, uint32(1001), id)
+	require.Equal(t, int32(-2), key)
+
+	_, _, ok = parsePgCancelRequest(&PgMessage{Type: pgMsgQuery, Payload: payload})
+	require.False(t, ok)
+	_, _, ok = parsePgCancelRequest(&PgMessage{Payload: appendPgInt32(nil, int32(pgProtocolVersion))})
+	require.False(t, ok)
+}
+
+func create_test_pg_server(pgPort int64, authMethod string, pm *privilege.Manager) *MOServer {
+	if err := config.GlobalSystemVariables.LoadInitialValues(); err != nil {
+		panic(err)
+	}
+	if err := config.LoadvarsConfigFromFile("test/system_vars_config.toml",
+		&config.GlobalSystemVariables); err != nil {
+		panic(err)
+	}
+	if err := config.GlobalSystemVariables.SetPgPort(pgPort); err != nil {
+		panic(err)
+	}
+	if err := config.GlobalSystemVariables.SetPgAuthMethod(authMethod); err != nil {
+		panic(err)
+	}
+
+	config.HostMmu = host.New(config.GlobalSystemVariables.GetHostMmuLimitation())
+	config.Mempool = mempool.New()
+	pu := config.NewParameterUnit(&config.GlobalSystemVariables, config.HostMmu, config.Mempool, config.StorageEngine, config.ClusterNodes, nil)
+	pu.Privilege = pm
+
+	ppu := NewPDCallbackParameterUnit(int(config.GlobalSystemVariables.GetPeriodOfEpochTimer()), int(config.GlobalSystemVariables.GetPeriodOfPersistence()), int(config.GlobalSystemVariables.GetPeriodOfDDLDeleteTimer()), int(config.GlobalSystemVariables.GetTimeoutOfHeartbeat()), config.GlobalSystemVariables.GetEnableEpochLogging(), math.MaxInt64)
+	pci := NewPDCallbackImpl(ppu)
+	pci.Id = 0
+
+	address := fmt.Sprintf("%s:%d", config.GlobalSystemVariables.GetHost(), config.GlobalSystemVariables.GetPort()+10)
+	return NewMOServer(address, pu, pci)
+}
+
+// pgTestClient is the client of the postgresql protocol for testing.
+type pgTestClient struct {
+	conn net.Conn
+	r    *bufio.Reader
+
+	//the database in the startup message, it is not sent if it is empty
+	database string
+}
+
+func (c *pgTestClient) send(typ byte, payload []byte) error {
+	var data []byte
+	if typ != 0 {
+		data = append(data, typ)
+	}
+	data = appendPgInt32(data, int32(len(payload)+4))
+	_, err := c.conn.Write(append(data, payload...))
+	return err
+}
+
+func (c *pgTestClient) receive() (byte, []byte, error) {
+	header := make([]byte, 5)
+	if _, err := io.ReadFull(c.r, header); err != nil {
+		return 0, nil, err
+	}
+	payload := make([]byte, binary.BigEndian.Uint32(header[1:])-4)
+	if _, err := io.ReadFull(c.r, payload); err != nil {
+		return 0, nil, err
+	}
+	return header[0], payload, nil
+}
+
+// receiveUntil receives the messages until the message of the type
+func (c *pgTestClient) receiveUntil(typ byte) ([]byte, []byte, error) {
+	var types []byte
+	for {
+		t, payload, err := c.receive()
+		if err != nil {
+			return types, nil, err
+		}
+		types = append(types, t)
+		if t == typ {
+			return types, payload, nil
+		}
+	}
+}
+
+// scram authenticates the user with SCRAM-SHA-256 and returns the signature of the server
+func (c *pgTestClient) scram(user, password string) error {
+	startup := appendPgInt32(nil, int32(pgProtocolVersion))
+	startup = appendPgString(appendPgString(startup, "user"), user)
+	if c.database != "" {
+		startup = appendPgString(appendPgString(startup, "database"), c.database)
+	}
+	startup = append(startup, 0)
+	if err := c.send(0, startup); err != nil {
+		return err
+	}
+	typ, payload, err := c.receive()
+	if err != nil {
+		return err
+	}
+	if typ != pgMsgAuthentication || int32(binary.BigEndian.Uint32(payload)) != pgAuthSASL {
+		return fmt.Errorf("unexpected message %c %v", typ, payload)
+	}
+
+	clientFirstBare := "n=,r=clientnonce"
+	initial := appendPgString(nil, pgScramMechanism)
+	initial = appendPgInt32(initial, int32(len(clientFirstBare)+3))
+	initial = append(initial, "n,,"+clientFirstBare...)
+	if err = c.send(pgMsgPasswordMessage, initial); err != nil {
+		return err
+	}
+	if typ, payload, err = c.receive(); err != nil {
+		return err
+	}
+	if typ != pgMsgAuthentication || int32(binary.BigEndian.Uint32(payload)) != pgAuthSASLContinue {
+		return fmt.Errorf("unexpected message %c %v", typ, payload)
+	}
+	serverFirst := string(payload[4:])
+	nonce := scramAttribute(serverFirst, 'r')
+	salt, err := base64.StdEncoding.DecodeString(scramAttribute(serverFirst, 's'))
+	if err != nil {
+		return err
+	}
+	iterations, err := strconv.Atoi(scramAttribute(serverFirst, 'i'))
+	if err != nil {
+		return err
+	}
+	withoutProof := "c=biws,r=" + nonce
+	authMessage := clientFirstBare + "," + serverFirst + "," + withoutProof
+	proof := scramClientProof(password, salt, iterations, authMessage)
+	if err = c.send(pgMsgPasswordMessage, []byte(withoutProof+",p="+base64.StdEncoding.EncodeToString(proof))); err != nil {
+		return err
+	}
+	if typ, payload, err = c.receive(); err != nil {
+		return err
+	}
+	if typ != pgMsgAuthentication || int32(binary.BigEndian.Uint32(payload)) != pgAuthSASLFinal {
+		return fmt.Errorf("unexpected message %c %s", typ, payload)
+	}
+	_, serverKey := privilege.ScramKeys(password, salt, iterations)
+	if !hmac.Equal([]byte("v="+base64.StdEncoding.EncodeToString(scramHMAC(serverKey, authMessage))), payload[4:]) {
+		return fmt.Errorf("invalid server signature")
+	}
+	return nil
+}
+
+func Test_pgServer(t *testing.T) {
+	const pgPort = 6005
+	mo := create_test_pg_server(pgPort, pgAuthMethodScram, nil)
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	cf := &CloseFlag{}
+	go func() {
+		cf.Open()
+		defer wg.Done()
+
+		err := mo.Start()
+		require.NoError(t, err)
+
+		for cf.IsOpened() {
+		}
+	}()
+	time.Sleep(100 * time.Millisecond)
+
+	dial := func() *pgTestClient {
+		conn, err := net.Dial("tcp", fmt.Sprintf("127.0.0.1:%d", pgPort))
+		require.NoError(t, err)
+		return &pgTestClient{conn: conn, r: bufio.NewReader(conn)}
+	}
+
+	//ssl is refused and the wrong password is rejected
+	c := dial()
+	require.NoError(t, c.send(0, appendPgInt32(nil, int32(pgSSLRequestCode))))
+	b, err := c.r.ReadByte()
+	require.NoError(t, err)
+	require.Equal(t, byte('N'), b)
+	err = c.scram(config.GlobalSystemVariables.GetDumpuser(), "wrong")
+	require.Error(t, err)
+	require.True(t, strings.Contains(err.Error(), "28P01"))
+	c.conn.Close()
+
+	c = dial()
+	c.database = "test"
+	require.NoError(t, c.scram(config.GlobalSystemVariables.GetDumpuser(), config.GlobalSystemVariables.GetDumppassword()))
+	types, payload, err := c.receiveUntil(pgMsgReadyForQuery)
+	require.NoError(t, err)
+	require.Equal(t, byte(pgMsgAuthentication), types[0])
+	require.Contains(t, types, byte(pgMsgParameterStatus))
+	require.Contains(t, types, byte(pgMsgBackendKeyData))
+	require.Equal(t, []byte{'I'}, payload)
+
+	//the empty query
+	require.NoError(t, c.send(pgMsgQuery, appendPgString(nil, " ")))
+	types, payload, err = c.receiveUntil(pgMsgReadyForQuery)
+	require.NoError(t, err)
+	require.Equal(t, []byte{pgMsgEmptyQueryResponse, pgMsgReadyForQuery}, types)
+	require.Equal(t, []byte{'I'}, payload)
+
+	//the error skips the extended query till Sync
+	require.NoError(t, c.send(pgMsgParse, append(appendPgString(appendPgString(nil, ""), "selec 1"), 0, 0)))
+	require.NoError(t, c.send(pgMsgDescribe, appendPgString([]byte{'S'}, "")))
+	require.NoError(t, c.send(pgMsgSync, nil))
+	types, _, err = c.receiveUntil(pgMsgReadyForQuery)
+	require.NoError(t, err)
+	require.Equal(t, []byte{pgMsgErrorResponse, pgMsgReadyForQuery}, types)
+
+	//the portal is suspended after the maximum number of rows
+	require.NoError(t, c.send(pgMsgParse, append(appendPgString(appendPgString(nil, ""), "show variables"), 0, 0)))
+	require.NoError(t, c.send(pgMsgBind, append(appendPgString(appendPgString(nil, ""), ""), 0, 0, 0, 0, 0, 0)))
+	require.NoError(t, c.send(pgMsgExecute, appendPgInt32(appendPgString(nil, ""), 2)))
+	require.NoError(t, c.send(pgMsgExecute, appendPgInt32(appendPgString(nil, ""), 0)))
+	require.NoError(t, c.send(pgMsgSync, nil))
+	types, _, err = c.receiveUntil(pgMsgPortalSuspended)
+	require.NoError(t, err)
+	require.Equal(t, []byte{pgMsgParseComplete, pgMsgBindComplete, pgMsgDataRow, pgMsgDataRow, pgMsgPortalSuspended}, types)
+	types, _, err = c.receiveUntil(pgMsgCommandComplete)
+	require.NoError(t, err)
+	require.Greater(t, len(types), 1)
+	for _, typ := range types[:len(types)-1] {
+		require.Equal(t, pgMsgDataRow, typ)
+	}
+	types, _, err = c.receiveUntil(pgMsgReadyForQuery)
+	require.NoError(t, err)
+	require.Equal(t, []byte{pgMsgReadyForQuery}, types)
+
+	require.NoError(t, c.send(pgMsgTerminate, nil))
+	c.conn.Close()
+
+	cf.Close()
+	err = mo.Stop()
+	require.NoError(t, err)
+	wg.Wait()
+}
+
+func Test_pgServerAccounts(t *testing.T) {
+	const pgPort = 6007
+	pm, err := privilege.New(nil)
+	require.NoError(t, err)
+	require.NoError(t, pm.Bootstrap("root", "111"))
+	verifier, err := privilege.EncodeScramVerifier("222")
+	require.NoError(t, err)
+	require.NoError(t, pm.CreateUsers([]privilege.Account{
+		{Name: "u1", Host: "%", AuthString: privilege.EncodePassword("222"), ScramVerifier: verifier, Auth: true},
+		//the password is given as the hash, which cannot check SCRAM
+		{Name: "u2", Host: "%", AuthString: privilege.EncodePassword("333"), Auth: true},
+		{Name: "u3", Host: "%"},
+	}, false))
+
+	//the accounts are authenticated by SCRAM even if the config asks for the plain password
+	mo := create_test_pg_server(pgPort, pgAuthMethodPassword, pm)
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	cf := &CloseFlag{}
+	go func() {
+		cf.Open()
+		defer wg.Done()
+
+		err := mo.Start()
+		require.NoError(t, err)
+
+		for cf.IsOpened() {
+		}
+	}()
+	time.Sleep(100 * time.Millisecond)
+
+	cases := []struct {
+		user, password string
+		ok             bool
+	}{
+		{"root", "111", true},
+		{"root", "112", false},
+		{"u1", "222", true},
+		{"u1", "111", false},
+		{"u2", "333", false},
+		{"u3", "", true},
+		{"u9", "111", false},
+	}
+	for _, c := range cases {
+		conn, err := net.Dial("tcp", fmt.Sprintf("127.0.0.1:%d", pgPort))
+		require.NoError(t, err)
+		client := &pgTestClient{conn: conn, r: bufio.NewReader(conn)}
+		err = client.scram(c.user, c.password)
+		if c.ok {
+			require.NoError(t, err, c.user)
+			_, payload, err := client.receiveUntil(pgMsgReadyForQuery)
+			require.NoError(t, err, c.user)
+			require.Equal(t, []byte{'I'}, payload)
+			require.NoError(t, client.send(pgMsgTerminate, nil))
+		} else {
+			require.Error(t, err, c.user)
+			require.True(t, strings.Contains(err.Error(), "28P01"), c.user)
+		}
+		conn.Close()
+	}
+
+	cf.Close()
+	err = mo.Stop()
+	require.NoError(t, err)
+	wg.Wait()
+}
@@ -0,0 +1,115 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"errors"
+
+	"github.com/fagongzi/goetty"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+)
+
+/*
+PgRoutineManager serves the clients of the postgresql protocol.
+The routines are kept in the RoutineManager shared with the mysql clients,
+so KILL and the write conflicts of the transactions work across the protocols.
+*/
+type PgRoutineManager struct {
+	*RoutineManager
+}
+
+func (prm *PgRoutineManager) Created(rs goetty.IOSession) {
+	defer func() {
+		if err := recover(); err != nil {
+			logutil.Errorf("create routine manager failed. err:%v", err)
+		}
+	}()
+	rm := prm.RoutineManager
+	pro := NewPgProtocol(nextConnectionID(), rs, int(rm.pu.SV.GetMaxBytesInOutbufToFlush()), rm.pu.SV)
+	pro.SetPrivilegeManager(rm.pu.Privilege)
+	exe := NewPgCmdExecutor()
+	exe.SetRoutineManager(rm)
+
+	routine := NewRoutine(pro, exe, rm.pu)
+	routine.SetRoutineMgr(rm)
+
+	rm.rwlock.Lock()
+	defer rm.rwlock.Unlock()
+
+	rm.clients[rs] = routine
+}
+
+/*
+CancelRequest comes from a new connection with the process id and the secret key
+in BackendKeyData, the statement of the routine is closed if the key matches.
+*/
+func (prm *PgRoutineManager) cancelRequest(id uint32, key int32) {
+	prm.rwlock.RLock()
+	defer prm.rwlock.RUnlock()
+	for _, rt := range prm.clients {
+		if pro, ok := rt.protocol.(*PgProtocolImpl); ok && pro.ConnectionID() == id && pro.secretKey == key {
+			logutil.Infof("will cancel the statement %d", id)
+			rt.notifyClose()
+			return
+		}
+	}
+}
+
+func (prm *PgRoutineManager) Handler(rs goetty.IOSession, msg interface{}, received uint64) error {
+	defer func() {
+		if err := recover(); err != nil {
+			logutil.Errorf("handle message failed. err:%v", err)
+		}
+	}()
+	rm := prm.RoutineManager
+	if rm.pu.SV.GetRejectWhenHeartbeatFromPDLeaderIsTimeout() {
+		if !rm.pdHook.CanAcceptSomething() {
+			logutil.Errorf("The Heartbeat From PDLeader Is Timeout. The Server Go Offline.")
+			return errors.New("The Heartbeat From PDLeader Is Timeout. The Server Reject Connection.\n")
+		}
+	}
+
+	rm.rwlock.RLock()
+	routine, ok := rm.clients[rs]
+	rm.rwlock.RUnlock()
+	if !ok {
+		return errors.New("routine does not exist")
+	}
+
+	protocol := routine.protocol.(*PgProtocolImpl)
+	message, ok := msg.(*PgMessage)
+	if !ok {
+		return errors.New("message is not PgMessage")
+	}
+
+	// finish startup and authentication
+	if !protocol.IsEstablished() {
+		if id, key, ok := parsePgCancelRequest(message); ok {
+			prm.cancelRequest(id, key)
+			return errors.New("the connection of CancelRequest is closed")
+		}
+		return protocol.handleStartup(message)
+	}
+
+	routine.requestChan <- &Request{
+		cmd:  int(message.Type),
+		data: message.Payload,
+	}
+	return nil
+}
+
+func NewPgRoutineManager(rm *RoutineManager) *PgRoutineManager {
+	return &PgRoutineManager{RoutineManager: rm}
+}
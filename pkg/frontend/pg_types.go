@@ -0,0 +1,577 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"encoding/binary"
+	"fmt"
+	"go/constant"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// the oids of the postgresql types
+const (
+	pgTypeBool      uint32 = 16
+	pgTypeInt8      uint32 = 20
+	pgTypeInt2      uint32 = 21
+	pgTypeInt4      uint32 = 23
+	pgTypeText      uint32 = 25
+	pgTypeFloat4    uint32 = 700
+	pgTypeFloat8    uint32 = 701
+	pgTypeUnknown   uint32 = 705
+	pgTypeBpchar    uint32 = 1042
+	pgTypeVarchar   uint32 = 1043
+	pgTypeDate      uint32 = 1082
+	pgTypeTimestamp uint32 = 1114
+	pgTypeNumeric   uint32 = 1700
+)
+
+// the format codes of the values
+const (
+	pgFormatText   int16 = 0
+	pgFormatBinary int16 = 1
+)
+
+// the sign of the binary numeric
+const (
+	pgNumericPos uint16 = 0x0000
+	pgNumericNeg uint16 = 0x4000
+	pgNumericNaN uint16 = 0xC000
+)
+
+// the sizes of the fixed-length types in the binary format
+var pgBinarySizes = map[uint32]int{
+	pgTypeBool:      1,
+	pgTypeInt2:      2,
+	pgTypeInt4:      4,
+	pgTypeInt8:      8,
+	pgTypeFloat4:    4,
+	pgTypeFloat8:    8,
+	pgTypeDate:      4,
+	pgTypeTimestamp: 8,
+}
+
+// the dates and timestamps of the binary format are counted from 2000-01-01
+var pgEpoch = types.FromCalendar(2000, 1, 1)
+
+/*
+convert the type in computation engine to the type of postgresql.
+The unsigned types are widened to the signed types which hold them,
+uint64 becomes numeric.
+*/
+func convertEngineTypeToPgType(engineType types.T) (oid uint32, size int16, err error) {
+	switch engineType {
+	case types.T_int8, types.T_uint8, types.T_int16:
+		return pgTypeInt2, 2, nil
+	case types.T_uint16, types.T_int32:
+		return pgTypeInt4, 4, nil
+	case types.T_uint32, types.T_int64:
+		return pgTypeInt8, 8, nil
+	case types.T_uint64, types.T_decimal:
+		return pgTypeNumeric, -1, nil
+	case types.T_float32:
+		return pgTypeFloat4, 4, nil
+	case types.T_float64:
+		return pgTypeFloat8, 8, nil
+	case types.T_char:
+		return pgTypeBpchar, -1, nil
+	case types.T_varchar:
+		return pgTypeVarchar, -1, nil
+	case types.T_date:
+		return pgTypeDate, 4, nil
+	case types.T_datetime:
+		return pgTypeTimestamp, 8, nil
+	default:
+		return 0, 0, fmt.Errorf("unsupported type %d ", engineType)
+	}
+}
+
+/*
+convert the column of the result set back to the type in computation engine.
+The columns are made by convertEngineTypeToMysqlType or by the statements
+the frontend handles itself, which are strings.
+*/
+func convertMysqlColumnToEngineType(col *MysqlColumn) types.T {
+	signed := uint32(col.Flag())&defines.UNSIGNED_FLAG == 0
+	switch col.ColumnType() {
+	case defines.MYSQL_TYPE_TINY:
+		if signed {
+			return types.T_int8
+		}
+		return types.T_uint8
+	case defines.MYSQL_TYPE_SHORT, defines.MYSQL_TYPE_YEAR:
+		if signed {
+			return types.T_int16
+		}
+		return types.T_uint16
+	case defines.MYSQL_TYPE_INT24, defines.MYSQL_TYPE_LONG:
+		if signed {
+			return types.T_int32
+		}
+		return types.T_uint32
+	case defines.MYSQL_TYPE_LONGLONG:
+		if signed {
+			return types.T_int64
+		}
+		return types.T_uint64
+	case defines.MYSQL_TYPE_FLOAT:
+		return types.T_float32
+	case defines.MYSQL_TYPE_DOUBLE:
+		return types.T_float64
+	case defines.MYSQL_TYPE_STRING:
+		return types.T_char
+	case defines.MYSQL_TYPE_DATE:
+		return types.T_date
+	case defines.MYSQL_TYPE_DATETIME, defines.MYSQL_TYPE_TIMESTAMP:
+		return types.T_datetime
+	case defines.MYSQL_TYPE_DECIMAL, defines.MYSQL_TYPE_NEWDECIMAL:
+		return types.T_decimal
+	default:
+		return types.T_varchar
+	}
+}
+
+// pgColumn is a field of the RowDescription.
+type pgColumn struct {
+	name string
+	oid  uint32
+	size int16
+}
+
+func makePgColumn(col *MysqlColumn) (pgColumn, error) {
+	oid, size, err := convertEngineTypeToPgType(convertMysqlColumnToEngineType(col))
+	if err != nil {
+		return pgColumn{}, err
+	}
+	return pgColumn{name: col.Name(), oid: oid, size: size}, nil
+}
+
+// appendPgValue appends the value of row r, column c in the format to data.
+// The value is the int32 length and the bytes, the length is -1 for NULL.
+func appendPgValue(data []byte, mrs *MysqlResultSet, r, c uint64, oid uint32, format int16) ([]byte, error) {
+	value, err := mrs.GetValue(r, c)
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return appendPgInt32(data, -1), nil
+	}
+
+	lenPos := len(data)
+	data = appendPgInt32(data, 0)
+	if format == pgFormatBinary {
+		data, err = appendPgBinaryValue(data, mrs, r, c, oid)
+	} else {
+		data, err = appendPgTextValue(data, mrs, r, c, oid)
+	}
+	if err != nil {
+		return nil, err
+	}
+	binary.BigEndian.PutUint32(data[lenPos:], uint32(len(data)-lenPos-4))
+	return data, nil
+}
+
+// appendPgCopyRow appends row r as a line of COPY TO STDOUT. NULL is \\N in the
+// text format and nothing in the csv format.
+func appendPgCopyRow(data []byte, mrs *MysqlResultSet, r uint64, columns []pgColumn, ep *tree.ExportParam) ([]byte, error) {
+	csv := ep.DataFormat == tree.DataFormatCSV
+	for c, col := range columns {
+		if c > 0 {
+			data = append(data, ep.Fields.Terminated...)
+		}
+		value, err := mrs.GetValue(r, uint64(c))
+		if err != nil {
+			return nil, err
+		}
+		if value == nil {
+			if !csv {
+				data = append(data, `\N`...)
+			}
+			continue
+		}
+		start := len(data)
+		if data, err = appendPgTextValue(data, mrs, r, uint64(c), col.oid); err != nil {
+			return nil, err
+		}
+		data = appendPgCopyField(data[:start], string(data[start:]), ep)
+	}
+	return append(data, '\n'), nil
+}
+
+// appendPgCopyField appends the value v of COPY TO STDOUT. The text format escapes
+// the backslash, the delimiter and the line breaks by backslashes, the csv format
+// quotes the value having them or the quote, and the empty value which is not NULL.
+func appendPgCopyField(data []byte, v string, ep *tree.ExportParam) []byte {
+	if ep.DataFormat != tree.DataFormatCSV {
+		for i := 0; i < len(v); i++ {
+			switch ch := v[i]; {
+			case ch == '\\':
+				data = append(data, '\\', '\\')
+			case ch == '\n':
+				data = append(data, '\\', 'n')
+			case ch == '\r':
+				data = append(data, '\\', 'r')
+			case ch == '\t':
+				data = append(data, '\\', 't')
+			case strings.HasPrefix(v[i:], ep.Fields.Terminated):
+				data = append(data, '\\', ch)
+			default:
+				data = append(data, ch)
+			}
+		}
+		return data
+	}
+	quote, escape := ep.Fields.EnclosedBy, ep.Fields.EscapedBy
+	if escape == 0 {
+		escape = quote
+	}
+	if v != "" && !strings.ContainsAny(v, "\r\n"+string(quote)+string(escape)) && !strings.Contains(v, ep.Fields.Terminated) {
+		return append(data, v...)
+	}
+	data = append(data, quote)
+	for i := 0; i < len(v); i++ {
+		if v[i] == quote || v[i] == escape {
+			data = append(data, escape)
+		}
+		data = append(data, v[i])
+	}
+	return append(data, quote)
+}
+
+func appendPgTextValue(data []byte, mrs *MysqlResultSet, r, c uint64, oid uint32) ([]byte, error) {
+	switch oid {
+	case pgTypeInt2, pgTypeInt4, pgTypeInt8:
+		v, err := mrs.GetInt64(r, c)
+		if err != nil {
+			return nil, err
+		}
+		return strconv.AppendInt(data, v, 10), nil
+	case pgTypeFloat4, pgTypeFloat8:
+		v, err := mrs.GetFloat64(r, c)
+		if err != nil {
+			return nil, err
+		}
+		if oid == pgTypeFloat4 {
+			return strconv.AppendFloat(data, v, 'g', -1, 32), nil
+		}
+		return strconv.AppendFloat(data, v, 'g', -1, 64), nil
+	case pgTypeDate, pgTypeTimestamp:
+		v, err := mrs.GetValue(r, c)
+		if err != nil {
+			return nil, err
+		}
+		switch t := v.(type) {
+		case types.Date:
+			return append(data, t.String()...), nil
+		case types.Datetime:
+			return append(data, t.String()...), nil
+		}
+	}
+	v, err := mrs.GetString(r, c)
+	if err != nil {
+		return nil, err
+	}
+	return append(data, v...), nil
+}
+
+func appendPgBinaryValue(data []byte, mrs *MysqlResultSet, r, c uint64, oid uint32) ([]byte, error) {
+	switch oid {
+	case pgTypeInt2, pgTypeInt4, pgTypeInt8:
+		v, err := mrs.GetInt64(r, c)
+		if err != nil {
+			return nil, err
+		}
+		switch oid {
+		case pgTypeInt2:
+			return appendPgInt16(data, int16(v)), nil
+		case pgTypeInt4:
+			return appendPgInt32(data, int32(v)), nil
+		}
+		return appendPgInt64(data, v), nil
+	case pgTypeFloat4:
+		v, err := mrs.GetFloat64(r, c)
+		if err != nil {
+			return nil, err
+		}
+		return appendPgInt32(data, int32(math.Float32bits(float32(v)))), nil
+	case pgTypeFloat8:
+		v, err := mrs.GetFloat64(r, c)
+		if err != nil {
+			return nil, err
+		}
+		return appendPgInt64(data, int64(math.Float64bits(v))), nil
+	case pgTypeNumeric:
+		v, err := mrs.GetString(r, c)
+		if err != nil {
+			return nil, err
+		}
+		return appendPgNumeric(data, v)
+	case pgTypeDate:
+		v, err := mrs.GetValue(r, c)
+		if err != nil {
+			return nil, err
+		}
+		d, ok := v.(types.Date)
+		if !ok {
+			return nil, fmt.Errorf("the value of date is %T", v)
+		}
+		return appendPgInt32(data, int32(d-pgEpoch)), nil
+	case pgTypeTimestamp:
+		v, err := mrs.GetValue(r, c)
+		if err != nil {
+			return nil, err
+		}
+		dt, ok := v.(types.Datetime)
+		if !ok {
+			return nil, fmt.Errorf("the value of timestamp is %T", v)
+		}
+		return appendPgInt64(data, pgTimestampFromDatetime(dt)), nil
+	}
+	//the binary format of the strings is the text
+	v, err := mrs.GetString(r, c)
+	if err != nil {
+		return nil, err
+	}
+	return append(data, v...), nil
+}
+
+// pgTimestampFromDatetime returns the microseconds since 2000-01-01 00:00:00.
+func pgTimestampFromDatetime(dt types.Datetime) int64 {
+	y, m, d, _ := dt.ToDate().Calendar(true)
+	hour, minute, sec := dt.Clock()
+	days := int64(types.FromCalendar(y, m, d) - pgEpoch)
+	secs := days*86400 + int64(hour)*3600 + int64(minute)*60 + int64(sec)
+	return secs*1000000 + int64(dt)&(1<<20-1)
+}
+
+// pgDatetimeString formats the microseconds since 2000-01-01 00:00:00.
+func pgDatetimeString(usec int64) string {
+	secs := usec / 1000000
+	micro := usec % 1000000
+	if micro < 0 {
+		secs--
+		micro += 1000000
+	}
+	days := secs / 86400
+	clock := secs % 86400
+	if clock < 0 {
+		days--
+		clock += 86400
+	}
+	s := fmt.Sprintf("%s %02d:%02d:%02d", (pgEpoch + types.Date(days)).String(), clock/3600, clock%3600/60, clock%60)
+	if micro != 0 {
+		s += fmt.Sprintf(".%06d", micro)
+	}
+	return s
+}
+
+/*
+appendPgNumeric appends the binary numeric of the decimal string.
+The numeric is ndigits, weight, sign and dscale in int16, and the digits
+in base 10000, weight is the exponent of the first digit.
+*/
+func appendPgNumeric(data []byte, s string) ([]byte, error) {
+	sign := pgNumericPos
+	if strings.HasPrefix(s, "-") {
+		sign = pgNumericNeg
+		s = s[1:]
+	} else if strings.HasPrefix(s, "+") {
+		s = s[1:]
+	}
+	intPart, fracPart := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		intPart, fracPart = s[:i], s[i+1:]
+	}
+	for _, part := range [2]string{intPart, fracPart} {
+		for i := 0; i < len(part); i++ {
+			if part[i] < '0' || part[i] > '9' {
+				return nil, fmt.Errorf("invalid numeric %s", s)
+			}
+		}
+	}
+	dscale := len(fracPart)
+
+	//align the parts to the groups of 4 digits
+	if n := len(intPart) % 4; n != 0 {
+		intPart = strings.Repeat("0", 4-n) + intPart
+	}
+	if n := len(fracPart) % 4; n != 0 {
+		fracPart += strings.Repeat("0", 4-n)
+	}
+	all := intPart + fracPart
+	digits := make([]int16, 0, len(all)/4)
+	for i := 0; i < len(all); i += 4 {
+		d, _ := strconv.Atoi(all[i : i+4])
+		digits = append(digits, int16(d))
+	}
+	weight := len(intPart)/4 - 1
+	for len(digits) > 0 && digits[0] == 0 {
+		digits = digits[1:]
+		weight--
+	}
+	for len(digits) > 0 && digits[len(digits)-1] == 0 {
+		digits = digits[:len(digits)-1]
+	}
+	if len(digits) == 0 {
+		weight = 0
+		sign = pgNumericPos
+	}
+
+	data = appendPgInt16(data, int16(len(digits)))
+	data = appendPgInt16(data, int16(weight))
+	data = appendPgInt16(data, int16(sign))
+	data = appendPgInt16(data, int16(dscale))
+	for _, d := range digits {
+		data = appendPgInt16(data, d)
+	}
+	return data, nil
+}
+
+// decodePgNumeric returns the decimal string of the binary numeric.
+func decodePgNumeric(v []byte) (string, error) {
+	if len(v) < 8 {
+		return "", errors.New(errno.InvalidBinaryRepresentation, "insufficient data left in message")
+	}
+	ndigits := int(int16(binary.BigEndian.Uint16(v)))
+	weight := int(int16(binary.BigEndian.Uint16(v[2:])))
+	sign := binary.BigEndian.Uint16(v[4:])
+	dscale := int(int16(binary.BigEndian.Uint16(v[6:])))
+	if ndigits < 0 || dscale < 0 || len(v) != 8+2*ndigits {
+		return "", errors.New(errno.InvalidBinaryRepresentation, "invalid length in external \"numeric\" value")
+	}
+	if sign == pgNumericNaN {
+		return "NaN", nil
+	}
+	digit := func(i int) int {
+		if i < 0 || i >= ndigits {
+			return 0
+		}
+		return int(binary.BigEndian.Uint16(v[8+2*i:]))
+	}
+
+	var sb strings.Builder
+	if sign == pgNumericNeg {
+		sb.WriteByte('-')
+	}
+	if weight < 0 {
+		sb.WriteByte('0')
+	} else {
+		sb.WriteString(strconv.Itoa(digit(0)))
+		for i := 1; i <= weight; i++ {
+			sb.WriteString(fmt.Sprintf("%04d", digit(i)))
+		}
+	}
+	if dscale > 0 {
+		var frac strings.Builder
+		for i := weight + 1; frac.Len() < dscale; i++ {
+			frac.WriteString(fmt.Sprintf("%04d", digit(i)))
+		}
+		sb.WriteByte('.')
+		sb.WriteString(frac.String()[:dscale])
+	}
+	return sb.String(), nil
+}
+
+/*
+decodePgParam converts the parameter of Bind into the expression bound to the placeholder.
+The parameters of unknown or string types are strings as the mysql does.
+*/
+func decodePgParam(oid uint32, format int16, v []byte) (tree.Expr, error) {
+	if v == nil {
+		return tree.NewNumVal(constant.MakeUnknown(), "NULL", false), nil
+	}
+	if format == pgFormatText {
+		s := string(v)
+		switch oid {
+		case pgTypeInt2, pgTypeInt4, pgTypeInt8:
+			i, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+			if err != nil {
+				return nil, errors.New(errno.InvalidTextRepresentation, fmt.Sprintf("invalid input syntax for type integer: \"%s\"", s))
+			}
+			return makeIntParam(i), nil
+		case pgTypeFloat4, pgTypeFloat8:
+			f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+			if err != nil {
+				return nil, errors.New(errno.InvalidTextRepresentation, fmt.Sprintf("invalid input syntax for type double precision: \"%s\"", s))
+			}
+			return makeFloatParam(f), nil
+		case pgTypeBool:
+			b, err := strconv.ParseBool(strings.TrimSpace(s))
+			if err != nil {
+				return nil, errors.New(errno.InvalidTextRepresentation, fmt.Sprintf("invalid input syntax for type boolean: \"%s\"", s))
+			}
+			if b {
+				return makeIntParam(1), nil
+			}
+			return makeIntParam(0), nil
+		}
+		return makeStringParam(s), nil
+	}
+
+	if size, ok := pgBinarySizes[oid]; ok && len(v) != size {
+		return nil, errors.New(errno.InvalidBinaryRepresentation, fmt.Sprintf("incorrect binary data format in bind parameter of type %d", oid))
+	}
+	switch oid {
+	case pgTypeBool:
+		return makeIntParam(int64(v[0])), nil
+	case pgTypeInt2:
+		return makeIntParam(int64(int16(binary.BigEndian.Uint16(v)))), nil
+	case pgTypeInt4:
+		return makeIntParam(int64(int32(binary.BigEndian.Uint32(v)))), nil
+	case pgTypeInt8:
+		return makeIntParam(int64(binary.BigEndian.Uint64(v))), nil
+	case pgTypeFloat4:
+		return makeFloatParam(float64(math.Float32frombits(binary.BigEndian.Uint32(v)))), nil
+	case pgTypeFloat8:
+		return makeFloatParam(math.Float64frombits(binary.BigEndian.Uint64(v))), nil
+	case pgTypeDate:
+		return makeStringParam((pgEpoch + types.Date(int32(binary.BigEndian.Uint32(v)))).String()), nil
+	case pgTypeTimestamp:
+		return makeStringParam(pgDatetimeString(int64(binary.BigEndian.Uint64(v)))), nil
+	case pgTypeNumeric:
+		s, err := decodePgNumeric(v)
+		if err != nil {
+			return nil, err
+		}
+		return makeStringParam(s), nil
+	case 0, pgTypeText, pgTypeVarchar, pgTypeBpchar, pgTypeUnknown:
+		return makeStringParam(string(v)), nil
+	}
+	return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("binary format of the parameter of type %d is not supported", oid))
+}
+
+func appendPgInt16(data []byte, v int16) []byte {
+	return append(data, byte(v>>8), byte(v))
+}
+
+func appendPgInt32(data []byte, v int32) []byte {
+	return append(data, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func appendPgInt64(data []byte, v int64) []byte {
+	return append(appendPgInt32(data, int32(v>>32)), byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func appendPgString(data []byte, s string) []byte {
+	return append(append(data, s...), 0)
+}
@@ -0,0 +1,336 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"encoding/binary"
+	"fmt"
+	"go/constant"
+	"math"
+	"reflect"
+	"strconv"
+
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/scanner"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// PrepareStmt is a statement prepared by COM_STMT_PREPARE.
+// The statement is parsed and bound once, every COM_STMT_EXECUTE executes a copy
+// of the ast, because the ast is rewritten while the plan is built.
+type PrepareStmt struct {
+	id        uint32
+	sql       string
+	stmt      tree.Statement
+	numParams int
+	// columns are the columns of the result, they are empty if the statement
+	// does not return a result set.
+	columns []interface{}
+	// paramTypes are the types of parameters sent by the client, 2 bytes for each parameter.
+	// The client sends them only when they are changed.
+	paramTypes []byte
+	// longData is the data sent by COM_STMT_SEND_LONG_DATA, key is the index of parameter.
+	longData map[int][]byte
+}
+
+// PrepareStmts are the prepared statements of a connection.
+type PrepareStmts struct {
+	lastId uint32
+	stmts  map[uint32]*PrepareStmt
+}
+
+func NewPrepareStmts() *PrepareStmts {
+	return &PrepareStmts{
+		stmts: make(map[uint32]*PrepareStmt),
+	}
+}
+
+func (ps *PrepareStmts) add(sql string, ast tree.Statement, numParams int, columns []interface{}) *PrepareStmt {
+	ps.lastId++
+	stmt := &PrepareStmt{
+		id:        ps.lastId,
+		sql:       sql,
+		stmt:      ast,
+		numParams: numParams,
+		columns:   columns,
+		longData:  make(map[int][]byte),
+	}
+	ps.stmts[stmt.id] = stmt
+	return stmt
+}
+
+func (stmt *PrepareStmt) resetLongData() {
+	if len(stmt.longData) > 0 {
+		stmt.longData = make(map[int][]byte)
+	}
+}
+
+func (ps *PrepareStmts) get(id uint32) (*PrepareStmt, bool) {
+	stmt, ok := ps.stmts[id]
+	return stmt, ok
+}
+
+func (ps *PrepareStmts) remove(id uint32) {
+	delete(ps.stmts, id)
+}
+
+// copyStatement returns a copy of the ast which can be rewritten without changing stmt.
+func copyStatement(stmt tree.Statement) tree.Statement {
+	return deepCopy(reflect.ValueOf(stmt)).Interface().(tree.Statement)
+}
+
+// deepCopy copies the pointers, slices and interfaces reachable from v by the
+// exported fields. The unexported fields are shared, they are never changed by the planner.
+func deepCopy(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Elem().Type())
+		c.Elem().Set(deepCopy(v.Elem()))
+		return c
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(deepCopy(v.Elem()))
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(deepCopy(v.Index(i)))
+		}
+		return c
+	case reflect.Array:
+		c := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(deepCopy(v.Index(i)))
+		}
+		return c
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if c.Field(i).CanSet() {
+				c.Field(i).Set(deepCopy(v.Field(i)))
+			}
+		}
+		return c
+	}
+	return v
+}
+
+// countParams returns the number of placeholders in sql.
+func countParams(sql string) int {
+	cnt := 0
+	s := scanner.NewScanner(dialect.MYSQL, sql)
+	for {
+		typ, _ := s.Scan()
+		if typ == 0 || typ == scanner.LEX_ERROR {
+			break
+		}
+		if typ == scanner.VALUE_ARG {
+			cnt++
+		}
+	}
+	return cnt
+}
+
+// ParseExecuteData decodes the parameters of COM_STMT_EXECUTE, data is the payload after
+// the iteration count.
+// the routine follows the article: https://dev.mysql.com/doc/internals/en/com-stmt-execute.html
+func (mp *MysqlProtocolImpl) ParseExecuteData(stmt *PrepareStmt, data []byte) ([]tree.Expr, error) {
+	if stmt.numParams == 0 {
+		return nil, nil
+	}
+	nulls, pos, ok := mp.readCountOfBytes(data, 0, (stmt.numParams+7)/8)
+	if !ok {
+		return nil, NewMysqlError(ER_MALFORMED_PACKET)
+	}
+	//new-params-bound-flag
+	bound, pos, ok := mp.io.ReadUint8(data, pos)
+	if !ok {
+		return nil, NewMysqlError(ER_MALFORMED_PACKET)
+	}
+	if bound == 1 {
+		var typs []byte
+		if typs, pos, ok = mp.readCountOfBytes(data, pos, 2*stmt.numParams); !ok {
+			return nil, NewMysqlError(ER_MALFORMED_PACKET)
+		}
+		stmt.paramTypes = append(stmt.paramTypes[:0], typs...)
+	}
+	if len(stmt.paramTypes) != 2*stmt.numParams {
+		return nil, NewMysqlError(ER_WRONG_ARGUMENTS, "mysqld_stmt_execute")
+	}
+
+	params := make([]tree.Expr, stmt.numParams)
+	for i := range params {
+		if nulls[i/8]&(1<<(i%8)) != 0 {
+			params[i] = tree.NewNumVal(constant.MakeUnknown(), "NULL", false)
+			continue
+		}
+		//the value has been sent by COM_STMT_SEND_LONG_DATA
+		if v, ok := stmt.longData[i]; ok {
+			params[i] = makeStringParam(string(v))
+			continue
+		}
+		typ, unsigned := stmt.paramTypes[2*i], stmt.paramTypes[2*i+1]&0x80 != 0
+		var err error
+		if params[i], pos, err = mp.readParam(data, pos, typ, unsigned); err != nil {
+			return nil, err
+		}
+	}
+	return params, nil
+}
+
+// readParam reads a parameter of binary protocol at the position,
+// it returns the value and the position after it.
+func (mp *MysqlProtocolImpl) readParam(data []byte, pos int, typ uint8, unsigned bool) (tree.Expr, int, error) {
+	var ok bool
+	var i int64
+	var u uint64
+	switch typ {
+	case defines.MYSQL_TYPE_NULL:
+		return tree.NewNumVal(constant.MakeUnknown(), "NULL", false), pos, nil
+	case defines.MYSQL_TYPE_TINY:
+		var v uint8
+		v, pos, ok = mp.io.ReadUint8(data, pos)
+		u, i = uint64(v), int64(int8(v))
+	case defines.MYSQL_TYPE_SHORT, defines.MYSQL_TYPE_YEAR:
+		var v uint16
+		v, pos, ok = mp.io.ReadUint16(data, pos)
+		u, i = uint64(v), int64(int16(v))
+	case defines.MYSQL_TYPE_INT24, defines.MYSQL_TYPE_LONG:
+		var v uint32
+		v, pos, ok = mp.io.ReadUint32(data, pos)
+		u, i = uint64(v), int64(int32(v))
+	case defines.MYSQL_TYPE_LONGLONG:
+		u, pos, ok = mp.io.ReadUint64(data, pos)
+		i = int64(u)
+	case defines.MYSQL_TYPE_FLOAT:
+		var v uint32
+		if v, pos, ok = mp.io.ReadUint32(data, pos); !ok {
+			return nil, 0, NewMysqlError(ER_MALFORMED_PACKET)
+		}
+		return makeFloatParam(float64(math.Float32frombits(v))), pos, nil
+	case defines.MYSQL_TYPE_DOUBLE:
+		var v uint64
+		if v, pos, ok = mp.io.ReadUint64(data, pos); !ok {
+			return nil, 0, NewMysqlError(ER_MALFORMED_PACKET)
+		}
+		return makeFloatParam(math.Float64frombits(v)), pos, nil
+	case defines.MYSQL_TYPE_DATE, defines.MYSQL_TYPE_DATETIME, defines.MYSQL_TYPE_TIMESTAMP, defines.MYSQL_TYPE_TIME:
+		var l uint8
+		var v []byte
+		if l, pos, ok = mp.io.ReadUint8(data, pos); !ok {
+			return nil, 0, NewMysqlError(ER_MALFORMED_PACKET)
+		}
+		if v, pos, ok = mp.readCountOfBytes(data, pos, int(l)); !ok {
+			return nil, 0, NewMysqlError(ER_MALFORMED_PACKET)
+		}
+		if typ == defines.MYSQL_TYPE_TIME {
+			return makeStringParam(decodeTime(v)), pos, nil
+		}
+		return makeStringParam(decodeDatetime(v, typ == defines.MYSQL_TYPE_DATE)), pos, nil
+	default:
+		//the others are sent as length encoded strings
+		var v string
+		if v, pos, ok = mp.readStringLenEnc(data, pos); !ok {
+			return nil, 0, NewMysqlError(ER_MALFORMED_PACKET)
+		}
+		return makeStringParam(v), pos, nil
+	}
+	if !ok {
+		return nil, 0, NewMysqlError(ER_MALFORMED_PACKET)
+	}
+	if unsigned {
+		return tree.NewNumVal(constant.MakeUint64(u), strconv.FormatUint(u, 10), false), pos, nil
+	}
+	return makeIntParam(i), pos, nil
+}
+
+// makeIntParam returns the expression of integer, the negative one is built as
+// the parser does, that is an unary minus on a positive number.
+func makeIntParam(v int64) tree.Expr {
+	if v < 0 {
+		u := uint64(-v)
+		return tree.NewUnaryExpr(tree.UNARY_MINUS, tree.NewNumVal(constant.MakeUint64(u), strconv.FormatUint(u, 10), false))
+	}
+	return tree.NewNumVal(constant.MakeInt64(v), strconv.FormatInt(v, 10), false)
+}
+
+func makeFloatParam(v float64) tree.Expr {
+	if v < 0 {
+		return tree.NewUnaryExpr(tree.UNARY_MINUS, makeFloatParam(-v))
+	}
+	return tree.NewNumValWithResFoalt(constant.MakeFloat64(v), strconv.FormatFloat(v, 'g', -1, 64), false, v)
+}
+
+func makeStringParam(v string) tree.Expr {
+	return tree.NewNumVal(constant.MakeString(v), v, false)
+}
+
+// decodeDatetime decodes the binary DATE, DATETIME and TIMESTAMP.
+func decodeDatetime(v []byte, dateOnly bool) string {
+	var year uint16
+	var month, day, hour, minute, second uint8
+	var micro uint32
+	if len(v) >= 4 {
+		year, month, day = binary.LittleEndian.Uint16(v), v[2], v[3]
+	}
+	if len(v) >= 7 {
+		hour, minute, second = v[4], v[5], v[6]
+	}
+	if len(v) >= 11 {
+		micro = binary.LittleEndian.Uint32(v[7:])
+	}
+	if dateOnly {
+		return fmt.Sprintf("%04d-%02d-%02d", year, month, day)
+	}
+	if micro != 0 {
+		return fmt.Sprintf("%04d-%02d-%02d %02d:%02d:%02d.%06d", year, month, day, hour, minute, second, micro)
+	}
+	return fmt.Sprintf("%04d-%02d-%02d %02d:%02d:%02d", year, month, day, hour, minute, second)
+}
+
+// decodeTime decodes the binary TIME.
+func decodeTime(v []byte) string {
+	var neg bool
+	var days uint32
+	var hour, minute, second uint8
+	var micro uint32
+	if len(v) >= 8 {
+		neg, days = v[0] == 1, binary.LittleEndian.Uint32(v[1:])
+		hour, minute, second = v[5], v[6], v[7]
+	}
+	if len(v) >= 12 {
+		micro = binary.LittleEndian.Uint32(v[8:])
+	}
+	sign := ""
+	if neg {
+		sign = "-"
+	}
+	hours := days*24 + uint32(hour)
+	if micro != 0 {
+		return fmt.Sprintf("%s%02d:%02d:%02d.%06d", sign, hours, minute, second, micro)
+	}
+	return fmt.Sprintf("%s%02d:%02d:%02d", sign, hours, minute, second)
+}
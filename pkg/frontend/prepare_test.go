@@ -0,0 +1,199 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"go/constant"
+	"testing"
+
+	"github.com/fagongzi/goetty/buf"
+	"github.com/golang/mock/gomock"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func Test_countParams(t *testing.T) {
+	convey.Convey("countParams succ", t, func() {
+		convey.So(countParams("select 1"), convey.ShouldEqual, 0)
+		convey.So(countParams("select a from t where a = ? and b = '?' limit ?"), convey.ShouldEqual, 2)
+		convey.So(countParams("insert into t values (?, ?), (?, 1)"), convey.ShouldEqual, 3)
+	})
+}
+
+func Test_copyStatement(t *testing.T) {
+	convey.Convey("copyStatement succ", t, func() {
+		sql := "select a + ? from t where b in (1, 2) limit ?"
+		stmts, err := parsers.Parse(dialect.MYSQL, sql)
+		convey.So(err, convey.ShouldBeNil)
+		stmt := copyStatement(stmts[0])
+		convey.So(tree.String(stmt, dialect.MYSQL), convey.ShouldEqual, tree.String(stmts[0], dialect.MYSQL))
+
+		//the changes of the copy are invisible to the original
+		sel := stmt.(*tree.Select)
+		sel.Limit.Count = tree.NewNumVal(constant.MakeInt64(1), "1", false)
+		sel.Select.(*tree.SelectClause).Where.Expr = nil
+		orig := stmts[0].(*tree.Select)
+		convey.So(orig.Limit.Count, convey.ShouldHaveSameTypeAs, &tree.ParamExpr{})
+		convey.So(orig.Select.(*tree.SelectClause).Where.Expr, convey.ShouldNotBeNil)
+	})
+}
+
+func Test_ParseExecuteData(t *testing.T) {
+	convey.Convey("ParseExecuteData succ", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		if err != nil {
+			t.Error(err)
+		}
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+
+		stmt := NewPrepareStmts().add("select ?, ?, ?, ?, ?", nil, 5, nil)
+		data := []byte{
+			//NULL-bitmap, the fourth parameter is null
+			0x08,
+			//new-params-bound-flag
+			0x01,
+			//types
+			0x08, 0x00, 0xfd, 0x00, 0x01, 0x80, 0x06, 0x00, 0x0a, 0x00,
+			//values
+			0xfb, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+			0x02, 'a', 'b',
+			0xff,
+			0x04, 0xe5, 0x07, 12, 31,
+		}
+		params, err := proto.ParseExecuteData(stmt, data)
+		convey.So(err, convey.ShouldBeNil)
+
+		var values []string
+		for _, p := range params {
+			values = append(values, tree.String(p, dialect.MYSQL))
+		}
+		convey.So(values, convey.ShouldResemble, []string{"-5", "ab", "255", "NULL", "2021-12-31"})
+
+		//the types are kept for the next execution
+		data = []byte{0x00, 0x00, 0x07, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00}
+		stmt.longData[3] = []byte("long")
+		params, err = proto.ParseExecuteData(stmt, data)
+		convey.So(err, convey.ShouldBeNil)
+		values = values[:0]
+		for _, p := range params {
+			values = append(values, tree.String(p, dialect.MYSQL))
+		}
+		convey.So(values, convey.ShouldResemble, []string{"7", "", "1", "long", "0000-00-00"})
+	})
+
+	convey.Convey("ParseExecuteData failed", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		if err != nil {
+			t.Error(err)
+		}
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+
+		stmt := NewPrepareStmts().add("select ?", nil, 1, nil)
+		//no types are bound
+		_, err = proto.ParseExecuteData(stmt, []byte{0x00, 0x00})
+		convey.So(err, convey.ShouldBeError)
+
+		//the value is truncated
+		_, err = proto.ParseExecuteData(stmt, []byte{0x00, 0x01, 0x03, 0x00, 0x01})
+		convey.So(err, convey.ShouldBeError)
+	})
+}
+
+func Test_mce_prepare(t *testing.T) {
+	convey.Convey("COM_STMT_PREPARE/COM_STMT_SEND_LONG_DATA/COM_STMT_RESET/COM_STMT_CLOSE", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().WriteAndFlush(gomock.Any()).Return(nil).AnyTimes()
+
+		pu, err := getParameterUnit("test/system_vars_config.toml", memEngine.NewTestEngine())
+		if err != nil {
+			t.Error(err)
+		}
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, pu.SV)
+
+		epochgc := getPCI()
+
+		guestMmu := guest.New(pu.SV.GetGuestMmuLimitation(), pu.HostMmu)
+
+		ses := NewSession(proto, epochgc, guestMmu, pu.Mempool, pu)
+
+		mce := NewMysqlCmdExecutor()
+		mce.SetRoutineManager(NewRoutineManager(pu, epochgc))
+		mce.PrepareSessionBeforeExecRequest(ses)
+
+		proto.SetDatabaseName("test")
+		resp, err := mce.ExecRequest(&Request{cmd: int(COM_STMT_PREPARE), data: []byte("select spID, score from t1 where spID > ? and userID < ? limit ?")})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp, convey.ShouldBeNil)
+		stmt, ok := ses.GetPrepareStmts().get(1)
+		convey.So(ok, convey.ShouldBeTrue)
+		convey.So(stmt.numParams, convey.ShouldEqual, 3)
+		convey.So(len(stmt.columns), convey.ShouldEqual, 2)
+		convey.So(stmt.columns[0].(Column).Name(), convey.ShouldEqual, "spID")
+		convey.So(stmt.columns[1].(Column).Name(), convey.ShouldEqual, "score")
+
+		//the errors of binding are returned by COM_STMT_PREPARE
+		resp, err = mce.ExecRequest(&Request{cmd: int(COM_STMT_PREPARE), data: []byte("select a from t where a > ?")})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp.category, convey.ShouldEqual, ErrorResponse)
+
+		//multiple statements are not supported
+		resp, err = mce.ExecRequest(&Request{cmd: int(COM_STMT_PREPARE), data: []byte("select 1; select 2")})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp.category, convey.ShouldEqual, ErrorResponse)
+
+		resp, err = mce.ExecRequest(&Request{cmd: int(COM_STMT_SEND_LONG_DATA), data: []byte{0x01, 0x00, 0x00, 0x00, 0x01, 0x00, 'a', 'b'}})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp, convey.ShouldBeNil)
+		_, err = mce.ExecRequest(&Request{cmd: int(COM_STMT_SEND_LONG_DATA), data: []byte{0x01, 0x00, 0x00, 0x00, 0x01, 0x00, 'c'}})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(string(stmt.longData[1]), convey.ShouldEqual, "abc")
+
+		resp, err = mce.ExecRequest(&Request{cmd: int(COM_STMT_RESET), data: []byte{0x01, 0x00, 0x00, 0x00}})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp.category, convey.ShouldEqual, OkResponse)
+		convey.So(stmt.longData, convey.ShouldBeEmpty)
+
+		//unknown statement
+		resp, err = mce.ExecRequest(&Request{cmd: int(COM_STMT_EXECUTE), data: []byte{0x02, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00}})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp.category, convey.ShouldEqual, ErrorResponse)
+
+		resp, err = mce.ExecRequest(&Request{cmd: int(COM_STMT_CLOSE), data: []byte{0x01, 0x00, 0x00, 0x00}})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp, convey.ShouldBeNil)
+		_, ok = ses.GetPrepareStmts().get(1)
+		convey.So(ok, convey.ShouldBeFalse)
+	})
+}
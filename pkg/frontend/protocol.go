@@ -21,6 +21,7 @@ import (
 
 	"github.com/fagongzi/goetty"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
 )
 
 // Response Categories
@@ -249,6 +250,8 @@ func (mp *MysqlProtocolImpl) SendResponse(resp *Response) error {
 		switch myerr := err.(type) {
 		case *MysqlError:
 			return mp.sendErrPacket(myerr.ErrorCode, myerr.SqlState, myerr.Error())
+		case *privilege.Error:
+			return mp.sendErrPacket(myerr.Code, myerr.State, myerr.Msg)
 		}
 		return mp.sendErrPacket(ER_UNKNOWN_ERROR, DefaultMySQLState, fmt.Sprintf("unknown error:%v", err))
 	case ResultResponse:
@@ -17,6 +17,7 @@ package frontend
 import (
 	"github.com/matrixorigin/matrixone/pkg/config"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/txn"
 	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"sync"
@@ -46,6 +47,12 @@ type Routine struct {
 	onceCloseNotifyChan    sync.Once
 
 	routineMgr *RoutineManager
+
+	//the explicit transaction of the connection, it lasts across the requests
+	txn *txn.Txn
+
+	//the prepared statements of the connection
+	prepareStmts *PrepareStmts
 }
 
 func (routine *Routine) GetClientProtocol() Protocol {
@@ -75,6 +82,13 @@ func (routine *Routine) Loop() {
 	var err error
 	var resp *Response
 	defer routine.Quit()
+	defer func() {
+		//the transaction which is not committed is rolled back when the connection is closed
+		if routine.txn != nil {
+			routine.txn.Rollback()
+			routine.txn = nil
+		}
+	}()
 	for{
 		quit := false
 		select {
@@ -92,8 +106,13 @@ func (routine *Routine) Loop() {
 
 		mgr := routine.GetRoutineMgr()
 
-		routine.protocol.(*MysqlProtocolImpl).sequenceId = req.seq
+		if mp, ok := routine.protocol.(*MysqlProtocolImpl); ok {
+			mp.sequenceId = req.seq
+		}
 		ses := NewSession(routine.protocol,mgr.getEpochgc(),routine.guestMmu,routine.mempool,mgr.getParameterUnit())
+		ses.txn = routine.txn
+		ses.txns = mgr.getTxnManager()
+		ses.prepareStmts = routine.prepareStmts
 
 		routine.executor.PrepareSessionBeforeExecRequest(ses)
 
@@ -101,6 +120,8 @@ func (routine *Routine) Loop() {
 			logutil.Errorf("routine execute request failed. error:%v \n", err)
 		}
 
+		routine.txn = ses.txn
+
 		if resp != nil {
 			if err = routine.protocol.SendResponse(resp); err != nil {
 				logutil.Errorf("routine send response failed %v. error:%v ", resp, err)
@@ -146,6 +167,7 @@ func NewRoutine(protocol MysqlProtocol, executor CmdExecutor,pu *config.Paramete
 		notifyChan:  make(chan interface{}),
 		guestMmu:    guest.New(pu.SV.GetGuestMmuLimitation(), pu.HostMmu),
 		mempool:     pu.Mempool,
+		prepareStmts: NewPrepareStmts(),
 	}
 
 	//async process request
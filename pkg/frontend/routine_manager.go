@@ -19,6 +19,7 @@ import (
 	"github.com/fagongzi/goetty"
 	"github.com/matrixorigin/matrixone/pkg/config"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/txn"
 	"sync"
 )
 
@@ -30,12 +31,22 @@ type RoutineManager struct {
 	pdHook *PDCallbackImpl
 
 	pu *config.ParameterUnit
+
+	//detects the write conflicts between the transactions of clients
+	txns *txn.Manager
 }
 
 func (rm *RoutineManager) getEpochgc() *PDCallbackImpl {
 	return rm.pdHook
 }
 
+func (rm *RoutineManager) getTxnManager() *txn.Manager {
+	if rm == nil {
+		return nil
+	}
+	return rm.txns
+}
+
 func (rm *RoutineManager) getParameterUnit() *config.ParameterUnit {
 	return rm.pu
 }
@@ -47,6 +58,7 @@ func (rm *RoutineManager) Created(rs goetty.IOSession) {
 		}
 	}()
 	pro := NewMysqlClientProtocol(nextConnectionID(),rs, int(rm.pu.SV.GetMaxBytesInOutbufToFlush()),rm.pu.SV)
+	pro.SetPrivilegeManager(rm.pu.Privilege)
 	exe := NewMysqlCmdExecutor()
 	exe.SetRoutineManager(rm)
 
@@ -157,6 +169,11 @@ func (rm *RoutineManager) Handler(rs goetty.IOSession, msg interface{}, received
 		length = packet.Length
 	}
 
+	// the packets of LOAD DATA LOCAL INFILE go to the loader rather than the routine
+	if protocol.receiveLocalInfile(payload) {
+		return nil
+	}
+
 	// finish handshake process
 	if !protocol.IsEstablished() {
 		logutil.Infof("HANDLE HANDSHAKE")
@@ -187,6 +204,7 @@ func NewRoutineManager(pu *config.ParameterUnit, pdHook *PDCallbackImpl) *Routin
 
 		pdHook: pdHook,
 		pu:     pu,
+		txns:   txn.NewManager(),
 	}
 	return rm
 }
@@ -30,6 +30,10 @@ var initConnectionID uint32 = 1000
 type MOServer struct {
 	addr string
 	app  goetty.NetApplication
+
+	//the listener for the clients of postgresql protocol, nil if it is disabled
+	pgAddr string
+	pgApp  goetty.NetApplication
 }
 
 func (mo *MOServer) Start() error {
@@ -46,10 +50,21 @@ func (mo *MOServer) Start() error {
 	fmt.Printf("++++++++++++++++++++++++++++++++++++++++++++++++\n")
 	fmt.Printf("++++++++++++++++++++++++++++++++++++++++++++++++\n")
 	fmt.Printf("++++++++++++++++++++++++++++++++++++++++++++++++\n")
+	if mo.pgApp != nil {
+		logutil.Infof("server listening on %s for postgresql", mo.pgAddr)
+		if err := mo.pgApp.Start(); err != nil {
+			return err
+		}
+	}
 	return mo.app.Start()
 }
 
 func (mo *MOServer) Stop() error {
+	if mo.pgApp != nil {
+		if err := mo.pgApp.Stop(); err != nil {
+			return err
+		}
+	}
 	return mo.app.Stop()
 }
 
@@ -71,8 +86,25 @@ func NewMOServer(addr string, pu *config.ParameterUnit, pdHook *PDCallbackImpl)
 		logutil.Panicf("start server failed with %+v", err)
 	}
 
-	return &MOServer{
+	mo := &MOServer{
 		addr: addr,
 		app:  app,
 	}
+
+	if pgPort := pu.SV.GetPgPort(); pgPort != 0 {
+		mo.pgAddr = fmt.Sprintf("%s:%d", pu.SV.GetHost(), pgPort)
+		pgEncoder, pgDecoder := NewPgCodec()
+		prm := NewPgRoutineManager(rm)
+		mo.pgApp, err = goetty.NewTCPApplication(mo.pgAddr, prm.Handler,
+			goetty.WithAppSessionOptions(
+				goetty.WithCodec(pgEncoder, pgDecoder),
+				goetty.WithLogger(logutil.GetGlobalLogger()),
+				goetty.WithBufSize(1024*1024, 1024*1024)),
+			goetty.WithAppSessionAware(prm))
+		if err != nil {
+			logutil.Panicf("start postgresql server failed with %+v", err)
+		}
+	}
+
+	return mo
 }
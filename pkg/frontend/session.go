@@ -16,7 +16,10 @@ package frontend
 
 import (
 	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/txn"
 	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 )
@@ -37,13 +40,29 @@ type Session struct {
 	GuestMmu *guest.Mmu
 	Mempool  *mempool.Mempool
 
-	sessionVars config.SystemVariables
+	//the session and user variables
+	vars *SessionVariables
 
 	Pu *config.ParameterUnit
 
 	ep *tree.ExportParam
 
 	closeRef *CloseExportData
+
+	//the exporter of SELECT ... INTO OUTFILE with FORMAT PARQUET or JSONLINES
+	exporter *formatExporter
+
+	//the explicit transaction started by BEGIN, nil means autocommit
+	txn *txn.Txn
+
+	//orders the writes of the sessions, nil means no conflict detection
+	txns *txn.Manager
+
+	//the statements prepared by COM_STMT_PREPARE in the connection
+	prepareStmts *PrepareStmts
+
+	//the dialect of the sql from the client
+	dialect dialect.DialectType
 }
 
 func NewSession(proto Protocol,pdHook *PDCallbackImpl,
@@ -54,6 +73,7 @@ func NewSession(proto Protocol,pdHook *PDCallbackImpl,
 		GuestMmu: gm,
 		Mempool: mp,
 		Pu: PU,
+		dialect: dialect.MYSQL,
 		ep: &tree.ExportParam{
 			Outfile: false,
 			Fields: &tree.Fields{},
@@ -64,4 +84,71 @@ func NewSession(proto Protocol,pdHook *PDCallbackImpl,
 
 func (ses *Session) GetEpochgc() *PDCallbackImpl {
 	return ses.pdHook
-}
\ No newline at end of file
+}
+
+// GetSessionVars returns the variables of the session.
+func (ses *Session) GetSessionVars() *SessionVariables {
+	if ses.vars == nil {
+		ses.vars = NewSessionVariables(ses.Pu.SV)
+	}
+	return ses.vars
+}
+
+// GetPrepareStmts returns the prepared statements of the session.
+func (ses *Session) GetPrepareStmts() *PrepareStmts {
+	if ses.prepareStmts == nil {
+		ses.prepareStmts = NewPrepareStmts()
+	}
+	return ses.prepareStmts
+}
+
+// InActiveTransaction returns true if there is an explicit transaction in the session.
+func (ses *Session) InActiveTransaction() bool {
+	return ses.txn != nil
+}
+
+// ServerStatus returns the status flags sent to the client in OK and EOF packets.
+func (ses *Session) ServerStatus() uint16 {
+	var status uint16
+	if ses.InActiveTransaction() {
+		status |= SERVER_STATUS_IN_TRANS
+	}
+	return status
+}
+
+// GetStorage returns the storage engine of session, the statements read and write
+// the transaction of session if it exists when they are executed.
+func (ses *Session) GetStorage() engine.Engine {
+	return &sessionStorage{ses: ses}
+}
+
+type sessionStorage struct {
+	ses *Session
+}
+
+func (s *sessionStorage) engine() engine.Engine {
+	if s.ses.txn != nil {
+		return s.ses.txn.Engine()
+	}
+	return s.ses.Pu.StorageEngine
+}
+
+func (s *sessionStorage) Delete(epoch uint64, name string) error {
+	return s.engine().Delete(epoch, name)
+}
+
+func (s *sessionStorage) Create(epoch uint64, name string, typ int) error {
+	return s.engine().Create(epoch, name, typ)
+}
+
+func (s *sessionStorage) Databases() []string {
+	return s.engine().Databases()
+}
+
+func (s *sessionStorage) Database(name string) (engine.Database, error) {
+	return s.engine().Database(name)
+}
+
+func (s *sessionStorage) Node(ip string) *engine.NodeInfo {
+	return s.engine().Node(ip)
+}
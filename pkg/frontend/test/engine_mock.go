@@ -191,6 +191,34 @@ func (mr *MockRelationMockRecorder) DropIndex(epoch, name interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DropIndex", reflect.TypeOf((*MockRelation)(nil).DropIndex), epoch, name)
 }
 
+// GetHideKey mocks base method.
+func (m *MockRelation) GetHideKey() *engine.Attribute {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHideKey")
+	ret0, _ := ret[0].(*engine.Attribute)
+	return ret0
+}
+
+// GetHideKey indicates an expected call of GetHideKey.
+func (mr *MockRelationMockRecorder) GetHideKey() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHideKey", reflect.TypeOf((*MockRelation)(nil).GetHideKey))
+}
+
+// GetPrimaryKeys mocks base method.
+func (m *MockRelation) GetPrimaryKeys() []*engine.Attribute {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPrimaryKeys")
+	ret0, _ := ret[0].([]*engine.Attribute)
+	return ret0
+}
+
+// GetPrimaryKeys indicates an expected call of GetPrimaryKeys.
+func (mr *MockRelationMockRecorder) GetPrimaryKeys() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrimaryKeys", reflect.TypeOf((*MockRelation)(nil).GetPrimaryKeys))
+}
+
 // ID mocks base method.
 func (m *MockRelation) ID() string {
 	m.ctrl.T.Helper()
@@ -40,6 +40,20 @@ func (m *MockComputationWrapper) EXPECT() *MockComputationWrapperMockRecorder {
 	return m.recorder
 }
 
+// Bind mocks base method.
+func (m *MockComputationWrapper) Bind() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Bind")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Bind indicates an expected call of Bind.
+func (mr *MockComputationWrapperMockRecorder) Bind() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bind", reflect.TypeOf((*MockComputationWrapper)(nil).Bind))
+}
+
 // Compile mocks base method.
 func (m *MockComputationWrapper) Compile(u interface{}, fill func(interface{}, *batch.Batch) error) error {
 	m.ctrl.T.Helper()
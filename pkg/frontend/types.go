@@ -15,6 +15,8 @@ type ComputationWrapper interface {
 
 	GetAffectedRows() uint64
 
+	Bind() error
+
 	Compile(u interface{},
 		fill func(interface{}, *batch.Batch) error) error
 
@@ -0,0 +1,492 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"fmt"
+	"go/constant"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// mysqlVariables are the system variables of MySQL which are read and set by the
+// clients and connectors, the values here are the defaults. They are kept for
+// compatibility and take no effect on the server except autocommit.
+var mysqlVariables = map[string]interface{}{
+	"autocommit":               int64(1),
+	"character_set_client":     "utf8mb4",
+	"character_set_connection": "utf8mb4",
+	"character_set_results":    "utf8mb4",
+	"character_set_server":     "utf8mb4",
+	"collation_connection":     "utf8mb4_general_ci",
+	"collation_server":         "utf8mb4_general_ci",
+	"interactive_timeout":      int64(28800),
+	"lower_case_table_names":   int64(0),
+	"max_allowed_packet":       int64(16777216),
+	"net_write_timeout":        int64(60),
+	"sql_mode":                 "ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES,NO_ZERO_IN_DATE,NO_ZERO_DATE,ERROR_FOR_DIVISION_BY_ZERO,NO_ENGINE_SUBSTITUTION",
+	"sql_select_limit":         uint64(math.MaxUint64),
+	"system_time_zone":         "UTC",
+	"time_zone":                "SYSTEM",
+	"transaction_isolation":    "REPEATABLE-READ",
+	"tx_isolation":             "REPEATABLE-READ",
+	"version_comment":          "MatrixOne",
+	"wait_timeout":             int64(28800),
+}
+
+// mysqlGlobals keeps the global values of mysqlVariables set by SET GLOBAL, there is
+// a map for every set of global variables, that is for every server.
+var mysqlGlobals sync.Map
+
+// secretVariables are the system variables holding credentials, they are read from
+// the configuration file only and are invisible to the clients.
+var secretVariables = map[string]bool{
+	"rootpassword": true,
+	"dumpuser":     true,
+	"dumppassword": true,
+}
+
+// names of SET NAMES and SET CHARACTER SET, they are accepted and ignored.
+var charsetAssignments = []string{"names", "charset", "character", "char"}
+
+func isCharsetAssignment(name string) bool {
+	for _, n := range charsetAssignments {
+		if strings.EqualFold(n, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// isSelectVariables checks if the statement only selects variables, like SELECT @@x, @y.
+// The parser fills the statement without FROM clause with the table dual.
+func isSelectVariables(sc *tree.SelectClause) bool {
+	if sc.From == nil || len(sc.From.Tables) != 1 || sc.Where != nil {
+		return false
+	}
+	if t, ok := sc.From.Tables[0].(*tree.AliasedTableExpr); !ok {
+		return false
+	} else if tn, ok := t.Expr.(*tree.TableName); !ok || tn.Schema() != "" || strings.ToLower(string(tn.Name())) != "dual" {
+		return false
+	}
+	for _, expr := range sc.Exprs {
+		if _, ok := expr.Expr.(*tree.VarExpr); !ok {
+			return false
+		}
+	}
+	return len(sc.Exprs) > 0
+}
+
+var (
+	defaultVariablesOnce sync.Once
+	defaultVariables     config.SystemVariables
+)
+
+// getDefaultVariable returns the initial value of the system variable.
+func getDefaultVariable(name string) (interface{}, error) {
+	defaultVariablesOnce.Do(func() {
+		if err := defaultVariables.LoadInitialValues(); err != nil {
+			panic(err)
+		}
+	})
+	return defaultVariables.GetParameter(name)
+}
+
+// SessionVariables are the variables of a session. The system variables set in the
+// session hide the global ones, the others are read from the global variables
+// shared by all sessions. User variables live in the session only.
+type SessionVariables struct {
+	global *config.SystemVariables
+	// the system variables set in session scope
+	system map[string]interface{}
+	// the user variables, the key is the lower case name
+	user map[string]interface{}
+}
+
+func NewSessionVariables(global *config.SystemVariables) *SessionVariables {
+	return &SessionVariables{
+		global: global,
+		system: make(map[string]interface{}),
+		user:   make(map[string]interface{}),
+	}
+}
+
+// lookupSystem returns the defined name of the system variable, the name is case insensitive.
+// The secret variables are not found.
+func (vars *SessionVariables) lookupSystem(name string) (string, bool) {
+	if secretVariables[strings.ToLower(name)] {
+		return "", false
+	}
+	for _, n := range vars.global.GetParameterNames() {
+		if strings.EqualFold(n, name) {
+			return n, true
+		}
+	}
+	if _, ok := mysqlVariables[strings.ToLower(name)]; ok {
+		return strings.ToLower(name), true
+	}
+	return "", false
+}
+
+// hasScope checks if the system variable can be set in the scope.
+func (vars *SessionVariables) hasScope(name string, scope string) bool {
+	if _, ok := mysqlVariables[name]; ok {
+		return true
+	}
+	scopes, err := vars.global.GetParameterScope(name)
+	if err != nil {
+		return false
+	}
+	for _, s := range scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// SystemNames returns the sorted names of all system variables except the secret ones.
+func (vars *SessionVariables) SystemNames() []string {
+	var names []string
+	for _, name := range vars.global.GetParameterNames() {
+		if !secretVariables[strings.ToLower(name)] {
+			names = append(names, name)
+		}
+	}
+	for name := range mysqlVariables {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return strings.ToLower(names[i]) < strings.ToLower(names[j])
+	})
+	return names
+}
+
+// GetSystem returns the value of the system variable. The global value is returned
+// if global is true or the variable is not set in the session.
+func (vars *SessionVariables) GetSystem(name string, global bool) (interface{}, error) {
+	n, ok := vars.lookupSystem(name)
+	if !ok {
+		return nil, NewMysqlError(ER_UNKNOWN_SYSTEM_VARIABLE, name)
+	}
+	if v, ok := vars.system[n]; ok && !global {
+		return v, nil
+	}
+	if v, ok := mysqlVariables[n]; ok {
+		if gv, ok := vars.mysqlGlobal().Load(n); ok {
+			return gv, nil
+		}
+		return v, nil
+	}
+	return vars.global.GetParameter(n)
+}
+
+// mysqlGlobal returns the global values of mysqlVariables shared with the other sessions.
+func (vars *SessionVariables) mysqlGlobal() *sync.Map {
+	m, _ := mysqlGlobals.LoadOrStore(vars.global, &sync.Map{})
+	return m.(*sync.Map)
+}
+
+// Autocommit returns false if the statements of session run in a transaction
+// started implicitly, that is SET autocommit = 0.
+func (vars *SessionVariables) Autocommit() bool {
+	v, err := vars.GetSystem("autocommit", false)
+	return err != nil || v != int64(0)
+}
+
+// SetSystem sets the value of the system variable in the session, or in all sessions
+// if global is true. The value is converted to the type of the variable and checked.
+func (vars *SessionVariables) SetSystem(name string, value interface{}, global bool) error {
+	n, ok := vars.lookupSystem(name)
+	if !ok {
+		return NewMysqlError(ER_UNKNOWN_SYSTEM_VARIABLE, name)
+	}
+	if _, ok := mysqlVariables[n]; ok {
+		if n == "autocommit" {
+			b, ok := convertSystemValue("bool", value)
+			if !ok {
+				return NewMysqlError(ER_WRONG_VALUE_FOR_VAR, name, variableString(value))
+			}
+			value = b
+		}
+		if b, ok := value.(bool); ok {
+			value = boolToInt64(b)
+		}
+		if global {
+			vars.mysqlGlobal().Store(n, value)
+			return nil
+		}
+		vars.system[n] = value
+		return nil
+	}
+	if mode, _ := vars.global.GetParameterUpdateMode(n); mode == "fix" {
+		return NewMysqlError(ER_INCORRECT_GLOBAL_LOCAL_VAR, name, "read only")
+	}
+	typ, err := vars.global.GetParameterDataType(n)
+	if err != nil {
+		return err
+	}
+	v, ok := convertSystemValue(typ, value)
+	if !ok {
+		return NewMysqlError(ER_WRONG_TYPE_FOR_VAR, name)
+	}
+	if global {
+		if !vars.hasScope(n, "global") {
+			return NewMysqlError(ER_INCORRECT_GLOBAL_LOCAL_VAR, name, "SESSION")
+		}
+		if err = vars.global.SetParameter(n, v); err != nil {
+			return NewMysqlError(ER_WRONG_VALUE_FOR_VAR, name, variableString(value))
+		}
+		return nil
+	}
+	if !vars.hasScope(n, "session") {
+		return NewMysqlError(ER_GLOBAL_VARIABLE, name)
+	}
+	if err = vars.global.CheckParameter(n, v); err != nil {
+		return NewMysqlError(ER_WRONG_VALUE_FOR_VAR, name, variableString(value))
+	}
+	vars.system[n] = v
+	return nil
+}
+
+// ResetSystem restores the system variable to the default, that is SET var = DEFAULT.
+// The session value is removed, the global value is set to the initial value.
+func (vars *SessionVariables) ResetSystem(name string, global bool) error {
+	n, ok := vars.lookupSystem(name)
+	if !ok {
+		return NewMysqlError(ER_UNKNOWN_SYSTEM_VARIABLE, name)
+	}
+	if !global {
+		delete(vars.system, n)
+		return nil
+	}
+	if _, ok := mysqlVariables[n]; ok {
+		vars.mysqlGlobal().Delete(n)
+		return nil
+	}
+	v, err := getDefaultVariable(n)
+	if err != nil {
+		return err
+	}
+	return vars.SetSystem(n, v, true)
+}
+
+// GetUser returns the value of the user variable, nil is returned if it is not set.
+func (vars *SessionVariables) GetUser(name string) interface{} {
+	return vars.user[strings.ToLower(name)]
+}
+
+// SetUser sets the value of the user variable, nil value means NULL.
+func (vars *SessionVariables) SetUser(name string, value interface{}) {
+	if b, ok := value.(bool); ok {
+		value = boolToInt64(b)
+	}
+	vars.user[strings.ToLower(name)] = value
+}
+
+// Get returns the value of the variable in the expression.
+func (vars *SessionVariables) Get(e *tree.VarExpr) (interface{}, error) {
+	if !e.System {
+		return vars.GetUser(e.Name), nil
+	}
+	return vars.GetSystem(e.Name, e.Global)
+}
+
+// Resolve returns the value of the variable as a constant expression, it is used
+// to bind the variables in the statement.
+func (vars *SessionVariables) Resolve(e *tree.VarExpr) (tree.Expr, error) {
+	v, err := vars.Get(e)
+	if err != nil {
+		return nil, err
+	}
+	switch v := v.(type) {
+	case nil:
+		return tree.NewNumVal(constant.MakeUnknown(), "NULL", false), nil
+	case bool:
+		return makeIntParam(boolToInt64(v)), nil
+	case int64:
+		return makeIntParam(v), nil
+	case uint64:
+		return tree.NewNumVal(constant.MakeUint64(v), strconv.FormatUint(v, 10), false), nil
+	case float64:
+		return makeFloatParam(v), nil
+	case string:
+		return makeStringParam(v), nil
+	}
+	return nil, fmt.Errorf("unsupported value %v of variable %s", v, e.Name)
+}
+
+// Eval returns the value of the constant expression assigned to the variable.
+func (vars *SessionVariables) Eval(expr tree.Expr) (interface{}, error) {
+	switch e := expr.(type) {
+	case *tree.NumVal:
+		switch e.Value.Kind() {
+		case constant.Unknown:
+			return nil, nil
+		case constant.Bool:
+			return constant.BoolVal(e.Value), nil
+		case constant.String:
+			return constant.StringVal(e.Value), nil
+		case constant.Int:
+			if v, ok := constant.Int64Val(e.Value); ok {
+				return v, nil
+			}
+			if v, ok := constant.Uint64Val(e.Value); ok {
+				return v, nil
+			}
+		case constant.Float:
+			v, _ := constant.Float64Val(e.Value)
+			return v, nil
+		}
+	case *tree.ParenExpr:
+		return vars.Eval(e.Expr)
+	case *tree.UnaryExpr:
+		v, err := vars.Eval(e.Expr)
+		if err != nil {
+			return nil, err
+		}
+		switch e.Op {
+		case tree.UNARY_PLUS:
+			return v, nil
+		case tree.UNARY_MINUS:
+			switch v := v.(type) {
+			case int64:
+				return -v, nil
+			case uint64:
+				if v <= math.MaxInt64+1 {
+					return -int64(v), nil
+				}
+			case float64:
+				return -v, nil
+			}
+		}
+	case *tree.VarExpr:
+		return vars.Get(e)
+	}
+	return nil, fmt.Errorf("unsupported value '%s' of variable", tree.String(expr, dialect.MYSQL))
+}
+
+// GetCountOfRowsPerSendingToClient returns the count of rows sent to the client in a group.
+func (vars *SessionVariables) GetCountOfRowsPerSendingToClient() int64 {
+	if v, ok := vars.system["countOfRowsPerSendingToClient"]; ok {
+		return v.(int64)
+	}
+	return vars.global.GetCountOfRowsPerSendingToClient()
+}
+
+// GetBatchSizeInLoadData returns the count of rows in a batch of load data.
+func (vars *SessionVariables) GetBatchSizeInLoadData() int64 {
+	if v, ok := vars.system["batchSizeInLoadData"]; ok {
+		return v.(int64)
+	}
+	return vars.global.GetBatchSizeInLoadData()
+}
+
+// GetLoadDataConcurrencyCount returns the count of routines writing batches in load data.
+func (vars *SessionVariables) GetLoadDataConcurrencyCount() int64 {
+	if v, ok := vars.system["loadDataConcurrencyCount"]; ok {
+		return v.(int64)
+	}
+	return vars.global.GetLoadDataConcurrencyCount()
+}
+
+// GetLoadDataSkipWritingBatch returns true if load data skips writing batches into the storage.
+func (vars *SessionVariables) GetLoadDataSkipWritingBatch() bool {
+	if v, ok := vars.system["loadDataSkipWritingBatch"]; ok {
+		return v.(bool)
+	}
+	return vars.global.GetLoadDataSkipWritingBatch()
+}
+
+// convertSystemValue converts the value to the data type of the system variable.
+func convertSystemValue(typ string, value interface{}) (interface{}, bool) {
+	switch typ {
+	case "int64":
+		switch v := value.(type) {
+		case int64:
+			return v, true
+		case uint64:
+			if v <= math.MaxInt64 {
+				return int64(v), true
+			}
+		case bool:
+			return boolToInt64(v), true
+		}
+	case "float64":
+		switch v := value.(type) {
+		case int64:
+			return float64(v), true
+		case uint64:
+			return float64(v), true
+		case float64:
+			return v, true
+		}
+	case "bool":
+		switch v := value.(type) {
+		case bool:
+			return v, true
+		case int64:
+			if v == 0 || v == 1 {
+				return v == 1, true
+			}
+		case uint64:
+			if v == 0 || v == 1 {
+				return v == 1, true
+			}
+		case string:
+			switch strings.ToLower(v) {
+			case "on", "true":
+				return true, true
+			case "off", "false":
+				return false, true
+			}
+		}
+	case "string":
+		if v, ok := value.(string); ok {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+// variableString returns the string of the value shown to the client.
+func variableString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "NULL"
+	case bool:
+		if v {
+			return "ON"
+		}
+		return "OFF"
+	case string:
+		return v
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+func boolToInt64(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
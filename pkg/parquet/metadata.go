@@ -0,0 +1,284 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"fmt"
+)
+
+// chunkMeta is the metadata of a column chunk.
+type chunkMeta struct {
+	typ            int32
+	encodings      []int32
+	path           string
+	codec          int32
+	numValues      int64
+	uncompressed   int64
+	compressed     int64
+	dataPageOffset int64
+	//0 if the chunk has no dictionary page
+	dictPageOffset int64
+}
+
+type rowGroupMeta struct {
+	chunks   []chunkMeta
+	byteSize int64
+	numRows  int64
+}
+
+// fileMeta is the footer of a parquet file.
+type fileMeta struct {
+	elements  []element
+	numRows   int64
+	rowGroups []rowGroupMeta
+}
+
+// pageHeader is the header of a page in a column chunk.
+type pageHeader struct {
+	typ          int32
+	uncompressed int32
+	compressed   int32
+	numValues    int32
+	numNulls     int32
+	encoding     int32
+	//the sizes of the levels of data page v2, which are not compressed
+	defLength  int32
+	repLength  int32
+	compressV2 bool
+}
+
+func (fm *fileMeta) encode() []byte {
+	w := newThriftWriter()
+	w.i32(1, 1)
+	w.list(2, thriftStruct, len(fm.elements)+1)
+	w.begin()
+	w.binary(4, []byte("schema"))
+	w.i32(5, int32(len(fm.elements)))
+	w.end()
+	for _, e := range fm.elements {
+		w.begin()
+		e.encode(w)
+		w.end()
+	}
+	w.i64(3, fm.numRows)
+	w.list(4, thriftStruct, len(fm.rowGroups))
+	for _, rg := range fm.rowGroups {
+		w.begin()
+		w.list(1, thriftStruct, len(rg.chunks))
+		for _, c := range rg.chunks {
+			w.begin()
+			w.i64(2, c.dataPageOffset)
+			w.structField(3)
+			c.encode(w)
+			w.end()
+			w.end()
+		}
+		w.i64(2, rg.byteSize)
+		w.i64(3, rg.numRows)
+		w.end()
+	}
+	w.binary(6, []byte("matrixone"))
+	w.end()
+	return w.buf
+}
+
+func (e element) encode(w *thriftWriter) {
+	w.i32(1, e.typ)
+	if e.typ == typeFixedLenByteArray {
+		w.i32(2, e.typeLength)
+	}
+	if e.optional {
+		w.i32(3, repetitionOptional)
+	} else {
+		w.i32(3, repetitionRequired)
+	}
+	w.binary(4, []byte(e.name))
+	if e.converted >= 0 {
+		w.i32(6, e.converted)
+	}
+	if e.isDecimal() {
+		w.i32(7, e.scale)
+		w.i32(8, e.precision)
+	}
+	if e.logical == 0 {
+		return
+	}
+	w.structField(10)
+	w.structField(int16(e.logical))
+	switch e.logical {
+	case logicalDecimal:
+		w.i32(1, e.scale)
+		w.i32(2, e.precision)
+	case logicalTimestamp:
+		w.bool(1, e.utc)
+		w.structField(2)
+		w.structField(int16(e.unit))
+		w.end()
+		w.end()
+	case logicalInteger:
+		w.byte(1, int8(e.bitWidth))
+		w.bool(2, e.signed)
+	}
+	w.end()
+	w.end()
+}
+
+func (c chunkMeta) encode(w *thriftWriter) {
+	w.i32(1, c.typ)
+	w.list(2, thriftI32, len(c.encodings))
+	for _, enc := range c.encodings {
+		w.zigzag(int64(enc))
+	}
+	w.list(3, thriftBinary, 1)
+	w.bytes([]byte(c.path))
+	w.i32(4, c.codec)
+	w.i64(5, c.numValues)
+	w.i64(6, c.uncompressed)
+	w.i64(7, c.compressed)
+	w.i64(9, c.dataPageOffset)
+	if c.dictPageOffset != 0 {
+		w.i64(11, c.dictPageOffset)
+	}
+}
+
+func (h *pageHeader) encode() []byte {
+	w := newThriftWriter()
+	w.i32(1, h.typ)
+	w.i32(2, h.uncompressed)
+	w.i32(3, h.compressed)
+	w.structField(5)
+	w.i32(1, h.numValues)
+	w.i32(2, h.encoding)
+	w.i32(3, encodingRLE)
+	w.i32(4, encodingRLE)
+	w.end()
+	w.end()
+	return w.buf
+}
+
+func decodeFileMeta(buf []byte) (*fileMeta, error) {
+	r := &thriftReader{buf: buf}
+	fs, err := r.readStruct()
+	if err != nil {
+		return nil, err
+	}
+	fm := &fileMeta{numRows: fs.int(3)}
+	schema := fs.list(2)
+	if len(schema) == 0 {
+		return nil, errThrift
+	}
+	root, _ := schema[0].(thriftFields)
+	if int(root.int(5)) != len(schema)-1 {
+		return nil, fmt.Errorf("nested columns in parquet file are not supported")
+	}
+	for _, v := range schema[1:] {
+		s, _ := v.(thriftFields)
+		if s == nil {
+			return nil, errThrift
+		}
+		e := element{
+			name:       string(s.binary(4)),
+			typ:        int32(s.int(1)),
+			typeLength: int32(s.int(2)),
+			optional:   s.int(3) == repetitionOptional,
+			converted:  -1,
+			scale:      int32(s.int(7)),
+			precision:  int32(s.int(8)),
+		}
+		if s.int(5) != 0 || s.int(3) > repetitionOptional {
+			return nil, fmt.Errorf("nested column %s in parquet file is not supported", e.name)
+		}
+		if s.has(6) {
+			e.converted = int32(s.int(6))
+		}
+		if lt := s.fields(10); lt != nil {
+			for id, v := range lt {
+				t, _ := v.(thriftFields)
+				e.logical = int32(id)
+				switch id {
+				case logicalDecimal:
+					e.scale, e.precision = int32(t.int(1)), int32(t.int(2))
+				case logicalTimestamp:
+					e.utc = t.bool(1)
+					for unit := range t.fields(2) {
+						e.unit = int32(unit)
+					}
+				case logicalInteger:
+					e.bitWidth, e.signed = int32(t.int(1)), t.bool(2)
+				}
+			}
+		}
+		fm.elements = append(fm.elements, e)
+	}
+	for _, v := range fs.list(4) {
+		g, _ := v.(thriftFields)
+		if g == nil {
+			return nil, errThrift
+		}
+		rg := rowGroupMeta{byteSize: g.int(2), numRows: g.int(3)}
+		for _, v := range g.list(1) {
+			c, _ := v.(thriftFields)
+			m := c.fields(3)
+			if m == nil {
+				return nil, fmt.Errorf("column chunks in other files are not supported")
+			}
+			rg.chunks = append(rg.chunks, chunkMeta{
+				typ:            int32(m.int(1)),
+				codec:          int32(m.int(4)),
+				numValues:      m.int(5),
+				uncompressed:   m.int(6),
+				compressed:     m.int(7),
+				dataPageOffset: m.int(9),
+				dictPageOffset: m.int(11),
+			})
+		}
+		if len(rg.chunks) != len(fm.elements) {
+			return nil, errThrift
+		}
+		fm.rowGroups = append(fm.rowGroups, rg)
+	}
+	return fm, nil
+}
+
+// decodePageHeader returns the header at the beginning of buf and its size.
+func decodePageHeader(buf []byte) (*pageHeader, int, error) {
+	r := &thriftReader{buf: buf}
+	fs, err := r.readStruct()
+	if err != nil {
+		return nil, 0, err
+	}
+	h := &pageHeader{
+		typ:          int32(fs.int(1)),
+		uncompressed: int32(fs.int(2)),
+		compressed:   int32(fs.int(3)),
+	}
+	switch h.typ {
+	case pageData:
+		d := fs.fields(5)
+		h.numValues, h.encoding = int32(d.int(1)), int32(d.int(2))
+	case pageDictionary:
+		d := fs.fields(7)
+		h.numValues, h.encoding = int32(d.int(1)), int32(d.int(2))
+	case pageDataV2:
+		d := fs.fields(8)
+		h.numValues, h.numNulls, h.encoding = int32(d.int(1)), int32(d.int(2)), int32(d.int(4))
+		h.defLength, h.repLength = int32(d.int(5)), int32(d.int(6))
+		h.compressV2 = !d.has(7) || d.bool(7)
+	}
+	if h.compressed < 0 || h.uncompressed < 0 {
+		return nil, 0, errThrift
+	}
+	return h, r.pos, nil
+}
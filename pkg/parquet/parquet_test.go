@@ -0,0 +1,171 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoundTrip(t *testing.T) {
+	dt, err := types.ParseDatetime("2021-12-31 23:59:58.123456")
+	require.NoError(t, err)
+	d, err := types.ParseDate("1969-07-20")
+	require.NoError(t, err)
+	dec, err := types.ParseDecimal("-12.34", 10, 2)
+	require.NoError(t, err)
+
+	cols := []Column{
+		{Name: "a", Type: types.Type{Oid: types.T_int8, Size: 1}},
+		{Name: "b", Type: types.Type{Oid: types.T_uint32, Size: 4}},
+		{Name: "c", Type: types.Type{Oid: types.T_int64, Size: 8}},
+		{Name: "d", Type: types.Type{Oid: types.T_float64, Size: 8}},
+		{Name: "e", Type: types.DecimalType(10, 2)},
+		{Name: "f", Type: types.Type{Oid: types.T_date, Size: 4}},
+		{Name: "g", Type: types.Type{Oid: types.T_datetime, Size: 8}},
+		{Name: "h", Type: types.Type{Oid: types.T_varchar, Size: 24}},
+	}
+	vecs := make([]*vector.Vector, len(cols))
+	for i, col := range cols {
+		vecs[i] = vector.New(col.Type)
+	}
+	vecs[0].Col = []int8{-1, 0, 127}
+	vecs[1].Col = []uint32{1 << 31, 0, 7}
+	vecs[2].Col = []int64{-1 << 40, 0, 1}
+	vecs[3].Col = []float64{1.5, 0, -2.25}
+	vecs[4].Col = []types.Decimal{dec, types.NewDecimal(0), types.NewDecimal(1)}
+	vecs[5].Col = []types.Date{d, 0, d + 1}
+	vecs[6].Col = []types.Datetime{dt, 0, dt}
+	vecs[7].Col = &types.Bytes{Data: []byte("xyz"), Offsets: []uint32{0, 1, 1}, Lengths: []uint32{1, 0, 2}}
+	for _, vec := range vecs {
+		nulls.Add(vec.Nsp, 1)
+	}
+
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, cols)
+	require.NoError(t, err)
+	rg, err := w.Encode(vecs, nil)
+	require.NoError(t, err)
+	size := w.Size(rg)
+	require.NoError(t, w.Write(rg))
+	//only the last row
+	rg, err = w.Encode(vecs, []int64{2})
+	require.NoError(t, err)
+	require.NoError(t, w.Write(rg))
+	require.Equal(t, int64(4), w.Rows())
+	require.NoError(t, w.Close())
+	require.Greater(t, int64(buf.Len()), size)
+
+	r, err := NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	fcols, err := r.Columns()
+	require.NoError(t, err)
+	require.Equal(t, cols, fcols)
+	require.Equal(t, 2, r.RowGroups())
+	require.Equal(t, int64(3), r.Rows(0))
+	require.Equal(t, int64(1), r.Rows(1))
+	for i, col := range cols {
+		vec, err := r.Read(0, i, col.Type)
+		require.NoError(t, err)
+		require.True(t, nulls.Contains(vec.Nsp, 1))
+		require.False(t, nulls.Contains(vec.Nsp, 0))
+		require.Equal(t, vecs[i].String(), vec.String(), col.Name)
+		vec, err = r.Read(1, i, col.Type)
+		require.NoError(t, err)
+		require.False(t, nulls.Any(vec.Nsp))
+	}
+
+	//conversions
+	vec, err := r.Read(0, 2, types.Type{Oid: types.T_varchar, Size: 24})
+	require.NoError(t, err)
+	require.Equal(t, "-1099511627776", string(vec.Col.(*types.Bytes).Get(0)))
+	vec, err = r.Read(0, 4, types.Type{Oid: types.T_float64, Size: 8})
+	require.NoError(t, err)
+	require.Equal(t, -12.34, vec.Col.([]float64)[0])
+	vec, err = r.Read(0, 6, types.Type{Oid: types.T_date, Size: 4})
+	require.NoError(t, err)
+	require.Equal(t, dt.ToDate(), vec.Col.([]types.Date)[0])
+	_, err = r.Read(0, 0, types.Type{Oid: types.T_date, Size: 4})
+	require.Error(t, err)
+	_, err = r.Read(0, 1, types.Type{Oid: types.T_int8, Size: 1})
+	require.Error(t, err)
+}
+
+func TestDictionary(t *testing.T) {
+	e := element{name: "s", typ: typeByteArray, optional: true, converted: convertedUTF8}
+
+	var dict []byte
+	for _, s := range []string{"foo", "bar"} {
+		dict = appendUint32(dict, uint32(len(s)))
+		dict = append(dict, s...)
+	}
+	compressed := snappy.Encode(nil, dict)
+	dh := &pageHeader{typ: pageDictionary, uncompressed: int32(len(dict)), compressed: int32(len(compressed))}
+	chunk := dictPageHeader(dh, 2)
+	chunk = append(chunk, compressed...)
+
+	//5 rows: foo, null, bar, bar, bar, the indexes are in a rle run and a bit-packed run
+	levels := []byte{1<<1 | 1, 0x1d}
+	data := []byte{2, 0, 0, 0}
+	binary.LittleEndian.PutUint32(data, uint32(len(levels)))
+	data = append(data, levels...)
+	data = append(data, 1)         // width 1
+	data = append(data, 1<<1, 0)   // foo
+	data = append(data, 1<<1|1, 7) // bar, bar, bar
+	compressed = snappy.Encode(nil, data)
+	h := &pageHeader{
+		typ:          pageData,
+		uncompressed: int32(len(data)),
+		compressed:   int32(len(compressed)),
+		numValues:    5,
+		encoding:     encodingRLEDictionary,
+	}
+	chunk = append(chunk, h.encode()...)
+	chunk = append(chunk, compressed...)
+
+	c := chunkMeta{codec: codecSnappy, numValues: 5}
+	vs, present, err := readChunk(e, c, chunk)
+	require.NoError(t, err)
+	require.Equal(t, []bool{true, false, true, true, true}, present)
+	vec, err := convert(e, vs, present, 5, types.Type{Oid: types.T_varchar, Size: 24})
+	require.NoError(t, err)
+	bs := vec.Col.(*types.Bytes)
+	require.Equal(t, "foo", string(bs.Get(0)))
+	require.True(t, nulls.Contains(vec.Nsp, 1))
+	for i := int64(2); i < 5; i++ {
+		require.Equal(t, "bar", string(bs.Get(i)))
+	}
+}
+
+// dictPageHeader encodes the header of a dictionary page which has n values.
+func dictPageHeader(h *pageHeader, n int32) []byte {
+	w := newThriftWriter()
+	w.i32(1, h.typ)
+	w.i32(2, h.uncompressed)
+	w.i32(3, h.compressed)
+	w.structField(7)
+	w.i32(1, n)
+	w.i32(2, encodingPlain)
+	w.end()
+	w.end()
+	return w.buf
+}
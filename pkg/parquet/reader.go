@@ -0,0 +1,642 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"sync"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+var errCorrupt = errors.New("corrupted parquet file")
+
+var (
+	zstdOnce    sync.Once
+	zstdDecoder *zstd.Decoder
+	zstdErr     error
+)
+
+// Reader reads the column chunks of a parquet file into vectors.
+type Reader struct {
+	r    io.ReaderAt
+	meta *fileMeta
+}
+
+func NewReader(r io.ReaderAt, size int64) (*Reader, error) {
+	if size < int64(2*len(magic)+4) {
+		return nil, errors.New("not a parquet file")
+	}
+	var tail [8]byte
+	if _, err := r.ReadAt(tail[:], size-8); err != nil {
+		return nil, err
+	}
+	if string(tail[4:]) != magic {
+		return nil, errors.New("not a parquet file")
+	}
+	n := int64(binary.LittleEndian.Uint32(tail[:]))
+	if n > size-8-int64(len(magic)) {
+		return nil, errCorrupt
+	}
+	footer := make([]byte, n)
+	if _, err := r.ReadAt(footer, size-8-n); err != nil {
+		return nil, err
+	}
+	meta, err := decodeFileMeta(footer)
+	if err != nil {
+		return nil, err
+	}
+	return &Reader{r: r, meta: meta}, nil
+}
+
+// Columns returns the columns of the file with the types they are read as by default.
+func (r *Reader) Columns() ([]Column, error) {
+	cols := make([]Column, len(r.meta.elements))
+	for i, e := range r.meta.elements {
+		typ, err := e.columnType()
+		if err != nil {
+			return nil, err
+		}
+		cols[i] = Column{Name: e.name, Type: typ}
+	}
+	return cols, nil
+}
+
+func (r *Reader) RowGroups() int {
+	return len(r.meta.rowGroups)
+}
+
+// Rows returns the count of rows in the row group rg.
+func (r *Reader) Rows(rg int) int64 {
+	return r.meta.rowGroups[rg].numRows
+}
+
+// Read reads the column col of the row group rg into a vector of type typ.
+func (r *Reader) Read(rg, col int, typ types.Type) (*vector.Vector, error) {
+	e := r.meta.elements[col]
+	c := r.meta.rowGroups[rg].chunks[col]
+	start := c.dataPageOffset
+	if c.dictPageOffset > 0 && c.dictPageOffset < start {
+		start = c.dictPageOffset
+	}
+	buf := make([]byte, c.compressed)
+	if _, err := r.r.ReadAt(buf, start); err != nil {
+		return nil, err
+	}
+	vs, present, err := readChunk(e, c, buf)
+	if err != nil {
+		return nil, fmt.Errorf("read column %s of parquet failed: %v", e.name, err)
+	}
+	return convert(e, vs, present, int(r.meta.rowGroups[rg].numRows), typ)
+}
+
+// values are the values of a column chunk except nulls, the integers, booleans and
+// timestamps are in ints, the floating point numbers are in floats and the others are in bytes.
+type values struct {
+	ints   []int64
+	floats []float64
+	bytes  [][]byte
+}
+
+func (vs *values) appendFrom(dict *values, i int) error {
+	switch {
+	case i < len(dict.ints):
+		vs.ints = append(vs.ints, dict.ints[i])
+	case i < len(dict.floats):
+		vs.floats = append(vs.floats, dict.floats[i])
+	case i < len(dict.bytes):
+		vs.bytes = append(vs.bytes, dict.bytes[i])
+	default:
+		return errCorrupt
+	}
+	return nil
+}
+
+// readChunk decodes the pages of a column chunk, present tells whether the value
+// of a row is not null, it is nil if the column is required.
+func readChunk(e element, c chunkMeta, buf []byte) (*values, []bool, error) {
+	vs := &values{}
+	var dict *values
+	var present []bool
+	for read := int64(0); len(buf) > 0 && read < c.numValues; {
+		h, n, err := decodePageHeader(buf)
+		if err != nil {
+			return nil, nil, err
+		}
+		buf = buf[n:]
+		if int(h.compressed) > len(buf) {
+			return nil, nil, errCorrupt
+		}
+		page := buf[:h.compressed]
+		buf = buf[h.compressed:]
+
+		var levels []byte
+		switch h.typ {
+		case pageDictionary:
+			data, err := decompress(c.codec, page, int(h.uncompressed))
+			if err != nil {
+				return nil, nil, err
+			}
+			dict = &values{}
+			if err = decodePlain(e, data, int(h.numValues), dict); err != nil {
+				return nil, nil, err
+			}
+			continue
+		case pageData:
+			if page, err = decompress(c.codec, page, int(h.uncompressed)); err != nil {
+				return nil, nil, err
+			}
+			if e.optional {
+				if len(page) < 4 {
+					return nil, nil, errCorrupt
+				}
+				l := int(binary.LittleEndian.Uint32(page))
+				if l > len(page)-4 {
+					return nil, nil, errCorrupt
+				}
+				levels, page = page[4:4+l], page[4+l:]
+			}
+		case pageDataV2:
+			if h.repLength != 0 {
+				return nil, nil, errors.New("repeated column is not supported")
+			}
+			if int(h.defLength) > len(page) {
+				return nil, nil, errCorrupt
+			}
+			levels, page = page[:h.defLength], page[h.defLength:]
+			if h.compressV2 {
+				if page, err = decompress(c.codec, page, int(h.uncompressed-h.defLength)); err != nil {
+					return nil, nil, err
+				}
+			}
+		default:
+			continue
+		}
+
+		count := int(h.numValues)
+		if e.optional {
+			defs, err := decodeHybrid(levels, 1, int(h.numValues))
+			if err != nil {
+				return nil, nil, err
+			}
+			count = 0
+			for _, d := range defs {
+				present = append(present, d == 1)
+				count += int(d)
+			}
+		}
+		if err = decodeValues(e, h.encoding, page, count, dict, vs); err != nil {
+			return nil, nil, err
+		}
+		read += int64(h.numValues)
+	}
+	return vs, present, nil
+}
+
+func decompress(codec int32, data []byte, size int) ([]byte, error) {
+	switch codec {
+	case codecUncompressed:
+		return data, nil
+	case codecSnappy:
+		return snappy.Decode(make([]byte, size), data)
+	case codecGzip:
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		return io.ReadAll(r)
+	case codecZstd:
+		zstdOnce.Do(func() {
+			zstdDecoder, zstdErr = zstd.NewReader(nil)
+		})
+		if zstdErr != nil {
+			return nil, zstdErr
+		}
+		return zstdDecoder.DecodeAll(data, make([]byte, 0, size))
+	}
+	return nil, fmt.Errorf("unsupported compression codec %d of parquet", codec)
+}
+
+func decodeValues(e element, encoding int32, data []byte, n int, dict *values, vs *values) error {
+	//the page of nulls may have no data
+	if n == 0 {
+		return nil
+	}
+	switch encoding {
+	case encodingPlain:
+		return decodePlain(e, data, n, vs)
+	case encodingPlainDict, encodingRLEDictionary:
+		if dict == nil || len(data) == 0 {
+			return errCorrupt
+		}
+		idx, err := decodeHybrid(data[1:], int(data[0]), n)
+		if err != nil {
+			return err
+		}
+		for _, i := range idx {
+			if err = vs.appendFrom(dict, int(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case encodingRLE:
+		if e.typ != typeBoolean || len(data) < 4 {
+			break
+		}
+		bs, err := decodeHybrid(data[4:], 1, n)
+		if err != nil {
+			return err
+		}
+		for _, b := range bs {
+			vs.ints = append(vs.ints, int64(b))
+		}
+		return nil
+	}
+	return fmt.Errorf("unsupported encoding %d of parquet", encoding)
+}
+
+// decodeHybrid decodes n values of the RLE/bit-packing hybrid encoding.
+func decodeHybrid(buf []byte, width, n int) ([]uint32, error) {
+	if width > 32 {
+		return nil, errCorrupt
+	}
+	out := make([]uint32, 0, n)
+	for len(out) < n {
+		h, k := binary.Uvarint(buf)
+		if k <= 0 {
+			return nil, errCorrupt
+		}
+		buf = buf[k:]
+		if h&1 == 1 {
+			cnt := int(h>>1) * 8
+			size := cnt * width / 8
+			if size > len(buf) {
+				size = len(buf)
+				cnt = size * 8 / width
+			}
+			for i := 0; i < cnt && len(out) < n; i++ {
+				var v uint32
+				for b := 0; b < width; b++ {
+					pos := i*width + b
+					v |= uint32(buf[pos/8]>>(pos%8)&1) << b
+				}
+				out = append(out, v)
+			}
+			buf = buf[size:]
+		} else {
+			w := (width + 7) / 8
+			if w > len(buf) {
+				return nil, errCorrupt
+			}
+			var v uint32
+			for j := 0; j < w; j++ {
+				v |= uint32(buf[j]) << (8 * j)
+			}
+			buf = buf[w:]
+			for j := 0; j < int(h>>1) && len(out) < n; j++ {
+				out = append(out, v)
+			}
+		}
+	}
+	return out, nil
+}
+
+func decodePlain(e element, data []byte, n int, vs *values) error {
+	size := map[int32]int{typeInt32: 4, typeInt64: 8, typeInt96: 12, typeFloat: 4, typeDouble: 8}[e.typ]
+	if e.typ == typeFixedLenByteArray {
+		size = int(e.typeLength)
+	}
+	if size*n > len(data) {
+		return errCorrupt
+	}
+	unsigned := false
+	if e.typ == typeInt32 && !e.isDecimal() && !e.isDate() {
+		_, signed := e.integer()
+		unsigned = !signed
+	}
+	for i := 0; i < n; i++ {
+		switch e.typ {
+		case typeBoolean:
+			if i/8 >= len(data) {
+				return errCorrupt
+			}
+			vs.ints = append(vs.ints, int64(data[i/8]>>(i%8)&1))
+		case typeInt32:
+			v := int32(binary.LittleEndian.Uint32(data[i*4:]))
+			if unsigned {
+				vs.ints = append(vs.ints, int64(uint32(v)))
+			} else {
+				vs.ints = append(vs.ints, int64(v))
+			}
+		case typeInt64:
+			vs.ints = append(vs.ints, int64(binary.LittleEndian.Uint64(data[i*8:])))
+		case typeInt96:
+			//nanoseconds of the day and the julian day, in microseconds since the unix epoch
+			nanos := int64(binary.LittleEndian.Uint64(data[i*12:]))
+			days := int64(binary.LittleEndian.Uint32(data[i*12+8:])) - 2440588
+			vs.ints = append(vs.ints, days*24*3600*1e6+nanos/1e3)
+		case typeFloat:
+			vs.floats = append(vs.floats, float64(math.Float32frombits(binary.LittleEndian.Uint32(data[i*4:]))))
+		case typeDouble:
+			vs.floats = append(vs.floats, math.Float64frombits(binary.LittleEndian.Uint64(data[i*8:])))
+		case typeFixedLenByteArray:
+			vs.bytes = append(vs.bytes, data[i*size:(i+1)*size])
+		case typeByteArray:
+			if len(data) < 4 {
+				return errCorrupt
+			}
+			l := int(binary.LittleEndian.Uint32(data))
+			if l > len(data)-4 {
+				return errCorrupt
+			}
+			vs.bytes = append(vs.bytes, data[4:4+l])
+			data = data[4+l:]
+		default:
+			return fmt.Errorf("unsupported physical type %d of parquet", e.typ)
+		}
+	}
+	return nil
+}
+
+// convert makes a vector of type typ from the values of a column chunk.
+func convert(e element, vs *values, present []bool, rows int, typ types.Type) (*vector.Vector, error) {
+	vec := vector.New(typ)
+	var set func(i, k int) error
+	mismatch := func() (*vector.Vector, error) {
+		ft, _ := e.columnType()
+		return nil, fmt.Errorf("can not read column %s of type %s in parquet as %s", e.name, ft, typ)
+	}
+	switch typ.Oid {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64:
+		if len(vs.floats) != 0 || len(vs.bytes) != 0 || e.isDecimal() || e.isDate() || e.isTimestamp() {
+			return mismatch()
+		}
+		bits := uint(typ.Size * 8)
+		_, signed := e.integer()
+		ints := make([]int64, rows)
+		set = func(i, k int) error {
+			v := vs.ints[k]
+			if (!signed && v < 0) || (bits < 64 && (v < -1<<(bits-1) || v >= 1<<(bits-1))) {
+				return fmt.Errorf("value of column %s in parquet is out of range of %s", e.name, typ)
+			}
+			ints[i] = v
+			return nil
+		}
+		defer func() {
+			switch typ.Oid {
+			case types.T_int8:
+				col := make([]int8, rows)
+				for i, v := range ints {
+					col[i] = int8(v)
+				}
+				vec.Col = col
+			case types.T_int16:
+				col := make([]int16, rows)
+				for i, v := range ints {
+					col[i] = int16(v)
+				}
+				vec.Col = col
+			case types.T_int32:
+				col := make([]int32, rows)
+				for i, v := range ints {
+					col[i] = int32(v)
+				}
+				vec.Col = col
+			case types.T_int64:
+				vec.Col = ints
+			}
+		}()
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		if len(vs.floats) != 0 || len(vs.bytes) != 0 || e.isDecimal() || e.isDate() || e.isTimestamp() {
+			return mismatch()
+		}
+		bits := uint(typ.Size * 8)
+		_, signed := e.integer()
+		uints := make([]uint64, rows)
+		set = func(i, k int) error {
+			v := vs.ints[k]
+			if (signed && v < 0) || (bits < 64 && uint64(v) >= 1<<bits) {
+				return fmt.Errorf("value of column %s in parquet is out of range of %s", e.name, typ)
+			}
+			uints[i] = uint64(v)
+			return nil
+		}
+		defer func() {
+			switch typ.Oid {
+			case types.T_uint8:
+				col := make([]uint8, rows)
+				for i, v := range uints {
+					col[i] = uint8(v)
+				}
+				vec.Col = col
+			case types.T_uint16:
+				col := make([]uint16, rows)
+				for i, v := range uints {
+					col[i] = uint16(v)
+				}
+				vec.Col = col
+			case types.T_uint32:
+				col := make([]uint32, rows)
+				for i, v := range uints {
+					col[i] = uint32(v)
+				}
+				vec.Col = col
+			case types.T_uint64:
+				vec.Col = uints
+			}
+		}()
+	case types.T_float32, types.T_float64:
+		if (len(vs.bytes) != 0 && !e.isDecimal()) || e.isDate() || e.isTimestamp() {
+			return mismatch()
+		}
+		floats := make([]float64, rows)
+		set = func(i, k int) error {
+			switch {
+			case len(vs.floats) != 0:
+				floats[i] = vs.floats[k]
+			case e.isDecimal():
+				d, err := decimalAt(vs, k)
+				if err != nil {
+					return err
+				}
+				floats[i] = d.ToFloat64(e.scale)
+			default:
+				floats[i] = float64(vs.ints[k])
+			}
+			return nil
+		}
+		defer func() {
+			if typ.Oid == types.T_float64 {
+				vec.Col = floats
+				return
+			}
+			col := make([]float32, rows)
+			for i, v := range floats {
+				col[i] = float32(v)
+			}
+			vec.Col = col
+		}()
+	case types.T_decimal:
+		if e.isDate() || e.isTimestamp() || (len(vs.bytes) != 0 && !e.isDecimal()) {
+			return mismatch()
+		}
+		prec, scale := types.DecimalPrecision(typ), types.DecimalScale(typ)
+		col := make([]types.Decimal, rows)
+		vec.Col = col
+		set = func(i, k int) (err error) {
+			switch {
+			case len(vs.floats) != 0:
+				col[i], err = types.DecimalFromFloat64(vs.floats[k], prec, scale)
+			case e.isDecimal():
+				var v types.Decimal
+				if v, err = decimalAt(vs, k); err != nil {
+					return err
+				}
+				col[i], err = v.Rescale(e.scale, prec, scale)
+			default:
+				col[i], err = types.DecimalFromInt64(vs.ints[k], prec, scale)
+			}
+			return err
+		}
+	case types.T_date:
+		if !e.isDate() && !e.isTimestamp() {
+			return mismatch()
+		}
+		col := make([]types.Date, rows)
+		vec.Col = col
+		set = func(i, k int) error {
+			if e.isDate() {
+				col[i] = types.Date(vs.ints[k] + unixEpochDays)
+			} else {
+				col[i] = toDatetime(vs.ints[k], e.timeUnit()).ToDate()
+			}
+			return nil
+		}
+	case types.T_datetime:
+		if !e.isDate() && !e.isTimestamp() {
+			return mismatch()
+		}
+		col := make([]types.Datetime, rows)
+		vec.Col = col
+		set = func(i, k int) error {
+			if e.isDate() {
+				col[i] = types.Date(vs.ints[k] + unixEpochDays).ToTime()
+			} else {
+				col[i] = toDatetime(vs.ints[k], e.timeUnit())
+			}
+			return nil
+		}
+	case types.T_char, types.T_varchar, types.T_json:
+		col := &types.Bytes{
+			Offsets: make([]uint32, rows),
+			Lengths: make([]uint32, rows),
+		}
+		vec.Col = col
+		set = func(i, k int) error {
+			var v []byte
+			switch {
+			case len(vs.bytes) != 0 && !e.isDecimal():
+				v = vs.bytes[k]
+			case len(vs.floats) != 0:
+				v = strconv.AppendFloat(nil, vs.floats[k], 'g', -1, 64)
+			case e.isDecimal():
+				d, err := decimalAt(vs, k)
+				if err != nil {
+					return err
+				}
+				v = []byte(d.Format(e.scale))
+			case e.isDate():
+				v = []byte(types.Date(vs.ints[k] + unixEpochDays).String())
+			case e.isTimestamp():
+				v = []byte(toDatetime(vs.ints[k], e.timeUnit()).String())
+			default:
+				v = strconv.AppendInt(nil, vs.ints[k], 10)
+			}
+			col.Offsets[i] = uint32(len(col.Data))
+			col.Lengths[i] = uint32(len(v))
+			col.Data = append(col.Data, v...)
+			return nil
+		}
+	default:
+		return mismatch()
+	}
+
+	count := len(vs.ints) + len(vs.floats) + len(vs.bytes)
+	for i, k := 0, 0; i < rows; i++ {
+		if present != nil && (i >= len(present) || !present[i]) {
+			nulls.Add(vec.Nsp, uint64(i))
+			continue
+		}
+		if k >= count {
+			return nil, errCorrupt
+		}
+		if err := set(i, k); err != nil {
+			return nil, err
+		}
+		k++
+	}
+	return vec, nil
+}
+
+// toDatetime converts a timestamp since the unix epoch.
+func toDatetime(v int64, unit int32) types.Datetime {
+	switch unit {
+	case unitMillis:
+		v *= 1e3
+	case unitNanos:
+		v /= 1e3
+	}
+	secs, micros := v/1e6, v%1e6
+	if micros < 0 {
+		secs, micros = secs-1, micros+1e6
+	}
+	return types.Datetime((secs+unixEpochSecs)<<20 + micros)
+}
+
+// decimalAt returns the unscaled value of the k-th decimal, which is stored
+// either as an integer or as bytes.
+func decimalAt(vs *values, k int) (types.Decimal, error) {
+	if len(vs.bytes) != 0 {
+		return decimalFromBytes(vs.bytes[k])
+	}
+	return types.NewDecimal(vs.ints[k]), nil
+}
+
+// decimalFromBytes decodes the unscaled value of a decimal in big-endian two's complement.
+func decimalFromBytes(b []byte) (types.Decimal, error) {
+	var v types.Decimal
+	if len(b) > 0 && b[0]&0x80 != 0 {
+		v = types.NewDecimal(-1)
+	}
+	for i, c := range b {
+		if i < len(b)-16 && int64(int8(c)) != v.Hi {
+			return types.Decimal{}, errors.New("decimal of parquet is out of range")
+		}
+		v = types.Decimal{Lo: v.Lo<<8 | uint64(c), Hi: v.Hi<<8 | int64(v.Lo>>56)}
+	}
+	return v, nil
+}
@@ -0,0 +1,301 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+// the types of the thrift compact protocol
+const (
+	thriftTrue   = 1
+	thriftFalse  = 2
+	thriftByte   = 3
+	thriftI16    = 4
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftDouble = 7
+	thriftBinary = 8
+	thriftList   = 9
+	thriftSet    = 10
+	thriftMap    = 11
+	thriftStruct = 12
+)
+
+var errThrift = errors.New("invalid thrift data in parquet file")
+
+// thriftWriter encodes the metadata of parquet in the thrift compact protocol.
+type thriftWriter struct {
+	buf []byte
+	//the last field id of the structs being written
+	last []int16
+}
+
+func newThriftWriter() *thriftWriter {
+	return &thriftWriter{last: []int16{0}}
+}
+
+func (w *thriftWriter) varint(v uint64) {
+	w.buf = appendUvarint(w.buf, v)
+}
+
+func (w *thriftWriter) zigzag(v int64) {
+	w.varint(uint64((v << 1) ^ (v >> 63)))
+}
+
+func (w *thriftWriter) field(id int16, typ byte) {
+	last := &w.last[len(w.last)-1]
+	if delta := id - *last; delta > 0 && delta <= 15 {
+		w.buf = append(w.buf, byte(delta)<<4|typ)
+	} else {
+		w.buf = append(w.buf, typ)
+		w.zigzag(int64(id))
+	}
+	*last = id
+}
+
+func (w *thriftWriter) i32(id int16, v int32) {
+	w.field(id, thriftI32)
+	w.zigzag(int64(v))
+}
+
+func (w *thriftWriter) i64(id int16, v int64) {
+	w.field(id, thriftI64)
+	w.zigzag(v)
+}
+
+func (w *thriftWriter) byte(id int16, v int8) {
+	w.field(id, thriftByte)
+	w.buf = append(w.buf, byte(v))
+}
+
+func (w *thriftWriter) bool(id int16, v bool) {
+	if v {
+		w.field(id, thriftTrue)
+	} else {
+		w.field(id, thriftFalse)
+	}
+}
+
+func (w *thriftWriter) binary(id int16, v []byte) {
+	w.field(id, thriftBinary)
+	w.bytes(v)
+}
+
+func (w *thriftWriter) bytes(v []byte) {
+	w.varint(uint64(len(v)))
+	w.buf = append(w.buf, v...)
+}
+
+// list starts a list field, the elements are written after it.
+func (w *thriftWriter) list(id int16, elem byte, n int) {
+	w.field(id, thriftList)
+	if n < 15 {
+		w.buf = append(w.buf, byte(n)<<4|elem)
+	} else {
+		w.buf = append(w.buf, 0xf0|elem)
+		w.varint(uint64(n))
+	}
+}
+
+// structField starts a struct field, it is ended by end.
+func (w *thriftWriter) structField(id int16) {
+	w.field(id, thriftStruct)
+	w.begin()
+}
+
+// begin starts a struct without field header, such as an element of a list.
+func (w *thriftWriter) begin() {
+	w.last = append(w.last, 0)
+}
+
+func (w *thriftWriter) end() {
+	w.buf = append(w.buf, 0)
+	w.last = w.last[:len(w.last)-1]
+}
+
+// thriftFields is a decoded struct, the values of the fields are int64, float64,
+// bool, []byte, []interface{} or thriftFields.
+type thriftFields map[int16]interface{}
+
+// thriftReader decodes the thrift compact protocol without the idl, the fields are
+// picked from the decoded structs.
+type thriftReader struct {
+	buf []byte
+	pos int
+}
+
+func (r *thriftReader) varint() (uint64, error) {
+	v, n := binary.Uvarint(r.buf[r.pos:])
+	if n <= 0 {
+		return 0, errThrift
+	}
+	r.pos += n
+	return v, nil
+}
+
+func (r *thriftReader) zigzag() (int64, error) {
+	v, err := r.varint()
+	if err != nil {
+		return 0, err
+	}
+	return int64(v>>1) ^ -int64(v&1), nil
+}
+
+func (r *thriftReader) next() (byte, error) {
+	if r.pos >= len(r.buf) {
+		return 0, errThrift
+	}
+	r.pos++
+	return r.buf[r.pos-1], nil
+}
+
+func (r *thriftReader) readStruct() (thriftFields, error) {
+	fs := make(thriftFields)
+	var last int16
+	for {
+		h, err := r.next()
+		if err != nil {
+			return nil, err
+		}
+		if h == 0 {
+			return fs, nil
+		}
+		typ := h & 0x0f
+		if delta := h >> 4; delta != 0 {
+			last += int16(delta)
+		} else {
+			id, err := r.zigzag()
+			if err != nil {
+				return nil, err
+			}
+			last = int16(id)
+		}
+		switch typ {
+		case thriftTrue:
+			fs[last] = true
+		case thriftFalse:
+			fs[last] = false
+		default:
+			if fs[last], err = r.readValue(typ); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+func (r *thriftReader) readValue(typ byte) (interface{}, error) {
+	switch typ {
+	case thriftTrue, thriftFalse:
+		b, err := r.next()
+		return b == thriftTrue, err
+	case thriftByte:
+		b, err := r.next()
+		return int64(int8(b)), err
+	case thriftI16, thriftI32, thriftI64:
+		return r.zigzag()
+	case thriftDouble:
+		if r.pos+8 > len(r.buf) {
+			return nil, errThrift
+		}
+		r.pos += 8
+		return math.Float64frombits(binary.LittleEndian.Uint64(r.buf[r.pos-8:])), nil
+	case thriftBinary:
+		n, err := r.varint()
+		if err != nil {
+			return nil, err
+		}
+		if uint64(len(r.buf)-r.pos) < n {
+			return nil, errThrift
+		}
+		r.pos += int(n)
+		return r.buf[r.pos-int(n) : r.pos], nil
+	case thriftList, thriftSet:
+		h, err := r.next()
+		if err != nil {
+			return nil, err
+		}
+		n := uint64(h >> 4)
+		if n == 15 {
+			if n, err = r.varint(); err != nil {
+				return nil, err
+			}
+		}
+		if n > uint64(len(r.buf)-r.pos) {
+			return nil, errThrift
+		}
+		vs := make([]interface{}, n)
+		for i := range vs {
+			if vs[i], err = r.readValue(h & 0x0f); err != nil {
+				return nil, err
+			}
+		}
+		return vs, nil
+	case thriftMap:
+		n, err := r.varint()
+		if err != nil || n == 0 {
+			return nil, err
+		}
+		kv, err := r.next()
+		if err != nil {
+			return nil, err
+		}
+		for i := uint64(0); i < 2*n; i++ {
+			typ := kv >> 4
+			if i%2 == 1 {
+				typ = kv & 0x0f
+			}
+			if _, err = r.readValue(typ); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	case thriftStruct:
+		return r.readStruct()
+	}
+	return nil, errThrift
+}
+
+func (fs thriftFields) int(id int16) int64 {
+	v, _ := fs[id].(int64)
+	return v
+}
+
+func (fs thriftFields) has(id int16) bool {
+	_, ok := fs[id]
+	return ok
+}
+
+func (fs thriftFields) bool(id int16) bool {
+	v, _ := fs[id].(bool)
+	return v
+}
+
+func (fs thriftFields) binary(id int16) []byte {
+	v, _ := fs[id].([]byte)
+	return v
+}
+
+func (fs thriftFields) fields(id int16) thriftFields {
+	v, _ := fs[id].(thriftFields)
+	return v
+}
+
+func (fs thriftFields) list(id int16) []interface{} {
+	v, _ := fs[id].([]interface{})
+	return v
+}
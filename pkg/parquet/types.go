@@ -0,0 +1,272 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package parquet reads and writes flat Apache Parquet files column by column,
+// the columns are exchanged as vectors.
+package parquet
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// physical types
+const (
+	typeBoolean           = 0
+	typeInt32             = 1
+	typeInt64             = 2
+	typeInt96             = 3
+	typeFloat             = 4
+	typeDouble            = 5
+	typeByteArray         = 6
+	typeFixedLenByteArray = 7
+)
+
+// converted types, the legacy annotations of the physical types
+const (
+	convertedUTF8            = 0
+	convertedDecimal         = 5
+	convertedDate            = 6
+	convertedTimestampMillis = 9
+	convertedTimestampMicros = 10
+	convertedUint8           = 11
+	convertedUint16          = 12
+	convertedUint32          = 13
+	convertedUint64          = 14
+	convertedInt8            = 15
+	convertedInt16           = 16
+	convertedInt32           = 17
+	convertedInt64           = 18
+	convertedJSON            = 19
+)
+
+// the field ids of the logical types in the LogicalType union
+const (
+	logicalString    = 1
+	logicalDecimal   = 5
+	logicalDate      = 6
+	logicalTimestamp = 8
+	logicalInteger   = 10
+	logicalJSON      = 12
+)
+
+// the field ids of the time units in the TimeUnit union
+const (
+	unitMillis = 1
+	unitMicros = 2
+	unitNanos  = 3
+)
+
+const (
+	repetitionRequired = 0
+	repetitionOptional = 1
+)
+
+const (
+	encodingPlain         = 0
+	encodingPlainDict     = 2
+	encodingRLE           = 3
+	encodingBitPacked     = 4
+	encodingRLEDictionary = 8
+)
+
+const (
+	codecUncompressed = 0
+	codecSnappy       = 1
+	codecGzip         = 2
+	codecZstd         = 6
+)
+
+const (
+	pageData       = 0
+	pageDictionary = 2
+	pageDataV2     = 3
+)
+
+const magic = "PAR1"
+
+// Column is a column of a parquet file.
+type Column struct {
+	Name string
+	Type types.Type
+}
+
+// element is the schema of a leaf column.
+type element struct {
+	name       string
+	typ        int32
+	typeLength int32
+	optional   bool
+	converted  int32 // -1 if there is no converted type
+	scale      int32
+	precision  int32
+	//logical type: the field id in the LogicalType union, 0 if there is no logical type
+	logical  int32
+	bitWidth int32
+	signed   bool
+	utc      bool
+	unit     int32
+}
+
+// newElement maps the type of a column to its parquet schema.
+func newElement(col Column) (element, error) {
+	e := element{name: col.Name, optional: true, converted: -1}
+	switch col.Type.Oid {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64:
+		e.typ = typeInt32
+		if col.Type.Oid == types.T_int64 {
+			e.typ = typeInt64
+		}
+		e.logical, e.bitWidth, e.signed = logicalInteger, col.Type.Size*8, true
+		e.converted = map[types.T]int32{types.T_int8: convertedInt8, types.T_int16: convertedInt16,
+			types.T_int32: convertedInt32, types.T_int64: convertedInt64}[col.Type.Oid]
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		e.typ = typeInt32
+		if col.Type.Oid == types.T_uint64 {
+			e.typ = typeInt64
+		}
+		e.logical, e.bitWidth = logicalInteger, col.Type.Size*8
+		e.converted = map[types.T]int32{types.T_uint8: convertedUint8, types.T_uint16: convertedUint16,
+			types.T_uint32: convertedUint32, types.T_uint64: convertedUint64}[col.Type.Oid]
+	case types.T_float32:
+		e.typ = typeFloat
+	case types.T_float64:
+		e.typ = typeDouble
+	case types.T_decimal:
+		//the 128-bit unscaled value in big-endian
+		e.typ, e.typeLength = typeFixedLenByteArray, 16
+		e.logical, e.converted = logicalDecimal, convertedDecimal
+		e.precision, e.scale = types.DecimalPrecision(col.Type), types.DecimalScale(col.Type)
+	case types.T_date:
+		e.typ = typeInt32
+		e.logical, e.converted = logicalDate, convertedDate
+	case types.T_datetime:
+		e.typ = typeInt64
+		e.logical, e.converted = logicalTimestamp, convertedTimestampMicros
+		e.utc, e.unit = true, unitMicros
+	case types.T_char, types.T_varchar:
+		e.typ = typeByteArray
+		e.logical, e.converted = logicalString, convertedUTF8
+	case types.T_json:
+		e.typ = typeByteArray
+		e.logical, e.converted = logicalJSON, convertedJSON
+	default:
+		return e, fmt.Errorf("unsupported type %s of column %s in parquet", col.Type, col.Name)
+	}
+	return e, nil
+}
+
+// columnType returns the type of the column in matrixone which the element is read as.
+func (e element) columnType() (types.Type, error) {
+	switch {
+	case e.isDecimal():
+		prec := e.precision
+		if prec <= 0 || prec > types.MaxDecimalPrecision {
+			prec = types.MaxDecimalPrecision
+		}
+		return types.DecimalType(prec, e.scale), nil
+	case e.isDate():
+		return types.Type{Oid: types.T_date, Size: 4}, nil
+	case e.isTimestamp():
+		return types.Type{Oid: types.T_datetime, Size: 8}, nil
+	}
+	switch e.typ {
+	case typeBoolean:
+		return types.Type{Oid: types.T_int8, Size: 1}, nil
+	case typeInt32, typeInt64:
+		width, signed := e.integer()
+		switch {
+		case width == 8 && signed:
+			return types.Type{Oid: types.T_int8, Size: 1}, nil
+		case width == 16 && signed:
+			return types.Type{Oid: types.T_int16, Size: 2}, nil
+		case width == 32 && signed:
+			return types.Type{Oid: types.T_int32, Size: 4}, nil
+		case width == 64 && signed:
+			return types.Type{Oid: types.T_int64, Size: 8}, nil
+		case width == 8:
+			return types.Type{Oid: types.T_uint8, Size: 1}, nil
+		case width == 16:
+			return types.Type{Oid: types.T_uint16, Size: 2}, nil
+		case width == 32:
+			return types.Type{Oid: types.T_uint32, Size: 4}, nil
+		default:
+			return types.Type{Oid: types.T_uint64, Size: 8}, nil
+		}
+	case typeFloat:
+		return types.Type{Oid: types.T_float32, Size: 4}, nil
+	case typeDouble:
+		return types.Type{Oid: types.T_float64, Size: 8}, nil
+	case typeByteArray, typeFixedLenByteArray:
+		if e.logical == logicalJSON || e.converted == convertedJSON {
+			return types.Type{Oid: types.T_json, Size: 24}, nil
+		}
+		return types.Type{Oid: types.T_varchar, Size: 24}, nil
+	}
+	return types.Type{}, fmt.Errorf("unsupported physical type %d of column %s in parquet", e.typ, e.name)
+}
+
+// integer returns the bit width and the signedness of an integer column.
+func (e element) integer() (int32, bool) {
+	if e.logical == logicalInteger {
+		return e.bitWidth, e.signed
+	}
+	switch e.converted {
+	case convertedInt8:
+		return 8, true
+	case convertedInt16:
+		return 16, true
+	case convertedInt32:
+		return 32, true
+	case convertedInt64:
+		return 64, true
+	case convertedUint8:
+		return 8, false
+	case convertedUint16:
+		return 16, false
+	case convertedUint32:
+		return 32, false
+	case convertedUint64:
+		return 64, false
+	}
+	if e.typ == typeInt32 {
+		return 32, true
+	}
+	return 64, true
+}
+
+func (e element) isDecimal() bool {
+	return e.logical == logicalDecimal || e.converted == convertedDecimal
+}
+
+func (e element) isDate() bool {
+	return e.logical == logicalDate || e.converted == convertedDate
+}
+
+func (e element) isTimestamp() bool {
+	return e.typ == typeInt96 || e.logical == logicalTimestamp ||
+		e.converted == convertedTimestampMillis || e.converted == convertedTimestampMicros
+}
+
+// timeUnit returns the unit of a timestamp column.
+func (e element) timeUnit() int32 {
+	if e.logical == logicalTimestamp {
+		return e.unit
+	}
+	if e.converted == convertedTimestampMillis {
+		return unitMillis
+	}
+	return unitMicros
+}
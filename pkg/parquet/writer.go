@@ -0,0 +1,263 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/golang/snappy"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+var (
+	//the days from 0001-01-01 to 1970-01-01
+	unixEpochDays = int64(types.FromCalendar(1970, 1, 1))
+	unixEpochSecs = unixEpochDays * 24 * 60 * 60
+)
+
+// Writer writes a parquet file, each row group is encoded from the vectors of a batch
+// and every column chunk of it is a page compressed by snappy.
+type Writer struct {
+	w    io.Writer
+	meta fileMeta
+	//bytes written
+	size int64
+}
+
+// RowGroup is an encoded row group which has not been written into the file.
+type RowGroup struct {
+	data []byte
+	//the offsets of the column chunks are relative to data
+	meta rowGroupMeta
+}
+
+func NewWriter(w io.Writer, cols []Column) (*Writer, error) {
+	pw := &Writer{w: w}
+	for _, col := range cols {
+		e, err := newElement(col)
+		if err != nil {
+			return nil, err
+		}
+		pw.meta.elements = append(pw.meta.elements, e)
+	}
+	if _, err := io.WriteString(w, magic); err != nil {
+		return nil, err
+	}
+	pw.size = int64(len(magic))
+	return pw, nil
+}
+
+// Rows returns the count of rows written.
+func (w *Writer) Rows() int64 {
+	return w.meta.numRows
+}
+
+// Encode encodes the rows sels of the vectors into a row group, all rows are
+// encoded if sels is nil.
+func (w *Writer) Encode(vecs []*vector.Vector, sels []int64) (*RowGroup, error) {
+	if len(vecs) != len(w.meta.elements) {
+		return nil, fmt.Errorf("%d vectors are written into %d columns of parquet", len(vecs), len(w.meta.elements))
+	}
+	rows := len(sels)
+	if sels == nil && len(vecs) != 0 {
+		rows = vector.Length(vecs[0])
+	}
+	rg := &RowGroup{meta: rowGroupMeta{numRows: int64(rows)}}
+	for i, vec := range vecs {
+		chunk := encodeColumn(w.meta.elements[i], vec, sels, rows)
+		chunk.dataPageOffset = int64(len(rg.data))
+		rg.data = append(rg.data, chunk.encodedPage...)
+		rg.meta.chunks = append(rg.meta.chunks, chunk.chunkMeta)
+		rg.meta.byteSize += chunk.uncompressed
+	}
+	return rg, nil
+}
+
+// Rows returns the count of rows in the row group.
+func (rg *RowGroup) Rows() int64 {
+	return rg.meta.numRows
+}
+
+// at returns the metadata of the row group written at offset.
+func (rg *RowGroup) at(offset int64) rowGroupMeta {
+	meta := rg.meta
+	meta.chunks = make([]chunkMeta, len(rg.meta.chunks))
+	for i, c := range rg.meta.chunks {
+		c.dataPageOffset += offset
+		meta.chunks[i] = c
+	}
+	return meta
+}
+
+// Size returns the size of the file if it is closed after the row group
+// is written, rg can be nil.
+func (w *Writer) Size(rg *RowGroup) int64 {
+	meta := w.meta
+	size := w.size
+	if rg != nil {
+		meta.rowGroups = append(meta.rowGroups[:len(meta.rowGroups):len(meta.rowGroups)], rg.at(w.size))
+		size += int64(len(rg.data))
+	}
+	return size + int64(len(meta.encode())) + 8
+}
+
+func (w *Writer) Write(rg *RowGroup) error {
+	if _, err := w.w.Write(rg.data); err != nil {
+		return err
+	}
+	w.meta.rowGroups = append(w.meta.rowGroups, rg.at(w.size))
+	w.meta.numRows += rg.meta.numRows
+	w.size += int64(len(rg.data))
+	return nil
+}
+
+// Close writes the footer of the file.
+func (w *Writer) Close() error {
+	footer := w.meta.encode()
+	footer = appendUint32(footer, uint32(len(footer)))
+	footer = append(footer, magic...)
+	if _, err := w.w.Write(footer); err != nil {
+		return err
+	}
+	w.size += int64(len(footer))
+	return nil
+}
+
+type encodedChunk struct {
+	chunkMeta
+	encodedPage []byte
+}
+
+// encodeColumn encodes the vector into a data page with the definition levels
+// and the values in plain encoding.
+func encodeColumn(e element, vec *vector.Vector, sels []int64, rows int) encodedChunk {
+	row := func(i int) int64 {
+		if sels != nil {
+			return sels[i]
+		}
+		return int64(i)
+	}
+	hasNull := nulls.Any(vec.Nsp)
+	isNull := func(i int) bool {
+		return hasNull && nulls.Contains(vec.Nsp, uint64(row(i)))
+	}
+
+	//definition levels in bit-packed runs of the RLE/bit-packing hybrid encoding
+	groups := (rows + 7) / 8
+	levels := make([]byte, 4, 4+binary.MaxVarintLen64+groups)
+	levels = appendUvarint(levels, uint64(groups)<<1|1)
+	bits := make([]byte, groups)
+	for i := 0; i < rows; i++ {
+		if !isNull(i) {
+			bits[i/8] |= 1 << (i % 8)
+		}
+	}
+	levels = append(levels, bits...)
+	binary.LittleEndian.PutUint32(levels, uint32(len(levels)-4))
+
+	page := levels
+	for i := 0; i < rows; i++ {
+		if isNull(i) {
+			continue
+		}
+		r := row(i)
+		switch vec.Typ.Oid {
+		case types.T_int8:
+			page = appendUint32(page, uint32(vec.Col.([]int8)[r]))
+		case types.T_int16:
+			page = appendUint32(page, uint32(vec.Col.([]int16)[r]))
+		case types.T_int32:
+			page = appendUint32(page, uint32(vec.Col.([]int32)[r]))
+		case types.T_int64:
+			page = appendUint64(page, uint64(vec.Col.([]int64)[r]))
+		case types.T_uint8:
+			page = appendUint32(page, uint32(vec.Col.([]uint8)[r]))
+		case types.T_uint16:
+			page = appendUint32(page, uint32(vec.Col.([]uint16)[r]))
+		case types.T_uint32:
+			page = appendUint32(page, vec.Col.([]uint32)[r])
+		case types.T_uint64:
+			page = appendUint64(page, vec.Col.([]uint64)[r])
+		case types.T_float32:
+			page = appendUint32(page, math.Float32bits(vec.Col.([]float32)[r]))
+		case types.T_float64:
+			page = appendUint64(page, math.Float64bits(vec.Col.([]float64)[r]))
+		case types.T_decimal:
+			page = appendDecimal(page, vec.Col.([]types.Decimal)[r])
+		case types.T_date:
+			page = appendUint32(page, uint32(int64(vec.Col.([]types.Date)[r])-unixEpochDays))
+		case types.T_datetime:
+			dt := int64(vec.Col.([]types.Datetime)[r])
+			page = appendUint64(page, uint64(((dt>>20)-unixEpochSecs)*1e6+dt&(1<<20-1)))
+		case types.T_char, types.T_varchar, types.T_json:
+			v := vec.Col.(*types.Bytes).Get(r)
+			page = appendUint32(page, uint32(len(v)))
+			page = append(page, v...)
+		}
+	}
+
+	compressed := snappy.Encode(nil, page)
+	h := &pageHeader{
+		typ:          pageData,
+		uncompressed: int32(len(page)),
+		compressed:   int32(len(compressed)),
+		numValues:    int32(rows),
+		encoding:     encodingPlain,
+	}
+	header := h.encode()
+	return encodedChunk{
+		chunkMeta: chunkMeta{
+			typ:          e.typ,
+			encodings:    []int32{encodingPlain, encodingRLE},
+			path:         e.name,
+			codec:        codecSnappy,
+			numValues:    int64(rows),
+			uncompressed: int64(len(header) + len(page)),
+			compressed:   int64(len(header) + len(compressed)),
+		},
+		encodedPage: append(header, compressed...),
+	}
+}
+
+func appendUvarint(buf []byte, v uint64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(tmp[:], v)
+	return append(buf, tmp[:n]...)
+}
+
+func appendUint32(buf []byte, v uint32) []byte {
+	var tmp [4]byte
+	binary.LittleEndian.PutUint32(tmp[:], v)
+	return append(buf, tmp[:]...)
+}
+
+func appendUint64(buf []byte, v uint64) []byte {
+	var tmp [8]byte
+	binary.LittleEndian.PutUint64(tmp[:], v)
+	return append(buf, tmp[:]...)
+}
+
+// appendDecimal appends the unscaled value of a decimal in big-endian two's complement.
+func appendDecimal(buf []byte, v types.Decimal) []byte {
+	var tmp [16]byte
+	binary.BigEndian.PutUint64(tmp[:], uint64(v.Hi))
+	binary.BigEndian.PutUint64(tmp[8:], v.Lo)
+	return append(buf, tmp[:]...)
+}
@@ -272,7 +272,7 @@ func (x Function_FuncFlag) String() string {
 }
 
 func (Function_FuncFlag) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{6, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{8, 0}
 }
 
 type OrderBySpec_OrderByFlag int32
@@ -309,7 +309,7 @@ func (x OrderBySpec_OrderByFlag) String() string {
 }
 
 func (OrderBySpec_OrderByFlag) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{13, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{15, 0}
 }
 
 type Node_NodeType int32
@@ -404,7 +404,7 @@ func (x Node_NodeType) String() string {
 }
 
 func (Node_NodeType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{15, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{17, 0}
 }
 
 type Node_JoinFlag int32
@@ -444,7 +444,7 @@ func (x Node_JoinFlag) String() string {
 }
 
 func (Node_JoinFlag) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{15, 1}
+	return fileDescriptor_2d655ab2f7683c23, []int{17, 1}
 }
 
 type Node_AggMode int32
@@ -472,7 +472,7 @@ func (x Node_AggMode) String() string {
 }
 
 func (Node_AggMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{15, 2}
+	return fileDescriptor_2d655ab2f7683c23, []int{17, 2}
 }
 
 type Query_StatementType int32
@@ -509,7 +509,7 @@ func (x Query_StatementType) String() string {
 }
 
 func (Query_StatementType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{16, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{18, 0}
 }
 
 type Type struct {
@@ -816,6 +816,127 @@ func (m *ColRef) GetColPos() int32 {
 	return 0
 }
 
+// Reference a column in the proj list of a node of the outer query,
+// depth is the number of query levels outward from the current query.
+type CorrColRef struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	RelPos               int32    `protobuf:"varint,2,opt,name=rel_pos,json=relPos,proto3" json:"rel_pos,omitempty"`
+	ColPos               int32    `protobuf:"varint,3,opt,name=col_pos,json=colPos,proto3" json:"col_pos,omitempty"`
+	Depth                int32    `protobuf:"varint,4,opt,name=depth,proto3" json:"depth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CorrColRef) Reset()         { *m = CorrColRef{} }
+func (m *CorrColRef) String() string { return proto.CompactTextString(m) }
+func (*CorrColRef) ProtoMessage()    {}
+func (*CorrColRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{5}
+}
+
+func (m *CorrColRef) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CorrColRef.Unmarshal(m, b)
+}
+func (m *CorrColRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CorrColRef.Marshal(b, m, deterministic)
+}
+func (m *CorrColRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CorrColRef.Merge(m, src)
+}
+func (m *CorrColRef) XXX_Size() int {
+	return xxx_messageInfo_CorrColRef.Size(m)
+}
+func (m *CorrColRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_CorrColRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CorrColRef proto.InternalMessageInfo
+
+func (m *CorrColRef) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CorrColRef) GetRelPos() int32 {
+	if m != nil {
+		return m.RelPos
+	}
+	return 0
+}
+
+func (m *CorrColRef) GetColPos() int32 {
+	if m != nil {
+		return m.ColPos
+	}
+	return 0
+}
+
+func (m *CorrColRef) GetDepth() int32 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+// Subquery in an expression, node_id is the root node of the subquery plan.
+type SubQuery struct {
+	NodeId               int32    `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	IsCorrelated         bool     `protobuf:"varint,2,opt,name=is_correlated,json=isCorrelated,proto3" json:"is_correlated,omitempty"`
+	IsScalar             bool     `protobuf:"varint,3,opt,name=is_scalar,json=isScalar,proto3" json:"is_scalar,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubQuery) Reset()         { *m = SubQuery{} }
+func (m *SubQuery) String() string { return proto.CompactTextString(m) }
+func (*SubQuery) ProtoMessage()    {}
+func (*SubQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{6}
+}
+
+func (m *SubQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubQuery.Unmarshal(m, b)
+}
+func (m *SubQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubQuery.Marshal(b, m, deterministic)
+}
+func (m *SubQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubQuery.Merge(m, src)
+}
+func (m *SubQuery) XXX_Size() int {
+	return xxx_messageInfo_SubQuery.Size(m)
+}
+func (m *SubQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubQuery proto.InternalMessageInfo
+
+func (m *SubQuery) GetNodeId() int32 {
+	if m != nil {
+		return m.NodeId
+	}
+	return 0
+}
+
+func (m *SubQuery) GetIsCorrelated() bool {
+	if m != nil {
+		return m.IsCorrelated
+	}
+	return false
+}
+
+func (m *SubQuery) GetIsScalar() bool {
+	if m != nil {
+		return m.IsScalar
+	}
+	return false
+}
+
 // Object ref, reference a object in database, 4 part name.
 type ObjectRef struct {
 	Server               int64    `protobuf:"varint,1,opt,name=server,proto3" json:"server,omitempty"`
@@ -835,7 +956,7 @@ func (m *ObjectRef) Reset()         { *m = ObjectRef{} }
 func (m *ObjectRef) String() string { return proto.CompactTextString(m) }
 func (*ObjectRef) ProtoMessage()    {}
 func (*ObjectRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{5}
+	return fileDescriptor_2d655ab2f7683c23, []int{7}
 }
 
 func (m *ObjectRef) XXX_Unmarshal(b []byte) error {
@@ -924,7 +1045,7 @@ func (m *Function) Reset()         { *m = Function{} }
 func (m *Function) String() string { return proto.CompactTextString(m) }
 func (*Function) ProtoMessage()    {}
 func (*Function) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{6}
+	return fileDescriptor_2d655ab2f7683c23, []int{8}
 }
 
 func (m *Function) XXX_Unmarshal(b []byte) error {
@@ -967,6 +1088,8 @@ type Expr struct {
 	//	*Expr_V
 	//	*Expr_Col
 	//	*Expr_F
+	//	*Expr_Sub
+	//	*Expr_Corr
 	Expr                 isExpr_Expr `protobuf_oneof:"expr"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
@@ -977,7 +1100,7 @@ func (m *Expr) Reset()         { *m = Expr{} }
 func (m *Expr) String() string { return proto.CompactTextString(m) }
 func (*Expr) ProtoMessage()    {}
 func (*Expr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{7}
+	return fileDescriptor_2d655ab2f7683c23, []int{9}
 }
 
 func (m *Expr) XXX_Unmarshal(b []byte) error {
@@ -1029,6 +1152,14 @@ type Expr_F struct {
 	F *Function `protobuf:"bytes,6,opt,name=f,proto3,oneof"`
 }
 
+type Expr_Sub struct {
+	Sub *SubQuery `protobuf:"bytes,7,opt,name=sub,proto3,oneof"`
+}
+
+type Expr_Corr struct {
+	Corr *CorrColRef `protobuf:"bytes,8,opt,name=corr,proto3,oneof"`
+}
+
 func (*Expr_C) isExpr_Expr() {}
 
 func (*Expr_P) isExpr_Expr() {}
@@ -1039,6 +1170,10 @@ func (*Expr_Col) isExpr_Expr() {}
 
 func (*Expr_F) isExpr_Expr() {}
 
+func (*Expr_Sub) isExpr_Expr() {}
+
+func (*Expr_Corr) isExpr_Expr() {}
+
 func (m *Expr) GetExpr() isExpr_Expr {
 	if m != nil {
 		return m.Expr
@@ -1081,6 +1216,20 @@ func (m *Expr) GetF() *Function {
 	return nil
 }
 
+func (m *Expr) GetSub() *SubQuery {
+	if x, ok := m.GetExpr().(*Expr_Sub); ok {
+		return x.Sub
+	}
+	return nil
+}
+
+func (m *Expr) GetCorr() *CorrColRef {
+	if x, ok := m.GetExpr().(*Expr_Corr); ok {
+		return x.Corr
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Expr) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1089,6 +1238,8 @@ func (*Expr) XXX_OneofWrappers() []interface{} {
 		(*Expr_V)(nil),
 		(*Expr_Col)(nil),
 		(*Expr_F)(nil),
+		(*Expr_Sub)(nil),
+		(*Expr_Corr)(nil),
 	}
 }
 
@@ -1105,7 +1256,7 @@ func (m *ColDef) Reset()         { *m = ColDef{} }
 func (m *ColDef) String() string { return proto.CompactTextString(m) }
 func (*ColDef) ProtoMessage()    {}
 func (*ColDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{8}
+	return fileDescriptor_2d655ab2f7683c23, []int{10}
 }
 
 func (m *ColDef) XXX_Unmarshal(b []byte) error {
@@ -1159,7 +1310,7 @@ func (m *TableDef) Reset()         { *m = TableDef{} }
 func (m *TableDef) String() string { return proto.CompactTextString(m) }
 func (*TableDef) ProtoMessage()    {}
 func (*TableDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{9}
+	return fileDescriptor_2d655ab2f7683c23, []int{11}
 }
 
 func (m *TableDef) XXX_Unmarshal(b []byte) error {
@@ -1209,7 +1360,7 @@ func (m *Cost) Reset()         { *m = Cost{} }
 func (m *Cost) String() string { return proto.CompactTextString(m) }
 func (*Cost) ProtoMessage()    {}
 func (*Cost) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{10}
+	return fileDescriptor_2d655ab2f7683c23, []int{12}
 }
 
 func (m *Cost) XXX_Unmarshal(b []byte) error {
@@ -1283,7 +1434,7 @@ func (m *ColData) Reset()         { *m = ColData{} }
 func (m *ColData) String() string { return proto.CompactTextString(m) }
 func (*ColData) ProtoMessage()    {}
 func (*ColData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{11}
+	return fileDescriptor_2d655ab2f7683c23, []int{13}
 }
 
 func (m *ColData) XXX_Unmarshal(b []byte) error {
@@ -1372,7 +1523,7 @@ func (m *RowsetData) Reset()         { *m = RowsetData{} }
 func (m *RowsetData) String() string { return proto.CompactTextString(m) }
 func (*RowsetData) ProtoMessage()    {}
 func (*RowsetData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{12}
+	return fileDescriptor_2d655ab2f7683c23, []int{14}
 }
 
 func (m *RowsetData) XXX_Unmarshal(b []byte) error {
@@ -1420,7 +1571,7 @@ func (m *OrderBySpec) Reset()         { *m = OrderBySpec{} }
 func (m *OrderBySpec) String() string { return proto.CompactTextString(m) }
 func (*OrderBySpec) ProtoMessage()    {}
 func (*OrderBySpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{13}
+	return fileDescriptor_2d655ab2f7683c23, []int{15}
 }
 
 func (m *OrderBySpec) XXX_Unmarshal(b []byte) error {
@@ -1476,7 +1627,7 @@ func (m *WindowSpec) Reset()         { *m = WindowSpec{} }
 func (m *WindowSpec) String() string { return proto.CompactTextString(m) }
 func (*WindowSpec) ProtoMessage()    {}
 func (*WindowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{14}
+	return fileDescriptor_2d655ab2f7683c23, []int{16}
 }
 
 func (m *WindowSpec) XXX_Unmarshal(b []byte) error {
@@ -1553,7 +1704,7 @@ func (m *Node) Reset()         { *m = Node{} }
 func (m *Node) String() string { return proto.CompactTextString(m) }
 func (*Node) ProtoMessage()    {}
 func (*Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{15}
+	return fileDescriptor_2d655ab2f7683c23, []int{17}
 }
 
 func (m *Node) XXX_Unmarshal(b []byte) error {
@@ -1709,7 +1860,9 @@ type Query struct {
 	// reachable from step roots.
 	Nodes []*Node `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
 	// Bound Parameter for the query.
-	Params               []*Expr  `protobuf:"bytes,4,rep,name=params,proto3" json:"params,omitempty"`
+	Params []*Expr `protobuf:"bytes,4,rep,name=params,proto3" json:"params,omitempty"`
+	// Column names of the result of the query.
+	Headings             []string `protobuf:"bytes,5,rep,name=headings,proto3" json:"headings,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1719,7 +1872,7 @@ func (m *Query) Reset()         { *m = Query{} }
 func (m *Query) String() string { return proto.CompactTextString(m) }
 func (*Query) ProtoMessage()    {}
 func (*Query) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{16}
+	return fileDescriptor_2d655ab2f7683c23, []int{18}
 }
 
 func (m *Query) XXX_Unmarshal(b []byte) error {
@@ -1768,6 +1921,13 @@ func (m *Query) GetParams() []*Expr {
 	return nil
 }
 
+func (m *Query) GetHeadings() []string {
+	if m != nil {
+		return m.Headings
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("plan.StatementType", StatementType_name, StatementType_value)
 	proto.RegisterEnum("plan.Type_TypeId", Type_TypeId_name, Type_TypeId_value)
@@ -1782,6 +1942,8 @@ func init() {
 	proto.RegisterType((*ParamRef)(nil), "plan.ParamRef")
 	proto.RegisterType((*VarRef)(nil), "plan.VarRef")
 	proto.RegisterType((*ColRef)(nil), "plan.ColRef")
+	proto.RegisterType((*CorrColRef)(nil), "plan.CorrColRef")
+	proto.RegisterType((*SubQuery)(nil), "plan.SubQuery")
 	proto.RegisterType((*ObjectRef)(nil), "plan.ObjectRef")
 	proto.RegisterType((*Function)(nil), "plan.Function")
 	proto.RegisterType((*Expr)(nil), "plan.Expr")
@@ -1799,137 +1961,144 @@ func init() {
 func init() { proto.RegisterFile("plan.proto", fileDescriptor_2d655ab2f7683c23) }
 
 var fileDescriptor_2d655ab2f7683c23 = []byte{
-	// 2101 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xcd, 0x72, 0xdb, 0xc8,
-	0x11, 0x16, 0xf8, 0x0b, 0x36, 0x25, 0x79, 0x3c, 0x2b, 0xdb, 0xf4, 0x5a, 0xb6, 0x69, 0x38, 0xde,
-	0xc8, 0xf6, 0xae, 0x6b, 0x4d, 0x69, 0x59, 0x4e, 0xe5, 0xa7, 0x16, 0xa4, 0x20, 0x19, 0x36, 0x04,
-	0x70, 0x87, 0xa0, 0xb4, 0xca, 0x1e, 0x58, 0x20, 0x01, 0xca, 0x50, 0x28, 0x80, 0x05, 0x40, 0x92,
-	0x95, 0xd3, 0x9e, 0x72, 0x4c, 0x9e, 0x22, 0xf7, 0xe4, 0x2d, 0x76, 0xcf, 0x79, 0x82, 0xbc, 0x43,
-	0x1e, 0x20, 0xd5, 0x3d, 0x20, 0x25, 0xaf, 0xec, 0xca, 0x21, 0x17, 0x54, 0x77, 0x7f, 0x3d, 0x8d,
-	0x9e, 0x99, 0xee, 0x6f, 0x66, 0x00, 0x66, 0x53, 0x2f, 0x7a, 0x31, 0x4b, 0xe2, 0x2c, 0xe6, 0x25,
-	0x94, 0xb5, 0xbf, 0x96, 0xa1, 0xe4, 0x5e, 0xcc, 0x02, 0xbe, 0x0a, 0x85, 0xd0, 0x6f, 0x28, 0x4d,
-	0x65, 0x63, 0x45, 0x14, 0x42, 0x9f, 0x7f, 0x0e, 0x6a, 0x74, 0x3a, 0x9d, 0x7a, 0xa3, 0x69, 0xd0,
-	0x28, 0x34, 0x95, 0x0d, 0x55, 0x2c, 0x74, 0xbe, 0x06, 0xe5, 0xf3, 0xd0, 0xcf, 0xde, 0x35, 0x8a,
-	0x4d, 0x65, 0xa3, 0x2c, 0xa4, 0xc2, 0xd7, 0xa1, 0x36, 0x4b, 0x82, 0x71, 0x98, 0x86, 0x71, 0xd4,
-	0x28, 0x11, 0x72, 0x69, 0xd0, 0xfe, 0x5e, 0x82, 0x0a, 0xfe, 0xc8, 0xf4, 0x79, 0x15, 0x8a, 0xba,
-	0x7d, 0xc8, 0x96, 0xb8, 0x0a, 0xa5, 0xbe, 0xab, 0x0b, 0xa6, 0xa0, 0xd4, 0x71, 0x1c, 0x8b, 0x01,
-	0x4a, 0xa6, 0xed, 0xbe, 0x62, 0x6b, 0xbc, 0x06, 0x65, 0xd3, 0x76, 0x5f, 0xb6, 0xd9, 0xad, 0x5c,
-	0xdc, 0x6c, 0xb1, 0xdb, 0xb9, 0xd8, 0xde, 0x62, 0x77, 0x38, 0x40, 0x05, 0x1d, 0x5a, 0xaf, 0x58,
-	0x03, 0xcd, 0x03, 0x1a, 0x77, 0x17, 0xcd, 0x03, 0x39, 0xf0, 0xf3, 0xb9, 0xbc, 0xd9, 0x62, 0xf7,
-	0xe6, 0x72, 0x7b, 0x8b, 0xad, 0xf3, 0x3a, 0x54, 0x07, 0xf9, 0xd8, 0xfb, 0xa8, 0xec, 0x58, 0x8e,
-	0x8e, 0x5e, 0x0f, 0x16, 0x4a, 0x7b, 0x8b, 0x3d, 0xe4, 0x2b, 0x50, 0xdb, 0x36, 0xba, 0xe6, 0x9e,
-	0x6e, 0xb5, 0xb7, 0x58, 0x93, 0xaf, 0x02, 0xe4, 0x2a, 0x0e, 0x7c, 0x84, 0xbe, 0xb9, 0xce, 0x34,
-	0x0c, 0xaf, 0xdb, 0x87, 0xa6, 0xed, 0xb2, 0x27, 0x7c, 0x19, 0x54, 0xdd, 0x3e, 0xa4, 0x38, 0xec,
-	0x0b, 0x8c, 0xa2, 0xdb, 0x87, 0xf6, 0x60, 0xaf, 0x63, 0x08, 0xf6, 0x6b, 0x9c, 0xe1, 0x60, 0x60,
-	0x6e, 0xb3, 0x0d, 0x4a, 0xba, 0xf3, 0xb2, 0xfd, 0x35, 0x7b, 0x9a, 0x8b, 0xaf, 0xb6, 0xd8, 0xb3,
-	0x5c, 0xfc, 0x4d, 0x8b, 0x3d, 0x97, 0x62, 0xab, 0xb5, 0xc5, 0xbe, 0xcc, 0xc5, 0x6f, 0xda, 0xec,
-	0x2b, 0x0c, 0xb0, 0xad, 0xbb, 0x06, 0x6b, 0xa1, 0xe4, 0x9a, 0x7b, 0x06, 0xdb, 0xc4, 0x3f, 0xa2,
-	0x8d, 0xb4, 0x2d, 0xfc, 0x23, 0x4a, 0x7d, 0x57, 0xdf, 0xeb, 0xb1, 0x6f, 0x10, 0x34, 0x6d, 0xd7,
-	0x10, 0xfb, 0xba, 0xc5, 0xda, 0x98, 0xb5, 0x6e, 0x1f, 0x92, 0xe7, 0x6f, 0x31, 0x42, 0xf7, 0xb5,
-	0x2e, 0xd8, 0xef, 0xd0, 0xbc, 0xaf, 0x0b, 0x52, 0x7e, 0x8f, 0xe6, 0x37, 0x7d, 0xc7, 0x66, 0x7f,
-	0xc0, 0x69, 0x75, 0x4c, 0x5b, 0x17, 0x87, 0x6c, 0x07, 0xc3, 0xee, 0xeb, 0x22, 0x57, 0x77, 0x31,
-	0x25, 0x5d, 0x08, 0xfd, 0x90, 0xfd, 0x11, 0x57, 0x66, 0xc7, 0x32, 0xbe, 0xef, 0x0c, 0x76, 0x76,
-	0x0c, 0xc1, 0x7e, 0xa0, 0x51, 0x87, 0xae, 0xa1, 0xbf, 0x62, 0x3e, 0x06, 0x26, 0xf9, 0x65, 0x9b,
-	0x05, 0x38, 0x86, 0x14, 0x36, 0xe1, 0x2a, 0x14, 0xfb, 0x86, 0xc5, 0x7e, 0x52, 0x38, 0x40, 0xd9,
-	0x1d, 0xf4, 0x2c, 0x83, 0xfd, 0xac, 0x68, 0xc7, 0x50, 0xee, 0xc6, 0x51, 0x9a, 0xf1, 0xdb, 0x50,
-	0x09, 0x53, 0x2c, 0x39, 0x2a, 0x4a, 0x55, 0xe4, 0x1a, 0x5f, 0x83, 0x52, 0x78, 0xe6, 0x4d, 0xa9,
-	0x28, 0x8b, 0xaf, 0x97, 0x04, 0x69, 0x68, 0xf5, 0xd1, 0x8a, 0x15, 0xa9, 0xa0, 0xd5, 0xcf, 0xad,
-	0x29, 0x5a, 0xb1, 0x1a, 0x6b, 0x68, 0x45, 0xad, 0x53, 0x85, 0xf2, 0x99, 0x37, 0x3d, 0x0d, 0xb4,
-	0x75, 0x50, 0x7b, 0x5e, 0xe2, 0x9d, 0x88, 0x60, 0xc2, 0x19, 0x14, 0x67, 0x71, 0x4a, 0xff, 0x2a,
-	0x0b, 0x14, 0xb5, 0x75, 0xa8, 0xec, 0x7b, 0x09, 0x62, 0x1c, 0x4a, 0x91, 0x77, 0x12, 0x10, 0x58,
-	0x13, 0x24, 0x6b, 0x36, 0x54, 0xba, 0xf1, 0xf4, 0x13, 0x28, 0xbf, 0x03, 0xd5, 0x24, 0x98, 0x0e,
-	0x31, 0x62, 0x81, 0x22, 0x56, 0x92, 0x60, 0xda, 0x8b, 0x53, 0x04, 0xc6, 0xb1, 0x04, 0x64, 0xf3,
-	0x54, 0xc6, 0x31, 0x02, 0xda, 0xbf, 0x14, 0xa8, 0x39, 0xa3, 0xe3, 0x60, 0x9c, 0x61, 0xcc, 0xdb,
-	0x50, 0x49, 0x83, 0xe4, 0x2c, 0x48, 0x28, 0x6a, 0x51, 0xe4, 0x1a, 0x76, 0xa9, 0x3f, 0x92, 0x53,
-	0x17, 0x05, 0x7f, 0x44, 0x7e, 0xe3, 0x77, 0xc1, 0x89, 0x47, 0xd1, 0xd0, 0x8f, 0x34, 0x9c, 0x4d,
-	0x3c, 0x3a, 0xa6, 0x79, 0x17, 0x05, 0x8a, 0xfc, 0x21, 0xd4, 0x65, 0x8c, 0x21, 0x25, 0x5b, 0xa6,
-	0x64, 0x41, 0x9a, 0xec, 0x3c, 0x65, 0x7f, 0x24, 0xc1, 0x0a, 0x81, 0x15, 0x7f, 0x44, 0x00, 0x8e,
-	0xa4, 0xa8, 0x12, 0xac, 0xe6, 0x23, 0xc9, 0x44, 0x0e, 0x77, 0x41, 0x8d, 0x47, 0xc7, 0x12, 0x55,
-	0x09, 0xad, 0xc6, 0xa3, 0x63, 0x84, 0xb4, 0x7f, 0x2b, 0xa0, 0xee, 0x9c, 0x46, 0xe3, 0x2c, 0x8c,
-	0x23, 0xfe, 0x18, 0x4a, 0x93, 0xd3, 0x68, 0x4c, 0x53, 0xaa, 0xb7, 0x6e, 0xbc, 0x20, 0x32, 0x5a,
-	0xcc, 0x59, 0x10, 0xc8, 0x1f, 0x40, 0xc9, 0x4b, 0x8e, 0x70, 0xd9, 0x8a, 0x1b, 0xf5, 0x16, 0x48,
-	0x27, 0xe3, 0xfd, 0x2c, 0x11, 0x64, 0xd7, 0xfe, 0x96, 0x47, 0xdc, 0x99, 0x7a, 0x47, 0x58, 0xa6,
-	0xb6, 0x63, 0x1b, 0x6c, 0x69, 0x51, 0xe2, 0xb6, 0x6e, 0x31, 0x2c, 0xa8, 0x4a, 0xdf, 0xd5, 0x3b,
-	0x96, 0xc1, 0x0a, 0x88, 0xec, 0x3b, 0x96, 0xee, 0x9a, 0x96, 0xc1, 0x4a, 0x12, 0x11, 0x66, 0xd7,
-	0x65, 0x2a, 0x67, 0xb0, 0xdc, 0x13, 0xce, 0xf6, 0xa0, 0x6b, 0x0c, 0xed, 0x81, 0x65, 0x31, 0xc6,
-	0x3f, 0x83, 0x1b, 0x0b, 0x8b, 0x23, 0x8d, 0x4d, 0x1c, 0xb2, 0xaf, 0x0b, 0x5d, 0xec, 0xb2, 0x6f,
-	0xb1, 0x66, 0xf5, 0xdd, 0x5d, 0xf6, 0x23, 0x32, 0x56, 0xf1, 0xc0, 0xb4, 0xd9, 0x8f, 0x05, 0xed,
-	0x67, 0x05, 0x4a, 0x98, 0x20, 0x5f, 0x87, 0x62, 0x76, 0x31, 0xcb, 0xa7, 0x97, 0x67, 0x8e, 0x94,
-	0x27, 0xd0, 0xcc, 0xef, 0x81, 0x32, 0xa6, 0x9d, 0xab, 0xb7, 0xea, 0x12, 0xa3, 0x3a, 0x7f, 0xbd,
-	0x24, 0x14, 0x9c, 0xb5, 0x32, 0xa3, 0x2d, 0xac, 0xb7, 0x56, 0x25, 0x38, 0x2f, 0x4c, 0xc4, 0x67,
-	0x7c, 0x1d, 0x94, 0x33, 0xda, 0xcd, 0x7a, 0x6b, 0x59, 0xe2, 0xb2, 0x34, 0x11, 0x3d, 0xe3, 0x4d,
-	0x28, 0x8e, 0xe3, 0x29, 0xed, 0xe9, 0x02, 0x97, 0xc5, 0xf9, 0x7a, 0x49, 0x20, 0x84, 0xf1, 0x27,
-	0xb4, 0xad, 0x8b, 0xf8, 0xf3, 0x5d, 0xc1, 0x08, 0x93, 0x4e, 0x05, 0x4a, 0xc1, 0xfb, 0x59, 0xa2,
-	0xf5, 0xa8, 0xaa, 0xb7, 0x83, 0xc9, 0xff, 0x98, 0xcc, 0xbc, 0xe6, 0x0b, 0x57, 0x6a, 0x7e, 0x0d,
-	0xca, 0xb3, 0x3f, 0x85, 0xfe, 0xfb, 0xf9, 0xa9, 0x40, 0x8a, 0xf6, 0x2d, 0xa8, 0x2e, 0x1e, 0x1a,
-	0xdb, 0x9f, 0xe8, 0x94, 0x26, 0x94, 0xc6, 0xf1, 0x74, 0xbe, 0xdf, 0x97, 0xc9, 0x6f, 0x63, 0x45,
-	0x20, 0xa2, 0x25, 0x50, 0xea, 0xc6, 0x69, 0x86, 0xa3, 0xc7, 0x5e, 0x22, 0xcf, 0x28, 0x45, 0x90,
-	0xcc, 0x1b, 0x50, 0x4d, 0xe2, 0xf3, 0x34, 0xfc, 0xb3, 0x4c, 0x45, 0x11, 0x73, 0x15, 0x3b, 0x20,
-	0xf2, 0xcf, 0x24, 0x1f, 0x08, 0x14, 0x31, 0xbf, 0x34, 0xf3, 0x92, 0x8c, 0xd6, 0x51, 0x11, 0x52,
-	0x41, 0x6b, 0x16, 0x67, 0x9e, 0x5c, 0x3d, 0x45, 0x48, 0x45, 0xfb, 0xa7, 0x02, 0x55, 0x4c, 0xc2,
-	0xcb, 0x3c, 0x7e, 0x0f, 0x6a, 0x49, 0x7c, 0x3e, 0x1c, 0xc7, 0xa7, 0x51, 0x96, 0xf3, 0x83, 0x9a,
-	0xc4, 0xe7, 0x5d, 0xd4, 0xf9, 0x7d, 0x00, 0x64, 0xa5, 0x1c, 0x95, 0xbd, 0x5e, 0x43, 0x8b, 0x84,
-	0xd7, 0xa0, 0x8c, 0x0a, 0x36, 0x7b, 0x71, 0x43, 0x15, 0x52, 0xc1, 0xdc, 0xc2, 0xcd, 0x56, 0xa3,
-	0xd4, 0x2c, 0x22, 0xd7, 0x84, 0x9b, 0x2d, 0xb2, 0xb4, 0xb7, 0x1a, 0xe5, 0x66, 0x11, 0xfb, 0x35,
-	0x6c, 0x6f, 0xa1, 0x65, 0xb2, 0xd9, 0x6a, 0x54, 0x9a, 0xc5, 0x8d, 0x82, 0x40, 0x91, 0x2c, 0xed,
-	0xad, 0x46, 0xb5, 0x59, 0xc4, 0x19, 0x4d, 0xda, 0x5b, 0x7c, 0x19, 0x94, 0xb4, 0xa1, 0x36, 0x8b,
-	0x1b, 0x35, 0xa1, 0xa4, 0xda, 0x01, 0x80, 0x88, 0xcf, 0xd3, 0x20, 0xa3, 0xac, 0xbf, 0x58, 0x30,
-	0x83, 0x72, 0x75, 0xdb, 0xe7, 0x7b, 0xb1, 0x60, 0x8a, 0x47, 0x1f, 0xac, 0xff, 0xca, 0xe5, 0xfa,
-	0x7b, 0x99, 0x97, 0x6f, 0xc0, 0x5f, 0x0a, 0x50, 0x77, 0x12, 0x3f, 0x48, 0x3a, 0x17, 0xfd, 0x59,
-	0x30, 0xe6, 0x4f, 0x40, 0x8d, 0x51, 0x1d, 0x8e, 0x2e, 0x1a, 0xca, 0xb5, 0x36, 0xad, 0xc6, 0xd2,
-	0x95, 0xbf, 0x80, 0xcf, 0xe6, 0x6e, 0xc3, 0x71, 0x3c, 0x9d, 0x7a, 0x58, 0x6e, 0xf2, 0x47, 0x65,
-	0x71, 0x33, 0xf7, 0xea, 0x2e, 0x00, 0xde, 0x85, 0xd5, 0x85, 0xff, 0x64, 0xea, 0x1d, 0xc9, 0x45,
-	0x5b, 0x6d, 0xdd, 0xcf, 0x89, 0xe2, 0x32, 0x83, 0xb9, 0x8c, 0x1c, 0x20, 0x96, 0xe3, 0x4b, 0x25,
-	0xd5, 0x7e, 0x58, 0xa4, 0x4a, 0x04, 0x81, 0x57, 0x8d, 0x7e, 0x57, 0x5e, 0x35, 0xb6, 0x8d, 0x7e,
-	0x97, 0x29, 0xfc, 0x06, 0xd4, 0xb1, 0xb1, 0xfb, 0xc3, 0x1d, 0x53, 0xf4, 0x5d, 0x56, 0xc0, 0xb3,
-	0x4b, 0x1a, 0x2c, 0xbd, 0xef, 0x4a, 0x8a, 0x18, 0xd8, 0xe6, 0x77, 0x03, 0x83, 0xa9, 0x1f, 0xd0,
-	0x0a, 0x43, 0xee, 0x81, 0x83, 0x30, 0xf2, 0xe3, 0x73, 0x5a, 0x87, 0xaf, 0x60, 0x79, 0xe6, 0x25,
-	0x59, 0x88, 0xe9, 0x7f, 0x7c, 0x2d, 0xea, 0x0b, 0xbc, 0x73, 0xc1, 0xbf, 0x04, 0x35, 0xf6, 0x83,
-	0xe4, 0x02, 0x5d, 0x25, 0x0f, 0xdc, 0xbc, 0x36, 0x33, 0x51, 0x25, 0x97, 0xce, 0x05, 0x56, 0xfb,
-	0x34, 0xf0, 0xfc, 0xbc, 0x99, 0x48, 0xc6, 0x0a, 0x98, 0x7a, 0x47, 0xf9, 0xdd, 0x0a, 0x45, 0xed,
-	0x1f, 0x35, 0x28, 0xd9, 0xb1, 0x1f, 0xf0, 0xaf, 0xa1, 0x16, 0xc5, 0x7e, 0x30, 0xcc, 0x2e, 0x66,
-	0xb2, 0xbf, 0x56, 0x5b, 0x9f, 0xc9, 0xe8, 0x08, 0xd3, 0x87, 0xba, 0x57, 0x8d, 0x72, 0x09, 0xf9,
-	0x9e, 0x46, 0x84, 0xfe, 0xfc, 0x88, 0x42, 0xd5, 0xf4, 0x91, 0x81, 0xc7, 0x71, 0x9a, 0xe5, 0x74,
-	0x04, 0xf3, 0x8a, 0x48, 0x33, 0x41, 0x76, 0x9a, 0x76, 0x12, 0x23, 0x6b, 0x0f, 0xa7, 0x61, 0x9a,
-	0x51, 0x19, 0xff, 0x72, 0xda, 0x12, 0xb7, 0xc2, 0x34, 0xc3, 0x8b, 0xe4, 0xf8, 0x5d, 0x38, 0xf5,
-	0x93, 0x20, 0xa2, 0xfa, 0x2e, 0x8b, 0x85, 0x8e, 0x59, 0x1f, 0xc7, 0x61, 0x24, 0xb3, 0xae, 0x5c,
-	0xcb, 0xfa, 0x4d, 0x1c, 0x46, 0xb4, 0xc7, 0x2a, 0x7a, 0x51, 0xd6, 0x8f, 0xa1, 0x1a, 0x47, 0xf2,
-	0xbf, 0xd5, 0x6b, 0xff, 0xad, 0xc4, 0x11, 0xfd, 0xf2, 0x29, 0xc0, 0xf9, 0xbb, 0x20, 0x09, 0xa4,
-	0x9f, 0x7a, 0xcd, 0xaf, 0x46, 0x28, 0xb9, 0x3e, 0x01, 0xf5, 0x28, 0x89, 0x4f, 0x67, 0xb8, 0x29,
-	0xb5, 0xeb, 0xb5, 0x4c, 0x58, 0xe7, 0x02, 0xe7, 0x4c, 0x62, 0x18, 0x1d, 0x0d, 0xd3, 0x20, 0x6b,
-	0xc0, 0xf5, 0x39, 0xcf, 0xf1, 0x7e, 0x90, 0xd1, 0x56, 0xcf, 0x3b, 0xa4, 0xfe, 0xe9, 0xad, 0xce,
-	0x1b, 0xe5, 0x39, 0xa8, 0xe7, 0x61, 0x34, 0x4c, 0x67, 0xc1, 0xb8, 0xb1, 0x4c, 0xde, 0x4c, 0x7a,
-	0x5f, 0xd6, 0x9a, 0xa8, 0x9e, 0x87, 0x11, 0x15, 0x5d, 0x13, 0xca, 0xd3, 0xf0, 0x24, 0xcc, 0x1a,
-	0x2b, 0x57, 0xb7, 0x87, 0x52, 0x90, 0x00, 0xd7, 0xa0, 0x12, 0x4f, 0x26, 0x98, 0xe5, 0xea, 0x35,
-	0x97, 0x1c, 0xe1, 0xcf, 0xa1, 0x96, 0x21, 0x13, 0x0c, 0xfd, 0x60, 0xd2, 0xb8, 0xf1, 0x51, 0x82,
-	0x50, 0xb3, 0x39, 0x6d, 0x6f, 0x00, 0x9e, 0xe7, 0xc3, 0x24, 0x98, 0x34, 0xd8, 0xc7, 0x8f, 0xee,
-	0x4a, 0x3c, 0x3a, 0xc6, 0x6b, 0xcb, 0x4b, 0xa8, 0x27, 0x44, 0x41, 0x43, 0xdf, 0xcb, 0xbc, 0xc6,
-	0xcd, 0xab, 0x93, 0xb9, 0xe4, 0x26, 0x01, 0xc9, 0x25, 0x4f, 0x3d, 0x86, 0x95, 0xe0, 0x7d, 0x96,
-	0x78, 0xc3, 0x78, 0x26, 0xf9, 0x81, 0xd3, 0xe1, 0xb0, 0x4c, 0x46, 0x47, 0xda, 0xb4, 0x9f, 0x0a,
-	0xa0, 0xce, 0x4b, 0x98, 0xee, 0xee, 0xf6, 0x5b, 0xdb, 0x39, 0xb0, 0xd9, 0x12, 0x36, 0xef, 0xbe,
-	0x6e, 0x0d, 0x8c, 0x61, 0xbf, 0xab, 0xdb, 0x4c, 0x41, 0x9d, 0x0e, 0x7e, 0xa9, 0x17, 0xf8, 0x4d,
-	0x58, 0xd9, 0x19, 0xd8, 0x5d, 0xd7, 0x74, 0x6c, 0x69, 0x2a, 0xa2, 0xc9, 0xf8, 0x5e, 0xf6, 0xb4,
-	0x34, 0x95, 0x30, 0x64, 0x4f, 0x38, 0x6f, 0x8c, 0xae, 0xcb, 0x80, 0xdf, 0x82, 0x9b, 0x0b, 0x7c,
-	0x3e, 0x96, 0xd5, 0x91, 0x0a, 0xf6, 0x74, 0xd7, 0x10, 0xa6, 0x6e, 0xb1, 0x35, 0x0c, 0x22, 0x8c,
-	0xee, 0x40, 0xf4, 0xcd, 0x7d, 0x63, 0xd8, 0x75, 0x0d, 0x76, 0x8b, 0x5e, 0x33, 0xa6, 0xfd, 0x96,
-	0xdd, 0xc6, 0x7b, 0x32, 0x4a, 0x32, 0xfa, 0x1d, 0x22, 0xa1, 0xdd, 0x5d, 0xf6, 0x80, 0x6e, 0xd5,
-	0x8e, 0x69, 0xb3, 0x87, 0x74, 0x0d, 0xd1, 0xf7, 0xf0, 0xca, 0xdb, 0xa4, 0x71, 0x8e, 0x70, 0xd9,
-	0x23, 0xba, 0xe3, 0xdb, 0xf8, 0x37, 0x0d, 0x43, 0x90, 0x38, 0xd4, 0x2d, 0x8b, 0x3d, 0xbe, 0xc2,
-	0x49, 0xbf, 0x42, 0xf9, 0xc0, 0xb4, 0xb7, 0x9d, 0x03, 0xf6, 0x04, 0xdd, 0x3a, 0xc2, 0xd1, 0xb7,
-	0xbb, 0x48, 0x5d, 0xf4, 0xa0, 0xe8, 0xf7, 0x2c, 0xd3, 0x65, 0x4f, 0xd1, 0x6b, 0x57, 0x77, 0x5f,
-	0x1b, 0x82, 0x3d, 0xa3, 0xa7, 0x49, 0xbf, 0x6f, 0x08, 0x97, 0xb5, 0xb4, 0x01, 0xa8, 0xf3, 0xb6,
-	0x92, 0x6f, 0x29, 0xdb, 0x10, 0x6c, 0x09, 0x45, 0x67, 0xe0, 0x1a, 0xf9, 0x5b, 0xac, 0x6f, 0xec,
-	0x99, 0xac, 0x80, 0x92, 0x6e, 0xbb, 0x66, 0x7e, 0x6d, 0x32, 0xed, 0x5d, 0x0b, 0x39, 0x51, 0x85,
-	0xd2, 0x9e, 0x2e, 0xde, 0x32, 0x46, 0x0f, 0x80, 0x5e, 0xcf, 0x3a, 0x64, 0x4d, 0x6d, 0x03, 0xaa,
-	0xfa, 0xd1, 0xd1, 0x1e, 0x52, 0x91, 0x0a, 0xa5, 0x1d, 0xbc, 0x39, 0x2d, 0xd1, 0x2b, 0xc0, 0x71,
-	0x5d, 0x67, 0x8f, 0x29, 0xb8, 0x08, 0xae, 0xd3, 0x63, 0x05, 0xed, 0x3f, 0x0a, 0x94, 0xbf, 0x3b,
-	0x0d, 0x92, 0x0b, 0xde, 0x86, 0x5a, 0x9a, 0x9d, 0x64, 0x57, 0x39, 0xeb, 0xae, 0xac, 0x15, 0xc2,
-	0x5f, 0xf4, 0x33, 0x2f, 0x0b, 0x4e, 0x82, 0x28, 0x93, 0xcc, 0x85, 0xbe, 0x54, 0x00, 0x74, 0x90,
-	0x07, 0xb3, 0xf9, 0x51, 0x22, 0x15, 0x6c, 0x0c, 0x24, 0x30, 0x79, 0x6a, 0x2c, 0xaa, 0x1e, 0xab,
-	0x46, 0x48, 0x00, 0x1b, 0x63, 0x86, 0xb7, 0xaa, 0xf4, 0x23, 0x94, 0x95, 0x23, 0xda, 0x01, 0xac,
-	0x7c, 0xf0, 0xdb, 0x0f, 0xab, 0x0d, 0x97, 0xc1, 0xb0, 0xb0, 0x4c, 0x14, 0xf9, 0xfa, 0xa4, 0x45,
-	0x2d, 0xa0, 0xbc, 0x6d, 0x58, 0x86, 0x6b, 0xb0, 0x22, 0x6d, 0x55, 0x8f, 0xde, 0x67, 0x25, 0x5c,
-	0xa0, 0x3d, 0x43, 0xec, 0x1a, 0xac, 0xfc, 0x6c, 0xff, 0x97, 0x81, 0x69, 0x4b, 0x31, 0xf0, 0xff,
-	0x15, 0x77, 0x54, 0xa1, 0xd7, 0xfc, 0xe6, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xb9, 0xe9, 0x03,
-	0xbd, 0xdb, 0x0f, 0x00, 0x00,
+	// 2214 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x72, 0xdb, 0xc8,
+	0xf1, 0x17, 0xf8, 0x09, 0x36, 0x25, 0x79, 0x3c, 0x2b, 0xdb, 0xf0, 0x5a, 0xb6, 0x69, 0xf8, 0x6f,
+	0xff, 0x65, 0x7b, 0xd7, 0xb5, 0xa6, 0xb8, 0x2c, 0xa7, 0xf2, 0x51, 0x0b, 0x92, 0x90, 0x0c, 0x1b,
+	0x04, 0xb8, 0x43, 0x50, 0x5a, 0x65, 0x0f, 0x2c, 0x90, 0x00, 0x25, 0x28, 0x10, 0xc0, 0x02, 0x20,
+	0xc9, 0xca, 0x69, 0x4f, 0x39, 0x26, 0xb9, 0xe4, 0x11, 0x72, 0x4f, 0xde, 0x62, 0x73, 0xce, 0x13,
+	0xe4, 0x4d, 0x52, 0x3d, 0x03, 0x52, 0xb2, 0x65, 0x57, 0x0e, 0xc9, 0x85, 0xd5, 0xdd, 0xbf, 0x9e,
+	0x46, 0xcf, 0x4c, 0xf7, 0x6f, 0x66, 0x08, 0x30, 0x0f, 0xdd, 0xe8, 0xe5, 0x3c, 0x89, 0xb3, 0x98,
+	0x96, 0x50, 0x56, 0xff, 0x58, 0x86, 0x92, 0x73, 0x31, 0xf7, 0xe9, 0x3a, 0x14, 0x02, 0x4f, 0x91,
+	0x1a, 0xd2, 0xd6, 0x1a, 0x2b, 0x04, 0x1e, 0xfd, 0x12, 0xe4, 0xe8, 0x34, 0x0c, 0xdd, 0x49, 0xe8,
+	0x2b, 0x85, 0x86, 0xb4, 0x25, 0xb3, 0xa5, 0x4e, 0x37, 0xa0, 0x7c, 0x1e, 0x78, 0xd9, 0x91, 0x52,
+	0x6c, 0x48, 0x5b, 0x65, 0x26, 0x14, 0xba, 0x09, 0xb5, 0x79, 0xe2, 0x4f, 0x83, 0x34, 0x88, 0x23,
+	0xa5, 0xc4, 0x91, 0x4b, 0x83, 0xfa, 0xd7, 0x12, 0x54, 0xf0, 0x43, 0x86, 0x47, 0xab, 0x50, 0xd4,
+	0xac, 0x03, 0xb2, 0x42, 0x65, 0x28, 0x0d, 0x1d, 0x8d, 0x11, 0x09, 0xa5, 0x8e, 0x6d, 0x9b, 0x04,
+	0x50, 0x32, 0x2c, 0xe7, 0x35, 0xd9, 0xa0, 0x35, 0x28, 0x1b, 0x96, 0xf3, 0xaa, 0x4d, 0x6e, 0xe5,
+	0xe2, 0x76, 0x93, 0xdc, 0xce, 0xc5, 0x76, 0x8b, 0xdc, 0xa1, 0x00, 0x15, 0x74, 0x68, 0xbe, 0x26,
+	0x0a, 0x9a, 0x47, 0x7c, 0xdc, 0x5d, 0x34, 0x8f, 0xc4, 0xc0, 0x2f, 0x17, 0xf2, 0x76, 0x93, 0xdc,
+	0x5b, 0xc8, 0xed, 0x16, 0xd9, 0xa4, 0x75, 0xa8, 0x8e, 0xf2, 0xb1, 0xf7, 0x51, 0xd9, 0x31, 0x6d,
+	0x0d, 0xbd, 0x1e, 0x2c, 0x95, 0x76, 0x8b, 0x3c, 0xa4, 0x6b, 0x50, 0xeb, 0xe9, 0x5d, 0xa3, 0xaf,
+	0x99, 0xed, 0x16, 0x69, 0xd0, 0x75, 0x80, 0x5c, 0xc5, 0x81, 0x8f, 0xd0, 0x37, 0xd7, 0x89, 0x8a,
+	0xe1, 0x35, 0xeb, 0xc0, 0xb0, 0x1c, 0xf2, 0x84, 0xae, 0x82, 0xac, 0x59, 0x07, 0x3c, 0x0e, 0x79,
+	0x8a, 0x51, 0x34, 0xeb, 0xc0, 0x1a, 0xf5, 0x3b, 0x3a, 0x23, 0xff, 0x8f, 0x33, 0x1c, 0x8d, 0x8c,
+	0x1e, 0xd9, 0xe2, 0x49, 0x77, 0x5e, 0xb5, 0xbf, 0x21, 0xcf, 0x72, 0xf1, 0x75, 0x8b, 0x3c, 0xcf,
+	0xc5, 0x5f, 0x34, 0xc9, 0x0b, 0x21, 0x36, 0x9b, 0x2d, 0xf2, 0x55, 0x2e, 0x7e, 0xdb, 0x26, 0x5f,
+	0x63, 0x80, 0x9e, 0xe6, 0xe8, 0xa4, 0x89, 0x92, 0x63, 0xf4, 0x75, 0xb2, 0x8d, 0x5f, 0x44, 0x1b,
+	0xd7, 0x5a, 0xf8, 0x45, 0x94, 0x86, 0x8e, 0xd6, 0x1f, 0x90, 0x6f, 0x11, 0x34, 0x2c, 0x47, 0x67,
+	0x7b, 0x9a, 0x49, 0xda, 0x98, 0xb5, 0x66, 0x1d, 0x70, 0xcf, 0x5f, 0x62, 0x84, 0xee, 0x1b, 0x8d,
+	0x91, 0x5f, 0xa1, 0x79, 0x4f, 0x63, 0x5c, 0xf9, 0x35, 0x9a, 0xdf, 0x0e, 0x6d, 0x8b, 0xfc, 0x06,
+	0xa7, 0xd5, 0x31, 0x2c, 0x8d, 0x1d, 0x90, 0x1d, 0x0c, 0xbb, 0xa7, 0xb1, 0x5c, 0xdd, 0xc5, 0x94,
+	0x34, 0xc6, 0xb4, 0x03, 0xf2, 0x5b, 0x5c, 0x99, 0x1d, 0x53, 0xff, 0xa1, 0x33, 0xda, 0xd9, 0xd1,
+	0x19, 0xf9, 0x91, 0x8f, 0x3a, 0x70, 0x74, 0xed, 0x35, 0xf1, 0x30, 0x30, 0x97, 0x5f, 0xb5, 0x89,
+	0x8f, 0x63, 0xb8, 0x42, 0x66, 0x54, 0x86, 0xe2, 0x50, 0x37, 0xc9, 0xcf, 0x12, 0x05, 0x28, 0x3b,
+	0xa3, 0x81, 0xa9, 0x93, 0x7f, 0x48, 0xea, 0x31, 0x94, 0xbb, 0x71, 0x94, 0x66, 0xf4, 0x36, 0x54,
+	0x82, 0x14, 0x4b, 0x8e, 0x17, 0xa5, 0xcc, 0x72, 0x8d, 0x6e, 0x40, 0x29, 0x38, 0x73, 0x43, 0x5e,
+	0x94, 0xc5, 0x37, 0x2b, 0x8c, 0x6b, 0x68, 0xf5, 0xd0, 0x8a, 0x15, 0x29, 0xa1, 0xd5, 0xcb, 0xad,
+	0x29, 0x5a, 0xb1, 0x1a, 0x6b, 0x68, 0x45, 0xad, 0x53, 0x85, 0xf2, 0x99, 0x1b, 0x9e, 0xfa, 0xea,
+	0x26, 0xc8, 0x03, 0x37, 0x71, 0x4f, 0x98, 0x3f, 0xa3, 0x04, 0x8a, 0xf3, 0x38, 0xe5, 0xdf, 0x2a,
+	0x33, 0x14, 0xd5, 0x4d, 0xa8, 0xec, 0xb9, 0x09, 0x62, 0x14, 0x4a, 0x91, 0x7b, 0xe2, 0x73, 0xb0,
+	0xc6, 0xb8, 0xac, 0x5a, 0x50, 0xe9, 0xc6, 0xe1, 0x67, 0x50, 0x7a, 0x07, 0xaa, 0x89, 0x1f, 0x8e,
+	0x31, 0x62, 0x81, 0x47, 0xac, 0x24, 0x7e, 0x38, 0x88, 0x53, 0x04, 0xa6, 0xb1, 0x00, 0x44, 0xf3,
+	0x54, 0xa6, 0x31, 0x02, 0xea, 0x11, 0x40, 0x37, 0x4e, 0x92, 0xff, 0x65, 0x4c, 0xec, 0x53, 0xcf,
+	0x9f, 0x67, 0x47, 0x79, 0x37, 0x0a, 0x45, 0xf5, 0x41, 0x1e, 0x9e, 0x4e, 0xbe, 0x3f, 0xf5, 0x93,
+	0x0b, 0x1c, 0x1a, 0xc5, 0x9e, 0x3f, 0xce, 0x5b, 0xbf, 0xcc, 0x2a, 0xa8, 0x1a, 0x1e, 0x7d, 0x0c,
+	0x6b, 0x41, 0x3a, 0x9e, 0xc6, 0x49, 0xe2, 0x87, 0x6e, 0xe6, 0x7b, 0x39, 0x07, 0xac, 0x06, 0x69,
+	0x77, 0x69, 0xa3, 0xf7, 0xa0, 0x16, 0xa4, 0xe3, 0x74, 0xea, 0x86, 0x6e, 0xc2, 0x3f, 0x2d, 0x33,
+	0x39, 0x48, 0x87, 0x5c, 0x57, 0xff, 0x29, 0x41, 0xcd, 0x9e, 0x1c, 0xfb, 0xd3, 0x0c, 0x27, 0x74,
+	0x1b, 0x2a, 0xa9, 0x9f, 0x9c, 0xf9, 0x09, 0xff, 0x4e, 0x91, 0xe5, 0x1a, 0xd2, 0x8e, 0x37, 0x11,
+	0x7b, 0xc9, 0x0a, 0xde, 0x84, 0xfb, 0x4d, 0x8f, 0xfc, 0x13, 0x57, 0x29, 0xe6, 0x7e, 0x5c, 0xc3,
+	0xed, 0x89, 0x27, 0xc7, 0x7c, 0x22, 0x45, 0x86, 0x22, 0x7d, 0x08, 0x75, 0x11, 0x63, 0xcc, 0x57,
+	0xaa, 0xcc, 0x57, 0x0a, 0x84, 0xc9, 0xca, 0xd7, 0xcb, 0x9b, 0x08, 0xb0, 0xc2, 0xc1, 0x8a, 0x37,
+	0xe1, 0x00, 0x8e, 0xe4, 0x51, 0x05, 0x58, 0xcd, 0x47, 0x72, 0x13, 0x77, 0xb8, 0x0b, 0x72, 0x3c,
+	0x39, 0x16, 0xa8, 0xcc, 0xd1, 0x6a, 0x3c, 0x39, 0x46, 0x48, 0xfd, 0x97, 0x04, 0xf2, 0xce, 0x69,
+	0x34, 0xcd, 0x82, 0x38, 0xa2, 0x8f, 0xa1, 0x34, 0x3b, 0x8d, 0xa6, 0x7c, 0x4a, 0xf5, 0xe6, 0x8d,
+	0x97, 0x9c, 0x5d, 0x97, 0x73, 0x66, 0x1c, 0xa4, 0x0f, 0xa0, 0xe4, 0x26, 0x87, 0xb8, 0x67, 0xc5,
+	0xad, 0x7a, 0x13, 0x84, 0x93, 0xfe, 0x7e, 0x9e, 0x30, 0x6e, 0x57, 0xff, 0x94, 0x47, 0xdc, 0x09,
+	0xdd, 0x43, 0xec, 0x3b, 0xcb, 0xb6, 0x74, 0xb2, 0xb2, 0xec, 0x59, 0x4b, 0x33, 0x09, 0x76, 0x48,
+	0x65, 0xe8, 0x68, 0x1d, 0x53, 0x27, 0x05, 0x44, 0xf6, 0x6c, 0x53, 0x73, 0x0c, 0x53, 0x27, 0x25,
+	0x81, 0x30, 0xa3, 0xeb, 0x10, 0x99, 0x12, 0x58, 0x1d, 0x30, 0xbb, 0x37, 0xea, 0xea, 0x63, 0x6b,
+	0x64, 0x9a, 0x84, 0xd0, 0x2f, 0xe0, 0xc6, 0xd2, 0x62, 0x0b, 0x63, 0x03, 0x87, 0xec, 0x69, 0x4c,
+	0x63, 0xbb, 0xe4, 0x3b, 0x6c, 0x42, 0x6d, 0x77, 0x97, 0xfc, 0x84, 0x14, 0x5c, 0xdc, 0x37, 0x2c,
+	0xf2, 0x53, 0x41, 0xfd, 0x4b, 0x01, 0x4a, 0x98, 0x20, 0xdd, 0x84, 0x62, 0x76, 0x31, 0xcf, 0xa7,
+	0x97, 0x67, 0x8e, 0x1c, 0xce, 0xd0, 0x4c, 0xef, 0x81, 0x34, 0xe5, 0x3b, 0x57, 0x6f, 0xd6, 0x05,
+	0xc6, 0x1b, 0xf7, 0xcd, 0x0a, 0x93, 0x70, 0xd6, 0xd2, 0x9c, 0x6f, 0x61, 0xbd, 0xb9, 0x2e, 0xc0,
+	0x45, 0xa7, 0x21, 0x3e, 0xa7, 0x9b, 0x20, 0x9d, 0xf1, 0xdd, 0xac, 0x37, 0x57, 0x05, 0x2e, 0x7a,
+	0x0d, 0xd1, 0x33, 0xda, 0x80, 0xe2, 0x34, 0x0e, 0x95, 0xf2, 0x55, 0x5c, 0x74, 0xc6, 0x9b, 0x15,
+	0x86, 0x10, 0xc6, 0x9f, 0x29, 0x95, 0xab, 0xf1, 0x17, 0xbb, 0x82, 0x11, 0x66, 0x54, 0x85, 0x62,
+	0x7a, 0x3a, 0x51, 0xaa, 0x57, 0x3d, 0x16, 0x55, 0x8f, 0x31, 0xd2, 0xd3, 0x09, 0x7d, 0x0a, 0x25,
+	0x2c, 0x70, 0xbe, 0xc5, 0xf5, 0x26, 0x59, 0x7c, 0x66, 0xd1, 0x84, 0xc8, 0x17, 0x88, 0x77, 0x2a,
+	0x50, 0xf2, 0xdf, 0xcf, 0x13, 0x75, 0xc0, 0x5b, 0xbe, 0xe7, 0xcf, 0xfe, 0xc3, 0xc2, 0x2c, 0x9a,
+	0xb7, 0x70, 0xa5, 0x79, 0x37, 0xa0, 0x3c, 0xff, 0x5d, 0xe0, 0xbd, 0x5f, 0x1c, 0x99, 0x5c, 0x51,
+	0xbf, 0x03, 0xd9, 0xc1, 0x13, 0xb5, 0xf7, 0x99, 0x96, 0x6f, 0x60, 0x86, 0xe1, 0xa2, 0x76, 0x2e,
+	0x17, 0xa2, 0x87, 0xd5, 0x85, 0x88, 0x9a, 0x40, 0xa9, 0x1b, 0xa7, 0x19, 0x8e, 0x9e, 0xba, 0x89,
+	0xe8, 0x62, 0x89, 0x71, 0x99, 0x2a, 0x50, 0x4d, 0xe2, 0xf3, 0x34, 0xf8, 0xbd, 0x48, 0x45, 0x62,
+	0x0b, 0x15, 0xbb, 0x29, 0xf2, 0xce, 0x04, 0x59, 0x32, 0x14, 0x31, 0xbf, 0x34, 0x73, 0x93, 0x8c,
+	0xef, 0x89, 0xc4, 0x84, 0x82, 0xd6, 0x2c, 0xce, 0x5c, 0xb1, 0x13, 0x12, 0x13, 0x8a, 0xfa, 0x77,
+	0x09, 0xaa, 0x98, 0x84, 0x9b, 0xb9, 0x48, 0x01, 0x49, 0x7c, 0x3e, 0x9e, 0xc6, 0xa7, 0x51, 0x96,
+	0x53, 0x88, 0x9c, 0xc4, 0xe7, 0x5d, 0xd4, 0xe9, 0x7d, 0x00, 0xa4, 0xec, 0x1c, 0x15, 0xa4, 0x55,
+	0x43, 0x8b, 0x80, 0x37, 0xa0, 0x8c, 0x0a, 0xb2, 0x56, 0x71, 0x4b, 0x66, 0x42, 0xc1, 0xdc, 0x82,
+	0xed, 0xa6, 0x52, 0x6a, 0x14, 0x91, 0x88, 0x83, 0xed, 0x26, 0xb7, 0xb4, 0x5b, 0x4a, 0xb9, 0x51,
+	0xc4, 0xde, 0x0f, 0xda, 0x2d, 0xb4, 0xcc, 0xb6, 0x9b, 0x4a, 0xa5, 0x51, 0xdc, 0x2a, 0x30, 0x14,
+	0xb9, 0xa5, 0xdd, 0x52, 0xaa, 0x8d, 0x22, 0xce, 0x68, 0xd6, 0x6e, 0xd1, 0x55, 0x90, 0x52, 0x45,
+	0x6e, 0x14, 0xb7, 0x6a, 0x4c, 0x4a, 0xd5, 0x7d, 0x00, 0x16, 0x9f, 0xa7, 0x7e, 0xc6, 0xb3, 0x7e,
+	0xba, 0x64, 0x19, 0xe9, 0x6a, 0x81, 0x2c, 0xf6, 0x62, 0xc9, 0x3a, 0x8f, 0x3e, 0x58, 0xff, 0xb5,
+	0xcb, 0xf5, 0x77, 0x33, 0x37, 0xdf, 0x80, 0x3f, 0x14, 0xa0, 0x6e, 0x27, 0x9e, 0x9f, 0x74, 0x2e,
+	0x86, 0x73, 0x7f, 0x4a, 0x9f, 0x80, 0x1c, 0xa3, 0x3a, 0x9e, 0x5c, 0x28, 0xd2, 0xb5, 0x96, 0xaf,
+	0xc6, 0xc2, 0x95, 0xbe, 0x84, 0x2f, 0x16, 0x6e, 0xe3, 0x69, 0x1c, 0x86, 0x2e, 0x96, 0xae, 0xf8,
+	0x50, 0x99, 0xdd, 0xcc, 0xbd, 0xba, 0x4b, 0x80, 0x76, 0x61, 0x7d, 0xe9, 0x3f, 0x0b, 0xdd, 0x43,
+	0xb1, 0x68, 0xeb, 0xcd, 0xfb, 0x39, 0xe9, 0x5c, 0x66, 0xb0, 0x90, 0x91, 0x4f, 0xd8, 0x6a, 0x7c,
+	0xa9, 0xa4, 0xea, 0x8f, 0xcb, 0x54, 0x51, 0xe7, 0xf7, 0xb0, 0x61, 0x57, 0xdc, 0xc3, 0x7a, 0xfa,
+	0xb0, 0x4b, 0x24, 0x7a, 0x03, 0xea, 0x48, 0x12, 0xc3, 0xf1, 0x8e, 0xc1, 0x86, 0x0e, 0x29, 0xe0,
+	0xc1, 0x2e, 0x0c, 0xa6, 0x36, 0x74, 0x04, 0xdd, 0x8c, 0x2c, 0xe3, 0xfb, 0x91, 0x4e, 0xe4, 0x0f,
+	0x28, 0x8a, 0x20, 0x8f, 0xc1, 0x7e, 0x10, 0x79, 0xf1, 0x39, 0x5f, 0x87, 0xaf, 0x61, 0x75, 0xee,
+	0x26, 0x59, 0x80, 0xe9, 0x7f, 0x7a, 0x2d, 0xea, 0x4b, 0xbc, 0x73, 0x41, 0xbf, 0x02, 0x39, 0xf6,
+	0xfc, 0xe4, 0x02, 0x5d, 0x05, 0xa7, 0xdc, 0xbc, 0x36, 0x33, 0x56, 0xe5, 0x2e, 0x9d, 0x0b, 0xac,
+	0xf6, 0xd0, 0x77, 0xbd, 0xbc, 0x99, 0xb8, 0x8c, 0x15, 0x10, 0xba, 0x87, 0xf9, 0x51, 0x87, 0xa2,
+	0xfa, 0xb7, 0x1a, 0x94, 0xac, 0xd8, 0xf3, 0xe9, 0x37, 0x50, 0xe3, 0xa7, 0x5c, 0x76, 0x31, 0x17,
+	0xfd, 0xb5, 0xde, 0xfc, 0x42, 0x44, 0x47, 0x98, 0xff, 0xf0, 0xee, 0x95, 0xa3, 0x5c, 0xba, 0x7a,
+	0x2e, 0x16, 0x3e, 0x38, 0x17, 0x1f, 0x60, 0x45, 0xa4, 0x59, 0x4e, 0x6d, 0xb0, 0xa8, 0x88, 0x34,
+	0x63, 0xdc, 0xce, 0xa7, 0x9d, 0xc4, 0x78, 0x02, 0x8c, 0xc3, 0x20, 0xcd, 0x78, 0x19, 0x7f, 0x3c,
+	0x6d, 0x81, 0x9b, 0x41, 0x9a, 0xe1, 0x2d, 0x7b, 0x7a, 0x14, 0x84, 0x5e, 0xe2, 0x47, 0xbc, 0xbe,
+	0xcb, 0x6c, 0xa9, 0x63, 0xd6, 0xc7, 0x71, 0x10, 0x89, 0xac, 0x2b, 0xd7, 0xb2, 0x7e, 0x1b, 0x07,
+	0x11, 0xdf, 0x63, 0x19, 0xbd, 0x78, 0xd6, 0x8f, 0xa1, 0x1a, 0x47, 0xe2, 0xbb, 0xd5, 0x6b, 0xdf,
+	0xad, 0xc4, 0x11, 0xff, 0xe4, 0x33, 0x80, 0xf3, 0x23, 0x3f, 0xf1, 0x85, 0x9f, 0x7c, 0xcd, 0xaf,
+	0xc6, 0x51, 0xee, 0xfa, 0x04, 0xe4, 0xc3, 0x24, 0x3e, 0x9d, 0xe3, 0xa6, 0xd4, 0xae, 0xd7, 0x32,
+	0xc7, 0x3a, 0x17, 0x38, 0x67, 0x2e, 0x06, 0xd1, 0xe1, 0x38, 0xf5, 0x33, 0x05, 0xae, 0xcf, 0x79,
+	0x81, 0x0f, 0xfd, 0x8c, 0x6f, 0xf5, 0xa2, 0x43, 0xea, 0x9f, 0xdf, 0x6a, 0xa1, 0xd0, 0x17, 0x20,
+	0x9f, 0x07, 0xd1, 0x38, 0x9d, 0xfb, 0x53, 0x65, 0xf5, 0x2a, 0x51, 0x5f, 0xd6, 0x1a, 0xab, 0x9e,
+	0x07, 0x11, 0x0a, 0xb4, 0x01, 0xe5, 0x30, 0x38, 0x09, 0x32, 0x65, 0xad, 0x21, 0x7d, 0x94, 0x82,
+	0x00, 0xa8, 0x0a, 0x95, 0x78, 0x36, 0xc3, 0x2c, 0xd7, 0xaf, 0xb9, 0xe4, 0x08, 0x7d, 0x01, 0xb5,
+	0x0c, 0x99, 0x60, 0xec, 0xf9, 0x33, 0xe5, 0xc6, 0x27, 0x09, 0x42, 0xce, 0x72, 0x89, 0x6e, 0x01,
+	0xde, 0x0d, 0xc6, 0x89, 0x3f, 0x53, 0xc8, 0xa7, 0xaf, 0x01, 0x95, 0x78, 0x72, 0x8c, 0x57, 0xa0,
+	0x57, 0x50, 0x4f, 0x38, 0x05, 0x8d, 0x3d, 0x37, 0x73, 0x95, 0x9b, 0x57, 0x27, 0x73, 0xc9, 0x4d,
+	0x0c, 0x92, 0xa5, 0x8c, 0xb7, 0x30, 0xff, 0x7d, 0x96, 0xb8, 0xe3, 0x78, 0x2e, 0xf8, 0x81, 0xf2,
+	0xc3, 0x61, 0x95, 0x1b, 0x6d, 0x61, 0x53, 0x7f, 0x2e, 0x80, 0xbc, 0x28, 0x61, 0xfe, 0xb0, 0xb1,
+	0xde, 0x59, 0xf6, 0xbe, 0x45, 0x56, 0xb0, 0x79, 0xf7, 0x34, 0x73, 0xa4, 0x8f, 0x87, 0x5d, 0xcd,
+	0x22, 0x12, 0xea, 0xfc, 0x12, 0x21, 0xf4, 0x02, 0xbd, 0x09, 0x6b, 0x3b, 0x23, 0xab, 0xeb, 0x18,
+	0xb6, 0x25, 0x4c, 0x45, 0x34, 0xe9, 0x3f, 0x88, 0x9e, 0x16, 0xa6, 0x12, 0x86, 0x1c, 0x30, 0xfb,
+	0xad, 0xde, 0x75, 0x08, 0xd0, 0x5b, 0x70, 0x73, 0x89, 0x2f, 0xc6, 0x92, 0x3a, 0x52, 0x41, 0x5f,
+	0x73, 0x74, 0x66, 0x68, 0x26, 0xd9, 0xc0, 0x20, 0x4c, 0xef, 0x8e, 0xd8, 0xd0, 0xd8, 0xd3, 0xc7,
+	0x5d, 0x47, 0x27, 0xb7, 0xf8, 0x53, 0xcf, 0xb0, 0xde, 0x91, 0xdb, 0xf8, 0x88, 0x40, 0x49, 0x44,
+	0xbf, 0xc3, 0x49, 0x68, 0x77, 0x97, 0x3c, 0xe0, 0x4f, 0x0e, 0xdb, 0xb0, 0xc8, 0x43, 0x7e, 0xa5,
+	0xd1, 0xfa, 0xf8, 0x1e, 0x68, 0xf0, 0x71, 0x36, 0x73, 0xc8, 0x23, 0xfe, 0x00, 0xb2, 0xf0, 0x6b,
+	0x2a, 0x86, 0xe0, 0xe2, 0x58, 0x33, 0x4d, 0xf2, 0xf8, 0x0a, 0x27, 0xfd, 0x1f, 0xca, 0xfb, 0x86,
+	0xd5, 0xb3, 0xf7, 0xc9, 0x13, 0x74, 0xeb, 0x30, 0x5b, 0xeb, 0x75, 0x91, 0xba, 0xf8, 0x6b, 0x6b,
+	0x38, 0x30, 0x0d, 0x87, 0x3c, 0x43, 0xaf, 0x5d, 0xcd, 0x79, 0xa3, 0x33, 0xf2, 0x1c, 0x65, 0x6d,
+	0x38, 0xd4, 0x99, 0x43, 0x9a, 0xea, 0x08, 0xe4, 0x45, 0x5b, 0x89, 0x87, 0xa6, 0xa5, 0x33, 0xb2,
+	0x82, 0xa2, 0x3d, 0x72, 0xf4, 0xfc, 0xa1, 0x3a, 0xd4, 0xfb, 0x06, 0x29, 0xa0, 0xa4, 0x59, 0x8e,
+	0x91, 0x5f, 0xc1, 0x0c, 0x6b, 0xd7, 0x44, 0x4e, 0x94, 0xa1, 0xd4, 0xd7, 0xd8, 0x3b, 0x42, 0x70,
+	0x90, 0x36, 0x18, 0x98, 0x07, 0xa4, 0xa1, 0x6e, 0x41, 0x55, 0x3b, 0x3c, 0xec, 0x23, 0x15, 0xc9,
+	0x50, 0xda, 0xc1, 0x5b, 0xd8, 0x0a, 0x7f, 0x22, 0xd9, 0x8e, 0x63, 0xf7, 0x89, 0x84, 0x8b, 0xe0,
+	0xd8, 0x03, 0x52, 0x50, 0xff, 0x5c, 0x80, 0xb2, 0xb8, 0x99, 0xb7, 0xa1, 0x96, 0x66, 0x27, 0xd9,
+	0x55, 0xce, 0xba, 0x2b, 0x6a, 0x85, 0xe3, 0x2f, 0x87, 0x99, 0x9b, 0xf9, 0x27, 0x7e, 0x94, 0x09,
+	0xe6, 0x42, 0x5f, 0x94, 0xc4, 0x41, 0xee, 0xcf, 0x17, 0x47, 0x89, 0x50, 0xb0, 0x31, 0x90, 0xc0,
+	0xc4, 0xa9, 0xb1, 0xac, 0x7a, 0xac, 0x1a, 0x26, 0x00, 0x6c, 0x8c, 0x39, 0xde, 0xd0, 0xd2, 0x4f,
+	0x50, 0x56, 0x8e, 0x20, 0x5b, 0x1d, 0xf9, 0xae, 0x17, 0x44, 0x87, 0x29, 0x67, 0xab, 0x1a, 0x5b,
+	0xea, 0xea, 0x3e, 0xac, 0x7d, 0x90, 0xd2, 0x87, 0x95, 0x88, 0x4b, 0xa4, 0x9b, 0x58, 0x42, 0x92,
+	0x78, 0xb6, 0xf3, 0x05, 0x2f, 0xa0, 0xdc, 0xd3, 0x4d, 0xdd, 0xd1, 0x49, 0x91, 0x6f, 0xe3, 0x80,
+	0x3f, 0x6c, 0x4b, 0xb8, 0x78, 0x7d, 0x9d, 0xed, 0xea, 0xa4, 0xfc, 0x7c, 0xef, 0xe3, 0xc0, 0x7c,
+	0xbb, 0x31, 0xf0, 0x7f, 0x15, 0x77, 0x52, 0xe1, 0x7f, 0x83, 0x6c, 0xff, 0x7b, 0x00, 0x23, 0xd1,
+	0x61, 0xc1, 0x14, 0x11, 0x00, 0x00,
 }
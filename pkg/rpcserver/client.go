@@ -0,0 +1,251 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpcserver
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/fagongzi/goetty"
+	"github.com/matrixorigin/matrixone/pkg/rpcserver/message"
+)
+
+func NewClient(maxsize int) *Client {
+	return &Client{
+		maxsize: maxsize,
+		conns:   make(map[string]*conn),
+	}
+}
+
+// NewStream opens a stream to the server, window is the count of
+// Data messages the server can send before they are received.
+func (c *Client) NewStream(addr string, window int) (*Stream, error) {
+	cn, err := c.getConn(addr)
+	if err != nil {
+		return nil, err
+	}
+	return cn.newStream(window)
+}
+
+// Close closes all connections, the streams on them are broken.
+func (c *Client) Close() {
+	c.Lock()
+	conns := c.conns
+	c.conns = make(map[string]*conn)
+	c.Unlock()
+	for _, cn := range conns {
+		cn.sess.Close()
+	}
+}
+
+// getConn returns the connection to addr, a new one is made if there is none or it is broken.
+func (c *Client) getConn(addr string) (*conn, error) {
+	c.Lock()
+	cn, ok := c.conns[addr]
+	c.Unlock()
+	if ok {
+		return cn, nil
+	}
+
+	// connect without the lock, the streams to the other servers are not blocked
+	encoder, decoder := NewCodec(c.maxsize)
+	sess := goetty.NewIOSession(goetty.WithCodec(encoder, decoder), goetty.WithEnableAsyncWrite(asyncFlushBatch))
+	if _, err := sess.Connect(addr, connectTimeout); err != nil {
+		return nil, err
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	// another stream has connected meanwhile
+	if cn, ok := c.conns[addr]; ok {
+		sess.Close()
+		return cn, nil
+	}
+	cn = &conn{
+		addr:    addr,
+		cli:     c,
+		sess:    sess,
+		streams: make(map[uint64]*Stream),
+	}
+	c.conns[addr] = cn
+	go cn.readLoop()
+	return cn, nil
+}
+
+func (c *Client) removeConn(cn *conn) {
+	c.Lock()
+	defer c.Unlock()
+	if c.conns[cn.addr] == cn {
+		delete(c.conns, cn.addr)
+	}
+}
+
+func (cn *conn) newStream(window int) (*Stream, error) {
+	cn.Lock()
+	defer cn.Unlock()
+	if cn.err != nil {
+		return nil, cn.err
+	}
+	cn.sid++
+	s := &Stream{
+		id:     cn.sid,
+		c:      cn,
+		window: window,
+		// the server sends at most window Data messages and the End message
+		ch:     make(chan *message.Message, window+1),
+		closed: make(chan struct{}),
+	}
+	cn.streams[s.id] = s
+	return s, nil
+}
+
+// readLoop dispatches the messages to the streams until the connection is broken.
+func (cn *conn) readLoop() {
+	for {
+		val, err := cn.sess.Read()
+		if err != nil {
+			cn.close(err)
+			return
+		}
+		m := val.(*message.Message)
+		cn.Lock()
+		s, ok := cn.streams[m.Sid]
+		cn.Unlock()
+		if !ok {
+			// the stream has been closed
+			message.Release(m)
+			continue
+		}
+		// each stream has a buffer of its window, a stream which exceeds it is
+		// broken alone so that the other streams on the connection are not stalled
+		select {
+		case s.ch <- m:
+		case <-s.closed:
+			message.Release(m)
+		default:
+			message.Release(m)
+			cn.breakStream(s, fmt.Errorf("stream %v exceeds the window %v", s.id, s.window))
+		}
+	}
+}
+
+// breakStream removes the stream from the connection and cancels it on the server, Recv returns err.
+func (cn *conn) breakStream(s *Stream, err error) {
+	cn.Lock()
+	_, ok := cn.streams[s.id]
+	delete(cn.streams, s.id)
+	cn.Unlock()
+	if !ok {
+		return
+	}
+	s.err = err
+	close(s.ch)
+	cn.write(&message.Message{Sid: s.id, Cmd: s.cmd, Type: Cancel})
+}
+
+// close breaks the connection, the streams on it get the error.
+func (cn *conn) close(err error) {
+	cn.cli.removeConn(cn)
+	cn.Lock()
+	cn.err = err
+	streams := cn.streams
+	cn.streams = make(map[uint64]*Stream)
+	cn.Unlock()
+	for _, s := range streams {
+		close(s.ch)
+	}
+	cn.sess.Close()
+}
+
+func (cn *conn) write(m *message.Message) error {
+	cn.Lock()
+	err := cn.err
+	cn.Unlock()
+	if err != nil {
+		return err
+	}
+	return cn.sess.WriteAndFlush(m)
+}
+
+// Request sends the request and the credits of the window.
+func (s *Stream) Request(cmd uint64, data []byte) error {
+	s.cmd = cmd
+	if err := s.c.write(&message.Message{Sid: s.id, Cmd: cmd, Type: Request, Data: data}); err != nil {
+		return err
+	}
+	return s.grant(s.window)
+}
+
+/*
+Recv returns the next Data or End message of the stream.
+Receiving a Data message means the previous one is consumed, so the
+credits are returned to the server when half of the window is consumed.
+*/
+func (s *Stream) Recv(ctx context.Context) (*message.Message, error) {
+	if s.consumed*2 >= s.window && s.consumed > 0 {
+		if err := s.grant(s.consumed); err != nil {
+			return nil, err
+		}
+		s.consumed = 0
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case m, ok := <-s.ch:
+		if !ok {
+			if s.err != nil {
+				return nil, s.err
+			}
+			s.c.Lock()
+			err := s.c.err
+			s.c.Unlock()
+			return nil, err
+		}
+		switch m.Type {
+		case Data:
+			s.consumed++
+		case End:
+			s.ended = true
+		}
+		return m, nil
+	}
+}
+
+func (s *Stream) grant(n int) error {
+	data := make([]byte, binary.MaxVarintLen64)
+	data = data[:binary.PutUvarint(data, uint64(n))]
+	return s.c.write(&message.Message{Sid: s.id, Cmd: s.cmd, Type: Credit, Data: data})
+}
+
+// Close releases the stream, the server is told to cancel it if it has not ended.
+func (s *Stream) Close() error {
+	s.c.Lock()
+	_, ok := s.c.streams[s.id]
+	delete(s.c.streams, s.id)
+	s.c.Unlock()
+	if !ok {
+		// the connection is broken
+		return nil
+	}
+	close(s.closed)
+	if s.ended {
+		return nil
+	}
+	return s.c.write(&message.Message{Sid: s.id, Cmd: s.cmd, Type: Cancel})
+}
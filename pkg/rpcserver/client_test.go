@@ -0,0 +1,254 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpcserver
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/rpcserver/message"
+
+	"github.com/fagongzi/goetty"
+	"github.com/stretchr/testify/require"
+)
+
+// counter sends the numbers from 0 to the count in the request, one number in each Data message.
+// The credits are ignored if Cmd of the request is floodCmd.
+const floodCmd = 1
+
+type counter struct {
+	sync.Mutex
+	sent     int64
+	canceled int64
+	streams  map[uint64]*Credits
+	cancels  map[uint64]context.CancelFunc
+}
+
+func (c *counter) process(_ uint64, val interface{}, conn goetty.IOSession) error {
+	m := val.(*message.Message)
+	c.Lock()
+	defer c.Unlock()
+	switch m.Type {
+	case Request:
+		n, _ := strconv.Atoi(string(m.Data))
+		ctx, cancel := context.WithCancel(context.Background())
+		credits := NewCredits()
+		c.streams[m.Sid], c.cancels[m.Sid] = credits, cancel
+		go func(sid, cmd uint64) {
+			defer cancel()
+			for i := 0; i < n; i++ {
+				if cmd == floodCmd {
+					if ctx.Err() != nil {
+						return
+					}
+				} else if err := credits.Acquire(ctx); err != nil {
+					atomic.AddInt64(&c.canceled, 1)
+					conn.WriteAndFlush(&message.Message{Sid: sid, Type: End, Code: []byte(err.Error())})
+					return
+				}
+				atomic.AddInt64(&c.sent, 1)
+				conn.WriteAndFlush(&message.Message{Sid: sid, Type: Data, Data: []byte(strconv.Itoa(i))})
+			}
+			conn.WriteAndFlush(&message.Message{Sid: sid, Type: End})
+		}(m.Sid, m.Cmd)
+	case Credit:
+		return c.streams[m.Sid].Grant(m.Data)
+	case Cancel:
+		c.cancels[m.Sid]()
+	}
+	return nil
+}
+
+func newCounterServer(t *testing.T, addr string) (Server, *counter) {
+	srv, err := New(addr, 1<<30, logutil.GetGlobalLogger())
+	require.NoError(t, err)
+	c := &counter{
+		streams: make(map[uint64]*Credits),
+		cancels: make(map[uint64]context.CancelFunc),
+	}
+	srv.Register(c.process)
+	require.NoError(t, srv.Run())
+	return srv, c
+}
+
+func TestClient(t *testing.T) {
+	addr := "127.0.0.1:8091"
+	srv, c := newCounterServer(t, addr)
+	defer srv.Stop()
+
+	cli := NewClient(1 << 30)
+	defer cli.Close()
+
+	// the streams share the connection
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(n int) {
+			defer wg.Done()
+			s, err := cli.NewStream(addr, 2)
+			require.NoError(t, err)
+			defer s.Close()
+			require.NoError(t, s.Request(0, []byte(strconv.Itoa(n))))
+			for i := 0; ; i++ {
+				m, err := s.Recv(context.Background())
+				require.NoError(t, err)
+				if m.Type == End {
+					require.Equal(t, 0, len(m.Code))
+					require.Equal(t, n, i)
+					return
+				}
+				require.Equal(t, strconv.Itoa(i), string(m.Data))
+			}
+		}(10 * (i + 1))
+	}
+	wg.Wait()
+	require.Equal(t, 1, len(cli.conns))
+	require.Equal(t, int64(100), atomic.LoadInt64(&c.sent))
+
+	// the server stops at the window until the results are received
+	atomic.StoreInt64(&c.sent, 0)
+	s, err := cli.NewStream(addr, 3)
+	require.NoError(t, err)
+	require.NoError(t, s.Request(0, []byte("100")))
+	time.Sleep(100 * time.Millisecond)
+	require.Equal(t, int64(3), atomic.LoadInt64(&c.sent))
+	for i := 0; i < 3; i++ {
+		_, err = s.Recv(context.Background())
+		require.NoError(t, err)
+	}
+	// the credits of 2 received results are returned
+	time.Sleep(100 * time.Millisecond)
+	require.Equal(t, int64(5), atomic.LoadInt64(&c.sent))
+
+	// the server is canceled when the stream is closed
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = s.Recv(ctx)
+	require.Equal(t, context.Canceled, err)
+	require.NoError(t, s.Close())
+	time.Sleep(100 * time.Millisecond)
+	require.Equal(t, int64(1), atomic.LoadInt64(&c.canceled))
+	require.Equal(t, int64(5), atomic.LoadInt64(&c.sent))
+}
+
+func TestClientBroken(t *testing.T) {
+	addr := "127.0.0.1:8092"
+	srv, _ := newCounterServer(t, addr)
+
+	cli := NewClient(1 << 30)
+	defer cli.Close()
+	s, err := cli.NewStream(addr, 1)
+	require.NoError(t, err)
+	require.NoError(t, s.Request(0, []byte("100")))
+	_, err = s.Recv(context.Background())
+	require.NoError(t, err)
+
+	// the streams get the error when the connection is broken
+	srv.Stop()
+	_, err = s.Recv(context.Background())
+	require.Error(t, err)
+	require.NoError(t, s.Close())
+	_, err = cli.NewStream(addr, 1)
+	require.Error(t, err)
+}
+
+func TestClientFlood(t *testing.T) {
+	addr := "127.0.0.1:8093"
+	srv, _ := newCounterServer(t, addr)
+	defer srv.Stop()
+
+	cli := NewClient(1 << 30)
+	defer cli.Close()
+
+	// the stream exceeding its window is not received meanwhile
+	fs, err := cli.NewStream(addr, 1)
+	require.NoError(t, err)
+	require.NoError(t, fs.Request(floodCmd, []byte("100")))
+	time.Sleep(100 * time.Millisecond)
+
+	// the other streams on the connection are not stalled
+	s, err := cli.NewStream(addr, 2)
+	require.NoError(t, err)
+	defer s.Close()
+	require.NoError(t, s.Request(0, []byte("10")))
+	for i := 0; ; i++ {
+		m, err := s.Recv(context.Background())
+		require.NoError(t, err)
+		if m.Type == End {
+			require.Equal(t, 10, i)
+			break
+		}
+	}
+
+	// the buffered messages are received before the error
+	for {
+		_, err = fs.Recv(context.Background())
+		if err != nil {
+			break
+		}
+	}
+	require.Error(t, err)
+	require.NotEqual(t, context.Canceled, err)
+	require.NoError(t, fs.Close())
+}
+
+func TestCredits(t *testing.T) {
+	c := NewCredits()
+	data := make([]byte, binary.MaxVarintLen64)
+	require.NoError(t, c.Grant(data[:binary.PutUvarint(data, 2)]))
+	require.NoError(t, c.Acquire(context.Background()))
+	require.NoError(t, c.Acquire(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.Equal(t, context.DeadlineExceeded, c.Acquire(ctx))
+	require.Error(t, c.Grant(nil))
+}
+
+func TestCompress(t *testing.T) {
+	data := bytes.Repeat([]byte("matrixone"), 100)
+	cdata, err := Compress(data)
+	require.NoError(t, err)
+	require.Equal(t, byte(compress.Lz4), cdata[0])
+	require.Less(t, len(cdata), len(data))
+	ddata, err := Decompress(cdata)
+	require.NoError(t, err)
+	require.Equal(t, data, ddata)
+
+	// the incompressible payload is kept as it is
+	data = make([]byte, 64)
+	_, err = rand.Read(data)
+	require.NoError(t, err)
+	cdata, err = Compress(data)
+	require.NoError(t, err)
+	require.Equal(t, byte(compress.None), cdata[0])
+	ddata, err = Decompress(cdata)
+	require.NoError(t, err)
+	require.Equal(t, data, ddata)
+
+	_, err = Decompress(nil)
+	require.Error(t, err)
+	_, err = Decompress([]byte{compress.Lz4, 0})
+	require.Error(t, err)
+}
@@ -0,0 +1,102 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpcserver
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/compress"
+
+	"github.com/pierrec/lz4"
+)
+
+func NewCredits() *Credits {
+	return &Credits{
+		notify: make(chan struct{}, 1),
+	}
+}
+
+// Grant adds the credits of the Credit message.
+func (c *Credits) Grant(data []byte) error {
+	n, size := binary.Uvarint(data)
+	if size <= 0 {
+		return fmt.Errorf("invalid credit message")
+	}
+	c.Lock()
+	c.n += int64(n)
+	c.Unlock()
+	select {
+	case c.notify <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Acquire takes a credit before a Data message is sent, it waits until the client grants one or ctx is done.
+func (c *Credits) Acquire(ctx context.Context) error {
+	for {
+		c.Lock()
+		if c.n > 0 {
+			c.n--
+			c.Unlock()
+			return nil
+		}
+		c.Unlock()
+		select {
+		case <-c.notify:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+/*
+Compress compresses the payload of a Data message by LZ4. The first byte is
+the compress type, and the size of the uncompressed payload follows if it is
+compressed. The payload is kept as it is if LZ4 cannot make it smaller.
+*/
+func Compress(data []byte) ([]byte, error) {
+	buf := make([]byte, 5+lz4.CompressBlockBound(len(data)))
+	cbuf, err := compress.Compress(data, buf[5:], compress.Lz4)
+	if err != nil {
+		return nil, err
+	}
+	if len(cbuf) == 0 || len(cbuf)+5 >= len(data)+1 {
+		return append([]byte{compress.None}, data...), nil
+	}
+	buf[0] = compress.Lz4
+	binary.BigEndian.PutUint32(buf[1:], uint32(len(data)))
+	return buf[:5+len(cbuf)], nil
+}
+
+// Decompress returns the payload compressed by Compress.
+func Decompress(data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("empty payload")
+	}
+	switch data[0] {
+	case compress.None:
+		return data[1:], nil
+	case compress.Lz4:
+		if len(data) < 5 {
+			return nil, fmt.Errorf("invalid lz4 payload")
+		}
+		buf := make([]byte, binary.BigEndian.Uint32(data[1:]))
+		return compress.Decompress(data[5:], buf, compress.Lz4)
+	}
+	return nil, fmt.Errorf("unexpected compress type: %d", data[0])
+}
@@ -27,8 +27,11 @@ func New(addr string, maxsize int, log *zap.Logger) (Server, error) {
 
 	s := new(server)
 	encoder, decoder := NewCodec(maxsize)
+	// the handlers write the results of the streams concurrently
 	if s.app, err = goetty.NewTCPApplication(addr, s.onMessage,
-		goetty.WithAppSessionOptions(goetty.WithCodec(encoder, decoder), goetty.WithLogger(log))); err != nil {
+		goetty.WithAppSessionOptions(goetty.WithCodec(encoder, decoder), goetty.WithLogger(log),
+			goetty.WithEnableAsyncWrite(asyncFlushBatch)),
+		goetty.WithAppSessionAware(s)); err != nil {
 		return nil, err
 	}
 	return s, nil
@@ -47,9 +50,21 @@ func (s *server) Register(f func(uint64, interface{}, goetty.IOSession) error) i
 	return len(s.fs)
 }
 
+func (s *server) RegisterClosed(f func(goetty.IOSession)) {
+	s.cfs = append(s.cfs, f)
+}
+
+func (s *server) Created(_ goetty.IOSession) {
+}
+
+func (s *server) Closed(sess goetty.IOSession) {
+	for _, f := range s.cfs {
+		f(sess)
+	}
+}
+
 func (s *server) onMessage(sess goetty.IOSession, value interface{}, seq uint64) error {
 	m := value.(*message.Message)
-	m.Sid = sess.ID()
 	defer message.Release(m)
 	if m.Cmd >= uint64(len(s.fs)) || s.fs[m.Cmd] == nil {
 		return fmt.Errorf("unsupport command '%v'", m.Cmd)
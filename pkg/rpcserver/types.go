@@ -15,16 +15,88 @@
 package rpcserver
 
 import (
+	"sync"
+	"time"
+
 	"github.com/fagongzi/goetty"
+	"github.com/matrixorigin/matrixone/pkg/rpcserver/message"
+)
+
+// The types of the messages, a stream is the messages with the same Sid on a connection.
+const (
+	// Request is the first message of a stream, Cmd selects the handler.
+	Request = iota
+	// Credit allows the server to send more Data messages, the count is a uvarint in Data.
+	Credit
+	// Cancel tells the server that the client gives up the stream.
+	Cancel
+	// Data is a result of the stream.
+	Data
+	// End is the last message of a stream, Code is the error if the stream failed.
+	End
+)
+
+const (
+	// asyncFlushBatch, the count of the messages flushed together by the write loop of a session
+	asyncFlushBatch = 16
+	// connectTimeout, the timeout of connecting to the server
+	connectTimeout = time.Second * 3
 )
 
 type Server interface {
 	Stop()
 	Run() error
 	Register(func(uint64, interface{}, goetty.IOSession) error) int
+	// RegisterClosed registers the function called when a session is closed.
+	RegisterClosed(func(goetty.IOSession))
 }
 
 type server struct {
 	app goetty.NetApplication
 	fs  []func(uint64, interface{}, goetty.IOSession) error
+	cfs []func(goetty.IOSession)
+}
+
+// Client keeps a connection to each server, the streams to the same server share the connection.
+type Client struct {
+	sync.Mutex
+	maxsize int
+	conns   map[string]*conn
+}
+
+type conn struct {
+	sync.Mutex
+	addr string
+	cli  *Client
+	sess goetty.IOSession
+	// sid, the id of the last stream
+	sid uint64
+	// err, the reason why the connection is broken
+	err     error
+	streams map[uint64]*Stream
+}
+
+// Stream is a request and its results.
+type Stream struct {
+	id uint64
+	// cmd, the handler of the stream on the server
+	cmd uint64
+	c   *conn
+	// window, the count of Data messages the server can send before they are received
+	window int
+	// consumed, the count of Data messages received whose credits are not returned
+	consumed int
+	// ended, the End message is received
+	ended bool
+	// err, the reason why the stream is broken while the connection is not
+	err    error
+	ch     chan *message.Message
+	closed chan struct{}
+}
+
+// Credits counts the Data messages the server can send on a stream.
+type Credits struct {
+	sync.Mutex
+	n      int64
+	notify chan struct{}
 }
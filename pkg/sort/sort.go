@@ -82,6 +82,13 @@ func Sort(desc bool, os []int64, vec *vector.Vector) {
 		} else {
 			int64s.Sort(*(*[]int64)(unsafe.Pointer(&vs)), os)
 		}
+	case types.T_decimal:
+		vs := vec.Col.([]types.Decimal)
+		if desc {
+			dint64s.Sort(*(*[]int64)(unsafe.Pointer(&vs)), os)
+		} else {
+			int64s.Sort(*(*[]int64)(unsafe.Pointer(&vs)), os)
+		}
 	case types.T_uint8:
 		if desc {
 			duint8s.Sort(vec.Col.([]uint8), os)
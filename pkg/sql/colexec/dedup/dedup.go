@@ -65,6 +65,8 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 				size += 4 + nullable
 			case types.T_int64, types.T_uint64, types.T_float64, types.T_datetime:
 				size += 8 + nullable
+			case types.T_decimal:
+				size += 16 + nullable
 			case types.T_char, types.T_varchar:
 				if width := vec.Typ.Width; width > 0 {
 					size += int(width) + nullable
@@ -662,6 +664,25 @@ func (ctr *Container) processH24(bat *batch.Batch, proc *process.Process) error
 						}
 					}
 				}
+			case types.T_decimal:
+				vs := vecs[j].Col.([]types.Decimal)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+					}
+					add.Uint32AddScalar(16, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+							ctr.keyOffs[k] += 17
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				vData := vs.Data
@@ -951,6 +972,25 @@ func (ctr *Container) processH32(bat *batch.Batch, proc *process.Process) error
 						}
 					}
 				}
+			case types.T_decimal:
+				vs := vecs[j].Col.([]types.Decimal)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+					}
+					add.Uint32AddScalar(16, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+							ctr.keyOffs[k] += 17
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				vData := vs.Data
@@ -1240,6 +1280,25 @@ func (ctr *Container) processH40(bat *batch.Batch, proc *process.Process) error
 						}
 					}
 				}
+			case types.T_decimal:
+				vs := vecs[j].Col.([]types.Decimal)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+					}
+					add.Uint32AddScalar(16, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+							ctr.keyOffs[k] += 17
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				vData := vs.Data
@@ -1503,6 +1562,23 @@ func (ctr *Container) processHStr(bat *batch.Batch, proc *process.Process) error
 						}
 					}
 				}
+			case types.T_decimal:
+				vs := vecs[j].Col.([]types.Decimal)
+				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*16)[:len(vs)*16]
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						keys[k] = append(keys[k], data[(i+k)*16:(i+k+1)*16]...)
+					}
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							keys[k] = append(keys[k], byte(1))
+						} else {
+							keys[k] = append(keys[k], byte(0))
+							keys[k] = append(keys[k], data[(i+k)*16:(i+k+1)*16]...)
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				if !nulls.Any(vecs[j].Nsp) {
@@ -0,0 +1,297 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extend
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func (_ *CaseExtend) IsLogical() bool {
+	return false
+}
+
+func (_ *CaseExtend) IsConstant() bool {
+	return false
+}
+
+func (e *CaseExtend) ReturnType() types.T {
+	return resultsType(e.results())
+}
+
+func (e *CaseExtend) Attributes() []string {
+	var attrs []string
+
+	for i, cond := range e.Conds {
+		attrs = append(attrs, cond.Attributes()...)
+		if e.Ress[i] != nil {
+			attrs = append(attrs, e.Ress[i].Attributes()...)
+		}
+	}
+	if e.Else != nil {
+		attrs = append(attrs, e.Else.Attributes()...)
+	}
+	return attrs
+}
+
+func (e *CaseExtend) ExtendAttributes() []*Attribute {
+	var attrs []*Attribute
+
+	for i, cond := range e.Conds {
+		attrs = append(attrs, cond.ExtendAttributes()...)
+		if e.Ress[i] != nil {
+			attrs = append(attrs, e.Ress[i].ExtendAttributes()...)
+		}
+	}
+	if e.Else != nil {
+		attrs = append(attrs, e.Else.ExtendAttributes()...)
+	}
+	return attrs
+}
+
+func (e *CaseExtend) Eval(bat *batch.Batch, proc *process.Process) (*vector.Vector, types.T, error) {
+	n := len(bat.Zs)
+	idx := make([]int64, n)
+	for i := range idx {
+		idx[i] = -1
+	}
+	rest := n
+	for k := 0; k < len(e.Conds) && rest > 0; k++ {
+		vec, _, err := e.Conds[k].Eval(bat, proc)
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, sel := range vec.Col.([]int64) {
+			if idx[sel] < 0 {
+				idx[sel] = int64(k)
+				rest--
+			}
+		}
+		process.Put(proc, vec)
+	}
+	for i := range idx {
+		if idx[i] < 0 {
+			idx[i] = int64(len(e.Conds))
+		}
+	}
+	typ := e.ReturnType()
+	vec, err := evalResults(e.results(), idx, typ, bat, proc)
+	if err != nil {
+		return nil, 0, err
+	}
+	return vec, typ, nil
+}
+
+func (a *CaseExtend) Eq(e Extend) bool {
+	b, ok := e.(*CaseExtend)
+	if !ok || len(a.Conds) != len(b.Conds) {
+		return false
+	}
+	for i := range a.Conds {
+		if !a.Conds[i].Eq(b.Conds[i]) || !extendEq(a.Ress[i], b.Ress[i]) {
+			return false
+		}
+	}
+	return extendEq(a.Else, b.Else)
+}
+
+func (e *CaseExtend) String() string {
+	var buf bytes.Buffer
+
+	buf.WriteString("case")
+	for i, cond := range e.Conds {
+		buf.WriteString(fmt.Sprintf(" when %s then %s", cond, extendString(e.Ress[i])))
+	}
+	if e.Else != nil {
+		buf.WriteString(fmt.Sprintf(" else %s", e.Else))
+	}
+	buf.WriteString(" end")
+	return buf.String()
+}
+
+// results returns the results of all branches, the last one is Else.
+func (e *CaseExtend) results() []Extend {
+	es := make([]Extend, 0, len(e.Ress)+1)
+	es = append(es, e.Ress...)
+	return append(es, e.Else)
+}
+
+func (_ *CoalesceExtend) IsLogical() bool {
+	return false
+}
+
+func (_ *CoalesceExtend) IsConstant() bool {
+	return false
+}
+
+func (e *CoalesceExtend) ReturnType() types.T {
+	return resultsType(e.Args)
+}
+
+func (e *CoalesceExtend) Attributes() []string {
+	var attrs []string
+
+	for _, arg := range e.Args {
+		attrs = append(attrs, arg.Attributes()...)
+	}
+	return attrs
+}
+
+func (e *CoalesceExtend) ExtendAttributes() []*Attribute {
+	var attrs []*Attribute
+
+	for _, arg := range e.Args {
+		attrs = append(attrs, arg.ExtendAttributes()...)
+	}
+	return attrs
+}
+
+func (e *CoalesceExtend) Eval(bat *batch.Batch, proc *process.Process) (*vector.Vector, types.T, error) {
+	n := len(bat.Zs)
+	idx := make([]int64, n)
+	for i := range idx {
+		idx[i] = -1
+	}
+	rest := n
+	vecs := make([]*vector.Vector, len(e.Args))
+	for k := 0; k < len(e.Args) && rest > 0; k++ {
+		vec, err := evalResult(e.Args[k], bat, proc)
+		if err != nil {
+			releaseResults(vecs, proc)
+			return nil, 0, err
+		}
+		vecs[k] = vec
+		constant := vector.Length(vec) == 1
+		for i := range idx {
+			if idx[i] >= 0 {
+				continue
+			}
+			if constant && !nulls.Contains(vec.Nsp, 0) || !constant && !nulls.Contains(vec.Nsp, uint64(i)) {
+				idx[i] = int64(k)
+				rest--
+			}
+		}
+	}
+	typ := e.ReturnType()
+	vec, err := overload.CaseEval(typ, idx, vecs, proc)
+	if err != nil {
+		return nil, 0, err
+	}
+	return vec, typ, nil
+}
+
+func (a *CoalesceExtend) Eq(e Extend) bool {
+	b, ok := e.(*CoalesceExtend)
+	if !ok || len(a.Args) != len(b.Args) {
+		return false
+	}
+	for i, arg := range a.Args {
+		if !arg.Eq(b.Args[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *CoalesceExtend) String() string {
+	var buf bytes.Buffer
+
+	buf.WriteString("coalesce(")
+	for i, arg := range e.Args {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(arg.String())
+	}
+	buf.WriteString(")")
+	return buf.String()
+}
+
+// evalResults evaluates the results which are chosen by some rows, the rows
+// choosing a null result are marked as null.
+func evalResults(es []Extend, idx []int64, typ types.T, bat *batch.Batch, proc *process.Process) (*vector.Vector, error) {
+	used := make([]bool, len(es))
+	for i, k := range idx {
+		if es[k] == nil {
+			idx[i] = -1
+			continue
+		}
+		used[k] = true
+	}
+	vecs := make([]*vector.Vector, len(es))
+	for k, e := range es {
+		if !used[k] {
+			continue
+		}
+		vec, err := evalResult(e, bat, proc)
+		if err != nil {
+			releaseResults(vecs, proc)
+			return nil, err
+		}
+		vecs[k] = vec
+	}
+	return overload.CaseEval(typ, idx, vecs, proc)
+}
+
+func evalResult(e Extend, bat *batch.Batch, proc *process.Process) (*vector.Vector, error) {
+	vec, _, err := e.Eval(bat, proc)
+	if err != nil {
+		return nil, err
+	}
+	if e.IsConstant() && vec.Ref == 0 { // constants are never released
+		vec.Ref = 1
+	}
+	return vec, nil
+}
+
+func releaseResults(vecs []*vector.Vector, proc *process.Process) {
+	for _, vec := range vecs {
+		if vec != nil && vec.Ref == 0 {
+			process.Put(proc, vec)
+		}
+	}
+}
+
+func resultsType(es []Extend) types.T {
+	var ts []types.T
+
+	for _, e := range es {
+		if e != nil {
+			ts = append(ts, e.ReturnType())
+		}
+	}
+	return overload.CaseReturnType(ts)
+}
+
+func extendEq(a, b Extend) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Eq(b)
+}
+
+func extendString(e Extend) string {
+	if e == nil {
+		return "null"
+	}
+	return e.String()
+}
@@ -0,0 +1,94 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extend
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func (_ *InExtend) IsLogical() bool {
+	return true
+}
+
+func (_ *InExtend) IsConstant() bool {
+	return false
+}
+
+func (_ *InExtend) ReturnType() types.T {
+	return types.T_sel
+}
+
+func (e *InExtend) Attributes() []string {
+	return e.E.Attributes()
+}
+
+func (e *InExtend) ExtendAttributes() []*Attribute {
+	return e.E.ExtendAttributes()
+}
+
+func (e *InExtend) Eval(bat *batch.Batch, proc *process.Process) (*vector.Vector, types.T, error) {
+	lv, lt, err := e.E.Eval(bat, proc)
+	if err != nil {
+		return nil, 0, err
+	}
+	vs := make([]*vector.Vector, len(e.Vs))
+	for i, v := range e.Vs {
+		if vs[i], _, err = v.Eval(bat, proc); err != nil {
+			return nil, 0, err
+		}
+	}
+	vec, err := overload.InEval(e.Not, lt, lv, vs, proc)
+	if err != nil {
+		return nil, 0, err
+	}
+	return vec, types.T_sel, nil
+}
+
+func (a *InExtend) Eq(e Extend) bool {
+	b, ok := e.(*InExtend)
+	if !ok || a.Not != b.Not || len(a.Vs) != len(b.Vs) || !a.E.Eq(b.E) {
+		return false
+	}
+	for i, v := range a.Vs {
+		if !v.Eq(b.Vs[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *InExtend) String() string {
+	var buf bytes.Buffer
+
+	buf.WriteString(e.E.String())
+	if e.Not {
+		buf.WriteString(" not")
+	}
+	buf.WriteString(" in (")
+	for i, v := range e.Vs {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(v.String())
+	}
+	buf.WriteString(")")
+	return buf.String()
+}
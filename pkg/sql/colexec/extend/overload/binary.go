@@ -38,13 +38,13 @@ func BinaryEval(op int, ltyp, rtyp types.T, lc, rc bool, lv, rv *vector.Vector,
 	if rule, ok := binaryOpsNeedCast(op, ltyp, rtyp); ok {
 		var err error
 		leftCast, rightCast := rule.targetTypes[0], rule.targetTypes[1]
-		if !lv.Typ.Eq(leftCast) {
+		if lv.Typ.Oid != leftCast.Oid {
 			lv, err = BinaryEval(Typecast, ltyp, leftCast.Oid, lc, false, lv, vector.New(leftCast), p)
 			if err != nil {
 				return nil, err
 			}
 		}
-		if !rv.Typ.Eq(rightCast) {
+		if rv.Typ.Oid != rightCast.Oid {
 			rv, err = BinaryEval(Typecast, rtyp, rightCast.Oid, rc, false, rv, vector.New(rightCast), p)
 			if err != nil {
 				return nil, err
@@ -53,10 +53,12 @@ func BinaryEval(op int, ltyp, rtyp types.T, lc, rc bool, lv, rv *vector.Vector,
 		ltyp, rtyp = leftCast.Oid, rightCast.Oid
 	}
 
-	if vector.Length(lv) == 1 {
+	// a vector of one row is taken as a constant, except when the other side
+	// is a constant already, whose vector must not be reused for the result.
+	if vector.Length(lv) == 1 && !rc {
 		lc = true
 	}
-	if vector.Length(rv) == 1 {
+	if vector.Length(rv) == 1 && !lc {
 		rc = true
 	}
 	if os, ok := BinOps[op]; ok {
@@ -0,0 +1,331 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overload
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/choose"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// CaseReturnType returns the type which all the results of a conditional
+// expression are converted to, it is T_any if there is no such type.
+func CaseReturnType(ts []types.T) types.T {
+	if len(ts) == 0 {
+		return types.T_any
+	}
+	typ := ts[0]
+	for _, t := range ts[1:] {
+		if typ = caseType(typ, t); typ == types.T_any {
+			break
+		}
+	}
+	return typ
+}
+
+// CaseEval builds the result of a conditional expression, the i-th row is
+// taken from vecs[idx[i]] and is null if idx[i] is negative. The vectors are
+// converted to typ and released if they are not referenced.
+func CaseEval(typ types.T, idx []int64, vecs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	var err error
+
+	defer func() {
+		for _, v := range vecs {
+			if v != nil && v.Ref == 0 {
+				process.Put(proc, v)
+			}
+		}
+	}()
+	rtyp := caseResultType(typ, vecs)
+	cs := make([]bool, len(vecs))
+	for i, v := range vecs {
+		if v == nil {
+			continue
+		}
+		if !v.Typ.Eq(rtyp) {
+			if vecs[i], err = BinaryEval(Typecast, v.Typ.Oid, rtyp.Oid, false, false, v, vector.New(rtyp), proc); err != nil {
+				vecs[i] = nil
+				if v.Ref == 0 {
+					process.Put(proc, v)
+				}
+				return nil, err
+			}
+		}
+		cs[i] = vector.Length(vecs[i]) == 1
+	}
+	n := len(idx)
+	vec, err := process.Get(proc, int64(n)*int64(rtyp.Size), rtyp)
+	if err != nil {
+		return nil, err
+	}
+	for i, k := range idx {
+		switch {
+		case k < 0:
+			nulls.Add(vec.Nsp, uint64(i))
+		case cs[k]:
+			if nulls.Contains(vecs[k].Nsp, 0) {
+				nulls.Add(vec.Nsp, uint64(i))
+			}
+		default:
+			if nulls.Contains(vecs[k].Nsp, uint64(i)) {
+				nulls.Add(vec.Nsp, uint64(i))
+			}
+		}
+	}
+	switch rtyp.Oid {
+	case types.T_int8:
+		vs := make([][]int8, len(vecs))
+		for i, v := range vecs {
+			if v != nil {
+				vs[i] = v.Col.([]int8)
+			}
+		}
+		vector.SetCol(vec, choose.I8Choose(vs, cs, idx, encoding.DecodeInt8Slice(vec.Data)[:n]))
+	case types.T_int16:
+		vs := make([][]int16, len(vecs))
+		for i, v := range vecs {
+			if v != nil {
+				vs[i] = v.Col.([]int16)
+			}
+		}
+		vector.SetCol(vec, choose.I16Choose(vs, cs, idx, encoding.DecodeInt16Slice(vec.Data)[:n]))
+	case types.T_int32:
+		vs := make([][]int32, len(vecs))
+		for i, v := range vecs {
+			if v != nil {
+				vs[i] = v.Col.([]int32)
+			}
+		}
+		vector.SetCol(vec, choose.I32Choose(vs, cs, idx, encoding.DecodeInt32Slice(vec.Data)[:n]))
+	case types.T_int64:
+		vs := make([][]int64, len(vecs))
+		for i, v := range vecs {
+			if v != nil {
+				vs[i] = v.Col.([]int64)
+			}
+		}
+		vector.SetCol(vec, choose.I64Choose(vs, cs, idx, encoding.DecodeInt64Slice(vec.Data)[:n]))
+	case types.T_uint8:
+		vs := make([][]uint8, len(vecs))
+		for i, v := range vecs {
+			if v != nil {
+				vs[i] = v.Col.([]uint8)
+			}
+		}
+		vector.SetCol(vec, choose.Ui8Choose(vs, cs, idx, encoding.DecodeUint8Slice(vec.Data)[:n]))
+	case types.T_uint16:
+		vs := make([][]uint16, len(vecs))
+		for i, v := range vecs {
+			if v != nil {
+				vs[i] = v.Col.([]uint16)
+			}
+		}
+		vector.SetCol(vec, choose.Ui16Choose(vs, cs, idx, encoding.DecodeUint16Slice(vec.Data)[:n]))
+	case types.T_uint32:
+		vs := make([][]uint32, len(vecs))
+		for i, v := range vecs {
+			if v != nil {
+				vs[i] = v.Col.([]uint32)
+			}
+		}
+		vector.SetCol(vec, choose.Ui32Choose(vs, cs, idx, encoding.DecodeUint32Slice(vec.Data)[:n]))
+	case types.T_uint64:
+		vs := make([][]uint64, len(vecs))
+		for i, v := range vecs {
+			if v != nil {
+				vs[i] = v.Col.([]uint64)
+			}
+		}
+		vector.SetCol(vec, choose.Ui64Choose(vs, cs, idx, encoding.DecodeUint64Slice(vec.Data)[:n]))
+	case types.T_float32:
+		vs := make([][]float32, len(vecs))
+		for i, v := range vecs {
+			if v != nil {
+				vs[i] = v.Col.([]float32)
+			}
+		}
+		vector.SetCol(vec, choose.Float32Choose(vs, cs, idx, encoding.DecodeFloat32Slice(vec.Data)[:n]))
+	case types.T_float64:
+		vs := make([][]float64, len(vecs))
+		for i, v := range vecs {
+			if v != nil {
+				vs[i] = v.Col.([]float64)
+			}
+		}
+		vector.SetCol(vec, choose.Float64Choose(vs, cs, idx, encoding.DecodeFloat64Slice(vec.Data)[:n]))
+	case types.T_decimal:
+		vs := make([][]types.Decimal, len(vecs))
+		for i, v := range vecs {
+			if v != nil {
+				vs[i] = v.Col.([]types.Decimal)
+			}
+		}
+		vector.SetCol(vec, choose.DecimalChoose(vs, cs, idx, encoding.DecodeDecimalSlice(vec.Data)[:n]))
+	case types.T_date:
+		vs := make([][]types.Date, len(vecs))
+		for i, v := range vecs {
+			if v != nil {
+				vs[i] = v.Col.([]types.Date)
+			}
+		}
+		vector.SetCol(vec, choose.DateChoose(vs, cs, idx, encoding.DecodeDateSlice(vec.Data)[:n]))
+	case types.T_datetime:
+		vs := make([][]types.Datetime, len(vecs))
+		for i, v := range vecs {
+			if v != nil {
+				vs[i] = v.Col.([]types.Datetime)
+			}
+		}
+		vector.SetCol(vec, choose.DatetimeChoose(vs, cs, idx, encoding.DecodeDatetimeSlice(vec.Data)[:n]))
+	case types.T_char, types.T_varchar:
+		process.Put(proc, vec)
+		vs := make([]*types.Bytes, len(vecs))
+		for i, v := range vecs {
+			if v != nil {
+				vs[i] = v.Col.(*types.Bytes)
+			}
+		}
+		col := choose.SChoose(vs, cs, idx, &types.Bytes{
+			Offsets: make([]uint32, 0, n),
+			Lengths: make([]uint32, 0, n),
+		})
+		if err = proc.Mp.Gm.Alloc(int64(cap(col.Data))); err != nil {
+			return nil, err
+		}
+		rvec := vector.New(rtyp)
+		rvec.Data = col.Data
+		nulls.Set(rvec.Nsp, vec.Nsp)
+		vector.SetCol(rvec, col)
+		return rvec, nil
+	default:
+		process.Put(proc, vec)
+		return nil, fmt.Errorf("case not yet implemented for %s", rtyp)
+	}
+	return vec, nil
+}
+
+// InEval selects the rows of lv which equal any of the constants of vs, or the
+// rows which are not null and differ from all of them if not is true.
+func InEval(not bool, ltyp types.T, lv *vector.Vector, vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	n := vector.Length(lv)
+	flgs := make([]bool, n)
+	{
+		ref := lv.Ref
+		lv.Ref++ // keeps lv alive while comparing it with every constant
+		for _, v := range vs {
+			vec, err := BinaryEval(EQ, ltyp, v.Typ.Oid, false, true, lv, v, proc)
+			if err != nil {
+				lv.Ref = ref
+				if ref == 0 {
+					process.Put(proc, lv)
+				}
+				return nil, err
+			}
+			for _, sel := range vec.Col.([]int64) {
+				flgs[sel] = true
+			}
+			process.Put(proc, vec)
+		}
+		lv.Ref = ref
+	}
+	vec, err := process.Get(proc, 8*int64(n), SelsType)
+	if err != nil {
+		return nil, err
+	}
+	rs := encoding.DecodeInt64Slice(vec.Data)[:0]
+	for i, flg := range flgs {
+		if flg == !not && !nulls.Contains(lv.Nsp, uint64(i)) {
+			rs = append(rs, int64(i))
+		}
+	}
+	vector.SetCol(vec, rs)
+	if lv.Ref == 0 {
+		process.Put(proc, lv)
+	}
+	return vec, nil
+}
+
+// caseResultType returns the type of the result vector, decimals keep the
+// largest scale of the results.
+func caseResultType(typ types.T, vecs []*vector.Vector) types.Type {
+	if typ == types.T_decimal {
+		scale := int32(0)
+		for _, v := range vecs {
+			if v != nil && v.Typ.Oid == types.T_decimal && types.DecimalScale(v.Typ) > scale {
+				scale = types.DecimalScale(v.Typ)
+			}
+		}
+		return types.DecimalType(types.MaxDecimalPrecision, scale)
+	}
+	for _, v := range vecs {
+		if v != nil && v.Typ.Oid == typ {
+			return v.Typ
+		}
+	}
+	return typ.ToType()
+}
+
+func caseType(a, b types.T) types.T {
+	switch {
+	case a == types.T_any || b == types.T_any:
+		return types.T_any
+	case a == b:
+		return a
+	case isString(a) && isString(b):
+		return types.T_varchar
+	case isNumeric(a) && isNumeric(b):
+		switch {
+		case isFloat(a) || isFloat(b):
+			return types.T_float64
+		case a == types.T_decimal || b == types.T_decimal:
+			return types.T_decimal
+		case isUnsigned(a) && isUnsigned(b):
+			return types.T_uint64
+		}
+		return types.T_int64
+	case isString(a) && isNumeric(b), isNumeric(a) && isString(b):
+		return types.T_varchar
+	}
+	return types.T_any
+}
+
+func isString(t types.T) bool {
+	return t == types.T_char || t == types.T_varchar
+}
+
+func isFloat(t types.T) bool {
+	return t == types.T_float32 || t == types.T_float64
+}
+
+func isUnsigned(t types.T) bool {
+	switch t {
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		return true
+	}
+	return false
+}
+
+func isNumeric(t types.T) bool {
+	switch t {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64, types.T_decimal:
+		return true
+	}
+	return isFloat(t) || isUnsigned(t)
+}
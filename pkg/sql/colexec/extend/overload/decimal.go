@@ -0,0 +1,617 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overload
+
+import (
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/add"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/div"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/eq"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/ge"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/gt"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/le"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/lt"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/mul"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/ne"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/sub"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+
+	roaring "github.com/RoaringBitmap/roaring/roaring64"
+)
+
+// decimal.go contains the operators of decimal, they are not generated
+// because the scale of decimal must be taken into account.
+
+var errDecimalCastOutOfRange = errors.New(errno.DataException, "Out of range value for decimal cast")
+
+// decimalArith contains the kernels of a decimal arithmetic operator,
+// the sels kernels are nil if the operator never fails on null rows.
+type decimalArith struct {
+	fn             func([]types.Decimal, []types.Decimal, []types.Decimal, int32) ([]types.Decimal, error)
+	fnSels         func([]types.Decimal, []types.Decimal, []types.Decimal, []int64, int32) ([]types.Decimal, error)
+	scalar         func(types.Decimal, []types.Decimal, []types.Decimal, int32) ([]types.Decimal, error)
+	scalarSels     func(types.Decimal, []types.Decimal, []types.Decimal, []int64, int32) ([]types.Decimal, error)
+	byScalar       func(types.Decimal, []types.Decimal, []types.Decimal, int32) ([]types.Decimal, error)
+	byScalarSels   func(types.Decimal, []types.Decimal, []types.Decimal, []int64, int32) ([]types.Decimal, error)
+	resultScale    func(ls, rs int32) int32
+	resultShift    func(ls, rs, scale int32) int32
+	alignOperators bool
+}
+
+// decimalCompare contains the kernels of a decimal comparison operator, the
+// reverse kernels are used when the left operand is a vector and the right is a constant.
+type decimalCompare struct {
+	fn                    func([]types.Decimal, []types.Decimal, []int64) []int64
+	nullable              func([]types.Decimal, []types.Decimal, *roaring.Bitmap, []int64) []int64
+	scalar                func(types.Decimal, []types.Decimal, []int64) []int64
+	nullableScalar        func(types.Decimal, []types.Decimal, *roaring.Bitmap, []int64) []int64
+	reverseScalar         func(types.Decimal, []types.Decimal, []int64) []int64
+	reverseNullableScalar func(types.Decimal, []types.Decimal, *roaring.Bitmap, []int64) []int64
+}
+
+func initDecimal() {
+	arithOps := map[int]*decimalArith{
+		Plus: {
+			fn:             noShift(add.DecimalAdd),
+			fnSels:         noShiftSels(add.DecimalAddSels),
+			scalar:         noShiftScalar(add.DecimalAddScalar),
+			scalarSels:     noShiftScalarSels(add.DecimalAddScalarSels),
+			byScalar:       noShiftScalar(add.DecimalAddScalar),
+			byScalarSels:   noShiftScalarSels(add.DecimalAddScalarSels),
+			resultScale:    func(ls, _ int32) int32 { return ls },
+			resultShift:    func(_, _, _ int32) int32 { return 0 },
+			alignOperators: true,
+		},
+		Minus: {
+			fn:             noShift(sub.DecimalSub),
+			fnSels:         noShiftSels(sub.DecimalSubSels),
+			scalar:         noShiftScalar(sub.DecimalSubScalar),
+			scalarSels:     noShiftScalarSels(sub.DecimalSubScalarSels),
+			byScalar:       noShiftScalar(sub.DecimalSubByScalar),
+			byScalarSels:   noShiftScalarSels(sub.DecimalSubByScalarSels),
+			resultScale:    func(ls, _ int32) int32 { return ls },
+			resultShift:    func(_, _, _ int32) int32 { return 0 },
+			alignOperators: true,
+		},
+		Mult: {
+			fn:           mul.DecimalMul,
+			fnSels:       mul.DecimalMulSels,
+			scalar:       mul.DecimalMulScalar,
+			scalarSels:   mul.DecimalMulScalarSels,
+			byScalar:     mul.DecimalMulScalar,
+			byScalarSels: mul.DecimalMulScalarSels,
+			resultScale: func(ls, rs int32) int32 {
+				if ls+rs > types.MaxDecimalPrecision {
+					return types.MaxDecimalPrecision
+				}
+				return ls + rs
+			},
+			resultShift: func(ls, rs, scale int32) int32 { return ls + rs - scale },
+		},
+		Div: {
+			fn:           div.DecimalDiv,
+			fnSels:       div.DecimalDivSels,
+			scalar:       div.DecimalDivScalar,
+			scalarSels:   div.DecimalDivScalarSels,
+			byScalar:     div.DecimalDivByScalar,
+			byScalarSels: div.DecimalDivByScalarSels,
+			resultScale: func(ls, _ int32) int32 {
+				if ls+types.DecimalDivIncrement > types.MaxDecimalPrecision {
+					return types.MaxDecimalPrecision
+				}
+				return ls + types.DecimalDivIncrement
+			},
+			resultShift: func(ls, rs, scale int32) int32 { return rs + scale - ls },
+		},
+	}
+	for op, k := range arithOps {
+		k := k
+		BinOps[op] = append(BinOps[op], &BinOp{
+			LeftType:   types.T_decimal,
+			RightType:  types.T_decimal,
+			ReturnType: types.T_decimal,
+			Fn: func(lv, rv *vector.Vector, proc *process.Process, lc, rc bool) (*vector.Vector, error) {
+				return decimalArithEval(k, lv, rv, proc, lc, rc)
+			},
+		})
+	}
+
+	compareOps := map[int]*decimalCompare{
+		EQ: {eq.DecimalEq, eq.DecimalEqNullable, eq.DecimalEqScalar, eq.DecimalEqNullableScalar, eq.DecimalEqScalar, eq.DecimalEqNullableScalar},
+		NE: {ne.DecimalNe, ne.DecimalNeNullable, ne.DecimalNeScalar, ne.DecimalNeNullableScalar, ne.DecimalNeScalar, ne.DecimalNeNullableScalar},
+		LT: {lt.DecimalLt, lt.DecimalLtNullable, lt.DecimalLtScalar, lt.DecimalLtNullableScalar, gt.DecimalGtScalar, gt.DecimalGtNullableScalar},
+		LE: {le.DecimalLe, le.DecimalLeNullable, le.DecimalLeScalar, le.DecimalLeNullableScalar, ge.DecimalGeScalar, ge.DecimalGeNullableScalar},
+		GT: {gt.DecimalGt, gt.DecimalGtNullable, gt.DecimalGtScalar, gt.DecimalGtNullableScalar, lt.DecimalLtScalar, lt.DecimalLtNullableScalar},
+		GE: {ge.DecimalGe, ge.DecimalGeNullable, ge.DecimalGeScalar, ge.DecimalGeNullableScalar, le.DecimalLeScalar, le.DecimalLeNullableScalar},
+	}
+	for op, k := range compareOps {
+		k := k
+		BinOps[op] = append(BinOps[op], &BinOp{
+			LeftType:   types.T_decimal,
+			RightType:  types.T_decimal,
+			ReturnType: types.T_sel,
+			Fn: func(lv, rv *vector.Vector, proc *process.Process, lc, rc bool) (*vector.Vector, error) {
+				return decimalCompareEval(k, lv, rv, proc, lc, rc)
+			},
+		})
+	}
+
+	UnaryOps[UnaryMinus] = append(UnaryOps[UnaryMinus], &UnaryOp{
+		Typ:        types.T_decimal,
+		ReturnType: types.T_decimal,
+		Fn: func(v *vector.Vector, proc *process.Process, _ bool) (*vector.Vector, error) {
+			vs := v.Col.([]types.Decimal)
+			vec, err := process.Get(proc, int64(len(vs)*encoding.DecimalSize), v.Typ)
+			if err != nil {
+				return nil, err
+			}
+			rs := encoding.DecodeDecimalSlice(vec.Data)[:len(vs)]
+			for i := range vs {
+				rs[i] = vs[i].Neg()
+			}
+			nulls.Set(vec.Nsp, v.Nsp)
+			vector.SetCol(vec, rs)
+			if v.Ref == 0 {
+				process.Put(proc, v)
+			}
+			return vec, nil
+		},
+	})
+
+	// type cast between decimal and other types
+	others := []types.T{
+		types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64, types.T_char, types.T_varchar,
+	}
+	for _, typ := range others {
+		BinOps[Typecast] = append(BinOps[Typecast], &BinOp{
+			LeftType:   typ,
+			RightType:  types.T_decimal,
+			ReturnType: types.T_decimal,
+			Fn:         castToDecimal,
+		}, &BinOp{
+			LeftType:   types.T_decimal,
+			RightType:  typ,
+			ReturnType: typ,
+			Fn:         castFromDecimal,
+		})
+	}
+	BinOps[Typecast] = append(BinOps[Typecast], &BinOp{
+		LeftType:   types.T_decimal,
+		RightType:  types.T_decimal,
+		ReturnType: types.T_decimal,
+		Fn:         castToDecimal,
+	})
+}
+
+// initCastRulesForDecimal makes integers be converted to decimal and decimal be
+// converted to double when they are operands of an arithmetic or comparison operator.
+func initCastRulesForDecimal() {
+	integers := []types.T{
+		types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+	}
+	floats := []types.T{types.T_float32, types.T_float64}
+	decimalType := types.DecimalType(types.MaxDecimalPrecision, 0)
+	float64Type := types.Type{Oid: types.T_float64, Size: 8}
+
+	for _, op := range []int{Plus, Minus, Mult, Div, EQ, NE, LT, LE, GT, GE} {
+		for _, typ := range integers {
+			OperatorCastRules[op] = append(OperatorCastRules[op], []castRule{
+				{NumArgs: 2, sourceTypes: []types.T{typ, types.T_decimal}, targetTypes: []types.Type{decimalType, decimalType}},
+				{NumArgs: 2, sourceTypes: []types.T{types.T_decimal, typ}, targetTypes: []types.Type{decimalType, decimalType}},
+			}...)
+		}
+		for _, typ := range floats {
+			OperatorCastRules[op] = append(OperatorCastRules[op], []castRule{
+				{NumArgs: 2, sourceTypes: []types.T{typ, types.T_decimal}, targetTypes: []types.Type{float64Type, float64Type}},
+				{NumArgs: 2, sourceTypes: []types.T{types.T_decimal, typ}, targetTypes: []types.Type{float64Type, float64Type}},
+			}...)
+		}
+	}
+}
+
+func decimalArithEval(k *decimalArith, lv, rv *vector.Vector, proc *process.Process, lc, rc bool) (*vector.Vector, error) {
+	var err error
+
+	lvs, rvs := lv.Col.([]types.Decimal), rv.Col.([]types.Decimal)
+	ls, rs := types.DecimalScale(lv.Typ), types.DecimalScale(rv.Typ)
+	if k.alignOperators && ls != rs {
+		if ls < rs {
+			if lvs, err = decimalRescale(lvs, lv.Nsp, ls, rs); err != nil {
+				return nil, err
+			}
+			ls = rs
+		} else {
+			if rvs, err = decimalRescale(rvs, rv.Nsp, rs, ls); err != nil {
+				return nil, err
+			}
+			rs = ls
+		}
+	}
+	scale := k.resultScale(ls, rs)
+	shift := k.resultShift(ls, rs, scale)
+
+	n := len(lvs)
+	if lc && !rc {
+		n = len(rvs)
+	}
+	vec, err := process.Get(proc, int64(n*encoding.DecimalSize), types.DecimalType(types.MaxDecimalPrecision, scale))
+	if err != nil {
+		return nil, err
+	}
+	col := encoding.DecodeDecimalSlice(vec.Data)[:n]
+	switch {
+	case lc && !rc:
+		nulls.Set(vec.Nsp, rv.Nsp)
+	case !lc && rc:
+		nulls.Set(vec.Nsp, lv.Nsp)
+	default:
+		nulls.Or(lv.Nsp, rv.Nsp, vec.Nsp)
+	}
+	var sels []int64
+	if k.fnSels != nil && nulls.Any(vec.Nsp) {
+		sels = process.GetSels(proc)
+		defer process.PutSels(sels, proc)
+		for i := uint64(0); i < uint64(n); i++ {
+			if !nulls.Contains(vec.Nsp, i) {
+				sels = append(sels, int64(i))
+			}
+		}
+	}
+	switch {
+	case lc && !rc:
+		if sels != nil {
+			_, err = k.scalarSels(lvs[0], rvs, col, sels, shift)
+		} else {
+			_, err = k.scalar(lvs[0], rvs, col, shift)
+		}
+	case !lc && rc:
+		if sels != nil {
+			_, err = k.byScalarSels(rvs[0], lvs, col, sels, shift)
+		} else {
+			_, err = k.byScalar(rvs[0], lvs, col, shift)
+		}
+	default:
+		if sels != nil {
+			_, err = k.fnSels(lvs, rvs, col, sels, shift)
+		} else {
+			_, err = k.fn(lvs, rvs, col, shift)
+		}
+	}
+	if err != nil {
+		process.Put(proc, vec)
+		return nil, err
+	}
+	vector.SetCol(vec, col)
+	if lv.Ref == 0 {
+		process.Put(proc, lv)
+	}
+	if rv.Ref == 0 {
+		process.Put(proc, rv)
+	}
+	return vec, nil
+}
+
+func decimalCompareEval(k *decimalCompare, lv, rv *vector.Vector, proc *process.Process, lc, rc bool) (*vector.Vector, error) {
+	var err error
+
+	lvs, rvs := lv.Col.([]types.Decimal), rv.Col.([]types.Decimal)
+	ls, rs := types.DecimalScale(lv.Typ), types.DecimalScale(rv.Typ)
+	switch {
+	case ls < rs:
+		lvs, err = decimalRescale(lvs, lv.Nsp, ls, rs)
+	case ls > rs:
+		rvs, err = decimalRescale(rvs, rv.Nsp, rs, ls)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	n := len(lvs)
+	if lc && !rc {
+		n = len(rvs)
+	}
+	vec, err := process.Get(proc, int64(n)*8, SelsType)
+	if err != nil {
+		return nil, err
+	}
+	col := encoding.DecodeInt64Slice(vec.Data)[:n]
+	switch {
+	case lc && !rc:
+		if nulls.Any(rv.Nsp) {
+			vector.SetCol(vec, k.nullableScalar(lvs[0], rvs, rv.Nsp.Np, col))
+		} else {
+			vector.SetCol(vec, k.scalar(lvs[0], rvs, col))
+		}
+	case !lc && rc:
+		if nulls.Any(lv.Nsp) {
+			vector.SetCol(vec, k.reverseNullableScalar(rvs[0], lvs, lv.Nsp.Np, col))
+		} else {
+			vector.SetCol(vec, k.reverseScalar(rvs[0], lvs, col))
+		}
+	default:
+		switch {
+		case nulls.Any(lv.Nsp) && nulls.Any(rv.Nsp):
+			vector.SetCol(vec, k.nullable(lvs, rvs, roaring.Or(lv.Nsp.Np, rv.Nsp.Np), col))
+		case nulls.Any(rv.Nsp):
+			vector.SetCol(vec, k.nullable(lvs, rvs, rv.Nsp.Np, col))
+		case nulls.Any(lv.Nsp):
+			vector.SetCol(vec, k.nullable(lvs, rvs, lv.Nsp.Np, col))
+		default:
+			vector.SetCol(vec, k.fn(lvs, rvs, col))
+		}
+	}
+	if lv.Ref == 0 {
+		process.Put(proc, lv)
+	}
+	if rv.Ref == 0 {
+		process.Put(proc, rv)
+	}
+	return vec, nil
+}
+
+// castToDecimal converts the values of lv to be decimals of type rv.Typ.
+func castToDecimal(lv, rv *vector.Vector, proc *process.Process, _, _ bool) (*vector.Vector, error) {
+	if lv.Typ.Oid == types.T_decimal && lv.Typ.Precision == rv.Typ.Precision && lv.Typ.Width == rv.Typ.Width {
+		if lv.Ref == 0 {
+			return lv, nil
+		}
+	}
+	precision, scale := types.DecimalPrecision(rv.Typ), types.DecimalScale(rv.Typ)
+	if scale > precision {
+		scale = precision
+	}
+	n := vector.Length(lv)
+	vec, err := process.Get(proc, int64(n*encoding.DecimalSize), types.DecimalType(precision, scale))
+	if err != nil {
+		return nil, err
+	}
+	rs := encoding.DecodeDecimalSlice(vec.Data)[:n]
+	for i := 0; i < n; i++ {
+		if nulls.Contains(lv.Nsp, uint64(i)) {
+			rs[i] = types.Decimal{}
+			continue
+		}
+		var r types.Decimal
+
+		switch vs := lv.Col.(type) {
+		case []int8:
+			r, err = types.DecimalFromInt64(int64(vs[i]), precision, scale)
+		case []int16:
+			r, err = types.DecimalFromInt64(int64(vs[i]), precision, scale)
+		case []int32:
+			r, err = types.DecimalFromInt64(int64(vs[i]), precision, scale)
+		case []int64:
+			r, err = types.DecimalFromInt64(vs[i], precision, scale)
+		case []uint8:
+			r, err = types.DecimalFromUint64(uint64(vs[i]), precision, scale)
+		case []uint16:
+			r, err = types.DecimalFromUint64(uint64(vs[i]), precision, scale)
+		case []uint32:
+			r, err = types.DecimalFromUint64(uint64(vs[i]), precision, scale)
+		case []uint64:
+			r, err = types.DecimalFromUint64(vs[i], precision, scale)
+		case []float32:
+			r, err = types.DecimalFromFloat64(float64(vs[i]), precision, scale)
+		case []float64:
+			r, err = types.DecimalFromFloat64(vs[i], precision, scale)
+		case []types.Decimal:
+			r, err = vs[i].Rescale(types.DecimalScale(lv.Typ), precision, scale)
+		case *types.Bytes:
+			r, err = types.ParseDecimal(string(vs.Get(int64(i))), precision, scale)
+		}
+		if err != nil {
+			process.Put(proc, vec)
+			return nil, err
+		}
+		rs[i] = r
+	}
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, rs)
+	if lv.Ref == 0 {
+		process.Put(proc, lv)
+	}
+	return vec, nil
+}
+
+// castFromDecimal converts the decimals of lv to be values of type rv.Typ.
+func castFromDecimal(lv, rv *vector.Vector, proc *process.Process, _, _ bool) (*vector.Vector, error) {
+	var vec *vector.Vector
+	var err error
+
+	defer func() {
+		if lv.Ref == 0 {
+			process.Put(proc, lv)
+		}
+	}()
+	vs := lv.Col.([]types.Decimal)
+	scale := types.DecimalScale(lv.Typ)
+	if rv.Typ.Oid == types.T_char || rv.Typ.Oid == types.T_varchar {
+		col := &types.Bytes{
+			Data:    make([]byte, 0, len(vs)),
+			Offsets: make([]uint32, len(vs)),
+			Lengths: make([]uint32, len(vs)),
+		}
+		for i, v := range vs {
+			s := v.Format(scale)
+			col.Offsets[i] = uint32(len(col.Data))
+			col.Lengths[i] = uint32(len(s))
+			col.Data = append(col.Data, s...)
+		}
+		if err = proc.Mp.Gm.Alloc(int64(cap(col.Data))); err != nil {
+			return nil, err
+		}
+		vec = vector.New(rv.Typ)
+		vec.Data = col.Data
+		nulls.Set(vec.Nsp, lv.Nsp)
+		vector.SetCol(vec, col)
+		return vec, nil
+	}
+	if vec, err = process.Get(proc, int64(len(vs)*rv.Typ.Oid.TypeLen()), rv.Typ); err != nil {
+		return nil, err
+	}
+	switch rv.Typ.Oid {
+	case types.T_float32:
+		rs := encoding.DecodeFloat32Slice(vec.Data)[:len(vs)]
+		for i, v := range vs {
+			rs[i] = float32(v.ToFloat64(scale))
+		}
+		vector.SetCol(vec, rs)
+	case types.T_float64:
+		rs := encoding.DecodeFloat64Slice(vec.Data)[:len(vs)]
+		for i, v := range vs {
+			rs[i] = v.ToFloat64(scale)
+		}
+		vector.SetCol(vec, rs)
+	default:
+		if err = decimalToInteger(vs, scale, lv.Nsp, vec); err != nil {
+			process.Put(proc, vec)
+			return nil, err
+		}
+	}
+	nulls.Set(vec.Nsp, lv.Nsp)
+	return vec, nil
+}
+
+// decimalToInteger rounds the decimals to integers, and checks that they are in the range of vec's type.
+func decimalToInteger(vs []types.Decimal, scale int32, nsp *nulls.Nulls, vec *vector.Vector) error {
+	var min, max int64 = math.MinInt64, math.MaxInt64
+
+	switch vec.Typ.Oid {
+	case types.T_int8:
+		min, max = math.MinInt8, math.MaxInt8
+	case types.T_int16:
+		min, max = math.MinInt16, math.MaxInt16
+	case types.T_int32:
+		min, max = math.MinInt32, math.MaxInt32
+	case types.T_uint8:
+		min, max = 0, math.MaxUint8
+	case types.T_uint16:
+		min, max = 0, math.MaxUint16
+	case types.T_uint32:
+		min, max = 0, math.MaxUint32
+	case types.T_uint64:
+		min = 0
+	}
+	xs := make([]int64, len(vs))
+	for i, v := range vs {
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		x, err := v.ToInt64(scale)
+		if err != nil || x < min || x > max {
+			return errDecimalCastOutOfRange
+		}
+		xs[i] = x
+	}
+	switch vec.Typ.Oid {
+	case types.T_int8:
+		rs := encoding.DecodeInt8Slice(vec.Data)[:len(vs)]
+		for i, x := range xs {
+			rs[i] = int8(x)
+		}
+		vector.SetCol(vec, rs)
+	case types.T_int16:
+		rs := encoding.DecodeInt16Slice(vec.Data)[:len(vs)]
+		for i, x := range xs {
+			rs[i] = int16(x)
+		}
+		vector.SetCol(vec, rs)
+	case types.T_int32:
+		rs := encoding.DecodeInt32Slice(vec.Data)[:len(vs)]
+		for i, x := range xs {
+			rs[i] = int32(x)
+		}
+		vector.SetCol(vec, rs)
+	case types.T_int64:
+		rs := encoding.DecodeInt64Slice(vec.Data)[:len(vs)]
+		copy(rs, xs)
+		vector.SetCol(vec, rs)
+	case types.T_uint8:
+		rs := encoding.DecodeUint8Slice(vec.Data)[:len(vs)]
+		for i, x := range xs {
+			rs[i] = uint8(x)
+		}
+		vector.SetCol(vec, rs)
+	case types.T_uint16:
+		rs := encoding.DecodeUint16Slice(vec.Data)[:len(vs)]
+		for i, x := range xs {
+			rs[i] = uint16(x)
+		}
+		vector.SetCol(vec, rs)
+	case types.T_uint32:
+		rs := encoding.DecodeUint32Slice(vec.Data)[:len(vs)]
+		for i, x := range xs {
+			rs[i] = uint32(x)
+		}
+		vector.SetCol(vec, rs)
+	case types.T_uint64:
+		rs := encoding.DecodeUint64Slice(vec.Data)[:len(vs)]
+		for i, x := range xs {
+			rs[i] = uint64(x)
+		}
+		vector.SetCol(vec, rs)
+	}
+	return nil
+}
+
+// decimalRescale returns the decimals which are converted from scale from to scale to.
+func decimalRescale(vs []types.Decimal, nsp *nulls.Nulls, from, to int32) ([]types.Decimal, error) {
+	var err error
+
+	rs := make([]types.Decimal, len(vs))
+	for i, v := range vs {
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		if rs[i], err = v.Rescale(from, types.MaxDecimalPrecision, to); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
+
+func noShift(fn func([]types.Decimal, []types.Decimal, []types.Decimal) ([]types.Decimal, error)) func([]types.Decimal, []types.Decimal, []types.Decimal, int32) ([]types.Decimal, error) {
+	return func(xs, ys, rs []types.Decimal, _ int32) ([]types.Decimal, error) {
+		return fn(xs, ys, rs)
+	}
+}
+
+func noShiftSels(fn func([]types.Decimal, []types.Decimal, []types.Decimal, []int64) ([]types.Decimal, error)) func([]types.Decimal, []types.Decimal, []types.Decimal, []int64, int32) ([]types.Decimal, error) {
+	return func(xs, ys, rs []types.Decimal, sels []int64, _ int32) ([]types.Decimal, error) {
+		return fn(xs, ys, rs, sels)
+	}
+}
+
+func noShiftScalar(fn func(types.Decimal, []types.Decimal, []types.Decimal) ([]types.Decimal, error)) func(types.Decimal, []types.Decimal, []types.Decimal, int32) ([]types.Decimal, error) {
+	return func(x types.Decimal, ys, rs []types.Decimal, _ int32) ([]types.Decimal, error) {
+		return fn(x, ys, rs)
+	}
+}
+
+func noShiftScalarSels(fn func(types.Decimal, []types.Decimal, []types.Decimal, []int64) ([]types.Decimal, error)) func(types.Decimal, []types.Decimal, []types.Decimal, []int64, int32) ([]types.Decimal, error) {
+	return func(x types.Decimal, ys, rs []types.Decimal, sels []int64, _ int32) ([]types.Decimal, error) {
+		return fn(x, ys, rs, sels)
+	}
+}
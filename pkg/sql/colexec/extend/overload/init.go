@@ -36,6 +36,7 @@ func init() {
 	initOperatorFunctions()
 	// init cast-rule from ops
 	initCastRulesForBinaryOps()
+	initCastRulesForDecimal()
 	initCastRulesForUnaryOps()
 	initCastRulesForMulti()
 	// init return type map from ops and cast-rule
@@ -163,6 +164,8 @@ func initOperatorFunctions() {
 	// others
 	initCast()
 	initLike()
+	// decimal
+	initDecimal()
 }
 
 func initReturnTypeFromBinary() {
@@ -47,6 +47,27 @@ type MultiExtend struct {
 	Args []Extend
 }
 
+// CaseExtend returns the result of the first condition which holds, or Else
+// if none of them holds, a nil result means null.
+type CaseExtend struct {
+	Conds []Extend
+	Ress  []Extend
+	Else  Extend
+}
+
+// CoalesceExtend returns the first argument which is not null.
+type CoalesceExtend struct {
+	Args []Extend
+}
+
+// InExtend selects the rows whose E equals one of the constants Vs, or
+// differs from all of them if Not is true.
+type InExtend struct {
+	Not bool
+	E   Extend
+	Vs  []Extend
+}
+
 type ParenExtend struct {
 	E Extend
 }
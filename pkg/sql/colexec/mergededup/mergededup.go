@@ -32,9 +32,7 @@ func String(_ interface{}, buf *bytes.Buffer) {
 
 func Prepare(_ *process.Process, arg interface{}) error {
 	argument := arg.(*Argument)
-	argument.ctr = new(container)
-	argument.ctr.state = running
-	argument.ctr.initFlag = false
+	argument.ctr = NewContainer()
 	return nil
 }
 
@@ -68,25 +66,7 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 					i--
 					continue
 				}
-				// do init work
-				if argument.ctr.initFlag == false {
-					initHashTable(argument, bat)
-					argument.ctr.initFlag = true
-				}
-				// do deduplication work
-				switch argument.ctr.typ {
-				case dedup.H8:
-					err = argument.ctr.processH8(bat, proc)
-				case dedup.H24:
-					err = argument.ctr.processH24(bat, proc)
-				case dedup.H32:
-					err = argument.ctr.processH32(bat, proc)
-				case dedup.H40:
-					err = argument.ctr.processH40(bat, proc)
-				default:
-					err = argument.ctr.processHStr(bat, proc)
-				}
-				if err != nil {
+				if err = argument.ctr.Insert(bat, proc); err != nil {
 					batch.Clean(argument.ctr.bat, proc.Mp)
 					proc.Reg.InputBatch = nil
 					return false, err
@@ -108,12 +88,44 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	}
 }
 
-func initHashTable(n *Argument, bat *batch.Batch) {
+// NewContainer returns a container which deduplicates the rows of batches,
+// the operators merging batches by hashing use it.
+func NewContainer() *Container {
+	return &Container{state: running}
+}
+
+// Insert deduplicates the rows of bat into the container, the duplicate count
+// of each row is accumulated in the Zs of the result batch.
+func (ctr *Container) Insert(bat *batch.Batch, proc *process.Process) error {
+	if !ctr.initFlag {
+		ctr.initHashTable(bat)
+		ctr.initFlag = true
+	}
+	switch ctr.typ {
+	case dedup.H8:
+		return ctr.processH8(bat, proc)
+	case dedup.H24:
+		return ctr.processH24(bat, proc)
+	case dedup.H32:
+		return ctr.processH32(bat, proc)
+	case dedup.H40:
+		return ctr.processH40(bat, proc)
+	default:
+		return ctr.processHStr(bat, proc)
+	}
+}
+
+// Batch returns the result batch, it is nil if nothing is inserted.
+func (ctr *Container) Batch() *batch.Batch {
+	return ctr.bat
+}
+
+func (ctr *Container) initHashTable(bat *batch.Batch) {
 	size := 0
-	n.ctr.bat = batch.New(true, bat.Attrs)
+	ctr.bat = batch.New(true, bat.Attrs)
 	for i, vec := range bat.Vecs {
-		n.ctr.bat.Vecs[i] = vector.New(vec.Typ)
-		n.ctr.bat.Vecs[i].Ref = vec.Ref
+		ctr.bat.Vecs[i] = vector.New(vec.Typ)
+		ctr.bat.Vecs[i].Ref = vec.Ref
 		switch vec.Typ.Oid {
 		case types.T_int8:
 			size += 1
@@ -151,45 +163,47 @@ func initHashTable(n *Argument, bat *batch.Batch) {
 			size += 4
 		case types.T_datetime:
 			size += 8
+		case types.T_decimal:
+			size += 16
 		}
 	}
-	n.ctr.keyOffs = make([]uint32, dedup.UnitLimit)
-	n.ctr.zKeyOffs = make([]uint32, dedup.UnitLimit)
-	n.ctr.inserted = make([]uint8, dedup.UnitLimit)
-	n.ctr.zInserted = make([]uint8, dedup.UnitLimit)
-	n.ctr.hashes = make([]uint64, dedup.UnitLimit)
-	n.ctr.strHashStates = make([][3]uint64, dedup.UnitLimit)
-	n.ctr.values = make([]uint64, dedup.UnitLimit)
-	n.ctr.intHashMap = &hashtable.Int64HashMap{}
-	n.ctr.strHashMap = &hashtable.StringHashMap{}
+	ctr.keyOffs = make([]uint32, dedup.UnitLimit)
+	ctr.zKeyOffs = make([]uint32, dedup.UnitLimit)
+	ctr.inserted = make([]uint8, dedup.UnitLimit)
+	ctr.zInserted = make([]uint8, dedup.UnitLimit)
+	ctr.hashes = make([]uint64, dedup.UnitLimit)
+	ctr.strHashStates = make([][3]uint64, dedup.UnitLimit)
+	ctr.values = make([]uint64, dedup.UnitLimit)
+	ctr.intHashMap = &hashtable.Int64HashMap{}
+	ctr.strHashMap = &hashtable.StringHashMap{}
 	switch {
 	case size <= 8:
-		n.ctr.typ = dedup.H8
-		n.ctr.h8.keys = make([]uint64, dedup.UnitLimit)
-		n.ctr.h8.zKeys = make([]uint64, dedup.UnitLimit)
-		n.ctr.intHashMap.Init()
+		ctr.typ = dedup.H8
+		ctr.h8.keys = make([]uint64, dedup.UnitLimit)
+		ctr.h8.zKeys = make([]uint64, dedup.UnitLimit)
+		ctr.intHashMap.Init()
 	case size <= 24:
-		n.ctr.typ = dedup.H24
-		n.ctr.h24.keys = make([][3]uint64, dedup.UnitLimit)
-		n.ctr.h24.zKeys = make([][3]uint64, dedup.UnitLimit)
-		n.ctr.strHashMap.Init()
+		ctr.typ = dedup.H24
+		ctr.h24.keys = make([][3]uint64, dedup.UnitLimit)
+		ctr.h24.zKeys = make([][3]uint64, dedup.UnitLimit)
+		ctr.strHashMap.Init()
 	case size <= 32:
-		n.ctr.typ = dedup.H32
-		n.ctr.h32.keys = make([][4]uint64, dedup.UnitLimit)
-		n.ctr.h32.zKeys = make([][4]uint64, dedup.UnitLimit)
-		n.ctr.strHashMap.Init()
+		ctr.typ = dedup.H32
+		ctr.h32.keys = make([][4]uint64, dedup.UnitLimit)
+		ctr.h32.zKeys = make([][4]uint64, dedup.UnitLimit)
+		ctr.strHashMap.Init()
 	case size <= 40:
-		n.ctr.typ = dedup.H40
-		n.ctr.h40.keys = make([][5]uint64, dedup.UnitLimit)
-		n.ctr.h40.zKeys = make([][5]uint64, dedup.UnitLimit)
-		n.ctr.strHashMap.Init()
+		ctr.typ = dedup.H40
+		ctr.h40.keys = make([][5]uint64, dedup.UnitLimit)
+		ctr.h40.zKeys = make([][5]uint64, dedup.UnitLimit)
+		ctr.strHashMap.Init()
 	default:
-		n.ctr.typ = dedup.HStr
-		n.ctr.strHashMap.Init()
+		ctr.typ = dedup.HStr
+		ctr.strHashMap.Init()
 	}
 }
 
-func (ctr *container) processH8(bat *batch.Batch, proc *process.Process) error {
+func (ctr *Container) processH8(bat *batch.Batch, proc *process.Process) error {
 	vecs := bat.Vecs
 	count := int64(len(bat.Zs))
 	for i := int64(0); i < count; i += dedup.UnitLimit {
@@ -309,7 +323,7 @@ func (ctr *container) processH8(bat *batch.Batch, proc *process.Process) error {
 	return nil
 }
 
-func (ctr *container) processH24(bat *batch.Batch, proc *process.Process) error {
+func (ctr *Container) processH24(bat *batch.Batch, proc *process.Process) error {
 	vecs := bat.Vecs
 	count := int64(len(bat.Zs))
 	for i := int64(0); i < count; i += dedup.UnitLimit {
@@ -394,6 +408,12 @@ func (ctr *container) processH24(bat *batch.Batch, proc *process.Process) error
 					*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
 				}
 				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+			case types.T_decimal:
+				vs := vecs[j].Col.([]types.Decimal)
+				for k := int64(0); k < n; k++ {
+					*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+				}
+				add.Uint32AddScalar(16, ctr.keyOffs[:n], ctr.keyOffs[:n])
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				for k := int64(0); k < n; k++ {
@@ -427,7 +447,7 @@ func (ctr *container) processH24(bat *batch.Batch, proc *process.Process) error
 	return nil
 }
 
-func (ctr *container) processH32(bat *batch.Batch, proc *process.Process) error {
+func (ctr *Container) processH32(bat *batch.Batch, proc *process.Process) error {
 	vecs := bat.Vecs
 	count := int64(len(bat.Zs))
 	for i := int64(0); i < count; i += dedup.UnitLimit {
@@ -512,6 +532,12 @@ func (ctr *container) processH32(bat *batch.Batch, proc *process.Process) error
 					*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
 				}
 				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+			case types.T_decimal:
+				vs := vecs[j].Col.([]types.Decimal)
+				for k := int64(0); k < n; k++ {
+					*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+				}
+				add.Uint32AddScalar(16, ctr.keyOffs[:n], ctr.keyOffs[:n])
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				for k := int64(0); k < n; k++ {
@@ -545,7 +571,7 @@ func (ctr *container) processH32(bat *batch.Batch, proc *process.Process) error
 	return nil
 }
 
-func (ctr *container) processH40(bat *batch.Batch, proc *process.Process) error {
+func (ctr *Container) processH40(bat *batch.Batch, proc *process.Process) error {
 	vecs := bat.Vecs
 	count := int64(len(bat.Zs))
 	for i := int64(0); i < count; i += dedup.UnitLimit {
@@ -630,6 +656,12 @@ func (ctr *container) processH40(bat *batch.Batch, proc *process.Process) error
 					*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
 				}
 				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+			case types.T_decimal:
+				vs := vecs[j].Col.([]types.Decimal)
+				for k := int64(0); k < n; k++ {
+					*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+				}
+				add.Uint32AddScalar(16, ctr.keyOffs[:n], ctr.keyOffs[:n])
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				for k := int64(0); k < n; k++ {
@@ -663,7 +695,7 @@ func (ctr *container) processH40(bat *batch.Batch, proc *process.Process) error
 	return nil
 }
 
-func (ctr *container) processHStr(bat *batch.Batch, proc *process.Process) error {
+func (ctr *Container) processHStr(bat *batch.Batch, proc *process.Process) error {
 	vecs := bat.Vecs
 	keys := make([][]byte, dedup.UnitLimit)
 	count := int64(len(bat.Zs))
@@ -746,6 +778,12 @@ func (ctr *container) processHStr(bat *batch.Batch, proc *process.Process) error
 				for k := int64(0); k < n; k++ {
 					keys[k] = append(keys[k], data[(i+k)*8:(i+k+1)*8]...)
 				}
+			case types.T_decimal:
+				vs := vecs[j].Col.([]types.Decimal)
+				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*16)[:len(vs)*16]
+				for k := int64(0); k < n; k++ {
+					keys[k] = append(keys[k], data[(i+k)*16:(i+k+1)*16]...)
+				}
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				for k := int64(0); k < n; k++ {
@@ -24,7 +24,7 @@ const (
 	end
 )
 
-type container struct {
+type Container struct {
 	state uint8
 
 	// typ is hash table type, used to do deduplication work
@@ -64,5 +64,5 @@ type container struct {
 }
 
 type Argument struct {
-	ctr *container
+	ctr *Container
 }
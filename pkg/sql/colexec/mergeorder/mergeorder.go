@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
 )
 
 func String(arg interface{}, buf *bytes.Buffer) {
@@ -55,16 +56,6 @@ func Prepare(_ *process.Process, arg interface{}) error {
 func Call(proc *process.Process, arg interface{}) (bool, error) {
 	argument := arg.(*Argument)
 
-	if len(proc.Reg.MergeReceivers) == 1 {
-		reg := proc.Reg.MergeReceivers[0]
-		bat := <-reg.Ch
-		if bat == nil {
-			proc.Reg.MergeReceivers = nil
-		}
-		proc.Reg.InputBatch = bat
-		return true, nil
-	}
-
 	for {
 		switch argument.ctr.state {
 		case running:
@@ -80,13 +71,36 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 					i--
 					continue
 				}
-				err := mergeSort(proc.Mp, argument, bat)
-				if err != nil {
+				if err := argument.ctr.fill(proc, argument, bat); err != nil {
+					argument.ctr.clean(proc)
 					return false, err
 				}
 				i--
 			}
-			argument.ctr.state = end
+			mheap.Release(proc.Mp, argument.ctr.size)
+			argument.ctr.size = 0
+			if len(argument.ctr.runs) == 0 {
+				argument.ctr.state = end
+				continue
+			}
+			if err := argument.ctr.newSources(); err != nil {
+				argument.ctr.clean(proc)
+				return false, err
+			}
+			argument.ctr.state = merging
+		case merging:
+			bat, err := argument.ctr.merge(proc)
+			if err != nil {
+				argument.ctr.clean(proc)
+				return false, err
+			}
+			if bat == nil {
+				argument.ctr.clean(proc)
+				argument.ctr.state = end
+				continue
+			}
+			proc.Reg.InputBatch = bat
+			return false, nil
 		case end:
 			proc.Reg.InputBatch = argument.ctr.bat
 			argument.ctr.bat = nil
@@ -95,6 +109,149 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	}
 }
 
+// fill merges the batch into the result, the result is written to a
+// temporary file as a sorted run if the memory limitation is exceeded.
+func (ctr *container) fill(proc *process.Process, arg *Argument, bat *batch.Batch) error {
+	size := spill.Size(bat)
+	if err := mheap.Reserve(proc.Mp, size); err != nil {
+		if !spill.Enabled(proc) {
+			batch.Clean(bat, proc.Mp)
+			return err
+		}
+		if ctr.bat != nil {
+			err = ctr.spill(ctr.bat, proc)
+			ctr.bat = nil
+			mheap.Release(proc.Mp, ctr.size)
+			ctr.size = 0
+			if err != nil {
+				batch.Clean(bat, proc.Mp)
+				return err
+			}
+		}
+		if err := mheap.Reserve(proc.Mp, size); err != nil { // the batch is a sorted run
+			return ctr.spill(bat, proc)
+		}
+	}
+	ctr.size += size
+	return mergeSort(proc.Mp, arg, bat)
+}
+
+// spill writes the sorted batch into a new run.
+func (ctr *container) spill(bat *batch.Batch, proc *process.Process) error {
+	defer batch.Clean(bat, proc.Mp)
+	f, err := spill.Create(proc)
+	if err != nil {
+		return err
+	}
+	ctr.runs = append(ctr.runs, f)
+	return f.Write(bat, proc.Mp)
+}
+
+func (ctr *container) newSources() error {
+	for _, f := range ctr.runs {
+		r, err := f.Reader()
+		if err != nil {
+			return err
+		}
+		src := &source{r: r}
+		ctr.srcs = append(ctr.srcs, src)
+		if src.bat, err = r.Read(); err != nil {
+			return err
+		}
+	}
+	if ctr.bat != nil {
+		ctr.srcs = append(ctr.srcs, &source{bat: ctr.bat})
+		ctr.bat = nil
+	}
+	if ctr.cmps[0] == nil { // all the batches are written to the runs
+		for _, src := range ctr.srcs {
+			if src.bat != nil {
+				for k := range ctr.cmps {
+					ctr.cmps[k] = compare.New(batch.GetVector(src.bat, ctr.attrs[k]).Typ.Oid, ctr.ds[k])
+				}
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// merge returns the next batch of the merged runs, nil is returned if all
+// the runs are exhausted.
+func (ctr *container) merge(proc *process.Process) (*batch.Batch, error) {
+	var rbat *batch.Batch
+
+	for rows := 0; rows < spill.BatchRows; rows++ {
+		k := -1
+		for i, src := range ctr.srcs {
+			if src.bat != nil && (k < 0 || ctr.less(src, ctr.srcs[k])) {
+				k = i
+			}
+		}
+		if k < 0 {
+			break
+		}
+		src := ctr.srcs[k]
+		if rbat == nil {
+			rbat = batch.New(true, src.bat.Attrs)
+			for i, vec := range src.bat.Vecs {
+				rbat.Vecs[i] = vector.New(vec.Typ)
+			}
+		}
+		for i, vec := range rbat.Vecs {
+			if err := vector.UnionOne(vec, batch.GetVector(src.bat, rbat.Attrs[i]), src.row, proc.Mp); err != nil {
+				batch.Clean(rbat, proc.Mp)
+				return nil, err
+			}
+		}
+		rbat.Zs = append(rbat.Zs, src.bat.Zs[src.row])
+		if src.row++; src.row < int64(len(src.bat.Zs)) {
+			continue
+		}
+		batch.Clean(src.bat, proc.Mp)
+		src.bat, src.row = nil, 0
+		if src.r != nil {
+			var err error
+
+			if src.bat, err = src.r.Read(); err != nil {
+				batch.Clean(rbat, proc.Mp)
+				return nil, err
+			}
+		}
+	}
+	return rbat, nil
+}
+
+// less returns true if the current row of x is ahead of the one of y.
+func (ctr *container) less(x, y *source) bool {
+	for i, cmp := range ctr.cmps {
+		cmp.Set(0, batch.GetVector(x.bat, ctr.attrs[i]))
+		cmp.Set(1, batch.GetVector(y.bat, ctr.attrs[i]))
+		if r := cmp.Compare(0, 1, x.row, y.row); r != 0 {
+			return r < 0
+		}
+	}
+	return false
+}
+
+// clean removes the temporary files.
+func (ctr *container) clean(proc *process.Process) {
+	for _, src := range ctr.srcs {
+		if src.r != nil {
+			src.r.Close()
+		}
+		if src.bat != nil {
+			batch.Clean(src.bat, proc.Mp)
+		}
+	}
+	for _, f := range ctr.runs {
+		f.Remove()
+	}
+	ctr.srcs, ctr.runs = nil, nil
+	mheap.Release(proc.Mp, ctr.size)
+	ctr.size = 0
+}
+
 func makeFlagsOne(n int) []uint8 {
 	t := make([]uint8, n)
 	for i := range t {
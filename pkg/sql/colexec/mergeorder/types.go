@@ -18,18 +18,22 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/compare"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
 )
 
 // state values
 const (
 	running = iota
+	merging
 	end
 )
 
 type container struct {
 	// state signs the statement of mergeOrder operator
 	//	1. if state is running, operator still range the mergeReceivers to do merge-sort.
-	//	2. if state is end, operator has done and should push data to next operator.
+	//	2. if state is merging, operator merges the sorted runs which have been
+	//	   written to the temporary files and pushes the results batch by batch.
+	//	3. if state is end, operator has done and should push data to next operator.
 	state uint8
 
 	attrs []string // sorted list of attributes
@@ -39,6 +43,21 @@ type container struct {
 
 	// bat store the result of merge-order
 	bat *batch.Batch
+	// size is the bytes of bat reserved from the guest mmu
+	size int64
+
+	// runs are the sorted results written to the temporary files when the
+	// memory limitation is exceeded
+	runs []*spill.File
+	// srcs are the sources merged by the final merge
+	srcs []*source
+}
+
+// source is a sorted run of the final merge.
+type source struct {
+	r   *spill.Reader // nil if the run is in memory
+	row int64
+	bat *batch.Batch // current batch of the run, nil if it is exhausted
 }
 
 type Argument struct {
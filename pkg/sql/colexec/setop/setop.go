@@ -0,0 +1,147 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package setop
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergededup"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+var names = [...]string{
+	Union:     "union",
+	Intersect: "intersect",
+	Except:    "except",
+}
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	n := arg.(*Argument)
+	if n.All {
+		buf.WriteString(fmt.Sprintf("%s all", names[n.Type]))
+		return
+	}
+	buf.WriteString(names[n.Type])
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	n := arg.(*Argument)
+	n.ctr = new(container)
+	n.ctr.ctr = mergededup.NewContainer()
+	return nil
+}
+
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	n := arg.(*Argument)
+	ctr := n.ctr
+	for {
+		switch ctr.state {
+		case Left:
+			if err := ctr.insert(proc.Reg.MergeReceivers[0], proc); err != nil {
+				ctr.clean(proc)
+				return false, err
+			}
+			if bat := ctr.ctr.Batch(); bat != nil {
+				ctr.zs = append(ctr.zs, bat.Zs...)
+			}
+			ctr.state = Right
+		case Right:
+			if err := ctr.insert(proc.Reg.MergeReceivers[1], proc); err != nil {
+				ctr.clean(proc)
+				return false, err
+			}
+			ctr.state = Eval
+		case Eval:
+			ctr.state = End
+			if bat := ctr.eval(n.Type, n.All, proc); bat != nil {
+				proc.Reg.InputBatch = bat
+				return true, nil
+			}
+		case End:
+			proc.Reg.InputBatch = nil
+			return true, nil
+		}
+	}
+}
+
+// insert inserts all the batches received from reg into the hash table.
+func (ctr *container) insert(reg *process.WaitRegister, proc *process.Process) error {
+	for {
+		bat := <-reg.Ch
+		if bat == nil {
+			return nil
+		}
+		if len(bat.Zs) == 0 {
+			continue
+		}
+		err := ctr.ctr.Insert(bat, proc)
+		batch.Clean(bat, proc.Mp)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// eval returns the result of set operation, the duplicate count of each row
+// is computed from the counts of the left and right operands.
+func (ctr *container) eval(typ int, all bool, proc *process.Process) *batch.Batch {
+	bat := ctr.ctr.Batch()
+	if bat == nil {
+		return nil
+	}
+	sels := make([]int64, 0, len(bat.Zs))
+	zs := make([]int64, 0, len(bat.Zs))
+	for i, z := range bat.Zs {
+		var l int64
+		if i < len(ctr.zs) {
+			l = ctr.zs[i]
+		}
+		r := z - l
+		switch typ {
+		case Intersect:
+			if z = l; r < l {
+				z = r
+			}
+		case Except:
+			if z = l - r; !all && r > 0 {
+				z = 0
+			}
+		}
+		if z > 0 {
+			if !all {
+				z = 1
+			}
+			sels = append(sels, int64(i))
+			zs = append(zs, z)
+		}
+	}
+	if len(sels) == 0 {
+		batch.Clean(bat, proc.Mp)
+		return nil
+	}
+	if len(sels) < len(bat.Zs) {
+		batch.Shrink(bat, sels)
+	}
+	bat.Zs = zs
+	return bat
+}
+
+func (ctr *container) clean(proc *process.Process) {
+	if bat := ctr.ctr.Batch(); bat != nil {
+		batch.Clean(bat, proc.Mp)
+	}
+}
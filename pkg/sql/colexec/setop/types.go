@@ -0,0 +1,46 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package setop
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergededup"
+)
+
+const (
+	Union = iota
+	Intersect
+	Except
+)
+
+const (
+	Left = iota
+	Right
+	Eval
+	End
+)
+
+type container struct {
+	state int
+	zs    []int64 // duplicate counts of the rows from the left operand
+	ctr   *mergededup.Container
+}
+
+// Argument merges the results of the left and right operands, which are
+// received from the first and second merge receivers.
+type Argument struct {
+	Type int  // type of set operation, Union, Intersect or Except
+	All  bool // if true, the duplicate rows are kept
+	ctr  *container
+}
@@ -15,13 +15,26 @@
 package updateTag
 
 import (
-	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
 
+type container struct {
+	done bool
+	dbat *batch.Batch // old rows which will be deleted
+	ubat *batch.Batch // new rows which will be written
+}
+
 type Argument struct {
-	Ts 		     uint64
+	Ts           uint64
 	Relation     engine.Relation
-	M	  	 	 sync.Mutex
+	UpdateAttrs  []string        // names of updated attributes
+	UpdateList   []extend.Extend // new values of updated attributes
+	M            sync.Mutex
 	AffectedRows uint64
-}
\ No newline at end of file
+	// ctr stores the attributes needn't do Serialization work
+	ctr container
+}
@@ -16,30 +16,213 @@ package updateTag
 
 import (
 	"bytes"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/compare"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
 func String(arg interface{}, buf *bytes.Buffer) {
-	buf.WriteString("update table")
+	n := arg.(*Argument)
+	buf.WriteString("update(")
+	for i, attr := range n.UpdateAttrs {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(fmt.Sprintf("%s = %s", attr, n.UpdateList[i]))
+	}
+	buf.WriteString(")")
 }
 
 func Prepare(_ *process.Process, _ interface{}) error {
 	return nil
 }
 
+// Call collects the old rows and the rows with updated attributes, they are
+// written after all the rows are read, so that the updated rows will not be
+// read and updated again by the same statement. The rows whose attributes are
+// not changed are neither written nor counted as affected rows, as MySQL does.
 func Call(proc *process.Process, arg interface{}) (bool, error) {
 	p := arg.(*Argument)
 	bat := proc.Reg.InputBatch
-	if bat == nil || len(bat.Zs) == 0 {
+	if bat == nil {
+		if p.ctr.done {
+			return false, nil
+		}
+		p.ctr.done = true
+		defer p.ctr.clean(proc)
+		return false, p.ctr.write(p)
+	}
+	if len(bat.Zs) == 0 {
 		return false, nil
 	}
-	// TODO: update logic
-	affectedRows := uint64(vector.Length(bat.Vecs[0]))
+	defer func() {
+		batch.Clean(bat, proc.Mp)
+		proc.Reg.InputBatch = &batch.Batch{}
+	}()
+	if err := batch.Shuffle(bat, proc.Mp); err != nil {
+		return false, err
+	}
+	n, err := p.ctr.collect(p, bat, proc)
+	if err != nil {
+		p.ctr.clean(proc)
+		return false, err
+	}
 	p.M.Lock()
-	p.AffectedRows += affectedRows
+	p.AffectedRows += uint64(n)
 	p.M.Unlock()
 	return false, nil
 }
 
+// collect appends the changed rows of bat to the container and returns the number of them
+func (ctr *container) collect(ap *Argument, bat *batch.Batch, proc *process.Process) (int, error) {
+	var vecs []*vector.Vector // vectors generated by update list
+
+	defer func() {
+		for _, vec := range vecs {
+			vector.Clean(vec, proc.Mp)
+		}
+	}()
+	if ctr.dbat == nil {
+		ctr.dbat = newBatch(bat)
+		ctr.ubat = newBatch(bat)
+	}
+	idxs := make([]int, len(ap.UpdateList))
+	uvecs := make([]*vector.Vector, len(bat.Vecs))
+	copy(uvecs, bat.Vecs)
+	for i, e := range ap.UpdateList {
+		vec, _, err := e.Eval(bat, proc)
+		if err != nil {
+			return 0, err
+		}
+		if !isBatchVector(bat, vec) {
+			vecs = append(vecs, vec)
+		}
+		idx := batch.GetVectorIndex(bat, ap.UpdateAttrs[i])
+		if idx < 0 {
+			return 0, fmt.Errorf("unknown column '%s'", ap.UpdateAttrs[i])
+		}
+		idxs[i] = idx
+		uvecs[idx] = vec
+	}
+	n := vector.Length(bat.Vecs[0])
+	sels := changedRows(bat.Vecs, uvecs, idxs, n)
+	if len(sels) == 0 {
+		return 0, nil
+	}
+	for i, vec := range bat.Vecs {
+		if err := fill(ctr.dbat.Vecs[i], vec, sels, n, proc); err != nil {
+			return 0, err
+		}
+		if err := fill(ctr.ubat.Vecs[i], uvecs[i], sels, n, proc); err != nil {
+			return 0, err
+		}
+	}
+	for _, sel := range sels {
+		ctr.dbat.Zs = append(ctr.dbat.Zs, bat.Zs[sel])
+		ctr.ubat.Zs = append(ctr.ubat.Zs, bat.Zs[sel])
+	}
+	return len(sels), nil
+}
+
+// write deletes the old rows and writes the updated rows, the old rows are
+// written back if the updated rows cannot be written.
+func (ctr *container) write(ap *Argument) error {
+	if ctr.dbat == nil || len(ctr.ubat.Zs) == 0 {
+		return nil
+	}
+	zs := ctr.dbat.Zs
+	//Note until storage supports deletion
+	ctr.dbat.Zs = []int64{-1, -1}
+	err := ap.Relation.Write(ap.Ts, ctr.dbat)
+	ctr.dbat.Zs = zs
+	if err != nil {
+		return err
+	}
+	if err = ap.Relation.Write(ap.Ts, ctr.ubat); err != nil {
+		if rerr := ap.Relation.Write(ap.Ts, ctr.dbat); rerr != nil {
+			return rerr
+		}
+		return err
+	}
+	return nil
+}
+
+func (ctr *container) clean(proc *process.Process) {
+	if ctr.dbat != nil {
+		batch.Clean(ctr.dbat, proc.Mp)
+		batch.Clean(ctr.ubat, proc.Mp)
+		ctr.dbat, ctr.ubat = nil, nil
+	}
+}
+
+// newBatch returns an empty batch with the same attributes as bat
+func newBatch(bat *batch.Batch) *batch.Batch {
+	rbat := batch.New(true, bat.Attrs)
+	for i, vec := range bat.Vecs {
+		rbat.Vecs[i] = vector.New(vec.Typ)
+	}
+	return rbat
+}
+
+// fill appends the rows of vec selected by sels to rvec, the value of a
+// constant vec is repeated for every row.
+func fill(rvec, vec *vector.Vector, sels []int64, n int, proc *process.Process) error {
+	if vector.Length(vec) != n {
+		sels = make([]int64, len(sels))
+	}
+	length := vector.Length(rvec)
+	if err := vector.Union(rvec, vec, sels, proc.Mp); err != nil {
+		return err
+	}
+	if vector.Length(rvec) != length+len(sels) {
+		return fmt.Errorf("update for type '%s' not implement now", vec.Typ)
+	}
+	return nil
+}
+
+// changedRows returns the rows of which at least one updated attribute
+// is changed, idxs are the positions of the updated attributes.
+func changedRows(vecs, uvecs []*vector.Vector, idxs []int, n int) []int64 {
+	cmps := make([]compare.Compare, len(idxs))
+	for i, idx := range idxs {
+		if vecs[idx].Typ.Oid == uvecs[idx].Typ.Oid {
+			if cmps[i] = compare.New(vecs[idx].Typ.Oid, false); cmps[i] != nil {
+				cmps[i].Set(0, vecs[idx])
+				cmps[i].Set(1, uvecs[idx])
+			}
+		}
+	}
+	sels := make([]int64, 0, n)
+	for row := int64(0); row < int64(n); row++ {
+		for i, idx := range idxs {
+			urow := row
+			if vector.Length(uvecs[idx]) != n { // constant value
+				urow = 0
+			}
+			if cmps[i] == nil {
+				sels = append(sels, row)
+				break
+			}
+			null := nulls.Contains(vecs[idx].Nsp, uint64(row))
+			unull := nulls.Contains(uvecs[idx].Nsp, uint64(urow))
+			if null != unull || (!null && cmps[i].Compare(0, 1, row, urow) != 0) {
+				sels = append(sels, row)
+				break
+			}
+		}
+	}
+	return sels
+}
 
+func isBatchVector(bat *batch.Batch, vec *vector.Vector) bool {
+	for _, v := range bat.Vecs {
+		if v == vec {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,100 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
+)
+
+// opcodes of window functions
+const (
+	RowNumber = iota
+	Rank
+	DenseRank
+	Lag
+	Lead
+	Aggregate
+)
+
+// units of window frame
+const (
+	Rows = iota
+	Range
+)
+
+// types of window frame bound
+const (
+	UnboundedPreceding = iota
+	Preceding
+	CurrentRow
+	Following
+	UnboundedFollowing
+)
+
+const (
+	Build = iota
+	End
+)
+
+var FuncNames = [...]string{
+	RowNumber: "row_number",
+	Rank:      "rank",
+	DenseRank: "dense_rank",
+	Lag:       "lag",
+	Lead:      "lead",
+}
+
+type Bound struct {
+	Type   int
+	Offset int64 // offset of preceding and following
+}
+
+type Frame struct {
+	Type  int // Rows or Range
+	Start Bound
+	End   Bound
+}
+
+type Func struct {
+	Op     int           // opcode of window function
+	Agg    int           // opcode of aggregation function if op is Aggregate
+	Ref    int           // reference count of the result
+	Name   string        // attribute of argument, empty if the function has no argument
+	Dflt   extend.Extend // constant default value of lag and lead, nil if it is null
+	Offset int64         // offset of lag and lead
+	Alias  string        // attribute of result
+	Type   types.Type    // type of result
+	Frame  Frame
+}
+
+type container struct {
+	state int
+	bat   *batch.Batch
+}
+
+// Spec is the window functions with the same partition and order.
+type Spec struct {
+	Partitions []string      // attributes of partition by
+	Fs         []order.Field // attributes of order by
+	Funcs      []Func
+}
+
+type Argument struct {
+	Specs []Spec
+	ctr   *container
+}
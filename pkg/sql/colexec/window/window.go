@@ -0,0 +1,508 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/partition"
+	"github.com/matrixorigin/matrixone/pkg/sort"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transformer"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	n := arg.(*Argument)
+	buf.WriteString("ω(")
+	for i, spec := range n.Specs {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString("[")
+		for j, attr := range spec.Partitions {
+			if j > 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(attr)
+		}
+		buf.WriteString("], [")
+		for j, f := range spec.Fs {
+			if j > 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(f.String())
+		}
+		buf.WriteString("], [")
+		for j, f := range spec.Funcs {
+			if j > 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(fmt.Sprintf("%s -> %s", f.String(), f.Alias))
+		}
+		buf.WriteString("]")
+	}
+	buf.WriteString(")")
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	n := arg.(*Argument)
+	n.ctr = new(container)
+	return nil
+}
+
+// Call buffers all the input batches, and returns the sorted rows with the
+// results of window functions when the input is exhausted.
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	n := arg.(*Argument)
+	ctr := n.ctr
+	bat := proc.Reg.InputBatch
+	if bat == nil {
+		if ctr.state == End || ctr.bat == nil {
+			ctr.state = End
+			return true, nil
+		}
+		ctr.state = End
+		if err := ctr.eval(n, proc); err != nil {
+			ctr.clean(proc)
+			return false, err
+		}
+		proc.Reg.InputBatch, ctr.bat = ctr.bat, nil
+		return false, nil
+	}
+	if len(bat.Zs) == 0 {
+		return false, nil
+	}
+	err := ctr.fill(bat, proc)
+	proc.Reg.InputBatch = &batch.Batch{}
+	if err != nil {
+		ctr.clean(proc)
+		return false, err
+	}
+	return false, nil
+}
+
+func (f Func) String() string {
+	var name string
+
+	if f.Op == Aggregate {
+		name = transformer.TransformerNames[f.Agg]
+	} else {
+		name = FuncNames[f.Op]
+	}
+	switch f.Op {
+	case Lag, Lead:
+		if f.Dflt == nil {
+			return fmt.Sprintf("%s(%s, %v, null)", name, f.Name, f.Offset)
+		}
+		return fmt.Sprintf("%s(%s, %v, %s)", name, f.Name, f.Offset, f.Dflt)
+	case Aggregate:
+		return fmt.Sprintf("%s(%s) %s", name, f.Name, f.Frame)
+	}
+	return fmt.Sprintf("%s()", name)
+}
+
+func (f Frame) String() string {
+	if f.Type == Range {
+		return fmt.Sprintf("range between %s and %s", f.Start, f.End)
+	}
+	return fmt.Sprintf("rows between %s and %s", f.Start, f.End)
+}
+
+func (b Bound) String() string {
+	switch b.Type {
+	case UnboundedPreceding:
+		return "unbounded preceding"
+	case Preceding:
+		return fmt.Sprintf("%v preceding", b.Offset)
+	case CurrentRow:
+		return "current row"
+	case Following:
+		return fmt.Sprintf("%v following", b.Offset)
+	}
+	return "unbounded following"
+}
+
+func (ctr *container) fill(bat *batch.Batch, proc *process.Process) error {
+	defer batch.Clean(bat, proc.Mp)
+	if len(bat.Sels) > 0 {
+		if err := batch.Shuffle(bat, proc.Mp); err != nil {
+			return err
+		}
+	}
+	if ctr.bat == nil {
+		ctr.bat = batch.New(true, bat.Attrs)
+		for i, vec := range bat.Vecs {
+			ctr.bat.Vecs[i] = vector.New(vec.Typ)
+			ctr.bat.Vecs[i].Ref = vec.Ref
+		}
+	} else {
+		batch.Reorder(bat, ctr.bat.Attrs)
+	}
+	sels := make([]int64, len(bat.Zs))
+	for i := range sels {
+		sels[i] = int64(i)
+	}
+	for i, vec := range bat.Vecs {
+		if err := vector.Union(ctr.bat.Vecs[i], vec, sels, proc.Mp); err != nil {
+			return err
+		}
+	}
+	ctr.bat.Zs = append(ctr.bat.Zs, bat.Zs...)
+	return nil
+}
+
+// eval evaluates the window functions spec by spec, the rows are sorted by
+// the partition and order attributes of each spec before evaluation.
+func (ctr *container) eval(n *Argument, proc *process.Process) error {
+	for i, spec := range n.Specs {
+		var sels []int64
+
+		if i == 0 { // expand the duplicate rows
+			for j, z := range ctr.bat.Zs {
+				for ; z > 0; z-- {
+					sels = append(sels, int64(j))
+				}
+			}
+		} else {
+			sels = make([]int64, len(ctr.bat.Zs))
+			for j := range sels {
+				sels[j] = int64(j)
+			}
+		}
+		attrs := make([]string, 0, len(spec.Partitions)+len(spec.Fs))
+		ds := make([]bool, 0, len(spec.Partitions)+len(spec.Fs))
+		for _, attr := range spec.Partitions {
+			attrs = append(attrs, attr)
+			ds = append(ds, false)
+		}
+		for _, f := range spec.Fs {
+			attrs = append(attrs, f.Attr)
+			ds = append(ds, f.Type == order.Descending)
+		}
+		sortSels(ctr.bat, sels, attrs, ds)
+		if err := ctr.shuffle(sels, proc); err != nil {
+			return err
+		}
+		if err := ctr.evalSpec(spec, proc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// shuffle rebuilds the batch by the rows of sels.
+func (ctr *container) shuffle(sels []int64, proc *process.Process) error {
+	bat := batch.New(true, ctr.bat.Attrs)
+	for i, vec := range ctr.bat.Vecs {
+		bat.Vecs[i] = vector.New(vec.Typ)
+		bat.Vecs[i].Ref = vec.Ref
+		if err := vector.Union(bat.Vecs[i], vec, sels, proc.Mp); err != nil {
+			bat.Vecs = bat.Vecs[:i+1]
+			batch.Clean(bat, proc.Mp)
+			return err
+		}
+	}
+	bat.Zs = make([]int64, len(sels))
+	for i := range bat.Zs {
+		bat.Zs[i] = 1
+	}
+	batch.Clean(ctr.bat, proc.Mp)
+	ctr.bat = bat
+	return nil
+}
+
+func (ctr *container) evalSpec(spec Spec, proc *process.Process) error {
+	rows := len(ctr.bat.Zs)
+	sels := make([]int64, rows)
+	for i := range sels {
+		sels[i] = int64(i)
+	}
+	// pflags[i] is true if the i-th row starts a partition, and
+	// oflags[i] is true if the i-th row starts a group of peers.
+	pflags := make([]bool, rows)
+	oflags := make([]bool, rows)
+	{
+		ps := make([]int64, 0, 16)
+		for _, attr := range spec.Partitions {
+			ps = partition.Partition(sels, pflags, ps, batch.GetVector(ctr.bat, attr))
+		}
+		pflags[0] = true
+		copy(oflags, pflags)
+		for _, f := range spec.Fs {
+			ps = partition.Partition(sels, oflags, ps, batch.GetVector(ctr.bat, f.Attr))
+		}
+	}
+	w := newWindow(pflags, oflags)
+	vecs := make([]*vector.Vector, len(spec.Funcs))
+	for i, f := range spec.Funcs {
+		var err error
+
+		switch f.Op {
+		case RowNumber, Rank, DenseRank:
+			vecs[i], err = w.ranking(f.Op, proc)
+		case Lag, Lead:
+			vecs[i], err = w.shift(f, ctr.bat, proc)
+		case Aggregate:
+			vecs[i], err = w.aggregate(f, ctr.bat, proc)
+		}
+		if err != nil {
+			for j := 0; j < i; j++ {
+				vector.Clean(vecs[j], proc.Mp)
+			}
+			return err
+		}
+		vecs[i].Ref = uint64(f.Ref)
+	}
+	for _, attr := range spec.Partitions {
+		batch.Reduce(ctr.bat, []string{attr}, proc.Mp)
+	}
+	for _, f := range spec.Fs {
+		batch.Reduce(ctr.bat, []string{f.Attr}, proc.Mp)
+	}
+	for i, f := range spec.Funcs {
+		if len(f.Name) > 0 {
+			batch.Reduce(ctr.bat, []string{f.Name}, proc.Mp)
+		}
+		ctr.bat.Attrs = append(ctr.bat.Attrs, f.Alias)
+		ctr.bat.Vecs = append(ctr.bat.Vecs, vecs[i])
+	}
+	return nil
+}
+
+func (ctr *container) clean(proc *process.Process) {
+	if ctr.bat != nil {
+		batch.Clean(ctr.bat, proc.Mp)
+		ctr.bat = nil
+	}
+	proc.Reg.InputBatch = &batch.Batch{}
+}
+
+// sortSels sorts the sels by the attributes one by one, the rows
+// with the same values of previous attributes are sorted by the next.
+func sortSels(bat *batch.Batch, sels []int64, attrs []string, ds []bool) {
+	if len(attrs) == 0 {
+		return
+	}
+	vec := batch.GetVector(bat, attrs[0])
+	sort.Sort(ds[0], sels, vec)
+	ps := make([]int64, 0, 16)
+	diffs := make([]bool, len(sels))
+	for i := 1; i < len(attrs); i++ {
+		ps = partition.Partition(sels, diffs, ps, vec)
+		vec = batch.GetVector(bat, attrs[i])
+		for j := range ps {
+			if j == len(ps)-1 {
+				sort.Sort(ds[i], sels[ps[j]:], vec)
+			} else {
+				sort.Sort(ds[i], sels[ps[j]:ps[j+1]], vec)
+			}
+		}
+	}
+}
+
+// window records the partition and the peers of each row.
+type window struct {
+	pstart []int64 // first row of the partition
+	pend   []int64 // last row of the partition
+	ostart []int64 // first row of the peers
+	oend   []int64 // last row of the peers
+}
+
+func newWindow(pflags, oflags []bool) *window {
+	rows := len(pflags)
+	w := &window{
+		pstart: make([]int64, rows),
+		pend:   make([]int64, rows),
+		ostart: make([]int64, rows),
+		oend:   make([]int64, rows),
+	}
+	for i := 0; i < rows; i++ {
+		if pflags[i] {
+			w.pstart[i] = int64(i)
+		} else {
+			w.pstart[i] = w.pstart[i-1]
+		}
+		if oflags[i] {
+			w.ostart[i] = int64(i)
+		} else {
+			w.ostart[i] = w.ostart[i-1]
+		}
+	}
+	for i := rows - 1; i >= 0; i-- {
+		if i == rows-1 || pflags[i+1] {
+			w.pend[i] = int64(i)
+		} else {
+			w.pend[i] = w.pend[i+1]
+		}
+		if i == rows-1 || oflags[i+1] {
+			w.oend[i] = int64(i)
+		} else {
+			w.oend[i] = w.oend[i+1]
+		}
+	}
+	return w
+}
+
+func (w *window) ranking(op int, proc *process.Process) (*vector.Vector, error) {
+	rows := len(w.pstart)
+	vec, err := process.Get(proc, int64(rows)*8, types.Type{Oid: types.T_int64, Size: 8})
+	if err != nil {
+		return nil, err
+	}
+	vs := encoding.DecodeInt64Slice(vec.Data)[:rows]
+	for i := 0; i < rows; i++ {
+		switch op {
+		case RowNumber:
+			vs[i] = int64(i) - w.pstart[i] + 1
+		case Rank:
+			vs[i] = w.ostart[i] - w.pstart[i] + 1
+		case DenseRank:
+			switch {
+			case int64(i) == w.pstart[i]:
+				vs[i] = 1
+			case int64(i) == w.ostart[i]:
+				vs[i] = vs[i-1] + 1
+			default:
+				vs[i] = vs[i-1]
+			}
+		}
+	}
+	vec.Col = vs
+	return vec, nil
+}
+
+func (w *window) shift(f Func, bat *batch.Batch, proc *process.Process) (*vector.Vector, error) {
+	var err error
+	var dvec *vector.Vector
+
+	src := batch.GetVector(bat, f.Name)
+	if f.Dflt != nil {
+		if dvec, _, err = f.Dflt.Eval(bat, proc); err != nil {
+			return nil, err
+		}
+		if _, ok := f.Dflt.(*extend.ValueExtend); !ok {
+			defer vector.Clean(dvec, proc.Mp)
+		}
+	}
+	vec := vector.New(src.Typ)
+	for i := range w.pstart {
+		j := int64(i) - f.Offset
+		if f.Op == Lead {
+			j = int64(i) + f.Offset
+		}
+		switch {
+		case j >= w.pstart[i] && j <= w.pend[i]:
+			if err := vector.UnionOne(vec, src, j, proc.Mp); err != nil {
+				vector.Clean(vec, proc.Mp)
+				return nil, err
+			}
+		case dvec != nil:
+			if err := vector.UnionOne(vec, dvec, 0, proc.Mp); err != nil {
+				vector.Clean(vec, proc.Mp)
+				return nil, err
+			}
+		default:
+			if err := vector.UnionOne(vec, src, int64(i), proc.Mp); err != nil {
+				vector.Clean(vec, proc.Mp)
+				return nil, err
+			}
+			nulls.Add(vec.Nsp, uint64(i))
+		}
+	}
+	return vec, nil
+}
+
+// aggregate evaluates the aggregation function over the frame of each row,
+// the i-th group of the ring holds the result of the i-th row.
+func (w *window) aggregate(f Func, bat *batch.Batch, proc *process.Process) (*vector.Vector, error) {
+	src := batch.GetVector(bat, f.Name)
+	r, err := transformer.New(f.Agg, src.Typ)
+	if err != nil {
+		return nil, err
+	}
+	rows := len(w.pstart)
+	if err := r.Grows(rows, proc.Mp); err != nil {
+		r.Free(proc.Mp)
+		return nil, err
+	}
+	zs := make([]int64, rows)
+	// the frame which starts from the first row of the partition grows
+	// monotonically, so the result is accumulated from the previous row.
+	cumulative := f.Frame.Start.Type == UnboundedPreceding
+	last := int64(-1)
+	for i := range w.pstart {
+		lo, hi := w.frame(f.Frame, int64(i))
+		if cumulative {
+			if int64(i) == w.pstart[i] {
+				last = lo - 1
+			} else {
+				r.Add(r, int64(i), int64(i-1))
+				zs[i] = zs[i-1]
+			}
+			for ; last < hi; last++ {
+				r.Fill(int64(i), last+1, 1, src)
+				zs[i]++
+			}
+			continue
+		}
+		for j := lo; j <= hi; j++ {
+			r.Fill(int64(i), j, 1, src)
+			zs[i]++
+		}
+	}
+	return r.Eval(zs), nil
+}
+
+// frame returns the first and the last row of the frame of the i-th row,
+// the frame is empty if the first is greater than the last.
+func (w *window) frame(f Frame, i int64) (int64, int64) {
+	lo := w.bound(f, f.Start, i, true)
+	hi := w.bound(f, f.End, i, false)
+	if lo < w.pstart[i] {
+		lo = w.pstart[i]
+	}
+	if hi > w.pend[i] {
+		hi = w.pend[i]
+	}
+	return lo, hi
+}
+
+func (w *window) bound(f Frame, b Bound, i int64, start bool) int64 {
+	switch b.Type {
+	case UnboundedPreceding:
+		return w.pstart[i]
+	case Preceding:
+		return i - b.Offset
+	case CurrentRow:
+		if f.Type == Range {
+			if start {
+				return w.ostart[i]
+			}
+			return w.oend[i]
+		}
+		return i
+	case Following:
+		return i + b.Offset
+	}
+	return w.pend[i]
+}
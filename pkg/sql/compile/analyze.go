@@ -0,0 +1,268 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"math/rand"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/approxcd"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/output"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+)
+
+const (
+	// histogramBuckets is the number of buckets of equi-depth histogram
+	histogramBuckets = 32
+	// sampleSize is the number of values sampled to build the histogram
+	sampleSize = 8192
+)
+
+// collector collects the statistics of attributes from the batches of rows.
+type collector struct {
+	rows int64
+	cols []*columnCollector
+	m    *mheap.Mheap
+}
+
+type columnCollector struct {
+	attr     engine.Attribute
+	ndv      *approxcd.ApproxCountDistinctRing // nil if the type is not supported
+	nulls    int64
+	hasRange bool
+	min, max float64
+	seen     int64     // number of the numeric values seen
+	sample   []float64 // reservoir sample of the numeric values
+}
+
+// compileAnalyze builds the scope of query reading the attributes, the
+// batches of query are consumed by a collector. The scope of query is
+// nil if the relation is empty.
+func (e *Exec) compileAnalyze(pn *plan.Analyze) (*Scope, error) {
+	s, err := e.compilePlanScope(pn.Qry.Scope)
+	if err != nil {
+		return nil, err
+	}
+	rs := &Scope{
+		Magic: Analyze,
+		Plan:  pn,
+		Proc:  e.c.proc,
+	}
+	if s != nil {
+		c, err := newCollector(pn.Attrs, e.c.proc.Mp)
+		if err != nil {
+			return nil, err
+		}
+		s.Instructions = append(s.Instructions, vm.Instruction{
+			Op: vm.Output,
+			Arg: &output.Argument{
+				Attrs: pn.Qry.Result,
+				Data:  c,
+				Func: func(u interface{}, bat *batch.Batch) error {
+					return u.(*collector).fill(bat)
+				},
+			},
+		})
+		rs.PreScopes = []*Scope{s}
+	}
+	return rs, nil
+}
+
+// Analyze runs the query of scope and replaces the statistics of relation,
+// the statistics of the attributes not analyzed this time are kept.
+func (s *Scope) Analyze(ts uint64, e engine.Engine) error {
+	var err error
+	var c *collector
+
+	p, _ := s.Plan.(*plan.Analyze)
+	defer p.Relation.Close()
+	if len(s.PreScopes) > 0 {
+		ps := s.PreScopes[0]
+		c = ps.Instructions[len(ps.Instructions)-1].Arg.(*output.Argument).Data.(*collector)
+		defer c.free()
+		if err = ps.run(e); err != nil {
+			return err
+		}
+	} else {
+		if c, err = newCollector(p.Attrs, s.Proc.Mp); err != nil {
+			return err
+		}
+		defer c.free()
+	}
+	stats := c.statistics()
+	for _, def := range p.Relation.TableDefs() {
+		if old, ok := def.(*engine.StatisticsDef); ok {
+			for _, col := range old.Columns {
+				if stats.Column(col.Name) == nil {
+					stats.Columns = append(stats.Columns, col)
+				}
+			}
+		}
+	}
+	return p.Relation.AddTableDef(ts, stats)
+}
+
+func newCollector(attrs []engine.Attribute, m *mheap.Mheap) (*collector, error) {
+	c := &collector{m: m}
+	for _, attr := range attrs {
+		cc := &columnCollector{attr: attr}
+		switch attr.Type.Oid {
+		case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+			types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+			types.T_float32, types.T_float64, types.T_date, types.T_datetime,
+			types.T_decimal, types.T_char, types.T_varchar, types.T_json:
+			cc.ndv = approxcd.NewApproxCountDistinct(attr.Type)
+			if err := cc.ndv.Grow(m); err != nil {
+				c.free()
+				return nil, err
+			}
+		}
+		c.cols = append(c.cols, cc)
+	}
+	return c, nil
+}
+
+func (c *collector) free() {
+	for _, cc := range c.cols {
+		if cc.ndv != nil {
+			cc.ndv.Free(c.m)
+			cc.ndv = nil
+		}
+	}
+}
+
+func (c *collector) fill(bat *batch.Batch) error {
+	for i, z := range bat.Zs {
+		if z <= 0 {
+			continue
+		}
+		row := int64(i)
+		if len(bat.Sels) > 0 {
+			row = bat.Sels[i]
+		}
+		c.rows += z
+		for j, cc := range c.cols {
+			cc.fill(bat.Vecs[j], row, z)
+		}
+	}
+	return nil
+}
+
+func (cc *columnCollector) fill(vec *vector.Vector, row, z int64) {
+	if nulls.Contains(vec.Nsp, uint64(row)) {
+		cc.nulls += z
+		return
+	}
+	if cc.ndv != nil {
+		cc.ndv.Fill(0, row, z, vec)
+	}
+	v, ok := numericValue(vec, row)
+	if !ok {
+		return
+	}
+	if !cc.hasRange {
+		cc.hasRange = true
+		cc.min, cc.max = v, v
+	} else if v < cc.min {
+		cc.min = v
+	} else if v > cc.max {
+		cc.max = v
+	}
+	cc.seen++
+	if len(cc.sample) < sampleSize {
+		cc.sample = append(cc.sample, v)
+	} else if k := rand.Int63n(cc.seen); k < sampleSize {
+		cc.sample[k] = v
+	}
+}
+
+func (c *collector) statistics() *engine.StatisticsDef {
+	stats := &engine.StatisticsDef{Rows: c.rows}
+	for _, cc := range c.cols {
+		col := engine.ColumnStatistics{
+			Name:     cc.attr.Name,
+			Ndv:      -1,
+			Nulls:    cc.nulls,
+			HasRange: cc.hasRange,
+			Min:      cc.min,
+			Max:      cc.max,
+		}
+		if cc.ndv != nil {
+			col.Ndv = int64(cc.ndv.Eval(nil).Col.([]uint64)[0])
+		}
+		if cc.hasRange {
+			col.Histogram = buildHistogram(cc.sample, cc.min, cc.max)
+		}
+		stats.Columns = append(stats.Columns, col)
+	}
+	return stats
+}
+
+// buildHistogram returns the bounds of equi-depth histogram of the sample,
+// the first and last bounds are the min and max of all the values.
+func buildHistogram(sample []float64, min, max float64) []float64 {
+	n := histogramBuckets
+	if len(sample) < n {
+		n = len(sample)
+	}
+	if n < 2 {
+		return nil
+	}
+	sort.Float64s(sample)
+	bs := make([]float64, n+1)
+	for i := range bs {
+		bs[i] = sample[i*(len(sample)-1)/n]
+	}
+	bs[0], bs[n] = min, max
+	return bs
+}
+
+// numericValue returns the value of row as float64, false if the attribute is not numeric.
+func numericValue(vec *vector.Vector, row int64) (float64, bool) {
+	switch vs := vec.Col.(type) {
+	case []int8:
+		return float64(vs[row]), true
+	case []int16:
+		return float64(vs[row]), true
+	case []int32:
+		return float64(vs[row]), true
+	case []int64:
+		return float64(vs[row]), true
+	case []uint8:
+		return float64(vs[row]), true
+	case []uint16:
+		return float64(vs[row]), true
+	case []uint32:
+		return float64(vs[row]), true
+	case []uint64:
+		return float64(vs[row]), true
+	case []float32:
+		return float64(vs[row]), true
+	case []float64:
+		return vs[row], true
+	case []types.Date:
+		return float64(vs[row]), true
+	case []types.Datetime:
+		return float64(vs[row]), true
+	}
+	return 0, false
+}
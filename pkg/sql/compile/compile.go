@@ -20,6 +20,8 @@ import (
 	_ "github.com/matrixorigin/matrixone/pkg/builtin/unary"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -29,21 +31,37 @@ func InitAddress(addr string) {
 }
 
 func New(db string, sql string, uid string,
-	e engine.Engine, proc *process.Process) *compile {
+	e engine.Engine, proc *process.Process, pc *privilege.Checker) *compile {
 	return &compile{
 		e:    e,
 		db:   db,
 		uid:  uid,
 		sql:  sql,
 		proc: proc,
+		pc:   pc,
+		dt:   dialect.MYSQL,
 	}
 }
 
+// SetDialect sets the dialect of the sql text, it is mysql by default.
+func (c *compile) SetDialect(dt dialect.DialectType) {
+	c.dt = dt
+}
+
+// SetStatements sets the parsed statements of the sql text, the sql text is
+// not parsed again by Build.
+func (c *compile) SetStatements(stmts []tree.Statement) {
+	c.stmts = stmts
+}
+
 // Build generates query execution list based on the result of sql parser.
 func (c *compile) Build() ([]*Exec, error) {
-	stmts, err := parsers.Parse(dialect.MYSQL, c.sql)
-	if err != nil {
-		return nil, err
+	stmts := c.stmts
+	if stmts == nil {
+		var err error
+		if stmts, err = parsers.Parse(c.dt, c.sql); err != nil {
+			return nil, err
+		}
 	}
 	es := make([]*Exec, len(stmts))
 	for i := range stmts {
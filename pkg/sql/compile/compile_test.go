@@ -64,6 +64,10 @@ var querys = []string{
 	"select userID,MAX(score) from t1 where userID not between 2 and 3 group by userID order by userID desc;",
 	"select sum(score) as sum from t1 where spID=6 group by score order by sum desc;",
 	"select userID,MAX(score) max_score from t1 where userID <2 || userID > 3 group by userID order by max_score;",
+	"explain select sum(R.price) from R join S on R.uid = S.uid",
+	"explain analyze SELECT userID, MIN(score) FROM t1 GROUP BY userID ORDER BY userID asc;",
+	"analyze table R(uid, price);",
+	"select * from R join S on R.uid = S.uid where R.price < 10",
 }
 
 func TestCompile(t *testing.T) {
@@ -84,7 +88,7 @@ func sqlOutput(_ interface{}, bat *batch.Batch) error {
 }
 
 func processQuery(query string, e engine.Engine, proc *process.Process) {
-	c := New("test", query, "", e, proc)
+	c := New("test", query, "", e, proc, nil)
 	es, err := c.Build()
 	if err != nil {
 		log.Fatal(err)
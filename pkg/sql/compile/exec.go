@@ -33,8 +33,6 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/oplus"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
-	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
-	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -47,25 +45,10 @@ func (e *Exec) Compile(u interface{}, fill func(interface{}, *batch.Batch) error
 		}
 	}()
 
-	// do semantic analysis and build plan for sql
-	// do ast rewrite
-	e.stmt = rewrite.AstRewrite(e.stmt)
-
-	pn, err := plan.New(e.c.db, e.c.sql, e.c.e).BuildStatement(e.stmt)
+	pn, err := e.buildPlan()
 	if err != nil {
 		return err
 	}
-	{
-		attrs := pn.ResultColumns()
-		cols := make([]*Col, len(attrs))
-		for i, attr := range attrs {
-			cols[i] = &Col{
-				Name: attr.Name,
-				Typ:  attr.Type.Oid,
-			}
-		}
-		e.resultCols = cols
-	}
 	e.u = u
 	e.e = e.c.e
 	e.fill = fill
@@ -79,6 +62,46 @@ func (e *Exec) Compile(u interface{}, fill func(interface{}, *batch.Batch) error
 	return nil
 }
 
+// Bind does the semantic analysis of the statement without compiling it,
+// it returns the columns of the result.
+func (e *Exec) Bind() (cols []*Col, err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			err = moerr.NewPanicError(e)
+		}
+	}()
+
+	if _, err = e.buildPlan(); err != nil {
+		return nil, err
+	}
+	return e.resultCols, nil
+}
+
+// buildPlan rewrites the ast and builds the plan of it, the columns of result are set.
+func (e *Exec) buildPlan() (plan.Plan, error) {
+	// do semantic analysis and build plan for sql
+	// do ast rewrite
+	e.stmt = rewrite.AstRewrite(e.stmt)
+
+	b := plan.New(e.c.db, e.c.sql, e.c.e, e.c.pc)
+	b.SetParams(e.params)
+	b.SetVariables(e.vars)
+	pn, err := b.BuildStatement(e.stmt)
+	if err != nil {
+		return nil, err
+	}
+	attrs := pn.ResultColumns()
+	cols := make([]*Col, len(attrs))
+	for i, attr := range attrs {
+		cols[i] = &Col{
+			Name: attr.Name,
+			Typ:  attr.Type.Oid,
+		}
+	}
+	e.resultCols = cols
+	return pn, nil
+}
+
 // Run is an important function of the compute-layer, it executes a single sql according to its scope
 func (e *Exec) Run(ts uint64) error {
 	if e.scope == nil {
@@ -113,6 +136,10 @@ func (e *Exec) Run(ts uint64) error {
 		return e.scope.DropTable(ts)
 	case DropIndex:
 		return e.scope.DropIndex(ts)
+	case AlterTable:
+		return e.scope.AlterTable(ts)
+	case Analyze:
+		return e.scope.Analyze(ts, e.c.e)
 	case ShowDatabases:
 		return e.scope.ShowDatabases(e.u, e.fill)
 	case ShowTables:
@@ -137,6 +164,18 @@ func (e *Exec) Run(ts uint64) error {
 		}
 		e.setAffectedRows(affectedRows)
 		return nil
+	case CreateUser:
+		return e.scope.CreateUser()
+	case DropUser:
+		return e.scope.DropUser()
+	case AlterUser:
+		return e.scope.AlterUser()
+	case Grant:
+		return e.scope.Grant()
+	case Revoke:
+		return e.scope.Revoke()
+	case Explain:
+		return e.scope.Explain(e.c.e, e.u, e.fill)
 	}
 	return nil
 }
@@ -191,6 +230,12 @@ func (e *Exec) compileScope(pn plan.Plan) (*Scope, error) {
 			Plan:  pn,
 			Proc:  e.c.proc,
 		}, nil
+	case *plan.AlterTable:
+		return &Scope{
+			Magic: AlterTable,
+			Plan:  pn,
+			Proc:  e.c.proc,
+		}, nil
 	case *plan.ShowDatabases:
 		return &Scope{
 			Magic: ShowDatabases,
@@ -221,10 +266,44 @@ func (e *Exec) compileScope(pn plan.Plan) (*Scope, error) {
 			Plan:  pn,
 			Proc:  e.c.proc,
 		}, nil
+	case *plan.Explain:
+		return e.compileExplain(qry)
+	case *plan.Analyze:
+		return e.compileAnalyze(qry)
 	case *plan.Delete:
 		return e.compileDelete(qry.Qry)
 	case *plan.Update:
-		return e.compileUpdate(qry.Qry)
+		return e.compileUpdate(qry)
+	case *plan.CreateUser:
+		return &Scope{
+			Magic: CreateUser,
+			Plan:  pn,
+			Proc:  e.c.proc,
+		}, nil
+	case *plan.DropUser:
+		return &Scope{
+			Magic: DropUser,
+			Plan:  pn,
+			Proc:  e.c.proc,
+		}, nil
+	case *plan.AlterUser:
+		return &Scope{
+			Magic: AlterUser,
+			Plan:  pn,
+			Proc:  e.c.proc,
+		}, nil
+	case *plan.Grant:
+		return &Scope{
+			Magic: Grant,
+			Plan:  pn,
+			Proc:  e.c.proc,
+		}, nil
+	case *plan.Revoke:
+		return &Scope{
+			Magic: Revoke,
+			Plan:  pn,
+			Proc:  e.c.proc,
+		}, nil
 	}
 	return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("query '%s' not support now", pn))
 }
@@ -238,6 +317,17 @@ func (e *Exec) SetSchema(db string) error {
 	return nil
 }
 
+// SetParams binds the values to the placeholders of statement, it must be called before Compile.
+func (e *Exec) SetParams(params []tree.Expr) {
+	e.params = params
+}
+
+// SetVariables sets the function which returns the value of system or user variable,
+// it must be called before Compile.
+func (e *Exec) SetVariables(vars func(*tree.VarExpr) (tree.Expr, error)) {
+	e.vars = vars
+}
+
 func (e *Exec) Columns() []*Col {
 	return e.resultCols
 }
@@ -306,11 +396,13 @@ func (e *Exec) compileDelete(qry *plan.Query) (*Scope, error) {
 			AffectedRows: 0,
 		},
 	})
+	s.Magic = Delete
 	e.scope = s
 	return s, nil
 }
 
-func (e *Exec) compileUpdate(qry *plan.Query) (*Scope, error) {
+func (e *Exec) compileUpdate(pn *plan.Update) (*Scope, error) {
+	qry := pn.Qry
 	if e.checkPlanScope(qry.Scope) != BQ {
 		return nil, errors.New(errno.FeatureNotSupported, "Only single table update is supported")
 	}
@@ -325,17 +417,16 @@ func (e *Exec) compileUpdate(qry *plan.Query) (*Scope, error) {
 	if s == nil {
 		return s, nil
 	}
-	attrs := make([]string, len(e.resultCols))
-	for i, col := range e.resultCols {
-		attrs[i] = col.Name
-	}
 	s.Instructions = append(s.Instructions, vm.Instruction{
 		Op: vm.UpdateTag,
 		Arg: &updateTag.Argument{
 			Relation:     rel,
+			UpdateAttrs:  pn.UpdateAttrs,
+			UpdateList:   pn.UpdateList,
 			AffectedRows: 0,
 		},
 	})
+	s.Magic = Update
 	e.scope = s
 	return s, nil
 }
@@ -360,10 +451,8 @@ func (e *Exec) compilePlanScope(s *plan.Scope) (*Scope, error) {
 			Arg: &merge.Argument{},
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(e.c.proc)
 		rs.Proc.Cancel = cancel
-		rs.Proc.Id = e.c.proc.Id
-		rs.Proc.Lim = e.c.proc.Lim
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -416,6 +505,8 @@ func (e *Exec) checkPlanScope(s *plan.Scope) int {
 		return e.checkPlanScope(s.Children[0])
 	case *plan.Dedup:
 		return e.checkPlanScope(s.Children[0])
+	case *plan.Window:
+		return e.checkPlanScope(s.Children[0])
 	case *plan.Limit:
 		return e.checkPlanScope(s.Children[0])
 	case *plan.Offset:
@@ -461,6 +552,8 @@ func (e *Exec) getRelationFromPlanScope(s *plan.Scope) engine.Relation {
 		return e.getRelationFromPlanScope(s.Children[0])
 	case *plan.Dedup:
 		return e.getRelationFromPlanScope(s.Children[0])
+	case *plan.Window:
+		return e.getRelationFromPlanScope(s.Children[0])
 	case *plan.Limit:
 		return e.getRelationFromPlanScope(s.Children[0])
 	case *plan.Offset:
@@ -539,10 +632,8 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 			Arg: constructMergeOrder(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(e.c.proc)
 		rs.Proc.Cancel = cancel
-		rs.Proc.Id = e.c.proc.Id
-		rs.Proc.Lim = e.c.proc.Lim
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -591,10 +682,8 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 			Arg: constructMergeDedup(),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(e.c.proc)
 		rs.Proc.Cancel = cancel
-		rs.Proc.Id = e.c.proc.Id
-		rs.Proc.Lim = e.c.proc.Lim
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -658,10 +747,8 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 			Arg: constructMergeLimit(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(e.c.proc)
 		rs.Proc.Cancel = cancel
-		rs.Proc.Id = e.c.proc.Id
-		rs.Proc.Lim = e.c.proc.Lim
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -704,10 +791,55 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 			Arg: constructMergeOffset(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(e.c.proc)
+		rs.Proc.Cancel = cancel
+		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
+		{
+			for i := 0; i < len(ss); i++ {
+				rs.Proc.Reg.MergeReceivers[i] = &process.WaitRegister{
+					Ctx: ctx,
+					Ch:  make(chan *batch.Batch, 1),
+				}
+			}
+		}
+		for i := range ss {
+			ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
+				Op: vm.Connector,
+				Arg: &connector.Argument{
+					Mmu: rs.Proc.Mp.Gm,
+					Reg: rs.Proc.Reg.MergeReceivers[i],
+				},
+			})
+		}
+		return []*Scope{rs}, nil
+	case *plan.Window:
+		ss, err := e.compileQ(ps.Children[0])
+		if err != nil {
+			return nil, err
+		}
+		if len(ss) == 0 {
+			return nil, nil
+		}
+		if len(ss) == 1 && ss[0].Magic == Merge {
+			ss[0].Instructions = append(ss[0].Instructions, vm.Instruction{
+				Op:  vm.Window,
+				Arg: constructWindow(op),
+			})
+			return ss, nil
+		}
+		rs := &Scope{Magic: Merge}
+		rs.PreScopes = ss
+		rs.Instructions = append(rs.Instructions, vm.Instruction{
+			Op:  vm.Merge,
+			Arg: &merge.Argument{},
+		})
+		rs.Instructions = append(rs.Instructions, vm.Instruction{
+			Op:  vm.Window,
+			Arg: constructWindow(op),
+		})
+		ctx, cancel := context.WithCancel(context.Background())
+		rs.Proc = process.NewFromProc(e.c.proc)
 		rs.Proc.Cancel = cancel
-		rs.Proc.Id = e.c.proc.Id
-		rs.Proc.Lim = e.c.proc.Lim
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -781,9 +913,7 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 				NodeInfo:   nodes[i],
 				Magic:      Remote,
 			}
-			ss[i].Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
-			ss[i].Proc.Id = e.c.proc.Id
-			ss[i].Proc.Lim = e.c.proc.Lim
+			ss[i].Proc = process.NewFromProc(e.c.proc)
 			ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 				Op:  vm.Transform,
 				Arg: constructBareTransform(op),
@@ -806,10 +936,8 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 			Arg: constructBareTransformFromDerived(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(e.c.proc)
 		rs.Proc.Cancel = cancel
-		rs.Proc.Id = e.c.proc.Id
-		rs.Proc.Lim = e.c.proc.Lim
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
 		rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
 			Ctx: ctx,
@@ -823,6 +951,56 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 			},
 		})
 		return []*Scope{rs}, nil
+	case *plan.SetOperation:
+		ss := make([]*Scope, len(ps.Children))
+		for i := range ps.Children {
+			s, err := e.compilePlanScope(ps.Children[i])
+			if err != nil {
+				return nil, err
+			}
+			ss[i] = s
+		}
+		if op.Type == plan.UNION && op.All {
+			rs := make([]*Scope, 0, len(ss))
+			for i := range ss {
+				if ss[i] != nil {
+					rs = append(rs, ss[i])
+				}
+			}
+			return rs, nil
+		}
+		rs := &Scope{Magic: Merge}
+		rs.Instructions = append(rs.Instructions, vm.Instruction{
+			Op:  vm.SetOp,
+			Arg: constructSetOp(op),
+		})
+		ctx, cancel := context.WithCancel(context.Background())
+		rs.Proc = process.NewFromProc(e.c.proc)
+		rs.Proc.Cancel = cancel
+		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
+		{
+			for i := 0; i < len(ss); i++ {
+				rs.Proc.Reg.MergeReceivers[i] = &process.WaitRegister{
+					Ctx: ctx,
+					Ch:  make(chan *batch.Batch, 1),
+				}
+			}
+		}
+		for i := range ss {
+			if ss[i] == nil { // empty operand
+				rs.Proc.Reg.MergeReceivers[i].Ch <- nil
+				continue
+			}
+			rs.PreScopes = append(rs.PreScopes, ss[i])
+			ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
+				Op: vm.Connector,
+				Arg: &connector.Argument{
+					Mmu: rs.Proc.Mp.Gm,
+					Reg: rs.Proc.Reg.MergeReceivers[i],
+				},
+			})
+		}
+		return []*Scope{rs}, nil
 	case *plan.Rename:
 		ss, err := e.compileQ(ps.Children[0])
 		if err != nil {
@@ -861,10 +1039,8 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 			Arg: constructResultProjection(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(e.c.proc)
 		rs.Proc.Cancel = cancel
-		rs.Proc.Id = e.c.proc.Id
-		rs.Proc.Lim = e.c.proc.Lim
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -943,6 +1119,19 @@ func (e *Exec) compileAQ(ps *plan.Scope) (*Scope, error) {
 			Arg: constructOffset(op),
 		})
 		return s, nil
+	case *plan.Window:
+		s, err := e.compileAQ(ps.Children[0])
+		if err != nil {
+			return nil, err
+		}
+		if s == nil {
+			return nil, nil
+		}
+		s.Instructions = append(s.Instructions, vm.Instruction{
+			Op:  vm.Window,
+			Arg: constructWindow(op),
+		})
+		return s, nil
 	case *plan.Restrict:
 		s, err := e.compileAQ(ps.Children[0])
 		if err != nil {
@@ -984,10 +1173,8 @@ func (e *Exec) compileAQ(ps *plan.Scope) (*Scope, error) {
 			Arg: constructUntransform(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(e.c.proc)
 		rs.Proc.Cancel = cancel
-		rs.Proc.Id = e.c.proc.Id
-		rs.Proc.Lim = e.c.proc.Lim
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
 		rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
 			Ctx: ctx,
@@ -1031,9 +1218,7 @@ func (e *Exec) compileAQ(ps *plan.Scope) (*Scope, error) {
 				NodeInfo:   nodes[i],
 				Magic:      Remote,
 			}
-			ss[i].Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
-			ss[i].Proc.Id = e.c.proc.Id
-			ss[i].Proc.Lim = e.c.proc.Lim
+			ss[i].Proc = process.NewFromProc(e.c.proc)
 			ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 				Op:  vm.Transform,
 				Arg: constructTransform(op),
@@ -1047,10 +1232,8 @@ func (e *Exec) compileAQ(ps *plan.Scope) (*Scope, error) {
 			Arg: &oplus.Argument{Typ: arg.Typ},
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(e.c.proc)
 		rs.Proc.Cancel = cancel
-		rs.Proc.Id = e.c.proc.Id
-		rs.Proc.Lim = e.c.proc.Lim
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -1086,10 +1269,8 @@ func (e *Exec) compileAQ(ps *plan.Scope) (*Scope, error) {
 			Arg: constructTransformFromDerived(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(e.c.proc)
 		rs.Proc.Cancel = cancel
-		rs.Proc.Id = e.c.proc.Id
-		rs.Proc.Lim = e.c.proc.Lim
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
 		rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
 			Ctx: ctx,
@@ -1142,6 +1323,7 @@ func (e *Exec) compileCQ(ps *plan.Scope) (*Scope, error) {
 		switch op.Type {
 		case plan.INNER:
 		case plan.NATURAL:
+		case plan.LEFT, plan.RIGHT, plan.FULL:
 		default:
 			return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("Unsupport join type '%v'", op.Type))
 		}
@@ -1159,11 +1341,14 @@ func (e *Exec) compileCQ(ps *plan.Scope) (*Scope, error) {
 		if err != nil {
 			return nil, err
 		}
+		if op.Type == plan.FULL && len(ss) > 1 {
+			return nil, errors.New(errno.FeatureNotSupported, "full join of a table on multiple nodes is not supported now")
+		}
 		for i := range ss {
 			ss[i].PreScopes = append(ss[i].PreScopes, children...)
 			ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 				Op:  vm.Join,
-				Arg: constructJoin(op),
+				Arg: constructJoin(op, ps.Children),
 			})
 		}
 		rs := &Scope{Magic: Merge}
@@ -1173,10 +1358,8 @@ func (e *Exec) compileCQ(ps *plan.Scope) (*Scope, error) {
 			Arg: &merge.Argument{},
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(e.c.proc)
 		rs.Proc.Cancel = cancel
-		rs.Proc.Id = e.c.proc.Id
-		rs.Proc.Lim = e.c.proc.Lim
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -1216,10 +1399,8 @@ func (e *Exec) compileCQ(ps *plan.Scope) (*Scope, error) {
 			Arg: constructMergeOrder(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(e.c.proc)
 		rs.Proc.Cancel = cancel
-		rs.Proc.Id = e.c.proc.Id
-		rs.Proc.Lim = e.c.proc.Lim
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
 		{
 			rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
@@ -1255,10 +1436,8 @@ func (e *Exec) compileCQ(ps *plan.Scope) (*Scope, error) {
 			Arg: constructMergeDedup(),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(e.c.proc)
 		rs.Proc.Cancel = cancel
-		rs.Proc.Id = e.c.proc.Id
-		rs.Proc.Lim = e.c.proc.Lim
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
 		{
 			rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
@@ -1294,10 +1473,8 @@ func (e *Exec) compileCQ(ps *plan.Scope) (*Scope, error) {
 			Arg: constructMergeLimit(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(e.c.proc)
 		rs.Proc.Cancel = cancel
-		rs.Proc.Id = e.c.proc.Id
-		rs.Proc.Lim = e.c.proc.Lim
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
 		{
 			rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
@@ -1333,10 +1510,8 @@ func (e *Exec) compileCQ(ps *plan.Scope) (*Scope, error) {
 			Arg: constructMergeOffset(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(e.c.proc)
 		rs.Proc.Cancel = cancel
-		rs.Proc.Id = e.c.proc.Id
-		rs.Proc.Lim = e.c.proc.Lim
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
 		{
 			rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
@@ -1352,6 +1527,19 @@ func (e *Exec) compileCQ(ps *plan.Scope) (*Scope, error) {
 			},
 		})
 		return rs, nil
+	case *plan.Window:
+		s, err := e.compileCQ(ps.Children[0])
+		if err != nil {
+			return nil, err
+		}
+		if s == nil {
+			return nil, nil
+		}
+		s.Instructions = append(s.Instructions, vm.Instruction{
+			Op:  vm.Window,
+			Arg: constructWindow(op),
+		})
+		return s, nil
 	case *plan.Restrict:
 		s, err := e.compileCQ(ps.Children[0])
 		if err != nil {
@@ -1463,6 +1651,19 @@ func (e *Exec) compileCAQ(ps *plan.Scope) (*Scope, error) {
 			Arg: constructOffset(op),
 		})
 		return s, nil
+	case *plan.Window:
+		s, err := e.compileCAQ(ps.Children[0])
+		if err != nil {
+			return nil, err
+		}
+		if s == nil {
+			return nil, nil
+		}
+		s.Instructions = append(s.Instructions, vm.Instruction{
+			Op:  vm.Window,
+			Arg: constructWindow(op),
+		})
+		return s, nil
 	case *plan.Restrict:
 		s, err := e.compileCAQ(ps.Children[0])
 		if err != nil {
@@ -1501,10 +1702,8 @@ func (e *Exec) compileCAQ(ps *plan.Scope) (*Scope, error) {
 			Arg: constructCAQUntransform(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(e.c.proc)
 		rs.Proc.Cancel = cancel
-		rs.Proc.Id = e.c.proc.Id
-		rs.Proc.Lim = e.c.proc.Lim
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -1565,6 +1764,9 @@ func (e *Exec) compileJoin(ps *plan.Scope) ([]*Scope, error) {
 	switch op.Type {
 	case plan.INNER:
 	case plan.NATURAL:
+	case plan.LEFT, plan.RIGHT:
+	case plan.FULL:
+		return nil, errors.New(errno.FeatureNotSupported, "full join with aggregation is not supported now")
 	default:
 		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("Unsupport join type '%v'", op.Type))
 	}
@@ -1586,7 +1788,7 @@ func (e *Exec) compileJoin(ps *plan.Scope) ([]*Scope, error) {
 		ss[i].PreScopes = append(ss[i].PreScopes, children...)
 		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 			Op:  vm.Times,
-			Arg: constructTimes(op),
+			Arg: constructTimes(op, ps.Children),
 		})
 	}
 	return ss, nil
@@ -1624,9 +1826,7 @@ func (e *Exec) compileFact(ps *plan.Scope) ([]*Scope, error) {
 				NodeInfo:   nodes[i],
 				Magic:      Remote,
 			}
-			ss[i].Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
-			ss[i].Proc.Id = e.c.proc.Id
-			ss[i].Proc.Lim = e.c.proc.Lim
+			ss[i].Proc = process.NewFromProc(e.c.proc)
 			ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 				Op:  vm.Transform,
 				Arg: constructBareTransform(op),
@@ -1638,40 +1838,21 @@ func (e *Exec) compileFact(ps *plan.Scope) ([]*Scope, error) {
 		if err != nil {
 			return nil, err
 		}
-		rs := &Scope{
-			Magic: Remote,
-			NodeInfo: engine.Node{
-				Id:   Address,
-				Addr: Address,
-			},
-		}
-		rs.PreScopes = []*Scope{child}
-		rs.Instructions = append(rs.Instructions, vm.Instruction{
-			Op:  vm.Merge,
-			Arg: &merge.Argument{},
-		})
+		rs := e.newFactScope(child)
 		rs.Instructions = append(rs.Instructions, vm.Instruction{
 			Op:  vm.Transform,
 			Arg: constructBareTransformFromDerived(op),
 		})
-		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
-		rs.Proc.Cancel = cancel
-		rs.Proc.Id = e.c.proc.Id
-		rs.Proc.Lim = e.c.proc.Lim
-		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
-		rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
-			Ctx: ctx,
-			Ch:  make(chan *batch.Batch, 1),
-		}
-		child.Instructions = append(child.Instructions, vm.Instruction{
-			Op: vm.Connector,
-			Arg: &connector.Argument{
-				Mmu: rs.Proc.Mp.Gm,
-				Reg: rs.Proc.Reg.MergeReceivers[0],
-			},
-		})
 		return []*Scope{rs}, nil
+	case *plan.Join: // nested outer join
+		child, err := e.compileCQ(ps)
+		if err != nil {
+			return nil, err
+		}
+		if child == nil {
+			return nil, nil
+		}
+		return []*Scope{e.newFactScope(child)}, nil
 	case *plan.Rename:
 		ss, err := e.compileFact(ps.Children[0])
 		if err != nil {
@@ -1712,6 +1893,39 @@ func (e *Exec) compileFact(ps *plan.Scope) ([]*Scope, error) {
 	return nil, nil
 }
 
+// newFactScope returns the scope which merges the result of child, it is the
+// fact of a join whose fact is not a table.
+func (e *Exec) newFactScope(child *Scope) *Scope {
+	rs := &Scope{
+		Magic: Remote,
+		NodeInfo: engine.Node{
+			Id:   Address,
+			Addr: Address,
+		},
+	}
+	rs.PreScopes = []*Scope{child}
+	rs.Instructions = append(rs.Instructions, vm.Instruction{
+		Op:  vm.Merge,
+		Arg: &merge.Argument{},
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	rs.Proc = process.NewFromProc(e.c.proc)
+	rs.Proc.Cancel = cancel
+	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
+	rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
+		Ctx: ctx,
+		Ch:  make(chan *batch.Batch, 1),
+	}
+	child.Instructions = append(child.Instructions, vm.Instruction{
+		Op: vm.Connector,
+		Arg: &connector.Argument{
+			Mmu: rs.Proc.Mp.Gm,
+			Reg: rs.Proc.Reg.MergeReceivers[0],
+		},
+	})
+	return rs
+}
+
 func (e *Exec) compileCAQFact(ps *plan.Scope) ([]*Scope, error) {
 	switch op := ps.Op.(type) {
 	case *plan.Relation:
@@ -1744,9 +1958,7 @@ func (e *Exec) compileCAQFact(ps *plan.Scope) ([]*Scope, error) {
 				NodeInfo:   nodes[i],
 				Magic:      Remote,
 			}
-			ss[i].Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
-			ss[i].Proc.Id = e.c.proc.Id
-			ss[i].Proc.Lim = e.c.proc.Lim
+			ss[i].Proc = process.NewFromProc(e.c.proc)
 			ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 				Op:  vm.Transform,
 				Arg: constructCAQTransform(op),
@@ -1775,10 +1987,8 @@ func (e *Exec) compileCAQFact(ps *plan.Scope) ([]*Scope, error) {
 			Arg: constructCAQTransformFromDerived(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(e.c.proc)
 		rs.Proc.Cancel = cancel
-		rs.Proc.Id = e.c.proc.Id
-		rs.Proc.Lim = e.c.proc.Lim
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
 		rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
 			Ctx: ctx,
@@ -1792,6 +2002,8 @@ func (e *Exec) compileCAQFact(ps *plan.Scope) ([]*Scope, error) {
 			},
 		})
 		return []*Scope{rs}, nil
+	case *plan.Join:
+		return nil, errors.New(errno.FeatureNotSupported, "aggregation over nested joins is not supported now")
 	case *plan.Rename:
 		ss, err := e.compileCAQFact(ps.Children[0])
 		if err != nil {
@@ -0,0 +1,160 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/output"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+var magicNames = map[int]string{
+	Merge:    "Merge",
+	Normal:   "Normal",
+	Remote:   "Remote",
+	Parallel: "Parallel",
+}
+
+// explainLine is a line of the plan, the statistics are attached to
+// the line of instruction for explain analyze.
+type explainLine struct {
+	text string
+	anal *process.AnalyzeInfo
+}
+
+// compileExplain builds the scope of query to be explained, the results of
+// query are discarded if it is run by explain analyze.
+func (e *Exec) compileExplain(pn *plan.Explain) (*Scope, error) {
+	s, err := e.compilePlanScope(pn.Qry.Scope)
+	if err != nil {
+		return nil, err
+	}
+	rs := &Scope{
+		Magic: Explain,
+		Plan:  pn,
+		Proc:  e.c.proc,
+	}
+	if s != nil {
+		if pn.Analyze {
+			s.Instructions = append(s.Instructions, vm.Instruction{
+				Op: vm.Output,
+				Arg: &output.Argument{
+					Func: func(_ interface{}, _ *batch.Batch) error { return nil },
+				},
+			})
+			analyzeScope(s)
+		}
+		rs.PreScopes = []*Scope{s}
+	}
+	return rs, nil
+}
+
+// Explain fills batch with the plan of scope, each line is a row.
+func (s *Scope) Explain(e engine.Engine, u interface{}, fill func(interface{}, *batch.Batch) error) error {
+	p, _ := s.Plan.(*plan.Explain)
+	// the scopes may be rewritten when they are running, so the plan is
+	// rendered before running.
+	lines := explainScopes(nil, "", s.PreScopes)
+	if p.Analyze && len(s.PreScopes) > 0 {
+		t := time.Now()
+		if err := s.PreScopes[0].run(e); err != nil {
+			return err
+		}
+		lines = append(lines, explainLine{text: fmt.Sprintf("Execution Time: %v", time.Since(t))})
+	}
+	vs := make([][]byte, len(lines))
+	for i, line := range lines {
+		if p.Analyze && line.anal != nil {
+			line.text += fmt.Sprintf(" (rows: %d, batches: %d, time: %v, memory: %d bytes)",
+				line.anal.Rows, line.anal.Batches, time.Duration(line.anal.TimeConsumed), line.anal.MemorySize)
+		}
+		vs[i] = []byte(line.text)
+	}
+	attrs := p.ResultColumns()
+	bat := batch.New(true, []string{attrs[0].Name})
+	vec := vector.New(attrs[0].Type)
+	if err := vector.Append(vec, vs); err != nil {
+		return err
+	}
+	bat.Vecs[0] = vec
+	bat.InitZsOne(len(vs))
+	return fill(u, bat)
+}
+
+// run runs the scope according to its magic.
+func (s *Scope) run(e engine.Engine) error {
+	switch s.Magic {
+	case Normal:
+		return s.Run(e)
+	case Merge:
+		return s.MergeRun(e)
+	case Remote:
+		return s.RemoteRun(e)
+	case Parallel:
+		return s.ParallelRun(e)
+	}
+	return nil
+}
+
+// analyzeScope attaches the statistics to all the instructions of s and its pre-scopes.
+func analyzeScope(s *Scope) {
+	for i := range s.Instructions {
+		s.Instructions[i].Anal = new(process.AnalyzeInfo)
+	}
+	for _, ps := range s.PreScopes {
+		analyzeScope(ps)
+	}
+}
+
+// explainScopes appends the lines of scopes to lines, the pre-scopes are indented.
+func explainScopes(lines []explainLine, prefix string, ss []*Scope) []explainLine {
+	for _, s := range ss {
+		text := fmt.Sprintf("%sScope (Magic: %s", prefix, magicNames[s.Magic])
+		if s.Proc != nil && len(s.Proc.Reg.MergeReceivers) > 0 {
+			text += fmt.Sprintf(", Receivers: %d", len(s.Proc.Reg.MergeReceivers))
+		}
+		lines = append(lines, explainLine{text: text + ")"})
+		if src := s.DataSource; src != nil {
+			attrs := append([]string{}, src.Attributes...)
+			sort.Strings(attrs)
+			lines = append(lines, explainLine{text: fmt.Sprintf("%s  Source: %s.%s [%s]", prefix,
+				src.SchemaName, src.RelationName, strings.Join(attrs, ", "))})
+		}
+		if len(s.NodeInfo.Addr) > 0 {
+			lines = append(lines, explainLine{text: fmt.Sprintf("%s  Node: %s (%s)", prefix, s.NodeInfo.Id, s.NodeInfo.Addr)})
+		}
+		for i, in := range s.Instructions {
+			var buf bytes.Buffer
+
+			vm.String(s.Instructions[i:i+1], &buf)
+			lines = append(lines, explainLine{text: fmt.Sprintf("%s  -> %s", prefix, buf.String()), anal: in.Anal})
+		}
+		if len(s.PreScopes) > 0 {
+			lines = append(lines, explainLine{text: prefix + "  PreScopes:"})
+			lines = explainScopes(lines, prefix+"    ", s.PreScopes)
+		}
+	}
+	return lines
+}
@@ -29,7 +29,9 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/projection"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/restrict"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/setop"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/top"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/window"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/join"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/times"
@@ -41,7 +43,8 @@ import (
 
 func dupInstruction(in vm.Instruction) vm.Instruction {
 	rin := vm.Instruction{
-		Op: in.Op,
+		Op:   in.Op,
+		Anal: in.Anal,
 	}
 	switch arg := in.Arg.(type) {
 	case *top.Argument:
@@ -59,6 +62,10 @@ func dupInstruction(in vm.Instruction) vm.Instruction {
 		rin.Arg = &order.Argument{
 			Fs: arg.Fs,
 		}
+	case *window.Argument:
+		rin.Arg = &window.Argument{
+			Specs: arg.Specs,
+		}
 	case *projection.Argument:
 		rin.Arg = &projection.Argument{
 			Rs: arg.Rs,
@@ -76,15 +83,22 @@ func dupInstruction(in vm.Instruction) vm.Instruction {
 		}
 	case *join.Argument:
 		rin.Arg = &join.Argument{
+			Type:   arg.Type,
 			Vars:   arg.Vars,
 			Bats:   arg.Bats,
+			Fact:   arg.Fact,
+			Views:  arg.Views,
 			Result: arg.Result,
+			Spill:  arg.Spill,
 		}
 	case *times.Argument:
 		rin.Arg = &times.Argument{
+			Type:   arg.Type,
 			Vars:   arg.Vars,
 			Bats:   arg.Bats,
+			Views:  arg.Views,
 			Result: arg.Result,
+			Spill:  arg.Spill,
 		}
 	case *restrict.Argument:
 		rin.Arg = &restrict.Argument{
@@ -102,6 +116,49 @@ func constructMergeDedup() *mergededup.Argument {
 	return &mergededup.Argument{}
 }
 
+func constructSetOp(op *plan.SetOperation) *setop.Argument {
+	arg := &setop.Argument{All: op.All}
+	switch op.Type {
+	case plan.UNION:
+		arg.Type = setop.Union
+	case plan.INTERSECT:
+		arg.Type = setop.Intersect
+	case plan.EXCEPT:
+		arg.Type = setop.Except
+	}
+	return arg
+}
+
+func constructWindow(op *plan.Window) *window.Argument {
+	arg := &window.Argument{
+		Specs: make([]window.Spec, len(op.Specs)),
+	}
+	for i, w := range op.Specs {
+		spec := &arg.Specs[i]
+		spec.Partitions = w.Partitions
+		spec.Fs = make([]order.Field, len(w.Fs))
+		for j, f := range w.Fs {
+			spec.Fs[j].Attr = f.Attr
+			spec.Fs[j].Type = order.Direction(f.Type)
+		}
+		spec.Funcs = make([]window.Func, len(w.Funcs))
+		for j, f := range w.Funcs {
+			spec.Funcs[j] = window.Func{
+				Op:     f.Op,
+				Agg:    f.Agg,
+				Ref:    f.Ref,
+				Name:   f.Name,
+				Dflt:   f.Dflt,
+				Offset: f.Offset,
+				Alias:  f.Alias,
+				Type:   f.Type,
+				Frame:  f.Frame,
+			}
+		}
+	}
+	return arg
+}
+
 func constructLimit(op *plan.Limit) *limit.Argument {
 	return &limit.Argument{
 		Limit: uint64(op.Limit),
@@ -225,15 +282,30 @@ func constructResultProjection(op *plan.ResultProjection) *projection.Argument {
 
 func constructUntransform(op *plan.Untransform) *untransform.Argument {
 	return &untransform.Argument{
-		FreeVars: op.FreeVars,
+		FreeVars:  op.FreeVars,
+		BoundVars: constructUntransformBoundVars(op),
 	}
 }
 
 func constructCAQUntransform(op *plan.Untransform) *untransform.Argument {
 	return &untransform.Argument{
-		FreeVars: op.FreeVars,
-		Type:     untransform.CAQ,
+		FreeVars:  op.FreeVars,
+		BoundVars: constructUntransformBoundVars(op),
+		Type:      untransform.CAQ,
+	}
+}
+
+func constructUntransformBoundVars(op *plan.Untransform) []untransform.BoundVar {
+	bvars := make([]untransform.BoundVar, len(op.Aggs))
+	for i, agg := range op.Aggs {
+		bvars[i] = untransform.BoundVar{
+			Op:    agg.Op,
+			Ref:   uint64(agg.Ref),
+			Alias: agg.Alias,
+			Type:  agg.Type.ToType(),
+		}
 	}
+	return bvars
 }
 
 func constructBareTransform(op *plan.Relation) *transform.Argument {
@@ -319,7 +391,7 @@ func constructTransform(op *plan.Relation) *transform.Argument {
 
 func constructTransformFromDerived(op *plan.DerivedRelation) *transform.Argument {
 	arg := new(transform.Argument)
-	if len(arg.FreeVars) == 0 {
+	if len(op.FreeVars) == 0 {
 		arg.Typ = transform.BoundVars
 	} else {
 		arg.Typ = transform.FreeVarsAndBoundVars
@@ -355,12 +427,9 @@ func constructTransformFromDerived(op *plan.DerivedRelation) *transform.Argument
 func constructCAQTransform(op *plan.Relation) *transform.Argument {
 	arg := new(transform.Argument)
 	arg.IsMerge = true
-	if len(op.FreeVars) == 0 {
-		arg.Typ = transform.BoundVars
-	} else {
-		arg.Typ = transform.FreeVarsAndBoundVars
-		arg.FreeVars = append(arg.FreeVars, op.FreeVars...)
-	}
+	// the fact of join is not aggregated, even if it has no free vars (cross product)
+	arg.Typ = transform.FreeVarsAndBoundVars
+	arg.FreeVars = append(arg.FreeVars, op.FreeVars...)
 	for _, bvar := range op.BoundVars {
 		arg.BoundVars = append(arg.BoundVars, transformer.Transformer{
 			Ref:   bvar.Ref,
@@ -391,12 +460,9 @@ func constructCAQTransform(op *plan.Relation) *transform.Argument {
 func constructCAQTransformFromDerived(op *plan.DerivedRelation) *transform.Argument {
 	arg := new(transform.Argument)
 	arg.IsMerge = true
-	if len(arg.FreeVars) == 0 {
-		arg.Typ = transform.BoundVars
-	} else {
-		arg.Typ = transform.FreeVarsAndBoundVars
-		arg.FreeVars = append(arg.FreeVars, op.FreeVars...)
-	}
+	// the fact of join is not aggregated, even if it has no free vars (cross product)
+	arg.Typ = transform.FreeVarsAndBoundVars
+	arg.FreeVars = append(arg.FreeVars, op.FreeVars...)
 	for _, bvar := range op.BoundVars {
 		arg.BoundVars = append(arg.BoundVars, transformer.Transformer{
 			Ref:   bvar.Ref,
@@ -424,7 +490,7 @@ func constructCAQTransformFromDerived(op *plan.DerivedRelation) *transform.Argum
 	return arg
 }
 
-func constructJoin(op *plan.Join) *join.Argument {
+func constructJoin(op *plan.Join, children []*plan.Scope) *join.Argument {
 	arg := new(join.Argument)
 	arg.Vars = make([][]string, len(op.Vars)-1)
 	for i := 1; i < len(op.Vars); i++ {
@@ -434,10 +500,22 @@ func constructJoin(op *plan.Join) *join.Argument {
 		}
 	}
 	arg.Result = append(arg.Result, op.Result...)
+	switch op.Type {
+	case plan.LEFT, plan.RIGHT:
+		arg.Type = join.Outer
+	case plan.FULL:
+		arg.Type = join.Full
+	}
+	if arg.Type != join.Inner {
+		arg.Fact = constructSchema(children[0])
+		for i := 1; i < len(children); i++ {
+			arg.Views = append(arg.Views, constructSchema(children[i]))
+		}
+	}
 	return arg
 }
 
-func constructTimes(op *plan.Join) *times.Argument {
+func constructTimes(op *plan.Join, children []*plan.Scope) *times.Argument {
 	arg := new(times.Argument)
 	arg.Vars = make([][]string, len(op.Vars)-1)
 	for i := 1; i < len(op.Vars); i++ {
@@ -447,5 +525,58 @@ func constructTimes(op *plan.Join) *times.Argument {
 		}
 	}
 	arg.Result = append(arg.Result, op.Result...)
+	if op.Type == plan.LEFT || op.Type == plan.RIGHT {
+		arg.Type = join.Outer
+		for i := 1; i < len(children); i++ {
+			arg.Views = append(arg.Views, times.Schema{
+				Schema: constructSchema(children[i]),
+				Rings:  constructRings(children[i]),
+			})
+		}
+	}
 	return arg
 }
+
+// constructSchema returns the result attributes of a child of join.
+func constructSchema(s *plan.Scope) join.Schema {
+	var schema join.Schema
+
+	for _, attr := range s.Result.Attrs {
+		if _, ok := s.Result.AttrsMap[attr]; !ok {
+			continue
+		}
+		schema.Attrs = append(schema.Attrs, attr)
+		schema.Types = append(schema.Types, s.Result.AttrsMap[attr].Type)
+	}
+	return schema
+}
+
+// constructRings returns the aggregations computed by a child of join.
+func constructRings(s *plan.Scope) []times.Ring {
+	var bvars []*plan.Aggregation
+
+	switch op := s.Op.(type) {
+	case *plan.Relation:
+		bvars = op.BoundVars
+	case *plan.DerivedRelation:
+		bvars = op.BoundVars
+	default:
+		if len(s.Children) == 1 {
+			return constructRings(s.Children[0])
+		}
+		return nil
+	}
+	rs := make([]times.Ring, len(bvars))
+	for i, bvar := range bvars {
+		rs[i] = times.Ring{
+			Op:    bvar.Op,
+			Ref:   bvar.Ref,
+			Alias: bvar.Alias,
+			Type:  bvar.Type.ToType(),
+		}
+		if bvar.E != nil {
+			rs[i].Type = bvar.E.ReturnType().ToType()
+		}
+	}
+	return rs
+}
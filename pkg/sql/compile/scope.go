@@ -22,7 +22,6 @@ import (
 	"net"
 	"runtime"
 	"strings"
-	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/dedup"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/deleteTag"
@@ -34,8 +33,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergetop"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/top"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/updateTag"
 
-	"github.com/fagongzi/goetty"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -43,6 +42,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/rpcserver"
 	"github.com/matrixorigin/matrixone/pkg/rpcserver/message"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/connector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/protocol"
@@ -53,8 +53,6 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vectorize/like"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
-	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
-	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/pipeline"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -148,6 +146,49 @@ func (s *Scope) DropIndex(ts uint64) error {
 	return p.Relation.DropIndex(ts, p.Id)
 }
 
+// AlterTable do alter table work according to alter table plan,
+// all the changes are applied to the relation as one schema change,
+// so either all of them take effect or none.
+func (s *Scope) AlterTable(ts uint64) error {
+	p, _ := s.Plan.(*plan.AlterTable)
+	defer p.Relation.Close()
+	def := &engine.SchemaChangeDef{Changes: make([]engine.SchemaChange, len(p.Defs))}
+	for i, d := range p.Defs {
+		def.Changes[i] = engine.SchemaChange{Drop: d.Drop, Def: d.Def}
+	}
+	return p.Relation.AddTableDef(ts, def)
+}
+
+// CreateUser do create user work according to create user plan
+func (s *Scope) CreateUser() error {
+	p, _ := s.Plan.(*plan.CreateUser)
+	return p.M.CreateUsers(p.Users, p.IfNotExistFlag)
+}
+
+// DropUser do drop user work according to drop user plan
+func (s *Scope) DropUser() error {
+	p, _ := s.Plan.(*plan.DropUser)
+	return p.M.DropUsers(p.Users, p.IfExistFlag)
+}
+
+// AlterUser do alter user work according to alter user plan
+func (s *Scope) AlterUser() error {
+	p, _ := s.Plan.(*plan.AlterUser)
+	return p.M.AlterUsers(p.Users, p.IfExistFlag)
+}
+
+// Grant grants privileges to users according to grant plan
+func (s *Scope) Grant() error {
+	p, _ := s.Plan.(*plan.Grant)
+	return p.M.Grant(p.Users, p.Grant)
+}
+
+// Revoke revokes privileges from users according to revoke plan
+func (s *Scope) Revoke() error {
+	p, _ := s.Plan.(*plan.Revoke)
+	return p.M.Revoke(p.Users, p.Grant)
+}
+
 // ShowDatabases fill batch with all database names
 func (s *Scope) ShowDatabases(u interface{}, fill func(interface{}, *batch.Batch) error) error {
 	p, _ := s.Plan.(*plan.ShowDatabases)
@@ -267,6 +308,8 @@ func (s *Scope) ShowColumns(u interface{}, fill func(interface{}, *batch.Batch)
 					switch tableOption.Attr.Type.Oid {
 					case types.T_date, types.T_datetime:
 						attrs[count].dft = fmt.Sprintf("%s", tableOption.Attr.Default.Value)
+					case types.T_decimal:
+						attrs[count].dft = tableOption.Attr.Default.Value.(types.Decimal).Format(types.DecimalScale(tableOption.Attr.Type))
 					default:
 						attrs[count].dft = fmt.Sprintf("%v", tableOption.Attr.Default.Value)
 					}
@@ -291,7 +334,9 @@ func (s *Scope) ShowColumns(u interface{}, fill func(interface{}, *batch.Batch)
 	for i, attr := range attrs {
 		var typ, pri string
 
-		if attr.typ.Width > 0 {
+		if attr.typ.Oid == types.T_decimal {
+			typ = fmt.Sprintf("%s(%v,%v)", strings.ToLower(attr.typ.String()), attr.typ.Width, attr.typ.Precision)
+		} else if attr.typ.Width > 0 {
 			typ = fmt.Sprintf("%s(%v)", strings.ToLower(attr.typ.String()), attr.typ.Width)
 		} else {
 			typ = strings.ToLower(attr.typ.String())
@@ -446,7 +491,7 @@ func (s *Scope) Delete(ts uint64, e engine.Engine) (uint64, error) {
 // Update will update rows from a single of table
 func (s *Scope) Update(ts uint64, e engine.Engine) (uint64, error) {
 	s.Magic = Merge
-	arg := s.Instructions[len(s.Instructions)-1].Arg.(*deleteTag.Argument)
+	arg := s.Instructions[len(s.Instructions)-1].Arg.(*updateTag.Argument)
 	arg.Ts = ts
 	defer arg.Relation.Close()
 	if err := s.MergeRun(e); err != nil {
@@ -504,66 +549,63 @@ func (s *Scope) MergeRun(e engine.Engine) error {
 }
 
 // RemoteRun send the scope to a remote node (if target node is itself, it is same to function ParallelRun) and run it.
+// The results are received with the flow control, and the remote scope is canceled if the receiver is done.
 func (s *Scope) RemoteRun(e engine.Engine) error {
 	var buf bytes.Buffer
 
 	if Address == s.NodeInfo.Addr {
 		return s.ParallelRun(e)
 	}
-	ps := Transfer(s)
-	err := protocol.EncodeScope(ps, &buf)
-	if err != nil {
-		return err
-	}
 	arg := s.Instructions[len(s.Instructions)-1].Arg.(*connector.Argument)
-	encoder, decoder := rpcserver.NewCodec(1 << 30)
-	conn := goetty.NewIOSession(goetty.WithCodec(encoder, decoder))
-	defer conn.Close()
-	addr, _ := net.ResolveTCPAddr("tcp", s.NodeInfo.Addr)
-	if _, err := conn.Connect(fmt.Sprintf("%v:%v", addr.IP, addr.Port+100), time.Second*3); err != nil {
+	// the end of the results
+	defer func() {
 		select {
 		case <-arg.Reg.Ctx.Done():
 		case arg.Reg.Ch <- nil:
 		}
+	}()
+	ps := Transfer(s)
+	err := protocol.EncodeScope(ps, &buf)
+	if err != nil {
 		return err
 	}
-	if err := conn.WriteAndFlush(&message.Message{Data: buf.Bytes()}); err != nil {
-		select {
-		case <-arg.Reg.Ctx.Done():
-		case arg.Reg.Ch <- nil:
-		}
+	addr, err := net.ResolveTCPAddr("tcp", s.NodeInfo.Addr)
+	if err != nil {
+		return err
+	}
+	stream, err := client.NewStream(fmt.Sprintf("%v:%v", addr.IP, addr.Port+100), remoteWindow)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+	if err := stream.Request(0, buf.Bytes()); err != nil {
 		return err
 	}
 	for {
-		val, err := conn.Read()
+		msg, err := stream.Recv(arg.Reg.Ctx)
 		if err != nil {
-			select {
-			case <-arg.Reg.Ctx.Done():
-			case arg.Reg.Ch <- nil:
+			if arg.Reg.Ctx.Err() != nil {
+				return nil
 			}
 			return err
 		}
-		msg := val.(*message.Message)
-		if len(msg.Code) > 0 {
-			select {
-			case <-arg.Reg.Ctx.Done():
-			case arg.Reg.Ch <- nil:
+		if msg.Type == rpcserver.End {
+			code := msg.Code
+			message.Release(msg)
+			if len(code) > 0 {
+				return errors.New(errno.SystemError, string(code))
 			}
-			return errors.New(errno.SystemError, string(msg.Code))
+			return nil
 		}
-		if msg.Sid == 1 {
-			select {
-			case <-arg.Reg.Ctx.Done():
-			case arg.Reg.Ch <- nil:
-			}
-			break
+		data, err := rpcserver.Decompress(msg.Data)
+		if err != nil {
+			message.Release(msg)
+			return err
 		}
-		bat, _, err := protocol.DecodeBatchWithProcess(val.(*message.Message).Data, s.Proc)
+		bat, _, err := protocol.DecodeBatchWithProcess(data, s.Proc)
+		// the batch may refer to the payload, it is not reused since a reused message is reset
+		message.Release(msg)
 		if err != nil {
-			select {
-			case <-arg.Reg.Ctx.Done():
-			case arg.Reg.Ch <- nil:
-			}
 			return err
 		}
 		if arg.Reg.Ch == nil {
@@ -574,10 +616,11 @@ func (s *Scope) RemoteRun(e engine.Engine) error {
 		}
 		select {
 		case <-arg.Reg.Ctx.Done():
+			batch.Clean(bat, s.Proc.Mp)
+			return nil
 		case arg.Reg.Ch <- bat:
 		}
 	}
-	return nil
 }
 
 // ParallelRun try to execute the scope in parallel way.
@@ -643,7 +686,7 @@ func (s *Scope) RunQ(e engine.Engine) error {
 			return err
 		}
 		defer rel.Close()
-		rds = rel.NewReader(mcpu, nil, nil)
+		rds = rel.NewReader(mcpu, s.readerCondition(), nil)
 	}
 	ss := make([]*Scope, mcpu)
 	for i := 0; i < mcpu; i++ {
@@ -658,9 +701,7 @@ func (s *Scope) RunQ(e engine.Engine) error {
 				Attributes:   s.DataSource.Attributes,
 			},
 		}
-		ss[i].Proc = process.New(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)))
-		ss[i].Proc.Id = s.Proc.Id
-		ss[i].Proc.Lim = s.Proc.Lim
+		ss[i].Proc = process.NewFromProc(s.Proc)
 	}
 	{
 		var flg bool
@@ -680,6 +721,7 @@ func (s *Scope) RunQ(e engine.Engine) error {
 						Fields: arg.Fs,
 						Limit:  arg.Limit,
 					},
+					Anal: in.Anal,
 				}
 				for i := range ss {
 					ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
@@ -702,6 +744,7 @@ func (s *Scope) RunQ(e engine.Engine) error {
 					Arg: &mergeorder.Argument{
 						Fields: arg.Fs,
 					},
+					Anal: in.Anal,
 				}
 				for i := range ss {
 					ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
@@ -718,8 +761,9 @@ func (s *Scope) RunQ(e engine.Engine) error {
 				flg = true
 				s.Instructions = append(s.Instructions[:1], s.Instructions[i+1:]...)
 				s.Instructions[0] = vm.Instruction{
-					Op:  vm.MergeDedup,
-					Arg: &mergededup.Argument{},
+					Op:   vm.MergeDedup,
+					Arg:  &mergededup.Argument{},
+					Anal: in.Anal,
 				}
 				for i := range ss {
 					ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
@@ -739,6 +783,7 @@ func (s *Scope) RunQ(e engine.Engine) error {
 					Arg: &mergelimit.Argument{
 						Limit: arg.Limit,
 					},
+					Anal: in.Anal,
 				}
 				for i := range ss {
 					ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
@@ -809,7 +854,7 @@ func (s *Scope) RunAQ(e engine.Engine) error {
 			return err
 		}
 		defer rel.Close()
-		rds = rel.NewReader(mcpu, nil, nil)
+		rds = rel.NewReader(mcpu, s.readerCondition(), nil)
 	}
 	ss := make([]*Scope, mcpu)
 	arg := s.Instructions[0].Arg.(*transform.Argument)
@@ -826,9 +871,7 @@ func (s *Scope) RunAQ(e engine.Engine) error {
 			},
 		}
 		ss[i].Instructions = append(ss[i].Instructions, dupInstruction(s.Instructions[0]))
-		ss[i].Proc = process.New(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)))
-		ss[i].Proc.Id = s.Proc.Id
-		ss[i].Proc.Lim = s.Proc.Lim
+		ss[i].Proc = process.NewFromProc(s.Proc)
 	}
 	if len(ss) > 3 {
 		ss = newMergeScope(ss, arg.Typ, s.Proc)
@@ -870,6 +913,8 @@ func (s *Scope) RunCQ(e engine.Engine, op *join.Argument) error {
 
 	{ // fill batchs
 		bats = make([]*batch.Batch, len(op.Vars))
+		sizes := make([]int64, len(op.Vars))
+		defer func() { releaseViews(op.Spill, sizes, s.Proc) }()
 		ctx, cancel := context.WithCancel(context.Background())
 		s.Proc.Cancel = cancel
 		s.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(s.PreScopes))
@@ -942,21 +987,21 @@ func (s *Scope) RunCQ(e engine.Engine, op *join.Argument) error {
 				if len(bat.Zs) == 0 {
 					continue
 				}
-				if bats[i] == nil {
-					bats[i] = bat
-				} else {
-					if bats[i], err = bats[i].Append(s.Proc.Mp, bat); err != nil {
-						for i := range bats {
-							if bats[i] != nil {
-								batch.Clean(bats[i], s.Proc.Mp)
-							}
+				if op.Spill, err = appendView(op.Spill, op.Vars, bats, i, bat, sizes, s.Proc); err != nil {
+					for i := range bats {
+						if bats[i] != nil {
+							batch.Clean(bats[i], s.Proc.Mp)
 						}
-						return err
 					}
+					return err
 				}
 			}
-			if bats[i] == nil {
-				flg = true
+			if bats[i] == nil && (op.Spill == nil || op.Spill.Index != i) {
+				if op.Type == join.Inner {
+					flg = true
+				} else {
+					bats[i] = constructEmptyView(op.Views[i])
+				}
 			}
 		}
 		if flg {
@@ -978,6 +1023,22 @@ func (s *Scope) RunCQ(e engine.Engine, op *join.Argument) error {
 			return nil
 		}
 		constructViews(bats, op.Vars)
+		if op.Spill != nil {
+			k := op.Spill.Index
+			op.Spill.Construct = constructSpilledView(op.Type, op.Vars[k], func() (*batch.Batch, error) {
+				return constructEmptyView(op.Views[k]), nil
+			})
+		}
+		if op.Type != join.Inner {
+			if err := appendNullRows(bats, s.Proc.Mp); err != nil {
+				for i := range bats {
+					if bats[i] != nil {
+						batch.Clean(bats[i], s.Proc.Mp)
+					}
+				}
+				return err
+			}
+		}
 		op.Bats = bats
 		defer func() {
 			for i := range bats {
@@ -988,6 +1049,12 @@ func (s *Scope) RunCQ(e engine.Engine, op *join.Argument) error {
 		}()
 	}
 	mcpu := runtime.NumCPU()
+	if op.Type == join.Full { // unmatched rows of views can only be found by one probe
+		mcpu = 1
+	}
+	if op.Spill != nil { // partitions of the spilled view are joined one by one
+		mcpu = 1
+	}
 	{
 		db, err := e.Database(s.DataSource.SchemaName)
 		if err != nil {
@@ -998,7 +1065,7 @@ func (s *Scope) RunCQ(e engine.Engine, op *join.Argument) error {
 			return err
 		}
 		defer rel.Close()
-		rds = rel.NewReader(mcpu, nil, nil)
+		rds = rel.NewReader(mcpu, s.readerCondition(), nil)
 	}
 	ss := make([]*Scope, mcpu)
 	for i := 0; i < mcpu; i++ {
@@ -1013,9 +1080,7 @@ func (s *Scope) RunCQ(e engine.Engine, op *join.Argument) error {
 				Attributes:   s.DataSource.Attributes,
 			},
 		}
-		ss[i].Proc = process.New(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)))
-		ss[i].Proc.Id = s.Proc.Id
-		ss[i].Proc.Lim = s.Proc.Lim
+		ss[i].Proc = process.NewFromProc(s.Proc)
 		{
 			for _, in := range s.Instructions {
 				ss[i].Instructions = append(ss[i].Instructions, dupInstruction(in))
@@ -1043,10 +1108,8 @@ func (s *Scope) RunCQ(e engine.Engine, op *join.Argument) error {
 	})
 	rs.Instructions = append(rs.Instructions, s.Instructions...)
 	ctx, cancel := context.WithCancel(context.Background())
-	rs.Proc = process.New(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)))
+	rs.Proc = process.NewFromProc(s.Proc)
 	rs.Proc.Cancel = cancel
-	rs.Proc.Id = s.Proc.Id
-	rs.Proc.Lim = s.Proc.Lim
 	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 	{
 		for i := 0; i < len(ss); i++ {
@@ -1076,7 +1139,9 @@ func (s *Scope) RunCQWithSubquery(e engine.Engine, op *join.Argument) error {
 	{ // fill batchs
 		rs := new(Scope)
 		bats = make([]*batch.Batch, len(op.Vars))
-		rs.Proc = process.New(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(s.Proc)
+		sizes := make([]int64, len(op.Vars))
+		defer func() { releaseViews(op.Spill, sizes, rs.Proc) }()
 		rs.PreScopes = s.PreScopes[1:]
 		ctx, cancel := context.WithCancel(context.Background())
 		rs.Proc.Cancel = cancel
@@ -1150,21 +1215,21 @@ func (s *Scope) RunCQWithSubquery(e engine.Engine, op *join.Argument) error {
 				if len(bat.Zs) == 0 {
 					continue
 				}
-				if bats[i] == nil {
-					bats[i] = bat
-				} else {
-					if bats[i], err = bats[i].Append(rs.Proc.Mp, bat); err != nil {
-						for i := range bats {
-							if bats[i] != nil {
-								batch.Clean(bats[i], rs.Proc.Mp)
-							}
+				if op.Spill, err = appendView(op.Spill, op.Vars, bats, i, bat, sizes, rs.Proc); err != nil {
+					for i := range bats {
+						if bats[i] != nil {
+							batch.Clean(bats[i], rs.Proc.Mp)
 						}
-						return err
 					}
+					return err
 				}
 			}
-			if bats[i] == nil {
-				flg = true
+			if bats[i] == nil && (op.Spill == nil || op.Spill.Index != i) {
+				if op.Type == join.Inner {
+					flg = true
+				} else {
+					bats[i] = constructEmptyView(op.Views[i])
+				}
 			}
 		}
 		if flg {
@@ -1186,6 +1251,22 @@ func (s *Scope) RunCQWithSubquery(e engine.Engine, op *join.Argument) error {
 			return nil
 		}
 		constructViews(bats, op.Vars)
+		if op.Spill != nil {
+			k := op.Spill.Index
+			op.Spill.Construct = constructSpilledView(op.Type, op.Vars[k], func() (*batch.Batch, error) {
+				return constructEmptyView(op.Views[k]), nil
+			})
+		}
+		if op.Type != join.Inner {
+			if err := appendNullRows(bats, s.Proc.Mp); err != nil {
+				for i := range bats {
+					if bats[i] != nil {
+						batch.Clean(bats[i], s.Proc.Mp)
+					}
+				}
+				return err
+			}
+		}
 		op.Bats = bats
 		defer func() {
 			for i := range bats {
@@ -1208,6 +1289,8 @@ func (s *Scope) RunCAQ(e engine.Engine, op *times.Argument) error {
 
 	{ // fill batchs
 		bats = make([]*batch.Batch, len(op.Vars))
+		sizes := make([]int64, len(op.Vars))
+		defer func() { releaseViews(op.Spill, sizes, s.Proc) }()
 		ctx, cancel := context.WithCancel(context.Background())
 		s.Proc.Cancel = cancel
 		s.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(s.PreScopes))
@@ -1280,21 +1363,26 @@ func (s *Scope) RunCAQ(e engine.Engine, op *times.Argument) error {
 				if len(bat.Zs) == 0 {
 					continue
 				}
-				if bats[i] == nil {
-					bats[i] = bat
-				} else {
-					if bats[i], err = bats[i].Append(s.Proc.Mp, bat); err != nil {
-						for i := range bats {
-							if bats[i] != nil {
-								batch.Clean(bats[i], s.Proc.Mp)
-							}
+				if op.Spill, err = appendView(op.Spill, op.Vars, bats, i, bat, sizes, s.Proc); err != nil {
+					for i := range bats {
+						if bats[i] != nil {
+							batch.Clean(bats[i], s.Proc.Mp)
 						}
-						return err
 					}
+					return err
 				}
 			}
-			if bats[i] == nil {
-				flg = true
+			if bats[i] == nil && (op.Spill == nil || op.Spill.Index != i) {
+				if op.Type == join.Inner {
+					flg = true
+				} else if bats[i], err = constructEmptyCAQView(op.Views[i]); err != nil {
+					for i := range bats {
+						if bats[i] != nil {
+							batch.Clean(bats[i], s.Proc.Mp)
+						}
+					}
+					return err
+				}
 			}
 		}
 		if flg {
@@ -1316,6 +1404,22 @@ func (s *Scope) RunCAQ(e engine.Engine, op *times.Argument) error {
 			return nil
 		}
 		constructViews(bats, op.Vars)
+		if op.Spill != nil {
+			k := op.Spill.Index
+			op.Spill.Construct = constructSpilledView(op.Type, op.Vars[k], func() (*batch.Batch, error) {
+				return constructEmptyCAQView(op.Views[k])
+			})
+		}
+		if op.Type != join.Inner {
+			if err := appendNullRows(bats, s.Proc.Mp); err != nil {
+				for i := range bats {
+					if bats[i] != nil {
+						batch.Clean(bats[i], s.Proc.Mp)
+					}
+				}
+				return err
+			}
+		}
 		op.Bats = bats
 		defer func() {
 			for i := range bats {
@@ -1326,6 +1430,9 @@ func (s *Scope) RunCAQ(e engine.Engine, op *times.Argument) error {
 		}()
 	}
 	mcpu := runtime.NumCPU()
+	if op.Spill != nil { // partitions of the spilled view are joined one by one
+		mcpu = 1
+	}
 	{
 		db, err := e.Database(s.DataSource.SchemaName)
 		if err != nil {
@@ -1336,7 +1443,7 @@ func (s *Scope) RunCAQ(e engine.Engine, op *times.Argument) error {
 			return err
 		}
 		defer rel.Close()
-		rds = rel.NewReader(mcpu, nil, nil)
+		rds = rel.NewReader(mcpu, s.readerCondition(), nil)
 	}
 	ss := make([]*Scope, mcpu)
 	for i := 0; i < mcpu; i++ {
@@ -1351,9 +1458,7 @@ func (s *Scope) RunCAQ(e engine.Engine, op *times.Argument) error {
 				Attributes:   s.DataSource.Attributes,
 			},
 		}
-		ss[i].Proc = process.New(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)))
-		ss[i].Proc.Id = s.Proc.Id
-		ss[i].Proc.Lim = s.Proc.Lim
+		ss[i].Proc = process.NewFromProc(s.Proc)
 		{
 			for _, in := range s.Instructions {
 				ss[i].Instructions = append(ss[i].Instructions, dupInstruction(in))
@@ -1400,10 +1505,8 @@ func (s *Scope) RunCAQ(e engine.Engine, op *times.Argument) error {
 	}
 	rs.Instructions = append(rs.Instructions, s.Instructions...)
 	ctx, cancel := context.WithCancel(context.Background())
-	rs.Proc = process.New(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)))
+	rs.Proc = process.NewFromProc(s.Proc)
 	rs.Proc.Cancel = cancel
-	rs.Proc.Id = s.Proc.Id
-	rs.Proc.Lim = s.Proc.Lim
 	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 	{
 		for i := 0; i < len(ss); i++ {
@@ -1433,7 +1536,9 @@ func (s *Scope) RunCAQWithSubquery(e engine.Engine, op *times.Argument) error {
 	{ // fill batchs
 		rs := new(Scope)
 		bats = make([]*batch.Batch, len(op.Vars))
-		rs.Proc = process.New(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(s.Proc)
+		sizes := make([]int64, len(op.Vars))
+		defer func() { releaseViews(op.Spill, sizes, rs.Proc) }()
 		rs.PreScopes = s.PreScopes[1:]
 		ctx, cancel := context.WithCancel(context.Background())
 		rs.Proc.Cancel = cancel
@@ -1507,21 +1612,26 @@ func (s *Scope) RunCAQWithSubquery(e engine.Engine, op *times.Argument) error {
 				if len(bat.Zs) == 0 {
 					continue
 				}
-				if bats[i] == nil {
-					bats[i] = bat
-				} else {
-					if bats[i], err = bats[i].Append(rs.Proc.Mp, bat); err != nil {
-						for i := range bats {
-							if bats[i] != nil {
-								batch.Clean(bats[i], rs.Proc.Mp)
-							}
+				if op.Spill, err = appendView(op.Spill, op.Vars, bats, i, bat, sizes, rs.Proc); err != nil {
+					for i := range bats {
+						if bats[i] != nil {
+							batch.Clean(bats[i], rs.Proc.Mp)
 						}
-						return err
 					}
+					return err
 				}
 			}
-			if bats[i] == nil {
-				flg = true
+			if bats[i] == nil && (op.Spill == nil || op.Spill.Index != i) {
+				if op.Type == join.Inner {
+					flg = true
+				} else if bats[i], err = constructEmptyCAQView(op.Views[i]); err != nil {
+					for i := range bats {
+						if bats[i] != nil {
+							batch.Clean(bats[i], s.Proc.Mp)
+						}
+					}
+					return err
+				}
 			}
 		}
 		if flg {
@@ -1543,6 +1653,22 @@ func (s *Scope) RunCAQWithSubquery(e engine.Engine, op *times.Argument) error {
 			return nil
 		}
 		constructViews(bats, op.Vars)
+		if op.Spill != nil {
+			k := op.Spill.Index
+			op.Spill.Construct = constructSpilledView(op.Type, op.Vars[k], func() (*batch.Batch, error) {
+				return constructEmptyCAQView(op.Views[k])
+			})
+		}
+		if op.Type != join.Inner {
+			if err := appendNullRows(bats, s.Proc.Mp); err != nil {
+				for i := range bats {
+					if bats[i] != nil {
+						batch.Clean(bats[i], s.Proc.Mp)
+					}
+				}
+				return err
+			}
+		}
 		op.Bats = bats
 		defer func() {
 			for i := range bats {
@@ -1582,11 +1708,9 @@ func newMergeScope(ss []*Scope, typ int, proc *process.Process) []*Scope {
 		{
 			m := len(rs[i].PreScopes)
 			ctx, cancel := context.WithCancel(context.Background())
-			rs[i].Proc = process.New(mheap.New(guest.New(proc.Mp.Gm.Limit, proc.Mp.Gm.Mmu)))
+			rs[i].Proc = process.NewFromProc(proc)
 			rs[i].Proc.Cancel = cancel
 			rs[i].Proc.Cancel = cancel
-			rs[i].Proc.Id = proc.Id
-			rs[i].Proc.Lim = proc.Lim
 			rs[i].Proc.Reg.MergeReceivers = make([]*process.WaitRegister, m)
 			{
 				for j := 0; j < m; j++ {
@@ -1636,11 +1760,9 @@ func newMergeOrderScope(ss []*Scope, arg *mergeorder.Argument, proc *process.Pro
 		{
 			m := len(rs[i].PreScopes)
 			ctx, cancel := context.WithCancel(context.Background())
-			rs[i].Proc = process.New(mheap.New(guest.New(proc.Mp.Gm.Limit, proc.Mp.Gm.Mmu)))
+			rs[i].Proc = process.NewFromProc(proc)
 			rs[i].Proc.Cancel = cancel
 			rs[i].Proc.Cancel = cancel
-			rs[i].Proc.Id = proc.Id
-			rs[i].Proc.Lim = proc.Lim
 			rs[i].Proc.Reg.MergeReceivers = make([]*process.WaitRegister, m)
 			{
 				for j := 0; j < m; j++ {
@@ -1690,11 +1812,9 @@ func newMergeLimitScope(ss []*Scope, limit uint64, proc *process.Process) []*Sco
 		{
 			m := len(rs[i].PreScopes)
 			ctx, cancel := context.WithCancel(context.Background())
-			rs[i].Proc = process.New(mheap.New(guest.New(proc.Mp.Gm.Limit, proc.Mp.Gm.Mmu)))
+			rs[i].Proc = process.NewFromProc(proc)
 			rs[i].Proc.Cancel = cancel
 			rs[i].Proc.Cancel = cancel
-			rs[i].Proc.Id = proc.Id
-			rs[i].Proc.Lim = proc.Lim
 			rs[i].Proc.Reg.MergeReceivers = make([]*process.WaitRegister, m)
 			{
 				for j := 0; j < m; j++ {
@@ -1747,11 +1867,9 @@ func newMergeTopScope(ss []*Scope, arg *mergetop.Argument, proc *process.Process
 		{
 			m := len(rs[i].PreScopes)
 			ctx, cancel := context.WithCancel(context.Background())
-			rs[i].Proc = process.New(mheap.New(guest.New(proc.Mp.Gm.Limit, proc.Mp.Gm.Mmu)))
+			rs[i].Proc = process.NewFromProc(proc)
 			rs[i].Proc.Cancel = cancel
 			rs[i].Proc.Cancel = cancel
-			rs[i].Proc.Id = proc.Id
-			rs[i].Proc.Lim = proc.Lim
 			rs[i].Proc.Reg.MergeReceivers = make([]*process.WaitRegister, m)
 			{
 				for j := 0; j < m; j++ {
@@ -1801,11 +1919,9 @@ func newMergeDedupScope(ss []*Scope, proc *process.Process) []*Scope {
 		{
 			m := len(rs[i].PreScopes)
 			ctx, cancel := context.WithCancel(context.Background())
-			rs[i].Proc = process.New(mheap.New(guest.New(proc.Mp.Gm.Limit, proc.Mp.Gm.Mmu)))
+			rs[i].Proc = process.NewFromProc(proc)
 			rs[i].Proc.Cancel = cancel
 			rs[i].Proc.Cancel = cancel
-			rs[i].Proc.Id = proc.Id
-			rs[i].Proc.Lim = proc.Lim
 			rs[i].Proc.Reg.MergeReceivers = make([]*process.WaitRegister, m)
 			{
 				for j := 0; j < m; j++ {
@@ -1828,3 +1944,32 @@ func newMergeDedupScope(ss []*Scope, proc *process.Process) []*Scope {
 	}
 	return rs
 }
+
+// readerCondition returns the condition of the data source which the readers
+// can use to skip rows. The transform restricts the rows after the projection,
+// so the condition is given only if its attributes are projected as themselves.
+func (s *Scope) readerCondition() extend.Extend {
+	if len(s.Instructions) == 0 || s.Instructions[0].Op != vm.Transform {
+		return nil
+	}
+	arg := s.Instructions[0].Arg.(*transform.Argument)
+	if arg.Restrict == nil {
+		return nil
+	}
+	if proj := arg.Projection; proj != nil {
+		for _, name := range arg.Restrict.E.Attributes() {
+			projected := false
+			for i, alias := range proj.As {
+				if alias == name {
+					attr, ok := proj.Es[i].(*extend.Attribute)
+					projected = ok && attr.Name == name
+					break
+				}
+			}
+			if !projected {
+				return nil
+			}
+		}
+	}
+	return arg.Restrict.E
+}
@@ -90,11 +90,16 @@ func UntransferIns(ins, pins vm.Instructions) {
 		case vm.Join:
 			a := ins[i].Arg.(*join.Argument)
 			pa := in.Arg.(*join.Argument)
+			a.Type = pa.Type
 			a.Vars = pa.Vars
+			a.Fact = pa.Fact
+			a.Views = pa.Views
 		case vm.Times:
 			a := ins[i].Arg.(*times.Argument)
 			pa := in.Arg.(*times.Argument)
+			a.Type = pa.Type
 			a.Vars = pa.Vars
+			a.Views = pa.Views
 		case vm.Merge:
 		case vm.Dedup:
 		case vm.Order:
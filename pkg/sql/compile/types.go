@@ -17,8 +17,11 @@ package compile
 import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/rpcserver"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -43,6 +46,14 @@ const (
 	ShowCreateDatabase
 	Delete
 	Update
+	CreateUser
+	DropUser
+	AlterUser
+	Grant
+	Revoke
+	Explain
+	AlterTable
+	Analyze
 )
 
 const (
@@ -54,6 +65,12 @@ const (
 
 var Address string
 
+// remoteWindow is the count of batches a remote scope can send before they are consumed.
+const remoteWindow = 4
+
+// client is shared by the remote scopes, the scopes sent to the same node share the connection.
+var client = rpcserver.NewClient(1 << 30)
+
 // Source contains information of a relation which will be used in execution,
 type Source struct {
 	IsMerge      bool
@@ -109,6 +126,10 @@ type Exec struct {
 	//fill is a result writer runs a callback function.
 	//fill will be called when result data is ready.
 	fill func(interface{}, *batch.Batch) error
+	//params are the values bound to the placeholders of prepared statement.
+	params []tree.Expr
+	//vars returns the value of system or user variable.
+	vars func(*tree.VarExpr) (tree.Expr, error)
 }
 
 // compile contains all the information needed for compilation.
@@ -123,4 +144,10 @@ type compile struct {
 	e engine.Engine
 	// proc stores the execution context.
 	proc *process.Process
+	// pc checks the privileges of the user, privileges are not checked if it is nil.
+	pc *privilege.Checker
+	// dt the dialect of the sql text.
+	dt dialect.DialectType
+	// stmts the parsed statements of the sql text, it is parsed by Build if nil.
+	stmts []tree.Statement
 }
@@ -23,6 +23,11 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/join"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/times"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transformer"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
 )
 
 const (
@@ -40,7 +45,93 @@ func init() {
 
 func constructViews(bats []*batch.Batch, vars [][]string) {
 	for i := range vars {
-		constructView(bats[i], vars[i])
+		if bats[i] != nil { // nil if the view is spilled
+			constructView(bats[i], vars[i])
+		}
+	}
+}
+
+// appendView appends the batch to the i-th view, the largest view is written
+// into partitions if the memory limitation is exceeded, sv is the spilled view.
+func appendView(sv *join.SpilledView, vars [][]string, bats []*batch.Batch, i int, bat *batch.Batch,
+	sizes []int64, proc *process.Process) (*join.SpilledView, error) {
+	if sv != nil && sv.Index == i {
+		defer batch.Clean(bat, proc.Mp)
+		return sv, sv.Part.Write(bat, proc.Mp)
+	}
+	size := spill.Size(bat)
+	if err := mheap.Reserve(proc.Mp, size); err != nil {
+		if sv != nil || !spill.Enabled(proc) {
+			batch.Clean(bat, proc.Mp)
+			return sv, err
+		}
+		k := i
+		for j := range sizes {
+			if sizes[j] > sizes[k] {
+				k = j
+			}
+		}
+		part, err := spill.NewPartition(spill.Partitions, vars[k], proc)
+		if err != nil {
+			batch.Clean(bat, proc.Mp)
+			return sv, err
+		}
+		sv = &join.SpilledView{Index: k, Part: part}
+		if bats[k] != nil {
+			err = part.Write(bats[k], proc.Mp)
+			batch.Clean(bats[k], proc.Mp)
+			bats[k] = nil
+			mheap.Release(proc.Mp, sizes[k])
+			sizes[k] = 0
+			if err != nil {
+				batch.Clean(bat, proc.Mp)
+				return sv, err
+			}
+		}
+		return appendView(sv, vars, bats, i, bat, sizes, proc)
+	}
+	sizes[i] += size
+	if bats[i] == nil {
+		bats[i] = bat
+		return sv, nil
+	}
+	b, err := bats[i].Append(proc.Mp, bat)
+	if err != nil {
+		return sv, err
+	}
+	bats[i] = b
+	return sv, nil
+}
+
+// releaseViews gives back the memory reserved by the views and removes
+// the files of the spilled view.
+func releaseViews(sv *join.SpilledView, sizes []int64, proc *process.Process) {
+	for _, size := range sizes {
+		mheap.Release(proc.Mp, size)
+	}
+	if sv != nil {
+		sv.Part.Remove()
+	}
+}
+
+// constructSpilledView returns the function which builds the spilled view from
+// the rows of a partition, empty returns the view without rows.
+func constructSpilledView(typ int, vars []string, empty func() (*batch.Batch, error)) func(*batch.Batch, *mheap.Mheap) (*batch.Batch, error) {
+	return func(bat *batch.Batch, m *mheap.Mheap) (*batch.Batch, error) {
+		if bat == nil {
+			var err error
+			if bat, err = empty(); err != nil {
+				return nil, err
+			}
+		}
+		constructView(bat, vars)
+		if typ != join.Inner {
+			if err := appendNullRows([]*batch.Batch{bat}, m); err != nil {
+				batch.Clean(bat, m)
+				return nil, err
+			}
+		}
+		return bat, nil
 	}
 }
 
@@ -48,7 +139,12 @@ func constructView(bat *batch.Batch, vars []string) {
 	var rows uint64
 
 	batch.Reorder(bat, vars)
-	if len(vars) == 1 {
+	if len(vars) == 0 {
+		constructViewWithoutVar(bat)
+		return
+	}
+	// a decimal does not fit in the keys of the integer hash map
+	if len(vars) == 1 && batch.GetVector(bat, vars[0]).Typ.Oid != types.T_decimal {
 		constructViewWithOneVar(bat, vars[0])
 		return
 	}
@@ -267,6 +363,22 @@ func constructView(bat *batch.Batch, vars []string) {
 						}
 					}
 				}
+			case types.T_decimal:
+				vs := vecs[j].Col.([]types.Decimal)
+				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*16)[:len(vs)*16]
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						keys[k] = append(keys[k], data[(i+k)*16:(i+k+1)*16]...)
+					}
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							zValues[k] = 0
+						} else {
+							keys[k] = append(keys[k], data[(i+k)*16:(i+k+1)*16]...)
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				if !nulls.Any(vecs[j].Nsp) {
@@ -293,7 +405,11 @@ func constructView(bat *batch.Batch, vars []string) {
 		{
 			for k, v := range values[:n] {
 				keys[k] = keys[k][:0]
+				if v == 0 {
+					continue
+				}
 				if v > rows {
+					rows++
 					ht.Sels = append(ht.Sels, make([]int64, 0, 8))
 				}
 				ai := int64(v) - 1
@@ -304,6 +420,20 @@ func constructView(bat *batch.Batch, vars []string) {
 	bat.Ht = ht
 }
 
+// constructViewWithoutVar constructs the view of a cross product, all the rows
+// are in one group.
+func constructViewWithoutVar(bat *batch.Batch) {
+	ht := &join.HashTable{}
+	if n := len(bat.Zs); n > 0 {
+		sels := make([]int64, n)
+		for i := range sels {
+			sels[i] = int64(i)
+		}
+		ht.Sels = append(ht.Sels, sels)
+	}
+	bat.Ht = ht
+}
+
 func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 	var rows uint64
 
@@ -334,6 +464,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 				ht.IntHashMap.InsertBatch(n, hashes, unsafe.Pointer(&keys[0]), values)
 				for k, v := range values[:n] {
 					if v > rows {
+						rows++
 						ht.Sels = append(ht.Sels, make([]int64, 0, 8))
 					}
 					ai := int64(v) - 1
@@ -351,7 +482,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 					copy(zValues[:n], OneInt64s[:n])
 					for k := 0; k < n; k++ {
 						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							zValues[i] = 0
+							zValues[k] = 0
 						}
 						keys[k] = uint64(vs[int(i)+k])
 					}
@@ -363,6 +494,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 						continue
 					}
 					if v > rows {
+						rows++
 						ht.Sels = append(ht.Sels, make([]int64, 0, 8))
 					}
 					ai := int64(v) - 1
@@ -396,6 +528,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 				ht.IntHashMap.InsertBatch(n, hashes, unsafe.Pointer(&keys[0]), values)
 				for k, v := range values[:n] {
 					if v > rows {
+						rows++
 						ht.Sels = append(ht.Sels, make([]int64, 0, 8))
 					}
 					ai := int64(v) - 1
@@ -413,7 +546,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 					copy(zValues[:n], OneInt64s[:n])
 					for k := 0; k < n; k++ {
 						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							zValues[i] = 0
+							zValues[k] = 0
 						}
 						keys[k] = uint64(vs[int(i)+k])
 					}
@@ -425,6 +558,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 						continue
 					}
 					if v > rows {
+						rows++
 						ht.Sels = append(ht.Sels, make([]int64, 0, 8))
 					}
 					ai := int64(v) - 1
@@ -458,6 +592,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 				ht.IntHashMap.InsertBatch(n, hashes, unsafe.Pointer(&keys[0]), values)
 				for k, v := range values[:n] {
 					if v > rows {
+						rows++
 						ht.Sels = append(ht.Sels, make([]int64, 0, 8))
 					}
 					ai := int64(v) - 1
@@ -475,7 +610,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 					copy(zValues[:n], OneInt64s[:n])
 					for k := 0; k < n; k++ {
 						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							zValues[i] = 0
+							zValues[k] = 0
 						}
 						keys[k] = uint64(vs[int(i)+k])
 					}
@@ -487,6 +622,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 						continue
 					}
 					if v > rows {
+						rows++
 						ht.Sels = append(ht.Sels, make([]int64, 0, 8))
 					}
 					ai := int64(v) - 1
@@ -520,6 +656,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 				ht.IntHashMap.InsertBatch(n, hashes, unsafe.Pointer(&keys[0]), values)
 				for k, v := range values[:n] {
 					if v > rows {
+						rows++
 						ht.Sels = append(ht.Sels, make([]int64, 0, 8))
 					}
 					ai := int64(v) - 1
@@ -537,7 +674,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 					copy(zValues[:n], OneInt64s[:n])
 					for k := 0; k < n; k++ {
 						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							zValues[i] = 0
+							zValues[k] = 0
 						}
 						keys[k] = uint64(vs[int(i)+k])
 					}
@@ -549,6 +686,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 						continue
 					}
 					if v > rows {
+						rows++
 						ht.Sels = append(ht.Sels, make([]int64, 0, 8))
 					}
 					ai := int64(v) - 1
@@ -582,6 +720,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 				ht.IntHashMap.InsertBatch(n, hashes, unsafe.Pointer(&keys[0]), values)
 				for k, v := range values[:n] {
 					if v > rows {
+						rows++
 						ht.Sels = append(ht.Sels, make([]int64, 0, 8))
 					}
 					ai := int64(v) - 1
@@ -599,7 +738,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 					copy(zValues[:n], OneInt64s[:n])
 					for k := 0; k < n; k++ {
 						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							zValues[i] = 0
+							zValues[k] = 0
 						}
 						keys[k] = uint64(vs[int(i)+k])
 					}
@@ -611,6 +750,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 						continue
 					}
 					if v > rows {
+						rows++
 						ht.Sels = append(ht.Sels, make([]int64, 0, 8))
 					}
 					ai := int64(v) - 1
@@ -644,6 +784,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 				ht.IntHashMap.InsertBatch(n, hashes, unsafe.Pointer(&keys[0]), values)
 				for k, v := range values[:n] {
 					if v > rows {
+						rows++
 						ht.Sels = append(ht.Sels, make([]int64, 0, 8))
 					}
 					ai := int64(v) - 1
@@ -661,7 +802,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 					copy(zValues[:n], OneInt64s[:n])
 					for k := 0; k < n; k++ {
 						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							zValues[i] = 0
+							zValues[k] = 0
 						}
 						keys[k] = uint64(vs[int(i)+k])
 					}
@@ -673,6 +814,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 						continue
 					}
 					if v > rows {
+						rows++
 						ht.Sels = append(ht.Sels, make([]int64, 0, 8))
 					}
 					ai := int64(v) - 1
@@ -706,6 +848,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 				ht.IntHashMap.InsertBatch(n, hashes, unsafe.Pointer(&keys[0]), values)
 				for k, v := range values[:n] {
 					if v > rows {
+						rows++
 						ht.Sels = append(ht.Sels, make([]int64, 0, 8))
 					}
 					ai := int64(v) - 1
@@ -723,7 +866,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 					copy(zValues[:n], OneInt64s[:n])
 					for k := 0; k < n; k++ {
 						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							zValues[i] = 0
+							zValues[k] = 0
 						}
 						keys[k] = uint64(vs[int(i)+k])
 					}
@@ -735,6 +878,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 						continue
 					}
 					if v > rows {
+						rows++
 						ht.Sels = append(ht.Sels, make([]int64, 0, 8))
 					}
 					ai := int64(v) - 1
@@ -768,6 +912,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 				ht.IntHashMap.InsertBatch(n, hashes, unsafe.Pointer(&keys[0]), values)
 				for k, v := range values[:n] {
 					if v > rows {
+						rows++
 						ht.Sels = append(ht.Sels, make([]int64, 0, 8))
 					}
 					ai := int64(v) - 1
@@ -785,7 +930,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 					copy(zValues[:n], OneInt64s[:n])
 					for k := 0; k < n; k++ {
 						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							zValues[i] = 0
+							zValues[k] = 0
 						}
 						keys[k] = uint64(vs[int(i)+k])
 					}
@@ -797,6 +942,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 						continue
 					}
 					if v > rows {
+						rows++
 						ht.Sels = append(ht.Sels, make([]int64, 0, 8))
 					}
 					ai := int64(v) - 1
@@ -830,6 +976,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 				ht.IntHashMap.InsertBatch(n, hashes, unsafe.Pointer(&keys[0]), values)
 				for k, v := range values[:n] {
 					if v > rows {
+						rows++
 						ht.Sels = append(ht.Sels, make([]int64, 0, 8))
 					}
 					ai := int64(v) - 1
@@ -847,7 +994,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 					copy(zValues[:n], OneInt64s[:n])
 					for k := 0; k < n; k++ {
 						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							zValues[i] = 0
+							zValues[k] = 0
 						}
 						keys[k] = uint64(vs[int(i)+k])
 					}
@@ -859,6 +1006,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 						continue
 					}
 					if v > rows {
+						rows++
 						ht.Sels = append(ht.Sels, make([]int64, 0, 8))
 					}
 					ai := int64(v) - 1
@@ -892,6 +1040,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 				ht.IntHashMap.InsertBatch(n, hashes, unsafe.Pointer(&keys[0]), values)
 				for k, v := range values[:n] {
 					if v > rows {
+						rows++
 						ht.Sels = append(ht.Sels, make([]int64, 0, 8))
 					}
 					ai := int64(v) - 1
@@ -909,7 +1058,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 					copy(zValues[:n], OneInt64s[:n])
 					for k := 0; k < n; k++ {
 						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							zValues[i] = 0
+							zValues[k] = 0
 						}
 						keys[k] = uint64(vs[int(i)+k])
 					}
@@ -921,6 +1070,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 						continue
 					}
 					if v > rows {
+						rows++
 						ht.Sels = append(ht.Sels, make([]int64, 0, 8))
 					}
 					ai := int64(v) - 1
@@ -954,6 +1104,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 				ht.IntHashMap.InsertBatch(n, hashes, unsafe.Pointer(&keys[0]), values)
 				for k, v := range values[:n] {
 					if v > rows {
+						rows++
 						ht.Sels = append(ht.Sels, make([]int64, 0, 8))
 					}
 					ai := int64(v) - 1
@@ -971,7 +1122,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 					copy(zValues[:n], OneInt64s[:n])
 					for k := 0; k < n; k++ {
 						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							zValues[i] = 0
+							zValues[k] = 0
 						}
 						keys[k] = uint64(vs[int(i)+k])
 					}
@@ -983,6 +1134,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 						continue
 					}
 					if v > rows {
+						rows++
 						ht.Sels = append(ht.Sels, make([]int64, 0, 8))
 					}
 					ai := int64(v) - 1
@@ -1016,6 +1168,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 				ht.IntHashMap.InsertBatch(n, hashes, unsafe.Pointer(&keys[0]), values)
 				for k, v := range values[:n] {
 					if v > rows {
+						rows++
 						ht.Sels = append(ht.Sels, make([]int64, 0, 8))
 					}
 					ai := int64(v) - 1
@@ -1033,7 +1186,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 					copy(zValues[:n], OneInt64s[:n])
 					for k := 0; k < n; k++ {
 						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							zValues[i] = 0
+							zValues[k] = 0
 						}
 						keys[k] = uint64(vs[int(i)+k])
 					}
@@ -1045,6 +1198,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 						continue
 					}
 					if v > rows {
+						rows++
 						ht.Sels = append(ht.Sels, make([]int64, 0, 8))
 					}
 					ai := int64(v) - 1
@@ -1083,6 +1237,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 				for k, v := range values[:n] {
 					keys[k] = keys[k][:0]
 					if v > rows {
+						rows++
 						ht.Sels = append(ht.Sels, make([]int64, 0, 8))
 					}
 					ai := int64(v) - 1
@@ -1100,7 +1255,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 					copy(zValues[:n], OneInt64s[:n])
 					for k := 0; k < n; k++ {
 						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							zValues[i] = 0
+							zValues[k] = 0
 						}
 						keys[k] = append(keys[k], vs.Get(int64(i+k))...)
 					}
@@ -1117,6 +1272,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 						continue
 					}
 					if v > rows {
+						rows++
 						ht.Sels = append(ht.Sels, make([]int64, 0, 8))
 					}
 					ai := int64(v) - 1
@@ -1127,3 +1283,62 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 		bat.Ht = ht
 	}
 }
+
+// constructEmptyView returns a view without rows, it is used when the view
+// of an outer join is empty.
+func constructEmptyView(schema join.Schema) *batch.Batch {
+	bat := batch.New(true, schema.Attrs)
+	for i, typ := range schema.Types {
+		bat.Vecs[i] = vector.New(typ)
+	}
+	return bat
+}
+
+// constructEmptyCAQView is like constructEmptyView, but the view also has
+// the rings of aggregations.
+func constructEmptyCAQView(schema times.Schema) (*batch.Batch, error) {
+	bat := constructEmptyView(schema.Schema)
+	for _, r := range schema.Rings {
+		rr, err := transformer.New(r.Op, r.Type)
+		if err != nil {
+			return nil, err
+		}
+		bat.Rs = append(bat.Rs, rr)
+		bat.As = append(bat.As, r.Alias)
+		bat.Refs = append(bat.Refs, uint64(r.Ref))
+	}
+	return bat, nil
+}
+
+// appendNullRows appends a row whose attributes are all null to each view of
+// an outer join, the row is the last group of the hash table of the view.
+func appendNullRows(bats []*batch.Batch, m *mheap.Mheap) error {
+	for _, bat := range bats {
+		if bat == nil { // the view is spilled
+			continue
+		}
+		row := int64(len(bat.Zs))
+		for i, vec := range bat.Vecs {
+			if vec.Or {
+				v, err := vector.Dup(vec, m)
+				if err != nil {
+					return err
+				}
+				bat.Vecs[i] = v
+			}
+			if err := vector.UnionOne(bat.Vecs[i], join.NullVector(vec.Typ), 0, m); err != nil {
+				return err
+			}
+		}
+		for _, r := range bat.Rs {
+			if err := r.Grow(m); err != nil {
+				return err
+			}
+			r.Fill(row, 0, 1, join.NullVector(r.Type()))
+		}
+		bat.Zs = append(bat.Zs, 1)
+		ht := bat.Ht.(*join.HashTable)
+		ht.Sels = append(ht.Sels, []int64{row})
+	}
+	return nil
+}
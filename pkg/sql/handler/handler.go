@@ -16,8 +16,11 @@ package handler
 
 import (
 	"bytes"
+	"context"
+	"fmt"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/rpcserver"
 	"github.com/matrixorigin/matrixone/pkg/rpcserver/message"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/output"
 	"github.com/matrixorigin/matrixone/pkg/sql/protocol"
@@ -30,39 +33,124 @@ import (
 
 func New(engine engine.Engine, proc *process.Process) *Handler {
 	return &Handler{
-		engine: engine,
-		proc:   proc,
+		engine:  engine,
+		proc:    proc,
+		streams: make(map[streamKey]*stream),
 	}
 }
 
+/*
+Process handles the messages of the streams. The scope of a Request runs
+in its own goroutine, so that the Credit and Cancel messages of the running
+streams are received meanwhile.
+*/
 func (hp *Handler) Process(_ uint64, val interface{}, conn goetty.IOSession) error {
-	ps, _, err := protocol.DecodeScope(val.(*message.Message).Data)
+	msg := val.(*message.Message)
+	key := streamKey{sess: conn.ID(), sid: msg.Sid}
+	switch msg.Type {
+	case rpcserver.Request:
+		ctx, cancel := context.WithCancel(context.Background())
+		st := &stream{
+			key:     key,
+			conn:    conn,
+			ctx:     ctx,
+			cancel:  cancel,
+			credits: rpcserver.NewCredits(),
+		}
+		hp.Lock()
+		if _, ok := hp.streams[key]; ok {
+			hp.Unlock()
+			cancel()
+			return fmt.Errorf("duplicate stream %v", msg.Sid)
+		}
+		hp.streams[key] = st
+		hp.Unlock()
+		// the message is released after Process returns
+		go hp.run(st, append([]byte{}, msg.Data...))
+	case rpcserver.Credit:
+		if st, ok := hp.getStream(key); ok {
+			return st.credits.Grant(msg.Data)
+		}
+	case rpcserver.Cancel:
+		if st, ok := hp.getStream(key); ok {
+			st.cancel()
+		}
+	default:
+		return fmt.Errorf("unexpected message type %v", msg.Type)
+	}
+	return nil
+}
+
+// Closed cancels the streams of the closed session.
+func (hp *Handler) Closed(conn goetty.IOSession) {
+	hp.Lock()
+	defer hp.Unlock()
+	for key, st := range hp.streams {
+		if key.sess == conn.ID() {
+			st.cancel()
+		}
+	}
+}
+
+func (hp *Handler) getStream(key streamKey) (*stream, bool) {
+	hp.Lock()
+	defer hp.Unlock()
+	st, ok := hp.streams[key]
+	return st, ok
+}
+
+func (hp *Handler) run(st *stream, data []byte) {
+	defer func() {
+		hp.Lock()
+		delete(hp.streams, st.key)
+		hp.Unlock()
+		st.cancel()
+	}()
+
+	end := &message.Message{Sid: st.key.sid, Type: rpcserver.End}
+	if err := hp.runScope(st, data); err != nil {
+		end.Code = []byte(err.Error())
+	}
+	st.conn.WriteAndFlush(end)
+}
+
+func (hp *Handler) runScope(st *stream, data []byte) error {
+	ps, _, err := protocol.DecodeScope(data)
 	if err != nil {
 		return err
 	}
-	s := recoverScope(ps, hp.proc)
+	// the pre-scopes stop when the stream is canceled or fails
+	s := recoverScope(st.ctx, ps, hp.proc)
 	s.Instructions[len(s.Instructions)-1] = vm.Instruction{
 		Op: vm.Output,
 		Arg: &output.Argument{
-			Data: conn,
+			Data: st,
 			Func: writeBack,
 		},
 	}
-	if err := s.ParallelRun(hp.engine); err != nil {
-		conn.WriteAndFlush(&message.Message{Code: []byte(err.Error())})
-	}
-	return conn.WriteAndFlush(&message.Message{Sid: 1})
+	return s.ParallelRun(hp.engine)
 }
 
+// writeBack sends the batch compressed when the client grants a credit.
 func writeBack(u interface{}, bat *batch.Batch) error {
 	var buf bytes.Buffer
 
-	conn := u.(goetty.IOSession)
+	st := u.(*stream)
+	if err := st.ctx.Err(); err != nil {
+		return err
+	}
 	if bat == nil || len(bat.Zs) == 0 {
 		return nil
 	}
 	if err := protocol.EncodeBatch(bat, &buf); err != nil {
 		return err
 	}
-	return conn.WriteAndFlush(&message.Message{Data: buf.Bytes()})
+	data, err := rpcserver.Compress(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	if err := st.credits.Acquire(st.ctx); err != nil {
+		return err
+	}
+	return st.conn.WriteAndFlush(&message.Message{Sid: st.key.sid, Type: rpcserver.Data, Data: data})
 }
@@ -27,7 +27,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
-func recoverScope(ps protocol.Scope, proc *process.Process) *compile.Scope {
+// recoverScope rebuilds the scope, the pre-scopes stop when ctx is done.
+func recoverScope(ctx context.Context, ps protocol.Scope, proc *process.Process) *compile.Scope {
 	s := new(compile.Scope)
 	s.Instructions = ps.Ins
 	s.Magic = ps.Magic
@@ -35,7 +36,9 @@ func recoverScope(ps protocol.Scope, proc *process.Process) *compile.Scope {
 	s.NodeInfo.Addr = ps.NodeInfo.Addr
 	s.Proc = process.New(mheap.New(guest.New(proc.Mp.Gm.Limit, proc.Mp.Gm.Mmu)))
 	if len(ps.PreScopes) > 0 {
-		ctx, cancel := context.WithCancel(context.Background())
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithCancel(ctx)
 		s.Proc.Cancel = cancel
 		s.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ps.PreScopes))
 		for i := 0; i < len(ps.PreScopes); i++ {
@@ -56,7 +59,7 @@ func recoverScope(ps protocol.Scope, proc *process.Process) *compile.Scope {
 	s.PreScopes = make([]*compile.Scope, len(ps.PreScopes))
 	for i := range ps.PreScopes {
 		ps.PreScopes[i].Ins = recoverInstructions(ps.PreScopes[i].Ins, s.Proc, s.Proc.Reg.MergeReceivers[i])
-		s.PreScopes[i] = recoverScope(ps.PreScopes[i], s.Proc)
+		s.PreScopes[i] = recoverScope(ctx, ps.PreScopes[i], s.Proc)
 	}
 	return s
 }
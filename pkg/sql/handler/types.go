@@ -15,11 +15,35 @@
 package handler
 
 import (
+	"context"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/rpcserver"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
+
+	"github.com/fagongzi/goetty"
 )
 
 type Handler struct {
+	sync.Mutex
 	engine engine.Engine
 	proc   *process.Process
+	// streams, the scopes running for the remote nodes
+	streams map[streamKey]*stream
+}
+
+// streamKey identifies a stream by the session and the stream id chosen by the client.
+type streamKey struct {
+	sess uint64
+	sid  uint64
+}
+
+// stream is a scope running for a remote node.
+type stream struct {
+	key     streamKey
+	conn    goetty.IOSession
+	ctx     context.Context
+	cancel  context.CancelFunc
+	credits *rpcserver.Credits
 }
@@ -47,6 +47,16 @@ func ParseOne(sql string) (tree.Statement, error) {
 type Lexer struct {
 	scanner *scanner.Scanner
 	stmts   []tree.Statement
+
+	// the tokens read ahead. FULL is a join only if it is followed by
+	// JOIN or OUTER, and an identifier otherwise. INTERVAL followed by a
+	// string without unit is an interval such as interval '1 year'.
+	aheads []aheadToken
+}
+
+type aheadToken struct {
+	typ int
+	str string
 }
 
 func NewLexer(dialectType dialect.DialectType, sql string) *Lexer {
@@ -56,7 +66,17 @@ func NewLexer(dialectType dialect.DialectType, sql string) *Lexer {
 }
 
 func (l *Lexer) Lex(lval *yySymType) int {
-	typ, str := l.scanner.Scan()
+	typ, str := l.scan()
+	switch typ {
+	case FULL:
+		if next := l.peek(0); next == JOIN || next == OUTER {
+			typ = FULL_LA
+		}
+	case INTERVAL:
+		if l.peek(0) == STRING && !isIntervalUnit(l.peek(1)) {
+			typ = INTERVAL_LA
+		}
+	}
 	l.scanner.LastToken = str
 
 	switch typ {
@@ -76,6 +96,33 @@ func (l *Lexer) Lex(lval *yySymType) int {
 	return typ
 }
 
+func (l *Lexer) scan() (int, string) {
+	if len(l.aheads) > 0 {
+		t := l.aheads[0]
+		l.aheads = l.aheads[1:]
+		return t.typ, t.str
+	}
+	return l.scanner.Scan()
+}
+
+// peek returns the type of the i-th token after the current one.
+func (l *Lexer) peek(i int) int {
+	for len(l.aheads) <= i {
+		typ, str := l.scanner.Scan()
+		l.aheads = append(l.aheads, aheadToken{typ: typ, str: str})
+	}
+	return l.aheads[i].typ
+}
+
+// isIntervalUnit returns true if the token is the unit of an interval.
+func isIntervalUnit(typ int) bool {
+	switch typ {
+	case MICROSECOND, SECOND, MINUTE, HOUR, DAY, WEEK, MONTH, QUARTER, YEAR:
+		return true
+	}
+	return false
+}
+
 func (l *Lexer) Error(err string) {
 	l.scanner.LastError = scanner.PositionedErr{Err: err, Pos: l.scanner.Pos + 1, Near: l.scanner.LastToken}
 }
@@ -12,6 +12,7 @@ import (
 
 import (
 	"go/constant"
+	"strconv"
 	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/defines"
@@ -21,404 +22,413 @@ import (
 
 const LEX_ERROR = 57346
 const UNION = 57347
-const SELECT = 57348
-const STREAM = 57349
-const INSERT = 57350
-const UPDATE = 57351
-const DELETE = 57352
-const FROM = 57353
-const WHERE = 57354
-const GROUP = 57355
-const HAVING = 57356
-const ORDER = 57357
-const BY = 57358
-const LIMIT = 57359
-const OFFSET = 57360
-const FOR = 57361
-const ALL = 57362
-const DISTINCT = 57363
-const DISTINCTROW = 57364
-const AS = 57365
-const EXISTS = 57366
-const ASC = 57367
-const DESC = 57368
-const INTO = 57369
-const DUPLICATE = 57370
-const DEFAULT = 57371
-const SET = 57372
-const LOCK = 57373
-const KEYS = 57374
-const VALUES = 57375
-const LAST_INSERT_ID = 57376
-const NEXT = 57377
-const VALUE = 57378
-const SHARE = 57379
-const MODE = 57380
-const SQL_NO_CACHE = 57381
-const SQL_CACHE = 57382
-const JOIN = 57383
-const STRAIGHT_JOIN = 57384
-const LEFT = 57385
-const RIGHT = 57386
-const INNER = 57387
-const OUTER = 57388
-const CROSS = 57389
-const NATURAL = 57390
-const USE = 57391
-const FORCE = 57392
-const ON = 57393
-const USING = 57394
-const SUBQUERY_AS_EXPR = 57395
-const ID = 57396
-const AT_ID = 57397
-const AT_AT_ID = 57398
-const STRING = 57399
-const VALUE_ARG = 57400
-const LIST_ARG = 57401
-const COMMENT = 57402
-const COMMENT_KEYWORD = 57403
-const INTEGRAL = 57404
-const HEX = 57405
-const HEXNUM = 57406
-const BIT_LITERAL = 57407
-const FLOAT = 57408
-const NULL = 57409
-const TRUE = 57410
-const FALSE = 57411
-const EMPTY_FROM_CLAUSE = 57412
-const LOWER_THAN_CHARSET = 57413
-const CHARSET = 57414
-const UNIQUE = 57415
-const KEY = 57416
-const OR = 57417
-const XOR = 57418
-const AND = 57419
-const NOT = 57420
-const BETWEEN = 57421
-const CASE = 57422
-const WHEN = 57423
-const THEN = 57424
-const ELSE = 57425
-const END = 57426
-const LE = 57427
-const GE = 57428
-const NE = 57429
-const NULL_SAFE_EQUAL = 57430
-const IS = 57431
-const LIKE = 57432
-const REGEXP = 57433
-const IN = 57434
-const ASSIGNMENT = 57435
-const SHIFT_LEFT = 57436
-const SHIFT_RIGHT = 57437
-const DIV = 57438
-const MOD = 57439
-const UNARY = 57440
-const COLLATE = 57441
-const BINARY = 57442
-const UNDERSCORE_BINARY = 57443
-const INTERVAL = 57444
-const BEGIN = 57445
-const START = 57446
-const TRANSACTION = 57447
-const COMMIT = 57448
-const ROLLBACK = 57449
-const WORK = 57450
-const CONSISTENT = 57451
-const SNAPSHOT = 57452
-const CHAIN = 57453
-const NO = 57454
-const RELEASE = 57455
-const BIT = 57456
-const TINYINT = 57457
-const SMALLINT = 57458
-const MEDIUMINT = 57459
-const INT = 57460
-const INTEGER = 57461
-const BIGINT = 57462
-const INTNUM = 57463
-const REAL = 57464
-const DOUBLE = 57465
-const FLOAT_TYPE = 57466
-const DECIMAL = 57467
-const NUMERIC = 57468
-const TIME = 57469
-const TIMESTAMP = 57470
-const DATETIME = 57471
-const YEAR = 57472
-const CHAR = 57473
-const VARCHAR = 57474
-const BOOL = 57475
-const CHARACTER = 57476
-const VARBINARY = 57477
-const NCHAR = 57478
-const TEXT = 57479
-const TINYTEXT = 57480
-const MEDIUMTEXT = 57481
-const LONGTEXT = 57482
-const BLOB = 57483
-const TINYBLOB = 57484
-const MEDIUMBLOB = 57485
-const LONGBLOB = 57486
-const JSON = 57487
-const ENUM = 57488
-const GEOMETRY = 57489
-const POINT = 57490
-const LINESTRING = 57491
-const POLYGON = 57492
-const GEOMETRYCOLLECTION = 57493
-const MULTIPOINT = 57494
-const MULTILINESTRING = 57495
-const MULTIPOLYGON = 57496
-const INT1 = 57497
-const INT2 = 57498
-const INT3 = 57499
-const INT4 = 57500
-const INT8 = 57501
-const CREATE = 57502
-const ALTER = 57503
-const DROP = 57504
-const RENAME = 57505
-const ANALYZE = 57506
-const ADD = 57507
-const SCHEMA = 57508
-const TABLE = 57509
-const INDEX = 57510
-const VIEW = 57511
-const TO = 57512
-const IGNORE = 57513
-const IF = 57514
-const PRIMARY = 57515
-const COLUMN = 57516
-const CONSTRAINT = 57517
-const SPATIAL = 57518
-const FULLTEXT = 57519
-const FOREIGN = 57520
-const KEY_BLOCK_SIZE = 57521
-const SHOW = 57522
-const DESCRIBE = 57523
-const EXPLAIN = 57524
-const DATE = 57525
-const ESCAPE = 57526
-const REPAIR = 57527
-const OPTIMIZE = 57528
-const TRUNCATE = 57529
-const MAXVALUE = 57530
-const PARTITION = 57531
-const REORGANIZE = 57532
-const LESS = 57533
-const THAN = 57534
-const PROCEDURE = 57535
-const TRIGGER = 57536
-const STATUS = 57537
-const VARIABLES = 57538
-const ROLE = 57539
-const PROXY = 57540
-const AVG_ROW_LENGTH = 57541
-const STORAGE = 57542
-const DISK = 57543
-const MEMORY = 57544
-const CHECKSUM = 57545
-const COMPRESSION = 57546
-const DATA = 57547
-const DIRECTORY = 57548
-const DELAY_KEY_WRITE = 57549
-const ENCRYPTION = 57550
-const ENGINE = 57551
-const MAX_ROWS = 57552
-const MIN_ROWS = 57553
-const PACK_KEYS = 57554
-const ROW_FORMAT = 57555
-const STATS_AUTO_RECALC = 57556
-const STATS_PERSISTENT = 57557
-const STATS_SAMPLE_PAGES = 57558
-const DYNAMIC = 57559
-const COMPRESSED = 57560
-const REDUNDANT = 57561
-const COMPACT = 57562
-const FIXED = 57563
-const COLUMN_FORMAT = 57564
-const AUTO_RANDOM = 57565
-const RESTRICT = 57566
-const CASCADE = 57567
-const ACTION = 57568
-const PARTIAL = 57569
-const SIMPLE = 57570
-const CHECK = 57571
-const ENFORCED = 57572
-const RANGE = 57573
-const LIST = 57574
-const ALGORITHM = 57575
-const LINEAR = 57576
-const PARTITIONS = 57577
-const SUBPARTITION = 57578
-const SUBPARTITIONS = 57579
-const TYPE = 57580
-const PROPERTIES = 57581
-const PARSER = 57582
-const VISIBLE = 57583
-const INVISIBLE = 57584
-const BTREE = 57585
-const HASH = 57586
-const RTREE = 57587
-const BSI = 57588
-const ZONEMAP = 57589
-const EXPIRE = 57590
-const ACCOUNT = 57591
-const UNLOCK = 57592
-const DAY = 57593
-const NEVER = 57594
-const SECOND = 57595
-const ASCII = 57596
-const COALESCE = 57597
-const COLLATION = 57598
-const HOUR = 57599
-const MICROSECOND = 57600
-const MINUTE = 57601
-const MONTH = 57602
-const QUARTER = 57603
-const REPEAT = 57604
-const REVERSE = 57605
-const ROW_COUNT = 57606
-const WEEK = 57607
-const REVOKE = 57608
-const FUNCTION = 57609
-const PRIVILEGES = 57610
-const TABLESPACE = 57611
-const EXECUTE = 57612
-const SUPER = 57613
-const GRANT = 57614
-const OPTION = 57615
-const REFERENCES = 57616
-const REPLICATION = 57617
-const SLAVE = 57618
-const CLIENT = 57619
-const USAGE = 57620
-const RELOAD = 57621
-const FILE = 57622
-const TEMPORARY = 57623
-const ROUTINE = 57624
-const EVENT = 57625
-const SHUTDOWN = 57626
-const NULLX = 57627
-const AUTO_INCREMENT = 57628
-const APPROXNUM = 57629
-const SIGNED = 57630
-const UNSIGNED = 57631
-const ZEROFILL = 57632
-const USER = 57633
-const IDENTIFIED = 57634
-const CIPHER = 57635
-const ISSUER = 57636
-const X509 = 57637
-const SUBJECT = 57638
-const SAN = 57639
-const REQUIRE = 57640
-const SSL = 57641
-const NONE = 57642
-const PASSWORD = 57643
-const MAX_QUERIES_PER_HOUR = 57644
-const MAX_UPDATES_PER_HOUR = 57645
-const MAX_CONNECTIONS_PER_HOUR = 57646
-const MAX_USER_CONNECTIONS = 57647
-const FORMAT = 57648
-const CONNECTION = 57649
-const LOAD = 57650
-const INFILE = 57651
-const TERMINATED = 57652
-const OPTIONALLY = 57653
-const ENCLOSED = 57654
-const ESCAPED = 57655
-const STARTING = 57656
-const LINES = 57657
-const DATABASES = 57658
-const TABLES = 57659
-const EXTENDED = 57660
-const FULL = 57661
-const PROCESSLIST = 57662
-const FIELDS = 57663
-const COLUMNS = 57664
-const OPEN = 57665
-const ERRORS = 57666
-const WARNINGS = 57667
-const INDEXES = 57668
-const NAMES = 57669
-const GLOBAL = 57670
-const SESSION = 57671
-const ISOLATION = 57672
-const LEVEL = 57673
-const READ = 57674
-const WRITE = 57675
-const ONLY = 57676
-const REPEATABLE = 57677
-const COMMITTED = 57678
-const UNCOMMITTED = 57679
-const SERIALIZABLE = 57680
-const LOCAL = 57681
-const EXCEPT = 57682
-const CURRENT_TIMESTAMP = 57683
-const DATABASE = 57684
-const CURRENT_TIME = 57685
-const LOCALTIME = 57686
-const LOCALTIMESTAMP = 57687
-const UTC_DATE = 57688
-const UTC_TIME = 57689
-const UTC_TIMESTAMP = 57690
-const REPLACE = 57691
-const CONVERT = 57692
-const SEPARATOR = 57693
-const CURRENT_DATE = 57694
-const CURRENT_USER = 57695
-const CURRENT_ROLE = 57696
-const MATCH = 57697
-const AGAINST = 57698
-const BOOLEAN = 57699
-const LANGUAGE = 57700
-const WITH = 57701
-const QUERY = 57702
-const EXPANSION = 57703
-const ADDDATE = 57704
-const BIT_AND = 57705
-const BIT_OR = 57706
-const BIT_XOR = 57707
-const CAST = 57708
-const COUNT = 57709
-const APPROX_COUNT_DISTINCT = 57710
-const APPROX_PERCENTILE = 57711
-const CURDATE = 57712
-const CURTIME = 57713
-const DATE_ADD = 57714
-const DATE_SUB = 57715
-const EXTRACT = 57716
-const GROUP_CONCAT = 57717
-const MAX = 57718
-const MID = 57719
-const MIN = 57720
-const NOW = 57721
-const POSITION = 57722
-const SESSION_USER = 57723
-const STD = 57724
-const STDDEV = 57725
-const STDDEV_POP = 57726
-const STDDEV_SAMP = 57727
-const SUBDATE = 57728
-const SUBSTR = 57729
-const SUBSTRING = 57730
-const SUM = 57731
-const SYSDATE = 57732
-const SYSTEM_USER = 57733
-const TRANSLATE = 57734
-const TRIM = 57735
-const VARIANCE = 57736
-const VAR_POP = 57737
-const VAR_SAMP = 57738
-const AVG = 57739
-const ROW = 57740
-const OUTFILE = 57741
-const HEADER = 57742
-const MAX_FILE_SIZE = 57743
-const FORCE_QUOTE = 57744
-const UNUSED = 57745
+const INTERSECT = 57348
+const EXCEPT = 57349
+const SELECT = 57350
+const STREAM = 57351
+const INSERT = 57352
+const UPDATE = 57353
+const DELETE = 57354
+const FROM = 57355
+const WHERE = 57356
+const GROUP = 57357
+const HAVING = 57358
+const ORDER = 57359
+const BY = 57360
+const LIMIT = 57361
+const OFFSET = 57362
+const FOR = 57363
+const ALL = 57364
+const DISTINCT = 57365
+const DISTINCTROW = 57366
+const AS = 57367
+const EXISTS = 57368
+const ASC = 57369
+const DESC = 57370
+const INTO = 57371
+const DUPLICATE = 57372
+const DEFAULT = 57373
+const SET = 57374
+const LOCK = 57375
+const KEYS = 57376
+const VALUES = 57377
+const LAST_INSERT_ID = 57378
+const NEXT = 57379
+const VALUE = 57380
+const SHARE = 57381
+const MODE = 57382
+const SQL_NO_CACHE = 57383
+const SQL_CACHE = 57384
+const JOIN = 57385
+const STRAIGHT_JOIN = 57386
+const LEFT = 57387
+const RIGHT = 57388
+const INNER = 57389
+const OUTER = 57390
+const CROSS = 57391
+const NATURAL = 57392
+const USE = 57393
+const FORCE = 57394
+const FULL_LA = 57395
+const INTERVAL_LA = 57396
+const ON = 57397
+const USING = 57398
+const SUBQUERY_AS_EXPR = 57399
+const ID = 57400
+const AT_ID = 57401
+const AT_AT_ID = 57402
+const STRING = 57403
+const VALUE_ARG = 57404
+const LIST_ARG = 57405
+const COMMENT = 57406
+const COMMENT_KEYWORD = 57407
+const INTEGRAL = 57408
+const HEX = 57409
+const HEXNUM = 57410
+const BIT_LITERAL = 57411
+const FLOAT = 57412
+const NULL = 57413
+const TRUE = 57414
+const FALSE = 57415
+const EMPTY_FROM_CLAUSE = 57416
+const LOWER_THAN_CHARSET = 57417
+const CHARSET = 57418
+const UNIQUE = 57419
+const KEY = 57420
+const OR = 57421
+const XOR = 57422
+const AND = 57423
+const NOT = 57424
+const BETWEEN = 57425
+const CASE = 57426
+const WHEN = 57427
+const THEN = 57428
+const ELSE = 57429
+const END = 57430
+const LE = 57431
+const GE = 57432
+const NE = 57433
+const NULL_SAFE_EQUAL = 57434
+const IS = 57435
+const LIKE = 57436
+const REGEXP = 57437
+const IN = 57438
+const ASSIGNMENT = 57439
+const SHIFT_LEFT = 57440
+const SHIFT_RIGHT = 57441
+const DIV = 57442
+const MOD = 57443
+const UNARY = 57444
+const COLLATE = 57445
+const BINARY = 57446
+const UNDERSCORE_BINARY = 57447
+const INTERVAL = 57448
+const BEGIN = 57449
+const START = 57450
+const TRANSACTION = 57451
+const COMMIT = 57452
+const ROLLBACK = 57453
+const WORK = 57454
+const CONSISTENT = 57455
+const SNAPSHOT = 57456
+const CHAIN = 57457
+const NO = 57458
+const RELEASE = 57459
+const BIT = 57460
+const TINYINT = 57461
+const SMALLINT = 57462
+const MEDIUMINT = 57463
+const INT = 57464
+const INTEGER = 57465
+const BIGINT = 57466
+const INTNUM = 57467
+const REAL = 57468
+const DOUBLE = 57469
+const FLOAT_TYPE = 57470
+const DECIMAL = 57471
+const NUMERIC = 57472
+const TIME = 57473
+const TIMESTAMP = 57474
+const DATETIME = 57475
+const YEAR = 57476
+const CHAR = 57477
+const VARCHAR = 57478
+const BOOL = 57479
+const CHARACTER = 57480
+const VARBINARY = 57481
+const NCHAR = 57482
+const TEXT = 57483
+const TINYTEXT = 57484
+const MEDIUMTEXT = 57485
+const LONGTEXT = 57486
+const BLOB = 57487
+const TINYBLOB = 57488
+const MEDIUMBLOB = 57489
+const LONGBLOB = 57490
+const JSON = 57491
+const ENUM = 57492
+const GEOMETRY = 57493
+const POINT = 57494
+const LINESTRING = 57495
+const POLYGON = 57496
+const GEOMETRYCOLLECTION = 57497
+const MULTIPOINT = 57498
+const MULTILINESTRING = 57499
+const MULTIPOLYGON = 57500
+const INT1 = 57501
+const INT2 = 57502
+const INT3 = 57503
+const INT4 = 57504
+const INT8 = 57505
+const CREATE = 57506
+const ALTER = 57507
+const DROP = 57508
+const RENAME = 57509
+const ANALYZE = 57510
+const ADD = 57511
+const SCHEMA = 57512
+const TABLE = 57513
+const INDEX = 57514
+const VIEW = 57515
+const TO = 57516
+const IGNORE = 57517
+const IF = 57518
+const PRIMARY = 57519
+const COLUMN = 57520
+const CONSTRAINT = 57521
+const SPATIAL = 57522
+const FULLTEXT = 57523
+const FOREIGN = 57524
+const KEY_BLOCK_SIZE = 57525
+const SHOW = 57526
+const DESCRIBE = 57527
+const EXPLAIN = 57528
+const DATE = 57529
+const ESCAPE = 57530
+const REPAIR = 57531
+const OPTIMIZE = 57532
+const TRUNCATE = 57533
+const MAXVALUE = 57534
+const PARTITION = 57535
+const REORGANIZE = 57536
+const LESS = 57537
+const THAN = 57538
+const PROCEDURE = 57539
+const TRIGGER = 57540
+const STATUS = 57541
+const VARIABLES = 57542
+const ROLE = 57543
+const PROXY = 57544
+const AVG_ROW_LENGTH = 57545
+const STORAGE = 57546
+const DISK = 57547
+const MEMORY = 57548
+const CHECKSUM = 57549
+const COMPRESSION = 57550
+const DATA = 57551
+const DIRECTORY = 57552
+const DELAY_KEY_WRITE = 57553
+const ENCRYPTION = 57554
+const ENGINE = 57555
+const MAX_ROWS = 57556
+const MIN_ROWS = 57557
+const PACK_KEYS = 57558
+const ROW_FORMAT = 57559
+const STATS_AUTO_RECALC = 57560
+const STATS_PERSISTENT = 57561
+const STATS_SAMPLE_PAGES = 57562
+const DYNAMIC = 57563
+const COMPRESSED = 57564
+const REDUNDANT = 57565
+const COMPACT = 57566
+const FIXED = 57567
+const COLUMN_FORMAT = 57568
+const AUTO_RANDOM = 57569
+const RESTRICT = 57570
+const CASCADE = 57571
+const ACTION = 57572
+const PARTIAL = 57573
+const SIMPLE = 57574
+const CHECK = 57575
+const ENFORCED = 57576
+const RANGE = 57577
+const LIST = 57578
+const ALGORITHM = 57579
+const LINEAR = 57580
+const PARTITIONS = 57581
+const SUBPARTITION = 57582
+const SUBPARTITIONS = 57583
+const TYPE = 57584
+const PROPERTIES = 57585
+const PARSER = 57586
+const VISIBLE = 57587
+const INVISIBLE = 57588
+const BTREE = 57589
+const HASH = 57590
+const RTREE = 57591
+const BSI = 57592
+const ZONEMAP = 57593
+const EXPIRE = 57594
+const ACCOUNT = 57595
+const UNLOCK = 57596
+const DAY = 57597
+const NEVER = 57598
+const SECOND = 57599
+const ASCII = 57600
+const COALESCE = 57601
+const COLLATION = 57602
+const HOUR = 57603
+const MICROSECOND = 57604
+const MINUTE = 57605
+const MONTH = 57606
+const QUARTER = 57607
+const REPEAT = 57608
+const REVERSE = 57609
+const ROW_COUNT = 57610
+const WEEK = 57611
+const REVOKE = 57612
+const FUNCTION = 57613
+const PRIVILEGES = 57614
+const TABLESPACE = 57615
+const EXECUTE = 57616
+const SUPER = 57617
+const GRANT = 57618
+const OPTION = 57619
+const REFERENCES = 57620
+const REPLICATION = 57621
+const SLAVE = 57622
+const CLIENT = 57623
+const USAGE = 57624
+const RELOAD = 57625
+const FILE = 57626
+const TEMPORARY = 57627
+const ROUTINE = 57628
+const EVENT = 57629
+const SHUTDOWN = 57630
+const NULLX = 57631
+const AUTO_INCREMENT = 57632
+const APPROXNUM = 57633
+const SIGNED = 57634
+const UNSIGNED = 57635
+const ZEROFILL = 57636
+const USER = 57637
+const IDENTIFIED = 57638
+const CIPHER = 57639
+const ISSUER = 57640
+const X509 = 57641
+const SUBJECT = 57642
+const SAN = 57643
+const REQUIRE = 57644
+const SSL = 57645
+const NONE = 57646
+const PASSWORD = 57647
+const MAX_QUERIES_PER_HOUR = 57648
+const MAX_UPDATES_PER_HOUR = 57649
+const MAX_CONNECTIONS_PER_HOUR = 57650
+const MAX_USER_CONNECTIONS = 57651
+const FORMAT = 57652
+const CONNECTION = 57653
+const LOAD = 57654
+const INFILE = 57655
+const TERMINATED = 57656
+const OPTIONALLY = 57657
+const ENCLOSED = 57658
+const ESCAPED = 57659
+const STARTING = 57660
+const LINES = 57661
+const DATABASES = 57662
+const TABLES = 57663
+const EXTENDED = 57664
+const FULL = 57665
+const PROCESSLIST = 57666
+const FIELDS = 57667
+const COLUMNS = 57668
+const OPEN = 57669
+const ERRORS = 57670
+const WARNINGS = 57671
+const INDEXES = 57672
+const NAMES = 57673
+const GLOBAL = 57674
+const SESSION = 57675
+const ISOLATION = 57676
+const LEVEL = 57677
+const READ = 57678
+const WRITE = 57679
+const ONLY = 57680
+const REPEATABLE = 57681
+const COMMITTED = 57682
+const UNCOMMITTED = 57683
+const SERIALIZABLE = 57684
+const LOCAL = 57685
+const CURRENT_TIMESTAMP = 57686
+const DATABASE = 57687
+const CURRENT_TIME = 57688
+const LOCALTIME = 57689
+const LOCALTIMESTAMP = 57690
+const UTC_DATE = 57691
+const UTC_TIME = 57692
+const UTC_TIMESTAMP = 57693
+const REPLACE = 57694
+const CONVERT = 57695
+const SEPARATOR = 57696
+const CURRENT_DATE = 57697
+const CURRENT_USER = 57698
+const CURRENT_ROLE = 57699
+const MATCH = 57700
+const AGAINST = 57701
+const BOOLEAN = 57702
+const LANGUAGE = 57703
+const WITH = 57704
+const QUERY = 57705
+const EXPANSION = 57706
+const ADDDATE = 57707
+const BIT_AND = 57708
+const BIT_OR = 57709
+const BIT_XOR = 57710
+const CAST = 57711
+const COUNT = 57712
+const APPROX_COUNT_DISTINCT = 57713
+const APPROX_PERCENTILE = 57714
+const CURDATE = 57715
+const CURTIME = 57716
+const DATE_ADD = 57717
+const DATE_SUB = 57718
+const EXTRACT = 57719
+const GROUP_CONCAT = 57720
+const MAX = 57721
+const MID = 57722
+const MIN = 57723
+const NOW = 57724
+const POSITION = 57725
+const SESSION_USER = 57726
+const STD = 57727
+const STDDEV = 57728
+const STDDEV_POP = 57729
+const STDDEV_SAMP = 57730
+const SUBDATE = 57731
+const SUBSTR = 57732
+const SUBSTRING = 57733
+const SUM = 57734
+const SYSDATE = 57735
+const SYSTEM_USER = 57736
+const TRANSLATE = 57737
+const TRIM = 57738
+const VARIANCE = 57739
+const VAR_POP = 57740
+const VAR_SAMP = 57741
+const AVG = 57742
+const ROW = 57743
+const OUTFILE = 57744
+const HEADER = 57745
+const MAX_FILE_SIZE = 57746
+const FORCE_QUOTE = 57747
+const OVER = 57748
+const ROWS = 57749
+const CURRENT = 57750
+const UNBOUNDED = 57751
+const PRECEDING = 57752
+const FOLLOWING = 57753
+const UNUSED = 57754
 
 var yyToknames = [...]string{
 	"$end",
@@ -426,6 +436,8 @@ var yyToknames = [...]string{
 	"$unk",
 	"LEX_ERROR",
 	"UNION",
+	"INTERSECT",
+	"EXCEPT",
 	"SELECT",
 	"STREAM",
 	"INSERT",
@@ -471,6 +483,8 @@ var yyToknames = [...]string{
 	"NATURAL",
 	"USE",
 	"FORCE",
+	"FULL_LA",
+	"INTERVAL_LA",
 	"ON",
 	"USING",
 	"SUBQUERY_AS_EXPR",
@@ -777,7 +791,6 @@ var yyToknames = [...]string{
 	"UNCOMMITTED",
 	"SERIALIZABLE",
 	"LOCAL",
-	"EXCEPT",
 	"CURRENT_TIMESTAMP",
 	"DATABASE",
 	"CURRENT_TIME",
@@ -840,6 +853,12 @@ var yyToknames = [...]string{
 	"HEADER",
 	"MAX_FILE_SIZE",
 	"FORCE_QUOTE",
+	"OVER",
+	"ROWS",
+	"CURRENT",
+	"UNBOUNDED",
+	"PRECEDING",
+	"FOLLOWING",
 	"UNUSED",
 	"';'",
 	"'@'",
@@ -851,1749 +870,1786 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line mysql_sql.y:5962
+//line mysql_sql.y:6353
 
 //line yacctab:1
 var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 52,
-	17, 334,
-	-2, 308,
-	-1, 56,
-	185, 471,
-	-2, 507,
-	-1, 65,
-	212, 234,
-	213, 234,
-	-2, 254,
-	-1, 307,
-	58, 1217,
-	422, 1217,
-	-2, 92,
-	-1, 326,
-	58, 634,
-	422, 634,
-	-2, 469,
-	-1, 327,
-	58, 462,
-	422, 462,
-	-2, 470,
-	-1, 334,
-	17, 335,
-	-2, 308,
-	-1, 567,
-	54, 752,
-	-2, 1258,
-	-1, 568,
-	54, 753,
-	-2, 1259,
-	-1, 569,
-	54, 754,
-	-2, 1260,
-	-1, 578,
-	54, 813,
-	-2, 1222,
-	-1, 579,
-	54, 815,
-	-2, 1233,
-	-1, 720,
-	1, 497,
-	421, 497,
-	-2, 504,
-	-1, 832,
-	17, 334,
-	-2, 692,
-	-1, 874,
-	119, 937,
-	-2, 935,
-	-1, 876,
-	119, 416,
-	-2, 932,
-	-1, 877,
-	119, 417,
-	-2, 933,
-	-1, 1068,
-	1, 498,
-	421, 498,
-	-2, 504,
-	-1, 1452,
-	1, 544,
-	206, 544,
-	421, 544,
-	-2, 504,
+	-1, 53,
+	19, 350,
+	-2, 324,
+	-1, 58,
+	189, 499,
+	-2, 535,
+	-1, 67,
+	216, 250,
+	217, 250,
+	-2, 270,
+	-1, 313,
+	62, 1287,
+	431, 1287,
+	-2, 94,
+	-1, 332,
+	62, 662,
+	431, 662,
+	-2, 497,
+	-1, 333,
+	62, 490,
+	431, 490,
+	-2, 498,
+	-1, 340,
+	19, 351,
+	-2, 324,
+	-1, 585,
+	58, 795,
+	-2, 1329,
+	-1, 586,
+	58, 796,
+	-2, 1330,
+	-1, 587,
+	58, 797,
+	-2, 1331,
+	-1, 596,
+	58, 859,
+	-2, 1293,
+	-1, 597,
+	58, 861,
+	-2, 1304,
+	-1, 744,
+	1, 525,
+	430, 525,
+	-2, 532,
+	-1, 864,
+	19, 350,
+	-2, 720,
+	-1, 912,
+	123, 1002,
+	-2, 1000,
+	-1, 914,
+	123, 442,
+	-2, 997,
+	-1, 915,
+	123, 443,
+	-2, 998,
+	-1, 1112,
+	1, 526,
+	430, 526,
+	-2, 532,
 	-1, 1454,
-	246, 659,
-	-2, 640,
-	-1, 1555,
-	1, 545,
-	206, 545,
-	421, 545,
-	-2, 504,
-	-1, 1583,
-	246, 659,
-	-2, 641,
-	-1, 1957,
-	55, 519,
-	56, 519,
-	-2, 504,
-	-1, 1961,
-	55, 519,
-	56, 519,
-	-2, 504,
-	-1, 1973,
-	55, 523,
-	56, 523,
-	-2, 504,
-	-1, 1976,
-	55, 524,
-	56, 524,
-	-2, 504,
+	250, 687,
+	-2, 668,
+	-1, 1587,
+	1, 572,
+	210, 572,
+	430, 572,
+	-2, 532,
+	-1, 1600,
+	250, 687,
+	-2, 669,
+	-1, 1698,
+	1, 573,
+	210, 573,
+	430, 573,
+	-2, 532,
+	-1, 2088,
+	59, 547,
+	60, 547,
+	-2, 532,
+	-1, 2093,
+	59, 547,
+	60, 547,
+	-2, 532,
+	-1, 2105,
+	59, 551,
+	60, 551,
+	-2, 532,
+	-1, 2108,
+	59, 552,
+	60, 552,
+	-2, 532,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 16245
+const yyLast = 16609
 
 var yyAct = [...]int{
-	711, 1116, 1963, 1961, 1960, 1968, 1934, 582, 1908, 1552,
-	701, 580, 1117, 1807, 599, 1880, 1923, 1595, 1864, 1781,
-	529, 1865, 1759, 1437, 81, 1718, 495, 283, 770, 1550,
-	1331, 1058, 527, 1710, 1769, 294, 84, 1551, 435, 1617,
-	81, 296, 1690, 1358, 1584, 1447, 385, 1517, 1249, 328,
-	328, 1616, 482, 1518, 1354, 1325, 1520, 1529, 757, 80,
-	1374, 556, 1525, 1359, 1224, 1499, 1336, 1391, 1363, 1390,
-	1061, 856, 386, 1284, 1025, 289, 662, 537, 865, 871,
-	81, 874, 499, 866, 581, 287, 19, 695, 857, 591,
-	1218, 51, 725, 1152, 670, 750, 1559, 1069, 714, 1115,
-	549, 698, 281, 608, 52, 335, 754, 726, 1039, 1031,
-	278, 298, 696, 334, 1118, 410, 437, 772, 727, 803,
-	333, 378, 687, 520, 299, 77, 423, 300, 1546, 1046,
-	52, 452, 1433, 1330, 478, 859, 1201, 290, 1475, 506,
-	75, 1042, 1348, 1326, 379, 1219, 1824, 1208, 744, 472,
-	303, 303, 502, 1799, 355, 739, 740, 1852, 1056, 1850,
-	365, 330, 538, 19, 729, 395, 507, 400, 399, 494,
-	496, 497, 493, 496, 497, 704, 467, 396, 392, 463,
-	1884, 52, 1868, 1869, 1708, 347, 394, 1711, 1712, 1713,
-	1714, 1214, 1789, 1215, 1792, 1216, 1549, 398, 1332, 708,
-	1337, 1338, 1339, 1340, 1187, 751, 504, 415, 1227, 1225,
-	1222, 1226, 1228, 1378, 1221, 1220, 1227, 1225, 1042, 1226,
-	1228, 1375, 458, 1044, 1463, 366, 1689, 1604, 1603, 454,
-	465, 466, 1600, 1543, 464, 1430, 453, 1701, 1508, 1482,
-	1486, 1488, 1490, 1492, 1493, 1495, 1512, 1402, 1400, 1401,
-	459, 688, 1477, 1478, 1479, 1480, 1461, 1462, 1483, 1847,
-	1464, 1511, 1465, 1466, 1467, 1468, 1469, 1470, 1471, 1472,
-	1473, 1474, 1481, 1377, 1867, 1695, 1953, 690, 81, 414,
-	1485, 1487, 1489, 1491, 1494, 1798, 1809, 1969, 413, 81,
-	1890, 397, 349, 1770, 1771, 1772, 1774, 1773, 781, 782,
-	780, 1849, 346, 345, 1230, 1231, 1232, 1233, 1476, 1805,
-	1806, 1684, 1809, 362, 1897, 439, 1854, 1832, 1783, 1944,
-	1653, 1652, 456, 341, 419, 1209, 462, 503, 332, 389,
-	1926, 440, 1392, 1815, 457, 460, 1856, 1857, 516, 461,
-	1679, 1970, 401, 1964, 455, 1675, 1935, 1801, 1802, 1641,
-	1509, 689, 1285, 412, 409, 1402, 1400, 1401, 492, 491,
-	1397, 483, 1396, 1395, 1393, 505, 1787, 449, 1205, 1236,
-	1092, 1050, 1367, 485, 1431, 328, 487, 288, 1247, 1341,
-	1088, 386, 386, 386, 370, 417, 52, 444, 389, 510,
-	1527, 1526, 1090, 1089, 484, 742, 486, 508, 509, 445,
-	743, 1087, 391, 552, 741, 1238, 368, 350, 367, 1948,
-	1912, 1328, 661, 1257, 817, 551, 1394, 340, 532, 667,
-	1199, 414, 81, 81, 81, 81, 1198, 1186, 1647, 1927,
-	671, 1318, 1930, 372, 371, 1744, 1180, 1082, 1054, 1024,
-	477, 496, 497, 496, 497, 441, 442, 443, 530, 328,
-	328, 414, 328, 439, 785, 473, 664, 439, 359, 488,
-	702, 391, 1326, 752, 1238, 1800, 360, 534, 348, 440,
-	328, 328, 418, 440, 1507, 685, 303, 476, 1063, 1237,
-	1368, 411, 1484, 328, 1782, 328, 515, 720, 710, 81,
-	1167, 764, 715, 1045, 657, 451, 1202, 540, 474, 469,
-	1855, 1510, 489, 734, 531, 328, 719, 526, 500, 1921,
-	1349, 498, 52, 501, 1120, 1119, 1819, 328, 386, 1320,
-	328, 1398, 1399, 1041, 722, 523, 524, 525, 732, 1680,
-	1681, 1182, 521, 758, 539, 765, 1364, 1367, 1094, 758,
-	721, 1924, 1925, 522, 328, 328, 769, 81, 1029, 684,
-	1677, 303, 783, 703, 1676, 519, 735, 706, 683, 672,
-	673, 674, 675, 416, 782, 780, 786, 717, 773, 1319,
-	716, 1670, 691, 1040, 723, 724, 730, 700, 441, 442,
-	443, 1449, 771, 707, 774, 731, 303, 834, 1112, 780,
-	490, 705, 736, 1686, 1685, 709, 718, 3, 833, 1113,
-	1503, 1125, 1498, 728, 543, 544, 545, 546, 547, 1227,
-	1225, 336, 1226, 1228, 357, 1258, 358, 365, 303, 369,
-	841, 356, 354, 353, 361, 518, 363, 364, 748, 1861,
-	1755, 767, 753, 763, 533, 1959, 1940, 1450, 1438, 749,
-	1745, 1747, 1748, 1749, 1746, 1368, 303, 760, 761, 762,
-	1361, 781, 782, 780, 1362, 1365, 768, 1753, 766, 863,
-	863, 868, 441, 442, 443, 530, 1754, 1579, 528, 1026,
-	1885, 835, 836, 837, 838, 407, 870, 395, 1053, 1059,
-	1060, 1891, 839, 1128, 393, 876, 781, 782, 780, 832,
-	373, 1071, 1130, 1752, 811, 1887, 441, 442, 443, 530,
-	1943, 877, 1837, 1159, 854, 1785, 1366, 818, 819, 820,
-	821, 822, 823, 824, 817, 1052, 1962, 1157, 1158, 1156,
-	1751, 531, 81, 1784, 1761, 1289, 1561, 1860, 1288, 283,
-	1741, 846, 781, 782, 780, 1264, 1084, 1721, 781, 782,
-	780, 1942, 862, 286, 12, 328, 1027, 773, 284, 6,
-	395, 781, 782, 780, 1739, 531, 1750, 1072, 1738, 781,
-	782, 780, 396, 774, 1737, 328, 1740, 1734, 869, 1023,
-	52, 1728, 1725, 758, 758, 758, 394, 552, 1724, 81,
-	1631, 875, 285, 5, 1036, 1109, 1110, 1700, 1630, 551,
-	781, 782, 780, 1106, 1107, 1108, 1760, 1629, 1628, 1073,
-	1074, 1075, 1625, 1126, 1127, 1547, 1443, 1085, 1076, 781,
-	782, 780, 1123, 1442, 1049, 1441, 1440, 1070, 1313, 665,
-	1078, 12, 1080, 1846, 1587, 1826, 6, 1140, 1141, 1142,
-	1143, 1144, 1145, 1146, 1147, 1148, 1149, 1150, 1151, 1079,
-	728, 854, 1161, 1162, 1077, 1813, 303, 1565, 1812, 1170,
-	1114, 1081, 1102, 1165, 1105, 1973, 1091, 1421, 1569, 1590,
-	5, 441, 442, 443, 1172, 1585, 1099, 1095, 1096, 1097,
-	1742, 1598, 1599, 1735, 1731, 1730, 1586, 1103, 1558, 781,
-	782, 780, 1560, 1562, 1564, 1941, 1566, 1567, 1568, 1570,
-	1571, 1572, 1574, 1575, 1576, 1577, 815, 825, 826, 818,
-	819, 820, 821, 822, 823, 824, 817, 1729, 1134, 1135,
-	1591, 1951, 1121, 1122, 1160, 1124, 1691, 1154, 1580, 1672,
-	1131, 1132, 1133, 1250, 1548, 1136, 1451, 1137, 1138, 1139,
-	816, 815, 825, 826, 818, 819, 820, 821, 822, 823,
-	824, 817, 1168, 1436, 1434, 1346, 1345, 1185, 1578, 1344,
-	1343, 1171, 1051, 1173, 825, 826, 818, 819, 820, 821,
-	822, 823, 824, 817, 850, 1557, 849, 848, 1918, 712,
-	1174, 666, 828, 1292, 831, 1834, 1260, 1291, 1260, 1978,
-	1573, 339, 1972, 1971, 1833, 1597, 1563, 1360, 829, 830,
-	827, 338, 816, 815, 825, 826, 818, 819, 820, 821,
-	822, 823, 824, 817, 820, 821, 822, 823, 824, 817,
-	1048, 1954, 1593, 816, 815, 825, 826, 818, 819, 820,
-	821, 822, 823, 824, 817, 1188, 1916, 1950, 1949, 414,
-	1416, 1820, 542, 1703, 1592, 1594, 1048, 1938, 671, 1410,
-	1048, 1937, 1193, 328, 1702, 1194, 328, 1537, 1196, 414,
-	1536, 328, 781, 782, 780, 1212, 1911, 1910, 1204, 1538,
-	1535, 781, 782, 780, 1516, 1210, 1211, 1637, 1875, 1452,
-	715, 816, 815, 825, 826, 818, 819, 820, 821, 822,
-	823, 824, 817, 1244, 1637, 1870, 1600, 789, 790, 791,
-	792, 793, 794, 328, 787, 1422, 1409, 1379, 1588, 1101,
-	1858, 81, 81, 1295, 816, 815, 825, 826, 818, 819,
-	820, 821, 822, 823, 824, 817, 1293, 1235, 781, 782,
-	780, 1637, 1830, 1192, 1290, 76, 1265, 23, 39, 24,
-	1274, 1261, 1273, 1191, 1262, 1263, 1252, 1253, 1206, 1637,
-	1829, 394, 1637, 1828, 1270, 1271, 1272, 1203, 1269, 1275,
-	1276, 1277, 1278, 1240, 1266, 1200, 1217, 1279, 1637, 1827,
-	1259, 1241, 1246, 1242, 778, 1070, 1234, 1818, 1817, 1169,
-	1282, 1283, 686, 73, 1243, 1929, 1245, 1287, 541, 863,
-	1248, 1305, 863, 1796, 1795, 1308, 663, 1296, 1413, 758,
-	1251, 1314, 1766, 1767, 1704, 758, 1026, 468, 328, 1766,
-	1765, 447, 328, 328, 1408, 1260, 328, 1311, 776, 816,
-	815, 825, 826, 818, 819, 820, 821, 822, 823, 824,
-	817, 1407, 1175, 1312, 1706, 1705, 781, 782, 780, 1028,
-	81, 1406, 1637, 1636, 1453, 1300, 1190, 1425, 1260, 1411,
-	414, 1307, 1281, 781, 782, 780, 1154, 395, 448, 1357,
-	1280, 1304, 1405, 781, 782, 780, 1404, 81, 1384, 832,
-	1306, 1042, 1303, 1302, 1297, 1347, 1309, 1389, 1310, 1315,
-	1316, 1974, 1388, 1386, 781, 782, 780, 1387, 781, 782,
-	780, 52, 1260, 1403, 1423, 1317, 1163, 1294, 1342, 781,
-	782, 780, 449, 1324, 781, 782, 780, 1260, 1268, 781,
-	782, 780, 1256, 1321, 1323, 449, 1420, 1181, 781, 782,
-	780, 76, 1164, 23, 39, 24, 1260, 1267, 1369, 1370,
-	1190, 1189, 1418, 328, 1022, 1419, 1371, 1184, 1183, 1384,
-	1178, 1177, 1383, 816, 815, 825, 826, 818, 819, 820,
-	821, 822, 823, 824, 817, 1048, 1047, 1101, 1415, 76,
-	446, 76, 1057, 1579, 447, 1412, 663, 76, 1417, 73,
-	1920, 1497, 517, 1914, 1898, 1895, 1893, 1836, 1779, 1764,
-	1414, 1448, 1762, 1424, 1757, 659, 1301, 1071, 656, 1350,
-	1351, 1446, 1698, 1697, 1515, 425, 428, 429, 430, 426,
-	1696, 427, 431, 1693, 1514, 1429, 420, 73, 1038, 658,
-	1683, 1668, 1439, 1642, 1519, 73, 1444, 425, 428, 429,
-	430, 426, 1561, 427, 431, 1634, 1501, 1611, 1610, 1521,
-	1694, 1530, 1496, 1532, 1426, 1460, 1504, 1445, 328, 328,
-	1502, 1500, 81, 1500, 1506, 1155, 758, 1239, 1195, 1958,
-	1176, 1093, 1086, 1522, 1523, 1524, 414, 855, 1505, 853,
-	852, 851, 847, 804, 414, 1556, 844, 842, 840, 73,
-	1533, 814, 1528, 1357, 813, 1286, 812, 1544, 810, 809,
-	425, 428, 429, 430, 426, 1534, 427, 431, 808, 807,
-	806, 805, 1539, 1066, 802, 1542, 816, 815, 825, 826,
-	818, 819, 820, 821, 822, 823, 824, 817, 1601, 1618,
-	1620, 801, 1618, 1618, 800, 799, 798, 1605, 1581, 797,
-	796, 1608, 1609, 795, 668, 660, 1607, 1624, 1606, 450,
-	1032, 1033, 1903, 1901, 1866, 1612, 1613, 1614, 1615, 1540,
-	1541, 1229, 680, 1565, 1100, 1035, 470, 681, 678, 1619,
-	1037, 677, 676, 679, 1569, 297, 682, 1179, 429, 430,
-	1877, 535, 536, 1621, 1622, 1071, 1623, 1327, 1643, 1059,
-	1060, 1064, 1627, 337, 1558, 738, 1639, 433, 1560, 1562,
-	1564, 1633, 1566, 1567, 1568, 1570, 1571, 1572, 1574, 1575,
-	1576, 1577, 816, 815, 825, 826, 818, 819, 820, 821,
-	822, 823, 824, 817, 475, 329, 1427, 339, 1915, 1671,
-	1638, 81, 1841, 1428, 1580, 1839, 1646, 338, 403, 405,
-	406, 338, 1448, 1120, 1119, 480, 481, 1794, 1793, 337,
-	1791, 1722, 1635, 1513, 1620, 1435, 1382, 1601, 1669, 1334,
-	1333, 339, 479, 1381, 1578, 1716, 1687, 1673, 414, 1197,
-	1255, 338, 663, 1905, 1904, 1723, 277, 1904, 1905, 432,
-	351, 1557, 1, 858, 1692, 864, 1758, 1876, 1907, 1717,
-	1835, 1879, 598, 1699, 583, 1786, 1573, 1756, 1213, 1707,
-	1720, 1788, 1563, 1709, 1055, 1719, 1632, 439, 1207, 471,
-	1298, 1299, 620, 610, 843, 611, 655, 404, 609, 1626,
-	1376, 344, 402, 440, 414, 1736, 352, 414, 414, 414,
-	1644, 1645, 1688, 1648, 1649, 1650, 1651, 1329, 1602, 1654,
-	1655, 1656, 1657, 1658, 1659, 1660, 1661, 1662, 1663, 1664,
-	1665, 1666, 1667, 1768, 1531, 1129, 1776, 1777, 1778, 1166,
-	1775, 1967, 1957, 1933, 1913, 1808, 1952, 1848, 1896, 1889,
-	1804, 1640, 301, 1790, 745, 511, 376, 1780, 383, 669,
-	1335, 1223, 1062, 1803, 1043, 697, 302, 1797, 81, 1810,
-	1811, 1763, 342, 1065, 343, 414, 1068, 1067, 788, 1153,
-	845, 554, 590, 584, 1373, 1821, 1372, 1596, 733, 26,
-	414, 434, 779, 872, 83, 1083, 1816, 873, 1715, 1726,
-	1727, 1545, 1881, 771, 1825, 1732, 1733, 1844, 597, 596,
-	595, 594, 424, 422, 421, 293, 292, 1254, 1380, 1831,
-	775, 777, 1863, 1862, 1822, 1840, 1823, 1842, 1843, 1838,
-	1432, 1682, 1743, 1678, 1674, 1814, 1555, 1554, 1582, 1583,
-	1589, 1459, 1851, 1853, 1455, 1457, 1458, 1883, 1456, 1454,
-	1355, 1859, 1356, 1353, 1352, 1034, 1030, 860, 867, 408,
-	713, 1882, 78, 1871, 1872, 1873, 1874, 291, 1104, 548,
-	72, 11, 1892, 18, 1894, 1886, 17, 16, 1888, 47,
-	46, 45, 44, 15, 8, 43, 42, 41, 14, 13,
-	37, 1899, 36, 35, 1902, 1909, 1900, 34, 33, 32,
-	31, 30, 29, 1906, 414, 28, 414, 27, 9, 55,
-	54, 53, 20, 702, 1917, 702, 1919, 21, 22, 61,
-	1922, 60, 1883, 1932, 59, 58, 57, 25, 10, 7,
-	4, 414, 1928, 2, 0, 0, 1882, 1931, 0, 1936,
-	702, 1939, 0, 0, 0, 0, 0, 1909, 1945, 0,
-	0, 1845, 0, 1947, 0, 0, 0, 0, 0, 1955,
-	0, 0, 0, 0, 0, 0, 0, 1956, 0, 0,
-	0, 0, 0, 0, 1966, 0, 1965, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1977, 1976, 1975, 1966,
-	990, 976, 0, 938, 992, 910, 926, 1000, 928, 929,
-	964, 888, 947, 207, 924, 880, 913, 914, 882, 921,
-	883, 911, 940, 152, 909, 979, 950, 177, 998, 179,
-	0, 0, 236, 192, 0, 0, 943, 981, 945, 969,
-	937, 965, 896, 958, 993, 925, 962, 994, 0, 0,
-	0, 0, 441, 442, 443, 0, 0, 0, 0, 135,
-	0, 0, 0, 0, 0, 961, 986, 923, 0, 0,
-	897, 991, 944, 963, 0, 881, 959, 0, 886, 889,
-	999, 984, 918, 919, 0, 0, 0, 0, 0, 0,
-	0, 941, 946, 966, 934, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 915, 0, 954, 0, 0, 0,
-	891, 887, 0, 939, 0, 126, 241, 255, 136, 232,
-	269, 140, 239, 132, 206, 228, 128, 253, 238, 189,
-	171, 172, 127, 0, 223, 150, 163, 147, 204, 988,
-	989, 146, 272, 890, 263, 130, 131, 262, 203, 250,
-	254, 190, 184, 129, 252, 188, 183, 175, 154, 167,
-	216, 182, 217, 168, 194, 193, 195, 1010, 1011, 1012,
-	1013, 1014, 895, 0, 916, 967, 0, 879, 975, 982,
-	936, 265, 985, 933, 932, 1017, 0, 1016, 240, 1018,
-	1019, 176, 980, 912, 922, 917, 920, 226, 209, 987,
-	953, 214, 224, 180, 251, 218, 256, 242, 264, 970,
-	219, 122, 243, 149, 191, 133, 134, 145, 151, 153,
-	155, 156, 200, 201, 212, 231, 244, 245, 246, 148,
-	141, 225, 142, 165, 143, 123, 233, 144, 124, 213,
-	249, 1015, 162, 221, 187, 125, 186, 215, 248, 247,
-	273, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	159, 878, 260, 0, 205, 977, 884, 894, 892, 930,
-	955, 956, 957, 1002, 972, 974, 973, 1001, 229, 0,
-	0, 0, 0, 0, 170, 211, 0, 230, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 885, 0,
-	237, 258, 271, 261, 931, 903, 942, 270, 906, 904,
-	971, 905, 960, 1003, 196, 197, 198, 199, 927, 139,
-	951, 935, 1004, 1005, 1006, 1007, 1008, 1009, 908, 983,
-	158, 164, 0, 166, 138, 210, 161, 268, 173, 202,
-	169, 234, 174, 181, 222, 267, 208, 227, 137, 257,
-	235, 185, 160, 902, 907, 901, 948, 949, 995, 996,
-	997, 968, 893, 978, 898, 900, 899, 952, 121, 0,
-	178, 266, 220, 157, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 616, 0, 0, 0,
-	1020, 1021, 274, 275, 276, 259, 207, 0, 0, 0,
-	0, 0, 592, 0, 0, 0, 152, 759, 0, 0,
-	177, 0, 179, 0, 0, 236, 192, 0, 0, 0,
-	0, 632, 640, 0, 0, 0, 0, 0, 0, 755,
-	0, 0, 585, 0, 0, 555, 622, 621, 600, 0,
-	0, 0, 135, 601, 0, 606, 0, 602, 605, 603,
-	604, 0, 0, 624, 0, 0, 0, 0, 0, 553,
-	589, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 586, 587, 0, 0, 0, 0, 617,
-	0, 588, 0, 0, 756, 0, 607, 0, 126, 241,
-	255, 136, 232, 269, 140, 239, 132, 206, 228, 128,
-	253, 238, 189, 171, 172, 127, 0, 223, 150, 163,
-	147, 204, 614, 615, 146, 579, 612, 263, 130, 131,
-	262, 203, 250, 254, 190, 184, 129, 252, 188, 183,
-	175, 154, 167, 216, 182, 217, 168, 194, 193, 195,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 265, 0, 0, 630, 0, 0,
-	0, 240, 0, 0, 176, 0, 0, 0, 613, 0,
-	226, 209, 643, 0, 214, 224, 180, 251, 218, 256,
-	242, 264, 0, 219, 122, 243, 149, 191, 133, 134,
-	145, 151, 153, 155, 156, 200, 201, 212, 231, 244,
-	245, 246, 148, 141, 225, 142, 165, 143, 123, 233,
-	144, 124, 213, 249, 0, 162, 221, 187, 125, 186,
-	215, 248, 247, 273, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 159, 0, 260, 628, 205, 642, 623,
-	625, 626, 629, 633, 634, 635, 636, 637, 639, 641,
-	644, 229, 0, 0, 0, 0, 0, 170, 211, 0,
-	230, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 237, 258, 271, 578, 0, 0, 0,
-	270, 0, 0, 0, 0, 0, 618, 196, 197, 198,
-	199, 631, 139, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 158, 164, 0, 166, 138, 210, 161,
-	268, 173, 202, 169, 234, 174, 181, 222, 267, 208,
-	227, 137, 257, 235, 185, 160, 650, 627, 649, 651,
-	652, 648, 653, 654, 638, 593, 0, 646, 645, 647,
-	0, 121, 0, 178, 266, 220, 157, 85, 557, 558,
-	559, 560, 561, 562, 563, 93, 564, 95, 96, 97,
-	98, 565, 100, 566, 102, 103, 104, 567, 568, 569,
-	570, 109, 571, 572, 573, 574, 114, 115, 116, 117,
-	575, 576, 577, 616, 0, 274, 275, 276, 259, 0,
-	0, 0, 0, 207, 0, 0, 0, 0, 0, 592,
-	0, 0, 0, 152, 1946, 0, 0, 177, 0, 179,
-	0, 0, 236, 192, 0, 0, 0, 0, 632, 640,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 585,
-	0, 0, 555, 622, 621, 600, 0, 0, 0, 135,
-	601, 0, 606, 0, 602, 605, 603, 604, 0, 0,
-	624, 0, 0, 0, 0, 0, 553, 589, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	586, 587, 0, 0, 0, 0, 617, 0, 588, 0,
-	0, 619, 0, 607, 0, 126, 241, 255, 136, 232,
-	269, 140, 239, 132, 206, 228, 128, 253, 238, 189,
-	171, 172, 127, 0, 223, 150, 163, 147, 204, 614,
-	615, 146, 579, 612, 263, 130, 131, 262, 203, 250,
-	254, 190, 184, 129, 252, 188, 183, 175, 154, 167,
-	216, 182, 217, 168, 194, 193, 195, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 265, 0, 0, 630, 0, 0, 0, 240, 0,
-	0, 176, 0, 0, 0, 613, 0, 226, 209, 643,
-	0, 214, 224, 180, 251, 218, 256, 242, 264, 0,
-	219, 122, 243, 149, 191, 133, 134, 145, 151, 153,
-	155, 156, 200, 201, 212, 231, 244, 245, 246, 148,
-	141, 225, 142, 165, 143, 123, 233, 144, 124, 213,
-	249, 0, 162, 221, 187, 125, 186, 215, 248, 247,
-	273, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	159, 0, 260, 628, 205, 642, 623, 625, 626, 629,
-	633, 634, 635, 636, 637, 639, 641, 644, 229, 0,
-	0, 0, 0, 0, 170, 211, 0, 230, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	237, 258, 271, 578, 0, 0, 0, 270, 0, 0,
-	0, 0, 0, 618, 196, 197, 198, 199, 631, 139,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	158, 164, 0, 166, 138, 210, 161, 268, 173, 202,
-	169, 234, 174, 181, 222, 267, 208, 227, 137, 257,
-	235, 185, 160, 650, 627, 649, 651, 652, 648, 653,
-	654, 638, 593, 0, 646, 645, 647, 0, 121, 0,
-	178, 266, 220, 157, 85, 557, 558, 559, 560, 561,
-	562, 563, 93, 564, 95, 96, 97, 98, 565, 100,
-	566, 102, 103, 104, 567, 568, 569, 570, 109, 571,
-	572, 573, 574, 114, 115, 116, 117, 575, 576, 577,
-	616, 0, 274, 275, 276, 259, 0, 0, 0, 0,
-	207, 0, 0, 0, 0, 0, 592, 0, 0, 0,
-	152, 759, 0, 0, 177, 0, 179, 0, 0, 236,
-	192, 0, 0, 0, 0, 632, 640, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 585, 0, 0, 555,
-	622, 621, 600, 0, 0, 0, 135, 601, 0, 606,
-	0, 602, 605, 603, 604, 0, 0, 624, 0, 0,
-	0, 0, 0, 553, 589, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 586, 587, 0,
-	0, 0, 0, 617, 0, 588, 0, 0, 619, 0,
-	607, 0, 126, 241, 255, 136, 232, 269, 140, 239,
-	132, 206, 228, 128, 253, 238, 189, 171, 172, 127,
-	0, 223, 150, 163, 147, 204, 614, 615, 146, 579,
-	612, 263, 130, 131, 262, 203, 250, 254, 190, 184,
-	129, 252, 188, 183, 175, 154, 167, 216, 182, 217,
-	168, 194, 193, 195, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 265, 0,
-	0, 630, 0, 0, 0, 240, 0, 0, 176, 0,
-	0, 0, 613, 0, 226, 209, 643, 0, 214, 224,
-	180, 251, 218, 256, 242, 264, 0, 219, 122, 243,
-	149, 191, 133, 134, 145, 151, 153, 155, 156, 200,
-	201, 212, 231, 244, 245, 246, 148, 141, 225, 142,
-	165, 143, 123, 233, 144, 124, 213, 249, 0, 162,
-	221, 187, 125, 186, 215, 248, 247, 273, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 159, 0, 260,
-	628, 205, 642, 623, 625, 626, 629, 633, 634, 635,
-	636, 637, 639, 641, 644, 229, 0, 0, 0, 0,
-	0, 170, 211, 0, 230, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 237, 258, 271,
-	578, 0, 0, 0, 270, 0, 0, 0, 0, 0,
-	618, 196, 197, 198, 199, 631, 139, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 158, 164, 0,
-	166, 138, 210, 161, 268, 173, 202, 169, 234, 174,
-	181, 222, 267, 208, 227, 137, 257, 235, 185, 160,
-	650, 627, 649, 651, 652, 648, 653, 654, 638, 593,
-	0, 646, 645, 647, 0, 121, 0, 178, 266, 220,
-	157, 85, 557, 558, 559, 560, 561, 562, 563, 93,
-	564, 95, 96, 97, 98, 565, 100, 566, 102, 103,
-	104, 567, 568, 569, 570, 109, 571, 572, 573, 574,
-	114, 115, 116, 117, 575, 576, 577, 0, 0, 274,
-	275, 276, 259, 76, 0, 616, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 207, 0, 0, 0, 0,
-	0, 592, 0, 0, 0, 152, 0, 0, 0, 177,
-	0, 179, 0, 0, 236, 192, 0, 0, 0, 0,
-	632, 640, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 585, 0, 0, 555, 622, 621, 600, 0, 0,
-	0, 135, 601, 0, 606, 0, 602, 605, 603, 604,
-	0, 0, 624, 0, 0, 0, 0, 0, 553, 589,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 586, 587, 0, 0, 0, 0, 617, 0,
-	588, 0, 0, 619, 0, 607, 0, 126, 241, 255,
-	136, 232, 269, 140, 239, 132, 206, 228, 128, 253,
-	238, 189, 171, 172, 127, 0, 223, 150, 163, 147,
-	204, 614, 615, 146, 579, 612, 263, 130, 131, 262,
-	203, 250, 254, 190, 184, 129, 252, 188, 183, 175,
-	154, 167, 216, 182, 217, 168, 194, 193, 195, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 265, 0, 0, 630, 0, 0, 0,
-	240, 0, 0, 176, 0, 0, 0, 613, 0, 226,
-	209, 643, 0, 214, 224, 180, 251, 218, 256, 242,
-	264, 0, 219, 122, 243, 149, 191, 133, 134, 145,
-	151, 153, 155, 156, 200, 201, 212, 231, 244, 245,
-	246, 148, 141, 225, 142, 165, 143, 123, 233, 144,
-	124, 213, 249, 0, 162, 221, 187, 125, 186, 215,
-	248, 247, 273, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 159, 0, 260, 628, 205, 642, 623, 625,
-	626, 629, 633, 634, 635, 636, 637, 639, 641, 644,
-	229, 0, 0, 0, 0, 0, 170, 211, 0, 230,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 237, 258, 271, 578, 0, 0, 0, 270,
-	0, 0, 0, 0, 0, 618, 196, 197, 198, 199,
-	631, 139, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 158, 164, 0, 166, 138, 210, 161, 268,
-	173, 202, 169, 234, 174, 181, 222, 267, 208, 227,
-	137, 257, 235, 185, 160, 650, 627, 649, 651, 652,
-	648, 653, 654, 638, 593, 0, 646, 645, 647, 0,
-	121, 0, 178, 266, 220, 157, 85, 557, 558, 559,
-	560, 561, 562, 563, 93, 564, 95, 96, 97, 98,
-	565, 100, 566, 102, 103, 104, 567, 568, 569, 570,
-	109, 571, 572, 573, 574, 114, 115, 116, 117, 575,
-	576, 577, 616, 0, 274, 275, 276, 259, 0, 0,
-	0, 0, 207, 0, 0, 0, 0, 0, 592, 0,
-	0, 0, 152, 0, 0, 0, 177, 0, 179, 0,
-	0, 236, 192, 0, 0, 0, 0, 632, 640, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 585, 0,
-	0, 555, 622, 621, 600, 0, 0, 0, 135, 601,
-	0, 606, 0, 602, 605, 603, 604, 0, 0, 624,
-	0, 0, 0, 0, 0, 553, 589, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 586,
-	587, 550, 0, 0, 0, 617, 0, 588, 0, 0,
-	619, 0, 607, 0, 126, 241, 255, 136, 232, 269,
-	140, 239, 132, 206, 228, 128, 253, 238, 189, 171,
-	172, 127, 0, 223, 150, 163, 147, 204, 614, 615,
-	146, 579, 612, 263, 130, 131, 262, 203, 250, 254,
-	190, 184, 129, 252, 188, 183, 175, 154, 167, 216,
-	182, 217, 168, 194, 193, 195, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	265, 0, 0, 630, 0, 0, 0, 240, 0, 0,
-	176, 0, 0, 0, 613, 0, 226, 209, 643, 0,
-	214, 224, 180, 251, 218, 256, 242, 264, 0, 219,
-	122, 243, 149, 191, 133, 134, 145, 151, 153, 155,
-	156, 200, 201, 212, 231, 244, 245, 246, 148, 141,
-	225, 142, 165, 143, 123, 233, 144, 124, 213, 249,
-	0, 162, 221, 187, 125, 186, 215, 248, 247, 273,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 159,
-	0, 260, 628, 205, 642, 623, 625, 626, 629, 633,
-	634, 635, 636, 637, 639, 641, 644, 229, 0, 0,
-	0, 0, 0, 170, 211, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 237,
-	258, 271, 578, 0, 0, 0, 270, 0, 0, 0,
-	0, 0, 618, 196, 197, 198, 199, 631, 139, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 158,
-	164, 0, 166, 138, 210, 161, 268, 173, 202, 169,
-	234, 174, 181, 222, 267, 208, 227, 137, 257, 235,
-	185, 160, 650, 627, 649, 651, 652, 648, 653, 654,
-	638, 593, 0, 646, 645, 647, 0, 121, 0, 178,
-	266, 220, 157, 85, 557, 558, 559, 560, 561, 562,
-	563, 93, 564, 95, 96, 97, 98, 565, 100, 566,
-	102, 103, 104, 567, 568, 569, 570, 109, 571, 572,
-	573, 574, 114, 115, 116, 117, 575, 576, 577, 616,
-	0, 274, 275, 276, 259, 0, 0, 0, 0, 207,
-	0, 0, 0, 0, 0, 592, 0, 0, 0, 152,
-	0, 0, 0, 177, 0, 179, 0, 0, 236, 192,
-	0, 0, 0, 0, 632, 640, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 585, 0, 0, 555, 622,
-	621, 600, 0, 0, 0, 135, 601, 0, 606, 0,
-	602, 605, 603, 604, 0, 0, 624, 0, 0, 0,
-	0, 0, 553, 589, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 586, 587, 0, 0,
-	0, 0, 617, 0, 588, 0, 0, 619, 0, 607,
-	0, 126, 241, 255, 136, 232, 269, 140, 239, 132,
-	206, 228, 128, 253, 238, 189, 171, 172, 127, 0,
-	223, 150, 163, 147, 204, 614, 615, 146, 579, 612,
-	263, 130, 131, 262, 203, 250, 254, 190, 184, 129,
-	252, 188, 183, 175, 154, 167, 216, 182, 217, 168,
-	194, 193, 195, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 265, 0, 0,
-	630, 0, 0, 0, 240, 0, 0, 176, 0, 0,
-	0, 613, 0, 226, 209, 643, 0, 214, 224, 180,
-	251, 218, 256, 242, 264, 0, 219, 122, 243, 149,
-	191, 133, 134, 145, 151, 153, 155, 156, 200, 201,
-	212, 231, 244, 245, 246, 148, 141, 225, 142, 165,
-	143, 123, 233, 144, 124, 213, 249, 0, 162, 221,
-	187, 125, 186, 215, 248, 247, 273, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 159, 0, 260, 628,
-	205, 642, 623, 625, 626, 629, 633, 634, 635, 636,
-	637, 639, 641, 644, 229, 0, 0, 0, 0, 0,
-	170, 211, 0, 230, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 237, 258, 271, 578,
-	0, 0, 0, 270, 0, 0, 0, 0, 0, 618,
-	196, 197, 198, 199, 631, 139, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 158, 164, 0, 166,
-	138, 210, 161, 268, 173, 202, 169, 234, 174, 181,
-	222, 267, 208, 227, 137, 257, 235, 185, 160, 650,
-	627, 649, 651, 652, 648, 653, 654, 638, 593, 0,
-	646, 645, 647, 0, 121, 0, 178, 266, 220, 157,
-	85, 557, 558, 559, 560, 561, 562, 563, 93, 564,
-	95, 96, 97, 98, 565, 100, 566, 102, 103, 104,
-	567, 568, 569, 570, 109, 571, 572, 573, 574, 114,
-	115, 116, 117, 575, 576, 577, 616, 0, 274, 275,
-	276, 259, 0, 0, 0, 0, 207, 0, 0, 0,
-	0, 0, 592, 0, 0, 0, 152, 0, 0, 0,
-	177, 0, 179, 0, 0, 236, 192, 0, 0, 0,
-	0, 632, 640, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 585, 0, 0, 555, 622, 621, 600, 0,
-	0, 0, 135, 601, 0, 606, 0, 602, 605, 603,
-	604, 0, 0, 624, 0, 0, 0, 0, 0, 0,
-	589, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 586, 587, 0, 0, 0, 0, 617,
-	0, 588, 0, 0, 619, 0, 607, 0, 126, 241,
-	255, 136, 232, 269, 140, 239, 132, 206, 228, 128,
-	253, 238, 189, 171, 172, 127, 0, 223, 150, 163,
-	147, 204, 614, 615, 146, 579, 612, 263, 130, 131,
-	262, 203, 250, 254, 190, 184, 129, 252, 188, 183,
-	175, 154, 167, 216, 182, 217, 168, 194, 193, 195,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 265, 0, 0, 630, 0, 0,
-	0, 240, 0, 0, 176, 0, 0, 0, 613, 0,
-	226, 209, 643, 0, 214, 224, 180, 251, 218, 256,
-	242, 264, 0, 219, 122, 243, 149, 191, 133, 134,
-	145, 151, 153, 155, 156, 200, 201, 212, 231, 244,
-	245, 246, 148, 141, 225, 142, 165, 143, 123, 233,
-	144, 124, 213, 249, 0, 162, 221, 187, 125, 186,
-	215, 248, 247, 273, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 159, 0, 260, 628, 205, 642, 623,
-	625, 626, 629, 633, 634, 635, 636, 637, 639, 641,
-	644, 229, 0, 0, 0, 0, 0, 170, 211, 0,
-	230, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 237, 258, 271, 578, 0, 0, 0,
-	270, 0, 0, 0, 0, 0, 618, 196, 197, 198,
-	199, 631, 139, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 158, 164, 0, 166, 138, 210, 161,
-	268, 173, 202, 169, 234, 174, 181, 222, 267, 208,
-	227, 137, 257, 235, 185, 160, 650, 627, 649, 651,
-	652, 648, 653, 654, 638, 593, 0, 646, 645, 647,
-	0, 121, 0, 178, 266, 220, 157, 85, 557, 558,
-	559, 560, 561, 562, 563, 93, 564, 95, 96, 97,
-	98, 565, 100, 566, 102, 103, 104, 567, 568, 569,
-	570, 109, 571, 572, 573, 574, 114, 115, 116, 117,
-	575, 576, 577, 616, 0, 274, 275, 276, 259, 0,
-	0, 0, 0, 207, 0, 0, 0, 0, 0, 592,
-	0, 0, 0, 152, 0, 0, 0, 177, 0, 179,
-	0, 0, 236, 192, 0, 0, 0, 0, 632, 640,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 555, 622, 621, 600, 0, 0, 0, 135,
-	601, 0, 606, 0, 602, 605, 603, 604, 0, 0,
-	624, 0, 0, 0, 0, 0, 553, 589, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	586, 587, 0, 0, 0, 0, 617, 0, 588, 0,
-	0, 619, 0, 607, 0, 126, 241, 255, 136, 232,
-	269, 140, 239, 132, 206, 228, 128, 253, 238, 189,
-	171, 172, 127, 0, 223, 150, 163, 147, 204, 614,
-	615, 146, 579, 612, 263, 130, 131, 262, 203, 250,
-	254, 190, 184, 129, 252, 188, 183, 175, 154, 167,
-	216, 182, 217, 168, 194, 193, 195, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 265, 0, 0, 630, 0, 0, 0, 240, 0,
-	0, 176, 0, 0, 0, 613, 0, 226, 209, 643,
-	0, 214, 224, 180, 251, 218, 256, 242, 264, 0,
-	219, 122, 243, 149, 191, 133, 134, 145, 151, 153,
-	155, 156, 200, 201, 212, 231, 244, 245, 246, 148,
-	141, 225, 142, 165, 143, 123, 233, 144, 124, 213,
-	249, 0, 162, 221, 187, 125, 186, 215, 248, 247,
-	273, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	159, 0, 260, 628, 205, 642, 623, 625, 626, 629,
-	633, 634, 635, 636, 637, 639, 641, 644, 229, 0,
-	0, 0, 0, 0, 170, 211, 0, 230, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	237, 258, 271, 578, 0, 0, 0, 270, 0, 0,
-	0, 0, 0, 618, 196, 197, 198, 199, 631, 139,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	158, 164, 0, 166, 138, 210, 161, 268, 173, 202,
-	169, 234, 174, 181, 222, 267, 208, 227, 137, 257,
-	235, 185, 160, 650, 627, 649, 651, 652, 648, 653,
-	654, 638, 593, 0, 646, 645, 647, 0, 121, 0,
-	178, 266, 220, 157, 85, 557, 558, 559, 560, 561,
-	562, 563, 93, 564, 95, 96, 97, 98, 565, 100,
-	566, 102, 103, 104, 567, 568, 569, 570, 109, 571,
-	572, 573, 574, 114, 115, 116, 117, 575, 576, 577,
-	0, 0, 274, 275, 276, 259, 313, 0, 312, 316,
-	308, 0, 0, 0, 0, 0, 0, 0, 207, 0,
-	304, 0, 0, 0, 0, 0, 0, 0, 152, 0,
-	0, 323, 177, 0, 179, 0, 0, 236, 192, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 326, 0, 0,
-	327, 0, 0, 0, 135, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	126, 241, 255, 136, 232, 269, 140, 239, 132, 206,
-	228, 128, 253, 238, 189, 171, 172, 127, 0, 223,
-	150, 163, 147, 204, 0, 0, 146, 272, 0, 263,
-	130, 131, 262, 203, 250, 254, 190, 184, 129, 252,
-	188, 183, 175, 154, 167, 216, 182, 217, 168, 194,
-	193, 195, 0, 0, 0, 0, 0, 306, 305, 309,
-	0, 0, 0, 0, 0, 311, 265, 0, 0, 0,
-	0, 0, 0, 240, 0, 0, 176, 315, 0, 0,
-	0, 0, 226, 209, 0, 0, 214, 224, 180, 251,
-	218, 307, 242, 264, 0, 331, 122, 243, 149, 191,
-	133, 134, 145, 151, 153, 155, 156, 200, 201, 212,
-	231, 244, 245, 246, 148, 141, 225, 142, 165, 143,
-	123, 233, 144, 124, 213, 249, 0, 162, 221, 187,
-	125, 186, 215, 248, 247, 273, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 159, 0, 260, 0, 205,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 229, 0, 0, 0, 310, 314, 317,
-	211, 318, 319, 0, 0, 320, 321, 322, 0, 0,
-	324, 325, 0, 0, 0, 237, 258, 271, 261, 0,
-	0, 0, 270, 0, 0, 0, 0, 0, 0, 196,
-	197, 198, 199, 0, 139, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 158, 164, 0, 166, 138,
-	210, 161, 268, 173, 202, 169, 234, 174, 181, 222,
-	267, 208, 227, 137, 257, 235, 185, 160, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 121, 0, 178, 266, 220, 157, 85,
-	86, 87, 88, 89, 90, 91, 92, 93, 94, 95,
-	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
-	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
-	116, 117, 118, 119, 120, 0, 0, 274, 275, 276,
-	259, 313, 0, 312, 316, 308, 0, 0, 0, 0,
-	0, 0, 0, 207, 0, 304, 0, 0, 0, 0,
-	0, 0, 0, 152, 0, 0, 323, 177, 0, 179,
-	0, 0, 236, 192, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 326, 0, 0, 327, 0, 0, 0, 135,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 126, 241, 255, 136, 232,
-	269, 140, 239, 132, 206, 228, 128, 253, 238, 189,
-	171, 172, 127, 0, 223, 150, 163, 147, 204, 0,
-	0, 146, 272, 0, 263, 130, 131, 262, 203, 250,
-	254, 190, 184, 129, 252, 188, 183, 175, 154, 167,
-	216, 182, 217, 168, 194, 193, 195, 0, 0, 0,
-	0, 0, 306, 305, 309, 0, 0, 0, 0, 0,
-	311, 265, 0, 0, 0, 0, 0, 0, 240, 0,
-	0, 176, 315, 0, 0, 0, 0, 226, 209, 0,
-	0, 214, 224, 180, 251, 218, 307, 242, 264, 0,
-	219, 122, 243, 149, 191, 133, 134, 145, 151, 153,
-	155, 156, 200, 201, 212, 231, 244, 245, 246, 148,
-	141, 225, 142, 165, 143, 123, 233, 144, 124, 213,
-	249, 0, 162, 221, 187, 125, 186, 215, 248, 247,
-	273, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	159, 0, 260, 0, 205, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 229, 0,
-	0, 0, 310, 314, 317, 211, 318, 319, 0, 0,
-	320, 321, 322, 0, 0, 324, 325, 0, 0, 0,
-	237, 258, 271, 261, 0, 0, 0, 270, 0, 0,
-	0, 0, 0, 0, 196, 197, 198, 199, 0, 139,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	158, 164, 0, 166, 138, 210, 161, 268, 173, 202,
-	169, 234, 174, 181, 222, 267, 208, 227, 137, 257,
-	235, 185, 160, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 121, 0,
-	178, 266, 220, 157, 85, 86, 87, 88, 89, 90,
-	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
-	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	207, 0, 274, 275, 276, 259, 0, 0, 0, 0,
-	152, 0, 0, 0, 177, 0, 179, 0, 0, 236,
-	192, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 82,
-	0, 0, 0, 0, 0, 0, 135, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1364, 1367,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 126, 241, 255, 136, 232, 269, 140, 239,
-	132, 206, 228, 128, 253, 238, 189, 171, 172, 127,
-	0, 223, 150, 163, 147, 204, 0, 0, 146, 272,
-	0, 263, 130, 131, 262, 203, 250, 254, 190, 184,
-	129, 252, 188, 183, 175, 154, 167, 216, 182, 217,
-	168, 194, 193, 195, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1368, 265, 0,
-	0, 0, 1361, 0, 1360, 240, 1362, 1365, 176, 0,
-	0, 0, 0, 0, 226, 209, 0, 0, 214, 224,
-	180, 251, 218, 256, 242, 264, 0, 219, 122, 243,
-	149, 191, 133, 134, 145, 151, 153, 155, 156, 200,
-	201, 212, 231, 244, 245, 246, 148, 141, 225, 142,
-	165, 143, 123, 233, 144, 124, 213, 249, 1366, 162,
-	221, 187, 125, 186, 215, 248, 247, 273, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 159, 0, 260,
-	0, 205, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 229, 0, 0, 0, 0,
-	0, 170, 211, 0, 230, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 237, 258, 271,
-	261, 0, 0, 0, 270, 0, 0, 0, 0, 0,
-	0, 196, 197, 198, 199, 0, 139, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 158, 164, 0,
-	166, 138, 210, 161, 268, 173, 202, 169, 234, 174,
-	181, 222, 267, 208, 227, 137, 257, 235, 185, 160,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 121, 0, 178, 266, 220,
-	157, 85, 86, 87, 88, 89, 90, 91, 92, 93,
-	94, 95, 96, 97, 98, 99, 100, 101, 102, 103,
-	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
-	114, 115, 116, 117, 118, 119, 120, 0, 0, 274,
-	275, 276, 259, 76, 0, 23, 39, 24, 0, 0,
-	0, 0, 0, 0, 0, 207, 279, 0, 0, 0,
-	0, 0, 0, 0, 0, 152, 0, 0, 0, 177,
-	0, 179, 0, 0, 236, 192, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 73, 0, 0, 82, 0, 0, 0, 0, 0,
-	0, 135, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 126, 241, 255,
-	136, 232, 269, 140, 239, 132, 206, 228, 128, 253,
-	238, 189, 171, 172, 127, 0, 223, 150, 163, 147,
-	204, 0, 0, 146, 272, 0, 263, 130, 131, 262,
-	203, 250, 254, 190, 184, 129, 252, 188, 183, 175,
-	154, 167, 216, 182, 217, 168, 194, 193, 195, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 282, 0,
-	0, 0, 0, 265, 0, 0, 0, 0, 0, 0,
-	240, 0, 0, 176, 0, 0, 0, 0, 0, 226,
-	209, 0, 0, 214, 224, 180, 251, 218, 256, 242,
-	264, 0, 219, 122, 243, 149, 191, 133, 134, 145,
-	151, 153, 155, 156, 200, 201, 212, 231, 244, 245,
-	246, 148, 141, 225, 142, 165, 143, 123, 233, 144,
-	124, 213, 249, 0, 162, 221, 187, 125, 186, 215,
-	248, 247, 273, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 159, 0, 260, 0, 205, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	229, 0, 0, 0, 0, 0, 170, 211, 0, 230,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 237, 258, 271, 261, 0, 0, 0, 270,
-	0, 0, 0, 0, 0, 0, 196, 197, 198, 199,
-	280, 139, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 158, 164, 0, 166, 138, 210, 161, 268,
-	173, 202, 169, 234, 174, 181, 222, 267, 208, 227,
-	137, 257, 235, 185, 160, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	121, 0, 178, 266, 220, 157, 85, 86, 87, 88,
+	734, 1169, 2095, 2093, 2092, 2100, 2065, 600, 2059, 618,
+	2038, 1934, 1695, 724, 1691, 2028, 1612, 1956, 1570, 1957,
+	1900, 546, 1844, 1885, 83, 510, 1916, 289, 1768, 1693,
+	801, 86, 1101, 1888, 1694, 445, 1726, 1431, 544, 1322,
+	1170, 83, 302, 300, 598, 1757, 1582, 496, 1437, 1622,
+	334, 334, 1440, 1601, 1407, 1725, 1662, 1625, 396, 787,
+	573, 1506, 1638, 1623, 1445, 1636, 1290, 82, 1592, 1441,
+	1419, 1106, 341, 894, 397, 1359, 1522, 340, 683, 599,
+	295, 721, 83, 1523, 1065, 554, 903, 293, 19, 909,
+	904, 912, 718, 1230, 514, 895, 1438, 52, 610, 780,
+	1216, 1284, 761, 749, 737, 1702, 1098, 1113, 691, 719,
+	1171, 1184, 566, 784, 629, 53, 750, 1130, 1168, 751,
+	1080, 284, 1082, 1071, 803, 419, 287, 447, 875, 834,
+	536, 309, 309, 710, 304, 388, 305, 306, 339, 1089,
+	432, 53, 296, 462, 79, 1838, 1839, 401, 1835, 1836,
+	876, 1676, 403, 1772, 1775, 1687, 1569, 1772, 488, 1837,
+	897, 1926, 389, 1085, 77, 522, 1269, 19, 1408, 1285,
+	1907, 1769, 1410, 404, 409, 408, 517, 482, 364, 769,
+	770, 511, 512, 509, 1280, 336, 508, 511, 512, 555,
+	405, 523, 374, 753, 53, 1960, 1961, 727, 477, 473,
+	1984, 2042, 1982, 1914, 407, 1414, 1969, 356, 520, 1917,
+	1918, 1919, 1920, 1415, 1972, 1416, 1778, 1571, 731, 1420,
+	1421, 1422, 1423, 1254, 1507, 424, 1293, 1291, 1288, 1292,
+	1294, 1099, 1287, 1286, 781, 1085, 1524, 1293, 1291, 1510,
+	1292, 1294, 1087, 375, 1754, 1621, 1620, 468, 464, 475,
+	476, 1617, 1684, 474, 1566, 463, 1832, 711, 1648, 1500,
+	1496, 1497, 1498, 1499, 1529, 1652, 1528, 1527, 1525, 1889,
+	1890, 1891, 1893, 1892, 1651, 469, 1509, 1296, 1297, 1298,
+	1299, 1986, 1979, 713, 83, 423, 1814, 1959, 2081, 2101,
+	2018, 1932, 1933, 1925, 1936, 83, 1981, 422, 406, 1446,
+	1449, 1936, 2025, 1952, 1749, 1902, 2057, 1796, 1942, 1795,
+	532, 338, 471, 398, 358, 507, 506, 348, 1988, 1989,
+	1526, 2102, 449, 1675, 355, 354, 1424, 2096, 1740, 2066,
+	1784, 1370, 450, 418, 1360, 2031, 1131, 1744, 428, 497,
+	521, 1967, 1501, 459, 1273, 350, 472, 466, 410, 1411,
+	518, 1145, 1093, 1302, 500, 1928, 1929, 712, 1320, 467,
+	470, 1685, 1449, 502, 421, 1649, 294, 376, 772, 465,
+	765, 763, 764, 1141, 762, 526, 83, 1664, 1663, 773,
+	398, 1143, 1142, 524, 525, 334, 400, 1502, 380, 1304,
+	1140, 397, 397, 397, 771, 377, 378, 498, 499, 454,
+	501, 2086, 2063, 53, 795, 1412, 1330, 849, 1450, 1267,
+	1266, 1253, 569, 1443, 550, 426, 455, 1444, 1447, 519,
+	1790, 682, 1136, 1247, 371, 1530, 1531, 1400, 688, 359,
+	423, 83, 83, 83, 83, 549, 2032, 382, 381, 349,
+	1126, 1097, 692, 1064, 451, 452, 453, 547, 816, 685,
+	551, 1870, 568, 400, 427, 420, 1304, 487, 515, 334,
+	334, 423, 334, 1303, 449, 1987, 309, 2074, 449, 1448,
+	1450, 1901, 1927, 725, 450, 479, 1548, 503, 450, 483,
+	334, 334, 708, 1408, 511, 512, 511, 512, 2053, 357,
+	504, 1432, 782, 1946, 1249, 1108, 1647, 83, 1147, 334,
+	334, 1193, 744, 548, 83, 678, 1069, 486, 1088, 1771,
+	1770, 425, 461, 1771, 1770, 1402, 1650, 557, 758, 531,
+	1231, 334, 743, 740, 542, 543, 733, 1270, 1745, 1746,
+	738, 484, 53, 334, 397, 1742, 334, 1084, 1503, 1741,
+	739, 309, 756, 726, 513, 1912, 516, 746, 2029, 2030,
+	745, 811, 796, 539, 540, 541, 1293, 1291, 1735, 1292,
+	1294, 334, 334, 800, 83, 1401, 759, 556, 1751, 814,
+	707, 368, 729, 693, 694, 695, 696, 788, 537, 369,
+	505, 309, 706, 788, 788, 1750, 804, 1083, 1135, 538,
+	730, 741, 1133, 754, 714, 755, 805, 723, 1231, 1165,
+	1365, 747, 748, 813, 811, 866, 535, 802, 545, 1596,
+	1166, 817, 728, 766, 309, 560, 561, 562, 563, 564,
+	1591, 2056, 1189, 732, 1186, 342, 3, 752, 1188, 1185,
+	1187, 1191, 1192, 1223, 742, 1331, 1190, 2090, 451, 452,
+	453, 547, 2071, 309, 865, 783, 379, 1221, 1222, 1220,
+	872, 292, 12, 798, 1181, 1604, 793, 794, 1871, 1873,
+	1874, 1875, 1872, 1183, 2055, 1173, 1172, 778, 878, 779,
+	790, 791, 792, 451, 452, 453, 547, 2019, 534, 2015,
+	901, 901, 906, 1881, 867, 868, 869, 870, 290, 6,
+	1066, 2007, 1607, 797, 291, 5, 799, 548, 1602, 1911,
+	402, 404, 1910, 1879, 1615, 1616, 416, 914, 1865, 1603,
+	873, 1864, 451, 452, 453, 1584, 1863, 915, 864, 383,
+	892, 1880, 843, 1860, 1854, 908, 1877, 366, 1851, 367,
+	374, 12, 548, 1850, 365, 363, 362, 370, 1820, 372,
+	373, 1878, 1668, 1608, 1692, 877, 83, 852, 853, 854,
+	855, 856, 849, 289, 1178, 1776, 907, 812, 813, 811,
+	1128, 403, 884, 1763, 1876, 1550, 1102, 1103, 6, 1067,
+	900, 1585, 804, 1368, 5, 334, 1367, 404, 812, 813,
+	811, 1667, 805, 820, 821, 822, 823, 824, 825, 1116,
+	818, 812, 813, 811, 405, 1761, 334, 1760, 1867, 812,
+	813, 811, 53, 812, 813, 811, 1953, 1756, 569, 1755,
+	83, 1578, 1577, 913, 1063, 2043, 1162, 1163, 1614, 1076,
+	1442, 812, 813, 811, 1576, 1117, 1118, 1119, 812, 813,
+	811, 788, 788, 788, 1179, 1180, 1866, 1575, 1138, 1847,
+	1394, 686, 1992, 1964, 1120, 1610, 1886, 1193, 568, 1978,
+	1114, 1092, 1159, 1160, 1161, 1122, 309, 1124, 1940, 1337,
+	1104, 812, 813, 811, 1939, 1909, 892, 1609, 1611, 1868,
+	1861, 1176, 1857, 752, 1123, 1121, 1125, 1152, 2105, 1856,
+	1167, 1132, 1199, 1137, 1855, 1155, 1777, 1237, 1323, 1758,
+	1158, 1737, 1204, 1205, 1206, 1207, 1208, 1209, 1210, 1211,
+	1212, 1213, 1214, 1215, 1148, 1149, 1150, 1225, 1226, 1144,
+	1690, 1688, 1475, 1586, 1833, 1232, 812, 813, 811, 1617,
+	1156, 857, 858, 850, 851, 852, 853, 854, 855, 856,
+	849, 1605, 1096, 1429, 1428, 1239, 812, 813, 811, 1174,
+	1175, 1224, 1177, 1819, 2073, 451, 452, 453, 1194, 1195,
+	1196, 1427, 1426, 1200, 1094, 1201, 1202, 1203, 1218, 1197,
+	1198, 1666, 888, 887, 1557, 812, 813, 811, 1189, 886,
+	1186, 1095, 879, 735, 1188, 1185, 1187, 1191, 1192, 1547,
+	1349, 687, 1190, 812, 813, 811, 812, 813, 811, 1541,
+	1234, 1333, 2110, 1252, 812, 813, 811, 2104, 2103, 1235,
+	1463, 812, 813, 811, 1241, 1091, 2082, 1963, 1238, 2079,
+	1240, 812, 813, 811, 1903, 1482, 1486, 1488, 1490, 1492,
+	1493, 1495, 1825, 1500, 1496, 1497, 1498, 1499, 1477, 1478,
+	1479, 1480, 1461, 1462, 1483, 1824, 1464, 1767, 1465, 1466,
+	1467, 1468, 1469, 1470, 1471, 1472, 1473, 1474, 1481, 812,
+	813, 811, 2078, 2077, 1091, 2069, 1485, 1487, 1489, 1491,
+	1494, 1091, 2068, 1373, 1678, 1255, 1333, 1372, 1829, 423,
+	847, 857, 858, 850, 851, 852, 853, 854, 855, 856,
+	849, 692, 1066, 1540, 1476, 1672, 334, 2062, 2061, 334,
+	1780, 1997, 423, 1671, 334, 1154, 1990, 1656, 1278, 1587,
+	1281, 1976, 1975, 1558, 1272, 812, 813, 811, 1780, 1962,
+	788, 1780, 1950, 1261, 1780, 1949, 1263, 850, 851, 852,
+	853, 854, 855, 856, 849, 1539, 1511, 1310, 1538, 1260,
+	1376, 423, 1374, 1314, 1315, 83, 1276, 1277, 1317, 1536,
+	1258, 738, 1371, 1313, 1348, 403, 334, 812, 813, 811,
+	812, 813, 811, 1347, 83, 83, 1780, 1948, 1535, 1780,
+	1947, 812, 813, 811, 1945, 1944, 1264, 1831, 1830, 1342,
+	1271, 1827, 1828, 1301, 1827, 1826, 1339, 1259, 1316, 1338,
+	812, 813, 811, 1332, 1534, 1319, 1274, 1521, 319, 1236,
+	318, 322, 314, 1780, 1779, 1268, 709, 1325, 1326, 1306,
+	1520, 1275, 310, 1257, 1561, 1282, 812, 813, 811, 812,
+	813, 811, 1334, 329, 558, 1335, 1336, 1307, 1114, 1308,
+	1300, 1354, 812, 813, 811, 1333, 1542, 1312, 684, 1309,
+	2106, 1519, 1311, 1333, 1532, 1344, 1345, 1346, 1321, 2052,
+	1318, 1350, 1351, 1352, 1353, 1333, 901, 1324, 1386, 901,
+	1257, 1398, 1389, 812, 813, 811, 1227, 1484, 1395, 1333,
+	1341, 1333, 1340, 1066, 1257, 1256, 334, 1357, 1358, 1362,
+	334, 334, 1366, 1068, 334, 1392, 1251, 1250, 812, 813,
+	811, 1242, 1377, 809, 788, 1393, 1588, 345, 346, 347,
+	788, 1245, 1244, 1091, 1090, 1085, 83, 1559, 78, 344,
+	78, 1356, 1329, 1381, 478, 78, 423, 458, 457, 1388,
+	78, 456, 23, 40, 24, 457, 459, 404, 1313, 1218,
+	1385, 1355, 680, 1364, 1248, 677, 1228, 1382, 1154, 807,
+	83, 1516, 1383, 1129, 864, 1387, 1384, 1100, 1378, 1430,
+	1433, 1434, 559, 1390, 1396, 1391, 533, 1397, 679, 2046,
+	75, 1403, 1405, 459, 2026, 75, 1425, 2023, 1399, 53,
+	75, 312, 311, 315, 2021, 2006, 1406, 1898, 1883, 317,
+	1842, 1417, 1823, 1821, 1518, 78, 1817, 23, 40, 24,
+	1816, 321, 1815, 1812, 1533, 1811, 1451, 1452, 1062, 1624,
+	1537, 1748, 1626, 1637, 1639, 715, 1631, 1460, 53, 1630,
+	1597, 1580, 1219, 1305, 1453, 334, 1549, 1552, 1262, 1243,
+	1515, 1233, 1555, 1516, 1146, 1139, 429, 1081, 893, 891,
+	1556, 890, 1546, 889, 885, 75, 835, 434, 437, 438,
+	439, 435, 1543, 436, 441, 882, 1544, 440, 880, 874,
+	1545, 75, 1553, 1590, 1551, 846, 845, 403, 844, 842,
+	841, 840, 839, 838, 837, 1583, 1560, 848, 847, 857,
+	858, 850, 851, 852, 853, 854, 855, 856, 849, 836,
+	1581, 316, 320, 716, 833, 324, 717, 832, 1565, 326,
+	327, 328, 831, 830, 330, 331, 1562, 829, 828, 1574,
+	2002, 1579, 827, 1594, 434, 437, 438, 439, 435, 826,
+	436, 441, 1643, 689, 440, 681, 460, 1618, 1813, 1593,
+	1589, 1593, 1110, 1628, 1629, 1595, 1655, 860, 2000, 863,
+	1072, 1073, 1958, 1295, 1153, 1075, 1627, 1632, 1633, 1634,
+	1635, 480, 1598, 861, 862, 859, 303, 848, 847, 857,
+	858, 850, 851, 852, 853, 854, 855, 856, 849, 705,
+	703, 438, 439, 701, 1654, 704, 1677, 1079, 702, 440,
+	1078, 1640, 1641, 699, 334, 334, 1642, 1646, 700, 1077,
+	698, 697, 2089, 1246, 1657, 2035, 552, 1659, 1660, 1661,
+	553, 423, 1645, 1658, 1644, 1115, 1105, 335, 1665, 423,
+	1699, 1670, 1727, 1729, 1567, 1727, 1727, 345, 346, 347,
+	788, 1313, 1102, 1103, 1563, 1669, 343, 768, 1283, 344,
+	443, 1564, 412, 414, 415, 1173, 1172, 2072, 83, 1680,
+	1683, 343, 494, 495, 492, 493, 490, 491, 485, 1728,
+	1583, 345, 346, 347, 2047, 2011, 2009, 1724, 1733, 1974,
+	1973, 1971, 1848, 344, 1732, 1681, 1682, 1843, 1689, 1736,
+	1730, 1731, 684, 1618, 1734, 1738, 1343, 1653, 1573, 1572,
+	1554, 1752, 848, 847, 857, 858, 850, 851, 852, 853,
+	854, 855, 856, 849, 1514, 489, 344, 1759, 1513, 1328,
+	684, 434, 437, 438, 439, 435, 83, 436, 441, 1762,
+	1361, 440, 1265, 1765, 2004, 2003, 2004, 283, 2003, 774,
+	442, 1786, 360, 1134, 1, 896, 902, 1884, 2034, 1766,
+	2058, 848, 847, 857, 858, 850, 851, 852, 853, 854,
+	855, 856, 849, 2005, 2037, 617, 601, 1966, 1413, 1773,
+	1913, 1968, 1915, 1729, 1279, 1840, 1409, 1782, 1787, 1788,
+	481, 1791, 1792, 1793, 1794, 1781, 1379, 1797, 1798, 1799,
+	1800, 1801, 1802, 1803, 1804, 1805, 1806, 1807, 1808, 1809,
+	1810, 1789, 78, 1380, 23, 40, 24, 641, 631, 1818,
+	881, 632, 676, 413, 630, 1764, 1508, 353, 411, 361,
+	1753, 423, 66, 1568, 1619, 1182, 73, 2099, 1849, 2088,
+	2064, 2045, 1935, 2080, 1980, 2024, 2017, 1931, 1834, 1841,
+	1783, 307, 775, 527, 386, 41, 1899, 690, 1418, 1289,
+	1882, 1107, 75, 423, 1846, 1845, 423, 423, 423, 2050,
+	1086, 449, 720, 308, 423, 1924, 1822, 1852, 1853, 351,
+	1109, 450, 352, 1858, 1859, 1862, 1112, 1111, 819, 1217,
+	883, 571, 1887, 1922, 1229, 1895, 1896, 1897, 1894, 1363,
+	871, 608, 602, 1908, 1505, 2048, 1504, 1613, 1923, 757,
+	26, 444, 1904, 810, 848, 847, 857, 858, 850, 851,
+	852, 853, 854, 855, 856, 849, 910, 85, 69, 70,
+	1930, 71, 72, 1127, 83, 911, 1921, 1774, 2039, 1674,
+	1673, 1937, 1938, 1369, 616, 615, 614, 613, 1679, 423,
+	848, 847, 857, 858, 850, 851, 852, 853, 854, 855,
+	856, 849, 433, 431, 1943, 848, 847, 857, 858, 850,
+	851, 852, 853, 854, 855, 856, 849, 802, 1951, 430,
+	299, 298, 1327, 1512, 806, 58, 68, 76, 808, 39,
+	1955, 1965, 1970, 848, 847, 857, 858, 850, 851, 852,
+	853, 854, 855, 856, 849, 67, 65, 64, 1983, 1985,
+	1954, 1905, 1906, 1977, 1686, 1747, 1869, 1743, 1739, 1991,
+	1993, 1994, 1995, 1996, 1998, 2001, 1999, 2014, 1941, 1698,
+	1697, 1599, 1600, 1606, 1459, 1455, 2010, 2008, 2012, 2013,
+	1457, 1458, 1456, 1454, 1439, 1436, 1435, 1074, 1070, 898,
+	905, 2016, 417, 736, 80, 297, 1157, 2041, 565, 74,
+	11, 18, 17, 16, 2027, 48, 2040, 47, 46, 45,
+	2033, 15, 423, 8, 423, 44, 2044, 2020, 43, 2022,
+	42, 14, 760, 13, 725, 2049, 725, 2051, 38, 37,
+	36, 49, 35, 2060, 34, 33, 32, 50, 31, 30,
+	29, 28, 27, 423, 9, 57, 56, 55, 54, 2067,
+	20, 21, 22, 2041, 2076, 725, 2070, 63, 62, 61,
+	60, 2054, 2040, 2075, 59, 25, 10, 7, 4, 2,
+	0, 2060, 2083, 51, 0, 2087, 0, 2091, 0, 0,
+	0, 0, 0, 0, 0, 0, 2098, 0, 2097, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2109, 2108,
+	2107, 2098, 0, 0, 0, 2085, 1030, 978, 960, 1016,
+	0, 977, 1032, 948, 965, 1040, 967, 968, 1004, 926,
+	987, 210, 963, 918, 951, 952, 920, 959, 921, 949,
+	980, 155, 947, 1019, 990, 180, 1038, 182, 0, 0,
+	241, 195, 0, 0, 983, 1021, 985, 1009, 976, 1005,
+	934, 998, 1033, 964, 0, 0, 1002, 1034, 0, 0,
+	0, 0, 451, 452, 453, 0, 0, 0, 0, 137,
+	0, 0, 0, 0, 0, 1001, 1026, 962, 0, 0,
+	935, 1031, 984, 1003, 0, 919, 999, 0, 924, 927,
+	1039, 1024, 956, 957, 0, 0, 0, 0, 0, 0,
+	0, 981, 986, 1006, 973, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 953, 0, 994, 0, 0, 0,
+	929, 925, 0, 979, 0, 128, 246, 260, 138, 237,
+	275, 142, 244, 134, 209, 232, 130, 258, 243, 192,
+	174, 175, 129, 0, 227, 153, 165, 150, 207, 1028,
+	1029, 149, 278, 928, 269, 132, 133, 268, 206, 255,
+	259, 193, 187, 131, 257, 191, 186, 178, 157, 170,
+	219, 185, 220, 171, 197, 196, 198, 1050, 1051, 1052,
+	1053, 1054, 933, 0, 954, 1007, 0, 917, 1015, 1022,
+	975, 271, 1025, 972, 971, 1057, 0, 1056, 245, 1058,
+	1059, 179, 1020, 950, 961, 955, 958, 230, 212, 1027,
+	993, 217, 228, 183, 256, 222, 261, 247, 270, 1010,
+	223, 124, 248, 152, 194, 135, 136, 148, 154, 156,
+	158, 159, 203, 204, 215, 235, 249, 250, 251, 151,
+	143, 229, 144, 167, 145, 125, 238, 146, 126, 216,
+	254, 1055, 164, 225, 190, 127, 189, 218, 253, 252,
+	279, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	162, 916, 266, 0, 208, 1017, 922, 932, 930, 969,
+	995, 996, 997, 1042, 1012, 1014, 1013, 1041, 233, 0,
+	0, 0, 0, 0, 173, 214, 0, 234, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 923, 0,
+	242, 264, 277, 267, 970, 941, 982, 276, 944, 942,
+	1011, 943, 1000, 1043, 199, 200, 201, 202, 966, 141,
+	991, 974, 1044, 1045, 1046, 1047, 1048, 1049, 946, 1023,
+	161, 166, 1375, 168, 140, 213, 163, 274, 176, 205,
+	172, 239, 177, 184, 226, 273, 211, 231, 139, 263,
+	240, 188, 940, 945, 939, 988, 989, 1035, 1036, 1037,
+	1008, 931, 1018, 936, 938, 937, 992, 123, 0, 181,
+	272, 224, 160, 0, 0, 0, 0, 0, 848, 847,
+	857, 858, 850, 851, 852, 853, 854, 855, 856, 849,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1060,
+	1061, 280, 281, 282, 637, 236, 147, 262, 221, 169,
+	265, 0, 0, 0, 210, 0, 0, 0, 0, 0,
+	611, 0, 0, 0, 155, 789, 0, 0, 180, 0,
+	182, 0, 0, 241, 195, 0, 0, 0, 0, 653,
+	661, 0, 0, 0, 0, 0, 0, 0, 628, 785,
+	0, 0, 603, 0, 0, 572, 643, 642, 619, 626,
+	0, 0, 137, 620, 0, 625, 0, 621, 624, 622,
+	623, 0, 0, 645, 0, 0, 0, 0, 0, 570,
+	607, 0, 609, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 604, 605, 0, 0, 0, 0, 638,
+	0, 606, 0, 0, 786, 0, 627, 0, 128, 246,
+	260, 138, 237, 275, 142, 244, 134, 209, 232, 130,
+	258, 243, 192, 174, 175, 129, 0, 227, 153, 165,
+	150, 207, 635, 636, 149, 597, 633, 269, 132, 133,
+	268, 206, 255, 259, 193, 187, 131, 257, 191, 186,
+	178, 157, 170, 219, 185, 220, 171, 197, 196, 198,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 271, 0, 0, 651, 0, 0,
+	0, 245, 0, 0, 179, 0, 0, 0, 634, 0,
+	230, 212, 664, 0, 217, 228, 183, 256, 222, 261,
+	247, 270, 0, 223, 124, 248, 152, 194, 135, 136,
+	148, 154, 156, 158, 159, 203, 204, 215, 235, 249,
+	250, 251, 151, 143, 229, 144, 167, 145, 125, 238,
+	146, 126, 216, 254, 0, 164, 225, 190, 127, 189,
+	218, 253, 252, 279, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 162, 0, 266, 649, 208, 663, 644,
+	646, 647, 650, 654, 655, 656, 657, 658, 660, 662,
+	665, 233, 0, 0, 0, 0, 0, 173, 214, 0,
+	234, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 242, 264, 277, 596, 0, 0, 0,
+	276, 0, 0, 0, 0, 0, 639, 199, 200, 201,
+	202, 652, 141, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 161, 166, 0, 168, 140, 213, 163,
+	274, 176, 205, 172, 239, 177, 184, 226, 273, 211,
+	231, 139, 263, 240, 188, 671, 648, 670, 672, 673,
+	669, 674, 675, 659, 612, 0, 667, 666, 668, 0,
+	123, 0, 181, 272, 224, 160, 87, 574, 575, 576,
+	577, 578, 579, 580, 95, 581, 97, 98, 582, 100,
+	583, 102, 584, 104, 105, 106, 585, 586, 587, 588,
+	111, 589, 590, 591, 592, 116, 117, 118, 119, 593,
+	594, 595, 0, 0, 280, 281, 282, 637, 236, 147,
+	262, 221, 169, 265, 0, 0, 0, 210, 0, 0,
+	0, 0, 0, 611, 0, 0, 0, 155, 2084, 0,
+	0, 180, 0, 182, 0, 0, 241, 195, 0, 0,
+	0, 0, 653, 661, 0, 0, 0, 0, 0, 0,
+	0, 628, 0, 0, 0, 603, 0, 0, 572, 643,
+	642, 619, 626, 0, 0, 137, 620, 0, 625, 0,
+	621, 624, 622, 623, 0, 0, 645, 0, 0, 0,
+	0, 0, 570, 607, 0, 609, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 604, 605, 0, 0,
+	0, 0, 638, 0, 606, 0, 0, 640, 0, 627,
+	0, 128, 246, 260, 138, 237, 275, 142, 244, 134,
+	209, 232, 130, 258, 243, 192, 174, 175, 129, 0,
+	227, 153, 165, 150, 207, 635, 636, 149, 597, 633,
+	269, 132, 133, 268, 206, 255, 259, 193, 187, 131,
+	257, 191, 186, 178, 157, 170, 219, 185, 220, 171,
+	197, 196, 198, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 271, 0, 0,
+	651, 0, 0, 0, 245, 0, 0, 179, 0, 0,
+	0, 634, 0, 230, 212, 664, 0, 217, 228, 183,
+	256, 222, 261, 247, 270, 0, 223, 124, 248, 152,
+	194, 135, 136, 148, 154, 156, 158, 159, 203, 204,
+	215, 235, 249, 250, 251, 151, 143, 229, 144, 167,
+	145, 125, 238, 146, 126, 216, 254, 0, 164, 225,
+	190, 127, 189, 218, 253, 252, 279, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 162, 0, 266, 649,
+	208, 663, 644, 646, 647, 650, 654, 655, 656, 657,
+	658, 660, 662, 665, 233, 0, 0, 0, 0, 0,
+	173, 214, 0, 234, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 242, 264, 277, 596,
+	0, 0, 0, 276, 0, 0, 0, 0, 0, 639,
+	199, 200, 201, 202, 652, 141, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 161, 166, 0, 168,
+	140, 213, 163, 274, 176, 205, 172, 239, 177, 184,
+	226, 273, 211, 231, 139, 263, 240, 188, 671, 648,
+	670, 672, 673, 669, 674, 675, 659, 612, 0, 667,
+	666, 668, 0, 123, 0, 181, 272, 224, 160, 87,
+	574, 575, 576, 577, 578, 579, 580, 95, 581, 97,
+	98, 582, 100, 583, 102, 584, 104, 105, 106, 585,
+	586, 587, 588, 111, 589, 590, 591, 592, 116, 117,
+	118, 119, 593, 594, 595, 0, 0, 280, 281, 282,
+	637, 236, 147, 262, 221, 169, 265, 0, 0, 0,
+	210, 0, 0, 0, 0, 0, 611, 0, 0, 0,
+	155, 789, 0, 0, 180, 0, 182, 0, 0, 241,
+	195, 0, 0, 0, 0, 653, 661, 0, 0, 0,
+	0, 0, 0, 0, 628, 0, 0, 0, 603, 0,
+	0, 572, 643, 642, 619, 626, 0, 0, 137, 620,
+	0, 625, 0, 621, 624, 622, 623, 0, 0, 645,
+	0, 0, 0, 0, 0, 570, 607, 0, 609, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 604,
+	605, 0, 0, 0, 0, 638, 0, 606, 0, 0,
+	640, 0, 627, 0, 128, 246, 260, 138, 237, 275,
+	142, 244, 134, 209, 232, 130, 258, 243, 192, 174,
+	175, 129, 0, 227, 153, 165, 150, 207, 635, 636,
+	149, 597, 633, 269, 132, 133, 268, 206, 255, 259,
+	193, 187, 131, 257, 191, 186, 178, 157, 170, 219,
+	185, 220, 171, 197, 196, 198, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	271, 0, 0, 651, 0, 0, 0, 245, 0, 0,
+	179, 0, 0, 0, 634, 0, 230, 212, 664, 0,
+	217, 228, 183, 256, 222, 261, 247, 270, 0, 223,
+	124, 248, 152, 194, 135, 136, 148, 154, 156, 158,
+	159, 203, 204, 215, 235, 249, 250, 251, 151, 143,
+	229, 144, 167, 145, 125, 238, 146, 126, 216, 254,
+	0, 164, 225, 190, 127, 189, 218, 253, 252, 279,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 162,
+	0, 266, 649, 208, 663, 644, 646, 647, 650, 654,
+	655, 656, 657, 658, 660, 662, 665, 233, 0, 0,
+	0, 0, 0, 173, 214, 0, 234, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 242,
+	264, 277, 596, 0, 0, 0, 276, 0, 0, 0,
+	0, 0, 639, 199, 200, 201, 202, 652, 141, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
+	166, 0, 168, 140, 213, 163, 274, 176, 205, 172,
+	239, 177, 184, 226, 273, 211, 231, 139, 263, 240,
+	188, 671, 648, 670, 672, 673, 669, 674, 675, 659,
+	612, 0, 667, 666, 668, 0, 123, 0, 181, 272,
+	224, 160, 87, 574, 575, 576, 577, 578, 579, 580,
+	95, 581, 97, 98, 582, 100, 583, 102, 584, 104,
+	105, 106, 585, 586, 587, 588, 111, 589, 590, 591,
+	592, 116, 117, 118, 119, 593, 594, 595, 0, 0,
+	280, 281, 282, 0, 236, 147, 262, 221, 169, 265,
+	78, 0, 637, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 210, 0, 0, 0, 0, 0, 611, 0,
+	0, 0, 155, 0, 0, 0, 180, 0, 182, 0,
+	0, 241, 195, 0, 0, 0, 0, 653, 661, 0,
+	0, 0, 0, 0, 0, 0, 628, 0, 0, 0,
+	603, 0, 0, 572, 643, 642, 619, 626, 0, 0,
+	137, 620, 0, 625, 0, 621, 624, 622, 623, 0,
+	0, 645, 0, 0, 0, 0, 0, 570, 607, 0,
+	609, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 604, 605, 0, 0, 0, 0, 638, 0, 606,
+	0, 0, 640, 0, 627, 0, 128, 246, 260, 138,
+	237, 275, 142, 244, 134, 209, 232, 130, 258, 243,
+	192, 174, 175, 129, 0, 227, 153, 165, 150, 207,
+	635, 636, 149, 597, 633, 269, 132, 133, 268, 206,
+	255, 259, 193, 187, 131, 257, 191, 186, 178, 157,
+	170, 219, 185, 220, 171, 197, 196, 198, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 271, 0, 0, 651, 0, 0, 0, 245,
+	0, 0, 179, 0, 0, 0, 634, 0, 230, 212,
+	664, 0, 217, 228, 183, 256, 222, 261, 247, 270,
+	0, 223, 124, 248, 152, 194, 135, 136, 148, 154,
+	156, 158, 159, 203, 204, 215, 235, 249, 250, 251,
+	151, 143, 229, 144, 167, 145, 125, 238, 146, 126,
+	216, 254, 0, 164, 225, 190, 127, 189, 218, 253,
+	252, 279, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 162, 0, 266, 649, 208, 663, 644, 646, 647,
+	650, 654, 655, 656, 657, 658, 660, 662, 665, 233,
+	0, 0, 0, 0, 0, 173, 214, 0, 234, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 242, 264, 277, 596, 0, 0, 0, 276, 0,
+	0, 0, 0, 0, 639, 199, 200, 201, 202, 652,
+	141, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 161, 166, 0, 168, 140, 213, 163, 274, 176,
+	205, 172, 239, 177, 184, 226, 273, 211, 231, 139,
+	263, 240, 188, 671, 648, 670, 672, 673, 669, 674,
+	675, 659, 612, 0, 667, 666, 668, 0, 123, 0,
+	181, 272, 224, 160, 87, 574, 575, 576, 577, 578,
+	579, 580, 95, 581, 97, 98, 582, 100, 583, 102,
+	584, 104, 105, 106, 585, 586, 587, 588, 111, 589,
+	590, 591, 592, 116, 117, 118, 119, 593, 594, 595,
+	0, 0, 280, 281, 282, 637, 236, 147, 262, 221,
+	169, 265, 0, 0, 0, 210, 0, 0, 0, 0,
+	0, 611, 0, 0, 0, 155, 0, 0, 0, 180,
+	0, 182, 0, 0, 241, 195, 0, 0, 0, 0,
+	653, 661, 0, 0, 0, 0, 0, 0, 0, 628,
+	0, 0, 0, 603, 0, 0, 572, 643, 642, 619,
+	626, 0, 0, 137, 620, 0, 625, 0, 621, 624,
+	622, 623, 0, 0, 645, 0, 0, 0, 0, 0,
+	570, 607, 0, 609, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 604, 605, 567, 0, 0, 0,
+	638, 0, 606, 0, 0, 640, 0, 627, 0, 128,
+	246, 260, 138, 237, 275, 142, 244, 134, 209, 232,
+	130, 258, 243, 192, 174, 175, 129, 0, 227, 153,
+	165, 150, 207, 635, 636, 149, 597, 633, 269, 132,
+	133, 268, 206, 255, 259, 193, 187, 131, 257, 191,
+	186, 178, 157, 170, 219, 185, 220, 171, 197, 196,
+	198, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 271, 0, 0, 651, 0,
+	0, 0, 245, 0, 0, 179, 0, 0, 0, 634,
+	0, 230, 212, 664, 0, 217, 228, 183, 256, 222,
+	261, 247, 270, 0, 223, 124, 248, 152, 194, 135,
+	136, 148, 154, 156, 158, 159, 203, 204, 215, 235,
+	249, 250, 251, 151, 143, 229, 144, 167, 145, 125,
+	238, 146, 126, 216, 254, 0, 164, 225, 190, 127,
+	189, 218, 253, 252, 279, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 162, 0, 266, 649, 208, 663,
+	644, 646, 647, 650, 654, 655, 656, 657, 658, 660,
+	662, 665, 233, 0, 0, 0, 0, 0, 173, 214,
+	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 242, 264, 277, 596, 0, 0,
+	0, 276, 0, 0, 0, 0, 0, 639, 199, 200,
+	201, 202, 652, 141, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 161, 166, 0, 168, 140, 213,
+	163, 274, 176, 205, 172, 239, 177, 184, 226, 273,
+	211, 231, 139, 263, 240, 188, 671, 648, 670, 672,
+	673, 669, 674, 675, 659, 612, 0, 667, 666, 668,
+	0, 123, 0, 181, 272, 224, 160, 87, 574, 575,
+	576, 577, 578, 579, 580, 95, 581, 97, 98, 582,
+	100, 583, 102, 584, 104, 105, 106, 585, 586, 587,
+	588, 111, 589, 590, 591, 592, 116, 117, 118, 119,
+	593, 594, 595, 0, 0, 280, 281, 282, 637, 236,
+	147, 262, 221, 169, 265, 0, 0, 0, 210, 0,
+	0, 0, 0, 0, 611, 0, 0, 0, 155, 0,
+	0, 0, 180, 0, 182, 0, 0, 241, 195, 0,
+	0, 0, 0, 653, 661, 0, 0, 0, 0, 0,
+	0, 0, 628, 0, 0, 0, 603, 0, 0, 572,
+	643, 642, 619, 626, 0, 0, 137, 620, 0, 625,
+	0, 621, 624, 622, 623, 0, 0, 645, 0, 0,
+	0, 0, 0, 570, 607, 0, 609, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 604, 605, 0,
+	0, 0, 0, 638, 0, 606, 0, 0, 640, 0,
+	627, 0, 128, 246, 260, 138, 237, 275, 142, 244,
+	134, 209, 232, 130, 258, 243, 192, 174, 175, 129,
+	0, 227, 153, 165, 150, 207, 635, 636, 149, 597,
+	633, 269, 132, 133, 268, 206, 255, 259, 193, 187,
+	131, 257, 191, 186, 178, 157, 170, 219, 185, 220,
+	171, 197, 196, 198, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 271, 0,
+	0, 651, 0, 0, 0, 245, 0, 0, 179, 0,
+	0, 0, 634, 0, 230, 212, 664, 0, 217, 228,
+	183, 256, 222, 261, 247, 270, 0, 223, 124, 248,
+	152, 194, 135, 136, 148, 154, 156, 158, 159, 203,
+	204, 215, 235, 249, 250, 251, 151, 143, 229, 144,
+	167, 145, 125, 238, 146, 126, 216, 254, 0, 164,
+	225, 190, 127, 189, 218, 253, 252, 279, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 162, 0, 266,
+	649, 208, 663, 644, 646, 647, 650, 654, 655, 656,
+	657, 658, 660, 662, 665, 233, 0, 0, 0, 0,
+	0, 173, 214, 0, 234, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 242, 264, 277,
+	596, 0, 0, 0, 276, 0, 0, 0, 0, 0,
+	639, 199, 200, 201, 202, 652, 141, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 161, 166, 0,
+	168, 140, 213, 163, 274, 176, 205, 172, 239, 177,
+	184, 226, 273, 211, 231, 139, 263, 240, 188, 671,
+	648, 670, 672, 673, 669, 674, 675, 659, 612, 0,
+	667, 666, 668, 0, 123, 0, 181, 272, 224, 160,
+	87, 574, 575, 576, 577, 578, 579, 580, 95, 581,
+	97, 98, 582, 100, 583, 102, 584, 104, 105, 106,
+	585, 586, 587, 588, 111, 589, 590, 591, 592, 116,
+	117, 118, 119, 593, 594, 595, 0, 0, 280, 281,
+	282, 637, 236, 147, 262, 221, 169, 265, 0, 0,
+	0, 210, 0, 0, 0, 0, 0, 611, 0, 0,
+	0, 155, 0, 0, 0, 180, 0, 182, 0, 0,
+	241, 195, 0, 0, 0, 0, 653, 661, 0, 0,
+	0, 0, 0, 0, 0, 628, 0, 0, 0, 603,
+	0, 0, 572, 643, 642, 619, 626, 0, 0, 137,
+	620, 0, 625, 0, 621, 624, 622, 623, 0, 0,
+	645, 0, 0, 0, 0, 0, 0, 607, 0, 609,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	604, 605, 0, 0, 0, 0, 638, 0, 606, 0,
+	0, 640, 0, 627, 0, 128, 246, 260, 138, 237,
+	275, 142, 244, 134, 209, 232, 130, 258, 243, 192,
+	174, 175, 129, 0, 227, 153, 165, 150, 207, 635,
+	636, 149, 597, 633, 269, 132, 133, 268, 206, 255,
+	259, 193, 187, 131, 257, 191, 186, 178, 157, 170,
+	219, 185, 220, 171, 197, 196, 198, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 271, 0, 0, 651, 0, 0, 0, 245, 0,
+	0, 179, 0, 0, 0, 634, 0, 230, 212, 664,
+	0, 217, 228, 183, 256, 222, 261, 247, 270, 0,
+	223, 124, 248, 152, 194, 135, 136, 148, 154, 156,
+	158, 159, 203, 204, 215, 235, 249, 250, 251, 151,
+	143, 229, 144, 167, 145, 125, 238, 146, 126, 216,
+	254, 0, 164, 225, 190, 127, 189, 218, 253, 252,
+	279, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	162, 0, 266, 649, 208, 663, 644, 646, 647, 650,
+	654, 655, 656, 657, 658, 660, 662, 665, 233, 0,
+	0, 0, 0, 0, 173, 214, 0, 234, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	242, 264, 277, 596, 0, 0, 0, 276, 0, 0,
+	0, 0, 0, 639, 199, 200, 201, 202, 652, 141,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	161, 166, 0, 168, 140, 213, 163, 274, 176, 205,
+	172, 239, 177, 184, 226, 273, 211, 231, 139, 263,
+	240, 188, 671, 648, 670, 672, 673, 669, 674, 675,
+	659, 612, 0, 667, 666, 668, 0, 123, 0, 181,
+	272, 224, 160, 87, 574, 575, 576, 577, 578, 579,
+	580, 95, 581, 97, 98, 582, 100, 583, 102, 584,
+	104, 105, 106, 585, 586, 587, 588, 111, 589, 590,
+	591, 592, 116, 117, 118, 119, 593, 594, 595, 0,
+	0, 280, 281, 282, 637, 236, 147, 262, 221, 169,
+	265, 0, 0, 0, 210, 0, 0, 0, 0, 0,
+	611, 0, 0, 0, 155, 0, 0, 0, 180, 0,
+	182, 0, 0, 241, 195, 0, 0, 0, 0, 653,
+	661, 0, 0, 0, 0, 0, 0, 0, 628, 0,
+	0, 0, 0, 0, 0, 572, 643, 642, 619, 626,
+	0, 0, 137, 620, 0, 625, 0, 621, 624, 622,
+	623, 0, 0, 645, 0, 0, 0, 0, 0, 570,
+	607, 0, 609, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 604, 605, 0, 0, 0, 0, 638,
+	0, 606, 0, 0, 640, 0, 627, 0, 128, 246,
+	260, 138, 237, 275, 142, 244, 134, 209, 232, 130,
+	258, 243, 192, 174, 175, 129, 0, 227, 153, 165,
+	150, 207, 635, 636, 149, 597, 633, 269, 132, 133,
+	268, 206, 255, 259, 193, 187, 131, 257, 191, 186,
+	178, 157, 170, 219, 185, 220, 171, 197, 196, 198,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 271, 0, 0, 651, 0, 0,
+	0, 245, 0, 0, 179, 0, 0, 0, 634, 0,
+	230, 212, 664, 0, 217, 228, 183, 256, 222, 261,
+	247, 270, 0, 223, 124, 248, 152, 194, 135, 136,
+	148, 154, 156, 158, 159, 203, 204, 215, 235, 249,
+	250, 251, 151, 143, 229, 144, 167, 145, 125, 238,
+	146, 126, 216, 254, 0, 164, 225, 190, 127, 189,
+	218, 253, 252, 279, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 162, 0, 266, 649, 208, 663, 644,
+	646, 647, 650, 654, 655, 656, 657, 658, 660, 662,
+	665, 233, 0, 0, 0, 0, 0, 173, 214, 0,
+	234, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 242, 264, 277, 596, 0, 0, 0,
+	276, 0, 0, 0, 0, 0, 639, 199, 200, 201,
+	202, 652, 141, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 161, 166, 0, 168, 140, 213, 163,
+	274, 176, 205, 172, 239, 177, 184, 226, 273, 211,
+	231, 139, 263, 240, 188, 671, 648, 670, 672, 673,
+	669, 674, 675, 659, 612, 0, 667, 666, 668, 0,
+	123, 0, 181, 272, 224, 160, 87, 574, 575, 576,
+	577, 578, 579, 580, 95, 581, 97, 98, 582, 100,
+	583, 102, 584, 104, 105, 106, 585, 586, 587, 588,
+	111, 589, 590, 591, 592, 116, 117, 118, 119, 593,
+	594, 595, 0, 0, 280, 281, 282, 0, 236, 147,
+	262, 221, 169, 265, 319, 0, 318, 322, 314, 0,
+	0, 0, 0, 0, 0, 0, 210, 0, 310, 0,
+	0, 0, 0, 0, 0, 0, 155, 0, 0, 329,
+	180, 0, 182, 0, 0, 241, 195, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 332, 0, 0,
+	333, 0, 0, 0, 137, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	128, 246, 260, 138, 237, 275, 142, 244, 134, 209,
+	232, 130, 258, 243, 192, 174, 175, 129, 0, 227,
+	153, 165, 150, 207, 0, 0, 149, 278, 0, 269,
+	132, 133, 268, 206, 255, 259, 193, 187, 131, 257,
+	191, 186, 178, 157, 170, 219, 185, 220, 171, 197,
+	196, 198, 0, 0, 0, 0, 0, 312, 311, 315,
+	0, 0, 0, 0, 0, 317, 271, 0, 0, 0,
+	0, 0, 0, 245, 0, 0, 179, 321, 0, 0,
+	0, 0, 230, 212, 0, 0, 217, 228, 183, 256,
+	222, 313, 247, 270, 0, 337, 124, 248, 152, 194,
+	135, 136, 148, 154, 156, 158, 159, 203, 204, 215,
+	235, 249, 250, 251, 151, 143, 229, 144, 167, 145,
+	125, 238, 146, 126, 216, 254, 0, 164, 225, 190,
+	127, 189, 218, 253, 252, 279, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 162, 0, 266, 0, 208,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 233, 0, 0, 0, 316, 320, 323,
+	214, 324, 325, 0, 0, 326, 327, 328, 0, 0,
+	330, 331, 0, 0, 0, 242, 264, 277, 267, 0,
+	0, 0, 276, 0, 0, 0, 0, 0, 0, 199,
+	200, 201, 202, 0, 141, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 161, 166, 0, 168, 140,
+	213, 163, 274, 176, 205, 172, 239, 177, 184, 226,
+	273, 211, 231, 139, 263, 240, 188, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 123, 0, 181, 272, 224, 160, 87, 88,
 	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
 	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
 	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 207, 0, 274, 275, 276, 259, 0, 0,
-	0, 0, 152, 375, 0, 0, 177, 0, 179, 0,
-	0, 236, 192, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 387, 388, 0, 0, 0, 0, 135, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 389,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 126, 241, 255, 136, 232, 269,
-	140, 239, 132, 206, 228, 128, 253, 238, 189, 171,
-	172, 127, 0, 223, 150, 163, 147, 204, 0, 0,
-	146, 272, 391, 263, 130, 390, 262, 203, 250, 254,
-	190, 184, 129, 252, 188, 183, 175, 154, 167, 216,
-	182, 217, 168, 194, 193, 195, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	265, 0, 0, 0, 0, 0, 0, 240, 0, 0,
-	176, 0, 0, 0, 0, 0, 226, 209, 0, 0,
-	214, 224, 180, 251, 218, 256, 242, 264, 374, 219,
-	122, 243, 149, 191, 133, 134, 145, 151, 153, 155,
-	156, 200, 201, 212, 231, 244, 245, 246, 148, 141,
-	225, 142, 165, 143, 123, 233, 144, 124, 213, 249,
-	0, 162, 221, 187, 125, 186, 215, 248, 247, 273,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 159,
-	0, 260, 0, 205, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 229, 0, 0,
-	0, 0, 0, 170, 211, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 237,
-	258, 271, 261, 0, 0, 0, 270, 0, 0, 0,
-	0, 0, 377, 196, 197, 198, 199, 0, 139, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 158,
-	164, 0, 166, 138, 210, 161, 268, 173, 384, 380,
-	381, 174, 181, 222, 267, 208, 227, 137, 257, 235,
-	382, 160, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 121, 0, 178,
-	266, 220, 157, 85, 86, 87, 88, 89, 90, 91,
-	92, 93, 94, 95, 96, 97, 98, 99, 100, 101,
-	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
-	112, 113, 114, 115, 116, 117, 118, 119, 120, 0,
-	207, 274, 275, 276, 259, 784, 0, 0, 0, 0,
-	152, 0, 0, 0, 177, 0, 179, 0, 0, 236,
-	192, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 82,
-	0, 0, 0, 0, 0, 0, 135, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	781, 782, 780, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 126, 241, 255, 136, 232, 269, 140, 239,
-	132, 206, 228, 128, 253, 238, 189, 171, 172, 127,
-	0, 223, 150, 163, 147, 204, 0, 0, 146, 272,
-	0, 263, 130, 131, 262, 203, 250, 254, 190, 184,
-	129, 252, 188, 183, 175, 154, 167, 216, 182, 217,
-	168, 194, 193, 195, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 265, 0,
-	0, 0, 0, 0, 0, 240, 0, 0, 176, 0,
-	0, 0, 0, 0, 226, 209, 0, 0, 214, 224,
-	180, 251, 218, 256, 242, 264, 0, 219, 122, 243,
-	149, 191, 133, 134, 145, 151, 153, 155, 156, 200,
-	201, 212, 231, 244, 245, 246, 148, 141, 225, 142,
-	165, 143, 123, 233, 144, 124, 213, 249, 0, 162,
-	221, 187, 125, 186, 215, 248, 247, 273, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 159, 0, 260,
-	0, 205, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 229, 0, 0, 0, 0,
-	0, 170, 211, 0, 230, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 237, 258, 271,
-	261, 0, 0, 0, 270, 0, 0, 0, 0, 0,
-	0, 196, 197, 198, 199, 0, 139, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 158, 164, 0,
-	166, 138, 210, 161, 268, 173, 202, 169, 234, 174,
-	181, 222, 267, 208, 227, 137, 257, 235, 185, 160,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 121, 0, 178, 266, 220,
-	157, 85, 86, 87, 88, 89, 90, 91, 92, 93,
-	94, 95, 96, 97, 98, 99, 100, 101, 102, 103,
-	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
-	114, 115, 116, 117, 118, 119, 120, 207, 0, 274,
-	275, 276, 259, 0, 0, 0, 0, 152, 0, 0,
-	0, 177, 0, 179, 0, 0, 236, 192, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 82, 387, 388, 0,
-	0, 0, 0, 135, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 389, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 126,
-	241, 255, 136, 232, 269, 140, 239, 132, 206, 228,
-	128, 253, 238, 189, 171, 172, 127, 0, 223, 150,
-	163, 147, 204, 0, 0, 146, 272, 391, 263, 130,
-	390, 262, 203, 250, 254, 190, 184, 129, 252, 188,
-	183, 175, 154, 167, 216, 182, 217, 168, 194, 193,
-	195, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 265, 0, 0, 0, 0,
-	0, 0, 240, 0, 0, 176, 0, 0, 0, 0,
-	0, 226, 209, 0, 0, 214, 224, 180, 251, 218,
-	256, 242, 264, 0, 219, 122, 243, 149, 191, 133,
-	134, 145, 151, 153, 155, 156, 200, 201, 212, 231,
-	244, 245, 246, 148, 141, 225, 142, 165, 143, 123,
-	233, 144, 124, 213, 249, 0, 162, 221, 187, 125,
-	186, 215, 248, 247, 273, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 159, 0, 260, 0, 205, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 229, 0, 0, 0, 0, 0, 170, 211,
-	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 237, 258, 271, 261, 0, 0,
-	0, 270, 0, 0, 0, 0, 0, 0, 196, 197,
-	198, 199, 0, 139, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 158, 164, 0, 166, 138, 210,
-	161, 268, 173, 384, 380, 381, 174, 181, 222, 267,
-	208, 227, 137, 257, 235, 382, 160, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 121, 0, 178, 266, 220, 157, 85, 86,
+	119, 120, 121, 122, 0, 0, 280, 281, 282, 0,
+	236, 147, 262, 221, 169, 265, 319, 0, 318, 322,
+	314, 0, 0, 0, 0, 0, 0, 0, 210, 0,
+	310, 0, 0, 0, 0, 0, 0, 0, 155, 0,
+	0, 329, 180, 0, 182, 0, 0, 241, 195, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 332,
+	0, 0, 333, 0, 0, 0, 137, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 128, 246, 260, 138, 237, 275, 142, 244,
+	134, 209, 232, 130, 258, 243, 192, 174, 175, 129,
+	0, 227, 153, 165, 150, 207, 0, 0, 149, 278,
+	0, 269, 132, 133, 268, 206, 255, 259, 193, 187,
+	131, 257, 191, 186, 178, 157, 170, 219, 185, 220,
+	171, 197, 196, 198, 0, 0, 0, 0, 0, 312,
+	311, 315, 0, 0, 0, 0, 0, 317, 271, 0,
+	0, 0, 0, 0, 0, 245, 0, 0, 179, 321,
+	0, 0, 0, 0, 230, 212, 0, 0, 217, 228,
+	183, 256, 222, 313, 247, 270, 0, 223, 124, 248,
+	152, 194, 135, 136, 148, 154, 156, 158, 159, 203,
+	204, 215, 235, 249, 250, 251, 151, 143, 229, 144,
+	167, 145, 125, 238, 146, 126, 216, 254, 0, 164,
+	225, 190, 127, 189, 218, 253, 252, 279, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 162, 0, 266,
+	0, 208, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 233, 0, 0, 0, 316,
+	320, 323, 214, 324, 325, 0, 0, 326, 327, 328,
+	0, 0, 330, 331, 0, 0, 0, 242, 264, 277,
+	267, 0, 0, 0, 276, 0, 0, 0, 0, 0,
+	0, 199, 200, 201, 202, 0, 141, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 161, 166, 0,
+	168, 140, 213, 163, 274, 176, 205, 172, 239, 177,
+	184, 226, 273, 211, 231, 139, 263, 240, 188, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 123, 0, 181, 272, 224, 160,
 	87, 88, 89, 90, 91, 92, 93, 94, 95, 96,
 	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
 	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 0, 0, 274, 275, 276, 259,
-	207, 0, 512, 0, 0, 0, 0, 0, 0, 0,
-	152, 513, 0, 0, 177, 0, 179, 0, 0, 236,
-	192, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 326,
-	0, 0, 327, 0, 0, 0, 135, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 126, 241, 255, 136, 232, 269, 140, 239,
-	132, 206, 228, 128, 253, 238, 189, 171, 172, 127,
-	0, 223, 150, 163, 147, 204, 0, 0, 146, 272,
-	0, 263, 130, 131, 262, 203, 250, 254, 190, 184,
-	129, 252, 188, 183, 175, 154, 167, 216, 182, 217,
-	168, 194, 193, 195, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 265, 0,
-	0, 0, 0, 0, 0, 240, 0, 0, 176, 0,
-	0, 0, 0, 0, 226, 209, 0, 0, 214, 224,
-	180, 251, 218, 256, 242, 264, 0, 219, 122, 243,
-	149, 191, 133, 134, 145, 151, 153, 155, 156, 200,
-	201, 212, 231, 244, 245, 246, 148, 141, 225, 142,
-	165, 143, 123, 233, 144, 124, 213, 249, 0, 162,
-	221, 187, 125, 186, 215, 248, 247, 273, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 159, 0, 260,
-	0, 205, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 229, 0, 0, 0, 0,
-	0, 170, 211, 0, 230, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 237, 258, 271,
-	261, 0, 0, 0, 270, 0, 0, 0, 0, 514,
-	0, 196, 197, 198, 199, 0, 139, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 158, 164, 0,
-	166, 138, 210, 161, 268, 173, 202, 169, 234, 174,
-	181, 222, 267, 208, 227, 137, 257, 235, 185, 160,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 121, 0, 178, 266, 220,
-	157, 85, 86, 87, 88, 89, 90, 91, 92, 93,
+	117, 118, 119, 120, 121, 122, 0, 0, 280, 281,
+	282, 210, 236, 147, 262, 221, 169, 265, 0, 0,
+	0, 155, 0, 0, 0, 180, 0, 182, 0, 0,
+	241, 195, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 84, 0, 0, 0, 0, 0, 0, 137,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1446, 1449, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 128, 246, 260, 138, 237,
+	275, 142, 244, 134, 209, 232, 130, 258, 243, 192,
+	174, 175, 129, 0, 227, 153, 165, 150, 207, 0,
+	0, 149, 278, 0, 269, 132, 133, 268, 206, 255,
+	259, 193, 187, 131, 257, 191, 186, 178, 157, 170,
+	219, 185, 220, 171, 197, 196, 198, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1450, 271, 0, 0, 0, 1443, 0, 1442, 245, 1444,
+	1447, 179, 0, 0, 0, 0, 0, 230, 212, 0,
+	0, 217, 228, 183, 256, 222, 261, 247, 270, 0,
+	223, 124, 248, 152, 194, 135, 136, 148, 154, 156,
+	158, 159, 203, 204, 215, 235, 249, 250, 251, 151,
+	143, 229, 144, 167, 145, 125, 238, 146, 126, 216,
+	254, 1448, 164, 225, 190, 127, 189, 218, 253, 252,
+	279, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	162, 0, 266, 0, 208, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 233, 0,
+	0, 0, 0, 0, 173, 214, 0, 234, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	242, 264, 277, 267, 0, 0, 0, 276, 0, 0,
+	0, 0, 0, 0, 199, 200, 201, 202, 0, 141,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	161, 166, 0, 168, 140, 213, 163, 274, 176, 205,
+	172, 239, 177, 184, 226, 273, 211, 231, 139, 263,
+	240, 188, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 123, 0, 181,
+	272, 224, 160, 87, 88, 89, 90, 91, 92, 93,
 	94, 95, 96, 97, 98, 99, 100, 101, 102, 103,
 	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
-	114, 115, 116, 117, 118, 119, 120, 76, 0, 274,
-	275, 276, 259, 0, 0, 0, 0, 0, 0, 207,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 152,
-	0, 0, 0, 177, 0, 179, 0, 0, 236, 192,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 73, 0, 861, 82, 0,
-	0, 0, 0, 0, 0, 135, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 126, 241, 255, 136, 232, 269, 140, 239, 132,
-	206, 228, 128, 253, 238, 189, 171, 172, 127, 0,
-	223, 150, 163, 147, 204, 0, 0, 146, 272, 0,
-	263, 130, 131, 262, 203, 250, 254, 190, 184, 129,
-	252, 188, 183, 175, 154, 167, 216, 182, 217, 168,
-	194, 193, 195, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 265, 0, 0,
-	0, 0, 0, 0, 240, 0, 0, 176, 0, 0,
-	0, 0, 0, 226, 209, 0, 0, 214, 224, 180,
-	251, 218, 256, 242, 264, 0, 219, 122, 243, 149,
-	191, 133, 134, 145, 151, 153, 155, 156, 200, 201,
-	212, 231, 244, 245, 246, 148, 141, 225, 142, 165,
-	143, 123, 233, 144, 124, 213, 249, 0, 162, 221,
-	187, 125, 186, 215, 248, 247, 273, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 159, 0, 260, 0,
-	205, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 229, 0, 0, 0, 0, 0,
-	170, 211, 0, 230, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 237, 258, 271, 261,
-	0, 0, 0, 270, 0, 0, 0, 0, 0, 0,
-	196, 197, 198, 199, 0, 139, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 158, 164, 0, 166,
-	138, 210, 161, 268, 173, 202, 169, 234, 174, 181,
-	222, 267, 208, 227, 137, 257, 235, 185, 160, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 121, 0, 178, 266, 220, 157,
-	85, 86, 87, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
-	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 0, 0, 274, 275,
-	276, 259, 207, 0, 747, 0, 0, 0, 0, 0,
-	0, 0, 152, 0, 0, 0, 177, 0, 179, 0,
-	0, 236, 192, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 326, 0, 0, 327, 0, 0, 0, 135, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 126, 241, 255, 136, 232, 269,
-	140, 239, 132, 206, 228, 128, 253, 238, 189, 171,
-	172, 127, 0, 223, 150, 163, 147, 204, 0, 0,
-	146, 272, 0, 263, 130, 131, 262, 203, 250, 254,
-	190, 184, 129, 252, 188, 183, 175, 154, 167, 216,
-	182, 217, 168, 194, 193, 195, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	265, 0, 0, 0, 0, 0, 0, 240, 0, 0,
-	176, 0, 0, 0, 0, 0, 226, 209, 0, 0,
-	214, 224, 180, 251, 218, 256, 242, 264, 0, 219,
-	122, 243, 149, 191, 133, 134, 145, 151, 153, 155,
-	156, 200, 201, 212, 231, 244, 245, 246, 148, 141,
-	225, 142, 165, 143, 123, 233, 144, 124, 213, 249,
-	0, 162, 221, 187, 125, 186, 215, 248, 247, 273,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 159,
-	0, 260, 0, 205, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 229, 0, 0,
-	0, 0, 0, 170, 211, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 237,
-	258, 271, 261, 0, 0, 0, 270, 0, 0, 0,
-	0, 746, 0, 196, 197, 198, 199, 0, 139, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 158,
-	164, 0, 166, 138, 210, 161, 268, 173, 202, 169,
-	234, 174, 181, 222, 267, 208, 227, 137, 257, 235,
-	185, 160, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 121, 0, 178,
-	266, 220, 157, 85, 86, 87, 88, 89, 90, 91,
+	114, 115, 116, 117, 118, 119, 120, 121, 122, 0,
+	0, 280, 281, 282, 0, 236, 147, 262, 221, 169,
+	265, 78, 0, 23, 40, 24, 0, 0, 0, 0,
+	0, 0, 0, 210, 285, 0, 0, 0, 0, 0,
+	0, 0, 0, 155, 0, 0, 0, 180, 0, 182,
+	0, 0, 241, 195, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 75, 0, 0, 84, 0, 0, 0, 0, 0,
+	0, 137, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 128, 246, 260,
+	138, 237, 275, 142, 244, 134, 209, 232, 130, 258,
+	243, 192, 174, 175, 129, 0, 227, 153, 165, 150,
+	207, 0, 0, 149, 278, 0, 269, 132, 133, 268,
+	206, 255, 259, 193, 187, 131, 257, 191, 186, 178,
+	157, 170, 219, 185, 220, 171, 197, 196, 198, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 288, 0,
+	0, 0, 0, 271, 0, 0, 0, 0, 0, 0,
+	245, 0, 0, 179, 0, 0, 0, 0, 0, 230,
+	212, 0, 0, 217, 228, 183, 256, 222, 261, 247,
+	270, 0, 223, 124, 248, 152, 194, 135, 136, 148,
+	154, 156, 158, 159, 203, 204, 215, 235, 249, 250,
+	251, 151, 143, 229, 144, 167, 145, 125, 238, 146,
+	126, 216, 254, 0, 164, 225, 190, 127, 189, 218,
+	253, 252, 279, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 162, 0, 266, 0, 208, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	233, 0, 0, 0, 0, 0, 173, 214, 0, 234,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 242, 264, 277, 267, 0, 0, 0, 276,
+	0, 0, 0, 0, 0, 0, 199, 200, 201, 202,
+	286, 141, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 161, 166, 0, 168, 140, 213, 163, 274,
+	176, 205, 172, 239, 177, 184, 226, 273, 211, 231,
+	139, 263, 240, 188, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 123,
+	0, 181, 272, 224, 160, 87, 88, 89, 90, 91,
 	92, 93, 94, 95, 96, 97, 98, 99, 100, 101,
 	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
-	112, 113, 114, 115, 116, 117, 118, 119, 120, 207,
-	0, 274, 275, 276, 259, 0, 0, 0, 0, 152,
-	0, 0, 0, 177, 0, 179, 0, 0, 236, 192,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1878, 82, 622,
-	0, 0, 0, 0, 0, 135, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 126, 241, 255, 136, 232, 269, 140, 239, 132,
-	206, 228, 128, 253, 238, 189, 171, 172, 127, 0,
-	223, 150, 163, 147, 204, 0, 0, 146, 272, 0,
-	263, 130, 131, 262, 203, 250, 254, 190, 184, 129,
-	252, 188, 183, 175, 154, 167, 216, 182, 217, 168,
-	194, 193, 195, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 265, 0, 0,
-	0, 0, 0, 0, 240, 0, 0, 176, 0, 0,
-	0, 0, 0, 226, 209, 0, 0, 214, 224, 180,
-	251, 218, 256, 242, 264, 0, 219, 122, 243, 149,
-	191, 133, 134, 145, 151, 153, 155, 156, 200, 201,
-	212, 231, 244, 245, 246, 148, 141, 225, 142, 165,
-	143, 123, 233, 144, 124, 213, 249, 0, 162, 221,
-	187, 125, 186, 215, 248, 247, 273, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 159, 0, 260, 0,
-	205, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 229, 0, 0, 0, 0, 0,
-	170, 211, 0, 230, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 237, 258, 271, 261,
-	0, 0, 0, 270, 0, 0, 0, 0, 0, 0,
-	196, 197, 198, 199, 0, 139, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 158, 164, 0, 166,
-	138, 210, 161, 268, 173, 202, 169, 234, 174, 181,
-	222, 267, 208, 227, 137, 257, 235, 185, 160, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 121, 0, 178, 266, 220, 157,
-	85, 86, 87, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
-	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 207, 0, 274, 275,
-	276, 259, 0, 0, 0, 0, 152, 0, 0, 0,
-	177, 0, 179, 0, 0, 236, 192, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 82, 0, 0, 699, 0,
-	0, 0, 135, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 126, 241,
-	255, 136, 232, 269, 140, 239, 132, 206, 228, 128,
-	253, 238, 189, 171, 172, 127, 0, 223, 150, 163,
-	147, 204, 0, 0, 146, 272, 0, 263, 130, 131,
-	262, 203, 250, 254, 190, 184, 129, 252, 188, 183,
-	175, 154, 167, 216, 182, 217, 168, 194, 193, 195,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 265, 0, 0, 0, 0, 0,
-	0, 240, 0, 0, 176, 0, 0, 0, 0, 0,
-	226, 209, 0, 0, 214, 224, 180, 251, 218, 256,
-	242, 264, 0, 219, 122, 243, 149, 191, 133, 134,
-	145, 151, 153, 155, 156, 200, 201, 212, 231, 244,
-	245, 246, 148, 141, 225, 142, 165, 143, 123, 233,
-	144, 124, 213, 249, 0, 162, 221, 187, 125, 186,
-	215, 248, 247, 273, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 159, 0, 260, 0, 205, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 229, 0, 0, 0, 0, 0, 170, 211, 0,
-	230, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 237, 258, 271, 261, 0, 0, 0,
-	270, 0, 0, 0, 0, 0, 1322, 196, 197, 198,
-	199, 0, 139, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 158, 164, 0, 166, 138, 210, 161,
-	268, 173, 202, 169, 234, 174, 181, 222, 267, 208,
-	227, 137, 257, 235, 185, 160, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 121, 0, 178, 266, 220, 157, 85, 86, 87,
-	88, 89, 90, 91, 92, 93, 94, 95, 96, 97,
-	98, 99, 100, 101, 102, 103, 104, 105, 106, 107,
-	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
-	118, 119, 120, 207, 0, 274, 275, 276, 259, 0,
-	0, 0, 0, 152, 1098, 0, 0, 177, 0, 179,
-	0, 0, 236, 192, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 82, 0, 0, 699, 0, 0, 0, 135,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 126, 241, 255, 136, 232,
-	269, 140, 239, 132, 206, 228, 128, 253, 238, 189,
-	171, 172, 127, 0, 223, 150, 163, 147, 204, 0,
-	0, 146, 272, 0, 263, 130, 131, 262, 203, 250,
-	254, 190, 184, 129, 252, 188, 183, 175, 154, 167,
-	216, 182, 217, 168, 194, 193, 195, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 265, 0, 0, 0, 0, 0, 0, 240, 0,
-	0, 176, 0, 0, 0, 0, 0, 226, 209, 0,
-	0, 214, 224, 180, 251, 218, 256, 242, 264, 0,
-	219, 122, 243, 149, 191, 133, 134, 145, 151, 153,
-	155, 156, 200, 201, 212, 231, 244, 245, 246, 148,
-	141, 225, 142, 165, 143, 123, 233, 144, 124, 213,
-	249, 0, 162, 221, 187, 125, 186, 215, 248, 247,
-	273, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	159, 0, 260, 0, 205, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 229, 0,
-	0, 0, 0, 0, 170, 211, 0, 230, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	237, 258, 271, 261, 0, 0, 0, 270, 0, 0,
-	0, 0, 0, 0, 196, 197, 198, 199, 0, 139,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	158, 164, 0, 166, 138, 210, 161, 268, 173, 202,
-	169, 234, 174, 181, 222, 267, 208, 227, 137, 257,
-	235, 185, 160, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 121, 0,
-	178, 266, 220, 157, 85, 86, 87, 88, 89, 90,
-	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
-	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	207, 0, 274, 275, 276, 259, 0, 0, 0, 0,
-	152, 0, 0, 0, 177, 0, 179, 0, 0, 236,
-	192, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 82,
-	622, 0, 0, 0, 0, 0, 135, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 126, 241, 255, 136, 232, 269, 140, 239,
-	132, 206, 228, 128, 253, 238, 189, 171, 172, 127,
-	0, 223, 150, 163, 147, 204, 0, 0, 146, 272,
-	0, 263, 130, 131, 262, 203, 250, 254, 190, 184,
-	129, 252, 188, 183, 175, 154, 167, 216, 182, 217,
-	168, 194, 193, 195, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 265, 0,
-	0, 0, 0, 0, 0, 240, 0, 0, 176, 0,
-	0, 0, 0, 0, 226, 209, 0, 0, 214, 224,
-	180, 251, 218, 256, 242, 264, 0, 219, 122, 243,
-	149, 191, 133, 134, 145, 151, 153, 155, 156, 200,
-	201, 212, 231, 244, 245, 246, 148, 141, 225, 142,
-	165, 143, 123, 233, 144, 124, 213, 249, 0, 162,
-	221, 187, 125, 186, 215, 248, 247, 273, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 159, 0, 260,
-	0, 205, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 229, 0, 0, 0, 0,
-	0, 170, 211, 0, 230, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 237, 258, 271,
-	261, 0, 0, 0, 270, 0, 0, 0, 0, 0,
-	0, 196, 197, 198, 199, 0, 139, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 158, 164, 0,
-	166, 138, 210, 161, 268, 173, 202, 169, 234, 174,
-	181, 222, 267, 208, 227, 137, 257, 235, 185, 160,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 121, 0, 178, 266, 220,
-	157, 85, 86, 87, 88, 89, 90, 91, 92, 93,
-	94, 95, 96, 97, 98, 99, 100, 101, 102, 103,
-	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
-	114, 115, 116, 117, 118, 119, 120, 207, 0, 274,
-	275, 276, 259, 0, 0, 0, 0, 152, 0, 0,
-	0, 177, 0, 179, 0, 0, 236, 192, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1553, 0, 0, 82, 0, 0, 0,
-	0, 0, 0, 135, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 126,
-	241, 255, 136, 232, 269, 140, 239, 132, 206, 228,
-	128, 253, 238, 189, 171, 172, 127, 0, 223, 150,
-	163, 147, 204, 0, 0, 146, 272, 0, 263, 130,
-	131, 262, 203, 250, 254, 190, 184, 129, 252, 188,
-	183, 175, 154, 167, 216, 182, 217, 168, 194, 193,
-	195, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 265, 0, 0, 0, 0,
-	0, 0, 240, 0, 0, 176, 0, 0, 0, 0,
-	0, 226, 209, 0, 0, 214, 224, 180, 251, 218,
-	256, 242, 264, 0, 219, 122, 243, 149, 191, 133,
-	134, 145, 151, 153, 155, 156, 200, 201, 212, 231,
-	244, 245, 246, 148, 141, 225, 142, 165, 143, 123,
-	233, 144, 124, 213, 249, 0, 162, 221, 187, 125,
-	186, 215, 248, 247, 273, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 159, 0, 260, 0, 205, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 229, 0, 0, 0, 0, 0, 170, 211,
-	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 237, 258, 271, 261, 0, 0,
-	0, 270, 0, 0, 0, 0, 0, 0, 196, 197,
-	198, 199, 0, 139, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 158, 164, 0, 166, 138, 210,
-	161, 268, 173, 202, 169, 234, 174, 181, 222, 267,
-	208, 227, 137, 257, 235, 185, 160, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 121, 0, 178, 266, 220, 157, 85, 86,
-	87, 88, 89, 90, 91, 92, 93, 94, 95, 96,
-	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
-	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 207, 0, 274, 275, 276, 259,
-	0, 0, 0, 0, 152, 0, 0, 0, 177, 0,
-	179, 0, 0, 236, 192, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 0, 0, 699, 0, 0, 0,
-	135, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 126, 241, 255, 136,
-	232, 269, 140, 239, 132, 206, 228, 128, 253, 238,
-	189, 171, 172, 127, 0, 223, 150, 163, 147, 204,
-	0, 0, 146, 272, 0, 263, 130, 131, 262, 203,
-	250, 254, 190, 184, 129, 252, 188, 183, 175, 154,
-	167, 216, 182, 217, 168, 194, 193, 195, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 265, 0, 0, 0, 0, 0, 0, 240,
-	0, 0, 176, 0, 0, 0, 0, 0, 226, 209,
-	0, 0, 214, 224, 180, 251, 218, 256, 242, 264,
-	0, 219, 122, 243, 149, 191, 133, 134, 145, 151,
-	153, 155, 156, 200, 201, 212, 231, 244, 245, 246,
-	148, 141, 225, 142, 165, 143, 123, 233, 144, 124,
-	213, 249, 0, 162, 221, 187, 125, 186, 215, 248,
-	247, 273, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 159, 0, 260, 0, 205, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 229,
-	0, 0, 0, 0, 0, 170, 211, 0, 230, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 237, 258, 271, 261, 0, 0, 0, 270, 0,
-	0, 0, 0, 0, 0, 196, 197, 198, 199, 0,
-	139, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 158, 164, 0, 166, 138, 210, 161, 268, 173,
-	202, 169, 234, 174, 181, 222, 267, 208, 227, 137,
-	257, 235, 185, 160, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 121,
-	0, 178, 266, 220, 157, 85, 86, 87, 88, 89,
-	90, 91, 92, 93, 94, 95, 96, 97, 98, 99,
-	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
-	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
-	120, 207, 0, 274, 275, 276, 259, 0, 0, 0,
-	0, 152, 0, 0, 0, 177, 0, 179, 0, 0,
-	236, 192, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	82, 0, 0, 0, 0, 0, 0, 135, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1385, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 126, 241, 255, 136, 232, 269, 140,
-	239, 132, 206, 228, 128, 253, 238, 189, 171, 172,
-	127, 0, 223, 150, 163, 147, 204, 0, 0, 146,
-	272, 0, 263, 130, 131, 262, 203, 250, 254, 190,
-	184, 129, 252, 188, 183, 175, 154, 167, 216, 182,
-	217, 168, 194, 193, 195, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 265,
-	0, 0, 0, 0, 0, 0, 240, 0, 0, 176,
-	0, 0, 0, 0, 0, 226, 209, 0, 0, 214,
-	224, 180, 251, 218, 256, 242, 264, 0, 219, 122,
-	243, 149, 191, 133, 134, 145, 151, 153, 155, 156,
-	200, 201, 212, 231, 244, 245, 246, 148, 141, 225,
-	142, 165, 143, 123, 233, 144, 124, 213, 249, 0,
-	162, 221, 187, 125, 186, 215, 248, 247, 273, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 159, 0,
-	260, 0, 205, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 229, 0, 0, 0,
-	0, 0, 170, 211, 0, 230, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 237, 258,
-	271, 261, 0, 0, 0, 270, 0, 0, 0, 0,
-	0, 0, 196, 197, 198, 199, 0, 139, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 158, 164,
-	0, 166, 138, 210, 161, 268, 173, 202, 169, 234,
-	174, 181, 222, 267, 208, 227, 137, 257, 235, 185,
-	160, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 121, 0, 178, 266,
-	220, 157, 85, 86, 87, 88, 89, 90, 91, 92,
-	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
-	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 119, 120, 207, 0,
-	274, 275, 276, 259, 0, 0, 0, 0, 152, 0,
-	0, 0, 177, 0, 179, 0, 0, 236, 192, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 295, 0, 0, 82, 0, 0,
-	0, 0, 0, 0, 135, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	126, 241, 255, 136, 232, 269, 140, 239, 132, 206,
-	228, 128, 253, 238, 189, 171, 172, 127, 0, 223,
-	150, 163, 147, 204, 0, 0, 146, 272, 0, 263,
-	130, 131, 262, 203, 250, 254, 190, 184, 129, 252,
-	188, 183, 175, 154, 167, 216, 182, 217, 168, 194,
-	193, 195, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 265, 0, 0, 0,
-	0, 0, 0, 240, 0, 0, 176, 0, 0, 0,
-	0, 0, 226, 209, 0, 0, 214, 224, 180, 251,
-	218, 256, 242, 264, 0, 219, 122, 243, 149, 191,
-	133, 134, 145, 151, 153, 155, 156, 200, 201, 212,
-	231, 244, 245, 246, 148, 141, 225, 142, 165, 143,
-	123, 233, 144, 124, 213, 249, 0, 162, 221, 187,
-	125, 186, 215, 248, 247, 273, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 159, 0, 260, 0, 205,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 229, 0, 0, 0, 0, 0, 170,
-	211, 0, 230, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 237, 258, 271, 261, 0,
-	0, 0, 270, 0, 0, 0, 0, 0, 0, 196,
-	197, 198, 199, 0, 139, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 158, 164, 0, 166, 138,
-	210, 161, 268, 173, 202, 169, 234, 174, 181, 222,
-	267, 208, 227, 137, 257, 235, 185, 160, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 121, 0, 178, 266, 220, 157, 85,
-	86, 87, 88, 89, 90, 91, 92, 93, 94, 95,
-	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
-	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
-	116, 117, 118, 119, 120, 207, 0, 274, 275, 276,
-	259, 0, 0, 0, 0, 152, 0, 0, 0, 177,
-	0, 179, 0, 0, 236, 192, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 82, 0, 0, 0, 0, 0,
-	0, 135, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1111, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 126, 241, 255,
-	136, 232, 269, 140, 239, 132, 206, 228, 128, 253,
-	238, 189, 171, 172, 127, 0, 223, 150, 163, 147,
-	204, 0, 0, 146, 272, 0, 263, 130, 131, 262,
-	203, 250, 254, 190, 184, 129, 252, 188, 183, 175,
-	154, 167, 216, 182, 217, 168, 194, 193, 195, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 265, 0, 0, 0, 0, 0, 0,
-	240, 0, 0, 176, 0, 0, 0, 0, 0, 226,
-	209, 0, 0, 214, 224, 180, 251, 218, 256, 242,
-	264, 0, 219, 122, 243, 149, 191, 133, 134, 145,
-	151, 153, 155, 156, 200, 201, 212, 231, 244, 245,
-	246, 148, 141, 225, 142, 165, 143, 123, 233, 144,
-	124, 213, 249, 0, 162, 221, 187, 125, 186, 215,
-	248, 247, 273, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 159, 0, 260, 0, 205, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	229, 0, 0, 0, 0, 0, 170, 211, 0, 230,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 237, 258, 271, 261, 0, 0, 0, 270,
-	0, 0, 0, 0, 0, 0, 196, 197, 198, 199,
-	0, 139, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 158, 164, 0, 166, 138, 210, 161, 268,
-	173, 202, 169, 234, 174, 181, 222, 267, 208, 227,
-	137, 257, 235, 185, 160, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	121, 0, 178, 266, 220, 157, 85, 86, 87, 88,
+	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
+	122, 0, 0, 280, 281, 282, 210, 236, 147, 262,
+	221, 169, 265, 0, 0, 0, 155, 385, 0, 0,
+	180, 0, 182, 0, 0, 241, 195, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 84, 393, 394,
+	0, 0, 0, 0, 137, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 398, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	128, 246, 260, 138, 237, 275, 142, 244, 134, 209,
+	232, 130, 258, 243, 192, 174, 175, 129, 0, 227,
+	153, 165, 150, 207, 0, 0, 149, 278, 400, 269,
+	132, 399, 268, 206, 255, 259, 193, 187, 131, 257,
+	191, 186, 178, 157, 170, 219, 185, 220, 171, 197,
+	196, 198, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 271, 0, 0, 0,
+	0, 0, 0, 245, 0, 0, 179, 0, 0, 0,
+	0, 0, 230, 212, 0, 0, 217, 228, 183, 256,
+	222, 261, 247, 270, 384, 223, 124, 248, 152, 194,
+	135, 136, 148, 154, 156, 158, 159, 203, 204, 215,
+	235, 249, 250, 251, 151, 143, 229, 144, 167, 145,
+	125, 238, 146, 126, 216, 254, 0, 164, 225, 190,
+	127, 189, 218, 253, 252, 279, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 162, 0, 266, 0, 208,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 233, 0, 0, 0, 0, 0, 173,
+	214, 0, 234, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 242, 264, 277, 267, 0,
+	0, 0, 276, 0, 0, 0, 0, 0, 387, 199,
+	200, 201, 202, 0, 141, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 161, 166, 0, 168, 140,
+	213, 163, 274, 176, 395, 390, 391, 177, 184, 226,
+	273, 211, 231, 139, 263, 240, 392, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 123, 0, 181, 272, 224, 160, 87, 88,
 	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
 	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
 	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 207, 0, 274, 275, 276, 259, 0, 0,
-	0, 0, 152, 0, 0, 0, 177, 0, 179, 0,
-	0, 236, 192, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 326, 0, 0, 327, 0, 0, 0, 135, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 126, 241, 255, 136, 232, 269,
-	140, 239, 132, 206, 228, 128, 253, 238, 189, 171,
-	172, 127, 0, 223, 150, 163, 147, 204, 0, 0,
-	146, 272, 0, 263, 130, 131, 262, 203, 250, 254,
-	190, 184, 129, 252, 188, 183, 175, 154, 167, 216,
-	182, 217, 168, 194, 193, 195, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	265, 0, 0, 0, 0, 0, 0, 240, 0, 0,
-	176, 0, 0, 0, 0, 0, 226, 209, 0, 0,
-	214, 224, 180, 251, 218, 256, 242, 264, 0, 219,
-	122, 243, 149, 191, 133, 134, 145, 151, 153, 155,
-	156, 200, 201, 212, 231, 244, 245, 246, 148, 141,
-	225, 142, 165, 143, 123, 233, 144, 124, 213, 249,
-	0, 162, 221, 187, 125, 186, 215, 248, 247, 273,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 159,
-	0, 260, 0, 205, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 229, 0, 0,
-	0, 0, 0, 170, 211, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 237,
-	258, 271, 261, 0, 0, 0, 270, 0, 0, 0,
-	0, 0, 0, 196, 197, 198, 199, 0, 139, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 158,
-	164, 0, 166, 138, 210, 161, 268, 173, 202, 169,
-	234, 174, 181, 222, 267, 208, 227, 137, 257, 235,
-	185, 160, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 121, 0, 178,
-	266, 220, 157, 85, 86, 87, 88, 89, 90, 91,
-	92, 93, 94, 95, 96, 97, 98, 99, 100, 101,
-	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
-	112, 113, 114, 115, 116, 117, 118, 119, 120, 207,
-	0, 274, 275, 276, 259, 0, 0, 0, 0, 152,
-	0, 0, 0, 177, 0, 179, 0, 0, 236, 192,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 82, 0,
-	0, 699, 0, 0, 0, 135, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 126, 241, 255, 136, 232, 269, 140, 239, 132,
-	206, 228, 128, 253, 238, 189, 171, 172, 127, 0,
-	223, 150, 163, 147, 204, 0, 0, 146, 272, 0,
-	263, 130, 131, 262, 203, 250, 254, 190, 184, 129,
-	252, 188, 183, 175, 154, 167, 216, 182, 217, 168,
-	194, 193, 195, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 265, 0, 0,
-	0, 0, 0, 0, 240, 0, 0, 176, 0, 0,
-	0, 0, 0, 226, 209, 0, 0, 214, 224, 180,
-	251, 218, 256, 242, 264, 0, 219, 122, 243, 149,
-	191, 133, 134, 145, 151, 153, 155, 156, 200, 201,
-	212, 231, 244, 245, 246, 148, 141, 225, 142, 165,
-	143, 123, 233, 144, 124, 213, 249, 0, 162, 221,
-	187, 125, 186, 215, 248, 247, 273, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 159, 0, 260, 0,
-	205, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 229, 0, 0, 0, 0, 0,
-	170, 211, 0, 230, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 237, 258, 271, 737,
-	0, 0, 0, 270, 0, 0, 0, 0, 0, 0,
-	196, 197, 198, 199, 0, 139, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 158, 164, 0, 166,
-	138, 210, 161, 268, 173, 202, 169, 234, 174, 181,
-	222, 267, 208, 227, 137, 257, 235, 185, 160, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 121, 0, 178, 266, 220, 157,
-	85, 86, 87, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
-	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 207, 0, 274, 275,
-	276, 259, 0, 0, 0, 79, 152, 0, 0, 0,
-	177, 0, 179, 0, 0, 236, 192, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 82, 0, 0, 0, 0,
-	0, 0, 135, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 126, 241,
-	255, 136, 232, 269, 140, 239, 132, 206, 228, 128,
-	253, 238, 189, 171, 172, 127, 0, 223, 150, 163,
-	147, 204, 0, 0, 146, 272, 0, 263, 130, 131,
-	262, 203, 250, 254, 190, 184, 129, 252, 188, 183,
-	175, 154, 167, 216, 182, 217, 168, 194, 193, 195,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 265, 0, 0, 0, 0, 0,
-	0, 240, 0, 0, 176, 0, 0, 0, 0, 0,
-	226, 209, 0, 0, 214, 224, 180, 251, 218, 256,
-	242, 264, 0, 219, 122, 243, 149, 191, 133, 134,
-	145, 151, 153, 155, 156, 200, 201, 212, 231, 244,
-	245, 246, 148, 141, 225, 142, 165, 143, 123, 233,
-	144, 124, 213, 249, 0, 162, 221, 187, 125, 186,
-	215, 248, 247, 273, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 159, 0, 260, 0, 205, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 229, 0, 0, 0, 0, 0, 170, 211, 0,
-	230, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 237, 258, 271, 261, 0, 0, 0,
-	270, 0, 0, 0, 0, 0, 0, 196, 197, 198,
-	199, 0, 139, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 158, 164, 0, 166, 138, 210, 161,
-	268, 173, 202, 169, 234, 174, 181, 222, 267, 208,
-	227, 137, 257, 235, 185, 160, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 121, 0, 178, 266, 220, 157, 85, 86, 87,
-	88, 89, 90, 91, 92, 93, 94, 95, 96, 97,
-	98, 99, 100, 101, 102, 103, 104, 105, 106, 107,
-	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
-	118, 119, 120, 207, 0, 274, 275, 276, 259, 0,
-	0, 0, 0, 152, 0, 0, 0, 177, 0, 179,
-	0, 0, 236, 192, 0, 0, 0, 0, 0, 0,
+	119, 120, 121, 122, 0, 0, 280, 281, 282, 0,
+	236, 147, 262, 221, 169, 265, 210, 0, 0, 0,
+	0, 815, 0, 0, 0, 0, 155, 0, 0, 0,
+	180, 0, 182, 0, 0, 241, 195, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 84, 0, 0,
+	0, 0, 0, 0, 137, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 812, 813,
+	811, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	128, 246, 260, 138, 237, 275, 142, 244, 134, 209,
+	232, 130, 258, 243, 192, 174, 175, 129, 0, 227,
+	153, 165, 150, 207, 0, 0, 149, 278, 0, 269,
+	132, 133, 268, 206, 255, 259, 193, 187, 131, 257,
+	191, 186, 178, 157, 170, 219, 185, 220, 171, 197,
+	196, 198, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 271, 0, 0, 0,
+	0, 0, 0, 245, 0, 0, 179, 0, 0, 0,
+	0, 0, 230, 212, 0, 0, 217, 228, 183, 256,
+	222, 261, 247, 270, 0, 223, 124, 248, 152, 194,
+	135, 136, 148, 154, 156, 158, 159, 203, 204, 215,
+	235, 249, 250, 251, 151, 143, 229, 144, 167, 145,
+	125, 238, 146, 126, 216, 254, 0, 164, 225, 190,
+	127, 189, 218, 253, 252, 279, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 162, 0, 266, 0, 208,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 233, 0, 0, 0, 0, 0, 173,
+	214, 0, 234, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 242, 264, 277, 267, 0,
+	0, 0, 276, 0, 0, 0, 0, 0, 0, 199,
+	200, 201, 202, 0, 141, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 161, 166, 0, 168, 140,
+	213, 163, 274, 176, 205, 172, 239, 177, 184, 226,
+	273, 211, 231, 139, 263, 240, 188, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 123, 0, 181, 272, 224, 160, 87, 88,
+	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
+	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
+	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
+	119, 120, 121, 122, 0, 0, 280, 281, 282, 210,
+	236, 147, 262, 221, 169, 265, 0, 0, 0, 155,
+	0, 0, 0, 180, 0, 182, 0, 0, 241, 195,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	84, 393, 394, 0, 0, 0, 0, 137, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 398, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 128, 246, 260, 138, 237, 275, 142,
+	244, 134, 209, 232, 130, 258, 243, 192, 174, 175,
+	129, 0, 227, 153, 165, 150, 207, 0, 0, 149,
+	278, 400, 269, 132, 399, 268, 206, 255, 259, 193,
+	187, 131, 257, 191, 186, 178, 157, 170, 219, 185,
+	220, 171, 197, 196, 198, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 271,
+	0, 0, 0, 0, 0, 0, 245, 0, 0, 179,
+	0, 0, 0, 0, 0, 230, 212, 0, 0, 217,
+	228, 183, 256, 222, 261, 247, 270, 0, 223, 124,
+	248, 152, 194, 135, 136, 148, 154, 156, 158, 159,
+	203, 204, 215, 235, 249, 250, 251, 151, 143, 229,
+	144, 167, 145, 125, 238, 146, 126, 216, 254, 0,
+	164, 225, 190, 127, 189, 218, 253, 252, 279, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 162, 0,
+	266, 0, 208, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 233, 0, 0, 0,
+	0, 0, 173, 214, 0, 234, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 242, 264,
+	277, 267, 0, 0, 0, 276, 0, 0, 0, 0,
+	0, 0, 199, 200, 201, 202, 0, 141, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 161, 166,
+	0, 168, 140, 213, 163, 274, 176, 395, 390, 391,
+	177, 184, 226, 273, 211, 231, 139, 263, 240, 392,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 123, 0, 181, 272, 224,
+	160, 87, 88, 89, 90, 91, 92, 93, 94, 95,
+	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
+	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
+	116, 117, 118, 119, 120, 121, 122, 0, 0, 280,
+	281, 282, 0, 236, 147, 262, 221, 169, 265, 210,
+	0, 528, 0, 0, 0, 0, 0, 0, 0, 155,
+	529, 0, 0, 180, 0, 182, 0, 0, 241, 195,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	332, 0, 0, 333, 0, 0, 0, 137, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 128, 246, 260, 138, 237, 275, 142,
+	244, 134, 209, 232, 130, 258, 243, 192, 174, 175,
+	129, 0, 227, 153, 165, 150, 207, 0, 0, 149,
+	278, 0, 269, 132, 133, 268, 206, 255, 259, 193,
+	187, 131, 257, 191, 186, 178, 157, 170, 219, 185,
+	220, 171, 197, 196, 198, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 271,
+	0, 0, 0, 0, 0, 0, 245, 0, 0, 179,
+	0, 0, 0, 0, 0, 230, 212, 0, 0, 217,
+	228, 183, 256, 222, 261, 247, 270, 0, 223, 124,
+	248, 152, 194, 135, 136, 148, 154, 156, 158, 159,
+	203, 204, 215, 235, 249, 250, 251, 151, 143, 229,
+	144, 167, 145, 125, 238, 146, 126, 216, 254, 0,
+	164, 225, 190, 127, 189, 218, 253, 252, 279, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 162, 0,
+	266, 0, 208, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 233, 0, 0, 0,
+	0, 0, 173, 214, 0, 234, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 242, 264,
+	277, 267, 0, 0, 0, 276, 0, 0, 0, 0,
+	530, 0, 199, 200, 201, 202, 0, 141, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 161, 166,
+	0, 168, 140, 213, 163, 274, 176, 205, 172, 239,
+	177, 184, 226, 273, 211, 231, 139, 263, 240, 188,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 123, 0, 181, 272, 224,
+	160, 87, 88, 89, 90, 91, 92, 93, 94, 95,
+	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
+	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
+	116, 117, 118, 119, 120, 121, 122, 78, 0, 280,
+	281, 282, 0, 236, 147, 262, 221, 169, 265, 210,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 155,
+	0, 0, 0, 180, 0, 182, 0, 0, 241, 195,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 75, 0, 899,
+	84, 0, 0, 0, 0, 0, 0, 137, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 128, 246, 260, 138, 237, 275, 142,
+	244, 134, 209, 232, 130, 258, 243, 192, 174, 175,
+	129, 0, 227, 153, 165, 150, 207, 0, 0, 149,
+	278, 0, 269, 132, 133, 268, 206, 255, 259, 193,
+	187, 131, 257, 191, 186, 178, 157, 170, 219, 185,
+	220, 171, 197, 196, 198, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 271,
+	0, 0, 0, 0, 0, 0, 245, 0, 0, 179,
+	0, 0, 0, 0, 0, 230, 212, 0, 0, 217,
+	228, 183, 256, 222, 261, 247, 270, 0, 223, 124,
+	248, 152, 194, 135, 136, 148, 154, 156, 158, 159,
+	203, 204, 215, 235, 249, 250, 251, 151, 143, 229,
+	144, 167, 145, 125, 238, 146, 126, 216, 254, 0,
+	164, 225, 190, 127, 189, 218, 253, 252, 279, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 162, 0,
+	266, 0, 208, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 233, 0, 0, 0,
+	0, 0, 173, 214, 0, 234, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 242, 264,
+	277, 267, 0, 0, 0, 276, 0, 0, 0, 0,
+	0, 0, 199, 200, 201, 202, 0, 141, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 161, 166,
+	0, 168, 140, 213, 163, 274, 176, 205, 172, 239,
+	177, 184, 226, 273, 211, 231, 139, 263, 240, 188,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 123, 0, 181, 272, 224,
+	160, 87, 88, 89, 90, 91, 92, 93, 94, 95,
+	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
+	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
+	116, 117, 118, 119, 120, 121, 122, 0, 0, 280,
+	281, 282, 0, 236, 147, 262, 221, 169, 265, 210,
+	0, 777, 0, 0, 0, 0, 0, 0, 0, 155,
+	0, 0, 0, 180, 0, 182, 0, 0, 241, 195,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	332, 0, 0, 333, 0, 0, 0, 137, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 128, 246, 260, 138, 237, 275, 142,
+	244, 134, 209, 232, 130, 258, 243, 192, 174, 175,
+	129, 0, 227, 153, 165, 150, 207, 0, 0, 149,
+	278, 0, 269, 132, 133, 268, 206, 255, 259, 193,
+	187, 131, 257, 191, 186, 178, 157, 170, 219, 185,
+	220, 171, 197, 196, 198, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 271,
+	0, 0, 0, 0, 0, 0, 245, 0, 0, 179,
+	0, 0, 0, 0, 0, 230, 212, 0, 0, 217,
+	228, 183, 256, 222, 261, 247, 270, 0, 223, 124,
+	248, 152, 194, 135, 136, 148, 154, 156, 158, 159,
+	203, 204, 215, 235, 249, 250, 251, 151, 143, 229,
+	144, 167, 145, 125, 238, 146, 126, 216, 254, 0,
+	164, 225, 190, 127, 189, 218, 253, 252, 279, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 162, 0,
+	266, 0, 208, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 233, 0, 0, 0,
+	0, 0, 173, 214, 0, 234, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 242, 264,
+	277, 267, 0, 0, 0, 276, 0, 0, 0, 0,
+	776, 0, 199, 200, 201, 202, 0, 141, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 161, 166,
+	0, 168, 140, 213, 163, 274, 176, 205, 172, 239,
+	177, 184, 226, 273, 211, 231, 139, 263, 240, 188,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 123, 0, 181, 272, 224,
+	160, 87, 88, 89, 90, 91, 92, 93, 94, 95,
+	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
+	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
+	116, 117, 118, 119, 120, 121, 122, 0, 0, 280,
+	281, 282, 210, 236, 147, 262, 221, 169, 265, 0,
+	0, 0, 155, 0, 0, 0, 180, 0, 182, 0,
+	0, 241, 195, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2036, 84, 643, 0, 0, 0, 0, 0,
+	137, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 128, 246, 260, 138,
+	237, 275, 142, 244, 134, 209, 232, 130, 258, 243,
+	192, 174, 175, 129, 0, 227, 153, 165, 150, 207,
+	0, 0, 149, 278, 0, 269, 132, 133, 268, 206,
+	255, 259, 193, 187, 131, 257, 191, 186, 178, 157,
+	170, 219, 185, 220, 171, 197, 196, 198, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 271, 0, 0, 0, 0, 0, 0, 245,
+	0, 0, 179, 0, 0, 0, 0, 0, 230, 212,
+	0, 0, 217, 228, 183, 256, 222, 261, 247, 270,
+	0, 223, 124, 248, 152, 194, 135, 136, 148, 154,
+	156, 158, 159, 203, 204, 215, 235, 249, 250, 251,
+	151, 143, 229, 144, 167, 145, 125, 238, 146, 126,
+	216, 254, 0, 164, 225, 190, 127, 189, 218, 253,
+	252, 279, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 162, 0, 266, 0, 208, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 233,
+	0, 0, 0, 0, 0, 173, 214, 0, 234, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 242, 264, 277, 267, 0, 0, 0, 276, 0,
+	0, 0, 0, 0, 0, 199, 200, 201, 202, 0,
+	141, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 161, 166, 0, 168, 140, 213, 163, 274, 176,
+	205, 172, 239, 177, 184, 226, 273, 211, 231, 139,
+	263, 240, 188, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 123, 0,
+	181, 272, 224, 160, 87, 88, 89, 90, 91, 92,
+	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
+	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
+	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
+	0, 0, 280, 281, 282, 210, 236, 147, 262, 221,
+	169, 265, 0, 0, 0, 155, 0, 0, 0, 180,
+	0, 182, 0, 0, 241, 195, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 84, 0, 0, 722,
+	0, 0, 0, 137, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 128,
+	246, 260, 138, 237, 275, 142, 244, 134, 209, 232,
+	130, 258, 243, 192, 174, 175, 129, 0, 227, 153,
+	165, 150, 207, 0, 0, 149, 278, 0, 269, 132,
+	133, 268, 206, 255, 259, 193, 187, 131, 257, 191,
+	186, 178, 157, 170, 219, 185, 220, 171, 197, 196,
+	198, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 271, 0, 0, 0, 0,
+	0, 0, 245, 0, 0, 179, 0, 0, 0, 0,
+	0, 230, 212, 0, 0, 217, 228, 183, 256, 222,
+	261, 247, 270, 0, 223, 124, 248, 152, 194, 135,
+	136, 148, 154, 156, 158, 159, 203, 204, 215, 235,
+	249, 250, 251, 151, 143, 229, 144, 167, 145, 125,
+	238, 146, 126, 216, 254, 0, 164, 225, 190, 127,
+	189, 218, 253, 252, 279, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 162, 0, 266, 0, 208, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 233, 0, 0, 0, 0, 0, 173, 214,
+	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 242, 264, 277, 267, 0, 0,
+	0, 276, 0, 0, 0, 0, 0, 1404, 199, 200,
+	201, 202, 0, 141, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 161, 166, 0, 168, 140, 213,
+	163, 274, 176, 205, 172, 239, 177, 184, 226, 273,
+	211, 231, 139, 263, 240, 188, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 123, 0, 181, 272, 224, 160, 87, 88, 89,
+	90, 91, 92, 93, 94, 95, 96, 97, 98, 99,
+	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
+	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
+	120, 121, 122, 0, 0, 280, 281, 282, 210, 236,
+	147, 262, 221, 169, 265, 0, 0, 0, 155, 1151,
+	0, 0, 180, 0, 182, 0, 0, 241, 195, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 84,
+	0, 0, 722, 0, 0, 0, 137, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 128, 246, 260, 138, 237, 275, 142, 244,
+	134, 209, 232, 130, 258, 243, 192, 174, 175, 129,
+	0, 227, 153, 165, 150, 207, 0, 0, 149, 278,
+	0, 269, 132, 133, 268, 206, 255, 259, 193, 187,
+	131, 257, 191, 186, 178, 157, 170, 219, 185, 220,
+	171, 197, 196, 198, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 271, 0,
+	0, 0, 0, 0, 0, 245, 0, 0, 179, 0,
+	0, 0, 0, 0, 230, 212, 0, 0, 217, 228,
+	183, 256, 222, 261, 247, 270, 0, 223, 124, 248,
+	152, 194, 135, 136, 148, 154, 156, 158, 159, 203,
+	204, 215, 235, 249, 250, 251, 151, 143, 229, 144,
+	167, 145, 125, 238, 146, 126, 216, 254, 0, 164,
+	225, 190, 127, 189, 218, 253, 252, 279, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 162, 0, 266,
+	0, 208, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 233, 0, 0, 0, 0,
+	0, 173, 214, 0, 234, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 242, 264, 277,
+	267, 0, 0, 0, 276, 0, 0, 0, 0, 0,
+	0, 199, 200, 201, 202, 0, 141, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 161, 166, 0,
+	168, 140, 213, 163, 274, 176, 205, 172, 239, 177,
+	184, 226, 273, 211, 231, 139, 263, 240, 188, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 123, 0, 181, 272, 224, 160,
+	87, 88, 89, 90, 91, 92, 93, 94, 95, 96,
+	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
+	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
+	117, 118, 119, 120, 121, 122, 0, 0, 280, 281,
+	282, 210, 236, 147, 262, 221, 169, 265, 0, 0,
+	0, 155, 0, 0, 0, 180, 0, 182, 0, 0,
+	241, 195, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 82, 0, 0, 0, 0, 0, 0, 135,
+	0, 0, 84, 643, 0, 0, 0, 0, 0, 137,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 126, 241, 255, 136, 232,
-	269, 140, 239, 132, 206, 228, 128, 253, 238, 189,
-	171, 172, 127, 0, 223, 150, 163, 147, 204, 0,
-	0, 146, 272, 0, 263, 130, 131, 262, 203, 250,
-	254, 190, 184, 129, 252, 188, 183, 175, 154, 167,
-	216, 182, 217, 168, 194, 193, 195, 0, 0, 0,
+	0, 0, 0, 0, 0, 128, 246, 260, 138, 237,
+	275, 142, 244, 134, 209, 232, 130, 258, 243, 192,
+	174, 175, 129, 0, 227, 153, 165, 150, 207, 0,
+	0, 149, 278, 0, 269, 132, 133, 268, 206, 255,
+	259, 193, 187, 131, 257, 191, 186, 178, 157, 170,
+	219, 185, 220, 171, 197, 196, 198, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 265, 0, 0, 0, 0, 0, 0, 240, 0,
-	0, 176, 0, 0, 0, 0, 0, 226, 209, 0,
-	0, 214, 224, 180, 251, 218, 256, 242, 264, 0,
-	219, 122, 243, 149, 191, 133, 134, 145, 151, 153,
-	155, 156, 200, 201, 212, 231, 244, 245, 246, 148,
-	141, 225, 142, 165, 143, 123, 233, 144, 124, 213,
-	249, 0, 162, 221, 187, 125, 186, 215, 248, 247,
-	273, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	159, 0, 260, 0, 205, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 229, 0,
-	0, 0, 0, 0, 170, 211, 0, 230, 0, 0,
+	0, 271, 0, 0, 0, 0, 0, 0, 245, 0,
+	0, 179, 0, 0, 0, 0, 0, 230, 212, 0,
+	0, 217, 228, 183, 256, 222, 261, 247, 270, 0,
+	223, 124, 248, 152, 194, 135, 136, 148, 154, 156,
+	158, 159, 203, 204, 215, 235, 249, 250, 251, 151,
+	143, 229, 144, 167, 145, 125, 238, 146, 126, 216,
+	254, 0, 164, 225, 190, 127, 189, 218, 253, 252,
+	279, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	162, 0, 266, 0, 208, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 233, 0,
+	0, 0, 0, 0, 173, 214, 0, 234, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	237, 258, 271, 261, 0, 0, 0, 270, 0, 0,
-	0, 0, 0, 0, 196, 197, 198, 199, 0, 139,
+	242, 264, 277, 267, 0, 0, 0, 276, 0, 0,
+	0, 0, 0, 0, 199, 200, 201, 202, 0, 141,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	158, 164, 0, 166, 138, 210, 161, 268, 173, 202,
-	169, 234, 174, 181, 222, 267, 208, 227, 137, 257,
-	235, 185, 160, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 121, 0,
-	178, 266, 220, 157, 85, 86, 87, 88, 89, 90,
+	161, 166, 0, 168, 140, 213, 163, 274, 176, 205,
+	172, 239, 177, 184, 226, 273, 211, 231, 139, 263,
+	240, 188, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 123, 0, 181,
+	272, 224, 160, 87, 88, 89, 90, 91, 92, 93,
+	94, 95, 96, 97, 98, 99, 100, 101, 102, 103,
+	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
+	114, 115, 116, 117, 118, 119, 120, 121, 122, 0,
+	0, 280, 281, 282, 210, 236, 147, 262, 221, 169,
+	265, 0, 0, 0, 155, 0, 0, 0, 180, 0,
+	182, 0, 0, 241, 195, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1696, 0, 0, 84, 0, 0, 0, 0,
+	0, 0, 137, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 128, 246,
+	260, 138, 237, 275, 142, 244, 134, 209, 232, 130,
+	258, 243, 192, 174, 175, 129, 0, 227, 153, 165,
+	150, 207, 0, 0, 149, 278, 0, 269, 132, 133,
+	268, 206, 255, 259, 193, 187, 131, 257, 191, 186,
+	178, 157, 170, 219, 185, 220, 171, 197, 196, 198,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 271, 0, 0, 0, 0, 0,
+	0, 245, 0, 0, 179, 0, 0, 0, 0, 0,
+	230, 212, 0, 0, 217, 228, 183, 256, 222, 261,
+	247, 270, 0, 223, 124, 248, 152, 194, 135, 136,
+	148, 154, 156, 158, 159, 203, 204, 215, 235, 249,
+	250, 251, 151, 143, 229, 144, 167, 145, 125, 238,
+	146, 126, 216, 254, 0, 164, 225, 190, 127, 189,
+	218, 253, 252, 279, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 162, 0, 266, 0, 208, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 233, 0, 0, 0, 0, 0, 173, 214, 0,
+	234, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 242, 264, 277, 267, 0, 0, 0,
+	276, 0, 0, 0, 0, 0, 0, 199, 200, 201,
+	202, 0, 141, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 161, 166, 0, 168, 140, 213, 163,
+	274, 176, 205, 172, 239, 177, 184, 226, 273, 211,
+	231, 139, 263, 240, 188, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	123, 0, 181, 272, 224, 160, 87, 88, 89, 90,
 	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
 	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
 	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	0, 207, 274, 275, 276, 259, 436, 0, 0, 0,
-	0, 152, 0, 0, 0, 177, 0, 179, 0, 0,
-	236, 192, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	441, 442, 443, 438, 0, 0, 0, 135, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 126, 241, 255, 136, 232, 269, 140,
-	239, 132, 206, 228, 128, 253, 238, 189, 171, 172,
-	127, 0, 223, 150, 163, 147, 204, 0, 0, 146,
-	272, 0, 263, 130, 131, 262, 203, 250, 254, 190,
-	184, 129, 252, 188, 183, 175, 154, 167, 216, 182,
-	217, 168, 194, 193, 195, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 265,
-	0, 0, 0, 0, 0, 0, 240, 0, 0, 176,
-	0, 0, 0, 0, 0, 226, 209, 0, 0, 214,
-	224, 180, 251, 218, 256, 242, 264, 0, 219, 122,
-	243, 149, 191, 133, 134, 145, 151, 153, 155, 156,
-	200, 201, 212, 231, 244, 245, 246, 148, 141, 225,
-	142, 165, 143, 123, 233, 144, 124, 213, 249, 0,
-	162, 221, 187, 125, 186, 215, 248, 247, 273, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 159, 0,
-	260, 0, 205, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 229, 0, 0, 0,
-	0, 0, 170, 211, 0, 230, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 237, 258,
-	271, 261, 0, 0, 0, 270, 0, 0, 0, 0,
-	0, 0, 196, 197, 198, 199, 0, 139, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 158, 164,
-	0, 166, 138, 210, 161, 268, 173, 202, 169, 234,
-	174, 181, 222, 267, 208, 227, 137, 257, 235, 185,
-	160, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 207, 0, 0, 0, 121, 0, 178, 266,
-	220, 157, 152, 0, 0, 0, 177, 0, 179, 0,
-	0, 236, 192, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 441, 442, 443, 438, 0, 0, 0, 135, 0,
-	274, 275, 276, 259, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 126, 241, 255, 136, 232, 269,
-	140, 239, 132, 206, 228, 128, 253, 238, 189, 171,
-	172, 127, 0, 223, 150, 163, 147, 204, 0, 0,
-	146, 272, 0, 263, 130, 131, 262, 203, 250, 254,
-	190, 184, 129, 252, 188, 183, 175, 154, 167, 216,
-	182, 217, 168, 194, 193, 195, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	265, 0, 0, 0, 0, 0, 0, 240, 0, 0,
-	176, 0, 0, 0, 0, 0, 226, 209, 0, 0,
-	214, 224, 180, 251, 218, 256, 242, 264, 0, 219,
-	122, 243, 149, 191, 133, 134, 145, 151, 153, 155,
-	156, 200, 201, 212, 231, 244, 245, 246, 148, 141,
-	225, 142, 165, 143, 123, 233, 144, 124, 213, 249,
-	0, 162, 221, 187, 125, 186, 215, 248, 247, 273,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 159,
-	0, 260, 0, 205, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 229, 0, 0,
-	0, 0, 0, 170, 211, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 237,
-	258, 271, 261, 0, 0, 0, 270, 0, 0, 0,
-	0, 0, 0, 196, 197, 198, 199, 0, 139, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 158,
-	164, 0, 166, 138, 210, 161, 268, 173, 202, 169,
-	234, 174, 181, 222, 267, 208, 227, 137, 257, 235,
-	185, 160, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 207, 0, 0, 0, 121, 0, 178,
-	266, 220, 157, 152, 0, 0, 0, 177, 0, 179,
-	0, 0, 236, 192, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 441, 442, 443, 0, 0, 0, 0, 135,
-	0, 274, 275, 276, 259, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 126, 241, 255, 136, 232,
-	269, 140, 239, 132, 206, 228, 128, 253, 238, 189,
-	171, 172, 127, 0, 223, 150, 163, 147, 204, 0,
-	0, 146, 272, 0, 263, 130, 131, 262, 203, 250,
-	254, 190, 184, 129, 252, 188, 183, 175, 154, 167,
-	216, 182, 217, 168, 194, 193, 195, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 265, 0, 0, 0, 0, 0, 0, 240, 0,
-	0, 176, 0, 0, 0, 0, 0, 226, 209, 0,
-	0, 214, 224, 180, 251, 218, 256, 242, 264, 0,
-	219, 122, 243, 149, 191, 133, 134, 145, 151, 153,
-	155, 156, 200, 201, 212, 231, 244, 245, 246, 148,
-	141, 225, 142, 165, 143, 123, 233, 144, 124, 213,
-	249, 0, 162, 221, 187, 125, 186, 215, 248, 247,
-	273, 1579, 0, 0, 0, 0, 0, 0, 0, 0,
-	159, 0, 260, 0, 205, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1071, 0, 0, 229, 0,
-	0, 0, 0, 0, 170, 211, 0, 230, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	237, 258, 271, 261, 0, 0, 0, 270, 0, 0,
-	1561, 0, 0, 0, 196, 197, 198, 199, 0, 139,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	158, 164, 0, 166, 138, 210, 161, 268, 173, 202,
-	169, 234, 174, 181, 222, 267, 208, 227, 137, 257,
-	235, 185, 160, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 121, 0,
-	178, 266, 220, 157, 0, 76, 0, 23, 39, 24,
-	313, 0, 312, 316, 308, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 304, 64, 0, 0, 0, 71,
-	0, 0, 0, 0, 0, 323, 0, 0, 0, 0,
-	0, 0, 274, 275, 276, 259, 0, 0, 40, 0,
-	0, 0, 0, 73, 0, 0, 0, 0, 0, 0,
-	0, 1565, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1569, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1558, 0, 0, 0, 1560, 1562, 1564, 0,
-	1566, 1567, 1568, 1570, 1571, 1572, 1574, 1575, 1576, 1577,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 67,
-	68, 0, 69, 70, 0, 0, 0, 0, 0, 0,
-	0, 0, 1580, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1578, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 56, 66, 74, 1557,
-	38, 306, 305, 309, 0, 0, 0, 0, 0, 311,
-	0, 0, 0, 0, 1573, 0, 65, 63, 62, 0,
-	1563, 315, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 692, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 48, 0, 0, 0, 0, 0, 49, 0,
-	0, 310, 314, 693, 0, 318, 694, 0, 0, 320,
-	321, 322, 0, 0, 324, 325, 0, 0, 0, 0,
+	121, 122, 0, 0, 280, 281, 282, 210, 236, 147,
+	262, 221, 169, 265, 0, 0, 0, 155, 0, 0,
+	0, 180, 0, 182, 0, 0, 241, 195, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 84, 0,
+	0, 722, 0, 0, 0, 137, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 128, 246, 260, 138, 237, 275, 142, 244, 134,
+	209, 232, 130, 258, 243, 192, 174, 175, 129, 0,
+	227, 153, 165, 150, 207, 0, 0, 149, 278, 0,
+	269, 132, 133, 268, 206, 255, 259, 193, 187, 131,
+	257, 191, 186, 178, 157, 170, 219, 185, 220, 171,
+	197, 196, 198, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 271, 0, 0,
+	0, 0, 0, 0, 245, 0, 0, 179, 0, 0,
+	0, 0, 0, 230, 212, 0, 0, 217, 228, 183,
+	256, 222, 261, 247, 270, 0, 223, 124, 248, 152,
+	194, 135, 136, 148, 154, 156, 158, 159, 203, 204,
+	215, 235, 249, 250, 251, 151, 143, 229, 144, 167,
+	145, 125, 238, 146, 126, 216, 254, 0, 164, 225,
+	190, 127, 189, 218, 253, 252, 279, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 162, 0, 266, 0,
+	208, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
+	173, 214, 0, 234, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 242, 264, 277, 267,
+	0, 0, 0, 276, 0, 0, 0, 0, 0, 0,
+	199, 200, 201, 202, 0, 141, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 161, 166, 0, 168,
+	140, 213, 163, 274, 176, 205, 172, 239, 177, 184,
+	226, 273, 211, 231, 139, 263, 240, 188, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 123, 0, 181, 272, 224, 160, 87,
+	88, 89, 90, 91, 92, 93, 94, 95, 96, 97,
+	98, 99, 100, 101, 102, 103, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 119, 120, 121, 122, 0, 0, 280, 281, 282,
+	210, 236, 147, 262, 221, 169, 265, 0, 0, 0,
+	155, 0, 0, 0, 180, 0, 182, 0, 0, 241,
+	195, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 50,
+	0, 84, 0, 0, 0, 0, 0, 0, 137, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1517, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 128, 246, 260, 138, 237, 275,
+	142, 244, 134, 209, 232, 130, 258, 243, 192, 174,
+	175, 129, 0, 227, 153, 165, 150, 207, 0, 0,
+	149, 278, 0, 269, 132, 133, 268, 206, 255, 259,
+	193, 187, 131, 257, 191, 186, 178, 157, 170, 219,
+	185, 220, 171, 197, 196, 198, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	271, 0, 0, 0, 0, 0, 0, 245, 0, 0,
+	179, 0, 0, 0, 0, 0, 230, 212, 0, 0,
+	217, 228, 183, 256, 222, 261, 247, 270, 0, 223,
+	124, 248, 152, 194, 135, 136, 148, 154, 156, 158,
+	159, 203, 204, 215, 235, 249, 250, 251, 151, 143,
+	229, 144, 167, 145, 125, 238, 146, 126, 216, 254,
+	0, 164, 225, 190, 127, 189, 218, 253, 252, 279,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 162,
+	0, 266, 0, 208, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 233, 0, 0,
+	0, 0, 0, 173, 214, 0, 234, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 242,
+	264, 277, 267, 0, 0, 0, 276, 0, 0, 0,
+	0, 0, 0, 199, 200, 201, 202, 0, 141, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
+	166, 0, 168, 140, 213, 163, 274, 176, 205, 172,
+	239, 177, 184, 226, 273, 211, 231, 139, 263, 240,
+	188, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 123, 0, 181, 272,
+	224, 160, 87, 88, 89, 90, 91, 92, 93, 94,
+	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
+	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
+	115, 116, 117, 118, 119, 120, 121, 122, 0, 0,
+	280, 281, 282, 210, 236, 147, 262, 221, 169, 265,
+	0, 0, 0, 155, 0, 0, 0, 180, 0, 182,
+	0, 0, 241, 195, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 301, 0, 0, 84, 0, 0, 0, 0, 0,
+	0, 137, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 128, 246, 260,
+	138, 237, 275, 142, 244, 134, 209, 232, 130, 258,
+	243, 192, 174, 175, 129, 0, 227, 153, 165, 150,
+	207, 0, 0, 149, 278, 0, 269, 132, 133, 268,
+	206, 255, 259, 193, 187, 131, 257, 191, 186, 178,
+	157, 170, 219, 185, 220, 171, 197, 196, 198, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 271, 0, 0, 0, 0, 0, 0,
+	245, 0, 0, 179, 0, 0, 0, 0, 0, 230,
+	212, 0, 0, 217, 228, 183, 256, 222, 261, 247,
+	270, 0, 223, 124, 248, 152, 194, 135, 136, 148,
+	154, 156, 158, 159, 203, 204, 215, 235, 249, 250,
+	251, 151, 143, 229, 144, 167, 145, 125, 238, 146,
+	126, 216, 254, 0, 164, 225, 190, 127, 189, 218,
+	253, 252, 279, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 162, 0, 266, 0, 208, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	233, 0, 0, 0, 0, 0, 173, 214, 0, 234,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 242, 264, 277, 267, 0, 0, 0, 276,
+	0, 0, 0, 0, 0, 0, 199, 200, 201, 202,
+	0, 141, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 161, 166, 0, 168, 140, 213, 163, 274,
+	176, 205, 172, 239, 177, 184, 226, 273, 211, 231,
+	139, 263, 240, 188, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 123,
+	0, 181, 272, 224, 160, 87, 88, 89, 90, 91,
+	92, 93, 94, 95, 96, 97, 98, 99, 100, 101,
+	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
+	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
+	122, 0, 0, 280, 281, 282, 210, 236, 147, 262,
+	221, 169, 265, 0, 0, 0, 155, 0, 0, 0,
+	180, 0, 182, 0, 0, 241, 195, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 84, 0, 0,
+	0, 0, 0, 0, 137, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1164, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	128, 246, 260, 138, 237, 275, 142, 244, 134, 209,
+	232, 130, 258, 243, 192, 174, 175, 129, 0, 227,
+	153, 165, 150, 207, 0, 0, 149, 278, 0, 269,
+	132, 133, 268, 206, 255, 259, 193, 187, 131, 257,
+	191, 186, 178, 157, 170, 219, 185, 220, 171, 197,
+	196, 198, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 271, 0, 0, 0,
+	0, 0, 0, 245, 0, 0, 179, 0, 0, 0,
+	0, 0, 230, 212, 0, 0, 217, 228, 183, 256,
+	222, 261, 247, 270, 0, 223, 124, 248, 152, 194,
+	135, 136, 148, 154, 156, 158, 159, 203, 204, 215,
+	235, 249, 250, 251, 151, 143, 229, 144, 167, 145,
+	125, 238, 146, 126, 216, 254, 0, 164, 225, 190,
+	127, 189, 218, 253, 252, 279, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 162, 0, 266, 0, 208,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 233, 0, 0, 0, 0, 0, 173,
+	214, 0, 234, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 242, 264, 277, 267, 0,
+	0, 0, 276, 0, 0, 0, 0, 0, 0, 199,
+	200, 201, 202, 0, 141, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 161, 166, 0, 168, 140,
+	213, 163, 274, 176, 205, 172, 239, 177, 184, 226,
+	273, 211, 231, 139, 263, 240, 188, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 123, 0, 181, 272, 224, 160, 87, 88,
+	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
+	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
+	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
+	119, 120, 121, 122, 0, 0, 280, 281, 282, 210,
+	236, 147, 262, 221, 169, 265, 0, 0, 0, 155,
+	0, 0, 0, 180, 0, 182, 0, 0, 241, 195,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	332, 0, 0, 333, 0, 0, 0, 137, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 128, 246, 260, 138, 237, 275, 142,
+	244, 134, 209, 232, 130, 258, 243, 192, 174, 175,
+	129, 0, 227, 153, 165, 150, 207, 0, 0, 149,
+	278, 0, 269, 132, 133, 268, 206, 255, 259, 193,
+	187, 131, 257, 191, 186, 178, 157, 170, 219, 185,
+	220, 171, 197, 196, 198, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 271,
+	0, 0, 0, 0, 0, 0, 245, 0, 0, 179,
+	0, 0, 0, 0, 0, 230, 212, 0, 0, 217,
+	228, 183, 256, 222, 261, 247, 270, 0, 223, 124,
+	248, 152, 194, 135, 136, 148, 154, 156, 158, 159,
+	203, 204, 215, 235, 249, 250, 251, 151, 143, 229,
+	144, 167, 145, 125, 238, 146, 126, 216, 254, 0,
+	164, 225, 190, 127, 189, 218, 253, 252, 279, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 162, 0,
+	266, 0, 208, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 233, 0, 0, 0,
+	0, 0, 173, 214, 0, 234, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 242, 264,
+	277, 267, 0, 0, 0, 276, 0, 0, 0, 0,
+	0, 0, 199, 200, 201, 202, 0, 141, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 161, 166,
+	0, 168, 140, 213, 163, 274, 176, 205, 172, 239,
+	177, 184, 226, 273, 211, 231, 139, 263, 240, 188,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 123, 0, 181, 272, 224,
+	160, 87, 88, 89, 90, 91, 92, 93, 94, 95,
+	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
+	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
+	116, 117, 118, 119, 120, 121, 122, 0, 0, 280,
+	281, 282, 210, 236, 147, 262, 221, 169, 265, 0,
+	0, 0, 155, 0, 0, 0, 180, 0, 182, 0,
+	0, 241, 195, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 84, 0, 0, 722, 0, 0, 0,
+	137, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 128, 246, 260, 138,
+	237, 275, 142, 244, 134, 209, 232, 130, 258, 243,
+	192, 174, 175, 129, 0, 227, 153, 165, 150, 207,
+	0, 0, 149, 278, 0, 269, 132, 133, 268, 206,
+	255, 259, 193, 187, 131, 257, 191, 186, 178, 157,
+	170, 219, 185, 220, 171, 197, 196, 198, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 271, 0, 0, 0, 0, 0, 0, 245,
+	0, 0, 179, 0, 0, 0, 0, 0, 230, 212,
+	0, 0, 217, 228, 183, 256, 222, 261, 247, 270,
+	0, 223, 124, 248, 152, 194, 135, 136, 148, 154,
+	156, 158, 159, 203, 204, 215, 235, 249, 250, 251,
+	151, 143, 229, 144, 167, 145, 125, 238, 146, 126,
+	216, 254, 0, 164, 225, 190, 127, 189, 218, 253,
+	252, 279, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 162, 0, 266, 0, 208, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 233,
+	0, 0, 0, 0, 0, 173, 214, 0, 234, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 242, 264, 277, 767, 0, 0, 0, 276, 0,
+	0, 0, 0, 0, 0, 199, 200, 201, 202, 0,
+	141, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 161, 166, 0, 168, 140, 213, 163, 274, 176,
+	205, 172, 239, 177, 184, 226, 273, 211, 231, 139,
+	263, 240, 188, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 123, 0,
+	181, 272, 224, 160, 87, 88, 89, 90, 91, 92,
+	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
+	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
+	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
+	0, 0, 280, 281, 282, 210, 236, 147, 262, 221,
+	169, 265, 0, 0, 81, 155, 0, 0, 0, 180,
+	0, 182, 0, 0, 241, 195, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 84, 0, 0, 0,
+	0, 0, 0, 137, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 128,
+	246, 260, 138, 237, 275, 142, 244, 134, 209, 232,
+	130, 258, 243, 192, 174, 175, 129, 0, 227, 153,
+	165, 150, 207, 0, 0, 149, 278, 0, 269, 132,
+	133, 268, 206, 255, 259, 193, 187, 131, 257, 191,
+	186, 178, 157, 170, 219, 185, 220, 171, 197, 196,
+	198, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 271, 0, 0, 0, 0,
+	0, 0, 245, 0, 0, 179, 0, 0, 0, 0,
+	0, 230, 212, 0, 0, 217, 228, 183, 256, 222,
+	261, 247, 270, 0, 223, 124, 248, 152, 194, 135,
+	136, 148, 154, 156, 158, 159, 203, 204, 215, 235,
+	249, 250, 251, 151, 143, 229, 144, 167, 145, 125,
+	238, 146, 126, 216, 254, 0, 164, 225, 190, 127,
+	189, 218, 253, 252, 279, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 162, 0, 266, 0, 208, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 233, 0, 0, 0, 0, 0, 173, 214,
+	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 242, 264, 277, 267, 0, 0,
+	0, 276, 0, 0, 0, 0, 0, 0, 199, 200,
+	201, 202, 0, 141, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 161, 166, 0, 168, 140, 213,
+	163, 274, 176, 205, 172, 239, 177, 184, 226, 273,
+	211, 231, 139, 263, 240, 188, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 123, 0, 181, 272, 224, 160, 87, 88, 89,
+	90, 91, 92, 93, 94, 95, 96, 97, 98, 99,
+	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
+	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
+	120, 121, 122, 0, 0, 280, 281, 282, 210, 236,
+	147, 262, 221, 169, 265, 0, 0, 0, 155, 0,
+	0, 0, 180, 0, 182, 0, 0, 241, 195, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 84,
+	0, 0, 0, 0, 0, 0, 137, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 128, 246, 260, 138, 237, 275, 142, 244,
+	134, 209, 232, 130, 258, 243, 192, 174, 175, 129,
+	0, 227, 153, 165, 150, 207, 0, 0, 149, 278,
+	0, 269, 132, 133, 268, 206, 255, 259, 193, 187,
+	131, 257, 191, 186, 178, 157, 170, 219, 185, 220,
+	171, 197, 196, 198, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 271, 0,
+	0, 0, 0, 0, 0, 245, 0, 0, 179, 0,
+	0, 0, 0, 0, 230, 212, 0, 0, 217, 228,
+	183, 256, 222, 261, 247, 270, 0, 223, 124, 248,
+	152, 194, 135, 136, 148, 154, 156, 158, 159, 203,
+	204, 215, 235, 249, 250, 251, 151, 143, 229, 144,
+	167, 145, 125, 238, 146, 126, 216, 254, 0, 164,
+	225, 190, 127, 189, 218, 253, 252, 279, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 162, 0, 266,
+	0, 208, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 233, 0, 0, 0, 0,
+	0, 173, 214, 0, 234, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 242, 264, 277,
+	267, 0, 0, 0, 276, 0, 0, 0, 0, 0,
+	0, 199, 200, 201, 202, 0, 141, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 161, 166, 0,
+	168, 140, 213, 163, 274, 176, 205, 172, 239, 177,
+	184, 226, 273, 211, 231, 139, 263, 240, 188, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 123, 0, 181, 272, 224, 160,
+	87, 88, 89, 90, 91, 92, 93, 94, 95, 96,
+	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
+	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
+	117, 118, 119, 120, 121, 122, 0, 0, 280, 281,
+	282, 0, 236, 147, 262, 221, 169, 265, 210, 0,
+	0, 0, 0, 446, 0, 0, 0, 0, 155, 0,
+	0, 0, 180, 0, 182, 0, 0, 241, 195, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 451,
+	452, 453, 448, 0, 0, 0, 137, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 128, 246, 260, 138, 237, 275, 142, 244,
+	134, 209, 232, 130, 258, 243, 192, 174, 175, 129,
+	0, 227, 153, 165, 150, 207, 0, 0, 149, 278,
+	0, 269, 132, 133, 268, 206, 255, 259, 193, 187,
+	131, 257, 191, 186, 178, 157, 170, 219, 185, 220,
+	171, 197, 196, 198, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 271, 0,
+	0, 0, 0, 0, 0, 245, 0, 0, 179, 0,
+	0, 0, 0, 0, 230, 212, 0, 0, 217, 228,
+	183, 256, 222, 261, 247, 270, 0, 223, 124, 248,
+	152, 194, 135, 136, 148, 154, 156, 158, 159, 203,
+	204, 215, 235, 249, 250, 251, 151, 143, 229, 144,
+	167, 145, 125, 238, 146, 126, 216, 254, 0, 164,
+	225, 190, 127, 189, 218, 253, 252, 279, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 162, 0, 266,
+	0, 208, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 233, 0, 0, 0, 0,
+	0, 173, 214, 0, 234, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 242, 264, 277,
+	267, 0, 0, 0, 276, 0, 0, 0, 0, 0,
+	0, 199, 200, 201, 202, 0, 141, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 161, 166, 0,
+	168, 140, 213, 163, 274, 176, 205, 172, 239, 177,
+	184, 226, 273, 211, 231, 139, 263, 240, 188, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 210, 123, 0, 181, 272, 224, 160,
+	0, 0, 0, 155, 0, 0, 0, 180, 0, 182,
+	0, 0, 241, 195, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 451, 452, 453, 448, 280, 281,
+	282, 137, 236, 147, 262, 221, 169, 265, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 128, 246, 260,
+	138, 237, 275, 142, 244, 134, 209, 232, 130, 258,
+	243, 192, 174, 175, 129, 0, 227, 153, 165, 150,
+	207, 0, 0, 149, 278, 0, 269, 132, 133, 268,
+	206, 255, 259, 193, 187, 131, 257, 191, 186, 178,
+	157, 170, 219, 185, 220, 171, 197, 196, 198, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 271, 0, 0, 0, 0, 0, 0,
+	245, 0, 0, 179, 0, 0, 0, 0, 0, 230,
+	212, 0, 0, 217, 228, 183, 256, 222, 261, 247,
+	270, 0, 223, 124, 248, 152, 194, 135, 136, 148,
+	154, 156, 158, 159, 203, 204, 215, 235, 249, 250,
+	251, 151, 143, 229, 144, 167, 145, 125, 238, 146,
+	126, 216, 254, 0, 164, 225, 190, 127, 189, 218,
+	253, 252, 279, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 162, 0, 266, 0, 208, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	233, 0, 0, 0, 0, 0, 173, 214, 0, 234,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 242, 264, 277, 267, 0, 0, 0, 276,
+	0, 0, 0, 0, 0, 0, 199, 200, 201, 202,
+	0, 141, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 161, 166, 0, 168, 140, 213, 163, 274,
+	176, 205, 172, 239, 177, 184, 226, 273, 211, 231,
+	139, 263, 240, 188, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 210, 123,
+	0, 181, 272, 224, 160, 0, 0, 0, 155, 0,
+	0, 0, 180, 0, 182, 0, 0, 241, 195, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 451,
+	452, 453, 0, 280, 281, 282, 137, 236, 147, 262,
+	221, 169, 265, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 128, 246, 260, 138, 237, 275, 142, 244,
+	134, 209, 232, 130, 258, 243, 192, 174, 175, 129,
+	0, 227, 153, 165, 150, 207, 0, 0, 149, 278,
+	0, 269, 132, 133, 268, 206, 255, 259, 193, 187,
+	131, 257, 191, 186, 178, 157, 170, 219, 185, 220,
+	171, 197, 196, 198, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 271, 0,
+	0, 0, 0, 0, 0, 245, 0, 0, 179, 0,
+	0, 0, 0, 0, 230, 212, 0, 0, 217, 228,
+	183, 256, 222, 261, 247, 270, 0, 223, 124, 248,
+	152, 194, 135, 136, 148, 154, 156, 158, 159, 203,
+	204, 215, 235, 249, 250, 251, 151, 143, 229, 144,
+	167, 145, 125, 238, 146, 126, 216, 254, 1722, 164,
+	225, 190, 127, 189, 218, 253, 252, 279, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 162, 0, 266,
+	0, 208, 0, 0, 1115, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 233, 0, 0, 0, 0,
+	0, 173, 214, 0, 234, 0, 0, 0, 0, 0,
+	0, 2094, 0, 0, 0, 0, 0, 242, 264, 277,
+	267, 1704, 0, 0, 276, 0, 0, 0, 0, 0,
+	0, 199, 200, 201, 202, 0, 141, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 161, 166, 0,
+	168, 140, 213, 163, 274, 176, 205, 172, 239, 177,
+	184, 226, 273, 211, 231, 139, 263, 240, 188, 0,
+	0, 0, 0, 0, 0, 0, 0, 1722, 0, 0,
+	0, 0, 0, 0, 123, 0, 181, 272, 224, 160,
+	0, 0, 0, 0, 0, 1722, 0, 0, 0, 0,
+	0, 0, 0, 1115, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1115, 0, 0, 0, 0, 0, 0, 280, 281,
+	282, 1785, 236, 147, 262, 221, 169, 265, 0, 0,
+	1704, 0, 1708, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1712, 0, 0, 0, 0, 1704, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1701, 0, 0, 0, 1703, 1705, 1707,
+	0, 1709, 1710, 1711, 1713, 1714, 1715, 1717, 1718, 1719,
+	1720, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1723, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1721, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1700, 1708, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1712, 0, 0, 1716, 0, 0, 0, 1708,
+	0, 1706, 0, 0, 0, 0, 0, 0, 0, 0,
+	1712, 0, 1701, 0, 0, 0, 1703, 1705, 1707, 0,
+	1709, 1710, 1711, 1713, 1714, 1715, 1717, 1718, 1719, 1720,
+	1701, 0, 0, 0, 1703, 1705, 1707, 0, 1709, 1710,
+	1711, 1713, 1714, 1715, 1717, 1718, 1719, 1720, 0, 0,
+	0, 0, 1723, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1723, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1721, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1700,
+	1721, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1716, 0, 0, 1700, 0, 0,
+	1706, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1716, 0, 0, 0, 0, 0, 1706,
 }
 
 var yyPact = [...]int{
-	15919, -1000, -296, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1754, -1000, -286, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 14375, 1684, -1000, 7093, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 178,
+	12763, 14778, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 6268,
+	5846, 85, -1000, 1592, -1000, -1000, -1000, -1000, 127, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 390, 55, 266,
+	270, 304, 304, 7496, 1626, 1297, -14, -1000, 1590, 1754,
+	123, 14778, -1000, 332, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 14048, 1635, -1000, 6877, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 193, 12460,
-	14445, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 6065, 5650,
-	106, -1000, 1592, -1000, -1000, -1000, 109, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 281, -83, 283, 284, 304,
-	304, 7274, 1626, 1351, -17, -1000, 1588, 15919, 148, 14445,
-	-1000, 362, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2612,67 +2668,70 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 12460, 14445, -117,
-	474, -1000, 1119, 353, -1000, -1000, -1000, -1000, 14445, 1366,
-	-1000, -1000, -1000, 1544, 14843, 1351, -1000, 1299, 1237, -1000,
-	-1000, 1465, -1000, 73, -49, -70, 36, -1000, -1000, 125,
-	-1000, -1000, -1000, -1000, -1000, -7, -1000, -56, -1000, -63,
-	-1000, -1000, -1000, -158, -1000, -1000, -1000, -1000, -1000, 1146,
-	312, 1485, -207, -1000, 1536, 1577, 1351, -282, 1616, 1595,
-	172, 172, 188, 172, 192, -1000, -1000, -1000, -1000, -1000,
-	-1000, 491, 146, -1000, -1000, -165, -176, 411, -176, -32,
-	-1000, -1000, -1000, -1000, -1000, -1000, 176, -1000, -210, -1000,
-	269, -1000, 259, -1000, 8472, 124, 1307, 536, -1000, 443,
-	14445, 14445, 14445, 443, 639, 605, 348, -1000, -1000, -1000,
-	1521, 1522, 1577, 1351, -1000, 1122, 976, 176, 176, 176,
-	176, 176, 4014, -1000, -1000, -1000, -1000, -1000, 1345, 1461,
-	-1000, 14445, 1344, -1000, 337, 754, 911, -1000, 14445, 1460,
-	14445, 12460, 12460, 12460, 12460, -1000, 1501, 1500, -1000, 1497,
-	1491, 1505, 15545, -1000, -1000, -1000, 15194, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 1116, 1626, 67, 15924, 11666, 13254,
-	14445, 11666, -1000, -1000, -1000, -1000, -1000, -159, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 67, 11666,
-	11666, -127, -1000, -1000, 1536, 4421, -1000, -1000, 909, 4421,
-	-1000, -1000, 11666, 486, 13254, 804, 14445, 172, 14445, -1000,
-	-1000, 411, 411, -1000, 491, 491, -1000, -1000, -170, 1630,
-	4828, -168, 14445, 172, 13651, 1541, -195, 278, 266, 272,
-	-1000, -1000, -209, -1000, -1000, 1250, 9284, 8069, 145, 11666,
-	2378, -1000, -1000, 443, 443, 443, 2378, 376, -1000, -1000,
-	-1000, -1000, -1000, -1000, 14445, -1000, -1000, 1536, -1000, -1000,
-	-1000, -1000, -1000, 11666, 13254, 14445, 14445, 15545, 1153, -1000,
-	-1000, 7672, 335, 4421, 998, 1459, -1000, 1456, 1455, 1452,
-	1451, 1450, 1447, 1430, 1399, 1427, 1426, -1000, -1000, -1000,
-	1425, 1424, 1415, 1414, 1399, 1412, 1410, 1407, -1000, -1000,
-	891, -1000, -1000, -1000, -1000, 3607, 4828, 4828, 4828, 4828,
-	-1000, -1000, 1405, 1404, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 5235, -1000, 1403,
-	1402, 1399, 1398, 907, 906, 904, 1397, 1396, 1395, 4828,
-	1393, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 12763, 14778, -103, 418, -1000, 1302, 331,
+	-1000, -1000, -1000, -1000, 14778, 1384, -1000, -1000, -1000, 1585,
+	15188, 1297, -1000, 1256, 1294, -1000, -1000, 1448, -1000, 81,
+	-34, -55, 57, -1000, -1000, 94, -1000, -1000, -1000, -1000,
+	-1000, 9, -1000, -41, -1000, -48, -1000, -1000, -1000, -140,
+	-1000, -1000, -1000, -1000, -1000, 1249, 284, 1476, -183, -1000,
+	1577, 1609, 1297, -261, 1657, 1604, 1602, 1600, 146, 146,
+	146, 165, 146, 175, -1000, -1000, -1000, -1000, -1000, -1000,
+	477, 99, -1000, -1000, -155, -148, 357, -148, -12, -1000,
+	-1000, -1000, -1000, -1000, -1000, 14778, 147, -1000, -188, -1000,
+	251, -1000, 241, -1000, 8719, 92, 1287, 585, -1000, 485,
+	14778, 14778, 14778, 485, 485, 577, 383, 327, -1000, 1544,
+	1548, 1609, 1297, -1000, 1154, 1282, 147, 147, 147, 147,
+	147, 4185, -1000, -1000, -1000, -1000, -1000, 1290, 1447, -1000,
+	14778, 1638, -1000, 326, 772, 917, -1000, 14778, 1445, 14778,
+	12763, 12763, 12763, 12763, -1000, 1528, 1527, -1000, 1520, 1510,
+	1507, 1506, 15898, -1000, -1000, -1000, 15543, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1136, 1626, 69, 1180, 11957, 13569,
+	14778, 11957, -1000, -1000, -1000, -1000, -1000, -141, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 69, 11957,
+	11957, -112, -1000, -1000, 1577, 4598, -1000, -1000, 909, 4598,
+	-1000, -1000, -1000, -1000, -1000, -1000, 14778, 438, 11957, 13569,
+	884, 14778, 146, 14778, -1000, -1000, 357, 357, -1000, 477,
+	477, -1000, -1000, -145, 1666, 5011, -161, 14778, 146, 188,
+	13972, 1581, -175, 264, 235, 247, -1000, -1000, 1692, -1000,
+	-1000, 1257, 9539, 8309, 170, 11957, 2524, -1000, -1000, 485,
+	485, 485, 2524, 2524, 285, -1000, -1000, -1000, -1000, -1000,
+	-1000, 14778, -1000, -1000, 1577, -1000, -1000, -1000, -1000, -1000,
+	11957, 13569, 14778, 14778, 15898, 1270, -1000, -1000, 7906, 325,
+	4598, 690, 1441, -1000, 1434, 1430, 1429, 1425, 1424, 1419,
+	1416, 1368, 1411, 1396, 1395, -1000, -1000, -1000, 1394, 1393,
+	1392, 1391, 1368, 1390, 1388, 1387, -1000, -1000, 1432, -1000,
+	-1000, -1000, -1000, 3772, 5011, 5011, 5011, 5011, -1000, 4598,
+	-1000, 1383, 1381, -273, -1000, -1000, -273, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 5424, 908, -1000,
+	1380, 1377, 1368, 1366, 905, 899, 898, 1365, 1363, 1361,
+	5011, 1360, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -280, -1000, 8881, 14445,
-	14445, -1000, 1596, 4421, 1975, -1000, 1305, 320, 14445, 1174,
-	-1000, 459, 1469, 1484, 1469, -1000, -1000, -1000, -1000, 1499,
-	-1000, 1357, -1000, -1000, -1000, -1000, -1000, 466, -1000, -1000,
-	-1000, -1000, -1000, -56, -63, 1206, -1000, -86, 71, -1000,
-	-1000, 1290, -1000, -1000, -1000, 466, 1206, 184, 892, -1000,
-	660, 319, -180, 1297, -1000, 654, 163, 1537, 1250, 1431,
-	1526, 14445, 1630, 1630, 1630, 411, 15545, 491, 14445, 491,
-	-1000, -1000, 491, -1000, 318, 14445, 163, 1388, -1000, -1000,
-	-1000, 274, 250, 263, 13254, 183, -1000, -1000, 1250, -1000,
-	-1000, -1000, 1387, 449, -1000, -1000, 4828, -1000, 608, -1000,
-	2378, 2378, 2378, -1000, 10475, -1000, -1000, 1206, 1250, 1483,
-	1292, -1000, -1000, -1000, -1000, 1630, 4014, -1000, 12460, -1000,
-	4421, 4421, 4421, -1000, 14445, 12857, -1000, 518, 4828, -1000,
-	-1000, -1000, -1000, -1000, -1000, 4421, 1593, 1593, 1593, 4421,
-	494, 4421, 4421, -1000, 627, 1593, 1593, 1593, 4421, 4421,
-	1593, -1000, 1593, 1593, 1593, 4828, 4828, 4828, 4828, 4828,
-	4828, 4828, 4828, 4828, 4828, 4828, 4828, 1381, 620, 4828,
-	4828, 4828, 976, 1230, 1257, -1000, -1000, -1000, -1000, -1000,
-	4421, 220, 4421, -1000, 1113, -1000, -1000, 4421, -1000, -1000,
-	-1000, 4421, 4828, 4421, -1000, 1593, 1167, -1000, 1386, -1000,
-	1275, 1514, -1000, 317, 1252, -1000, 442, 1272, -1000, 1577,
-	608, -1000, 308, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -258, -1000, 9129,
+	14778, 14778, -1000, 1659, 4598, 2111, -1000, 1367, 320, 14778,
+	1214, -1000, 413, 1465, 1470, 1465, -1000, -1000, -1000, -1000,
+	1526, -1000, 1517, -1000, 1514, -1000, -1000, 1359, -1000, -1000,
+	476, -1000, -1000, -1000, -1000, -1000, -41, -48, 1236, -1000,
+	-71, 77, -1000, -1000, 1234, -1000, -1000, -1000, 476, 1236,
+	161, 890, -1000, 912, 318, -96, 1278, -1000, 739, 1359,
+	1560, 176, 1257, 1456, 1554, 14778, 1666, 1666, 1666, 357,
+	15898, 477, 14778, 477, -1000, -1000, 477, -1000, 317, 14778,
+	1274, -1000, 141, 141, 397, 141, 176, 1357, -1000, -1000,
+	-1000, 259, 239, 248, 13569, 160, -1000, -1000, 1257, -1000,
+	-1000, -1000, 1356, 405, -1000, -1000, 5011, -1000, 709, -1000,
+	2524, 2524, 2524, -1000, -1000, 10748, -1000, -1000, 1236, 1257,
+	1469, 1269, -1000, -1000, -1000, -1000, 1666, 4185, -1000, 12763,
+	-1000, 4598, 4598, 4598, -1000, 14778, 13166, -1000, 525, 5011,
+	-1000, -1000, -1000, -1000, -1000, -1000, 4598, 1593, 1593, 1593,
+	4598, 643, 4598, 4598, -1000, 594, 350, 1593, 1593, 1593,
+	4598, 4598, 1593, -1000, 1593, 1593, 1593, 5011, 5011, 5011,
+	5011, 5011, 5011, 5011, 5011, 5011, 5011, 5011, 5011, 1344,
+	546, 5011, 5011, 5011, 1282, 1196, 1267, -1000, -1000, -1000,
+	-1000, 431, 709, -1000, 4598, -1000, 1353, -1000, 696, -1000,
+	4598, -1000, 1129, -1000, -1000, 4598, -1000, -1000, -1000, 4598,
+	5011, 4598, -1000, 1593, 1222, -1000, 1351, -1000, 1232, 1538,
+	-1000, 300, 1265, -1000, 401, 1217, -1000, 1609, 709, -1000,
+	288, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2687,140 +2746,151 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -120, -1000, 14445, 1265, -1000, 1596, 14445, 4421,
-	-1000, -1000, 4421, 1384, -1000, 4421, -1000, -1000, -1000, 1628,
-	307, 301, 11666, -1000, 120, 11666, -1000, -1000, 14445, 181,
-	11666, -41, 4421, 4421, 14445, -141, -134, 4421, -1000, -1000,
-	-1000, -231, -1000, -102, -1000, 1480, 44, -1000, 1526, -1000,
-	254, -1000, 1383, -1000, -1000, -1000, 1630, -1000, 411, -1000,
-	411, 491, 14445, -1000, -1000, -231, 1106, -1000, -1000, -1000,
-	248, 1250, 11666, 863, 145, -1000, -1000, -1000, -1000, -1000,
-	14445, 14445, 1627, -1000, 1247, 1429, -1000, 485, 509, -1000,
-	294, -1000, -1000, 545, -1000, 1104, 1150, 608, 4421, -1000,
-	-1000, 4421, 4421, 712, 4421, 1098, 1261, 1242, -1000, 1092,
-	-1000, 4421, 4421, 4421, 1076, 1074, 4421, 4421, 4421, 4421,
-	851, 794, -1000, 897, 897, 302, 302, 302, 302, 302,
-	602, 602, -1000, -1000, -1000, 3607, 1381, 4828, 4828, 4828,
-	151, 1481, 1385, -1000, 4421, 673, -1000, -1000, 1068, -1000,
-	921, 1060, 1232, 1047, 4421, -280, 3192, 1343, 14445, -280,
-	14445, 14445, 3192, -1000, 14445, -1000, 1975, 753, -1000, -1000,
-	14445, 1577, -1000, 608, 608, 14445, 608, 11666, 324, 462,
-	-1000, 10078, 11666, -1000, -1000, 11666, 86, 1530, -1000, -1000,
-	608, 608, 292, -284, -129, 1614, 1613, -1000, -1000, -119,
-	-1000, -1000, -1000, 299, -1000, 890, 889, 886, 885, 14445,
-	-1000, -1000, -1000, -1000, -1000, 421, 421, 421, 1521, 6462,
-	-1000, 1630, 1630, 411, -1000, -45, -96, -1000, 1206, 1041,
-	-1000, -1000, -1000, -1000, 1619, 1610, 12460, 12063, -1000, -1000,
-	4421, 1221, 1216, 1211, 216, 1227, -1000, -1000, -1000, -1000,
-	1200, 1196, 1175, -1000, -1000, 1165, 1148, 1040, 983, 1183,
-	-1000, 151, 1481, 1108, -1000, 4828, 4828, 974, 216, 388,
-	-1000, -1000, 388, -1000, 4828, -1000, 801, -1000, 1039, 1229,
-	-1000, -280, -1000, -1000, 1167, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 1181, 1206, -1000, -1000,
-	-1000, -1000, 11666, 1580, 163, -1000, -54, 190, 14445, -286,
-	884, -1000, 1609, 883, 578, -119, -1000, 751, 750, 748,
-	741, -94, -1000, -1000, -1000, -1000, -1000, 1373, 388, -1000,
-	521, 866, 1013, 1179, -1000, -1000, -1000, 108, 460, -1000,
-	14445, 525, 295, 172, 295, 523, 1372, -1000, -1000, -1000,
-	-1000, 1630, -1000, -45, -1000, 207, 232, -19, 1607, -1000,
-	-1000, 4421, 4421, 1429, -1000, -1000, 608, -1000, -1000, -1000,
-	1008, -1000, 1350, 1365, -1000, 1350, 1350, 1350, 255, 255,
-	1367, 1369, 1367, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 4828, -1000, -1000, -1000, 1004, 994, 991,
-	1003, -1000, -1000, 3192, 1167, -1000, -1000, 11666, 11666, -233,
-	-57, 14445, -291, 740, -1000, 864, -133, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 11269, -1000, -1000, -1000, -1000,
-	-1000, -1000, 15796, 6462, 795, -78, -1000, -1000, -1000, 1350,
-	-1000, 1365, 1350, 1350, 1350, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 1364, 1363, -1000, 1350, 1350, 1350,
-	1350, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 14445, 14445,
-	-1000, 14445, 14445, 172, 4421, -1000, -1000, -1000, -1000, 737,
-	-1000, -1000, -1000, 863, 608, 1150, -1000, -1000, -1000, 733,
-	-1000, 732, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	723, -1000, 715, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -168, -1000, 1361, -1000, -1000, 1606,
-	1177, -1000, 1350, 4421, 143, 1348, -1000, 421, 421, 313,
-	421, 421, 421, 421, 98, 97, 421, 421, 421, 421,
-	421, 421, 421, 421, 421, 421, 421, 421, 421, 421,
-	1347, -1000, -1000, 795, -1000, -1000, 501, 4828, -1000, -1000,
-	859, 521, 316, 311, 1346, -1000, 65, 517, 516, -1000,
-	14445, -1000, -81, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	856, 856, -1000, -1000, -1000, -1000, 1339, 1368, 20, 1336,
-	-1000, 1329, 1328, 14445, 731, -31, -1000, -1000, 988, 977,
-	1139, 1169, -148, -140, 14445, 578, -1000, 11269, 1534, 681,
-	-1000, 1605, 15796, -1000, 713, 707, 421, 421, 706, 847,
-	815, 814, 421, 421, 702, 813, 15194, 699, 693, 689,
-	701, 810, 406, 691, 628, 601, 14445, 1320, 736, -1000,
-	-1000, 1481, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 659, 1318, -1000, -1000, 1315, -1000, -1000,
-	1144, -1000, 1137, 11269, 33, 33, 11269, 11269, 11269, 1314,
-	237, -1000, -1000, -1000, 658, -1000, 640, 178, -139, -140,
-	-1000, 1604, -135, 1602, 1601, 1128, -1000, -1000, 89, -1000,
-	-1000, 1534, 61, -1000, -1000, -1000, 388, 388, -1000, -1000,
-	-1000, -1000, 788, 785, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -105, -1000, 14778, 1205, -1000, 1659, 14778, 3350,
+	-1000, -1000, 4598, 1350, -1000, 4598, -1000, -1000, -1000, -1000,
+	-1000, 14778, 1679, 287, 286, 11957, -1000, 148, 11957, -1000,
+	-1000, 14778, 153, 11957, -96, 4598, 4598, 14778, -158, 14778,
+	4598, -1000, -1000, -1000, 1583, -1000, -210, -1000, -88, 1468,
+	13, -1000, 1554, -1000, 234, -1000, 1345, -1000, -1000, -1000,
+	1666, -1000, 357, -1000, 357, 477, 14778, -1000, -1000, 188,
+	14778, -1000, 14778, 14778, 14778, -1000, -1000, 14778, -210, 1125,
+	-1000, -1000, -1000, 224, 1257, 11957, 824, 170, -1000, -1000,
+	-1000, -1000, -1000, 14778, 14778, 1664, -1000, 1243, 1451, -1000,
+	520, 467, -1000, 283, -1000, -1000, 561, -1000, 1123, 1186,
+	709, 4598, -1000, -1000, 4598, 4598, 834, 4598, 1116, 1202,
+	1200, -1000, 1109, -1000, 1643, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 4598, 4598, 4598, 1093, 1084, 967,
+	4598, 4598, 4598, 4598, 814, 964, -1000, 636, 636, 291,
+	291, 291, 291, 291, 1008, 1008, -1000, -1000, -1000, 3772,
+	1344, 5011, 5011, 5011, 129, 1810, 1606, -1000, 4598, 509,
+	-1000, 4598, 717, 121, -1000, 1082, -1000, 1007, 1072, 2393,
+	1070, 4598, -258, 3350, 1292, 14778, -258, 14778, 14778, 3350,
+	-1000, 14778, -1000, 2111, 771, -1000, -1000, 14778, 1609, -1000,
+	-1000, 709, 14778, 709, 1191, 11957, 316, 454, -1000, 10345,
+	11957, -1000, -1000, 11957, 104, -20, 709, 709, 282, -131,
+	-118, -1000, -1000, 1297, -1000, -104, -1000, -1000, -1000, 242,
+	-1000, 888, 887, 870, 869, 14778, -1000, -1000, -1000, -1000,
+	-1000, 398, 398, 398, 1544, 6671, -1000, 1666, 1666, 357,
+	-1000, -1000, -1000, 880, -1000, 151, -1000, 355, -46, -74,
+	-1000, 1236, 1066, -1000, -1000, -1000, -1000, 1662, 1656, 12763,
+	12360, -1000, -1000, 4598, 1171, 1140, 1127, 116, 1174, -1000,
+	-1000, -1000, -1000, 4598, 1124, 1098, 1079, -1000, -1000, 4598,
+	1068, 1065, 1023, 929, 1166, -1000, 129, 1810, 1352, -1000,
+	5011, 5011, 919, 384, -1000, 4598, 675, 116, 612, 1659,
+	1642, -1000, -1000, 612, -1000, 5011, -1000, 904, -1000, 1043,
+	1238, -1000, -258, -1000, -1000, 1222, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1144, -1000, 1236,
+	-1000, -1000, -1000, -1000, 11957, 1586, 176, -1000, -39, 1565,
+	-1000, -1000, 14778, -264, -114, 1641, 1640, -1000, -104, -1000,
+	768, 755, 743, 742, -77, -1000, -1000, -1000, -1000, -1000,
+	1343, 612, -1000, 651, 849, 1039, 1227, -1000, -1000, -1000,
+	219, -1000, 14778, 539, 281, 146, 281, 528, 1342, -1000,
+	-1000, -1000, -1000, 1666, 624, -64, -1000, -1000, -1000, 1331,
+	-1000, 1334, 1331, 1331, 1331, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1341, 1338, -1000, 1331, 1331, 1331,
+	1331, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1335, 1336, 1336, 1336,
+	1335, 14778, 1553, 1551, -1000, -46, -1000, 225, 243, -4,
+	1639, -1000, -1000, 4598, 4598, 1451, -1000, -1000, 709, -1000,
+	-1000, -1000, 1037, -1000, 1331, 1334, -1000, 1331, 1331, 1331,
+	238, 238, -1000, 901, -1000, -1000, -1000, 721, -1000, -1000,
+	-1000, -1000, -1000, -1000, 5011, -1000, -1000, -1000, -1000, 709,
+	4598, 1033, 1025, -101, 4598, 1004, 1838, -1000, -1000, 3350,
+	1222, -1000, -1000, 11957, 11957, -211, -42, 173, -266, 847,
+	-1000, 1630, 846, 680, -1000, -1000, -1000, -1000, -1000, -1000,
+	11554, -1000, -1000, -1000, -1000, -1000, -1000, 16280, 6671, -1000,
+	-1000, 14778, 14778, -1000, 14778, 14778, 146, 4598, -1000, -1000,
+	624, -1000, -1000, 484, 5011, -1000, -1000, 827, 651, 297,
+	306, 1333, -1000, 54, 504, 487, -1000, 14778, -1000, -67,
+	-1000, -1000, -1000, -1000, 740, -1000, 738, -1000, -1000, -1000,
+	825, 825, -1000, -1000, -1000, -1000, -1000, 728, -1000, 726,
+	-1000, -1000, -1000, -1000, 5011, -1000, -1000, -1000, -1000, 694,
+	-1000, -1000, -1000, 824, 709, 1186, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 4598, -1000,
+	709, -1000, -1000, 977, 84, -1000, -1000, 1186, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 14778, -268, 686, -1000, 822,
+	-117, -1000, -1000, 1134, -1000, 1331, 4598, 120, 16262, -1000,
+	398, 398, 301, 398, 398, 398, 398, 82, 80, 398,
+	398, 398, 398, 398, 398, 398, 398, 398, 398, 398,
+	398, 398, 398, 1327, -1000, 1325, 1452, 27, 1324, -1000,
+	1322, 1318, 14778, 883, -1000, -1000, 1810, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 669, 1315,
+	-1000, -1000, 1314, -1000, -1000, 975, 962, 1115, -1000, 1112,
+	1009, 1108, 1810, -16, -1000, -1000, 854, -1000, -1000, 88,
+	-279, -259, -282, -161, -1000, 1312, -1000, -1000, 1629, -1000,
+	11554, 1575, 779, -1000, 1624, 16280, -1000, 664, 659, 398,
+	398, 655, 820, 815, 808, 398, 398, 654, 806, 15543,
+	647, 642, 639, 767, 805, 420, 695, 672, 652, 14778,
+	1310, 782, 11554, 5, 5, 11554, 11554, 11554, 1309, 220,
+	954, 4598, -205, 11554, -1000, -1000, -1000, 801, -1000, 633,
+	-1000, 630, -1000, -1000, 461, -1000, -1000, -1000, -1000, -1000,
+	-133, -122, 14778, 680, 93, -1000, -1000, 1575, 39, -1000,
+	-1000, -1000, 612, 612, -1000, -1000, -1000, -1000, 800, 794,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 116, 14445, 1112, -1000,
-	427, 975, 4421, -226, 11269, -1000, 765, -1000, 1103, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 1087, 1084, 1066, 11269,
-	-1000, -1000, -1000, 70, 928, 919, 1313, 637, -129, 1589,
-	-1000, 578, 1586, 578, 578, -1000, 14445, -1000, 421, 763,
-	2, -1000, -1000, -1000, 49, 105, 103, -1000, 239, -1000,
-	-1000, -1000, -1000, -1000, -1000, 118, 1044, -1000, 736, 667,
-	-1000, 573, 1473, -1000, -62, 1029, -1000, -1000, -1000, -1000,
-	-1000, 1012, -1000, -1000, -1000, 1520, 9681, -152, -1000, 610,
-	-1000, 578, -1000, -1000, -1000, 630, -1000, 804, 37, 616,
-	4828, 1312, 4828, 1311, 64, 1310, -1000, -1000, -1000, -1000,
-	-1000, 237, -1000, -1000, 1472, 1471, 1634, -1000, -1000, -1000,
-	-1000, 89, 89, 89, 89, -59, -1000, 14445, -1000, 1001,
-	-1000, -1000, -1000, 291, -1000, -1000, -1000, -1000, -1000, 1309,
-	1582, -1000, 970, 14445, 912, 14445, 1306, 420, 4828, -1000,
-	-1000, 1639, -1000, 1637, 300, 300, -1000, 1120, -1000, 343,
-	-1000, 10872, 14445, -1000, 140, 35, -1000, 985, -1000, 981,
-	14445, 571, 829, -1000, -1000, -1000, 671, 76, -1000, 14445,
-	2785, -1000, 290, 972, -1000, 854, 22, -1000, -1000, 955,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 608, 14445, -1000,
-	140, 1406, -1000, 570, -1000, -1000, -1000, 662, 135, -1000,
-	-1000, 662, 34, -1000, 132, -1000, -1000, 927, -1000, 798,
-	1217, -1000, 34, 15796, 4421, -1000, 15796, 923, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 87, 14778, 1105, -1000, 400, 1100, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1097, 1055, 1052, 11554, -1000,
+	-1000, -1000, 52, -1000, 746, 1467, -1000, -53, 1049, -1000,
+	947, 783, 88, 149, -129, -122, -1000, 1623, -119, 1622,
+	1621, 1042, -1000, -1000, -1000, 398, 785, 21, -1000, -1000,
+	-1000, 40, 144, 142, -1000, 200, -1000, -1000, -1000, -1000,
+	-1000, -1000, 96, 1036, -1000, 782, 778, -1000, -1000, -1000,
+	-1000, 1031, -1000, 220, -1000, -1000, 1463, 1435, 1683, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1307, 622, -114, 1618,
+	-1000, 680, 1617, 680, 680, -1000, 14778, 610, -1000, 884,
+	33, 608, 5011, 1306, 5011, 1299, 48, 1296, -1000, -1000,
+	-1000, -1000, -1000, 93, 93, 93, 93, -44, -1000, -1000,
+	1685, -1000, 1686, 303, 303, 1543, 9942, -135, -1000, 751,
+	-1000, 680, -1000, -1000, -1000, -1000, -1000, 1291, 1616, -1000,
+	1795, 14778, 1759, 14778, 1181, 395, 5011, -1000, -1000, -1000,
+	-1000, 590, 59, -1000, -1000, 14778, -1000, 1028, -1000, -1000,
+	-1000, 279, -1000, -1000, -1000, -1000, 119, 46, -1000, 1002,
+	-1000, 995, 14778, 573, 1557, -1000, -1000, -1000, 885, -1000,
+	374, -1000, 11151, 14778, 993, -1000, 948, 30, -1000, -1000,
+	946, -1000, -1000, 14778, 2937, -1000, 278, -1000, 119, 1537,
+	-1000, 568, -1000, -1000, -1000, 709, 14778, -1000, 16143, 115,
+	-1000, -1000, -1000, 16143, 32, -1000, 108, -1000, -1000, 938,
+	-1000, 817, 1172, -1000, 32, 16280, 4598, -1000, 16280, 932,
+	-1000,
 }
 
 var yyPgo = [...]int{
-	0, 597, 1923, 1920, 782, 748, 1919, 1918, 1917, 1916,
-	1915, 1914, 1911, 1909, 1908, 1907, 1902, 1901, 1900, 1899,
-	1898, 1897, 1895, 1892, 1891, 1890, 1889, 1888, 1887, 1883,
-	1882, 1880, 743, 1879, 1878, 1877, 1876, 1875, 1874, 102,
-	1873, 1872, 1871, 1870, 1869, 1867, 1866, 1863, 1861, 120,
-	85, 91, 1860, 103, 140, 1859, 100, 1858, 75, 137,
-	1857, 1852, 31, 98, 1850, 113, 105, 77, 162, 83,
-	74, 1849, 1848, 1847, 109, 1846, 1845, 1844, 1843, 54,
-	1842, 63, 35, 28, 1840, 69, 1839, 1838, 1836, 1835,
-	1834, 67, 1831, 62, 44, 1830, 1829, 1828, 1827, 1826,
-	32, 1825, 45, 1824, 1823, 1822, 1821, 1820, 1816, 1814,
-	16, 18, 21, 1813, 1812, 17, 2, 1811, 1810, 76,
-	1808, 1807, 1806, 611, 1805, 1804, 1803, 126, 1802, 114,
-	1801, 1800, 1799, 1798, 9, 1792, 42, 1791, 1788, 1787,
-	46, 1785, 1784, 81, 36, 142, 79, 1783, 1782, 1781,
-	116, 20, 101, 0, 117, 38, 1779, 110, 118, 1778,
-	82, 154, 92, 48, 1777, 43, 60, 1776, 1774, 1773,
-	61, 11, 1772, 84, 12, 73, 1771, 93, 99, 1,
-	88, 1770, 119, 1769, 1768, 97, 1767, 1766, 52, 96,
-	1764, 1763, 1762, 29, 1761, 37, 25, 1757, 111, 127,
-	1756, 1755, 1754, 112, 87, 70, 1752, 1751, 64, 1750,
-	90, 66, 94, 1749, 619, 1748, 95, 55, 19, 1747,
-	121, 1746, 144, 123, 106, 1745, 1744, 124, 1545, 122,
-	1742, 108, 10, 1741, 1740, 13, 1739, 26, 1738, 1737,
-	1736, 1735, 6, 1734, 1733, 1732, 3, 5, 1731, 4,
-	89, 1729, 1725, 47, 56, 53, 57, 1724, 1708, 1707,
-	1702, 1696, 206, 1692, 1691, 1690, 1689, 1688, 1687, 1686,
-	71, 1685, 1684, 1683, 1682, 58, 1681, 1680, 1679, 1678,
-	1676, 33, 1674, 1673, 23, 1671, 30, 1669, 1668, 1665,
-	14, 1664, 1662, 15, 1661, 1660, 7, 8, 1658, 1657,
-	51, 39, 34, 65, 68, 1656, 22, 1655, 78, 1653,
-	1652, 1650, 107, 1649,
-}
-
-//line mysql_sql.y:5962
+	0, 626, 2079, 2078, 694, 688, 2077, 2076, 2075, 2074,
+	2070, 2069, 2068, 2067, 2062, 2061, 2060, 2058, 2057, 2056,
+	2055, 2054, 2052, 2051, 2050, 2049, 2048, 2046, 2045, 2044,
+	2042, 2040, 2039, 2038, 651, 2033, 102, 2032, 2031, 2030,
+	2028, 2025, 2023, 126, 2021, 2019, 2018, 2017, 2015, 2013,
+	2012, 2011, 2010, 138, 87, 97, 2009, 114, 164, 2008,
+	112, 2006, 80, 142, 2005, 2004, 32, 104, 2003, 77,
+	72, 85, 189, 90, 84, 120, 2002, 2000, 1999, 123,
+	1998, 1997, 1996, 1995, 48, 1994, 69, 43, 30, 96,
+	76, 1993, 1992, 1991, 1990, 1985, 83, 1984, 56, 53,
+	1983, 1982, 1981, 1980, 1979, 38, 1978, 46, 1968, 1967,
+	1966, 1965, 1964, 1962, 1961, 15, 17, 19, 1960, 1940,
+	16, 2, 1938, 1934, 78, 1933, 1932, 1931, 625, 1930,
+	1929, 1913, 140, 1912, 110, 1897, 1896, 1895, 1894, 128,
+	1893, 1890, 28, 1889, 12, 1888, 45, 1887, 1886, 1885,
+	58, 1883, 1877, 91, 31, 37, 89, 1876, 1863, 1861,
+	127, 21, 81, 0, 106, 124, 35, 1860, 121, 119,
+	1859, 94, 178, 103, 39, 1857, 52, 61, 1856, 1854,
+	1852, 60, 44, 1851, 1850, 1849, 93, 1844, 79, 40,
+	75, 1841, 100, 118, 1, 95, 1840, 129, 1839, 1838,
+	107, 1837, 1836, 47, 105, 1832, 1830, 1829, 29, 1826,
+	34, 22, 1825, 134, 137, 1823, 1822, 1820, 109, 92,
+	71, 1811, 1809, 66, 1808, 101, 70, 108, 1807, 646,
+	99, 54, 20, 1806, 135, 1804, 162, 130, 113, 1803,
+	1802, 136, 1536, 133, 1801, 122, 13, 1800, 1797, 11,
+	1796, 25, 1795, 1794, 1793, 1792, 6, 1791, 1790, 1789,
+	3, 5, 1787, 4, 98, 111, 1785, 49, 57, 63,
+	65, 62, 1784, 1783, 1780, 1779, 208, 1778, 1777, 1776,
+	1775, 1774, 1773, 1772, 73, 1771, 1770, 1768, 1767, 59,
+	1763, 1746, 1740, 1736, 1735, 26, 1734, 1732, 14, 1731,
+	18, 1730, 1728, 1727, 9, 1726, 1725, 10, 1724, 1723,
+	7, 8, 1710, 1708, 55, 36, 33, 68, 64, 1707,
+	23, 1706, 86, 1705, 1704, 117, 1703, 1702, 116, 1700,
+}
+
+//line mysql_sql.y:6353
 type yySymType struct {
 	union interface{}
 	id    int
@@ -2834,6 +2904,16 @@ func (st *yySymType) aliasedTableExprUnion() *tree.AliasedTableExpr {
 	return v
 }
 
+func (st *yySymType) alterTableOptionUnion() tree.AlterTableOption {
+	v, _ := st.union.(tree.AlterTableOption)
+	return v
+}
+
+func (st *yySymType) alterTableOptionsUnion() []tree.AlterTableOption {
+	v, _ := st.union.([]tree.AlterTableOption)
+	return v
+}
+
 func (st *yySymType) assignmentUnion() *tree.Assignment {
 	v, _ := st.union.(*tree.Assignment)
 	return v
@@ -2949,6 +3029,21 @@ func (st *yySymType) fieldsListUnion() []*tree.Fields {
 	return v
 }
 
+func (st *yySymType) frameBoundUnion() *tree.FrameBound {
+	v, _ := st.union.(*tree.FrameBound)
+	return v
+}
+
+func (st *yySymType) frameClauseUnion() *tree.FrameClause {
+	v, _ := st.union.(*tree.FrameClause)
+	return v
+}
+
+func (st *yySymType) frameTypeUnion() tree.FrameType {
+	v, _ := st.union.(tree.FrameType)
+	return v
+}
+
 func (st *yySymType) fromUnion() *tree.From {
 	v, _ := st.union.(*tree.From)
 	return v
@@ -3374,242 +3469,271 @@ func (st *yySymType) varExprUnion() *tree.VarExpr {
 	return v
 }
 
+func (st *yySymType) whenUnion() *tree.When {
+	v, _ := st.union.(*tree.When)
+	return v
+}
+
+func (st *yySymType) whensUnion() []*tree.When {
+	v, _ := st.union.([]*tree.When)
+	return v
+}
+
 func (st *yySymType) whereUnion() *tree.Where {
 	v, _ := st.union.(*tree.Where)
 	return v
 }
 
+func (st *yySymType) windowSpecUnion() *tree.WindowSpec {
+	v, _ := st.union.(*tree.WindowSpec)
+	return v
+}
+
 func (st *yySymType) zeroFillOptUnion() bool {
 	v, _ := st.union.(bool)
 	return v
 }
 
 var yyR1 = [...]int{
-	0, 310, 2, 2, 1, 1, 1, 1, 1, 1,
+	0, 324, 2, 2, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 47, 299, 299, 298, 298, 297, 297, 296, 296,
-	296, 295, 295, 295, 294, 294, 293, 293, 291, 291,
-	292, 290, 289, 289, 287, 287, 285, 285, 286, 286,
-	280, 280, 283, 283, 281, 281, 281, 281, 284, 279,
-	279, 279, 278, 278, 46, 46, 46, 217, 217, 45,
-	45, 231, 231, 231, 231, 231, 229, 229, 229, 229,
-	228, 228, 227, 227, 232, 232, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 40,
-	40, 40, 40, 43, 44, 225, 225, 225, 225, 225,
-	226, 226, 226, 41, 42, 42, 216, 216, 221, 221,
-	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
-	220, 215, 215, 224, 224, 224, 223, 223, 222, 222,
-	34, 34, 34, 37, 36, 214, 214, 214, 214, 214,
-	214, 214, 214, 35, 35, 35, 35, 35, 35, 33,
-	33, 32, 213, 213, 212, 39, 39, 39, 39, 38,
-	38, 38, 38, 38, 38, 38, 156, 156, 156, 48,
-	7, 31, 31, 262, 262, 167, 167, 168, 168, 166,
-	166, 166, 166, 166, 166, 265, 266, 163, 20, 20,
-	20, 20, 20, 20, 20, 20, 20, 20, 30, 311,
-	311, 311, 28, 29, 261, 261, 261, 27, 26, 25,
-	24, 24, 23, 22, 22, 160, 160, 162, 162, 158,
-	312, 312, 237, 237, 161, 161, 21, 21, 159, 159,
-	141, 157, 157, 157, 6, 8, 8, 8, 8, 8,
-	13, 12, 11, 10, 9, 5, 4, 269, 269, 269,
-	269, 269, 269, 307, 307, 307, 308, 73, 73, 69,
-	69, 270, 270, 180, 309, 309, 277, 277, 276, 276,
-	275, 275, 71, 71, 72, 72, 61, 61, 49, 49,
-	282, 282, 282, 282, 288, 288, 259, 259, 107, 107,
-	137, 137, 138, 138, 50, 50, 51, 51, 51, 67,
-	67, 68, 68, 68, 66, 66, 65, 64, 64, 63,
-	62, 62, 62, 53, 53, 52, 52, 52, 52, 52,
-	123, 123, 123, 54, 263, 263, 263, 268, 268, 120,
-	120, 121, 121, 119, 119, 55, 55, 56, 56, 56,
-	56, 118, 118, 117, 57, 57, 58, 58, 60, 60,
-	60, 60, 128, 128, 127, 127, 127, 127, 76, 76,
-	126, 125, 125, 125, 75, 75, 74, 74, 70, 70,
-	59, 59, 124, 313, 313, 122, 149, 149, 149, 155,
-	155, 148, 148, 148, 154, 154, 150, 150, 151, 151,
-	151, 3, 3, 3, 16, 16, 16, 14, 210, 210,
-	209, 209, 211, 211, 211, 211, 205, 205, 206, 206,
-	206, 206, 207, 207, 207, 208, 208, 208, 208, 204,
-	204, 203, 201, 201, 201, 202, 202, 202, 202, 202,
-	202, 152, 152, 15, 198, 198, 199, 199, 199, 200,
-	200, 192, 192, 192, 192, 19, 196, 196, 197, 197,
-	197, 197, 197, 193, 193, 195, 195, 191, 191, 191,
-	191, 191, 18, 190, 190, 188, 188, 186, 186, 187,
-	187, 185, 185, 185, 189, 189, 17, 264, 264, 233,
-	233, 236, 236, 243, 243, 244, 244, 242, 242, 249,
-	249, 248, 248, 247, 247, 246, 246, 245, 245, 240,
-	240, 239, 239, 234, 234, 234, 234, 234, 235, 235,
-	238, 238, 241, 241, 98, 98, 99, 99, 99, 116,
-	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
-	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
-	116, 116, 116, 116, 116, 116, 116, 116, 305, 305,
-	306, 101, 101, 101, 105, 105, 105, 105, 105, 105,
-	100, 100, 100, 102, 102, 102, 83, 83, 82, 82,
-	77, 77, 78, 78, 79, 79, 80, 80, 81, 81,
-	81, 81, 81, 81, 219, 219, 303, 303, 304, 304,
-	300, 300, 300, 302, 302, 302, 302, 302, 301, 301,
-	84, 135, 135, 135, 153, 153, 153, 134, 134, 134,
-	97, 97, 96, 96, 94, 94, 94, 94, 94, 94,
-	94, 94, 94, 94, 94, 94, 94, 218, 218, 164,
-	164, 165, 165, 115, 113, 113, 114, 114, 114, 114,
-	111, 112, 110, 110, 110, 110, 110, 109, 109, 108,
-	108, 108, 194, 194, 106, 106, 104, 104, 104, 103,
-	103, 103, 250, 171, 171, 171, 171, 171, 171, 171,
-	171, 171, 171, 171, 171, 171, 173, 173, 173, 173,
-	173, 173, 173, 173, 173, 173, 173, 173, 173, 173,
-	173, 173, 173, 173, 173, 85, 85, 85, 85, 85,
-	85, 85, 85, 85, 93, 93, 93, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 274, 274, 274, 130, 130, 130, 132, 132,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 181, 181, 182, 182, 271, 271, 271, 271,
-	271, 271, 272, 272, 273, 273, 273, 273, 267, 267,
-	267, 267, 267, 267, 267, 267, 267, 267, 267, 267,
-	267, 267, 267, 267, 267, 267, 267, 267, 267, 267,
-	267, 267, 267, 267, 267, 267, 172, 129, 129, 129,
-	251, 183, 178, 178, 179, 179, 174, 174, 174, 174,
-	174, 176, 176, 176, 176, 170, 170, 170, 170, 170,
-	170, 170, 170, 170, 175, 175, 177, 177, 184, 184,
-	184, 184, 184, 184, 95, 95, 95, 95, 252, 169,
-	169, 169, 169, 169, 169, 169, 86, 86, 86, 86,
-	90, 90, 92, 92, 92, 92, 92, 92, 92, 92,
-	92, 92, 92, 92, 92, 92, 91, 91, 91, 89,
-	89, 89, 89, 89, 87, 87, 87, 87, 87, 87,
-	87, 87, 87, 87, 87, 87, 87, 87, 87, 88,
-	136, 136, 253, 253, 254, 254, 255, 256, 256, 257,
-	257, 257, 258, 258, 258, 260, 260, 140, 140, 140,
-	145, 145, 139, 139, 146, 146, 147, 147, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 142, 142,
-	142, 142, 142, 142, 142, 142, 142, 142, 142, 142,
-	142, 142, 142, 142, 142, 142, 142, 142, 142, 142,
-	142, 142, 142, 142, 142, 142, 142, 142, 142, 142,
-	142, 142, 142, 142,
+	1, 51, 164, 164, 313, 313, 312, 312, 311, 311,
+	310, 310, 310, 309, 309, 309, 308, 308, 307, 307,
+	305, 305, 306, 304, 303, 303, 301, 301, 299, 299,
+	300, 300, 294, 294, 297, 297, 295, 295, 295, 295,
+	298, 293, 293, 293, 292, 292, 50, 50, 50, 231,
+	231, 49, 49, 245, 245, 245, 245, 245, 243, 243,
+	243, 243, 242, 242, 241, 241, 246, 246, 244, 244,
+	244, 244, 244, 244, 244, 244, 244, 244, 244, 244,
+	244, 244, 244, 244, 244, 244, 244, 244, 244, 244,
+	244, 244, 244, 244, 244, 244, 244, 244, 244, 244,
+	244, 44, 44, 44, 44, 47, 48, 239, 239, 239,
+	239, 239, 240, 240, 240, 45, 46, 46, 230, 230,
+	235, 235, 234, 234, 234, 234, 234, 234, 234, 234,
+	234, 234, 234, 234, 238, 238, 238, 237, 237, 236,
+	236, 38, 38, 38, 41, 40, 229, 229, 229, 229,
+	229, 229, 229, 229, 39, 39, 39, 39, 39, 39,
+	35, 35, 34, 228, 228, 227, 43, 43, 43, 43,
+	42, 42, 42, 42, 42, 42, 42, 167, 167, 167,
+	52, 7, 7, 33, 37, 37, 36, 36, 36, 36,
+	36, 36, 325, 325, 326, 326, 326, 32, 32, 276,
+	276, 178, 178, 179, 179, 177, 177, 177, 177, 177,
+	177, 279, 280, 174, 21, 21, 21, 21, 21, 21,
+	21, 21, 21, 21, 31, 327, 327, 327, 29, 30,
+	275, 275, 275, 28, 27, 26, 25, 25, 24, 23,
+	23, 171, 171, 173, 173, 169, 328, 328, 251, 251,
+	172, 172, 22, 22, 170, 170, 151, 168, 168, 168,
+	6, 8, 8, 8, 8, 8, 13, 12, 11, 10,
+	9, 5, 4, 283, 283, 283, 283, 283, 283, 321,
+	321, 321, 322, 78, 78, 73, 73, 284, 284, 195,
+	323, 323, 291, 291, 290, 290, 289, 289, 76, 76,
+	77, 77, 65, 65, 53, 53, 296, 296, 296, 296,
+	302, 302, 273, 273, 112, 112, 147, 147, 148, 148,
+	54, 54, 55, 55, 55, 71, 71, 72, 72, 72,
+	70, 70, 69, 68, 68, 67, 66, 66, 66, 57,
+	57, 56, 56, 56, 56, 56, 128, 128, 128, 128,
+	128, 128, 128, 128, 128, 58, 277, 277, 277, 282,
+	282, 125, 125, 126, 126, 124, 124, 59, 59, 60,
+	60, 60, 60, 123, 123, 122, 61, 61, 62, 62,
+	64, 64, 64, 64, 133, 133, 132, 132, 132, 132,
+	132, 132, 81, 81, 131, 130, 130, 130, 80, 80,
+	79, 79, 75, 75, 74, 74, 63, 63, 129, 329,
+	329, 127, 159, 159, 159, 166, 166, 158, 158, 158,
+	165, 165, 160, 160, 161, 161, 161, 3, 3, 3,
+	16, 16, 16, 16, 20, 14, 225, 225, 224, 224,
+	226, 226, 226, 226, 220, 220, 221, 221, 221, 221,
+	222, 222, 222, 223, 223, 223, 223, 219, 219, 218,
+	216, 216, 216, 217, 217, 217, 217, 217, 217, 162,
+	162, 15, 213, 213, 214, 214, 214, 215, 215, 207,
+	207, 207, 207, 19, 211, 211, 212, 212, 212, 212,
+	212, 208, 208, 210, 210, 206, 206, 206, 206, 206,
+	18, 205, 205, 203, 203, 201, 201, 202, 202, 200,
+	200, 200, 204, 204, 17, 278, 278, 247, 247, 250,
+	250, 257, 257, 258, 258, 256, 256, 263, 263, 262,
+	262, 261, 261, 260, 260, 259, 259, 254, 254, 253,
+	253, 248, 248, 248, 248, 248, 249, 249, 252, 252,
+	255, 255, 103, 103, 104, 104, 104, 121, 121, 121,
+	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
+	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
+	121, 121, 121, 121, 121, 121, 319, 319, 320, 106,
+	106, 106, 110, 110, 110, 110, 110, 110, 105, 105,
+	105, 107, 107, 107, 88, 88, 87, 87, 82, 82,
+	83, 83, 84, 84, 85, 85, 86, 86, 86, 86,
+	86, 86, 233, 233, 317, 317, 318, 318, 314, 314,
+	314, 316, 316, 316, 316, 316, 315, 315, 89, 145,
+	145, 145, 163, 163, 163, 144, 144, 144, 102, 102,
+	101, 101, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 232, 232, 175, 175, 176,
+	176, 120, 118, 118, 119, 119, 119, 119, 116, 117,
+	115, 115, 115, 115, 115, 114, 114, 113, 113, 113,
+	209, 209, 111, 111, 109, 109, 109, 108, 108, 108,
+	264, 182, 182, 182, 182, 182, 182, 182, 182, 182,
+	182, 182, 182, 182, 188, 188, 188, 188, 188, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	188, 188, 188, 188, 90, 90, 90, 90, 90, 90,
+	90, 90, 90, 98, 98, 98, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 139, 139, 140, 140, 141, 141, 141, 143, 143,
+	142, 142, 142, 142, 142, 288, 288, 288, 135, 135,
+	135, 135, 135, 135, 137, 137, 136, 136, 136, 136,
+	136, 136, 136, 136, 136, 136, 136, 136, 196, 196,
+	197, 197, 285, 285, 285, 285, 285, 285, 286, 286,
+	287, 287, 287, 287, 281, 281, 281, 281, 281, 281,
+	281, 281, 281, 281, 281, 281, 281, 281, 281, 281,
+	281, 281, 281, 281, 281, 281, 281, 281, 281, 281,
+	281, 281, 183, 183, 184, 184, 187, 187, 186, 185,
+	185, 134, 134, 134, 265, 265, 265, 265, 265, 265,
+	265, 265, 265, 198, 193, 193, 194, 194, 189, 189,
+	189, 189, 189, 191, 191, 191, 191, 181, 181, 181,
+	181, 181, 181, 181, 181, 181, 190, 190, 192, 192,
+	199, 199, 199, 199, 199, 199, 100, 100, 100, 100,
+	266, 180, 180, 180, 180, 180, 180, 180, 180, 91,
+	91, 91, 91, 95, 95, 97, 97, 97, 97, 97,
+	97, 97, 97, 97, 97, 97, 97, 97, 97, 96,
+	96, 96, 96, 96, 94, 94, 94, 94, 94, 92,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92,
+	92, 92, 92, 92, 93, 146, 146, 267, 267, 268,
+	268, 269, 270, 270, 271, 271, 271, 272, 272, 272,
+	274, 274, 150, 150, 150, 155, 155, 149, 149, 156,
+	156, 157, 157, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 152,
+	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
+	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
+	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
+	152, 152, 152, 152, 152,
 }
 
 var yyR2 = [...]int{
 	0, 1, 1, 3, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	0, 14, 0, 2, 1, 3, 3, 3, 1, 3,
-	5, 0, 2, 3, 1, 3, 1, 1, 1, 1,
-	1, 1, 0, 3, 0, 3, 0, 3, 0, 3,
-	0, 2, 1, 2, 3, 4, 3, 3, 1, 0,
-	1, 1, 0, 1, 9, 4, 7, 0, 3, 7,
-	4, 1, 3, 3, 3, 1, 0, 1, 1, 1,
-	1, 3, 1, 4, 1, 3, 1, 2, 1, 1,
-	2, 2, 1, 1, 1, 1, 1, 1, 1, 1,
-	2, 1, 2, 1, 2, 2, 1, 1, 1, 3,
-	2, 2, 2, 2, 2, 2, 2, 1, 1, 1,
-	1, 1, 1, 3, 6, 3, 1, 1, 1, 1,
-	1, 1, 1, 2, 4, 6, 1, 4, 1, 3,
-	3, 4, 4, 4, 3, 2, 4, 4, 2, 2,
-	2, 1, 1, 1, 1, 1, 1, 1, 1, 3,
-	1, 1, 1, 2, 2, 0, 4, 2, 4, 1,
-	5, 3, 2, 1, 2, 2, 4, 4, 5, 2,
-	1, 7, 1, 3, 3, 1, 1, 1, 1, 2,
-	3, 4, 7, 2, 5, 3, 1, 1, 1, 6,
-	1, 7, 9, 0, 2, 0, 1, 1, 2, 2,
-	2, 1, 4, 2, 2, 3, 0, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 5, 1,
-	1, 1, 5, 5, 0, 1, 1, 2, 2, 3,
-	6, 7, 4, 7, 8, 0, 2, 0, 2, 2,
-	1, 1, 1, 1, 0, 1, 4, 5, 1, 3,
-	1, 1, 3, 5, 1, 1, 1, 1, 1, 1,
-	4, 4, 6, 4, 4, 6, 4, 2, 1, 5,
-	4, 4, 2, 0, 1, 3, 3, 1, 3, 1,
-	3, 1, 3, 4, 0, 1, 0, 1, 1, 3,
-	1, 1, 0, 4, 1, 3, 2, 1, 0, 8,
-	0, 4, 7, 4, 0, 2, 0, 2, 0, 2,
-	0, 4, 1, 3, 1, 2, 4, 3, 4, 0,
-	1, 2, 4, 4, 0, 1, 3, 1, 3, 2,
-	0, 1, 1, 3, 3, 1, 3, 3, 3, 3,
-	1, 2, 2, 7, 0, 1, 1, 1, 1, 0,
-	2, 0, 3, 0, 2, 1, 3, 1, 2, 3,
-	5, 0, 1, 2, 1, 3, 1, 1, 4, 4,
-	4, 3, 2, 2, 2, 3, 2, 3, 0, 2,
-	1, 1, 2, 2, 0, 1, 2, 4, 1, 3,
-	1, 3, 3, 0, 1, 2, 0, 1, 2, 1,
-	1, 0, 1, 2, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 6, 0, 2,
-	1, 2, 2, 2, 2, 2, 0, 1, 2, 2,
-	2, 2, 1, 3, 2, 2, 2, 2, 2, 1,
-	3, 2, 1, 3, 2, 0, 3, 3, 5, 5,
-	4, 1, 1, 4, 1, 3, 1, 3, 2, 1,
-	1, 0, 1, 1, 1, 11, 0, 2, 3, 2,
-	3, 1, 1, 1, 3, 3, 4, 0, 2, 2,
-	2, 2, 5, 1, 1, 0, 3, 0, 1, 1,
-	2, 4, 4, 4, 0, 1, 10, 0, 1, 0,
-	6, 0, 4, 0, 3, 1, 3, 4, 5, 0,
-	3, 1, 3, 2, 3, 1, 2, 0, 6, 0,
-	2, 0, 2, 4, 5, 4, 5, 1, 6, 5,
-	0, 3, 0, 1, 0, 1, 1, 3, 2, 3,
-	3, 4, 4, 3, 3, 3, 3, 4, 4, 3,
+	0, 15, 0, 2, 0, 2, 1, 3, 3, 3,
+	1, 3, 5, 0, 2, 3, 1, 3, 1, 1,
+	1, 1, 1, 1, 0, 3, 0, 3, 0, 3,
+	0, 3, 0, 2, 1, 2, 3, 4, 3, 3,
+	1, 0, 1, 1, 0, 1, 9, 4, 7, 0,
+	3, 7, 4, 1, 3, 3, 3, 1, 0, 1,
+	1, 1, 1, 3, 1, 4, 1, 3, 1, 2,
+	1, 1, 2, 2, 1, 1, 1, 1, 1, 1,
+	1, 1, 2, 1, 2, 1, 2, 2, 1, 1,
+	1, 3, 2, 2, 2, 2, 2, 2, 2, 1,
+	1, 1, 1, 1, 1, 3, 6, 3, 1, 1,
+	1, 1, 1, 1, 1, 2, 4, 6, 1, 4,
+	1, 3, 3, 4, 4, 4, 3, 3, 2, 4,
+	4, 2, 2, 2, 1, 1, 1, 1, 1, 1,
+	3, 1, 1, 1, 2, 2, 0, 4, 2, 4,
+	1, 5, 3, 2, 1, 2, 2, 4, 4, 5,
+	2, 1, 7, 1, 3, 3, 1, 1, 1, 1,
+	2, 3, 4, 7, 2, 5, 3, 1, 1, 1,
+	6, 1, 1, 4, 1, 3, 3, 3, 5, 6,
+	5, 3, 0, 1, 0, 1, 1, 7, 9, 0,
+	2, 0, 1, 1, 2, 2, 2, 1, 4, 2,
+	2, 3, 0, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 5, 1, 1, 1, 5, 5,
+	0, 1, 1, 2, 2, 3, 6, 7, 4, 7,
+	8, 0, 2, 0, 2, 2, 1, 1, 1, 1,
+	0, 1, 4, 5, 1, 3, 1, 1, 3, 5,
+	1, 1, 1, 1, 1, 1, 4, 4, 6, 4,
+	4, 6, 4, 2, 1, 5, 4, 4, 2, 0,
+	1, 3, 3, 1, 3, 1, 3, 1, 3, 4,
+	0, 1, 0, 1, 1, 3, 1, 1, 0, 4,
+	1, 3, 2, 1, 0, 9, 0, 4, 7, 4,
+	0, 2, 0, 2, 0, 2, 0, 4, 1, 3,
+	1, 2, 4, 3, 4, 0, 1, 2, 4, 4,
+	0, 1, 3, 1, 3, 2, 0, 1, 1, 3,
+	3, 1, 3, 3, 3, 3, 1, 2, 2, 1,
+	2, 2, 1, 2, 2, 7, 0, 1, 1, 1,
+	1, 0, 2, 0, 3, 0, 2, 1, 3, 1,
+	2, 3, 5, 0, 1, 2, 1, 3, 1, 1,
+	4, 4, 4, 3, 2, 2, 2, 3, 2, 3,
+	2, 3, 0, 2, 1, 1, 2, 2, 0, 1,
+	2, 4, 0, 3, 1, 3, 1, 4, 3, 0,
+	1, 2, 0, 1, 2, 1, 1, 0, 1, 2,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 7, 6, 0, 2, 1, 2,
+	2, 2, 2, 2, 0, 1, 2, 2, 2, 2,
+	1, 3, 2, 2, 2, 2, 2, 1, 3, 2,
+	1, 3, 2, 0, 3, 3, 5, 5, 4, 1,
+	1, 4, 1, 3, 1, 3, 2, 1, 1, 0,
+	1, 1, 1, 11, 0, 2, 3, 2, 3, 1,
+	1, 1, 3, 3, 4, 0, 2, 2, 2, 2,
+	5, 1, 1, 0, 3, 0, 1, 1, 2, 4,
+	4, 4, 0, 1, 10, 0, 1, 0, 6, 0,
+	4, 0, 3, 1, 3, 4, 5, 0, 3, 1,
+	3, 2, 3, 1, 2, 0, 6, 0, 2, 0,
+	2, 4, 5, 4, 5, 1, 6, 5, 0, 3,
+	0, 1, 0, 1, 1, 3, 2, 3, 3, 4,
+	4, 3, 3, 3, 3, 4, 4, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 4, 5, 4, 1, 3,
-	3, 0, 2, 2, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 3, 1, 3,
-	0, 1, 1, 3, 1, 1, 2, 1, 7, 7,
-	7, 7, 8, 5, 0, 1, 0, 1, 1, 1,
-	1, 3, 3, 1, 1, 1, 1, 1, 0, 1,
-	3, 1, 3, 5, 1, 1, 1, 1, 3, 5,
-	0, 1, 1, 2, 1, 2, 2, 1, 1, 2,
-	2, 2, 2, 2, 1, 5, 6, 1, 2, 0,
-	1, 1, 2, 5, 0, 1, 1, 1, 2, 2,
-	3, 3, 1, 1, 2, 2, 2, 0, 1, 2,
-	2, 2, 0, 3, 0, 3, 1, 1, 1, 1,
-	1, 1, 1, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 1, 1, 1, 1, 3,
-	5, 2, 2, 2, 2, 1, 1, 2, 6, 6,
-	6, 1, 1, 1, 1, 1, 2, 2, 1, 2,
-	2, 2, 2, 2, 0, 1, 1, 5, 4, 4,
-	5, 5, 5, 5, 4, 5, 5, 5, 5, 5,
-	5, 5, 1, 1, 1, 4, 4, 4, 2, 2,
-	4, 2, 2, 4, 6, 2, 2, 2, 4, 6,
-	4, 2, 0, 1, 2, 3, 1, 1, 1, 1,
-	1, 1, 0, 2, 1, 1, 1, 1, 1, 1,
+	3, 3, 3, 4, 5, 4, 1, 3, 3, 0,
+	2, 2, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 3, 1, 3, 0, 1,
+	1, 3, 1, 1, 2, 1, 7, 7, 7, 7,
+	8, 5, 0, 1, 0, 1, 1, 1, 1, 3,
+	3, 1, 1, 1, 1, 1, 0, 1, 3, 1,
+	3, 5, 1, 1, 1, 1, 3, 5, 0, 1,
+	1, 2, 1, 2, 2, 1, 1, 2, 2, 2,
+	2, 2, 1, 5, 6, 1, 2, 0, 1, 1,
+	2, 5, 0, 1, 1, 1, 2, 2, 3, 3,
+	1, 1, 2, 2, 2, 0, 1, 2, 2, 2,
+	0, 3, 0, 3, 1, 1, 1, 1, 1, 1,
+	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 1, 1, 1, 1, 3, 5, 2,
+	2, 2, 2, 1, 5, 1, 2, 6, 6, 6,
+	2, 1, 1, 2, 1, 2, 2, 1, 2, 2,
+	2, 2, 2, 0, 1, 1, 5, 4, 4, 5,
+	5, 5, 5, 4, 5, 5, 5, 5, 5, 5,
+	5, 0, 6, 0, 3, 0, 2, 5, 1, 1,
+	2, 2, 2, 2, 2, 1, 1, 1, 4, 4,
+	6, 8, 6, 4, 2, 2, 4, 2, 2, 4,
+	6, 2, 2, 2, 4, 6, 4, 2, 0, 1,
+	2, 3, 1, 1, 1, 1, 1, 1, 0, 2,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 3, 2, 0, 1, 1, 2, 4, 0,
+	2, 0, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 3, 0, 1, 1, 3, 3, 3,
+	3, 2, 1, 3, 4, 3, 1, 3, 4, 4,
+	5, 3, 4, 5, 6, 1, 0, 2, 1, 1,
+	1, 1, 1, 1, 1, 1, 2, 2, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 3,
+	1, 1, 1, 2, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 2,
+	2, 2, 2, 2, 1, 2, 2, 2, 2, 2,
+	2, 2, 2, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 4, 4, 1, 1, 3, 0, 1, 0,
+	3, 3, 0, 5, 0, 3, 5, 0, 1, 1,
+	0, 1, 1, 2, 2, 0, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 3, 0, 1, 1,
-	1, 3, 0, 1, 1, 3, 3, 3, 3, 2,
-	1, 3, 4, 3, 1, 3, 4, 4, 5, 3,
-	4, 5, 6, 1, 0, 2, 1, 1, 1, 1,
-	1, 1, 1, 1, 2, 2, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 3, 1, 1, 1,
-	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 2, 2, 2, 1,
-	2, 2, 2, 2, 2, 2, 2, 2, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 4, 4, 1,
-	1, 3, 0, 1, 0, 3, 3, 0, 5, 0,
-	3, 5, 0, 1, 1, 0, 1, 1, 2, 2,
-	0, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -3643,425 +3767,453 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1,
+	1, 1, 1, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -310, -2, -1, -3, -4, -5, -6, -38, -20,
-	-7, -48, -32, -33, -34, -40, -45, -46, -47, -50,
-	-16, -15, -14, 8, 10, -8, -156, -21, -22, -23,
-	-24, -25, -26, -27, -28, -29, -30, -31, 181, 9,
-	49, -35, -36, -37, -41, -42, -43, -44, 283, 289,
-	325, -51, -53, -17, -18, -19, 177, -9, -10, -11,
-	-12, -13, 199, 198, 26, 197, 178, 120, 121, 123,
-	124, 30, -52, 54, 179, -54, 6, 421, -61, 27,
-	-82, -153, 57, -142, -144, 379, 380, 381, 382, 383,
-	384, 385, 386, 387, 388, 389, 390, 391, 392, 393,
-	394, 395, 396, 397, 398, 399, 400, 401, 402, 403,
-	404, 405, 406, 407, 408, 409, 410, 411, 412, 413,
-	414, 373, 216, 240, 243, 250, 120, 137, 131, 158,
-	150, 151, 128, 220, 221, 64, 123, 353, 339, 324,
-	126, 235, 237, 239, 242, 222, 146, 142, 234, 218,
-	140, 223, 28, 224, 163, 225, 226, 378, 335, 265,
-	357, 341, 247, 141, 336, 238, 338, 164, 168, 345,
-	289, 135, 136, 343, 347, 162, 196, 32, 375, 34,
-	208, 348, 166, 161, 157, 356, 251, 249, 160, 134,
-	156, 219, 38, 170, 169, 171, 319, 320, 321, 322,
-	227, 228, 344, 153, 143, 269, 129, 18, 351, 203,
-	340, 290, 229, 244, 206, 252, 165, 167, 210, 215,
-	377, 248, 349, 139, 207, 236, 202, 352, 130, 283,
-	292, 230, 124, 241, 346, 355, 37, 305, 133, 127,
-	193, 121, 212, 217, 231, 232, 233, 254, 253, 245,
-	154, 209, 159, 132, 155, 122, 211, 354, 306, 420,
-	267, 308, 152, 149, 213, 186, 376, 350, 342, 125,
-	312, 307, 147, 255, 417, 418, 419, 11, -157, 19,
-	323, -39, 181, -153, -5, -4, -32, -50, 184, -58,
-	-59, -60, -122, -124, -82, 54, -153, -228, -198, -227,
-	-199, -230, -200, -152, 20, 178, 177, 211, 10, 179,
-	287, 185, 8, 6, 288, 197, 9, 289, 291, 292,
-	295, 296, 297, 31, 300, 301, 57, 60, -153, -228,
-	-198, 215, 222, -49, -65, -66, -123, 27, 15, 5,
-	308, 214, -192, -190, -264, 194, 193, 76, 359, 183,
-	298, -311, -261, 342, 341, -161, 340, 333, 335, 177,
-	185, 343, 32, 345, 346, 336, 308, 125, 122, -214,
-	80, 130, 129, -214, 214, 29, -221, 318, -220, -222,
-	345, 346, 356, -215, 344, -140, -153, 58, 59, 75,
-	151, 148, -66, -123, -65, -51, -53, 308, 214, 185,
-	184, 359, -263, 20, -268, 21, 22, -1, -71, 206,
-	-82, 119, -58, -134, -153, 324, 89, -39, 119, -82,
-	30, -125, -126, -127, -128, 41, 45, 47, 42, 43,
-	44, 48, -313, 23, -149, -155, 23, -150, 60, -151,
-	-144, 57, 58, 59, -51, -53, 51, 55, 11, 55,
-	54, 422, 58, 285, 299, 308, 286, 298, 186, 214,
-	299, 214, 333, 186, 290, 293, 294, 334, 51, 187,
-	51, -278, 356, -49, -68, 17, -54, -53, 416, 16,
-	20, 21, -188, 189, -188, 185, -188, 184, -312, 11,
-	99, 213, 212, 337, 334, -237, 338, 339, -161, -160,
-	97, -161, 184, 359, -262, 189, 349, 376, 128, 129,
-	130, -225, 20, 29, 317, -198, 214, 55, 89, 19,
-	-223, 89, 100, -222, -222, -222, -223, -100, 29, -151,
-	60, 116, -100, 29, 119, 30, 30, -67, -68, -54,
-	-53, 56, 56, -262, -262, -262, -262, -262, -55, -56,
-	107, -174, -153, 81, -176, 57, -170, 380, 381, 382,
-	383, 384, 385, 386, 388, 393, 395, 399, 400, 401,
-	402, 404, 405, 406, 407, 412, 413, 414, 308, 147,
-	-171, -173, -296, -291, -169, 54, 105, 106, 113, 82,
-	-172, -250, 24, 367, -130, -131, -132, -133, -292, -290,
-	60, 65, 69, 71, 72, 70, 67, 118, -53, -267,
-	-273, -271, 148, 200, 144, 145, 8, 111, 318, 116,
-	-274, 59, 58, 271, 75, 272, 273, 359, 268, 274,
-	189, 323, 43, 275, 276, 277, 278, 279, 366, 280,
-	44, 281, 270, 204, 282, 370, 369, 371, 363, 360,
-	358, 361, 362, 364, 365, -269, 33, -50, 54, 30,
-	54, -153, -119, 12, 119, 65, 60, -153, 54, -213,
-	-212, -134, -59, -59, -59, -59, 41, 41, 41, 46,
-	41, 46, 41, -127, -150, -155, 56, -229, 184, 284,
-	210, -227, 211, 289, 292, -204, -203, -201, -152, 60,
-	-199, -232, -134, -152, 334, -229, -204, -203, 326, -49,
-	-174, -153, 60, -64, -63, -174, -204, 81, -198, -151,
-	-153, -188, -82, -160, -160, -162, -312, -158, -312, 334,
-	-119, -173, -237, -159, -153, -188, -204, 308, 24, 350,
-	351, 126, 129, 128, 357, -226, 317, 20, -198, -220,
-	-216, 60, 318, -203, -224, 51, 116, -275, -174, 29,
-	-223, -223, -223, -224, 115, -153, -49, -204, -198, -153,
-	-83, -82, -154, -151, -144, -118, 55, -117, 11, -148,
-	80, 78, 79, -153, 23, 119, -174, 96, -184, 89,
-	90, 91, 92, 93, 94, 54, 54, 54, 54, 54,
-	54, 54, 54, -182, 54, 54, 54, 54, 54, 54,
-	54, -182, 54, 54, 54, 102, 101, 112, 105, 106,
-	107, 108, 109, 110, 111, 103, 104, 99, 81, 97,
-	98, 83, -53, -174, -179, -173, -173, -173, -173, -250,
-	54, -174, 54, -272, 54, -181, -182, 54, 60, 60,
-	60, 54, 54, 54, -173, 54, -270, -180, -309, 415,
-	-73, 56, -69, -153, -307, -308, -69, -72, -153, -66,
-	-174, -146, -147, -139, -143, -150, -151, -144, 266, 182,
-	20, 80, 23, 25, 271, 303, 83, 116, 16, 84,
-	148, 115, 273, 367, 272, 177, 47, 75, 369, 371,
-	370, 360, 358, 310, 314, 316, 313, 359, 333, 29,
-	10, 26, 198, 21, 22, 109, 179, 200, 87, 88,
-	201, 24, 199, 72, 19, 50, 11, 323, 13, 14,
-	274, 309, 189, 188, 99, 326, 185, 45, 8, 118,
-	27, 96, 311, 41, 77, 43, 97, 17, 361, 362,
-	31, 325, 372, 205, 111, 275, 276, 277, 48, 81,
-	317, 70, 51, 78, 15, 46, 98, 180, 366, 44,
-	214, 315, 279, 281, 280, 183, 6, 270, 368, 30,
-	197, 42, 184, 334, 86, 187, 71, 204, 144, 145,
-	5, 76, 9, 49, 52, 363, 364, 365, 33, 85,
-	12, 282, 278, 318, 327, 328, 329, 330, 331, 332,
-	172, 173, 174, 175, 176, 246, 192, 190, 194, 195,
-	415, 416, 19, -39, 119, -70, -153, -119, 55, 89,
-	-75, -74, 51, 52, -76, 51, -74, 41, 41, -231,
-	107, 57, 55, -202, 309, 422, 58, 56, 55, -231,
-	187, 60, 55, 18, 119, -282, 338, 55, -62, 25,
-	26, -205, -206, 315, 24, -191, 52, -186, -187, -185,
-	-189, 29, -82, -119, -119, -119, -160, -154, -162, -157,
-	-162, -158, 119, -141, -153, -205, 54, 127, 130, 130,
-	129, -198, 187, 54, 89, -224, -224, -224, 29, -152,
-	51, 55, -119, -56, -57, -58, -174, -174, -174, -153,
-	-153, 107, 70, 81, -170, -178, -179, -174, -129, 21,
-	20, -129, -129, -174, -129, 107, -179, -179, 56, -252,
-	65, -129, -129, -129, -178, -178, -129, -129, -129, -129,
-	-171, -171, -171, -171, -171, -171, -171, -171, -171, -171,
-	-171, -171, -177, -183, -250, 54, 99, 97, 98, 83,
-	-173, -171, -171, 56, 55, -174, -251, 270, -178, 56,
-	-179, -178, -171, -178, -129, 55, 54, 56, 55, 33,
-	119, 55, 89, 56, 55, -67, 119, 324, -153, 56,
-	55, -66, -212, -174, -174, 54, -174, 11, 119, 119,
-	-203, 16, 376, -152, -134, 187, -204, -279, 188, 366,
-	-174, -174, -153, -288, 332, 327, 329, -63, -210, 376,
-	317, 316, 312, -207, -208, 311, 313, 310, 314, 51,
-	260, 261, 262, 263, -185, -140, 115, 225, 151, 54,
-	-119, -160, -160, -162, -153, -210, 56, 130, -204, -163,
-	60, -216, -82, -82, -121, 13, 55, 119, 70, 56,
-	55, -174, -174, -174, 23, -179, 56, 56, 56, 56,
-	-174, -174, -174, 56, 56, -174, -174, -174, -174, -179,
-	-177, -173, -171, -171, -175, 201, 80, -174, 55, 52,
-	56, 56, 52, 56, 55, 56, -174, -180, -277, -276,
-	-275, 33, -50, -69, -270, -153, -308, -275, -153, -146,
-	-143, -151, -144, 65, -153, -67, -70, -204, 107, 107,
-	57, -152, 318, -152, -204, -217, 376, 27, 119, -259,
-	417, -286, 327, 16, 16, -209, -211, 319, 320, 321,
-	322, 80, -208, 60, 60, 60, 60, -82, -145, 89,
-	-145, -145, -77, -78, -79, -84, -80, -134, -165, -81,
-	192, 190, 194, -304, 76, 195, 246, 77, 185, -119,
-	-119, -160, -167, -168, -166, 266, -265, 318, 309, 56,
-	-120, 14, 16, -58, -153, 107, -174, 56, 56, 56,
-	-85, -91, 116, 148, 200, 147, 146, 144, 305, 306,
-	140, 141, 139, 56, 56, 56, 56, 56, 56, 56,
-	56, 56, -175, 80, -173, -170, 56, -85, -100, -100,
-	-171, 56, 56, 55, -270, 56, -152, 16, 23, -205,
-	289, 184, -107, 418, 60, 16, 60, -284, 60, -211,
-	65, 65, 65, 65, -208, 54, -100, -102, -151, 60,
-	116, 60, 56, 55, -86, -90, -87, -89, -88, -92,
-	-91, 148, 149, 116, 152, 154, 155, 156, 157, 158,
-	159, 160, 161, 162, 163, 30, 200, 144, 145, 146,
-	147, 164, 131, 150, 374, 172, 132, 173, 133, 174,
-	134, 175, 135, 136, 176, 137, -81, -153, 77, -303,
-	-304, -188, -303, 77, 54, -119, -166, 267, 31, 118,
-	269, 29, 265, 16, -174, -179, 56, -253, -255, 54,
-	-254, 54, -253, -253, -253, -93, 136, 135, -93, -256,
-	54, -257, 54, -256, -170, 56, 56, 56, 56, -275,
-	-152, -152, -217, 290, -82, -137, 419, 65, 60, 329,
-	-193, -195, -134, 54, -98, -99, -116, 303, 216, -189,
-	220, 64, 221, 324, 222, 185, 224, 225, 226, 196,
-	227, 228, 229, 318, 230, 231, 232, 233, 286, 5,
-	256, -79, -97, -96, -94, 70, 81, 29, 303, -95,
-	64, 115, 239, 217, 240, -115, -164, 190, 76, 77,
-	291, -165, -258, 306, 305, -253, -254, -255, -253, -253,
-	54, 54, -253, -253, -253, -253, -300, -301, -153, -301,
-	-153, -300, -300, -188, -174, 65, -266, -163, 65, 65,
-	65, 65, -280, -237, 54, 16, 56, 55, -253, -174,
-	-233, 206, 55, -116, -145, -145, -140, 115, -145, -145,
-	-145, -145, 223, 223, -145, -145, -145, -145, -145, -145,
-	-145, -145, -145, -145, -145, -145, -145, -145, 54, -94,
-	70, -171, 60, -102, -103, 29, 238, 234, -104, 29,
-	218, 219, -106, 54, 246, 77, 77, -82, -260, 307,
-	-136, 60, -136, 54, 52, 255, 54, 54, 54, -301,
-	56, 268, 56, 56, 55, 56, 55, -287, 332, -283,
-	-281, 327, 328, 329, 330, -138, -153, -284, -196, -195,
-	-62, 56, 16, -116, 65, 65, -145, -145, 65, 60,
-	60, 60, -145, -145, 65, 60, -155, 65, 65, 65,
-	65, 29, 60, -105, 29, 234, 238, 235, 236, 237,
-	65, 29, 65, 29, 65, 29, -153, 54, -305, -306,
-	60, 65, 54, -194, 54, 56, 55, 56, -193, -302,
-	260, 261, 262, 264, 263, -302, -193, -193, -193, 54,
-	-219, -218, 247, 81, 65, 65, -289, 188, -285, 331,
-	-281, 16, 329, 16, 16, 56, 55, -197, 196, 64,
-	376, 258, 259, -62, -234, 248, 249, -235, -241, 251,
-	-100, -100, 60, 60, -101, 217, -83, 56, 55, 89,
-	56, -174, -109, -108, 372, -193, 60, 56, 56, 56,
-	56, -193, 247, 56, 56, -295, 54, 65, -286, 16,
-	-284, 16, -284, -284, -153, -145, 60, 257, -239, 252,
-	54, -237, 54, -237, 77, 261, 218, 219, 56, -306,
-	60, 56, -113, -114, -111, -112, 51, 336, 244, 245,
-	56, -196, -196, -196, -196, 56, -299, 30, 56, -294,
-	-293, -135, -290, -153, 332, 60, -284, 65, -151, -236,
-	253, 65, -171, 54, -171, 54, -238, 250, 54, -218,
-	-112, 51, -111, 51, 10, 9, -115, -298, -297, -296,
-	56, 55, 119, -243, 54, 16, 56, -232, 56, -232,
-	54, 89, -171, -110, 241, 242, 30, 129, -110, 55,
-	89, -293, -153, -244, -242, 206, -235, 56, 56, -232,
-	65, 56, 70, 29, 243, -297, 29, -174, 119, 56,
-	55, 57, -240, 254, 56, -153, -242, -245, 33, 65,
-	-249, -246, 54, -116, 208, -249, -116, -248, -247, 253,
-	209, 56, 55, 57, 54, -247, -246, -179, 56,
+	-1000, -324, -2, -1, -3, -4, -5, -6, -42, -21,
+	-7, -52, -34, -35, -38, -44, -49, -50, -51, -54,
+	-16, -15, -14, 10, 12, -8, -167, -22, -23, -24,
+	-25, -26, -27, -28, -29, -30, -31, -32, -33, 185,
+	11, 51, -39, -40, -41, -45, -46, -47, -48, 287,
+	293, 329, -55, -57, -17, -18, -19, -20, 181, -9,
+	-10, -11, -12, -13, 203, 202, 28, 201, 182, 124,
+	125, 127, 128, 32, -56, 58, 183, -58, 8, 430,
+	-65, 29, -87, -163, 61, -152, -154, 382, 383, 384,
+	385, 386, 387, 388, 389, 390, 391, 392, 393, 394,
+	395, 396, 397, 398, 399, 400, 401, 402, 403, 404,
+	405, 406, 407, 408, 409, 410, 411, 412, 413, 414,
+	415, 416, 417, 376, 220, 244, 247, 254, 124, 141,
+	135, 162, 154, 155, 132, 224, 225, 68, 127, 357,
+	343, 328, 130, 239, 241, 243, 246, 425, 226, 150,
+	146, 238, 222, 144, 227, 30, 228, 167, 229, 230,
+	381, 339, 269, 345, 251, 145, 340, 242, 342, 428,
+	168, 172, 349, 293, 139, 140, 347, 351, 166, 200,
+	34, 378, 36, 212, 352, 170, 165, 161, 360, 255,
+	253, 164, 138, 160, 223, 40, 174, 173, 175, 323,
+	324, 325, 326, 231, 232, 348, 157, 147, 273, 133,
+	20, 355, 207, 344, 294, 233, 248, 210, 256, 169,
+	171, 427, 214, 219, 380, 252, 353, 143, 211, 240,
+	206, 356, 134, 287, 296, 234, 424, 128, 245, 350,
+	359, 39, 309, 137, 131, 197, 125, 216, 221, 235,
+	236, 237, 258, 257, 249, 158, 213, 163, 136, 159,
+	126, 215, 426, 358, 310, 429, 271, 312, 156, 153,
+	217, 190, 379, 354, 346, 129, 316, 311, 151, 259,
+	420, 421, 422, 13, -168, 21, 327, -43, 185, -163,
+	-5, -4, -34, -54, 188, -62, -63, -64, -127, -129,
+	-87, 58, -163, -242, -213, -241, -214, -244, -215, -162,
+	22, 182, 181, 215, 12, 183, 291, 189, 10, 8,
+	292, 201, 11, 293, 295, 296, 299, 300, 301, 33,
+	304, 305, 61, 64, -163, -242, -213, 219, 226, -53,
+	-69, -70, -128, 29, 17, 5, 6, 7, 190, 312,
+	218, -207, -205, -278, 198, 197, 80, 362, 187, 302,
+	-327, -275, 346, 345, -172, 344, 337, 339, 181, 189,
+	347, 34, 349, 350, 340, 188, 312, 129, 126, -229,
+	84, 134, 133, -229, 218, 31, -235, 322, -234, -236,
+	349, 350, 360, 62, 63, 348, -150, -163, 79, 155,
+	152, -70, -128, -69, -55, -57, 312, 218, 189, 188,
+	362, -277, 22, -282, 23, 24, -1, -76, 210, -87,
+	123, -62, -144, -163, 328, 93, -43, 123, -87, 32,
+	-130, -131, -132, -133, 43, 47, 49, 44, 45, 46,
+	53, 50, -329, 25, -159, -166, 25, -160, 64, -161,
+	-154, 61, 62, 63, -55, -57, 55, 59, 13, 59,
+	58, 431, 62, 289, 303, 312, 290, 302, 190, 218,
+	303, 218, 337, 190, 294, 297, 298, 338, 55, 191,
+	55, -292, 360, -53, -72, 19, -58, -57, 419, 18,
+	22, 23, 22, 23, 22, 23, -203, 193, -203, -203,
+	189, -203, 188, -328, 13, 103, 217, 216, 341, 338,
+	-251, 342, 343, -172, -171, 101, -172, 188, 362, -87,
+	-276, 193, 353, 379, 132, 133, 134, -239, 22, 31,
+	321, -213, 218, 59, 93, 21, -237, 93, 104, -236,
+	-236, -236, -237, -237, -105, 31, -161, 64, 120, -105,
+	31, 123, 32, 32, -71, -72, -58, -57, 60, 60,
+	-276, -276, -276, -276, -276, -59, -60, 111, -189, -163,
+	85, -191, 61, -181, 383, 384, 385, 386, 387, 388,
+	389, 391, 394, 396, 398, 402, 403, 404, 405, 407,
+	408, 409, 410, 415, 416, 417, 312, 151, -182, -188,
+	-310, -305, -180, 58, 109, 110, 117, 86, -183, 88,
+	-264, 26, 370, -135, -136, -137, -138, -306, -304, 64,
+	69, 73, 75, 76, 74, 71, 65, 122, 54, -57,
+	-281, -287, -285, 152, 204, 148, 149, 10, 115, 322,
+	120, -288, 63, 62, 275, 79, 276, 277, 362, 272,
+	278, 193, 327, 45, 279, 280, 281, 282, 283, 369,
+	284, 46, 285, 274, 208, 286, 373, 372, 374, 366,
+	363, 361, 364, 365, 367, 368, -283, 35, -54, 58,
+	32, 58, -163, -124, 14, 123, 69, 64, -163, 58,
+	-228, -227, -144, -63, -63, -63, -63, 43, 43, 43,
+	48, 43, 48, 43, 48, 43, -132, -160, -166, 60,
+	-243, 188, 288, 214, -241, 215, 293, 296, -219, -218,
+	-216, -162, 64, -214, -246, -144, -162, 338, -243, -219,
+	-218, 330, -53, -189, -163, 64, -68, -67, -189, -87,
+	85, -219, -213, -161, -163, -203, -87, -171, -171, -173,
+	-328, -169, -328, 338, -124, -188, -251, -170, -163, -203,
+	-37, -36, 186, 183, 184, 182, -219, 312, 26, 354,
+	355, 130, 133, 132, 7, -240, 321, 22, -213, -234,
+	-230, 64, 322, -218, -238, 55, 120, -289, -189, 31,
+	-237, -237, -237, -238, -238, 119, -163, -53, -219, -213,
+	-163, -88, -87, -165, -161, -154, -123, 59, -122, 13,
+	-158, 84, 82, 83, -163, 25, 123, -189, 100, -199,
+	93, 94, 95, 96, 97, 98, 58, 58, 58, 58,
+	58, 58, 58, 58, -197, 58, 58, 58, 58, 58,
+	58, 58, 58, -197, 58, 58, 58, 106, 105, 116,
+	109, 110, 111, 112, 113, 114, 115, 107, 108, 103,
+	85, 101, 102, 87, -57, -189, -194, -188, -188, -188,
+	-188, -184, -189, -264, 58, -139, 423, -139, -189, 64,
+	58, -286, 58, -196, -197, 58, 64, 64, 64, 58,
+	58, 58, -188, 58, -284, -195, -323, 418, -78, 60,
+	-73, -163, -321, -322, -73, -77, -163, -70, -189, -156,
+	-157, -149, -153, -160, -161, -154, 270, 186, 22, 84,
+	25, 27, 275, 307, 87, 120, 18, 88, 152, 119,
+	277, 370, 276, 181, 49, 79, 372, 374, 373, 363,
+	361, 314, 318, 320, 317, 362, 337, 31, 12, 28,
+	202, 23, 24, 113, 183, 204, 91, 92, 205, 26,
+	7, 203, 76, 21, 52, 13, 327, 15, 16, 278,
+	313, 193, 192, 103, 330, 189, 47, 10, 6, 122,
+	29, 100, 315, 43, 81, 45, 101, 19, 364, 365,
+	33, 329, 375, 209, 115, 279, 280, 281, 50, 85,
+	321, 74, 55, 82, 17, 48, 102, 184, 369, 46,
+	218, 319, 283, 285, 284, 187, 8, 274, 371, 32,
+	201, 44, 188, 338, 90, 191, 75, 208, 148, 149,
+	5, 80, 11, 51, 56, 366, 367, 368, 35, 89,
+	14, 286, 282, 322, 331, 332, 333, 334, 335, 336,
+	176, 177, 178, 179, 180, 250, 196, 194, 198, 199,
+	418, 419, 21, -43, 123, -74, -163, -124, 59, 93,
+	-80, -79, 55, 56, -81, 55, -79, 43, 43, 43,
+	-75, 58, -245, 111, 61, 59, -217, 313, 431, 62,
+	60, 59, -245, 191, 64, 59, 20, 123, -164, 327,
+	59, -66, 27, 28, -75, 26, -220, -221, 319, -206,
+	56, -201, -202, -200, -204, 31, -87, -124, -124, -124,
+	-171, -165, -173, -168, -173, -169, 123, -151, -163, 59,
+	-325, 195, -325, 195, -326, 191, 25, -325, -220, 58,
+	131, 134, 134, 133, -213, 191, 58, 93, -238, -238,
+	-238, 31, -162, 55, 59, -124, -60, -61, -62, -189,
+	-189, -189, -163, -163, 111, 74, 85, -181, -193, -194,
+	-189, -134, 23, 22, -134, -134, -189, -134, 111, -194,
+	-194, 60, -266, 69, -265, 279, 274, 280, 278, 272,
+	286, 281, 282, 151, -134, -134, -134, -193, -193, -189,
+	-134, -134, -134, -134, -182, -182, -182, -182, -182, -182,
+	-182, -182, -182, -182, -182, -182, -192, -198, -264, 58,
+	103, 101, 102, 87, -188, -182, -182, 60, 59, -187,
+	-186, 89, -189, 58, -265, -193, 60, -194, -193, -182,
+	-193, -134, 59, 58, 60, 59, 35, 123, 59, 93,
+	60, 59, -71, 123, 328, -163, 60, 59, -70, -227,
+	-289, -189, 58, -189, -74, 13, 123, 123, -218, 18,
+	379, -162, -144, 191, -219, -164, -189, -189, -163, -296,
+	342, -163, -67, 25, -225, 379, 321, 320, 316, -222,
+	-223, 315, 317, 314, 318, 55, 264, 265, 266, 267,
+	-200, -150, 119, 229, 155, 58, -124, -171, -171, -173,
+	-163, -36, -89, -144, -163, -163, -87, -163, -225, 60,
+	134, -219, -174, 64, -230, -87, -87, -126, 15, 59,
+	123, 74, 60, 59, -189, -189, -189, 25, -194, 60,
+	60, 60, 60, 13, -189, -189, -189, 60, 60, 13,
+	-189, -189, -189, -189, -194, -192, -188, -182, -182, -190,
+	205, 84, -189, -185, -186, 91, -189, 59, 56, -140,
+	210, 60, 60, 56, 60, 59, 60, -189, -195, -291,
+	-290, -289, 35, -54, -73, -284, -163, -322, -289, -163,
+	-156, -153, -161, -154, 69, -163, -71, -74, 60, -219,
+	111, 111, 61, -162, 322, -162, -219, -231, 379, -293,
+	192, 369, 123, -302, 336, 331, 333, -54, -224, -226,
+	323, 324, 325, 326, 84, -223, 64, 64, 64, 64,
+	-87, -155, 93, -155, -155, -82, -83, -84, -89, -85,
+	-176, -86, 196, 194, 198, -318, 80, 199, 250, 81,
+	189, -124, -124, -171, -91, -95, -92, -94, -93, -97,
+	-96, 152, 153, 120, 156, 158, 159, 160, 161, 162,
+	163, 164, 165, 166, 167, 32, 204, 148, 149, 150,
+	151, 168, 135, 154, 377, 176, 136, 177, 137, 178,
+	138, 179, 139, 140, 180, 141, 144, 145, 146, 147,
+	143, 191, 32, 183, -178, -179, -177, 270, -279, 322,
+	313, 60, -125, 16, 18, -62, -163, 111, -189, 60,
+	60, 60, -90, -96, 120, 152, 204, 151, 150, 148,
+	309, 310, 60, -189, 60, 60, 60, -189, 60, 60,
+	60, 60, 60, -190, 84, -188, -181, 60, 92, -189,
+	90, -90, -105, -70, 18, -105, -182, 60, 60, 59,
+	-284, 60, -162, 18, 25, -220, 293, 29, -273, 420,
+	-300, 331, 18, 18, -226, 69, 69, 69, 69, -223,
+	58, -105, -107, -161, 64, 120, 64, 60, 59, -86,
+	-163, 81, -317, -318, -203, -317, 81, 58, -124, -102,
+	-101, -99, 74, 85, 31, 307, -100, 68, 119, 243,
+	221, 244, -120, -175, 194, 80, 81, 295, -176, -272,
+	310, 309, -267, -269, 58, -268, 58, -269, -267, -267,
+	58, 58, -267, -267, -267, -267, -270, 58, -271, 58,
+	-271, -271, -270, -163, 31, 31, -177, 271, 33, 122,
+	273, 31, 269, 18, -189, -194, 60, -267, -268, -267,
+	-267, -267, -98, 140, 139, -98, 60, 60, 21, -181,
+	-189, 60, 60, -141, -143, 424, 252, -194, 60, 60,
+	-289, -162, -162, -231, 294, 188, -112, 421, 64, 18,
+	64, -298, 64, -208, -210, -144, 58, -103, -104, -121,
+	307, 220, -204, 224, 68, 225, 328, 226, 189, 228,
+	229, 230, 200, 231, 232, 233, 322, 234, 235, 236,
+	237, 290, 5, 260, -84, -314, -315, -163, -315, -163,
+	-314, -314, -203, -189, -99, 74, -182, 64, -107, -108,
+	31, 242, 238, -109, 31, 222, 223, -111, 58, 250,
+	81, 81, -87, -274, 311, 69, 69, -146, 64, -146,
+	69, 69, -182, 69, -280, -174, -189, 60, -142, 87,
+	426, 425, 69, -87, -147, 422, 69, 64, 333, 60,
+	59, -267, -189, -247, 210, 59, -121, -155, -155, -150,
+	119, -155, -155, -155, -155, 227, 227, -155, -155, -155,
+	-155, -155, -155, -155, -155, -155, -155, -155, -155, -155,
+	-155, 58, 58, 56, 259, 58, 58, 58, -315, 60,
+	69, 58, -209, 58, 60, 60, 60, 59, 60, 59,
+	60, 59, 272, 60, -142, 427, 428, 418, 427, 428,
+	-294, -251, 58, 18, -211, -210, -66, 60, 18, -121,
+	69, 69, -155, -155, 69, 64, 64, 64, -155, -155,
+	69, 64, -166, 69, 69, 69, 69, 31, 64, -110,
+	31, 238, 242, 239, 240, 241, 69, 31, 69, 31,
+	69, 31, -163, 58, -319, -320, 64, -208, -316, 264,
+	265, 266, 268, 267, -316, -208, -208, -208, 58, -233,
+	-232, 251, 85, 60, -189, -114, -113, 375, -208, 64,
+	69, 69, 84, -301, 336, -297, -295, 331, 332, 333,
+	334, -148, -163, -298, -212, 200, 68, 379, 262, 263,
+	-66, -248, 252, 253, -249, -255, 255, -105, -105, 64,
+	64, -106, 221, -88, 60, 59, 93, 60, 60, 60,
+	60, -208, 251, 60, -118, -119, -116, -117, 55, 340,
+	248, 249, 60, 60, 60, -142, -303, 192, -299, 335,
+	-295, 18, 333, 18, 18, 60, 59, -155, 64, 261,
+	-253, 256, 58, -251, 58, -251, 81, 265, 222, 223,
+	60, -320, 64, -211, -211, -211, -211, 60, -232, -117,
+	55, -116, 55, 12, 11, -309, 58, 69, -300, 18,
+	-298, 18, -298, -298, -163, 69, -161, -250, 257, 69,
+	-182, 58, -182, 58, -252, 254, 58, -120, -115, 245,
+	246, 32, 133, -115, -313, 32, 60, -308, -307, -145,
+	-304, -163, 336, 64, -298, -257, 58, 18, 60, -246,
+	60, -246, 58, 93, -182, 74, 31, 247, -312, -311,
+	-310, 60, 59, 123, -258, -256, 210, -249, 60, 60,
+	-246, 69, 60, 59, 93, -307, -163, 60, 59, 61,
+	-254, 258, 60, -311, 31, -189, 123, -256, -259, 35,
+	69, -163, -263, -260, 58, -121, 212, -263, -121, -262,
+	-261, 257, 213, 60, 59, 61, 58, -261, -260, -194,
+	60,
 }
 
 var yyDef = [...]int{
 	20, -2, 1, 2, 4, 5, 6, 7, 8, 9,
 	10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
-	421, 422, 423, 0, 0, 264, 0, 218, 219, 220,
-	221, 222, 223, 224, 225, 226, 227, 200, 0, 0,
-	180, 160, 161, 162, 119, 120, 121, 122, 0, 0,
-	0, 324, -2, 424, 425, 426, -2, 265, 266, 267,
-	268, 269, 196, 197, 198, -2, 0, 173, 0, 165,
-	165, 0, 334, 0, 0, 345, 354, 20, 302, 0,
-	307, 598, 634, 635, 636, 1238, 1239, 1240, 1241, 1242,
-	1243, 1244, 1245, 1246, 1247, 1248, 1249, 1250, 1251, 1252,
-	1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260, 1261, 1262,
-	1263, 1264, 1265, 1266, 1267, 1268, 1269, 1270, 1271, 1272,
-	1273, 1082, 1083, 1084, 1085, 1086, 1087, 1088, 1089, 1090,
-	1091, 1092, 1093, 1094, 1095, 1096, 1097, 1098, 1099, 1100,
-	1101, 1102, 1103, 1104, 1105, 1106, 1107, 1108, 1109, 1110,
-	1111, 1112, 1113, 1114, 1115, 1116, 1117, 1118, 1119, 1120,
-	1121, 1122, 1123, 1124, 1125, 1126, 1127, 1128, 1129, 1130,
-	1131, 1132, 1133, 1134, 1135, 1136, 1137, 1138, 1139, 1140,
-	1141, 1142, 1143, 1144, 1145, 1146, 1147, 1148, 1149, 1150,
-	1151, 1152, 1153, 1154, 1155, 1156, 1157, 1158, 1159, 1160,
-	1161, 1162, 1163, 1164, 1165, 1166, 1167, 1168, 1169, 1170,
-	1171, 1172, 1173, 1174, 1175, 1176, 1177, 1178, 1179, 1180,
-	1181, 1182, 1183, 1184, 1185, 1186, 1187, 1188, 1189, 1190,
-	1191, 1192, 1193, 1194, 1195, 1196, 1197, 1198, 1199, 1200,
-	1201, 1202, 1203, 1204, 1205, 1206, 1207, 1208, 1209, 1210,
-	1211, 1212, 1213, 1214, 1215, 1216, 1217, 1218, 1219, 1220,
-	1221, 1222, 1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230,
-	1231, 1232, 1233, 1234, 1235, 1236, 1237, 0, 189, 0,
-	0, 193, 0, 261, 185, 186, 187, 188, 0, 0,
-	376, 377, 400, 403, 406, 0, 179, 0, 0, 80,
-	464, 82, 466, 0, 86, 88, 89, -2, 93, 94,
-	95, 96, 97, 98, 99, 0, 101, 1131, 103, 1191,
-	106, 107, 108, 0, 117, 118, -2, -2, 461, 0,
-	0, 1180, 62, 325, -2, 0, 0, 0, 0, 350,
-	495, 495, 0, 495, 0, 472, 473, 474, 493, 494,
-	508, 0, 0, 237, 238, 0, 254, 245, 254, 0,
-	229, 230, 231, 235, 236, 255, 203, 174, 175, 164,
-	0, 169, 0, 163, 0, 0, 133, 0, 138, 0,
-	1130, 1195, 1146, 0, 1163, 0, 158, 151, 152, 927,
-	1092, 0, 329, 0, 335, 0, 334, 203, 203, 203,
-	203, 203, 0, 355, 356, 357, 358, 3, 0, 0,
-	306, 0, 363, 190, 637, 0, 0, 195, 0, 0,
-	0, 0, 0, 0, 0, 391, 0, 0, 390, 0,
-	0, 0, 0, 404, 405, 407, 0, 409, 410, 416,
-	417, 418, 419, 420, 0, 334, 76, 0, 0, 0,
-	0, 0, 468, 87, 116, 90, 91, 0, 111, 113,
-	115, 114, 100, 112, 102, 104, 105, 110, 76, 0,
-	0, 0, 63, 327, 308, 0, 347, 349, 0, 0,
-	351, 352, 0, 0, 0, 0, 0, 495, 0, 250,
-	251, 245, 245, 239, 247, 0, 252, 253, 0, 363,
-	0, 0, 0, 495, 0, 0, 0, 0, 167, 0,
-	172, 123, 128, 126, 127, 129, 0, 0, 0, 0,
-	0, 156, 157, 0, 0, 0, 0, 145, 148, 590,
-	591, 592, 149, 150, 0, 928, 929, 308, 330, 346,
-	348, 343, 344, 0, 0, 0, 0, 0, 371, 365,
-	367, 411, 28, 0, 830, 634, 834, 1239, 1240, 1241,
-	1242, 1243, 1244, 1245, 1247, 1252, 1254, -2, -2, -2,
-	1261, 1263, 1264, 1265, 1266, 1271, 1272, 1273, -2, -2,
-	843, 705, 706, 707, 708, 0, 0, 0, 0, 0,
-	715, 716, 0, 0, 721, 722, 723, 724, 38, 39,
-	859, 860, 861, 862, 863, 864, 865, 797, 692, 0,
-	782, 772, 0, 792, 810, 811, 0, 0, 0, 0,
-	0, 40, 41, 788, 789, 790, 791, 793, 794, 795,
-	796, 798, 799, 800, 801, 802, 803, 804, 805, 806,
-	807, 808, 809, 812, 814, 784, 785, 786, 787, 776,
-	777, 778, 779, 780, 781, 276, 294, 278, 0, 283,
-	0, 599, 334, 0, 0, 191, 0, 262, 0, 363,
-	182, 0, 394, 388, 0, 381, 392, 393, 384, 0,
-	386, 0, 382, 383, 401, 408, 402, 0, 77, 78,
-	79, 81, 92, 0, 0, 70, 449, 455, 452, 462,
-	465, 0, 84, 467, 109, 0, 65, 0, 0, 328,
-	331, 28, 310, 336, 337, 340, 436, 0, 463, 487,
-	-2, 0, 363, 363, 363, 245, 0, 247, 0, 247,
-	242, 246, 0, 256, 258, 0, 436, 1222, 204, 176,
-	177, 0, 0, 171, 0, 0, 130, 131, 132, 139,
-	134, 136, 0, 0, 140, 153, 154, 155, 300, 301,
-	0, 0, 0, 144, 0, 159, 326, 270, 271, 0,
-	273, 596, 274, 414, 415, 363, 0, 372, 0, 368,
-	0, 0, 0, 412, 0, 0, 829, 0, 0, 848,
-	849, 850, 851, 852, 853, 822, 817, 817, 817, 0,
-	817, 0, 0, 758, 0, 817, 817, 817, 822, 822,
-	817, 759, 817, 817, 817, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, -2, 824, 0, 711, 712, 713, 714, 717,
-	0, 0, 822, 761, 0, 762, 773, 0, 765, 766,
-	767, 822, 0, 822, 771, 817, 277, 291, 0, 295,
-	0, 0, 287, 289, 282, 284, 0, 0, 304, 329,
-	364, 638, 0, 934, -2, 936, -2, -2, 938, 939,
-	940, 941, 942, 943, 944, 945, 946, 947, 948, 949,
-	950, 951, 952, 953, 954, 955, 956, 957, 958, 959,
-	960, 961, 962, 963, 964, 965, 966, 967, 968, 969,
-	970, 971, 972, 973, 974, 975, 976, 977, 978, 979,
-	980, 981, 982, 983, 984, 985, 986, 987, 988, 989,
-	990, 991, 992, 993, 994, 995, 996, 997, 998, 999,
-	1000, 1001, 1002, 1003, 1004, 1005, 1006, 1007, 1008, 1009,
-	1010, 1011, 1012, 1013, 1014, 1015, 1016, 1017, 1018, 1019,
-	1020, 1021, 1022, 1023, 1024, 1025, 1026, 1027, 1028, 1029,
-	1030, 1031, 1032, 1033, 1034, 1035, 1036, 1037, 1038, 1039,
-	1040, 1041, 1042, 1043, 1044, 1045, 1046, 1047, 1048, 1049,
-	1050, 1051, 1052, 1053, 1054, 1055, 1056, 1057, 1058, 1059,
-	1060, 1061, 1062, 1063, 1064, 1065, 1066, 1067, 1068, 1069,
-	1070, 1071, 1072, 1073, 1074, 1075, 1076, 1077, 1078, 1079,
-	1080, 1081, 0, 194, 0, 0, 398, 334, 0, 0,
-	378, 395, 0, 0, 379, 0, 380, 385, 387, 0,
-	71, 75, 0, 451, 0, 0, 454, 83, 0, 0,
-	0, 59, 0, 0, 0, 314, 0, 0, 339, 341,
-	342, 428, 437, 0, 496, 0, 0, 492, -2, 499,
-	0, 505, 0, 228, 232, 233, 363, 248, 245, 249,
-	245, 247, 0, 257, 260, 428, 0, 178, 166, 168,
-	0, 125, 0, 0, 0, 141, 142, 143, 146, 147,
-	0, 0, 361, 366, 373, 374, 826, 827, 828, 413,
-	29, 369, 831, 0, 833, 0, 823, 824, 0, 818,
-	819, 0, 0, 0, 0, 0, 0, 0, 774, 0,
-	858, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	693, 694, 695, 696, 697, 698, 699, 700, 701, 702,
-	703, 704, 835, 846, 847, 0, 0, 0, 0, 0,
-	844, 839, 0, 709, 0, 0, 816, 820, 0, 783,
-	0, 0, 0, 0, 0, 294, 296, 0, 0, 294,
-	0, 0, 0, 303, 0, 275, 0, 0, 263, 199,
-	0, 329, 183, 184, 396, 0, 389, 0, 0, 0,
-	450, 0, 0, 453, 85, 0, 67, 0, 60, 61,
-	332, 333, 29, 316, 48, 0, 0, 338, 427, 0,
-	438, 439, 440, 441, 442, 0, 0, 0, 0, 0,
-	488, 489, 490, 491, 500, 930, 930, 930, 0, 600,
-	240, 363, 363, 245, 259, 205, 0, 170, 124, 0,
-	217, 135, 272, 597, 359, 0, 0, 0, 832, 755,
-	0, 0, 0, 0, 0, 0, 744, 738, 739, 775,
-	0, 0, 0, 757, 756, 0, 0, 0, 0, 0,
-	836, 844, 840, 0, 837, 0, 0, 825, 0, 0,
-	760, 763, 0, 768, 0, 770, 0, 292, 0, 297,
-	298, 294, 281, 288, 280, 290, 285, 286, 305, 639,
-	935, 932, 933, 192, 399, 181, 0, 69, 72, 73,
-	74, 456, 0, 457, 436, 66, 0, 0, 0, 318,
-	0, 315, 0, 0, 0, 429, 430, 0, 0, 0,
-	0, 0, 444, 445, 446, 447, 448, 0, 0, 931,
-	0, 0, 0, 601, 602, 604, 605, 0, 0, 607,
-	661, 0, 616, 495, 616, 0, 0, 618, 619, 243,
-	241, 363, 201, 206, 207, 0, 211, 0, 0, 137,
-	353, 0, 0, 375, 30, 370, 825, 740, 741, 742,
-	0, 725, 912, 914, 728, 912, 912, 912, 734, 734,
-	917, 919, 917, 743, 745, 746, 749, 747, 750, 751,
-	737, 821, 838, 0, 845, 841, 710, 0, 0, 0,
-	0, 748, 293, 0, 279, 397, 460, 0, 0, 67,
-	0, 0, 320, 0, 317, 0, 311, 313, 58, 431,
-	432, 433, 434, 435, 443, 0, 501, 502, 593, 594,
-	595, 503, -2, 0, -2, 922, 867, 868, 869, 912,
-	871, 914, 0, 912, 912, 898, 899, 900, 901, 902,
-	903, 904, 905, 906, 0, 0, 889, 912, 912, 912,
-	912, 909, 872, 873, 874, 875, 876, 877, 878, 879,
-	880, 881, 882, 883, 884, 885, 606, 662, 628, 628,
-	617, 628, 628, 495, 0, 244, 208, 209, 210, 0,
-	213, 214, 216, 0, 360, 362, 718, 726, 913, 0,
-	727, 0, 729, 730, 731, 732, 735, 736, 733, 886,
-	0, 887, 0, 888, 842, 719, 720, 764, 769, 299,
-	458, 459, 64, 68, 50, 309, 0, 319, 49, 0,
-	0, 483, 912, 0, 509, -2, 546, 930, 930, 0,
-	930, 930, 930, 930, 0, 0, 930, 930, 930, 930,
-	930, 930, 930, 930, 930, 930, 930, 930, 930, 930,
-	0, 603, 630, -2, 642, 644, 0, 0, 647, 648,
-	0, 0, 0, 0, 684, 654, 0, 0, 856, 857,
-	0, 660, 925, 923, 924, 870, 894, 895, 896, 897,
-	0, 0, 890, 891, 892, 893, 0, 620, 629, 0,
-	629, 0, 0, 628, 0, 0, 215, 202, 0, 0,
-	0, 0, 44, 0, 0, 0, 476, 0, 340, 0,
-	506, 0, 504, 548, 0, 0, 930, 930, 0, 0,
-	0, 0, 930, 930, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 643,
-	645, 646, 649, 650, 651, 689, 690, 691, 652, 686,
-	687, 688, 653, 0, 0, 854, 855, 682, 866, 926,
-	0, 910, 0, 0, 0, 0, 0, 0, 0, 0,
-	614, 212, 916, 915, 0, 920, 0, 42, 46, 51,
-	52, 0, 0, 0, 0, 0, 322, 312, 475, 484,
-	485, 340, 542, 547, 549, 550, 0, 0, 553, 554,
-	555, 556, 0, 0, 559, 560, 561, 562, 563, 564,
-	565, 566, 567, 568, 584, 585, 586, 587, 588, 589,
-	569, 570, 571, 572, 573, 574, 581, 0, 0, 578,
-	0, 0, 0, 677, 0, 907, 0, 908, 0, 621,
-	623, 624, 625, 626, 627, 622, 0, 0, 0, 0,
-	613, 615, 657, 0, 0, 0, 31, 0, 48, 0,
-	53, 0, 0, 0, 0, 321, 0, 477, 930, 0,
-	0, 481, 482, 486, 531, 0, 0, 537, 0, 543,
-	551, 552, 557, 558, 575, 0, 0, 577, 0, 0,
-	685, 0, 664, 678, 0, 0, 911, 476, 476, 476,
-	476, 0, 658, 918, 921, 22, 0, 0, 45, 0,
-	54, 0, 56, 57, 323, 0, 479, 0, 511, 0,
-	0, 0, 0, 0, 540, 0, 582, 583, 576, 579,
-	580, 655, 663, 665, 666, 667, 0, 679, 680, 681,
-	683, 608, 609, 610, 611, 0, 21, 0, 32, 0,
-	34, 36, 37, 631, 43, 47, 55, 478, 480, 513,
-	0, 532, 0, 0, 0, 0, 0, 0, 0, 656,
-	668, 0, 669, 0, 0, 0, 612, 23, 24, 0,
-	33, 0, 0, 510, 0, 542, 533, 0, 535, 0,
-	0, 0, 0, 670, 672, 673, 0, 0, 671, 0,
-	0, 35, 632, 0, 515, 0, 529, 534, 536, 0,
-	541, 539, 674, 676, 675, 25, 26, 27, 0, 514,
-	0, 527, 512, 0, 538, 633, 516, -2, 0, 530,
-	517, -2, 0, 525, 0, 518, 526, 0, 521, 0,
-	0, 520, 0, -2, 0, 522, -2, 0, 528,
+	447, 448, 449, 0, 0, 280, 0, 234, 235, 236,
+	237, 238, 239, 240, 241, 242, 243, 201, 202, 0,
+	0, 181, 161, 162, 163, 121, 122, 123, 124, 0,
+	0, 0, 340, -2, 450, 451, 452, 453, -2, 281,
+	282, 283, 284, 285, 197, 198, 199, -2, 0, 174,
+	0, 166, 166, 0, 350, 0, 0, 361, 376, 20,
+	318, 0, 323, 626, 662, 663, 664, 1309, 1310, 1311,
+	1312, 1313, 1314, 1315, 1316, 1317, 1318, 1319, 1320, 1321,
+	1322, 1323, 1324, 1325, 1326, 1327, 1328, 1329, 1330, 1331,
+	1332, 1333, 1334, 1335, 1336, 1337, 1338, 1339, 1340, 1341,
+	1342, 1343, 1344, 1149, 1150, 1151, 1152, 1153, 1154, 1155,
+	1156, 1157, 1158, 1159, 1160, 1161, 1162, 1163, 1164, 1165,
+	1166, 1167, 1168, 1169, 1170, 1171, 1172, 1173, 1174, 1175,
+	1176, 1177, 1178, 1179, 1180, 1181, 1182, 1183, 1184, 1185,
+	1186, 1187, 1188, 1189, 1190, 1191, 1192, 1193, 1194, 1195,
+	1196, 1197, 1198, 1199, 1200, 1201, 1202, 1203, 1204, 1205,
+	1206, 1207, 1208, 1209, 1210, 1211, 1212, 1213, 1214, 1215,
+	1216, 1217, 1218, 1219, 1220, 1221, 1222, 1223, 1224, 1225,
+	1226, 1227, 1228, 1229, 1230, 1231, 1232, 1233, 1234, 1235,
+	1236, 1237, 1238, 1239, 1240, 1241, 1242, 1243, 1244, 1245,
+	1246, 1247, 1248, 1249, 1250, 1251, 1252, 1253, 1254, 1255,
+	1256, 1257, 1258, 1259, 1260, 1261, 1262, 1263, 1264, 1265,
+	1266, 1267, 1268, 1269, 1270, 1271, 1272, 1273, 1274, 1275,
+	1276, 1277, 1278, 1279, 1280, 1281, 1282, 1283, 1284, 1285,
+	1286, 1287, 1288, 1289, 1290, 1291, 1292, 1293, 1294, 1295,
+	1296, 1297, 1298, 1299, 1300, 1301, 1302, 1303, 1304, 1305,
+	1306, 1307, 1308, 0, 190, 0, 0, 194, 0, 277,
+	186, 187, 188, 189, 0, 0, 398, 399, 426, 429,
+	432, 0, 180, 0, 0, 82, 492, 84, 494, 0,
+	88, 90, 91, -2, 95, 96, 97, 98, 99, 100,
+	101, 0, 103, 1199, 105, 1260, 108, 109, 110, 0,
+	119, 120, -2, -2, 489, 0, 0, 1249, 64, 341,
+	-2, 0, 0, 0, 0, 366, 369, 372, 523, 523,
+	523, 0, 523, 0, 500, 501, 502, 521, 522, 536,
+	0, 0, 253, 254, 0, 270, 261, 270, 0, 245,
+	246, 247, 251, 252, 271, 0, 219, 175, 176, 165,
+	0, 170, 0, 164, 0, 0, 135, 0, 140, 0,
+	1198, 1265, 1214, 0, 0, 1231, 0, 159, 992, 1159,
+	0, 345, 0, 351, 0, 350, 219, 219, 219, 219,
+	219, 0, 377, 378, 379, 380, 3, 0, 0, 322,
+	0, 385, 191, 665, 0, 0, 196, 0, 0, 0,
+	0, 0, 0, 0, 415, 0, 0, 414, 0, 0,
+	0, 0, 0, 430, 431, 433, 0, 435, 436, 442,
+	443, 444, 445, 446, 0, 350, 78, 0, 0, 0,
+	0, 0, 496, 89, 118, 92, 93, 0, 113, 115,
+	117, 116, 102, 114, 104, 106, 107, 112, 78, 0,
+	0, 0, 65, 343, 324, 0, 363, 365, 0, 0,
+	367, 368, 370, 371, 373, 374, 0, 0, 0, 0,
+	0, 0, 523, 0, 266, 267, 261, 261, 255, 263,
+	0, 268, 269, 0, 385, 0, 0, 0, 523, 0,
+	0, 0, 0, 0, 168, 0, 173, 125, 130, 128,
+	129, 131, 0, 0, 0, 0, 0, 157, 158, 0,
+	0, 0, 0, 0, 148, 151, 618, 619, 620, 152,
+	153, 0, 993, 994, 324, 346, 362, 364, 359, 360,
+	0, 0, 0, 0, 0, 393, 387, 389, 437, 30,
+	0, 892, 662, 896, 1310, 1311, 1312, 1313, 1314, 1315,
+	1316, 1318, 1321, 1323, 1325, -2, -2, -2, 1332, 1334,
+	1335, 1336, 1337, 1342, 1343, 1344, -2, -2, 905, 733,
+	734, 735, 736, 0, 0, 0, 0, 0, 743, 864,
+	745, 0, 0, 781, 751, 752, 781, 40, 41, 921,
+	922, 923, 924, 925, 926, 927, 928, 843, 0, 720,
+	0, 828, 818, 0, 838, 856, 857, 0, 0, 0,
+	0, 0, 42, 43, 834, 835, 836, 837, 839, 840,
+	841, 842, 844, 845, 846, 847, 848, 849, 850, 851,
+	852, 853, 854, 855, 858, 860, 830, 831, 832, 833,
+	822, 823, 824, 825, 826, 827, 292, 310, 294, 0,
+	299, 0, 627, 350, 0, 0, 192, 0, 278, 0,
+	385, 183, 0, 418, 412, 0, 403, 416, 417, 406,
+	0, 408, 0, 410, 0, 404, 405, 422, 434, 428,
+	0, 79, 80, 81, 83, 94, 0, 0, 72, 477,
+	483, 480, 490, 493, 0, 86, 495, 111, 0, 67,
+	0, 0, 344, 347, 30, 22, 352, 353, 356, 422,
+	0, 464, 491, 515, -2, 0, 385, 385, 385, 261,
+	0, 263, 0, 263, 258, 262, 0, 272, 274, 0,
+	203, 204, 212, 212, 214, 212, 464, 1293, 220, 177,
+	178, 0, 0, 172, 0, 0, 132, 133, 134, 141,
+	136, 138, 0, 0, 142, 154, 155, 156, 316, 317,
+	0, 0, 0, 146, 147, 0, 160, 342, 286, 287,
+	0, 289, 624, 290, 440, 441, 385, 0, 394, 0,
+	390, 0, 0, 0, 438, 0, 0, 891, 0, 0,
+	910, 911, 912, 913, 914, 915, 884, 871, 871, 871,
+	0, 871, 0, 0, 804, 0, 0, 871, 871, 871,
+	884, 884, 871, 805, 871, 871, 871, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, -2, 886, 0, 739, 740, 741,
+	742, 0, 865, 746, 0, 750, 0, 753, 0, 863,
+	884, 807, 0, 808, 819, 0, 811, 812, 813, 884,
+	0, 884, 817, 871, 293, 307, 0, 311, 0, 0,
+	303, 305, 298, 300, 0, 0, 320, 345, 386, 666,
+	0, 999, -2, 1001, -2, -2, 1003, 1004, 1005, 1006,
+	1007, 1008, 1009, 1010, 1011, 1012, 1013, 1014, 1015, 1016,
+	1017, 1018, 1019, 1020, 1021, 1022, 1023, 1024, 1025, 1026,
+	1027, 1028, 1029, 1030, 1031, 1032, 1033, 1034, 1035, 1036,
+	1037, 1038, 1039, 1040, 1041, 1042, 1043, 1044, 1045, 1046,
+	1047, 1048, 1049, 1050, 1051, 1052, 1053, 1054, 1055, 1056,
+	1057, 1058, 1059, 1060, 1061, 1062, 1063, 1064, 1065, 1066,
+	1067, 1068, 1069, 1070, 1071, 1072, 1073, 1074, 1075, 1076,
+	1077, 1078, 1079, 1080, 1081, 1082, 1083, 1084, 1085, 1086,
+	1087, 1088, 1089, 1090, 1091, 1092, 1093, 1094, 1095, 1096,
+	1097, 1098, 1099, 1100, 1101, 1102, 1103, 1104, 1105, 1106,
+	1107, 1108, 1109, 1110, 1111, 1112, 1113, 1114, 1115, 1116,
+	1117, 1118, 1119, 1120, 1121, 1122, 1123, 1124, 1125, 1126,
+	1127, 1128, 1129, 1130, 1131, 1132, 1133, 1134, 1135, 1136,
+	1137, 1138, 1139, 1140, 1141, 1142, 1143, 1144, 1145, 1146,
+	1147, 1148, 0, 195, 0, 0, 424, 350, 0, 0,
+	400, 419, 0, 0, 401, 0, 402, 407, 409, 411,
+	427, 0, 0, 73, 77, 0, 479, 0, 0, 482,
+	85, 0, 0, 0, 22, 0, 0, 0, 326, 0,
+	0, 355, 357, 358, 0, 524, 456, 465, 0, 0,
+	0, 520, -2, 527, 0, 533, 0, 244, 248, 249,
+	385, 264, 261, 265, 261, 263, 0, 273, 276, 0,
+	0, 213, 0, 0, 0, 215, 216, 0, 456, 0,
+	179, 167, 169, 0, 127, 0, 0, 0, 143, 144,
+	145, 149, 150, 0, 0, 383, 388, 395, 396, 888,
+	889, 890, 439, 31, 391, 893, 0, 895, 0, 885,
+	886, 0, 872, 873, 0, 0, 0, 0, 0, 0,
+	0, 820, 0, 920, 0, 874, 875, 876, 877, 878,
+	879, 880, 881, 882, 0, 0, 0, 0, 0, 886,
+	0, 0, 0, 0, 721, 722, 723, 724, 725, 726,
+	727, 728, 729, 730, 731, 732, 897, 908, 909, 0,
+	0, 0, 0, 0, 906, 901, 0, 737, 0, 869,
+	866, 0, 0, 783, 862, 0, 829, 0, 0, 0,
+	0, 0, 310, 312, 0, 0, 310, 0, 0, 0,
+	319, 0, 291, 0, 0, 279, 200, 0, 345, 184,
+	185, 420, 0, 413, 0, 0, 0, 0, 478, 0,
+	0, 481, 87, 0, 69, 61, 348, 349, 31, 330,
+	0, 23, 354, 0, 455, 0, 466, 467, 468, 469,
+	470, 0, 0, 0, 0, 0, 516, 517, 518, 519,
+	528, 995, 995, 995, 0, 628, 256, 385, 385, 261,
+	275, 205, 206, 0, 207, 0, 211, 0, 221, 0,
+	171, 126, 0, 233, 137, 288, 625, 381, 0, 0,
+	0, 894, 798, 0, 0, 0, 0, 0, 0, 773,
+	767, 768, 821, 0, 0, 0, 0, 803, 799, 0,
+	0, 0, 0, 0, 0, 898, 906, 902, 0, 899,
+	0, 0, 887, 0, 867, 0, 0, 0, 0, 350,
+	0, 806, 809, 0, 814, 0, 816, 0, 308, 0,
+	313, 314, 310, 297, 304, 296, 306, 301, 302, 321,
+	667, 1000, 997, 998, 193, 425, 182, 0, 423, 71,
+	74, 75, 76, 484, 0, 485, 464, 68, 0, 0,
+	62, 63, 0, 332, 50, 0, 0, 454, 457, 458,
+	0, 0, 0, 0, 0, 472, 473, 474, 475, 476,
+	0, 0, 996, 0, 0, 0, 629, 630, 632, 633,
+	0, 635, 689, 0, 644, 523, 644, 0, 0, 646,
+	647, 259, 257, 385, -2, 987, 930, 931, 932, 977,
+	934, 979, 0, 977, 977, 963, 964, 965, 966, 967,
+	968, 969, 970, 971, 0, 0, 954, 977, 977, 977,
+	977, 974, 935, 936, 937, 938, 939, 940, 941, 942,
+	943, 944, 945, 946, 947, 948, 982, 984, 984, 984,
+	982, 0, 0, 0, 217, 222, 223, 0, 227, 0,
+	0, 139, 375, 0, 0, 397, 32, 392, 887, 769,
+	770, 771, 0, 754, 977, 979, 757, 977, 977, 977,
+	763, 763, 772, 0, 774, 775, 778, 0, 776, 779,
+	780, 766, 883, 900, 0, 907, 903, 738, 744, 870,
+	0, 0, 0, 785, 0, 0, 0, 777, 309, 0,
+	295, 421, 488, 0, 0, 69, 0, 0, 334, 0,
+	331, 0, 0, 0, 459, 460, 461, 462, 463, 471,
+	0, 529, 530, 621, 622, 623, 531, -2, 0, 634,
+	690, 656, 656, 645, 656, 656, 523, 0, 260, 658,
+	-2, 670, 672, 0, 0, 675, 676, 0, 0, 0,
+	0, 712, 682, 0, 0, 918, 919, 0, 688, 990,
+	988, 989, 933, 978, 0, 959, 0, 960, 961, 962,
+	0, 0, 955, 956, 957, 958, 949, 0, 950, 0,
+	951, 952, 953, 208, 0, 210, 224, 225, 226, 0,
+	229, 230, 232, 0, 382, 384, 747, 755, 756, 758,
+	759, 760, 761, 764, 765, 762, 802, 800, 0, 904,
+	868, 748, 749, 0, 0, 788, 789, 784, 810, 815,
+	315, 486, 487, 66, 70, 0, 336, 0, 333, 0,
+	327, 329, 60, 0, 511, 977, 0, 537, -2, 574,
+	995, 995, 0, 995, 995, 995, 995, 0, 0, 995,
+	995, 995, 995, 995, 995, 995, 995, 995, 995, 995,
+	995, 995, 995, 0, 631, 0, 648, 657, 0, 657,
+	0, 0, 656, 0, 671, 673, 674, 677, 678, 679,
+	717, 718, 719, 680, 714, 715, 716, 681, 0, 0,
+	916, 917, 710, 929, 991, 0, 0, 0, 975, 0,
+	0, 0, 209, 0, 231, 218, 0, 782, 786, 0,
+	0, 0, 0, 52, 325, 0, 335, 51, 0, 504,
+	0, 356, 0, 534, 0, 532, 576, 0, 0, 995,
+	995, 0, 0, 0, 0, 995, 995, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 642,
+	0, 0, 705, 0, 981, 980, 972, 0, 973, 0,
+	985, 0, 228, 801, 0, 790, 791, 792, 793, 794,
+	46, 0, 0, 0, 503, 512, 513, 356, 570, 575,
+	577, 578, 0, 0, 581, 582, 583, 584, 0, 0,
+	587, 588, 589, 590, 591, 592, 593, 594, 595, 596,
+	612, 613, 614, 615, 616, 617, 597, 598, 599, 600,
+	601, 602, 609, 0, 0, 606, 0, 0, 649, 651,
+	652, 653, 654, 655, 650, 0, 0, 0, 0, 641,
+	643, 685, 0, 713, 0, 692, 706, 0, 0, 976,
+	0, 0, 0, 44, 48, 53, 54, 0, 0, 0,
+	0, 0, 338, 328, 505, 995, 0, 0, 509, 510,
+	514, 559, 0, 0, 565, 0, 571, 579, 580, 585,
+	586, 603, 0, 0, 605, 0, 0, 504, 504, 504,
+	504, 0, 686, 683, 691, 693, 694, 695, 0, 707,
+	708, 709, 711, 983, 986, 787, 33, 0, 50, 0,
+	55, 0, 0, 0, 0, 337, 0, 0, 507, 0,
+	539, 0, 0, 0, 0, 0, 568, 0, 610, 611,
+	604, 607, 608, 636, 637, 638, 639, 0, 684, 696,
+	0, 697, 0, 0, 0, 24, 0, 0, 47, 0,
+	56, 0, 58, 59, 339, 506, 508, 541, 0, 560,
+	0, 0, 0, 0, 0, 0, 0, 640, 698, 700,
+	701, 0, 0, 699, 21, 0, 34, 0, 36, 38,
+	39, 659, 45, 49, 57, 538, 0, 570, 561, 0,
+	563, 0, 0, 0, 0, 702, 704, 703, 25, 26,
+	0, 35, 0, 0, 0, 543, 0, 557, 562, 564,
+	0, 569, 567, 0, 0, 37, 660, 542, 0, 555,
+	540, 0, 566, 27, 28, 29, 0, 544, -2, 0,
+	558, 661, 545, -2, 0, 553, 0, 546, 554, 0,
+	549, 0, 0, 548, 0, -2, 0, 550, -2, 0,
+	556,
 }
 
 var yyTok1 = [...]int{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 82, 3, 3, 3, 110, 102, 3,
-	54, 56, 107, 105, 55, 106, 119, 108, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 421,
-	90, 89, 91, 3, 422, 3, 3, 3, 3, 3,
+	3, 3, 3, 86, 3, 3, 3, 114, 106, 3,
+	58, 60, 111, 109, 59, 110, 123, 112, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 430,
+	94, 93, 95, 3, 431, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 112, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 116, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 101, 3, 113,
+	3, 3, 3, 3, 105, 3, 117,
 }
 
 var yyTok2 = [...]int{
@@ -4070,12 +4222,12 @@ var yyTok2 = [...]int{
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
 	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
-	52, 53, 57, 58, 59, 60, 61, 62, 63, 64,
+	52, 53, 54, 55, 56, 57, 61, 62, 63, 64,
 	65, 66, 67, 68, 69, 70, 71, 72, 73, 74,
-	75, 76, 77, 78, 79, 80, 81, 83, 84, 85,
-	86, 87, 88, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 103, 104, 109, 111, 114, 115, 116, 117,
-	118, 120, 121, 122, 123, 124, 125, 126, 127, 128,
+	75, 76, 77, 78, 79, 80, 81, 82, 83, 84,
+	85, 87, 88, 89, 90, 91, 92, 96, 97, 98,
+	99, 100, 101, 102, 103, 104, 107, 108, 113, 115,
+	118, 119, 120, 121, 122, 124, 125, 126, 127, 128,
 	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
 	139, 140, 141, 142, 143, 144, 145, 146, 147, 148,
 	149, 150, 151, 152, 153, 154, 155, 156, 157, 158,
@@ -4123,7 +4275,9 @@ var yyTok3 = [...]int{
 	57730, 405, 57731, 406, 57732, 407, 57733, 408, 57734, 409,
 	57735, 410, 57736, 411, 57737, 412, 57738, 413, 57739, 414,
 	57740, 415, 57741, 416, 57742, 417, 57743, 418, 57744, 419,
-	57745, 420, 0,
+	57745, 420, 57746, 421, 57747, 422, 57748, 423, 57749, 424,
+	57750, 425, 57751, 426, 57752, 427, 57753, 428, 57754, 429,
+	0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -4473,7 +4627,7 @@ yydefault:
 
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:474
+//line mysql_sql.y:497
 		{
 			if yyDollar[1].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[1].statementUnion())
@@ -4481,7 +4635,7 @@ yydefault:
 		}
 	case 3:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:480
+//line mysql_sql.y:503
 		{
 			if yyDollar[3].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[3].statementUnion())
@@ -4490,7 +4644,7 @@ yydefault:
 	case 19:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:503
+//line mysql_sql.y:526
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
@@ -4498,65 +4652,83 @@ yydefault:
 	case 20:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:507
+//line mysql_sql.y:530
 		{
 			yyLOCAL = tree.Statement(nil)
 		}
 		yyVAL.union = yyLOCAL
 	case 21:
-		yyDollar = yyS[yypt-14 : yypt+1]
+		yyDollar = yyS[yypt-15 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:513
+//line mysql_sql.y:536
 		{
 			yyLOCAL = &tree.Load{
 				Local:             yyDollar[3].boolValUnion(),
 				File:              yyDollar[5].str,
-				DuplicateHandling: yyDollar[6].duplicateKeyUnion(),
-				Table:             yyDollar[9].tableNameUnion(),
-				Fields:            yyDollar[10].fieldsUnion(),
-				Lines:             yyDollar[11].linesUnion(),
-				IgnoredLines:      uint64(yyDollar[12].int64ValUnion()),
-				ColumnList:        yyDollar[13].loadColumnsUnion(),
-				Assignments:       yyDollar[14].updateExprsUnion(),
+				DataFormat:        tree.DataFormat(yyDollar[6].str),
+				DuplicateHandling: yyDollar[7].duplicateKeyUnion(),
+				Table:             yyDollar[10].tableNameUnion(),
+				Fields:            yyDollar[11].fieldsUnion(),
+				Lines:             yyDollar[12].linesUnion(),
+				IgnoredLines:      uint64(yyDollar[13].int64ValUnion()),
+				ColumnList:        yyDollar[14].loadColumnsUnion(),
+				Assignments:       yyDollar[15].updateExprsUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
 	case 22:
 		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:552
+		{
+			yyVAL.str = ""
+		}
+	case 23:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line mysql_sql.y:556
+		{
+			f, ok := tree.NewDataFormat(yyDollar[2].str)
+			if !ok {
+				yylex.Error("unknown data format " + yyDollar[2].str)
+				return 1
+			}
+			yyVAL.str = string(f)
+		}
+	case 24:
+		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:528
+//line mysql_sql.y:566
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 23:
+	case 25:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:532
+//line mysql_sql.y:570
 		{
 			yyLOCAL = yyDollar[2].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 24:
+	case 26:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:538
+//line mysql_sql.y:576
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 25:
+	case 27:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:542
+//line mysql_sql.y:580
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 26:
+	case 28:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:548
+//line mysql_sql.y:586
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -4564,10 +4736,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 27:
+	case 29:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:555
+//line mysql_sql.y:593
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -4575,58 +4747,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 28:
+	case 30:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:564
+//line mysql_sql.y:602
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 29:
+	case 31:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:568
+//line mysql_sql.y:606
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 30:
+	case 32:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:572
+//line mysql_sql.y:610
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 31:
+	case 33:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:577
+//line mysql_sql.y:615
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 32:
+	case 34:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:581
+//line mysql_sql.y:619
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 33:
+	case 35:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:585
+//line mysql_sql.y:623
 		{
 			yyLOCAL = yyDollar[2].loadColumnsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 34:
+	case 36:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:591
+//line mysql_sql.y:629
 		{
 			switch yyDollar[1].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -4636,10 +4808,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 35:
+	case 37:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:600
+//line mysql_sql.y:638
 		{
 			switch yyDollar[3].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -4649,42 +4821,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 36:
+	case 38:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:611
+//line mysql_sql.y:649
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 37:
+	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:615
+//line mysql_sql.y:653
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 38:
+	case 40:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:621
+//line mysql_sql.y:659
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 39:
+	case 41:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:625
+//line mysql_sql.y:663
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 40:
+	case 42:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:631
+//line mysql_sql.y:669
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -4707,10 +4879,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 41:
+	case 43:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:655
+//line mysql_sql.y:693
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var r string
@@ -4729,34 +4901,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 42:
+	case 44:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:674
+//line mysql_sql.y:712
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 43:
+	case 45:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:678
+//line mysql_sql.y:716
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 44:
+	case 46:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:683
+//line mysql_sql.y:721
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 45:
+	case 47:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:687
+//line mysql_sql.y:725
 		{
 			yyLOCAL = &tree.Lines{
 				StartingBy:   yyDollar[2].str,
@@ -4764,42 +4936,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 46:
+	case 48:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:695
+//line mysql_sql.y:733
 		{
 			yyVAL.str = ""
 		}
-	case 47:
+	case 49:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:699
+//line mysql_sql.y:737
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 48:
+	case 50:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:704
+//line mysql_sql.y:742
 		{
 			yyVAL.str = "\n"
 		}
-	case 49:
+	case 51:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:708
+//line mysql_sql.y:746
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 50:
+	case 52:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:713
+//line mysql_sql.y:751
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 51:
+	case 53:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:717
+//line mysql_sql.y:755
 		{
 			res := &tree.Fields{
 				Terminated: "\t",
@@ -4822,36 +4994,36 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 52:
+	case 54:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:741
+//line mysql_sql.y:779
 		{
 			yyLOCAL = []*tree.Fields{yyDollar[1].fieldsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 53:
+	case 55:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:745
+//line mysql_sql.y:783
 		{
 			yyLOCAL = append(yyDollar[1].fieldsListUnion(), yyDollar[2].fieldsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 54:
+	case 56:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:751
+//line mysql_sql.y:789
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[3].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 55:
+	case 57:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:757
+//line mysql_sql.y:795
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -4870,10 +5042,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 56:
+	case 58:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:775
+//line mysql_sql.y:813
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -4891,10 +5063,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 57:
+	case 59:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:792
+//line mysql_sql.y:830
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -4912,50 +5084,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 59:
+	case 61:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:815
+//line mysql_sql.y:853
 		{
 			yyLOCAL = &tree.DuplicateKeyError{}
 		}
 		yyVAL.union = yyLOCAL
-	case 60:
+	case 62:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:819
+//line mysql_sql.y:857
 		{
 			yyLOCAL = &tree.DuplicateKeyIgnore{}
 		}
 		yyVAL.union = yyLOCAL
-	case 61:
+	case 63:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:823
+//line mysql_sql.y:861
 		{
 			yyLOCAL = &tree.DuplicateKeyReplace{}
 		}
 		yyVAL.union = yyLOCAL
-	case 62:
+	case 64:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:828
+//line mysql_sql.y:866
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 63:
+	case 65:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:832
+//line mysql_sql.y:870
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 64:
+	case 66:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:838
+//line mysql_sql.y:876
 		{
 			yyLOCAL = &tree.Grant{
 				Privileges:  yyDollar[2].privilegesUnion(),
@@ -4966,10 +5138,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 65:
+	case 67:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:848
+//line mysql_sql.y:886
 		{
 			yyLOCAL = &tree.Grant{
 				IsGrantRole:      true,
@@ -4978,10 +5150,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 66:
+	case 68:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:856
+//line mysql_sql.y:894
 		{
 			yyLOCAL = &tree.Grant{
 				IsProxy:     true,
@@ -4991,26 +5163,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 67:
+	case 69:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:866
+//line mysql_sql.y:904
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 68:
+	case 70:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:870
+//line mysql_sql.y:908
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 69:
+	case 71:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:880
+//line mysql_sql.y:918
 		{
 			yyLOCAL = &tree.Revoke{
 				Privileges: yyDollar[2].privilegesUnion(),
@@ -5021,10 +5193,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 70:
+	case 72:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:890
+//line mysql_sql.y:928
 		{
 			yyLOCAL = &tree.Revoke{
 				IsRevokeRole:      true,
@@ -5033,30 +5205,30 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 71:
+	case 73:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:900
+//line mysql_sql.y:938
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_DATABASE,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 72:
+	case 74:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:906
+//line mysql_sql.y:944
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_GLOBAL,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 73:
+	case 75:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:912
+//line mysql_sql.y:950
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:  tree.PRIVILEGE_LEVEL_TYPE_DATABASE,
@@ -5064,10 +5236,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 74:
+	case 76:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:919
+//line mysql_sql.y:957
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_TABLE,
@@ -5076,10 +5248,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 75:
+	case 77:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:927
+//line mysql_sql.y:965
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_TABLE,
@@ -5087,58 +5259,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 76:
+	case 78:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:935
+//line mysql_sql.y:973
 		{
 			yyLOCAL = tree.OBJECT_TYPE_NONE
 		}
 		yyVAL.union = yyLOCAL
-	case 77:
+	case 79:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:939
+//line mysql_sql.y:977
 		{
 			yyLOCAL = tree.OBJECT_TYPE_TABLE
 		}
 		yyVAL.union = yyLOCAL
-	case 78:
+	case 80:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:943
+//line mysql_sql.y:981
 		{
 			yyLOCAL = tree.OBJECT_TYPE_FUNCTION
 		}
 		yyVAL.union = yyLOCAL
-	case 79:
+	case 81:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:947
+//line mysql_sql.y:985
 		{
 			yyLOCAL = tree.OBJECT_TYPE_PROCEDURE
 		}
 		yyVAL.union = yyLOCAL
-	case 80:
+	case 82:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:953
+//line mysql_sql.y:991
 		{
 			yyLOCAL = []*tree.Privilege{yyDollar[1].privilegeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 81:
+	case 83:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:957
+//line mysql_sql.y:995
 		{
 			yyLOCAL = append(yyDollar[1].privilegesUnion(), yyDollar[3].privilegeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 82:
+	case 84:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:963
+//line mysql_sql.y:1001
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -5146,10 +5318,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 83:
+	case 85:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:970
+//line mysql_sql.y:1008
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -5157,418 +5329,418 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 84:
+	case 86:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:979
+//line mysql_sql.y:1017
 		{
 			yyLOCAL = []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 85:
+	case 87:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:983
+//line mysql_sql.y:1021
 		{
 			yyLOCAL = append(yyDollar[1].unresolveNamesUnion(), yyDollar[3].unresolvedNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 86:
+	case 88:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:989
+//line mysql_sql.y:1027
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 87:
+	case 89:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:993
+//line mysql_sql.y:1031
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 88:
+	case 90:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:997
+//line mysql_sql.y:1035
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER
 		}
 		yyVAL.union = yyLOCAL
-	case 89:
+	case 91:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1001
+//line mysql_sql.y:1039
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE
 		}
 		yyVAL.union = yyLOCAL
-	case 90:
+	case 92:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1005
+//line mysql_sql.y:1043
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_USER
 		}
 		yyVAL.union = yyLOCAL
-	case 91:
+	case 93:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1009
+//line mysql_sql.y:1047
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TABLESPACE
 		}
 		yyVAL.union = yyLOCAL
-	case 92:
+	case 94:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1013
+//line mysql_sql.y:1051
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_TRIGGER
 		}
 		yyVAL.union = yyLOCAL
-	case 93:
+	case 95:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1017
+//line mysql_sql.y:1055
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DELETE
 		}
 		yyVAL.union = yyLOCAL
-	case 94:
+	case 96:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1021
+//line mysql_sql.y:1059
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP
 		}
 		yyVAL.union = yyLOCAL
-	case 95:
+	case 97:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1025
+//line mysql_sql.y:1063
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EXECUTE
 		}
 		yyVAL.union = yyLOCAL
-	case 96:
+	case 98:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1029
+//line mysql_sql.y:1067
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INDEX
 		}
 		yyVAL.union = yyLOCAL
-	case 97:
+	case 99:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1033
+//line mysql_sql.y:1071
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INSERT
 		}
 		yyVAL.union = yyLOCAL
-	case 98:
+	case 100:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1037
+//line mysql_sql.y:1075
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SELECT
 		}
 		yyVAL.union = yyLOCAL
-	case 99:
+	case 101:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1041
+//line mysql_sql.y:1079
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SUPER
 		}
 		yyVAL.union = yyLOCAL
-	case 100:
+	case 102:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1045
+//line mysql_sql.y:1083
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_DATABASES
 		}
 		yyVAL.union = yyLOCAL
-	case 101:
+	case 103:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1049
+//line mysql_sql.y:1087
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_UPDATE
 		}
 		yyVAL.union = yyLOCAL
-	case 102:
+	case 104:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1053
+//line mysql_sql.y:1091
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION
 		}
 		yyVAL.union = yyLOCAL
-	case 103:
+	case 105:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1057
+//line mysql_sql.y:1095
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REFERENCES
 		}
 		yyVAL.union = yyLOCAL
-	case 104:
+	case 106:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1061
+//line mysql_sql.y:1099
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_SLAVE
 		}
 		yyVAL.union = yyLOCAL
-	case 105:
+	case 107:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1065
+//line mysql_sql.y:1103
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_CLIENT
 		}
 		yyVAL.union = yyLOCAL
-	case 106:
+	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1069
+//line mysql_sql.y:1107
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_USAGE
 		}
 		yyVAL.union = yyLOCAL
-	case 107:
+	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1073
+//line mysql_sql.y:1111
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_RELOAD
 		}
 		yyVAL.union = yyLOCAL
-	case 108:
+	case 110:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1077
+//line mysql_sql.y:1115
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_FILE
 		}
 		yyVAL.union = yyLOCAL
-	case 109:
+	case 111:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1081
+//line mysql_sql.y:1119
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TEMPORARY_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 110:
+	case 112:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1085
+//line mysql_sql.y:1123
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_LOCK_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 111:
+	case 113:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1089
+//line mysql_sql.y:1127
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 112:
+	case 114:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1093
+//line mysql_sql.y:1131
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 113:
+	case 115:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1097
+//line mysql_sql.y:1135
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 114:
+	case 116:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1101
+//line mysql_sql.y:1139
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 115:
+	case 117:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1105
+//line mysql_sql.y:1143
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 116:
+	case 118:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1109
+//line mysql_sql.y:1147
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 117:
+	case 119:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1113
+//line mysql_sql.y:1151
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EVENT
 		}
 		yyVAL.union = yyLOCAL
-	case 118:
+	case 120:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1117
+//line mysql_sql.y:1155
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHUTDOWN
 		}
 		yyVAL.union = yyLOCAL
-	case 123:
+	case 125:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1129
+//line mysql_sql.y:1167
 		{
 			yyLOCAL = yyDollar[3].setRoleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 124:
+	case 126:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1135
+//line mysql_sql.y:1173
 		{
 			dr := yyDollar[4].setDefaultRoleUnion()
 			dr.Users = yyDollar[6].usersUnion()
 			yyLOCAL = dr
 		}
 		yyVAL.union = yyLOCAL
-	case 125:
+	case 127:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1143
+//line mysql_sql.y:1181
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_ALL_EXCEPT, Roles: yyDollar[3].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 126:
+	case 128:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1147
+//line mysql_sql.y:1185
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_DEFAULT, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 127:
+	case 129:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1151
+//line mysql_sql.y:1189
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_NONE, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 128:
+	case 130:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1155
+//line mysql_sql.y:1193
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_ALL, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 129:
+	case 131:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1159
+//line mysql_sql.y:1197
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 130:
+	case 132:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1165
+//line mysql_sql.y:1203
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NONE, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 131:
+	case 133:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1169
+//line mysql_sql.y:1207
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_ALL, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 132:
+	case 134:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1173
+//line mysql_sql.y:1211
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 133:
+	case 135:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1179
+//line mysql_sql.y:1217
 		{
 			yyLOCAL = &tree.SetVar{Assignments: yyDollar[2].varAssignmentExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 134:
+	case 136:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1185
+//line mysql_sql.y:1223
 		{
 			yyLOCAL = &tree.SetPassword{Password: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 135:
+	case 137:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1189
+//line mysql_sql.y:1227
 		{
 			yyLOCAL = &tree.SetPassword{User: yyDollar[4].userUnion(), Password: yyDollar[6].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 137:
+	case 139:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line mysql_sql.y:1196
+//line mysql_sql.y:1234
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 138:
+	case 140:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1202
+//line mysql_sql.y:1240
 		{
 			yyLOCAL = []*tree.VarAssignmentExpr{yyDollar[1].varAssignmentExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 139:
+	case 141:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1206
+//line mysql_sql.y:1244
 		{
 			yyLOCAL = append(yyDollar[1].varAssignmentExprsUnion(), yyDollar[3].varAssignmentExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 140:
+	case 142:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1212
+//line mysql_sql.y:1250
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5577,10 +5749,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 141:
+	case 143:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1220
+//line mysql_sql.y:1258
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5590,10 +5762,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 142:
+	case 144:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1229
+//line mysql_sql.y:1267
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5602,10 +5774,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 143:
+	case 145:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1237
+//line mysql_sql.y:1275
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5614,10 +5786,21 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 144:
+	case 146:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.VarAssignmentExpr
+//line mysql_sql.y:1283
+		{
+			yyLOCAL = &tree.VarAssignmentExpr{
+				Name:  yyDollar[1].str,
+				Value: yyDollar[3].exprUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 147:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1245
+//line mysql_sql.y:1290
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -5641,265 +5824,271 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 145:
+	case 148:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1268
+//line mysql_sql.y:1313
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
-				Name:  yyDollar[1].str,
-				Value: tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false),
+				System: true,
+				Name:   yyDollar[1].str,
+				Value:  tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 146:
+	case 149:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1275
+//line mysql_sql.y:1321
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
-				Name:  yyDollar[1].str,
-				Value: tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false),
+				System: true,
+				Name:   yyDollar[1].str,
+				Value:  tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 147:
+	case 150:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1282
+//line mysql_sql.y:1329
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
+				System:   true,
 				Name:     yyDollar[1].str,
 				Value:    tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false),
 				Reserved: tree.NewNumVal(constant.MakeString(yyDollar[4].str), yyDollar[4].str, false),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 148:
+	case 151:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1290
+//line mysql_sql.y:1338
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
-				Name:  yyDollar[1].str,
-				Value: &tree.DefaultVal{},
+				System: true,
+				Name:   yyDollar[1].str,
+				Value:  &tree.DefaultVal{},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 149:
+	case 152:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1297
+//line mysql_sql.y:1346
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
-				Name:  yyDollar[1].str,
-				Value: tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false),
+				System: true,
+				Name:   yyDollar[1].str,
+				Value:  tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 150:
+	case 153:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1304
+//line mysql_sql.y:1354
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
-				Name:  yyDollar[1].str,
-				Value: &tree.DefaultVal{},
+				System: true,
+				Name:   yyDollar[1].str,
+				Value:  &tree.DefaultVal{},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 153:
+	case 154:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1317
+//line mysql_sql.y:1364
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 154:
+	case 155:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1321
+//line mysql_sql.y:1368
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 155:
+	case 156:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1325
+//line mysql_sql.y:1372
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 156:
+	case 157:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1331
+//line mysql_sql.y:1378
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 157:
+	case 158:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1335
+//line mysql_sql.y:1382
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 159:
+	case 160:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1342
+//line mysql_sql.y:1389
 		{
 			yyVAL.str = yyDollar[1].str + "." + yyDollar[3].str
 		}
-	case 163:
+	case 164:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1353
+//line mysql_sql.y:1400
 		{
 			yyLOCAL = &tree.RollbackTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 164:
+	case 165:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1359
+//line mysql_sql.y:1406
 		{
 			yyLOCAL = &tree.CommitTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 165:
+	case 166:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1364
+//line mysql_sql.y:1411
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 166:
+	case 167:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1368
+//line mysql_sql.y:1415
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 167:
+	case 168:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1372
+//line mysql_sql.y:1419
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 168:
+	case 169:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1376
+//line mysql_sql.y:1423
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 169:
+	case 170:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1380
+//line mysql_sql.y:1427
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 170:
+	case 171:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1384
+//line mysql_sql.y:1431
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 171:
+	case 172:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1388
+//line mysql_sql.y:1435
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 172:
+	case 173:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1392
+//line mysql_sql.y:1439
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 173:
+	case 174:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1398
+//line mysql_sql.y:1445
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 174:
+	case 175:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1402
+//line mysql_sql.y:1449
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 175:
+	case 176:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1406
+//line mysql_sql.y:1453
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 176:
+	case 177:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1410
+//line mysql_sql.y:1457
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_WRITE)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
 		}
 		yyVAL.union = yyLOCAL
-	case 177:
+	case 178:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1415
+//line mysql_sql.y:1462
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_ONLY)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
 		}
 		yyVAL.union = yyLOCAL
-	case 178:
+	case 179:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1420
+//line mysql_sql.y:1467
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 179:
+	case 180:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1426
+//line mysql_sql.y:1473
 		{
 			yyLOCAL = &tree.Use{Name: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 180:
+	case 181:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1430
+//line mysql_sql.y:1477
 		{
 			yyLOCAL = &tree.Use{}
 		}
 		yyVAL.union = yyLOCAL
-	case 181:
+	case 182:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1436
+//line mysql_sql.y:1483
 		{
 			yyLOCAL = &tree.Update{
 				Table:   yyDollar[2].tableExprUnion(),
@@ -5910,108 +6099,205 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 182:
+	case 183:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1449
+//line mysql_sql.y:1496
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 183:
+	case 184:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1453
+//line mysql_sql.y:1500
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 184:
+	case 185:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:1459
+//line mysql_sql.y:1506
 		{
 			yyLOCAL = &tree.UpdateExpr{Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}, Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 188:
+	case 189:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1468
+//line mysql_sql.y:1515
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 189:
+	case 190:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1474
+//line mysql_sql.y:1521
 		{
 			st := &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion()}
 			yyLOCAL = tree.NewExplainStmt(st, "")
 		}
 		yyVAL.union = yyLOCAL
-	case 190:
+	case 191:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1479
+//line mysql_sql.y:1526
 		{
 			st := &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion(), ColName: yyDollar[3].unresolvedNameUnion()}
 			yyLOCAL = tree.NewExplainStmt(st, "")
 		}
 		yyVAL.union = yyLOCAL
-	case 191:
+	case 192:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1484
+//line mysql_sql.y:1531
 		{
 			yyLOCAL = tree.NewExplainFor("", uint64(yyDollar[4].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 192:
+	case 193:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1488
+//line mysql_sql.y:1535
 		{
 			yyLOCAL = tree.NewExplainFor(yyDollar[4].str, uint64(yyDollar[7].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 193:
+	case 194:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1492
+//line mysql_sql.y:1539
 		{
 			yyLOCAL = tree.NewExplainStmt(yyDollar[2].statementUnion(), "row")
 		}
 		yyVAL.union = yyLOCAL
-	case 194:
+	case 195:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1496
+//line mysql_sql.y:1543
 		{
 			yyLOCAL = tree.NewExplainStmt(yyDollar[5].statementUnion(), yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 195:
+	case 196:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1500
+//line mysql_sql.y:1547
 		{
 			yyLOCAL = tree.NewExplainAnalyze(yyDollar[3].statementUnion(), "")
 		}
 		yyVAL.union = yyLOCAL
-	case 199:
+	case 200:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1511
+//line mysql_sql.y:1558
 		{
 			yyLOCAL = tree.NewAnalyzeStmt(yyDollar[3].tableNameUnion(), yyDollar[5].identifierListUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 201:
+	case 203:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1568
+		{
+			yyLOCAL = tree.NewAlterTable(*yyDollar[3].tableNameUnion(), yyDollar[4].alterTableOptionsUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 204:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []tree.AlterTableOption
+//line mysql_sql.y:1574
+		{
+			yyLOCAL = []tree.AlterTableOption{yyDollar[1].alterTableOptionUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 205:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []tree.AlterTableOption
+//line mysql_sql.y:1578
+		{
+			yyLOCAL = append(yyDollar[1].alterTableOptionsUnion(), yyDollar[3].alterTableOptionUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 206:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1584
+		{
+			yyLOCAL = &tree.AlterTableAddColumn{Column: yyDollar[3].columnTableDefUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 207:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1588
+		{
+			yyLOCAL = &tree.AlterTableDropColumn{Name: tree.Identifier(yyDollar[3].str)}
+		}
+		yyVAL.union = yyLOCAL
+	case 208:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1592
+		{
+			yyLOCAL = &tree.AlterTableRenameColumn{Name: tree.Identifier(yyDollar[3].str), NewName: tree.Identifier(yyDollar[5].str)}
+		}
+		yyVAL.union = yyLOCAL
+	case 209:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1596
+		{
+			yyLOCAL = &tree.AlterTableColumnDefault{Name: tree.Identifier(yyDollar[3].str), Expr: yyDollar[6].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 210:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1600
+		{
+			yyLOCAL = &tree.AlterTableColumnDefault{Name: tree.Identifier(yyDollar[3].str)}
+		}
+		yyVAL.union = yyLOCAL
+	case 211:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1604
+		{
+			yyLOCAL = &tree.AlterTableRename{Name: *yyDollar[3].tableNameUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 212:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:1609
+		{
+		}
+	case 213:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line mysql_sql.y:1611
+		{
+		}
+	case 214:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:1614
+		{
+		}
+	case 215:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line mysql_sql.y:1616
+		{
+		}
+	case 216:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line mysql_sql.y:1618
+		{
+		}
+	case 217:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1521
+//line mysql_sql.y:1622
 		{
 			yyLOCAL = &tree.AlterUser{
 				IfExists:   yyDollar[3].boolValUnion(),
@@ -6023,10 +6309,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 202:
+	case 218:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1532
+//line mysql_sql.y:1633
 		{
 			auth := &tree.User{
 				AuthString: yyDollar[9].str,
@@ -6039,118 +6325,118 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 203:
+	case 219:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1545
+//line mysql_sql.y:1646
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 204:
+	case 220:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1549
+//line mysql_sql.y:1650
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 205:
+	case 221:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1554
+//line mysql_sql.y:1655
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 206:
+	case 222:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1558
+//line mysql_sql.y:1659
 		{
 			yyLOCAL = yyDollar[1].userMiscOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 207:
+	case 223:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1564
+//line mysql_sql.y:1665
 		{
 			yyLOCAL = []tree.UserMiscOption{yyDollar[1].userMiscOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 208:
+	case 224:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1568
+//line mysql_sql.y:1669
 		{
 			yyLOCAL = append(yyDollar[1].userMiscOptionsUnion(), yyDollar[2].userMiscOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 209:
+	case 225:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1574
+//line mysql_sql.y:1675
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountUnlock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 210:
+	case 226:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1578
+//line mysql_sql.y:1679
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountLock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 211:
+	case 227:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1582
+//line mysql_sql.y:1683
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNone{}
 		}
 		yyVAL.union = yyLOCAL
-	case 212:
+	case 228:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1586
+//line mysql_sql.y:1687
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireInterval{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 213:
+	case 229:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1590
+//line mysql_sql.y:1691
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNever{}
 		}
 		yyVAL.union = yyLOCAL
-	case 214:
+	case 230:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1594
+//line mysql_sql.y:1695
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 215:
+	case 231:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1600
+//line mysql_sql.y:1701
 		{
 			yyVAL.item = nil
 		}
-	case 216:
+	case 232:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1605
+//line mysql_sql.y:1706
 		{
 			yyVAL.item = nil
 		}
-	case 228:
+	case 244:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1626
+//line mysql_sql.y:1727
 		{
 			yyLOCAL = &tree.ShowIndex{
 				TableName: *yyDollar[4].tableNameUnion(),
@@ -6158,10 +6444,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 232:
+	case 248:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1640
+//line mysql_sql.y:1741
 		{
 			yyLOCAL = &tree.ShowVariables{
 				Global: yyDollar[2].boolValUnion(),
@@ -6170,10 +6456,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 233:
+	case 249:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1650
+//line mysql_sql.y:1751
 		{
 			yyLOCAL = &tree.ShowStatus{
 				Global: yyDollar[2].boolValUnion(),
@@ -6182,58 +6468,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 234:
+	case 250:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1659
+//line mysql_sql.y:1760
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 235:
+	case 251:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1663
+//line mysql_sql.y:1764
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 236:
+	case 252:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1667
+//line mysql_sql.y:1768
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 237:
+	case 253:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1673
+//line mysql_sql.y:1774
 		{
 			yyLOCAL = &tree.ShowWarnings{}
 		}
 		yyVAL.union = yyLOCAL
-	case 238:
+	case 254:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1679
+//line mysql_sql.y:1780
 		{
 			yyLOCAL = &tree.ShowErrors{}
 		}
 		yyVAL.union = yyLOCAL
-	case 239:
+	case 255:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1685
+//line mysql_sql.y:1786
 		{
 			yyLOCAL = &tree.ShowProcessList{Full: yyDollar[2].fullOptUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 240:
+	case 256:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1691
+//line mysql_sql.y:1792
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   false,
@@ -6244,10 +6530,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 241:
+	case 257:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1701
+//line mysql_sql.y:1802
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   true,
@@ -6258,18 +6544,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 242:
+	case 258:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1713
+//line mysql_sql.y:1814
 		{
 			yyLOCAL = &tree.ShowDatabases{Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 243:
+	case 259:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1719
+//line mysql_sql.y:1820
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   false,
@@ -6282,10 +6568,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 244:
+	case 260:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1731
+//line mysql_sql.y:1832
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   true,
@@ -6298,118 +6584,118 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 245:
+	case 261:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:1744
+//line mysql_sql.y:1845
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 246:
+	case 262:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:1748
+//line mysql_sql.y:1849
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.LIKE, nil, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 247:
+	case 263:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1753
+//line mysql_sql.y:1854
 		{
 			yyVAL.str = ""
 		}
-	case 248:
+	case 264:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:1757
+//line mysql_sql.y:1858
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 249:
+	case 265:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1763
+//line mysql_sql.y:1864
 		{
 			yyLOCAL = yyDollar[2].unresolvedObjectNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 254:
+	case 270:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1776
+//line mysql_sql.y:1877
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 255:
+	case 271:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1780
+//line mysql_sql.y:1881
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 256:
+	case 272:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1786
+//line mysql_sql.y:1887
 		{
 			yyLOCAL = &tree.ShowCreateTable{Name: yyDollar[4].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 257:
+	case 273:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1790
+//line mysql_sql.y:1891
 		{
 			yyLOCAL = &tree.ShowCreateDatabase{IfNotExists: yyDollar[4].ifNotExistsUnion(), Name: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 258:
+	case 274:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1796
+//line mysql_sql.y:1897
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 259:
+	case 275:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1800
+//line mysql_sql.y:1901
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 261:
+	case 277:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1809
+//line mysql_sql.y:1910
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 262:
+	case 278:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1813
+//line mysql_sql.y:1914
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 263:
+	case 279:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1817
+//line mysql_sql.y:1918
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(3, [3]string{yyDollar[5].str, yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 270:
+	case 286:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1833
+//line mysql_sql.y:1934
 		{
 			yyLOCAL = &tree.DropUser{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -6417,10 +6703,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 271:
+	case 287:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1842
+//line mysql_sql.y:1943
 		{
 			yyLOCAL = &tree.DropRole{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -6428,10 +6714,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 272:
+	case 288:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1851
+//line mysql_sql.y:1952
 		{
 			yyLOCAL = &tree.DropIndex{
 				Name:      tree.Identifier(yyDollar[4].str),
@@ -6440,26 +6726,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 273:
+	case 289:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1861
+//line mysql_sql.y:1962
 		{
 			yyLOCAL = &tree.DropTable{IfExists: yyDollar[3].boolValUnion(), Names: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 274:
+	case 290:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1867
+//line mysql_sql.y:1968
 		{
 			yyLOCAL = &tree.DropDatabase{Name: tree.Identifier(yyDollar[4].str), IfExists: yyDollar[3].boolValUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 275:
+	case 291:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1873
+//line mysql_sql.y:1974
 		{
 			yyLOCAL = &tree.Delete{
 				Table:   yyDollar[3].tableExprUnion(),
@@ -6469,10 +6755,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 276:
+	case 292:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1888
+//line mysql_sql.y:1989
 		{
 			ins := yyDollar[4].insertUnion()
 			ins.Table = yyDollar[2].tableExprUnion()
@@ -6480,10 +6766,10 @@ yydefault:
 			yyLOCAL = ins
 		}
 		yyVAL.union = yyLOCAL
-	case 277:
+	case 293:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1897
+//line mysql_sql.y:1998
 		{
 			vc := tree.NewValuesClause(yyDollar[2].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -6491,20 +6777,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 278:
+	case 294:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1904
+//line mysql_sql.y:2005
 		{
 			yyLOCAL = &tree.Insert{
 				Rows: tree.NewSelect(yyDollar[1].selectUnion(), nil, nil),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 279:
+	case 295:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1910
+//line mysql_sql.y:2011
 		{
 			vc := tree.NewValuesClause(yyDollar[5].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -6513,10 +6799,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 280:
+	case 296:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1918
+//line mysql_sql.y:2019
 		{
 			vc := tree.NewValuesClause(yyDollar[4].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -6524,10 +6810,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 281:
+	case 297:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1925
+//line mysql_sql.y:2026
 		{
 			yyLOCAL = &tree.Insert{
 				Columns: yyDollar[2].identifierListUnion(),
@@ -6535,10 +6821,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 282:
+	case 298:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1932
+//line mysql_sql.y:2033
 		{
 			if yyDollar[2].assignmentsUnion() == nil {
 				yylex.Error("the set list of insert can not be empty")
@@ -6557,34 +6843,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 283:
+	case 299:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:1951
+//line mysql_sql.y:2052
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 284:
+	case 300:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:1955
+//line mysql_sql.y:2056
 		{
 			yyLOCAL = []*tree.Assignment{yyDollar[1].assignmentUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 285:
+	case 301:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:1959
+//line mysql_sql.y:2060
 		{
 			yyLOCAL = append(yyDollar[1].assignmentsUnion(), yyDollar[3].assignmentUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 286:
+	case 302:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Assignment
-//line mysql_sql.y:1965
+//line mysql_sql.y:2066
 		{
 			yyLOCAL = &tree.Assignment{
 				Column: tree.Identifier(yyDollar[1].str),
@@ -6592,171 +6878,172 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 287:
+	case 303:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:1974
+//line mysql_sql.y:2075
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 288:
+	case 304:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:1978
+//line mysql_sql.y:2079
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 289:
+	case 305:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1984
+//line mysql_sql.y:2085
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 290:
+	case 306:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1988
+//line mysql_sql.y:2089
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 291:
+	case 307:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:1994
+//line mysql_sql.y:2095
 		{
 			yyLOCAL = []tree.Exprs{yyDollar[1].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 292:
+	case 308:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:1998
+//line mysql_sql.y:2099
 		{
 			yyLOCAL = append(yyDollar[1].rowsExprsUnion(), yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 293:
+	case 309:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2004
+//line mysql_sql.y:2105
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 294:
+	case 310:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2009
+//line mysql_sql.y:2110
 		{
 		}
-	case 296:
+	case 312:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2013
+//line mysql_sql.y:2114
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 298:
+	case 314:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2020
+//line mysql_sql.y:2121
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 299:
+	case 315:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2024
+//line mysql_sql.y:2125
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 301:
+	case 317:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:2031
+//line mysql_sql.y:2132
 		{
 			yyLOCAL = &tree.DefaultVal{}
 		}
 		yyVAL.union = yyLOCAL
-	case 302:
+	case 318:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2036
+//line mysql_sql.y:2137
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 303:
+	case 319:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2040
+//line mysql_sql.y:2141
 		{
 			yyLOCAL = yyDollar[3].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 304:
+	case 320:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2046
+//line mysql_sql.y:2147
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 305:
+	case 321:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2050
+//line mysql_sql.y:2151
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 306:
+	case 322:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2056
+//line mysql_sql.y:2157
 		{
 			yyLOCAL = yyDollar[2].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 307:
+	case 323:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2060
+//line mysql_sql.y:2161
 		{
 			yyLOCAL = yyDollar[1].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 308:
+	case 324:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:2065
+//line mysql_sql.y:2166
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 309:
-		yyDollar = yyS[yypt-8 : yypt+1]
+	case 325:
+		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:2069
+//line mysql_sql.y:2170
 		{
 			yyLOCAL = &tree.ExportParam{
 				Outfile:     true,
 				FilePath:    yyDollar[3].str,
-				Fields:      yyDollar[4].fieldsUnion(),
-				Lines:       yyDollar[5].linesUnion(),
-				Header:      yyDollar[6].unsignedOptUnion(),
-				MaxFileSize: uint64(yyDollar[7].int64ValUnion()) * 1024,
-				ForceQuote:  yyDollar[8].strsUnion(),
+				DataFormat:  tree.DataFormat(yyDollar[4].str),
+				Fields:      yyDollar[5].fieldsUnion(),
+				Lines:       yyDollar[6].linesUnion(),
+				Header:      yyDollar[7].unsignedOptUnion(),
+				MaxFileSize: uint64(yyDollar[8].int64ValUnion()) * 1024,
+				ForceQuote:  yyDollar[9].strsUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 310:
+	case 326:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2082
+//line mysql_sql.y:2184
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: ",",
@@ -6764,10 +7051,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 311:
+	case 327:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2089
+//line mysql_sql.y:2191
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[4].str,
@@ -6775,10 +7062,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 312:
+	case 328:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2096
+//line mysql_sql.y:2198
 		{
 			str := yyDollar[7].str
 			if str != "\\" && len(str) > 1 {
@@ -6797,10 +7084,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 313:
+	case 329:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2114
+//line mysql_sql.y:2216
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -6819,38 +7106,38 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 314:
+	case 330:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:2133
+//line mysql_sql.y:2235
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: "\n",
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 315:
+	case 331:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:2139
+//line mysql_sql.y:2241
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: yyDollar[2].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 316:
+	case 332:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2146
+//line mysql_sql.y:2248
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 317:
+	case 333:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2150
+//line mysql_sql.y:2252
 		{
 			str := strings.ToLower(yyDollar[2].str)
 			if str == "true" {
@@ -6863,227 +7150,227 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 318:
+	case 334:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:2163
+//line mysql_sql.y:2265
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 319:
+	case 335:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:2167
+//line mysql_sql.y:2269
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 320:
+	case 336:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2172
+//line mysql_sql.y:2274
 		{
 			yyLOCAL = []string{}
 		}
 		yyVAL.union = yyLOCAL
-	case 321:
+	case 337:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2176
+//line mysql_sql.y:2278
 		{
 			yyLOCAL = yyDollar[3].strsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 322:
+	case 338:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2183
+//line mysql_sql.y:2285
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 323:
+	case 339:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2188
+//line mysql_sql.y:2290
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 325:
+	case 341:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2195
+//line mysql_sql.y:2297
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), Ep: yyDollar[2].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 326:
+	case 342:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2201
+//line mysql_sql.y:2303
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 327:
+	case 343:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2205
+//line mysql_sql.y:2307
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Ep: yyDollar[3].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 328:
+	case 344:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2209
+//line mysql_sql.y:2311
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 329:
+	case 345:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2214
+//line mysql_sql.y:2316
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 330:
+	case 346:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2218
+//line mysql_sql.y:2320
 		{
 			yyLOCAL = yyDollar[1].limitUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 331:
+	case 347:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2224
+//line mysql_sql.y:2326
 		{
 			yyLOCAL = &tree.Limit{Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 332:
+	case 348:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2228
+//line mysql_sql.y:2330
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[2].exprUnion(), Count: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 333:
+	case 349:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2232
+//line mysql_sql.y:2334
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[4].exprUnion(), Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 334:
+	case 350:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2237
+//line mysql_sql.y:2339
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 335:
+	case 351:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2241
+//line mysql_sql.y:2343
 		{
 			yyLOCAL = yyDollar[1].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 336:
+	case 352:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2247
+//line mysql_sql.y:2349
 		{
 			yyLOCAL = yyDollar[3].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 337:
+	case 353:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2253
+//line mysql_sql.y:2355
 		{
 			yyLOCAL = tree.OrderBy{yyDollar[1].orderUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 338:
+	case 354:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2257
+//line mysql_sql.y:2359
 		{
 			yyLOCAL = append(yyDollar[1].orderByUnion(), yyDollar[3].orderUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 339:
+	case 355:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Order
-//line mysql_sql.y:2263
+//line mysql_sql.y:2365
 		{
 			yyLOCAL = &tree.Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 340:
+	case 356:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2268
+//line mysql_sql.y:2370
 		{
 			yyLOCAL = tree.DefaultDirection
 		}
 		yyVAL.union = yyLOCAL
-	case 341:
+	case 357:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2272
+//line mysql_sql.y:2374
 		{
 			yyLOCAL = tree.Ascending
 		}
 		yyVAL.union = yyLOCAL
-	case 342:
+	case 358:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2276
+//line mysql_sql.y:2378
 		{
 			yyLOCAL = tree.Descending
 		}
 		yyVAL.union = yyLOCAL
-	case 343:
+	case 359:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2283
+//line mysql_sql.y:2385
 		{
 			yyLOCAL = &tree.ParenSelect{Select: yyDollar[2].selectUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 344:
+	case 360:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2287
+//line mysql_sql.y:2389
 		{
 			yyLOCAL = &tree.ParenSelect{Select: &tree.Select{Select: yyDollar[2].selectStatementUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 345:
+	case 361:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2293
+//line mysql_sql.y:2395
 		{
 			yyLOCAL = yyDollar[1].selectStatementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 346:
+	case 362:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2297
+//line mysql_sql.y:2399
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7094,10 +7381,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 347:
+	case 363:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2307
+//line mysql_sql.y:2409
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7108,10 +7395,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 348:
+	case 364:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2317
+//line mysql_sql.y:2419
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7122,10 +7409,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 349:
+	case 365:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2327
+//line mysql_sql.y:2429
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7136,10 +7423,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 350:
+	case 366:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2339
+//line mysql_sql.y:2441
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -7148,10 +7435,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 351:
+	case 367:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2347
+//line mysql_sql.y:2449
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -7160,10 +7447,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 352:
+	case 368:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2355
+//line mysql_sql.y:2457
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -7172,10 +7459,82 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 353:
+	case 369:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line mysql_sql.y:2465
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.INTERSECT,
+				All:      false,
+				Distinct: false,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 370:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line mysql_sql.y:2473
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.INTERSECT,
+				All:      true,
+				Distinct: false,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 371:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line mysql_sql.y:2481
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.INTERSECT,
+				All:      false,
+				Distinct: true,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 372:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line mysql_sql.y:2489
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.EXCEPT,
+				All:      false,
+				Distinct: false,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 373:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line mysql_sql.y:2497
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.EXCEPT,
+				All:      true,
+				Distinct: false,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 374:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line mysql_sql.y:2505
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.EXCEPT,
+				All:      false,
+				Distinct: true,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 375:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2365
+//line mysql_sql.y:2515
 		{
 			yyLOCAL = &tree.SelectClause{
 				Distinct: yyDollar[2].boolValUnion(),
@@ -7187,130 +7546,130 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 354:
+	case 376:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2377
+//line mysql_sql.y:2527
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 355:
+	case 377:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2381
+//line mysql_sql.y:2531
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 356:
+	case 378:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2385
+//line mysql_sql.y:2535
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 359:
+	case 381:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2394
+//line mysql_sql.y:2544
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 360:
+	case 382:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2398
+//line mysql_sql.y:2548
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstHaving, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 361:
+	case 383:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:2403
+//line mysql_sql.y:2553
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 362:
+	case 384:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:2407
+//line mysql_sql.y:2557
 		{
 			yyLOCAL = tree.GroupBy(yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 363:
+	case 385:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2412
+//line mysql_sql.y:2562
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 364:
+	case 386:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2416
+//line mysql_sql.y:2566
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstWhere, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 365:
+	case 387:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:2422
+//line mysql_sql.y:2572
 		{
 			yyLOCAL = tree.SelectExprs{yyDollar[1].selectExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 366:
+	case 388:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:2426
+//line mysql_sql.y:2576
 		{
 			yyLOCAL = append(yyDollar[1].selectExprsUnion(), yyDollar[3].selectExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 367:
+	case 389:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2432
+//line mysql_sql.y:2582
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.StarExpr()}
 		}
 		yyVAL.union = yyLOCAL
-	case 368:
+	case 390:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2436
+//line mysql_sql.y:2586
 		{
 			yyLOCAL = tree.SelectExpr{Expr: yyDollar[1].exprUnion(), As: tree.UnrestrictedIdentifier(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 369:
+	case 391:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2440
+//line mysql_sql.y:2590
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 370:
+	case 392:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2444
+//line mysql_sql.y:2594
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[3].str, yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 371:
+	case 393:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2450
+//line mysql_sql.y:2600
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			tn := tree.NewTableName(tree.Identifier("dual"), prefix)
@@ -7319,44 +7678,44 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 372:
+	case 394:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2458
+//line mysql_sql.y:2608
 		{
 			yyLOCAL = yyDollar[1].fromUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 373:
+	case 395:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2464
+//line mysql_sql.y:2614
 		{
 			yyLOCAL = &tree.From{
 				Tables: yyDollar[2].tableExprsUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 374:
+	case 396:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:2472
+//line mysql_sql.y:2622
 		{
 			yyLOCAL = tree.TableExprs{yyDollar[1].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 375:
+	case 397:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:2476
+//line mysql_sql.y:2626
 		{
 			yyLOCAL = append(yyDollar[1].tableExprsUnion(), yyDollar[3].tableExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 378:
+	case 400:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2486
+//line mysql_sql.y:2636
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -7366,10 +7725,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 379:
+	case 401:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2495
+//line mysql_sql.y:2645
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -7379,10 +7738,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 380:
+	case 402:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2505
+//line mysql_sql.y:2655
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -7392,10 +7751,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 381:
+	case 403:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2514
+//line mysql_sql.y:2664
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -7404,177 +7763,209 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 382:
+	case 404:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2524
+//line mysql_sql.y:2674
 		{
 			yyVAL.str = tree.JOIN_TYPE_NATURAL
 		}
-	case 383:
+	case 405:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2528
+//line mysql_sql.y:2678
 		{
-			if yyDollar[2].str == tree.JOIN_TYPE_LEFT {
+			switch yyDollar[2].str {
+			case tree.JOIN_TYPE_LEFT:
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_LEFT
-			} else {
+			case tree.JOIN_TYPE_RIGHT:
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_RIGHT
+			default:
+				yyVAL.str = tree.JOIN_TYPE_NATURAL_FULL
 			}
 		}
-	case 384:
+	case 406:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2538
+//line mysql_sql.y:2691
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 385:
+	case 407:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2542
+//line mysql_sql.y:2695
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 386:
+	case 408:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2546
+//line mysql_sql.y:2699
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 387:
+	case 409:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2550
+//line mysql_sql.y:2703
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 388:
+	case 410:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line mysql_sql.y:2707
+		{
+			yyVAL.str = tree.JOIN_TYPE_FULL
+		}
+	case 411:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line mysql_sql.y:2711
+		{
+			yyVAL.str = tree.JOIN_TYPE_FULL
+		}
+	case 412:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2556
+//line mysql_sql.y:2717
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 389:
+	case 413:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2560
+//line mysql_sql.y:2721
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 390:
+	case 414:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2566
+//line mysql_sql.y:2727
 		{
 			yyVAL.str = tree.JOIN_TYPE_STRAIGHT
 		}
-	case 391:
+	case 415:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2572
+//line mysql_sql.y:2733
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 392:
+	case 416:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2576
+//line mysql_sql.y:2737
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 393:
+	case 417:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2580
+//line mysql_sql.y:2741
 		{
 			yyVAL.str = tree.JOIN_TYPE_CROSS
 		}
-	case 394:
+	case 418:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2586
+//line mysql_sql.y:2747
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 395:
+	case 419:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2590
+//line mysql_sql.y:2751
 		{
 			yyLOCAL = yyDollar[1].joinCondUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 396:
+	case 420:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2596
+//line mysql_sql.y:2757
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 397:
+	case 421:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2600
+//line mysql_sql.y:2761
 		{
 			yyLOCAL = &tree.UsingJoinCond{Cols: yyDollar[3].identifierListUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 398:
+	case 422:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.IdentifierList
+//line mysql_sql.y:2766
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 423:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.IdentifierList
+//line mysql_sql.y:2770
+		{
+			yyLOCAL = yyDollar[2].identifierListUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 424:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2606
+//line mysql_sql.y:2776
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 399:
+	case 425:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2610
+//line mysql_sql.y:2780
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 400:
+	case 426:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2616
+//line mysql_sql.y:2786
 		{
 			yyLOCAL = yyDollar[1].aliasedTableExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 401:
-		yyDollar = yyS[yypt-3 : yypt+1]
+	case 427:
+		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2620
+//line mysql_sql.y:2790
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].parenTableExprUnion(),
 				As: tree.AliasClause{
 					Alias: tree.Identifier(yyDollar[3].str),
+					Cols:  yyDollar[4].identifierListUnion(),
 				},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 402:
+	case 428:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ParenTableExpr
-//line mysql_sql.y:2632
+//line mysql_sql.y:2803
 		{
 			yyLOCAL = &tree.ParenTableExpr{Expr: yyDollar[2].selectUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 403:
+	case 429:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2637
+//line mysql_sql.y:2808
 		{
 		}
-	case 404:
+	case 430:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2638
+//line mysql_sql.y:2809
 		{
 		}
-	case 405:
+	case 431:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.AliasedTableExpr
-//line mysql_sql.y:2642
+//line mysql_sql.y:2813
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].tableNameUnion(),
@@ -7584,46 +7975,59 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 406:
+	case 432:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2654
+//line mysql_sql.y:2825
 		{
 			yyVAL.str = ""
 		}
-	case 407:
+	case 433:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2658
+//line mysql_sql.y:2829
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 408:
+	case 434:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2662
+//line mysql_sql.y:2833
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 411:
+	case 437:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2671
+//line mysql_sql.y:2842
 		{
 			yyVAL.str = ""
 		}
-	case 412:
+	case 438:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2675
+//line mysql_sql.y:2846
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 413:
+	case 439:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2679
+//line mysql_sql.y:2850
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 427:
+	case 454:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2880
+		{
+			yyLOCAL = &tree.CreateView{
+				Name:        yyDollar[4].tableNameUnion(),
+				ColNames:    yyDollar[5].identifierListUnion(),
+				AsSource:    yyDollar[7].selectUnion(),
+				IfNotExists: yyDollar[3].ifNotExistsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 455:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2708
+//line mysql_sql.y:2891
 		{
 			yyLOCAL = &tree.CreateUser{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -7633,189 +8037,189 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 428:
+	case 456:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2718
+//line mysql_sql.y:2901
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 429:
+	case 457:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2722
+//line mysql_sql.y:2905
 		{
 			yyLOCAL = yyDollar[2].resourceOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 430:
+	case 458:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2728
+//line mysql_sql.y:2911
 		{
 			yyLOCAL = []tree.ResourceOption{yyDollar[1].resourceOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 431:
+	case 459:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2732
+//line mysql_sql.y:2915
 		{
 			yyLOCAL = append(yyDollar[1].resourceOptionsUnion(), yyDollar[2].resourceOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 432:
+	case 460:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2738
+//line mysql_sql.y:2921
 		{
 			yyLOCAL = &tree.ResourceOptionMaxQueriesPerHour{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 433:
+	case 461:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2742
+//line mysql_sql.y:2925
 		{
 			yyLOCAL = &tree.ResourceOptionMaxUpdatesPerHour{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 434:
+	case 462:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2746
+//line mysql_sql.y:2929
 		{
 			yyLOCAL = &tree.ResourceOptionMaxConnectionPerHour{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 435:
+	case 463:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2750
+//line mysql_sql.y:2933
 		{
 			yyLOCAL = &tree.ResourceOptionMaxUserConnections{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 436:
+	case 464:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2756
+//line mysql_sql.y:2939
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 438:
+	case 466:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2763
+//line mysql_sql.y:2946
 		{
 			t := &tree.TlsOptionNone{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 439:
+	case 467:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2768
+//line mysql_sql.y:2951
 		{
 			t := &tree.TlsOptionSSL{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 440:
+	case 468:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2773
+//line mysql_sql.y:2956
 		{
 			t := &tree.TlsOptionX509{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 441:
+	case 469:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2778
+//line mysql_sql.y:2961
 		{
 			yyLOCAL = yyDollar[2].tlsOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 442:
+	case 470:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2784
+//line mysql_sql.y:2967
 		{
 			yyLOCAL = []tree.TlsOption{yyDollar[1].tlsOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 443:
+	case 471:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2788
+//line mysql_sql.y:2971
 		{
 			yyLOCAL = append(yyDollar[1].tlsOptionsUnion(), yyDollar[3].tlsOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 444:
+	case 472:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2792
+//line mysql_sql.y:2975
 		{
 			yyLOCAL = append(yyDollar[1].tlsOptionsUnion(), yyDollar[2].tlsOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 445:
+	case 473:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2798
+//line mysql_sql.y:2981
 		{
 			yyLOCAL = &tree.TlsOptionIssuer{Issuer: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 446:
+	case 474:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2802
+//line mysql_sql.y:2985
 		{
 			yyLOCAL = &tree.TlsOptionSubject{Subject: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 447:
+	case 475:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2806
+//line mysql_sql.y:2989
 		{
 			yyLOCAL = &tree.TlsOptionCipher{Cipher: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 448:
+	case 476:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2810
+//line mysql_sql.y:2993
 		{
 			yyLOCAL = &tree.TlsOptionSan{San: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 449:
+	case 477:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:2816
+//line mysql_sql.y:2999
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 450:
+	case 478:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:2820
+//line mysql_sql.y:3003
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 451:
+	case 479:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:2826
+//line mysql_sql.y:3009
 		{
 			yyLOCAL = &tree.User{
 				Username:   yyDollar[1].usernameRecordUnion().Username,
@@ -7827,42 +8231,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 452:
+	case 480:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:2839
+//line mysql_sql.y:3022
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: "%"}
 		}
 		yyVAL.union = yyLOCAL
-	case 453:
+	case 481:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:2843
+//line mysql_sql.y:3026
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 454:
+	case 482:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:2847
+//line mysql_sql.y:3030
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 455:
+	case 483:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:2852
+//line mysql_sql.y:3035
 		{
 			yyLOCAL = &tree.AuthRecord{}
 		}
 		yyVAL.union = yyLOCAL
-	case 456:
+	case 484:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:2856
+//line mysql_sql.y:3039
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthString: yyDollar[3].str,
@@ -7870,20 +8274,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 457:
+	case 485:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:2863
+//line mysql_sql.y:3046
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 458:
+	case 486:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:2869
+//line mysql_sql.y:3052
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
@@ -7892,10 +8296,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 459:
+	case 487:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:2877
+//line mysql_sql.y:3060
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
@@ -7903,20 +8307,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 460:
+	case 488:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:2884
+//line mysql_sql.y:3067
 		{
 			yyLOCAL = &tree.AuthRecord{
 				HashString: yyDollar[4].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 463:
+	case 491:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2896
+//line mysql_sql.y:3079
 		{
 			yyLOCAL = &tree.CreateRole{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -7924,82 +8328,82 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 464:
+	case 492:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:2905
+//line mysql_sql.y:3088
 		{
 			yyLOCAL = []*tree.Role{yyDollar[1].roleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 465:
+	case 493:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:2909
+//line mysql_sql.y:3092
 		{
 			yyLOCAL = append(yyDollar[1].rolesUnion(), yyDollar[3].roleUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 466:
+	case 494:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:2915
+//line mysql_sql.y:3098
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: "%"}
 		}
 		yyVAL.union = yyLOCAL
-	case 467:
+	case 495:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:2919
+//line mysql_sql.y:3102
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 468:
+	case 496:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:2923
+//line mysql_sql.y:3106
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 471:
+	case 499:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:2932
+//line mysql_sql.y:3115
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_NONE
 		}
 		yyVAL.union = yyLOCAL
-	case 472:
+	case 500:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:2936
+//line mysql_sql.y:3119
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_FULLTEXT
 		}
 		yyVAL.union = yyLOCAL
-	case 473:
+	case 501:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:2940
+//line mysql_sql.y:3123
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_SPATIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 474:
+	case 502:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:2944
+//line mysql_sql.y:3127
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_UNIQUE
 		}
 		yyVAL.union = yyLOCAL
-	case 475:
+	case 503:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2950
+//line mysql_sql.y:3133
 		{
 			var io *tree.IndexOption = nil
 			if yyDollar[11].indexOptionUnion() == nil && yyDollar[5].indexTypeUnion() != tree.INDEX_TYPE_INVALID {
@@ -8018,18 +8422,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 476:
+	case 504:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:2969
+//line mysql_sql.y:3152
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 477:
+	case 505:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:2973
+//line mysql_sql.y:3156
 		{
 			// Merge the options
 			if yyDollar[1].indexOptionUnion() == nil {
@@ -8050,123 +8454,123 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 478:
+	case 506:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:2995
+//line mysql_sql.y:3178
 		{
 			yyLOCAL = &tree.IndexOption{KeyBlockSize: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 479:
+	case 507:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:2999
+//line mysql_sql.y:3182
 		{
 			yyLOCAL = &tree.IndexOption{Comment: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 480:
+	case 508:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3003
+//line mysql_sql.y:3186
 		{
 			yyLOCAL = &tree.IndexOption{ParserName: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 481:
+	case 509:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3007
+//line mysql_sql.y:3190
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_VISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 482:
+	case 510:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3011
+//line mysql_sql.y:3194
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_INVISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 483:
+	case 511:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:3017
+//line mysql_sql.y:3200
 		{
 			yyLOCAL = []*tree.KeyPart{yyDollar[1].keyPartUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 484:
+	case 512:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:3021
+//line mysql_sql.y:3204
 		{
 			yyLOCAL = append(yyDollar[1].keyPartsUnion(), yyDollar[3].keyPartUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 485:
+	case 513:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:3027
+//line mysql_sql.y:3210
 		{
 			// Order is parsed but just ignored as MySQL did.
 			yyLOCAL = &tree.KeyPart{ColName: yyDollar[1].unresolvedNameUnion(), Length: int(yyDollar[2].lengthOptUnion()), Direction: yyDollar[3].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 486:
+	case 514:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:3032
+//line mysql_sql.y:3215
 		{
 			yyLOCAL = &tree.KeyPart{Expr: yyDollar[2].exprUnion(), Direction: yyDollar[4].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 487:
+	case 515:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3037
+//line mysql_sql.y:3220
 		{
 			yyLOCAL = tree.INDEX_TYPE_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 488:
+	case 516:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3041
+//line mysql_sql.y:3224
 		{
 			yyLOCAL = tree.INDEX_TYPE_BTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 489:
+	case 517:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3045
+//line mysql_sql.y:3228
 		{
 			yyLOCAL = tree.INDEX_TYPE_HASH
 		}
 		yyVAL.union = yyLOCAL
-	case 490:
+	case 518:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3049
+//line mysql_sql.y:3232
 		{
 			yyLOCAL = tree.INDEX_TYPE_RTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 491:
+	case 519:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3053
+//line mysql_sql.y:3236
 		{
 			yyLOCAL = tree.INDEX_TYPE_BSI
 		}
 		yyVAL.union = yyLOCAL
-	case 492:
+	case 520:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3059
+//line mysql_sql.y:3242
 		{
 			yyLOCAL = &tree.CreateDatabase{
 				IfNotExists:   yyDollar[3].ifNotExistsUnion(),
@@ -8175,98 +8579,98 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 495:
+	case 523:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3073
+//line mysql_sql.y:3256
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 496:
+	case 524:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3077
+//line mysql_sql.y:3260
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 497:
+	case 525:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3082
+//line mysql_sql.y:3265
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 498:
+	case 526:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3086
+//line mysql_sql.y:3269
 		{
 			yyLOCAL = yyDollar[1].createOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 499:
+	case 527:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3092
+//line mysql_sql.y:3275
 		{
 			yyLOCAL = []tree.CreateOption{yyDollar[1].createOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 500:
+	case 528:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3096
+//line mysql_sql.y:3279
 		{
 			yyLOCAL = append(yyDollar[1].createOptionsUnion(), yyDollar[2].createOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 501:
+	case 529:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3102
+//line mysql_sql.y:3285
 		{
 			yyLOCAL = &tree.CreateOptionCharset{IsDefault: yyDollar[1].defaultOptionalUnion(), Charset: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 502:
+	case 530:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3106
+//line mysql_sql.y:3289
 		{
 			yyLOCAL = &tree.CreateOptionCollate{IsDefault: yyDollar[1].defaultOptionalUnion(), Collate: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 503:
+	case 531:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3110
+//line mysql_sql.y:3293
 		{
 			yyLOCAL = &tree.CreateOptionEncryption{Encrypt: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 504:
+	case 532:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3115
+//line mysql_sql.y:3298
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 505:
+	case 533:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3119
+//line mysql_sql.y:3302
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 506:
+	case 534:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3125
+//line mysql_sql.y:3308
 		{
 			yyLOCAL = &tree.CreateTable{
 				Temporary:       yyDollar[2].boolValUnion(),
@@ -8278,34 +8682,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 507:
+	case 535:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3137
+//line mysql_sql.y:3320
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 508:
+	case 536:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3141
+//line mysql_sql.y:3324
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 509:
+	case 537:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:3146
+//line mysql_sql.y:3329
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 510:
+	case 538:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:3150
+//line mysql_sql.y:3333
 		{
 			yyDollar[3].partitionByUnion().Num = uint64(yyDollar[4].int64ValUnion())
 			yyLOCAL = &tree.PartitionOption{
@@ -8315,18 +8719,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 511:
+	case 539:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3160
+//line mysql_sql.y:3343
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 512:
+	case 540:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3164
+//line mysql_sql.y:3347
 		{
 			yyLOCAL = &tree.PartitionBy{
 				IsSubPartition: true,
@@ -8335,42 +8739,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 513:
+	case 541:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3173
+//line mysql_sql.y:3356
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 514:
+	case 542:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3177
+//line mysql_sql.y:3360
 		{
 			yyLOCAL = yyDollar[2].partitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 515:
+	case 543:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3183
+//line mysql_sql.y:3366
 		{
 			yyLOCAL = []*tree.Partition{yyDollar[1].partitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 516:
+	case 544:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3187
+//line mysql_sql.y:3370
 		{
 			yyLOCAL = append(yyDollar[1].partitionsUnion(), yyDollar[3].partitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 517:
+	case 545:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:3193
+//line mysql_sql.y:3376
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -8380,10 +8784,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 518:
+	case 546:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:3202
+//line mysql_sql.y:3385
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -8393,42 +8797,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 519:
+	case 547:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3212
+//line mysql_sql.y:3395
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 520:
+	case 548:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3216
+//line mysql_sql.y:3399
 		{
 			yyLOCAL = yyDollar[2].subPartitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 521:
+	case 549:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3222
+//line mysql_sql.y:3405
 		{
 			yyLOCAL = []*tree.SubPartition{yyDollar[1].subPartitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 522:
+	case 550:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3226
+//line mysql_sql.y:3409
 		{
 			yyLOCAL = append(yyDollar[1].subPartitionsUnion(), yyDollar[3].subPartitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 523:
+	case 551:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:3232
+//line mysql_sql.y:3415
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -8436,10 +8840,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 524:
+	case 552:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:3239
+//line mysql_sql.y:3422
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -8447,50 +8851,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 525:
+	case 553:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3248
+//line mysql_sql.y:3431
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 526:
+	case 554:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3252
+//line mysql_sql.y:3435
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 527:
+	case 555:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:3257
+//line mysql_sql.y:3440
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 528:
+	case 556:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:3261
+//line mysql_sql.y:3444
 		{
 			yyLOCAL = &tree.ValuesLessThan{ValueList: yyDollar[5].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 529:
+	case 557:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3266
+//line mysql_sql.y:3449
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 530:
+	case 558:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3270
+//line mysql_sql.y:3453
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -8500,18 +8904,18 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 531:
+	case 559:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3280
+//line mysql_sql.y:3463
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 532:
+	case 560:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3284
+//line mysql_sql.y:3467
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -8521,10 +8925,10 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 533:
+	case 561:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3295
+//line mysql_sql.y:3478
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -8533,10 +8937,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 534:
+	case 562:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3303
+//line mysql_sql.y:3486
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -8545,10 +8949,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 535:
+	case 563:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3311
+//line mysql_sql.y:3494
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -8557,10 +8961,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 536:
+	case 564:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3319
+//line mysql_sql.y:3502
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -8569,10 +8973,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 538:
+	case 566:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3330
+//line mysql_sql.y:3513
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.KeyType{
@@ -8583,10 +8987,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 539:
+	case 567:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3340
+//line mysql_sql.y:3523
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.HashType{
@@ -8596,478 +9000,478 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 540:
+	case 568:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3350
+//line mysql_sql.y:3533
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 541:
+	case 569:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3354
+//line mysql_sql.y:3537
 		{
 			yyLOCAL = yyDollar[3].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 542:
+	case 570:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3359
+//line mysql_sql.y:3542
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 543:
+	case 571:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3363
+//line mysql_sql.y:3546
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 544:
+	case 572:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3368
+//line mysql_sql.y:3551
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 545:
+	case 573:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3372
+//line mysql_sql.y:3555
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 546:
+	case 574:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3378
+//line mysql_sql.y:3561
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 547:
+	case 575:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3382
+//line mysql_sql.y:3565
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[3].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 548:
+	case 576:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3386
+//line mysql_sql.y:3569
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 549:
+	case 577:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3392
+//line mysql_sql.y:3575
 		{
 			yyLOCAL = tree.NewTableOptionAutoIncrement(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 550:
+	case 578:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3396
+//line mysql_sql.y:3579
 		{
 			yyLOCAL = tree.NewTableOptionAvgRowLength(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 551:
+	case 579:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3400
+//line mysql_sql.y:3583
 		{
 			yyLOCAL = tree.NewTableOptionCharset(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 552:
+	case 580:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3404
+//line mysql_sql.y:3587
 		{
 			yyLOCAL = tree.NewTableOptionCollate(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 553:
+	case 581:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3408
+//line mysql_sql.y:3591
 		{
 			yyLOCAL = tree.NewTableOptionChecksum(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 554:
+	case 582:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3412
+//line mysql_sql.y:3595
 		{
 			yyLOCAL = tree.NewTableOptionComment(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 555:
+	case 583:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3416
+//line mysql_sql.y:3599
 		{
 			yyLOCAL = tree.NewTableOptionCompression(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 556:
+	case 584:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3420
+//line mysql_sql.y:3603
 		{
 			yyLOCAL = tree.NewTableOptionConnection(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 557:
+	case 585:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3424
+//line mysql_sql.y:3607
 		{
 			yyLOCAL = tree.NewTableOptionDataDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 558:
+	case 586:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3428
+//line mysql_sql.y:3611
 		{
 			yyLOCAL = tree.NewTableOptionIndexDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 559:
+	case 587:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3432
+//line mysql_sql.y:3615
 		{
 			yyLOCAL = tree.NewTableOptionDelayKeyWrite(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 560:
+	case 588:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3436
+//line mysql_sql.y:3619
 		{
 			yyLOCAL = tree.NewTableOptionEncryption(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 561:
+	case 589:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3440
+//line mysql_sql.y:3623
 		{
 			yyLOCAL = tree.NewTableOptionEngine(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 562:
+	case 590:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3444
+//line mysql_sql.y:3627
 		{
 			yyLOCAL = tree.NewTableOptionKeyBlockSize(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 563:
+	case 591:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3448
+//line mysql_sql.y:3631
 		{
 			yyLOCAL = tree.NewTableOptionMaxRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 564:
+	case 592:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3452
+//line mysql_sql.y:3635
 		{
 			yyLOCAL = tree.NewTableOptionMinRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 565:
+	case 593:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3456
+//line mysql_sql.y:3639
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 566:
+	case 594:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3460
+//line mysql_sql.y:3643
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 567:
+	case 595:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3464
+//line mysql_sql.y:3647
 		{
 			yyLOCAL = tree.NewTableOptionPassword(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 568:
+	case 596:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3468
+//line mysql_sql.y:3651
 		{
 			yyLOCAL = tree.NewTableOptionRowFormat(yyDollar[3].rowFormatTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 569:
+	case 597:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3472
+//line mysql_sql.y:3655
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 570:
+	case 598:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3476
+//line mysql_sql.y:3659
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 571:
+	case 599:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3480
+//line mysql_sql.y:3663
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 572:
+	case 600:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3484
+//line mysql_sql.y:3667
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 573:
+	case 601:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3488
+//line mysql_sql.y:3671
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 574:
+	case 602:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3492
+//line mysql_sql.y:3675
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 575:
+	case 603:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3496
+//line mysql_sql.y:3679
 		{
 			yyLOCAL = tree.NewTableOptionTablespace(yyDollar[3].str, yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 576:
+	case 604:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3500
+//line mysql_sql.y:3683
 		{
 			yyLOCAL = tree.NewTableOptionUnion(yyDollar[4].tableNamesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 577:
+	case 605:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3504
+//line mysql_sql.y:3687
 		{
 			yyLOCAL = &tree.TableOptionProperties{Preperties: yyDollar[3].propertiesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 578:
+	case 606:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:3511
+//line mysql_sql.y:3694
 		{
 			yyLOCAL = []tree.Property{yyDollar[1].propertyUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 579:
+	case 607:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:3515
+//line mysql_sql.y:3698
 		{
 			yyLOCAL = append(yyDollar[1].propertiesUnion(), yyDollar[3].propertyUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 580:
+	case 608:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Property
-//line mysql_sql.y:3521
+//line mysql_sql.y:3704
 		{
 			yyLOCAL = tree.Property{Key: yyDollar[1].str, Value: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 581:
+	case 609:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3526
+//line mysql_sql.y:3709
 		{
 			yyVAL.str = ""
 		}
-	case 582:
+	case 610:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3530
+//line mysql_sql.y:3713
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 583:
+	case 611:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3534
+//line mysql_sql.y:3717
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 584:
+	case 612:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3540
+//line mysql_sql.y:3723
 		{
 			yyLOCAL = tree.ROW_FORMAT_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 585:
+	case 613:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3544
+//line mysql_sql.y:3727
 		{
 			yyLOCAL = tree.ROW_FORMAT_DYNAMIC
 		}
 		yyVAL.union = yyLOCAL
-	case 586:
+	case 614:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3548
+//line mysql_sql.y:3731
 		{
 			yyLOCAL = tree.ROW_FORMAT_FIXED
 		}
 		yyVAL.union = yyLOCAL
-	case 587:
+	case 615:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3552
+//line mysql_sql.y:3735
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPRESSED
 		}
 		yyVAL.union = yyLOCAL
-	case 588:
+	case 616:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3556
+//line mysql_sql.y:3739
 		{
 			yyLOCAL = tree.ROW_FORMAT_REDUNDANT
 		}
 		yyVAL.union = yyLOCAL
-	case 589:
+	case 617:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3560
+//line mysql_sql.y:3743
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPACT
 		}
 		yyVAL.union = yyLOCAL
-	case 596:
+	case 624:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:3576
+//line mysql_sql.y:3759
 		{
 			yyLOCAL = tree.TableNames{yyDollar[1].tableNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 597:
+	case 625:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:3580
+//line mysql_sql.y:3763
 		{
 			yyLOCAL = append(yyDollar[1].tableNamesUnion(), yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 598:
+	case 626:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3589
+//line mysql_sql.y:3772
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[1].str), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 599:
+	case 627:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3594
+//line mysql_sql.y:3777
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].str), ExplicitSchema: true}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[3].str), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 600:
+	case 628:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:3600
+//line mysql_sql.y:3783
 		{
 			yyLOCAL = tree.TableDefs(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 602:
+	case 630:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:3607
+//line mysql_sql.y:3790
 		{
 			yyLOCAL = tree.TableDefs{yyDollar[1].tableDefUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 603:
+	case 631:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:3611
+//line mysql_sql.y:3794
 		{
 			yyLOCAL = append(yyDollar[1].tableDefsUnion(), yyDollar[3].tableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 604:
+	case 632:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3617
+//line mysql_sql.y:3800
 		{
 			yyLOCAL = tree.TableDef(yyDollar[1].columnTableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 605:
+	case 633:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3621
+//line mysql_sql.y:3804
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 606:
+	case 634:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3627
+//line mysql_sql.y:3810
 		{
 			if yyDollar[1].str != "" {
 				switch v := yyDollar[2].tableDefUnion().(type) {
@@ -9078,18 +9482,18 @@ yydefault:
 			yyLOCAL = yyDollar[2].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 607:
+	case 635:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3637
+//line mysql_sql.y:3820
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 608:
+	case 636:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3643
+//line mysql_sql.y:3826
 		{
 			yyLOCAL = &tree.PrimaryKeyIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -9099,10 +9503,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 609:
+	case 637:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3652
+//line mysql_sql.y:3835
 		{
 			yyLOCAL = &tree.FullTextIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -9112,10 +9516,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 610:
+	case 638:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3661
+//line mysql_sql.y:3844
 		{
 			keyTyp := tree.INDEX_TYPE_INVALID
 			if yyDollar[3].strsUnion()[1] != "" {
@@ -9139,10 +9543,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 611:
+	case 639:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3684
+//line mysql_sql.y:3867
 		{
 			yyLOCAL = &tree.UniqueIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -9152,10 +9556,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 612:
+	case 640:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3693
+//line mysql_sql.y:3876
 		{
 			yyLOCAL = &tree.ForeignKey{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -9166,10 +9570,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 613:
+	case 641:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3703
+//line mysql_sql.y:3886
 		{
 			yyLOCAL = &tree.CheckIndex{
 				Expr:     yyDollar[3].exprUnion(),
@@ -9177,298 +9581,298 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 614:
+	case 642:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3711
+//line mysql_sql.y:3894
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 616:
+	case 644:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3717
+//line mysql_sql.y:3900
 		{
 			yyVAL.str = ""
 		}
-	case 617:
+	case 645:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3721
+//line mysql_sql.y:3904
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 620:
+	case 648:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3731
+//line mysql_sql.y:3914
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = ""
 		}
 		yyVAL.union = yyLOCAL
-	case 621:
+	case 649:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3737
+//line mysql_sql.y:3920
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 622:
+	case 650:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3743
+//line mysql_sql.y:3926
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 628:
+	case 656:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3757
+//line mysql_sql.y:3940
 		{
 			yyVAL.str = ""
 		}
-	case 630:
+	case 658:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ColumnTableDef
-//line mysql_sql.y:3764
+//line mysql_sql.y:3947
 		{
 			yyLOCAL = tree.NewColumnTableDef(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), yyDollar[3].columnAttributesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 631:
+	case 659:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3770
+//line mysql_sql.y:3953
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 632:
+	case 660:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3774
+//line mysql_sql.y:3957
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 633:
+	case 661:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3778
+//line mysql_sql.y:3961
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 637:
+	case 665:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3789
+//line mysql_sql.y:3972
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 638:
+	case 666:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3793
+//line mysql_sql.y:3976
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 639:
+	case 667:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3797
+//line mysql_sql.y:3980
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 640:
+	case 668:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3802
+//line mysql_sql.y:3985
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 641:
+	case 669:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3806
+//line mysql_sql.y:3989
 		{
 			yyLOCAL = yyDollar[1].columnAttributesUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 642:
+	case 670:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3812
+//line mysql_sql.y:3995
 		{
 			yyLOCAL = []tree.ColumnAttribute{yyDollar[1].columnAttributeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 643:
+	case 671:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3816
+//line mysql_sql.y:3999
 		{
 			yyLOCAL = append(yyDollar[1].columnAttributesUnion(), yyDollar[2].columnAttributeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 644:
+	case 672:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3822
+//line mysql_sql.y:4005
 		{
 			yyLOCAL = tree.NewAttributeNull(true)
 		}
 		yyVAL.union = yyLOCAL
-	case 645:
+	case 673:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3826
+//line mysql_sql.y:4009
 		{
 			yyLOCAL = tree.NewAttributeNull(false)
 		}
 		yyVAL.union = yyLOCAL
-	case 646:
+	case 674:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3830
+//line mysql_sql.y:4013
 		{
 			yyLOCAL = tree.NewAttributeDefault(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 647:
+	case 675:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3834
+//line mysql_sql.y:4017
 		{
 			yyLOCAL = tree.NewAttributeAutoIncrement()
 		}
 		yyVAL.union = yyLOCAL
-	case 648:
+	case 676:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3838
+//line mysql_sql.y:4021
 		{
 			yyLOCAL = yyDollar[1].columnAttributeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 649:
+	case 677:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3842
+//line mysql_sql.y:4025
 		{
 			yyLOCAL = tree.NewAttributeComment(tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false))
 		}
 		yyVAL.union = yyLOCAL
-	case 650:
+	case 678:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3846
+//line mysql_sql.y:4029
 		{
 			yyLOCAL = tree.NewAttributeCollate(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 651:
+	case 679:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3850
+//line mysql_sql.y:4033
 		{
 			yyLOCAL = tree.NewAttributeColumnFormat(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 652:
+	case 680:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3854
+//line mysql_sql.y:4037
 		{
 			yyLOCAL = tree.NewAttributeStorage(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 653:
+	case 681:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3858
+//line mysql_sql.y:4041
 		{
 			yyLOCAL = tree.NewAttributeAutoRandom(int(yyDollar[2].int64ValUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 654:
+	case 682:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3862
+//line mysql_sql.y:4045
 		{
 			yyLOCAL = yyDollar[1].attributeReferenceUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 655:
+	case 683:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3866
+//line mysql_sql.y:4049
 		{
 			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), false, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 656:
+	case 684:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3870
+//line mysql_sql.y:4053
 		{
 			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), yyDollar[6].boolValUnion(), yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 657:
+	case 685:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3880
+//line mysql_sql.y:4063
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 658:
+	case 686:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3884
+//line mysql_sql.y:4067
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 659:
+	case 687:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3889
+//line mysql_sql.y:4072
 		{
 			yyVAL.str = ""
 		}
-	case 660:
+	case 688:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3893
+//line mysql_sql.y:4076
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 661:
+	case 689:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3899
+//line mysql_sql.y:4082
 		{
 			yyVAL.str = ""
 		}
-	case 662:
+	case 690:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3903
+//line mysql_sql.y:4086
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 663:
+	case 691:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AttributeReference
-//line mysql_sql.y:3909
+//line mysql_sql.y:4092
 		{
 			yyLOCAL = &tree.AttributeReference{
 				TableName: yyDollar[2].tableNameUnion(),
@@ -9479,10 +9883,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 664:
+	case 692:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:3920
+//line mysql_sql.y:4103
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -9490,10 +9894,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 666:
+	case 694:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:3930
+//line mysql_sql.y:4113
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -9501,10 +9905,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 667:
+	case 695:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:3937
+//line mysql_sql.y:4120
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -9512,10 +9916,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 668:
+	case 696:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:3944
+//line mysql_sql.y:4127
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -9523,10 +9927,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 669:
+	case 697:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:3951
+//line mysql_sql.y:4134
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[2].referenceOptionTypeUnion(),
@@ -9534,355 +9938,363 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 670:
+	case 698:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:3960
+//line mysql_sql.y:4143
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 671:
+	case 699:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:3966
+//line mysql_sql.y:4149
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 672:
+	case 700:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:3972
+//line mysql_sql.y:4155
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_RESTRICT
 		}
 		yyVAL.union = yyLOCAL
-	case 673:
+	case 701:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:3976
+//line mysql_sql.y:4159
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_CASCADE
 		}
 		yyVAL.union = yyLOCAL
-	case 674:
+	case 702:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:3980
+//line mysql_sql.y:4163
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_NULL
 		}
 		yyVAL.union = yyLOCAL
-	case 675:
+	case 703:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:3984
+//line mysql_sql.y:4167
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_NO_ACTION
 		}
 		yyVAL.union = yyLOCAL
-	case 676:
+	case 704:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:3988
+//line mysql_sql.y:4171
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 677:
+	case 705:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:3993
+//line mysql_sql.y:4176
 		{
 			yyLOCAL = tree.MATCH_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 679:
+	case 707:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4000
+//line mysql_sql.y:4183
 		{
 			yyLOCAL = tree.MATCH_FULL
 		}
 		yyVAL.union = yyLOCAL
-	case 680:
+	case 708:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4004
+//line mysql_sql.y:4187
 		{
 			yyLOCAL = tree.MATCH_PARTIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 681:
+	case 709:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4008
+//line mysql_sql.y:4191
 		{
 			yyLOCAL = tree.MATCH_SIMPLE
 		}
 		yyVAL.union = yyLOCAL
-	case 682:
+	case 710:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:4013
+//line mysql_sql.y:4196
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 683:
+	case 711:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:4017
+//line mysql_sql.y:4200
 		{
 			yyLOCAL = yyDollar[2].keyPartsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 684:
+	case 712:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4022
+//line mysql_sql.y:4205
 		{
 			yyLOCAL = -1
 		}
 		yyVAL.union = yyLOCAL
-	case 685:
+	case 713:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4026
+//line mysql_sql.y:4209
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 692:
+	case 720:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Subquery
-//line mysql_sql.y:4042
+//line mysql_sql.y:4225
 		{
 			yyLOCAL = &tree.Subquery{Select: yyDollar[1].selectStatementUnion(), Exists: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 693:
+	case 721:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4048
+//line mysql_sql.y:4231
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_AND, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 694:
+	case 722:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4052
+//line mysql_sql.y:4235
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_OR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 695:
+	case 723:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4056
+//line mysql_sql.y:4239
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_XOR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 696:
+	case 724:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4060
+//line mysql_sql.y:4243
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.PLUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 697:
+	case 725:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4064
+//line mysql_sql.y:4247
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MINUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 698:
+	case 726:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4068
+//line mysql_sql.y:4251
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MULTI, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 699:
+	case 727:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4072
+//line mysql_sql.y:4255
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 700:
+	case 728:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4076
+//line mysql_sql.y:4259
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.INTEGER_DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 701:
+	case 729:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4080
+//line mysql_sql.y:4263
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 702:
+	case 730:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4084
+//line mysql_sql.y:4267
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 703:
+	case 731:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4088
+//line mysql_sql.y:4271
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.LEFT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 704:
+	case 732:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4092
+//line mysql_sql.y:4275
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.RIGHT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 705:
+	case 733:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4096
+//line mysql_sql.y:4279
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 706:
+	case 734:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4102
+//line mysql_sql.y:4285
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 707:
+	case 735:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4106
+//line mysql_sql.y:4289
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 708:
+	case 736:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4110
+//line mysql_sql.y:4293
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 709:
+	case 737:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4114
+//line mysql_sql.y:4297
 		{
 			yyLOCAL = tree.NewParenExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 710:
+	case 738:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4118
+//line mysql_sql.y:4301
 		{
 			yyLOCAL = tree.NewTuple(append(yyDollar[2].exprsUnion(), yyDollar[4].exprUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 711:
+	case 739:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4122
+//line mysql_sql.y:4305
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_PLUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 712:
+	case 740:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4126
+//line mysql_sql.y:4309
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MINUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 713:
+	case 741:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4130
+//line mysql_sql.y:4313
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_TILDE, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 714:
+	case 742:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4134
+//line mysql_sql.y:4317
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MARK, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 715:
+	case 743:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4138
+//line mysql_sql.y:4321
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 716:
+	case 744:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4325
+		{
+			yyLOCAL = tree.NewCaseExpr(yyDollar[2].exprUnion(), yyDollar[3].whensUnion(), yyDollar[4].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 745:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4142
+//line mysql_sql.y:4329
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 717:
+	case 746:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4146
+//line mysql_sql.y:4333
 		{
 			yyDollar[2].subqueryUnion().Exists = true
 			yyLOCAL = yyDollar[2].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 718:
+	case 747:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4151
+//line mysql_sql.y:4338
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 719:
+	case 748:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4155
+//line mysql_sql.y:4342
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 720:
+	case 749:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4159
+//line mysql_sql.y:4346
 		{
 			name := tree.SetUnresolvedName("convert")
 			es := tree.NewNumVal(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false)
@@ -9892,42 +10304,44 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 721:
-		yyDollar = yyS[yypt-1 : yypt+1]
+	case 750:
+		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4168
+//line mysql_sql.y:4355
 		{
+			yyDollar[1].funcExprUnion().WindowSpec = yyDollar[2].windowSpecUnion()
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 722:
+	case 751:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4172
+//line mysql_sql.y:4360
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 723:
+	case 752:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4176
+//line mysql_sql.y:4364
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 724:
-		yyDollar = yyS[yypt-1 : yypt+1]
+	case 753:
+		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4180
+//line mysql_sql.y:4368
 		{
+			yyDollar[1].funcExprUnion().WindowSpec = yyDollar[2].windowSpecUnion()
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 726:
+	case 755:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4187
+//line mysql_sql.y:4376
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -9941,10 +10355,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 727:
+	case 756:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4200
+//line mysql_sql.y:4389
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -9958,10 +10372,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 728:
+	case 757:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4213
+//line mysql_sql.y:4402
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -9974,10 +10388,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 729:
+	case 758:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4225
+//line mysql_sql.y:4414
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -9992,10 +10406,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 730:
+	case 759:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4239
+//line mysql_sql.y:4428
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10011,10 +10425,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 731:
+	case 760:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4254
+//line mysql_sql.y:4443
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10030,10 +10444,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 732:
+	case 761:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4269
+//line mysql_sql.y:4458
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10047,10 +10461,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 733:
+	case 762:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4282
+//line mysql_sql.y:4471
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10065,15 +10479,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 734:
+	case 763:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4297
+//line mysql_sql.y:4486
 		{
 		}
-	case 737:
+	case 766:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4303
+//line mysql_sql.y:4492
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10083,10 +10497,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 738:
+	case 767:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4312
+//line mysql_sql.y:4501
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10095,10 +10509,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 739:
+	case 768:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4320
+//line mysql_sql.y:4509
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10107,10 +10521,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 740:
+	case 769:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4328
+//line mysql_sql.y:4517
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10120,10 +10534,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 741:
+	case 770:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4337
+//line mysql_sql.y:4526
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10133,10 +10547,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 742:
+	case 771:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4346
+//line mysql_sql.y:4535
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10146,10 +10560,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 743:
+	case 772:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4355
+//line mysql_sql.y:4544
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10159,10 +10573,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 744:
+	case 773:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4364
+//line mysql_sql.y:4553
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			es := tree.NewNumVal(constant.MakeString("*"), "*", false)
@@ -10172,10 +10586,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 745:
+	case 774:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4373
+//line mysql_sql.y:4562
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10185,10 +10599,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 746:
+	case 775:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4382
+//line mysql_sql.y:4571
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10198,10 +10612,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 747:
+	case 776:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4391
+//line mysql_sql.y:4580
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10211,10 +10625,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 748:
+	case 777:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4400
+//line mysql_sql.y:4589
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10224,10 +10638,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 749:
+	case 778:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4409
+//line mysql_sql.y:4598
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10237,10 +10651,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 750:
+	case 779:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4418
+//line mysql_sql.y:4607
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10250,10 +10664,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 751:
+	case 780:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4427
+//line mysql_sql.y:4616
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10263,10 +10677,124 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 755:
+	case 781:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.WindowSpec
+//line mysql_sql.y:4626
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 782:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.WindowSpec
+//line mysql_sql.y:4630
+		{
+			yyLOCAL = tree.NewWindowSpec(yyDollar[3].exprsUnion(), yyDollar[4].orderByUnion(), yyDollar[5].frameClauseUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 783:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line mysql_sql.y:4635
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 784:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line mysql_sql.y:4639
+		{
+			yyLOCAL = yyDollar[3].exprsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 785:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.FrameClause
+//line mysql_sql.y:4644
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 786:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameClause
+//line mysql_sql.y:4648
+		{
+			yyLOCAL = tree.NewFrameClause(yyDollar[1].frameTypeUnion(), yyDollar[2].frameBoundUnion(), tree.NewFrameBound(tree.FRAME_CURRENT_ROW, nil))
+		}
+		yyVAL.union = yyLOCAL
+	case 787:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FrameClause
+//line mysql_sql.y:4652
+		{
+			yyLOCAL = tree.NewFrameClause(yyDollar[1].frameTypeUnion(), yyDollar[3].frameBoundUnion(), yyDollar[5].frameBoundUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 788:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.FrameType
+//line mysql_sql.y:4658
+		{
+			yyLOCAL = tree.FRAME_ROWS
+		}
+		yyVAL.union = yyLOCAL
+	case 789:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.FrameType
+//line mysql_sql.y:4662
+		{
+			yyLOCAL = tree.FRAME_RANGE
+		}
+		yyVAL.union = yyLOCAL
+	case 790:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:4668
+		{
+			yyLOCAL = tree.NewFrameBound(tree.FRAME_UNBOUNDED_PRECEDING, nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 791:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:4672
+		{
+			yyLOCAL = tree.NewFrameBound(tree.FRAME_UNBOUNDED_FOLLOWING, nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 792:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:4676
+		{
+			yyLOCAL = tree.NewFrameBound(tree.FRAME_CURRENT_ROW, nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 793:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:4680
+		{
+			ival := util.GetUint64(yyDollar[1].item)
+			yyLOCAL = tree.NewFrameBound(tree.FRAME_PRECEDING, tree.NewNumVal(constant.MakeUint64(ival), strconv.FormatUint(ival, 10), false))
+		}
+		yyVAL.union = yyLOCAL
+	case 794:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:4685
+		{
+			ival := util.GetUint64(yyDollar[1].item)
+			yyLOCAL = tree.NewFrameBound(tree.FRAME_FOLLOWING, tree.NewNumVal(constant.MakeUint64(ival), strconv.FormatUint(ival, 10), false))
+		}
+		yyVAL.union = yyLOCAL
+	case 798:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4443
+//line mysql_sql.y:4697
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10275,10 +10803,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 756:
+	case 799:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4451
+//line mysql_sql.y:4705
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10287,10 +10815,48 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 757:
+	case 800:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4713
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[3].exprUnion(), yyDollar[5].exprUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 801:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4721
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[3].exprUnion(), yyDollar[5].exprUnion(), yyDollar[7].exprUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 802:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4729
+		{
+			t := yyDollar[3].intervalTypeUnion()
+			unit := t.ToString()
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{tree.NewNumVal(constant.MakeString(unit), unit, false), yyDollar[5].exprUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 803:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4459
+//line mysql_sql.y:4739
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10299,10 +10865,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 758:
+	case 804:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4477
+//line mysql_sql.y:4757
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -10315,10 +10881,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 759:
+	case 805:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4489
+//line mysql_sql.y:4769
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -10331,10 +10897,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 760:
+	case 806:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4503
+//line mysql_sql.y:4783
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10343,10 +10909,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 761:
+	case 807:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4511
+//line mysql_sql.y:4791
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10354,10 +10920,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 762:
+	case 808:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4518
+//line mysql_sql.y:4798
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -10370,10 +10936,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 763:
+	case 809:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4530
+//line mysql_sql.y:4810
 		{
 			name := tree.SetUnresolvedName("char")
 			yyLOCAL = &tree.FuncExpr{
@@ -10382,10 +10948,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 764:
+	case 810:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4538
+//line mysql_sql.y:4818
 		{
 			cn := tree.NewNumVal(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false)
 			es := yyDollar[3].exprsUnion()
@@ -10397,10 +10963,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 765:
+	case 811:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4549
+//line mysql_sql.y:4829
 		{
 			val := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
 			name := tree.SetUnresolvedName("date")
@@ -10410,10 +10976,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 766:
+	case 812:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4558
+//line mysql_sql.y:4838
 		{
 			val := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
 			name := tree.SetUnresolvedName("time")
@@ -10423,10 +10989,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 767:
+	case 813:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4567
+//line mysql_sql.y:4847
 		{
 			val := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
 			name := tree.SetUnresolvedName("timestamp")
@@ -10436,10 +11002,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 768:
+	case 814:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4576
+//line mysql_sql.y:4856
 		{
 			name := tree.SetUnresolvedName("insert")
 			yyLOCAL = &tree.FuncExpr{
@@ -10448,10 +11014,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 769:
+	case 815:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4584
+//line mysql_sql.y:4864
 		{
 			es := tree.Exprs{yyDollar[3].exprUnion()}
 			es = append(es, yyDollar[5].exprUnion())
@@ -10462,10 +11028,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 770:
+	case 816:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4594
+//line mysql_sql.y:4874
 		{
 			name := tree.SetUnresolvedName("password")
 			yyLOCAL = &tree.FuncExpr{
@@ -10474,10 +11040,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 771:
+	case 817:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4602
+//line mysql_sql.y:4882
 		{
 			name := tree.SetUnresolvedName("binary")
 			yyLOCAL = &tree.FuncExpr{
@@ -10486,375 +11052,507 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 772:
+	case 818:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4891
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 819:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4895
+		{
+			yyLOCAL = yyDollar[1].exprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 820:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4901
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 821:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4905
+		{
+			yyLOCAL = yyDollar[2].numValUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 828:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:4918
+		{
+		}
+	case 829:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line mysql_sql.y:4920
+		{
+		}
+	case 862:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4960
+		{
+			yyLOCAL = &tree.IntervalExpr{
+				Expr: yyDollar[2].exprUnion(),
+				Type: yyDollar[3].intervalTypeUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 863:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4967
+		{
+			// the unit is in the string, such as interval '1 year'
+			yyLOCAL = &tree.IntervalExpr{
+				Expr: tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false),
+				Type: tree.INTERVAL_TYPE_INVALID,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 864:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4611
+//line mysql_sql.y:4976
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 773:
+	case 865:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4615
+//line mysql_sql.y:4980
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 774:
+	case 866:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*tree.When
+//line mysql_sql.y:4986
+		{
+			yyLOCAL = []*tree.When{yyDollar[1].whenUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 867:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL tree.Expr
-//line mysql_sql.y:4621
+		var yyLOCAL []*tree.When
+//line mysql_sql.y:4990
 		{
-			yyLOCAL = nil
+			yyLOCAL = append(yyDollar[1].whensUnion(), yyDollar[2].whenUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 775:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL tree.Expr
-//line mysql_sql.y:4625
+	case 868:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.When
+//line mysql_sql.y:4996
 		{
-			yyLOCAL = yyDollar[2].numValUnion()
+			yyLOCAL = tree.NewWhen(yyDollar[2].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 782:
+	case 869:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4638
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:5001
 		{
+			yyLOCAL = nil
 		}
-	case 783:
+		yyVAL.union = yyLOCAL
+	case 870:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4640
-		{
-		}
-	case 816:
-		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4680
+//line mysql_sql.y:5005
 		{
-			yyLOCAL = &tree.IntervalExpr{
-				Expr: yyDollar[2].exprUnion(),
-				Type: yyDollar[3].intervalTypeUnion(),
-			}
+			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 817:
+	case 871:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:4688
+//line mysql_sql.y:5010
 		{
 			yyLOCAL = tree.FUNC_TYPE_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 818:
+	case 872:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:4692
+//line mysql_sql.y:5014
 		{
 			yyLOCAL = tree.FUNC_TYPE_DISTINCT
 		}
 		yyVAL.union = yyLOCAL
-	case 819:
+	case 873:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:4696
+//line mysql_sql.y:5018
 		{
 			yyLOCAL = tree.FUNC_TYPE_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 820:
+	case 874:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IntervalType
+//line mysql_sql.y:5024
+		{
+			yyLOCAL = tree.INTERVAL_TYPE_MICROSECOND
+		}
+		yyVAL.union = yyLOCAL
+	case 875:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IntervalType
-//line mysql_sql.y:4702
+//line mysql_sql.y:5028
 		{
 			yyLOCAL = tree.INTERVAL_TYPE_SECOND
 		}
 		yyVAL.union = yyLOCAL
-	case 821:
+	case 876:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IntervalType
+//line mysql_sql.y:5032
+		{
+			yyLOCAL = tree.INTERVAL_TYPE_MINUTE
+		}
+		yyVAL.union = yyLOCAL
+	case 877:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IntervalType
+//line mysql_sql.y:5036
+		{
+			yyLOCAL = tree.INTERVAL_TYPE_HOUR
+		}
+		yyVAL.union = yyLOCAL
+	case 878:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IntervalType
+//line mysql_sql.y:5040
+		{
+			yyLOCAL = tree.INTERVAL_TYPE_DAY
+		}
+		yyVAL.union = yyLOCAL
+	case 879:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IntervalType
+//line mysql_sql.y:5044
+		{
+			yyLOCAL = tree.INTERVAL_TYPE_WEEK
+		}
+		yyVAL.union = yyLOCAL
+	case 880:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IntervalType
+//line mysql_sql.y:5048
+		{
+			yyLOCAL = tree.INTERVAL_TYPE_MONTH
+		}
+		yyVAL.union = yyLOCAL
+	case 881:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IntervalType
+//line mysql_sql.y:5052
+		{
+			yyLOCAL = tree.INTERVAL_TYPE_QUARTER
+		}
+		yyVAL.union = yyLOCAL
+	case 882:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IntervalType
+//line mysql_sql.y:5056
+		{
+			yyLOCAL = tree.INTERVAL_TYPE_YEAR
+		}
+		yyVAL.union = yyLOCAL
+	case 883:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Tuple
-//line mysql_sql.y:4708
+//line mysql_sql.y:5062
 		{
 			yyLOCAL = tree.NewTuple(yyDollar[2].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 822:
+	case 884:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4713
+//line mysql_sql.y:5067
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 823:
+	case 885:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4717
+//line mysql_sql.y:5071
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 824:
+	case 886:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4723
+//line mysql_sql.y:5077
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 825:
+	case 887:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4727
+//line mysql_sql.y:5081
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 826:
+	case 888:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4734
+//line mysql_sql.y:5088
 		{
 			yyLOCAL = tree.NewAndExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 827:
+	case 889:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4738
+//line mysql_sql.y:5092
 		{
 			yyLOCAL = tree.NewOrExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 828:
+	case 890:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4742
+//line mysql_sql.y:5096
 		{
 			yyLOCAL = tree.NewXorExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 829:
+	case 891:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4746
+//line mysql_sql.y:5100
 		{
 			yyLOCAL = tree.NewNotExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 830:
+	case 892:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4750
+//line mysql_sql.y:5104
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 831:
+	case 893:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4756
+//line mysql_sql.y:5110
 		{
 			yyLOCAL = tree.NewIsNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 832:
+	case 894:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4760
+//line mysql_sql.y:5114
 		{
 			yyLOCAL = tree.NewIsNotNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 833:
+	case 895:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4764
+//line mysql_sql.y:5118
 		{
 			yyLOCAL = tree.NewComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 835:
+	case 897:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4771
+//line mysql_sql.y:5125
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.IN, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 836:
+	case 898:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4775
+//line mysql_sql.y:5129
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_IN, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 837:
+	case 899:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4779
+//line mysql_sql.y:5133
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.LIKE, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 838:
+	case 900:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4783
+//line mysql_sql.y:5137
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.NOT_LIKE, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 839:
+	case 901:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4787
+//line mysql_sql.y:5141
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.REG_MATCH, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 840:
+	case 902:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4791
+//line mysql_sql.y:5145
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_REG_MATCH, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 841:
+	case 903:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4795
+//line mysql_sql.y:5149
 		{
 			yyLOCAL = tree.NewRangeCond(false, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 842:
+	case 904:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4799
+//line mysql_sql.y:5153
 		{
 			yyLOCAL = tree.NewRangeCond(true, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[6].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 844:
+	case 906:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4805
+//line mysql_sql.y:5159
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 845:
+	case 907:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4809
+//line mysql_sql.y:5163
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 846:
+	case 908:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4815
+//line mysql_sql.y:5169
 		{
 			yyLOCAL = yyDollar[1].tupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 847:
+	case 909:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4819
+//line mysql_sql.y:5173
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 848:
+	case 910:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:4826
+//line mysql_sql.y:5180
 		{
 			yyLOCAL = tree.EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 849:
+	case 911:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:4830
+//line mysql_sql.y:5184
 		{
 			yyLOCAL = tree.LESS_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 850:
+	case 912:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:4834
+//line mysql_sql.y:5188
 		{
 			yyLOCAL = tree.GREAT_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 851:
+	case 913:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:4838
+//line mysql_sql.y:5192
 		{
 			yyLOCAL = tree.LESS_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 852:
+	case 914:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:4842
+//line mysql_sql.y:5196
 		{
 			yyLOCAL = tree.GREAT_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 853:
+	case 915:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:4846
+//line mysql_sql.y:5200
 		{
 			yyLOCAL = tree.NOT_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 854:
+	case 916:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4853
+//line mysql_sql.y:5207
 		{
 			yyLOCAL = tree.NewAttributePrimaryKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 855:
+	case 917:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4857
+//line mysql_sql.y:5211
 		{
 			yyLOCAL = tree.NewAttributeUniqueKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 856:
+	case 918:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4861
+//line mysql_sql.y:5215
 		{
 			yyLOCAL = tree.NewAttributeUnique()
 		}
 		yyVAL.union = yyLOCAL
-	case 857:
+	case 919:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4865
+//line mysql_sql.y:5219
 		{
 			yyLOCAL = tree.NewAttributeKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 858:
+	case 920:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.NumVal
-//line mysql_sql.y:4871
+//line mysql_sql.y:5225
 		{
 			ival, errStr := util.GetInt64(yyDollar[1].item)
 			if errStr != "" {
@@ -10868,96 +11566,106 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 859:
+	case 921:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4886
+//line mysql_sql.y:5240
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 860:
+	case 922:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4890
+//line mysql_sql.y:5244
 		{
 			ival := util.GetUint64(yyDollar[1].item)
 			yyLOCAL = tree.NewNumVal(constant.MakeUint64(ival), yylex.(*Lexer).scanner.LastToken, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 861:
+	case 923:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4895
+//line mysql_sql.y:5249
 		{
 			fval := yyDollar[1].item.(float64)
 			yyLOCAL = tree.NewNumValWithResFoalt(constant.MakeFloat64(fval), yylex.(*Lexer).scanner.LastToken, false, fval)
 		}
 		yyVAL.union = yyLOCAL
-	case 862:
+	case 924:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4900
+//line mysql_sql.y:5254
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeBool(true), "", false)
 		}
 		yyVAL.union = yyLOCAL
-	case 863:
+	case 925:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4904
+//line mysql_sql.y:5258
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeBool(false), "", false)
 		}
 		yyVAL.union = yyLOCAL
-	case 864:
+	case 926:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4908
+//line mysql_sql.y:5262
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeUnknown(), "", false)
 		}
 		yyVAL.union = yyLOCAL
-	case 865:
+	case 927:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4912
+//line mysql_sql.y:5266
 		{
 			ival := util.GetUint64(yyDollar[1].item)
 			yyLOCAL = tree.NewNumVal(constant.MakeUint64(ival), yylex.(*Lexer).scanner.LastToken, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 866:
+	case 928:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:5271
+		{
+			// the scanner names the placeholders as :v1, :v2, ...
+			offset, _ := strconv.Atoi(yyDollar[1].str[2:])
+			yyLOCAL = tree.NewParamExpr(offset)
+		}
+		yyVAL.union = yyLOCAL
+	case 929:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4922
+//line mysql_sql.y:5281
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.Unsigned = yyDollar[2].unsignedOptUnion()
 			yyLOCAL.InternalType.Zerofill = yyDollar[3].zeroFillOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 870:
+	case 933:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4933
+//line mysql_sql.y:5292
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.DisplayWith = yyDollar[2].lengthOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 871:
+	case 934:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4938
+//line mysql_sql.y:5297
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 872:
+	case 935:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4944
+//line mysql_sql.y:5303
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10970,10 +11678,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 873:
+	case 936:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4956
+//line mysql_sql.y:5315
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10986,10 +11694,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 874:
+	case 937:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4968
+//line mysql_sql.y:5327
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11002,10 +11710,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 875:
+	case 938:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4980
+//line mysql_sql.y:5339
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11019,10 +11727,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 876:
+	case 939:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4993
+//line mysql_sql.y:5352
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11036,10 +11744,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 877:
+	case 940:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5006
+//line mysql_sql.y:5365
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11053,10 +11761,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 878:
+	case 941:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5019
+//line mysql_sql.y:5378
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11070,10 +11778,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 879:
+	case 942:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5032
+//line mysql_sql.y:5391
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11087,10 +11795,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 880:
+	case 943:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5045
+//line mysql_sql.y:5404
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11104,10 +11812,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 881:
+	case 944:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5058
+//line mysql_sql.y:5417
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11121,10 +11829,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 882:
+	case 945:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5071
+//line mysql_sql.y:5430
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11138,10 +11846,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 883:
+	case 946:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5084
+//line mysql_sql.y:5443
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11155,10 +11863,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 884:
+	case 947:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5097
+//line mysql_sql.y:5456
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11172,10 +11880,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 885:
+	case 948:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5110
+//line mysql_sql.y:5469
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11189,10 +11897,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 886:
+	case 949:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5125
+//line mysql_sql.y:5484
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().DisplayWith > 255 {
@@ -11216,10 +11924,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 887:
+	case 950:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5148
+//line mysql_sql.y:5507
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().Precision != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Precision > yyDollar[2].lengthScaleOptUnion().DisplayWith {
@@ -11256,10 +11964,56 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 888:
+	case 951:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5196
+//line mysql_sql.y:5543
+		{
+			locale := ""
+			if yyDollar[2].lengthScaleOptUnion().Precision != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Precision > yyDollar[2].lengthScaleOptUnion().DisplayWith {
+				yylex.Error("For float(M,D), double(M,D) or decimal(M,D), M must be >= D (column 'a'))")
+				return 1
+			}
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.DecimalFamily,
+					FamilyString: yyDollar[1].str,
+					Width:        64,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_DECIMAL),
+					DisplayWith:  yyDollar[2].lengthScaleOptUnion().DisplayWith,
+					Precision:    yyDollar[2].lengthScaleOptUnion().Precision,
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 952:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.T
+//line mysql_sql.y:5562
+		{
+			locale := ""
+			if yyDollar[2].lengthScaleOptUnion().Precision != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Precision > yyDollar[2].lengthScaleOptUnion().DisplayWith {
+				yylex.Error("For float(M,D), double(M,D) or decimal(M,D), M must be >= D (column 'a'))")
+				return 1
+			}
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.DecimalFamily,
+					FamilyString: yyDollar[1].str,
+					Width:        64,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_DECIMAL),
+					DisplayWith:  yyDollar[2].lengthScaleOptUnion().DisplayWith,
+					Precision:    yyDollar[2].lengthScaleOptUnion().Precision,
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 953:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.T
+//line mysql_sql.y:5581
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11275,10 +12029,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 889:
+	case 954:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5213
+//line mysql_sql.y:5598
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11291,10 +12045,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 890:
+	case 955:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5225
+//line mysql_sql.y:5610
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11310,10 +12064,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 891:
+	case 956:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5240
+//line mysql_sql.y:5625
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11329,10 +12083,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 892:
+	case 957:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5255
+//line mysql_sql.y:5640
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11348,10 +12102,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 893:
+	case 958:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5270
+//line mysql_sql.y:5655
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11366,10 +12120,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 894:
+	case 959:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5286
+//line mysql_sql.y:5671
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11383,10 +12137,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 895:
+	case 960:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5299
+//line mysql_sql.y:5684
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11400,10 +12154,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 896:
+	case 961:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5312
+//line mysql_sql.y:5697
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11417,10 +12171,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 897:
+	case 962:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5325
+//line mysql_sql.y:5710
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11434,10 +12188,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 898:
+	case 963:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5338
+//line mysql_sql.y:5723
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11450,10 +12204,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 899:
+	case 964:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5350
+//line mysql_sql.y:5735
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11466,10 +12220,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 900:
+	case 965:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5362
+//line mysql_sql.y:5747
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11482,10 +12236,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 901:
+	case 966:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5374
+//line mysql_sql.y:5759
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11498,10 +12252,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 902:
+	case 967:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5386
+//line mysql_sql.y:5771
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11514,10 +12268,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 903:
+	case 968:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5398
+//line mysql_sql.y:5783
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11530,10 +12284,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 904:
+	case 969:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5410
+//line mysql_sql.y:5795
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11546,10 +12300,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 905:
+	case 970:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5422
+//line mysql_sql.y:5807
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11562,10 +12316,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 906:
+	case 971:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5434
+//line mysql_sql.y:5819
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11578,10 +12332,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 907:
+	case 972:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5446
+//line mysql_sql.y:5831
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11595,10 +12349,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 908:
+	case 973:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5459
+//line mysql_sql.y:5844
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11612,10 +12366,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 909:
+	case 974:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5474
+//line mysql_sql.y:5859
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11628,59 +12382,59 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 910:
+	case 975:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5497
+//line mysql_sql.y:5882
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 911:
+	case 976:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5502
+//line mysql_sql.y:5887
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 912:
+	case 977:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5508
+//line mysql_sql.y:5893
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 914:
+	case 979:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5514
+//line mysql_sql.y:5899
 		{
 			yyLOCAL = -1
 		}
 		yyVAL.union = yyLOCAL
-	case 915:
+	case 980:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5518
+//line mysql_sql.y:5903
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 916:
+	case 981:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5524
+//line mysql_sql.y:5909
 		{
 			yyLOCAL = tree.GetDisplayWith(int32(yyDollar[2].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 917:
+	case 982:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5530
+//line mysql_sql.y:5915
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.NotDefineDisplayWidth,
@@ -11688,10 +12442,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 918:
+	case 983:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5537
+//line mysql_sql.y:5922
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -11699,10 +12453,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 919:
+	case 984:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5546
+//line mysql_sql.y:5931
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.NotDefineDisplayWidth,
@@ -11710,10 +12464,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 920:
+	case 985:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5553
+//line mysql_sql.y:5938
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -11721,10 +12475,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 921:
+	case 986:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5560
+//line mysql_sql.y:5945
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -11732,52 +12486,52 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 922:
+	case 987:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5569
+//line mysql_sql.y:5954
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 923:
+	case 988:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5573
+//line mysql_sql.y:5958
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 924:
+	case 989:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5577
+//line mysql_sql.y:5962
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 925:
+	case 990:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:5583
+//line mysql_sql.y:5968
 		{
 		}
-	case 926:
+	case 991:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5585
+//line mysql_sql.y:5970
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 930:
+	case 995:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:5595
+//line mysql_sql.y:5980
 		{
 			yyVAL.str = ""
 		}
-	case 931:
+	case 996:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:5599
+//line mysql_sql.y:5984
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
@@ -16,6 +16,7 @@
 package mysql
     
 import (
+    "strconv"
     "strings"
     "go/constant"
 
@@ -42,6 +43,8 @@ import (
     columnTableDef *tree.ColumnTableDef
     tableOption tree.TableOption
     tableOptions []tree.TableOption
+    alterTableOption tree.AlterTableOption
+    alterTableOptions []tree.AlterTableOption
     tableExprs tree.TableExprs
     tableExpr tree.TableExpr
     rowFormatType tree.RowFormatType
@@ -55,6 +58,10 @@ import (
     direction tree.Direction
     orderBy tree.OrderBy
     order *tree.Order
+    windowSpec *tree.WindowSpec
+    frameClause *tree.FrameClause
+    frameBound *tree.FrameBound
+    frameType tree.FrameType
     limit *tree.Limit
     unionTypeRecord *tree.UnionTypeRecord
     parenTableExpr *tree.ParenTableExpr
@@ -116,6 +123,8 @@ import (
     numVal *tree.NumVal
     subPartition *tree.SubPartition
     subPartitions []*tree.SubPartition
+    when *tree.When
+    whens []*tree.When
 
     subquery *tree.Subquery
     intervalType tree.IntervalType
@@ -157,13 +166,14 @@ import (
 }
 
 %token LEX_ERROR
-%left <str> UNION
+%left <str> UNION INTERSECT EXCEPT
 %token <str> SELECT STREAM INSERT UPDATE DELETE FROM WHERE GROUP HAVING ORDER BY LIMIT OFFSET FOR
 %token <str> ALL DISTINCT DISTINCTROW AS EXISTS ASC DESC INTO DUPLICATE DEFAULT SET LOCK KEYS
 %token <str> VALUES LAST_INSERT_ID
 %token <str> NEXT VALUE SHARE MODE
 %token <str> SQL_NO_CACHE SQL_CACHE
 %left <str> JOIN STRAIGHT_JOIN LEFT RIGHT INNER OUTER CROSS NATURAL USE FORCE
+%token <str> FULL_LA INTERVAL_LA
 %left <str> ON USING
 %left <str> SUBQUERY_AS_EXPR
 %left <str> '(' ',' ')'
@@ -255,7 +265,7 @@ import (
 
 // SET tokens
 %token <str> NAMES GLOBAL SESSION ISOLATION LEVEL READ WRITE ONLY REPEATABLE COMMITTED UNCOMMITTED SERIALIZABLE
-%token <str> LOCAL EXCEPT
+%token <str> LOCAL
 
 // Functions
 %token <str> CURRENT_TIMESTAMP DATABASE
@@ -278,6 +288,9 @@ import (
 // Insert
 %token <str> ROW OUTFILE HEADER MAX_FILE_SIZE FORCE_QUOTE
 
+// Window
+%token <str> OVER ROWS CURRENT UNBOUNDED PRECEDING FOLLOWING
+
 %token <str> UNUSED
 
 %type <statement> stmt
@@ -286,11 +299,13 @@ import (
 %type <statement> drop_ddl_stmt drop_database_stmt drop_table_stmt drop_index_stmt
 %type <statement> drop_role_stmt drop_user_stmt
 %type <statement> create_user_stmt create_role_stmt
-%type <statement> create_ddl_stmt create_table_stmt create_database_stmt create_index_stmt
+%type <statement> create_ddl_stmt create_table_stmt create_database_stmt create_index_stmt create_view_stmt
 %type <statement> show_stmt show_create_stmt show_columns_stmt show_databases_stmt
 %type <statement> show_tables_stmt show_process_stmt show_errors_stmt show_warnings_stmt
 %type <statement> show_variables_stmt show_status_stmt show_index_stmt
-%type <statement> alter_user_stmt update_stmt use_stmt
+%type <statement> alter_user_stmt alter_table_stmt update_stmt use_stmt
+%type <alterTableOption> alter_table_spec
+%type <alterTableOptions> alter_table_spec_list
 %type <statement> transaction_stmt begin_stmt commit_stmt rollback_stmt
 %type <statement> explain_stmt explainable_stmt
 %type <statement> set_stmt set_variable_stmt set_password_stmt set_role_stmt set_default_role_stmt
@@ -310,7 +325,7 @@ import (
 %type <orderBy> order_list order_by_clause order_by_opt
 %type <limit> limit_opt limit_clause
 %type <str> insert_column
-%type <identifierList> column_list partition_clause_opt partition_id_list insert_column_list
+%type <identifierList> column_list column_list_opt partition_clause_opt partition_id_list insert_column_list
 %type <joinCond> join_condition join_condition_opt on_expression_opt
 
 %type <tableDefs> table_elem_list_opt table_elem_list
@@ -345,6 +360,11 @@ import (
 %type <funcExpr> function_call_keyword
 %type <funcExpr> function_call_nonkeyword
 %type <funcExpr> function_call_aggregate
+%type <windowSpec> window_spec_opt
+%type <exprs> window_partition_by_opt
+%type <frameClause> window_frame_opt
+%type <frameBound> window_frame_bound
+%type <frameType> window_frame_units
 
 %type <unresolvedName> column_name column_name_unresolved
 %type <strs> enum_values force_quote_opt force_quote_list
@@ -352,7 +372,7 @@ import (
 %type <str> not_keyword
 %type <str> reserved_keyword non_reserved_keyword
 %type <str> equal_opt reserved_sql_id reserved_table_id
-%type <str> as_name_opt as_opt_id table_id id_or_var name_string ident
+%type <str> as_name_opt as_opt_id table_id id_or_var name_string ident data_format_opt
 %type <str> database_id table_alias explain_sym
 %type <unresolvedObjectName> unresolved_object_name table_column_name
 %type <unresolvedObjectName> table_name_unresolved
@@ -365,6 +385,9 @@ import (
 %type <expr> literal
 %type <expr> predicate
 %type <expr> bit_expr interval_expr
+%type <expr> expression_opt else_opt
+%type <when> when_clause
+%type <whens> when_clause_list
 %type <expr> simple_expr
 %type <expr> expression like_escape_opt boolean_primary col_tuple
 %type <exprs> expression_list_opt
@@ -396,7 +419,7 @@ import (
 %type <updateExpr> update_expression
 %type <updateExprs> update_list
 %type <completionType> completion_type
-%type <str> id_prefix_at password_opt
+%type <str> password_opt
 %type <boolVal> grant_option_opt enforce enforce_opt
 
 %type <varAssignmentExpr> var_assignment
@@ -509,19 +532,34 @@ stmt:
     }
 
 load_data_stmt:
-    LOAD DATA local_opt INFILE STRING duplicate_opt INTO TABLE table_name load_fields load_lines ignore_lines columns_or_variable_list_opt load_set_spec_opt
+    LOAD DATA local_opt INFILE STRING data_format_opt duplicate_opt INTO TABLE table_name load_fields load_lines ignore_lines columns_or_variable_list_opt load_set_spec_opt
     {
         $$ = &tree.Load{
             Local: $3,
             File: $5,
-            DuplicateHandling: $6,
-            Table: $9,
-            Fields: $10,
-            Lines: $11,
-            IgnoredLines: uint64($12),
-            ColumnList: $13,
-            Assignments: $14,
+            DataFormat: tree.DataFormat($6),
+            DuplicateHandling: $7,
+            Table: $10,
+            Fields: $11,
+            Lines: $12,
+            IgnoredLines: uint64($13),
+            ColumnList: $14,
+            Assignments: $15,
+        }
+    }
+
+data_format_opt:
+    {
+        $$ = ""
+    }
+|   FORMAT ident
+    {
+        f, ok := tree.NewDataFormat($2)
+        if !ok {
+            yylex.Error("unknown data format " + $2)
+            return 1
         }
+        $$ = string(f)
     }
 
 load_set_spec_opt:
@@ -1241,7 +1279,14 @@ var_assignment:
             Value: $4,
         }
     }
-|   id_prefix_at equal_or_assignment set_expr
+|   AT_ID equal_or_assignment set_expr
+    {
+        $$ = &tree.VarAssignmentExpr{
+            Name: $1,
+            Value: $3,
+        }
+    }
+|   AT_AT_ID equal_or_assignment set_expr
     {
     	vs := strings.Split($1, ".")
         var isGlobal bool
@@ -1267,6 +1312,7 @@ var_assignment:
 |   NAMES charset_name
     {
         $$ = &tree.VarAssignmentExpr{
+            System: true,
             Name: $1,
             Value: tree.NewNumVal(constant.MakeString($2), $2, false),
         }
@@ -1274,6 +1320,7 @@ var_assignment:
 |   NAMES charset_name COLLATE DEFAULT
     {
         $$ = &tree.VarAssignmentExpr{
+            System: true,
             Name: $1,
             Value: tree.NewNumVal(constant.MakeString($2), $2, false),
         }
@@ -1281,6 +1328,7 @@ var_assignment:
 |   NAMES charset_name COLLATE name_string
     {
         $$ = &tree.VarAssignmentExpr{
+            System: true,
             Name: $1,
             Value: tree.NewNumVal(constant.MakeString($2), $2, false),
             Reserved: tree.NewNumVal(constant.MakeString($4), $4, false),
@@ -1289,6 +1337,7 @@ var_assignment:
 |   NAMES DEFAULT
     {
         $$ = &tree.VarAssignmentExpr{
+            System: true,
             Name: $1,
             Value: &tree.DefaultVal{},
         }
@@ -1296,6 +1345,7 @@ var_assignment:
 |   charset_keyword charset_name
     {
         $$ = &tree.VarAssignmentExpr{
+            System: true,
             Name: $1,
             Value: tree.NewNumVal(constant.MakeString($2), $2, false),
         }
@@ -1303,15 +1353,12 @@ var_assignment:
 |   charset_keyword DEFAULT
     {
         $$ = &tree.VarAssignmentExpr{
+            System: true,
             Name: $1,
             Value: &tree.DefaultVal{},
         }
     }
 
-id_prefix_at:
-    AT_ID
-|   AT_AT_ID
-
 set_expr:
     ON
     {
@@ -1455,7 +1502,7 @@ update_list:
     }
 
 update_expression:
-    column_name '=' expression
+    column_name '=' expr_or_default
     {
         $$ = &tree.UpdateExpr{Names: []*tree.UnresolvedName{$1}, Expr: $3}
     }
@@ -1514,7 +1561,61 @@ analyze_stmt:
 
 alter_stmt:
     alter_user_stmt
-// |    alter_ddl_stmt
+|   alter_table_stmt
+
+alter_table_stmt:
+    ALTER TABLE table_name alter_table_spec_list
+    {
+        $$ = tree.NewAlterTable(*$3, $4)
+    }
+
+alter_table_spec_list:
+    alter_table_spec
+    {
+        $$ = []tree.AlterTableOption{$1}
+    }
+|   alter_table_spec_list ',' alter_table_spec
+    {
+        $$ = append($1, $3)
+    }
+
+alter_table_spec:
+    ADD column_keyword_opt column_def
+    {
+        $$ = &tree.AlterTableAddColumn{Column: $3}
+    }
+|   DROP column_keyword_opt ident
+    {
+        $$ = &tree.AlterTableDropColumn{Name: tree.Identifier($3)}
+    }
+|   RENAME COLUMN ident TO ident
+    {
+        $$ = &tree.AlterTableRenameColumn{Name: tree.Identifier($3), NewName: tree.Identifier($5)}
+    }
+|   ALTER column_keyword_opt ident SET DEFAULT bit_expr
+    {
+        $$ = &tree.AlterTableColumnDefault{Name: tree.Identifier($3), Expr: $6}
+    }
+|   ALTER column_keyword_opt ident DROP DEFAULT
+    {
+        $$ = &tree.AlterTableColumnDefault{Name: tree.Identifier($3)}
+    }
+|   RENAME to_or_as_opt table_name
+    {
+        $$ = &tree.AlterTableRename{Name: *$3}
+    }
+
+column_keyword_opt:
+    {}
+|   COLUMN
+    {}
+
+to_or_as_opt:
+    {}
+|   TO
+    {}
+|   AS
+    {}
 
 alter_user_stmt:
     ALTER USER exists_opt user_spec_list require_clause_opt conn_options pwd_or_lck_opt
@@ -2065,16 +2166,17 @@ export_data_param_opt:
     {
         $$ = nil
     }
-|   INTO OUTFILE STRING export_fields export_lines_opt header_opt max_file_size_opt force_quote_opt
+|   INTO OUTFILE STRING data_format_opt export_fields export_lines_opt header_opt max_file_size_opt force_quote_opt
     {
         $$ = &tree.ExportParam{
             Outfile:    true,
             FilePath :  $3,
-            Fields:     $4,
-            Lines:      $5,
-            Header:     $6,
-            MaxFileSize:uint64($7)*1024,
-            ForceQuote: $8,
+            DataFormat: tree.DataFormat($4),
+            Fields:     $5,
+            Lines:      $6,
+            Header:     $7,
+            MaxFileSize:uint64($8)*1024,
+            ForceQuote: $9,
         }
     }
 
@@ -2359,6 +2461,54 @@ union_op:
             Distinct: true,
         }
     }
+|   INTERSECT
+    {
+        $$ = &tree.UnionTypeRecord{
+            Type: tree.INTERSECT,
+            All: false,
+            Distinct: false,
+        }
+    }
+|   INTERSECT ALL
+    {
+        $$ = &tree.UnionTypeRecord{
+            Type: tree.INTERSECT,
+            All: true,
+            Distinct: false,
+        }
+    }
+|   INTERSECT DISTINCT
+    {
+        $$ = &tree.UnionTypeRecord{
+            Type: tree.INTERSECT,
+            All: false,
+            Distinct: true,
+        }
+    }
+|   EXCEPT
+    {
+        $$ = &tree.UnionTypeRecord{
+            Type: tree.EXCEPT,
+            All: false,
+            Distinct: false,
+        }
+    }
+|   EXCEPT ALL
+    {
+        $$ = &tree.UnionTypeRecord{
+            Type: tree.EXCEPT,
+            All: true,
+            Distinct: false,
+        }
+    }
+|   EXCEPT DISTINCT
+    {
+        $$ = &tree.UnionTypeRecord{
+            Type: tree.EXCEPT,
+            All: false,
+            Distinct: true,
+        }
+    }
 
 simple_select_clause:
     SELECT distinct_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt
@@ -2526,10 +2676,13 @@ natural_join:
     }
 |   NATURAL outer_join
     {
-        if $2 == tree.JOIN_TYPE_LEFT {
+        switch $2 {
+        case tree.JOIN_TYPE_LEFT:
             $$ = tree.JOIN_TYPE_NATURAL_LEFT
-        } else {
+        case tree.JOIN_TYPE_RIGHT:
             $$ = tree.JOIN_TYPE_NATURAL_RIGHT
+        default:
+            $$ = tree.JOIN_TYPE_NATURAL_FULL
         }
     }
 
@@ -2550,6 +2703,14 @@ outer_join:
     {
         $$ = tree.JOIN_TYPE_RIGHT
     }
+|   FULL_LA JOIN
+    {
+        $$ = tree.JOIN_TYPE_FULL
+    }
+|   FULL_LA OUTER JOIN
+    {
+        $$ = tree.JOIN_TYPE_FULL
+    }
 
 on_expression_opt:
     %prec JOIN
@@ -2601,6 +2762,15 @@ join_condition:
         $$ = &tree.UsingJoinCond{Cols: $3}
     }
 
+column_list_opt:
+    {
+        $$ = nil
+    }
+|   '(' column_list ')'
+    {
+        $$ = $2
+    }
+
 column_list:
     ident
     {
@@ -2616,12 +2786,13 @@ table_factor:
     {
         $$ = $1
     }
-|   derived_table as_opt table_id
+|   derived_table as_opt table_id column_list_opt
     {
         $$ = &tree.AliasedTableExpr{
             Expr: $1,
             As: tree.AliasClause{
                 Alias: tree.Identifier($3),
+                Cols: $4,
             },
         }
     }
@@ -2702,6 +2873,18 @@ create_ddl_stmt:
     create_table_stmt
 |   create_database_stmt
 |   create_index_stmt
+|   create_view_stmt
+
+create_view_stmt:
+    CREATE VIEW not_exists_opt table_name column_list_opt AS select_stmt
+    {
+        $$ = &tree.CreateView{
+            Name: $4,
+            ColNames: $5,
+            AsSource: $7,
+            IfNotExists: $3,
+        }
+    }
 
 create_user_stmt:
     CREATE USER not_exists_opt user_spec_list require_clause_opt conn_options
@@ -4138,6 +4321,10 @@ simple_expr:
     {
         $$ = $1
     }
+|   CASE expression_opt when_clause_list else_opt END
+    {
+        $$ = tree.NewCaseExpr($2, $3, $4)
+    }
 |   subquery
     {
         $$ = $1
@@ -4164,8 +4351,9 @@ simple_expr:
             Exprs: tree.Exprs{$3, es},
         }
     }
-|   function_call_generic
+|   function_call_generic window_spec_opt
     {
+        $1.WindowSpec = $2
         $$ = $1
     }
 |   function_call_keyword
@@ -4176,8 +4364,9 @@ simple_expr:
     {
         $$ = $1
     }
-|   function_call_aggregate
+|   function_call_aggregate window_spec_opt
     {
+        $1.WindowSpec = $2
         $$ = $1
     }
 
@@ -4433,6 +4622,71 @@ function_call_aggregate:
         }
     }
 
+window_spec_opt:
+    {
+        $$ = nil
+    }
+|   OVER '(' window_partition_by_opt order_by_opt window_frame_opt ')'
+    {
+        $$ = tree.NewWindowSpec($3, $4, $5)
+    }
+
+window_partition_by_opt:
+    {
+        $$ = nil
+    }
+|   PARTITION BY expression_list
+    {
+        $$ = $3
+    }
+
+window_frame_opt:
+    {
+        $$ = nil
+    }
+|   window_frame_units window_frame_bound
+    {
+        $$ = tree.NewFrameClause($1, $2, tree.NewFrameBound(tree.FRAME_CURRENT_ROW, nil))
+    }
+|   window_frame_units BETWEEN window_frame_bound AND window_frame_bound
+    {
+        $$ = tree.NewFrameClause($1, $3, $5)
+    }
+
+window_frame_units:
+    ROWS
+    {
+        $$ = tree.FRAME_ROWS
+    }
+|   RANGE
+    {
+        $$ = tree.FRAME_RANGE
+    }
+
+window_frame_bound:
+    UNBOUNDED PRECEDING
+    {
+        $$ = tree.NewFrameBound(tree.FRAME_UNBOUNDED_PRECEDING, nil)
+    }
+|   UNBOUNDED FOLLOWING
+    {
+        $$ = tree.NewFrameBound(tree.FRAME_UNBOUNDED_FOLLOWING, nil)
+    }
+|   CURRENT ROW
+    {
+        $$ = tree.NewFrameBound(tree.FRAME_CURRENT_ROW, nil)
+    }
+|   INTEGRAL PRECEDING
+    {
+        ival := util.GetUint64($1)
+        $$ = tree.NewFrameBound(tree.FRAME_PRECEDING, tree.NewNumVal(constant.MakeUint64(ival), strconv.FormatUint(ival, 10), false))
+    }
+|   INTEGRAL FOLLOWING
+    {
+        ival := util.GetUint64($1)
+        $$ = tree.NewFrameBound(tree.FRAME_FOLLOWING, tree.NewNumVal(constant.MakeUint64(ival), strconv.FormatUint(ival, 10), false))
+    }
+
 std_dev_pop:
     STD
 |   STDDEV
@@ -4455,6 +4709,32 @@ function_call_generic:
                 Exprs: $3,
             }
     }
+|   SUBSTRING '(' expression FROM expression ')'
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$3, $5},
+        }
+    }
+|   SUBSTRING '(' expression FROM expression FOR expression ')'
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$3, $5, $7},
+        }
+    }
+|   EXTRACT '(' interval_type FROM expression ')'
+    {
+        t := $3
+        unit := t.ToString()
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{tree.NewNumVal(constant.MakeString(unit), unit, false), $5},
+        }
+    }
 |   SUBSTR '(' expression_list_opt ')'
     {
                 name := tree.SetUnresolvedName(strings.ToLower($1))
@@ -4683,6 +4963,48 @@ interval_expr:
             Type: $3,
         }
     }
+|   INTERVAL_LA STRING
+    {
+        // the unit is in the string, such as interval '1 year'
+        $$ = &tree.IntervalExpr{
+            Expr: tree.NewNumVal(constant.MakeString($2), $2, false),
+            Type: tree.INTERVAL_TYPE_INVALID,
+        }
+    }
+
+expression_opt:
+    {
+        $$ = nil
+    }
+|   expression
+    {
+        $$ = $1
+    }
+
+when_clause_list:
+    when_clause
+    {
+        $$ = []*tree.When{$1}
+    }
+|   when_clause_list when_clause
+    {
+        $$ = append($1, $2)
+    }
+
+when_clause:
+    WHEN expression THEN expression
+    {
+        $$ = tree.NewWhen($2, $4)
+    }
+
+else_opt:
+    {
+        $$ = nil
+    }
+|   ELSE expression
+    {
+        $$ = $2
+    }
 
 func_type_opt:
     {
@@ -4698,10 +5020,42 @@ func_type_opt:
     }
 
 interval_type:
-    SECOND
+    MICROSECOND
+    {
+        $$ = tree.INTERVAL_TYPE_MICROSECOND
+    }
+|   SECOND
     {
         $$ = tree.INTERVAL_TYPE_SECOND
     }
+|   MINUTE
+    {
+        $$ = tree.INTERVAL_TYPE_MINUTE
+    }
+|   HOUR
+    {
+        $$ = tree.INTERVAL_TYPE_HOUR
+    }
+|   DAY
+    {
+        $$ = tree.INTERVAL_TYPE_DAY
+    }
+|   WEEK
+    {
+        $$ = tree.INTERVAL_TYPE_WEEK
+    }
+|   MONTH
+    {
+        $$ = tree.INTERVAL_TYPE_MONTH
+    }
+|   QUARTER
+    {
+        $$ = tree.INTERVAL_TYPE_QUARTER
+    }
+|   YEAR
+    {
+        $$ = tree.INTERVAL_TYPE_YEAR
+    }
 
 tuple_expression:
     '(' expression_list ')'
@@ -4913,9 +5267,14 @@ literal:
 		ival := util.GetUint64($1)
 		$$ = tree.NewNumVal(constant.MakeUint64(ival), yylex.(*Lexer).scanner.LastToken, false)
 	}
+|   VALUE_ARG
+    {
+        // the scanner names the placeholders as :v1, :v2, ...
+        offset, _ := strconv.Atoi($1[2:])
+        $$ = tree.NewParamExpr(offset)
+    }
 // |   HEX
 // |   BIT_LITERAL
-// |   VALUE_ARG
 
 column_type:
     numeric_type unsigned_opt zero_fill_opt
@@ -5180,18 +5539,44 @@ decimal_type:
                 }
         }
     }
-// |   DECIMAL decimal_length_opt
-//     {
-//         $$ = tree.TYPE_DOUBLE
-//         $$.InternalType.DisplayWith = $2.DisplayWith
-//         $$.InternalType.Precision = $2.Precision
-//     }
-// |   NUMERIC decimal_length_opt
-//     {
-//         $$ = tree.TYPE_DOUBLE
-//         $$.InternalType.DisplayWith = $2.DisplayWith
-//         $$.InternalType.Precision = $2.Precision
-//     }
+|   DECIMAL decimal_length_opt
+    {
+        locale := ""
+        if $2.Precision != tree.NotDefineDec && $2.Precision > $2.DisplayWith {
+		yylex.Error("For float(M,D), double(M,D) or decimal(M,D), M must be >= D (column 'a'))")
+		return 1
+        }
+        $$ = &tree.T{
+            InternalType: tree.InternalType{
+		Family: tree.DecimalFamily,
+		FamilyString: $1,
+		Width:  64,
+		Locale: &locale,
+		Oid:    uint32(defines.MYSQL_TYPE_DECIMAL),
+		DisplayWith: $2.DisplayWith,
+		Precision: $2.Precision,
+	    },
+        }
+    }
+|   NUMERIC decimal_length_opt
+    {
+        locale := ""
+        if $2.Precision != tree.NotDefineDec && $2.Precision > $2.DisplayWith {
+		yylex.Error("For float(M,D), double(M,D) or decimal(M,D), M must be >= D (column 'a'))")
+		return 1
+        }
+        $$ = &tree.T{
+            InternalType: tree.InternalType{
+		Family: tree.DecimalFamily,
+		FamilyString: $1,
+		Width:  64,
+		Locale: &locale,
+		Oid:    uint32(defines.MYSQL_TYPE_DECIMAL),
+		DisplayWith: $2.DisplayWith,
+		Precision: $2.Precision,
+	    },
+        }
+    }
 |   REAL float_length_opt
     {
         locale := ""
@@ -5657,6 +6042,7 @@ reserved_keyword:
 |   END
 |   ESCAPE
 |   EXISTS
+|   EXCEPT
 |   EXPLAIN
 |   FALSE
 |   FOR
@@ -5674,6 +6060,7 @@ reserved_keyword:
 |   INDEX
 |   INNER
 |   INSERT
+|   INTERSECT
 |   INTERVAL
 |   INTO
 |   IS
@@ -5783,6 +6170,7 @@ non_reserved_keyword:
 |   COMPACT
 |   COLUMN_FORMAT
 |   CASCADE
+|   CURRENT
 |   DATA
 |   DATETIME
 |   DECIMAL
@@ -5798,13 +6186,13 @@ non_reserved_keyword:
 |   EXPANSION
 |   EXTENDED
 |   EXPIRE
-|   EXCEPT
 |   ERRORS
 |   ENFORCED
 |   FLOAT_TYPE
 |   FULL
 |   FIXED
 |   FIELDS
+|   FOLLOWING
 |   GEOMETRY
 |   GEOMETRYCOLLECTION
 |   GLOBAL
@@ -5856,6 +6244,7 @@ non_reserved_keyword:
 |   PARTITIONS
 |   POINT
 |   POLYGON
+|   PRECEDING
 |   PROCEDURE
 |   PROXY
 |   QUERY
@@ -5870,6 +6259,7 @@ non_reserved_keyword:
 |   REVOKE
 |   REPLICATION
 |   ROW_FORMAT
+|   ROWS
 |   ROLLBACK
 |   RESTRICT
 |   SESSION
@@ -5895,6 +6285,7 @@ non_reserved_keyword:
 |   TINYTEXT
 |   TRANSACTION
 |   TRIGGER
+|   UNBOUNDED
 |   UNCOMMITTED
 |   UNSIGNED
 |   UNUSED
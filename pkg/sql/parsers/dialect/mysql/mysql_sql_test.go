@@ -56,6 +56,35 @@ var (
 		input  string
 		output string
 	}{{
+		input: "select a, row_number() over (partition by b order by c desc) from t",
+	}, {
+		input:  "select sum(a) over (order by b rows 2 preceding), lag(a, 1) over (), count(*) over (partition by b) from t",
+		output: "select sum(a) over (order by b rows between 2 preceding and current row), lag(a, 1) over (), count(*) over (partition by b) from t",
+	}, {
+		input: "select avg(a) over (partition by b, c order by d range between unbounded preceding and unbounded following) as x from t",
+	}, {
+		input:  "select substring(c_phone from 1 for 2) from customer",
+		output: "select substring(c_phone, 1, 2) from customer",
+	}, {
+		input:  "select extract(year from o_orderdate) from orders",
+		output: "select extract(year, o_orderdate) from orders",
+	}, {
+		input: "select sum(case when a = 1 then b else 0 end), case a when 1 then 2 end from t",
+	}, {
+		input:  "select * from t where d < date '1997-07-01' + interval '3' month",
+		output: "select * from t where d < date(1997-07-01) + interval 3 month",
+	}, {
+		input:  "select * from t where d < date '1997-07-01' + interval '1 year' and e > interval '3' day",
+		output: "select * from t where d < date(1997-07-01) + interval 1 year and e > interval 3 day",
+	}, {
+		input: "select c_count from (select c_custkey, count(o_orderkey) from customer) as c_orders (c_custkey, c_count)",
+	}, {
+		input: "create view v (a, b) as select a, b from t",
+	}, {
+		input: "select a from t where a = ? and b > ? limit ?",
+	}, {
+		input: "insert into t values (?, ?), (1, ?)",
+	}, {
 		input: "select substr(name, 5) from t1",
 	}, {
 		input: "select substring(name, 5) from t1",
@@ -168,6 +197,15 @@ var (
 	}, {
 		input:  "load data infile 'data.txt' into table db.a",
 		output: "load data infile data.txt into table db.a",
+	}, {
+		input:  "load data local infile 'data.parquet' format PARQUET replace into table db.a (a, b)",
+		output: "load data local infile data.parquet format parquet replace into table db.a (a, b)",
+	}, {
+		input:  "load data infile 'data.json' format jsonlines into table db.a",
+		output: "load data infile data.json format jsonlines into table db.a",
+	}, {
+		input:  "load data infile 'data.txt' format csv into table db.a",
+		output: "load data infile data.txt into table db.a",
 	}, {
 		input:  "show tables from test01 where tables_in_test01 like '%t2%'",
 		output: "show tables from test01 where tables_in_test01 like %t2%",
@@ -397,8 +435,10 @@ var (
 		input:  "set password for u1@h1 = 'ppp'",
 		output: "set password for u1@h1 = ppp",
 	}, {
-		input:  "set @a = 0, @b = 1",
-		output: "set a = 0, b = 1",
+		input: "set @a = 0, @b = 1",
+	}, {
+		input:  "set @a = @@session.b, @@c = @d",
+		output: "set @a = @@b, c = @d",
 	}, {
 		input:  "set a = 0, session b = 1, @@session.c = 1, global d = 1, @@global.e = 1",
 		output: "set a = 0, b = 1, c = 1, global d = 1, global e = 1",
@@ -462,6 +502,15 @@ var (
 	}, {
 		input:  "alter user if exists user() identified by 'test'",
 		output: "alter user if exists user() identified by test",
+	}, {
+		input:  "alter table t add c int default 1, drop b, rename column a to d",
+		output: "alter table t add column c int default 1, drop column b, rename column a to d",
+	}, {
+		input:  "alter table db.t alter column a set default 10, alter b drop default",
+		output: "alter table db.t alter column a set default 10, alter column b drop default",
+	}, {
+		input:  "alter table t rename as t2",
+		output: "alter table t rename to t2",
 	}, {
 		input: "show index from t where true",
 	}, {
@@ -607,6 +656,10 @@ var (
 		input: "create table db.t (db.t.a int(20) null)",
 	}, {
 		input: "create table t (a float(20, 20) not null, b int(20) null, c int(30) null)",
+	}, {
+		input: "create table t (a decimal(10, 2) not null, b numeric(18) null, c decimal)",
+	}, {
+		input: "select cast(a as decimal(10, 2)) from t",
 	}, {
 		input: "create table t1 (t time(3) null, dt datetime(6) null, ts timestamp(1) null)",
 	}, {
@@ -631,6 +684,16 @@ var (
 		input: "select * from t union all select c from t1",
 	}, {
 		input: "select * from t union distinct select c from t1",
+	}, {
+		input: "select a from t intersect select c from t1",
+	}, {
+		input: "select a from t intersect all select c from t1",
+	}, {
+		input: "select a from t except select c from t1",
+	}, {
+		input: "select a from t except all select c from t1 union select d from t2",
+	}, {
+		input: "(select a from t) except distinct (select c from t1) order by a limit 1",
 	}, {
 		input: "select * from (select a from t) as t1",
 	}, {
@@ -652,6 +715,14 @@ var (
 		output: "select * from t1 right join t2 on 123",
 	}, {
 		input: "select * from t1 natural left join t2",
+	}, {
+		input:  "select * from t1 full outer join t2 using (a)",
+		output: "select * from t1 full join t2 using (a)",
+	}, {
+		input: "select * from t1 natural full join t2",
+	}, {
+		input:  "select full.a from t1 full join t2 full on t1.a = full.a",
+		output: "select full.a from t1 full join t2 as full on t1.a = full.a",
 	}, {
 		input:  "select 1",
 		output: "select 1 from dual",
@@ -681,6 +752,9 @@ var (
 	}, {
 		input:  "select $ from t into outfile '/Users/tmp/test' FIELDS TERMINATED BY ',' LINES TERMINATED BY '\n' header 'FALSE' MAX_FILE_SIZE 100 FORCE_QUOTE (a, b)",
 		output: "select $ from t into outfile /Users/tmp/test fields terminated by , enclosed by \" lines terminated by \n header false max_file_size 102400 force_quote a, b",
+	}, {
+		input:  "select $ from t into outfile '/Users/tmp/test' format parquet max_file_size 100",
+		output: "select $ from t into outfile /Users/tmp/test format parquet fields terminated by , enclosed by \" lines terminated by \n header true max_file_size 102400",
 	}}
 )
 
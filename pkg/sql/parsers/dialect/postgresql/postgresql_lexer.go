@@ -16,6 +16,8 @@ package postgresql
 
 import (
 	"errors"
+	"fmt"
+	"math"
 	"strconv"
 
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
@@ -37,7 +39,7 @@ func ParseOne(sql string) (tree.Statement, error) {
 		return nil, lexer.scanner.LastError
 	}
 	if len(lexer.stmts) != 1 {
-		return nil, errors.New("Sytax Error, or too many sql to parse")
+		return nil, errors.New("syntax error, or too many sql to parse")
 	}
 	return lexer.stmts[0], nil
 }
@@ -45,6 +47,16 @@ func ParseOne(sql string) (tree.Statement, error) {
 type Lexer struct {
 	scanner *scanner.Scanner
 	stmts   []tree.Statement
+
+	// the tokens read ahead. FULL is a join only if it is followed by
+	// JOIN or OUTER, and an identifier otherwise. INTERVAL followed by a
+	// string without unit is an interval such as interval '1 year'.
+	aheads []aheadToken
+}
+
+type aheadToken struct {
+	typ int
+	str string
 }
 
 func NewLexer(dialectType dialect.DialectType, sql string) *Lexer {
@@ -54,7 +66,17 @@ func NewLexer(dialectType dialect.DialectType, sql string) *Lexer {
 }
 
 func (l *Lexer) Lex(lval *yySymType) int {
-	typ, str := l.scanner.Scan()
+	typ, str := l.scan()
+	switch typ {
+	case FULL:
+		if next := l.peek(0); next == JOIN || next == OUTER {
+			typ = FULL_LA
+		}
+	case INTERVAL:
+		if l.peek(0) == STRING && !isIntervalUnit(l.peek(1)) {
+			typ = INTERVAL_LA
+		}
+	}
 	l.scanner.LastToken = str
 
 	switch typ {
@@ -74,6 +96,33 @@ func (l *Lexer) Lex(lval *yySymType) int {
 	return typ
 }
 
+func (l *Lexer) scan() (int, string) {
+	if len(l.aheads) > 0 {
+		t := l.aheads[0]
+		l.aheads = l.aheads[1:]
+		return t.typ, t.str
+	}
+	return l.scanner.Scan()
+}
+
+// peek returns the type of the i-th token after the current one.
+func (l *Lexer) peek(i int) int {
+	for len(l.aheads) <= i {
+		typ, str := l.scanner.Scan()
+		l.aheads = append(l.aheads, aheadToken{typ: typ, str: str})
+	}
+	return l.aheads[i].typ
+}
+
+// isIntervalUnit returns true if the token is the unit of an interval.
+func isIntervalUnit(typ int) bool {
+	switch typ {
+	case MICROSECOND, SECOND, MINUTE, HOUR, DAY, WEEK, MONTH, QUARTER, YEAR:
+		return true
+	}
+	return false
+}
+
 func (l *Lexer) Error(err string) {
 	l.scanner.LastError = scanner.PositionedErr{Err: err, Pos: l.scanner.Pos + 1, Near: l.scanner.LastToken}
 }
@@ -83,11 +132,19 @@ func (l *Lexer) AppendStmt(stmt tree.Statement) {
 }
 
 func (l *Lexer) toInt(lval *yySymType, str string) int {
-	ival, err := strconv.ParseInt(str, 10, 64)
+	ival, err := strconv.ParseUint(str, 10, 64)
 	if err != nil {
+		// TODO: toDecimal()
 		l.scanner.LastError = err
+		return LEX_ERROR
+	}
+	switch {
+	case ival <= math.MaxInt64:
+		lval.item = int64(ival)
+	default:
+		lval.item = ival
 	}
-	lval.item = ival
+	lval.str = str
 	return INTEGRAL
 }
 
@@ -95,6 +152,7 @@ func (l *Lexer) toFloat(lval *yySymType, str string) int {
 	fval, err := strconv.ParseFloat(str, 64)
 	if err != nil {
 		l.scanner.LastError = err
+		return LEX_ERROR
 	}
 	lval.item = fval
 	return FLOAT
@@ -105,9 +163,95 @@ func (l *Lexer) toHex(lval *yySymType, str string) int {
 }
 
 func (l *Lexer) toHexNum(lval *yySymType, str string) int {
+	ival, err := strconv.ParseUint(str[2:], 16, 64)
+	if err != nil {
+		// TODO: toDecimal()
+		l.scanner.LastError = err
+		return LEX_ERROR
+	}
+	switch {
+	case ival <= math.MaxInt64:
+		lval.item = int64(ival)
+	default:
+		lval.item = ival
+	}
+	lval.str = str
 	return HEXNUM
 }
 
 func (l *Lexer) toBit(lval *yySymType, str string) int {
 	return BIT_LITERAL
 }
+
+func getUint64(num interface{}) uint64 {
+	switch v := num.(type) {
+	case int64:
+		return uint64(v)
+	case uint64:
+		return v
+	}
+	return 0
+}
+
+func getInt64(num interface{}) (int64, string) {
+	switch v := num.(type) {
+	case int64:
+		return v, ""
+	}
+	return -1, fmt.Sprintf("%d is out of range int64", num)
+}
+
+// newCopyParam makes the file parameters of COPY from its options. Like
+// postgresql, the text format is delimited by tabs without quoting, and the
+// csv format is delimited by commas with double quotes.
+func newCopyParam(opts []tree.Property) (*tree.ExportParam, error) {
+	ep := &tree.ExportParam{
+		Fields: &tree.Fields{},
+		Lines:  &tree.Lines{TerminatedBy: "\n"},
+	}
+	text := true
+	for _, opt := range opts {
+		switch opt.Key {
+		case "format":
+			if opt.Value == "text" {
+				ep.DataFormat, text = "", true
+				break
+			}
+			f, ok := tree.NewDataFormat(opt.Value)
+			if !ok {
+				return nil, fmt.Errorf("unknown data format %s", opt.Value)
+			}
+			ep.DataFormat, text = f, false
+		case "delimiter":
+			if len(opt.Value) == 0 {
+				return nil, errors.New("copy delimiter can not be empty")
+			}
+			ep.Fields.Terminated = opt.Value
+		case "header":
+			ep.Header = opt.Value == "true"
+		case "quote":
+			if len(opt.Value) != 1 {
+				return nil, errors.New("copy quote must be a single one-byte character")
+			}
+			ep.Fields.EnclosedBy = opt.Value[0]
+		case "escape":
+			if len(opt.Value) != 1 {
+				return nil, errors.New("copy escape must be a single one-byte character")
+			}
+			ep.Fields.EscapedBy = opt.Value[0]
+		}
+	}
+	if text && ep.Fields.EnclosedBy != 0 {
+		return nil, errors.New("copy quote available only in csv mode")
+	}
+	if ep.Fields.Terminated == "" {
+		ep.Fields.Terminated = "\t"
+		if !text {
+			ep.Fields.Terminated = ","
+		}
+	}
+	if !text && ep.Fields.EnclosedBy == 0 {
+		ep.Fields.EnclosedBy = '"'
+	}
+	return ep, nil
+}
@@ -11,29 +11,895 @@ import (
 //line postgresql_sql.y:16
 
 import (
+	"go/constant"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/util"
 )
 
-const USE = 57346
-const ID = 57347
-const INTEGRAL = 57348
-const FLOAT = 57349
-const HEX = 57350
-const HEXNUM = 57351
-const BIT_LITERAL = 57352
+const LEX_ERROR = 57346
+const UNION = 57347
+const INTERSECT = 57348
+const EXCEPT = 57349
+const SELECT = 57350
+const STREAM = 57351
+const INSERT = 57352
+const UPDATE = 57353
+const DELETE = 57354
+const FROM = 57355
+const WHERE = 57356
+const GROUP = 57357
+const HAVING = 57358
+const ORDER = 57359
+const BY = 57360
+const LIMIT = 57361
+const OFFSET = 57362
+const FOR = 57363
+const ALL = 57364
+const DISTINCT = 57365
+const DISTINCTROW = 57366
+const AS = 57367
+const EXISTS = 57368
+const ASC = 57369
+const DESC = 57370
+const INTO = 57371
+const DUPLICATE = 57372
+const DEFAULT = 57373
+const SET = 57374
+const LOCK = 57375
+const KEYS = 57376
+const VALUES = 57377
+const LAST_INSERT_ID = 57378
+const NEXT = 57379
+const VALUE = 57380
+const SHARE = 57381
+const MODE = 57382
+const SQL_NO_CACHE = 57383
+const SQL_CACHE = 57384
+const JOIN = 57385
+const STRAIGHT_JOIN = 57386
+const LEFT = 57387
+const RIGHT = 57388
+const INNER = 57389
+const OUTER = 57390
+const CROSS = 57391
+const NATURAL = 57392
+const USE = 57393
+const FORCE = 57394
+const FULL_LA = 57395
+const INTERVAL_LA = 57396
+const ON = 57397
+const USING = 57398
+const SUBQUERY_AS_EXPR = 57399
+const ID = 57400
+const AT_ID = 57401
+const AT_AT_ID = 57402
+const STRING = 57403
+const VALUE_ARG = 57404
+const LIST_ARG = 57405
+const COMMENT = 57406
+const COMMENT_KEYWORD = 57407
+const INTEGRAL = 57408
+const HEX = 57409
+const HEXNUM = 57410
+const BIT_LITERAL = 57411
+const FLOAT = 57412
+const NULL = 57413
+const TRUE = 57414
+const FALSE = 57415
+const EMPTY_FROM_CLAUSE = 57416
+const LOWER_THAN_CHARSET = 57417
+const CHARSET = 57418
+const UNIQUE = 57419
+const KEY = 57420
+const OR = 57421
+const XOR = 57422
+const AND = 57423
+const NOT = 57424
+const BETWEEN = 57425
+const CASE = 57426
+const WHEN = 57427
+const THEN = 57428
+const ELSE = 57429
+const END = 57430
+const LE = 57431
+const GE = 57432
+const NE = 57433
+const NULL_SAFE_EQUAL = 57434
+const IS = 57435
+const LIKE = 57436
+const REGEXP = 57437
+const IN = 57438
+const ASSIGNMENT = 57439
+const CONCAT_OP = 57440
+const SHIFT_LEFT = 57441
+const SHIFT_RIGHT = 57442
+const DIV = 57443
+const MOD = 57444
+const UNARY = 57445
+const COLLATE = 57446
+const BINARY = 57447
+const UNDERSCORE_BINARY = 57448
+const INTERVAL = 57449
+const BEGIN = 57450
+const START = 57451
+const TRANSACTION = 57452
+const COMMIT = 57453
+const ROLLBACK = 57454
+const WORK = 57455
+const CONSISTENT = 57456
+const SNAPSHOT = 57457
+const CHAIN = 57458
+const NO = 57459
+const RELEASE = 57460
+const BIT = 57461
+const TINYINT = 57462
+const SMALLINT = 57463
+const MEDIUMINT = 57464
+const INT = 57465
+const INTEGER = 57466
+const BIGINT = 57467
+const INTNUM = 57468
+const REAL = 57469
+const DOUBLE = 57470
+const FLOAT_TYPE = 57471
+const DECIMAL = 57472
+const NUMERIC = 57473
+const TIME = 57474
+const TIMESTAMP = 57475
+const DATETIME = 57476
+const YEAR = 57477
+const CHAR = 57478
+const VARCHAR = 57479
+const BOOL = 57480
+const CHARACTER = 57481
+const VARBINARY = 57482
+const NCHAR = 57483
+const TEXT = 57484
+const TINYTEXT = 57485
+const MEDIUMTEXT = 57486
+const LONGTEXT = 57487
+const BLOB = 57488
+const TINYBLOB = 57489
+const MEDIUMBLOB = 57490
+const LONGBLOB = 57491
+const JSON = 57492
+const ENUM = 57493
+const GEOMETRY = 57494
+const POINT = 57495
+const LINESTRING = 57496
+const POLYGON = 57497
+const GEOMETRYCOLLECTION = 57498
+const MULTIPOINT = 57499
+const MULTILINESTRING = 57500
+const MULTIPOLYGON = 57501
+const INT1 = 57502
+const INT2 = 57503
+const INT3 = 57504
+const INT4 = 57505
+const INT8 = 57506
+const CREATE = 57507
+const ALTER = 57508
+const DROP = 57509
+const RENAME = 57510
+const ANALYZE = 57511
+const ADD = 57512
+const SCHEMA = 57513
+const TABLE = 57514
+const INDEX = 57515
+const VIEW = 57516
+const TO = 57517
+const IGNORE = 57518
+const IF = 57519
+const PRIMARY = 57520
+const COLUMN = 57521
+const CONSTRAINT = 57522
+const SPATIAL = 57523
+const FULLTEXT = 57524
+const FOREIGN = 57525
+const KEY_BLOCK_SIZE = 57526
+const SHOW = 57527
+const DESCRIBE = 57528
+const EXPLAIN = 57529
+const DATE = 57530
+const ESCAPE = 57531
+const REPAIR = 57532
+const OPTIMIZE = 57533
+const TRUNCATE = 57534
+const MAXVALUE = 57535
+const PARTITION = 57536
+const REORGANIZE = 57537
+const LESS = 57538
+const THAN = 57539
+const PROCEDURE = 57540
+const TRIGGER = 57541
+const STATUS = 57542
+const VARIABLES = 57543
+const ROLE = 57544
+const PROXY = 57545
+const AVG_ROW_LENGTH = 57546
+const STORAGE = 57547
+const DISK = 57548
+const MEMORY = 57549
+const CHECKSUM = 57550
+const COMPRESSION = 57551
+const DATA = 57552
+const DIRECTORY = 57553
+const DELAY_KEY_WRITE = 57554
+const ENCRYPTION = 57555
+const ENGINE = 57556
+const MAX_ROWS = 57557
+const MIN_ROWS = 57558
+const PACK_KEYS = 57559
+const ROW_FORMAT = 57560
+const STATS_AUTO_RECALC = 57561
+const STATS_PERSISTENT = 57562
+const STATS_SAMPLE_PAGES = 57563
+const DYNAMIC = 57564
+const COMPRESSED = 57565
+const REDUNDANT = 57566
+const COMPACT = 57567
+const FIXED = 57568
+const COLUMN_FORMAT = 57569
+const AUTO_RANDOM = 57570
+const RESTRICT = 57571
+const CASCADE = 57572
+const ACTION = 57573
+const PARTIAL = 57574
+const SIMPLE = 57575
+const CHECK = 57576
+const ENFORCED = 57577
+const RANGE = 57578
+const LIST = 57579
+const ALGORITHM = 57580
+const LINEAR = 57581
+const PARTITIONS = 57582
+const SUBPARTITION = 57583
+const SUBPARTITIONS = 57584
+const TYPE = 57585
+const PROPERTIES = 57586
+const PARSER = 57587
+const VISIBLE = 57588
+const INVISIBLE = 57589
+const BTREE = 57590
+const HASH = 57591
+const RTREE = 57592
+const BSI = 57593
+const ZONEMAP = 57594
+const EXPIRE = 57595
+const ACCOUNT = 57596
+const UNLOCK = 57597
+const DAY = 57598
+const NEVER = 57599
+const SECOND = 57600
+const ASCII = 57601
+const COALESCE = 57602
+const COLLATION = 57603
+const HOUR = 57604
+const MICROSECOND = 57605
+const MINUTE = 57606
+const MONTH = 57607
+const QUARTER = 57608
+const REPEAT = 57609
+const REVERSE = 57610
+const ROW_COUNT = 57611
+const WEEK = 57612
+const REVOKE = 57613
+const FUNCTION = 57614
+const PRIVILEGES = 57615
+const TABLESPACE = 57616
+const EXECUTE = 57617
+const SUPER = 57618
+const GRANT = 57619
+const OPTION = 57620
+const REFERENCES = 57621
+const REPLICATION = 57622
+const SLAVE = 57623
+const CLIENT = 57624
+const USAGE = 57625
+const RELOAD = 57626
+const FILE = 57627
+const TEMPORARY = 57628
+const ROUTINE = 57629
+const EVENT = 57630
+const SHUTDOWN = 57631
+const NULLX = 57632
+const AUTO_INCREMENT = 57633
+const APPROXNUM = 57634
+const SIGNED = 57635
+const UNSIGNED = 57636
+const ZEROFILL = 57637
+const USER = 57638
+const IDENTIFIED = 57639
+const CIPHER = 57640
+const ISSUER = 57641
+const X509 = 57642
+const SUBJECT = 57643
+const SAN = 57644
+const REQUIRE = 57645
+const SSL = 57646
+const NONE = 57647
+const PASSWORD = 57648
+const MAX_QUERIES_PER_HOUR = 57649
+const MAX_UPDATES_PER_HOUR = 57650
+const MAX_CONNECTIONS_PER_HOUR = 57651
+const MAX_USER_CONNECTIONS = 57652
+const FORMAT = 57653
+const CONNECTION = 57654
+const LOAD = 57655
+const INFILE = 57656
+const TERMINATED = 57657
+const OPTIONALLY = 57658
+const ENCLOSED = 57659
+const ESCAPED = 57660
+const STARTING = 57661
+const LINES = 57662
+const DATABASES = 57663
+const TABLES = 57664
+const EXTENDED = 57665
+const FULL = 57666
+const PROCESSLIST = 57667
+const FIELDS = 57668
+const COLUMNS = 57669
+const OPEN = 57670
+const ERRORS = 57671
+const WARNINGS = 57672
+const INDEXES = 57673
+const NAMES = 57674
+const GLOBAL = 57675
+const SESSION = 57676
+const ISOLATION = 57677
+const LEVEL = 57678
+const READ = 57679
+const WRITE = 57680
+const ONLY = 57681
+const REPEATABLE = 57682
+const COMMITTED = 57683
+const UNCOMMITTED = 57684
+const SERIALIZABLE = 57685
+const LOCAL = 57686
+const CURRENT_TIMESTAMP = 57687
+const DATABASE = 57688
+const CURRENT_TIME = 57689
+const LOCALTIME = 57690
+const LOCALTIMESTAMP = 57691
+const UTC_DATE = 57692
+const UTC_TIME = 57693
+const UTC_TIMESTAMP = 57694
+const REPLACE = 57695
+const CONVERT = 57696
+const SEPARATOR = 57697
+const CURRENT_DATE = 57698
+const CURRENT_USER = 57699
+const CURRENT_ROLE = 57700
+const MATCH = 57701
+const AGAINST = 57702
+const BOOLEAN = 57703
+const LANGUAGE = 57704
+const WITH = 57705
+const QUERY = 57706
+const EXPANSION = 57707
+const ADDDATE = 57708
+const BIT_AND = 57709
+const BIT_OR = 57710
+const BIT_XOR = 57711
+const CAST = 57712
+const COUNT = 57713
+const APPROX_COUNT_DISTINCT = 57714
+const APPROX_PERCENTILE = 57715
+const CURDATE = 57716
+const CURTIME = 57717
+const DATE_ADD = 57718
+const DATE_SUB = 57719
+const EXTRACT = 57720
+const GROUP_CONCAT = 57721
+const MAX = 57722
+const MID = 57723
+const MIN = 57724
+const NOW = 57725
+const POSITION = 57726
+const SESSION_USER = 57727
+const STD = 57728
+const STDDEV = 57729
+const STDDEV_POP = 57730
+const STDDEV_SAMP = 57731
+const SUBDATE = 57732
+const SUBSTR = 57733
+const SUBSTRING = 57734
+const SUM = 57735
+const SYSDATE = 57736
+const SYSTEM_USER = 57737
+const TRANSLATE = 57738
+const TRIM = 57739
+const VARIANCE = 57740
+const VAR_POP = 57741
+const VAR_SAMP = 57742
+const AVG = 57743
+const ROW = 57744
+const OUTFILE = 57745
+const HEADER = 57746
+const MAX_FILE_SIZE = 57747
+const FORCE_QUOTE = 57748
+const OVER = 57749
+const ROWS = 57750
+const CURRENT = 57751
+const UNBOUNDED = 57752
+const PRECEDING = 57753
+const FOLLOWING = 57754
+const UNUSED = 57755
+const ILIKE = 57756
+const RETURNING = 57757
+const COPY = 57758
+const DELIMITER = 57759
+const QUOTE = 57760
+const STDOUT = 57761
+const PRECISION = 57762
+const SERIAL = 57763
+const SMALLSERIAL = 57764
+const BIGSERIAL = 57765
+const BYTEA = 57766
+const GENERATED = 57767
+const ALWAYS = 57768
+const IDENTITY = 57769
+const NULLS = 57770
+const FIRST = 57771
+const LAST = 57772
+const TYPECAST = 57773
 
 var yyToknames = [...]string{
 	"$end",
 	"error",
 	"$unk",
+	"LEX_ERROR",
+	"UNION",
+	"INTERSECT",
+	"EXCEPT",
+	"SELECT",
+	"STREAM",
+	"INSERT",
+	"UPDATE",
+	"DELETE",
+	"FROM",
+	"WHERE",
+	"GROUP",
+	"HAVING",
+	"ORDER",
+	"BY",
+	"LIMIT",
+	"OFFSET",
+	"FOR",
+	"ALL",
+	"DISTINCT",
+	"DISTINCTROW",
+	"AS",
+	"EXISTS",
+	"ASC",
+	"DESC",
+	"INTO",
+	"DUPLICATE",
+	"DEFAULT",
+	"SET",
+	"LOCK",
+	"KEYS",
+	"VALUES",
+	"LAST_INSERT_ID",
+	"NEXT",
+	"VALUE",
+	"SHARE",
+	"MODE",
+	"SQL_NO_CACHE",
+	"SQL_CACHE",
+	"JOIN",
+	"STRAIGHT_JOIN",
+	"LEFT",
+	"RIGHT",
+	"INNER",
+	"OUTER",
+	"CROSS",
+	"NATURAL",
 	"USE",
+	"FORCE",
+	"FULL_LA",
+	"INTERVAL_LA",
+	"ON",
+	"USING",
+	"SUBQUERY_AS_EXPR",
+	"'('",
+	"','",
+	"')'",
 	"ID",
+	"AT_ID",
+	"AT_AT_ID",
+	"STRING",
+	"VALUE_ARG",
+	"LIST_ARG",
+	"COMMENT",
+	"COMMENT_KEYWORD",
 	"INTEGRAL",
-	"FLOAT",
 	"HEX",
 	"HEXNUM",
 	"BIT_LITERAL",
+	"FLOAT",
+	"NULL",
+	"TRUE",
+	"FALSE",
+	"EMPTY_FROM_CLAUSE",
+	"LOWER_THAN_CHARSET",
+	"CHARSET",
+	"UNIQUE",
+	"KEY",
+	"OR",
+	"XOR",
+	"AND",
+	"NOT",
+	"'!'",
+	"BETWEEN",
+	"CASE",
+	"WHEN",
+	"THEN",
+	"ELSE",
+	"END",
+	"'='",
+	"'<'",
+	"'>'",
+	"LE",
+	"GE",
+	"NE",
+	"NULL_SAFE_EQUAL",
+	"IS",
+	"LIKE",
+	"REGEXP",
+	"IN",
+	"ASSIGNMENT",
+	"'|'",
+	"CONCAT_OP",
+	"'&'",
+	"SHIFT_LEFT",
+	"SHIFT_RIGHT",
+	"'+'",
+	"'-'",
+	"'*'",
+	"'/'",
+	"DIV",
+	"'%'",
+	"MOD",
+	"'^'",
+	"'~'",
+	"UNARY",
+	"COLLATE",
+	"BINARY",
+	"UNDERSCORE_BINARY",
+	"INTERVAL",
+	"'.'",
+	"BEGIN",
+	"START",
+	"TRANSACTION",
+	"COMMIT",
+	"ROLLBACK",
+	"WORK",
+	"CONSISTENT",
+	"SNAPSHOT",
+	"CHAIN",
+	"NO",
+	"RELEASE",
+	"BIT",
+	"TINYINT",
+	"SMALLINT",
+	"MEDIUMINT",
+	"INT",
+	"INTEGER",
+	"BIGINT",
+	"INTNUM",
+	"REAL",
+	"DOUBLE",
+	"FLOAT_TYPE",
+	"DECIMAL",
+	"NUMERIC",
+	"TIME",
+	"TIMESTAMP",
+	"DATETIME",
+	"YEAR",
+	"CHAR",
+	"VARCHAR",
+	"BOOL",
+	"CHARACTER",
+	"VARBINARY",
+	"NCHAR",
+	"TEXT",
+	"TINYTEXT",
+	"MEDIUMTEXT",
+	"LONGTEXT",
+	"BLOB",
+	"TINYBLOB",
+	"MEDIUMBLOB",
+	"LONGBLOB",
+	"JSON",
+	"ENUM",
+	"GEOMETRY",
+	"POINT",
+	"LINESTRING",
+	"POLYGON",
+	"GEOMETRYCOLLECTION",
+	"MULTIPOINT",
+	"MULTILINESTRING",
+	"MULTIPOLYGON",
+	"INT1",
+	"INT2",
+	"INT3",
+	"INT4",
+	"INT8",
+	"CREATE",
+	"ALTER",
+	"DROP",
+	"RENAME",
+	"ANALYZE",
+	"ADD",
+	"SCHEMA",
+	"TABLE",
+	"INDEX",
+	"VIEW",
+	"TO",
+	"IGNORE",
+	"IF",
+	"PRIMARY",
+	"COLUMN",
+	"CONSTRAINT",
+	"SPATIAL",
+	"FULLTEXT",
+	"FOREIGN",
+	"KEY_BLOCK_SIZE",
+	"SHOW",
+	"DESCRIBE",
+	"EXPLAIN",
+	"DATE",
+	"ESCAPE",
+	"REPAIR",
+	"OPTIMIZE",
+	"TRUNCATE",
+	"MAXVALUE",
+	"PARTITION",
+	"REORGANIZE",
+	"LESS",
+	"THAN",
+	"PROCEDURE",
+	"TRIGGER",
+	"STATUS",
+	"VARIABLES",
+	"ROLE",
+	"PROXY",
+	"AVG_ROW_LENGTH",
+	"STORAGE",
+	"DISK",
+	"MEMORY",
+	"CHECKSUM",
+	"COMPRESSION",
+	"DATA",
+	"DIRECTORY",
+	"DELAY_KEY_WRITE",
+	"ENCRYPTION",
+	"ENGINE",
+	"MAX_ROWS",
+	"MIN_ROWS",
+	"PACK_KEYS",
+	"ROW_FORMAT",
+	"STATS_AUTO_RECALC",
+	"STATS_PERSISTENT",
+	"STATS_SAMPLE_PAGES",
+	"DYNAMIC",
+	"COMPRESSED",
+	"REDUNDANT",
+	"COMPACT",
+	"FIXED",
+	"COLUMN_FORMAT",
+	"AUTO_RANDOM",
+	"RESTRICT",
+	"CASCADE",
+	"ACTION",
+	"PARTIAL",
+	"SIMPLE",
+	"CHECK",
+	"ENFORCED",
+	"RANGE",
+	"LIST",
+	"ALGORITHM",
+	"LINEAR",
+	"PARTITIONS",
+	"SUBPARTITION",
+	"SUBPARTITIONS",
+	"TYPE",
+	"PROPERTIES",
+	"PARSER",
+	"VISIBLE",
+	"INVISIBLE",
+	"BTREE",
+	"HASH",
+	"RTREE",
+	"BSI",
+	"ZONEMAP",
+	"EXPIRE",
+	"ACCOUNT",
+	"UNLOCK",
+	"DAY",
+	"NEVER",
+	"SECOND",
+	"ASCII",
+	"COALESCE",
+	"COLLATION",
+	"HOUR",
+	"MICROSECOND",
+	"MINUTE",
+	"MONTH",
+	"QUARTER",
+	"REPEAT",
+	"REVERSE",
+	"ROW_COUNT",
+	"WEEK",
+	"REVOKE",
+	"FUNCTION",
+	"PRIVILEGES",
+	"TABLESPACE",
+	"EXECUTE",
+	"SUPER",
+	"GRANT",
+	"OPTION",
+	"REFERENCES",
+	"REPLICATION",
+	"SLAVE",
+	"CLIENT",
+	"USAGE",
+	"RELOAD",
+	"FILE",
+	"TEMPORARY",
+	"ROUTINE",
+	"EVENT",
+	"SHUTDOWN",
+	"NULLX",
+	"AUTO_INCREMENT",
+	"APPROXNUM",
+	"SIGNED",
+	"UNSIGNED",
+	"ZEROFILL",
+	"USER",
+	"IDENTIFIED",
+	"CIPHER",
+	"ISSUER",
+	"X509",
+	"SUBJECT",
+	"SAN",
+	"REQUIRE",
+	"SSL",
+	"NONE",
+	"PASSWORD",
+	"MAX_QUERIES_PER_HOUR",
+	"MAX_UPDATES_PER_HOUR",
+	"MAX_CONNECTIONS_PER_HOUR",
+	"MAX_USER_CONNECTIONS",
+	"FORMAT",
+	"CONNECTION",
+	"LOAD",
+	"INFILE",
+	"TERMINATED",
+	"OPTIONALLY",
+	"ENCLOSED",
+	"ESCAPED",
+	"STARTING",
+	"LINES",
+	"DATABASES",
+	"TABLES",
+	"EXTENDED",
+	"FULL",
+	"PROCESSLIST",
+	"FIELDS",
+	"COLUMNS",
+	"OPEN",
+	"ERRORS",
+	"WARNINGS",
+	"INDEXES",
+	"NAMES",
+	"GLOBAL",
+	"SESSION",
+	"ISOLATION",
+	"LEVEL",
+	"READ",
+	"WRITE",
+	"ONLY",
+	"REPEATABLE",
+	"COMMITTED",
+	"UNCOMMITTED",
+	"SERIALIZABLE",
+	"LOCAL",
+	"CURRENT_TIMESTAMP",
+	"DATABASE",
+	"CURRENT_TIME",
+	"LOCALTIME",
+	"LOCALTIMESTAMP",
+	"UTC_DATE",
+	"UTC_TIME",
+	"UTC_TIMESTAMP",
+	"REPLACE",
+	"CONVERT",
+	"SEPARATOR",
+	"CURRENT_DATE",
+	"CURRENT_USER",
+	"CURRENT_ROLE",
+	"MATCH",
+	"AGAINST",
+	"BOOLEAN",
+	"LANGUAGE",
+	"WITH",
+	"QUERY",
+	"EXPANSION",
+	"ADDDATE",
+	"BIT_AND",
+	"BIT_OR",
+	"BIT_XOR",
+	"CAST",
+	"COUNT",
+	"APPROX_COUNT_DISTINCT",
+	"APPROX_PERCENTILE",
+	"CURDATE",
+	"CURTIME",
+	"DATE_ADD",
+	"DATE_SUB",
+	"EXTRACT",
+	"GROUP_CONCAT",
+	"MAX",
+	"MID",
+	"MIN",
+	"NOW",
+	"POSITION",
+	"SESSION_USER",
+	"STD",
+	"STDDEV",
+	"STDDEV_POP",
+	"STDDEV_SAMP",
+	"SUBDATE",
+	"SUBSTR",
+	"SUBSTRING",
+	"SUM",
+	"SYSDATE",
+	"SYSTEM_USER",
+	"TRANSLATE",
+	"TRIM",
+	"VARIANCE",
+	"VAR_POP",
+	"VAR_SAMP",
+	"AVG",
+	"ROW",
+	"OUTFILE",
+	"HEADER",
+	"MAX_FILE_SIZE",
+	"FORCE_QUOTE",
+	"OVER",
+	"ROWS",
+	"CURRENT",
+	"UNBOUNDED",
+	"PRECEDING",
+	"FOLLOWING",
+	"UNUSED",
+	"ILIKE",
+	"RETURNING",
+	"COPY",
+	"DELIMITER",
+	"QUOTE",
+	"STDOUT",
+	"PRECISION",
+	"SERIAL",
+	"SMALLSERIAL",
+	"BIGSERIAL",
+	"BYTEA",
+	"GENERATED",
+	"ALWAYS",
+	"IDENTITY",
+	"NULLS",
+	"FIRST",
+	"LAST",
+	"TYPECAST",
 	"';'",
+	"'@'",
 }
 
 var yyStatenames = [...]string{}
@@ -42,32 +908,2238 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line postgresql_sql.y:74
+//line postgresql_sql.y:6686
 
 //line yacctab:1
 var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
+	-1, 55,
+	19, 376,
+	20, 376,
+	-2, 345,
+	-1, 60,
+	190, 528,
+	-2, 564,
+	-1, 69,
+	217, 269,
+	218, 269,
+	-2, 289,
+	-1, 328,
+	62, 1335,
+	450, 1335,
+	-2, 113,
+	-1, 347,
+	62, 692,
+	450, 692,
+	-2, 526,
+	-1, 348,
+	62, 519,
+	450, 519,
+	-2, 527,
+	-1, 357,
+	19, 377,
+	20, 377,
+	-2, 345,
+	-1, 606,
+	58, 829,
+	-2, 1391,
+	-1, 607,
+	58, 830,
+	-2, 1392,
+	-1, 608,
+	58, 831,
+	-2, 1393,
+	-1, 617,
+	58, 893,
+	-2, 1341,
+	-1, 618,
+	58, 895,
+	-2, 1352,
+	-1, 773,
+	1, 554,
+	449, 554,
+	-2, 561,
+	-1, 896,
+	19, 376,
+	20, 376,
+	-2, 752,
+	-1, 946,
+	124, 1048,
+	-2, 1046,
+	-1, 948,
+	124, 471,
+	-2, 1043,
+	-1, 949,
+	124, 472,
+	-2, 1044,
+	-1, 1159,
+	1, 555,
+	449, 555,
+	-2, 561,
+	-1, 1602,
+	251, 719,
+	-2, 698,
+	-1, 1603,
+	251, 719,
+	-2, 698,
+	-1, 1710,
+	1, 601,
+	211, 601,
+	449, 601,
+	-2, 561,
+	-1, 1723,
+	251, 719,
+	-2, 699,
+	-1, 1811,
+	1, 602,
+	211, 602,
+	449, 602,
+	-2, 561,
+	-1, 2193,
+	59, 576,
+	60, 576,
+	-2, 561,
+	-1, 2198,
+	59, 576,
+	60, 576,
+	-2, 561,
+	-1, 2210,
+	59, 580,
+	60, 580,
+	-2, 561,
+	-1, 2213,
+	59, 581,
+	60, 581,
+	-2, 561,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 9
+const yyLast = 18545
 
 var yyAct = [...]int{
-	6, 3, 7, 5, 1, 4, 2, 0, 8,
+	762, 1217, 2200, 2198, 2197, 2205, 2170, 621, 2164, 639,
+	2143, 2040, 1808, 745, 1804, 2133, 1736, 2064, 1693, 2063,
+	1951, 567, 2007, 619, 2022, 85, 1992, 531, 304, 1879,
+	830, 315, 565, 1148, 1435, 1806, 88, 462, 1807, 1724,
+	1995, 1705, 85, 317, 1839, 413, 517, 1742, 1544, 1479,
+	1838, 349, 349, 1579, 85, 1218, 84, 816, 1585, 1482,
+	1767, 1617, 1722, 1593, 594, 1715, 1589, 1567, 1403, 1377,
+	1153, 928, 358, 357, 1663, 535, 414, 1283, 1635, 1475,
+	1279, 310, 1281, 1280, 85, 354, 755, 308, 20, 1376,
+	943, 704, 575, 946, 739, 938, 929, 1327, 1496, 1494,
+	937, 1480, 1265, 1397, 631, 1586, 620, 809, 790, 1815,
+	742, 650, 55, 54, 1160, 436, 766, 740, 1145, 1135,
+	712, 1219, 586, 587, 1232, 319, 500, 813, 464, 1117,
+	1216, 1107, 302, 863, 299, 907, 356, 1177, 779, 55,
+	778, 405, 731, 1299, 321, 780, 449, 311, 320, 418,
+	420, 81, 557, 832, 1476, 895, 1124, 1727, 1395, 479,
+	2059, 324, 324, 1563, 1564, 355, 2010, 1851, 1131, 20,
+	1273, 1382, 927, 1789, 1495, 1883, 757, 351, 1945, 1946,
+	1942, 1943, 908, 1886, 1270, 1272, 1269, 1800, 1692, 422,
+	79, 421, 509, 55, 1731, 1944, 931, 1365, 1120, 890,
+	1725, 894, 1137, 1545, 1398, 2016, 1739, 1740, 543, 538,
+	1547, 1726, 499, 798, 799, 891, 893, 889, 576, 877,
+	878, 876, 887, 888, 880, 881, 882, 883, 884, 885,
+	886, 879, 1286, 541, 544, 1391, 1883, 391, 530, 782,
+	406, 529, 532, 533, 2089, 381, 1732, 1305, 1309, 1311,
+	1313, 1315, 1316, 1318, 1880, 1323, 1319, 1320, 1321, 1322,
+	1301, 1302, 1303, 1304, 1284, 1285, 1306, 748, 1287, 494,
+	1288, 1289, 1290, 1291, 1292, 1294, 1295, 1296, 1297, 1298,
+	375, 426, 425, 532, 533, 2067, 2068, 2032, 1308, 1310,
+	1312, 1314, 1317, 1378, 490, 2023, 2024, 2025, 2026, 85,
+	440, 2147, 2077, 2020, 1560, 2074, 1561, 1889, 1562, 1694,
+	85, 424, 439, 752, 1352, 441, 1300, 1568, 1569, 1570,
+	1571, 1738, 1146, 1590, 1618, 1621, 2087, 1406, 1404, 1401,
+	1405, 1407, 1122, 1400, 1399, 1869, 392, 466, 1746, 1745,
+	1741, 445, 481, 810, 485, 1788, 1406, 1404, 1734, 1405,
+	1407, 1797, 467, 492, 493, 491, 1120, 1687, 732, 480,
+	1996, 1997, 1998, 2000, 1999, 1925, 1937, 1752, 1756, 2084,
+	1733, 1735, 486, 2091, 2186, 1755, 1620, 2066, 2206, 2123,
+	438, 2086, 2130, 539, 734, 2042, 1380, 1548, 1409, 1410,
+	1411, 1412, 2058, 85, 2038, 2039, 895, 2042, 1907, 1379,
+	1381, 1864, 349, 2162, 2009, 423, 1906, 353, 414, 414,
+	414, 2093, 2094, 519, 520, 55, 522, 1855, 2048, 553,
+	2031, 1572, 1741, 488, 540, 528, 527, 2207, 472, 590,
+	471, 2201, 2171, 1895, 1728, 1509, 443, 435, 703, 1178,
+	518, 489, 1859, 542, 483, 709, 570, 440, 85, 85,
+	85, 85, 2072, 388, 1612, 374, 484, 487, 733, 713,
+	393, 373, 1369, 753, 1192, 1128, 482, 1753, 502, 1597,
+	521, 508, 1798, 2136, 523, 476, 349, 349, 440, 349,
+	309, 466, 2034, 2035, 589, 466, 397, 1613, 1433, 1307,
+	746, 1769, 1768, 1188, 503, 1183, 467, 349, 349, 547,
+	467, 729, 756, 794, 792, 793, 801, 791, 1190, 1189,
+	545, 546, 324, 802, 1271, 1187, 524, 394, 85, 1545,
+	349, 349, 699, 773, 1138, 85, 800, 552, 395, 532,
+	533, 578, 1882, 1881, 2191, 824, 399, 398, 2168, 787,
+	1132, 1977, 349, 772, 1123, 892, 55, 478, 758, 768,
+	507, 1558, 1293, 1493, 349, 414, 775, 349, 2092, 1366,
+	1443, 760, 763, 563, 564, 785, 767, 1363, 1729, 375,
+	774, 2008, 365, 825, 1537, 2137, 1362, 504, 1598, 372,
+	371, 1351, 349, 349, 829, 85, 788, 324, 728, 747,
+	843, 750, 1850, 1882, 1881, 714, 715, 716, 717, 2033,
+	367, 385, 811, 776, 777, 727, 1751, 833, 496, 386,
+	577, 532, 533, 817, 770, 751, 831, 1155, 1754, 817,
+	817, 744, 834, 735, 1345, 1857, 898, 783, 534, 1856,
+	537, 324, 415, 1173, 1860, 1861, 795, 1143, 749, 1614,
+	1101, 759, 754, 784, 845, 706, 771, 846, 560, 561,
+	562, 572, 1406, 1404, 444, 1405, 1407, 581, 582, 583,
+	584, 585, 1182, 1241, 324, 437, 1180, 879, 1221, 1220,
+	781, 558, 525, 1415, 812, 2179, 827, 536, 1539, 807,
+	897, 2158, 559, 556, 376, 1580, 904, 2134, 2135, 2052,
+	1347, 822, 823, 324, 366, 1637, 808, 1119, 1194, 1105,
+	442, 935, 935, 940, 910, 1669, 417, 1328, 828, 1417,
+	769, 756, 826, 819, 820, 821, 842, 840, 1323, 1319,
+	1320, 1321, 1322, 1642, 2018, 1641, 1640, 1638, 948, 1538,
+	1866, 840, 899, 900, 901, 902, 896, 905, 421, 468,
+	469, 470, 568, 949, 374, 1865, 1719, 872, 1118, 1978,
+	1980, 1981, 1982, 1979, 415, 555, 3, 383, 1226, 384,
+	391, 942, 526, 1714, 382, 380, 379, 387, 924, 389,
+	390, 1594, 1597, 909, 1328, 85, 1504, 941, 420, 1639,
+	1553, 1554, 304, 1416, 1237, 1452, 1234, 916, 1773, 1175,
+	1236, 1233, 1235, 1239, 1240, 1901, 934, 1102, 1238, 569,
+	2060, 833, 1848, 1103, 349, 396, 1163, 841, 842, 840,
+	1954, 1214, 422, 2161, 421, 1671, 834, 841, 842, 840,
+	55, 1445, 841, 842, 840, 349, 2195, 1772, 417, 1805,
+	2176, 1417, 841, 842, 840, 947, 1988, 590, 433, 85,
+	2124, 1100, 841, 842, 840, 1209, 1210, 2120, 1112, 841,
+	842, 840, 307, 12, 1167, 1116, 2160, 334, 2112, 333,
+	337, 329, 1212, 1227, 1228, 369, 1185, 1164, 1165, 1166,
+	1972, 325, 1444, 1213, 1987, 817, 817, 817, 1139, 1127,
+	400, 1598, 344, 1161, 1643, 1644, 1591, 1241, 1971, 1970,
+	1592, 1595, 589, 2148, 1967, 1151, 1206, 1207, 1208, 1961,
+	1252, 1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260, 1261,
+	1262, 1263, 1264, 1215, 324, 1224, 1170, 1276, 1277, 1334,
+	1205, 1169, 924, 1171, 781, 1986, 1247, 1202, 590, 1191,
+	1179, 1172, 1184, 1168, 12, 1199, 882, 883, 884, 885,
+	886, 879, 1596, 1658, 427, 1958, 1336, 1195, 1196, 1197,
+	887, 888, 880, 881, 882, 883, 884, 885, 886, 879,
+	1203, 1144, 1329, 1985, 877, 878, 876, 887, 888, 880,
+	881, 882, 883, 884, 885, 886, 879, 1957, 359, 1222,
+	1223, 1984, 1225, 589, 468, 469, 470, 1707, 1242, 1243,
+	1244, 1933, 2097, 1248, 1267, 1249, 1250, 1251, 1274, 1275,
+	1245, 1246, 849, 850, 851, 852, 853, 854, 1237, 847,
+	1234, 841, 842, 840, 1236, 1233, 1235, 1239, 1240, 1983,
+	1887, 2177, 1238, 1877, 841, 842, 840, 1876, 571, 1464,
+	1993, 327, 326, 330, 1350, 1331, 2155, 305, 6, 332,
+	1149, 1150, 1974, 1332, 1708, 2083, 1871, 1338, 306, 5,
+	1339, 336, 1335, 1229, 1337, 419, 1701, 1700, 468, 469,
+	470, 568, 1231, 1699, 1698, 736, 877, 878, 876, 887,
+	888, 880, 881, 882, 883, 884, 885, 886, 879, 1667,
+	1973, 877, 878, 876, 887, 888, 880, 881, 882, 883,
+	884, 885, 886, 879, 1666, 841, 842, 840, 841, 842,
+	840, 566, 1353, 1662, 1661, 440, 876, 887, 888, 880,
+	881, 882, 883, 884, 885, 886, 879, 713, 569, 6,
+	1533, 349, 707, 2046, 349, 2045, 1975, 440, 1968, 349,
+	5, 468, 469, 470, 568, 1375, 1938, 1964, 1963, 1368,
+	2210, 331, 335, 737, 1387, 339, 738, 1392, 1930, 341,
+	342, 343, 2184, 1962, 345, 346, 1888, 1875, 841, 842,
+	840, 817, 1436, 1357, 1358, 1771, 1141, 1360, 2012, 2178,
+	841, 842, 840, 1852, 1423, 1803, 1355, 420, 440, 1801,
+	1427, 1428, 85, 1507, 1709, 1430, 1506, 841, 842, 840,
+	1426, 569, 1664, 349, 1577, 1576, 1384, 1385, 1386, 1575,
+	1388, 85, 85, 767, 1574, 1140, 1556, 1414, 1555, 841,
+	842, 840, 1552, 1429, 880, 881, 882, 883, 884, 885,
+	886, 879, 1130, 1370, 1129, 1356, 920, 1453, 841, 842,
+	840, 1678, 1438, 1439, 1367, 468, 469, 470, 1364, 1668,
+	919, 918, 911, 1654, 764, 1420, 708, 1421, 1371, 1940,
+	1372, 1373, 1374, 841, 842, 840, 1448, 2215, 1653, 1419,
+	1939, 841, 842, 840, 1393, 841, 842, 840, 1652, 1161,
+	1469, 2153, 1878, 1512, 1413, 1449, 1448, 1511, 1450, 1451,
+	841, 842, 840, 1425, 1791, 1424, 1651, 1434, 1785, 1431,
+	841, 842, 840, 2209, 2208, 1784, 1473, 1474, 1459, 1460,
+	1461, 1776, 1437, 1775, 1465, 1466, 1467, 1468, 841, 842,
+	840, 1649, 1761, 1422, 1126, 2187, 877, 878, 876, 887,
+	888, 880, 881, 882, 883, 884, 885, 886, 879, 1710,
+	362, 363, 364, 841, 842, 840, 1485, 1486, 2183, 2182,
+	1679, 1648, 361, 1622, 935, 1515, 1525, 935, 1126, 2174,
+	1528, 1489, 1490, 1491, 1492, 1477, 1126, 2173, 2167, 2166,
+	756, 1513, 349, 841, 842, 840, 349, 349, 1647, 1510,
+	349, 1463, 1470, 1531, 1267, 1891, 2102, 1471, 1472, 1551,
+	896, 1501, 421, 1462, 1505, 580, 1457, 1484, 1532, 1634,
+	841, 842, 840, 1454, 1516, 1201, 2095, 817, 1447, 1520,
+	2081, 2080, 1432, 817, 1633, 1527, 1891, 2069, 1333, 85,
+	1632, 841, 842, 840, 1891, 2056, 1524, 1891, 2055, 440,
+	1498, 1499, 705, 1500, 1503, 730, 841, 842, 840, 1324,
+	1522, 1426, 841, 842, 840, 1891, 2054, 1517, 579, 1523,
+	1578, 1448, 1529, 85, 1627, 1530, 1535, 1526, 1534, 1891,
+	2053, 841, 842, 840, 55, 80, 1536, 24, 41, 25,
+	2051, 2050, 1891, 1890, 1543, 1340, 1588, 1104, 1099, 1581,
+	1582, 1573, 1780, 1557, 1782, 1781, 1540, 1542, 80, 80,
+	24, 41, 25, 1711, 1565, 877, 878, 876, 887, 888,
+	880, 881, 882, 883, 884, 885, 886, 879, 1601, 1778,
+	1779, 1778, 1777, 701, 1631, 77, 698, 1604, 55, 1606,
+	1605, 1630, 1599, 1600, 1646, 1550, 1690, 1134, 1682, 1792,
+	1650, 1448, 1655, 495, 1626, 1448, 1645, 474, 77, 700,
+	1636, 1550, 1549, 1448, 1456, 1448, 1455, 473, 1677, 1120,
+	1673, 474, 349, 1660, 838, 1676, 1134, 1354, 1349, 1348,
+	475, 1656, 1657, 1343, 1342, 1134, 1133, 1126, 1125, 1627,
+	1670, 80, 1680, 1665, 877, 878, 876, 887, 888, 880,
+	881, 882, 883, 884, 885, 886, 879, 836, 80, 1442,
+	476, 1674, 420, 1659, 1636, 1672, 705, 446, 1521, 1346,
+	836, 1713, 1325, 1681, 1201, 1176, 476, 1147, 451, 454,
+	455, 456, 452, 1706, 453, 458, 554, 2211, 457, 2157,
+	2151, 77, 1704, 1747, 1686, 451, 454, 455, 456, 452,
+	1689, 453, 458, 2131, 2128, 457, 2126, 1759, 77, 80,
+	1924, 24, 41, 25, 1697, 2111, 2005, 1990, 1949, 1936,
+	1717, 1702, 1934, 1928, 451, 454, 455, 456, 452, 68,
+	453, 458, 1683, 75, 457, 1712, 1716, 1927, 1716, 2140,
+	1718, 1926, 1923, 1922, 1481, 1863, 1743, 1483, 1720, 1703,
+	1495, 1497, 42, 1488, 1487, 1268, 1418, 1790, 1750, 77,
+	1758, 1383, 1359, 1341, 1330, 349, 349, 1762, 1193, 1186,
+	1764, 1765, 1766, 1721, 1760, 501, 925, 923, 1763, 922,
+	921, 917, 864, 914, 440, 1770, 912, 906, 77, 875,
+	874, 873, 440, 1812, 871, 1840, 1842, 870, 1840, 1840,
+	869, 868, 867, 1774, 1426, 866, 865, 1783, 862, 861,
+	1115, 1514, 860, 859, 858, 1796, 817, 857, 1793, 856,
+	855, 710, 85, 702, 477, 1157, 71, 72, 2107, 73,
+	74, 1849, 1108, 1109, 1706, 726, 2105, 455, 456, 1588,
+	1841, 2065, 1408, 1847, 1200, 457, 1845, 318, 1843, 1844,
+	1837, 1111, 1870, 1867, 1853, 497, 1846, 877, 878, 876,
+	887, 888, 880, 881, 882, 883, 884, 885, 886, 879,
+	1114, 1113, 1873, 724, 719, 1794, 1795, 722, 725, 85,
+	718, 720, 723, 60, 70, 78, 721, 40, 2194, 1344,
+	1478, 573, 574, 1932, 1897, 1749, 1748, 1162, 1688, 350,
+	1149, 1150, 1152, 69, 67, 66, 360, 797, 2011, 1874,
+	1884, 877, 878, 876, 887, 888, 880, 881, 882, 883,
+	884, 885, 886, 879, 1931, 1396, 1842, 1684, 460, 2152,
+	362, 363, 364, 2116, 1685, 429, 431, 432, 1892, 1221,
+	1220, 1900, 361, 515, 516, 1893, 1142, 1898, 1899, 2114,
+	1902, 1903, 1904, 1905, 360, 2079, 1908, 1909, 1910, 1911,
+	1912, 1913, 1914, 1915, 1916, 1917, 1918, 1919, 1920, 1921,
+	1929, 361, 440, 513, 514, 511, 512, 505, 506, 1956,
+	2078, 362, 363, 364, 2076, 1955, 1950, 1802, 1757, 50,
+	1941, 1696, 1948, 361, 2108, 51, 1695, 1675, 1625, 510,
+	1624, 1989, 1441, 705, 440, 1629, 1953, 440, 440, 440,
+	1952, 1458, 466, 2109, 2108, 2109, 1446, 440, 1361, 298,
+	803, 459, 377, 1181, 1, 930, 936, 467, 1969, 926,
+	2028, 52, 1136, 1991, 1959, 1960, 2139, 2163, 2110, 1994,
+	1965, 1966, 2002, 2003, 2004, 2029, 2001, 2142, 638, 622,
+	2071, 1559, 2017, 878, 876, 887, 888, 880, 881, 882,
+	883, 884, 885, 886, 879, 2019, 2073, 2021, 2036, 1390,
+	2013, 85, 2043, 2044, 1947, 1546, 498, 1518, 1519, 662,
+	652, 913, 653, 697, 430, 651, 440, 1872, 1619, 370,
+	428, 378, 1868, 1691, 1744, 1230, 2204, 2193, 2169, 2150,
+	2041, 2049, 831, 2185, 2085, 2129, 2122, 2037, 1894, 322,
+	804, 548, 403, 2006, 711, 1566, 1402, 1154, 1121, 741,
+	323, 2057, 2030, 1935, 368, 1156, 2075, 1159, 2070, 1158,
+	848, 1266, 915, 592, 53, 1326, 1502, 903, 629, 623,
+	1616, 1615, 1737, 786, 27, 461, 2088, 2090, 839, 944,
+	87, 1174, 945, 2027, 2098, 2099, 2100, 2101, 2096, 1885,
+	2144, 2104, 2119, 2103, 2106, 2082, 1787, 1786, 1508, 637,
+	636, 2115, 2113, 2117, 2118, 635, 634, 450, 448, 447,
+	314, 313, 1440, 1623, 835, 837, 2121, 2062, 2061, 2014,
+	2015, 2125, 2146, 2127, 1799, 1862, 1976, 1858, 1854, 2132,
+	2047, 2145, 1811, 1810, 1723, 2138, 1730, 440, 1603, 440,
+	1282, 2149, 1607, 1602, 1278, 1587, 1584, 1583, 1110, 746,
+	2154, 746, 2156, 1106, 932, 939, 434, 765, 2165, 1394,
+	82, 312, 1204, 76, 11, 2159, 19, 18, 440, 17,
+	16, 49, 48, 47, 2172, 46, 15, 8, 2146, 2181,
+	746, 2175, 45, 44, 43, 14, 789, 2145, 2180, 13,
+	39, 38, 37, 36, 35, 34, 2165, 2188, 33, 32,
+	2192, 31, 2196, 30, 29, 28, 9, 59, 58, 57,
+	56, 2203, 21, 2202, 22, 23, 65, 64, 63, 62,
+	61, 26, 10, 2214, 2213, 2212, 2203, 7, 4, 2,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1064, 1012, 994, 1050, 2190, 1011, 1066, 982, 999,
+	1074, 1001, 1002, 1038, 960, 1021, 1098, 997, 952, 985,
+	986, 954, 993, 955, 983, 1014, 157, 981, 1053, 1024,
+	182, 1072, 184, 0, 0, 242, 197, 0, 0, 1017,
+	1055, 1019, 1043, 1010, 1039, 968, 1032, 1067, 998, 0,
+	0, 1036, 1068, 0, 0, 0, 0, 468, 469, 470,
+	0, 0, 0, 0, 139, 0, 0, 0, 0, 0,
+	1035, 1060, 996, 0, 0, 969, 1065, 1018, 1037, 0,
+	953, 1033, 0, 958, 961, 1073, 1058, 990, 991, 0,
+	0, 0, 0, 0, 0, 0, 1015, 1020, 1040, 1007,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	987, 0, 1028, 0, 0, 0, 963, 959, 0, 1013,
+	0, 130, 247, 261, 140, 238, 276, 144, 245, 136,
+	211, 233, 132, 259, 244, 194, 176, 177, 131, 0,
+	228, 155, 167, 152, 209, 1062, 1063, 151, 279, 962,
+	270, 134, 135, 269, 208, 256, 260, 195, 189, 133,
+	258, 193, 188, 180, 159, 172, 220, 187, 221, 173,
+	199, 198, 200, 1084, 1085, 1086, 1087, 1088, 967, 0,
+	988, 1041, 0, 951, 1049, 1056, 1009, 272, 1059, 1006,
+	1005, 1091, 0, 1090, 246, 1092, 1093, 181, 1054, 984,
+	995, 989, 992, 231, 213, 1061, 1027, 218, 229, 185,
+	257, 223, 262, 248, 271, 1044, 224, 126, 249, 154,
+	196, 137, 138, 150, 156, 158, 160, 161, 205, 206,
+	216, 236, 250, 251, 252, 153, 145, 230, 146, 169,
+	147, 127, 239, 148, 128, 217, 255, 1089, 166, 226,
+	192, 129, 191, 219, 254, 253, 280, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 164, 950, 267, 0,
+	210, 1051, 956, 966, 964, 1003, 1029, 1030, 1031, 1076,
+	1046, 1048, 1047, 1075, 234, 0, 0, 0, 0, 0,
+	175, 215, 0, 235, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 957, 0, 243, 265, 278, 268,
+	1004, 975, 1016, 277, 978, 976, 1045, 977, 1034, 1077,
+	201, 202, 203, 204, 1000, 143, 1025, 1008, 1078, 1079,
+	1080, 1081, 1082, 1083, 980, 1057, 163, 168, 0, 170,
+	142, 214, 165, 275, 178, 207, 174, 240, 179, 186,
+	227, 274, 212, 232, 141, 264, 241, 190, 974, 979,
+	973, 1022, 1023, 1069, 1070, 1071, 1042, 965, 1052, 970,
+	972, 971, 1026, 125, 0, 183, 273, 225, 162, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1094, 1095, 281, 282, 283,
+	0, 237, 149, 263, 222, 171, 266, 1096, 1097, 284,
+	285, 286, 297, 658, 287, 288, 289, 290, 291, 292,
+	293, 294, 295, 296, 0, 0, 0, 0, 0, 632,
+	0, 0, 0, 157, 818, 0, 0, 182, 0, 184,
+	0, 0, 242, 197, 0, 0, 0, 0, 674, 682,
+	0, 0, 0, 0, 0, 0, 0, 649, 814, 0,
+	0, 624, 0, 0, 593, 664, 663, 640, 647, 0,
+	0, 139, 641, 0, 646, 0, 642, 645, 643, 644,
+	0, 0, 666, 0, 0, 0, 0, 0, 591, 628,
+	0, 630, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 625, 626, 0, 0, 0, 0, 659,
+	0, 627, 0, 0, 815, 0, 648, 0, 130, 247,
+	261, 140, 238, 276, 144, 245, 136, 211, 233, 132,
+	259, 244, 194, 176, 177, 131, 0, 228, 155, 167,
+	152, 209, 656, 657, 151, 618, 654, 270, 134, 135,
+	269, 208, 256, 260, 195, 189, 133, 258, 193, 188,
+	180, 159, 172, 220, 187, 221, 173, 199, 198, 200,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 272, 0, 0, 672, 0, 0,
+	0, 246, 0, 0, 181, 0, 0, 0, 655, 0,
+	231, 213, 685, 0, 218, 229, 185, 257, 223, 262,
+	248, 271, 0, 224, 126, 249, 154, 196, 137, 138,
+	150, 156, 158, 160, 161, 205, 206, 216, 236, 250,
+	251, 252, 153, 145, 230, 146, 169, 147, 127, 239,
+	148, 128, 217, 255, 0, 166, 226, 192, 129, 191,
+	219, 254, 253, 280, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 164, 0, 267, 670, 210, 684, 665,
+	667, 668, 671, 675, 676, 677, 678, 679, 681, 683,
+	686, 234, 0, 0, 0, 0, 0, 175, 215, 0,
+	235, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 243, 265, 278, 617, 0, 0, 0,
+	277, 0, 0, 0, 0, 0, 660, 201, 202, 203,
+	204, 673, 143, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 163, 168, 0, 170, 142, 214, 165,
+	275, 178, 207, 174, 240, 179, 186, 227, 274, 212,
+	232, 141, 264, 241, 190, 692, 669, 691, 693, 694,
+	690, 695, 696, 680, 633, 0, 688, 687, 689, 0,
+	125, 0, 183, 273, 225, 162, 89, 595, 596, 597,
+	598, 599, 600, 601, 97, 602, 99, 100, 603, 102,
+	604, 104, 605, 106, 107, 108, 606, 607, 608, 609,
+	113, 610, 611, 612, 613, 118, 119, 120, 121, 614,
+	615, 616, 0, 0, 281, 282, 283, 0, 237, 149,
+	263, 222, 171, 266, 0, 658, 284, 285, 286, 297,
+	0, 287, 288, 289, 290, 291, 292, 293, 294, 295,
+	296, 632, 0, 0, 0, 157, 2189, 0, 0, 182,
+	0, 184, 0, 0, 242, 197, 0, 0, 0, 0,
+	674, 682, 0, 0, 0, 0, 0, 0, 0, 649,
+	0, 0, 0, 624, 0, 0, 593, 664, 663, 640,
+	647, 0, 0, 139, 641, 0, 646, 0, 642, 645,
+	643, 644, 0, 0, 666, 0, 0, 0, 0, 0,
+	591, 628, 0, 630, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 625, 626, 0, 0, 0,
+	0, 659, 0, 627, 0, 0, 661, 0, 648, 0,
+	130, 247, 261, 140, 238, 276, 144, 245, 136, 211,
+	233, 132, 259, 244, 194, 176, 177, 131, 0, 228,
+	155, 167, 152, 209, 656, 657, 151, 618, 654, 270,
+	134, 135, 269, 208, 256, 260, 195, 189, 133, 258,
+	193, 188, 180, 159, 172, 220, 187, 221, 173, 199,
+	198, 200, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 272, 0, 0, 672,
+	0, 0, 0, 246, 0, 0, 181, 0, 0, 0,
+	655, 0, 231, 213, 685, 0, 218, 229, 185, 257,
+	223, 262, 248, 271, 0, 224, 126, 249, 154, 196,
+	137, 138, 150, 156, 158, 160, 161, 205, 206, 216,
+	236, 250, 251, 252, 153, 145, 230, 146, 169, 147,
+	127, 239, 148, 128, 217, 255, 0, 166, 226, 192,
+	129, 191, 219, 254, 253, 280, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 164, 0, 267, 670, 210,
+	684, 665, 667, 668, 671, 675, 676, 677, 678, 679,
+	681, 683, 686, 234, 0, 0, 0, 0, 0, 175,
+	215, 0, 235, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 243, 265, 278, 617, 0,
+	0, 0, 277, 0, 0, 0, 0, 0, 660, 201,
+	202, 203, 204, 673, 143, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 163, 168, 0, 170, 142,
+	214, 165, 275, 178, 207, 174, 240, 179, 186, 227,
+	274, 212, 232, 141, 264, 241, 190, 692, 669, 691,
+	693, 694, 690, 695, 696, 680, 633, 0, 688, 687,
+	689, 0, 125, 0, 183, 273, 225, 162, 89, 595,
+	596, 597, 598, 599, 600, 601, 97, 602, 99, 100,
+	603, 102, 604, 104, 605, 106, 107, 108, 606, 607,
+	608, 609, 113, 610, 611, 612, 613, 118, 119, 120,
+	121, 614, 615, 616, 0, 0, 281, 282, 283, 0,
+	237, 149, 263, 222, 171, 266, 0, 658, 284, 285,
+	286, 297, 0, 287, 288, 289, 290, 291, 292, 293,
+	294, 295, 296, 632, 0, 0, 0, 157, 818, 0,
+	0, 182, 0, 184, 0, 0, 242, 197, 0, 0,
+	0, 0, 674, 682, 0, 0, 0, 0, 0, 0,
+	0, 649, 0, 0, 0, 624, 0, 0, 593, 664,
+	663, 640, 647, 0, 0, 139, 641, 0, 646, 0,
+	642, 645, 643, 644, 0, 0, 666, 0, 0, 0,
+	0, 0, 591, 628, 0, 630, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 625, 626, 0,
+	0, 0, 0, 659, 0, 627, 0, 0, 661, 0,
+	648, 0, 130, 247, 261, 140, 238, 276, 144, 245,
+	136, 211, 233, 132, 259, 244, 194, 176, 177, 131,
+	0, 228, 155, 167, 152, 209, 656, 657, 151, 618,
+	654, 270, 134, 135, 269, 208, 256, 260, 195, 189,
+	133, 258, 193, 188, 180, 159, 172, 220, 187, 221,
+	173, 199, 198, 200, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 272, 0,
+	0, 672, 0, 0, 0, 246, 0, 0, 181, 0,
+	0, 0, 655, 0, 231, 213, 685, 0, 218, 229,
+	185, 257, 223, 262, 248, 271, 0, 224, 126, 249,
+	154, 196, 137, 138, 150, 156, 158, 160, 161, 205,
+	206, 216, 236, 250, 251, 252, 153, 145, 230, 146,
+	169, 147, 127, 239, 148, 128, 217, 255, 0, 166,
+	226, 192, 129, 191, 219, 254, 253, 280, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 164, 0, 267,
+	670, 210, 684, 665, 667, 668, 671, 675, 676, 677,
+	678, 679, 681, 683, 686, 234, 0, 0, 0, 0,
+	0, 175, 215, 0, 235, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 243, 265, 278,
+	617, 0, 0, 0, 277, 0, 0, 0, 0, 0,
+	660, 201, 202, 203, 204, 673, 143, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 163, 168, 0,
+	170, 142, 214, 165, 275, 178, 207, 174, 240, 179,
+	186, 227, 274, 212, 232, 141, 264, 241, 190, 692,
+	669, 691, 693, 694, 690, 695, 696, 680, 633, 0,
+	688, 687, 689, 0, 125, 0, 183, 273, 225, 162,
+	89, 595, 596, 597, 598, 599, 600, 601, 97, 602,
+	99, 100, 603, 102, 604, 104, 605, 106, 107, 108,
+	606, 607, 608, 609, 113, 610, 611, 612, 613, 118,
+	119, 120, 121, 614, 615, 616, 0, 0, 281, 282,
+	283, 0, 237, 149, 263, 222, 171, 266, 0, 0,
+	284, 285, 286, 297, 0, 287, 288, 289, 290, 291,
+	292, 293, 294, 295, 296, 80, 0, 658, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 632, 0, 0, 0, 157, 0, 0,
+	0, 182, 0, 184, 0, 0, 242, 197, 0, 0,
+	0, 0, 674, 682, 0, 0, 0, 0, 0, 0,
+	0, 649, 0, 0, 0, 624, 0, 0, 593, 664,
+	663, 640, 647, 0, 0, 139, 641, 0, 646, 0,
+	642, 645, 643, 644, 0, 0, 666, 0, 0, 0,
+	0, 0, 591, 628, 0, 630, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 625, 626, 0,
+	0, 0, 0, 659, 0, 627, 0, 0, 661, 0,
+	648, 0, 130, 247, 261, 140, 238, 276, 144, 245,
+	136, 211, 233, 132, 259, 244, 194, 176, 177, 131,
+	0, 228, 155, 167, 152, 209, 656, 657, 151, 618,
+	654, 270, 134, 135, 269, 208, 256, 260, 195, 189,
+	133, 258, 193, 188, 180, 159, 172, 220, 187, 221,
+	173, 199, 198, 200, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 272, 0,
+	0, 672, 0, 0, 0, 246, 0, 0, 181, 0,
+	0, 0, 655, 0, 231, 213, 685, 0, 218, 229,
+	185, 257, 223, 262, 248, 271, 0, 224, 126, 249,
+	154, 196, 137, 138, 150, 156, 158, 160, 161, 205,
+	206, 216, 236, 250, 251, 252, 153, 145, 230, 146,
+	169, 147, 127, 239, 148, 128, 217, 255, 0, 166,
+	226, 192, 129, 191, 219, 254, 253, 280, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 164, 0, 267,
+	670, 210, 684, 665, 667, 668, 671, 675, 676, 677,
+	678, 679, 681, 683, 686, 234, 0, 0, 0, 0,
+	0, 175, 215, 0, 235, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 243, 265, 278,
+	617, 0, 0, 0, 277, 0, 0, 0, 0, 0,
+	660, 201, 202, 203, 204, 673, 143, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 163, 168, 0,
+	170, 142, 214, 165, 275, 178, 207, 174, 240, 179,
+	186, 227, 274, 212, 232, 141, 264, 241, 190, 692,
+	669, 691, 693, 694, 690, 695, 696, 680, 633, 0,
+	688, 687, 689, 0, 125, 0, 183, 273, 225, 162,
+	89, 595, 596, 597, 598, 599, 600, 601, 97, 602,
+	99, 100, 603, 102, 604, 104, 605, 106, 107, 108,
+	606, 607, 608, 609, 113, 610, 611, 612, 613, 118,
+	119, 120, 121, 614, 615, 616, 0, 0, 281, 282,
+	283, 0, 237, 149, 263, 222, 171, 266, 0, 0,
+	284, 285, 286, 297, 658, 287, 288, 289, 290, 291,
+	292, 293, 294, 295, 296, 0, 1389, 0, 0, 0,
+	632, 0, 0, 0, 157, 0, 0, 0, 182, 0,
+	184, 0, 0, 242, 197, 0, 0, 0, 0, 674,
+	682, 0, 0, 0, 0, 0, 0, 0, 649, 0,
+	0, 0, 624, 0, 0, 593, 664, 663, 640, 647,
+	0, 0, 139, 641, 0, 646, 0, 642, 645, 643,
+	644, 0, 0, 666, 0, 0, 0, 0, 0, 591,
+	628, 0, 630, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 625, 626, 0, 0, 0, 0,
+	659, 0, 627, 0, 0, 661, 0, 648, 0, 130,
+	247, 261, 140, 238, 276, 144, 245, 136, 211, 233,
+	132, 259, 244, 194, 176, 177, 131, 0, 228, 155,
+	167, 152, 209, 656, 657, 151, 618, 654, 270, 134,
+	135, 269, 208, 256, 260, 195, 189, 133, 258, 193,
+	188, 180, 159, 172, 220, 187, 221, 173, 199, 198,
+	200, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 272, 0, 0, 672, 0,
+	0, 0, 246, 0, 0, 181, 0, 0, 0, 655,
+	0, 231, 213, 685, 0, 218, 229, 185, 257, 223,
+	262, 248, 271, 0, 224, 126, 249, 154, 196, 137,
+	138, 150, 156, 158, 160, 161, 205, 206, 216, 236,
+	250, 251, 252, 153, 145, 230, 146, 169, 147, 127,
+	239, 148, 128, 217, 255, 0, 166, 226, 192, 129,
+	191, 219, 254, 253, 280, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 164, 0, 267, 670, 210, 684,
+	665, 667, 668, 671, 675, 676, 677, 678, 679, 681,
+	683, 686, 234, 0, 0, 0, 0, 0, 175, 215,
+	0, 235, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 243, 265, 278, 617, 0, 0,
+	0, 277, 0, 0, 0, 0, 0, 660, 201, 202,
+	203, 204, 673, 143, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 163, 168, 0, 170, 142, 214,
+	165, 275, 178, 207, 174, 240, 179, 186, 227, 274,
+	212, 232, 141, 264, 241, 190, 692, 669, 691, 693,
+	694, 690, 695, 696, 680, 633, 0, 688, 687, 689,
+	0, 125, 0, 183, 273, 225, 162, 89, 595, 596,
+	597, 598, 599, 600, 601, 97, 602, 99, 100, 603,
+	102, 604, 104, 605, 106, 107, 108, 606, 607, 608,
+	609, 113, 610, 611, 612, 613, 118, 119, 120, 121,
+	614, 615, 616, 0, 0, 281, 282, 283, 0, 237,
+	149, 263, 222, 171, 266, 0, 658, 284, 285, 286,
+	297, 0, 287, 288, 289, 290, 291, 292, 293, 294,
+	295, 296, 632, 0, 0, 0, 157, 0, 0, 0,
+	182, 0, 184, 0, 0, 242, 197, 0, 0, 0,
+	0, 674, 682, 0, 0, 0, 0, 0, 0, 0,
+	649, 0, 0, 0, 624, 0, 0, 593, 664, 663,
+	640, 647, 0, 0, 139, 641, 0, 646, 0, 642,
+	645, 643, 644, 0, 0, 666, 0, 0, 0, 0,
+	0, 591, 628, 0, 630, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 625, 626, 588, 0,
+	0, 0, 659, 0, 627, 0, 0, 661, 0, 648,
+	0, 130, 247, 261, 140, 238, 276, 144, 245, 136,
+	211, 233, 132, 259, 244, 194, 176, 177, 131, 0,
+	228, 155, 167, 152, 209, 656, 657, 151, 618, 654,
+	270, 134, 135, 269, 208, 256, 260, 195, 189, 133,
+	258, 193, 188, 180, 159, 172, 220, 187, 221, 173,
+	199, 198, 200, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 272, 0, 0,
+	672, 0, 0, 0, 246, 0, 0, 181, 0, 0,
+	0, 655, 0, 231, 213, 685, 0, 218, 229, 185,
+	257, 223, 262, 248, 271, 0, 224, 126, 249, 154,
+	196, 137, 138, 150, 156, 158, 160, 161, 205, 206,
+	216, 236, 250, 251, 252, 153, 145, 230, 146, 169,
+	147, 127, 239, 148, 128, 217, 255, 0, 166, 226,
+	192, 129, 191, 219, 254, 253, 280, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 164, 0, 267, 670,
+	210, 684, 665, 667, 668, 671, 675, 676, 677, 678,
+	679, 681, 683, 686, 234, 0, 0, 0, 0, 0,
+	175, 215, 0, 235, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 243, 265, 278, 617,
+	0, 0, 0, 277, 0, 0, 0, 0, 0, 660,
+	201, 202, 203, 204, 673, 143, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 163, 168, 0, 170,
+	142, 214, 165, 275, 178, 207, 174, 240, 179, 186,
+	227, 274, 212, 232, 141, 264, 241, 190, 692, 669,
+	691, 693, 694, 690, 695, 696, 680, 633, 0, 688,
+	687, 689, 0, 125, 0, 183, 273, 225, 162, 89,
+	595, 596, 597, 598, 599, 600, 601, 97, 602, 99,
+	100, 603, 102, 604, 104, 605, 106, 107, 108, 606,
+	607, 608, 609, 113, 610, 611, 612, 613, 118, 119,
+	120, 121, 614, 615, 616, 0, 0, 281, 282, 283,
+	0, 237, 149, 263, 222, 171, 266, 0, 0, 284,
+	285, 286, 297, 658, 287, 288, 289, 290, 291, 292,
+	293, 294, 295, 296, 0, 761, 0, 0, 0, 632,
+	0, 0, 0, 157, 0, 0, 0, 182, 0, 184,
+	0, 0, 242, 197, 0, 0, 0, 0, 674, 682,
+	0, 0, 0, 0, 0, 0, 0, 649, 0, 0,
+	0, 624, 0, 0, 593, 664, 663, 640, 647, 0,
+	0, 139, 641, 0, 646, 0, 642, 645, 643, 644,
+	0, 0, 666, 0, 0, 0, 0, 0, 591, 628,
+	0, 630, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 625, 626, 0, 0, 0, 0, 659,
+	0, 627, 0, 0, 661, 0, 648, 0, 130, 247,
+	261, 140, 238, 276, 144, 245, 136, 211, 233, 132,
+	259, 244, 194, 176, 177, 131, 0, 228, 155, 167,
+	152, 209, 656, 657, 151, 618, 654, 270, 134, 135,
+	269, 208, 256, 260, 195, 189, 133, 258, 193, 188,
+	180, 159, 172, 220, 187, 221, 173, 199, 198, 200,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 272, 0, 0, 672, 0, 0,
+	0, 246, 0, 0, 181, 0, 0, 0, 655, 0,
+	231, 213, 685, 0, 218, 229, 185, 257, 223, 262,
+	248, 271, 0, 224, 126, 249, 154, 196, 137, 138,
+	150, 156, 158, 160, 161, 205, 206, 216, 236, 250,
+	251, 252, 153, 145, 230, 146, 169, 147, 127, 239,
+	148, 128, 217, 255, 0, 166, 226, 192, 129, 191,
+	219, 254, 253, 280, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 164, 0, 267, 670, 210, 684, 665,
+	667, 668, 671, 675, 676, 677, 678, 679, 681, 683,
+	686, 234, 0, 0, 0, 0, 0, 175, 215, 0,
+	235, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 243, 265, 278, 617, 0, 0, 0,
+	277, 0, 0, 0, 0, 0, 660, 201, 202, 203,
+	204, 673, 143, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 163, 168, 0, 170, 142, 214, 165,
+	275, 178, 207, 174, 240, 179, 186, 227, 274, 212,
+	232, 141, 264, 241, 190, 692, 669, 691, 693, 694,
+	690, 695, 696, 680, 633, 0, 688, 687, 689, 0,
+	125, 0, 183, 273, 225, 162, 89, 595, 596, 597,
+	598, 599, 600, 601, 97, 602, 99, 100, 603, 102,
+	604, 104, 605, 106, 107, 108, 606, 607, 608, 609,
+	113, 610, 611, 612, 613, 118, 119, 120, 121, 614,
+	615, 616, 0, 0, 281, 282, 283, 0, 237, 149,
+	263, 222, 171, 266, 0, 658, 284, 285, 286, 297,
+	0, 287, 288, 289, 290, 291, 292, 293, 294, 295,
+	296, 632, 0, 0, 0, 157, 0, 0, 0, 182,
+	0, 184, 0, 0, 242, 197, 0, 0, 0, 0,
+	674, 682, 0, 0, 0, 0, 0, 0, 0, 649,
+	0, 0, 0, 624, 0, 0, 593, 664, 663, 640,
+	647, 0, 0, 139, 641, 0, 646, 0, 642, 645,
+	643, 644, 0, 0, 666, 0, 0, 0, 0, 0,
+	591, 628, 0, 630, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 625, 626, 0, 0, 0,
+	0, 659, 0, 627, 0, 0, 661, 0, 648, 0,
+	130, 247, 261, 140, 238, 276, 144, 245, 136, 211,
+	233, 132, 259, 244, 194, 176, 177, 131, 0, 228,
+	155, 167, 152, 209, 656, 657, 151, 618, 654, 270,
+	134, 135, 269, 208, 256, 260, 195, 189, 133, 258,
+	193, 188, 180, 159, 172, 220, 187, 221, 173, 199,
+	198, 200, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 272, 0, 0, 672,
+	0, 0, 0, 246, 0, 0, 181, 0, 0, 0,
+	655, 0, 231, 213, 685, 0, 218, 229, 185, 257,
+	223, 262, 248, 271, 0, 224, 126, 249, 154, 196,
+	137, 138, 150, 156, 158, 160, 161, 205, 206, 216,
+	236, 250, 251, 252, 153, 145, 230, 146, 169, 147,
+	127, 239, 148, 128, 217, 255, 0, 166, 226, 192,
+	129, 191, 219, 254, 253, 280, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 164, 0, 267, 670, 210,
+	684, 665, 667, 668, 671, 675, 676, 677, 678, 679,
+	681, 683, 686, 234, 0, 0, 0, 0, 0, 175,
+	215, 0, 235, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 243, 265, 278, 617, 0,
+	0, 0, 277, 0, 0, 0, 0, 0, 660, 201,
+	202, 203, 204, 673, 143, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 163, 168, 0, 170, 142,
+	214, 165, 275, 178, 207, 174, 240, 179, 186, 227,
+	274, 212, 232, 141, 264, 241, 190, 692, 669, 691,
+	693, 694, 690, 695, 696, 680, 633, 0, 688, 687,
+	689, 0, 125, 0, 183, 273, 225, 162, 89, 595,
+	596, 597, 598, 599, 600, 601, 97, 602, 99, 100,
+	603, 102, 604, 104, 605, 106, 107, 108, 606, 607,
+	608, 609, 113, 610, 611, 612, 613, 118, 119, 120,
+	121, 614, 615, 616, 0, 0, 281, 282, 283, 0,
+	237, 149, 263, 222, 171, 266, 0, 658, 284, 285,
+	286, 297, 0, 287, 288, 289, 290, 291, 292, 293,
+	294, 295, 296, 632, 0, 0, 0, 157, 0, 0,
+	0, 182, 0, 184, 0, 0, 242, 197, 0, 0,
+	0, 0, 674, 682, 0, 0, 0, 0, 0, 0,
+	0, 649, 0, 0, 0, 624, 0, 0, 593, 664,
+	663, 640, 647, 0, 0, 139, 641, 0, 646, 0,
+	642, 645, 643, 644, 0, 0, 666, 0, 0, 0,
+	0, 0, 0, 628, 0, 630, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 625, 626, 0,
+	0, 0, 0, 659, 0, 627, 0, 0, 661, 0,
+	648, 0, 130, 247, 261, 140, 238, 276, 144, 245,
+	136, 211, 233, 132, 259, 244, 194, 176, 177, 131,
+	0, 228, 155, 167, 152, 209, 656, 657, 151, 618,
+	654, 270, 134, 135, 269, 208, 256, 260, 195, 189,
+	133, 258, 193, 188, 180, 159, 172, 220, 187, 221,
+	173, 199, 198, 200, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 272, 0,
+	0, 672, 0, 0, 0, 246, 0, 0, 181, 0,
+	0, 0, 655, 0, 231, 213, 685, 0, 218, 229,
+	185, 257, 223, 262, 248, 271, 0, 224, 126, 249,
+	154, 196, 137, 138, 150, 156, 158, 160, 161, 205,
+	206, 216, 236, 250, 251, 252, 153, 145, 230, 146,
+	169, 147, 127, 239, 148, 128, 217, 255, 0, 166,
+	226, 192, 129, 191, 219, 254, 253, 280, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 164, 0, 267,
+	670, 210, 684, 665, 667, 668, 671, 675, 676, 677,
+	678, 679, 681, 683, 686, 234, 0, 0, 0, 0,
+	0, 175, 215, 0, 235, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 243, 265, 278,
+	617, 0, 0, 0, 277, 0, 0, 0, 0, 0,
+	660, 201, 202, 203, 204, 673, 143, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 163, 168, 0,
+	170, 142, 214, 165, 275, 178, 207, 174, 240, 179,
+	186, 227, 274, 212, 232, 141, 264, 241, 190, 692,
+	669, 691, 693, 694, 690, 695, 696, 680, 633, 0,
+	688, 687, 689, 0, 125, 0, 183, 273, 225, 162,
+	89, 595, 596, 597, 598, 599, 600, 601, 97, 602,
+	99, 100, 603, 102, 604, 104, 605, 106, 107, 108,
+	606, 607, 608, 609, 113, 610, 611, 612, 613, 118,
+	119, 120, 121, 614, 615, 616, 0, 0, 281, 282,
+	283, 0, 237, 149, 263, 222, 171, 266, 0, 658,
+	284, 285, 286, 297, 0, 287, 288, 289, 290, 291,
+	292, 293, 294, 295, 296, 632, 0, 0, 0, 157,
+	0, 0, 0, 182, 0, 184, 0, 0, 242, 197,
+	0, 0, 0, 0, 674, 682, 0, 0, 0, 0,
+	0, 0, 0, 649, 0, 0, 0, 0, 0, 0,
+	593, 664, 663, 640, 647, 0, 0, 139, 641, 0,
+	646, 0, 642, 645, 643, 644, 0, 0, 666, 0,
+	0, 0, 0, 0, 591, 628, 0, 630, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 625,
+	626, 0, 0, 0, 0, 659, 0, 627, 0, 0,
+	661, 0, 648, 0, 130, 247, 261, 140, 238, 276,
+	144, 245, 136, 211, 233, 132, 259, 244, 194, 176,
+	177, 131, 0, 228, 155, 167, 152, 209, 656, 657,
+	151, 618, 654, 270, 134, 135, 269, 208, 256, 260,
+	195, 189, 133, 258, 193, 188, 180, 159, 172, 220,
+	187, 221, 173, 199, 198, 200, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	272, 0, 0, 672, 0, 0, 0, 246, 0, 0,
+	181, 0, 0, 0, 655, 0, 231, 213, 685, 0,
+	218, 229, 185, 257, 223, 262, 248, 271, 0, 224,
+	126, 249, 154, 196, 137, 138, 150, 156, 158, 160,
+	161, 205, 206, 216, 236, 250, 251, 252, 153, 145,
+	230, 146, 169, 147, 127, 239, 148, 128, 217, 255,
+	0, 166, 226, 192, 129, 191, 219, 254, 253, 280,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 164,
+	0, 267, 670, 210, 684, 665, 667, 668, 671, 675,
+	676, 677, 678, 679, 681, 683, 686, 234, 0, 0,
+	0, 0, 0, 175, 215, 0, 235, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 243,
+	265, 278, 617, 0, 0, 0, 277, 0, 0, 0,
+	0, 0, 660, 201, 202, 203, 204, 673, 143, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 163,
+	168, 0, 170, 142, 214, 165, 275, 178, 207, 174,
+	240, 179, 186, 227, 274, 212, 232, 141, 264, 241,
+	190, 692, 669, 691, 693, 694, 690, 695, 696, 680,
+	633, 0, 688, 687, 689, 0, 125, 0, 183, 273,
+	225, 162, 89, 595, 596, 597, 598, 599, 600, 601,
+	97, 602, 99, 100, 603, 102, 604, 104, 605, 106,
+	107, 108, 606, 607, 608, 609, 113, 610, 611, 612,
+	613, 118, 119, 120, 121, 614, 615, 616, 0, 0,
+	281, 282, 283, 0, 237, 149, 263, 222, 171, 266,
+	0, 0, 284, 285, 286, 297, 0, 287, 288, 289,
+	290, 291, 292, 293, 294, 295, 296, 334, 0, 333,
+	337, 329, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 325, 0, 0, 0, 0, 0, 0, 0, 157,
+	0, 0, 344, 182, 0, 184, 0, 0, 242, 197,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	347, 0, 0, 348, 0, 0, 0, 139, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 130, 247, 261, 140, 238, 276,
+	144, 245, 136, 211, 233, 132, 259, 244, 194, 176,
+	177, 131, 0, 228, 155, 167, 152, 209, 0, 0,
+	151, 279, 0, 270, 134, 135, 269, 208, 256, 260,
+	195, 189, 133, 258, 193, 188, 180, 159, 172, 220,
+	187, 221, 173, 199, 198, 200, 0, 0, 0, 0,
+	0, 327, 326, 330, 0, 0, 0, 0, 0, 332,
+	272, 0, 0, 0, 0, 0, 0, 246, 0, 0,
+	181, 336, 0, 0, 0, 0, 231, 213, 0, 0,
+	218, 229, 185, 257, 223, 328, 248, 271, 0, 352,
+	126, 249, 154, 196, 137, 138, 150, 156, 158, 160,
+	161, 205, 206, 216, 236, 250, 251, 252, 153, 145,
+	230, 146, 169, 147, 127, 239, 148, 128, 217, 255,
+	0, 166, 226, 192, 129, 191, 219, 254, 253, 280,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 164,
+	0, 267, 0, 210, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 234, 0, 0,
+	0, 331, 335, 338, 215, 339, 340, 0, 0, 341,
+	342, 343, 0, 0, 345, 346, 0, 0, 0, 243,
+	265, 278, 268, 0, 0, 0, 277, 0, 0, 0,
+	0, 0, 0, 201, 202, 203, 204, 0, 143, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 163,
+	168, 0, 170, 142, 214, 165, 275, 178, 207, 174,
+	240, 179, 186, 227, 274, 212, 232, 141, 264, 241,
+	190, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 125, 0, 183, 273,
+	225, 162, 89, 90, 91, 92, 93, 94, 95, 96,
+	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
+	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
+	117, 118, 119, 120, 121, 122, 123, 124, 0, 0,
+	281, 282, 283, 0, 237, 149, 263, 222, 171, 266,
+	0, 0, 284, 285, 286, 297, 0, 287, 288, 289,
+	290, 291, 292, 293, 294, 295, 296, 334, 0, 333,
+	337, 329, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 325, 0, 0, 0, 0, 0, 0, 0, 157,
+	0, 0, 344, 182, 0, 184, 0, 0, 242, 197,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	347, 0, 0, 348, 0, 0, 0, 139, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 130, 247, 261, 140, 238, 276,
+	144, 245, 136, 211, 233, 132, 259, 244, 194, 176,
+	177, 131, 0, 228, 155, 167, 152, 209, 0, 0,
+	151, 279, 0, 270, 134, 135, 269, 208, 256, 260,
+	195, 189, 133, 258, 193, 188, 180, 159, 172, 220,
+	187, 221, 173, 199, 198, 200, 0, 0, 0, 0,
+	0, 327, 326, 330, 0, 0, 0, 0, 0, 332,
+	272, 0, 0, 0, 0, 0, 0, 246, 0, 0,
+	181, 336, 0, 0, 0, 0, 231, 213, 0, 0,
+	218, 229, 185, 257, 223, 328, 248, 271, 0, 224,
+	126, 249, 154, 196, 137, 138, 150, 156, 158, 160,
+	161, 205, 206, 216, 236, 250, 251, 252, 153, 145,
+	230, 146, 169, 147, 127, 239, 148, 128, 217, 255,
+	0, 166, 226, 192, 129, 191, 219, 254, 253, 280,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 164,
+	0, 267, 0, 210, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 234, 0, 0,
+	0, 331, 335, 338, 215, 339, 340, 0, 0, 341,
+	342, 343, 0, 0, 345, 346, 0, 0, 0, 243,
+	265, 278, 268, 0, 0, 0, 277, 0, 0, 0,
+	0, 0, 0, 201, 202, 203, 204, 0, 143, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 163,
+	168, 0, 170, 142, 214, 165, 275, 178, 207, 174,
+	240, 179, 186, 227, 274, 212, 232, 141, 264, 241,
+	190, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 125, 0, 183, 273,
+	225, 162, 89, 90, 91, 92, 93, 94, 95, 96,
+	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
+	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
+	117, 118, 119, 120, 121, 122, 123, 124, 0, 0,
+	281, 282, 283, 0, 237, 149, 263, 222, 171, 266,
+	0, 0, 284, 285, 286, 297, 0, 287, 288, 289,
+	290, 291, 292, 293, 294, 295, 296, 157, 0, 0,
+	0, 182, 0, 184, 0, 0, 242, 197, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 86, 0,
+	0, 0, 0, 0, 0, 139, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1594, 1597, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 130, 247, 261, 140, 238, 276, 144, 245,
+	136, 211, 233, 132, 259, 244, 194, 176, 177, 131,
+	0, 228, 155, 167, 152, 209, 0, 0, 151, 279,
+	0, 270, 134, 135, 269, 208, 256, 260, 195, 189,
+	133, 258, 193, 188, 180, 159, 172, 220, 187, 221,
+	173, 199, 198, 200, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1598, 272, 0,
+	0, 0, 1591, 0, 1590, 246, 1592, 1595, 181, 0,
+	0, 0, 0, 0, 231, 213, 0, 0, 218, 229,
+	185, 257, 223, 262, 248, 271, 0, 224, 126, 249,
+	154, 196, 137, 138, 150, 156, 158, 160, 161, 205,
+	206, 216, 236, 250, 251, 252, 153, 145, 230, 146,
+	169, 147, 127, 239, 148, 128, 217, 255, 1596, 166,
+	226, 192, 129, 191, 219, 254, 253, 280, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 164, 0, 267,
+	0, 210, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 234, 0, 0, 0, 0,
+	0, 175, 215, 0, 235, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 243, 265, 278,
+	268, 0, 0, 0, 277, 0, 0, 0, 0, 0,
+	0, 201, 202, 203, 204, 0, 143, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 163, 168, 0,
+	170, 142, 214, 165, 275, 178, 207, 174, 240, 179,
+	186, 227, 274, 212, 232, 141, 264, 241, 190, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 125, 0, 183, 273, 225, 162,
+	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
+	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
+	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
+	119, 120, 121, 122, 123, 124, 0, 0, 281, 282,
+	283, 0, 237, 149, 263, 222, 171, 266, 0, 0,
+	284, 285, 286, 297, 0, 287, 288, 289, 290, 291,
+	292, 293, 294, 295, 296, 80, 0, 24, 41, 25,
+	0, 0, 0, 0, 0, 0, 0, 0, 300, 0,
+	0, 0, 0, 0, 0, 0, 0, 157, 0, 0,
+	0, 182, 0, 184, 0, 0, 242, 197, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 77, 0, 0, 86, 0,
+	0, 0, 0, 0, 0, 139, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 130, 247, 261, 140, 238, 276, 144, 245,
+	136, 211, 233, 132, 259, 244, 194, 176, 177, 131,
+	0, 228, 155, 167, 152, 209, 0, 0, 151, 279,
+	0, 270, 134, 135, 269, 208, 256, 260, 195, 189,
+	133, 258, 193, 188, 180, 159, 172, 220, 187, 221,
+	173, 199, 198, 200, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 303, 0, 0, 0, 0, 272, 0,
+	0, 0, 0, 0, 0, 246, 0, 0, 181, 0,
+	0, 0, 0, 0, 231, 213, 0, 0, 218, 229,
+	185, 257, 223, 262, 248, 271, 0, 224, 126, 249,
+	154, 196, 137, 138, 150, 156, 158, 160, 161, 205,
+	206, 216, 236, 250, 251, 252, 153, 145, 230, 146,
+	169, 147, 127, 239, 148, 128, 217, 255, 0, 166,
+	226, 192, 129, 191, 219, 254, 253, 280, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 164, 0, 267,
+	0, 210, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 234, 0, 0, 0, 0,
+	0, 175, 215, 0, 235, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 243, 265, 278,
+	268, 0, 0, 0, 277, 0, 0, 0, 0, 0,
+	0, 201, 202, 203, 204, 301, 143, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 163, 168, 0,
+	170, 142, 214, 165, 275, 178, 207, 174, 240, 179,
+	186, 227, 274, 212, 232, 141, 264, 241, 190, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 125, 0, 183, 273, 225, 162,
+	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
+	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
+	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
+	119, 120, 121, 122, 123, 124, 0, 0, 281, 282,
+	283, 0, 237, 149, 263, 222, 171, 266, 0, 0,
+	284, 285, 286, 297, 0, 287, 288, 289, 290, 291,
+	292, 293, 294, 295, 296, 157, 402, 0, 0, 182,
+	0, 184, 0, 0, 242, 197, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 86, 410, 411, 0,
+	0, 0, 0, 139, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 415, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	130, 247, 261, 140, 238, 276, 144, 245, 136, 211,
+	233, 132, 259, 244, 194, 176, 177, 131, 0, 228,
+	155, 167, 152, 209, 0, 0, 151, 279, 417, 270,
+	134, 416, 269, 208, 256, 260, 195, 189, 133, 258,
+	193, 188, 180, 159, 172, 220, 187, 221, 173, 199,
+	198, 200, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 272, 0, 0, 0,
+	0, 0, 0, 246, 0, 0, 181, 0, 0, 0,
+	0, 0, 231, 213, 0, 0, 218, 229, 185, 257,
+	223, 262, 248, 271, 401, 224, 126, 249, 154, 196,
+	137, 138, 150, 156, 158, 160, 161, 205, 206, 216,
+	236, 250, 251, 252, 153, 145, 230, 146, 169, 147,
+	127, 239, 148, 128, 217, 255, 0, 166, 226, 192,
+	129, 191, 219, 254, 253, 280, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 164, 0, 267, 0, 210,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 234, 0, 0, 0, 0, 0, 175,
+	215, 0, 235, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 243, 265, 278, 268, 0,
+	0, 0, 277, 0, 0, 0, 0, 0, 404, 201,
+	202, 203, 204, 0, 143, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 163, 168, 0, 170, 142,
+	214, 165, 275, 178, 412, 407, 408, 179, 186, 227,
+	274, 212, 232, 141, 264, 241, 409, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 125, 0, 183, 273, 225, 162, 89, 90,
+	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
+	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
+	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
+	121, 122, 123, 124, 0, 0, 281, 282, 283, 0,
+	237, 149, 263, 222, 171, 266, 0, 0, 284, 285,
+	286, 297, 0, 287, 288, 289, 290, 291, 292, 293,
+	294, 295, 296, 844, 0, 0, 0, 0, 157, 0,
+	0, 0, 182, 0, 184, 0, 0, 242, 197, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 86,
+	0, 0, 0, 0, 0, 0, 139, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	841, 842, 840, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 130, 247, 261, 140, 238, 276, 144,
+	245, 136, 211, 233, 132, 259, 244, 194, 176, 177,
+	131, 0, 228, 155, 167, 152, 209, 0, 0, 151,
+	279, 0, 270, 134, 135, 269, 208, 256, 260, 195,
+	189, 133, 258, 193, 188, 180, 159, 172, 220, 187,
+	221, 173, 199, 198, 200, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 272,
+	0, 0, 0, 0, 0, 0, 246, 0, 0, 181,
+	0, 0, 0, 0, 0, 231, 213, 0, 0, 218,
+	229, 185, 257, 223, 262, 248, 271, 0, 224, 126,
+	249, 154, 196, 137, 138, 150, 156, 158, 160, 161,
+	205, 206, 216, 236, 250, 251, 252, 153, 145, 230,
+	146, 169, 147, 127, 239, 148, 128, 217, 255, 0,
+	166, 226, 192, 129, 191, 219, 254, 253, 280, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 164, 0,
+	267, 0, 210, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 234, 0, 0, 0,
+	0, 0, 175, 215, 0, 235, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 243, 265,
+	278, 268, 0, 0, 0, 277, 0, 0, 0, 0,
+	0, 0, 201, 202, 203, 204, 0, 143, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 163, 168,
+	0, 170, 142, 214, 165, 275, 178, 207, 174, 240,
+	179, 186, 227, 274, 212, 232, 141, 264, 241, 190,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 125, 0, 183, 273, 225,
+	162, 89, 90, 91, 92, 93, 94, 95, 96, 97,
+	98, 99, 100, 101, 102, 103, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 119, 120, 121, 122, 123, 124, 0, 0, 281,
+	282, 283, 0, 237, 149, 263, 222, 171, 266, 0,
+	0, 284, 285, 286, 297, 0, 287, 288, 289, 290,
+	291, 292, 293, 294, 295, 296, 157, 0, 0, 0,
+	182, 0, 184, 0, 0, 242, 197, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 86, 410, 411,
+	0, 0, 0, 0, 139, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 415, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 130, 247, 261, 140, 238, 276, 144, 245, 136,
+	211, 233, 132, 259, 244, 194, 176, 177, 131, 0,
+	228, 155, 167, 152, 209, 0, 0, 151, 279, 417,
+	270, 134, 416, 269, 208, 256, 260, 195, 189, 133,
+	258, 193, 188, 180, 159, 172, 220, 187, 221, 173,
+	199, 198, 200, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 272, 0, 0,
+	0, 0, 0, 0, 246, 0, 0, 181, 0, 0,
+	0, 0, 0, 231, 213, 0, 0, 218, 229, 185,
+	257, 223, 262, 248, 271, 0, 224, 126, 249, 154,
+	196, 137, 138, 150, 156, 158, 160, 161, 205, 206,
+	216, 236, 250, 251, 252, 153, 145, 230, 146, 169,
+	147, 127, 239, 148, 128, 217, 255, 0, 166, 226,
+	192, 129, 191, 219, 254, 253, 280, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 164, 0, 267, 0,
+	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 234, 0, 0, 0, 0, 0,
+	175, 215, 0, 235, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 243, 265, 278, 268,
+	0, 0, 0, 277, 0, 0, 0, 0, 0, 0,
+	201, 202, 203, 204, 0, 143, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 163, 168, 0, 170,
+	142, 214, 165, 275, 178, 412, 407, 408, 179, 186,
+	227, 274, 212, 232, 141, 264, 241, 409, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 125, 0, 183, 273, 225, 162, 89,
+	90, 91, 92, 93, 94, 95, 96, 97, 98, 99,
+	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
+	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
+	120, 121, 122, 123, 124, 0, 0, 281, 282, 283,
+	0, 237, 149, 263, 222, 171, 266, 0, 0, 284,
+	285, 286, 297, 0, 287, 288, 289, 290, 291, 292,
+	293, 294, 295, 296, 549, 0, 0, 0, 0, 0,
+	0, 0, 157, 550, 0, 0, 182, 0, 184, 0,
+	0, 242, 197, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 347, 0, 0, 348, 0, 0, 0,
+	139, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 130, 247, 261,
+	140, 238, 276, 144, 245, 136, 211, 233, 132, 259,
+	244, 194, 176, 177, 131, 0, 228, 155, 167, 152,
+	209, 0, 0, 151, 279, 0, 270, 134, 135, 269,
+	208, 256, 260, 195, 189, 133, 258, 193, 188, 180,
+	159, 172, 220, 187, 221, 173, 199, 198, 200, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 272, 0, 0, 0, 0, 0, 0,
+	246, 0, 0, 181, 0, 0, 0, 0, 0, 231,
+	213, 0, 0, 218, 229, 185, 257, 223, 262, 248,
+	271, 0, 224, 126, 249, 154, 196, 137, 138, 150,
+	156, 158, 160, 161, 205, 206, 216, 236, 250, 251,
+	252, 153, 145, 230, 146, 169, 147, 127, 239, 148,
+	128, 217, 255, 0, 166, 226, 192, 129, 191, 219,
+	254, 253, 280, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 164, 0, 267, 0, 210, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	234, 0, 0, 0, 0, 0, 175, 215, 0, 235,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 243, 265, 278, 268, 0, 0, 0, 277,
+	0, 0, 0, 0, 551, 0, 201, 202, 203, 204,
+	0, 143, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 163, 168, 0, 170, 142, 214, 165, 275,
+	178, 207, 174, 240, 179, 186, 227, 274, 212, 232,
+	141, 264, 241, 190, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 125,
+	0, 183, 273, 225, 162, 89, 90, 91, 92, 93,
+	94, 95, 96, 97, 98, 99, 100, 101, 102, 103,
+	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
+	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
+	124, 0, 0, 281, 282, 283, 0, 237, 149, 263,
+	222, 171, 266, 80, 0, 284, 285, 286, 297, 0,
+	287, 288, 289, 290, 291, 292, 293, 294, 295, 296,
+	0, 0, 0, 0, 0, 157, 0, 0, 0, 182,
+	0, 184, 0, 0, 242, 197, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 77, 0, 933, 86, 0, 0, 0,
+	0, 0, 0, 139, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	130, 247, 261, 140, 238, 276, 144, 245, 136, 211,
+	233, 132, 259, 244, 194, 176, 177, 131, 0, 228,
+	155, 167, 152, 209, 0, 0, 151, 279, 0, 270,
+	134, 135, 269, 208, 256, 260, 195, 189, 133, 258,
+	193, 188, 180, 159, 172, 220, 187, 221, 173, 199,
+	198, 200, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 272, 0, 0, 0,
+	0, 0, 0, 246, 0, 0, 181, 0, 0, 0,
+	0, 0, 231, 213, 0, 0, 218, 229, 185, 257,
+	223, 262, 248, 271, 0, 224, 126, 249, 154, 196,
+	137, 138, 150, 156, 158, 160, 161, 205, 206, 216,
+	236, 250, 251, 252, 153, 145, 230, 146, 169, 147,
+	127, 239, 148, 128, 217, 255, 0, 166, 226, 192,
+	129, 191, 219, 254, 253, 280, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 164, 0, 267, 0, 210,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 234, 0, 0, 0, 0, 0, 175,
+	215, 0, 235, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 243, 265, 278, 268, 0,
+	0, 0, 277, 0, 0, 0, 0, 0, 0, 201,
+	202, 203, 204, 0, 143, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 163, 168, 0, 170, 142,
+	214, 165, 275, 178, 207, 174, 240, 179, 186, 227,
+	274, 212, 232, 141, 264, 241, 190, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 125, 0, 183, 273, 225, 162, 89, 90,
+	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
+	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
+	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
+	121, 122, 123, 124, 0, 0, 281, 282, 283, 0,
+	237, 149, 263, 222, 171, 266, 0, 0, 284, 285,
+	286, 297, 0, 287, 288, 289, 290, 291, 292, 293,
+	294, 295, 296, 806, 0, 0, 0, 0, 0, 0,
+	0, 157, 0, 0, 0, 182, 0, 184, 0, 0,
+	242, 197, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 347, 0, 0, 348, 0, 0, 0, 139,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 130, 247, 261, 140,
+	238, 276, 144, 245, 136, 211, 233, 132, 259, 244,
+	194, 176, 177, 131, 0, 228, 155, 167, 152, 209,
+	0, 0, 151, 279, 0, 270, 134, 135, 269, 208,
+	256, 260, 195, 189, 133, 258, 193, 188, 180, 159,
+	172, 220, 187, 221, 173, 199, 198, 200, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 272, 0, 0, 0, 0, 0, 0, 246,
+	0, 0, 181, 0, 0, 0, 0, 0, 231, 213,
+	0, 0, 218, 229, 185, 257, 223, 262, 248, 271,
+	0, 224, 126, 249, 154, 196, 137, 138, 150, 156,
+	158, 160, 161, 205, 206, 216, 236, 250, 251, 252,
+	153, 145, 230, 146, 169, 147, 127, 239, 148, 128,
+	217, 255, 0, 166, 226, 192, 129, 191, 219, 254,
+	253, 280, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 164, 0, 267, 0, 210, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 234,
+	0, 0, 0, 0, 0, 175, 215, 0, 235, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 243, 265, 278, 268, 0, 0, 0, 277, 0,
+	0, 0, 0, 805, 0, 201, 202, 203, 204, 0,
+	143, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 163, 168, 0, 170, 142, 214, 165, 275, 178,
+	207, 174, 240, 179, 186, 227, 274, 212, 232, 141,
+	264, 241, 190, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 125, 0,
+	183, 273, 225, 162, 89, 90, 91, 92, 93, 94,
+	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
+	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	0, 0, 281, 282, 283, 0, 237, 149, 263, 222,
+	171, 266, 0, 0, 284, 285, 286, 297, 0, 287,
+	288, 289, 290, 291, 292, 293, 294, 295, 296, 157,
+	0, 0, 0, 182, 0, 184, 0, 0, 242, 197,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2141,
+	86, 664, 0, 0, 0, 0, 0, 139, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 130, 247, 261, 140, 238, 276,
+	144, 245, 136, 211, 233, 132, 259, 244, 194, 176,
+	177, 131, 0, 228, 155, 167, 152, 209, 0, 0,
+	151, 279, 0, 270, 134, 135, 269, 208, 256, 260,
+	195, 189, 133, 258, 193, 188, 180, 159, 172, 220,
+	187, 221, 173, 199, 198, 200, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	272, 0, 0, 0, 0, 0, 0, 246, 0, 0,
+	181, 0, 0, 0, 0, 0, 231, 213, 0, 0,
+	218, 229, 185, 257, 223, 262, 248, 271, 0, 224,
+	126, 249, 154, 196, 137, 138, 150, 156, 158, 160,
+	161, 205, 206, 216, 236, 250, 251, 252, 153, 145,
+	230, 146, 169, 147, 127, 239, 148, 128, 217, 255,
+	0, 166, 226, 192, 129, 191, 219, 254, 253, 280,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 164,
+	0, 267, 0, 210, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 234, 0, 0,
+	0, 0, 0, 175, 215, 0, 235, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 243,
+	265, 278, 268, 0, 0, 0, 277, 0, 0, 0,
+	0, 0, 0, 201, 202, 203, 204, 0, 143, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 163,
+	168, 0, 170, 142, 214, 165, 275, 178, 207, 174,
+	240, 179, 186, 227, 274, 212, 232, 141, 264, 241,
+	190, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 125, 0, 183, 273,
+	225, 162, 89, 90, 91, 92, 93, 94, 95, 96,
+	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
+	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
+	117, 118, 119, 120, 121, 122, 123, 124, 0, 0,
+	281, 282, 283, 0, 237, 149, 263, 222, 171, 266,
+	0, 0, 284, 285, 286, 297, 0, 287, 288, 289,
+	290, 291, 292, 293, 294, 295, 296, 157, 0, 0,
+	0, 182, 0, 184, 0, 0, 242, 197, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 86, 0,
+	0, 743, 0, 0, 0, 139, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 130, 247, 261, 140, 238, 276, 144, 245,
+	136, 211, 233, 132, 259, 244, 194, 176, 177, 131,
+	0, 228, 155, 167, 152, 209, 0, 0, 151, 279,
+	0, 270, 134, 135, 269, 208, 256, 260, 195, 189,
+	133, 258, 193, 188, 180, 159, 172, 220, 187, 221,
+	173, 199, 198, 200, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 272, 0,
+	0, 0, 0, 0, 0, 246, 0, 0, 181, 0,
+	0, 0, 0, 0, 231, 213, 0, 0, 218, 229,
+	185, 257, 223, 262, 248, 271, 0, 224, 126, 249,
+	154, 196, 137, 138, 150, 156, 158, 160, 161, 205,
+	206, 216, 236, 250, 251, 252, 153, 145, 230, 146,
+	169, 147, 127, 239, 148, 128, 217, 255, 0, 166,
+	226, 192, 129, 191, 219, 254, 253, 280, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 164, 0, 267,
+	0, 210, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 234, 0, 0, 0, 0,
+	0, 175, 215, 0, 235, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 243, 265, 278,
+	268, 0, 0, 0, 277, 0, 0, 0, 0, 0,
+	1541, 201, 202, 203, 204, 0, 143, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 163, 168, 0,
+	170, 142, 214, 165, 275, 178, 207, 174, 240, 179,
+	186, 227, 274, 212, 232, 141, 264, 241, 190, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 125, 0, 183, 273, 225, 162,
+	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
+	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
+	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
+	119, 120, 121, 122, 123, 124, 0, 0, 281, 282,
+	283, 0, 237, 149, 263, 222, 171, 266, 0, 0,
+	284, 285, 286, 297, 0, 287, 288, 289, 290, 291,
+	292, 293, 294, 295, 296, 157, 1198, 0, 0, 182,
+	0, 184, 0, 0, 242, 197, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 86, 0, 0, 743,
+	0, 0, 0, 139, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	130, 247, 261, 140, 238, 276, 144, 245, 136, 211,
+	233, 132, 259, 244, 194, 176, 177, 131, 0, 228,
+	155, 167, 152, 209, 0, 0, 151, 279, 0, 270,
+	134, 135, 269, 208, 256, 260, 195, 189, 133, 258,
+	193, 188, 180, 159, 172, 220, 187, 221, 173, 199,
+	198, 200, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 272, 0, 0, 0,
+	0, 0, 0, 246, 0, 0, 181, 0, 0, 0,
+	0, 0, 231, 213, 0, 0, 218, 229, 185, 257,
+	223, 262, 248, 271, 0, 224, 126, 249, 154, 196,
+	137, 138, 150, 156, 158, 160, 161, 205, 206, 216,
+	236, 250, 251, 252, 153, 145, 230, 146, 169, 147,
+	127, 239, 148, 128, 217, 255, 0, 166, 226, 192,
+	129, 191, 219, 254, 253, 280, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 164, 0, 267, 0, 210,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 234, 0, 0, 0, 0, 0, 175,
+	215, 0, 235, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 243, 265, 278, 268, 0,
+	0, 0, 277, 0, 0, 0, 0, 0, 0, 201,
+	202, 203, 204, 0, 143, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 163, 168, 0, 170, 142,
+	214, 165, 275, 178, 207, 174, 240, 179, 186, 227,
+	274, 212, 232, 141, 264, 241, 190, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 125, 0, 183, 273, 225, 162, 89, 90,
+	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
+	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
+	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
+	121, 122, 123, 124, 0, 0, 281, 282, 283, 0,
+	237, 149, 263, 222, 171, 266, 0, 0, 284, 285,
+	286, 297, 0, 287, 288, 289, 290, 291, 292, 293,
+	294, 295, 296, 157, 0, 0, 0, 182, 0, 184,
+	0, 0, 242, 197, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 86, 664, 0, 0, 0, 0,
+	0, 139, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 130, 247,
+	261, 140, 238, 276, 144, 245, 136, 211, 233, 132,
+	259, 244, 194, 176, 177, 131, 0, 228, 155, 167,
+	152, 209, 0, 0, 151, 279, 0, 270, 134, 135,
+	269, 208, 256, 260, 195, 189, 133, 258, 193, 188,
+	180, 159, 172, 220, 187, 221, 173, 199, 198, 200,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 272, 0, 0, 0, 0, 0,
+	0, 246, 0, 0, 181, 0, 0, 0, 0, 0,
+	231, 213, 0, 0, 218, 229, 185, 257, 223, 262,
+	248, 271, 0, 224, 126, 249, 154, 196, 137, 138,
+	150, 156, 158, 160, 161, 205, 206, 216, 236, 250,
+	251, 252, 153, 145, 230, 146, 169, 147, 127, 239,
+	148, 128, 217, 255, 0, 166, 226, 192, 129, 191,
+	219, 254, 253, 280, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 164, 0, 267, 0, 210, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 234, 0, 0, 0, 0, 0, 175, 215, 0,
+	235, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 243, 265, 278, 268, 0, 0, 0,
+	277, 0, 0, 0, 0, 0, 0, 201, 202, 203,
+	204, 0, 143, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 163, 168, 0, 170, 142, 214, 165,
+	275, 178, 207, 174, 240, 179, 186, 227, 274, 212,
+	232, 141, 264, 241, 190, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	125, 0, 183, 273, 225, 162, 89, 90, 91, 92,
+	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
+	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
+	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
+	123, 124, 0, 0, 281, 282, 283, 0, 237, 149,
+	263, 222, 171, 266, 0, 0, 284, 285, 286, 297,
+	0, 287, 288, 289, 290, 291, 292, 293, 294, 295,
+	296, 157, 0, 0, 0, 182, 0, 184, 0, 0,
+	242, 197, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1809,
+	0, 0, 86, 0, 0, 0, 0, 0, 0, 139,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 130, 247, 261, 140,
+	238, 276, 144, 245, 136, 211, 233, 132, 259, 244,
+	194, 176, 177, 131, 0, 228, 155, 167, 152, 209,
+	0, 0, 151, 279, 0, 270, 134, 135, 269, 208,
+	256, 260, 195, 189, 133, 258, 193, 188, 180, 159,
+	172, 220, 187, 221, 173, 199, 198, 200, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 272, 0, 0, 0, 0, 0, 0, 246,
+	0, 0, 181, 0, 0, 0, 0, 0, 231, 213,
+	0, 0, 218, 229, 185, 257, 223, 262, 248, 271,
+	0, 224, 126, 249, 154, 196, 137, 138, 150, 156,
+	158, 160, 161, 205, 206, 216, 236, 250, 251, 252,
+	153, 145, 230, 146, 169, 147, 127, 239, 148, 128,
+	217, 255, 0, 166, 226, 192, 129, 191, 219, 254,
+	253, 280, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 164, 0, 267, 0, 210, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 234,
+	0, 0, 0, 0, 0, 175, 215, 0, 235, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 243, 265, 278, 268, 0, 0, 0, 277, 0,
+	0, 0, 0, 0, 0, 201, 202, 203, 204, 0,
+	143, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 163, 168, 0, 170, 142, 214, 165, 275, 178,
+	207, 174, 240, 179, 186, 227, 274, 212, 232, 141,
+	264, 241, 190, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 125, 0,
+	183, 273, 225, 162, 89, 90, 91, 92, 93, 94,
+	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
+	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	0, 0, 281, 282, 283, 0, 237, 149, 263, 222,
+	171, 266, 0, 0, 284, 285, 286, 297, 0, 287,
+	288, 289, 290, 291, 292, 293, 294, 295, 296, 157,
+	0, 0, 0, 182, 0, 184, 0, 0, 242, 197,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	86, 0, 0, 743, 0, 0, 0, 139, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 130, 247, 261, 140, 238, 276,
+	144, 245, 136, 211, 233, 132, 259, 244, 194, 176,
+	177, 131, 0, 228, 155, 167, 152, 209, 0, 0,
+	151, 279, 0, 270, 134, 135, 269, 208, 256, 260,
+	195, 189, 133, 258, 193, 188, 180, 159, 172, 220,
+	187, 221, 173, 199, 198, 200, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	272, 0, 0, 0, 0, 0, 0, 246, 0, 0,
+	181, 0, 0, 0, 0, 0, 231, 213, 0, 0,
+	218, 229, 185, 257, 223, 262, 248, 271, 0, 224,
+	126, 249, 154, 196, 137, 138, 150, 156, 158, 160,
+	161, 205, 206, 216, 236, 250, 251, 252, 153, 145,
+	230, 146, 169, 147, 127, 239, 148, 128, 217, 255,
+	0, 166, 226, 192, 129, 191, 219, 254, 253, 280,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 164,
+	0, 267, 0, 210, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 234, 0, 0,
+	0, 0, 0, 175, 215, 0, 235, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 243,
+	265, 278, 268, 0, 0, 0, 277, 0, 0, 0,
+	0, 0, 0, 201, 202, 203, 204, 0, 143, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 163,
+	168, 0, 170, 142, 214, 165, 275, 178, 207, 174,
+	240, 179, 186, 227, 274, 212, 232, 141, 264, 241,
+	190, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 125, 0, 183, 273,
+	225, 162, 89, 90, 91, 92, 93, 94, 95, 96,
+	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
+	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
+	117, 118, 119, 120, 121, 122, 123, 124, 0, 0,
+	281, 282, 283, 0, 237, 149, 263, 222, 171, 266,
+	0, 0, 284, 285, 286, 297, 0, 287, 288, 289,
+	290, 291, 292, 293, 294, 295, 296, 157, 0, 0,
+	0, 182, 0, 184, 0, 0, 242, 197, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 86, 0,
+	0, 0, 0, 0, 0, 139, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1628,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 130, 247, 261, 140, 238, 276, 144, 245,
+	136, 211, 233, 132, 259, 244, 194, 176, 177, 131,
+	0, 228, 155, 167, 152, 209, 0, 0, 151, 279,
+	0, 270, 134, 135, 269, 208, 256, 260, 195, 189,
+	133, 258, 193, 188, 180, 159, 172, 220, 187, 221,
+	173, 199, 198, 200, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 272, 0,
+	0, 0, 0, 0, 0, 246, 0, 0, 181, 0,
+	0, 0, 0, 0, 231, 213, 0, 0, 218, 229,
+	185, 257, 223, 262, 248, 271, 0, 224, 126, 249,
+	154, 196, 137, 138, 150, 156, 158, 160, 161, 205,
+	206, 216, 236, 250, 251, 252, 153, 145, 230, 146,
+	169, 147, 127, 239, 148, 128, 217, 255, 0, 166,
+	226, 192, 129, 191, 219, 254, 253, 280, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 164, 0, 267,
+	0, 210, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 234, 0, 0, 0, 0,
+	0, 175, 215, 0, 235, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 243, 265, 278,
+	268, 0, 0, 0, 277, 0, 0, 0, 0, 0,
+	0, 201, 202, 203, 204, 0, 143, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 163, 168, 0,
+	170, 142, 214, 165, 275, 178, 207, 174, 240, 179,
+	186, 227, 274, 212, 232, 141, 264, 241, 190, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 125, 0, 183, 273, 225, 162,
+	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
+	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
+	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
+	119, 120, 121, 122, 123, 124, 0, 0, 281, 282,
+	283, 0, 237, 149, 263, 222, 171, 266, 0, 0,
+	284, 285, 286, 297, 0, 287, 288, 289, 290, 291,
+	292, 293, 294, 295, 296, 157, 0, 0, 0, 182,
+	0, 184, 0, 0, 242, 197, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 316, 0, 0, 86, 0, 0, 0,
+	0, 0, 0, 139, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	130, 247, 261, 140, 238, 276, 144, 245, 136, 211,
+	233, 132, 259, 244, 194, 176, 177, 131, 0, 228,
+	155, 167, 152, 209, 0, 0, 151, 279, 0, 270,
+	134, 135, 269, 208, 256, 260, 195, 189, 133, 258,
+	193, 188, 180, 159, 172, 220, 187, 221, 173, 199,
+	198, 200, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 272, 0, 0, 0,
+	0, 0, 0, 246, 0, 0, 181, 0, 0, 0,
+	0, 0, 231, 213, 0, 0, 218, 229, 185, 257,
+	223, 262, 248, 271, 0, 224, 126, 249, 154, 196,
+	137, 138, 150, 156, 158, 160, 161, 205, 206, 216,
+	236, 250, 251, 252, 153, 145, 230, 146, 169, 147,
+	127, 239, 148, 128, 217, 255, 0, 166, 226, 192,
+	129, 191, 219, 254, 253, 280, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 164, 0, 267, 0, 210,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 234, 0, 0, 0, 0, 0, 175,
+	215, 0, 235, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 243, 265, 278, 268, 0,
+	0, 0, 277, 0, 0, 0, 0, 0, 0, 201,
+	202, 203, 204, 0, 143, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 163, 168, 0, 170, 142,
+	214, 165, 275, 178, 207, 174, 240, 179, 186, 227,
+	274, 212, 232, 141, 264, 241, 190, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 125, 0, 183, 273, 225, 162, 89, 90,
+	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
+	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
+	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
+	121, 122, 123, 124, 0, 0, 281, 282, 283, 0,
+	237, 149, 263, 222, 171, 266, 0, 0, 284, 285,
+	286, 297, 0, 287, 288, 289, 290, 291, 292, 293,
+	294, 295, 296, 157, 0, 0, 0, 182, 0, 184,
+	0, 0, 242, 197, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 86, 0, 0, 0, 0, 0,
+	0, 139, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1211, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 130, 247,
+	261, 140, 238, 276, 144, 245, 136, 211, 233, 132,
+	259, 244, 194, 176, 177, 131, 0, 228, 155, 167,
+	152, 209, 0, 0, 151, 279, 0, 270, 134, 135,
+	269, 208, 256, 260, 195, 189, 133, 258, 193, 188,
+	180, 159, 172, 220, 187, 221, 173, 199, 198, 200,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 272, 0, 0, 0, 0, 0,
+	0, 246, 0, 0, 181, 0, 0, 0, 0, 0,
+	231, 213, 0, 0, 218, 229, 185, 257, 223, 262,
+	248, 271, 0, 224, 126, 249, 154, 196, 137, 138,
+	150, 156, 158, 160, 161, 205, 206, 216, 236, 250,
+	251, 252, 153, 145, 230, 146, 169, 147, 127, 239,
+	148, 128, 217, 255, 0, 166, 226, 192, 129, 191,
+	219, 254, 253, 280, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 164, 0, 267, 0, 210, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 234, 0, 0, 0, 0, 0, 175, 215, 0,
+	235, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 243, 265, 278, 268, 0, 0, 0,
+	277, 0, 0, 0, 0, 0, 0, 201, 202, 203,
+	204, 0, 143, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 163, 168, 0, 170, 142, 214, 165,
+	275, 178, 207, 174, 240, 179, 186, 227, 274, 212,
+	232, 141, 264, 241, 190, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	125, 0, 183, 273, 225, 162, 89, 90, 91, 92,
+	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
+	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
+	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
+	123, 124, 0, 0, 281, 282, 283, 0, 237, 149,
+	263, 222, 171, 266, 0, 0, 284, 285, 286, 297,
+	0, 287, 288, 289, 290, 291, 292, 293, 294, 295,
+	296, 157, 0, 0, 0, 182, 0, 184, 0, 0,
+	242, 197, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 347, 0, 0, 348, 0, 0, 0, 139,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 130, 247, 261, 140,
+	238, 276, 144, 245, 136, 211, 233, 132, 259, 244,
+	194, 176, 177, 131, 0, 228, 155, 167, 152, 209,
+	0, 0, 151, 279, 0, 270, 134, 135, 269, 208,
+	256, 260, 195, 189, 133, 258, 193, 188, 180, 159,
+	172, 220, 187, 221, 173, 199, 198, 200, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 272, 0, 0, 0, 0, 0, 0, 246,
+	0, 0, 181, 0, 0, 0, 0, 0, 231, 213,
+	0, 0, 218, 229, 185, 257, 223, 262, 248, 271,
+	0, 224, 126, 249, 154, 196, 137, 138, 150, 156,
+	158, 160, 161, 205, 206, 216, 236, 250, 251, 252,
+	153, 145, 230, 146, 169, 147, 127, 239, 148, 128,
+	217, 255, 0, 166, 226, 192, 129, 191, 219, 254,
+	253, 280, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 164, 0, 267, 0, 210, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 234,
+	0, 0, 0, 0, 0, 175, 215, 0, 235, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 243, 265, 278, 268, 0, 0, 0, 277, 0,
+	0, 0, 0, 0, 0, 201, 202, 203, 204, 0,
+	143, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 163, 168, 0, 170, 142, 214, 165, 275, 178,
+	207, 174, 240, 179, 186, 227, 274, 212, 232, 141,
+	264, 241, 190, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 125, 0,
+	183, 273, 225, 162, 89, 90, 91, 92, 93, 94,
+	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
+	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	0, 0, 281, 282, 283, 0, 237, 149, 263, 222,
+	171, 266, 0, 0, 284, 285, 286, 297, 0, 287,
+	288, 289, 290, 291, 292, 293, 294, 295, 296, 157,
+	0, 0, 0, 182, 0, 184, 0, 0, 242, 197,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	86, 0, 0, 743, 0, 0, 0, 139, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 130, 247, 261, 140, 238, 276,
+	144, 245, 136, 211, 233, 132, 259, 244, 194, 176,
+	177, 131, 0, 228, 155, 167, 152, 209, 0, 0,
+	151, 279, 0, 270, 134, 135, 269, 208, 256, 260,
+	195, 189, 133, 258, 193, 188, 180, 159, 172, 220,
+	187, 221, 173, 199, 198, 200, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	272, 0, 0, 0, 0, 0, 0, 246, 0, 0,
+	181, 0, 0, 0, 0, 0, 231, 213, 0, 0,
+	218, 229, 185, 257, 223, 262, 248, 271, 0, 224,
+	126, 249, 154, 196, 137, 138, 150, 156, 158, 160,
+	161, 205, 206, 216, 236, 250, 251, 252, 153, 145,
+	230, 146, 169, 147, 127, 239, 148, 128, 217, 255,
+	0, 166, 226, 192, 129, 191, 219, 254, 253, 280,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 164,
+	0, 267, 0, 210, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 234, 0, 0,
+	0, 0, 0, 175, 215, 0, 235, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 243,
+	265, 278, 796, 0, 0, 0, 277, 0, 0, 0,
+	0, 0, 0, 201, 202, 203, 204, 0, 143, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 163,
+	168, 0, 170, 142, 214, 165, 275, 178, 207, 174,
+	240, 179, 186, 227, 274, 212, 232, 141, 264, 241,
+	190, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 125, 0, 183, 273,
+	225, 162, 89, 90, 91, 92, 93, 94, 95, 96,
+	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
+	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
+	117, 118, 119, 120, 121, 122, 123, 124, 0, 0,
+	281, 282, 283, 0, 237, 149, 263, 222, 171, 266,
+	0, 0, 284, 285, 286, 297, 0, 287, 288, 289,
+	290, 291, 292, 293, 294, 295, 296, 157, 0, 0,
+	0, 182, 0, 184, 0, 0, 242, 197, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 77, 0, 0, 86, 0,
+	0, 0, 0, 0, 0, 139, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 130, 247, 261, 140, 238, 276, 144, 245,
+	136, 211, 233, 132, 259, 244, 194, 176, 177, 131,
+	0, 228, 155, 167, 152, 209, 0, 0, 151, 279,
+	0, 270, 134, 135, 269, 208, 256, 260, 195, 189,
+	133, 258, 193, 188, 180, 159, 172, 220, 187, 221,
+	173, 199, 198, 200, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 272, 0,
+	0, 0, 0, 0, 0, 246, 0, 0, 181, 0,
+	0, 0, 0, 0, 231, 213, 0, 0, 218, 229,
+	185, 257, 223, 262, 248, 271, 0, 224, 126, 249,
+	154, 196, 137, 138, 150, 156, 158, 160, 161, 205,
+	206, 216, 236, 250, 251, 252, 153, 145, 230, 146,
+	169, 147, 127, 239, 148, 128, 217, 255, 0, 166,
+	226, 192, 129, 191, 219, 254, 253, 280, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 164, 0, 267,
+	0, 210, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 234, 0, 0, 0, 0,
+	0, 175, 215, 0, 235, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 243, 265, 278,
+	268, 0, 0, 0, 277, 0, 0, 0, 0, 0,
+	0, 201, 202, 203, 204, 0, 143, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 163, 168, 0,
+	170, 142, 214, 165, 275, 178, 207, 174, 240, 179,
+	186, 227, 274, 212, 232, 141, 264, 241, 190, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 125, 0, 183, 273, 225, 162,
+	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
+	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
+	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
+	119, 120, 121, 122, 123, 124, 0, 0, 281, 282,
+	283, 0, 237, 149, 263, 222, 171, 266, 0, 0,
+	284, 285, 286, 297, 0, 287, 288, 289, 290, 291,
+	292, 293, 294, 295, 296, 83, 157, 0, 0, 0,
+	182, 0, 184, 0, 0, 242, 197, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 86, 0, 0,
+	0, 0, 0, 0, 139, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 130, 247, 261, 140, 238, 276, 144, 245, 136,
+	211, 233, 132, 259, 244, 194, 176, 177, 131, 0,
+	228, 155, 167, 152, 209, 0, 0, 151, 279, 0,
+	270, 134, 135, 269, 208, 256, 260, 195, 189, 133,
+	258, 193, 188, 180, 159, 172, 220, 187, 221, 173,
+	199, 198, 200, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 272, 0, 0,
+	0, 0, 0, 0, 246, 0, 0, 181, 0, 0,
+	0, 0, 0, 231, 213, 0, 0, 218, 229, 185,
+	257, 223, 262, 248, 271, 0, 224, 126, 249, 154,
+	196, 137, 138, 150, 156, 158, 160, 161, 205, 206,
+	216, 236, 250, 251, 252, 153, 145, 230, 146, 169,
+	147, 127, 239, 148, 128, 217, 255, 0, 166, 226,
+	192, 129, 191, 219, 254, 253, 280, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 164, 0, 267, 0,
+	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 234, 0, 0, 0, 0, 0,
+	175, 215, 0, 235, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 243, 265, 278, 268,
+	0, 0, 0, 277, 0, 0, 0, 0, 0, 0,
+	201, 202, 203, 204, 0, 143, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 163, 168, 0, 170,
+	142, 214, 165, 275, 178, 207, 174, 240, 179, 186,
+	227, 274, 212, 232, 141, 264, 241, 190, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 125, 0, 183, 273, 225, 162, 89,
+	90, 91, 92, 93, 94, 95, 96, 97, 98, 99,
+	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
+	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
+	120, 121, 122, 123, 124, 0, 0, 281, 282, 283,
+	0, 237, 149, 263, 222, 171, 266, 0, 0, 284,
+	285, 286, 297, 0, 287, 288, 289, 290, 291, 292,
+	293, 294, 295, 296, 157, 0, 0, 0, 182, 0,
+	184, 0, 0, 242, 197, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 86, 0, 0, 0, 0,
+	0, 0, 139, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 130,
+	247, 261, 140, 238, 276, 144, 245, 136, 211, 233,
+	132, 259, 244, 194, 176, 177, 131, 0, 228, 155,
+	167, 152, 209, 0, 0, 151, 279, 0, 270, 134,
+	135, 269, 208, 256, 260, 195, 189, 133, 258, 193,
+	188, 180, 159, 172, 220, 187, 221, 173, 199, 198,
+	200, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 272, 0, 0, 0, 0,
+	0, 0, 246, 0, 0, 181, 0, 0, 0, 0,
+	0, 231, 213, 0, 0, 218, 229, 185, 257, 223,
+	262, 248, 271, 0, 224, 126, 249, 154, 196, 137,
+	138, 150, 156, 158, 160, 161, 205, 206, 216, 236,
+	250, 251, 252, 153, 145, 230, 146, 169, 147, 127,
+	239, 148, 128, 217, 255, 0, 166, 226, 192, 129,
+	191, 219, 254, 253, 280, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 164, 0, 267, 0, 210, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 234, 0, 0, 0, 0, 0, 175, 215,
+	0, 235, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 243, 265, 278, 268, 0, 0,
+	0, 277, 0, 0, 0, 0, 0, 0, 201, 202,
+	203, 204, 0, 143, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 163, 168, 0, 170, 142, 214,
+	165, 275, 178, 207, 174, 240, 179, 186, 227, 274,
+	212, 232, 141, 264, 241, 190, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 125, 0, 183, 273, 225, 162, 89, 90, 91,
+	92, 93, 94, 95, 96, 97, 98, 99, 100, 101,
+	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
+	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
+	122, 123, 124, 0, 0, 281, 282, 283, 0, 237,
+	149, 263, 222, 171, 266, 0, 0, 284, 285, 286,
+	297, 0, 287, 288, 289, 290, 291, 292, 293, 294,
+	295, 296, 463, 0, 0, 0, 0, 157, 0, 0,
+	0, 182, 0, 184, 0, 0, 242, 197, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 468, 469,
+	470, 465, 0, 0, 0, 139, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 130, 247, 261, 140, 238, 276, 144, 245,
+	136, 211, 233, 132, 259, 244, 194, 176, 177, 131,
+	0, 228, 155, 167, 152, 209, 0, 0, 151, 279,
+	0, 270, 134, 135, 269, 208, 256, 260, 195, 189,
+	133, 258, 193, 188, 180, 159, 172, 220, 187, 221,
+	173, 199, 198, 200, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 272, 0,
+	0, 0, 0, 0, 0, 246, 0, 0, 181, 0,
+	0, 0, 0, 0, 231, 213, 0, 0, 218, 229,
+	185, 257, 223, 262, 248, 271, 0, 224, 126, 249,
+	154, 196, 137, 138, 150, 156, 158, 160, 161, 205,
+	206, 216, 236, 250, 251, 252, 153, 145, 230, 146,
+	169, 147, 127, 239, 148, 128, 217, 255, 0, 166,
+	226, 192, 129, 191, 219, 254, 253, 280, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 164, 0, 267,
+	0, 210, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 234, 0, 0, 0, 0,
+	0, 175, 215, 0, 235, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 243, 265, 278,
+	268, 0, 0, 0, 277, 0, 0, 0, 0, 0,
+	0, 201, 202, 203, 204, 0, 143, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 163, 168, 0,
+	170, 142, 214, 165, 275, 178, 207, 174, 240, 179,
+	186, 227, 274, 212, 232, 141, 264, 241, 190, 157,
+	0, 0, 0, 182, 0, 184, 0, 0, 242, 197,
+	0, 0, 0, 0, 125, 0, 183, 273, 225, 162,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	468, 469, 470, 465, 0, 0, 0, 139, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 281, 282,
+	283, 0, 237, 149, 263, 222, 171, 266, 0, 0,
+	284, 285, 286, 297, 0, 287, 288, 289, 290, 291,
+	292, 293, 294, 295, 296, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 130, 247, 261, 140, 238, 276,
+	144, 245, 136, 211, 233, 132, 259, 244, 194, 176,
+	177, 131, 0, 228, 155, 167, 152, 209, 0, 0,
+	151, 279, 0, 270, 134, 135, 269, 208, 256, 260,
+	195, 189, 133, 258, 193, 188, 180, 159, 172, 220,
+	187, 221, 173, 199, 198, 200, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	272, 0, 0, 0, 0, 0, 0, 246, 0, 0,
+	181, 0, 0, 0, 0, 0, 231, 213, 0, 0,
+	218, 229, 185, 257, 223, 262, 248, 271, 0, 224,
+	126, 249, 154, 196, 137, 138, 150, 156, 158, 160,
+	161, 205, 206, 216, 236, 250, 251, 252, 153, 145,
+	230, 146, 169, 147, 127, 239, 148, 128, 217, 255,
+	0, 166, 226, 192, 129, 191, 219, 254, 253, 280,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 164,
+	0, 267, 0, 210, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 234, 0, 0,
+	0, 0, 0, 175, 215, 0, 235, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 243,
+	265, 278, 268, 0, 0, 0, 277, 0, 0, 0,
+	0, 0, 0, 201, 202, 203, 204, 0, 143, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 163,
+	168, 0, 170, 142, 214, 165, 275, 178, 207, 174,
+	240, 179, 186, 227, 274, 212, 232, 141, 264, 241,
+	190, 157, 0, 0, 0, 182, 0, 184, 0, 0,
+	242, 197, 0, 0, 0, 0, 125, 0, 183, 273,
+	225, 162, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 468, 469, 470, 0, 0, 0, 0, 139,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	281, 282, 283, 0, 237, 149, 263, 222, 171, 266,
+	0, 0, 284, 285, 286, 297, 0, 287, 288, 289,
+	290, 291, 292, 293, 294, 295, 296, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 130, 247, 261, 140,
+	238, 276, 144, 245, 136, 211, 233, 132, 259, 244,
+	194, 176, 177, 131, 0, 228, 155, 167, 152, 209,
+	0, 0, 151, 279, 0, 270, 134, 135, 269, 208,
+	256, 260, 195, 189, 133, 258, 193, 188, 180, 159,
+	172, 220, 187, 221, 173, 199, 198, 200, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 272, 0, 0, 0, 0, 0, 0, 246,
+	0, 0, 181, 0, 0, 0, 0, 0, 231, 213,
+	0, 0, 218, 229, 185, 257, 223, 262, 248, 271,
+	0, 224, 126, 249, 154, 196, 137, 138, 150, 156,
+	158, 160, 161, 205, 206, 216, 236, 250, 251, 252,
+	153, 145, 230, 146, 169, 147, 127, 239, 148, 128,
+	217, 255, 0, 166, 226, 192, 129, 191, 219, 254,
+	253, 280, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 164, 0, 267, 0, 210, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 234,
+	0, 0, 0, 0, 0, 175, 215, 0, 235, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 243, 265, 278, 268, 0, 0, 0, 277, 0,
+	0, 0, 0, 0, 0, 201, 202, 203, 204, 0,
+	143, 0, 0, 0, 0, 0, 0, 0, 1835, 0,
+	0, 163, 168, 0, 170, 142, 214, 165, 275, 178,
+	207, 174, 240, 179, 186, 227, 274, 212, 232, 141,
+	264, 241, 190, 1299, 1162, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 125, 0,
+	183, 273, 225, 162, 0, 0, 0, 0, 0, 0,
+	0, 2199, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1817, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 281, 282, 283, 0, 237, 149, 263, 222,
+	171, 266, 0, 0, 284, 285, 286, 297, 0, 287,
+	288, 289, 290, 291, 292, 293, 294, 295, 296, 0,
+	0, 0, 1286, 0, 0, 0, 0, 0, 0, 0,
+	0, 1835, 0, 0, 0, 0, 0, 1305, 1309, 1311,
+	1313, 1315, 1316, 1318, 0, 1323, 1319, 1320, 1321, 1322,
+	1301, 1302, 1303, 1304, 1284, 1285, 1306, 1162, 1287, 0,
+	1288, 1289, 1290, 1291, 1292, 1294, 1295, 1296, 1297, 1298,
+	1611, 0, 0, 0, 0, 0, 0, 0, 1308, 1310,
+	1312, 1314, 1317, 0, 0, 1896, 0, 0, 0, 0,
+	0, 0, 0, 1821, 1817, 0, 0, 0, 0, 0,
+	1835, 0, 0, 0, 1825, 0, 1300, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1814, 0, 1162, 0, 1816, 1818,
+	1820, 0, 1822, 1823, 1824, 1826, 1827, 1828, 1830, 1831,
+	1832, 1833, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1817, 1836, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1834, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1813, 0, 0, 0, 0, 1821, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1829, 1825, 0, 0,
+	0, 0, 1819, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1814, 0, 0,
+	0, 1816, 1818, 1820, 0, 1822, 1823, 1824, 1826, 1827,
+	1828, 1830, 1831, 1832, 1833, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1307,
+	0, 0, 0, 0, 0, 1821, 0, 1836, 0, 0,
+	0, 0, 0, 0, 0, 0, 1825, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1814, 1834, 0, 0,
+	1816, 1818, 1820, 0, 1822, 1823, 1824, 1826, 1827, 1828,
+	1830, 1831, 1832, 1833, 1813, 0, 0, 0, 0, 1609,
+	1608, 1610, 1293, 0, 0, 0, 0, 0, 0, 1829,
+	0, 0, 0, 0, 0, 1819, 1836, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1834, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1813, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1829, 0,
+	0, 0, 0, 0, 1819,
 }
 
 var yyPact = [...]int{
-	-1, -1000, -11, -1000, -1000, -3, -1, -1000, -1000,
+	1621, -1000, -298, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 16186, 1926, -1000, 8217, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	291, 14095, 16604, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	7359, 6919, 180, 15767, -1000, 1845, -1000, -1000, -1000, -1000,
+	381, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 419,
+	147, 387, 401, 402, 402, 8635, 1896, 1570, 92, -1000,
+	1833, 1621, 226, 16604, -1000, 541, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 14095, 16604,
+	-14, 607, -1000, 1470, 530, -1000, -1000, -1000, -1000, 16604,
+	1555, -1000, -1000, -1000, 1823, 17027, 1570, -1000, 1482, 1537,
+	-1000, -1000, 1686, -1000, 97, 69, 38, 153, -1000, -1000,
+	204, -1000, -1000, -1000, -1000, -1000, 103, -1000, 60, -1000,
+	55, -1000, -1000, -1000, -70, -1000, -1000, -1000, -1000, -1000,
+	1468, 416, 1720, -149, 1637, 276, -1000, 1797, 1878, 1570,
+	-228, 1901, 1873, 1871, 1841, 246, 246, 246, 280, 246,
+	285, -1000, -1000, -1000, -1000, -1000, -1000, 659, 208, -1000,
+	-1000, -101, -104, 576, -104, 20, -1000, -1000, -1000, -1000,
+	-1000, -1000, 16604, 249, -1000, -146, -1000, 377, -1000, 364,
+	-1000, 9902, 200, 1547, 662, -1000, 578, 16604, 16604, 16604,
+	578, 578, 1070, 997, 527, -1000, 1779, 1780, 1878, 1570,
+	-1000, 1378, 1325, 249, 249, 249, 249, 249, 4786, -1000,
+	-1000, -1000, -1000, -1000, 1471, 1685, -1000, 16604, 1572, -1000,
+	521, 1053, 1182, -1000, 16604, 1683, 16604, 14095, 14095, 14095,
+	14095, -1000, 1757, 1751, -1000, 1758, 1754, 1750, 1712, 17691,
+	-1000, -1000, -1000, 17359, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1365, 1896, 169, 849, 13259, 14931, 16604, 13259, -1000,
+	-1000, -1000, -1000, -1000, -72, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 169, 13259, 13259, -18, -1000,
+	450, 16604, 112, -1000, 1797, 5213, 5635, -1000, -1000, 1180,
+	5635, -1000, -1000, -1000, -1000, -1000, -1000, 16604, 625, 13259,
+	14931, 1174, 16604, 246, 16604, -1000, -1000, 576, 576, -1000,
+	659, 659, -1000, -1000, -100, 1909, 6057, -60, 16604, 246,
+	320, 15349, 1801, -142, 395, 372, 380, -1000, -1000, 1933,
+	-1000, -1000, 1521, 10751, 9476, 279, 13259, 2653, -1000, -1000,
+	578, 578, 578, 2653, 2653, 415, -1000, -1000, -1000, -1000,
+	-1000, -1000, 16604, -1000, -1000, 1797, -1000, -1000, -1000, -1000,
+	-1000, 13259, 14931, 16604, 16604, 17691, 1531, -1000, -1000, 9058,
+	520, 5635, 909, 1682, -1000, 1681, 1679, 1676, 1675, 1674,
+	1671, 1670, 1644, 1668, 1667, 1664, -1000, -1000, -1000, 1663,
+	1662, 1659, 1656, 1644, 1653, 1652, 1651, -1000, -1000, 114,
+	-293, -1000, -1000, -1000, 3937, 6057, 6057, 6057, 6057, -1000,
+	5635, -1000, 1650, 1649, -242, -1000, -1000, -242, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 6479, 1178,
+	-1000, 1648, 1645, 1644, 1643, 1177, 1176, 1162, 1642, 1641,
+	1639, 6057, 1638, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -260, -223, -1000,
+	10325, 16604, 16604, -1000, 1874, 5635, 2226, -1000, 1447, 516,
+	16604, 1408, -1000, 606, 1697, 1716, 1697, -1000, -1000, -1000,
+	-1000, 1748, -1000, 1747, -1000, 1687, -1000, -1000, 1637, -1000,
+	-1000, 636, -1000, -1000, -1000, -1000, -1000, 60, 55, 1480,
+	-1000, 18, 94, -1000, -1000, 1498, -1000, -1000, -1000, 636,
+	1480, 273, 1160, 1158, 104, 1496, -1000, 144, 144, -1000,
+	1146, 1846, 513, 942, -6, 1538, -1000, 1013, 1637, 1796,
+	297, 1521, 1689, 1786, 16604, 1909, 1909, 1909, 576, 17691,
+	659, 16604, 659, -1000, -293, 659, -1000, 509, 16604, 1536,
+	-1000, 243, 243, 470, 243, 297, 1631, -1000, -1000, -1000,
+	383, 358, 374, 14931, 272, -1000, -1000, 1521, -1000, -1000,
+	-1000, 1630, 605, -1000, -1000, 6057, -1000, 929, -1000, 2653,
+	2653, 2653, -1000, -1000, 12005, -1000, -1000, 1480, 1521, 1709,
+	1535, -1000, -1000, -1000, -1000, 1909, 4786, -1000, 14095, -1000,
+	5635, 5635, 5635, -1000, 16604, 14513, -1000, 788, 6057, -1000,
+	-1000, -1000, -1000, -1000, -1000, 5635, 1837, 1837, 1837, 5635,
+	646, 5635, 5635, -1000, 993, 511, 1837, 1837, 1837, 5635,
+	5635, 1837, -1000, 1837, 1837, 1837, 6057, 6057, 6057, 6057,
+	6057, 6057, 6057, 6057, 6057, 6057, 6057, 6057, 6057, 1617,
+	83, 6057, 6057, 6057, 6057, 111, 1325, 1369, 1533, -293,
+	-293, -293, -293, 618, 929, -1000, 5635, -1000, 1626, -1000,
+	735, -1000, 5635, -1000, 1348, -1000, -1000, 5635, -1000, -1000,
+	-1000, 5635, 6057, 5635, -293, 1837, -1000, 4786, 1406, -1000,
+	1625, -1000, 1494, 1774, -1000, 500, 1530, -1000, 597, 1489,
+	-1000, 1878, 929, -1000, 457, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -15,
+	-1000, 16604, 1487, 1874, 16604, 3497, -1000, -1000, 5635, 1624,
+	-1000, 5635, -1000, -1000, -1000, -1000, -1000, 1925, 452, 443,
+	13259, -1000, 179, 13259, -1000, -1000, 16604, 270, 13259, -6,
+	144, 144, 144, -1000, 16604, -1000, -1000, -35, 1623, -1000,
+	5635, 5635, 5635, 16604, 4364, -108, 16604, 5635, -287, -1000,
+	-1000, 1820, -1000, -176, -1000, 12, 1707, 123, -1000, 1786,
+	-1000, 553, -1000, 1618, -1000, -1000, -1000, 1909, -1000, 576,
+	-1000, 576, 659, 16604, -1000, -1000, 320, 16604, -1000, 16604,
+	16604, 16604, -1000, -1000, 16604, -176, 1342, -1000, -1000, -1000,
+	353, 1521, 13259, 1098, 279, -1000, -1000, -1000, -1000, -1000,
+	16604, 16604, 1907, -1000, 1520, 1601, -1000, 633, 647, -1000,
+	436, -1000, -1000, 798, 1923, -1000, 1338, 1382, 929, 5635,
+	-1000, -1000, 5635, 5635, 760, 5635, 1333, 1476, 1474, -1000,
+	1326, -1000, 1918, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 5635, 5635, 5635, 1323, 1311, 1016, 5635, 5635,
+	5635, 5635, 842, 1867, 999, -1000, 824, 824, 550, 550,
+	550, 550, 550, 1104, 1104, -1000, -1000, -1000, 3937, 1617,
+	6057, 6057, 6057, 6057, -52, -52, 1380, 1726, -1000, -1000,
+	-1000, -1000, 1606, -1000, 1609, 1606, 1606, 1606, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1616, 1615,
+	-1000, 1606, 1606, 1606, 1606, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 116,
+	1613, 1613, 1613, 1612, -1000, 5635, 685, -1000, 5635, 1127,
+	224, -1000, 1309, -1000, 1217, 1301, 1672, 1285, 5635, 1518,
+	-223, 3497, 1553, 16604, -223, 16604, 16604, 3497, -1000, 16604,
+	-1000, 2226, 1051, -1000, -1000, 1878, -1000, -1000, 929, 16604,
+	929, 13259, 462, 617, -1000, 11587, 13259, -1000, -1000, 13259,
+	139, 17, -1000, -1000, -1000, -1000, 1472, -1000, 16604, 1148,
+	705, 1144, 1142, -35, 929, 929, 929, 427, 929, -1000,
+	-33, -26, -1000, -1000, -1000, -283, 1570, -1000, -7, -1000,
+	-1000, -1000, 337, -1000, 1140, 1135, 1131, 1130, 16604, -1000,
+	-1000, -1000, -1000, -1000, 592, 592, 592, 1779, 7777, -1000,
+	1909, 1909, 576, -1000, -1000, -1000, 18021, -1000, 262, -1000,
+	455, 53, 11, -1000, 1480, 1283, -1000, -1000, -1000, -1000,
+	1904, 1900, 14095, 13677, -1000, 1912, 6057, -1000, 5635, 1350,
+	1344, 1329, 574, 1466, -1000, -1000, -1000, -1000, 5635, 1308,
+	1281, 1251, -1000, -1000, 5635, 1226, 1208, 1198, 1183, 1462,
+	-1000, -52, -52, 1380, 859, -1000, 6057, -1000, 6057, -1000,
+	-1000, 1035, -1000, 1034, -1000, -1000, -1000, 1128, 1128, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1025, -1000, 1010, -1000, -1000,
+	-1000, 1179, 613, -1000, 5635, 725, 574, 678, 1874, 1899,
+	-1000, -1000, 678, -1000, 6057, -1000, 1171, -1000, 1280, 1503,
+	-1000, -223, -1000, -1000, 1406, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1458, 1480, -1000, -1000, -1000,
+	-1000, 13259, 1829, 297, -1000, 63, 1789, -1000, -1000, -1000,
+	-35, -1000, -1000, -1000, -1000, -1000, -1000, 1456, 16604, -233,
+	-23, 1898, 1893, -1000, -1000, -1000, -7, -1000, 995, 994,
+	988, 987, 31, -1000, -1000, -1000, -1000, -1000, 1611, 678,
+	-1000, 923, 1120, 1269, 1424, -1000, -1000, -1000, 691, -1000,
+	16604, 682, 388, 246, 388, 665, 1610, -1000, -1000, -1000,
+	-1000, 1909, 126, 126, 28, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 16604, 1785, 1784, -1000, 53, -1000, 334, 344,
+	98, 1890, -1000, -1000, 5635, 5635, 1601, -1000, -1000, 6057,
+	-1000, 929, -1000, -1000, -1000, 1252, -1000, 1606, 1609, -1000,
+	1606, 1606, 1606, 351, 351, -1000, 1105, -1000, -1000, -1000,
+	767, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 6057, -293,
+	-1000, 1243, 1241, 1442, -1000, 1440, 1413, 1415, -1000, -1000,
+	929, 5635, 1235, 1228, -80, 5635, 1224, 1459, -1000, -1000,
+	3497, 1406, -1000, -1000, 13259, 13259, -177, 56, 283, -1000,
+	-1000, -235, 1115, -1000, 1889, 1111, 765, -1000, -1000, -1000,
+	-1000, -1000, -1000, 12841, -1000, -1000, -1000, -1000, -1000, -1000,
+	18215, 7777, -1000, -1000, 16604, 16604, -1000, 16604, 16604, 246,
+	5635, -1000, -1000, 126, -1000, -1000, 728, 6057, -1000, 149,
+	-1000, 1109, 923, 386, 411, 1607, -1000, 150, 664, 649,
+	-1000, 16604, -1000, -1000, 23, -1000, -1000, -1000, 6057, -1000,
+	-1000, -1000, -1000, 977, -1000, -1000, -1000, 1098, 929, 1382,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 5635, -1000, -1000, -1000, -1000, 1093, -1000,
+	958, -1000, 954, 929, -1000, -1000, 1212, 167, -1000, -1000,
+	1382, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 16604, -240,
+	951, -1000, 1092, -27, -1000, -1000, 1403, -1000, 1606, 5635,
+	222, 18146, -1000, 592, 592, 675, 592, 592, 592, 592,
+	178, 170, 592, 592, 592, 592, 592, 592, 592, 592,
+	592, 592, 592, 592, 592, 592, 1605, -1000, 1604, 1574,
+	105, 1603, -1000, 1599, 1585, 16604, 1088, -1000, -1000, 1380,
+	1819, 1782, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 922, 1584, -1000, -1000, 1581, -1000, -1000,
+	1380, 93, -1000, -1000, 1076, -1000, 1200, 1189, -1000, -1000,
+	106, -248, -224, -250, -60, -1000, 1580, -1000, -1000, 1888,
+	-1000, 12841, 1793, 750, -1000, 1887, 18215, -1000, 908, 876,
+	592, 592, 830, 1089, 1074, 1073, 592, 592, 825, 1064,
+	17359, 820, 819, 801, 1011, 1062, 510, 950, 894, 805,
+	16604, 1579, 966, 12841, 95, 95, 12841, 12841, 12841, 1578,
+	319, -278, 1803, 1108, 5635, -171, 12841, -1000, -1000, -1000,
+	-1000, 640, -1000, -1000, -1000, -1000, -1000, -34, -37, 16604,
+	765, 219, -1000, -1000, 1793, 141, -1000, -1000, -1000, 678,
+	678, -1000, -1000, -1000, -1000, 1061, 1059, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 196,
+	16604, 1401, -1000, 596, 1390, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1376, 1358, 1355, 12841, -1000, -1000, -1000, 140,
+	-1000, -284, -1000, 740, 1706, -1000, 36, 1347, 106, 259,
+	-31, -37, -1000, 1886, -32, 1882, 1857, 1341, -1000, -1000,
+	-1000, 592, 981, 107, -1000, -1000, -1000, 124, 268, 186,
+	-1000, 292, -1000, -1000, -1000, -1000, -1000, -1000, 188, 1336,
+	-1000, 966, 928, -1000, -1000, -1000, -1000, 1316, -1000, -1000,
+	319, -1000, -1000, 1701, 1693, 1922, -1000, -1000, -1000, -1000,
+	-1000, 1577, 789, -23, 1851, -1000, 765, 1835, 765, 765,
+	-1000, 16604, 778, -1000, 1174, 121, 771, 6057, 1568, 6057,
+	1566, 127, 1565, -1000, -1000, -1000, -1000, -1000, 219, 219,
+	219, 219, 44, -1000, -1000, 1924, -1000, 1902, 441, 441,
+	1627, 11169, -36, -1000, 829, -1000, 765, -1000, -1000, -1000,
+	-1000, -1000, 1552, 1831, -1000, 1211, 16604, 976, 16604, 1551,
+	588, 6057, -1000, -1000, -1000, -1000, 782, 155, -1000, -1000,
+	16604, -1000, 1299, -1000, -1000, -1000, 414, -1000, -1000, -1000,
+	-1000, 221, 129, -1000, 1297, -1000, 1289, 16604, 761, 961,
+	-1000, -1000, -1000, 1110, -1000, 582, -1000, 12423, 16604, 1279,
+	-1000, 1091, 115, -1000, -1000, 1255, -1000, -1000, 16604, 3075,
+	-1000, 410, -1000, 221, 1773, -1000, 757, -1000, -1000, -1000,
+	929, 16604, -1000, 18023, 218, -1000, -1000, -1000, 18023, 120,
+	-1000, 213, -1000, -1000, 1234, -1000, 1079, 1549, -1000, 120,
+	18215, 5635, -1000, 18215, 1197, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 1, 6, 5, 4,
+	0, 756, 2219, 2218, 1048, 1037, 2217, 2212, 2211, 2210,
+	2209, 2208, 2207, 2206, 2205, 2204, 2202, 2200, 2199, 2198,
+	2197, 2196, 2195, 2194, 2193, 2191, 2189, 2188, 2185, 2184,
+	2183, 2182, 2181, 2180, 852, 2179, 108, 2176, 2175, 2174,
+	2173, 2172, 2167, 132, 2166, 2165, 2163, 2162, 2161, 2160,
+	2159, 2157, 2156, 2154, 136, 87, 113, 2153, 111, 190,
+	122, 123, 2152, 81, 147, 2151, 2150, 33, 2149, 116,
+	2147, 73, 72, 92, 218, 95, 86, 126, 2146, 2145,
+	2144, 131, 2143, 2138, 2137, 2136, 58, 2135, 66, 31,
+	30, 105, 78, 2134, 2133, 83, 2132, 82, 80, 77,
+	2130, 2128, 60, 39, 2126, 2124, 62, 2123, 2122, 32,
+	2120, 41, 2118, 2117, 2116, 2115, 2114, 2110, 2109, 15,
+	19, 17, 2108, 2107, 16, 2, 2105, 2104, 91, 2103,
+	2102, 2101, 978, 2100, 2099, 2098, 146, 2097, 121, 2096,
+	2095, 2090, 2089, 135, 2088, 2087, 29, 2086, 12, 2080,
+	74, 2079, 2073, 2072, 45, 2071, 2070, 93, 36, 53,
+	90, 2069, 2068, 2065, 128, 21, 110, 0, 118, 153,
+	37, 2064, 134, 145, 2063, 75, 245, 140, 34, 2062,
+	47, 61, 2061, 2060, 2059, 64, 23, 2058, 2057, 2056,
+	97, 2055, 106, 55, 79, 2053, 102, 130, 1, 96,
+	2052, 133, 2051, 2050, 114, 2049, 2047, 46, 109, 865,
+	2045, 2044, 35, 2043, 38, 20, 2042, 125, 144, 2040,
+	2039, 2038, 117, 94, 70, 2037, 2036, 68, 2035, 103,
+	67, 120, 2034, 805, 107, 48, 22, 2033, 141, 2032,
+	240, 152, 127, 2031, 2030, 148, 1767, 142, 2029, 129,
+	13, 2028, 2027, 11, 2026, 27, 2025, 2024, 2023, 2020,
+	6, 2019, 2018, 2017, 3, 5, 2016, 4, 104, 124,
+	2015, 49, 59, 101, 99, 98, 2014, 2013, 2012, 2011,
+	233, 2010, 2009, 2008, 2007, 2005, 2004, 2003, 71, 2002,
+	2001, 2000, 1999, 57, 1998, 1997, 1996, 1995, 1994, 24,
+	1989, 1987, 14, 1986, 18, 1985, 1971, 1970, 9, 1969,
+	1968, 10, 1967, 1958, 7, 8, 1957, 1956, 50, 44,
+	40, 65, 63, 1953, 26, 69, 89, 1952, 119, 1949,
+	1946, 100, 1945, 1944, 137, 1943, 1942, 138, 1941,
 }
 
-//line postgresql_sql.y:74
+//line postgresql_sql.y:6686
 type yySymType struct {
 	union interface{}
 	id    int
@@ -76,426 +3148,10135 @@ type yySymType struct {
 	yys   int
 }
 
-func (st *yySymType) statementUnion() tree.Statement {
-	v, _ := st.union.(tree.Statement)
+func (st *yySymType) aliasedTableExprUnion() *tree.AliasedTableExpr {
+	v, _ := st.union.(*tree.AliasedTableExpr)
 	return v
 }
 
-func (st *yySymType) statementsUnion() []tree.Statement {
-	v, _ := st.union.([]tree.Statement)
+func (st *yySymType) alterTableOptionUnion() tree.AlterTableOption {
+	v, _ := st.union.(tree.AlterTableOption)
 	return v
 }
 
-var yyR1 = [...]int{
-	0, 4, 2, 2, 1, 3, 3,
+func (st *yySymType) alterTableOptionsUnion() []tree.AlterTableOption {
+	v, _ := st.union.([]tree.AlterTableOption)
+	return v
 }
 
-var yyR2 = [...]int{
-	0, 1, 1, 3, 1, 2, 1,
+func (st *yySymType) assignmentUnion() *tree.Assignment {
+	v, _ := st.union.(*tree.Assignment)
+	return v
 }
 
-var yyChk = [...]int{
-	-1000, -4, -2, -1, -3, 4, 11, 5, -1,
+func (st *yySymType) assignmentsUnion() []*tree.Assignment {
+	v, _ := st.union.([]*tree.Assignment)
+	return v
 }
 
-var yyDef = [...]int{
-	0, -2, 1, 2, 4, 6, 0, 5, 3,
+func (st *yySymType) attributeNullUnion() tree.AttributeNull {
+	v, _ := st.union.(tree.AttributeNull)
+	return v
 }
 
-var yyTok1 = [...]int{
-	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 11,
+func (st *yySymType) attributeReferenceUnion() *tree.AttributeReference {
+	v, _ := st.union.(*tree.AttributeReference)
+	return v
 }
 
-var yyTok2 = [...]int{
-	2, 3, 4, 5, 6, 7, 8, 9, 10,
+func (st *yySymType) authRecordUnion() *tree.AuthRecord {
+	v, _ := st.union.(*tree.AuthRecord)
+	return v
 }
 
-var yyTok3 = [...]int{
-	0,
+func (st *yySymType) boolValUnion() bool {
+	v, _ := st.union.(bool)
+	return v
 }
 
-var yyErrorMessages = [...]struct {
-	state int
-	token int
-	msg   string
-}{}
+func (st *yySymType) columnAttributeUnion() tree.ColumnAttribute {
+	v, _ := st.union.(tree.ColumnAttribute)
+	return v
+}
 
-//line yaccpar:1
+func (st *yySymType) columnAttributesUnion() []tree.ColumnAttribute {
+	v, _ := st.union.([]tree.ColumnAttribute)
+	return v
+}
 
-/*	parser for yacc output	*/
+func (st *yySymType) columnTableDefUnion() *tree.ColumnTableDef {
+	v, _ := st.union.(*tree.ColumnTableDef)
+	return v
+}
 
-func yyIaddr(v interface{}) __yyunsafe__.Pointer {
-	type h struct {
-		t __yyunsafe__.Pointer
-		p __yyunsafe__.Pointer
-	}
-	return (*h)(__yyunsafe__.Pointer(&v)).p
+func (st *yySymType) columnTypeUnion() *tree.T {
+	v, _ := st.union.(*tree.T)
+	return v
 }
 
-var (
-	yyDebug        = 0
-	yyErrorVerbose = false
-)
+func (st *yySymType) comparisionExprUnion() *tree.ComparisonExpr {
+	v, _ := st.union.(*tree.ComparisonExpr)
+	return v
+}
 
-type yyLexer interface {
-	Lex(lval *yySymType) int
-	Error(s string)
+func (st *yySymType) comparisonOpUnion() tree.ComparisonOp {
+	v, _ := st.union.(tree.ComparisonOp)
+	return v
 }
 
-type yyParser interface {
-	Parse(yyLexer) int
-	Lookahead() int
+func (st *yySymType) completionTypeUnion() tree.CompletionType {
+	v, _ := st.union.(tree.CompletionType)
+	return v
 }
 
-type yyParserImpl struct {
-	lval  yySymType
-	stack [yyInitialStackSize]yySymType
-	char  int
+func (st *yySymType) createOptionUnion() tree.CreateOption {
+	v, _ := st.union.(tree.CreateOption)
+	return v
 }
 
-func (p *yyParserImpl) Lookahead() int {
-	return p.char
+func (st *yySymType) createOptionsUnion() []tree.CreateOption {
+	v, _ := st.union.([]tree.CreateOption)
+	return v
 }
 
-func yyNewParser() yyParser {
-	return &yyParserImpl{}
+func (st *yySymType) defaultOptionalUnion() bool {
+	v, _ := st.union.(bool)
+	return v
 }
 
-const yyFlag = -1000
+func (st *yySymType) directionUnion() tree.Direction {
+	v, _ := st.union.(tree.Direction)
+	return v
+}
 
-func yyTokname(c int) string {
-	if c >= 1 && c-1 < len(yyToknames) {
-		if yyToknames[c-1] != "" {
-			return yyToknames[c-1]
-		}
-	}
-	return __yyfmt__.Sprintf("tok-%v", c)
+func (st *yySymType) duplicateKeyUnion() tree.DuplicateKey {
+	v, _ := st.union.(tree.DuplicateKey)
+	return v
 }
 
-func yyStatname(s int) string {
-	if s >= 0 && s < len(yyStatenames) {
-		if yyStatenames[s] != "" {
-			return yyStatenames[s]
-		}
-	}
-	return __yyfmt__.Sprintf("state-%v", s)
+func (st *yySymType) exportParmUnion() *tree.ExportParam {
+	v, _ := st.union.(*tree.ExportParam)
+	return v
 }
 
-func yyErrorMessage(state, lookAhead int) string {
-	const TOKSTART = 4
+func (st *yySymType) exprUnion() tree.Expr {
+	v, _ := st.union.(tree.Expr)
+	return v
+}
 
-	if !yyErrorVerbose {
-		return "syntax error"
-	}
+func (st *yySymType) exprsUnion() tree.Exprs {
+	v, _ := st.union.(tree.Exprs)
+	return v
+}
 
-	for _, e := range yyErrorMessages {
-		if e.state == state && e.token == lookAhead {
-			return "syntax error: " + e.msg
-		}
-	}
+func (st *yySymType) fieldsUnion() *tree.Fields {
+	v, _ := st.union.(*tree.Fields)
+	return v
+}
 
-	res := "syntax error: unexpected " + yyTokname(lookAhead)
+func (st *yySymType) fieldsListUnion() []*tree.Fields {
+	v, _ := st.union.([]*tree.Fields)
+	return v
+}
 
-	// To match Bison, suggest at most four expected tokens.
-	expected := make([]int, 0, 4)
+func (st *yySymType) frameBoundUnion() *tree.FrameBound {
+	v, _ := st.union.(*tree.FrameBound)
+	return v
+}
 
-	// Look for shiftable tokens.
-	base := yyPact[state]
-	for tok := TOKSTART; tok-1 < len(yyToknames); tok++ {
-		if n := base + tok; n >= 0 && n < yyLast && yyChk[yyAct[n]] == tok {
-			if len(expected) == cap(expected) {
-				return res
-			}
-			expected = append(expected, tok)
-		}
-	}
+func (st *yySymType) frameClauseUnion() *tree.FrameClause {
+	v, _ := st.union.(*tree.FrameClause)
+	return v
+}
 
-	if yyDef[state] == -2 {
-		i := 0
-		for yyExca[i] != -1 || yyExca[i+1] != state {
-			i += 2
-		}
+func (st *yySymType) frameTypeUnion() tree.FrameType {
+	v, _ := st.union.(tree.FrameType)
+	return v
+}
 
-		// Look for tokens that we accept or reduce.
-		for i += 2; yyExca[i] >= 0; i += 2 {
-			tok := yyExca[i]
-			if tok < TOKSTART || yyExca[i+1] == 0 {
-				continue
-			}
-			if len(expected) == cap(expected) {
-				return res
-			}
-			expected = append(expected, tok)
-		}
+func (st *yySymType) fromUnion() *tree.From {
+	v, _ := st.union.(*tree.From)
+	return v
+}
 
-		// If the default action is to accept or reduce, give up.
-		if yyExca[i+1] != 0 {
-			return res
-		}
-	}
+func (st *yySymType) fullOptUnion() bool {
+	v, _ := st.union.(bool)
+	return v
+}
 
-	for i, tok := range expected {
-		if i == 0 {
-			res += ", expecting "
-		} else {
-			res += " or "
-		}
-		res += yyTokname(tok)
-	}
-	return res
+func (st *yySymType) funcExprUnion() *tree.FuncExpr {
+	v, _ := st.union.(*tree.FuncExpr)
+	return v
 }
 
-func yylex1(lex yyLexer, lval *yySymType) (char, token int) {
-	token = 0
-	char = lex.Lex(lval)
-	if char <= 0 {
-		token = yyTok1[0]
-		goto out
-	}
-	if char < len(yyTok1) {
-		token = yyTok1[char]
-		goto out
-	}
-	if char >= yyPrivate {
-		if char < yyPrivate+len(yyTok2) {
-			token = yyTok2[char-yyPrivate]
-			goto out
-		}
-	}
-	for i := 0; i < len(yyTok3); i += 2 {
-		token = yyTok3[i+0]
-		if token == char {
-			token = yyTok3[i+1]
-			goto out
-		}
-	}
+func (st *yySymType) funcTypeUnion() tree.FuncType {
+	v, _ := st.union.(tree.FuncType)
+	return v
+}
 
-out:
-	if token == 0 {
-		token = yyTok2[1] /* unknown char */
-	}
-	if yyDebug >= 3 {
-		__yyfmt__.Printf("lex %s(%d)\n", yyTokname(token), uint(char))
-	}
-	return char, token
+func (st *yySymType) groupByUnion() tree.GroupBy {
+	v, _ := st.union.(tree.GroupBy)
+	return v
 }
 
-func yyParse(yylex yyLexer) int {
-	return yyNewParser().Parse(yylex)
+func (st *yySymType) identifierListUnion() tree.IdentifierList {
+	v, _ := st.union.(tree.IdentifierList)
+	return v
 }
 
-func (yyrcvr *yyParserImpl) Parse(yylex yyLexer) int {
-	var yyn int
-	var yyVAL yySymType
-	var yyDollar []yySymType
-	_ = yyDollar // silence set and not used
-	yyS := yyrcvr.stack[:]
+func (st *yySymType) ifNotExistsUnion() bool {
+	v, _ := st.union.(bool)
+	return v
+}
 
-	Nerrs := 0   /* number of errors */
-	Errflag := 0 /* error recovery flag */
-	yystate := 0
-	yyrcvr.char = -1
-	yytoken := -1 // yyrcvr.char translated into internal numbering
-	defer func() {
-		// Make sure we report no lookahead when not parsing.
-		yystate = -1
-		yyrcvr.char = -1
-		yytoken = -1
-	}()
-	yyp := -1
-	goto yystack
+func (st *yySymType) indexCategoryUnion() tree.IndexCategory {
+	v, _ := st.union.(tree.IndexCategory)
+	return v
+}
 
-ret0:
-	return 0
+func (st *yySymType) indexOptionUnion() *tree.IndexOption {
+	v, _ := st.union.(*tree.IndexOption)
+	return v
+}
 
-ret1:
-	return 1
+func (st *yySymType) indexTypeUnion() tree.IndexType {
+	v, _ := st.union.(tree.IndexType)
+	return v
+}
 
-yystack:
-	/* put a state and value onto the stack */
-	if yyDebug >= 4 {
-		__yyfmt__.Printf("char %v in %v\n", yyTokname(yytoken), yyStatname(yystate))
-	}
+func (st *yySymType) insertUnion() *tree.Insert {
+	v, _ := st.union.(*tree.Insert)
+	return v
+}
 
-	yyp++
-	if yyp >= len(yyS) {
-		nyys := make([]yySymType, len(yyS)*2)
-		copy(nyys, yyS)
-		yyS = nyys
-	}
-	yyS[yyp] = yyVAL
-	yyS[yyp].yys = yystate
+func (st *yySymType) int64ValUnion() int64 {
+	v, _ := st.union.(int64)
+	return v
+}
 
-yynewstate:
-	yyn = yyPact[yystate]
-	if yyn <= yyFlag {
-		goto yydefault /* simple state */
-	}
-	if yyrcvr.char < 0 {
-		yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
-	}
+func (st *yySymType) intervalTypeUnion() tree.IntervalType {
+	v, _ := st.union.(tree.IntervalType)
+	return v
+}
+
+func (st *yySymType) joinCondUnion() tree.JoinCond {
+	v, _ := st.union.(tree.JoinCond)
+	return v
+}
+
+func (st *yySymType) keyPartUnion() *tree.KeyPart {
+	v, _ := st.union.(*tree.KeyPart)
+	return v
+}
+
+func (st *yySymType) keyPartsUnion() []*tree.KeyPart {
+	v, _ := st.union.([]*tree.KeyPart)
+	return v
+}
+
+func (st *yySymType) lengthOptUnion() int32 {
+	v, _ := st.union.(int32)
+	return v
+}
+
+func (st *yySymType) lengthScaleOptUnion() tree.LengthScaleOpt {
+	v, _ := st.union.(tree.LengthScaleOpt)
+	return v
+}
+
+func (st *yySymType) limitUnion() *tree.Limit {
+	v, _ := st.union.(*tree.Limit)
+	return v
+}
+
+func (st *yySymType) linesUnion() *tree.Lines {
+	v, _ := st.union.(*tree.Lines)
+	return v
+}
+
+func (st *yySymType) loadColumnUnion() tree.LoadColumn {
+	v, _ := st.union.(tree.LoadColumn)
+	return v
+}
+
+func (st *yySymType) loadColumnsUnion() []tree.LoadColumn {
+	v, _ := st.union.([]tree.LoadColumn)
+	return v
+}
+
+func (st *yySymType) matchTypeUnion() tree.MatchType {
+	v, _ := st.union.(tree.MatchType)
+	return v
+}
+
+func (st *yySymType) nullsPositionUnion() tree.NullsPosition {
+	v, _ := st.union.(tree.NullsPosition)
+	return v
+}
+
+func (st *yySymType) numValUnion() *tree.NumVal {
+	v, _ := st.union.(*tree.NumVal)
+	return v
+}
+
+func (st *yySymType) objectTypeUnion() tree.ObjectType {
+	v, _ := st.union.(tree.ObjectType)
+	return v
+}
+
+func (st *yySymType) orderUnion() *tree.Order {
+	v, _ := st.union.(*tree.Order)
+	return v
+}
+
+func (st *yySymType) orderByUnion() tree.OrderBy {
+	v, _ := st.union.(tree.OrderBy)
+	return v
+}
+
+func (st *yySymType) parenTableExprUnion() *tree.ParenTableExpr {
+	v, _ := st.union.(*tree.ParenTableExpr)
+	return v
+}
+
+func (st *yySymType) partitionUnion() *tree.Partition {
+	v, _ := st.union.(*tree.Partition)
+	return v
+}
+
+func (st *yySymType) partitionByUnion() *tree.PartitionBy {
+	v, _ := st.union.(*tree.PartitionBy)
+	return v
+}
+
+func (st *yySymType) partitionOptionUnion() *tree.PartitionOption {
+	v, _ := st.union.(*tree.PartitionOption)
+	return v
+}
+
+func (st *yySymType) partitionsUnion() []*tree.Partition {
+	v, _ := st.union.([]*tree.Partition)
+	return v
+}
+
+func (st *yySymType) privilegeUnion() *tree.Privilege {
+	v, _ := st.union.(*tree.Privilege)
+	return v
+}
+
+func (st *yySymType) privilegeLevelUnion() *tree.PrivilegeLevel {
+	v, _ := st.union.(*tree.PrivilegeLevel)
+	return v
+}
+
+func (st *yySymType) privilegeTypeUnion() tree.PrivilegeType {
+	v, _ := st.union.(tree.PrivilegeType)
+	return v
+}
+
+func (st *yySymType) privilegesUnion() []*tree.Privilege {
+	v, _ := st.union.([]*tree.Privilege)
+	return v
+}
+
+func (st *yySymType) propertiesUnion() []tree.Property {
+	v, _ := st.union.([]tree.Property)
+	return v
+}
+
+func (st *yySymType) propertyUnion() tree.Property {
+	v, _ := st.union.(tree.Property)
+	return v
+}
+
+func (st *yySymType) referenceOnRecordUnion() *tree.ReferenceOnRecord {
+	v, _ := st.union.(*tree.ReferenceOnRecord)
+	return v
+}
+
+func (st *yySymType) referenceOptionTypeUnion() tree.ReferenceOptionType {
+	v, _ := st.union.(tree.ReferenceOptionType)
+	return v
+}
+
+func (st *yySymType) resourceOptionUnion() tree.ResourceOption {
+	v, _ := st.union.(tree.ResourceOption)
+	return v
+}
+
+func (st *yySymType) resourceOptionsUnion() []tree.ResourceOption {
+	v, _ := st.union.([]tree.ResourceOption)
+	return v
+}
+
+func (st *yySymType) roleUnion() *tree.Role {
+	v, _ := st.union.(*tree.Role)
+	return v
+}
+
+func (st *yySymType) rolesUnion() []*tree.Role {
+	v, _ := st.union.([]*tree.Role)
+	return v
+}
+
+func (st *yySymType) rowFormatTypeUnion() tree.RowFormatType {
+	v, _ := st.union.(tree.RowFormatType)
+	return v
+}
+
+func (st *yySymType) rowsExprsUnion() []tree.Exprs {
+	v, _ := st.union.([]tree.Exprs)
+	return v
+}
+
+func (st *yySymType) selectUnion() *tree.Select {
+	v, _ := st.union.(*tree.Select)
+	return v
+}
+
+func (st *yySymType) selectExprUnion() tree.SelectExpr {
+	v, _ := st.union.(tree.SelectExpr)
+	return v
+}
+
+func (st *yySymType) selectExprsUnion() tree.SelectExprs {
+	v, _ := st.union.(tree.SelectExprs)
+	return v
+}
+
+func (st *yySymType) selectStatementUnion() tree.SelectStatement {
+	v, _ := st.union.(tree.SelectStatement)
+	return v
+}
+
+func (st *yySymType) setDefaultRoleUnion() *tree.SetDefaultRole {
+	v, _ := st.union.(*tree.SetDefaultRole)
+	return v
+}
+
+func (st *yySymType) setRoleUnion() *tree.SetRole {
+	v, _ := st.union.(*tree.SetRole)
+	return v
+}
+
+func (st *yySymType) statementUnion() tree.Statement {
+	v, _ := st.union.(tree.Statement)
+	return v
+}
+
+func (st *yySymType) statementsUnion() []tree.Statement {
+	v, _ := st.union.([]tree.Statement)
+	return v
+}
+
+func (st *yySymType) strsUnion() []string {
+	v, _ := st.union.([]string)
+	return v
+}
+
+func (st *yySymType) subPartitionUnion() *tree.SubPartition {
+	v, _ := st.union.(*tree.SubPartition)
+	return v
+}
+
+func (st *yySymType) subPartitionsUnion() []*tree.SubPartition {
+	v, _ := st.union.([]*tree.SubPartition)
+	return v
+}
+
+func (st *yySymType) subqueryUnion() *tree.Subquery {
+	v, _ := st.union.(*tree.Subquery)
+	return v
+}
+
+func (st *yySymType) tableDefUnion() tree.TableDef {
+	v, _ := st.union.(tree.TableDef)
+	return v
+}
+
+func (st *yySymType) tableDefsUnion() tree.TableDefs {
+	v, _ := st.union.(tree.TableDefs)
+	return v
+}
+
+func (st *yySymType) tableExprUnion() tree.TableExpr {
+	v, _ := st.union.(tree.TableExpr)
+	return v
+}
+
+func (st *yySymType) tableExprsUnion() tree.TableExprs {
+	v, _ := st.union.(tree.TableExprs)
+	return v
+}
+
+func (st *yySymType) tableNameUnion() *tree.TableName {
+	v, _ := st.union.(*tree.TableName)
+	return v
+}
+
+func (st *yySymType) tableNamesUnion() tree.TableNames {
+	v, _ := st.union.(tree.TableNames)
+	return v
+}
+
+func (st *yySymType) tableOptionUnion() tree.TableOption {
+	v, _ := st.union.(tree.TableOption)
+	return v
+}
+
+func (st *yySymType) tableOptionsUnion() []tree.TableOption {
+	v, _ := st.union.([]tree.TableOption)
+	return v
+}
+
+func (st *yySymType) tlsOptionUnion() tree.TlsOption {
+	v, _ := st.union.(tree.TlsOption)
+	return v
+}
+
+func (st *yySymType) tlsOptionsUnion() []tree.TlsOption {
+	v, _ := st.union.([]tree.TlsOption)
+	return v
+}
+
+func (st *yySymType) tupleUnion() *tree.Tuple {
+	v, _ := st.union.(*tree.Tuple)
+	return v
+}
+
+func (st *yySymType) unionTypeRecordUnion() *tree.UnionTypeRecord {
+	v, _ := st.union.(*tree.UnionTypeRecord)
+	return v
+}
+
+func (st *yySymType) unresolveNamesUnion() []*tree.UnresolvedName {
+	v, _ := st.union.([]*tree.UnresolvedName)
+	return v
+}
+
+func (st *yySymType) unresolvedNameUnion() *tree.UnresolvedName {
+	v, _ := st.union.(*tree.UnresolvedName)
+	return v
+}
+
+func (st *yySymType) unresolvedObjectNameUnion() *tree.UnresolvedObjectName {
+	v, _ := st.union.(*tree.UnresolvedObjectName)
+	return v
+}
+
+func (st *yySymType) unsignedOptUnion() bool {
+	v, _ := st.union.(bool)
+	return v
+}
+
+func (st *yySymType) updateExprUnion() *tree.UpdateExpr {
+	v, _ := st.union.(*tree.UpdateExpr)
+	return v
+}
+
+func (st *yySymType) updateExprsUnion() tree.UpdateExprs {
+	v, _ := st.union.(tree.UpdateExprs)
+	return v
+}
+
+func (st *yySymType) userUnion() *tree.User {
+	v, _ := st.union.(*tree.User)
+	return v
+}
+
+func (st *yySymType) userMiscOptionUnion() tree.UserMiscOption {
+	v, _ := st.union.(tree.UserMiscOption)
+	return v
+}
+
+func (st *yySymType) userMiscOptionsUnion() []tree.UserMiscOption {
+	v, _ := st.union.([]tree.UserMiscOption)
+	return v
+}
+
+func (st *yySymType) usernameRecordUnion() *tree.UsernameRecord {
+	v, _ := st.union.(*tree.UsernameRecord)
+	return v
+}
+
+func (st *yySymType) usersUnion() []*tree.User {
+	v, _ := st.union.([]*tree.User)
+	return v
+}
+
+func (st *yySymType) valuesUnion() tree.Values {
+	v, _ := st.union.(tree.Values)
+	return v
+}
+
+func (st *yySymType) varAssignmentExprUnion() *tree.VarAssignmentExpr {
+	v, _ := st.union.(*tree.VarAssignmentExpr)
+	return v
+}
+
+func (st *yySymType) varAssignmentExprsUnion() []*tree.VarAssignmentExpr {
+	v, _ := st.union.([]*tree.VarAssignmentExpr)
+	return v
+}
+
+func (st *yySymType) varExprUnion() *tree.VarExpr {
+	v, _ := st.union.(*tree.VarExpr)
+	return v
+}
+
+func (st *yySymType) whenUnion() *tree.When {
+	v, _ := st.union.(*tree.When)
+	return v
+}
+
+func (st *yySymType) whensUnion() []*tree.When {
+	v, _ := st.union.([]*tree.When)
+	return v
+}
+
+func (st *yySymType) whereUnion() *tree.Where {
+	v, _ := st.union.(*tree.Where)
+	return v
+}
+
+func (st *yySymType) windowSpecUnion() *tree.WindowSpec {
+	v, _ := st.union.(*tree.WindowSpec)
+	return v
+}
+
+func (st *yySymType) zeroFillOptUnion() bool {
+	v, _ := st.union.(bool)
+	return v
+}
+
+var yyR1 = [...]int{
+	0, 333, 2, 2, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 51, 52, 52, 52, 52, 52, 328, 327,
+	327, 327, 326, 326, 325, 325, 325, 325, 325, 325,
+	325, 168, 168, 317, 317, 316, 316, 315, 315, 314,
+	314, 314, 313, 313, 313, 312, 312, 311, 311, 309,
+	309, 310, 308, 307, 307, 305, 305, 303, 303, 304,
+	304, 298, 298, 301, 301, 299, 299, 299, 299, 302,
+	297, 297, 297, 296, 296, 50, 50, 50, 235, 235,
+	49, 49, 249, 249, 249, 249, 249, 247, 247, 247,
+	247, 246, 246, 245, 245, 250, 250, 248, 248, 248,
+	248, 248, 248, 248, 248, 248, 248, 248, 248, 248,
+	248, 248, 248, 248, 248, 248, 248, 248, 248, 248,
+	248, 248, 248, 248, 248, 248, 248, 248, 248, 248,
+	44, 44, 44, 44, 47, 48, 243, 243, 243, 243,
+	243, 244, 244, 244, 45, 46, 46, 234, 234, 239,
+	239, 238, 238, 238, 238, 238, 238, 238, 238, 238,
+	238, 238, 238, 242, 242, 242, 241, 241, 240, 240,
+	38, 38, 38, 41, 40, 233, 233, 233, 233, 233,
+	233, 233, 233, 39, 39, 39, 39, 39, 39, 35,
+	35, 34, 232, 232, 231, 43, 43, 43, 43, 42,
+	42, 42, 42, 42, 42, 42, 171, 171, 171, 53,
+	7, 7, 33, 37, 37, 36, 36, 36, 36, 36,
+	36, 334, 334, 335, 335, 335, 32, 32, 280, 280,
+	182, 182, 183, 183, 181, 181, 181, 181, 181, 181,
+	283, 284, 178, 21, 21, 21, 21, 21, 21, 21,
+	21, 21, 21, 31, 336, 336, 336, 29, 30, 279,
+	279, 279, 28, 27, 26, 25, 25, 24, 23, 23,
+	175, 175, 177, 177, 173, 337, 337, 255, 255, 176,
+	176, 22, 22, 174, 174, 155, 172, 172, 172, 6,
+	8, 8, 8, 8, 8, 13, 12, 11, 10, 9,
+	5, 4, 329, 329, 287, 287, 287, 287, 287, 287,
+	330, 330, 330, 331, 80, 80, 75, 75, 288, 288,
+	199, 332, 332, 295, 295, 294, 294, 293, 293, 78,
+	78, 79, 79, 66, 66, 54, 54, 300, 300, 300,
+	300, 306, 306, 277, 277, 116, 116, 151, 151, 152,
+	152, 55, 55, 56, 56, 56, 73, 73, 74, 74,
+	74, 74, 74, 74, 74, 74, 72, 72, 71, 70,
+	70, 69, 67, 67, 67, 68, 68, 68, 58, 58,
+	57, 57, 57, 57, 57, 132, 132, 132, 132, 132,
+	132, 132, 132, 132, 59, 281, 281, 281, 286, 286,
+	129, 129, 130, 130, 128, 128, 60, 60, 61, 61,
+	61, 61, 127, 127, 126, 62, 62, 63, 63, 65,
+	65, 65, 65, 137, 137, 136, 136, 136, 136, 136,
+	136, 83, 83, 135, 134, 134, 134, 82, 82, 81,
+	81, 77, 77, 76, 76, 64, 64, 133, 338, 338,
+	131, 163, 163, 163, 170, 170, 162, 162, 162, 169,
+	169, 164, 164, 165, 165, 165, 3, 3, 3, 16,
+	16, 16, 16, 20, 14, 229, 229, 228, 228, 230,
+	230, 230, 230, 224, 224, 225, 225, 225, 225, 226,
+	226, 226, 227, 227, 227, 227, 223, 223, 222, 220,
+	220, 220, 221, 221, 221, 221, 221, 221, 166, 166,
+	15, 217, 217, 218, 218, 218, 219, 219, 211, 211,
+	211, 211, 19, 215, 215, 216, 216, 216, 216, 216,
+	212, 212, 214, 214, 210, 210, 210, 210, 210, 18,
+	209, 209, 207, 207, 205, 205, 206, 206, 204, 204,
+	204, 208, 208, 17, 282, 282, 251, 251, 254, 254,
+	261, 261, 262, 262, 260, 260, 267, 267, 266, 266,
+	265, 265, 264, 264, 263, 263, 258, 258, 257, 257,
+	252, 252, 252, 252, 252, 253, 253, 256, 256, 259,
+	259, 107, 107, 108, 108, 108, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 323, 323, 324, 110, 110,
+	110, 114, 114, 114, 114, 114, 114, 109, 109, 109,
+	111, 111, 111, 90, 90, 89, 89, 84, 84, 85,
+	85, 86, 86, 87, 87, 88, 88, 88, 88, 88,
+	88, 237, 237, 321, 321, 322, 322, 318, 318, 318,
+	320, 320, 320, 320, 320, 319, 319, 91, 91, 149,
+	149, 149, 167, 167, 167, 148, 148, 148, 106, 106,
+	105, 105, 103, 103, 103, 103, 103, 103, 103, 103,
+	103, 103, 103, 103, 103, 103, 103, 236, 236, 179,
+	179, 180, 180, 124, 122, 122, 123, 123, 123, 123,
+	120, 121, 119, 119, 119, 119, 119, 118, 118, 117,
+	117, 117, 213, 213, 115, 115, 113, 113, 113, 112,
+	112, 112, 268, 186, 186, 186, 186, 186, 186, 186,
+	186, 186, 186, 186, 186, 186, 186, 192, 192, 192,
+	192, 192, 192, 192, 192, 192, 192, 192, 192, 192,
+	192, 192, 192, 192, 192, 192, 192, 192, 92, 92,
+	92, 92, 92, 92, 92, 92, 92, 102, 102, 102,
+	142, 142, 142, 142, 142, 142, 142, 142, 142, 142,
+	142, 142, 142, 142, 142, 143, 143, 144, 144, 145,
+	145, 145, 147, 147, 146, 146, 146, 146, 146, 292,
+	292, 292, 139, 139, 139, 139, 139, 139, 141, 141,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 140, 200, 200, 201, 201, 289, 289, 289, 289,
+	289, 289, 290, 290, 291, 291, 291, 291, 285, 285,
+	285, 285, 285, 285, 285, 285, 285, 285, 285, 285,
+	285, 285, 285, 285, 285, 285, 285, 285, 285, 285,
+	285, 285, 285, 285, 285, 285, 187, 187, 188, 188,
+	191, 191, 190, 189, 189, 138, 138, 138, 269, 269,
+	269, 269, 269, 269, 269, 269, 269, 202, 197, 197,
+	198, 198, 193, 193, 193, 193, 193, 195, 195, 195,
+	195, 195, 195, 185, 185, 185, 185, 185, 185, 185,
+	185, 185, 185, 185, 194, 194, 196, 196, 203, 203,
+	203, 203, 203, 203, 104, 104, 104, 104, 270, 184,
+	184, 184, 184, 184, 184, 184, 184, 93, 93, 93,
+	94, 94, 94, 94, 98, 98, 100, 100, 100, 100,
+	100, 100, 100, 100, 100, 100, 100, 100, 100, 100,
+	101, 101, 101, 99, 99, 99, 99, 99, 99, 97,
+	97, 97, 97, 97, 95, 95, 95, 95, 95, 95,
+	95, 95, 95, 95, 95, 95, 95, 95, 95, 95,
+	96, 150, 150, 271, 271, 272, 272, 273, 274, 274,
+	275, 275, 275, 276, 276, 276, 278, 278, 154, 154,
+	154, 159, 159, 153, 153, 160, 160, 161, 161, 157,
+	157, 157, 157, 157, 157, 157, 157, 157, 157, 157,
+	157, 157, 157, 157, 157, 157, 157, 157, 157, 157,
+	157, 157, 157, 157, 157, 157, 157, 157, 157, 157,
+	157, 157, 157, 157, 157, 157, 157, 157, 157, 157,
+	157, 157, 157, 157, 157, 157, 157, 157, 157, 157,
+	157, 157, 157, 157, 157, 157, 157, 157, 157, 157,
+	157, 157, 157, 157, 157, 157, 157, 157, 157, 157,
+	157, 157, 157, 157, 157, 157, 157, 157, 157, 157,
+	157, 157, 157, 157, 157, 157, 157, 157, 157, 157,
+	157, 157, 157, 157, 157, 157, 157, 157, 157, 157,
+	157, 157, 157, 157, 157, 157, 157, 157, 157, 157,
+	157, 157, 157, 157, 157, 157, 157, 157, 157, 157,
+	157, 157, 157, 157, 157, 157, 157, 157, 157, 157,
+	157, 157, 157, 157, 157, 157, 157, 157, 157, 157,
+	157, 157, 157, 157, 157, 157, 157, 157, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 156, 156, 156, 156, 156, 156, 156, 156, 156,
+	156, 156, 156, 156, 156, 156, 156, 156, 156, 156,
+	156, 156, 156, 156, 156, 156, 156, 156, 156, 156,
+	156, 156, 156, 156, 156, 156, 156,
+}
+
+var yyR2 = [...]int{
+	0, 1, 1, 3, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 0, 15, 6, 6, 6, 5, 5, 1, 0,
+	3, 4, 1, 3, 2, 2, 1, 2, 2, 2,
+	2, 0, 2, 0, 2, 1, 3, 3, 3, 1,
+	3, 5, 0, 2, 3, 1, 3, 1, 1, 1,
+	1, 1, 1, 0, 3, 0, 3, 0, 3, 0,
+	3, 0, 2, 1, 2, 3, 4, 3, 3, 1,
+	0, 1, 1, 0, 1, 9, 4, 7, 0, 3,
+	7, 4, 1, 3, 3, 3, 1, 0, 1, 1,
+	1, 1, 3, 1, 4, 1, 3, 1, 2, 1,
+	1, 2, 2, 1, 1, 1, 1, 1, 1, 1,
+	1, 2, 1, 2, 1, 2, 2, 1, 1, 1,
+	3, 2, 2, 2, 2, 2, 2, 2, 1, 1,
+	1, 1, 1, 1, 3, 6, 3, 1, 1, 1,
+	1, 1, 1, 1, 2, 4, 6, 1, 4, 1,
+	3, 3, 4, 4, 4, 3, 3, 2, 4, 4,
+	2, 2, 2, 1, 1, 1, 1, 1, 1, 3,
+	1, 1, 1, 2, 2, 0, 4, 2, 4, 1,
+	5, 3, 2, 1, 2, 2, 4, 4, 5, 2,
+	1, 7, 1, 3, 3, 1, 1, 1, 1, 2,
+	3, 4, 7, 2, 5, 3, 1, 1, 1, 6,
+	1, 1, 4, 1, 3, 3, 3, 5, 6, 5,
+	3, 0, 1, 0, 1, 1, 7, 9, 0, 2,
+	0, 1, 1, 2, 2, 2, 1, 4, 2, 2,
+	3, 0, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 5, 1, 1, 1, 5, 5, 0,
+	1, 1, 2, 2, 3, 6, 7, 4, 7, 8,
+	0, 2, 0, 2, 2, 1, 1, 1, 1, 0,
+	1, 4, 5, 1, 3, 1, 1, 3, 5, 1,
+	1, 1, 1, 1, 1, 4, 4, 6, 4, 4,
+	6, 5, 0, 2, 2, 1, 5, 4, 4, 2,
+	0, 1, 3, 3, 1, 3, 1, 3, 1, 3,
+	4, 0, 1, 0, 1, 1, 3, 1, 1, 0,
+	4, 1, 3, 2, 1, 0, 9, 0, 4, 7,
+	4, 0, 2, 0, 2, 0, 2, 0, 4, 1,
+	3, 1, 2, 4, 3, 4, 0, 1, 2, 4,
+	4, 2, 4, 2, 4, 4, 0, 1, 3, 1,
+	3, 3, 0, 1, 1, 0, 2, 2, 3, 3,
+	1, 3, 3, 3, 3, 1, 2, 2, 1, 2,
+	2, 1, 2, 2, 7, 0, 1, 1, 1, 1,
+	0, 2, 0, 3, 0, 2, 1, 3, 1, 2,
+	3, 5, 0, 1, 2, 1, 3, 1, 1, 4,
+	4, 4, 3, 2, 2, 2, 3, 2, 3, 2,
+	3, 0, 2, 1, 1, 2, 2, 0, 1, 2,
+	4, 0, 3, 1, 3, 1, 4, 3, 0, 1,
+	2, 0, 1, 2, 1, 1, 0, 1, 2, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 7, 6, 0, 2, 1, 2, 2,
+	2, 2, 2, 0, 1, 2, 2, 2, 2, 1,
+	3, 2, 2, 2, 2, 2, 1, 3, 2, 1,
+	3, 2, 0, 3, 3, 5, 5, 4, 1, 1,
+	4, 1, 3, 1, 3, 2, 1, 1, 0, 1,
+	1, 1, 11, 0, 2, 3, 2, 3, 1, 1,
+	1, 3, 3, 4, 0, 2, 2, 2, 2, 5,
+	1, 1, 0, 3, 0, 1, 1, 2, 4, 4,
+	4, 0, 1, 10, 0, 1, 0, 6, 0, 4,
+	0, 3, 1, 3, 4, 5, 0, 3, 1, 3,
+	2, 3, 1, 2, 0, 6, 0, 2, 0, 2,
+	4, 5, 4, 5, 1, 6, 5, 0, 3, 0,
+	1, 0, 1, 1, 3, 2, 3, 3, 4, 4,
+	3, 3, 3, 3, 4, 4, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 4, 5, 4, 1, 3, 3, 0, 2,
+	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 3, 1, 3, 0, 1, 1,
+	3, 1, 1, 2, 1, 7, 7, 7, 7, 8,
+	5, 0, 1, 0, 1, 1, 1, 1, 3, 3,
+	1, 1, 1, 1, 1, 0, 1, 3, 3, 1,
+	3, 5, 1, 1, 1, 1, 3, 5, 0, 1,
+	1, 2, 1, 2, 2, 1, 4, 5, 1, 2,
+	2, 2, 2, 2, 1, 5, 6, 1, 2, 0,
+	1, 1, 2, 5, 0, 1, 1, 1, 2, 2,
+	3, 3, 1, 1, 2, 2, 2, 0, 1, 2,
+	2, 2, 0, 3, 0, 3, 1, 1, 1, 1,
+	1, 1, 1, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 1, 1, 1, 1,
+	3, 5, 2, 2, 2, 2, 1, 5, 1, 2,
+	3, 6, 6, 6, 2, 1, 1, 2, 1, 2,
+	2, 1, 2, 2, 2, 2, 2, 0, 1, 1,
+	5, 4, 4, 5, 5, 5, 5, 4, 5, 5,
+	5, 5, 5, 5, 5, 0, 6, 0, 3, 0,
+	2, 5, 1, 1, 2, 2, 2, 2, 2, 1,
+	1, 1, 4, 4, 6, 8, 6, 4, 2, 2,
+	4, 2, 2, 4, 6, 2, 2, 2, 4, 6,
+	4, 2, 0, 1, 2, 3, 1, 1, 1, 1,
+	1, 1, 0, 2, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 3, 2, 0, 1,
+	1, 2, 4, 0, 2, 0, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 3, 0, 1,
+	1, 3, 3, 3, 3, 2, 1, 3, 4, 5,
+	6, 3, 1, 3, 4, 4, 5, 4, 5, 3,
+	4, 5, 6, 1, 0, 2, 1, 1, 1, 1,
+	1, 1, 1, 1, 2, 2, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	3, 1, 1, 1, 2, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 2, 2, 2, 2, 2, 2, 1,
+	2, 2, 2, 2, 2, 2, 2, 2, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 4, 4,
+	1, 1, 3, 0, 1, 0, 3, 3, 0, 5,
+	0, 3, 5, 0, 1, 1, 0, 1, 1, 2,
+	2, 0, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1,
+}
+
+var yyChk = [...]int{
+	-1000, -333, -2, -1, -3, -4, -5, -6, -42, -21,
+	-7, -53, -34, -35, -38, -44, -49, -50, -51, -52,
+	-55, -16, -15, -14, 10, 12, -8, -171, -22, -23,
+	-24, -25, -26, -27, -28, -29, -30, -31, -32, -33,
+	186, 11, 51, -39, -40, -41, -45, -46, -47, -48,
+	288, 294, 330, 433, -56, -58, -17, -18, -19, -20,
+	182, -9, -10, -11, -12, -13, 204, 203, 28, 202,
+	183, 125, 126, 128, 129, 32, -57, 58, 184, -59,
+	8, 449, -66, 29, -89, -167, 61, -156, -158, 383,
+	384, 385, 386, 387, 388, 389, 390, 391, 392, 393,
+	394, 395, 396, 397, 398, 399, 400, 401, 402, 403,
+	404, 405, 406, 407, 408, 409, 410, 411, 412, 413,
+	414, 415, 416, 417, 418, 377, 221, 245, 248, 255,
+	125, 142, 136, 163, 155, 156, 133, 225, 226, 68,
+	128, 358, 344, 329, 131, 240, 242, 244, 247, 426,
+	227, 151, 147, 239, 223, 145, 228, 30, 229, 168,
+	230, 231, 382, 340, 270, 346, 252, 146, 341, 243,
+	343, 429, 169, 173, 350, 294, 140, 141, 348, 352,
+	167, 201, 34, 379, 36, 213, 353, 171, 166, 162,
+	361, 256, 254, 165, 139, 161, 224, 40, 175, 174,
+	176, 324, 325, 326, 327, 232, 233, 349, 158, 148,
+	274, 134, 356, 208, 345, 295, 234, 249, 211, 257,
+	170, 172, 428, 215, 220, 381, 253, 354, 144, 212,
+	241, 207, 357, 135, 288, 297, 235, 425, 129, 246,
+	351, 360, 39, 310, 138, 132, 198, 126, 217, 222,
+	236, 237, 238, 259, 258, 250, 159, 214, 164, 137,
+	160, 127, 216, 427, 359, 311, 430, 272, 313, 157,
+	154, 218, 191, 380, 355, 347, 130, 317, 312, 152,
+	260, 421, 422, 423, 433, 434, 435, 438, 439, 440,
+	441, 442, 443, 444, 445, 446, 447, 436, 13, -172,
+	21, 328, -43, 186, -167, -5, -4, -34, -55, 189,
+	-63, -64, -65, -131, -133, -89, 58, -167, -246, -217,
+	-245, -218, -248, -219, -166, 22, 183, 182, 216, 12,
+	184, 292, 190, 10, 8, 293, 202, 11, 294, 296,
+	297, 300, 301, 302, 33, 305, 306, 61, 64, -167,
+	-246, -217, 220, 227, -89, -58, -54, -71, -72, -132,
+	29, 17, 5, 6, 7, 191, 313, 219, -211, -209,
+	-282, 199, 198, 80, 363, 188, 303, -336, -279, 347,
+	346, -176, 345, 338, 340, 182, 190, 348, 34, 350,
+	351, 341, 189, 313, 130, 127, -233, 84, 135, 134,
+	-233, 219, 31, -239, 323, -238, -240, 350, 351, 361,
+	62, 63, 349, -154, -167, 79, 156, 153, -72, -132,
+	-71, -56, -58, 313, 219, 190, 189, -209, -281, 22,
+	-286, 23, 24, -1, -78, 211, -89, 124, -63, -148,
+	-167, 329, 93, -43, 124, -89, 32, -134, -135, -136,
+	-137, 43, 47, 49, 44, 45, 46, 53, 50, -338,
+	25, -163, -170, 25, -164, 64, -165, -158, 61, 62,
+	63, -56, -58, 55, 59, 13, 59, 58, 450, 62,
+	290, 304, 313, 291, 303, 191, 219, 304, 219, 338,
+	191, 295, 298, 299, 339, 55, 192, 55, -296, 361,
+	-77, 58, 192, -54, -74, 19, 20, -59, -58, 420,
+	18, 22, 23, 22, 23, 22, 23, -207, 194, -207,
+	-207, 190, -207, 189, -337, 13, 103, 218, 217, 342,
+	339, -255, 343, 344, -176, -175, 101, -176, 189, 363,
+	-89, -280, 194, 354, 380, 133, 134, 135, -243, 22,
+	31, 322, -217, 219, 59, 93, 21, -241, 93, 104,
+	-240, -240, -240, -241, -241, -109, 31, -165, 64, 121,
+	-109, 31, 124, 32, 32, -73, -74, -59, -58, 60,
+	60, -280, -280, -280, -280, -280, -60, -61, 112, -193,
+	-167, 85, -195, 61, -185, 384, 385, 386, 387, 388,
+	389, 390, 392, 395, 397, 399, 403, 404, 405, 406,
+	408, 409, 410, 411, 416, 417, 418, 313, 152, -186,
+	-192, -314, -309, -184, 58, 110, 111, 118, 86, -187,
+	88, -268, 26, 371, -139, -140, -141, -142, -310, -308,
+	64, 69, 73, 75, 76, 74, 71, 65, 123, 54,
+	-58, -285, -291, -289, 153, 205, 149, 150, 10, 116,
+	323, 121, -292, 63, 62, 276, 79, 277, 278, 363,
+	273, 279, 194, 328, 45, 280, 281, 282, 283, 284,
+	370, 285, 46, 286, 275, 209, 287, 374, 373, 375,
+	367, 364, 362, 365, 366, 368, 369, -287, 35, -55,
+	58, 32, 58, -167, -128, 14, 124, 69, 64, -167,
+	58, -232, -231, -148, -64, -64, -64, -64, 43, 43,
+	43, 48, 43, 48, 43, 48, 43, -136, -164, -170,
+	60, -247, 189, 289, 215, -245, 216, 294, 297, -223,
+	-222, -220, -166, 64, -218, -250, -148, -166, 339, -247,
+	-223, -222, 331, 13, 192, -76, -167, 64, 436, -54,
+	-193, 22, -167, -193, 64, -70, -69, -193, -89, 85,
+	-223, -217, -165, -167, -207, -89, -175, -175, -177, -337,
+	-173, -337, 339, -128, -192, -255, -174, -167, -207, -37,
+	-36, 187, 184, 185, 183, -223, 313, 26, 355, 356,
+	131, 134, 133, 7, -244, 322, 22, -217, -238, -234,
+	64, 323, -222, -242, 55, 121, -293, -193, 31, -241,
+	-241, -241, -242, -242, 120, -167, -54, -223, -217, -167,
+	-90, -89, -169, -165, -158, -127, 59, -126, 13, -162,
+	84, 82, 83, -167, 25, 124, -193, 100, -203, 93,
+	94, 95, 96, 97, 98, 58, 58, 58, 58, 58,
+	58, 58, 58, -201, 58, 58, 58, 58, 58, 58,
+	58, 58, -201, 58, 58, 58, 107, 105, 106, 117,
+	110, 111, 112, 113, 114, 115, 116, 108, 109, 103,
+	85, 101, 431, 102, 87, 448, -58, -193, -198, -192,
+	-192, -192, -192, -188, -193, -268, 58, -143, 424, -143,
+	-193, 64, 58, -290, 58, -200, -201, 58, 64, 64,
+	64, 58, 58, 58, -192, 58, -329, 432, -288, -199,
+	-332, 419, -80, 60, -75, -167, -330, -331, -75, -79,
+	-167, -72, -193, -160, -161, -153, -157, -164, -165, -158,
+	271, 187, 22, 84, 25, 27, 276, 308, 87, 121,
+	18, 88, 153, 120, 278, 371, 277, 182, 49, 79,
+	373, 375, 374, 364, 362, 315, 319, 321, 318, 363,
+	338, 31, 12, 28, 203, 23, 24, 114, 184, 205,
+	91, 92, 206, 26, 7, 204, 76, 21, 52, 13,
+	328, 15, 16, 279, 314, 194, 193, 103, 331, 190,
+	47, 10, 6, 123, 29, 100, 316, 43, 81, 45,
+	101, 19, 365, 366, 33, 330, 376, 210, 116, 280,
+	281, 282, 50, 85, 322, 74, 55, 82, 17, 48,
+	102, 185, 370, 46, 219, 320, 284, 286, 285, 188,
+	8, 275, 372, 32, 202, 44, 189, 339, 90, 192,
+	75, 209, 149, 150, 5, 80, 11, 51, 56, 367,
+	368, 369, 35, 89, 14, 287, 283, 323, 332, 333,
+	334, 335, 336, 337, 177, 178, 179, 180, 181, 251,
+	197, 195, 199, 200, 419, 420, 431, 432, 20, 21,
+	-43, 124, -76, -128, 59, 93, -82, -81, 55, 56,
+	-83, 55, -81, 43, 43, 43, -77, -249, 112, 61,
+	59, -221, 314, 450, 62, 60, 59, -249, 192, 64,
+	64, 64, 436, 60, 59, -328, -327, 58, 380, -328,
+	59, 20, 20, 124, 19, -168, 328, 59, -67, 27,
+	28, -77, 26, -224, -225, 320, -210, 56, -205, -206,
+	-204, -208, 31, -89, -128, -128, -128, -175, -169, -177,
+	-172, -177, -173, 124, -155, -167, 59, -334, 196, -334,
+	196, -335, 192, 25, -334, -224, 58, 132, 135, 135,
+	134, -217, 192, 58, 93, -242, -242, -242, 31, -166,
+	55, 59, -128, -61, -62, -63, -193, -193, -193, -167,
+	-167, 112, 74, 85, 23, -185, -197, -198, -193, -138,
+	23, 22, -138, -138, -193, -138, 112, -198, -198, 60,
+	-270, 69, -269, 280, 275, 281, 279, 273, 287, 282,
+	283, 152, -138, -138, -138, -197, -197, -193, -138, -138,
+	-138, -138, -186, -186, -186, -186, -186, -186, -186, -186,
+	-186, -186, -186, -186, -186, -196, -202, -268, 58, 103,
+	101, 431, 102, 87, -192, -192, -186, -186, -93, -98,
+	-95, -97, -100, -99, 153, 154, 121, 157, 159, 160,
+	161, 162, 163, 441, 164, 165, 166, 167, 168, 32,
+	205, 149, 150, 151, 152, 136, 155, 378, 177, 137,
+	178, 138, 179, 139, 180, 140, 141, 181, 142, 145,
+	146, 147, 148, 144, 60, 59, -191, -190, 89, -193,
+	58, -269, -197, 60, -198, -197, -186, -197, -138, -60,
+	59, 58, 60, 59, 35, 124, 59, 93, 60, 59,
+	-73, 124, 329, -167, 60, -72, -231, -293, -193, 58,
+	-193, 13, 124, 124, -222, 18, 380, -166, -148, 192,
+	-223, -168, -328, -328, -328, -167, -326, -325, 328, 434,
+	421, 435, 206, 58, -193, -193, -193, -167, -193, 22,
+	-300, 343, -167, -69, -68, 445, 25, -229, 380, 322,
+	321, 317, -226, -227, 316, 318, 315, 319, 55, 265,
+	266, 267, 268, -204, -154, 120, 230, 156, 58, -128,
+	-175, -175, -177, -167, -36, -91, -148, -167, -167, -89,
+	-167, -229, 60, 135, -223, -178, 64, -234, -89, -89,
+	-130, 15, 59, 124, 74, 23, 13, 60, 59, -193,
+	-193, -193, 25, -198, 60, 60, 60, 60, 13, -193,
+	-193, -193, 60, 60, 13, -193, -193, -193, -193, -198,
+	-196, -192, -192, -186, -186, -194, 206, -194, 84, -271,
+	-273, 58, -272, 58, -273, -271, -271, 58, 58, -271,
+	-271, -271, -271, 437, -274, 58, -275, 58, -275, -275,
+	-274, -193, -189, -190, 91, -193, 59, 56, -144, 211,
+	60, 60, 56, 60, 59, 60, -193, -199, -295, -294,
+	-293, 35, -55, -75, -288, -167, -331, -293, -167, -160,
+	-157, -165, -158, 69, -73, -76, -223, 112, 112, 61,
+	-166, 323, -166, -223, -235, 380, -297, 193, 370, 60,
+	59, -167, 64, 75, 76, 64, 64, -326, 124, -306,
+	337, 332, 334, 446, 447, -55, -228, -230, 324, 325,
+	326, 327, 84, -227, 64, 64, 64, 64, -89, -159,
+	93, -159, -159, -84, -85, -86, -91, -87, -180, -88,
+	197, 195, 199, -322, 80, 200, 251, 81, 190, -128,
+	-128, -175, -94, -101, -98, -95, -97, -96, 439, 438,
+	440, 169, 192, 32, 184, -182, -183, -181, 271, -283,
+	323, 314, 60, -129, 16, 18, -63, -167, 112, 13,
+	-185, -193, 60, 60, 60, -92, -99, 121, 153, 205,
+	152, 151, 149, 310, 311, 60, -193, 60, 60, 60,
+	-193, 60, 60, 60, 60, 60, -194, -194, 84, -192,
+	-185, 69, 69, -150, 64, -150, 69, 69, 60, 92,
+	-193, 90, -92, -109, -72, 18, -109, -186, 60, 60,
+	59, -288, 60, -166, 18, 25, -224, 294, 29, -325,
+	60, -277, 421, -304, 332, 18, 18, -230, 69, 69,
+	69, 69, -227, 58, -109, -111, -165, 64, 121, 64,
+	60, 59, -88, -167, 81, -321, -322, -207, -321, 81,
+	58, -128, -106, -105, -103, 74, 85, 31, 308, 442,
+	-104, 68, 120, 244, 222, 245, -124, -179, 195, 80,
+	81, 296, -180, -106, -276, 311, 310, -167, 31, 31,
+	-181, 272, 33, 123, 274, 31, 270, 18, -193, -198,
+	-185, 60, -271, -272, -271, -271, -271, -102, 141, 140,
+	-102, 60, 60, 21, -185, 60, 60, 60, 59, 60,
+	59, 60, 59, -193, 60, 60, -145, -147, 425, 253,
+	-198, 60, 60, -293, -166, -166, -235, 295, 189, -116,
+	422, 64, 18, 64, -302, 64, -212, -214, -148, 58,
+	-107, -108, -125, 308, 221, -208, 225, 68, 226, 329,
+	227, 190, 229, 230, 231, 201, 232, 233, 234, 323,
+	235, 236, 237, 238, 291, 5, 261, -86, -318, -319,
+	-167, -319, -167, -318, -318, -207, -193, -103, 74, -186,
+	443, 18, 64, -111, -112, 31, 243, 239, -113, 31,
+	223, 224, -115, 58, 251, 81, 81, -89, -278, 312,
+	-186, 69, -284, -178, -193, 64, 69, 69, 60, -146,
+	87, 427, 426, 69, -89, -151, 423, 69, 64, 334,
+	60, 59, -271, -193, -251, 211, 59, -125, -159, -159,
+	-154, 120, -159, -159, -159, -159, 228, 228, -159, -159,
+	-159, -159, -159, -159, -159, -159, -159, -159, -159, -159,
+	-159, -159, 58, 58, 56, 260, 58, 58, 58, -319,
+	60, 25, 31, 69, 58, -213, 58, 273, 60, 60,
+	60, -146, 428, 429, 419, 428, 429, -298, -255, 58,
+	18, -215, -214, -67, 60, 18, -125, 69, 69, -159,
+	-159, 69, 64, 64, 64, -159, -159, 69, 64, -170,
+	69, 69, 69, 69, 31, 64, -114, 31, 239, 243,
+	240, 241, 242, 69, 31, 69, 31, 69, 31, -167,
+	58, -323, -324, 64, -212, -320, 265, 266, 267, 269,
+	268, -320, -212, -212, -212, 58, -237, -236, 252, 85,
+	444, 25, 60, -193, -118, -117, 376, -212, 84, -305,
+	337, -301, -299, 332, 333, 334, 335, -152, -167, -302,
+	-216, 201, 68, 380, 263, 264, -67, -252, 253, 254,
+	-253, -259, 256, -109, -109, 64, 64, -110, 222, -90,
+	60, 59, 93, 60, 60, 60, 60, -212, 252, 444,
+	60, -122, -123, -120, -121, 55, 341, 249, 250, 60,
+	-146, -307, 193, -303, 336, -299, 18, 334, 18, 18,
+	60, 59, -159, 64, 262, -257, 257, 58, -255, 58,
+	-255, 81, 266, 223, 224, 60, -324, 64, -215, -215,
+	-215, -215, 60, -236, -121, 55, -120, 55, 12, 11,
+	-313, 58, 69, -304, 18, -302, 18, -302, -302, -167,
+	69, -165, -254, 258, 69, -186, 58, -186, 58, -256,
+	255, 58, -124, -119, 246, 247, 32, 134, -119, -317,
+	32, 60, -312, -311, -149, -308, -167, 337, 64, -302,
+	-261, 58, 18, 60, -250, 60, -250, 58, 93, -186,
+	74, 31, 248, -316, -315, -314, 60, 59, 124, -262,
+	-260, 211, -253, 60, 60, -250, 69, 60, 59, 93,
+	-311, -167, 60, 59, 61, -258, 259, 60, -315, 31,
+	-193, 124, -260, -263, 35, 69, -167, -267, -264, 58,
+	-125, 213, -267, -125, -266, -265, 258, 214, 60, 59,
+	61, 58, -265, -264, -198, 60,
+}
+
+var yyDef = [...]int{
+	21, -2, 1, 2, 4, 5, 6, 7, 8, 9,
+	10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
+	20, 476, 477, 478, 0, 0, 299, 0, 253, 254,
+	255, 256, 257, 258, 259, 260, 261, 262, 220, 221,
+	0, 0, 200, 180, 181, 182, 140, 141, 142, 143,
+	0, 0, 0, 0, 361, -2, 479, 480, 481, 482,
+	-2, 300, 301, 302, 303, 304, 216, 217, 218, -2,
+	0, 193, 0, 185, 185, 0, 376, 0, 0, 390,
+	405, 21, 339, 0, 344, 655, 692, 693, 694, 1371,
+	1372, 1373, 1374, 1375, 1376, 1377, 1378, 1379, 1380, 1381,
+	1382, 1383, 1384, 1385, 1386, 1387, 1388, 1389, 1390, 1391,
+	1392, 1393, 1394, 1395, 1396, 1397, 1398, 1399, 1400, 1401,
+	1402, 1403, 1404, 1405, 1406, 1198, 1199, 1200, 1201, 1202,
+	1203, 1204, 1205, 1206, 1207, 1208, 1209, 1210, 1211, 1212,
+	1213, 1214, 1215, 1216, 1217, 1218, 1219, 1220, 1221, 1222,
+	1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230, 1231, 1232,
+	1233, 1234, 1235, 1236, 1237, 1238, 1239, 1240, 1241, 1242,
+	1243, 1244, 1245, 1246, 1247, 1248, 1249, 1250, 1251, 1252,
+	1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260, 1261, 1262,
+	1263, 1264, 1265, 1266, 1267, 1268, 1269, 1270, 1271, 1272,
+	1273, 1274, 1275, 1276, 1277, 1278, 1279, 1280, 1281, 1282,
+	1283, 1284, 1285, 1286, 1287, 1288, 1289, 1290, 1291, 1292,
+	1293, 1294, 1295, 1296, 1297, 1298, 1299, 1300, 1301, 1302,
+	1303, 1304, 1305, 1306, 1307, 1308, 1309, 1310, 1311, 1312,
+	1313, 1314, 1315, 1316, 1317, 1318, 1319, 1320, 1321, 1322,
+	1323, 1324, 1325, 1326, 1327, 1328, 1329, 1330, 1331, 1332,
+	1333, 1334, 1335, 1336, 1337, 1338, 1339, 1340, 1341, 1342,
+	1343, 1344, 1345, 1346, 1347, 1348, 1349, 1350, 1351, 1352,
+	1353, 1354, 1355, 1356, 1357, 1358, 1359, 1360, 1361, 1362,
+	1363, 1364, 1365, 1366, 1367, 1368, 1369, 1370, 0, 209,
+	0, 0, 213, 0, 296, 205, 206, 207, 208, 0,
+	0, 427, 428, 455, 458, 461, 0, 199, 0, 0,
+	101, 521, 103, 523, 0, 107, 109, 110, -2, 114,
+	115, 116, 117, 118, 119, 120, 0, 122, 1248, 124,
+	1308, 127, 128, 129, 0, 138, 139, -2, -2, 518,
+	0, 0, 1297, 83, 451, 0, 362, -2, 0, 0,
+	0, 0, 395, 398, 401, 552, 552, 552, 0, 552,
+	0, 529, 530, 531, 550, 551, 565, 0, 0, 272,
+	273, 0, 289, 280, 289, 0, 264, 265, 266, 270,
+	271, 290, 0, 238, 194, 195, 184, 0, 189, 0,
+	183, 0, 0, 154, 0, 159, 0, 1247, 1313, 1263,
+	0, 0, 1280, 0, 178, 1038, 1208, 0, 366, 0,
+	377, 0, 376, 238, 238, 238, 238, 238, 0, 406,
+	407, 408, 409, 3, 0, 0, 343, 0, 414, 210,
+	695, 0, 0, 215, 0, 0, 0, 0, 0, 0,
+	0, 444, 0, 0, 443, 0, 0, 0, 0, 0,
+	459, 460, 462, 0, 464, 465, 471, 472, 473, 474,
+	475, 0, 376, 97, 0, 0, 0, 0, 0, 525,
+	108, 137, 111, 112, 0, 132, 134, 136, 135, 121,
+	133, 123, 125, 126, 131, 97, 0, 0, 0, 84,
+	0, 0, 0, 364, 345, 0, 0, 392, 394, 0,
+	0, 396, 397, 399, 400, 402, 403, 0, 0, 0,
+	0, 0, 0, 552, 0, 285, 286, 280, 280, 274,
+	282, 0, 287, 288, 0, 414, 0, 0, 0, 552,
+	0, 0, 0, 0, 0, 187, 0, 192, 144, 149,
+	147, 148, 150, 0, 0, 0, 0, 0, 176, 177,
+	0, 0, 0, 0, 0, 167, 170, 647, 648, 649,
+	171, 172, 0, 1039, 1040, 345, 367, 391, 393, 388,
+	389, 0, 0, 0, 0, 0, 422, 416, 418, 466,
+	49, 0, 926, 692, 932, 1372, 1373, 1374, 1375, 1376,
+	1377, 1378, 1380, 1383, 1385, 1387, -2, -2, -2, 1394,
+	1396, 1397, 1398, 1399, 1404, 1405, 1406, -2, -2, 943,
+	766, 767, 768, 769, 0, 0, 0, 0, 0, 776,
+	898, 778, 0, 0, 815, 785, 786, 815, 59, 60,
+	959, 960, 961, 962, 963, 964, 965, 966, 877, 0,
+	752, 0, 862, 852, 0, 872, 890, 891, 0, 0,
+	0, 0, 0, 61, 62, 868, 869, 870, 871, 873,
+	874, 875, 876, 878, 879, 880, 881, 882, 883, 884,
+	885, 886, 887, 888, 889, 892, 894, 864, 865, 866,
+	867, 856, 857, 858, 859, 860, 861, 312, 331, 315,
+	0, 320, 0, 656, 376, 0, 0, 211, 0, 297,
+	0, 414, 202, 0, 447, 441, 0, 432, 445, 446,
+	435, 0, 437, 0, 439, 0, 433, 434, 451, 463,
+	457, 0, 98, 99, 100, 102, 113, 0, 0, 91,
+	506, 512, 509, 519, 522, 0, 105, 524, 130, 0,
+	86, 0, 0, 0, 0, 0, 453, 29, 29, 365,
+	368, 371, 49, 373, 41, 378, 379, 382, 451, 0,
+	493, 520, 544, -2, 0, 414, 414, 414, 280, 0,
+	282, 0, 282, 277, 281, 0, 291, 293, 0, 222,
+	223, 231, 231, 233, 231, 493, 1341, 239, 196, 197,
+	0, 0, 191, 0, 0, 151, 152, 153, 160, 155,
+	157, 0, 0, 161, 173, 174, 175, 337, 338, 0,
+	0, 0, 165, 166, 0, 179, 363, 305, 306, 0,
+	308, 653, 309, 469, 470, 414, 0, 423, 0, 419,
+	0, 0, 0, 467, 0, 0, 925, 0, 0, 948,
+	949, 950, 951, 952, 953, 918, 905, 905, 905, 0,
+	905, 0, 0, 838, 0, 0, 905, 905, 905, 918,
+	918, 905, 839, 905, 905, 905, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, -2, 920, 0, 772,
+	773, 774, 775, 0, 899, 779, 0, 784, 0, 787,
+	0, 897, 918, 841, 0, 842, 853, 0, 845, 846,
+	847, 918, 0, 918, 851, 905, 311, 0, 314, 328,
+	0, 332, 0, 0, 324, 326, 319, 321, 0, 0,
+	341, 366, 415, 696, 0, 1045, -2, 1047, -2, -2,
+	1049, 1050, 1051, 1052, 1053, 1054, 1055, 1056, 1057, 1058,
+	1059, 1060, 1061, 1062, 1063, 1064, 1065, 1066, 1067, 1068,
+	1069, 1070, 1071, 1072, 1073, 1074, 1075, 1076, 1077, 1078,
+	1079, 1080, 1081, 1082, 1083, 1084, 1085, 1086, 1087, 1088,
+	1089, 1090, 1091, 1092, 1093, 1094, 1095, 1096, 1097, 1098,
+	1099, 1100, 1101, 1102, 1103, 1104, 1105, 1106, 1107, 1108,
+	1109, 1110, 1111, 1112, 1113, 1114, 1115, 1116, 1117, 1118,
+	1119, 1120, 1121, 1122, 1123, 1124, 1125, 1126, 1127, 1128,
+	1129, 1130, 1131, 1132, 1133, 1134, 1135, 1136, 1137, 1138,
+	1139, 1140, 1141, 1142, 1143, 1144, 1145, 1146, 1147, 1148,
+	1149, 1150, 1151, 1152, 1153, 1154, 1155, 1156, 1157, 1158,
+	1159, 1160, 1161, 1162, 1163, 1164, 1165, 1166, 1167, 1168,
+	1169, 1170, 1171, 1172, 1173, 1174, 1175, 1176, 1177, 1178,
+	1179, 1180, 1181, 1182, 1183, 1184, 1185, 1186, 1187, 1188,
+	1189, 1190, 1191, 1192, 1193, 1194, 1195, 1196, 1197, 0,
+	214, 0, 0, 376, 0, 0, 429, 448, 0, 0,
+	430, 0, 431, 436, 438, 440, 456, 0, 92, 96,
+	0, 508, 0, 0, 511, 104, 0, 0, 0, 41,
+	29, 29, 29, 452, 0, 26, 28, 0, 0, 27,
+	0, 0, 0, 0, 0, 347, 0, 0, 385, 383,
+	384, 0, 553, 485, 494, 0, 0, 0, 549, -2,
+	556, 0, 562, 0, 263, 267, 268, 414, 283, 280,
+	284, 280, 282, 0, 292, 295, 0, 0, 232, 0,
+	0, 0, 234, 235, 0, 485, 0, 198, 186, 188,
+	0, 146, 0, 0, 0, 162, 163, 164, 168, 169,
+	0, 0, 412, 417, 424, 425, 922, 923, 924, 468,
+	50, 420, 927, 0, 0, 931, 0, 919, 920, 0,
+	906, 907, 0, 0, 0, 0, 0, 0, 0, 854,
+	0, 958, 0, 908, 909, 910, 911, 912, 913, 914,
+	915, 916, 0, 0, 0, 0, 0, 920, 0, 0,
+	0, 0, 753, 754, 755, 756, 757, 758, 759, 760,
+	761, 762, 763, 764, 765, 933, 946, 947, 0, 0,
+	0, 0, 0, 0, 944, 944, 939, 0, 780, 967,
+	968, 969, 1023, 975, 1025, 0, 1023, 1023, 1008, 1009,
+	1010, 1011, 1012, 1013, 1014, 1015, 1016, 1017, 0, 0,
+	999, 1023, 1023, 1023, 1023, 976, 977, 978, 979, 980,
+	981, 982, 983, 984, 985, 986, 987, 988, 989, 1028,
+	1030, 1030, 1030, 1028, 770, 0, 903, 900, 0, 0,
+	817, 896, 0, 863, 0, 0, 0, 0, 0, 313,
+	331, 333, 0, 0, 331, 0, 0, 0, 340, 0,
+	310, 0, 0, 298, 219, 366, 203, 204, 449, 0,
+	442, 0, 0, 0, 507, 0, 0, 510, 106, 0,
+	88, 80, 23, 24, 25, 454, 0, 32, 0, 0,
+	36, 0, 0, 0, 369, 370, 372, 50, 374, 375,
+	351, 0, 42, 380, 381, 0, 0, 484, 0, 495,
+	496, 497, 498, 499, 0, 0, 0, 0, 0, 545,
+	546, 547, 548, 557, 1041, 1041, 1041, 0, 657, 275,
+	414, 414, 280, 294, 224, 225, 0, 226, 0, 230,
+	0, 240, 0, 190, 145, 0, 252, 156, 307, 654,
+	410, 0, 0, 0, 928, 0, 0, 832, 0, 0,
+	0, 0, 0, 0, 807, 801, 802, 855, 0, 0,
+	0, 0, 837, 833, 0, 0, 0, 0, 0, 0,
+	934, 944, 944, 940, 0, 935, 0, 937, 0, 974,
+	1024, 0, 1004, 0, 1005, 1006, 1007, 0, 0, 1000,
+	1001, 1002, 1003, 993, 994, 0, 995, 0, 996, 997,
+	998, 921, 0, 901, 0, 0, 0, 0, 376, 0,
+	840, 843, 0, 848, 0, 850, 0, 329, 0, 334,
+	335, 331, 318, 325, 317, 327, 322, 323, 342, 697,
+	1046, 1043, 1044, 212, 201, 0, 90, 93, 94, 95,
+	513, 0, 514, 493, 87, 0, 0, 81, 82, 30,
+	0, 34, 35, 37, 38, 39, 40, 0, 0, 353,
+	69, 0, 0, 386, 387, 483, 486, 487, 0, 0,
+	0, 0, 0, 501, 502, 503, 504, 505, 0, 0,
+	1042, 0, 0, 0, 658, 659, 661, 662, 0, 664,
+	721, 0, 673, 552, 673, 0, 0, 675, 676, 278,
+	276, 414, -2, -2, 1033, 971, 972, 973, 990, 991,
+	992, 1020, 0, 0, 0, 236, 241, 242, 0, 246,
+	0, 0, 158, 404, 0, 0, 426, 51, 421, 0,
+	929, 921, 803, 804, 805, 0, 788, 1023, 1025, 791,
+	1023, 1023, 1023, 797, 797, 806, 0, 808, 809, 812,
+	0, 810, 813, 814, 800, 917, 936, 938, 0, 945,
+	941, 0, 0, 0, 1021, 0, 0, 0, 771, 777,
+	904, 0, 0, 0, 819, 0, 0, 0, 811, 330,
+	0, 316, 450, 517, 0, 0, 88, 0, 0, 33,
+	31, 355, 0, 352, 0, 0, 0, 488, 489, 490,
+	491, 492, 500, 0, 558, 559, 650, 651, 652, 560,
+	-2, 0, 663, 722, 685, 685, 674, 685, 685, 552,
+	0, 279, 687, -2, 700, 702, 0, 0, 705, 0,
+	708, 0, 0, 0, 0, 744, 714, 0, 0, 956,
+	957, 0, 720, 688, 1036, 1034, 1035, 227, 0, 229,
+	243, 244, 245, 0, 248, 249, 251, 0, 411, 413,
+	930, 781, 789, 790, 792, 793, 794, 795, 798, 799,
+	796, 836, 834, 0, 942, 1027, 1026, 1018, 0, 1019,
+	0, 1031, 0, 902, 782, 783, 0, 0, 822, 823,
+	818, 844, 849, 336, 515, 516, 85, 89, 0, 357,
+	0, 354, 0, 348, 350, 79, 0, 540, 1023, 0,
+	566, -2, 603, 1041, 1041, 0, 1041, 1041, 1041, 1041,
+	0, 0, 1041, 1041, 1041, 1041, 1041, 1041, 1041, 1041,
+	1041, 1041, 1041, 1041, 1041, 1041, 0, 660, 0, 677,
+	686, 0, 686, 0, 0, 685, 0, 701, 703, 704,
+	0, 0, 709, 710, 711, 749, 750, 751, 712, 746,
+	747, 748, 713, 0, 0, 954, 955, 742, 970, 1037,
+	228, 0, 250, 237, 0, 1022, 0, 0, 816, 820,
+	0, 0, 0, 0, 71, 346, 0, 356, 70, 0,
+	533, 0, 382, 0, 563, 0, 561, 605, 0, 0,
+	1041, 1041, 0, 0, 0, 0, 1041, 1041, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	671, 0, 0, 0, 0, 737, 0, 247, 835, 1029,
+	1032, 0, 824, 825, 826, 827, 828, 65, 0, 0,
+	0, 532, 541, 542, 382, 599, 604, 606, 607, 0,
+	0, 610, 611, 612, 613, 0, 0, 616, 617, 618,
+	619, 620, 621, 622, 623, 624, 625, 641, 642, 643,
+	644, 645, 646, 626, 627, 628, 629, 630, 631, 638,
+	0, 0, 635, 0, 0, 678, 680, 681, 682, 683,
+	684, 679, 0, 0, 0, 0, 670, 672, 717, 0,
+	706, 0, 745, 0, 724, 738, 0, 0, 0, 63,
+	67, 72, 73, 0, 0, 0, 0, 0, 359, 349,
+	534, 1041, 0, 0, 538, 539, 543, 588, 0, 0,
+	594, 0, 600, 608, 609, 614, 615, 632, 0, 0,
+	634, 0, 0, 533, 533, 533, 533, 0, 718, 707,
+	715, 723, 725, 726, 727, 0, 739, 740, 741, 743,
+	821, 52, 0, 69, 0, 74, 0, 0, 0, 0,
+	358, 0, 0, 536, 0, 568, 0, 0, 0, 0,
+	0, 597, 0, 639, 640, 633, 636, 637, 665, 666,
+	667, 668, 0, 716, 728, 0, 729, 0, 0, 0,
+	43, 0, 0, 66, 0, 75, 0, 77, 78, 360,
+	535, 537, 570, 0, 589, 0, 0, 0, 0, 0,
+	0, 0, 669, 730, 732, 733, 0, 0, 731, 22,
+	0, 53, 0, 55, 57, 58, 689, 64, 68, 76,
+	567, 0, 599, 590, 0, 592, 0, 0, 0, 0,
+	734, 736, 735, 44, 45, 0, 54, 0, 0, 0,
+	572, 0, 586, 591, 593, 0, 598, 596, 0, 0,
+	56, 690, 571, 0, 584, 569, 0, 595, 46, 47,
+	48, 0, 573, -2, 0, 587, 691, 574, -2, 0,
+	582, 0, 575, 583, 0, 578, 0, 0, 577, 0,
+	-2, 0, 579, -2, 0, 585,
+}
+
+var yyTok1 = [...]int{
+	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 86, 3, 3, 3, 115, 107, 3,
+	58, 60, 112, 110, 59, 111, 124, 113, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 449,
+	94, 93, 95, 3, 450, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 117, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 105, 3, 118,
+}
+
+var yyTok2 = [...]int{
+	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
+	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
+	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
+	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
+	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
+	52, 53, 54, 55, 56, 57, 61, 62, 63, 64,
+	65, 66, 67, 68, 69, 70, 71, 72, 73, 74,
+	75, 76, 77, 78, 79, 80, 81, 82, 83, 84,
+	85, 87, 88, 89, 90, 91, 92, 96, 97, 98,
+	99, 100, 101, 102, 103, 104, 106, 108, 109, 114,
+	116, 119, 120, 121, 122, 123, 125, 126, 127, 128,
+	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
+	139, 140, 141, 142, 143, 144, 145, 146, 147, 148,
+	149, 150, 151, 152, 153, 154, 155, 156, 157, 158,
+	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
+	169, 170, 171, 172, 173, 174, 175, 176, 177, 178,
+	179, 180, 181, 182, 183, 184, 185, 186, 187, 188,
+	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
+	199, 200, 201, 202, 203, 204, 205, 206, 207, 208,
+	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
+	219, 220, 221, 222, 223, 224, 225, 226, 227, 228,
+	229, 230, 231, 232, 233, 234, 235, 236, 237, 238,
+	239, 240, 241, 242, 243, 244, 245, 246, 247, 248,
+	249, 250, 251, 252, 253, 254, 255, 256, 257, 258,
+	259, 260, 261, 262, 263, 264, 265, 266, 267, 268,
+	269, 270, 271, 272, 273, 274,
+}
+
+var yyTok3 = [...]int{
+	57600, 275, 57601, 276, 57602, 277, 57603, 278, 57604, 279,
+	57605, 280, 57606, 281, 57607, 282, 57608, 283, 57609, 284,
+	57610, 285, 57611, 286, 57612, 287, 57613, 288, 57614, 289,
+	57615, 290, 57616, 291, 57617, 292, 57618, 293, 57619, 294,
+	57620, 295, 57621, 296, 57622, 297, 57623, 298, 57624, 299,
+	57625, 300, 57626, 301, 57627, 302, 57628, 303, 57629, 304,
+	57630, 305, 57631, 306, 57632, 307, 57633, 308, 57634, 309,
+	57635, 310, 57636, 311, 57637, 312, 57638, 313, 57639, 314,
+	57640, 315, 57641, 316, 57642, 317, 57643, 318, 57644, 319,
+	57645, 320, 57646, 321, 57647, 322, 57648, 323, 57649, 324,
+	57650, 325, 57651, 326, 57652, 327, 57653, 328, 57654, 329,
+	57655, 330, 57656, 331, 57657, 332, 57658, 333, 57659, 334,
+	57660, 335, 57661, 336, 57662, 337, 57663, 338, 57664, 339,
+	57665, 340, 57666, 341, 57667, 342, 57668, 343, 57669, 344,
+	57670, 345, 57671, 346, 57672, 347, 57673, 348, 57674, 349,
+	57675, 350, 57676, 351, 57677, 352, 57678, 353, 57679, 354,
+	57680, 355, 57681, 356, 57682, 357, 57683, 358, 57684, 359,
+	57685, 360, 57686, 361, 57687, 362, 57688, 363, 57689, 364,
+	57690, 365, 57691, 366, 57692, 367, 57693, 368, 57694, 369,
+	57695, 370, 57696, 371, 57697, 372, 57698, 373, 57699, 374,
+	57700, 375, 57701, 376, 57702, 377, 57703, 378, 57704, 379,
+	57705, 380, 57706, 381, 57707, 382, 57708, 383, 57709, 384,
+	57710, 385, 57711, 386, 57712, 387, 57713, 388, 57714, 389,
+	57715, 390, 57716, 391, 57717, 392, 57718, 393, 57719, 394,
+	57720, 395, 57721, 396, 57722, 397, 57723, 398, 57724, 399,
+	57725, 400, 57726, 401, 57727, 402, 57728, 403, 57729, 404,
+	57730, 405, 57731, 406, 57732, 407, 57733, 408, 57734, 409,
+	57735, 410, 57736, 411, 57737, 412, 57738, 413, 57739, 414,
+	57740, 415, 57741, 416, 57742, 417, 57743, 418, 57744, 419,
+	57745, 420, 57746, 421, 57747, 422, 57748, 423, 57749, 424,
+	57750, 425, 57751, 426, 57752, 427, 57753, 428, 57754, 429,
+	57755, 430, 57756, 431, 57757, 432, 57758, 433, 57759, 434,
+	57760, 435, 57761, 436, 57762, 437, 57763, 438, 57764, 439,
+	57765, 440, 57766, 441, 57767, 442, 57768, 443, 57769, 444,
+	57770, 445, 57771, 446, 57772, 447, 57773, 448, 0,
+}
+
+var yyErrorMessages = [...]struct {
+	state int
+	token int
+	msg   string
+}{}
+
+//line yaccpar:1
+
+/*	parser for yacc output	*/
+
+func yyIaddr(v interface{}) __yyunsafe__.Pointer {
+	type h struct {
+		t __yyunsafe__.Pointer
+		p __yyunsafe__.Pointer
+	}
+	return (*h)(__yyunsafe__.Pointer(&v)).p
+}
+
+var (
+	yyDebug        = 0
+	yyErrorVerbose = false
+)
+
+type yyLexer interface {
+	Lex(lval *yySymType) int
+	Error(s string)
+}
+
+type yyParser interface {
+	Parse(yyLexer) int
+	Lookahead() int
+}
+
+type yyParserImpl struct {
+	lval  yySymType
+	stack [yyInitialStackSize]yySymType
+	char  int
+}
+
+func (p *yyParserImpl) Lookahead() int {
+	return p.char
+}
+
+func yyNewParser() yyParser {
+	return &yyParserImpl{}
+}
+
+const yyFlag = -1000
+
+func yyTokname(c int) string {
+	if c >= 1 && c-1 < len(yyToknames) {
+		if yyToknames[c-1] != "" {
+			return yyToknames[c-1]
+		}
+	}
+	return __yyfmt__.Sprintf("tok-%v", c)
+}
+
+func yyStatname(s int) string {
+	if s >= 0 && s < len(yyStatenames) {
+		if yyStatenames[s] != "" {
+			return yyStatenames[s]
+		}
+	}
+	return __yyfmt__.Sprintf("state-%v", s)
+}
+
+func yyErrorMessage(state, lookAhead int) string {
+	const TOKSTART = 4
+
+	if !yyErrorVerbose {
+		return "syntax error"
+	}
+
+	for _, e := range yyErrorMessages {
+		if e.state == state && e.token == lookAhead {
+			return "syntax error: " + e.msg
+		}
+	}
+
+	res := "syntax error: unexpected " + yyTokname(lookAhead)
+
+	// To match Bison, suggest at most four expected tokens.
+	expected := make([]int, 0, 4)
+
+	// Look for shiftable tokens.
+	base := yyPact[state]
+	for tok := TOKSTART; tok-1 < len(yyToknames); tok++ {
+		if n := base + tok; n >= 0 && n < yyLast && yyChk[yyAct[n]] == tok {
+			if len(expected) == cap(expected) {
+				return res
+			}
+			expected = append(expected, tok)
+		}
+	}
+
+	if yyDef[state] == -2 {
+		i := 0
+		for yyExca[i] != -1 || yyExca[i+1] != state {
+			i += 2
+		}
+
+		// Look for tokens that we accept or reduce.
+		for i += 2; yyExca[i] >= 0; i += 2 {
+			tok := yyExca[i]
+			if tok < TOKSTART || yyExca[i+1] == 0 {
+				continue
+			}
+			if len(expected) == cap(expected) {
+				return res
+			}
+			expected = append(expected, tok)
+		}
+
+		// If the default action is to accept or reduce, give up.
+		if yyExca[i+1] != 0 {
+			return res
+		}
+	}
+
+	for i, tok := range expected {
+		if i == 0 {
+			res += ", expecting "
+		} else {
+			res += " or "
+		}
+		res += yyTokname(tok)
+	}
+	return res
+}
+
+func yylex1(lex yyLexer, lval *yySymType) (char, token int) {
+	token = 0
+	char = lex.Lex(lval)
+	if char <= 0 {
+		token = yyTok1[0]
+		goto out
+	}
+	if char < len(yyTok1) {
+		token = yyTok1[char]
+		goto out
+	}
+	if char >= yyPrivate {
+		if char < yyPrivate+len(yyTok2) {
+			token = yyTok2[char-yyPrivate]
+			goto out
+		}
+	}
+	for i := 0; i < len(yyTok3); i += 2 {
+		token = yyTok3[i+0]
+		if token == char {
+			token = yyTok3[i+1]
+			goto out
+		}
+	}
+
+out:
+	if token == 0 {
+		token = yyTok2[1] /* unknown char */
+	}
+	if yyDebug >= 3 {
+		__yyfmt__.Printf("lex %s(%d)\n", yyTokname(token), uint(char))
+	}
+	return char, token
+}
+
+func yyParse(yylex yyLexer) int {
+	return yyNewParser().Parse(yylex)
+}
+
+func (yyrcvr *yyParserImpl) Parse(yylex yyLexer) int {
+	var yyn int
+	var yyVAL yySymType
+	var yyDollar []yySymType
+	_ = yyDollar // silence set and not used
+	yyS := yyrcvr.stack[:]
+
+	Nerrs := 0   /* number of errors */
+	Errflag := 0 /* error recovery flag */
+	yystate := 0
+	yyrcvr.char = -1
+	yytoken := -1 // yyrcvr.char translated into internal numbering
+	defer func() {
+		// Make sure we report no lookahead when not parsing.
+		yystate = -1
+		yyrcvr.char = -1
+		yytoken = -1
+	}()
+	yyp := -1
+	goto yystack
+
+ret0:
+	return 0
+
+ret1:
+	return 1
+
+yystack:
+	/* put a state and value onto the stack */
+	if yyDebug >= 4 {
+		__yyfmt__.Printf("char %v in %v\n", yyTokname(yytoken), yyStatname(yystate))
+	}
+
+	yyp++
+	if yyp >= len(yyS) {
+		nyys := make([]yySymType, len(yyS)*2)
+		copy(nyys, yyS)
+		yyS = nyys
+	}
+	yyS[yyp] = yyVAL
+	yyS[yyp].yys = yystate
+
+yynewstate:
+	yyn = yyPact[yystate]
+	if yyn <= yyFlag {
+		goto yydefault /* simple state */
+	}
+	if yyrcvr.char < 0 {
+		yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
+	}
 	yyn += yytoken
 	if yyn < 0 || yyn >= yyLast {
 		goto yydefault
 	}
-	yyn = yyAct[yyn]
-	if yyChk[yyn] == yytoken { /* valid shift */
-		yyrcvr.char = -1
-		yytoken = -1
-		yyVAL = yyrcvr.lval
-		yystate = yyn
-		if Errflag > 0 {
-			Errflag--
+	yyn = yyAct[yyn]
+	if yyChk[yyn] == yytoken { /* valid shift */
+		yyrcvr.char = -1
+		yytoken = -1
+		yyVAL = yyrcvr.lval
+		yystate = yyn
+		if Errflag > 0 {
+			Errflag--
+		}
+		goto yystack
+	}
+
+yydefault:
+	/* default state action */
+	yyn = yyDef[yystate]
+	if yyn == -2 {
+		if yyrcvr.char < 0 {
+			yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
+		}
+
+		/* look through exception table */
+		xi := 0
+		for {
+			if yyExca[xi+0] == -1 && yyExca[xi+1] == yystate {
+				break
+			}
+			xi += 2
+		}
+		for xi += 2; ; xi += 2 {
+			yyn = yyExca[xi+0]
+			if yyn < 0 || yyn == yytoken {
+				break
+			}
+		}
+		yyn = yyExca[xi+1]
+		if yyn < 0 {
+			goto ret0
+		}
+	}
+	if yyn == 0 {
+		/* error ... attempt to resume parsing */
+		switch Errflag {
+		case 0: /* brand new error */
+			yylex.Error(yyErrorMessage(yystate, yytoken))
+			Nerrs++
+			if yyDebug >= 1 {
+				__yyfmt__.Printf("%s", yyStatname(yystate))
+				__yyfmt__.Printf(" saw %s\n", yyTokname(yytoken))
+			}
+			fallthrough
+
+		case 1, 2: /* incompletely recovered error ... try again */
+			Errflag = 3
+
+			/* find a state where "error" is a legal shift action */
+			for yyp >= 0 {
+				yyn = yyPact[yyS[yyp].yys] + yyErrCode
+				if yyn >= 0 && yyn < yyLast {
+					yystate = yyAct[yyn] /* simulate a shift of "error" */
+					if yyChk[yystate] == yyErrCode {
+						goto yystack
+					}
+				}
+
+				/* the current p has no shift on "error", pop stack */
+				if yyDebug >= 2 {
+					__yyfmt__.Printf("error recovery pops state %d\n", yyS[yyp].yys)
+				}
+				yyp--
+			}
+			/* there is no state on the stack with an error shift ... abort */
+			goto ret1
+
+		case 3: /* no shift yet; clobber input char */
+			if yyDebug >= 2 {
+				__yyfmt__.Printf("error recovery discards %s\n", yyTokname(yytoken))
+			}
+			if yytoken == yyEofCode {
+				goto ret1
+			}
+			yyrcvr.char = -1
+			yytoken = -1
+			goto yynewstate /* try again in the same state */
+		}
+	}
+
+	/* reduction by production yyn */
+	if yyDebug >= 2 {
+		__yyfmt__.Printf("reduce %v in:\n\t%v\n", yyn, yyStatname(yystate))
+	}
+
+	yynt := yyn
+	yypt := yyp
+	_ = yypt // guard against "declared and not used"
+
+	yyp -= yyR2[yyn]
+	// yyp is now the index of $0. Perform the default action. Iff the
+	// reduced production is ε, $1 is possibly out of range.
+	if yyp+1 >= len(yyS) {
+		nyys := make([]yySymType, len(yyS)*2)
+		copy(nyys, yyS)
+		yyS = nyys
+	}
+	yyVAL = yyS[yyp+1]
+
+	/* consult goto table to find next state */
+	yyn = yyR1[yyn]
+	yyg := yyPgo[yyn]
+	yyj := yyg + yyS[yyp].yys + 1
+
+	if yyj >= yyLast {
+		yystate = yyAct[yyg]
+	} else {
+		yystate = yyAct[yyj]
+		if yyChk[yystate] != -yyn {
+			yystate = yyAct[yyg]
+		}
+	}
+	// dummy call; replaced with literal code
+	switch yynt {
+
+	case 2:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line postgresql_sql.y:509
+		{
+			if yyDollar[1].statementUnion() != nil {
+				yylex.(*Lexer).AppendStmt(yyDollar[1].statementUnion())
+			}
+		}
+	case 3:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line postgresql_sql.y:515
+		{
+			if yyDollar[3].statementUnion() != nil {
+				yylex.(*Lexer).AppendStmt(yyDollar[3].statementUnion())
+			}
+		}
+	case 20:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:539
+		{
+			yyLOCAL = yyDollar[1].selectUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 21:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:543
+		{
+			yyLOCAL = tree.Statement(nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 22:
+		yyDollar = yyS[yypt-15 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:549
+		{
+			yyLOCAL = &tree.Load{
+				Local:             yyDollar[3].boolValUnion(),
+				File:              yyDollar[5].str,
+				DataFormat:        tree.DataFormat(yyDollar[6].str),
+				DuplicateHandling: yyDollar[7].duplicateKeyUnion(),
+				Table:             yyDollar[10].tableNameUnion(),
+				Fields:            yyDollar[11].fieldsUnion(),
+				Lines:             yyDollar[12].linesUnion(),
+				IgnoredLines:      uint64(yyDollar[13].int64ValUnion()),
+				ColumnList:        yyDollar[14].loadColumnsUnion(),
+				Assignments:       yyDollar[15].updateExprsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 23:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:566
+		{
+			var cols []tree.LoadColumn
+			for _, c := range yyDollar[3].identifierListUnion() {
+				cols = append(cols, tree.SetUnresolvedName(string(c)))
+			}
+			var ignored uint64
+			if yyDollar[6].exportParmUnion().Header {
+				ignored = 1
+			}
+			yyLOCAL = &tree.Load{
+				File:              yyDollar[5].str,
+				DataFormat:        yyDollar[6].exportParmUnion().DataFormat,
+				DuplicateHandling: &tree.DuplicateKeyError{},
+				Table:             yyDollar[2].tableNameUnion(),
+				Fields:            yyDollar[6].exportParmUnion().Fields,
+				Lines:             yyDollar[6].exportParmUnion().Lines,
+				IgnoredLines:      ignored,
+				ColumnList:        cols,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 24:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:587
+		{
+			exprs := tree.SelectExprs{{Expr: tree.StarExpr()}}
+			if len(yyDollar[3].identifierListUnion()) > 0 {
+				exprs = make(tree.SelectExprs, len(yyDollar[3].identifierListUnion()))
+				for i, c := range yyDollar[3].identifierListUnion() {
+					exprs[i] = tree.SelectExpr{Expr: tree.SetUnresolvedName(string(c))}
+				}
+			}
+			yyDollar[6].exportParmUnion().Outfile = true
+			yyDollar[6].exportParmUnion().FilePath = yyDollar[5].str
+			yyLOCAL = &tree.Select{
+				Select: &tree.SelectClause{
+					Exprs: exprs,
+					From: &tree.From{
+						Tables: tree.TableExprs{&tree.AliasedTableExpr{Expr: yyDollar[2].tableNameUnion()}},
+					},
+				},
+				Ep: yyDollar[6].exportParmUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 25:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:608
+		{
+			exprs := tree.SelectExprs{{Expr: tree.StarExpr()}}
+			if len(yyDollar[3].identifierListUnion()) > 0 {
+				exprs = make(tree.SelectExprs, len(yyDollar[3].identifierListUnion()))
+				for i, c := range yyDollar[3].identifierListUnion() {
+					exprs[i] = tree.SelectExpr{Expr: tree.SetUnresolvedName(string(c))}
+				}
+			}
+			if !yyDollar[6].exportParmUnion().DataFormat.IsCSV() {
+				yylex.Error("copy to stdout supports only the text and csv formats")
+				return 1
+			}
+			yyDollar[6].exportParmUnion().Stdout = true
+			yyLOCAL = &tree.Select{
+				Select: &tree.SelectClause{
+					Exprs: exprs,
+					From: &tree.From{
+						Tables: tree.TableExprs{&tree.AliasedTableExpr{Expr: yyDollar[2].tableNameUnion()}},
+					},
+				},
+				Ep: yyDollar[6].exportParmUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 26:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:632
+		{
+			yyDollar[5].exportParmUnion().Outfile = true
+			yyDollar[5].exportParmUnion().FilePath = yyDollar[4].str
+			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), Ep: yyDollar[5].exportParmUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 27:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:638
+		{
+			if !yyDollar[5].exportParmUnion().DataFormat.IsCSV() {
+				yylex.Error("copy to stdout supports only the text and csv formats")
+				return 1
+			}
+			yyDollar[5].exportParmUnion().Stdout = true
+			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), Ep: yyDollar[5].exportParmUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 28:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.ExportParam
+//line postgresql_sql.y:649
+		{
+			ep, err := newCopyParam(yyDollar[1].propertiesUnion())
+			if err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyLOCAL = ep
+		}
+		yyVAL.union = yyLOCAL
+	case 29:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL []tree.Property
+//line postgresql_sql.y:659
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 30:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []tree.Property
+//line postgresql_sql.y:663
+		{
+			yyLOCAL = yyDollar[2].propertiesUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 31:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL []tree.Property
+//line postgresql_sql.y:667
+		{
+			yyLOCAL = yyDollar[3].propertiesUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 32:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []tree.Property
+//line postgresql_sql.y:673
+		{
+			yyLOCAL = []tree.Property{yyDollar[1].propertyUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 33:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []tree.Property
+//line postgresql_sql.y:677
+		{
+			yyLOCAL = append(yyDollar[1].propertiesUnion(), yyDollar[3].propertyUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 34:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Property
+//line postgresql_sql.y:683
+		{
+			yyLOCAL = tree.Property{Key: "format", Value: strings.ToLower(yyDollar[2].str)}
+		}
+		yyVAL.union = yyLOCAL
+	case 35:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Property
+//line postgresql_sql.y:687
+		{
+			yyLOCAL = tree.Property{Key: "delimiter", Value: yyDollar[2].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 36:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Property
+//line postgresql_sql.y:691
+		{
+			yyLOCAL = tree.Property{Key: "header", Value: "true"}
+		}
+		yyVAL.union = yyLOCAL
+	case 37:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Property
+//line postgresql_sql.y:695
+		{
+			yyLOCAL = tree.Property{Key: "header", Value: "true"}
+		}
+		yyVAL.union = yyLOCAL
+	case 38:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Property
+//line postgresql_sql.y:699
+		{
+			yyLOCAL = tree.Property{Key: "header", Value: "false"}
+		}
+		yyVAL.union = yyLOCAL
+	case 39:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Property
+//line postgresql_sql.y:703
+		{
+			yyLOCAL = tree.Property{Key: "quote", Value: yyDollar[2].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 40:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Property
+//line postgresql_sql.y:707
+		{
+			yyLOCAL = tree.Property{Key: "escape", Value: yyDollar[2].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 41:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line postgresql_sql.y:712
+		{
+			yyVAL.str = ""
+		}
+	case 42:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line postgresql_sql.y:716
+		{
+			f, ok := tree.NewDataFormat(yyDollar[2].str)
+			if !ok {
+				yylex.Error("unknown data format " + yyDollar[2].str)
+				return 1
+			}
+			yyVAL.str = string(f)
+		}
+	case 43:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.UpdateExprs
+//line postgresql_sql.y:726
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 44:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.UpdateExprs
+//line postgresql_sql.y:730
+		{
+			yyLOCAL = yyDollar[2].updateExprsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 45:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.UpdateExprs
+//line postgresql_sql.y:736
+		{
+			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 46:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.UpdateExprs
+//line postgresql_sql.y:740
+		{
+			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 47:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.UpdateExpr
+//line postgresql_sql.y:746
+		{
+			yyLOCAL = &tree.UpdateExpr{
+				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
+				Expr:  &tree.DefaultVal{},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 48:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.UpdateExpr
+//line postgresql_sql.y:753
+		{
+			yyLOCAL = &tree.UpdateExpr{
+				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
+				Expr:  yyDollar[3].exprUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 49:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.UnresolvedName
+//line postgresql_sql.y:762
+		{
+			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 50:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.UnresolvedName
+//line postgresql_sql.y:766
+		{
+			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 51:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.UnresolvedName
+//line postgresql_sql.y:770
+		{
+			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 52:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL []tree.LoadColumn
+//line postgresql_sql.y:775
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 53:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL []tree.LoadColumn
+//line postgresql_sql.y:779
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 54:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []tree.LoadColumn
+//line postgresql_sql.y:783
+		{
+			yyLOCAL = yyDollar[2].loadColumnsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 55:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []tree.LoadColumn
+//line postgresql_sql.y:789
+		{
+			switch yyDollar[1].loadColumnUnion().(type) {
+			case *tree.UnresolvedName:
+				yyLOCAL = []tree.LoadColumn{yyDollar[1].loadColumnUnion().(*tree.UnresolvedName)}
+			case *tree.VarExpr:
+				yyLOCAL = []tree.LoadColumn{yyDollar[1].loadColumnUnion().(*tree.VarExpr)}
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 56:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []tree.LoadColumn
+//line postgresql_sql.y:798
+		{
+			switch yyDollar[3].loadColumnUnion().(type) {
+			case *tree.UnresolvedName:
+				yyLOCAL = append(yyDollar[1].loadColumnsUnion(), yyDollar[3].loadColumnUnion().(*tree.UnresolvedName))
+			case *tree.VarExpr:
+				yyLOCAL = append(yyDollar[1].loadColumnsUnion(), yyDollar[3].loadColumnUnion().(*tree.VarExpr))
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 57:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.LoadColumn
+//line postgresql_sql.y:809
+		{
+			yyLOCAL = yyDollar[1].unresolvedNameUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 58:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.LoadColumn
+//line postgresql_sql.y:813
+		{
+			yyLOCAL = yyDollar[1].varExprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 59:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.VarExpr
+//line postgresql_sql.y:819
+		{
+			yyLOCAL = yyDollar[1].varExprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 60:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.VarExpr
+//line postgresql_sql.y:823
+		{
+			yyLOCAL = yyDollar[1].varExprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 61:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.VarExpr
+//line postgresql_sql.y:829
+		{
+			vs := strings.Split(yyDollar[1].str, ".")
+			var isGlobal bool
+			if strings.ToLower(vs[0]) == "global" {
+				isGlobal = true
+			}
+			var r string
+			if len(vs) == 2 {
+				r = vs[1]
+			} else if len(vs) == 1 {
+				r = vs[0]
+			} else {
+				yylex.Error("variable syntax error")
+				return 1
+			}
+			yyLOCAL = &tree.VarExpr{
+				Name:   r,
+				System: true,
+				Global: isGlobal,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 62:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.VarExpr
+//line postgresql_sql.y:853
+		{
+			vs := strings.Split(yyDollar[1].str, ".")
+			var r string
+			if len(vs) == 2 {
+				r = vs[1]
+			} else if len(vs) == 1 {
+				r = vs[0]
+			} else {
+				yylex.Error("variable syntax error")
+				return 1
+			}
+			yyLOCAL = &tree.VarExpr{
+				Name:   r,
+				System: false,
+				Global: false,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 63:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL int64
+//line postgresql_sql.y:872
+		{
+			yyLOCAL = 0
+		}
+		yyVAL.union = yyLOCAL
+	case 64:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL int64
+//line postgresql_sql.y:876
+		{
+			yyLOCAL = yyDollar[2].item.(int64)
+		}
+		yyVAL.union = yyLOCAL
+	case 65:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.Lines
+//line postgresql_sql.y:881
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 66:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.Lines
+//line postgresql_sql.y:885
+		{
+			yyLOCAL = &tree.Lines{
+				StartingBy:   yyDollar[2].str,
+				TerminatedBy: yyDollar[3].str,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 67:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line postgresql_sql.y:893
+		{
+			yyVAL.str = ""
+		}
+	case 68:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line postgresql_sql.y:897
+		{
+			yyVAL.str = yyDollar[3].str
+		}
+	case 69:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line postgresql_sql.y:902
+		{
+			yyVAL.str = "\n"
+		}
+	case 70:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line postgresql_sql.y:906
+		{
+			yyVAL.str = yyDollar[3].str
+		}
+	case 71:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.Fields
+//line postgresql_sql.y:911
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 72:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.Fields
+//line postgresql_sql.y:915
+		{
+			res := &tree.Fields{
+				Terminated: "\t",
+				EscapedBy:  0,
+			}
+			for _, f := range yyDollar[2].fieldsListUnion() {
+				if f.Terminated != "" {
+					res.Terminated = f.Terminated
+				}
+				if f.Optionally {
+					res.Optionally = f.Optionally
+				}
+				if f.EnclosedBy != 0 {
+					res.EnclosedBy = f.EnclosedBy
+				}
+				if f.EscapedBy != 0 {
+					res.EscapedBy = f.EscapedBy
+				}
+			}
+			yyLOCAL = res
+		}
+		yyVAL.union = yyLOCAL
+	case 73:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*tree.Fields
+//line postgresql_sql.y:939
+		{
+			yyLOCAL = []*tree.Fields{yyDollar[1].fieldsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 74:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL []*tree.Fields
+//line postgresql_sql.y:943
+		{
+			yyLOCAL = append(yyDollar[1].fieldsListUnion(), yyDollar[2].fieldsUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 75:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.Fields
+//line postgresql_sql.y:949
+		{
+			yyLOCAL = &tree.Fields{
+				Terminated: yyDollar[3].str,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 76:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.Fields
+//line postgresql_sql.y:955
+		{
+			str := yyDollar[4].str
+			if str != "\\" && len(str) > 1 {
+				yylex.Error("error field terminator")
+				return 1
+			}
+			var b byte
+			if len(str) != 0 {
+				b = byte(str[0])
+			} else {
+				b = 0
+			}
+			yyLOCAL = &tree.Fields{
+				Optionally: true,
+				EnclosedBy: b,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 77:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.Fields
+//line postgresql_sql.y:973
+		{
+			str := yyDollar[3].str
+			if str != "\\" && len(str) > 1 {
+				yylex.Error("error field terminator")
+				return 1
+			}
+			var b byte
+			if len(str) != 0 {
+				b = byte(str[0])
+			} else {
+				b = 0
+			}
+			yyLOCAL = &tree.Fields{
+				EnclosedBy: b,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 78:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.Fields
+//line postgresql_sql.y:990
+		{
+			str := yyDollar[3].str
+			if str != "\\" && len(str) > 1 {
+				yylex.Error("error field terminator")
+				return 1
+			}
+			var b byte
+			if len(str) != 0 {
+				b = byte(str[0])
+			} else {
+				b = 0
+			}
+			yyLOCAL = &tree.Fields{
+				EscapedBy: b,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 80:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.DuplicateKey
+//line postgresql_sql.y:1013
+		{
+			yyLOCAL = &tree.DuplicateKeyError{}
+		}
+		yyVAL.union = yyLOCAL
+	case 81:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.DuplicateKey
+//line postgresql_sql.y:1017
+		{
+			yyLOCAL = &tree.DuplicateKeyIgnore{}
+		}
+		yyVAL.union = yyLOCAL
+	case 82:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.DuplicateKey
+//line postgresql_sql.y:1021
+		{
+			yyLOCAL = &tree.DuplicateKeyReplace{}
+		}
+		yyVAL.union = yyLOCAL
+	case 83:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line postgresql_sql.y:1026
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 84:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL bool
+//line postgresql_sql.y:1030
+		{
+			yyLOCAL = true
+		}
+		yyVAL.union = yyLOCAL
+	case 85:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1036
+		{
+			yyLOCAL = &tree.Grant{
+				Privileges:  yyDollar[2].privilegesUnion(),
+				ObjType:     yyDollar[4].objectTypeUnion(),
+				Level:       yyDollar[5].privilegeLevelUnion(),
+				Users:       yyDollar[7].usersUnion(),
+				GrantOption: yyDollar[9].boolValUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 86:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1046
+		{
+			yyLOCAL = &tree.Grant{
+				IsGrantRole:      true,
+				RolesInGrantRole: yyDollar[2].rolesUnion(),
+				Users:            yyDollar[4].usersUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 87:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1054
+		{
+			yyLOCAL = &tree.Grant{
+				IsProxy:     true,
+				ProxyUser:   yyDollar[4].userUnion(),
+				Users:       yyDollar[6].usersUnion(),
+				GrantOption: yyDollar[7].boolValUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 88:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line postgresql_sql.y:1064
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 89:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL bool
+//line postgresql_sql.y:1068
+		{
+			yyLOCAL = true
+		}
+		yyVAL.union = yyLOCAL
+	case 90:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1078
+		{
+			yyLOCAL = &tree.Revoke{
+				Privileges: yyDollar[2].privilegesUnion(),
+				ObjType:    yyDollar[4].objectTypeUnion(),
+				Level:      yyDollar[5].privilegeLevelUnion(),
+				Users:      yyDollar[7].usersUnion(),
+				Roles:      nil,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 91:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1088
+		{
+			yyLOCAL = &tree.Revoke{
+				IsRevokeRole:      true,
+				RolesInRevokeRole: yyDollar[2].rolesUnion(),
+				Users:             yyDollar[4].usersUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 92:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.PrivilegeLevel
+//line postgresql_sql.y:1098
+		{
+			yyLOCAL = &tree.PrivilegeLevel{
+				Level: tree.PRIVILEGE_LEVEL_TYPE_DATABASE,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 93:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.PrivilegeLevel
+//line postgresql_sql.y:1104
+		{
+			yyLOCAL = &tree.PrivilegeLevel{
+				Level: tree.PRIVILEGE_LEVEL_TYPE_GLOBAL,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 94:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.PrivilegeLevel
+//line postgresql_sql.y:1110
+		{
+			yyLOCAL = &tree.PrivilegeLevel{
+				Level:  tree.PRIVILEGE_LEVEL_TYPE_DATABASE,
+				DbName: yyDollar[1].str,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 95:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.PrivilegeLevel
+//line postgresql_sql.y:1117
+		{
+			yyLOCAL = &tree.PrivilegeLevel{
+				Level:   tree.PRIVILEGE_LEVEL_TYPE_TABLE,
+				DbName:  yyDollar[1].str,
+				TabName: yyDollar[3].str,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 96:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.PrivilegeLevel
+//line postgresql_sql.y:1125
+		{
+			yyLOCAL = &tree.PrivilegeLevel{
+				Level:   tree.PRIVILEGE_LEVEL_TYPE_TABLE,
+				TabName: yyDollar[1].str,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 97:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.ObjectType
+//line postgresql_sql.y:1133
+		{
+			yyLOCAL = tree.OBJECT_TYPE_NONE
+		}
+		yyVAL.union = yyLOCAL
+	case 98:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.ObjectType
+//line postgresql_sql.y:1137
+		{
+			yyLOCAL = tree.OBJECT_TYPE_TABLE
+		}
+		yyVAL.union = yyLOCAL
+	case 99:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.ObjectType
+//line postgresql_sql.y:1141
+		{
+			yyLOCAL = tree.OBJECT_TYPE_FUNCTION
+		}
+		yyVAL.union = yyLOCAL
+	case 100:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.ObjectType
+//line postgresql_sql.y:1145
+		{
+			yyLOCAL = tree.OBJECT_TYPE_PROCEDURE
+		}
+		yyVAL.union = yyLOCAL
+	case 101:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*tree.Privilege
+//line postgresql_sql.y:1151
+		{
+			yyLOCAL = []*tree.Privilege{yyDollar[1].privilegeUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 102:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []*tree.Privilege
+//line postgresql_sql.y:1155
+		{
+			yyLOCAL = append(yyDollar[1].privilegesUnion(), yyDollar[3].privilegeUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 103:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.Privilege
+//line postgresql_sql.y:1161
+		{
+			yyLOCAL = &tree.Privilege{
+				Type:       yyDollar[1].privilegeTypeUnion(),
+				ColumnList: nil,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 104:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.Privilege
+//line postgresql_sql.y:1168
+		{
+			yyLOCAL = &tree.Privilege{
+				Type:       yyDollar[1].privilegeTypeUnion(),
+				ColumnList: yyDollar[3].unresolveNamesUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 105:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*tree.UnresolvedName
+//line postgresql_sql.y:1177
+		{
+			yyLOCAL = []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 106:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []*tree.UnresolvedName
+//line postgresql_sql.y:1181
+		{
+			yyLOCAL = append(yyDollar[1].unresolveNamesUnion(), yyDollar[3].unresolvedNameUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 107:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.PrivilegeType
+//line postgresql_sql.y:1187
+		{
+			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
+		}
+		yyVAL.union = yyLOCAL
+	case 108:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.PrivilegeType
+//line postgresql_sql.y:1191
+		{
+			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
+		}
+		yyVAL.union = yyLOCAL
+	case 109:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.PrivilegeType
+//line postgresql_sql.y:1195
+		{
+			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER
+		}
+		yyVAL.union = yyLOCAL
+	case 110:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.PrivilegeType
+//line postgresql_sql.y:1199
+		{
+			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE
+		}
+		yyVAL.union = yyLOCAL
+	case 111:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.PrivilegeType
+//line postgresql_sql.y:1203
+		{
+			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_USER
+		}
+		yyVAL.union = yyLOCAL
+	case 112:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.PrivilegeType
+//line postgresql_sql.y:1207
+		{
+			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TABLESPACE
+		}
+		yyVAL.union = yyLOCAL
+	case 113:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.PrivilegeType
+//line postgresql_sql.y:1211
+		{
+			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_TRIGGER
+		}
+		yyVAL.union = yyLOCAL
+	case 114:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.PrivilegeType
+//line postgresql_sql.y:1215
+		{
+			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DELETE
+		}
+		yyVAL.union = yyLOCAL
+	case 115:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.PrivilegeType
+//line postgresql_sql.y:1219
+		{
+			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP
+		}
+		yyVAL.union = yyLOCAL
+	case 116:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.PrivilegeType
+//line postgresql_sql.y:1223
+		{
+			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EXECUTE
+		}
+		yyVAL.union = yyLOCAL
+	case 117:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.PrivilegeType
+//line postgresql_sql.y:1227
+		{
+			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INDEX
+		}
+		yyVAL.union = yyLOCAL
+	case 118:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.PrivilegeType
+//line postgresql_sql.y:1231
+		{
+			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INSERT
+		}
+		yyVAL.union = yyLOCAL
+	case 119:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.PrivilegeType
+//line postgresql_sql.y:1235
+		{
+			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SELECT
+		}
+		yyVAL.union = yyLOCAL
+	case 120:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.PrivilegeType
+//line postgresql_sql.y:1239
+		{
+			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SUPER
+		}
+		yyVAL.union = yyLOCAL
+	case 121:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.PrivilegeType
+//line postgresql_sql.y:1243
+		{
+			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_DATABASES
+		}
+		yyVAL.union = yyLOCAL
+	case 122:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.PrivilegeType
+//line postgresql_sql.y:1247
+		{
+			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_UPDATE
+		}
+		yyVAL.union = yyLOCAL
+	case 123:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.PrivilegeType
+//line postgresql_sql.y:1251
+		{
+			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION
+		}
+		yyVAL.union = yyLOCAL
+	case 124:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.PrivilegeType
+//line postgresql_sql.y:1255
+		{
+			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REFERENCES
+		}
+		yyVAL.union = yyLOCAL
+	case 125:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.PrivilegeType
+//line postgresql_sql.y:1259
+		{
+			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_SLAVE
+		}
+		yyVAL.union = yyLOCAL
+	case 126:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.PrivilegeType
+//line postgresql_sql.y:1263
+		{
+			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_CLIENT
+		}
+		yyVAL.union = yyLOCAL
+	case 127:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.PrivilegeType
+//line postgresql_sql.y:1267
+		{
+			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_USAGE
+		}
+		yyVAL.union = yyLOCAL
+	case 128:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.PrivilegeType
+//line postgresql_sql.y:1271
+		{
+			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_RELOAD
+		}
+		yyVAL.union = yyLOCAL
+	case 129:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.PrivilegeType
+//line postgresql_sql.y:1275
+		{
+			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_FILE
+		}
+		yyVAL.union = yyLOCAL
+	case 130:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.PrivilegeType
+//line postgresql_sql.y:1279
+		{
+			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TEMPORARY_TABLES
+		}
+		yyVAL.union = yyLOCAL
+	case 131:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.PrivilegeType
+//line postgresql_sql.y:1283
+		{
+			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_LOCK_TABLES
+		}
+		yyVAL.union = yyLOCAL
+	case 132:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.PrivilegeType
+//line postgresql_sql.y:1287
+		{
+			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_VIEW
+		}
+		yyVAL.union = yyLOCAL
+	case 133:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.PrivilegeType
+//line postgresql_sql.y:1291
+		{
+			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_VIEW
+		}
+		yyVAL.union = yyLOCAL
+	case 134:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.PrivilegeType
+//line postgresql_sql.y:1295
+		{
+			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROLE
+		}
+		yyVAL.union = yyLOCAL
+	case 135:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.PrivilegeType
+//line postgresql_sql.y:1299
+		{
+			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_ROLE
+		}
+		yyVAL.union = yyLOCAL
+	case 136:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.PrivilegeType
+//line postgresql_sql.y:1303
+		{
+			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROUTINE
+		}
+		yyVAL.union = yyLOCAL
+	case 137:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.PrivilegeType
+//line postgresql_sql.y:1307
+		{
+			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_ROUTINE
+		}
+		yyVAL.union = yyLOCAL
+	case 138:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.PrivilegeType
+//line postgresql_sql.y:1311
+		{
+			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EVENT
+		}
+		yyVAL.union = yyLOCAL
+	case 139:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.PrivilegeType
+//line postgresql_sql.y:1315
+		{
+			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHUTDOWN
+		}
+		yyVAL.union = yyLOCAL
+	case 144:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1327
+		{
+			yyLOCAL = yyDollar[3].setRoleUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 145:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1333
+		{
+			dr := yyDollar[4].setDefaultRoleUnion()
+			dr.Users = yyDollar[6].usersUnion()
+			yyLOCAL = dr
+		}
+		yyVAL.union = yyLOCAL
+	case 146:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.SetRole
+//line postgresql_sql.y:1341
+		{
+			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_ALL_EXCEPT, Roles: yyDollar[3].rolesUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 147:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.SetRole
+//line postgresql_sql.y:1345
+		{
+			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_DEFAULT, Roles: nil}
+		}
+		yyVAL.union = yyLOCAL
+	case 148:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.SetRole
+//line postgresql_sql.y:1349
+		{
+			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_NONE, Roles: nil}
+		}
+		yyVAL.union = yyLOCAL
+	case 149:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.SetRole
+//line postgresql_sql.y:1353
+		{
+			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_ALL, Roles: nil}
+		}
+		yyVAL.union = yyLOCAL
+	case 150:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.SetRole
+//line postgresql_sql.y:1357
+		{
+			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 151:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.SetDefaultRole
+//line postgresql_sql.y:1363
+		{
+			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NONE, Roles: nil}
+		}
+		yyVAL.union = yyLOCAL
+	case 152:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.SetDefaultRole
+//line postgresql_sql.y:1367
+		{
+			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_ALL, Roles: nil}
+		}
+		yyVAL.union = yyLOCAL
+	case 153:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.SetDefaultRole
+//line postgresql_sql.y:1371
+		{
+			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 154:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1377
+		{
+			yyLOCAL = &tree.SetVar{Assignments: yyDollar[2].varAssignmentExprsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 155:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1383
+		{
+			yyLOCAL = &tree.SetPassword{Password: yyDollar[4].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 156:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1387
+		{
+			yyLOCAL = &tree.SetPassword{User: yyDollar[4].userUnion(), Password: yyDollar[6].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 158:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line postgresql_sql.y:1394
+		{
+			yyVAL.str = yyDollar[3].str
+		}
+	case 159:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*tree.VarAssignmentExpr
+//line postgresql_sql.y:1400
+		{
+			yyLOCAL = []*tree.VarAssignmentExpr{yyDollar[1].varAssignmentExprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 160:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []*tree.VarAssignmentExpr
+//line postgresql_sql.y:1404
+		{
+			yyLOCAL = append(yyDollar[1].varAssignmentExprsUnion(), yyDollar[3].varAssignmentExprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 161:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.VarAssignmentExpr
+//line postgresql_sql.y:1410
+		{
+			yyLOCAL = &tree.VarAssignmentExpr{
+				System: true,
+				Name:   yyDollar[1].str,
+				Value:  yyDollar[3].exprUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 162:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.VarAssignmentExpr
+//line postgresql_sql.y:1418
+		{
+			yyLOCAL = &tree.VarAssignmentExpr{
+				System: true,
+				Global: true,
+				Name:   yyDollar[2].str,
+				Value:  yyDollar[4].exprUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 163:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.VarAssignmentExpr
+//line postgresql_sql.y:1427
+		{
+			yyLOCAL = &tree.VarAssignmentExpr{
+				System: true,
+				Name:   yyDollar[2].str,
+				Value:  yyDollar[4].exprUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 164:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.VarAssignmentExpr
+//line postgresql_sql.y:1435
+		{
+			yyLOCAL = &tree.VarAssignmentExpr{
+				System: true,
+				Name:   yyDollar[2].str,
+				Value:  yyDollar[4].exprUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 165:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.VarAssignmentExpr
+//line postgresql_sql.y:1443
+		{
+			yyLOCAL = &tree.VarAssignmentExpr{
+				Name:  yyDollar[1].str,
+				Value: yyDollar[3].exprUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 166:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.VarAssignmentExpr
+//line postgresql_sql.y:1450
+		{
+			vs := strings.Split(yyDollar[1].str, ".")
+			var isGlobal bool
+			if strings.ToLower(vs[0]) == "global" {
+				isGlobal = true
+			}
+			var r string
+			if len(vs) == 2 {
+				r = vs[1]
+			} else if len(vs) == 1 {
+				r = vs[0]
+			} else {
+				yylex.Error("variable syntax error")
+				return 1
+			}
+			yyLOCAL = &tree.VarAssignmentExpr{
+				System: true,
+				Global: isGlobal,
+				Name:   r,
+				Value:  yyDollar[3].exprUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 167:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.VarAssignmentExpr
+//line postgresql_sql.y:1473
+		{
+			yyLOCAL = &tree.VarAssignmentExpr{
+				System: true,
+				Name:   yyDollar[1].str,
+				Value:  tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 168:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.VarAssignmentExpr
+//line postgresql_sql.y:1481
+		{
+			yyLOCAL = &tree.VarAssignmentExpr{
+				System: true,
+				Name:   yyDollar[1].str,
+				Value:  tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 169:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.VarAssignmentExpr
+//line postgresql_sql.y:1489
+		{
+			yyLOCAL = &tree.VarAssignmentExpr{
+				System:   true,
+				Name:     yyDollar[1].str,
+				Value:    tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false),
+				Reserved: tree.NewNumVal(constant.MakeString(yyDollar[4].str), yyDollar[4].str, false),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 170:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.VarAssignmentExpr
+//line postgresql_sql.y:1498
+		{
+			yyLOCAL = &tree.VarAssignmentExpr{
+				System: true,
+				Name:   yyDollar[1].str,
+				Value:  &tree.DefaultVal{},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 171:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.VarAssignmentExpr
+//line postgresql_sql.y:1506
+		{
+			yyLOCAL = &tree.VarAssignmentExpr{
+				System: true,
+				Name:   yyDollar[1].str,
+				Value:  tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 172:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.VarAssignmentExpr
+//line postgresql_sql.y:1514
+		{
+			yyLOCAL = &tree.VarAssignmentExpr{
+				System: true,
+				Name:   yyDollar[1].str,
+				Value:  &tree.DefaultVal{},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 173:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1524
+		{
+			yyLOCAL = tree.NewNumVal(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false)
+		}
+		yyVAL.union = yyLOCAL
+	case 174:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1528
+		{
+			yyLOCAL = tree.NewNumVal(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false)
+		}
+		yyVAL.union = yyLOCAL
+	case 175:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1532
+		{
+			yyLOCAL = yyDollar[1].exprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 176:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line postgresql_sql.y:1538
+		{
+			yyVAL.str = string(yyDollar[1].str)
+		}
+	case 177:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line postgresql_sql.y:1542
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 179:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line postgresql_sql.y:1549
+		{
+			yyVAL.str = yyDollar[1].str + "." + yyDollar[3].str
+		}
+	case 183:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1560
+		{
+			yyLOCAL = &tree.RollbackTransaction{Type: yyDollar[2].completionTypeUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 184:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1566
+		{
+			yyLOCAL = &tree.CommitTransaction{Type: yyDollar[2].completionTypeUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 185:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.CompletionType
+//line postgresql_sql.y:1571
+		{
+			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
+		}
+		yyVAL.union = yyLOCAL
+	case 186:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.CompletionType
+//line postgresql_sql.y:1575
+		{
+			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
+		}
+		yyVAL.union = yyLOCAL
+	case 187:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.CompletionType
+//line postgresql_sql.y:1579
+		{
+			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
+		}
+		yyVAL.union = yyLOCAL
+	case 188:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.CompletionType
+//line postgresql_sql.y:1583
+		{
+			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
+		}
+		yyVAL.union = yyLOCAL
+	case 189:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.CompletionType
+//line postgresql_sql.y:1587
+		{
+			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
+		}
+		yyVAL.union = yyLOCAL
+	case 190:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.CompletionType
+//line postgresql_sql.y:1591
+		{
+			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
+		}
+		yyVAL.union = yyLOCAL
+	case 191:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.CompletionType
+//line postgresql_sql.y:1595
+		{
+			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
+		}
+		yyVAL.union = yyLOCAL
+	case 192:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.CompletionType
+//line postgresql_sql.y:1599
+		{
+			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
+		}
+		yyVAL.union = yyLOCAL
+	case 193:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1605
+		{
+			yyLOCAL = &tree.BeginTransaction{}
+		}
+		yyVAL.union = yyLOCAL
+	case 194:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1609
+		{
+			yyLOCAL = &tree.BeginTransaction{}
+		}
+		yyVAL.union = yyLOCAL
+	case 195:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1613
+		{
+			yyLOCAL = &tree.BeginTransaction{}
+		}
+		yyVAL.union = yyLOCAL
+	case 196:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1617
+		{
+			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_WRITE)
+			yyLOCAL = &tree.BeginTransaction{Modes: m}
+		}
+		yyVAL.union = yyLOCAL
+	case 197:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1622
+		{
+			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_ONLY)
+			yyLOCAL = &tree.BeginTransaction{Modes: m}
+		}
+		yyVAL.union = yyLOCAL
+	case 198:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1627
+		{
+			yyLOCAL = &tree.BeginTransaction{}
+		}
+		yyVAL.union = yyLOCAL
+	case 199:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1633
+		{
+			yyLOCAL = &tree.Use{Name: yyDollar[2].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 200:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1637
+		{
+			yyLOCAL = &tree.Use{}
+		}
+		yyVAL.union = yyLOCAL
+	case 201:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1643
+		{
+			yyLOCAL = &tree.Update{
+				Table:   yyDollar[2].tableExprUnion(),
+				Exprs:   yyDollar[4].updateExprsUnion(),
+				Where:   yyDollar[5].whereUnion(),
+				OrderBy: yyDollar[6].orderByUnion(),
+				Limit:   yyDollar[7].limitUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 202:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.UpdateExprs
+//line postgresql_sql.y:1656
+		{
+			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 203:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.UpdateExprs
+//line postgresql_sql.y:1660
+		{
+			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 204:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.UpdateExpr
+//line postgresql_sql.y:1666
+		{
+			yyLOCAL = &tree.UpdateExpr{Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}, Expr: yyDollar[3].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 208:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1675
+		{
+			yyLOCAL = yyDollar[1].selectUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 209:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1681
+		{
+			st := &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion()}
+			yyLOCAL = tree.NewExplainStmt(st, "")
+		}
+		yyVAL.union = yyLOCAL
+	case 210:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1686
+		{
+			st := &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion(), ColName: yyDollar[3].unresolvedNameUnion()}
+			yyLOCAL = tree.NewExplainStmt(st, "")
+		}
+		yyVAL.union = yyLOCAL
+	case 211:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1691
+		{
+			yyLOCAL = tree.NewExplainFor("", uint64(yyDollar[4].item.(int64)))
+		}
+		yyVAL.union = yyLOCAL
+	case 212:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1695
+		{
+			yyLOCAL = tree.NewExplainFor(yyDollar[4].str, uint64(yyDollar[7].item.(int64)))
+		}
+		yyVAL.union = yyLOCAL
+	case 213:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1699
+		{
+			yyLOCAL = tree.NewExplainStmt(yyDollar[2].statementUnion(), "row")
+		}
+		yyVAL.union = yyLOCAL
+	case 214:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1703
+		{
+			yyLOCAL = tree.NewExplainStmt(yyDollar[5].statementUnion(), yyDollar[4].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 215:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1707
+		{
+			yyLOCAL = tree.NewExplainAnalyze(yyDollar[3].statementUnion(), "")
+		}
+		yyVAL.union = yyLOCAL
+	case 219:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1718
+		{
+			yyLOCAL = tree.NewAnalyzeStmt(yyDollar[3].tableNameUnion(), yyDollar[5].identifierListUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 222:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1728
+		{
+			yyLOCAL = tree.NewAlterTable(*yyDollar[3].tableNameUnion(), yyDollar[4].alterTableOptionsUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 223:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []tree.AlterTableOption
+//line postgresql_sql.y:1734
+		{
+			yyLOCAL = []tree.AlterTableOption{yyDollar[1].alterTableOptionUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 224:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []tree.AlterTableOption
+//line postgresql_sql.y:1738
+		{
+			yyLOCAL = append(yyDollar[1].alterTableOptionsUnion(), yyDollar[3].alterTableOptionUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 225:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line postgresql_sql.y:1744
+		{
+			yyLOCAL = &tree.AlterTableAddColumn{Column: yyDollar[3].columnTableDefUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 226:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line postgresql_sql.y:1748
+		{
+			yyLOCAL = &tree.AlterTableDropColumn{Name: tree.Identifier(yyDollar[3].str)}
+		}
+		yyVAL.union = yyLOCAL
+	case 227:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line postgresql_sql.y:1752
+		{
+			yyLOCAL = &tree.AlterTableRenameColumn{Name: tree.Identifier(yyDollar[3].str), NewName: tree.Identifier(yyDollar[5].str)}
+		}
+		yyVAL.union = yyLOCAL
+	case 228:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line postgresql_sql.y:1756
+		{
+			yyLOCAL = &tree.AlterTableColumnDefault{Name: tree.Identifier(yyDollar[3].str), Expr: yyDollar[6].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 229:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line postgresql_sql.y:1760
+		{
+			yyLOCAL = &tree.AlterTableColumnDefault{Name: tree.Identifier(yyDollar[3].str)}
+		}
+		yyVAL.union = yyLOCAL
+	case 230:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line postgresql_sql.y:1764
+		{
+			yyLOCAL = &tree.AlterTableRename{Name: *yyDollar[3].tableNameUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 231:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line postgresql_sql.y:1769
+		{
+		}
+	case 232:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line postgresql_sql.y:1771
+		{
+		}
+	case 233:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line postgresql_sql.y:1774
+		{
+		}
+	case 234:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line postgresql_sql.y:1776
+		{
+		}
+	case 235:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line postgresql_sql.y:1778
+		{
+		}
+	case 236:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1782
+		{
+			yyLOCAL = &tree.AlterUser{
+				IfExists:   yyDollar[3].boolValUnion(),
+				IsUserFunc: false,
+				Users:      yyDollar[4].usersUnion(),
+				TlsOpts:    yyDollar[5].tlsOptionsUnion(),
+				ResOpts:    yyDollar[6].resourceOptionsUnion(),
+				MiscOpts:   yyDollar[7].userMiscOptionsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 237:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1793
+		{
+			auth := &tree.User{
+				AuthString: yyDollar[9].str,
+				ByAuth:     true,
+			}
+			yyLOCAL = &tree.AlterUser{
+				IfExists:   yyDollar[3].boolValUnion(),
+				IsUserFunc: true,
+				UserFunc:   auth,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 238:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line postgresql_sql.y:1806
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 239:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL bool
+//line postgresql_sql.y:1810
+		{
+			yyLOCAL = true
+		}
+		yyVAL.union = yyLOCAL
+	case 240:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL []tree.UserMiscOption
+//line postgresql_sql.y:1815
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 241:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []tree.UserMiscOption
+//line postgresql_sql.y:1819
+		{
+			yyLOCAL = yyDollar[1].userMiscOptionsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 242:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []tree.UserMiscOption
+//line postgresql_sql.y:1825
+		{
+			yyLOCAL = []tree.UserMiscOption{yyDollar[1].userMiscOptionUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 243:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL []tree.UserMiscOption
+//line postgresql_sql.y:1829
+		{
+			yyLOCAL = append(yyDollar[1].userMiscOptionsUnion(), yyDollar[2].userMiscOptionUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 244:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.UserMiscOption
+//line postgresql_sql.y:1835
+		{
+			yyLOCAL = &tree.UserMiscOptionAccountUnlock{}
+		}
+		yyVAL.union = yyLOCAL
+	case 245:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.UserMiscOption
+//line postgresql_sql.y:1839
+		{
+			yyLOCAL = &tree.UserMiscOptionAccountLock{}
+		}
+		yyVAL.union = yyLOCAL
+	case 246:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.UserMiscOption
+//line postgresql_sql.y:1843
+		{
+			yyLOCAL = &tree.UserMiscOptionPasswordExpireNone{}
+		}
+		yyVAL.union = yyLOCAL
+	case 247:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.UserMiscOption
+//line postgresql_sql.y:1847
+		{
+			yyLOCAL = &tree.UserMiscOptionPasswordExpireInterval{Value: yyDollar[3].item.(int64)}
+		}
+		yyVAL.union = yyLOCAL
+	case 248:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.UserMiscOption
+//line postgresql_sql.y:1851
+		{
+			yyLOCAL = &tree.UserMiscOptionPasswordExpireNever{}
+		}
+		yyVAL.union = yyLOCAL
+	case 249:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.UserMiscOption
+//line postgresql_sql.y:1855
+		{
+			yyLOCAL = &tree.UserMiscOptionPasswordExpireDefault{}
+		}
+		yyVAL.union = yyLOCAL
+	case 250:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line postgresql_sql.y:1861
+		{
+			yyVAL.item = nil
+		}
+	case 251:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line postgresql_sql.y:1866
+		{
+			yyVAL.item = nil
+		}
+	case 263:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1887
+		{
+			yyLOCAL = &tree.ShowIndex{
+				TableName: *yyDollar[4].tableNameUnion(),
+				Where:     yyDollar[5].whereUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 267:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1901
+		{
+			yyLOCAL = &tree.ShowVariables{
+				Global: yyDollar[2].boolValUnion(),
+				Like:   yyDollar[4].comparisionExprUnion(),
+				Where:  yyDollar[5].whereUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 268:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1911
+		{
+			yyLOCAL = &tree.ShowStatus{
+				Global: yyDollar[2].boolValUnion(),
+				Like:   yyDollar[4].comparisionExprUnion(),
+				Where:  yyDollar[5].whereUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 269:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line postgresql_sql.y:1920
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 270:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL bool
+//line postgresql_sql.y:1924
+		{
+			yyLOCAL = true
+		}
+		yyVAL.union = yyLOCAL
+	case 271:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL bool
+//line postgresql_sql.y:1928
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 272:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1934
+		{
+			yyLOCAL = &tree.ShowWarnings{}
+		}
+		yyVAL.union = yyLOCAL
+	case 273:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1940
+		{
+			yyLOCAL = &tree.ShowErrors{}
+		}
+		yyVAL.union = yyLOCAL
+	case 274:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1946
+		{
+			yyLOCAL = &tree.ShowProcessList{Full: yyDollar[2].fullOptUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 275:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1952
+		{
+			yyLOCAL = &tree.ShowTables{
+				Open:   false,
+				Full:   yyDollar[2].fullOptUnion(),
+				DBName: yyDollar[4].str,
+				Like:   yyDollar[5].comparisionExprUnion(),
+				Where:  yyDollar[6].whereUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 276:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1962
+		{
+			yyLOCAL = &tree.ShowTables{
+				Open:   true,
+				Full:   yyDollar[3].fullOptUnion(),
+				DBName: yyDollar[5].str,
+				Like:   yyDollar[6].comparisionExprUnion(),
+				Where:  yyDollar[7].whereUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 277:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1974
+		{
+			yyLOCAL = &tree.ShowDatabases{Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 278:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1980
+		{
+			yyLOCAL = &tree.ShowColumns{
+				Ext:   false,
+				Full:  yyDollar[2].fullOptUnion(),
+				Table: yyDollar[4].unresolvedObjectNameUnion(),
+				// colName: $3,
+				DBName: yyDollar[5].str,
+				Like:   yyDollar[6].comparisionExprUnion(),
+				Where:  yyDollar[7].whereUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 279:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:1992
+		{
+			yyLOCAL = &tree.ShowColumns{
+				Ext:   true,
+				Full:  yyDollar[3].fullOptUnion(),
+				Table: yyDollar[5].unresolvedObjectNameUnion(),
+				// colName: $3,
+				DBName: yyDollar[6].str,
+				Like:   yyDollar[7].comparisionExprUnion(),
+				Where:  yyDollar[8].whereUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 280:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.ComparisonExpr
+//line postgresql_sql.y:2005
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 281:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.ComparisonExpr
+//line postgresql_sql.y:2009
+		{
+			yyLOCAL = tree.NewComparisonExpr(tree.LIKE, nil, yyDollar[2].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 282:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line postgresql_sql.y:2014
+		{
+			yyVAL.str = ""
+		}
+	case 283:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line postgresql_sql.y:2018
+		{
+			yyVAL.str = yyDollar[2].str
+		}
+	case 284:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.UnresolvedObjectName
+//line postgresql_sql.y:2024
+		{
+			yyLOCAL = yyDollar[2].unresolvedObjectNameUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 289:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line postgresql_sql.y:2037
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 290:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL bool
+//line postgresql_sql.y:2041
+		{
+			yyLOCAL = true
+		}
+		yyVAL.union = yyLOCAL
+	case 291:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:2047
+		{
+			yyLOCAL = &tree.ShowCreateTable{Name: yyDollar[4].unresolvedObjectNameUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 292:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:2051
+		{
+			yyLOCAL = &tree.ShowCreateDatabase{IfNotExists: yyDollar[4].ifNotExistsUnion(), Name: yyDollar[5].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 293:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.UnresolvedObjectName
+//line postgresql_sql.y:2057
+		{
+			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].str})
+		}
+		yyVAL.union = yyLOCAL
+	case 294:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.UnresolvedObjectName
+//line postgresql_sql.y:2061
+		{
+			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].str, yyDollar[1].str})
+		}
+		yyVAL.union = yyLOCAL
+	case 296:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.UnresolvedObjectName
+//line postgresql_sql.y:2070
+		{
+			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].str})
+		}
+		yyVAL.union = yyLOCAL
+	case 297:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.UnresolvedObjectName
+//line postgresql_sql.y:2074
+		{
+			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].str, yyDollar[1].str})
+		}
+		yyVAL.union = yyLOCAL
+	case 298:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.UnresolvedObjectName
+//line postgresql_sql.y:2078
+		{
+			yyLOCAL = tree.SetUnresolvedObjectName(3, [3]string{yyDollar[5].str, yyDollar[3].str, yyDollar[1].str})
+		}
+		yyVAL.union = yyLOCAL
+	case 305:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:2094
+		{
+			yyLOCAL = &tree.DropUser{
+				IfExists: yyDollar[3].boolValUnion(),
+				Users:    yyDollar[4].usersUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 306:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:2103
+		{
+			yyLOCAL = &tree.DropRole{
+				IfExists: yyDollar[3].boolValUnion(),
+				Roles:    yyDollar[4].rolesUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 307:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:2112
+		{
+			yyLOCAL = &tree.DropIndex{
+				Name:      tree.Identifier(yyDollar[4].str),
+				TableName: *yyDollar[6].tableNameUnion(),
+				IfExists:  yyDollar[3].boolValUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 308:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:2122
+		{
+			yyLOCAL = &tree.DropTable{IfExists: yyDollar[3].boolValUnion(), Names: yyDollar[4].tableNamesUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 309:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:2128
+		{
+			yyLOCAL = &tree.DropDatabase{Name: tree.Identifier(yyDollar[4].str), IfExists: yyDollar[3].boolValUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 310:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:2134
+		{
+			yyLOCAL = &tree.Delete{
+				Table:   yyDollar[3].tableExprUnion(),
+				Where:   yyDollar[4].whereUnion(),
+				OrderBy: yyDollar[5].orderByUnion(),
+				Limit:   yyDollar[6].limitUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 311:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:2149
+		{
+			ins := yyDollar[4].insertUnion()
+			ins.Table = yyDollar[2].tableExprUnion()
+			ins.PartitionNames = yyDollar[3].identifierListUnion()
+			ins.Returning = yyDollar[5].selectExprsUnion()
+			yyLOCAL = ins
+		}
+		yyVAL.union = yyLOCAL
+	case 312:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.SelectExprs
+//line postgresql_sql.y:2158
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 313:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.SelectExprs
+//line postgresql_sql.y:2162
+		{
+			yyLOCAL = yyDollar[2].selectExprsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 314:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.Insert
+//line postgresql_sql.y:2168
+		{
+			vc := tree.NewValuesClause(yyDollar[2].rowsExprsUnion())
+			yyLOCAL = &tree.Insert{
+				Rows: tree.NewSelect(vc, nil, nil),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 315:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.Insert
+//line postgresql_sql.y:2175
+		{
+			yyLOCAL = &tree.Insert{
+				Rows: tree.NewSelect(yyDollar[1].selectUnion(), nil, nil),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 316:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.Insert
+//line postgresql_sql.y:2181
+		{
+			vc := tree.NewValuesClause(yyDollar[5].rowsExprsUnion())
+			yyLOCAL = &tree.Insert{
+				Columns: yyDollar[2].identifierListUnion(),
+				Rows:    tree.NewSelect(vc, nil, nil),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 317:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.Insert
+//line postgresql_sql.y:2189
+		{
+			vc := tree.NewValuesClause(yyDollar[4].rowsExprsUnion())
+			yyLOCAL = &tree.Insert{
+				Rows: tree.NewSelect(vc, nil, nil),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 318:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.Insert
+//line postgresql_sql.y:2196
+		{
+			yyLOCAL = &tree.Insert{
+				Columns: yyDollar[2].identifierListUnion(),
+				Rows:    tree.NewSelect(yyDollar[4].selectUnion(), nil, nil),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 319:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.Insert
+//line postgresql_sql.y:2203
+		{
+			if yyDollar[2].assignmentsUnion() == nil {
+				yylex.Error("the set list of insert can not be empty")
+				return 1
+			}
+			var identList tree.IdentifierList
+			var valueList tree.Exprs
+			for _, a := range yyDollar[2].assignmentsUnion() {
+				identList = append(identList, a.Column)
+				valueList = append(valueList, a.Expr)
+			}
+			vc := tree.NewValuesClause([]tree.Exprs{valueList})
+			yyLOCAL = &tree.Insert{
+				Columns: identList,
+				Rows:    tree.NewSelect(vc, nil, nil),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 320:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL []*tree.Assignment
+//line postgresql_sql.y:2222
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 321:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*tree.Assignment
+//line postgresql_sql.y:2226
+		{
+			yyLOCAL = []*tree.Assignment{yyDollar[1].assignmentUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 322:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []*tree.Assignment
+//line postgresql_sql.y:2230
+		{
+			yyLOCAL = append(yyDollar[1].assignmentsUnion(), yyDollar[3].assignmentUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 323:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.Assignment
+//line postgresql_sql.y:2236
+		{
+			yyLOCAL = &tree.Assignment{
+				Column: tree.Identifier(yyDollar[1].str),
+				Expr:   yyDollar[3].exprUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 324:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IdentifierList
+//line postgresql_sql.y:2245
+		{
+			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
+		}
+		yyVAL.union = yyLOCAL
+	case 325:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.IdentifierList
+//line postgresql_sql.y:2249
+		{
+			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
+		}
+		yyVAL.union = yyLOCAL
+	case 326:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line postgresql_sql.y:2255
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 327:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line postgresql_sql.y:2259
+		{
+			yyVAL.str = yyDollar[3].str
+		}
+	case 328:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []tree.Exprs
+//line postgresql_sql.y:2265
+		{
+			yyLOCAL = []tree.Exprs{yyDollar[1].exprsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 329:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []tree.Exprs
+//line postgresql_sql.y:2269
+		{
+			yyLOCAL = append(yyDollar[1].rowsExprsUnion(), yyDollar[3].exprsUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 330:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line postgresql_sql.y:2275
+		{
+			yyLOCAL = yyDollar[3].exprsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 331:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line postgresql_sql.y:2280
+		{
+		}
+	case 333:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line postgresql_sql.y:2284
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 335:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line postgresql_sql.y:2291
+		{
+			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 336:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line postgresql_sql.y:2295
+		{
+			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 338:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:2302
+		{
+			yyLOCAL = &tree.DefaultVal{}
+		}
+		yyVAL.union = yyLOCAL
+	case 339:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.IdentifierList
+//line postgresql_sql.y:2307
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 340:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.IdentifierList
+//line postgresql_sql.y:2311
+		{
+			yyLOCAL = yyDollar[3].identifierListUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 341:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IdentifierList
+//line postgresql_sql.y:2317
+		{
+			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
+		}
+		yyVAL.union = yyLOCAL
+	case 342:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.IdentifierList
+//line postgresql_sql.y:2321
+		{
+			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
+		}
+		yyVAL.union = yyLOCAL
+	case 343:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.TableExpr
+//line postgresql_sql.y:2327
+		{
+			yyLOCAL = yyDollar[2].tableNameUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 344:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.TableExpr
+//line postgresql_sql.y:2331
+		{
+			yyLOCAL = yyDollar[1].tableNameUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 345:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.ExportParam
+//line postgresql_sql.y:2336
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 346:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		var yyLOCAL *tree.ExportParam
+//line postgresql_sql.y:2340
+		{
+			yyLOCAL = &tree.ExportParam{
+				Outfile:     true,
+				FilePath:    yyDollar[3].str,
+				DataFormat:  tree.DataFormat(yyDollar[4].str),
+				Fields:      yyDollar[5].fieldsUnion(),
+				Lines:       yyDollar[6].linesUnion(),
+				Header:      yyDollar[7].unsignedOptUnion(),
+				MaxFileSize: uint64(yyDollar[8].int64ValUnion()) * 1024,
+				ForceQuote:  yyDollar[9].strsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 347:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.Fields
+//line postgresql_sql.y:2354
+		{
+			yyLOCAL = &tree.Fields{
+				Terminated: ",",
+				EnclosedBy: '"',
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 348:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.Fields
+//line postgresql_sql.y:2361
+		{
+			yyLOCAL = &tree.Fields{
+				Terminated: yyDollar[4].str,
+				EnclosedBy: '"',
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 349:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL *tree.Fields
+//line postgresql_sql.y:2368
+		{
+			str := yyDollar[7].str
+			if str != "\\" && len(str) > 1 {
+				yylex.Error("export1 error field terminator")
+				return 1
+			}
+			var b byte
+			if len(str) != 0 {
+				b = byte(str[0])
+			} else {
+				b = 0
+			}
+			yyLOCAL = &tree.Fields{
+				Terminated: yyDollar[4].str,
+				EnclosedBy: b,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 350:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.Fields
+//line postgresql_sql.y:2386
+		{
+			str := yyDollar[4].str
+			if str != "\\" && len(str) > 1 {
+				yylex.Error("export2 error field terminator")
+				return 1
+			}
+			var b byte
+			if len(str) != 0 {
+				b = byte(str[0])
+			} else {
+				b = 0
+			}
+			yyLOCAL = &tree.Fields{
+				Terminated: ",",
+				EnclosedBy: b,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 351:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.Lines
+//line postgresql_sql.y:2405
+		{
+			yyLOCAL = &tree.Lines{
+				TerminatedBy: "\n",
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 352:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.Lines
+//line postgresql_sql.y:2411
+		{
+			yyLOCAL = &tree.Lines{
+				TerminatedBy: yyDollar[2].str,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 353:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line postgresql_sql.y:2418
+		{
+			yyLOCAL = true
+		}
+		yyVAL.union = yyLOCAL
+	case 354:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL bool
+//line postgresql_sql.y:2422
+		{
+			str := strings.ToLower(yyDollar[2].str)
+			if str == "true" {
+				yyLOCAL = true
+			} else if str == "false" {
+				yyLOCAL = false
+			} else {
+				yylex.Error("error header flag")
+				return 1
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 355:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL int64
+//line postgresql_sql.y:2435
+		{
+			yyLOCAL = 0
+		}
+		yyVAL.union = yyLOCAL
+	case 356:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL int64
+//line postgresql_sql.y:2439
+		{
+			yyLOCAL = yyDollar[2].item.(int64)
+		}
+		yyVAL.union = yyLOCAL
+	case 357:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL []string
+//line postgresql_sql.y:2444
+		{
+			yyLOCAL = []string{}
+		}
+		yyVAL.union = yyLOCAL
+	case 358:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL []string
+//line postgresql_sql.y:2448
+		{
+			yyLOCAL = yyDollar[3].strsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 359:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []string
+//line postgresql_sql.y:2455
+		{
+			yyLOCAL = make([]string, 0, 4)
+			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 360:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []string
+//line postgresql_sql.y:2460
+		{
+			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 362:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.Select
+//line postgresql_sql.y:2467
+		{
+			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), Ep: yyDollar[2].exportParmUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 363:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.Select
+//line postgresql_sql.y:2473
+		{
+			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 364:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.Select
+//line postgresql_sql.y:2477
+		{
+			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Ep: yyDollar[3].exportParmUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 365:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.Select
+//line postgresql_sql.y:2481
+		{
+			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 366:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.Limit
+//line postgresql_sql.y:2486
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 367:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.Limit
+//line postgresql_sql.y:2490
+		{
+			yyLOCAL = yyDollar[1].limitUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 368:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.Limit
+//line postgresql_sql.y:2496
+		{
+			yyLOCAL = &tree.Limit{Count: yyDollar[2].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 369:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.Limit
+//line postgresql_sql.y:2500
+		{
+			yyLOCAL = &tree.Limit{Offset: yyDollar[2].exprUnion(), Count: yyDollar[4].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 370:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.Limit
+//line postgresql_sql.y:2504
+		{
+			yyLOCAL = &tree.Limit{Offset: yyDollar[4].exprUnion(), Count: yyDollar[2].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 371:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.Limit
+//line postgresql_sql.y:2508
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 372:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.Limit
+//line postgresql_sql.y:2512
+		{
+			yyLOCAL = &tree.Limit{Offset: yyDollar[4].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 373:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.Limit
+//line postgresql_sql.y:2516
+		{
+			yyLOCAL = &tree.Limit{Offset: yyDollar[2].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 374:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.Limit
+//line postgresql_sql.y:2520
+		{
+			yyLOCAL = &tree.Limit{Offset: yyDollar[2].exprUnion(), Count: yyDollar[4].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 375:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.Limit
+//line postgresql_sql.y:2524
+		{
+			yyLOCAL = &tree.Limit{Offset: yyDollar[2].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 376:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.OrderBy
+//line postgresql_sql.y:2529
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 377:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.OrderBy
+//line postgresql_sql.y:2533
+		{
+			yyLOCAL = yyDollar[1].orderByUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 378:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.OrderBy
+//line postgresql_sql.y:2539
+		{
+			yyLOCAL = yyDollar[3].orderByUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 379:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.OrderBy
+//line postgresql_sql.y:2545
+		{
+			yyLOCAL = tree.OrderBy{yyDollar[1].orderUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 380:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.OrderBy
+//line postgresql_sql.y:2549
+		{
+			yyLOCAL = append(yyDollar[1].orderByUnion(), yyDollar[3].orderUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 381:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.Order
+//line postgresql_sql.y:2555
+		{
+			yyLOCAL = &tree.Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].directionUnion(), NullsPosition: yyDollar[3].nullsPositionUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 382:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.Direction
+//line postgresql_sql.y:2560
+		{
+			yyLOCAL = tree.DefaultDirection
+		}
+		yyVAL.union = yyLOCAL
+	case 383:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Direction
+//line postgresql_sql.y:2564
+		{
+			yyLOCAL = tree.Ascending
+		}
+		yyVAL.union = yyLOCAL
+	case 384:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Direction
+//line postgresql_sql.y:2568
+		{
+			yyLOCAL = tree.Descending
+		}
+		yyVAL.union = yyLOCAL
+	case 385:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.NullsPosition
+//line postgresql_sql.y:2573
+		{
+			yyLOCAL = tree.DefaultNullsPosition
+		}
+		yyVAL.union = yyLOCAL
+	case 386:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.NullsPosition
+//line postgresql_sql.y:2577
+		{
+			yyLOCAL = tree.NullsFirst
+		}
+		yyVAL.union = yyLOCAL
+	case 387:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.NullsPosition
+//line postgresql_sql.y:2581
+		{
+			yyLOCAL = tree.NullsLast
+		}
+		yyVAL.union = yyLOCAL
+	case 388:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.SelectStatement
+//line postgresql_sql.y:2588
+		{
+			yyLOCAL = &tree.ParenSelect{Select: yyDollar[2].selectUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 389:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.SelectStatement
+//line postgresql_sql.y:2592
+		{
+			yyLOCAL = &tree.ParenSelect{Select: &tree.Select{Select: yyDollar[2].selectStatementUnion()}}
+		}
+		yyVAL.union = yyLOCAL
+	case 390:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.SelectStatement
+//line postgresql_sql.y:2598
+		{
+			yyLOCAL = yyDollar[1].selectStatementUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 391:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.SelectStatement
+//line postgresql_sql.y:2602
+		{
+			yyLOCAL = &tree.UnionClause{
+				Type:     yyDollar[2].unionTypeRecordUnion().Type,
+				Left:     yyDollar[1].selectStatementUnion(),
+				Right:    yyDollar[3].selectStatementUnion(),
+				All:      yyDollar[2].unionTypeRecordUnion().All,
+				Distinct: yyDollar[2].unionTypeRecordUnion().Distinct,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 392:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.SelectStatement
+//line postgresql_sql.y:2612
+		{
+			yyLOCAL = &tree.UnionClause{
+				Type:     yyDollar[2].unionTypeRecordUnion().Type,
+				Left:     yyDollar[1].selectStatementUnion(),
+				Right:    yyDollar[3].selectStatementUnion(),
+				All:      yyDollar[2].unionTypeRecordUnion().All,
+				Distinct: yyDollar[2].unionTypeRecordUnion().Distinct,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 393:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.SelectStatement
+//line postgresql_sql.y:2622
+		{
+			yyLOCAL = &tree.UnionClause{
+				Type:     yyDollar[2].unionTypeRecordUnion().Type,
+				Left:     yyDollar[1].selectStatementUnion(),
+				Right:    yyDollar[3].selectStatementUnion(),
+				All:      yyDollar[2].unionTypeRecordUnion().All,
+				Distinct: yyDollar[2].unionTypeRecordUnion().Distinct,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 394:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.SelectStatement
+//line postgresql_sql.y:2632
+		{
+			yyLOCAL = &tree.UnionClause{
+				Type:     yyDollar[2].unionTypeRecordUnion().Type,
+				Left:     yyDollar[1].selectStatementUnion(),
+				Right:    yyDollar[3].selectStatementUnion(),
+				All:      yyDollar[2].unionTypeRecordUnion().All,
+				Distinct: yyDollar[2].unionTypeRecordUnion().Distinct,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 395:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line postgresql_sql.y:2644
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.UNION,
+				All:      false,
+				Distinct: false,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 396:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line postgresql_sql.y:2652
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.UNION,
+				All:      true,
+				Distinct: false,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 397:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line postgresql_sql.y:2660
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.UNION,
+				All:      false,
+				Distinct: true,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 398:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line postgresql_sql.y:2668
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.INTERSECT,
+				All:      false,
+				Distinct: false,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 399:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line postgresql_sql.y:2676
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.INTERSECT,
+				All:      true,
+				Distinct: false,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 400:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line postgresql_sql.y:2684
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.INTERSECT,
+				All:      false,
+				Distinct: true,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 401:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line postgresql_sql.y:2692
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.EXCEPT,
+				All:      false,
+				Distinct: false,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 402:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line postgresql_sql.y:2700
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.EXCEPT,
+				All:      true,
+				Distinct: false,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 403:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line postgresql_sql.y:2708
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.EXCEPT,
+				All:      false,
+				Distinct: true,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 404:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.SelectStatement
+//line postgresql_sql.y:2718
+		{
+			yyLOCAL = &tree.SelectClause{
+				Distinct: yyDollar[2].boolValUnion(),
+				Exprs:    yyDollar[3].selectExprsUnion(),
+				From:     yyDollar[4].fromUnion(),
+				Where:    yyDollar[5].whereUnion(),
+				GroupBy:  yyDollar[6].groupByUnion(),
+				Having:   yyDollar[7].whereUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 405:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line postgresql_sql.y:2730
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 406:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL bool
+//line postgresql_sql.y:2734
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 407:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL bool
+//line postgresql_sql.y:2738
+		{
+			yyLOCAL = true
+		}
+		yyVAL.union = yyLOCAL
+	case 410:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.Where
+//line postgresql_sql.y:2747
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 411:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.Where
+//line postgresql_sql.y:2751
+		{
+			yyLOCAL = &tree.Where{Type: tree.AstHaving, Expr: yyDollar[2].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 412:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.GroupBy
+//line postgresql_sql.y:2756
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 413:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.GroupBy
+//line postgresql_sql.y:2760
+		{
+			yyLOCAL = tree.GroupBy(yyDollar[3].exprsUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 414:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.Where
+//line postgresql_sql.y:2765
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 415:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.Where
+//line postgresql_sql.y:2769
+		{
+			yyLOCAL = &tree.Where{Type: tree.AstWhere, Expr: yyDollar[2].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 416:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.SelectExprs
+//line postgresql_sql.y:2775
+		{
+			yyLOCAL = tree.SelectExprs{yyDollar[1].selectExprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 417:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.SelectExprs
+//line postgresql_sql.y:2779
+		{
+			yyLOCAL = append(yyDollar[1].selectExprsUnion(), yyDollar[3].selectExprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 418:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.SelectExpr
+//line postgresql_sql.y:2785
+		{
+			yyLOCAL = tree.SelectExpr{Expr: tree.StarExpr()}
+		}
+		yyVAL.union = yyLOCAL
+	case 419:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.SelectExpr
+//line postgresql_sql.y:2789
+		{
+			yyLOCAL = tree.SelectExpr{Expr: yyDollar[1].exprUnion(), As: tree.UnrestrictedIdentifier(yyDollar[2].str)}
+		}
+		yyVAL.union = yyLOCAL
+	case 420:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.SelectExpr
+//line postgresql_sql.y:2793
+		{
+			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[1].str)}
+		}
+		yyVAL.union = yyLOCAL
+	case 421:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.SelectExpr
+//line postgresql_sql.y:2797
+		{
+			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[3].str, yyDollar[1].str)}
+		}
+		yyVAL.union = yyLOCAL
+	case 422:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.From
+//line postgresql_sql.y:2803
+		{
+			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
+			tn := tree.NewTableName(tree.Identifier("dual"), prefix)
+			yyLOCAL = &tree.From{
+				Tables: tree.TableExprs{&tree.AliasedTableExpr{Expr: tn}},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 423:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.From
+//line postgresql_sql.y:2811
+		{
+			yyLOCAL = yyDollar[1].fromUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 424:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.From
+//line postgresql_sql.y:2817
+		{
+			yyLOCAL = &tree.From{
+				Tables: yyDollar[2].tableExprsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 425:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.TableExprs
+//line postgresql_sql.y:2825
+		{
+			yyLOCAL = tree.TableExprs{yyDollar[1].tableExprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 426:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.TableExprs
+//line postgresql_sql.y:2829
+		{
+			yyLOCAL = append(yyDollar[1].tableExprsUnion(), yyDollar[3].tableExprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 429:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.TableExpr
+//line postgresql_sql.y:2839
+		{
+			yyLOCAL = &tree.JoinTableExpr{
+				Left:     yyDollar[1].tableExprUnion(),
+				JoinType: yyDollar[2].str,
+				Right:    yyDollar[3].tableExprUnion(),
+				Cond:     yyDollar[4].joinCondUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 430:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.TableExpr
+//line postgresql_sql.y:2848
+		{
+			yyLOCAL = &tree.JoinTableExpr{
+				Left:     yyDollar[1].tableExprUnion(),
+				JoinType: yyDollar[2].str,
+				Right:    yyDollar[3].tableExprUnion(),
+				Cond:     yyDollar[4].joinCondUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 431:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.TableExpr
+//line postgresql_sql.y:2858
+		{
+			yyLOCAL = &tree.JoinTableExpr{
+				Left:     yyDollar[1].tableExprUnion(),
+				JoinType: yyDollar[2].str,
+				Right:    yyDollar[3].tableExprUnion(),
+				Cond:     yyDollar[4].joinCondUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 432:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.TableExpr
+//line postgresql_sql.y:2867
+		{
+			yyLOCAL = &tree.JoinTableExpr{
+				Left:     yyDollar[1].tableExprUnion(),
+				JoinType: yyDollar[2].str,
+				Right:    yyDollar[3].tableExprUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 433:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line postgresql_sql.y:2877
+		{
+			yyVAL.str = tree.JOIN_TYPE_NATURAL
+		}
+	case 434:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line postgresql_sql.y:2881
+		{
+			switch yyDollar[2].str {
+			case tree.JOIN_TYPE_LEFT:
+				yyVAL.str = tree.JOIN_TYPE_NATURAL_LEFT
+			case tree.JOIN_TYPE_RIGHT:
+				yyVAL.str = tree.JOIN_TYPE_NATURAL_RIGHT
+			default:
+				yyVAL.str = tree.JOIN_TYPE_NATURAL_FULL
+			}
+		}
+	case 435:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line postgresql_sql.y:2894
+		{
+			yyVAL.str = tree.JOIN_TYPE_LEFT
+		}
+	case 436:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line postgresql_sql.y:2898
+		{
+			yyVAL.str = tree.JOIN_TYPE_LEFT
+		}
+	case 437:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line postgresql_sql.y:2902
+		{
+			yyVAL.str = tree.JOIN_TYPE_RIGHT
+		}
+	case 438:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line postgresql_sql.y:2906
+		{
+			yyVAL.str = tree.JOIN_TYPE_RIGHT
+		}
+	case 439:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line postgresql_sql.y:2910
+		{
+			yyVAL.str = tree.JOIN_TYPE_FULL
+		}
+	case 440:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line postgresql_sql.y:2914
+		{
+			yyVAL.str = tree.JOIN_TYPE_FULL
+		}
+	case 441:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.JoinCond
+//line postgresql_sql.y:2920
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 442:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.JoinCond
+//line postgresql_sql.y:2924
+		{
+			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 443:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line postgresql_sql.y:2930
+		{
+			yyVAL.str = tree.JOIN_TYPE_STRAIGHT
+		}
+	case 444:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line postgresql_sql.y:2936
+		{
+			yyVAL.str = tree.JOIN_TYPE_INNER
+		}
+	case 445:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line postgresql_sql.y:2940
+		{
+			yyVAL.str = tree.JOIN_TYPE_INNER
+		}
+	case 446:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line postgresql_sql.y:2944
+		{
+			yyVAL.str = tree.JOIN_TYPE_CROSS
+		}
+	case 447:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.JoinCond
+//line postgresql_sql.y:2950
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 448:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.JoinCond
+//line postgresql_sql.y:2954
+		{
+			yyLOCAL = yyDollar[1].joinCondUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 449:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.JoinCond
+//line postgresql_sql.y:2960
+		{
+			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 450:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.JoinCond
+//line postgresql_sql.y:2964
+		{
+			yyLOCAL = &tree.UsingJoinCond{Cols: yyDollar[3].identifierListUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 451:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.IdentifierList
+//line postgresql_sql.y:2969
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 452:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.IdentifierList
+//line postgresql_sql.y:2973
+		{
+			yyLOCAL = yyDollar[2].identifierListUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 453:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IdentifierList
+//line postgresql_sql.y:2979
+		{
+			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
+		}
+		yyVAL.union = yyLOCAL
+	case 454:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.IdentifierList
+//line postgresql_sql.y:2983
+		{
+			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
+		}
+		yyVAL.union = yyLOCAL
+	case 455:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.TableExpr
+//line postgresql_sql.y:2989
+		{
+			yyLOCAL = yyDollar[1].aliasedTableExprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 456:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.TableExpr
+//line postgresql_sql.y:2993
+		{
+			yyLOCAL = &tree.AliasedTableExpr{
+				Expr: yyDollar[1].parenTableExprUnion(),
+				As: tree.AliasClause{
+					Alias: tree.Identifier(yyDollar[3].str),
+					Cols:  yyDollar[4].identifierListUnion(),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 457:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.ParenTableExpr
+//line postgresql_sql.y:3006
+		{
+			yyLOCAL = &tree.ParenTableExpr{Expr: yyDollar[2].selectUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 458:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line postgresql_sql.y:3011
+		{
+		}
+	case 459:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line postgresql_sql.y:3012
+		{
+		}
+	case 460:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.AliasedTableExpr
+//line postgresql_sql.y:3016
+		{
+			yyLOCAL = &tree.AliasedTableExpr{
+				Expr: yyDollar[1].tableNameUnion(),
+				As: tree.AliasClause{
+					Alias: tree.Identifier(yyDollar[2].str),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 461:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line postgresql_sql.y:3028
+		{
+			yyVAL.str = ""
+		}
+	case 462:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line postgresql_sql.y:3032
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 463:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line postgresql_sql.y:3036
+		{
+			yyVAL.str = yyDollar[2].str
+		}
+	case 466:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line postgresql_sql.y:3045
+		{
+			yyVAL.str = ""
+		}
+	case 467:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line postgresql_sql.y:3049
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 468:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line postgresql_sql.y:3053
+		{
+			yyVAL.str = yyDollar[2].str
+		}
+	case 483:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:3083
+		{
+			yyLOCAL = &tree.CreateView{
+				Name:        yyDollar[4].tableNameUnion(),
+				ColNames:    yyDollar[5].identifierListUnion(),
+				AsSource:    yyDollar[7].selectUnion(),
+				IfNotExists: yyDollar[3].ifNotExistsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 484:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:3094
+		{
+			yyLOCAL = &tree.CreateUser{
+				IfNotExists: yyDollar[3].ifNotExistsUnion(),
+				Users:       yyDollar[4].usersUnion(),
+				TlsOpts:     yyDollar[5].tlsOptionsUnion(),
+				ResOpts:     yyDollar[6].resourceOptionsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 485:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL []tree.ResourceOption
+//line postgresql_sql.y:3104
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 486:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL []tree.ResourceOption
+//line postgresql_sql.y:3108
+		{
+			yyLOCAL = yyDollar[2].resourceOptionsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 487:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []tree.ResourceOption
+//line postgresql_sql.y:3114
+		{
+			yyLOCAL = []tree.ResourceOption{yyDollar[1].resourceOptionUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 488:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL []tree.ResourceOption
+//line postgresql_sql.y:3118
+		{
+			yyLOCAL = append(yyDollar[1].resourceOptionsUnion(), yyDollar[2].resourceOptionUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 489:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.ResourceOption
+//line postgresql_sql.y:3124
+		{
+			yyLOCAL = &tree.ResourceOptionMaxQueriesPerHour{Count: yyDollar[2].item.(int64)}
+		}
+		yyVAL.union = yyLOCAL
+	case 490:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.ResourceOption
+//line postgresql_sql.y:3128
+		{
+			yyLOCAL = &tree.ResourceOptionMaxUpdatesPerHour{Count: yyDollar[2].item.(int64)}
+		}
+		yyVAL.union = yyLOCAL
+	case 491:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.ResourceOption
+//line postgresql_sql.y:3132
+		{
+			yyLOCAL = &tree.ResourceOptionMaxConnectionPerHour{Count: yyDollar[2].item.(int64)}
+		}
+		yyVAL.union = yyLOCAL
+	case 492:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.ResourceOption
+//line postgresql_sql.y:3136
+		{
+			yyLOCAL = &tree.ResourceOptionMaxUserConnections{Count: yyDollar[2].item.(int64)}
+		}
+		yyVAL.union = yyLOCAL
+	case 493:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL []tree.TlsOption
+//line postgresql_sql.y:3142
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 495:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL []tree.TlsOption
+//line postgresql_sql.y:3149
+		{
+			t := &tree.TlsOptionNone{}
+			yyLOCAL = []tree.TlsOption{t}
+		}
+		yyVAL.union = yyLOCAL
+	case 496:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL []tree.TlsOption
+//line postgresql_sql.y:3154
+		{
+			t := &tree.TlsOptionSSL{}
+			yyLOCAL = []tree.TlsOption{t}
+		}
+		yyVAL.union = yyLOCAL
+	case 497:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL []tree.TlsOption
+//line postgresql_sql.y:3159
+		{
+			t := &tree.TlsOptionX509{}
+			yyLOCAL = []tree.TlsOption{t}
+		}
+		yyVAL.union = yyLOCAL
+	case 498:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL []tree.TlsOption
+//line postgresql_sql.y:3164
+		{
+			yyLOCAL = yyDollar[2].tlsOptionsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 499:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []tree.TlsOption
+//line postgresql_sql.y:3170
+		{
+			yyLOCAL = []tree.TlsOption{yyDollar[1].tlsOptionUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 500:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []tree.TlsOption
+//line postgresql_sql.y:3174
+		{
+			yyLOCAL = append(yyDollar[1].tlsOptionsUnion(), yyDollar[3].tlsOptionUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 501:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL []tree.TlsOption
+//line postgresql_sql.y:3178
+		{
+			yyLOCAL = append(yyDollar[1].tlsOptionsUnion(), yyDollar[2].tlsOptionUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 502:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.TlsOption
+//line postgresql_sql.y:3184
+		{
+			yyLOCAL = &tree.TlsOptionIssuer{Issuer: yyDollar[2].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 503:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.TlsOption
+//line postgresql_sql.y:3188
+		{
+			yyLOCAL = &tree.TlsOptionSubject{Subject: yyDollar[2].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 504:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.TlsOption
+//line postgresql_sql.y:3192
+		{
+			yyLOCAL = &tree.TlsOptionCipher{Cipher: yyDollar[2].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 505:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.TlsOption
+//line postgresql_sql.y:3196
+		{
+			yyLOCAL = &tree.TlsOptionSan{San: yyDollar[2].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 506:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*tree.User
+//line postgresql_sql.y:3202
+		{
+			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 507:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []*tree.User
+//line postgresql_sql.y:3206
+		{
+			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 508:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.User
+//line postgresql_sql.y:3212
+		{
+			yyLOCAL = &tree.User{
+				Username:   yyDollar[1].usernameRecordUnion().Username,
+				Hostname:   yyDollar[1].usernameRecordUnion().Hostname,
+				AuthPlugin: yyDollar[2].authRecordUnion().AuthPlugin,
+				AuthString: yyDollar[2].authRecordUnion().AuthString,
+				HashString: yyDollar[2].authRecordUnion().HashString,
+				ByAuth:     yyDollar[2].authRecordUnion().ByAuth,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 509:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.UsernameRecord
+//line postgresql_sql.y:3225
+		{
+			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: "%"}
+		}
+		yyVAL.union = yyLOCAL
+	case 510:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.UsernameRecord
+//line postgresql_sql.y:3229
+		{
+			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[3].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 511:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.UsernameRecord
+//line postgresql_sql.y:3233
+		{
+			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[2].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 512:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.AuthRecord
+//line postgresql_sql.y:3238
+		{
+			yyLOCAL = &tree.AuthRecord{}
+		}
+		yyVAL.union = yyLOCAL
+	case 513:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.AuthRecord
+//line postgresql_sql.y:3242
+		{
+			yyLOCAL = &tree.AuthRecord{
+				AuthString: yyDollar[3].str,
+				ByAuth:     true,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 514:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.AuthRecord
+//line postgresql_sql.y:3249
+		{
+			yyLOCAL = &tree.AuthRecord{
+				AuthPlugin: yyDollar[3].str,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 515:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.AuthRecord
+//line postgresql_sql.y:3255
+		{
+			yyLOCAL = &tree.AuthRecord{
+				AuthPlugin: yyDollar[3].str,
+				AuthString: yyDollar[5].str,
+				ByAuth:     true,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 516:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.AuthRecord
+//line postgresql_sql.y:3263
+		{
+			yyLOCAL = &tree.AuthRecord{
+				AuthPlugin: yyDollar[3].str,
+				HashString: yyDollar[5].str,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 517:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.AuthRecord
+//line postgresql_sql.y:3270
+		{
+			yyLOCAL = &tree.AuthRecord{
+				HashString: yyDollar[4].str,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 520:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:3282
+		{
+			yyLOCAL = &tree.CreateRole{
+				IfNotExists: yyDollar[3].ifNotExistsUnion(),
+				Roles:       yyDollar[4].rolesUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 521:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*tree.Role
+//line postgresql_sql.y:3291
+		{
+			yyLOCAL = []*tree.Role{yyDollar[1].roleUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 522:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []*tree.Role
+//line postgresql_sql.y:3295
+		{
+			yyLOCAL = append(yyDollar[1].rolesUnion(), yyDollar[3].roleUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 523:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.Role
+//line postgresql_sql.y:3301
+		{
+			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: "%"}
+		}
+		yyVAL.union = yyLOCAL
+	case 524:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.Role
+//line postgresql_sql.y:3305
+		{
+			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: yyDollar[3].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 525:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.Role
+//line postgresql_sql.y:3309
+		{
+			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: yyDollar[2].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 528:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.IndexCategory
+//line postgresql_sql.y:3318
+		{
+			yyLOCAL = tree.INDEX_CATEGORY_NONE
+		}
+		yyVAL.union = yyLOCAL
+	case 529:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IndexCategory
+//line postgresql_sql.y:3322
+		{
+			yyLOCAL = tree.INDEX_CATEGORY_FULLTEXT
+		}
+		yyVAL.union = yyLOCAL
+	case 530:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IndexCategory
+//line postgresql_sql.y:3326
+		{
+			yyLOCAL = tree.INDEX_CATEGORY_SPATIAL
+		}
+		yyVAL.union = yyLOCAL
+	case 531:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IndexCategory
+//line postgresql_sql.y:3330
+		{
+			yyLOCAL = tree.INDEX_CATEGORY_UNIQUE
+		}
+		yyVAL.union = yyLOCAL
+	case 532:
+		yyDollar = yyS[yypt-11 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:3336
+		{
+			var io *tree.IndexOption = nil
+			if yyDollar[11].indexOptionUnion() == nil && yyDollar[5].indexTypeUnion() != tree.INDEX_TYPE_INVALID {
+				io = &tree.IndexOption{IType: yyDollar[5].indexTypeUnion()}
+			} else if yyDollar[11].indexOptionUnion() != nil {
+				io = yyDollar[11].indexOptionUnion()
+				io.IType = yyDollar[5].indexTypeUnion()
+			}
+			yyLOCAL = &tree.CreateIndex{
+				Name:        tree.Identifier(yyDollar[4].str),
+				Table:       *yyDollar[7].tableNameUnion(),
+				IndexCat:    yyDollar[2].indexCategoryUnion(),
+				KeyParts:    yyDollar[9].keyPartsUnion(),
+				IndexOption: io,
+				MiscOption:  nil,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 533:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.IndexOption
+//line postgresql_sql.y:3355
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 534:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.IndexOption
+//line postgresql_sql.y:3359
+		{
+			// Merge the options
+			if yyDollar[1].indexOptionUnion() == nil {
+				yyLOCAL = yyDollar[2].indexOptionUnion()
+			} else {
+				opt1 := yyDollar[1].indexOptionUnion()
+				opt2 := yyDollar[2].indexOptionUnion()
+				if len(opt2.Comment) > 0 {
+					opt1.Comment = opt2.Comment
+				} else if opt2.KeyBlockSize > 0 {
+					opt1.KeyBlockSize = opt2.KeyBlockSize
+				} else if len(opt2.ParserName) > 0 {
+					opt1.ParserName = opt2.ParserName
+				} else if opt2.Visible != tree.VISIBLE_TYPE_INVALID {
+					opt1.Visible = opt2.Visible
+				}
+				yyLOCAL = opt1
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 535:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.IndexOption
+//line postgresql_sql.y:3381
+		{
+			yyLOCAL = &tree.IndexOption{KeyBlockSize: uint64(yyDollar[3].item.(int64))}
+		}
+		yyVAL.union = yyLOCAL
+	case 536:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.IndexOption
+//line postgresql_sql.y:3385
+		{
+			yyLOCAL = &tree.IndexOption{Comment: yyDollar[2].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 537:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.IndexOption
+//line postgresql_sql.y:3389
+		{
+			yyLOCAL = &tree.IndexOption{ParserName: yyDollar[3].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 538:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.IndexOption
+//line postgresql_sql.y:3393
+		{
+			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_VISIBLE}
+		}
+		yyVAL.union = yyLOCAL
+	case 539:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.IndexOption
+//line postgresql_sql.y:3397
+		{
+			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_INVISIBLE}
+		}
+		yyVAL.union = yyLOCAL
+	case 540:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*tree.KeyPart
+//line postgresql_sql.y:3403
+		{
+			yyLOCAL = []*tree.KeyPart{yyDollar[1].keyPartUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 541:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []*tree.KeyPart
+//line postgresql_sql.y:3407
+		{
+			yyLOCAL = append(yyDollar[1].keyPartsUnion(), yyDollar[3].keyPartUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 542:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.KeyPart
+//line postgresql_sql.y:3413
+		{
+			// Order is parsed but just ignored as MySQL did.
+			yyLOCAL = &tree.KeyPart{ColName: yyDollar[1].unresolvedNameUnion(), Length: int(yyDollar[2].lengthOptUnion()), Direction: yyDollar[3].directionUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 543:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.KeyPart
+//line postgresql_sql.y:3418
+		{
+			yyLOCAL = &tree.KeyPart{Expr: yyDollar[2].exprUnion(), Direction: yyDollar[4].directionUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 544:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.IndexType
+//line postgresql_sql.y:3423
+		{
+			yyLOCAL = tree.INDEX_TYPE_INVALID
+		}
+		yyVAL.union = yyLOCAL
+	case 545:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.IndexType
+//line postgresql_sql.y:3427
+		{
+			yyLOCAL = tree.INDEX_TYPE_BTREE
+		}
+		yyVAL.union = yyLOCAL
+	case 546:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.IndexType
+//line postgresql_sql.y:3431
+		{
+			yyLOCAL = tree.INDEX_TYPE_HASH
+		}
+		yyVAL.union = yyLOCAL
+	case 547:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.IndexType
+//line postgresql_sql.y:3435
+		{
+			yyLOCAL = tree.INDEX_TYPE_RTREE
+		}
+		yyVAL.union = yyLOCAL
+	case 548:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.IndexType
+//line postgresql_sql.y:3439
+		{
+			yyLOCAL = tree.INDEX_TYPE_BSI
+		}
+		yyVAL.union = yyLOCAL
+	case 549:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:3445
+		{
+			yyLOCAL = &tree.CreateDatabase{
+				IfNotExists:   yyDollar[3].ifNotExistsUnion(),
+				Name:          tree.Identifier(yyDollar[4].str),
+				CreateOptions: yyDollar[5].createOptionsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 552:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line postgresql_sql.y:3459
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 553:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL bool
+//line postgresql_sql.y:3463
+		{
+			yyLOCAL = true
+		}
+		yyVAL.union = yyLOCAL
+	case 554:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL []tree.CreateOption
+//line postgresql_sql.y:3468
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 555:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []tree.CreateOption
+//line postgresql_sql.y:3472
+		{
+			yyLOCAL = yyDollar[1].createOptionsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 556:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []tree.CreateOption
+//line postgresql_sql.y:3478
+		{
+			yyLOCAL = []tree.CreateOption{yyDollar[1].createOptionUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 557:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL []tree.CreateOption
+//line postgresql_sql.y:3482
+		{
+			yyLOCAL = append(yyDollar[1].createOptionsUnion(), yyDollar[2].createOptionUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 558:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.CreateOption
+//line postgresql_sql.y:3488
+		{
+			yyLOCAL = &tree.CreateOptionCharset{IsDefault: yyDollar[1].defaultOptionalUnion(), Charset: yyDollar[4].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 559:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.CreateOption
+//line postgresql_sql.y:3492
+		{
+			yyLOCAL = &tree.CreateOptionCollate{IsDefault: yyDollar[1].defaultOptionalUnion(), Collate: yyDollar[4].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 560:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.CreateOption
+//line postgresql_sql.y:3496
+		{
+			yyLOCAL = &tree.CreateOptionEncryption{Encrypt: yyDollar[4].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 561:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line postgresql_sql.y:3501
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 562:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL bool
+//line postgresql_sql.y:3505
+		{
+			yyLOCAL = true
+		}
+		yyVAL.union = yyLOCAL
+	case 563:
+		yyDollar = yyS[yypt-10 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:3511
+		{
+			yyLOCAL = &tree.CreateTable{
+				Temporary:       yyDollar[2].boolValUnion(),
+				IfNotExists:     yyDollar[4].ifNotExistsUnion(),
+				Table:           *yyDollar[5].tableNameUnion(),
+				Defs:            yyDollar[7].tableDefsUnion(),
+				Options:         yyDollar[9].tableOptionsUnion(),
+				PartitionOption: yyDollar[10].partitionOptionUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 564:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line postgresql_sql.y:3523
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 565:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL bool
+//line postgresql_sql.y:3527
+		{
+			yyLOCAL = true
+		}
+		yyVAL.union = yyLOCAL
+	case 566:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.PartitionOption
+//line postgresql_sql.y:3532
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 567:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.PartitionOption
+//line postgresql_sql.y:3536
+		{
+			yyDollar[3].partitionByUnion().Num = uint64(yyDollar[4].int64ValUnion())
+			yyLOCAL = &tree.PartitionOption{
+				PartBy:     *yyDollar[3].partitionByUnion(),
+				SubPartBy:  yyDollar[5].partitionByUnion(),
+				Partitions: yyDollar[6].partitionsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 568:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.PartitionBy
+//line postgresql_sql.y:3546
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 569:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.PartitionBy
+//line postgresql_sql.y:3550
+		{
+			yyLOCAL = &tree.PartitionBy{
+				IsSubPartition: true,
+				PType:          yyDollar[3].partitionByUnion(),
+				Num:            uint64(yyDollar[4].int64ValUnion()),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 570:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL []*tree.Partition
+//line postgresql_sql.y:3559
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 571:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []*tree.Partition
+//line postgresql_sql.y:3563
+		{
+			yyLOCAL = yyDollar[2].partitionsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 572:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*tree.Partition
+//line postgresql_sql.y:3569
+		{
+			yyLOCAL = []*tree.Partition{yyDollar[1].partitionUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 573:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []*tree.Partition
+//line postgresql_sql.y:3573
+		{
+			yyLOCAL = append(yyDollar[1].partitionsUnion(), yyDollar[3].partitionUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 574:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.Partition
+//line postgresql_sql.y:3579
+		{
+			yyLOCAL = &tree.Partition{
+				Name:    tree.Identifier(yyDollar[2].str),
+				Values:  yyDollar[3].valuesUnion(),
+				Options: nil,
+				Subs:    yyDollar[4].subPartitionsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 575:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.Partition
+//line postgresql_sql.y:3588
+		{
+			yyLOCAL = &tree.Partition{
+				Name:    tree.Identifier(yyDollar[2].str),
+				Values:  yyDollar[3].valuesUnion(),
+				Options: yyDollar[4].tableOptionsUnion(),
+				Subs:    yyDollar[5].subPartitionsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 576:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL []*tree.SubPartition
+//line postgresql_sql.y:3598
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 577:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []*tree.SubPartition
+//line postgresql_sql.y:3602
+		{
+			yyLOCAL = yyDollar[2].subPartitionsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 578:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*tree.SubPartition
+//line postgresql_sql.y:3608
+		{
+			yyLOCAL = []*tree.SubPartition{yyDollar[1].subPartitionUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 579:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []*tree.SubPartition
+//line postgresql_sql.y:3612
+		{
+			yyLOCAL = append(yyDollar[1].subPartitionsUnion(), yyDollar[3].subPartitionUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 580:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.SubPartition
+//line postgresql_sql.y:3618
+		{
+			yyLOCAL = &tree.SubPartition{
+				Name:    tree.Identifier(yyDollar[2].str),
+				Options: nil,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 581:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.SubPartition
+//line postgresql_sql.y:3625
+		{
+			yyLOCAL = &tree.SubPartition{
+				Name:    tree.Identifier(yyDollar[2].str),
+				Options: yyDollar[3].tableOptionsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 582:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []tree.TableOption
+//line postgresql_sql.y:3634
+		{
+			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 583:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL []tree.TableOption
+//line postgresql_sql.y:3638
+		{
+			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 584:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.Values
+//line postgresql_sql.y:3643
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 585:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Values
+//line postgresql_sql.y:3647
+		{
+			yyLOCAL = &tree.ValuesLessThan{ValueList: yyDollar[5].exprsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 586:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL int64
+//line postgresql_sql.y:3652
+		{
+			yyLOCAL = 0
+		}
+		yyVAL.union = yyLOCAL
+	case 587:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL int64
+//line postgresql_sql.y:3656
+		{
+			res := yyDollar[2].item.(int64)
+			if res == 0 {
+				yylex.Error("partitions can not be 0")
+				return 1
+			}
+			yyLOCAL = res
+		}
+		yyVAL.union = yyLOCAL
+	case 588:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL int64
+//line postgresql_sql.y:3666
+		{
+			yyLOCAL = 0
+		}
+		yyVAL.union = yyLOCAL
+	case 589:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL int64
+//line postgresql_sql.y:3670
+		{
+			res := yyDollar[2].item.(int64)
+			if res == 0 {
+				yylex.Error("partitions can not be 0")
+				return 1
+			}
+			yyLOCAL = res
+		}
+		yyVAL.union = yyLOCAL
+	case 590:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.PartitionBy
+//line postgresql_sql.y:3681
+		{
+			yyLOCAL = &tree.PartitionBy{
+				PType: &tree.RangeType{
+					Expr: yyDollar[3].exprUnion(),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 591:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.PartitionBy
+//line postgresql_sql.y:3689
+		{
+			yyLOCAL = &tree.PartitionBy{
+				PType: &tree.RangeType{
+					ColumnList: yyDollar[4].unresolveNamesUnion(),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 592:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.PartitionBy
+//line postgresql_sql.y:3697
+		{
+			yyLOCAL = &tree.PartitionBy{
+				PType: &tree.ListType{
+					Expr: yyDollar[3].exprUnion(),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 593:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.PartitionBy
+//line postgresql_sql.y:3705
+		{
+			yyLOCAL = &tree.PartitionBy{
+				PType: &tree.ListType{
+					ColumnList: yyDollar[4].unresolveNamesUnion(),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 595:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.PartitionBy
+//line postgresql_sql.y:3716
+		{
+			yyLOCAL = &tree.PartitionBy{
+				PType: &tree.KeyType{
+					Linear:     yyDollar[1].boolValUnion(),
+					ColumnList: yyDollar[5].unresolveNamesUnion(),
+					Algorithm:  yyDollar[3].int64ValUnion(),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 596:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.PartitionBy
+//line postgresql_sql.y:3726
+		{
+			yyLOCAL = &tree.PartitionBy{
+				PType: &tree.HashType{
+					Linear: yyDollar[1].boolValUnion(),
+					Expr:   yyDollar[4].exprUnion(),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 597:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL int64
+//line postgresql_sql.y:3736
+		{
+			yyLOCAL = 0
+		}
+		yyVAL.union = yyLOCAL
+	case 598:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL int64
+//line postgresql_sql.y:3740
+		{
+			yyLOCAL = yyDollar[3].item.(int64)
+		}
+		yyVAL.union = yyLOCAL
+	case 599:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line postgresql_sql.y:3745
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 600:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL bool
+//line postgresql_sql.y:3749
+		{
+			yyLOCAL = true
+		}
+		yyVAL.union = yyLOCAL
+	case 601:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL []tree.TableOption
+//line postgresql_sql.y:3754
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 602:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []tree.TableOption
+//line postgresql_sql.y:3758
+		{
+			yyLOCAL = yyDollar[1].tableOptionsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 603:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []tree.TableOption
+//line postgresql_sql.y:3764
+		{
+			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 604:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []tree.TableOption
+//line postgresql_sql.y:3768
+		{
+			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[3].tableOptionUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 605:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL []tree.TableOption
+//line postgresql_sql.y:3772
+		{
+			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 606:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.TableOption
+//line postgresql_sql.y:3778
+		{
+			yyLOCAL = tree.NewTableOptionAutoIncrement(uint64(yyDollar[3].item.(int64)))
+		}
+		yyVAL.union = yyLOCAL
+	case 607:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.TableOption
+//line postgresql_sql.y:3782
+		{
+			yyLOCAL = tree.NewTableOptionAvgRowLength(uint64(yyDollar[3].item.(int64)))
+		}
+		yyVAL.union = yyLOCAL
+	case 608:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.TableOption
+//line postgresql_sql.y:3786
+		{
+			yyLOCAL = tree.NewTableOptionCharset(yyDollar[4].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 609:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.TableOption
+//line postgresql_sql.y:3790
+		{
+			yyLOCAL = tree.NewTableOptionCollate(yyDollar[4].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 610:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.TableOption
+//line postgresql_sql.y:3794
+		{
+			yyLOCAL = tree.NewTableOptionChecksum(uint64(yyDollar[3].item.(int64)))
+		}
+		yyVAL.union = yyLOCAL
+	case 611:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.TableOption
+//line postgresql_sql.y:3798
+		{
+			yyLOCAL = tree.NewTableOptionComment(yyDollar[3].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 612:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.TableOption
+//line postgresql_sql.y:3802
+		{
+			yyLOCAL = tree.NewTableOptionCompression(yyDollar[3].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 613:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.TableOption
+//line postgresql_sql.y:3806
+		{
+			yyLOCAL = tree.NewTableOptionConnection(yyDollar[3].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 614:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.TableOption
+//line postgresql_sql.y:3810
+		{
+			yyLOCAL = tree.NewTableOptionDataDirectory(yyDollar[4].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 615:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.TableOption
+//line postgresql_sql.y:3814
+		{
+			yyLOCAL = tree.NewTableOptionIndexDirectory(yyDollar[4].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 616:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.TableOption
+//line postgresql_sql.y:3818
+		{
+			yyLOCAL = tree.NewTableOptionDelayKeyWrite(uint64(yyDollar[3].item.(int64)))
+		}
+		yyVAL.union = yyLOCAL
+	case 617:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.TableOption
+//line postgresql_sql.y:3822
+		{
+			yyLOCAL = tree.NewTableOptionEncryption(yyDollar[3].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 618:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.TableOption
+//line postgresql_sql.y:3826
+		{
+			yyLOCAL = tree.NewTableOptionEngine(yyDollar[3].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 619:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.TableOption
+//line postgresql_sql.y:3830
+		{
+			yyLOCAL = tree.NewTableOptionKeyBlockSize(uint64(yyDollar[3].item.(int64)))
+		}
+		yyVAL.union = yyLOCAL
+	case 620:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.TableOption
+//line postgresql_sql.y:3834
+		{
+			yyLOCAL = tree.NewTableOptionMaxRows(uint64(yyDollar[3].item.(int64)))
+		}
+		yyVAL.union = yyLOCAL
+	case 621:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.TableOption
+//line postgresql_sql.y:3838
+		{
+			yyLOCAL = tree.NewTableOptionMinRows(uint64(yyDollar[3].item.(int64)))
+		}
+		yyVAL.union = yyLOCAL
+	case 622:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.TableOption
+//line postgresql_sql.y:3842
+		{
+			yyLOCAL = &tree.TableOptionPackKeys{Value: yyDollar[3].item.(int64)}
+		}
+		yyVAL.union = yyLOCAL
+	case 623:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.TableOption
+//line postgresql_sql.y:3846
+		{
+			yyLOCAL = &tree.TableOptionPackKeys{Default: true}
+		}
+		yyVAL.union = yyLOCAL
+	case 624:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.TableOption
+//line postgresql_sql.y:3850
+		{
+			yyLOCAL = tree.NewTableOptionPassword(yyDollar[3].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 625:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.TableOption
+//line postgresql_sql.y:3854
+		{
+			yyLOCAL = tree.NewTableOptionRowFormat(yyDollar[3].rowFormatTypeUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 626:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.TableOption
+//line postgresql_sql.y:3858
+		{
+			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Value: uint64(yyDollar[3].item.(int64))}
+		}
+		yyVAL.union = yyLOCAL
+	case 627:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.TableOption
+//line postgresql_sql.y:3862
+		{
+			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Default: true}
+		}
+		yyVAL.union = yyLOCAL
+	case 628:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.TableOption
+//line postgresql_sql.y:3866
+		{
+			yyLOCAL = &tree.TableOptionStatsPersistent{Value: uint64(yyDollar[3].item.(int64))}
+		}
+		yyVAL.union = yyLOCAL
+	case 629:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.TableOption
+//line postgresql_sql.y:3870
+		{
+			yyLOCAL = &tree.TableOptionStatsPersistent{Default: true}
+		}
+		yyVAL.union = yyLOCAL
+	case 630:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.TableOption
+//line postgresql_sql.y:3874
+		{
+			yyLOCAL = &tree.TableOptionStatsSamplePages{Value: uint64(yyDollar[3].item.(int64))}
+		}
+		yyVAL.union = yyLOCAL
+	case 631:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.TableOption
+//line postgresql_sql.y:3878
+		{
+			yyLOCAL = &tree.TableOptionStatsSamplePages{Default: true}
+		}
+		yyVAL.union = yyLOCAL
+	case 632:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.TableOption
+//line postgresql_sql.y:3882
+		{
+			yyLOCAL = tree.NewTableOptionTablespace(yyDollar[3].str, yyDollar[4].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 633:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.TableOption
+//line postgresql_sql.y:3886
+		{
+			yyLOCAL = tree.NewTableOptionUnion(yyDollar[4].tableNamesUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 634:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.TableOption
+//line postgresql_sql.y:3890
+		{
+			yyLOCAL = &tree.TableOptionProperties{Preperties: yyDollar[3].propertiesUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 635:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []tree.Property
+//line postgresql_sql.y:3897
+		{
+			yyLOCAL = []tree.Property{yyDollar[1].propertyUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 636:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []tree.Property
+//line postgresql_sql.y:3901
+		{
+			yyLOCAL = append(yyDollar[1].propertiesUnion(), yyDollar[3].propertyUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 637:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Property
+//line postgresql_sql.y:3907
+		{
+			yyLOCAL = tree.Property{Key: yyDollar[1].str, Value: yyDollar[3].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 638:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line postgresql_sql.y:3912
+		{
+			yyVAL.str = ""
+		}
+	case 639:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line postgresql_sql.y:3916
+		{
+			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
+		}
+	case 640:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line postgresql_sql.y:3920
+		{
+			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
+		}
+	case 641:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.RowFormatType
+//line postgresql_sql.y:3926
+		{
+			yyLOCAL = tree.ROW_FORMAT_DEFAULT
+		}
+		yyVAL.union = yyLOCAL
+	case 642:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.RowFormatType
+//line postgresql_sql.y:3930
+		{
+			yyLOCAL = tree.ROW_FORMAT_DYNAMIC
+		}
+		yyVAL.union = yyLOCAL
+	case 643:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.RowFormatType
+//line postgresql_sql.y:3934
+		{
+			yyLOCAL = tree.ROW_FORMAT_FIXED
+		}
+		yyVAL.union = yyLOCAL
+	case 644:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.RowFormatType
+//line postgresql_sql.y:3938
+		{
+			yyLOCAL = tree.ROW_FORMAT_COMPRESSED
+		}
+		yyVAL.union = yyLOCAL
+	case 645:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.RowFormatType
+//line postgresql_sql.y:3942
+		{
+			yyLOCAL = tree.ROW_FORMAT_REDUNDANT
+		}
+		yyVAL.union = yyLOCAL
+	case 646:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.RowFormatType
+//line postgresql_sql.y:3946
+		{
+			yyLOCAL = tree.ROW_FORMAT_COMPACT
+		}
+		yyVAL.union = yyLOCAL
+	case 653:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.TableNames
+//line postgresql_sql.y:3962
+		{
+			yyLOCAL = tree.TableNames{yyDollar[1].tableNameUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 654:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.TableNames
+//line postgresql_sql.y:3966
+		{
+			yyLOCAL = append(yyDollar[1].tableNamesUnion(), yyDollar[3].tableNameUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 655:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.TableName
+//line postgresql_sql.y:3975
+		{
+			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
+			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[1].str), prefix)
+		}
+		yyVAL.union = yyLOCAL
+	case 656:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.TableName
+//line postgresql_sql.y:3980
+		{
+			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].str), ExplicitSchema: true}
+			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[3].str), prefix)
+		}
+		yyVAL.union = yyLOCAL
+	case 657:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.TableDefs
+//line postgresql_sql.y:3986
+		{
+			yyLOCAL = tree.TableDefs(nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 659:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.TableDefs
+//line postgresql_sql.y:3993
+		{
+			yyLOCAL = tree.TableDefs{yyDollar[1].tableDefUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 660:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.TableDefs
+//line postgresql_sql.y:3997
+		{
+			yyLOCAL = append(yyDollar[1].tableDefsUnion(), yyDollar[3].tableDefUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 661:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.TableDef
+//line postgresql_sql.y:4003
+		{
+			yyLOCAL = tree.TableDef(yyDollar[1].columnTableDefUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 662:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.TableDef
+//line postgresql_sql.y:4007
+		{
+			yyLOCAL = yyDollar[1].tableDefUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 663:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.TableDef
+//line postgresql_sql.y:4013
+		{
+			if yyDollar[1].str != "" {
+				switch v := yyDollar[2].tableDefUnion().(type) {
+				case *tree.PrimaryKeyIndex:
+					v.Name = yyDollar[1].str
+				}
+			}
+			yyLOCAL = yyDollar[2].tableDefUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 664:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.TableDef
+//line postgresql_sql.y:4023
+		{
+			yyLOCAL = yyDollar[1].tableDefUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 665:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.TableDef
+//line postgresql_sql.y:4029
+		{
+			yyLOCAL = &tree.PrimaryKeyIndex{
+				KeyParts:    yyDollar[5].keyPartsUnion(),
+				Name:        yyDollar[3].strsUnion()[0],
+				Empty:       yyDollar[3].strsUnion()[1] == "",
+				IndexOption: yyDollar[7].indexOptionUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 666:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.TableDef
+//line postgresql_sql.y:4038
+		{
+			yyLOCAL = &tree.FullTextIndex{
+				KeyParts:    yyDollar[5].keyPartsUnion(),
+				Name:        yyDollar[3].str,
+				Empty:       true,
+				IndexOption: yyDollar[7].indexOptionUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 667:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.TableDef
+//line postgresql_sql.y:4047
+		{
+			keyTyp := tree.INDEX_TYPE_INVALID
+			if yyDollar[3].strsUnion()[1] != "" {
+				t := strings.ToLower(yyDollar[3].strsUnion()[1])
+				switch t {
+				case "zonemap":
+					keyTyp = tree.INDEX_TYPE_ZONEMAP
+				case "bsi":
+					keyTyp = tree.INDEX_TYPE_BSI
+				default:
+					yylex.Error("Invail the type of index")
+					return 1
+				}
+			}
+			yyLOCAL = &tree.Index{
+				IfNotExists: yyDollar[2].ifNotExistsUnion(),
+				KeyParts:    yyDollar[5].keyPartsUnion(),
+				Name:        yyDollar[3].strsUnion()[0],
+				KeyType:     keyTyp,
+				IndexOption: yyDollar[7].indexOptionUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 668:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.TableDef
+//line postgresql_sql.y:4070
+		{
+			yyLOCAL = &tree.UniqueIndex{
+				KeyParts:    yyDollar[5].keyPartsUnion(),
+				Name:        yyDollar[3].strsUnion()[0],
+				Empty:       yyDollar[3].strsUnion()[1] == "",
+				IndexOption: yyDollar[7].indexOptionUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 669:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL tree.TableDef
+//line postgresql_sql.y:4079
+		{
+			yyLOCAL = &tree.ForeignKey{
+				IfNotExists: yyDollar[3].ifNotExistsUnion(),
+				KeyParts:    yyDollar[6].keyPartsUnion(),
+				Name:        yyDollar[4].str,
+				Refer:       yyDollar[8].attributeReferenceUnion(),
+				Empty:       true,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 670:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.TableDef
+//line postgresql_sql.y:4089
+		{
+			yyLOCAL = &tree.CheckIndex{
+				Expr:     yyDollar[3].exprUnion(),
+				Enforced: yyDollar[5].boolValUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 671:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line postgresql_sql.y:4097
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 673:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line postgresql_sql.y:4103
+		{
+			yyVAL.str = ""
+		}
+	case 674:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line postgresql_sql.y:4107
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 677:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []string
+//line postgresql_sql.y:4117
+		{
+			yyLOCAL = make([]string, 2)
+			yyLOCAL[0] = yyDollar[1].str
+			yyLOCAL[1] = ""
+		}
+		yyVAL.union = yyLOCAL
+	case 678:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []string
+//line postgresql_sql.y:4123
+		{
+			yyLOCAL = make([]string, 2)
+			yyLOCAL[0] = yyDollar[1].str
+			yyLOCAL[1] = yyDollar[3].str
+		}
+		yyVAL.union = yyLOCAL
+	case 679:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []string
+//line postgresql_sql.y:4129
+		{
+			yyLOCAL = make([]string, 2)
+			yyLOCAL[0] = yyDollar[1].str
+			yyLOCAL[1] = yyDollar[3].str
+		}
+		yyVAL.union = yyLOCAL
+	case 685:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line postgresql_sql.y:4143
+		{
+			yyVAL.str = ""
+		}
+	case 687:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.ColumnTableDef
+//line postgresql_sql.y:4150
+		{
+			yyLOCAL = tree.NewColumnTableDef(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), yyDollar[3].columnAttributesUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 688:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.ColumnTableDef
+//line postgresql_sql.y:4154
+		{
+			attrs := []tree.ColumnAttribute{tree.NewAttributeNull(false), tree.NewAttributeAutoIncrement()}
+			yyLOCAL = tree.NewColumnTableDef(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), append(attrs, yyDollar[3].columnAttributesUnion()...))
+		}
+		yyVAL.union = yyLOCAL
+	case 689:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.UnresolvedName
+//line postgresql_sql.y:4161
+		{
+			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 690:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.UnresolvedName
+//line postgresql_sql.y:4165
+		{
+			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 691:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.UnresolvedName
+//line postgresql_sql.y:4169
+		{
+			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 695:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.UnresolvedName
+//line postgresql_sql.y:4180
+		{
+			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 696:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.UnresolvedName
+//line postgresql_sql.y:4184
+		{
+			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 697:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.UnresolvedName
+//line postgresql_sql.y:4188
+		{
+			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 698:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL []tree.ColumnAttribute
+//line postgresql_sql.y:4193
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 699:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []tree.ColumnAttribute
+//line postgresql_sql.y:4197
+		{
+			yyLOCAL = yyDollar[1].columnAttributesUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 700:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []tree.ColumnAttribute
+//line postgresql_sql.y:4203
+		{
+			yyLOCAL = []tree.ColumnAttribute{yyDollar[1].columnAttributeUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 701:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL []tree.ColumnAttribute
+//line postgresql_sql.y:4207
+		{
+			yyLOCAL = append(yyDollar[1].columnAttributesUnion(), yyDollar[2].columnAttributeUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 702:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line postgresql_sql.y:4213
+		{
+			yyLOCAL = tree.NewAttributeNull(true)
+		}
+		yyVAL.union = yyLOCAL
+	case 703:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line postgresql_sql.y:4217
+		{
+			yyLOCAL = tree.NewAttributeNull(false)
+		}
+		yyVAL.union = yyLOCAL
+	case 704:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line postgresql_sql.y:4221
+		{
+			yyLOCAL = tree.NewAttributeDefault(yyDollar[2].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 705:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line postgresql_sql.y:4225
+		{
+			yyLOCAL = tree.NewAttributeAutoIncrement()
+		}
+		yyVAL.union = yyLOCAL
+	case 706:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line postgresql_sql.y:4229
+		{
+			yyLOCAL = tree.NewAttributeAutoIncrement()
+		}
+		yyVAL.union = yyLOCAL
+	case 707:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line postgresql_sql.y:4233
+		{
+			yyLOCAL = tree.NewAttributeAutoIncrement()
+		}
+		yyVAL.union = yyLOCAL
+	case 708:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line postgresql_sql.y:4237
+		{
+			yyLOCAL = yyDollar[1].columnAttributeUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 709:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line postgresql_sql.y:4241
+		{
+			yyLOCAL = tree.NewAttributeComment(tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false))
+		}
+		yyVAL.union = yyLOCAL
+	case 710:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line postgresql_sql.y:4245
+		{
+			yyLOCAL = tree.NewAttributeCollate(yyDollar[2].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 711:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line postgresql_sql.y:4249
+		{
+			yyLOCAL = tree.NewAttributeColumnFormat(yyDollar[2].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 712:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line postgresql_sql.y:4253
+		{
+			yyLOCAL = tree.NewAttributeStorage(yyDollar[2].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 713:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line postgresql_sql.y:4257
+		{
+			yyLOCAL = tree.NewAttributeAutoRandom(int(yyDollar[2].int64ValUnion()))
+		}
+		yyVAL.union = yyLOCAL
+	case 714:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line postgresql_sql.y:4261
+		{
+			yyLOCAL = yyDollar[1].attributeReferenceUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 715:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line postgresql_sql.y:4265
+		{
+			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), false, yyDollar[1].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 716:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line postgresql_sql.y:4269
+		{
+			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), yyDollar[6].boolValUnion(), yyDollar[1].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 717:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL bool
+//line postgresql_sql.y:4279
+		{
+			yyLOCAL = true
+		}
+		yyVAL.union = yyLOCAL
+	case 718:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL bool
+//line postgresql_sql.y:4283
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 719:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line postgresql_sql.y:4288
+		{
+			yyVAL.str = ""
+		}
+	case 720:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line postgresql_sql.y:4292
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 721:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line postgresql_sql.y:4298
+		{
+			yyVAL.str = ""
+		}
+	case 722:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line postgresql_sql.y:4302
+		{
+			yyVAL.str = yyDollar[2].str
+		}
+	case 723:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.AttributeReference
+//line postgresql_sql.y:4308
+		{
+			yyLOCAL = &tree.AttributeReference{
+				TableName: yyDollar[2].tableNameUnion(),
+				KeyParts:  yyDollar[3].keyPartsUnion(),
+				Match:     yyDollar[4].matchTypeUnion(),
+				OnDelete:  yyDollar[5].referenceOnRecordUnion().OnDelete,
+				OnUpdate:  yyDollar[5].referenceOnRecordUnion().OnUpdate,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 724:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.ReferenceOnRecord
+//line postgresql_sql.y:4319
+		{
+			yyLOCAL = &tree.ReferenceOnRecord{
+				OnDelete: tree.REFERENCE_OPTION_INVALID,
+				OnUpdate: tree.REFERENCE_OPTION_INVALID,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 726:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.ReferenceOnRecord
+//line postgresql_sql.y:4329
+		{
+			yyLOCAL = &tree.ReferenceOnRecord{
+				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
+				OnUpdate: tree.REFERENCE_OPTION_INVALID,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 727:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.ReferenceOnRecord
+//line postgresql_sql.y:4336
+		{
+			yyLOCAL = &tree.ReferenceOnRecord{
+				OnDelete: tree.REFERENCE_OPTION_INVALID,
+				OnUpdate: yyDollar[1].referenceOptionTypeUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 728:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.ReferenceOnRecord
+//line postgresql_sql.y:4343
+		{
+			yyLOCAL = &tree.ReferenceOnRecord{
+				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
+				OnUpdate: yyDollar[2].referenceOptionTypeUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 729:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.ReferenceOnRecord
+//line postgresql_sql.y:4350
+		{
+			yyLOCAL = &tree.ReferenceOnRecord{
+				OnDelete: yyDollar[2].referenceOptionTypeUnion(),
+				OnUpdate: yyDollar[1].referenceOptionTypeUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 730:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.ReferenceOptionType
+//line postgresql_sql.y:4359
+		{
+			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 731:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.ReferenceOptionType
+//line postgresql_sql.y:4365
+		{
+			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 732:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.ReferenceOptionType
+//line postgresql_sql.y:4371
+		{
+			yyLOCAL = tree.REFERENCE_OPTION_RESTRICT
+		}
+		yyVAL.union = yyLOCAL
+	case 733:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.ReferenceOptionType
+//line postgresql_sql.y:4375
+		{
+			yyLOCAL = tree.REFERENCE_OPTION_CASCADE
+		}
+		yyVAL.union = yyLOCAL
+	case 734:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.ReferenceOptionType
+//line postgresql_sql.y:4379
+		{
+			yyLOCAL = tree.REFERENCE_OPTION_SET_NULL
+		}
+		yyVAL.union = yyLOCAL
+	case 735:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.ReferenceOptionType
+//line postgresql_sql.y:4383
+		{
+			yyLOCAL = tree.REFERENCE_OPTION_NO_ACTION
+		}
+		yyVAL.union = yyLOCAL
+	case 736:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.ReferenceOptionType
+//line postgresql_sql.y:4387
+		{
+			yyLOCAL = tree.REFERENCE_OPTION_SET_DEFAULT
+		}
+		yyVAL.union = yyLOCAL
+	case 737:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.MatchType
+//line postgresql_sql.y:4392
+		{
+			yyLOCAL = tree.MATCH_INVALID
+		}
+		yyVAL.union = yyLOCAL
+	case 739:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.MatchType
+//line postgresql_sql.y:4399
+		{
+			yyLOCAL = tree.MATCH_FULL
+		}
+		yyVAL.union = yyLOCAL
+	case 740:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.MatchType
+//line postgresql_sql.y:4403
+		{
+			yyLOCAL = tree.MATCH_PARTIAL
+		}
+		yyVAL.union = yyLOCAL
+	case 741:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.MatchType
+//line postgresql_sql.y:4407
+		{
+			yyLOCAL = tree.MATCH_SIMPLE
+		}
+		yyVAL.union = yyLOCAL
+	case 742:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL []*tree.KeyPart
+//line postgresql_sql.y:4412
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 743:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []*tree.KeyPart
+//line postgresql_sql.y:4416
+		{
+			yyLOCAL = yyDollar[2].keyPartsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 744:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL int64
+//line postgresql_sql.y:4421
+		{
+			yyLOCAL = -1
+		}
+		yyVAL.union = yyLOCAL
+	case 745:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL int64
+//line postgresql_sql.y:4425
+		{
+			yyLOCAL = yyDollar[2].item.(int64)
+		}
+		yyVAL.union = yyLOCAL
+	case 752:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.Subquery
+//line postgresql_sql.y:4441
+		{
+			yyLOCAL = &tree.Subquery{Select: yyDollar[1].selectStatementUnion(), Exists: false}
+		}
+		yyVAL.union = yyLOCAL
+	case 753:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:4447
+		{
+			yyLOCAL = tree.NewBinaryExpr(tree.BIT_AND, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 754:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:4451
+		{
+			yyLOCAL = tree.NewBinaryExpr(tree.BIT_OR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 755:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:4455
+		{
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(tree.SetUnresolvedName("concat")),
+				Exprs: tree.Exprs{yyDollar[1].exprUnion(), yyDollar[3].exprUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 756:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:4462
+		{
+			yyLOCAL = tree.NewBinaryExpr(tree.BIT_XOR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 757:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:4466
+		{
+			yyLOCAL = tree.NewBinaryExpr(tree.PLUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 758:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:4470
+		{
+			yyLOCAL = tree.NewBinaryExpr(tree.MINUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 759:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:4474
+		{
+			yyLOCAL = tree.NewBinaryExpr(tree.MULTI, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 760:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:4478
+		{
+			yyLOCAL = tree.NewBinaryExpr(tree.DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 761:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:4482
+		{
+			yyLOCAL = tree.NewBinaryExpr(tree.INTEGER_DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 762:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:4486
+		{
+			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 763:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:4490
+		{
+			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 764:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:4494
+		{
+			yyLOCAL = tree.NewBinaryExpr(tree.LEFT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 765:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:4498
+		{
+			yyLOCAL = tree.NewBinaryExpr(tree.RIGHT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 766:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:4502
+		{
+			yyLOCAL = yyDollar[1].exprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 767:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:4508
+		{
+			yyLOCAL = yyDollar[1].unresolvedNameUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 768:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:4512
+		{
+			yyLOCAL = yyDollar[1].varExprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 769:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:4516
+		{
+			yyLOCAL = yyDollar[1].exprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 770:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:4520
+		{
+			yyLOCAL = tree.NewParenExpr(yyDollar[2].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 771:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:4524
+		{
+			yyLOCAL = tree.NewTuple(append(yyDollar[2].exprsUnion(), yyDollar[4].exprUnion()))
+		}
+		yyVAL.union = yyLOCAL
+	case 772:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:4528
+		{
+			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_PLUS, yyDollar[2].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 773:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:4532
+		{
+			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MINUS, yyDollar[2].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 774:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:4536
+		{
+			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_TILDE, yyDollar[2].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 775:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:4540
+		{
+			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MARK, yyDollar[2].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 776:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:4544
+		{
+			yyLOCAL = yyDollar[1].exprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 777:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:4548
+		{
+			yyLOCAL = tree.NewCaseExpr(yyDollar[2].exprUnion(), yyDollar[3].whensUnion(), yyDollar[4].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 778:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:4552
+		{
+			yyLOCAL = yyDollar[1].subqueryUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 779:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:4556
+		{
+			yyDollar[2].subqueryUnion().Exists = true
+			yyLOCAL = yyDollar[2].subqueryUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 780:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:4561
+		{
+			yyLOCAL = tree.NewCastExpr(yyDollar[1].exprUnion(), yyDollar[3].columnTypeUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 781:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:4565
+		{
+			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 782:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:4569
+		{
+			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 783:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:4573
+		{
+			name := tree.SetUnresolvedName("convert")
+			es := tree.NewNumVal(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false)
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[3].exprUnion(), es},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 784:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:4582
+		{
+			yyDollar[1].funcExprUnion().WindowSpec = yyDollar[2].windowSpecUnion()
+			yyLOCAL = yyDollar[1].funcExprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 785:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:4587
+		{
+			yyLOCAL = yyDollar[1].funcExprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 786:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:4591
+		{
+			yyLOCAL = yyDollar[1].funcExprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 787:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:4595
+		{
+			yyDollar[1].funcExprUnion().WindowSpec = yyDollar[2].windowSpecUnion()
+			yyLOCAL = yyDollar[1].funcExprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 789:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:4603
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.StringFamily,
+					FamilyString: yyDollar[1].str,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_VARCHAR),
+					DisplayWith:  yyDollar[2].lengthOptUnion(),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 790:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:4616
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.StringFamily,
+					FamilyString: yyDollar[1].str,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_VARCHAR),
+					DisplayWith:  yyDollar[2].lengthOptUnion(),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 791:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:4629
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.DateFamily,
+					FamilyString: yyDollar[1].str,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_DATE),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 792:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:4641
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.IntFamily,
+					FamilyString: yyDollar[1].str,
+					DisplayWith:  yyDollar[2].lengthOptUnion(),
+					Width:        16,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_YEAR),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 793:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:4655
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:             tree.TimestampFamily,
+					Precision:          0,
+					FamilyString:       yyDollar[1].str,
+					DisplayWith:        yyDollar[2].lengthOptUnion(),
+					TimePrecisionIsSet: false,
+					Locale:             &locale,
+					Oid:                uint32(defines.MYSQL_TYPE_DATETIME),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 794:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:4670
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:             tree.TimeFamily,
+					FamilyString:       yyDollar[1].str,
+					DisplayWith:        yyDollar[2].lengthOptUnion(),
+					Precision:          0,
+					TimePrecisionIsSet: false,
+					Locale:             &locale,
+					Oid:                uint32(defines.MYSQL_TYPE_TIME),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 795:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:4685
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.IntFamily,
+					FamilyString: yyDollar[1].str,
+					Width:        64,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_LONGLONG),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 796:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:4698
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.IntFamily,
+					FamilyString: yyDollar[1].str,
+					Width:        64,
+					Locale:       &locale,
+					Unsigned:     true,
+					Oid:          uint32(defines.MYSQL_TYPE_LONGLONG),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 797:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line postgresql_sql.y:4713
+		{
+		}
+	case 800:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line postgresql_sql.y:4719
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
+				Type:  yyDollar[3].funcTypeUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 801:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line postgresql_sql.y:4728
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: yyDollar[3].exprsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 802:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line postgresql_sql.y:4736
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: yyDollar[3].exprsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 803:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line postgresql_sql.y:4744
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
+				Type:  yyDollar[3].funcTypeUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 804:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line postgresql_sql.y:4753
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
+				Type:  yyDollar[3].funcTypeUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 805:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line postgresql_sql.y:4762
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
+				Type:  yyDollar[3].funcTypeUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 806:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line postgresql_sql.y:4771
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: yyDollar[4].exprsUnion(),
+				Type:  yyDollar[3].funcTypeUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 807:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line postgresql_sql.y:4780
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			es := tree.NewNumVal(constant.MakeString("*"), "*", false)
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{es},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 808:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line postgresql_sql.y:4789
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
+				Type:  yyDollar[3].funcTypeUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 809:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line postgresql_sql.y:4798
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
+				Type:  yyDollar[3].funcTypeUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 810:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line postgresql_sql.y:4807
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
+				Type:  yyDollar[3].funcTypeUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 811:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line postgresql_sql.y:4816
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
+				Type:  yyDollar[3].funcTypeUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 812:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line postgresql_sql.y:4825
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
+				Type:  yyDollar[3].funcTypeUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 813:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line postgresql_sql.y:4834
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
+				Type:  yyDollar[3].funcTypeUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 814:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line postgresql_sql.y:4843
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
+				Type:  yyDollar[3].funcTypeUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 815:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.WindowSpec
+//line postgresql_sql.y:4853
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 816:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.WindowSpec
+//line postgresql_sql.y:4857
+		{
+			yyLOCAL = tree.NewWindowSpec(yyDollar[3].exprsUnion(), yyDollar[4].orderByUnion(), yyDollar[5].frameClauseUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 817:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line postgresql_sql.y:4862
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 818:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line postgresql_sql.y:4866
+		{
+			yyLOCAL = yyDollar[3].exprsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 819:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.FrameClause
+//line postgresql_sql.y:4871
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 820:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameClause
+//line postgresql_sql.y:4875
+		{
+			yyLOCAL = tree.NewFrameClause(yyDollar[1].frameTypeUnion(), yyDollar[2].frameBoundUnion(), tree.NewFrameBound(tree.FRAME_CURRENT_ROW, nil))
+		}
+		yyVAL.union = yyLOCAL
+	case 821:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FrameClause
+//line postgresql_sql.y:4879
+		{
+			yyLOCAL = tree.NewFrameClause(yyDollar[1].frameTypeUnion(), yyDollar[3].frameBoundUnion(), yyDollar[5].frameBoundUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 822:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.FrameType
+//line postgresql_sql.y:4885
+		{
+			yyLOCAL = tree.FRAME_ROWS
+		}
+		yyVAL.union = yyLOCAL
+	case 823:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.FrameType
+//line postgresql_sql.y:4889
+		{
+			yyLOCAL = tree.FRAME_RANGE
+		}
+		yyVAL.union = yyLOCAL
+	case 824:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line postgresql_sql.y:4895
+		{
+			yyLOCAL = tree.NewFrameBound(tree.FRAME_UNBOUNDED_PRECEDING, nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 825:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line postgresql_sql.y:4899
+		{
+			yyLOCAL = tree.NewFrameBound(tree.FRAME_UNBOUNDED_FOLLOWING, nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 826:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line postgresql_sql.y:4903
+		{
+			yyLOCAL = tree.NewFrameBound(tree.FRAME_CURRENT_ROW, nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 827:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line postgresql_sql.y:4907
+		{
+			ival := util.GetUint64(yyDollar[1].item)
+			yyLOCAL = tree.NewFrameBound(tree.FRAME_PRECEDING, tree.NewNumVal(constant.MakeUint64(ival), strconv.FormatUint(ival, 10), false))
+		}
+		yyVAL.union = yyLOCAL
+	case 828:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line postgresql_sql.y:4912
+		{
+			ival := util.GetUint64(yyDollar[1].item)
+			yyLOCAL = tree.NewFrameBound(tree.FRAME_FOLLOWING, tree.NewNumVal(constant.MakeUint64(ival), strconv.FormatUint(ival, 10), false))
+		}
+		yyVAL.union = yyLOCAL
+	case 832:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line postgresql_sql.y:4924
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: yyDollar[3].exprsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 833:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line postgresql_sql.y:4932
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: yyDollar[3].exprsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 834:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line postgresql_sql.y:4940
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[3].exprUnion(), yyDollar[5].exprUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 835:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line postgresql_sql.y:4948
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[3].exprUnion(), yyDollar[5].exprUnion(), yyDollar[7].exprUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 836:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line postgresql_sql.y:4956
+		{
+			t := yyDollar[3].intervalTypeUnion()
+			unit := t.ToString()
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{tree.NewNumVal(constant.MakeString(unit), unit, false), yyDollar[5].exprUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 837:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line postgresql_sql.y:4966
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: yyDollar[3].exprsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 838:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line postgresql_sql.y:4984
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			var es tree.Exprs = nil
+			if yyDollar[2].exprUnion() != nil {
+				es = append(es, yyDollar[2].exprUnion())
+			}
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: es,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 839:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line postgresql_sql.y:4996
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			var es tree.Exprs = nil
+			if yyDollar[2].exprUnion() != nil {
+				es = append(es, yyDollar[2].exprUnion())
+			}
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: es,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 840:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line postgresql_sql.y:5010
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: yyDollar[3].exprsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 841:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line postgresql_sql.y:5018
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func: tree.FuncName2ResolvableFunctionReference(name),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 842:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line postgresql_sql.y:5025
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			var es tree.Exprs = nil
+			if yyDollar[2].exprUnion() != nil {
+				es = append(es, yyDollar[2].exprUnion())
+			}
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: es,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 843:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line postgresql_sql.y:5037
+		{
+			name := tree.SetUnresolvedName("char")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: yyDollar[3].exprsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 844:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line postgresql_sql.y:5045
+		{
+			cn := tree.NewNumVal(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false)
+			es := yyDollar[3].exprsUnion()
+			es = append(es, cn)
+			name := tree.SetUnresolvedName("char")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: es,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 845:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line postgresql_sql.y:5056
+		{
+			val := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
+			name := tree.SetUnresolvedName("date")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{val},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 846:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line postgresql_sql.y:5065
+		{
+			val := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
+			name := tree.SetUnresolvedName("time")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{val},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 847:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line postgresql_sql.y:5074
+		{
+			val := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
+			name := tree.SetUnresolvedName("timestamp")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{val},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 848:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line postgresql_sql.y:5083
+		{
+			name := tree.SetUnresolvedName("insert")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: yyDollar[3].exprsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 849:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line postgresql_sql.y:5091
+		{
+			es := tree.Exprs{yyDollar[3].exprUnion()}
+			es = append(es, yyDollar[5].exprUnion())
+			name := tree.SetUnresolvedName("mod")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: es,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 850:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line postgresql_sql.y:5101
+		{
+			name := tree.SetUnresolvedName("password")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: yyDollar[3].exprsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 851:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line postgresql_sql.y:5109
+		{
+			name := tree.SetUnresolvedName("binary")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[2].exprUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 852:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5118
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 853:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5122
+		{
+			yyLOCAL = yyDollar[1].exprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 854:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5128
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 855:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5132
+		{
+			yyLOCAL = yyDollar[2].numValUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 862:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line postgresql_sql.y:5145
+		{
+		}
+	case 863:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line postgresql_sql.y:5147
+		{
+		}
+	case 896:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5187
+		{
+			yyLOCAL = &tree.IntervalExpr{
+				Expr: yyDollar[2].exprUnion(),
+				Type: yyDollar[3].intervalTypeUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 897:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5194
+		{
+			// the unit is in the string, such as interval '1 year'
+			yyLOCAL = &tree.IntervalExpr{
+				Expr: tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false),
+				Type: tree.INTERVAL_TYPE_INVALID,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 898:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5203
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 899:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5207
+		{
+			yyLOCAL = yyDollar[1].exprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 900:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*tree.When
+//line postgresql_sql.y:5213
+		{
+			yyLOCAL = []*tree.When{yyDollar[1].whenUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 901:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL []*tree.When
+//line postgresql_sql.y:5217
+		{
+			yyLOCAL = append(yyDollar[1].whensUnion(), yyDollar[2].whenUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 902:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.When
+//line postgresql_sql.y:5223
+		{
+			yyLOCAL = tree.NewWhen(yyDollar[2].exprUnion(), yyDollar[4].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 903:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5228
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 904:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5232
+		{
+			yyLOCAL = yyDollar[2].exprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 905:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.FuncType
+//line postgresql_sql.y:5237
+		{
+			yyLOCAL = tree.FUNC_TYPE_DEFAULT
+		}
+		yyVAL.union = yyLOCAL
+	case 906:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.FuncType
+//line postgresql_sql.y:5241
+		{
+			yyLOCAL = tree.FUNC_TYPE_DISTINCT
+		}
+		yyVAL.union = yyLOCAL
+	case 907:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.FuncType
+//line postgresql_sql.y:5245
+		{
+			yyLOCAL = tree.FUNC_TYPE_ALL
+		}
+		yyVAL.union = yyLOCAL
+	case 908:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IntervalType
+//line postgresql_sql.y:5251
+		{
+			yyLOCAL = tree.INTERVAL_TYPE_MICROSECOND
+		}
+		yyVAL.union = yyLOCAL
+	case 909:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IntervalType
+//line postgresql_sql.y:5255
+		{
+			yyLOCAL = tree.INTERVAL_TYPE_SECOND
+		}
+		yyVAL.union = yyLOCAL
+	case 910:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IntervalType
+//line postgresql_sql.y:5259
+		{
+			yyLOCAL = tree.INTERVAL_TYPE_MINUTE
+		}
+		yyVAL.union = yyLOCAL
+	case 911:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IntervalType
+//line postgresql_sql.y:5263
+		{
+			yyLOCAL = tree.INTERVAL_TYPE_HOUR
+		}
+		yyVAL.union = yyLOCAL
+	case 912:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IntervalType
+//line postgresql_sql.y:5267
+		{
+			yyLOCAL = tree.INTERVAL_TYPE_DAY
+		}
+		yyVAL.union = yyLOCAL
+	case 913:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IntervalType
+//line postgresql_sql.y:5271
+		{
+			yyLOCAL = tree.INTERVAL_TYPE_WEEK
+		}
+		yyVAL.union = yyLOCAL
+	case 914:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IntervalType
+//line postgresql_sql.y:5275
+		{
+			yyLOCAL = tree.INTERVAL_TYPE_MONTH
+		}
+		yyVAL.union = yyLOCAL
+	case 915:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IntervalType
+//line postgresql_sql.y:5279
+		{
+			yyLOCAL = tree.INTERVAL_TYPE_QUARTER
+		}
+		yyVAL.union = yyLOCAL
+	case 916:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IntervalType
+//line postgresql_sql.y:5283
+		{
+			yyLOCAL = tree.INTERVAL_TYPE_YEAR
+		}
+		yyVAL.union = yyLOCAL
+	case 917:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.Tuple
+//line postgresql_sql.y:5289
+		{
+			yyLOCAL = tree.NewTuple(yyDollar[2].exprsUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 918:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line postgresql_sql.y:5294
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 919:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line postgresql_sql.y:5298
+		{
+			yyLOCAL = yyDollar[1].exprsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 920:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line postgresql_sql.y:5304
+		{
+			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 921:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line postgresql_sql.y:5308
+		{
+			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 922:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5315
+		{
+			yyLOCAL = tree.NewAndExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 923:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5319
+		{
+			yyLOCAL = tree.NewOrExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 924:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5323
+		{
+			yyLOCAL = tree.NewXorExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 925:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5327
+		{
+			yyLOCAL = tree.NewNotExpr(yyDollar[2].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 926:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5331
+		{
+			yyLOCAL = yyDollar[1].exprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 927:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5337
+		{
+			yyLOCAL = tree.NewIsNullExpr(yyDollar[1].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 928:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5341
+		{
+			yyLOCAL = tree.NewIsNotNullExpr(yyDollar[1].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 929:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5345
+		{
+			yyLOCAL = tree.NewComparisonExpr(tree.IS_DISTINCT_FROM, yyDollar[1].exprUnion(), yyDollar[5].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 930:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5349
+		{
+			yyLOCAL = tree.NewComparisonExpr(tree.IS_NOT_DISTINCT_FROM, yyDollar[1].exprUnion(), yyDollar[6].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 931:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5353
+		{
+			yyLOCAL = tree.NewComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 933:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5360
+		{
+			yyLOCAL = tree.NewComparisonExpr(tree.IN, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 934:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5364
+		{
+			yyLOCAL = tree.NewComparisonExpr(tree.NOT_IN, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 935:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5368
+		{
+			yyLOCAL = tree.NewComparisonExprWithEscape(tree.LIKE, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[4].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 936:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5372
+		{
+			yyLOCAL = tree.NewComparisonExprWithEscape(tree.NOT_LIKE, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[5].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 937:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5376
+		{
+			yyLOCAL = tree.NewComparisonExprWithEscape(tree.ILIKE, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[4].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 938:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5380
+		{
+			yyLOCAL = tree.NewComparisonExprWithEscape(tree.NOT_ILIKE, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[5].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 939:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5384
+		{
+			yyLOCAL = tree.NewComparisonExpr(tree.REG_MATCH, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 940:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5388
+		{
+			yyLOCAL = tree.NewComparisonExpr(tree.NOT_REG_MATCH, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 941:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5392
+		{
+			yyLOCAL = tree.NewRangeCond(false, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[5].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 942:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5396
+		{
+			yyLOCAL = tree.NewRangeCond(true, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[6].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 944:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5402
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 945:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5406
+		{
+			yyLOCAL = yyDollar[2].exprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 946:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5412
+		{
+			yyLOCAL = yyDollar[1].tupleUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 947:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5416
+		{
+			yyLOCAL = yyDollar[1].subqueryUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 948:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.ComparisonOp
+//line postgresql_sql.y:5423
+		{
+			yyLOCAL = tree.EQUAL
+		}
+		yyVAL.union = yyLOCAL
+	case 949:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.ComparisonOp
+//line postgresql_sql.y:5427
+		{
+			yyLOCAL = tree.LESS_THAN
+		}
+		yyVAL.union = yyLOCAL
+	case 950:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.ComparisonOp
+//line postgresql_sql.y:5431
+		{
+			yyLOCAL = tree.GREAT_THAN
+		}
+		yyVAL.union = yyLOCAL
+	case 951:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.ComparisonOp
+//line postgresql_sql.y:5435
+		{
+			yyLOCAL = tree.LESS_THAN_EQUAL
+		}
+		yyVAL.union = yyLOCAL
+	case 952:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.ComparisonOp
+//line postgresql_sql.y:5439
+		{
+			yyLOCAL = tree.GREAT_THAN_EQUAL
+		}
+		yyVAL.union = yyLOCAL
+	case 953:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.ComparisonOp
+//line postgresql_sql.y:5443
+		{
+			yyLOCAL = tree.NOT_EQUAL
+		}
+		yyVAL.union = yyLOCAL
+	case 954:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line postgresql_sql.y:5450
+		{
+			yyLOCAL = tree.NewAttributePrimaryKey()
+		}
+		yyVAL.union = yyLOCAL
+	case 955:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line postgresql_sql.y:5454
+		{
+			yyLOCAL = tree.NewAttributeUniqueKey()
+		}
+		yyVAL.union = yyLOCAL
+	case 956:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line postgresql_sql.y:5458
+		{
+			yyLOCAL = tree.NewAttributeUnique()
+		}
+		yyVAL.union = yyLOCAL
+	case 957:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line postgresql_sql.y:5462
+		{
+			yyLOCAL = tree.NewAttributeKey()
+		}
+		yyVAL.union = yyLOCAL
+	case 958:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.NumVal
+//line postgresql_sql.y:5468
+		{
+			ival, errStr := util.GetInt64(yyDollar[1].item)
+			if errStr != "" {
+				yylex.Error(errStr)
+				return 1
+			}
+			if ival > 0 {
+				yyLOCAL = tree.NewNumValWithResInt(constant.MakeInt64(ival), yylex.(*Lexer).scanner.LastToken, false, ival)
+			} else {
+				yyLOCAL = tree.NewNumValWithResInt(constant.MakeInt64(ival), yylex.(*Lexer).scanner.LastToken, true, ival)
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 959:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5483
+		{
+			yyLOCAL = tree.NewNumVal(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false)
+		}
+		yyVAL.union = yyLOCAL
+	case 960:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5487
+		{
+			ival := util.GetUint64(yyDollar[1].item)
+			yyLOCAL = tree.NewNumVal(constant.MakeUint64(ival), yylex.(*Lexer).scanner.LastToken, false)
+		}
+		yyVAL.union = yyLOCAL
+	case 961:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5492
+		{
+			fval := yyDollar[1].item.(float64)
+			yyLOCAL = tree.NewNumValWithResFoalt(constant.MakeFloat64(fval), yylex.(*Lexer).scanner.LastToken, false, fval)
+		}
+		yyVAL.union = yyLOCAL
+	case 962:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5497
+		{
+			yyLOCAL = tree.NewNumVal(constant.MakeBool(true), "", false)
+		}
+		yyVAL.union = yyLOCAL
+	case 963:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5501
+		{
+			yyLOCAL = tree.NewNumVal(constant.MakeBool(false), "", false)
+		}
+		yyVAL.union = yyLOCAL
+	case 964:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5505
+		{
+			yyLOCAL = tree.NewNumVal(constant.MakeUnknown(), "", false)
+		}
+		yyVAL.union = yyLOCAL
+	case 965:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5509
+		{
+			ival := util.GetUint64(yyDollar[1].item)
+			yyLOCAL = tree.NewNumVal(constant.MakeUint64(ival), yylex.(*Lexer).scanner.LastToken, false)
+		}
+		yyVAL.union = yyLOCAL
+	case 966:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:5514
+		{
+			// the scanner names the placeholders as :v1, :v2, ...
+			offset, _ := strconv.Atoi(yyDollar[1].str[2:])
+			yyLOCAL = tree.NewParamExpr(offset)
+		}
+		yyVAL.union = yyLOCAL
+	case 970:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:5529
+		{
+			yyLOCAL = yyDollar[1].columnTypeUnion()
+			yyLOCAL.InternalType.Unsigned = yyDollar[2].unsignedOptUnion()
+			yyLOCAL.InternalType.Zerofill = yyDollar[3].zeroFillOptUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 974:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:5540
+		{
+			yyLOCAL = yyDollar[1].columnTypeUnion()
+			yyLOCAL.InternalType.DisplayWith = yyDollar[2].lengthOptUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 975:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:5545
+		{
+			yyLOCAL = yyDollar[1].columnTypeUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 976:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:5551
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.BitFamily,
+					FamilyString: yyDollar[1].str,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_BIT),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 977:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:5563
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.BoolFamily,
+					FamilyString: yyDollar[1].str,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_BOOL),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 978:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:5575
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.BoolFamily,
+					FamilyString: yyDollar[1].str,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_BOOL),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 979:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:5587
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.IntFamily,
+					FamilyString: yyDollar[1].str,
+					Width:        8,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_TINY),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 980:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:5600
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.IntFamily,
+					FamilyString: yyDollar[1].str,
+					Width:        8,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_TINY),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 981:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:5613
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.IntFamily,
+					FamilyString: yyDollar[1].str,
+					Width:        16,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_SHORT),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 982:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:5626
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.IntFamily,
+					FamilyString: yyDollar[1].str,
+					Width:        16,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_SHORT),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 983:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:5639
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.IntFamily,
+					FamilyString: yyDollar[1].str,
+					Width:        24,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_INT24),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 984:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:5652
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.IntFamily,
+					FamilyString: yyDollar[1].str,
+					Width:        24,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_INT24),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 985:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:5665
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.IntFamily,
+					FamilyString: yyDollar[1].str,
+					Width:        32,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_LONG),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 986:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:5678
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.IntFamily,
+					FamilyString: yyDollar[1].str,
+					Width:        32,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_LONG),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 987:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:5691
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.IntFamily,
+					FamilyString: yyDollar[1].str,
+					Width:        32,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_LONG),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 988:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:5704
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.IntFamily,
+					FamilyString: yyDollar[1].str,
+					Width:        64,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_LONGLONG),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 989:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:5717
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.IntFamily,
+					FamilyString: yyDollar[1].str,
+					Width:        64,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_LONGLONG),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 990:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:5733
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.IntFamily,
+					FamilyString: "smallint",
+					Width:        16,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_SHORT),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 991:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:5746
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.IntFamily,
+					FamilyString: "int",
+					Width:        32,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_LONG),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 992:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:5759
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.IntFamily,
+					FamilyString: "bigint",
+					Width:        64,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_LONGLONG),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 993:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:5774
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.FloatFamily,
+					FamilyString: yyDollar[1].str,
+					Width:        64,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_DOUBLE),
+					DisplayWith:  tree.NotDefineDisplayWidth,
+					Precision:    tree.NotDefineDec,
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 994:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:5789
+		{
+			locale := ""
+			if yyDollar[2].lengthScaleOptUnion().DisplayWith > 255 {
+				yylex.Error("Display width for double out of range (max = 255)")
+				return 1
+			}
+			if yyDollar[2].lengthScaleOptUnion().Precision != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Precision > yyDollar[2].lengthScaleOptUnion().DisplayWith {
+				yylex.Error("For float(M,D), double(M,D) or decimal(M,D), M must be >= D (column 'a'))")
+				return 1
+			}
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.FloatFamily,
+					FamilyString: yyDollar[1].str,
+					Width:        64,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_DOUBLE),
+					DisplayWith:  yyDollar[2].lengthScaleOptUnion().DisplayWith,
+					Precision:    yyDollar[2].lengthScaleOptUnion().Precision,
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 995:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:5812
+		{
+			locale := ""
+			if yyDollar[2].lengthScaleOptUnion().Precision != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Precision > yyDollar[2].lengthScaleOptUnion().DisplayWith {
+				yylex.Error("For float(M,D), double(M,D) or decimal(M,D), M must be >= D (column 'a'))")
+				return 1
+			}
+			if yyDollar[2].lengthScaleOptUnion().DisplayWith > 53 {
+				yylex.Error("For float(M), M must between 0 and 53.")
+				return 1
+			} else if yyDollar[2].lengthScaleOptUnion().DisplayWith >= 24 {
+				yyLOCAL = &tree.T{
+					InternalType: tree.InternalType{
+						Family:       tree.FloatFamily,
+						FamilyString: yyDollar[1].str,
+						Width:        64,
+						Locale:       &locale,
+						Oid:          uint32(defines.MYSQL_TYPE_DOUBLE),
+						DisplayWith:  yyDollar[2].lengthScaleOptUnion().DisplayWith,
+						Precision:    yyDollar[2].lengthScaleOptUnion().Precision,
+					},
+				}
+			} else {
+				yyLOCAL = &tree.T{
+					InternalType: tree.InternalType{
+						Family:       tree.FloatFamily,
+						FamilyString: yyDollar[1].str,
+						Width:        32,
+						Locale:       &locale,
+						Oid:          uint32(defines.MYSQL_TYPE_FLOAT),
+						DisplayWith:  yyDollar[2].lengthScaleOptUnion().DisplayWith,
+						Precision:    yyDollar[2].lengthScaleOptUnion().Precision,
+					},
+				}
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 996:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:5848
+		{
+			locale := ""
+			if yyDollar[2].lengthScaleOptUnion().Precision != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Precision > yyDollar[2].lengthScaleOptUnion().DisplayWith {
+				yylex.Error("For float(M,D), double(M,D) or decimal(M,D), M must be >= D (column 'a'))")
+				return 1
+			}
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.DecimalFamily,
+					FamilyString: yyDollar[1].str,
+					Width:        64,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_DECIMAL),
+					DisplayWith:  yyDollar[2].lengthScaleOptUnion().DisplayWith,
+					Precision:    yyDollar[2].lengthScaleOptUnion().Precision,
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 997:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:5867
+		{
+			locale := ""
+			if yyDollar[2].lengthScaleOptUnion().Precision != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Precision > yyDollar[2].lengthScaleOptUnion().DisplayWith {
+				yylex.Error("For float(M,D), double(M,D) or decimal(M,D), M must be >= D (column 'a'))")
+				return 1
+			}
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.DecimalFamily,
+					FamilyString: yyDollar[1].str,
+					Width:        64,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_DECIMAL),
+					DisplayWith:  yyDollar[2].lengthScaleOptUnion().DisplayWith,
+					Precision:    yyDollar[2].lengthScaleOptUnion().Precision,
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 998:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:5886
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.FloatFamily,
+					FamilyString: yyDollar[1].str,
+					Width:        64,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_DOUBLE),
+					DisplayWith:  yyDollar[2].lengthScaleOptUnion().DisplayWith,
+					Precision:    yyDollar[2].lengthScaleOptUnion().Precision,
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 999:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:5903
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.DateFamily,
+					FamilyString: yyDollar[1].str,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_DATE),
+				},
+			}
 		}
-		goto yystack
-	}
-
-yydefault:
-	/* default state action */
-	yyn = yyDef[yystate]
-	if yyn == -2 {
-		if yyrcvr.char < 0 {
-			yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
+		yyVAL.union = yyLOCAL
+	case 1000:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:5915
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:             tree.TimeFamily,
+					FamilyString:       yyDollar[1].str,
+					DisplayWith:        yyDollar[2].lengthOptUnion(),
+					Precision:          0,
+					TimePrecisionIsSet: false,
+					Locale:             &locale,
+					Oid:                uint32(defines.MYSQL_TYPE_TIME),
+				},
+			}
 		}
-
-		/* look through exception table */
-		xi := 0
-		for {
-			if yyExca[xi+0] == -1 && yyExca[xi+1] == yystate {
-				break
+		yyVAL.union = yyLOCAL
+	case 1001:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:5930
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:             tree.TimestampFamily,
+					Precision:          0,
+					FamilyString:       yyDollar[1].str,
+					DisplayWith:        yyDollar[2].lengthOptUnion(),
+					TimePrecisionIsSet: false,
+					Locale:             &locale,
+					Oid:                uint32(defines.MYSQL_TYPE_TIMESTAMP),
+				},
 			}
-			xi += 2
 		}
-		for xi += 2; ; xi += 2 {
-			yyn = yyExca[xi+0]
-			if yyn < 0 || yyn == yytoken {
-				break
+		yyVAL.union = yyLOCAL
+	case 1002:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:5945
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:             tree.TimestampFamily,
+					Precision:          0,
+					FamilyString:       yyDollar[1].str,
+					DisplayWith:        yyDollar[2].lengthOptUnion(),
+					TimePrecisionIsSet: false,
+					Locale:             &locale,
+					Oid:                uint32(defines.MYSQL_TYPE_DATETIME),
+				},
 			}
 		}
-		yyn = yyExca[xi+1]
-		if yyn < 0 {
-			goto ret0
+		yyVAL.union = yyLOCAL
+	case 1003:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:5960
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.IntFamily,
+					FamilyString: yyDollar[1].str,
+					DisplayWith:  yyDollar[2].lengthOptUnion(),
+					Width:        16,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_YEAR),
+				},
+			}
 		}
-	}
-	if yyn == 0 {
-		/* error ... attempt to resume parsing */
-		switch Errflag {
-		case 0: /* brand new error */
-			yylex.Error(yyErrorMessage(yystate, yytoken))
-			Nerrs++
-			if yyDebug >= 1 {
-				__yyfmt__.Printf("%s", yyStatname(yystate))
-				__yyfmt__.Printf(" saw %s\n", yyTokname(yytoken))
+		yyVAL.union = yyLOCAL
+	case 1004:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:5976
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.StringFamily,
+					FamilyString: yyDollar[1].str,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_VARCHAR),
+					DisplayWith:  yyDollar[2].lengthOptUnion(),
+				},
 			}
-			fallthrough
-
-		case 1, 2: /* incompletely recovered error ... try again */
-			Errflag = 3
-
-			/* find a state where "error" is a legal shift action */
-			for yyp >= 0 {
-				yyn = yyPact[yyS[yyp].yys] + yyErrCode
-				if yyn >= 0 && yyn < yyLast {
-					yystate = yyAct[yyn] /* simulate a shift of "error" */
-					if yyChk[yystate] == yyErrCode {
-						goto yystack
-					}
-				}
-
-				/* the current p has no shift on "error", pop stack */
-				if yyDebug >= 2 {
-					__yyfmt__.Printf("error recovery pops state %d\n", yyS[yyp].yys)
-				}
-				yyp--
+		}
+		yyVAL.union = yyLOCAL
+	case 1005:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:5989
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.StringFamily,
+					Locale:       &locale,
+					FamilyString: yyDollar[1].str,
+					DisplayWith:  yyDollar[2].lengthOptUnion(),
+					Oid:          uint32(defines.MYSQL_TYPE_VARCHAR),
+				},
 			}
-			/* there is no state on the stack with an error shift ... abort */
-			goto ret1
-
-		case 3: /* no shift yet; clobber input char */
-			if yyDebug >= 2 {
-				__yyfmt__.Printf("error recovery discards %s\n", yyTokname(yytoken))
+		}
+		yyVAL.union = yyLOCAL
+	case 1006:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:6002
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.StringFamily,
+					FamilyString: yyDollar[1].str,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_VARCHAR),
+					DisplayWith:  yyDollar[2].lengthOptUnion(),
+				},
 			}
-			if yytoken == yyEofCode {
-				goto ret1
+		}
+		yyVAL.union = yyLOCAL
+	case 1007:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:6015
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.StringFamily,
+					Locale:       &locale,
+					FamilyString: yyDollar[1].str,
+					DisplayWith:  yyDollar[2].lengthOptUnion(),
+					Oid:          uint32(defines.MYSQL_TYPE_VARCHAR),
+				},
 			}
-			yyrcvr.char = -1
-			yytoken = -1
-			goto yynewstate /* try again in the same state */
 		}
-	}
-
-	/* reduction by production yyn */
-	if yyDebug >= 2 {
-		__yyfmt__.Printf("reduce %v in:\n\t%v\n", yyn, yyStatname(yystate))
-	}
-
-	yynt := yyn
-	yypt := yyp
-	_ = yypt // guard against "declared and not used"
-
-	yyp -= yyR2[yyn]
-	// yyp is now the index of $0. Perform the default action. Iff the
-	// reduced production is ε, $1 is possibly out of range.
-	if yyp+1 >= len(yyS) {
-		nyys := make([]yySymType, len(yyS)*2)
-		copy(nyys, yyS)
-		yyS = nyys
-	}
-	yyVAL = yyS[yyp+1]
-
-	/* consult goto table to find next state */
-	yyn = yyR1[yyn]
-	yyg := yyPgo[yyn]
-	yyj := yyg + yyS[yyp].yys + 1
-
-	if yyj >= yyLast {
-		yystate = yyAct[yyg]
-	} else {
-		yystate = yyAct[yyj]
-		if yyChk[yystate] != -yyn {
-			yystate = yyAct[yyg]
+		yyVAL.union = yyLOCAL
+	case 1008:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:6028
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.BlobFamily,
+					FamilyString: yyDollar[1].str,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_BLOB),
+				},
+			}
 		}
-	}
-	// dummy call; replaced with literal code
-	switch yynt {
-
-	case 2:
+		yyVAL.union = yyLOCAL
+	case 1009:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line postgresql_sql.y:50
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:6040
 		{
-			if yyDollar[1].statementUnion() != nil {
-				yylex.(*Lexer).AppendStmt(yyDollar[1].statementUnion())
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.BlobFamily,
+					FamilyString: yyDollar[1].str,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_TINY_BLOB),
+				},
 			}
 		}
-	case 3:
+		yyVAL.union = yyLOCAL
+	case 1010:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:6052
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.BlobFamily,
+					FamilyString: yyDollar[1].str,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_MEDIUM_BLOB),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1011:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:6064
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.BlobFamily,
+					FamilyString: yyDollar[1].str,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_LONG_BLOB),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1012:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:6076
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.BlobFamily,
+					FamilyString: yyDollar[1].str,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_BLOB),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1013:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:6088
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.BlobFamily,
+					FamilyString: "blob",
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_BLOB),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1014:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:6100
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.BlobFamily,
+					FamilyString: yyDollar[1].str,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_TINY_BLOB),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1015:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:6112
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.BlobFamily,
+					FamilyString: yyDollar[1].str,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_MEDIUM_BLOB),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1016:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:6124
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.BlobFamily,
+					FamilyString: yyDollar[1].str,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_LONG_BLOB),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1017:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:6136
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.JsonFamily,
+					FamilyString: yyDollar[1].str,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_JSON),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1018:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:6148
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.EnumFamily,
+					FamilyString: yyDollar[1].str,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_ENUM),
+					EnumValues:   yyDollar[3].strsUnion(),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1019:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:6161
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.SetFamily,
+					FamilyString: yyDollar[1].str,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_SET),
+					EnumValues:   yyDollar[3].strsUnion(),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1020:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:6176
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.GeometryFamily,
+					FamilyString: yyDollar[1].str,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_GEOMETRY),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1021:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []string
+//line postgresql_sql.y:6199
+		{
+			yyLOCAL = make([]string, 0, 4)
+			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 1022:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line postgresql_sql.y:56
+		var yyLOCAL []string
+//line postgresql_sql.y:6204
 		{
-			if yyDollar[3].statementUnion() != nil {
-				yylex.(*Lexer).AppendStmt(yyDollar[3].statementUnion())
+			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 1023:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL int32
+//line postgresql_sql.y:6210
+		{
+			yyLOCAL = 0
+		}
+		yyVAL.union = yyLOCAL
+	case 1025:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL int32
+//line postgresql_sql.y:6216
+		{
+			yyLOCAL = -1
+		}
+		yyVAL.union = yyLOCAL
+	case 1026:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL int32
+//line postgresql_sql.y:6220
+		{
+			yyLOCAL = int32(yyDollar[2].item.(int64))
+		}
+		yyVAL.union = yyLOCAL
+	case 1027:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL int32
+//line postgresql_sql.y:6226
+		{
+			yyLOCAL = tree.GetDisplayWith(int32(yyDollar[2].item.(int64)))
+		}
+		yyVAL.union = yyLOCAL
+	case 1028:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.LengthScaleOpt
+//line postgresql_sql.y:6232
+		{
+			yyLOCAL = tree.LengthScaleOpt{
+				DisplayWith: tree.NotDefineDisplayWidth,
+				Precision:   tree.NotDefineDec,
 			}
 		}
-	case 5:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL tree.Statement
-//line postgresql_sql.y:67
+		yyVAL.union = yyLOCAL
+	case 1029:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.LengthScaleOpt
+//line postgresql_sql.y:6239
 		{
-			yyLOCAL = &tree.Use{Name: yyDollar[2].str}
+			yyLOCAL = tree.LengthScaleOpt{
+				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
+				Precision:   int32(yyDollar[4].item.(int64)),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1030:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.LengthScaleOpt
+//line postgresql_sql.y:6248
+		{
+			yyLOCAL = tree.LengthScaleOpt{
+				DisplayWith: tree.NotDefineDisplayWidth,
+				Precision:   tree.NotDefineDec,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1031:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.LengthScaleOpt
+//line postgresql_sql.y:6255
+		{
+			yyLOCAL = tree.LengthScaleOpt{
+				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
+				Precision:   tree.NotDefineDec,
+			}
 		}
 		yyVAL.union = yyLOCAL
-	case 6:
+	case 1032:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.LengthScaleOpt
+//line postgresql_sql.y:6262
+		{
+			yyLOCAL = tree.LengthScaleOpt{
+				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
+				Precision:   int32(yyDollar[4].item.(int64)),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1033:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line postgresql_sql.y:6271
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 1034:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL tree.Statement
-//line postgresql_sql.y:71
+		var yyLOCAL bool
+//line postgresql_sql.y:6275
+		{
+			yyLOCAL = true
+		}
+		yyVAL.union = yyLOCAL
+	case 1035:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL bool
+//line postgresql_sql.y:6279
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 1036:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line postgresql_sql.y:6285
 		{
-			yyLOCAL = &tree.Use{}
+		}
+	case 1037:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL bool
+//line postgresql_sql.y:6287
+		{
+			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
+	case 1041:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line postgresql_sql.y:6297
+		{
+			yyVAL.str = ""
+		}
+	case 1042:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line postgresql_sql.y:6301
+		{
+			yyVAL.str = string(yyDollar[1].str)
+		}
 	}
 	goto yystack /* stack new state and value */
 }
@@ -44,3 +44,141 @@ func TestDebug(t *testing.T) {
 		t.Errorf("Parsing failed. \nExpected/Got:\n%s\n%s", debugSQL.output, out)
 	}
 }
+
+var (
+	validSQL = []struct {
+		input  string
+		output string
+	}{{
+		input: "select a, b from t where a > 1 order by b limit 10 offset 5",
+	}, {
+		input: "select a from t offset 5",
+	}, {
+		input:  "select a from t offset 5 limit 10",
+		output: "select a from t limit 10 offset 5",
+	}, {
+		input:  "select a from t limit all offset 5",
+		output: "select a from t offset 5",
+	}, {
+		input:  "select a from t where d < date '1998-12-01' - interval '90 days'",
+		output: "select a from t where d < date(1998-12-01) - interval 90 days",
+	}, {
+		input:  "select a::int, b::varchar(10), -c::double from t",
+		output: "select cast(a as int), cast(b as varchar(10)), -cast(c as double) from t",
+	}, {
+		input:  "select * from t where a ilike 'x%' and b not ilike '%y'",
+		output: "select * from t where a ilike x% and b not ilike %y",
+	}, {
+		input:  "select \"A b\", \"t\".\"c\" from \"T\"",
+		output: "select A b, t.c from T",
+	}, {
+		input:  "select * from t where a = $1 and b in ($2, $3)",
+		output: "select * from t where a = ? and b in (?, ?)",
+	}, {
+		input: "insert into t (a, b) values (1, 2) returning a, b as c",
+	}, {
+		input: "insert into t select * from s returning *",
+	}, {
+		input: "create table t (a int primary key, b varchar(20) not null)",
+	}, {
+		input: "drop table if exists t, s",
+	}, {
+		input:  "create schema if not exists db",
+		output: "create database if not exists db",
+	}, {
+		input:  "drop schema if exists db",
+		output: "drop database if exists db",
+	}, {
+		input: "drop database db",
+	}, {
+		input:  "copy t from '/tmp/t.txt'",
+		output: "load data infile /tmp/t.txt into table t fields terminated by \t lines terminated by \n",
+	}, {
+		input:  "copy t (a, b) from '/tmp/t.csv' with (format csv, header, delimiter '|')",
+		output: "load data infile /tmp/t.csv into table t fields terminated by | enclosed by \" lines terminated by \n ignore 1 lines (a, b)",
+	}, {
+		input:  "copy t from '/tmp/t.parquet' (format parquet)",
+		output: "load data infile /tmp/t.parquet format parquet into table t fields terminated by , enclosed by \" lines terminated by \n",
+	}, {
+		input:  "copy t (a, b) to '/tmp/t.csv' (format csv, header true)",
+		output: "select a, b from t into outfile /tmp/t.csv fields terminated by , enclosed by \" lines terminated by \n header true",
+	}, {
+		input:  "copy (select a from t where b = 1) to '/tmp/t.txt'",
+		output: "(select a from t where b = 1) into outfile /tmp/t.txt fields terminated by \t lines terminated by \n header false",
+	}, {
+		input:  "copy t (a, b) to stdout",
+		output: "select a, b from t into stdout fields terminated by \t lines terminated by \n header false",
+	}, {
+		input:  "copy (select a from t) to stdout with (format csv, header)",
+		output: "(select a from t) into stdout fields terminated by , enclosed by \" lines terminated by \n header true",
+	}, {
+		input:  "select 'a' || b || 'c' from t where b || 'x' = 'yx'",
+		output: "select concat(concat(a, b), c) from t where concat(b, x) = yx",
+	}, {
+		input:  "select E'a\\tb', e'it\\'s', 'c\\d', 'it''s' from t",
+		output: "select a\tb, it's, c\\d, it's from t",
+	}, {
+		input:  "create table t (a double precision, b bytea)",
+		output: "create table t (a double, b blob)",
+	}, {
+		input:  "create table t (a serial primary key, b smallserial, c bigserial)",
+		output: "create table t (a int not null auto_increment primary key, b smallint not null auto_increment, c bigint not null auto_increment)",
+	}, {
+		input:  "create table t (a int generated always as identity, b bigint generated by default as identity not null)",
+		output: "create table t (a int auto_increment, b bigint auto_increment not null)",
+	}, {
+		input:  "select a from t limit all",
+		output: "select a from t",
+	}, {
+		input:  "select a from t offset 5 limit all",
+		output: "select a from t offset 5",
+	}, {
+		input: "select a, b from t order by a desc nulls last, b nulls first",
+	}, {
+		input: "select * from t where a is distinct from b and c is not distinct from null",
+	}}
+)
+
+func TestValid(t *testing.T) {
+	for _, tcase := range validSQL {
+		if tcase.output == "" {
+			tcase.output = tcase.input
+		}
+		ast, err := ParseOne(tcase.input)
+		if err != nil {
+			t.Errorf("Parse(%q) err: %v", tcase.input, err)
+			continue
+		}
+		out := tree.String(ast, dialect.POSTGRESQL)
+		if tcase.output != out {
+			t.Errorf("Parsing failed. \nExpected/Got:\n%s\n%s", tcase.output, out)
+		}
+	}
+}
+
+var (
+	invalidSQL = []struct {
+		input string
+	}{{
+		input: "copy t from '/tmp/t.txt' (format binary)",
+	}, {
+		input: "copy t from '/tmp/t.txt' (quote '\"')",
+	}, {
+		input: "copy t from '/tmp/t.csv' (format csv, delimiter '')",
+	}, {
+		input: "copy t to stdout (format parquet)",
+	}, {
+		input: "select `a` from t",
+	}, {
+		input: "create table `t` (a int)",
+	}}
+)
+
+func TestInvalid(t *testing.T) {
+	for _, tcase := range invalidSQL {
+		_, err := ParseOne(tcase.input)
+		if err == nil {
+			t.Errorf("Parse(%q) should be failed", tcase.input)
+		}
+	}
+}
@@ -39,7 +39,8 @@ type Scanner struct {
 func NewScanner(dialectType dialect.DialectType, sql string) *Scanner {
 	initTokens(dialectType)
 	return &Scanner{
-		buf: sql,
+		buf:         sql,
+		dialectType: dialectType,
 	}
 }
 
@@ -69,7 +70,7 @@ func (s *Scanner) Scan() (int, string) {
 		var tBytes string
 		if s.cur() == '`' {
 			s.skip(1)
-			tID, tBytes = s.scanLiteralIdentifier()
+			tID, tBytes = s.scanLiteralIdentifier('`')
 		} else if s.cur() == eofChar {
 			return LEX_ERROR, ""
 		} else {
@@ -79,6 +80,8 @@ func (s *Scanner) Scan() (int, string) {
 			return tID, ""
 		}
 		return tokenID, tBytes
+	case ch == '$' && s.dialectType == dialect.POSTGRESQL && isDigit(s.peek(1)):
+		return s.scanPositionalArg()
 	case isLetter(ch):
 		if ch == 'X' || ch == 'x' {
 			if s.peek(1) == '\'' {
@@ -92,6 +95,11 @@ func (s *Scanner) Scan() (int, string) {
 				return s.scanBitLiteral()
 			}
 		}
+		// postgresql's escape string constant, like E'a\tb'
+		if (ch == 'E' || ch == 'e') && s.peek(1) == '\'' && s.dialectType == dialect.POSTGRESQL {
+			s.skip(2)
+			return s.scanString('\'', STRING)
+		}
 		return s.scanIdentifier(false)
 	case isDigit(ch):
 		return s.scanNumber()
@@ -100,6 +108,10 @@ func (s *Scanner) Scan() (int, string) {
 			s.skip(2)
 			return ASSIGNMENT, ""
 		}
+		if s.peek(1) == ':' && s.dialectType == dialect.POSTGRESQL {
+			s.skip(2)
+			return TYPECAST, ""
+		}
 		// Like mysql -h ::1 ?
 		return s.scanBindVar()
 	case ch == ';':
@@ -154,6 +166,10 @@ func (s *Scanner) stepBackOneChar(ch uint16) (int, string) {
 	case '|':
 		if s.cur() == '|' {
 			s.skip(1)
+			// postgresql's || is the string concatenation
+			if s.dialectType == dialect.POSTGRESQL {
+				return CONCAT_OP, ""
+			}
 			return OR, ""
 		}
 		return int(ch), ""
@@ -221,10 +237,23 @@ func (s *Scanner) stepBackOneChar(ch uint16) (int, string) {
 			return NE, ""
 		}
 		return int(ch), ""
-	case '\'', '"':
+	case '"':
+		// postgresql quotes the identifiers by double quotes
+		if s.dialectType == dialect.POSTGRESQL {
+			return s.scanLiteralIdentifier('"')
+		}
+		return s.scanString(ch, STRING)
+	case '\'':
+		// backslashes are ordinary characters in the standard strings of postgresql
+		if s.dialectType == dialect.POSTGRESQL {
+			return s.scanStandardString()
+		}
 		return s.scanString(ch, STRING)
 	case '`':
-		return s.scanLiteralIdentifier()
+		if s.dialectType == dialect.POSTGRESQL {
+			return LEX_ERROR, string(byte(ch))
+		}
+		return s.scanLiteralIdentifier('`')
 	default:
 		return LEX_ERROR, string(byte(ch))
 	}
@@ -307,6 +336,28 @@ func (s *Scanner) scanStringSlow(buffer *strings.Builder, delim uint16, typ int)
 	return typ, buffer.String()
 }
 
+// scanStandardString scans a string surrounded by single quotes in which
+// only the doubled quote is an escape sequence.
+func (s *Scanner) scanStandardString() (int, string) {
+	var buffer strings.Builder
+
+	start := s.Pos
+	for {
+		switch s.cur() {
+		case '\'':
+			buffer.WriteString(s.buf[start:s.Pos])
+			s.skip(1)
+			if s.cur() != '\'' {
+				return STRING, buffer.String()
+			}
+			start = s.Pos
+		case eofChar:
+			return LEX_ERROR, s.buf[start:s.Pos]
+		}
+		s.skip(1)
+	}
+}
+
 var encodeRef = map[byte]byte{
 	'0':    '\x00',
 	'\'':   '\'',
@@ -319,15 +370,16 @@ var encodeRef = map[byte]byte{
 	'\\':   '\\',
 }
 
-// scanLiteralIdentifier scans an identifier enclosed by backticks. If the identifier
-// is a simple literal, it'll be returned as a slice of the input buffer. If the identifier
-// contains escape sequences, this function will fall back to scanLiteralIdentifierSlow
-func (s *Scanner) scanLiteralIdentifier() (int, string) {
+// scanLiteralIdentifier scans an identifier enclosed by backticks, or double quotes
+// of postgresql. If the identifier is a simple literal, it'll be returned as a slice
+// of the input buffer. If the identifier contains escape sequences, this function
+// will fall back to scanLiteralIdentifierSlow
+func (s *Scanner) scanLiteralIdentifier(delim uint16) (int, string) {
 	start := s.Pos
 	for {
 		switch s.cur() {
-		case '`':
-			if s.peek(1) != '`' {
+		case delim:
+			if s.peek(1) != delim {
 				if s.Pos == start {
 					return LEX_ERROR, ""
 				}
@@ -338,7 +390,7 @@ func (s *Scanner) scanLiteralIdentifier() (int, string) {
 			var buf strings.Builder
 			buf.WriteString(s.buf[start:s.Pos])
 			s.skip(1)
-			return s.scanLiteralIdentifierSlow(&buf)
+			return s.scanLiteralIdentifierSlow(&buf, delim)
 		case eofChar:
 			// Premature EOF.
 			return LEX_ERROR, s.buf[start:s.Pos]
@@ -353,21 +405,21 @@ func (s *Scanner) scanLiteralIdentifier() (int, string) {
 // scanLiteralIdentifier once the first escape sequence is found in the identifier.
 // The provided `buf` contains the contents of the identifier that have been scanned
 // so far.
-func (s *Scanner) scanLiteralIdentifierSlow(buf *strings.Builder) (int, string) {
+func (s *Scanner) scanLiteralIdentifierSlow(buf *strings.Builder, delim uint16) (int, string) {
 	backTickSeen := true
 	for {
 		if backTickSeen {
-			if s.cur() != '`' {
+			if s.cur() != delim {
 				break
 			}
 			backTickSeen = false
-			buf.WriteByte('`')
+			buf.WriteByte(byte(delim))
 			s.skip(1)
 			continue
 		}
 		// The previous char was not a backtick.
 		switch s.cur() {
-		case '`':
+		case delim:
 			backTickSeen = true
 		case eofChar:
 			// Premature EOF.
@@ -488,6 +540,17 @@ func (s *Scanner) scanBindVar() (int, string) {
 	return token, s.buf[start:s.Pos]
 }
 
+// scanPositionalArg scans a positional parameter of postgresql like $1, and names it
+// as the placeholders of mysql, assumes the '$' has not been scanned
+func (s *Scanner) scanPositionalArg() (int, string) {
+	s.skip(1)
+	start := s.Pos
+	for isDigit(s.cur()) {
+		s.skip(1)
+	}
+	return VALUE_ARG, ":v" + s.buf[start:s.Pos]
+}
+
 // scanNumber scans any SQL numeric literal, either floating point or integer
 func (s *Scanner) scanNumber() (int, string) {
 	start := s.Pos
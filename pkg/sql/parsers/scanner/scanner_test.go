@@ -166,3 +166,43 @@ func TestBuffer(t *testing.T) {
 		}
 	}
 }
+
+func TestPostgresql(t *testing.T) {
+	initTokens(dialect.POSTGRESQL)
+	testcases := []struct {
+		in   string
+		id   int
+		want string
+	}{{
+		in:   `"aa"`,
+		id:   ID,
+		want: "aa",
+	}, {
+		in:   `"a""b"`,
+		id:   ID,
+		want: `a"b`,
+	}, {
+		in:   `"a`,
+		id:   LEX_ERROR,
+		want: "a",
+	}, {
+		in:   "::int",
+		id:   TYPECAST,
+		want: "",
+	}, {
+		in:   "$12",
+		id:   VALUE_ARG,
+		want: ":v12",
+	}, {
+		in:   "ilike",
+		id:   ILIKE,
+		want: "ilike",
+	}}
+
+	for _, tcase := range testcases {
+		id, got := NewScanner(dialect.POSTGRESQL, tcase.in).Scan()
+		if tcase.id != id || string(got) != tcase.want {
+			t.Errorf("Scan(%q) = (%s, %q), want (%s, %q)", tcase.in, tokenName(id), got, tokenName(tcase.id), tcase.want)
+		}
+	}
+}
@@ -148,6 +148,7 @@ func initTokens(dialectType dialect.DialectType) {
 		SHUTDOWN = MYSQL_SHUTDOWN
 		REVOKE = MYSQL_REVOKE
 		EXCEPT = MYSQL_EXCEPT
+		INTERSECT = MYSQL_INTERSECT
 		LOCAL = MYSQL_LOCAL
 		ASSIGNMENT = MYSQL_ASSIGNMENT
 		NO = MYSQL_NO
@@ -413,16 +414,432 @@ func initTokens(dialectType dialect.DialectType) {
 		WITH = MYSQL_WITH
 		QUERY = MYSQL_QUERY
 		EXPANSION = MYSQL_EXPANSION
+		OVER = MYSQL_OVER
+		ROWS = MYSQL_ROWS
+		CURRENT = MYSQL_CURRENT
+		UNBOUNDED = MYSQL_UNBOUNDED
+		PRECEDING = MYSQL_PRECEDING
+		FOLLOWING = MYSQL_FOLLOWING
 		UNUSED = MYSQL_UNUSED
 
 	case dialect.POSTGRESQL:
+		LEX_ERROR = POSTGRESQL_LEX_ERROR
+		TYPE = POSTGRESQL_TYPE
+		ZONEMAP = POSTGRESQL_ZONEMAP
+		BSI = POSTGRESQL_BSI
+		ROW = POSTGRESQL_ROW
+		PROPERTIES = POSTGRESQL_PROPERTIES
+		INT1 = POSTGRESQL_INT1
+		INT2 = POSTGRESQL_INT2
+		INT3 = POSTGRESQL_INT3
+		INT4 = POSTGRESQL_INT4
+		INT8 = POSTGRESQL_INT8
+		LINES = POSTGRESQL_LINES
+		STARTING = POSTGRESQL_STARTING
+		TERMINATED = POSTGRESQL_TERMINATED
+		OPTIONALLY = POSTGRESQL_OPTIONALLY
+		ENCLOSED = POSTGRESQL_ENCLOSED
+		ESCAPED = POSTGRESQL_ESCAPED
+		LOAD = POSTGRESQL_LOAD
+		INFILE = POSTGRESQL_INFILE
+		OUTFILE = POSTGRESQL_OUTFILE
+		HEADER = POSTGRESQL_HEADER
+		MAX_FILE_SIZE = POSTGRESQL_MAX_FILE_SIZE
+		FORCE_QUOTE = POSTGRESQL_FORCE_QUOTE
+		AVG = POSTGRESQL_AVG
+		ADDDATE = POSTGRESQL_ADDDATE
+		COUNT = POSTGRESQL_COUNT
+		APPROX_COUNT_DISTINCT = POSTGRESQL_APPROX_COUNT_DISTINCT
+		CURDATE = POSTGRESQL_CURDATE
+		DATE_ADD = POSTGRESQL_DATE_ADD
+		DATE_SUB = POSTGRESQL_DATE_SUB
+		EXTRACT = POSTGRESQL_EXTRACT
+		MAX = POSTGRESQL_MAX
+		MID = POSTGRESQL_MID
+		NOW = POSTGRESQL_NOW
+		POSITION = POSTGRESQL_POSITION
+		SESSION_USER = POSTGRESQL_SESSION_USER
+		STD = POSTGRESQL_STD
+		STDDEV = POSTGRESQL_STDDEV
+		STDDEV_POP = POSTGRESQL_STDDEV_POP
+		STDDEV_SAMP = POSTGRESQL_STDDEV_SAMP
+		SUBDATE = POSTGRESQL_SUBDATE
+		SUM = POSTGRESQL_SUM
+		SYSTEM_USER = POSTGRESQL_SYSTEM_USER
+		TRANSLATE = POSTGRESQL_TRANSLATE
+		TRIM = POSTGRESQL_TRIM
+		VARIANCE = POSTGRESQL_VARIANCE
+		VAR_POP = POSTGRESQL_VAR_POP
+		VAR_SAMP = POSTGRESQL_VAR_SAMP
+		CURTIME = POSTGRESQL_CURTIME
+		SYSDATE = POSTGRESQL_SYSDATE
+		QUARTER = POSTGRESQL_QUARTER
+		REPEAT = POSTGRESQL_REPEAT
+		REVERSE = POSTGRESQL_REVERSE
+		ROW_COUNT = POSTGRESQL_ROW_COUNT
+		WEEK = POSTGRESQL_WEEK
+		ASCII = POSTGRESQL_ASCII
+		COALESCE = POSTGRESQL_COALESCE
+		COLLATION = POSTGRESQL_COLLATION
+		HOUR = POSTGRESQL_HOUR
+		MICROSECOND = POSTGRESQL_MICROSECOND
+		MINUTE = POSTGRESQL_MINUTE
+		MONTH = POSTGRESQL_MONTH
+		CURRENT_ROLE = POSTGRESQL_CURRENT_ROLE
+		CURRENT_USER = POSTGRESQL_CURRENT_USER
+		SECOND = POSTGRESQL_SECOND
+		SUBPARTITION = POSTGRESQL_SUBPARTITION
+		SUBPARTITIONS = POSTGRESQL_SUBPARTITIONS
+		PARTITIONS = POSTGRESQL_PARTITIONS
+		LINEAR = POSTGRESQL_LINEAR
+		ALGORITHM = POSTGRESQL_ALGORITHM
+		LIST = POSTGRESQL_LIST
+		RANGE = POSTGRESQL_RANGE
+		CHECK = POSTGRESQL_CHECK
+		ENFORCED = POSTGRESQL_ENFORCED
+		RESTRICT = POSTGRESQL_RESTRICT
+		CASCADE = POSTGRESQL_CASCADE
+		ACTION = POSTGRESQL_ACTION
+		PARTIAL = POSTGRESQL_PARTIAL
+		SIMPLE = POSTGRESQL_SIMPLE
+		AUTO_RANDOM = POSTGRESQL_AUTO_RANDOM
+		COLUMN_FORMAT = POSTGRESQL_COLUMN_FORMAT
+		CHECKSUM = POSTGRESQL_CHECKSUM
+		COMPRESSION = POSTGRESQL_COMPRESSION
+		COMPRESSED = POSTGRESQL_COMPRESSED
+		DATA = POSTGRESQL_DATA
+		DIRECTORY = POSTGRESQL_DIRECTORY
+		DELAY_KEY_WRITE = POSTGRESQL_DELAY_KEY_WRITE
+		ENCRYPTION = POSTGRESQL_ENCRYPTION
+		ENGINE = POSTGRESQL_ENGINE
+		MIN_ROWS = POSTGRESQL_MIN_ROWS
+		MAX_ROWS = POSTGRESQL_MAX_ROWS
+		PACK_KEYS = POSTGRESQL_PACK_KEYS
+		ROW_FORMAT = POSTGRESQL_ROW_FORMAT
+		STATS_AUTO_RECALC = POSTGRESQL_STATS_AUTO_RECALC
+		STATS_PERSISTENT = POSTGRESQL_STATS_PERSISTENT
+		STATS_SAMPLE_PAGES = POSTGRESQL_STATS_SAMPLE_PAGES
+		DYNAMIC = POSTGRESQL_DYNAMIC
+		FIXED = POSTGRESQL_FIXED
+		REDUNDANT = POSTGRESQL_REDUNDANT
+		COMPACT = POSTGRESQL_COMPACT
+		STORAGE = POSTGRESQL_STORAGE
+		DISK = POSTGRESQL_DISK
+		MEMORY = POSTGRESQL_MEMORY
+		AVG_ROW_LENGTH = POSTGRESQL_AVG_ROW_LENGTH
+		PROXY = POSTGRESQL_PROXY
+		FUNCTION = POSTGRESQL_FUNCTION
+		PRIVILEGES = POSTGRESQL_PRIVILEGES
+		TABLESPACE = POSTGRESQL_TABLESPACE
+		EXECUTE = POSTGRESQL_EXECUTE
+		SUPER = POSTGRESQL_SUPER
+		GRANT = POSTGRESQL_GRANT
+		OPTION = POSTGRESQL_OPTION
+		REFERENCES = POSTGRESQL_REFERENCES
+		REPLICATION = POSTGRESQL_REPLICATION
+		SLAVE = POSTGRESQL_SLAVE
+		CLIENT = POSTGRESQL_CLIENT
+		USAGE = POSTGRESQL_USAGE
+		RELOAD = POSTGRESQL_RELOAD
+		FILE = POSTGRESQL_FILE
+		TEMPORARY = POSTGRESQL_TEMPORARY
+		ROUTINE = POSTGRESQL_ROUTINE
+		EVENT = POSTGRESQL_EVENT
+		SHUTDOWN = POSTGRESQL_SHUTDOWN
+		REVOKE = POSTGRESQL_REVOKE
+		EXCEPT = POSTGRESQL_EXCEPT
+		INTERSECT = POSTGRESQL_INTERSECT
+		LOCAL = POSTGRESQL_LOCAL
+		ASSIGNMENT = POSTGRESQL_ASSIGNMENT
+		NO = POSTGRESQL_NO
+		CHAIN = POSTGRESQL_CHAIN
+		RELEASE = POSTGRESQL_RELEASE
+		WORK = POSTGRESQL_WORK
+		CONSISTENT = POSTGRESQL_CONSISTENT
+		SNAPSHOT = POSTGRESQL_SNAPSHOT
+		CONNECTION = POSTGRESQL_CONNECTION
+		FORMAT = POSTGRESQL_FORMAT
+		EXPIRE = POSTGRESQL_EXPIRE
+		ACCOUNT = POSTGRESQL_ACCOUNT
+		UNLOCK = POSTGRESQL_UNLOCK
+		DAY = POSTGRESQL_DAY
+		NEVER = POSTGRESQL_NEVER
+		INDEXES = POSTGRESQL_INDEXES
+		WARNINGS = POSTGRESQL_WARNINGS
+		ERRORS = POSTGRESQL_ERRORS
+		OPEN = POSTGRESQL_OPEN
+		FIELDS = POSTGRESQL_FIELDS
+		COLUMNS = POSTGRESQL_COLUMNS
+		PASSWORD = POSTGRESQL_PASSWORD
+		MAX_QUERIES_PER_HOUR = POSTGRESQL_MAX_QUERIES_PER_HOUR
+		MAX_UPDATES_PER_HOUR = POSTGRESQL_MAX_UPDATES_PER_HOUR
+		MAX_CONNECTIONS_PER_HOUR = POSTGRESQL_MAX_CONNECTIONS_PER_HOUR
+		MAX_USER_CONNECTIONS = POSTGRESQL_MAX_USER_CONNECTIONS
+		CIPHER = POSTGRESQL_CIPHER
+		ISSUER = POSTGRESQL_ISSUER
+		X509 = POSTGRESQL_X509
+		SUBJECT = POSTGRESQL_SUBJECT
+		SAN = POSTGRESQL_SAN
+		REQUIRE = POSTGRESQL_REQUIRE
+		SSL = POSTGRESQL_SSL
+		NONE = POSTGRESQL_NONE
+		IDENTIFIED = POSTGRESQL_IDENTIFIED
+		USER = POSTGRESQL_USER
+		AT_ID = POSTGRESQL_AT_ID
+		AT_AT_ID = POSTGRESQL_AT_AT_ID
+		ROLE = POSTGRESQL_ROLE
+		PARSER = POSTGRESQL_PARSER
+		VISIBLE = POSTGRESQL_VISIBLE
+		INVISIBLE = POSTGRESQL_INVISIBLE
+		BTREE = POSTGRESQL_BTREE
+		HASH = POSTGRESQL_HASH
+		RTREE = POSTGRESQL_RTREE
+		UNION = POSTGRESQL_UNION
+		SELECT = POSTGRESQL_SELECT
+		STREAM = POSTGRESQL_STREAM
+		INSERT = POSTGRESQL_INSERT
+		UPDATE = POSTGRESQL_UPDATE
+		DELETE = POSTGRESQL_DELETE
+		FROM = POSTGRESQL_FROM
+		WHERE = POSTGRESQL_WHERE
+		GROUP = POSTGRESQL_GROUP
+		HAVING = POSTGRESQL_HAVING
+		ORDER = POSTGRESQL_ORDER
+		BY = POSTGRESQL_BY
+		LIMIT = POSTGRESQL_LIMIT
+		OFFSET = POSTGRESQL_OFFSET
+		FOR = POSTGRESQL_FOR
+		ALL = POSTGRESQL_ALL
+		DISTINCT = POSTGRESQL_DISTINCT
+		AS = POSTGRESQL_AS
+		EXISTS = POSTGRESQL_EXISTS
+		ASC = POSTGRESQL_ASC
+		DESC = POSTGRESQL_DESC
+		INTO = POSTGRESQL_INTO
+		DUPLICATE = POSTGRESQL_DUPLICATE
+		KEY = POSTGRESQL_KEY
+		DEFAULT = POSTGRESQL_DEFAULT
+		SET = POSTGRESQL_SET
+		LOCK = POSTGRESQL_LOCK
+		KEYS = POSTGRESQL_KEYS
+		VALUES = POSTGRESQL_VALUES
+		LAST_INSERT_ID = POSTGRESQL_LAST_INSERT_ID
+		NEXT = POSTGRESQL_NEXT
+		VALUE = POSTGRESQL_VALUE
+		SHARE = POSTGRESQL_SHARE
+		MODE = POSTGRESQL_MODE
+		SQL_NO_CACHE = POSTGRESQL_SQL_NO_CACHE
+		SQL_CACHE = POSTGRESQL_SQL_CACHE
+		JOIN = POSTGRESQL_JOIN
+		STRAIGHT_JOIN = POSTGRESQL_STRAIGHT_JOIN
+		LEFT = POSTGRESQL_LEFT
+		RIGHT = POSTGRESQL_RIGHT
+		INNER = POSTGRESQL_INNER
+		OUTER = POSTGRESQL_OUTER
+		CROSS = POSTGRESQL_CROSS
+		NATURAL = POSTGRESQL_NATURAL
 		USE = POSTGRESQL_USE
+		FORCE = POSTGRESQL_FORCE
+		ON = POSTGRESQL_ON
+		USING = POSTGRESQL_USING
 		ID = POSTGRESQL_ID
+		HEX = POSTGRESQL_HEX
+		STRING = POSTGRESQL_STRING
 		INTEGRAL = POSTGRESQL_INTEGRAL
 		FLOAT = POSTGRESQL_FLOAT
-		HEX = POSTGRESQL_HEX
 		HEXNUM = POSTGRESQL_HEXNUM
+		VALUE_ARG = POSTGRESQL_VALUE_ARG
+		LIST_ARG = POSTGRESQL_LIST_ARG
+		COMMENT = POSTGRESQL_COMMENT
+		COMMENT_KEYWORD = POSTGRESQL_COMMENT_KEYWORD
 		BIT_LITERAL = POSTGRESQL_BIT_LITERAL
+		NULL = POSTGRESQL_NULL
+		TRUE = POSTGRESQL_TRUE
+		FALSE = POSTGRESQL_FALSE
+		OR = POSTGRESQL_OR
+		XOR = POSTGRESQL_XOR
+		AND = POSTGRESQL_AND
+		NOT = POSTGRESQL_NOT
+		BETWEEN = POSTGRESQL_BETWEEN
+		CASE = POSTGRESQL_CASE
+		WHEN = POSTGRESQL_WHEN
+		THEN = POSTGRESQL_THEN
+		ELSE = POSTGRESQL_ELSE
+		END = POSTGRESQL_END
+		LE = POSTGRESQL_LE
+		GE = POSTGRESQL_GE
+		NE = POSTGRESQL_NE
+		NULL_SAFE_EQUAL = POSTGRESQL_NULL_SAFE_EQUAL
+		IS = POSTGRESQL_IS
+		LIKE = POSTGRESQL_LIKE
+		REGEXP = POSTGRESQL_REGEXP
+		IN = POSTGRESQL_IN
+		SHIFT_LEFT = POSTGRESQL_SHIFT_LEFT
+		SHIFT_RIGHT = POSTGRESQL_SHIFT_RIGHT
+		DIV = POSTGRESQL_DIV
+		MOD = POSTGRESQL_MOD
+		UNARY = POSTGRESQL_UNARY
+		COLLATE = POSTGRESQL_COLLATE
+		BINARY = POSTGRESQL_BINARY
+		UNDERSCORE_BINARY = POSTGRESQL_UNDERSCORE_BINARY
+		INTERVAL = POSTGRESQL_INTERVAL
+		BEGIN = POSTGRESQL_BEGIN
+		START = POSTGRESQL_START
+		TRANSACTION = POSTGRESQL_TRANSACTION
+		COMMIT = POSTGRESQL_COMMIT
+		ROLLBACK = POSTGRESQL_ROLLBACK
+		BIT = POSTGRESQL_BIT
+		TINYINT = POSTGRESQL_TINYINT
+		SMALLINT = POSTGRESQL_SMALLINT
+		MEDIUMINT = POSTGRESQL_MEDIUMINT
+		INT = POSTGRESQL_INT
+		INTEGER = POSTGRESQL_INTEGER
+		BIGINT = POSTGRESQL_BIGINT
+		INTNUM = POSTGRESQL_INTNUM
+		REAL = POSTGRESQL_REAL
+		DOUBLE = POSTGRESQL_DOUBLE
+		FLOAT_TYPE = POSTGRESQL_FLOAT_TYPE
+		DECIMAL = POSTGRESQL_DECIMAL
+		NUMERIC = POSTGRESQL_NUMERIC
+		TIME = POSTGRESQL_TIME
+		TIMESTAMP = POSTGRESQL_TIMESTAMP
+		DATETIME = POSTGRESQL_DATETIME
+		YEAR = POSTGRESQL_YEAR
+		CHAR = POSTGRESQL_CHAR
+		VARCHAR = POSTGRESQL_VARCHAR
+		BOOL = POSTGRESQL_BOOL
+		CHARACTER = POSTGRESQL_CHARACTER
+		VARBINARY = POSTGRESQL_VARBINARY
+		NCHAR = POSTGRESQL_NCHAR
+		TEXT = POSTGRESQL_TEXT
+		TINYTEXT = POSTGRESQL_TINYTEXT
+		MEDIUMTEXT = POSTGRESQL_MEDIUMTEXT
+		LONGTEXT = POSTGRESQL_LONGTEXT
+		BLOB = POSTGRESQL_BLOB
+		TINYBLOB = POSTGRESQL_TINYBLOB
+		MEDIUMBLOB = POSTGRESQL_MEDIUMBLOB
+		LONGBLOB = POSTGRESQL_LONGBLOB
+		JSON = POSTGRESQL_JSON
+		ENUM = POSTGRESQL_ENUM
+		GEOMETRY = POSTGRESQL_GEOMETRY
+		POINT = POSTGRESQL_POINT
+		LINESTRING = POSTGRESQL_LINESTRING
+		POLYGON = POSTGRESQL_POLYGON
+		GEOMETRYCOLLECTION = POSTGRESQL_GEOMETRYCOLLECTION
+		MULTIPOINT = POSTGRESQL_MULTIPOINT
+		MULTILINESTRING = POSTGRESQL_MULTILINESTRING
+		MULTIPOLYGON = POSTGRESQL_MULTIPOLYGON
+		CREATE = POSTGRESQL_CREATE
+		ALTER = POSTGRESQL_ALTER
+		DROP = POSTGRESQL_DROP
+		RENAME = POSTGRESQL_RENAME
+		ANALYZE = POSTGRESQL_ANALYZE
+		ADD = POSTGRESQL_ADD
+		SCHEMA = POSTGRESQL_SCHEMA
+		TABLE = POSTGRESQL_TABLE
+		INDEX = POSTGRESQL_INDEX
+		VIEW = POSTGRESQL_VIEW
+		TO = POSTGRESQL_TO
+		IGNORE = POSTGRESQL_IGNORE
+		IF = POSTGRESQL_IF
+		UNIQUE = POSTGRESQL_UNIQUE
+		PRIMARY = POSTGRESQL_PRIMARY
+		COLUMN = POSTGRESQL_COLUMN
+		CONSTRAINT = POSTGRESQL_CONSTRAINT
+		SPATIAL = POSTGRESQL_SPATIAL
+		FULLTEXT = POSTGRESQL_FULLTEXT
+		FOREIGN = POSTGRESQL_FOREIGN
+		KEY_BLOCK_SIZE = POSTGRESQL_KEY_BLOCK_SIZE
+		SHOW = POSTGRESQL_SHOW
+		DESCRIBE = POSTGRESQL_DESCRIBE
+		EXPLAIN = POSTGRESQL_EXPLAIN
+		DATE = POSTGRESQL_DATE
+		ESCAPE = POSTGRESQL_ESCAPE
+		REPAIR = POSTGRESQL_REPAIR
+		OPTIMIZE = POSTGRESQL_OPTIMIZE
+		TRUNCATE = POSTGRESQL_TRUNCATE
+		MAXVALUE = POSTGRESQL_MAXVALUE
+		PARTITION = POSTGRESQL_PARTITION
+		REORGANIZE = POSTGRESQL_REORGANIZE
+		LESS = POSTGRESQL_LESS
+		THAN = POSTGRESQL_THAN
+		PROCEDURE = POSTGRESQL_PROCEDURE
+		TRIGGER = POSTGRESQL_TRIGGER
+		STATUS = POSTGRESQL_STATUS
+		VARIABLES = POSTGRESQL_VARIABLES
+		NULLX = POSTGRESQL_NULLX
+		AUTO_INCREMENT = POSTGRESQL_AUTO_INCREMENT
+		APPROXNUM = POSTGRESQL_APPROXNUM
+		SIGNED = POSTGRESQL_SIGNED
+		UNSIGNED = POSTGRESQL_UNSIGNED
+		ZEROFILL = POSTGRESQL_ZEROFILL
+		DATABASES = POSTGRESQL_DATABASES
+		TABLES = POSTGRESQL_TABLES
+		EXTENDED = POSTGRESQL_EXTENDED
+		FULL = POSTGRESQL_FULL
+		PROCESSLIST = POSTGRESQL_PROCESSLIST
+		NAMES = POSTGRESQL_NAMES
+		CHARSET = POSTGRESQL_CHARSET
+		GLOBAL = POSTGRESQL_GLOBAL
+		SESSION = POSTGRESQL_SESSION
+		ISOLATION = POSTGRESQL_ISOLATION
+		LEVEL = POSTGRESQL_LEVEL
+		READ = POSTGRESQL_READ
+		WRITE = POSTGRESQL_WRITE
+		ONLY = POSTGRESQL_ONLY
+		REPEATABLE = POSTGRESQL_REPEATABLE
+		COMMITTED = POSTGRESQL_COMMITTED
+		UNCOMMITTED = POSTGRESQL_UNCOMMITTED
+		SERIALIZABLE = POSTGRESQL_SERIALIZABLE
+		CURRENT_TIMESTAMP = POSTGRESQL_CURRENT_TIMESTAMP
+		DATABASE = POSTGRESQL_DATABASE
+		CURRENT_DATE = POSTGRESQL_CURRENT_DATE
+		CURRENT_TIME = POSTGRESQL_CURRENT_TIME
+		LOCALTIME = POSTGRESQL_LOCALTIME
+		LOCALTIMESTAMP = POSTGRESQL_LOCALTIMESTAMP
+		UTC_DATE = POSTGRESQL_UTC_DATE
+		UTC_TIME = POSTGRESQL_UTC_TIME
+		UTC_TIMESTAMP = POSTGRESQL_UTC_TIMESTAMP
+		REPLACE = POSTGRESQL_REPLACE
+		CONVERT = POSTGRESQL_CONVERT
+		CAST = POSTGRESQL_CAST
+		SUBSTR = POSTGRESQL_SUBSTR
+		SUBSTRING = POSTGRESQL_SUBSTRING
+		GROUP_CONCAT = POSTGRESQL_GROUP_CONCAT
+		SEPARATOR = POSTGRESQL_SEPARATOR
+		MATCH = POSTGRESQL_MATCH
+		AGAINST = POSTGRESQL_AGAINST
+		BOOLEAN = POSTGRESQL_BOOLEAN
+		LANGUAGE = POSTGRESQL_LANGUAGE
+		WITH = POSTGRESQL_WITH
+		QUERY = POSTGRESQL_QUERY
+		EXPANSION = POSTGRESQL_EXPANSION
+		OVER = POSTGRESQL_OVER
+		ROWS = POSTGRESQL_ROWS
+		CURRENT = POSTGRESQL_CURRENT
+		UNBOUNDED = POSTGRESQL_UNBOUNDED
+		PRECEDING = POSTGRESQL_PRECEDING
+		FOLLOWING = POSTGRESQL_FOLLOWING
+		UNUSED = POSTGRESQL_UNUSED
+		ILIKE = POSTGRESQL_ILIKE
+		RETURNING = POSTGRESQL_RETURNING
+		COPY = POSTGRESQL_COPY
+		DELIMITER = POSTGRESQL_DELIMITER
+		QUOTE = POSTGRESQL_QUOTE
+		TYPECAST = POSTGRESQL_TYPECAST
+		CONCAT_OP = POSTGRESQL_CONCAT_OP
+		PRECISION = POSTGRESQL_PRECISION
+		SERIAL = POSTGRESQL_SERIAL
+		SMALLSERIAL = POSTGRESQL_SMALLSERIAL
+		BIGSERIAL = POSTGRESQL_BIGSERIAL
+		BYTEA = POSTGRESQL_BYTEA
+		GENERATED = POSTGRESQL_GENERATED
+		ALWAYS = POSTGRESQL_ALWAYS
+		IDENTITY = POSTGRESQL_IDENTITY
+		NULLS = POSTGRESQL_NULLS
+		FIRST = POSTGRESQL_FIRST
+		LAST = POSTGRESQL_LAST
+		STDOUT = POSTGRESQL_STDOUT
 	}
 	keywords = map[string]int{
 		"accessible":               UNUSED,
@@ -498,6 +915,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"sysdate":                  SYSDATE,
 		"create":                   CREATE,
 		"cross":                    CROSS,
+		"current":                  CURRENT,
 		"current_date":             CURRENT_DATE,
 		"current_time":             CURRENT_TIME,
 		"current_timestamp":        CURRENT_TIMESTAMP,
@@ -559,6 +977,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"float":                    FLOAT_TYPE,
 		"float4":                   UNUSED,
 		"float8":                   UNUSED,
+		"following":                FOLLOWING,
 		"for":                      FOR,
 		"force":                    FORCE,
 		"foreign":                  FOREIGN,
@@ -603,6 +1022,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"int4":                     INT4,
 		"int8":                     INT8,
 		"integer":                  INTEGER,
+		"intersect":                INTERSECT,
 		"interval":                 INTERVAL,
 		"into":                     INTO,
 		"invisible":                INVISIBLE,
@@ -689,6 +1109,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"out":                      UNUSED,
 		"outer":                    OUTER,
 		"outfile":                  OUTFILE,
+		"over":                     OVER,
 		"header":					HEADER,
 		"max_file_size":			MAX_FILE_SIZE,
 		"force_quote":				FORCE_QUOTE,
@@ -700,6 +1121,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"pack_keys":                PACK_KEYS,
 		"point":                    POINT,
 		"polygon":                  POLYGON,
+		"preceding":                PRECEDING,
 		"precision":                UNUSED,
 		"primary":                  PRIMARY,
 		"processlist":              PROCESSLIST,
@@ -740,6 +1162,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"row":						ROW,
 		"row_format":               ROW_FORMAT,
 		"row_count":                ROW_COUNT,
+		"rows":                     ROWS,
 		"rtree":                    RTREE,
 		"schema":                   SCHEMA,
 		"schemas":                  UNUSED,
@@ -801,6 +1224,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"trigger":                  TRIGGER,
 		"true":                     TRUE,
 		"truncate":                 TRUNCATE,
+		"unbounded":                UNBOUNDED,
 		"uncommitted":              UNCOMMITTED,
 		"undo":                     UNUSED,
 		"union":                    UNION,
@@ -865,6 +1289,25 @@ func initTokens(dialectType dialect.DialectType) {
 		"var_samp":                 VAR_SAMP,
 		"type":						TYPE,
 	}
+	if dialectType == dialect.POSTGRESQL {
+		keywords["always"] = ALWAYS
+		keywords["bigserial"] = BIGSERIAL
+		keywords["bytea"] = BYTEA
+		keywords["copy"] = COPY
+		keywords["delimiter"] = DELIMITER
+		keywords["first"] = FIRST
+		keywords["generated"] = GENERATED
+		keywords["identity"] = IDENTITY
+		keywords["ilike"] = ILIKE
+		keywords["last"] = LAST
+		keywords["nulls"] = NULLS
+		keywords["precision"] = PRECISION
+		keywords["quote"] = QUOTE
+		keywords["returning"] = RETURNING
+		keywords["serial"] = SERIAL
+		keywords["smallserial"] = SMALLSERIAL
+		keywords["stdout"] = STDOUT
+	}
 }
 
 // mysql
@@ -977,6 +1420,7 @@ var (
 	USAGE                    int
 	REVOKE                   int
 	EXCEPT                   int
+	INTERSECT                int
 	LOCAL                    int
 	ASSIGNMENT               int
 	RELEASE                  int
@@ -1256,6 +1700,12 @@ var (
 	FORCE_QUOTE				 int
 	STARTING                 int
 	LINES                    int
+	OVER                     int
+	ROWS                     int
+	CURRENT                  int
+	UNBOUNDED                int
+	PRECEDING                int
+	FOLLOWING                int
 	UNUSED                   int
 	INT1                     int
 	INT2                     int
@@ -1263,3 +1713,26 @@ var (
 	INT4                     int
 	INT8                     int
 )
+
+// postgresql
+var (
+	ILIKE                    int
+	RETURNING                int
+	COPY                     int
+	DELIMITER                int
+	QUOTE                    int
+	TYPECAST                 int
+	CONCAT_OP                int
+	PRECISION                int
+	SERIAL                   int
+	SMALLSERIAL              int
+	BIGSERIAL                int
+	BYTEA                    int
+	GENERATED                int
+	ALWAYS                   int
+	IDENTITY                 int
+	NULLS                    int
+	FIRST                    int
+	LAST                     int
+	STDOUT                   int
+)
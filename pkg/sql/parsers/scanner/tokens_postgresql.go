@@ -1,10 +1,431 @@
 // Code generated by goyacc -o postgresql_sql.go -c postgresql postgresql_sql.y. DO NOT EDIT.
 package scanner
 
-const POSTGRESQL_USE = 57346
-const POSTGRESQL_ID = 57347
-const POSTGRESQL_INTEGRAL = 57348
-const POSTGRESQL_FLOAT = 57349
-const POSTGRESQL_HEX = 57350
-const POSTGRESQL_HEXNUM = 57351
-const POSTGRESQL_BIT_LITERAL = 57352
+const POSTGRESQL_LEX_ERROR = 57346
+const POSTGRESQL_UNION = 57347
+const POSTGRESQL_INTERSECT = 57348
+const POSTGRESQL_EXCEPT = 57349
+const POSTGRESQL_SELECT = 57350
+const POSTGRESQL_STREAM = 57351
+const POSTGRESQL_INSERT = 57352
+const POSTGRESQL_UPDATE = 57353
+const POSTGRESQL_DELETE = 57354
+const POSTGRESQL_FROM = 57355
+const POSTGRESQL_WHERE = 57356
+const POSTGRESQL_GROUP = 57357
+const POSTGRESQL_HAVING = 57358
+const POSTGRESQL_ORDER = 57359
+const POSTGRESQL_BY = 57360
+const POSTGRESQL_LIMIT = 57361
+const POSTGRESQL_OFFSET = 57362
+const POSTGRESQL_FOR = 57363
+const POSTGRESQL_ALL = 57364
+const POSTGRESQL_DISTINCT = 57365
+const POSTGRESQL_DISTINCTROW = 57366
+const POSTGRESQL_AS = 57367
+const POSTGRESQL_EXISTS = 57368
+const POSTGRESQL_ASC = 57369
+const POSTGRESQL_DESC = 57370
+const POSTGRESQL_INTO = 57371
+const POSTGRESQL_DUPLICATE = 57372
+const POSTGRESQL_DEFAULT = 57373
+const POSTGRESQL_SET = 57374
+const POSTGRESQL_LOCK = 57375
+const POSTGRESQL_KEYS = 57376
+const POSTGRESQL_VALUES = 57377
+const POSTGRESQL_LAST_INSERT_ID = 57378
+const POSTGRESQL_NEXT = 57379
+const POSTGRESQL_VALUE = 57380
+const POSTGRESQL_SHARE = 57381
+const POSTGRESQL_MODE = 57382
+const POSTGRESQL_SQL_NO_CACHE = 57383
+const POSTGRESQL_SQL_CACHE = 57384
+const POSTGRESQL_JOIN = 57385
+const POSTGRESQL_STRAIGHT_JOIN = 57386
+const POSTGRESQL_LEFT = 57387
+const POSTGRESQL_RIGHT = 57388
+const POSTGRESQL_INNER = 57389
+const POSTGRESQL_OUTER = 57390
+const POSTGRESQL_CROSS = 57391
+const POSTGRESQL_NATURAL = 57392
+const POSTGRESQL_USE = 57393
+const POSTGRESQL_FORCE = 57394
+const POSTGRESQL_FULL_LA = 57395
+const POSTGRESQL_INTERVAL_LA = 57396
+const POSTGRESQL_ON = 57397
+const POSTGRESQL_USING = 57398
+const POSTGRESQL_SUBQUERY_AS_EXPR = 57399
+const POSTGRESQL_ID = 57400
+const POSTGRESQL_AT_ID = 57401
+const POSTGRESQL_AT_AT_ID = 57402
+const POSTGRESQL_STRING = 57403
+const POSTGRESQL_VALUE_ARG = 57404
+const POSTGRESQL_LIST_ARG = 57405
+const POSTGRESQL_COMMENT = 57406
+const POSTGRESQL_COMMENT_KEYWORD = 57407
+const POSTGRESQL_INTEGRAL = 57408
+const POSTGRESQL_HEX = 57409
+const POSTGRESQL_HEXNUM = 57410
+const POSTGRESQL_BIT_LITERAL = 57411
+const POSTGRESQL_FLOAT = 57412
+const POSTGRESQL_NULL = 57413
+const POSTGRESQL_TRUE = 57414
+const POSTGRESQL_FALSE = 57415
+const POSTGRESQL_EMPTY_FROM_CLAUSE = 57416
+const POSTGRESQL_LOWER_THAN_CHARSET = 57417
+const POSTGRESQL_CHARSET = 57418
+const POSTGRESQL_UNIQUE = 57419
+const POSTGRESQL_KEY = 57420
+const POSTGRESQL_OR = 57421
+const POSTGRESQL_XOR = 57422
+const POSTGRESQL_AND = 57423
+const POSTGRESQL_NOT = 57424
+const POSTGRESQL_BETWEEN = 57425
+const POSTGRESQL_CASE = 57426
+const POSTGRESQL_WHEN = 57427
+const POSTGRESQL_THEN = 57428
+const POSTGRESQL_ELSE = 57429
+const POSTGRESQL_END = 57430
+const POSTGRESQL_LE = 57431
+const POSTGRESQL_GE = 57432
+const POSTGRESQL_NE = 57433
+const POSTGRESQL_NULL_SAFE_EQUAL = 57434
+const POSTGRESQL_IS = 57435
+const POSTGRESQL_LIKE = 57436
+const POSTGRESQL_REGEXP = 57437
+const POSTGRESQL_IN = 57438
+const POSTGRESQL_ASSIGNMENT = 57439
+const POSTGRESQL_CONCAT_OP = 57440
+const POSTGRESQL_SHIFT_LEFT = 57441
+const POSTGRESQL_SHIFT_RIGHT = 57442
+const POSTGRESQL_DIV = 57443
+const POSTGRESQL_MOD = 57444
+const POSTGRESQL_UNARY = 57445
+const POSTGRESQL_COLLATE = 57446
+const POSTGRESQL_BINARY = 57447
+const POSTGRESQL_UNDERSCORE_BINARY = 57448
+const POSTGRESQL_INTERVAL = 57449
+const POSTGRESQL_BEGIN = 57450
+const POSTGRESQL_START = 57451
+const POSTGRESQL_TRANSACTION = 57452
+const POSTGRESQL_COMMIT = 57453
+const POSTGRESQL_ROLLBACK = 57454
+const POSTGRESQL_WORK = 57455
+const POSTGRESQL_CONSISTENT = 57456
+const POSTGRESQL_SNAPSHOT = 57457
+const POSTGRESQL_CHAIN = 57458
+const POSTGRESQL_NO = 57459
+const POSTGRESQL_RELEASE = 57460
+const POSTGRESQL_BIT = 57461
+const POSTGRESQL_TINYINT = 57462
+const POSTGRESQL_SMALLINT = 57463
+const POSTGRESQL_MEDIUMINT = 57464
+const POSTGRESQL_INT = 57465
+const POSTGRESQL_INTEGER = 57466
+const POSTGRESQL_BIGINT = 57467
+const POSTGRESQL_INTNUM = 57468
+const POSTGRESQL_REAL = 57469
+const POSTGRESQL_DOUBLE = 57470
+const POSTGRESQL_FLOAT_TYPE = 57471
+const POSTGRESQL_DECIMAL = 57472
+const POSTGRESQL_NUMERIC = 57473
+const POSTGRESQL_TIME = 57474
+const POSTGRESQL_TIMESTAMP = 57475
+const POSTGRESQL_DATETIME = 57476
+const POSTGRESQL_YEAR = 57477
+const POSTGRESQL_CHAR = 57478
+const POSTGRESQL_VARCHAR = 57479
+const POSTGRESQL_BOOL = 57480
+const POSTGRESQL_CHARACTER = 57481
+const POSTGRESQL_VARBINARY = 57482
+const POSTGRESQL_NCHAR = 57483
+const POSTGRESQL_TEXT = 57484
+const POSTGRESQL_TINYTEXT = 57485
+const POSTGRESQL_MEDIUMTEXT = 57486
+const POSTGRESQL_LONGTEXT = 57487
+const POSTGRESQL_BLOB = 57488
+const POSTGRESQL_TINYBLOB = 57489
+const POSTGRESQL_MEDIUMBLOB = 57490
+const POSTGRESQL_LONGBLOB = 57491
+const POSTGRESQL_JSON = 57492
+const POSTGRESQL_ENUM = 57493
+const POSTGRESQL_GEOMETRY = 57494
+const POSTGRESQL_POINT = 57495
+const POSTGRESQL_LINESTRING = 57496
+const POSTGRESQL_POLYGON = 57497
+const POSTGRESQL_GEOMETRYCOLLECTION = 57498
+const POSTGRESQL_MULTIPOINT = 57499
+const POSTGRESQL_MULTILINESTRING = 57500
+const POSTGRESQL_MULTIPOLYGON = 57501
+const POSTGRESQL_INT1 = 57502
+const POSTGRESQL_INT2 = 57503
+const POSTGRESQL_INT3 = 57504
+const POSTGRESQL_INT4 = 57505
+const POSTGRESQL_INT8 = 57506
+const POSTGRESQL_CREATE = 57507
+const POSTGRESQL_ALTER = 57508
+const POSTGRESQL_DROP = 57509
+const POSTGRESQL_RENAME = 57510
+const POSTGRESQL_ANALYZE = 57511
+const POSTGRESQL_ADD = 57512
+const POSTGRESQL_SCHEMA = 57513
+const POSTGRESQL_TABLE = 57514
+const POSTGRESQL_INDEX = 57515
+const POSTGRESQL_VIEW = 57516
+const POSTGRESQL_TO = 57517
+const POSTGRESQL_IGNORE = 57518
+const POSTGRESQL_IF = 57519
+const POSTGRESQL_PRIMARY = 57520
+const POSTGRESQL_COLUMN = 57521
+const POSTGRESQL_CONSTRAINT = 57522
+const POSTGRESQL_SPATIAL = 57523
+const POSTGRESQL_FULLTEXT = 57524
+const POSTGRESQL_FOREIGN = 57525
+const POSTGRESQL_KEY_BLOCK_SIZE = 57526
+const POSTGRESQL_SHOW = 57527
+const POSTGRESQL_DESCRIBE = 57528
+const POSTGRESQL_EXPLAIN = 57529
+const POSTGRESQL_DATE = 57530
+const POSTGRESQL_ESCAPE = 57531
+const POSTGRESQL_REPAIR = 57532
+const POSTGRESQL_OPTIMIZE = 57533
+const POSTGRESQL_TRUNCATE = 57534
+const POSTGRESQL_MAXVALUE = 57535
+const POSTGRESQL_PARTITION = 57536
+const POSTGRESQL_REORGANIZE = 57537
+const POSTGRESQL_LESS = 57538
+const POSTGRESQL_THAN = 57539
+const POSTGRESQL_PROCEDURE = 57540
+const POSTGRESQL_TRIGGER = 57541
+const POSTGRESQL_STATUS = 57542
+const POSTGRESQL_VARIABLES = 57543
+const POSTGRESQL_ROLE = 57544
+const POSTGRESQL_PROXY = 57545
+const POSTGRESQL_AVG_ROW_LENGTH = 57546
+const POSTGRESQL_STORAGE = 57547
+const POSTGRESQL_DISK = 57548
+const POSTGRESQL_MEMORY = 57549
+const POSTGRESQL_CHECKSUM = 57550
+const POSTGRESQL_COMPRESSION = 57551
+const POSTGRESQL_DATA = 57552
+const POSTGRESQL_DIRECTORY = 57553
+const POSTGRESQL_DELAY_KEY_WRITE = 57554
+const POSTGRESQL_ENCRYPTION = 57555
+const POSTGRESQL_ENGINE = 57556
+const POSTGRESQL_MAX_ROWS = 57557
+const POSTGRESQL_MIN_ROWS = 57558
+const POSTGRESQL_PACK_KEYS = 57559
+const POSTGRESQL_ROW_FORMAT = 57560
+const POSTGRESQL_STATS_AUTO_RECALC = 57561
+const POSTGRESQL_STATS_PERSISTENT = 57562
+const POSTGRESQL_STATS_SAMPLE_PAGES = 57563
+const POSTGRESQL_DYNAMIC = 57564
+const POSTGRESQL_COMPRESSED = 57565
+const POSTGRESQL_REDUNDANT = 57566
+const POSTGRESQL_COMPACT = 57567
+const POSTGRESQL_FIXED = 57568
+const POSTGRESQL_COLUMN_FORMAT = 57569
+const POSTGRESQL_AUTO_RANDOM = 57570
+const POSTGRESQL_RESTRICT = 57571
+const POSTGRESQL_CASCADE = 57572
+const POSTGRESQL_ACTION = 57573
+const POSTGRESQL_PARTIAL = 57574
+const POSTGRESQL_SIMPLE = 57575
+const POSTGRESQL_CHECK = 57576
+const POSTGRESQL_ENFORCED = 57577
+const POSTGRESQL_RANGE = 57578
+const POSTGRESQL_LIST = 57579
+const POSTGRESQL_ALGORITHM = 57580
+const POSTGRESQL_LINEAR = 57581
+const POSTGRESQL_PARTITIONS = 57582
+const POSTGRESQL_SUBPARTITION = 57583
+const POSTGRESQL_SUBPARTITIONS = 57584
+const POSTGRESQL_TYPE = 57585
+const POSTGRESQL_PROPERTIES = 57586
+const POSTGRESQL_PARSER = 57587
+const POSTGRESQL_VISIBLE = 57588
+const POSTGRESQL_INVISIBLE = 57589
+const POSTGRESQL_BTREE = 57590
+const POSTGRESQL_HASH = 57591
+const POSTGRESQL_RTREE = 57592
+const POSTGRESQL_BSI = 57593
+const POSTGRESQL_ZONEMAP = 57594
+const POSTGRESQL_EXPIRE = 57595
+const POSTGRESQL_ACCOUNT = 57596
+const POSTGRESQL_UNLOCK = 57597
+const POSTGRESQL_DAY = 57598
+const POSTGRESQL_NEVER = 57599
+const POSTGRESQL_SECOND = 57600
+const POSTGRESQL_ASCII = 57601
+const POSTGRESQL_COALESCE = 57602
+const POSTGRESQL_COLLATION = 57603
+const POSTGRESQL_HOUR = 57604
+const POSTGRESQL_MICROSECOND = 57605
+const POSTGRESQL_MINUTE = 57606
+const POSTGRESQL_MONTH = 57607
+const POSTGRESQL_QUARTER = 57608
+const POSTGRESQL_REPEAT = 57609
+const POSTGRESQL_REVERSE = 57610
+const POSTGRESQL_ROW_COUNT = 57611
+const POSTGRESQL_WEEK = 57612
+const POSTGRESQL_REVOKE = 57613
+const POSTGRESQL_FUNCTION = 57614
+const POSTGRESQL_PRIVILEGES = 57615
+const POSTGRESQL_TABLESPACE = 57616
+const POSTGRESQL_EXECUTE = 57617
+const POSTGRESQL_SUPER = 57618
+const POSTGRESQL_GRANT = 57619
+const POSTGRESQL_OPTION = 57620
+const POSTGRESQL_REFERENCES = 57621
+const POSTGRESQL_REPLICATION = 57622
+const POSTGRESQL_SLAVE = 57623
+const POSTGRESQL_CLIENT = 57624
+const POSTGRESQL_USAGE = 57625
+const POSTGRESQL_RELOAD = 57626
+const POSTGRESQL_FILE = 57627
+const POSTGRESQL_TEMPORARY = 57628
+const POSTGRESQL_ROUTINE = 57629
+const POSTGRESQL_EVENT = 57630
+const POSTGRESQL_SHUTDOWN = 57631
+const POSTGRESQL_NULLX = 57632
+const POSTGRESQL_AUTO_INCREMENT = 57633
+const POSTGRESQL_APPROXNUM = 57634
+const POSTGRESQL_SIGNED = 57635
+const POSTGRESQL_UNSIGNED = 57636
+const POSTGRESQL_ZEROFILL = 57637
+const POSTGRESQL_USER = 57638
+const POSTGRESQL_IDENTIFIED = 57639
+const POSTGRESQL_CIPHER = 57640
+const POSTGRESQL_ISSUER = 57641
+const POSTGRESQL_X509 = 57642
+const POSTGRESQL_SUBJECT = 57643
+const POSTGRESQL_SAN = 57644
+const POSTGRESQL_REQUIRE = 57645
+const POSTGRESQL_SSL = 57646
+const POSTGRESQL_NONE = 57647
+const POSTGRESQL_PASSWORD = 57648
+const POSTGRESQL_MAX_QUERIES_PER_HOUR = 57649
+const POSTGRESQL_MAX_UPDATES_PER_HOUR = 57650
+const POSTGRESQL_MAX_CONNECTIONS_PER_HOUR = 57651
+const POSTGRESQL_MAX_USER_CONNECTIONS = 57652
+const POSTGRESQL_FORMAT = 57653
+const POSTGRESQL_CONNECTION = 57654
+const POSTGRESQL_LOAD = 57655
+const POSTGRESQL_INFILE = 57656
+const POSTGRESQL_TERMINATED = 57657
+const POSTGRESQL_OPTIONALLY = 57658
+const POSTGRESQL_ENCLOSED = 57659
+const POSTGRESQL_ESCAPED = 57660
+const POSTGRESQL_STARTING = 57661
+const POSTGRESQL_LINES = 57662
+const POSTGRESQL_DATABASES = 57663
+const POSTGRESQL_TABLES = 57664
+const POSTGRESQL_EXTENDED = 57665
+const POSTGRESQL_FULL = 57666
+const POSTGRESQL_PROCESSLIST = 57667
+const POSTGRESQL_FIELDS = 57668
+const POSTGRESQL_COLUMNS = 57669
+const POSTGRESQL_OPEN = 57670
+const POSTGRESQL_ERRORS = 57671
+const POSTGRESQL_WARNINGS = 57672
+const POSTGRESQL_INDEXES = 57673
+const POSTGRESQL_NAMES = 57674
+const POSTGRESQL_GLOBAL = 57675
+const POSTGRESQL_SESSION = 57676
+const POSTGRESQL_ISOLATION = 57677
+const POSTGRESQL_LEVEL = 57678
+const POSTGRESQL_READ = 57679
+const POSTGRESQL_WRITE = 57680
+const POSTGRESQL_ONLY = 57681
+const POSTGRESQL_REPEATABLE = 57682
+const POSTGRESQL_COMMITTED = 57683
+const POSTGRESQL_UNCOMMITTED = 57684
+const POSTGRESQL_SERIALIZABLE = 57685
+const POSTGRESQL_LOCAL = 57686
+const POSTGRESQL_CURRENT_TIMESTAMP = 57687
+const POSTGRESQL_DATABASE = 57688
+const POSTGRESQL_CURRENT_TIME = 57689
+const POSTGRESQL_LOCALTIME = 57690
+const POSTGRESQL_LOCALTIMESTAMP = 57691
+const POSTGRESQL_UTC_DATE = 57692
+const POSTGRESQL_UTC_TIME = 57693
+const POSTGRESQL_UTC_TIMESTAMP = 57694
+const POSTGRESQL_REPLACE = 57695
+const POSTGRESQL_CONVERT = 57696
+const POSTGRESQL_SEPARATOR = 57697
+const POSTGRESQL_CURRENT_DATE = 57698
+const POSTGRESQL_CURRENT_USER = 57699
+const POSTGRESQL_CURRENT_ROLE = 57700
+const POSTGRESQL_MATCH = 57701
+const POSTGRESQL_AGAINST = 57702
+const POSTGRESQL_BOOLEAN = 57703
+const POSTGRESQL_LANGUAGE = 57704
+const POSTGRESQL_WITH = 57705
+const POSTGRESQL_QUERY = 57706
+const POSTGRESQL_EXPANSION = 57707
+const POSTGRESQL_ADDDATE = 57708
+const POSTGRESQL_BIT_AND = 57709
+const POSTGRESQL_BIT_OR = 57710
+const POSTGRESQL_BIT_XOR = 57711
+const POSTGRESQL_CAST = 57712
+const POSTGRESQL_COUNT = 57713
+const POSTGRESQL_APPROX_COUNT_DISTINCT = 57714
+const POSTGRESQL_APPROX_PERCENTILE = 57715
+const POSTGRESQL_CURDATE = 57716
+const POSTGRESQL_CURTIME = 57717
+const POSTGRESQL_DATE_ADD = 57718
+const POSTGRESQL_DATE_SUB = 57719
+const POSTGRESQL_EXTRACT = 57720
+const POSTGRESQL_GROUP_CONCAT = 57721
+const POSTGRESQL_MAX = 57722
+const POSTGRESQL_MID = 57723
+const POSTGRESQL_MIN = 57724
+const POSTGRESQL_NOW = 57725
+const POSTGRESQL_POSITION = 57726
+const POSTGRESQL_SESSION_USER = 57727
+const POSTGRESQL_STD = 57728
+const POSTGRESQL_STDDEV = 57729
+const POSTGRESQL_STDDEV_POP = 57730
+const POSTGRESQL_STDDEV_SAMP = 57731
+const POSTGRESQL_SUBDATE = 57732
+const POSTGRESQL_SUBSTR = 57733
+const POSTGRESQL_SUBSTRING = 57734
+const POSTGRESQL_SUM = 57735
+const POSTGRESQL_SYSDATE = 57736
+const POSTGRESQL_SYSTEM_USER = 57737
+const POSTGRESQL_TRANSLATE = 57738
+const POSTGRESQL_TRIM = 57739
+const POSTGRESQL_VARIANCE = 57740
+const POSTGRESQL_VAR_POP = 57741
+const POSTGRESQL_VAR_SAMP = 57742
+const POSTGRESQL_AVG = 57743
+const POSTGRESQL_ROW = 57744
+const POSTGRESQL_OUTFILE = 57745
+const POSTGRESQL_HEADER = 57746
+const POSTGRESQL_MAX_FILE_SIZE = 57747
+const POSTGRESQL_FORCE_QUOTE = 57748
+const POSTGRESQL_OVER = 57749
+const POSTGRESQL_ROWS = 57750
+const POSTGRESQL_CURRENT = 57751
+const POSTGRESQL_UNBOUNDED = 57752
+const POSTGRESQL_PRECEDING = 57753
+const POSTGRESQL_FOLLOWING = 57754
+const POSTGRESQL_UNUSED = 57755
+const POSTGRESQL_ILIKE = 57756
+const POSTGRESQL_RETURNING = 57757
+const POSTGRESQL_COPY = 57758
+const POSTGRESQL_DELIMITER = 57759
+const POSTGRESQL_QUOTE = 57760
+const POSTGRESQL_STDOUT = 57761
+const POSTGRESQL_PRECISION = 57762
+const POSTGRESQL_SERIAL = 57763
+const POSTGRESQL_SMALLSERIAL = 57764
+const POSTGRESQL_BIGSERIAL = 57765
+const POSTGRESQL_BYTEA = 57766
+const POSTGRESQL_GENERATED = 57767
+const POSTGRESQL_ALWAYS = 57768
+const POSTGRESQL_IDENTITY = 57769
+const POSTGRESQL_NULLS = 57770
+const POSTGRESQL_FIRST = 57771
+const POSTGRESQL_LAST = 57772
+const POSTGRESQL_TYPECAST = 57773
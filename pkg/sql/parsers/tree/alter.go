@@ -82,3 +82,102 @@ func NewAlterUser(ife bool, iuf bool, uf *User, u []*User, r []*Role, t []TlsOpt
 		MiscOpts:   m,
 	}
 }
+
+// AlterTable changes the columns or the name of a table
+type AlterTable struct {
+	statementImpl
+	Table   TableName
+	Options []AlterTableOption
+}
+
+func (node *AlterTable) Format(ctx *FmtCtx) {
+	ctx.WriteString("alter table ")
+	node.Table.Format(ctx)
+	prefix := " "
+	for _, opt := range node.Options {
+		ctx.WriteString(prefix)
+		opt.Format(ctx)
+		prefix = ", "
+	}
+}
+
+func NewAlterTable(t TableName, opts []AlterTableOption) *AlterTable {
+	return &AlterTable{
+		Table:   t,
+		Options: opts,
+	}
+}
+
+type AlterTableOption interface {
+	NodeFormatter
+}
+
+type alterTableOptionImpl struct {
+	AlterTableOption
+}
+
+// AlterTableAddColumn is ADD [COLUMN] col_def
+type AlterTableAddColumn struct {
+	alterTableOptionImpl
+	Column *ColumnTableDef
+}
+
+func (node *AlterTableAddColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("add column ")
+	node.Column.Format(ctx)
+}
+
+// AlterTableDropColumn is DROP [COLUMN] col_name
+type AlterTableDropColumn struct {
+	alterTableOptionImpl
+	Name Identifier
+}
+
+func (node *AlterTableDropColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop column ")
+	ctx.WriteString(string(node.Name))
+}
+
+// AlterTableRenameColumn is RENAME COLUMN old_name TO new_name
+type AlterTableRenameColumn struct {
+	alterTableOptionImpl
+	Name    Identifier
+	NewName Identifier
+}
+
+func (node *AlterTableRenameColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("rename column ")
+	ctx.WriteString(string(node.Name))
+	ctx.WriteString(" to ")
+	ctx.WriteString(string(node.NewName))
+}
+
+// AlterTableColumnDefault is ALTER [COLUMN] col_name SET DEFAULT expr,
+// the default is dropped if Expr is nil.
+type AlterTableColumnDefault struct {
+	alterTableOptionImpl
+	Name Identifier
+	Expr Expr
+}
+
+func (node *AlterTableColumnDefault) Format(ctx *FmtCtx) {
+	ctx.WriteString("alter column ")
+	ctx.WriteString(string(node.Name))
+	if node.Expr == nil {
+		ctx.WriteString(" drop default")
+		return
+	}
+	ctx.WriteString(" set default ")
+	node.Expr.Format(ctx)
+}
+
+// AlterTableRename is RENAME [TO | AS] new_table_name
+type AlterTableRename struct {
+	alterTableOptionImpl
+	Name TableName
+}
+
+func (node *AlterTableRename) Format(ctx *FmtCtx) {
+	ctx.WriteString("rename to ")
+	node.Name.Format(ctx)
+}
@@ -2206,3 +2206,35 @@ func NewCreateUser(ife bool, u []*User, r []*Role, tls []TlsOption, res []Resour
 		MiscOpts:    misc,
 	}
 }
+
+type CreateView struct {
+	statementImpl
+	Name        *TableName
+	ColNames    IdentifierList
+	AsSource    *Select
+	IfNotExists bool
+}
+
+func (node *CreateView) Format(ctx *FmtCtx) {
+	ctx.WriteString("create view ")
+	if node.IfNotExists {
+		ctx.WriteString("if not exists ")
+	}
+	node.Name.Format(ctx)
+	if len(node.ColNames) > 0 {
+		ctx.WriteString(" (")
+		node.ColNames.Format(ctx)
+		ctx.WriteByte(')')
+	}
+	ctx.WriteString(" as ")
+	node.AsSource.Format(ctx)
+}
+
+func NewCreateView(n *TableName, c IdentifierList, s *Select, ife bool) *CreateView {
+	return &CreateView{
+		Name:        n,
+		ColNames:    c,
+		AsSource:    s,
+		IfNotExists: ife,
+	}
+}
@@ -26,6 +26,11 @@ type explainImpl struct {
 	Format    string
 }
 
+// GetFormat returns the output format of explain.
+func (node *explainImpl) GetFormat() string {
+	return node.Format
+}
+
 //EXPLAIN stmt statement
 type ExplainStmt struct {
 	explainImpl
@@ -16,6 +16,7 @@ package tree
 
 import (
 	"fmt"
+	"strings"
 )
 
 //AST for the expression
@@ -193,6 +194,8 @@ const (
 	NOT_LIKE                             // NOT LIKE
 	REG_MATCH                            // REG_MATCH
 	NOT_REG_MATCH                        // NOT REG_MATCH
+	ILIKE                                // ILIKE
+	NOT_ILIKE                            // NOT ILIKE
 	IS_DISTINCT_FROM
 	IS_NOT_DISTINCT_FROM
 
@@ -230,6 +233,10 @@ func (op ComparisonOp) ToString() string {
 		return "reg_match"
 	case NOT_REG_MATCH:
 		return "not reg_match"
+	case ILIKE:
+		return "ilike"
+	case NOT_ILIKE:
+		return "not ilike"
 	case IS_DISTINCT_FROM:
 		return "is distinct from"
 	case IS_NOT_DISTINCT_FROM:
@@ -528,6 +535,9 @@ type FuncExpr struct {
 
 	//aggregations which specify an order.
 	OrderBy OrderBy
+
+	//window over which the function is evaluated, nil if it is not a window function.
+	WindowSpec *WindowSpec
 }
 
 func (node *FuncExpr) Format(ctx *FmtCtx) {
@@ -540,6 +550,10 @@ func (node *FuncExpr) Format(ctx *FmtCtx) {
 	}
 	node.Exprs.Format(ctx)
 	ctx.WriteByte(')')
+	if node.WindowSpec != nil {
+		ctx.WriteByte(' ')
+		node.WindowSpec.Format(ctx)
+	}
 }
 
 func NewFuncExpr(ft FuncType, name *UnresolvedName, e Exprs, order OrderBy) *FuncExpr {
@@ -635,6 +649,23 @@ type CaseExpr struct {
 	Else  Expr
 }
 
+func (node *CaseExpr) Format(ctx *FmtCtx) {
+	ctx.WriteString("case")
+	if node.Expr != nil {
+		ctx.WriteByte(' ')
+		node.Expr.Format(ctx)
+	}
+	for _, w := range node.Whens {
+		ctx.WriteByte(' ')
+		w.Format(ctx)
+	}
+	if node.Else != nil {
+		ctx.WriteString(" else ")
+		node.Else.Format(ctx)
+	}
+	ctx.WriteString(" end")
+}
+
 func NewCaseExpr(e Expr, w []*When, el Expr) *CaseExpr {
 	return &CaseExpr{
 		Expr:  e,
@@ -649,6 +680,13 @@ type When struct {
 	Val  Expr
 }
 
+func (node *When) Format(ctx *FmtCtx) {
+	ctx.WriteString("when ")
+	node.Cond.Format(ctx)
+	ctx.WriteString(" then ")
+	node.Val.Format(ctx)
+}
+
 func NewWhen(c, v Expr) *When {
 	return &When{
 		Cond: c,
@@ -661,8 +699,24 @@ type IntervalType int
 
 func (node *IntervalType) ToString() string {
 	switch *node {
+	case INTERVAL_TYPE_MICROSECOND:
+		return "microsecond"
 	case INTERVAL_TYPE_SECOND:
 		return "second"
+	case INTERVAL_TYPE_MINUTE:
+		return "minute"
+	case INTERVAL_TYPE_HOUR:
+		return "hour"
+	case INTERVAL_TYPE_DAY:
+		return "day"
+	case INTERVAL_TYPE_WEEK:
+		return "week"
+	case INTERVAL_TYPE_MONTH:
+		return "month"
+	case INTERVAL_TYPE_QUARTER:
+		return "quarter"
+	case INTERVAL_TYPE_YEAR:
+		return "year"
 	default:
 		return "Unknown IntervalType"
 	}
@@ -738,6 +792,21 @@ func NewIntervalExpr(t IntervalType) *IntervalExpr {
 	}
 }
 
+// SplitInterval splits an interval string such as '1 year' into the number
+// and the unit, it returns false if the string is not an interval.
+func SplitInterval(s string) (string, IntervalType, bool) {
+	fields := strings.Fields(s)
+	if len(fields) == 2 {
+		unit := strings.TrimSuffix(strings.ToLower(fields[1]), "s")
+		for typ := INTERVAL_TYPE_MICROSECOND; typ <= INTERVAL_TYPE_YEAR; typ++ {
+			if typ.ToString() == unit {
+				return fields[0], typ, true
+			}
+		}
+	}
+	return "", INTERVAL_TYPE_INVALID, false
+}
+
 //the DEFAULT expression.
 type DefaultVal struct {
 	exprImpl
@@ -757,6 +826,23 @@ func NewDefaultVal(e Expr) *DefaultVal {
 	}
 }
 
+// ParamExpr is the placeholder '?' of a prepared statement.
+type ParamExpr struct {
+	exprImpl
+	// Offset is the position of the placeholder in statement, it starts from 1.
+	Offset int
+}
+
+func (node *ParamExpr) Format(ctx *FmtCtx) {
+	ctx.WriteByte('?')
+}
+
+func NewParamExpr(offset int) *ParamExpr {
+	return &ParamExpr{
+		Offset: offset,
+	}
+}
+
 type MaxValue struct {
 	exprImpl
 }
@@ -21,6 +21,8 @@ type Insert struct {
 	PartitionNames IdentifierList
 	Columns        IdentifierList
 	Rows           *Select
+	//RETURNING list of postgresql
+	Returning SelectExprs
 }
 
 func (node *Insert) Format(ctx *FmtCtx) {
@@ -42,6 +44,10 @@ func (node *Insert) Format(ctx *FmtCtx) {
 		ctx.WriteByte(' ')
 		node.Rows.Format(ctx)
 	}
+	if node.Returning != nil {
+		ctx.WriteString(" returning ")
+		node.Returning.Format(ctx)
+	}
 }
 
 func NewInsert(t TableExpr, c IdentifierList, r *Select, p IdentifierList) *Insert {
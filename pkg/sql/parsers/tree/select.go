@@ -74,6 +74,8 @@ type Order struct {
 	Direction Direction
 	//without order
 	NullOrder bool
+	// where the nulls are placed, like NULLS FIRST of postgresql
+	NullsPosition NullsPosition
 }
 
 func (node *Order) Format(ctx *FmtCtx) {
@@ -82,6 +84,10 @@ func (node *Order) Format(ctx *FmtCtx) {
 		ctx.WriteByte(' ')
 		ctx.WriteString(node.Direction.String())
 	}
+	if node.NullsPosition != DefaultNullsPosition {
+		ctx.WriteByte(' ')
+		ctx.WriteString(node.NullsPosition.String())
+	}
 }
 
 func NewOrder(e Expr, d Direction, o bool) *Order {
@@ -115,6 +121,29 @@ func (d Direction) String() string {
 	return directionName[d]
 }
 
+// NullsPosition for ordering the nulls.
+type NullsPosition int8
+
+// NullsPosition values.
+const (
+	DefaultNullsPosition NullsPosition = iota
+	NullsFirst
+	NullsLast
+)
+
+var nullsPositionName = [...]string{
+	DefaultNullsPosition: "",
+	NullsFirst:           "nulls first",
+	NullsLast:            "nulls last",
+}
+
+func (p NullsPosition) String() string {
+	if p < 0 || p > NullsPosition(len(nullsPositionName)-1) {
+		return fmt.Sprintf("NullsPosition(%d)", p)
+	}
+	return nullsPositionName[p]
+}
+
 //the LIMIT clause.
 type Limit struct {
 	Offset, Count Expr
@@ -260,6 +289,7 @@ const (
 	JOIN_TYPE_NATURAL       = "NATURAL"
 	JOIN_TYPE_NATURAL_LEFT  = "NATURAL LEFT"
 	JOIN_TYPE_NATURAL_RIGHT = "NATURAL RIGHT"
+	JOIN_TYPE_NATURAL_FULL  = "NATURAL FULL"
 )
 
 //the table expression
@@ -378,12 +408,18 @@ func NewParenTableExpr(e TableExpr) *ParenTableExpr {
 type AliasClause struct {
 	NodeFormatter
 	Alias Identifier
+	Cols  IdentifierList
 }
 
 func (node *AliasClause) Format(ctx *FmtCtx) {
 	if node.Alias != "" {
 		ctx.WriteString(string(node.Alias))
 	}
+	if node.Cols != nil {
+		ctx.WriteString(" (")
+		node.Cols.Format(ctx)
+		ctx.WriteByte(')')
+	}
 }
 
 //the table expression coupled with an optional alias.
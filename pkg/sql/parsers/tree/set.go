@@ -51,6 +51,9 @@ func (node *VarAssignmentExpr) Format(ctx *FmtCtx) {
 	if node.Global {
 		ctx.WriteString("global ")
 	}
+	if !node.System {
+		ctx.WriteByte('@')
+	}
 	ctx.WriteString(node.Name)
 	ctx.WriteString(" =")
 	if node.Value != nil {
@@ -42,6 +42,7 @@ const (
 	BlobFamily
 
 	GeometryFamily
+	DecimalFamily
 )
 
 type IntervalDurationField struct {
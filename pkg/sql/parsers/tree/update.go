@@ -18,6 +18,7 @@ import (
 	"bufio"
 	"os"
 	"strconv"
+	"strings"
 )
 
 //update statement
@@ -123,6 +124,38 @@ type Load struct {
 	ColumnList []LoadColumn
 	//set col_name
 	Assignments UpdateExprs
+	//the format of the file, the fields and lines are for csv only
+	DataFormat DataFormat
+}
+
+//DataFormat is the format of the file of LOAD DATA and SELECT ... INTO OUTFILE
+type DataFormat string
+
+const (
+	DataFormatCSV       DataFormat = "csv"
+	DataFormatParquet   DataFormat = "parquet"
+	DataFormatJSONLines DataFormat = "jsonlines"
+)
+
+//NewDataFormat returns the format by its name, ok is false if it is unknown
+func NewDataFormat(name string) (DataFormat, bool) {
+	switch f := DataFormat(strings.ToLower(name)); f {
+	case DataFormatCSV, DataFormatParquet, DataFormatJSONLines:
+		return f, true
+	}
+	return "", false
+}
+
+//IsCSV returns true for the delimited text, which is the default format
+func (f DataFormat) IsCSV() bool {
+	return f == "" || f == DataFormatCSV
+}
+
+func (f DataFormat) Format(ctx *FmtCtx) {
+	if !f.IsCSV() {
+		ctx.WriteString(" format ")
+		ctx.WriteString(string(f))
+	}
 }
 
 func (node *Load) Format(ctx *FmtCtx) {
@@ -132,6 +165,7 @@ func (node *Load) Format(ctx *FmtCtx) {
 	}
 	ctx.WriteString(" infile ")
 	ctx.WriteString(node.File)
+	node.DataFormat.Format(ctx)
 
 	switch node.DuplicateHandling.(type) {
 	case *DuplicateKeyError:
@@ -296,6 +330,8 @@ type ExportParam struct {
 	Writer *bufio.Writer
 	// outfile flag
 	Outfile bool
+	// stdout flag, the rows are sent to the client by COPY TO STDOUT
+	Stdout bool
 	// filename path
 	FilePath string
 	// Fields
@@ -308,6 +344,8 @@ type ExportParam struct {
 	CurFileSize uint64
 	Rows uint64
 	FileCnt uint
+	// the format of the file
+	DataFormat DataFormat
 	// header flag
 	Header bool
 	ForceQuote []string
@@ -321,10 +359,14 @@ type ExportParam struct {
 }
 
 func (ep *ExportParam) Format(ctx *FmtCtx) {
-	if ep.FilePath == "" {
+	if ep.Stdout {
+		ctx.WriteString("into stdout")
+	} else if ep.FilePath == "" {
 		return
+	} else {
+		ctx.WriteString("into outfile " + ep.FilePath)
 	}
-	ctx.WriteString("into outfile " + ep.FilePath)
+	ep.DataFormat.Format(ctx)
 	if ep.Fields != nil {
 		ctx.WriteByte(' ')
 		ep.Fields.Format(ctx)
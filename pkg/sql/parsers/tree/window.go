@@ -0,0 +1,137 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import "fmt"
+
+// the window specification of the window function.
+type WindowSpec struct {
+	PartitionBy Exprs
+	OrderBy     OrderBy
+	Frame       *FrameClause
+}
+
+func (node *WindowSpec) Format(ctx *FmtCtx) {
+	ctx.WriteString("over (")
+	prefix := ""
+	if len(node.PartitionBy) > 0 {
+		ctx.WriteString("partition by ")
+		node.PartitionBy.Format(ctx)
+		prefix = " "
+	}
+	if len(node.OrderBy) > 0 {
+		ctx.WriteString(prefix)
+		node.OrderBy.Format(ctx)
+		prefix = " "
+	}
+	if node.Frame != nil {
+		ctx.WriteString(prefix)
+		node.Frame.Format(ctx)
+	}
+	ctx.WriteByte(')')
+}
+
+func NewWindowSpec(partitionBy Exprs, orderBy OrderBy, frame *FrameClause) *WindowSpec {
+	return &WindowSpec{
+		PartitionBy: partitionBy,
+		OrderBy:     orderBy,
+		Frame:       frame,
+	}
+}
+
+// FrameType specifies the unit of the window frame.
+type FrameType int
+
+func (t FrameType) ToString() string {
+	switch t {
+	case FRAME_ROWS:
+		return "rows"
+	case FRAME_RANGE:
+		return "range"
+	default:
+		return "Unknown FrameType"
+	}
+}
+
+const (
+	FRAME_ROWS FrameType = iota
+	FRAME_RANGE
+)
+
+// the window frame, the rows between start and end are the frame of the current row.
+type FrameClause struct {
+	Type  FrameType
+	Start *FrameBound
+	End   *FrameBound
+}
+
+func (node *FrameClause) Format(ctx *FmtCtx) {
+	ctx.WriteString(node.Type.ToString())
+	ctx.WriteString(" between ")
+	node.Start.Format(ctx)
+	ctx.WriteString(" and ")
+	node.End.Format(ctx)
+}
+
+func NewFrameClause(t FrameType, start, end *FrameBound) *FrameClause {
+	return &FrameClause{
+		Type:  t,
+		Start: start,
+		End:   end,
+	}
+}
+
+// FrameBoundType specifies the type of the window frame bound.
+type FrameBoundType int
+
+const (
+	FRAME_UNBOUNDED_PRECEDING FrameBoundType = iota
+	FRAME_PRECEDING
+	FRAME_CURRENT_ROW
+	FRAME_FOLLOWING
+	FRAME_UNBOUNDED_FOLLOWING
+)
+
+// the bound of the window frame, offset is only used by preceding and following.
+type FrameBound struct {
+	Type   FrameBoundType
+	Offset Expr
+}
+
+func (node *FrameBound) Format(ctx *FmtCtx) {
+	switch node.Type {
+	case FRAME_UNBOUNDED_PRECEDING:
+		ctx.WriteString("unbounded preceding")
+	case FRAME_PRECEDING:
+		node.Offset.Format(ctx)
+		ctx.WriteString(" preceding")
+	case FRAME_CURRENT_ROW:
+		ctx.WriteString("current row")
+	case FRAME_FOLLOWING:
+		node.Offset.Format(ctx)
+		ctx.WriteString(" following")
+	case FRAME_UNBOUNDED_FOLLOWING:
+		ctx.WriteString("unbounded following")
+	default:
+		ctx.WriteString(fmt.Sprintf("FrameBoundType(%d)", node.Type))
+	}
+}
+
+func NewFrameBound(t FrameBoundType, offset Expr) *FrameBound {
+	return &FrameBound{
+		Type:   t,
+		Offset: offset,
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// BuildAlterTable checks the changes of table definition in order, so that
+// a change can refer to the columns added or renamed by the changes before it.
+func (b *build) BuildAlterTable(stmt *tree.AlterTable, plan *AlterTable) error {
+	dbName, id, r, err := b.tableName(&stmt.Table)
+	if err != nil {
+		return err
+	}
+	attrs := make(map[string]engine.Attribute) // columns of the table after the changes
+	keys := make(map[string]struct{})          // columns used by primary key or indexes
+	for _, def := range r.TableDefs() {
+		switch v := def.(type) {
+		case *engine.AttributeDef:
+			attrs[v.Attr.Name] = v.Attr
+			if v.Attr.Primary {
+				keys[v.Attr.Name] = struct{}{}
+			}
+		case *engine.PrimaryIndexDef:
+			for _, name := range v.Names {
+				keys[name] = struct{}{}
+			}
+		case *engine.IndexTableDef:
+			for _, name := range v.ColNames {
+				keys[name] = struct{}{}
+			}
+		}
+	}
+	defs := make([]AlterTableDef, 0, len(stmt.Options))
+	for _, opt := range stmt.Options {
+		switch o := opt.(type) {
+		case *tree.AlterTableAddColumn:
+			def, pks, err := b.getTableDef(o.Column)
+			if err != nil {
+				return err
+			}
+			if pks != nil {
+				return errors.New(errno.FeatureNotSupported, "add primary key column is not supported now")
+			}
+			attr := def.(*engine.AttributeDef).Attr
+			if _, ok := attrs[attr.Name]; ok {
+				return errors.New(errno.DuplicateColumn, fmt.Sprintf("Duplicate column name '%s'", attr.Name))
+			}
+			attrs[attr.Name] = attr
+			defs = append(defs, AlterTableDef{Def: def})
+		case *tree.AlterTableDropColumn:
+			name := string(o.Name)
+			attr, ok := attrs[name]
+			if !ok {
+				return errors.New(errno.UndefinedColumn, fmt.Sprintf("Can't DROP '%s'; check that column/key exists", name))
+			}
+			if _, ok := keys[name]; ok {
+				return errors.New(errno.FeatureNotSupported, fmt.Sprintf("drop key column '%s' is not supported now", name))
+			}
+			if len(attrs) == 1 {
+				return errors.New(errno.InvalidTableDefinition, "You can't delete all columns with ALTER TABLE; use DROP TABLE instead")
+			}
+			delete(attrs, name)
+			defs = append(defs, AlterTableDef{Drop: true, Def: &engine.AttributeDef{Attr: attr}})
+		case *tree.AlterTableRenameColumn:
+			name, newName := string(o.Name), string(o.NewName)
+			attr, ok := attrs[name]
+			if !ok {
+				return errors.New(errno.UndefinedColumn, fmt.Sprintf("Unknown column '%s' in '%s'", name, id))
+			}
+			if _, ok := keys[name]; ok {
+				return errors.New(errno.FeatureNotSupported, fmt.Sprintf("rename key column '%s' is not supported now", name))
+			}
+			if _, ok := attrs[newName]; ok {
+				return errors.New(errno.DuplicateColumn, fmt.Sprintf("Duplicate column name '%s'", newName))
+			}
+			delete(attrs, name)
+			attr.Name = newName
+			attrs[newName] = attr
+			defs = append(defs, AlterTableDef{Def: &engine.RenameColumnDef{Name: name, NewName: newName}})
+		case *tree.AlterTableColumnDefault:
+			name := string(o.Name)
+			attr, ok := attrs[name]
+			if !ok {
+				return errors.New(errno.UndefinedColumn, fmt.Sprintf("Unknown column '%s' in '%s'", name, id))
+			}
+			dflt := engine.EmptyDefaultExpr
+			if o.Expr != nil {
+				column := &tree.ColumnTableDef{
+					Name:       tree.SetUnresolvedName(name),
+					Attributes: []tree.ColumnAttribute{tree.NewAttributeDefault(o.Expr)},
+				}
+				if dflt, err = getDefaultExprFromColumnDef(column, &attr.Type); err != nil {
+					return err
+				}
+			}
+			attr.Default = dflt
+			attrs[name] = attr
+			defs = append(defs, AlterTableDef{Def: &engine.ColumnDefaultDef{Name: name, Default: dflt}})
+		case *tree.AlterTableRename:
+			if len(o.Name.SchemaName) > 0 && string(o.Name.SchemaName) != dbName {
+				return errors.New(errno.FeatureNotSupported, "rename table to another database is not supported now")
+			}
+			newName := string(o.Name.ObjectName)
+			if newName == id {
+				continue
+			}
+			db, err := b.e.Database(dbName)
+			if err != nil {
+				return errors.New(errno.InvalidSchemaName, err.Error())
+			}
+			if nr, err := db.Relation(newName); err == nil {
+				nr.Close()
+				return errors.New(errno.DuplicateTable, fmt.Sprintf("Table '%s' already exists", newName))
+			}
+			defs = append(defs, AlterTableDef{Def: &engine.RenameTableDef{Name: newName}})
+		default:
+			return errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport alter table option: '%v'", opt))
+		}
+	}
+	plan.Id = id
+	plan.Relation = r
+	plan.Defs = defs
+	return nil
+}
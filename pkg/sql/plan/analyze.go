@@ -0,0 +1,76 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// BuildAnalyze builds the query reading the columns to be analyzed, all the
+// columns are analyzed if no column is given.
+func (b *build) BuildAnalyze(stmt *tree.AnalyzeStmt, plan *Analyze) error {
+	_, id, r, err := b.tableName(stmt.Table)
+	if err != nil {
+		return err
+	}
+	attrs := make(map[string]engine.Attribute)
+	var names []string
+	for _, def := range r.TableDefs() {
+		if v, ok := def.(*engine.AttributeDef); ok {
+			attrs[v.Attr.Name] = v.Attr
+			names = append(names, v.Attr.Name)
+		}
+	}
+	if len(stmt.Cols) > 0 {
+		names = names[:0]
+		mp := make(map[string]struct{})
+		for _, col := range stmt.Cols {
+			name := string(col)
+			if _, ok := attrs[name]; !ok {
+				r.Close()
+				return errors.New(errno.UndefinedColumn, fmt.Sprintf("Unknown column '%s' in '%s'", name, id))
+			}
+			if _, ok := mp[name]; !ok {
+				mp[name] = struct{}{}
+				names = append(names, name)
+			}
+		}
+	}
+	exprs := make(tree.SelectExprs, len(names))
+	for i, name := range names {
+		exprs[i] = tree.SelectExpr{Expr: tree.SetUnresolvedName(name)}
+		plan.Attrs = append(plan.Attrs, attrs[name])
+	}
+	sel := &tree.Select{
+		Select: &tree.SelectClause{
+			Exprs: exprs,
+			From:  &tree.From{Tables: tree.TableExprs{stmt.Table}},
+		},
+	}
+	pn, err := b.buildStatement(sel)
+	if err != nil {
+		r.Close()
+		return err
+	}
+	plan.Id = id
+	plan.Relation = r
+	plan.Qry = pn.(*Query)
+	return nil
+}
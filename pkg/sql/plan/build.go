@@ -21,18 +21,29 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
 
-func New(db string, sql string, e engine.Engine) *build {
+// New returns a plan builder, pc is the privilege checker of the user who runs
+// the statement, privileges are not checked if it is nil.
+func New(db string, sql string, e engine.Engine, pc *privilege.Checker) *build {
 	return &build{
 		e:   e,
 		db:  db,
 		sql: sql,
+		pc:  pc,
 	}
 }
 
 func (b *build) BuildStatement(stmt tree.Statement) (Plan, error) {
+	if err := b.checkPrivilege(stmt); err != nil {
+		return nil, err
+	}
+	return b.buildStatement(stmt)
+}
+
+func (b *build) buildStatement(stmt tree.Statement) (Plan, error) {
 	switch stmt := stmt.(type) {
 	case *tree.Select:
 		qry := &Query{}
@@ -46,6 +57,23 @@ func (b *build) BuildStatement(stmt tree.Statement) (Plan, error) {
 			return nil, err
 		}
 		return qry, nil
+	case *tree.ExplainStmt:
+		if st, ok := stmt.Statement.(*tree.ShowColumns); ok { // describe table
+			return b.buildStatement(st)
+		}
+		plan := &Explain{}
+		if err := b.BuildExplain(stmt.Statement, stmt.GetFormat(), plan); err != nil {
+			return nil, err
+		}
+		return plan, nil
+	case *tree.ExplainAnalyze:
+		plan := &Explain{Analyze: true}
+		if err := b.BuildExplain(stmt.Statement, stmt.GetFormat(), plan); err != nil {
+			return nil, err
+		}
+		return plan, nil
+	case *tree.ExplainFor:
+		return nil, errors.New(errno.FeatureNotSupported, "explain for connection is not supported now")
 	case *tree.Insert:
 		plan := &Insert{}
 		if err := b.BuildInsert(stmt, plan); err != nil {
@@ -64,6 +92,18 @@ func (b *build) BuildStatement(stmt tree.Statement) (Plan, error) {
 			return nil, err
 		}
 		return plan, nil
+	case *tree.AlterTable:
+		plan := &AlterTable{}
+		if err := b.BuildAlterTable(stmt, plan); err != nil {
+			return nil, err
+		}
+		return plan, nil
+	case *tree.AnalyzeStmt:
+		plan := &Analyze{}
+		if err := b.BuildAnalyze(stmt, plan); err != nil {
+			return nil, err
+		}
+		return plan, nil
 	case *tree.DropDatabase:
 		plan := &DropDatabase{E: b.e}
 		if err := b.BuildDropDatabase(stmt, plan); err != nil {
@@ -124,6 +164,42 @@ func (b *build) BuildStatement(stmt tree.Statement) (Plan, error) {
 			return nil, err
 		}
 		return plan, nil
+	case *tree.Update:
+		plan := &Update{}
+		if err := b.buildUpdatePlan(stmt, plan); err != nil {
+			return nil, err
+		}
+		return plan, nil
+	case *tree.CreateUser:
+		plan := &CreateUser{}
+		if err := b.BuildCreateUser(stmt, plan); err != nil {
+			return nil, err
+		}
+		return plan, nil
+	case *tree.DropUser:
+		plan := &DropUser{}
+		if err := b.BuildDropUser(stmt, plan); err != nil {
+			return nil, err
+		}
+		return plan, nil
+	case *tree.AlterUser:
+		plan := &AlterUser{}
+		if err := b.BuildAlterUser(stmt, plan); err != nil {
+			return nil, err
+		}
+		return plan, nil
+	case *tree.Grant:
+		plan := &Grant{}
+		if err := b.BuildGrant(stmt, plan); err != nil {
+			return nil, err
+		}
+		return plan, nil
+	case *tree.Revoke:
+		plan := &Revoke{}
+		if err := b.BuildRevoke(stmt, plan); err != nil {
+			return nil, err
+		}
+		return plan, nil
 	}
 	return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unexpected statement: '%v'", tree.String(stmt, dialect.MYSQL)))
 }
@@ -48,6 +48,11 @@ var querys = []string{
 	"select distinct sum(spID) as sum from t1 where score>1 group by userID order by sum asc;",
 	"select userID,MAX(score) from t1 where userID between 2 and 3 group by userID;",
 	"select userID,MAX(score) from t1 where userID not between 2 and 3 group by userID order by userID desc;",
+	"select spID,userID,score,row_number() over (partition by userID order by score desc) as rn from t1 order by rn;",
+	"select userID,sum(score) over (order by spID rows between 1 preceding and current row),lag(score, 2) over (order by spID) from t1;",
+	"select userID,MAX(score),rank() over (order by MAX(score) desc) from t1 group by userID;",
+	"select spID,case when score > 1 then userID else 0 end,if(score > 1, score, 0),ifnull(score, 0),nullif(userID, 2) from t1;",
+	"select spID from t1 order by case userID when 1 then 0 else 1 end;",
 	"select spID,userID,score from t1 limit 2,1;",
 	"select spID,userID,score from t1 limit 2 offset 1;",
 	"select sum(score) as sum from t1 where spID=6 group by score order by sum desc;",
@@ -194,7 +199,7 @@ func processQuery(query string, e engine.Engine) {
 	if err != nil {
 		log.Fatal(err)
 	}
-	b := New("test", query, e)
+	b := New("test", query, e, nil)
 	for _, stmt := range stmts {
 		fmt.Printf("%s\n", query)
 		qry, err := b.BuildStatement(stmt)
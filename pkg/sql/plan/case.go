@@ -0,0 +1,355 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"go/constant"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// conditionalFuncs are the functions which are built as conditional extends.
+var conditionalFuncs = map[string]struct{}{
+	"if":       {},
+	"ifnull":   {},
+	"coalesce": {},
+	"nullif":   {},
+}
+
+func (b *build) buildCase(e *tree.CaseExpr, qry *Query, fn func(tree.Expr, *Query) (extend.Extend, error)) (extend.Extend, error) {
+	ce := new(extend.CaseExtend)
+	for _, w := range e.Whens {
+		var err error
+		var cond, res extend.Extend
+
+		if e.Expr != nil {
+			if isNullValue(w.Cond) { // x = null never holds
+				continue
+			}
+			cond, err = b.buildComparison(tree.NewComparisonExpr(tree.EQUAL, e.Expr, w.Cond), qry, fn)
+		} else {
+			cond, err = fn(w.Cond, qry)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if res, err = b.buildResult(w.Val, qry, fn); err != nil {
+			return nil, err
+		}
+		ce.Conds = append(ce.Conds, buildCondition(cond))
+		ce.Ress = append(ce.Ress, res)
+	}
+	if e.Else != nil {
+		var err error
+
+		if ce.Else, err = b.buildResult(e.Else, qry, fn); err != nil {
+			return nil, err
+		}
+	}
+	return ce, nil
+}
+
+// buildConditionalFunc builds the function if, ifnull, coalesce or nullif.
+func (b *build) buildConditionalFunc(name string, e *tree.FuncExpr, qry *Query, fn func(tree.Expr, *Query) (extend.Extend, error)) (extend.Extend, error) {
+	switch name {
+	case "if":
+		if len(e.Exprs) != 3 {
+			break
+		}
+		return b.buildCase(tree.NewCaseExpr(nil, []*tree.When{tree.NewWhen(e.Exprs[0], e.Exprs[1])}, e.Exprs[2]), qry, fn)
+	case "nullif":
+		if len(e.Exprs) != 2 {
+			break
+		}
+		if isNullValue(e.Exprs[1]) {
+			return b.buildCase(tree.NewCaseExpr(nil, nil, e.Exprs[0]), qry, fn)
+		}
+		return b.buildCase(tree.NewCaseExpr(nil, []*tree.When{
+			tree.NewWhen(tree.NewComparisonExpr(tree.EQUAL, e.Exprs[0], e.Exprs[1]), tree.NewNumVal(constant.MakeUnknown(), "null", false)),
+		}, e.Exprs[0]), qry, fn)
+	case "ifnull", "coalesce":
+		if len(e.Exprs) == 0 || (name == "ifnull" && len(e.Exprs) != 2) {
+			break
+		}
+		ce := new(extend.CoalesceExtend)
+		for _, expr := range e.Exprs {
+			arg, err := b.buildResult(expr, qry, fn)
+			if err != nil {
+				return nil, err
+			}
+			if arg != nil {
+				ce.Args = append(ce.Args, arg)
+			}
+		}
+		if len(ce.Args) == 0 {
+			return nil, errors.New(errno.IndeterminateDatatype, fmt.Sprintf("'%s' is always null", tree.String(e, dialect.MYSQL)))
+		}
+		return ce, nil
+	}
+	return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("Incorrect parameter count in the call to native function '%s'", name))
+}
+
+// buildIn builds the in or not in expression whose right side is a list.
+func (b *build) buildIn(e *tree.ComparisonExpr, qry *Query, fn func(tree.Expr, *Query) (extend.Extend, error)) (extend.Extend, error) {
+	tuple := e.Right.(*tree.Tuple)
+	left, err := fn(e.Left, qry)
+	if err != nil {
+		return nil, err
+	}
+	ie := &extend.InExtend{Not: e.Op == tree.NOT_IN, E: left}
+	hasNull := false
+	for _, expr := range tuple.Exprs {
+		if isNullValue(expr) {
+			hasNull = true
+			continue
+		}
+		v, err := fn(expr, qry)
+		if err != nil {
+			return nil, err
+		}
+		ie.Vs = append(ie.Vs, v)
+	}
+	var r extend.Extend
+	if len(ie.Vs) > 0 {
+		r = ie
+	}
+	if hasNull {
+		// x in (..., null) is null unless it holds, and x not in (..., null)
+		// is null unless it does not hold.
+		if ie.Not {
+			r = andExtend(r, nullValue())
+		} else {
+			r = orExtend(r, nullValue())
+		}
+	}
+	return r, nil
+}
+
+// buildResult builds a result of conditional expression, nil means null.
+func (b *build) buildResult(n tree.Expr, qry *Query, fn func(tree.Expr, *Query) (extend.Extend, error)) (extend.Extend, error) {
+	if isNullValue(n) {
+		return nil, nil
+	}
+	e, err := fn(n, qry)
+	if err != nil {
+		return nil, err
+	}
+	if e.IsLogical() {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support in conditional expression", e))
+	}
+	return e, nil
+}
+
+// buildCondition makes e be a logical extend, a value holds if it is not zero.
+func buildCondition(e extend.Extend) extend.Extend {
+	switch v := e.(type) {
+	case *extend.ParenExtend:
+		v.E = buildCondition(v.E)
+		return v
+	case *extend.UnaryExtend:
+		if v.Op == overload.Not {
+			v.E = buildCondition(v.E)
+			return v
+		}
+	case *extend.BinaryExtend:
+		if v.Op == overload.And || v.Op == overload.Or {
+			v.Left, v.Right = buildCondition(v.Left), buildCondition(v.Right)
+			return v
+		}
+	}
+	if e.IsLogical() || e.IsConstant() || e.ReturnType() == types.T_sel {
+		return e
+	}
+	zero, _ := buildValue(constant.MakeInt64(0))
+	return &extend.BinaryExtend{Op: overload.NE, Left: e, Right: zero}
+}
+
+func isNullValue(n tree.Expr) bool {
+	switch e := n.(type) {
+	case *tree.ParenExpr:
+		return isNullValue(e.Expr)
+	case *tree.NumVal:
+		return e.Value.Kind() == constant.Unknown
+	}
+	return false
+}
+
+// pruneCase removes the branches whose condition is constant, the first
+// branch whose condition always holds becomes the else branch.
+func (b *build) pruneCase(e *extend.CaseExtend) (extend.Extend, error) {
+	var err error
+
+	for i := 0; i < len(e.Conds); i++ {
+		if e.Conds[i], err = b.pruneExtend(e.Conds[i], false); err != nil {
+			return nil, err
+		}
+		if e.Ress[i] != nil {
+			if e.Ress[i], err = b.pruneExtend(e.Ress[i], true); err != nil {
+				return nil, err
+			}
+			if isNull(e.Ress[i]) {
+				e.Ress[i] = nil
+			}
+		}
+		v, ok := e.Conds[i].(*extend.ValueExtend)
+		if !ok {
+			continue
+		}
+		if !isZero(v) {
+			e.Else = e.Ress[i]
+			e.Conds, e.Ress = e.Conds[:i], e.Ress[:i]
+			break
+		}
+		e.Conds = append(e.Conds[:i], e.Conds[i+1:]...)
+		e.Ress = append(e.Ress[:i], e.Ress[i+1:]...)
+		i--
+	}
+	if e.Else != nil {
+		if e.Else, err = b.pruneExtend(e.Else, true); err != nil {
+			return nil, err
+		}
+		if isNull(e.Else) {
+			e.Else = nil
+		}
+	}
+	if isNullCase(e) {
+		return nil, errors.New(errno.IndeterminateDatatype, fmt.Sprintf("'%s' is always null", e))
+	}
+	if len(e.Conds) == 0 {
+		return e.Else, nil
+	}
+	if e.ReturnType() == types.T_any {
+		return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("incompatible results in '%s'", e))
+	}
+	return e, nil
+}
+
+func isNullCase(e *extend.CaseExtend) bool {
+	for _, r := range e.Ress {
+		if r != nil {
+			return false
+		}
+	}
+	return e.Else == nil
+}
+
+// pruneCoalesce removes the arguments after the first constant.
+func (b *build) pruneCoalesce(e *extend.CoalesceExtend) (extend.Extend, error) {
+	var err error
+
+	for i := 0; i < len(e.Args); i++ {
+		if e.Args[i], err = b.pruneExtend(e.Args[i], true); err != nil {
+			return nil, err
+		}
+		if isNull(e.Args[i]) {
+			e.Args = append(e.Args[:i], e.Args[i+1:]...)
+			i--
+			continue
+		}
+		if e.Args[i].IsConstant() {
+			e.Args = e.Args[:i+1]
+			break
+		}
+	}
+	if len(e.Args) == 0 {
+		return nil, errors.New(errno.IndeterminateDatatype, fmt.Sprintf("'%s' is always null", e))
+	}
+	if len(e.Args) == 1 {
+		return e.Args[0], nil
+	}
+	if e.ReturnType() == types.T_any {
+		return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("incompatible results in '%s'", e))
+	}
+	return e, nil
+}
+
+// pruneIn folds the constant in expression, and the list which is not
+// constant is rewritten to be comparisons.
+func (b *build) pruneIn(e *extend.InExtend) (extend.Extend, error) {
+	var err error
+
+	if e.E, err = b.pruneExtend(e.E, true); err != nil {
+		return nil, err
+	}
+	if isNull(e.E) {
+		return nullValue(), nil
+	}
+	constant := true
+	for i := range e.Vs {
+		if e.Vs[i], err = b.pruneExtend(e.Vs[i], true); err != nil {
+			return nil, err
+		}
+		if !e.Vs[i].IsConstant() {
+			constant = false
+		}
+	}
+	if !constant {
+		var r extend.Extend
+
+		for _, v := range e.Vs {
+			if e.Not {
+				r = andExtend(r, &extend.BinaryExtend{Op: overload.NE, Left: e.E, Right: v})
+			} else {
+				r = orExtend(r, &extend.BinaryExtend{Op: overload.EQ, Left: e.E, Right: v})
+			}
+		}
+		return b.pruneExtend(r, false)
+	}
+	lv, ok := e.E.(*extend.ValueExtend)
+	if !ok {
+		return e, nil
+	}
+	flg := false
+	for _, v := range e.Vs {
+		r, err := Eq(lv, v.(*extend.ValueExtend))
+		if err != nil {
+			return nil, err
+		}
+		if !isZero(r.(*extend.ValueExtend)) {
+			flg = true
+			break
+		}
+	}
+	vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	vec.Ref = 1
+	if flg != e.Not {
+		vector.SetCol(vec, []int64{1})
+	} else {
+		vector.SetCol(vec, []int64{0})
+	}
+	return &extend.ValueExtend{V: vec}, nil
+}
+
+func andExtend(l, r extend.Extend) extend.Extend {
+	if l == nil {
+		return r
+	}
+	return &extend.BinaryExtend{Op: overload.And, Left: l, Right: r}
+}
+
+func orExtend(l, r extend.Extend) extend.Extend {
+	if l == nil {
+		return r
+	}
+	return &extend.BinaryExtend{Op: overload.Or, Left: l, Right: r}
+}
@@ -17,6 +17,7 @@ package plan
 import (
 	"bytes"
 	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/errno"
@@ -410,6 +411,23 @@ func minus(x, y *extend.ValueExtend) (extend.Extend, error) {
 	return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf(" %s cannot minus %s", y.V.Typ, x.V.Typ))
 }
 
+// nullValue returns the constant null. It is typed as a zero of int64, so a
+// null condition never holds as the three-valued logic requires once the
+// not operators are pushed down.
+func nullValue() *extend.ValueExtend {
+	vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	vec.Ref = 1
+	vec.Col = []int64{0}
+	nulls.Add(vec.Nsp, 0)
+	return &extend.ValueExtend{V: vec}
+}
+
+// isNull returns true if e is the constant null.
+func isNull(e extend.Extend) bool {
+	v, ok := e.(*extend.ValueExtend)
+	return ok && nulls.Contains(v.V.Nsp, 0)
+}
+
 func isZero(e *extend.ValueExtend) bool {
 	if e.V.Typ.Oid == types.T_int64 && e.V.Col.([]int64)[0] == 0 {
 		return true
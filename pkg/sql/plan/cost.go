@@ -0,0 +1,301 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// the statistics based estimates are only used when all the inputs to be
+// compared have statistics, the shape of the join graph decides otherwise.
+
+// columnStatistics returns the statistics of attr, nil if attr is not an
+// attribute of an analyzed relation.
+func (s *Scope) columnStatistics(attr string) *engine.ColumnStatistics {
+	switch op := s.Op.(type) {
+	case *Relation:
+		if op.Stats == nil {
+			return nil
+		}
+		return op.Stats.Column(attr)
+	case *Projection:
+		for i, name := range op.As {
+			if name != attr {
+				continue
+			}
+			if e, ok := op.Es[i].(*extend.Attribute); ok && len(s.Children) == 1 {
+				return s.Children[0].columnStatistics(e.Name)
+			}
+			return nil
+		}
+	case *Restrict:
+		return s.Children[0].columnStatistics(attr)
+	}
+	return nil
+}
+
+// statistics returns the statistics of the relation which the rows of s
+// are read from, nil if the relation is not analyzed.
+func (s *Scope) statistics() *engine.StatisticsDef {
+	switch op := s.Op.(type) {
+	case *Relation:
+		return op.Stats
+	case *Projection, *Restrict:
+		if len(s.Children) == 1 {
+			return s.Children[0].statistics()
+		}
+	}
+	return nil
+}
+
+// estimateSelectivities estimates the selectivities of the filters on the
+// inputs of join, a filter is counted if all its attributes belong to one input.
+func (b *build) estimateSelectivities(es []extend.Extend, ss *ScopeSet) []float64 {
+	var ok bool
+
+	sels := make([]float64, len(ss.Scopes))
+	for i := range sels {
+		sels[i] = 1
+	}
+	for _, e := range es {
+		i := -1
+		attrs := make(map[string]string)
+		for _, attr := range e.Attributes() {
+			j, name, err := b.getJoinAttribute(getUnresolvedName(attr), ss)
+			if err != nil || (i >= 0 && i != j) {
+				i = -1
+				break
+			}
+			i = j
+			attrs[attr] = name
+		}
+		if i < 0 || ss.Scopes[i].statistics() == nil {
+			continue
+		}
+		s := ss.Scopes[i]
+		sels[i] *= selectivity(e, func(attr string) *engine.ColumnStatistics {
+			return s.columnStatistics(attrs[attr])
+		})
+		ok = true
+	}
+	if !ok {
+		return nil
+	}
+	return sels
+}
+
+// estimateRows estimates the rows of the i-th input after its filters,
+// -1 if the input is not analyzed. The rows counted by ANALYZE TABLE are
+// used if the relation does not count its rows.
+func estimateRows(i int, ss []*Scope, sels []float64) float64 {
+	stats := ss[i].statistics()
+	if stats == nil {
+		return -1
+	}
+	rows := float64(ss[i].Rows())
+	if rows <= 0 {
+		rows = float64(stats.Rows)
+	}
+	if sels != nil {
+		rows *= sels[i]
+	}
+	return rows
+}
+
+// largestInput returns the input with the most estimated rows, the one with
+// more edges wins a tie. It returns -1 if any of inputs is not analyzed.
+func largestInput(is []int, ss []*Scope, sels []float64, ess []*EdgeSet) int {
+	j := -1
+	var max float64
+	for _, i := range is {
+		rows := estimateRows(i, ss, sels)
+		if rows < 0 {
+			return -1
+		}
+		if j < 0 || rows > max || (rows == max && getEdgesCount(i, ess) > getEdgesCount(j, ess)) {
+			j, max = i, rows
+		}
+	}
+	return j
+}
+
+// estimateFanout estimates the number of rows of the input es.I2 matching
+// a row of the input es.I1, -1 if either of them is not analyzed. The join
+// selectivity of a shared vertex is 1 / max(ndv(R.v), ndv(S.v)).
+func estimateFanout(es *EdgeSet, ss []*Scope, sels []float64) float64 {
+	rows := estimateRows(es.I2, ss, sels)
+	if rows < 0 || ss[es.I1].statistics() == nil {
+		return -1
+	}
+	for _, v := range connectedVertexs(es.E1.Vs, es.E2.Vs) {
+		attr := strconv.Itoa(v)
+		ndv := int64(1)
+		for _, s := range []*Scope{ss[es.I1], ss[es.I2]} {
+			if col := s.columnStatistics(attr); col != nil && col.Ndv > ndv {
+				ndv = col.Ndv
+			}
+		}
+		rows /= float64(ndv)
+	}
+	return rows
+}
+
+// selectivity estimates the fraction of rows satisfying e, stats returns
+// the statistics of an attribute. It is 1 if the fraction is unknown.
+func selectivity(e extend.Extend, stats func(string) *engine.ColumnStatistics) float64 {
+	switch v := e.(type) {
+	case *extend.ParenExtend:
+		return selectivity(v.E, stats)
+	case *extend.BinaryExtend:
+		switch v.Op {
+		case overload.And:
+			return selectivity(v.Left, stats) * selectivity(v.Right, stats)
+		case overload.Or:
+			l, r := selectivity(v.Left, stats), selectivity(v.Right, stats)
+			return l + r - l*r
+		case overload.EQ, overload.NE, overload.LT, overload.LE, overload.GT, overload.GE:
+			op := v.Op
+			attr, ok := v.Left.(*extend.Attribute)
+			val, vok := v.Right.(*extend.ValueExtend)
+			if !ok || !vok {
+				if attr, ok = v.Right.(*extend.Attribute); !ok {
+					return 1
+				}
+				if val, vok = v.Left.(*extend.ValueExtend); !vok {
+					return 1
+				}
+				op = swapComparison(op)
+			}
+			col := stats(attr.Name)
+			if col == nil {
+				return 1
+			}
+			return comparisonSelectivity(op, col, val)
+		}
+	}
+	return 1
+}
+
+// comparisonSelectivity estimates the fraction of rows satisfying attr op val,
+// the fraction of a range is estimated by the histogram if it exists, otherwise
+// by the min and max of attribute.
+func comparisonSelectivity(op int, col *engine.ColumnStatistics, val *extend.ValueExtend) float64 {
+	eq := float64(1)
+	if col.Ndv > 0 {
+		eq = 1 / float64(col.Ndv)
+	}
+	switch op {
+	case overload.EQ:
+		return eq
+	case overload.NE:
+		return 1 - eq
+	}
+	x, ok := numericValue(val)
+	if !ok || !col.HasRange {
+		return 1
+	}
+	lt := fractionBelow(x, col) // fraction of values less than x
+	switch op {
+	case overload.LT:
+		return lt
+	case overload.LE:
+		return clamp(lt + eq)
+	case overload.GT:
+		return clamp(1 - lt - eq)
+	default: // overload.GE
+		return 1 - lt
+	}
+}
+
+// fractionBelow estimates the fraction of values less than x.
+func fractionBelow(x float64, col *engine.ColumnStatistics) float64 {
+	if x <= col.Min {
+		return 0
+	}
+	if x > col.Max {
+		return 1
+	}
+	bs := col.Histogram
+	if len(bs) < 2 {
+		if col.Max == col.Min {
+			return 0
+		}
+		return (x - col.Min) / (col.Max - col.Min)
+	}
+	// bs[i-1] < x <= bs[i]
+	i := sort.SearchFloat64s(bs, x)
+	if i == 0 {
+		return 0
+	}
+	if i == len(bs) {
+		return 1
+	}
+	f := float64(i - 1)
+	if bs[i] > bs[i-1] {
+		f += (x - bs[i-1]) / (bs[i] - bs[i-1])
+	}
+	return f / float64(len(bs)-1)
+}
+
+func numericValue(val *extend.ValueExtend) (float64, bool) {
+	if val.V == nil || nulls.Contains(val.V.Nsp, 0) {
+		return 0, false
+	}
+	switch vs := val.V.Col.(type) {
+	case []int64:
+		return float64(vs[0]), true
+	case []uint64:
+		return float64(vs[0]), true
+	case []float64:
+		return vs[0], true
+	case []types.Date:
+		return float64(vs[0]), true
+	case []types.Datetime:
+		return float64(vs[0]), true
+	}
+	return 0, false
+}
+
+// swapComparison returns the operator of comparison whose operands are swapped.
+func swapComparison(op int) int {
+	switch op {
+	case overload.LT:
+		return overload.GT
+	case overload.LE:
+		return overload.GE
+	case overload.GT:
+		return overload.LT
+	case overload.GE:
+		return overload.LE
+	}
+	return op
+}
+
+func clamp(f float64) float64 {
+	switch {
+	case f < 0:
+		return 0
+	case f > 1:
+		return 1
+	}
+	return f
+}
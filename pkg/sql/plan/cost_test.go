@@ -0,0 +1,124 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectivity(t *testing.T) {
+	col := &engine.ColumnStatistics{
+		Name:      "a",
+		Ndv:       10,
+		HasRange:  true,
+		Min:       0,
+		Max:       100,
+		Histogram: []float64{0, 10, 20, 100},
+	}
+	stats := func(string) *engine.ColumnStatistics { return col }
+	value := func(v int64) *extend.ValueExtend {
+		return &extend.ValueExtend{V: testutil.MakeInt64Vector([]int64{v}, 0)}
+	}
+	attr := &extend.Attribute{Name: "a"}
+	tests := []struct {
+		e    extend.Extend
+		want float64
+	}{
+		{&extend.BinaryExtend{Op: overload.EQ, Left: attr, Right: value(5)}, 0.1},
+		{&extend.BinaryExtend{Op: overload.NE, Left: attr, Right: value(5)}, 0.9},
+		{&extend.BinaryExtend{Op: overload.LT, Left: attr, Right: value(10)}, 1.0 / 3},
+		{&extend.BinaryExtend{Op: overload.GE, Left: attr, Right: value(20)}, 1.0 / 3},
+		{&extend.BinaryExtend{Op: overload.GT, Left: value(15), Right: attr}, 0.5},
+		{&extend.BinaryExtend{Op: overload.LT, Left: attr, Right: value(-1)}, 0},
+		{&extend.BinaryExtend{Op: overload.GE, Left: attr, Right: value(200)}, 0},
+		{&extend.BinaryExtend{
+			Op:    overload.And,
+			Left:  &extend.BinaryExtend{Op: overload.GE, Left: attr, Right: value(10)},
+			Right: &extend.BinaryExtend{Op: overload.EQ, Left: attr, Right: value(15)},
+		}, 2.0 / 3 * 0.1},
+		{&extend.BinaryExtend{Op: overload.Plus, Left: attr, Right: value(1)}, 1},
+	}
+	for _, tt := range tests {
+		require.InDelta(t, tt.want, selectivity(tt.e, stats), 1e-9, tt.e.String())
+	}
+}
+
+// TestJoinOrder checks that the fact of join is the input with the most rows
+// after the filters, which are estimated by the statistics.
+func TestJoinOrder(t *testing.T) {
+	e := memEngine.NewTestEngine()
+	db, err := e.Database("test")
+	require.NoError(t, err)
+	for _, name := range []string{"R", "S"} {
+		r, err := db.Relation(name)
+		require.NoError(t, err)
+		require.NoError(t, r.AddTableDef(0, &engine.StatisticsDef{
+			Rows: 20,
+			Columns: []engine.ColumnStatistics{
+				{Name: "uid", Ndv: 4, HasRange: true, Min: 0, Max: 3},
+				{Name: "price", Ndv: 100, HasRange: true, Min: 0, Max: 100},
+			},
+		}))
+		r.Close()
+	}
+	tests := []struct {
+		sql  string
+		fact string
+	}{
+		{"select * from R, S where R.uid = S.uid and R.price < 1", "S"},
+		{"select * from R, S where R.uid = S.uid and S.price < 1", "R"},
+		{"select * from S, R where S.uid = R.uid and R.price > 99", "S"},
+	}
+	for _, tt := range tests {
+		stmts, err := parsers.Parse(dialect.MYSQL, tt.sql)
+		require.NoError(t, err)
+		pn, err := New("test", tt.sql, e, nil).BuildStatement(stmts[0])
+		require.NoError(t, err)
+		s := findJoin(pn.(*Query).Scope)
+		require.NotNil(t, s, tt.sql)
+		require.Equal(t, tt.fact, relationName(s.Children[0]), tt.sql)
+	}
+}
+
+func findJoin(s *Scope) *Scope {
+	if _, ok := s.Op.(*Join); ok {
+		return s
+	}
+	for _, child := range s.Children {
+		if js := findJoin(child); js != nil {
+			return js
+		}
+	}
+	return nil
+}
+
+func relationName(s *Scope) string {
+	if rel, ok := s.Op.(*Relation); ok {
+		return rel.Name
+	}
+	if len(s.Children) != 1 {
+		return ""
+	}
+	return relationName(s.Children[0])
+}
@@ -224,11 +224,35 @@ func (b *build) getTableDefType(typ tree.ResolvableTypeReference) (*types.Type,
 			return &types.Type{Oid: types.T_date, Size: 4}, nil
 		case defines.MYSQL_TYPE_DATETIME:
 			return &types.Type{Oid: types.T_datetime, Size: 8}, nil
+		case defines.MYSQL_TYPE_DECIMAL:
+			t, err := getDecimalType(n.InternalType)
+			if err != nil {
+				return nil, err
+			}
+			return &t, nil
 		}
 	}
 	return nil, errors.New(errno.IndeterminateDatatype, fmt.Sprintf("unsupport type: '%v'", typ))
 }
 
+// getDecimalType returns the type of DECIMAL(M, D), M is 10 and D is 0 if they are not specified.
+func getDecimalType(typ tree.InternalType) (types.Type, error) {
+	precision, scale := typ.DisplayWith, typ.Precision
+	if precision <= 0 {
+		precision = types.DefaultDecimalPrecision
+	}
+	if scale < 0 {
+		scale = 0
+	}
+	if precision > types.MaxDecimalPrecision {
+		return types.Type{}, errors.New(errno.FeatureNotSupported, fmt.Sprintf("Too-big precision %d specified for decimal. Maximum is %d.", precision, types.MaxDecimalPrecision))
+	}
+	if scale > precision {
+		return types.Type{}, errors.New(errno.InvalidColumnDefinition, "For float(M,D), double(M,D) or decimal(M,D), M must be >= D")
+	}
+	return types.DecimalType(precision, scale), nil
+}
+
 // getDefaultExprFromColumnDef returns
 // has default expr or not / column default expr string / is null expression / error msg
 // from column definition when create table.
@@ -356,6 +380,11 @@ func rangeCheck(value interface{}, typ types.Type, columnName string, rowNumber
 		return nil, errors.New(errno.DataException, fmt.Sprintf("Data too long for column '%s' at row %d", columnName, rowNumber))
 	case types.Date, types.Datetime:
 		return v, nil
+	case types.Decimal:
+		if typ.Oid == types.T_decimal {
+			return v, nil
+		}
+		return nil, errors.New(errno.DatatypeMismatch, "unexpected type and value")
 	default:
 		return nil, errors.New(errno.DatatypeMismatch, "unexpected type and value")
 	}
@@ -24,7 +24,8 @@ func (b *build) buildDeletePlan(deleteStmt *tree.Delete, plan *Delete) error {
 	selectStmt = rewrite.Rewrite(selectStmt)
 	selectStmt = rewrite.AstRewrite(selectStmt)
 
-	queryPlan, err := b.BuildStatement(selectStmt)
+	b.hideKey = true
+	queryPlan, err := b.buildStatement(selectStmt)
 	if err != nil {
 		return err
 	}
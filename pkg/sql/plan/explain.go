@@ -0,0 +1,42 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+func (b *build) BuildExplain(stmt tree.Statement, format string, plan *Explain) error {
+	switch format {
+	case "", "row", "tree", "traditional":
+	default:
+		return errors.New(errno.FeatureNotSupported, fmt.Sprintf("explain format '%s' is not supported now", format))
+	}
+	pn, err := b.buildStatement(stmt)
+	if err != nil {
+		return err
+	}
+	qry, ok := pn.(*Query)
+	if !ok {
+		return errors.New(errno.FeatureNotSupported, fmt.Sprintf("explain '%s' is not supported now", tree.String(stmt, dialect.MYSQL)))
+	}
+	plan.Qry = qry
+	return nil
+}
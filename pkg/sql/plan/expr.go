@@ -65,6 +65,8 @@ func buildValue(val constant.Value) (extend.Extend, error) {
 			Lengths: []uint32{uint32(len(v))},
 		}
 		return &extend.ValueExtend{V: vec}, nil
+	case constant.Unknown:
+		return nullValue(), nil
 	default:
 		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("unsupport value: %v", val))
 	}
@@ -192,6 +194,10 @@ func (b *build) buildCast(e *tree.CastExpr, qry *Query, fn func(tree.Expr, *Quer
 	case defines.MYSQL_TYPE_DATETIME:
 		typ.Size = 8
 		typ.Oid = types.T_datetime
+	case defines.MYSQL_TYPE_DECIMAL:
+		if typ, err = getDecimalType(e.Type.(*tree.T).InternalType); err != nil {
+			return nil, err
+		}
 	case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING:
 		typ.Size = 24
 		typ.Oid = types.T_varchar
@@ -226,6 +232,12 @@ func (b *build) buildFunc(flg bool, e *tree.FuncExpr, qry *Query, fn func(tree.E
 		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", e))
 	}
 	funcName := strings.ToLower(name.Parts[0])
+	if e.WindowSpec != nil {
+		return nil, errors.New(errno.WindowingError, fmt.Sprintf("You cannot use the window function '%s' in this context.", funcName))
+	}
+	if _, ok := conditionalFuncs[funcName]; ok {
+		return b.buildConditionalFunc(funcName, e, qry, fn)
+	}
 	if !flg {
 		if _, ok := transformer.TransformerNamesMap[funcName]; ok {
 			return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("Invalid use of group function"))
@@ -275,6 +287,7 @@ func (b *build) buildBinary(e *tree.BinaryExpr, qry *Query, fn func(tree.Expr, *
 		if err != nil {
 			return nil, err
 		}
+		left, right = decimalLiterals(e.Left, e.Right, left, right)
 		return &extend.BinaryExtend{Op: overload.Plus, Left: left, Right: right}, nil
 	case tree.MINUS:
 		left, err := fn(e.Left, qry)
@@ -285,6 +298,7 @@ func (b *build) buildBinary(e *tree.BinaryExpr, qry *Query, fn func(tree.Expr, *
 		if err != nil {
 			return nil, err
 		}
+		left, right = decimalLiterals(e.Left, e.Right, left, right)
 		return &extend.BinaryExtend{Op: overload.Minus, Left: left, Right: right}, nil
 	case tree.MULTI:
 		left, err := fn(e.Left, qry)
@@ -295,6 +309,7 @@ func (b *build) buildBinary(e *tree.BinaryExpr, qry *Query, fn func(tree.Expr, *
 		if err != nil {
 			return nil, err
 		}
+		left, right = decimalLiterals(e.Left, e.Right, left, right)
 		return &extend.BinaryExtend{Op: overload.Mult, Left: left, Right: right}, nil
 	case tree.MOD:
 		left, err := fn(e.Left, qry)
@@ -315,6 +330,7 @@ func (b *build) buildBinary(e *tree.BinaryExpr, qry *Query, fn func(tree.Expr, *
 		if err != nil {
 			return nil, err
 		}
+		left, right = decimalLiterals(e.Left, e.Right, left, right)
 		return &extend.BinaryExtend{Op: overload.Div, Left: left, Right: right}, nil
 	case tree.INTEGER_DIV:
 		left, err := fn(e.Left, qry)
@@ -332,6 +348,10 @@ func (b *build) buildBinary(e *tree.BinaryExpr, qry *Query, fn func(tree.Expr, *
 
 func (b *build) buildComparison(e *tree.ComparisonExpr, qry *Query, fn func(tree.Expr, *Query) (extend.Extend, error)) (extend.Extend, error) {
 	switch e.Op {
+	case tree.IN, tree.NOT_IN:
+		if _, ok := e.Right.(*tree.Tuple); ok {
+			return b.buildIn(e, qry, fn)
+		}
 	case tree.EQUAL:
 		left, err := fn(e.Left, qry)
 		if err != nil {
@@ -341,6 +361,7 @@ func (b *build) buildComparison(e *tree.ComparisonExpr, qry *Query, fn func(tree
 		if err != nil {
 			return nil, err
 		}
+		left, right = decimalLiterals(e.Left, e.Right, left, right)
 		return &extend.BinaryExtend{Op: overload.EQ, Left: left, Right: right}, nil
 	case tree.LESS_THAN:
 		left, err := fn(e.Left, qry)
@@ -351,6 +372,7 @@ func (b *build) buildComparison(e *tree.ComparisonExpr, qry *Query, fn func(tree
 		if err != nil {
 			return nil, err
 		}
+		left, right = decimalLiterals(e.Left, e.Right, left, right)
 		return &extend.BinaryExtend{Op: overload.LT, Left: left, Right: right}, nil
 	case tree.LESS_THAN_EQUAL:
 		left, err := fn(e.Left, qry)
@@ -361,6 +383,7 @@ func (b *build) buildComparison(e *tree.ComparisonExpr, qry *Query, fn func(tree
 		if err != nil {
 			return nil, err
 		}
+		left, right = decimalLiterals(e.Left, e.Right, left, right)
 		return &extend.BinaryExtend{Op: overload.LE, Left: left, Right: right}, nil
 	case tree.GREAT_THAN:
 		left, err := fn(e.Left, qry)
@@ -371,6 +394,7 @@ func (b *build) buildComparison(e *tree.ComparisonExpr, qry *Query, fn func(tree
 		if err != nil {
 			return nil, err
 		}
+		left, right = decimalLiterals(e.Left, e.Right, left, right)
 		return &extend.BinaryExtend{Op: overload.GT, Left: left, Right: right}, nil
 	case tree.GREAT_THAN_EQUAL:
 		left, err := fn(e.Left, qry)
@@ -381,6 +405,7 @@ func (b *build) buildComparison(e *tree.ComparisonExpr, qry *Query, fn func(tree
 		if err != nil {
 			return nil, err
 		}
+		left, right = decimalLiterals(e.Left, e.Right, left, right)
 		return &extend.BinaryExtend{Op: overload.GE, Left: left, Right: right}, nil
 	case tree.NOT_EQUAL:
 		left, err := fn(e.Left, qry)
@@ -391,6 +416,7 @@ func (b *build) buildComparison(e *tree.ComparisonExpr, qry *Query, fn func(tree
 		if err != nil {
 			return nil, err
 		}
+		left, right = decimalLiterals(e.Left, e.Right, left, right)
 		return &extend.BinaryExtend{Op: overload.NE, Left: left, Right: right}, nil
 	case tree.LIKE:
 		left, err := fn(e.Left, qry)
@@ -402,10 +428,90 @@ func (b *build) buildComparison(e *tree.ComparisonExpr, qry *Query, fn func(tree
 			return nil, err
 		}
 		return &extend.BinaryExtend{Op: overload.Like, Left: left, Right: right}, nil
+	case tree.IS_DISTINCT_FROM, tree.IS_NOT_DISTINCT_FROM:
+		return fn(rewriteDistinctFrom(e), qry)
 	}
 	return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", e))
 }
 
+// decimalLiterals makes a fractional literal be a decimal of its own scale if
+// the other operand is a decimal, so that the operator is not evaluated by doubles.
+func decimalLiterals(l, r tree.Expr, left, right extend.Extend) (extend.Extend, extend.Extend) {
+	if left.ReturnType() == types.T_decimal {
+		if v, ok := decimalLiteral(r); ok {
+			return left, v
+		}
+	}
+	if right.ReturnType() == types.T_decimal {
+		if v, ok := decimalLiteral(l); ok {
+			return v, right
+		}
+	}
+	return left, right
+}
+
+// decimalLiteral returns the decimal of a literal like 1.25, but a literal
+// with exponent is still a double.
+func decimalLiteral(n tree.Expr) (extend.Extend, bool) {
+	num, ok := n.(*tree.NumVal)
+	if !ok || num.Value.Kind() != constant.Float {
+		return nil, false
+	}
+	str := num.String()
+	i := strings.IndexByte(str, '.')
+	if i < 0 || strings.ContainsAny(str, "eE") {
+		return nil, false
+	}
+	typ := types.DecimalType(types.MaxDecimalPrecision, int32(len(str)-i-1))
+	v, err := types.ParseDecimal(str, typ.Width, typ.Precision)
+	if err != nil {
+		return nil, false
+	}
+	vec := vector.New(typ)
+	vec.Ref = 1
+	vec.Col = []types.Decimal{v}
+	return &extend.ValueExtend{V: vec}, true
+}
+
+// rewriteDistinctFrom rewrites x is distinct from y to be
+// (x <> y or x is null or y is null) and (x is not null or y is not null),
+// and x is not distinct from y to be
+// (x = y and x is not null and y is not null) or (x is null and y is null).
+func rewriteDistinctFrom(e *tree.ComparisonExpr) tree.Expr {
+	l, r := e.Left, e.Right
+	if e.Op == tree.IS_DISTINCT_FROM {
+		ne := tree.NewOrExpr(tree.NewOrExpr(tree.NewComparisonExpr(tree.NOT_EQUAL, l, r), nullCheck(l, true)), nullCheck(r, true))
+		notBothNull := tree.NewOrExpr(nullCheck(l, false), nullCheck(r, false))
+		return tree.NewAndExpr(tree.NewParenExpr(ne), tree.NewParenExpr(notBothNull))
+	}
+	eq := tree.NewAndExpr(tree.NewAndExpr(tree.NewComparisonExpr(tree.EQUAL, l, r), nullCheck(l, false)), nullCheck(r, false))
+	bothNull := tree.NewAndExpr(nullCheck(l, true), nullCheck(r, true))
+	return tree.NewOrExpr(tree.NewParenExpr(eq), tree.NewParenExpr(bothNull))
+}
+
+// nullCheck returns e is null or e is not null, which is folded if e is a constant.
+func nullCheck(e tree.Expr, isNull bool) tree.Expr {
+	if v, ok := e.(*tree.NumVal); ok {
+		if (v.Value.Kind() == constant.Unknown) == isNull {
+			return tree.NewNumVal(constant.MakeInt64(1), "1", false)
+		}
+		return tree.NewNumVal(constant.MakeInt64(0), "0", false)
+	}
+	if isNull {
+		return tree.NewIsNullExpr(e)
+	}
+	return tree.NewIsNotNullExpr(e)
+}
+
+// buildNullCheck builds the function isnull or isnotnull.
+func (b *build) buildNullCheck(name string, n tree.Expr, qry *Query, fn func(tree.Expr, *Query) (extend.Extend, error)) (extend.Extend, error) {
+	e, err := fn(n, qry)
+	if err != nil {
+		return nil, err
+	}
+	return buildFunctionExtend(&extend.FuncExtend{Name: name, Args: []extend.Extend{e}})
+}
+
 func (b *build) buildAttribute(e *tree.UnresolvedName, qry *Query) (extend.Extend, error) {
 	if e.Star {
 		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", e))
@@ -432,6 +538,13 @@ func (b *build) buildAttribute(e *tree.UnresolvedName, qry *Query) (extend.Exten
 					}, nil
 				}
 			}
+			// the attribute which is not ambiguous is not qualified after join
+			if attr, ok := qry.Scope.Result.AttrsMap[e.Parts[0]]; ok && qry.Scope.hasAttribute(e.Parts[1], e.Parts[0]) {
+				return &extend.Attribute{
+					Name: e.Parts[0],
+					Type: attr.Type.Oid,
+				}, nil
+			}
 		} else {
 			for _, attr := range qry.Scope.Result.Attrs {
 				if attr == e.Parts[0] {
@@ -520,6 +633,8 @@ func buildConstant(typ types.Type, n tree.Expr) (interface{}, error) {
 				return val * -1, nil
 			case float64:
 				return val * -1, nil
+			case types.Decimal:
+				return val.Neg(), nil
 			}
 			return v, nil
 		}
@@ -630,6 +745,8 @@ func buildConstant(typ types.Type, n tree.Expr) (interface{}, error) {
 			return float32(floatResult), nil
 		case types.T_float64:
 			return floatResult, nil
+		case types.T_decimal:
+			return types.DecimalFromFloat64(floatResult, types.DecimalPrecision(typ), types.DecimalScale(typ))
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("unexpected return type '%v' for binary expression '%v'", typ, e.Op))
 		}
@@ -688,6 +805,8 @@ func buildConstantValue(typ types.Type, num *tree.NumVal) (interface{}, error) {
 			if !num.Negative() {
 				return types.ParseDatetime(str)
 			}
+		case types.T_decimal:
+			return types.ParseDecimal(str, types.DecimalPrecision(typ), types.DecimalScale(typ))
 		}
 	case constant.Float:
 		switch typ.Oid {
@@ -744,6 +863,8 @@ func buildConstantValue(typ types.Type, num *tree.NumVal) (interface{}, error) {
 			return float64(v), nil
 		case types.T_datetime:
 			return types.ParseDatetime(str)
+		case types.T_decimal:
+			return types.ParseDecimal(str, types.DecimalPrecision(typ), types.DecimalScale(typ))
 		}
 	case constant.String:
 		if !num.Negative() {
@@ -754,6 +875,8 @@ func buildConstantValue(typ types.Type, num *tree.NumVal) (interface{}, error) {
 				return types.ParseDate(constant.StringVal(val))
 			case types.T_datetime:
 				return types.ParseDatetime(constant.StringVal(val))
+			case types.T_decimal:
+				return types.ParseDecimal(constant.StringVal(val), types.DecimalPrecision(typ), types.DecimalScale(typ))
 			}
 		}
 	}
@@ -810,6 +933,25 @@ func pruneExtendAttribute(e extend.Extend) extend.Extend {
 	case *extend.BinaryExtend:
 		v.Left = pruneExtendAttribute(v.Left)
 		v.Right = pruneExtendAttribute(v.Right)
+	case *extend.CaseExtend:
+		for i := range v.Conds {
+			v.Conds[i] = pruneExtendAttribute(v.Conds[i])
+			if v.Ress[i] != nil {
+				v.Ress[i] = pruneExtendAttribute(v.Ress[i])
+			}
+		}
+		if v.Else != nil {
+			v.Else = pruneExtendAttribute(v.Else)
+		}
+	case *extend.CoalesceExtend:
+		for i, arg := range v.Args {
+			v.Args[i] = pruneExtendAttribute(arg)
+		}
+	case *extend.InExtend:
+		v.E = pruneExtendAttribute(v.E)
+		for i, arg := range v.Vs {
+			v.Vs[i] = pruneExtendAttribute(arg)
+		}
 	}
 	return e
 }
@@ -830,6 +972,25 @@ func pruneExtend(e extend.Extend) extend.Extend {
 		for i, arg := range v.Args {
 			v.Args[i] = pruneExtend(arg)
 		}
+	case *extend.CaseExtend:
+		for i := range v.Conds {
+			v.Conds[i] = pruneExtend(v.Conds[i])
+			if v.Ress[i] != nil {
+				v.Ress[i] = pruneExtend(v.Ress[i])
+			}
+		}
+		if v.Else != nil {
+			v.Else = pruneExtend(v.Else)
+		}
+	case *extend.CoalesceExtend:
+		for i, arg := range v.Args {
+			v.Args[i] = pruneExtend(arg)
+		}
+	case *extend.InExtend:
+		v.E = pruneExtend(v.E)
+		for i, arg := range v.Vs {
+			v.Vs[i] = pruneExtend(arg)
+		}
 	}
 	return e
 }
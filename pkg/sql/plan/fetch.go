@@ -97,6 +97,18 @@ func (b *build) buildFetchExpr(n tree.Expr, qry *Query) (extend.Extend, error) {
 	switch e := n.(type) {
 	case *tree.NumVal:
 		return buildValue(e.Value)
+	case *tree.ParamExpr:
+		v, err := b.bindParam(e)
+		if err != nil {
+			return nil, err
+		}
+		return b.buildFetchExpr(v, qry)
+	case *tree.VarExpr:
+		v, err := b.bindVar(e)
+		if err != nil {
+			return nil, err
+		}
+		return b.buildFetchExpr(v, qry)
 	case *tree.ParenExpr:
 		return b.buildFetchExpr(e.Expr, qry)
 	case *tree.OrExpr:
@@ -117,6 +129,8 @@ func (b *build) buildFetchExpr(n tree.Expr, qry *Query) (extend.Extend, error) {
 		return b.buildCast(e, qry, b.buildFetchExpr)
 	case *tree.RangeCond:
 		return b.buildBetween(e, qry, b.buildFetchExpr)
+	case *tree.CaseExpr:
+		return b.buildCase(e, qry, b.buildFetchExpr)
 	case *tree.UnresolvedName:
 		return b.buildAttribute(e, qry)
 	}
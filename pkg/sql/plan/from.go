@@ -36,7 +36,13 @@ func (b *build) buildFrom(tbls tree.TableExprs, qry *Query) error {
 		if err := b.buildTableReference(tbl, qry); err != nil {
 			return err
 		}
-		ss.Scopes = append(ss.Scopes, qry.Pop().Scopes...)
+		for _, s := range qry.Pop().Scopes {
+			if jp, ok := s.Op.(*Join); ok && jp.Type == CROSS { // flatten the nested cross join
+				ss.Scopes = append(ss.Scopes, s.Children...)
+				continue
+			}
+			ss.Scopes = append(ss.Scopes, s)
+		}
 	}
 	s, err := b.buildJoinedScope(ss)
 	if err != nil {
@@ -134,11 +140,12 @@ func (b *build) buildTable(tbl *tree.TableName, qry *Query) error {
 		qry.Push(ss)
 		return nil
 	}
-	attrs, attrsMap, rows, err := b.getSchemaInfo(rel.Schema, rel.Name)
+	attrs, attrsMap, rows, stats, err := b.getSchemaInfo(rel.Schema, rel.Name)
 	if err != nil {
 		return err
 	}
 	rel.Rows = rows
+	rel.Stats = stats
 	rel.Attrs = attrsMap
 	{ // construct projection
 		for _, attr := range attrs {
@@ -161,15 +168,17 @@ func (b *build) buildTable(tbl *tree.TableName, qry *Query) error {
 }
 
 func (b *build) buildAliasedTable(tbl tree.TableExpr, alias string, qry *Query) error {
+	var rebuilt bool
+
 	if qry.Flg {
 		switch tbl.(type) {
 		case *tree.TableName:
-			if qry.RenameRels[alias] != nil {
+			if qry.RenameRels[alias] != nil { // the relation is renamed again, so that the alias is kept
 				ss := newScopeSet()
 				ss.JoinType = RELATION
 				ss.Scopes = append(ss.Scopes, qry.RenameRels[alias])
 				qry.Push(ss)
-				return nil
+				rebuilt = true
 			}
 		case *tree.ParenTableExpr:
 			ss := newScopeSet()
@@ -180,8 +189,10 @@ func (b *build) buildAliasedTable(tbl tree.TableExpr, alias string, qry *Query)
 		}
 
 	}
-	if err := b.buildTableReference(tbl, qry); err != nil {
-		return err
+	if !rebuilt {
+		if err := b.buildTableReference(tbl, qry); err != nil {
+			return err
+		}
 	}
 	if len(alias) == 0 {
 		return nil
@@ -244,6 +255,7 @@ func (b *build) buildJoinedTable(stmt *tree.JoinTableExpr, qry *Query) error {
 
 func (b *build) buildJoinedTree(stmt *tree.JoinTableExpr, qry *Query) error {
 	var jt int // join type
+	var natural bool
 
 	if err := b.buildTableReferenceInJoinedTable(stmt.Left, qry); err != nil {
 		return err
@@ -262,25 +274,61 @@ func (b *build) buildJoinedTree(stmt *tree.JoinTableExpr, qry *Query) error {
 		jt = RIGHT
 	case tree.JOIN_TYPE_NATURAL:
 		jt = NATURAL
-	case tree.JOIN_TYPE_INNER:
+	case tree.JOIN_TYPE_NATURAL_LEFT:
+		jt, natural = LEFT, true
+	case tree.JOIN_TYPE_NATURAL_RIGHT:
+		jt, natural = RIGHT, true
+	case tree.JOIN_TYPE_NATURAL_FULL:
+		jt, natural = FULL, true
+	case tree.JOIN_TYPE_INNER, tree.JOIN_TYPE_STRAIGHT:
 		if stmt.Cond == nil {
 			jt = CROSS
 		} else {
 			jt = INNER
 		}
 	}
-	if jt == FULL || jt == LEFT || jt == RIGHT || jt == INNER {
+	if !natural && (jt == FULL || jt == LEFT || jt == RIGHT || jt == INNER) {
 		switch stmt.Cond.(type) {
 		case *tree.OnJoinCond:
 		case *tree.UsingJoinCond:
-			return errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport join condition '%v'", tree.String(stmt.Cond, dialect.MYSQL)))
 		default:
 			return errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport join condition '%v'", tree.String(stmt.Cond, dialect.MYSQL)))
 		}
 	}
 	rss := qry.Pop()
 	lss := qry.Pop()
+	if _, ok := stmt.Cond.(*tree.UsingJoinCond); ok || jt == FULL || jt == LEFT || jt == RIGHT {
+		// outer joins and joins with USING are built at once
+		left, err := b.buildJoinedInput(lss)
+		if err != nil {
+			return err
+		}
+		right, err := b.buildJoinedInput(rss)
+		if err != nil {
+			return err
+		}
+		s, err := b.buildOuterJoin(jt, stmt.Cond, left, right)
+		if err != nil {
+			return err
+		}
+		ss := newScopeSet()
+		ss.JoinType = RELATION
+		ss.Scopes = append(ss.Scopes, s)
+		qry.Push(ss)
+		return nil
+	}
 	rjt, ljt := rss.JoinType, lss.JoinType
+	if isInnerJoin(jt) && isInnerJoin(ljt) && isInnerJoin(rjt) { // a cross join is an inner join without conditions
+		if jt == INNER || ljt == INNER || rjt == INNER {
+			jt = INNER
+		}
+		if ljt != RELATION {
+			ljt = jt
+		}
+		if rjt != RELATION {
+			rjt = jt
+		}
+	}
 	if (jt == ljt && jt == rjt) || (ljt == RELATION && rjt == RELATION) ||
 		(ljt == RELATION && rjt == jt) || (rjt == RELATION && ljt == jt) {
 		ss := newScopeSet()
@@ -294,17 +342,15 @@ func (b *build) buildJoinedTree(stmt *tree.JoinTableExpr, qry *Query) error {
 			if err := b.buildJoinCond(cond.Expr, ss); err != nil {
 				return err
 			}
-		case *tree.UsingJoinCond:
-		default:
 		}
 		qry.Push(ss)
 		return nil
 	}
-	left, err := b.buildJoinedScope(lss)
+	left, err := b.buildJoinedInput(lss)
 	if err != nil {
 		return err
 	}
-	right, err := b.buildJoinedScope(rss)
+	right, err := b.buildJoinedInput(rss)
 	if err != nil {
 		return err
 	}
@@ -317,12 +363,9 @@ func (b *build) buildJoinedTree(stmt *tree.JoinTableExpr, qry *Query) error {
 		if err := b.buildJoinCond(cond.Expr, ss); err != nil {
 			return err
 		}
-	case *tree.UsingJoinCond:
-	default:
 	}
 	qry.Push(ss)
 	return nil
-
 }
 
 func (b *build) buildJoinedScope(ss *ScopeSet) (*Scope, error) {
@@ -338,28 +381,57 @@ func (b *build) buildJoinedScope(ss *ScopeSet) (*Scope, error) {
 	}
 }
 
-func (b *build) getSchemaInfo(schema string, name string) ([]string, map[string]*Attribute, int64, error) {
+// buildJoinedInput builds the scope of an input of join, a cross join is built
+// as an inner join without conditions, only the cross join of the FROM clause
+// gets its join conditions from the WHERE clause.
+func (b *build) buildJoinedInput(ss *ScopeSet) (*Scope, error) {
+	if ss.JoinType == CROSS {
+		return b.buildQualifiedJoin(INNER, ss)
+	}
+	return b.buildJoinedScope(ss)
+}
+
+func isInnerJoin(joinType int) bool {
+	return joinType == INNER || joinType == CROSS || joinType == RELATION
+}
+
+// getSchemaInfo returns the attributes, rows and statistics of relation,
+// the statistics is nil if the relation is never analyzed.
+func (b *build) getSchemaInfo(schema string, name string) ([]string, map[string]*Attribute, int64, *engine.StatisticsDef, error) {
 	var attrs []string
+	var stats *engine.StatisticsDef
 
 	db, err := b.e.Database(schema)
 	if err != nil {
-		return nil, nil, -1, errors.New(errno.SyntaxErrororAccessRuleViolation, err.Error())
+		return nil, nil, -1, nil, errors.New(errno.SyntaxErrororAccessRuleViolation, err.Error())
 	}
 	r, err := db.Relation(name)
 	if err != nil {
-		return nil, nil, -1, errors.New(errno.SyntaxErrororAccessRuleViolation, err.Error())
+		return nil, nil, -1, nil, errors.New(errno.SyntaxErrororAccessRuleViolation, err.Error())
 	}
 	defer r.Close()
 	defs := r.TableDefs()
 	attrsMap := make(map[string]*Attribute)
 	for _, def := range defs {
-		if v, ok := def.(*engine.AttributeDef); ok {
+		switch v := def.(type) {
+		case *engine.AttributeDef:
 			attrsMap[v.Attr.Name] = &Attribute{
 				Name: v.Attr.Name,
 				Type: v.Attr.Type,
 			}
 			attrs = append(attrs, v.Attr.Name)
+		case *engine.StatisticsDef:
+			stats = v
+		}
+	}
+	if b.hideKey {
+		if key := r.GetHideKey(); key != nil {
+			attrsMap[key.Name] = &Attribute{
+				Name: key.Name,
+				Type: key.Type,
+			}
+			attrs = append(attrs, key.Name)
 		}
 	}
-	return attrs, attrsMap, r.Rows(), nil
+	return attrs, attrsMap, r.Rows(), stats, nil
 }
@@ -29,15 +29,6 @@ func cloneGraph(gp *Graph) *Graph {
 	return gq
 }
 
-func existIndependentEdge(gp *Graph) bool {
-	for i, e := range gp.Es {
-		if isIndependentEdge(i, e.Vs, gp.Es) {
-			return true
-		}
-	}
-	return false
-}
-
 func pruneGraph(gp *Graph) *Graph {
 	var flg bool
 
@@ -100,18 +91,6 @@ func (vp *VertexSet) Contains(j int) bool {
 	return false
 }
 
-func isIndependentEdge(j int, vs []int, es []*Edge) bool {
-	for i, e := range es {
-		if i == j {
-			continue
-		}
-		if isConnected(vs, e.Vs) {
-			return false
-		}
-	}
-	return true
-}
-
 func weight(vs, ws []int) int {
 	var w int
 
@@ -216,3 +195,34 @@ func isEndEdgeSet(es *EdgeSet, ess []*EdgeSet) bool {
 	}
 	return true
 }
+
+// connectedComponents returns the connected components of a graph of n edges,
+// ess are the pairs of connected edges. The component of the i-th edge is the
+// first one.
+func connectedComponents(i, n int, ess []*EdgeSet) [][]int {
+	var iss [][]int
+
+	js := make([]int, 0, n+1)
+	js = append(js, i)
+	for j := 0; j < n; j++ {
+		js = append(js, j)
+	}
+	flgs := make([]bool, n)
+	for _, j := range js {
+		if flgs[j] {
+			continue
+		}
+		flgs[j] = true
+		is := []int{j}
+		for k := 0; k < len(is); k++ {
+			for _, es := range ess {
+				if es.I1 == is[k] && !flgs[es.I2] {
+					flgs[es.I2] = true
+					is = append(is, es.I2)
+				}
+			}
+		}
+		iss = append(iss, is)
+	}
+	return iss
+}
@@ -79,6 +79,8 @@ func (b *build) buildGroupByExpr(n tree.Expr, qry *Query) (extend.Extend, error)
 		return b.buildCast(e, qry, b.buildGroupByExpr)
 	case *tree.RangeCond:
 		return b.buildBetween(e, qry, b.buildGroupByExpr)
+	case *tree.CaseExpr:
+		return b.buildCase(e, qry, b.buildGroupByExpr)
 	case *tree.UnresolvedName:
 		return b.buildAttribute(e, qry)
 	}
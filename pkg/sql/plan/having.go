@@ -43,6 +43,12 @@ func (b *build) buildHavingExpr(n tree.Expr, qry *Query) (extend.Extend, error)
 	switch e := n.(type) {
 	case *tree.NumVal:
 		return buildValue(e.Value)
+	case *tree.ParamExpr:
+		v, err := b.bindParam(e)
+		if err != nil {
+			return nil, err
+		}
+		return b.buildHavingExpr(v, qry)
 	case *tree.ParenExpr:
 		return b.buildHavingExpr(e.Expr, qry)
 	case *tree.OrExpr:
@@ -63,6 +69,8 @@ func (b *build) buildHavingExpr(n tree.Expr, qry *Query) (extend.Extend, error)
 		return b.buildCast(e, qry, b.buildHavingExpr)
 	case *tree.RangeCond:
 		return b.buildBetween(e, qry, b.buildHavingExpr)
+	case *tree.CaseExpr:
+		return b.buildCase(e, qry, b.buildHavingExpr)
 	case *tree.UnresolvedName:
 		return b.buildAttribute(e, qry)
 	}
@@ -38,6 +38,9 @@ func (b *build) BuildInsert(stmt *tree.Insert, plan *Insert) error {
 	if _, ok := stmt.Table.(*tree.TableName); !ok {
 		return errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport table: '%v'", stmt.Table))
 	}
+	if stmt.Returning != nil {
+		return errors.New(errno.FeatureNotSupported, "insert with returning is not support now")
+	}
 	switch typ := stmt.Rows.Select.(type) {
 	case *tree.ValuesClause:
 		rows = typ
@@ -93,6 +96,9 @@ func (b *build) BuildInsert(stmt *tree.Insert, plan *Insert) error {
 			return errors.New(errno.InvalidColumnReference, fmt.Sprintf("Column count doesn't match value count at row '%v'", i))
 		}
 	}
+	if err = b.bindRows(rows.Rows); err != nil {
+		return err
+	}
 
 	bat = batch.New(true, attrs)
 	for i, attr := range attrs {
@@ -398,6 +404,28 @@ func (b *build) BuildInsert(stmt *tree.Insert, plan *Insert) error {
 			if err := vector.Append(vec, vs); err != nil {
 				return err
 			}
+		case types.T_decimal:
+			vs := make([]types.Decimal, len(rows.Rows))
+			{
+				for j, row := range rows.Rows {
+					v, err := buildConstant(vec.Typ, row[i])
+					if err != nil {
+						return err
+					}
+					if v == nil {
+						nulls.Add(vec.Nsp, uint64(j))
+					} else {
+						if vv, err := rangeCheck(v.(types.Decimal), vec.Typ, bat.Attrs[i], j+1); err != nil {
+							return err
+						} else {
+							vs[j] = vv.(types.Decimal)
+						}
+					}
+				}
+			}
+			if err := vector.Append(vec, vs); err != nil {
+				return err
+			}
 		default:
 			return errors.New(errno.DatatypeMismatch, fmt.Sprintf("insert for type '%v' not implement now", vec.Typ))
 		}
@@ -440,6 +468,8 @@ func (b *build) BuildInsert(stmt *tree.Insert, plan *Insert) error {
 			vec.Col = make([]types.Date, len(rows.Rows))
 		case types.T_datetime:
 			vec.Col = make([]types.Datetime, len(rows.Rows))
+		case types.T_decimal:
+			vec.Col = make([]types.Decimal, len(rows.Rows))
 		default:
 			return errors.New(errno.DatatypeMismatch, fmt.Sprintf("insert for type '%v' not implement now", vec.Typ))
 		}
@@ -479,6 +509,9 @@ func makeExprFromVal(typ types.Type, value interface{}, isNull bool) tree.Expr {
 	case types.T_datetime:
 		res := value.(types.Datetime).String()
 		return tree.NewNumVal(constant.MakeString(res), res, false)
+	case types.T_decimal:
+		res := value.(types.Decimal).Format(types.DecimalScale(typ))
+		return tree.NewNumVal(constant.MakeString(res), res, false)
 	}
 	return tree.NewNumVal(constant.MakeUnknown(), "NULL", false)
 }
@@ -20,6 +20,8 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
@@ -75,10 +77,11 @@ func (b *build) buildQualifiedJoin(joinType int, ss *ScopeSet) (*Scope, error) {
 	var ts []types.Type
 	var attrs, as []string
 
+	conds, rconds := b.splitCyclicConds(len(ss.Scopes), ss.Conds)
 	nss := make([]*Scope, len(ss.Scopes))
 	{
 		ap := new(int) // alias generator
-		b.initAliasGenerator(ap, ss.Conds)
+		b.initAliasGenerator(ap, conds)
 		tm := make([]*Scope, 0, len(ss.Scopes))
 		for i, s := range ss.Scopes {
 			tm = append(tm[:0], ss.Scopes[:i]...)
@@ -86,7 +89,7 @@ func (b *build) buildQualifiedJoin(joinType int, ss *ScopeSet) (*Scope, error) {
 			as0 := make([]string, 0, len(s.Result.Attrs))
 			ts0 := make([]types.Type, 0, len(s.Result.Attrs))
 			for j := range s.Result.Attrs {
-				alias, attr := b.generateAlias(i, ap, s.Result.Attrs[j], s, tm, ss.Conds)
+				alias, attr := b.generateAlias(i, ap, s.Result.Attrs[j], s, tm, conds)
 				{
 					as = append(as, attr)
 					attrs = append(attrs, alias)
@@ -98,14 +101,112 @@ func (b *build) buildQualifiedJoin(joinType int, ss *ScopeSet) (*Scope, error) {
 			nss[i] = b.buildRename(s, s.Result.Attrs, as0, ts0)
 		}
 	}
-	ss.Scopes = nss
-	s, err := b.buildHyperGraph(joinType, ss)
+	rs := newScopeSet()
+	rs.Scopes = nss
+	rs.Sels = ss.Sels
+	s, err := b.buildHyperGraph(joinType, rs)
 	if err != nil {
 		return nil, err
 	}
+	if len(rconds) > 0 {
+		s = b.buildResidualRestrict(s, rconds, ss.Scopes, nss)
+	}
 	return b.buildRename(s, attrs, as, ts), nil
 }
 
+// splitCyclicConds splits the join conditions of n inputs into the conditions
+// of an acyclic join and the residual conditions which close the cycles, the
+// residual conditions are evaluated after the join.
+func (b *build) splitCyclicConds(n int, conds []*JoinCondition) ([]*JoinCondition, []*JoinCondition) {
+	var tconds, rconds []*JoinCondition
+
+	if !b.isCyclicJoin(n, conds) {
+		return conds, nil
+	}
+	for _, cond := range conds {
+		if b.isCyclicJoin(n, append(tconds[:len(tconds):len(tconds)], cond)) {
+			rconds = append(rconds, cond)
+		} else {
+			tconds = append(tconds, cond)
+		}
+	}
+	return tconds, rconds
+}
+
+// isCyclicJoin returns true if the hyper graph of the join of n inputs is cyclic,
+// an attribute is the vertex of the first condition it appears in, the same as
+// generateAlias.
+func (b *build) isCyclicJoin(n int, conds []*JoinCondition) bool {
+	ap := new(int)
+	b.initAliasGenerator(ap, conds)
+	gp := &Graph{Es: make([]*Edge, n)}
+	for i := range gp.Es {
+		gp.Es[i] = new(Edge)
+	}
+	mp := make(map[string]int)
+	for _, cond := range conds {
+		for _, attr := range []string{strconv.Itoa(cond.R) + "." + cond.Rattr, strconv.Itoa(cond.S) + "." + cond.Sattr} {
+			if _, ok := mp[attr]; !ok {
+				mp[attr] = cond.Alias
+			}
+		}
+	}
+	for _, cond := range conds {
+		for _, e := range []struct {
+			i    int
+			attr string
+		}{{cond.R, cond.Rattr}, {cond.S, cond.Sattr}} {
+			v := mp[strconv.Itoa(e.i)+"."+e.attr]
+			if !isConnected([]int{v}, gp.Es[e.i].Vs) {
+				gp.Es[e.i].Vs = append(gp.Es[e.i].Vs, v)
+			}
+		}
+	}
+	return !isEmptyGraph(pruneGraph(gp))
+}
+
+// buildResidualRestrict filters the result of join by the residual conditions,
+// ss are the inputs of join and nss are them with attributes renamed to aliases.
+func (b *build) buildResidualRestrict(child *Scope, conds []*JoinCondition, ss, nss []*Scope) *Scope {
+	var es []extend.Extend
+
+	alias := func(i int, attr string) *extend.Attribute {
+		for j, name := range ss[i].Result.Attrs {
+			if name == attr {
+				name = nss[i].Result.Attrs[j]
+				return &extend.Attribute{
+					Name: name,
+					Type: nss[i].Result.AttrsMap[name].Type.Oid,
+				}
+			}
+		}
+		return nil
+	}
+	for _, cond := range conds {
+		es = append(es, &extend.BinaryExtend{
+			Op:    overload.EQ,
+			Left:  alias(cond.R, cond.Rattr),
+			Right: alias(cond.S, cond.Sattr),
+		})
+	}
+	s := &Scope{
+		Name:     child.Name,
+		Children: []*Scope{child},
+		Op:       &Restrict{E: extendsToAndExtend(es)},
+	}
+	{ // construct result
+		s.Result.AttrsMap = make(map[string]*Attribute)
+		for _, attr := range child.Result.Attrs {
+			s.Result.Attrs = append(s.Result.Attrs, attr)
+			s.Result.AttrsMap[attr] = &Attribute{
+				Name: attr,
+				Type: child.Result.AttrsMap[attr].Type,
+			}
+		}
+	}
+	return s
+}
+
 func (b *build) buildNaturalJoin(joinType int, ss *ScopeSet) (*Scope, error) {
 	var attrs []string
 	var ts []types.Type
@@ -157,24 +258,87 @@ func (b *build) buildHyperGraph(joinType int, ss *ScopeSet) (*Scope, error) {
 	if err := b.checkHyperGraph(gp, ss); err != nil {
 		return nil, err
 	}
-	ness := []*EdgeSet{}
-	{
-		ess := buildEdgeSet(gp.Es)
-		ness = b.decomposition(buildVertexSet(gp.Es), b.initVertexSet(gp.Es, ss.Scopes, ess), ess, ness, ss.Scopes)
+	var root *Scope
+	ess := buildEdgeSet(gp.Es)
+	is := make([]int, len(gp.Es))
+	for i := range is {
+		is[i] = i
 	}
-	return b.buildJoinTree(joinType, new(Scope), ness[0].I1, ness, ss.Scopes), nil
+	vp := b.initVertexSet(joinType, is, gp.Es, ss.Scopes, ss.Sels, ess)
+	for _, is := range connectedComponents(vp.Is[0], len(gp.Es), ess) {
+		s := ss.Scopes[is[0]]
+		if len(is) > 1 {
+			es := make([]*Edge, len(is))
+			for k, i := range is {
+				es[k] = gp.Es[i]
+			}
+			if root != nil { // the fact is not in this component
+				vp = b.initVertexSet(INNER, is, gp.Es, ss.Scopes, ss.Sels, ess)
+			}
+			ness := b.decomposition(buildVertexSet(es), vp, ess, []*EdgeSet{}, ss.Scopes, ss.Sels)
+			s = b.buildJoinTree(joinType, new(Scope), ness[0].I1, ness, ss.Scopes)
+		}
+		if root == nil {
+			root = s
+			continue
+		}
+		root = b.buildCrossProduct(joinType, root, s)
+	}
+	return root, nil
 }
 
-func (b *build) decomposition(vp, nvp *VertexSet, ess, ness []*EdgeSet, ss []*Scope) []*EdgeSet {
+// buildCrossProduct joins s to root without any join attribute, it is used
+// to join the disconnected components of a join graph, root is the fact.
+func (b *build) buildCrossProduct(joinType int, root, s *Scope) *Scope {
+	op, ok := root.Op.(*Join)
+	if !ok {
+		op = &Join{Type: joinType, Vars: make([][]int, 1)}
+		root = &Scope{
+			Op:       op,
+			Children: []*Scope{root},
+		}
+		root.Result.AttrsMap = make(map[string]*Attribute)
+		for _, attr := range root.Children[0].Result.Attrs {
+			root.Result.Attrs = append(root.Result.Attrs, attr)
+			root.Result.AttrsMap[attr] = &Attribute{
+				Name: attr,
+				Type: root.Children[0].Result.AttrsMap[attr].Type,
+			}
+		}
+	}
+	root.Children = append(root.Children, s)
+	op.Vars = append(op.Vars, nil)
+	for _, attr := range s.Result.Attrs {
+		if _, ok := root.Result.AttrsMap[attr]; !ok {
+			root.Result.Attrs = append(root.Result.Attrs, attr)
+			root.Result.AttrsMap[attr] = &Attribute{
+				Name: attr,
+				Type: s.Result.AttrsMap[attr].Type,
+			}
+		}
+	}
+	return root
+}
+
+// decomposition orders the inputs to be joined, the input joined next is the
+// one with the least estimated fanout if both inputs compared are analyzed.
+func (b *build) decomposition(vp, nvp *VertexSet, ess, ness []*EdgeSet, ss []*Scope, sels []float64) []*EdgeSet {
 	var j int
 	var w int
+	var fanout float64
 
 	j = -1
 	for i, es := range ess {
 		if nvp.Contains(es.I1) && !nvp.Contains(es.I2) {
+			f := estimateFanout(es, ss, sels)
+			if j >= 0 && f >= 0 && fanout >= 0 {
+				if f < fanout {
+					j, w, fanout = i, es.W, f
+				}
+				continue
+			}
 			if j < 0 || es.W > w || ss[ess[j].I2].Rows() > ss[ess[i].I2].Rows() {
-				j = i
-				w = es.W
+				j, w, fanout = i, es.W, f
 			}
 		}
 	}
@@ -184,7 +348,7 @@ func (b *build) decomposition(vp, nvp *VertexSet, ess, ness []*EdgeSet, ss []*Sc
 		nvp.Es = append(nvp.Es, ess[j].E2)
 	}
 	if len(vp.Es) != len(nvp.Es) {
-		ness = b.decomposition(vp, nvp, ess, ness, ss)
+		ness = b.decomposition(vp, nvp, ess, ness, ss, sels)
 	}
 	return ness
 }
@@ -256,17 +420,31 @@ func (b *build) buildPath(joinType int, root *Scope, es *EdgeSet, ess []*EdgeSet
 	}
 }
 
-func (b *build) initVertexSet(es []*Edge, ss []*Scope, ess []*EdgeSet) *VertexSet {
-	var j int
+// initVertexSet chooses the fact among the inputs is, the others are the build
+// side of hash join. The fact is the input with the most estimated rows if all
+// the inputs are analyzed.
+func (b *build) initVertexSet(joinType int, is []int, es []*Edge, ss []*Scope, sels []float64, ess []*EdgeSet) *VertexSet {
 	var cnt int
 	var rows int64
 
+	j := is[0]
 	vp := new(VertexSet)
-	for i := range es {
-		if j < 0 || getEdgesCount(i, ess) > cnt || ss[i].Rows() > rows {
-			j = i
-			rows = ss[i].Rows()
-			cnt = getEdgesCount(i, ess)
+	switch joinType {
+	case LEFT, FULL: // the preserved input is the fact
+		j = 0
+	case RIGHT:
+		j = 1
+	default:
+		if k := largestInput(is, ss, sels, ess); k >= 0 {
+			j = k
+			break
+		}
+		for _, i := range is {
+			if getEdgesCount(i, ess) > cnt || ss[i].Rows() > rows {
+				j = i
+				rows = ss[i].Rows()
+				cnt = getEdgesCount(i, ess)
+			}
 		}
 	}
 	vp.Is = append(vp.Is, j)
@@ -274,10 +452,9 @@ func (b *build) initVertexSet(es []*Edge, ss []*Scope, ess []*EdgeSet) *VertexSe
 	return vp
 }
 
+// checkHyperGraph rejects the cyclic graph, the cycles of qualified joins are
+// broken by splitCyclicConds, so it only happens to natural joins.
 func (b *build) checkHyperGraph(gp *Graph, ss *ScopeSet) error {
-	if existIndependentEdge(gp) {
-		return errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("isolated relation not support now"))
-	}
 	if !isEmptyGraph(pruneGraph(cloneGraph(gp))) { // check whether is a cyclic graph
 		return errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("cyclic join not support now"))
 	}
@@ -455,3 +632,189 @@ func traceAttribute(s *Scope, attr string) string {
 	}
 	return attr
 }
+
+// buildOuterJoin builds the join of exactly two inputs, it is used by outer joins
+// and joins with USING, which can not be merged with other joins. The common
+// columns of USING and NATURAL are returned once, and the join attributes of a
+// null-extended input get their own aliases, so that they are null for the rows
+// without a match.
+func (b *build) buildOuterJoin(joinType int, cond tree.JoinCond, left, right *Scope) (*Scope, error) {
+	var ts []types.Type
+	var attrs, as []string
+
+	ss := newScopeSet()
+	ss.JoinType = joinType
+	ss.Scopes = []*Scope{left, right}
+	cols, err := b.buildOuterJoinCond(cond, ss)
+	if err != nil {
+		return nil, err
+	}
+	ap := new(int) // alias generator
+	b.initAliasGenerator(ap, ss.Conds)
+	mp := make(map[string]string) // common column -> alias
+	for i, col := range cols {
+		mp[col] = strconv.Itoa(ss.Conds[i].Alias)
+	}
+	{ // common columns are ordered as the preserved input
+		s := left
+		if joinType == RIGHT {
+			s = right
+		}
+		for _, attr := range s.Result.Attrs {
+			if alias, ok := mp[attr]; ok {
+				attrs = append(attrs, alias)
+				as = append(as, attr)
+				ts = append(ts, s.Result.AttrsMap[attr].Type)
+			}
+		}
+	}
+	nss := make([]*Scope, len(ss.Scopes))
+	for i, s := range ss.Scopes {
+		tm := []*Scope{ss.Scopes[1-i]}
+		attrs0 := make([]string, 0, len(s.Result.Attrs))
+		as0 := make([]string, 0, len(s.Result.Attrs))
+		ts0 := make([]types.Type, 0, len(s.Result.Attrs))
+		for _, attr := range s.Result.Attrs {
+			typ := s.Result.AttrsMap[attr].Type
+			alias, name := b.generateAlias(i, ap, attr, s, tm, ss.Conds)
+			attrs0 = append(attrs0, attr)
+			as0 = append(as0, alias)
+			ts0 = append(ts0, typ)
+			if _, ok := mp[attr]; ok {
+				continue
+			}
+			if isNullExtended(joinType, i) && isJoinAttribute(i, attr, ss.Conds) {
+				(*ap)++
+				alias = strconv.Itoa(*ap)
+				attrs0 = append(attrs0, attr)
+				as0 = append(as0, alias)
+				ts0 = append(ts0, typ)
+			}
+			attrs = append(attrs, alias)
+			as = append(as, name)
+			ts = append(ts, typ)
+		}
+		nss[i] = b.buildRename(s, attrs0, as0, ts0)
+	}
+	ss.Scopes = nss
+	s, err := b.buildHyperGraph(joinType, ss)
+	if err != nil {
+		return nil, err
+	}
+	return b.buildRename(s, attrs, as, ts), nil
+}
+
+// buildOuterJoinCond builds the join conditions of two inputs and returns the
+// common columns of USING and NATURAL, the i-th condition is the one of the i-th
+// common column.
+func (b *build) buildOuterJoinCond(cond tree.JoinCond, ss *ScopeSet) ([]string, error) {
+	var cols []string
+
+	left, right := ss.Scopes[0], ss.Scopes[1]
+	switch cond := cond.(type) {
+	case *tree.OnJoinCond:
+		return nil, b.buildJoinCond(cond.Expr, ss)
+	case *tree.UsingJoinCond:
+		for _, col := range cond.Cols {
+			name := string(col)
+			_, lok := left.Result.AttrsMap[name]
+			_, rok := right.Result.AttrsMap[name]
+			if !lok || !rok {
+				return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("Unknown column '%s' in 'from clause'", name))
+			}
+			for _, c := range cols {
+				if c == name {
+					return nil, errors.New(errno.DuplicateColumn, fmt.Sprintf("Duplicate column name '%s'", name))
+				}
+			}
+			cols = append(cols, name)
+		}
+	default: // natural join
+		for _, attr := range left.Result.Attrs {
+			if _, ok := right.Result.AttrsMap[attr]; ok {
+				cols = append(cols, attr)
+			}
+		}
+		if len(cols) == 0 {
+			return nil, errors.New(errno.SQLStatementNotYetComplete, "natural outer join without common columns not support now")
+		}
+	}
+	for _, col := range cols {
+		if left.Result.AttrsMap[col].Type.Oid != right.Result.AttrsMap[col].Type.Oid {
+			return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("unsupport join condition '%v': type mismatch", col))
+		}
+		ss.Conds = append(ss.Conds, &JoinCondition{
+			R:     0,
+			S:     1,
+			Rattr: col,
+			Sattr: col,
+		})
+	}
+	return cols, nil
+}
+
+// isNullExtended returns true if the i-th input of a two-way join is null-extended.
+func isNullExtended(joinType int, i int) bool {
+	switch joinType {
+	case LEFT:
+		return i == 1
+	case RIGHT:
+		return i == 0
+	case FULL:
+		return true
+	}
+	return false
+}
+
+func isJoinAttribute(i int, attr string, conds []*JoinCondition) bool {
+	for _, cond := range conds {
+		if (cond.R == i && cond.Rattr == attr) || (cond.S == i && cond.Sattr == attr) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasResidualRestrict returns true if there is a restrict on the result of join,
+// the derived relations are not considered.
+func hasResidualRestrict(s *Scope) bool {
+	switch s.Op.(type) {
+	case *Restrict:
+		if _, ok := s.Children[0].Op.(*Join); ok {
+			return true
+		}
+	case *DerivedRelation:
+		return false
+	}
+	for _, child := range s.Children {
+		if hasResidualRestrict(child) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasJoin returns true if the scope is a join of relations, the nested flag
+// indicates whether only the join of joins is considered.
+func hasJoin(nested bool, s *Scope) bool {
+	switch s.Op.(type) {
+	case *Join:
+		if !nested {
+			return true
+		}
+		for _, child := range s.Children {
+			if hasJoin(false, child) {
+				return true
+			}
+		}
+		return false
+	case *Relation, *DerivedRelation:
+		return false
+	}
+	for _, child := range s.Children {
+		if hasJoin(nested, child) {
+			return true
+		}
+	}
+	return false
+}
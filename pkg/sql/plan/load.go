@@ -0,0 +1,95 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// LoadVariable returns the name of the attribute which holds the values of
+// user variable of LOAD DATA column list.
+func LoadVariable(name string) string {
+	return "@" + name
+}
+
+// BuildLoadAssignments builds the SET clause of LOAD DATA, the i-th extend
+// computes the new value of the i-th returned attribute. The extends are
+// evaluated on the batch of the attributes of relation and the user variables
+// of column list, the variables are attributes of type varchar named by LoadVariable.
+func (b *build) BuildLoadAssignments(attrs []engine.Attribute, vars []string, exprs tree.UpdateExprs) ([]string, []extend.Extend, error) {
+	var names []string
+	var es []extend.Extend
+
+	s := &Scope{Result: ResultAttributes{AttrsMap: make(map[string]*Attribute)}}
+	for _, attr := range attrs {
+		s.Result.Attrs = append(s.Result.Attrs, attr.Name)
+		s.Result.AttrsMap[attr.Name] = &Attribute{Name: attr.Name, Type: attr.Type}
+	}
+	for _, v := range vars {
+		name := LoadVariable(v)
+		s.Result.Attrs = append(s.Result.Attrs, name)
+		s.Result.AttrsMap[name] = &Attribute{Name: name, Type: types.Type{Oid: types.T_varchar, Size: 24}}
+	}
+	qry := &Query{Scope: s}
+	{ // the user variables of column list hide the variables of session
+		vs := b.vars
+		defer func() { b.vars = vs }()
+		b.vars = func(e *tree.VarExpr) (tree.Expr, error) {
+			if name := LoadVariable(e.Name); !e.System && s.Result.AttrsMap[name] != nil {
+				return tree.SetUnresolvedName(name), nil
+			}
+			if vs == nil {
+				return nil, errors.New(errno.UndefinedObject, fmt.Sprintf("Unknown system variable '%s'", e.Name))
+			}
+			return vs(e)
+		}
+	}
+	for _, expr := range exprs {
+		if expr.Tuple || len(expr.Names) != 1 {
+			return nil, nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("'%s' is not support now", tree.String(expr, dialect.MYSQL)))
+		}
+		name := expr.Names[0].Parts[0]
+		var attr *engine.Attribute
+		for i := range attrs {
+			if attrs[i].Name == name {
+				attr = &attrs[i]
+				break
+			}
+		}
+		if attr == nil {
+			return nil, nil, errors.New(errno.UndefinedColumn, fmt.Sprintf("Unknown column '%s' in 'field list'", tree.String(expr.Names[0], dialect.MYSQL)))
+		}
+		for _, n := range names {
+			if n == name {
+				return nil, nil, errors.New(errno.DuplicateColumn, fmt.Sprintf("Column '%s' specified twice", name))
+			}
+		}
+		e, err := b.buildUpdateExpr(*attr, expr.Expr, qry)
+		if err != nil {
+			return nil, nil, err
+		}
+		names = append(names, name)
+		es = append(es, e)
+	}
+	return names, es, nil
+}
@@ -30,6 +30,9 @@ func (b *build) buildOrderBy(orders tree.OrderBy, proj0, proj *Projection, qry *
 	var e extend.Extend
 
 	for _, order := range orders {
+		if err = checkNullsPosition(order); err != nil {
+			return nil, err
+		}
 		ovar := tree.String(order.Expr, dialect.MYSQL)
 		for i := range proj0.As {
 			if ovar == proj0.As[i] {
@@ -58,6 +61,14 @@ func (b *build) buildOrderBy(orders tree.OrderBy, proj0, proj *Projection, qry *
 	return fs, nil
 }
 
+// checkNullsPosition rejects the nulls position, the sort does not place the nulls.
+func checkNullsPosition(order *tree.Order) error {
+	if order.NullsPosition != tree.DefaultNullsPosition {
+		return errors.New(errno.FeatureNotSupported, fmt.Sprintf("'%s' is not supported now", order.NullsPosition))
+	}
+	return nil
+}
+
 func (b *build) buildOrderByExpr(n tree.Expr, qry *Query) (extend.Extend, error) {
 	switch e := n.(type) {
 	case *tree.NumVal:
@@ -82,6 +93,8 @@ func (b *build) buildOrderByExpr(n tree.Expr, qry *Query) (extend.Extend, error)
 		return b.buildCast(e, qry, b.buildOrderByExpr)
 	case *tree.RangeCond:
 		return b.buildBetween(e, qry, b.buildOrderByExpr)
+	case *tree.CaseExpr:
+		return b.buildCase(e, qry, b.buildOrderByExpr)
 	case *tree.UnresolvedName:
 		return b.buildAttribute(e, qry)
 	}
@@ -0,0 +1,68 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// SetParams binds the values to the placeholders of a prepared statement,
+// the i-th value is bound to the placeholder whose offset is i+1.
+func (b *build) SetParams(params []tree.Expr) {
+	b.params = params
+}
+
+// SetVariables sets the function which returns the value of system or user variable.
+func (b *build) SetVariables(vars func(*tree.VarExpr) (tree.Expr, error)) {
+	b.vars = vars
+}
+
+// bindVar returns the value of the variable.
+func (b *build) bindVar(e *tree.VarExpr) (tree.Expr, error) {
+	if b.vars == nil {
+		return nil, errors.New(errno.UndefinedObject, fmt.Sprintf("Unknown system variable '%s'", e.Name))
+	}
+	return b.vars(e)
+}
+
+// bindParam returns the value bound to the placeholder.
+func (b *build) bindParam(e *tree.ParamExpr) (tree.Expr, error) {
+	if e.Offset < 1 || e.Offset > len(b.params) {
+		return nil, errors.New(errno.UndefinedParameter, fmt.Sprintf("no value is bound to parameter %v", e.Offset))
+	}
+	return b.params[e.Offset-1], nil
+}
+
+// bindRows replaces the placeholders of values with the values bound to them.
+func (b *build) bindRows(rows []tree.Exprs) error {
+	for _, row := range rows {
+		for i, expr := range row {
+			p, ok := expr.(*tree.ParamExpr)
+			if !ok {
+				continue
+			}
+			v, err := b.bindParam(p)
+			if err != nil {
+				return err
+			}
+			row[i] = v
+		}
+	}
+	return nil
+}
@@ -0,0 +1,257 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
+)
+
+// checkPrivilege returns error if the user has not the privileges required by the statement.
+// The privileges of GRANT and REVOKE are checked when their plans are built.
+func (b *build) checkPrivilege(stmt tree.Statement) error {
+	if b.pc == nil {
+		return nil
+	}
+	switch stmt := stmt.(type) {
+	case *tree.Select:
+		return b.checkTables(privilege.Select, selectTables(stmt, nil))
+	case *tree.ParenSelect:
+		return b.checkTables(privilege.Select, selectTables(stmt, nil))
+	case *tree.ExplainStmt:
+		return b.checkPrivilege(stmt.Statement)
+	case *tree.ExplainAnalyze:
+		return b.checkPrivilege(stmt.Statement)
+	case *tree.Insert:
+		if err := b.checkTables(privilege.Insert, tableExprTables(stmt.Table, nil)); err != nil {
+			return err
+		}
+		if stmt.Rows != nil {
+			return b.checkTables(privilege.Select, selectTables(stmt.Rows, nil))
+		}
+		return nil
+	case *tree.Delete:
+		// the columns read by where clause require SELECT privilege
+		privs := privilege.Delete
+		if stmt.Where != nil {
+			privs |= privilege.Select
+		}
+		if err := b.checkTables(privs, tableExprTables(stmt.Table, nil)); err != nil {
+			return err
+		}
+		return b.checkTables(privilege.Select, whereTables(stmt.Where, nil))
+	case *tree.Update:
+		privs := privilege.Update
+		if stmt.Where != nil {
+			privs |= privilege.Select
+		}
+		if err := b.checkTables(privs, tableExprTables(stmt.Table, nil)); err != nil {
+			return err
+		}
+		var tbls []*tree.TableName
+		for _, tbl := range stmt.From {
+			tbls = tableExprTables(tbl, tbls)
+		}
+		for _, e := range stmt.Exprs {
+			tbls = exprTables(e.Expr, tbls)
+		}
+		return b.checkTables(privilege.Select, whereTables(stmt.Where, tbls))
+	case *tree.CreateDatabase:
+		return b.pc.CheckDatabase(privilege.Create, string(stmt.Name))
+	case *tree.DropDatabase:
+		return b.pc.CheckDatabase(privilege.Drop, string(stmt.Name))
+	case *tree.CreateTable:
+		return b.checkTables(privilege.Create, []*tree.TableName{&stmt.Table})
+	case *tree.DropTable:
+		tbls := make([]*tree.TableName, len(stmt.Names))
+		for i := range stmt.Names {
+			tbls[i] = stmt.Names[i]
+		}
+		return b.checkTables(privilege.Drop, tbls)
+	case *tree.AlterTable:
+		// renaming a table requires the privileges to drop it and create the new one
+		for _, opt := range stmt.Options {
+			if o, ok := opt.(*tree.AlterTableRename); ok {
+				if err := b.checkTables(privilege.Alter|privilege.Drop, []*tree.TableName{&stmt.Table}); err != nil {
+					return err
+				}
+				name := o.Name
+				if len(name.SchemaName) == 0 {
+					name.SchemaName = stmt.Table.SchemaName
+				}
+				return b.checkTables(privilege.Create|privilege.Insert, []*tree.TableName{&name})
+			}
+		}
+		return b.checkTables(privilege.Alter, []*tree.TableName{&stmt.Table})
+	case *tree.AnalyzeStmt:
+		return b.checkTables(privilege.Select|privilege.Insert, []*tree.TableName{stmt.Table})
+	case *tree.CreateIndex:
+		return b.checkTables(privilege.Index, []*tree.TableName{&stmt.Table})
+	case *tree.DropIndex:
+		return b.checkTables(privilege.Index, []*tree.TableName{&stmt.TableName})
+	case *tree.ShowColumns:
+		tbl := stmt.Table.ToTableName()
+		return b.checkTables(privilege.Select, []*tree.TableName{&tbl})
+	case *tree.ShowCreateTable:
+		tbl := stmt.Name.ToTableName()
+		return b.checkTables(privilege.Select, []*tree.TableName{&tbl})
+	case *tree.ShowTables:
+		if len(stmt.DBName) == 0 {
+			return b.pc.CheckAny(b.db)
+		}
+		return b.pc.CheckAny(stmt.DBName)
+	case *tree.ShowCreateDatabase:
+		return b.pc.CheckAny(stmt.Name)
+	case *tree.CreateUser, *tree.DropUser:
+		return b.pc.CheckGlobal(privilege.CreateUser)
+	case *tree.AlterUser:
+		// everyone can change the password of himself
+		if stmt.IsUserFunc {
+			return nil
+		}
+		if len(stmt.Users) == 1 && stmt.Users[0].Username == b.pc.Name() && stmt.Users[0].Hostname == b.pc.Host() {
+			return nil
+		}
+		return b.pc.CheckGlobal(privilege.CreateUser)
+	}
+	return nil
+}
+
+func (b *build) checkTables(privs privilege.Type, tbls []*tree.TableName) error {
+	for _, tbl := range tbls {
+		db := string(tbl.SchemaName)
+		if len(db) == 0 {
+			db = b.db
+		}
+		if err := b.pc.CheckTable(privs, db, string(tbl.ObjectName)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// selectTables appends the tables read by stmt to tbls, including the
+// tables read by its subqueries.
+func selectTables(stmt tree.SelectStatement, tbls []*tree.TableName) []*tree.TableName {
+	switch stmt := stmt.(type) {
+	case *tree.Select:
+		tbls = selectTables(stmt.Select, tbls)
+		for _, o := range stmt.OrderBy {
+			tbls = exprTables(o.Expr, tbls)
+		}
+		return tbls
+	case *tree.ParenSelect:
+		return selectTables(stmt.Select, tbls)
+	case *tree.UnionClause:
+		return selectTables(stmt.Right, selectTables(stmt.Left, tbls))
+	case *tree.SelectClause:
+		for _, e := range stmt.Exprs {
+			tbls = exprTables(e.Expr, tbls)
+		}
+		if stmt.From != nil {
+			for _, tbl := range stmt.From.Tables {
+				tbls = tableExprTables(tbl, tbls)
+			}
+		}
+		tbls = whereTables(stmt.Where, tbls)
+		for _, e := range stmt.GroupBy {
+			tbls = exprTables(e, tbls)
+		}
+		return whereTables(stmt.Having, tbls)
+	}
+	return tbls
+}
+
+// whereTables appends the tables read by the subqueries of where to tbls.
+func whereTables(where *tree.Where, tbls []*tree.TableName) []*tree.TableName {
+	if where == nil {
+		return tbls
+	}
+	return exprTables(where.Expr, tbls)
+}
+
+// exprTables appends the tables read by the subqueries of expr to tbls.
+func exprTables(expr tree.Expr, tbls []*tree.TableName) []*tree.TableName {
+	switch e := expr.(type) {
+	case *tree.Subquery:
+		return selectTables(e.Select, tbls)
+	case *tree.ParenExpr:
+		return exprTables(e.Expr, tbls)
+	case *tree.UnaryExpr:
+		return exprTables(e.Expr, tbls)
+	case *tree.NotExpr:
+		return exprTables(e.Expr, tbls)
+	case *tree.IsNullExpr:
+		return exprTables(e.Expr, tbls)
+	case *tree.IsNotNullExpr:
+		return exprTables(e.Expr, tbls)
+	case *tree.CastExpr:
+		return exprTables(e.Expr, tbls)
+	case *tree.IntervalExpr:
+		return exprTables(e.Expr, tbls)
+	case *tree.BinaryExpr:
+		return exprTables(e.Right, exprTables(e.Left, tbls))
+	case *tree.ComparisonExpr:
+		return exprTables(e.Right, exprTables(e.Left, tbls))
+	case *tree.AndExpr:
+		return exprTables(e.Right, exprTables(e.Left, tbls))
+	case *tree.OrExpr:
+		return exprTables(e.Right, exprTables(e.Left, tbls))
+	case *tree.XorExpr:
+		return exprTables(e.Right, exprTables(e.Left, tbls))
+	case *tree.RangeCond:
+		return exprTables(e.To, exprTables(e.From, exprTables(e.Left, tbls)))
+	case *tree.FuncExpr:
+		return exprsTables(e.Exprs, tbls)
+	case *tree.Tuple:
+		return exprsTables(e.Exprs, tbls)
+	case *tree.ExprList:
+		return exprsTables(e.Exprs, tbls)
+	case *tree.CaseExpr:
+		tbls = exprTables(e.Else, exprTables(e.Expr, tbls))
+		for _, w := range e.Whens {
+			tbls = exprTables(w.Val, exprTables(w.Cond, tbls))
+		}
+	}
+	return tbls
+}
+
+func exprsTables(exprs tree.Exprs, tbls []*tree.TableName) []*tree.TableName {
+	for _, e := range exprs {
+		tbls = exprTables(e, tbls)
+	}
+	return tbls
+}
+
+// tableExprTables appends the tables referenced by tbl to tbls.
+func tableExprTables(tbl tree.TableExpr, tbls []*tree.TableName) []*tree.TableName {
+	switch tbl := tbl.(type) {
+	case *tree.Select:
+		return selectTables(tbl, tbls)
+	case *tree.TableName:
+		return append(tbls, tbl)
+	case *tree.JoinTableExpr:
+		tbls = tableExprTables(tbl.Right, tableExprTables(tbl.Left, tbls))
+		if cond, ok := tbl.Cond.(*tree.OnJoinCond); ok {
+			tbls = exprTables(cond.Expr, tbls)
+		}
+		return tbls
+	case *tree.ParenTableExpr:
+		return tableExprTables(tbl.Expr, tbls)
+	case *tree.AliasedTableExpr:
+		return tableExprTables(tbl.Expr, tbls)
+	}
+	return tbls
+}
@@ -24,9 +24,36 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 
-func (b *build) buildProjection(exprs tree.SelectExprs, proj *Projection, qry *Query) (*ResultProjection, error) {
+func (b *build) buildProjection(exprs tree.SelectExprs, proj *Projection, ws *windows, qry *Query) (*ResultProjection, error) {
 	op := new(ResultProjection)
 	for _, expr := range exprs {
+		if hasWindowFunc(expr.Expr) {
+			e, err := b.buildWindowProjectionExpr(expr.Expr, ws, qry)
+			if err != nil {
+				return nil, err
+			}
+			if e, err = b.pruneExtend(e, true); err != nil {
+				return nil, err
+			}
+			name := e.String()
+			if len(expr.As) > 0 {
+				name = string(expr.As)
+			}
+			ws.wproj.Rs = append(ws.wproj.Rs, 0)
+			ws.wproj.Es = append(ws.wproj.Es, e)
+			ws.wproj.As = append(ws.wproj.As, name)
+			op.Rs = append(op.Rs, 0)
+			op.Es = append(op.Es, &extend.Attribute{
+				Name: name,
+				Type: e.ReturnType(),
+			})
+			if len(expr.As) > 0 {
+				op.As = append(op.As, name)
+			} else {
+				op.As = append(op.As, tree.String(&expr, dialect.MYSQL))
+			}
+			continue
+		}
 		if _, ok := expr.Expr.(tree.UnqualifiedStar); ok {
 			for _, attr := range qry.Scope.Result.Attrs {
 				proj.Rs = append(proj.Rs, 0)
@@ -81,6 +108,18 @@ func (b *build) buildProjectionExpr(n tree.Expr, qry *Query) (extend.Extend, err
 	switch e := n.(type) {
 	case *tree.NumVal:
 		return buildValue(e.Value)
+	case *tree.ParamExpr:
+		v, err := b.bindParam(e)
+		if err != nil {
+			return nil, err
+		}
+		return b.buildProjectionExpr(v, qry)
+	case *tree.VarExpr:
+		v, err := b.bindVar(e)
+		if err != nil {
+			return nil, err
+		}
+		return b.buildProjectionExpr(v, qry)
 	case *tree.ParenExpr:
 		return b.buildProjectionExpr(e.Expr, qry)
 	case *tree.OrExpr:
@@ -101,6 +140,12 @@ func (b *build) buildProjectionExpr(n tree.Expr, qry *Query) (extend.Extend, err
 		return b.buildCast(e, qry, b.buildProjectionExpr)
 	case *tree.RangeCond:
 		return b.buildBetween(e, qry, b.buildProjectionExpr)
+	case *tree.CaseExpr:
+		return b.buildCase(e, qry, b.buildProjectionExpr)
+	case *tree.IsNullExpr:
+		return b.buildNullCheck("isnull", e.Expr, qry, b.buildProjectionExpr)
+	case *tree.IsNotNullExpr:
+		return b.buildNullCheck("isnotnull", e.Expr, qry, b.buildProjectionExpr)
 	case *tree.UnresolvedName:
 		return b.buildAttribute(e, qry)
 	}
@@ -54,6 +54,12 @@ func (b *build) pruneExtend(e extend.Extend, isProjection bool) (extend.Extend,
 				return nil, err
 			}
 		}
+	case *extend.CaseExtend:
+		return b.pruneCase(n)
+	case *extend.CoalesceExtend:
+		return b.pruneCoalesce(n)
+	case *extend.InExtend:
+		return b.pruneIn(n)
 	case *extend.ParenExtend:
 		if n.E, err = b.pruneExtend(n.E, false); err != nil {
 			return nil, err
@@ -66,6 +72,10 @@ func (b *build) pruneExtend(e extend.Extend, isProjection bool) (extend.Extend,
 		if n.Right, err = b.pruneExtend(n.Right, false); err != nil {
 			return nil, err
 		}
+		// the result is null if an operand of the operator is null
+		if n.Op != overload.Or && n.Op != overload.And && (isNull(n.Left) || isNull(n.Right)) {
+			return nullValue(), nil
+		}
 		switch n.Op {
 		case overload.Or:
 			return b.pruneOr(n)
@@ -112,11 +122,11 @@ func (b *build) pruneConditionNot(e *extend.UnaryExtend) (extend.Extend, error)
 		ext = v.E
 	}
 	if cnt%2 == 0 {
-		return ext, nil
+		return b.pruneExtend(ext, false)
 	}
-	// split not extends
-	ext = logicInverse(ext)
-	return ext, nil
+	// split not extends, the operands are pruned after the not operators
+	// are pushed down, since not null is still null.
+	return b.pruneExtend(logicInverse(ext), false)
 }
 
 func (b *build) pruneProjectionNot(e *extend.UnaryExtend) (extend.Extend, error) {
@@ -176,6 +186,8 @@ func logicInverse(e extend.Extend) extend.Extend {
 		return &extend.ParenExtend{E: logicInverse(v.E)}
 	case *extend.BinaryExtend:
 		return splitNotBinary(v)
+	case *extend.InExtend:
+		return &extend.InExtend{Not: !v.Not, E: v.E, Vs: v.Vs}
 	case *extend.UnaryExtend:
 		if v.Op == overload.Not {
 			return logicInverse(v.E)
@@ -401,6 +413,7 @@ func (b *build) pruneDiv(e *extend.BinaryExtend) (extend.Extend, error) {
 			if err := toFloat64(re); err != nil {
 				return nil, err
 			}
+		case types.T_decimal:
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -452,6 +465,7 @@ func (b *build) pruneDiv(e *extend.BinaryExtend) (extend.Extend, error) {
 			if err := toFloat64(le); err != nil {
 				return nil, err
 			}
+		case types.T_decimal:
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -614,6 +628,7 @@ func (b *build) pruneMul(e *extend.BinaryExtend) (extend.Extend, error) {
 			if err := toFloat64(re); err != nil {
 				return nil, err
 			}
+		case types.T_decimal:
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -662,6 +677,7 @@ func (b *build) pruneMul(e *extend.BinaryExtend) (extend.Extend, error) {
 			if err := toFloat64(le); err != nil {
 				return nil, err
 			}
+		case types.T_decimal:
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -716,6 +732,7 @@ func (b *build) prunePlus(e *extend.BinaryExtend) (extend.Extend, error) {
 			if err := toFloat64(re); err != nil {
 				return nil, err
 			}
+		case types.T_decimal:
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -764,6 +781,7 @@ func (b *build) prunePlus(e *extend.BinaryExtend) (extend.Extend, error) {
 			if err := toFloat64(le); err != nil {
 				return nil, err
 			}
+		case types.T_decimal:
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -818,6 +836,7 @@ func (b *build) pruneMinus(e *extend.BinaryExtend) (extend.Extend, error) {
 			if err := toFloat64(re); err != nil {
 				return nil, err
 			}
+		case types.T_decimal:
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -866,6 +885,7 @@ func (b *build) pruneMinus(e *extend.BinaryExtend) (extend.Extend, error) {
 			if err := toFloat64(le); err != nil {
 				return nil, err
 			}
+		case types.T_decimal:
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -927,6 +947,7 @@ func (b *build) pruneEq(e *extend.BinaryExtend) (extend.Extend, error) {
 		case types.T_varchar:
 		case types.T_date:
 		case types.T_datetime:
+		case types.T_decimal:
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -982,6 +1003,7 @@ func (b *build) pruneEq(e *extend.BinaryExtend) (extend.Extend, error) {
 		case types.T_varchar:
 		case types.T_date:
 		case types.T_datetime:
+		case types.T_decimal:
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -1043,6 +1065,7 @@ func (b *build) pruneNe(e *extend.BinaryExtend) (extend.Extend, error) {
 		case types.T_varchar:
 		case types.T_date:
 		case types.T_datetime:
+		case types.T_decimal:
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -1098,6 +1121,7 @@ func (b *build) pruneNe(e *extend.BinaryExtend) (extend.Extend, error) {
 		case types.T_varchar:
 		case types.T_date:
 		case types.T_datetime:
+		case types.T_decimal:
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -1159,6 +1183,7 @@ func (b *build) pruneLt(e *extend.BinaryExtend) (extend.Extend, error) {
 		case types.T_varchar:
 		case types.T_date:
 		case types.T_datetime:
+		case types.T_decimal:
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -1214,6 +1239,7 @@ func (b *build) pruneLt(e *extend.BinaryExtend) (extend.Extend, error) {
 		case types.T_varchar:
 		case types.T_date:
 		case types.T_datetime:
+		case types.T_decimal:
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -1275,6 +1301,7 @@ func (b *build) pruneLe(e *extend.BinaryExtend) (extend.Extend, error) {
 		case types.T_varchar:
 		case types.T_date:
 		case types.T_datetime:
+		case types.T_decimal:
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -1330,6 +1357,7 @@ func (b *build) pruneLe(e *extend.BinaryExtend) (extend.Extend, error) {
 		case types.T_varchar:
 		case types.T_date:
 		case types.T_datetime:
+		case types.T_decimal:
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -1391,6 +1419,7 @@ func (b *build) pruneGt(e *extend.BinaryExtend) (extend.Extend, error) {
 		case types.T_varchar:
 		case types.T_date:
 		case types.T_datetime:
+		case types.T_decimal:
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -1446,6 +1475,7 @@ func (b *build) pruneGt(e *extend.BinaryExtend) (extend.Extend, error) {
 		case types.T_varchar:
 		case types.T_date:
 		case types.T_datetime:
+		case types.T_decimal:
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -1507,6 +1537,7 @@ func (b *build) pruneGe(e *extend.BinaryExtend) (extend.Extend, error) {
 		case types.T_varchar:
 		case types.T_date:
 		case types.T_datetime:
+		case types.T_decimal:
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -1562,6 +1593,7 @@ func (b *build) pruneGe(e *extend.BinaryExtend) (extend.Extend, error) {
 		case types.T_varchar:
 		case types.T_date:
 		case types.T_datetime:
+		case types.T_decimal:
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
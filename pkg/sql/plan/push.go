@@ -31,6 +31,8 @@ func isCAQ(flg bool, s *Scope) bool {
 		return isCAQ(flg, s.Children[0])
 	case *Dedup:
 		return isCAQ(flg, s.Children[0])
+	case *Window:
+		return isCAQ(flg, s.Children[0])
 	case *Limit:
 		return isCAQ(flg, s.Children[0])
 	case *Offset:
@@ -54,21 +56,23 @@ func isCAQ(flg bool, s *Scope) bool {
 	return false
 }
 
-func pushDownUntransform(s *Scope, fvars []string) {
+func pushDownUntransform(s *Scope, fvars []string, aggs []*Aggregation) {
 	switch op := s.Op.(type) {
 	case *Order:
-		pushDownUntransform(s.Children[0], fvars)
+		pushDownUntransform(s.Children[0], fvars, aggs)
 	case *Dedup:
-		pushDownUntransform(s.Children[0], fvars)
+		pushDownUntransform(s.Children[0], fvars, aggs)
+	case *Window:
+		pushDownUntransform(s.Children[0], fvars, aggs)
 	case *Limit:
-		pushDownUntransform(s.Children[0], fvars)
+		pushDownUntransform(s.Children[0], fvars, aggs)
 	case *Offset:
-		pushDownUntransform(s.Children[0], fvars)
+		pushDownUntransform(s.Children[0], fvars, aggs)
 	case *Restrict:
-		pushDownUntransform(s.Children[0], fvars)
+		pushDownUntransform(s.Children[0], fvars, aggs)
 	case *Projection:
 		if uop, ok := s.Children[0].Op.(*Untransform); ok {
-			pushDownUntransform(s.Children[0].Children[0], uop.FreeVars)
+			pushDownUntransform(s.Children[0].Children[0], uop.FreeVars, uop.Aggs)
 			s.Children[0] = s.Children[0].Children[0]
 			return
 		}
@@ -100,10 +104,10 @@ func pushDownUntransform(s *Scope, fvars []string) {
 					}
 				}
 			}
-			rs.Op = &Untransform{rfvars}
+			rs.Op = &Untransform{FreeVars: rfvars, Aggs: aggs}
 			s.Children = []*Scope{rs}
 			for i := range child.Children {
-				pushDownUntransform(child.Children[i], rfvars)
+				pushDownUntransform(child.Children[i], rfvars, nil)
 			}
 			return
 		}
@@ -129,11 +133,11 @@ func pushDownUntransform(s *Scope, fvars []string) {
 			}
 			return
 		}
-		pushDownUntransform(s.Children[0], fvars)
+		pushDownUntransform(s.Children[0], fvars, aggs)
 	case *ResultProjection:
-		pushDownUntransform(s.Children[0], fvars)
+		pushDownUntransform(s.Children[0], fvars, aggs)
 	case *Rename:
-		pushDownUntransform(s.Children[0], fvars)
+		pushDownUntransform(s.Children[0], fvars, aggs)
 	}
 }
 
@@ -218,6 +222,8 @@ func (s *Scope) pushDownJoinAttribute(attrs []string) {
 		s.Children[0].pushDownJoinAttribute(attrs)
 	case *Dedup:
 		s.Children[0].pushDownJoinAttribute(attrs)
+	case *Window:
+		s.Children[0].pushDownJoinAttribute(attrs)
 	case *Limit:
 		s.Children[0].pushDownJoinAttribute(attrs)
 	case *Offset:
@@ -366,6 +372,25 @@ func pushDownProjectionExtend(e extend.Extend, qry *Query) extend.Extend {
 			v.Args[i] = pushDownProjectionExtend(v.Args[i], qry)
 		}
 		return v
+	case *extend.CaseExtend:
+		for i := range v.Conds {
+			v.Conds[i] = pushDownProjectionExtend(v.Conds[i], qry)
+			if v.Ress[i] != nil {
+				v.Ress[i] = pushDownProjectionExtend(v.Ress[i], qry)
+			}
+		}
+		if v.Else != nil {
+			v.Else = pushDownProjectionExtend(v.Else, qry)
+		}
+	case *extend.CoalesceExtend:
+		for i, arg := range v.Args {
+			v.Args[i] = pushDownProjectionExtend(arg, qry)
+		}
+	case *extend.InExtend:
+		v.E = pushDownProjectionExtend(v.E, qry)
+		for i, arg := range v.Vs {
+			v.Vs[i] = pushDownProjectionExtend(arg, qry)
+		}
 	}
 	return e
 }
@@ -378,11 +403,11 @@ func pushDownRestrictExtend(e extend.Extend, qry *Query) bool {
 		return false
 	}
 	aliases := make([]string, len(attrs))
-	if s = findScopeWithAttribute(attrs[0].Name, &aliases[0], qry.Scope); s == nil {
+	if s = findRestrictScopeWithAttribute(attrs[0].Name, &aliases[0], qry.Scope); s == nil {
 		return false
 	}
 	for i := 1; i < len(attrs); i++ {
-		t := findScopeWithAttribute(attrs[i].Name, &aliases[i], qry.Scope)
+		t := findRestrictScopeWithAttribute(attrs[i].Name, &aliases[i], qry.Scope)
 		if t != s {
 			return false
 		}
@@ -416,10 +441,24 @@ func pushDownRestrictExtend(e extend.Extend, qry *Query) bool {
 }
 
 func findScopeWithAttribute(name string, alias *string, s *Scope) *Scope {
+	return findScope(name, alias, s, false)
+}
+
+// findRestrictScopeWithAttribute is like findScopeWithAttribute, but it never
+// goes into the null-extended inputs of outer joins, where a filter would drop
+// rows that should be null-extended instead.
+func findRestrictScopeWithAttribute(name string, alias *string, s *Scope) *Scope {
+	return findScope(name, alias, s, true)
+}
+
+func findScope(name string, alias *string, s *Scope, isRestrict bool) *Scope {
 	switch op := s.Op.(type) {
 	case *Join:
 		for i := 0; i < len(s.Children); i++ {
-			if rp := findScopeWithAttribute(name, alias, s.Children[i]); rp != nil {
+			if isRestrict && (op.Type == FULL || (i > 0 && (op.Type == LEFT || op.Type == RIGHT))) {
+				continue
+			}
+			if rp := findScope(name, alias, s.Children[i], isRestrict); rp != nil {
 				return rp
 			}
 		}
@@ -437,7 +476,7 @@ func findScopeWithAttribute(name string, alias *string, s *Scope) *Scope {
 		for i := range op.Es {
 			if op.As[i] == name {
 				if e, ok := op.Es[i].(*extend.Attribute); ok {
-					return findScopeWithAttribute(e.Name, alias, s.Children[0])
+					return findScope(e.Name, alias, s.Children[0], isRestrict)
 				}
 			}
 		}
@@ -445,7 +484,7 @@ func findScopeWithAttribute(name string, alias *string, s *Scope) *Scope {
 		for i := range op.Es {
 			if op.As[i] == name {
 				if e, ok := op.Es[i].(*extend.Attribute); ok {
-					return findScopeWithAttribute(e.Name, alias, s.Children[0])
+					return findScope(e.Name, alias, s.Children[0], isRestrict)
 				}
 			}
 		}
@@ -56,6 +56,11 @@ func (s *Scope) prune(attrsMap map[string]uint64, fvars []string) {
 		}
 		ms := s.classifying()
 		for i := 0; i < len(s.Children); i++ {
+			if i > 0 && len(op.Vars[i]) == 0 && len(ms[i]) == 0 { // keep an attribute for the rows of cross product
+				if attrs := s.Children[i].Result.Attrs; len(attrs) > 0 {
+					ms[i][attrs[0]] = 1
+				}
+			}
 			s.Children[i].prune(ms[i], fvars)
 		}
 	case *Order:
@@ -65,6 +70,33 @@ func (s *Scope) prune(attrsMap map[string]uint64, fvars []string) {
 		s.Children[0].prune(attrsMap, fvars)
 	case *Dedup:
 		s.Children[0].prune(attrsMap, fvars)
+	case *Window:
+		for _, w := range op.Specs {
+			for _, f := range w.Funcs {
+				if ref, ok := attrsMap[f.Alias]; ok {
+					f.Ref = int(ref)
+				}
+				delete(attrsMap, f.Alias)
+			}
+			for i := 0; i < len(w.Funcs); i++ {
+				if w.Funcs[i].Ref == 0 {
+					w.Funcs = append(w.Funcs[:i], w.Funcs[i+1:]...)
+					i--
+				}
+			}
+		}
+		for _, w := range op.Specs {
+			for _, attr := range w.Partitions {
+				attrsMap[attr]++
+			}
+			for _, f := range w.Fs {
+				attrsMap[f.Attr]++
+			}
+			for _, f := range w.Funcs {
+				attrsMap[f.Name]++
+			}
+		}
+		s.Children[0].prune(attrsMap, fvars)
 	case *Limit:
 		s.Children[0].prune(attrsMap, fvars)
 	case *Offset:
@@ -295,6 +327,8 @@ func (s *Scope) getAggregations() []*Aggregation {
 		return s.Children[0].getAggregations()
 	case *Dedup:
 		return s.Children[0].getAggregations()
+	case *Window:
+		return s.Children[0].getAggregations()
 	case *Limit:
 		return s.Children[0].getAggregations()
 	case *Offset:
@@ -323,6 +357,8 @@ func (s *Scope) pruneProjection(aggs []*Aggregation) {
 		s.Children[0].pruneProjection(aggs)
 	case *Dedup:
 		s.Children[0].pruneProjection(aggs)
+	case *Window:
+		s.Children[0].pruneProjection(aggs)
 	case *Limit:
 		s.Children[0].pruneProjection(aggs)
 	case *Offset:
@@ -370,6 +406,13 @@ func (s *Scope) reset() {
 		s.Children[0].reset()
 	case *Dedup:
 		s.Children[0].reset()
+	case *Window:
+		for _, w := range op.Specs {
+			for _, f := range w.Funcs {
+				f.Ref = 0
+			}
+		}
+		s.Children[0].reset()
 	case *Limit:
 		s.Children[0].reset()
 	case *Offset:
@@ -444,6 +487,8 @@ func (s *Scope) registerRelations(qry *Query) {
 		s.Children[0].registerRelations(qry)
 	case *Dedup:
 		s.Children[0].registerRelations(qry)
+	case *Window:
+		s.Children[0].registerRelations(qry)
 	case Limit:
 		s.Children[0].registerRelations(qry)
 	case Offset:
@@ -475,6 +520,23 @@ func (s *Scope) registerRelations(qry *Query) {
 	}
 }
 
+// hasAttribute returns true if the relation named rel in the scope has the attribute.
+func (s *Scope) hasAttribute(rel, attr string) bool {
+	switch s.Op.(type) {
+	case *Relation, *DerivedRelation, *Rename:
+		if s.Name == rel {
+			_, ok := s.Result.AttrsMap[attr]
+			return ok
+		}
+	}
+	for _, child := range s.Children {
+		if child.hasAttribute(rel, attr) {
+			return true
+		}
+	}
+	return false
+}
+
 func printScopes(prefix []byte, ss []*Scope, buf *bytes.Buffer) {
 	for _, s := range ss {
 		if len(s.Children) > 0 {
@@ -487,6 +549,8 @@ func printScopes(prefix []byte, ss []*Scope, buf *bytes.Buffer) {
 			buf.WriteString(fmt.Sprintf("%s%v\n", prefix, printOrder(op)))
 		case *Dedup:
 			buf.WriteString(fmt.Sprintf("%s%v\n", prefix, printDedup(op)))
+		case *Window:
+			buf.WriteString(fmt.Sprintf("%s%v\n", prefix, printWindow(op)))
 		case *Limit:
 			buf.WriteString(fmt.Sprintf("%s%v\n", prefix, printLimit(op)))
 		case *Offset:
@@ -505,6 +569,8 @@ func printScopes(prefix []byte, ss []*Scope, buf *bytes.Buffer) {
 			buf.WriteString(fmt.Sprintf("%s%v\n", prefix, printUntransform(op)))
 		case *DerivedRelation:
 			buf.WriteString(fmt.Sprintf("%s%v\n", prefix, printDerivedRelation(op, s)))
+		case *SetOperation:
+			buf.WriteString(fmt.Sprintf("%s%v\n", prefix, printSetOperation(op)))
 		}
 	}
 }
@@ -517,6 +583,42 @@ func printDedup(op *Dedup) string {
 	return fmt.Sprintf("δ()")
 }
 
+func printWindow(op *Window) string {
+	var buf bytes.Buffer
+
+	buf.WriteString("ω(")
+	for i, w := range op.Specs {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(fmt.Sprintf("%v, [", w.Partitions))
+		for j, f := range w.Fs {
+			if j > 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(f.String())
+		}
+		buf.WriteString("], [")
+		for j, f := range w.Funcs {
+			if j > 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(fmt.Sprintf("%s: %v", f.Alias, f.Ref))
+		}
+		buf.WriteString("]")
+	}
+	buf.WriteString(")")
+	return buf.String()
+}
+
+func printSetOperation(op *SetOperation) string {
+	names := [...]string{UNION: "∪", INTERSECT: "∩", EXCEPT: "−"}
+	if op.All {
+		return fmt.Sprintf("%s all", names[op.Type])
+	}
+	return names[op.Type]
+}
+
 func printOrder(op *Order) string {
 	var buf bytes.Buffer
 
@@ -31,6 +31,8 @@ func (b *build) buildSelectStatement(stmt tree.SelectStatement, qry *Query) erro
 		return b.buildSelect(stmt.Select, qry)
 	case *tree.SelectClause:
 		return b.buildSelectClause(stmt, nil, qry)
+	case *tree.UnionClause:
+		return b.buildUnion(stmt, nil, qry)
 	}
 	return errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unknown select statement '%T'", stmt))
 }
@@ -66,6 +68,10 @@ func (b *build) buildSelectWithoutParens(stmt tree.SelectStatement, orderBy tree
 		if err := b.buildSelectClause(stmt, orderBy, qry); err != nil {
 			return err
 		}
+	case *tree.UnionClause:
+		if err := b.buildUnion(stmt, orderBy, qry); err != nil {
+			return err
+		}
 	default:
 		return errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unknown select statement: %T", stmt))
 	}
@@ -82,11 +88,15 @@ func (b *build) buildSelectClause(stmt *tree.SelectClause, orderBy tree.OrderBy,
 	var fs []*Field
 	var fvars []string
 	var e1, e2 extend.Extend // e1 = where filter condition, e2 = having filter condition
+	var jsels []float64
 	var jconds []*JoinCondition
 
 	if stmt.From == nil {
 		return errors.New(errno.SQLStatementNotYetComplete, "need from clause")
 	}
+	if stmt, err = b.unnestSubqueries(stmt); err != nil {
+		return err
+	}
 	if err = b.buildFrom(stmt.From.Tables, qry); err != nil {
 		return err
 	}
@@ -94,7 +104,14 @@ func (b *build) buildSelectClause(stmt *tree.SelectClause, orderBy tree.OrderBy,
 	proj := new(Projection)
 	groupProj, orderProj := new(Projection), new(Projection)
 	if stmt.Where != nil {
-		if e1, jconds, err = b.buildWhere(stmt.Where, qry); err != nil {
+		if e1, jconds, jsels, err = b.buildWhere(stmt.Where, qry); err != nil {
+			return err
+		}
+	}
+	if jp, ok := qry.Scope.Op.(*Join); ok && jp.Type == CROSS { // cross product
+		ss := newScopeSet()
+		ss.Scopes = qry.Scope.Children
+		if qry.Scope, err = b.buildQualifiedJoin(INNER, ss); err != nil {
 			return err
 		}
 	}
@@ -103,7 +120,8 @@ func (b *build) buildSelectClause(stmt *tree.SelectClause, orderBy tree.OrderBy,
 			return err
 		}
 	}
-	result, err := b.buildProjection(stmt.Exprs, proj, qry)
+	ws := &windows{proj: proj, wproj: new(Projection)}
+	result, err := b.buildProjection(stmt.Exprs, proj, ws, qry)
 	if err != nil {
 		return err
 	}
@@ -113,7 +131,12 @@ func (b *build) buildSelectClause(stmt *tree.SelectClause, orderBy tree.OrderBy,
 		}
 	}
 	if len(orderBy) > 0 {
-		if fs, err = b.buildOrderBy(orderBy, proj, orderProj, qry); err != nil {
+		if len(ws.ws) > 0 {
+			fs, err = b.buildWindowOrderBy(orderBy, ws, orderProj, qry)
+		} else {
+			fs, err = b.buildOrderBy(orderBy, proj, orderProj, qry)
+		}
+		if err != nil {
 			return err
 		}
 	}
@@ -137,6 +160,15 @@ func (b *build) buildSelectClause(stmt *tree.SelectClause, orderBy tree.OrderBy,
 	if e2 != nil {
 		e2 = pushDownRestrict(false, e2, qry)
 	}
+	if len(qry.Aggs) > 0 || len(fvars) > 0 {
+		if (e1 != nil && hasJoin(false, qry.Scope)) || hasResidualRestrict(qry.Scope) || hasJoin(true, qry.Scope) {
+			// the restrict over join must be evaluated before aggregation, and the
+			// aggregation cannot be pushed down through the join of joins, so the
+			// query is rebuilt as an aggregation over the filtered join
+			*qry = Query{}
+			return b.buildSelectClause(b.buildFilteredDerived(stmt), orderBy, qry)
+		}
+	}
 	if len(qry.Aggs) > 0 {
 		if err = pushDownAggregation(qry.Aggs, qry); err != nil {
 			return err
@@ -160,16 +192,15 @@ func (b *build) buildSelectClause(stmt *tree.SelectClause, orderBy tree.OrderBy,
 		qry0.Scope = qry0.Pop().Scopes[0]
 		qry.Scope = qry0.Scope
 		if jp, ok := qry.Scope.Op.(*Join); ok && jp.Type == CROSS {
-			if len(jconds) > 0 {
-				ss := newScopeSet()
-				ss.Conds = jconds
-				ss.Scopes = qry.Scope.Children
-				s, err := b.buildQualifiedJoin(INNER, ss)
-				if err != nil {
-					return err
-				}
-				qry.Scope = s
+			ss := newScopeSet()
+			ss.Conds = jconds
+			ss.Sels = jsels
+			ss.Scopes = qry.Scope.Children
+			s, err := b.buildQualifiedJoin(INNER, ss)
+			if err != nil {
+				return err
 			}
+			qry.Scope = s
 		}
 		qry.Scope.pushDownJoinAttribute(nil)
 	}
@@ -207,7 +238,11 @@ func (b *build) buildSelectClause(stmt *tree.SelectClause, orderBy tree.OrderBy,
 				}
 			}
 		}
-		s.Op = &Untransform{fvars}
+		op := &Untransform{FreeVars: fvars}
+		if len(fvars) == 0 {
+			op.Aggs = qry.Aggs
+		}
+		s.Op = op
 		qry.Scope = s
 	}
 	{
@@ -218,6 +253,9 @@ func (b *build) buildSelectClause(stmt *tree.SelectClause, orderBy tree.OrderBy,
 		proj.Es = append(proj.Es, orderProj.Es...)
 		proj.Rs = append(proj.Rs, orderProj.Rs...)
 		proj = pruneProjection(proj)
+		if len(ws.ws) > 0 {
+			ws.fillArgument(qry)
+		}
 		s := &Scope{
 			Name:     qry.Scope.Name,
 			Children: []*Scope{qry.Scope},
@@ -254,6 +292,64 @@ func (b *build) buildSelectClause(stmt *tree.SelectClause, orderBy tree.OrderBy,
 		s.Op = op
 		qry.Scope = s
 	}
+	if len(ws.ws) > 0 {
+		{
+			s := &Scope{
+				Name:     qry.Scope.Name,
+				Children: []*Scope{qry.Scope},
+			}
+			{ // construct result
+				s.Result.AttrsMap = make(map[string]*Attribute)
+				for _, attr := range qry.Scope.Result.Attrs {
+					s.Result.Attrs = append(s.Result.Attrs, attr)
+					s.Result.AttrsMap[attr] = &Attribute{
+						Name: attr,
+						Type: qry.Scope.Result.AttrsMap[attr].Type,
+					}
+				}
+				for _, w := range ws.ws {
+					for _, f := range w.Funcs {
+						s.Result.Attrs = append(s.Result.Attrs, f.Alias)
+						s.Result.AttrsMap[f.Alias] = &Attribute{
+							Name: f.Alias,
+							Type: f.Type,
+						}
+					}
+				}
+			}
+			s.Op = &Window{Specs: ws.ws}
+			qry.Scope = s
+		}
+		wproj := new(Projection)
+		for _, attr := range qry.Scope.Result.Attrs {
+			wproj.Rs = append(wproj.Rs, 0)
+			wproj.Es = append(wproj.Es, &extend.Attribute{
+				Name: attr,
+				Type: qry.Scope.Result.AttrsMap[attr].Type.Oid,
+			})
+			wproj.As = append(wproj.As, attr)
+		}
+		wproj.Rs = append(wproj.Rs, ws.wproj.Rs...)
+		wproj.Es = append(wproj.Es, ws.wproj.Es...)
+		wproj.As = append(wproj.As, ws.wproj.As...)
+		wproj = pruneProjection(wproj)
+		s := &Scope{
+			Name:     qry.Scope.Name,
+			Children: []*Scope{qry.Scope},
+		}
+		{ // construct result
+			s.Result.AttrsMap = make(map[string]*Attribute)
+			for i, attr := range wproj.As {
+				s.Result.Attrs = append(s.Result.Attrs, attr)
+				s.Result.AttrsMap[attr] = &Attribute{
+					Name: attr,
+					Type: wproj.Es[i].ReturnType().ToType(),
+				}
+			}
+		}
+		s.Op = wproj
+		qry.Scope = s
+	}
 	if stmt.Distinct {
 		s := &Scope{
 			Name:     qry.Scope.Name,
@@ -315,9 +411,23 @@ func (b *build) buildSelectClause(stmt *tree.SelectClause, orderBy tree.OrderBy,
 		}
 		qry.Scope.prune(attrsMap, nil)
 		if isCAQ(false, qry.Scope) {
-			pushDownUntransform(qry.Scope, nil)
+			pushDownUntransform(qry.Scope, nil, nil)
 			qry.Scope.pruneProjection(qry.Scope.getAggregations())
 		}
 	}
 	return nil
 }
+
+// buildFilteredDerived returns the statement whose from clause is replaced by
+// the derived table "select * from ... where ...".
+func (b *build) buildFilteredDerived(stmt *tree.SelectClause) *tree.SelectClause {
+	sub := &tree.SelectClause{
+		Exprs: tree.SelectExprs{{Expr: tree.UnqualifiedStar{}}},
+		From:  stmt.From,
+		Where: stmt.Where,
+	}
+	nstmt := *stmt
+	nstmt.Where = nil
+	nstmt.From = &tree.From{Tables: tree.TableExprs{newDerivedTable(b.newDerivedName(), sub)}}
+	return &nstmt
+}
@@ -0,0 +1,882 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"go/constant"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transformer"
+)
+
+// unnester rewrites the subqueries of a select clause into joins with derived tables.
+type unnester struct {
+	b      *build
+	tables tree.TableExprs // tables of from clause and the inner joined derived tables
+	conds  []tree.Expr     // conditions of where clause
+	outers []outerJoin     // derived tables which are left joined after the inner joins
+}
+
+type outerJoin struct {
+	tbl    tree.TableExpr
+	on     tree.Expr
+	filter tree.Expr // filter of anti join, nil if it is a scalar subquery
+}
+
+// correlation is the predicates of a subquery, the correlated predicates are
+// "inners[i] = outers[i]" and "inner op outer".
+type correlation struct {
+	locals []tree.Expr
+	inners []tree.Expr
+	outers []tree.Expr
+	op     tree.ComparisonOp
+	inner  tree.Expr
+	outer  tree.Expr
+}
+
+// newDerivedName returns a unique name of the generated derived table.
+func (b *build) newDerivedName() string {
+	b.derived++
+	return fmt.Sprintf("_derived%d", b.derived)
+}
+
+func newDerivedTable(name string, stmt *tree.SelectClause) *tree.AliasedTableExpr {
+	return &tree.AliasedTableExpr{
+		Expr: &tree.ParenTableExpr{Expr: &tree.Select{Select: stmt}},
+		As:   tree.AliasClause{Alias: tree.Identifier(name)},
+	}
+}
+
+// fromTable is a table of from clause and its columns.
+type fromTable struct {
+	name string
+	cols []string
+}
+
+// unnestSubqueries rewrites the subqueries of the where clause and the select
+// list into joins with derived tables, the statement is not modified. exists
+// and in are semi joins, the derived table is the distinct correlated columns.
+// not exists and not in are anti joins, the derived table is left joined and
+// the unmatched rows are kept, the nulls of not in are ignored. scalar subquery
+// is joined with its result grouped by the correlated columns, and a non-equal
+// correlated predicate of exists is evaluated by the min and max of the inner
+// column.
+func (b *build) unnestSubqueries(stmt *tree.SelectClause) (*tree.SelectClause, error) {
+	if stmt.Having != nil && containsSubquery(stmt.Having.Expr) {
+		return nil, errors.New(errno.FeatureNotSupported, "subquery in having clause is not supported now")
+	}
+	if stmt.From == nil || !hasSubquery(stmt) {
+		return stmt, nil
+	}
+	u := &unnester{b: b}
+	u.tables = append(u.tables, stmt.From.Tables...)
+	if stmt.Where != nil {
+		for _, cond := range splitConjuncts(stmt.Where.Expr) {
+			if err := u.unnestCondition(cond); err != nil {
+				return nil, err
+			}
+		}
+	}
+	exprs, err := u.unnestProjection(stmt)
+	if err != nil {
+		return nil, err
+	}
+	nstmt := *stmt
+	nstmt.Exprs = exprs
+	nstmt.Where = nil
+	nstmt.From = &tree.From{Tables: u.tables}
+	conds := u.conds
+	if len(u.outers) > 0 {
+		var tbl tree.TableExpr
+
+		if len(u.tables) == 1 && len(u.conds) == 0 {
+			tbl = u.tables[0]
+		} else {
+			tbl = newDerivedTable(b.newDerivedName(), &tree.SelectClause{
+				Exprs: tree.SelectExprs{{Expr: tree.UnqualifiedStar{}}},
+				From:  &tree.From{Tables: u.tables},
+				Where: newWhere(u.conds),
+			})
+		}
+		conds = nil
+		for _, oj := range u.outers {
+			tbl = &tree.JoinTableExpr{
+				JoinType: tree.JOIN_TYPE_LEFT,
+				Left:     tbl,
+				Right:    oj.tbl,
+				Cond:     &tree.OnJoinCond{Expr: oj.on},
+			}
+			if oj.filter != nil {
+				conds = append(conds, oj.filter)
+			}
+		}
+		nstmt.From = &tree.From{Tables: tree.TableExprs{tbl}}
+	}
+	nstmt.Where = newWhere(conds)
+	return &nstmt, nil
+}
+
+func (u *unnester) unnestCondition(cond tree.Expr) error {
+	switch e := stripParens(cond).(type) {
+	case *tree.Subquery:
+		if e.Exists {
+			return u.unnestExists(e, nil, false)
+		}
+	case *tree.NotExpr:
+		switch n := stripParens(e.Expr).(type) {
+		case *tree.Subquery:
+			if n.Exists {
+				return u.unnestExists(n, nil, true)
+			}
+		case *tree.ComparisonExpr:
+			if sub, ok := n.Right.(*tree.Subquery); ok && n.Op == tree.IN && n.SubOp == 0 {
+				return u.unnestExists(sub, n.Left, true)
+			}
+		}
+	case *tree.ComparisonExpr:
+		if sub, ok := e.Right.(*tree.Subquery); ok && (e.Op == tree.IN || e.Op == tree.NOT_IN) && e.SubOp == 0 {
+			return u.unnestExists(sub, e.Left, e.Op == tree.NOT_IN)
+		}
+	}
+	e, err := u.unnestScalar(cond, false)
+	if err != nil {
+		return err
+	}
+	u.conds = append(u.conds, e)
+	return nil
+}
+
+// unnestExists rewrites exists, in and their negations, left is the left
+// operand of in.
+func (u *unnester) unnestExists(sub *tree.Subquery, left tree.Expr, anti bool) error {
+	stmt, err := u.b.subqueryClause(sub)
+	if err != nil {
+		return err
+	}
+	c, err := u.b.correlate(stmt)
+	if err != nil {
+		return err
+	}
+	grouped := len(stmt.GroupBy) > 0 || stmt.Having != nil
+	if left != nil {
+		if len(stmt.Exprs) != 1 || isStar(stmt.Exprs[0].Expr) {
+			return errors.New(errno.InvalidColumnReference, "Operand should contain 1 column(s)")
+		}
+		if grouped && len(c.inners) > 0 {
+			return errors.New(errno.FeatureNotSupported, "correlated subquery with group by is not supported now")
+		}
+		c.inners = append(c.inners, stmt.Exprs[0].Expr)
+		c.outers = append(c.outers, left)
+	} else if grouped {
+		return errors.New(errno.FeatureNotSupported, "exists subquery with group by is not supported now")
+	}
+	name := u.b.newDerivedName()
+	sel := &tree.SelectClause{
+		From:    stmt.From,
+		Where:   newWhere(c.locals),
+		GroupBy: stmt.GroupBy,
+		Having:  stmt.Having,
+	}
+	eqs := u.keys(name, c, sel)
+	var filter tree.Expr
+	switch {
+	case c.inner != nil:
+		min, max := fmt.Sprintf("%s_min", name), fmt.Sprintf("%s_max", name)
+		sel.Exprs = append(sel.Exprs,
+			tree.SelectExpr{Expr: newFuncExpr("min", c.inner), As: tree.UnrestrictedIdentifier(min)},
+			tree.SelectExpr{Expr: newFuncExpr("max", c.inner), As: tree.UnrestrictedIdentifier(max)})
+		sel.GroupBy = c.inners
+		filter = rangePredicate(c.op, tree.SetUnresolvedName(min), tree.SetUnresolvedName(max), c.outer, anti)
+		if anti && len(c.inners) == 0 {
+			filter = tree.NewOrExpr(tree.NewIsNullExpr(tree.SetUnresolvedName(min)), filter)
+		}
+	case len(c.inners) == 0:
+		cnt := fmt.Sprintf("%s_cnt", name)
+		star := tree.NewNumVal(constant.MakeString("*"), "*", false)
+		sel.Exprs = append(sel.Exprs, tree.SelectExpr{Expr: newFuncExpr("count", star), As: tree.UnrestrictedIdentifier(cnt)})
+		op := tree.GREAT_THAN
+		if anti {
+			op = tree.EQUAL
+		}
+		filter = tree.NewComparisonExpr(op, tree.SetUnresolvedName(cnt), tree.NewNumVal(constant.MakeInt64(0), "0", false))
+	default:
+		sel.Distinct = true
+	}
+	tbl := newDerivedTable(name, sel)
+	if !anti || len(c.inners) == 0 { // the aggregation without keys has exactly one row
+		u.tables = append(u.tables, tbl)
+		u.conds = append(u.conds, eqs...)
+		if filter != nil {
+			u.conds = append(u.conds, filter)
+		}
+		return nil
+	}
+	isNull := tree.NewIsNullExpr(tree.SetUnresolvedName(fmt.Sprintf("%s_k0", name)))
+	if filter != nil {
+		filter = tree.NewParenExpr(tree.NewOrExpr(isNull, filter))
+	} else {
+		filter = isNull
+	}
+	u.outers = append(u.outers, outerJoin{tbl: tbl, on: andExprs(eqs), filter: filter})
+	return nil
+}
+
+// unnestScalar replaces the scalar subqueries of the expression by the columns
+// of derived tables, the derived table is left joined if it is in projection.
+func (u *unnester) unnestScalar(e tree.Expr, isProjection bool) (tree.Expr, error) {
+	return mapExpr(e, func(e tree.Expr) (tree.Expr, bool, error) {
+		switch t := e.(type) {
+		case *tree.ComparisonExpr:
+			if _, ok := t.Right.(*tree.Subquery); ok && (t.SubOp != 0 || t.Op == tree.IN || t.Op == tree.NOT_IN) {
+				return nil, true, errors.New(errno.FeatureNotSupported, fmt.Sprintf("subquery '%s' is not supported now", tree.String(t, dialect.MYSQL)))
+			}
+		case *tree.Subquery:
+			if t.Exists {
+				return nil, true, errors.New(errno.FeatureNotSupported, fmt.Sprintf("subquery '%s' is not supported now", tree.String(t, dialect.MYSQL)))
+			}
+			col, err := u.unnestScalarSubquery(t, isProjection)
+			return col, true, err
+		}
+		return e, false, nil
+	})
+}
+
+func (u *unnester) unnestScalarSubquery(sub *tree.Subquery, isProjection bool) (tree.Expr, error) {
+	stmt, err := u.b.subqueryClause(sub)
+	if err != nil {
+		return nil, err
+	}
+	if len(stmt.Exprs) != 1 || isStar(stmt.Exprs[0].Expr) {
+		return nil, errors.New(errno.InvalidColumnReference, "Operand should contain 1 column(s)")
+	}
+	c, err := u.b.correlate(stmt)
+	if err != nil {
+		return nil, err
+	}
+	if c.inner != nil {
+		return nil, errors.New(errno.FeatureNotSupported, "non-equal correlated predicate of scalar subquery is not supported now")
+	}
+	if len(c.inners) > 0 && (len(stmt.GroupBy) > 0 || stmt.Having != nil) {
+		return nil, errors.New(errno.FeatureNotSupported, "correlated subquery with group by is not supported now")
+	}
+	if len(c.inners) > 0 && hasCount(stmt.Exprs[0].Expr) {
+		return nil, errors.New(errno.FeatureNotSupported, "correlated subquery with count is not supported now")
+	}
+	name := u.b.newDerivedName()
+	sel := &tree.SelectClause{
+		From:    stmt.From,
+		Where:   newWhere(c.locals),
+		GroupBy: stmt.GroupBy,
+		Having:  stmt.Having,
+	}
+	eqs := u.keys(name, c, sel)
+	if len(c.inners) > 0 && hasAggregation(stmt.Exprs[0].Expr) {
+		sel.GroupBy = c.inners
+	}
+	v := fmt.Sprintf("%s_v", name)
+	sel.Exprs = append(sel.Exprs, tree.SelectExpr{Expr: stmt.Exprs[0].Expr, As: tree.UnrestrictedIdentifier(v)})
+	tbl := newDerivedTable(name, sel)
+	if len(c.inners) == 0 || !isProjection {
+		u.tables = append(u.tables, tbl)
+		u.conds = append(u.conds, eqs...)
+	} else {
+		u.outers = append(u.outers, outerJoin{tbl: tbl, on: andExprs(eqs)})
+	}
+	return tree.SetUnresolvedName(v), nil
+}
+
+// unnestProjection replaces the scalar subqueries of select list, and expands
+// the star which would contain the columns of derived tables.
+func (u *unnester) unnestProjection(stmt *tree.SelectClause) (tree.SelectExprs, error) {
+	var exprs tree.SelectExprs
+
+	for _, expr := range stmt.Exprs {
+		if isStar(expr.Expr) {
+			if len(u.tables) == len(stmt.From.Tables) && len(u.outers) == 0 {
+				exprs = append(exprs, expr)
+				continue
+			}
+			tbls, err := u.b.getFromTables(stmt.From.Tables)
+			if err != nil {
+				return nil, err
+			}
+			exprs = append(exprs, expandStar(tbls)...)
+			continue
+		}
+		e, err := u.unnestScalar(expr.Expr, true)
+		if err != nil {
+			return nil, err
+		}
+		as := expr.As
+		if len(as) == 0 && e != expr.Expr { // keep the name of column
+			as = tree.UnrestrictedIdentifier(tree.String(&expr, dialect.MYSQL))
+		}
+		exprs = append(exprs, tree.SelectExpr{Expr: e, As: as})
+	}
+	return exprs, nil
+}
+
+// keys adds the correlated inner columns to the select list of derived table
+// and returns the join conditions.
+func (u *unnester) keys(name string, c *correlation, sel *tree.SelectClause) []tree.Expr {
+	eqs := make([]tree.Expr, len(c.inners))
+	for i, e := range c.inners {
+		k := fmt.Sprintf("%s_k%d", name, i)
+		sel.Exprs = append(sel.Exprs, tree.SelectExpr{Expr: e, As: tree.UnrestrictedIdentifier(k)})
+		eqs[i] = tree.NewComparisonExpr(tree.EQUAL, c.outers[i], tree.SetUnresolvedName(k))
+	}
+	return eqs
+}
+
+// subqueryClause returns the unnested select clause of subquery.
+func (b *build) subqueryClause(sub *tree.Subquery) (*tree.SelectClause, error) {
+	stmt := sub.Select
+	for {
+		switch s := stmt.(type) {
+		case *tree.ParenSelect:
+			stmt = s.Select
+			continue
+		case *tree.Select:
+			if s.Limit != nil {
+				return nil, errors.New(errno.FeatureNotSupported, "limit in subquery is not supported now")
+			}
+			stmt = s.Select
+			continue
+		case *tree.SelectClause:
+			if s.From == nil {
+				return nil, errors.New(errno.SQLStatementNotYetComplete, "need from clause")
+			}
+			return b.unnestSubqueries(s)
+		}
+		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("subquery '%s' is not supported now", tree.String(sub, dialect.MYSQL)))
+	}
+}
+
+// correlate splits the predicates of subquery into the local predicates and
+// the correlated predicates.
+func (b *build) correlate(stmt *tree.SelectClause) (*correlation, error) {
+	tbls, err := b.getFromTables(stmt.From.Tables)
+	if err != nil {
+		return nil, err
+	}
+	for _, expr := range stmt.Exprs {
+		if !isStar(expr.Expr) && hasOuterColumn(expr.Expr, tbls) {
+			return nil, errors.New(errno.FeatureNotSupported, "correlated column in select list of subquery is not supported now")
+		}
+	}
+	c := new(correlation)
+	if stmt.Where == nil {
+		return c, nil
+	}
+	for _, cond := range splitConjuncts(stmt.Where.Expr) {
+		if !hasOuterColumn(cond, tbls) {
+			c.locals = append(c.locals, cond)
+			continue
+		}
+		e, ok := stripParens(cond).(*tree.ComparisonExpr)
+		if !ok || e.SubOp != 0 || e.Op > tree.NOT_EQUAL {
+			return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("correlated predicate '%s' is not supported now", tree.String(cond, dialect.MYSQL)))
+		}
+		inner, outer, op := e.Left, e.Right, e.Op
+		if !isLocal(inner, tbls) || !isOuter(outer, tbls) {
+			inner, outer, op = e.Right, e.Left, reverseComparison(e.Op)
+			if !isLocal(inner, tbls) || !isOuter(outer, tbls) {
+				return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("correlated predicate '%s' is not supported now", tree.String(cond, dialect.MYSQL)))
+			}
+		}
+		switch {
+		case op == tree.EQUAL:
+			c.inners = append(c.inners, inner)
+			c.outers = append(c.outers, outer)
+		case c.inner != nil:
+			return nil, errors.New(errno.FeatureNotSupported, "multiple non-equal correlated predicates are not supported now")
+		default:
+			c.op, c.inner, c.outer = op, inner, outer
+		}
+	}
+	return c, nil
+}
+
+// getFromTables returns the tables of from clause and their columns.
+func (b *build) getFromTables(tbls tree.TableExprs) ([]fromTable, error) {
+	var rs []fromTable
+
+	for _, tbl := range tbls {
+		ts, err := b.getFromTable(tbl, "")
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, ts...)
+	}
+	return rs, nil
+}
+
+func (b *build) getFromTable(tbl tree.TableExpr, alias string) ([]fromTable, error) {
+	switch t := tbl.(type) {
+	case *tree.TableName:
+		schema := string(t.SchemaName)
+		if len(schema) == 0 {
+			schema = b.db
+		}
+		attrs, _, _, _, err := b.getSchemaInfo(schema, string(t.ObjectName))
+		if err != nil {
+			return nil, err
+		}
+		if len(alias) == 0 {
+			alias = string(t.ObjectName)
+		}
+		return []fromTable{{name: alias, cols: attrs}}, nil
+	case *tree.AliasedTableExpr:
+		return b.getFromTable(t.Expr, string(t.As.Alias))
+	case *tree.ParenTableExpr:
+		if sel, ok := t.Expr.(*tree.Select); ok {
+			cols, err := b.getSelectColumns(sel)
+			if err != nil {
+				return nil, err
+			}
+			return []fromTable{{name: alias, cols: cols}}, nil
+		}
+		return b.getFromTable(t.Expr, alias)
+	case *tree.JoinTableExpr:
+		left, err := b.getFromTable(t.Left, "")
+		if err != nil {
+			return nil, err
+		}
+		if t.Right == nil {
+			return left, nil
+		}
+		right, err := b.getFromTable(t.Right, "")
+		if err != nil {
+			return nil, err
+		}
+		return append(left, right...), nil
+	}
+	return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unknown table expr: %T", tbl))
+}
+
+// getSelectColumns returns the names of result columns of the select statement.
+func (b *build) getSelectColumns(stmt tree.SelectStatement) ([]string, error) {
+	for {
+		switch s := stmt.(type) {
+		case *tree.Select:
+			stmt = s.Select
+			continue
+		case *tree.ParenSelect:
+			stmt = s.Select
+			continue
+		case *tree.SelectClause:
+			var cols []string
+
+			for _, expr := range s.Exprs {
+				switch e := expr.Expr.(type) {
+				case tree.UnqualifiedStar:
+					tbls, err := b.getFromTables(s.From.Tables)
+					if err != nil {
+						return nil, err
+					}
+					for _, tbl := range tbls {
+						cols = append(cols, tbl.cols...)
+					}
+				case *tree.UnresolvedName:
+					switch {
+					case len(expr.As) > 0:
+						cols = append(cols, string(expr.As))
+					case e.Star:
+						tbls, err := b.getFromTables(s.From.Tables)
+						if err != nil {
+							return nil, err
+						}
+						for _, tbl := range tbls {
+							if tbl.name == e.Parts[0] {
+								cols = append(cols, tbl.cols...)
+							}
+						}
+					default:
+						cols = append(cols, e.Parts[0])
+					}
+				default:
+					if len(expr.As) > 0 {
+						cols = append(cols, string(expr.As))
+					} else {
+						cols = append(cols, tree.String(&expr, dialect.MYSQL))
+					}
+				}
+			}
+			return cols, nil
+		}
+		return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unknown select statement: %T", stmt))
+	}
+}
+
+// expandStar returns the columns of tables, the ambiguous column is qualified.
+func expandStar(tbls []fromTable) tree.SelectExprs {
+	var exprs tree.SelectExprs
+
+	cnts := make(map[string]int)
+	for _, tbl := range tbls {
+		for _, col := range tbl.cols {
+			cnts[col]++
+		}
+	}
+	for _, tbl := range tbls {
+		for _, col := range tbl.cols {
+			if cnts[col] > 1 {
+				exprs = append(exprs, tree.SelectExpr{Expr: tree.SetUnresolvedName(tbl.name, col)})
+			} else {
+				exprs = append(exprs, tree.SelectExpr{Expr: tree.SetUnresolvedName(col)})
+			}
+		}
+	}
+	return exprs
+}
+
+// isLocalColumn returns true if the column belongs to the tables.
+func isLocalColumn(n *tree.UnresolvedName, tbls []fromTable) bool {
+	for _, tbl := range tbls {
+		if n.NumParts > 1 {
+			if tbl.name == n.Parts[1] {
+				return true
+			}
+			continue
+		}
+		for _, col := range tbl.cols {
+			if col == n.Parts[0] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isLocal returns true if the expression has columns and all of them belong to the tables.
+func isLocal(e tree.Expr, tbls []fromTable) bool {
+	var local, outer bool
+
+	walkColumns(e, func(n *tree.UnresolvedName) {
+		if isLocalColumn(n, tbls) {
+			local = true
+		} else {
+			outer = true
+		}
+	})
+	return local && !outer
+}
+
+// isOuter returns true if the expression has columns and none of them belongs to the tables.
+func isOuter(e tree.Expr, tbls []fromTable) bool {
+	var local, outer bool
+
+	walkColumns(e, func(n *tree.UnresolvedName) {
+		if isLocalColumn(n, tbls) {
+			local = true
+		} else {
+			outer = true
+		}
+	})
+	return outer && !local
+}
+
+func hasOuterColumn(e tree.Expr, tbls []fromTable) bool {
+	var outer bool
+
+	walkColumns(e, func(n *tree.UnresolvedName) {
+		if !isLocalColumn(n, tbls) {
+			outer = true
+		}
+	})
+	return outer
+}
+
+func walkColumns(e tree.Expr, fn func(*tree.UnresolvedName)) {
+	mapExpr(e, func(e tree.Expr) (tree.Expr, bool, error) {
+		if n, ok := e.(*tree.UnresolvedName); ok && !n.Star {
+			fn(n)
+		}
+		return e, false, nil
+	})
+}
+
+func hasSubquery(stmt *tree.SelectClause) bool {
+	if stmt.Where != nil && containsSubquery(stmt.Where.Expr) {
+		return true
+	}
+	for _, expr := range stmt.Exprs {
+		if containsSubquery(expr.Expr) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsSubquery(e tree.Expr) bool {
+	var ok bool
+
+	mapExpr(e, func(e tree.Expr) (tree.Expr, bool, error) {
+		if _, isSub := e.(*tree.Subquery); isSub {
+			ok = true
+			return e, true, nil
+		}
+		return e, false, nil
+	})
+	return ok
+}
+
+func hasAggregation(e tree.Expr) bool {
+	return hasFunction(e, func(name string) bool {
+		_, ok := transformer.TransformerNamesMap[name]
+		return ok
+	})
+}
+
+// hasCount returns true if the expression counts rows, whose result of an empty
+// group is 0 rather than null.
+func hasCount(e tree.Expr) bool {
+	return hasFunction(e, func(name string) bool {
+		return name == "count" || name == "approx_count_distinct"
+	})
+}
+
+func hasFunction(e tree.Expr, fn func(string) bool) bool {
+	var ok bool
+
+	mapExpr(e, func(e tree.Expr) (tree.Expr, bool, error) {
+		if f, isFunc := e.(*tree.FuncExpr); isFunc {
+			if name, isName := f.Func.FunctionReference.(*tree.UnresolvedName); isName {
+				if fn(strings.ToLower(name.Parts[0])) {
+					ok = true
+					return e, true, nil
+				}
+			}
+		}
+		return e, false, nil
+	})
+	return ok
+}
+
+// mapExpr returns the expression whose sub expressions are replaced by fn, the
+// sub expression is not visited if fn handles the expression. the expression
+// is copied if any of its sub expressions is replaced.
+func mapExpr(e tree.Expr, fn func(tree.Expr) (tree.Expr, bool, error)) (tree.Expr, error) {
+	if e == nil {
+		return nil, nil
+	}
+	if r, ok, err := fn(e); ok || err != nil {
+		return r, err
+	}
+	var err error
+	var changed bool
+
+	orig := e
+	sub := func(e tree.Expr) tree.Expr {
+		if err != nil {
+			return e
+		}
+		var r tree.Expr
+		if r, err = mapExpr(e, fn); r != e {
+			changed = true
+		}
+		return r
+	}
+	switch t := e.(type) {
+	case *tree.ParenExpr:
+		n := *t
+		n.Expr = sub(t.Expr)
+		e = &n
+	case *tree.AndExpr:
+		n := *t
+		n.Left, n.Right = sub(t.Left), sub(t.Right)
+		e = &n
+	case *tree.OrExpr:
+		n := *t
+		n.Left, n.Right = sub(t.Left), sub(t.Right)
+		e = &n
+	case *tree.XorExpr:
+		n := *t
+		n.Left, n.Right = sub(t.Left), sub(t.Right)
+		e = &n
+	case *tree.NotExpr:
+		n := *t
+		n.Expr = sub(t.Expr)
+		e = &n
+	case *tree.ComparisonExpr:
+		n := *t
+		n.Left, n.Right = sub(t.Left), sub(t.Right)
+		e = &n
+	case *tree.BinaryExpr:
+		n := *t
+		n.Left, n.Right = sub(t.Left), sub(t.Right)
+		e = &n
+	case *tree.UnaryExpr:
+		n := *t
+		n.Expr = sub(t.Expr)
+		e = &n
+	case *tree.IsNullExpr:
+		n := *t
+		n.Expr = sub(t.Expr)
+		e = &n
+	case *tree.IsNotNullExpr:
+		n := *t
+		n.Expr = sub(t.Expr)
+		e = &n
+	case *tree.CastExpr:
+		n := *t
+		n.Expr = sub(t.Expr)
+		e = &n
+	case *tree.RangeCond:
+		n := *t
+		n.Left, n.From, n.To = sub(t.Left), sub(t.From), sub(t.To)
+		e = &n
+	case *tree.FuncExpr:
+		n := *t
+		n.Exprs = make(tree.Exprs, len(t.Exprs))
+		for i, arg := range t.Exprs {
+			n.Exprs[i] = sub(arg)
+		}
+		e = &n
+	case *tree.CaseExpr:
+		n := *t
+		n.Expr, n.Else = sub(t.Expr), sub(t.Else)
+		n.Whens = make([]*tree.When, len(t.Whens))
+		for i, w := range t.Whens {
+			n.Whens[i] = tree.NewWhen(sub(w.Cond), sub(w.Val))
+		}
+		e = &n
+	case *tree.Tuple:
+		n := *t
+		n.Exprs = make(tree.Exprs, len(t.Exprs))
+		for i, arg := range t.Exprs {
+			n.Exprs[i] = sub(arg)
+		}
+		e = &n
+	default:
+		return e, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !changed {
+		return orig, nil
+	}
+	return e, nil
+}
+
+// rangePredicate returns the predicate which is true if there is a value v
+// in [min, max] satisfying v op e, or its negation.
+func rangePredicate(op tree.ComparisonOp, min, max, e tree.Expr, not bool) tree.Expr {
+	switch op {
+	case tree.LESS_THAN, tree.LESS_THAN_EQUAL:
+		if not {
+			return tree.NewComparisonExpr(negateComparison(op), min, e)
+		}
+		return tree.NewComparisonExpr(op, min, e)
+	case tree.GREAT_THAN, tree.GREAT_THAN_EQUAL:
+		if not {
+			return tree.NewComparisonExpr(negateComparison(op), max, e)
+		}
+		return tree.NewComparisonExpr(op, max, e)
+	}
+	if not {
+		return tree.NewParenExpr(tree.NewAndExpr(tree.NewComparisonExpr(tree.EQUAL, min, e), tree.NewComparisonExpr(tree.EQUAL, max, e)))
+	}
+	return tree.NewParenExpr(tree.NewOrExpr(tree.NewComparisonExpr(tree.NOT_EQUAL, min, e), tree.NewComparisonExpr(tree.NOT_EQUAL, max, e)))
+}
+
+// reverseComparison returns op' which satisfies a op b = b op' a.
+func reverseComparison(op tree.ComparisonOp) tree.ComparisonOp {
+	switch op {
+	case tree.LESS_THAN:
+		return tree.GREAT_THAN
+	case tree.LESS_THAN_EQUAL:
+		return tree.GREAT_THAN_EQUAL
+	case tree.GREAT_THAN:
+		return tree.LESS_THAN
+	case tree.GREAT_THAN_EQUAL:
+		return tree.LESS_THAN_EQUAL
+	}
+	return op
+}
+
+func negateComparison(op tree.ComparisonOp) tree.ComparisonOp {
+	switch op {
+	case tree.EQUAL:
+		return tree.NOT_EQUAL
+	case tree.LESS_THAN:
+		return tree.GREAT_THAN_EQUAL
+	case tree.LESS_THAN_EQUAL:
+		return tree.GREAT_THAN
+	case tree.GREAT_THAN:
+		return tree.LESS_THAN_EQUAL
+	case tree.GREAT_THAN_EQUAL:
+		return tree.LESS_THAN
+	}
+	return tree.EQUAL
+}
+
+func splitConjuncts(e tree.Expr) []tree.Expr {
+	switch t := e.(type) {
+	case *tree.AndExpr:
+		return append(splitConjuncts(t.Left), splitConjuncts(t.Right)...)
+	case *tree.ParenExpr:
+		if _, ok := t.Expr.(*tree.AndExpr); ok {
+			return splitConjuncts(t.Expr)
+		}
+	}
+	return []tree.Expr{e}
+}
+
+func andExprs(es []tree.Expr) tree.Expr {
+	if len(es) == 0 {
+		return nil
+	}
+	e := es[0]
+	for _, expr := range es[1:] {
+		e = tree.NewAndExpr(e, expr)
+	}
+	return e
+}
+
+func newWhere(es []tree.Expr) *tree.Where {
+	if len(es) == 0 {
+		return nil
+	}
+	return &tree.Where{Type: tree.AstWhere, Expr: andExprs(es)}
+}
+
+func newFuncExpr(name string, args ...tree.Expr) *tree.FuncExpr {
+	return &tree.FuncExpr{
+		Func:  tree.FuncName2ResolvableFunctionReference(tree.SetUnresolvedName(name)),
+		Exprs: args,
+	}
+}
+
+func stripParens(e tree.Expr) tree.Expr {
+	for {
+		p, ok := e.(*tree.ParenExpr)
+		if !ok {
+			return e
+		}
+		e = p.Expr
+	}
+}
+
+func isStar(e tree.Expr) bool {
+	_, ok := e.(tree.UnqualifiedStar)
+	return ok
+}
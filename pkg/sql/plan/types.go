@@ -21,6 +21,9 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/window"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
 
@@ -36,6 +39,13 @@ const (
 	RELATION
 )
 
+// types of set operation
+const (
+	UNION = iota
+	INTERSECT
+	EXCEPT
+)
+
 // Direction for ordering results.
 type Direction int8
 
@@ -68,6 +78,7 @@ type Aggregation struct {
 
 type Relation struct {
 	Rows   int64
+	Stats  *engine.StatisticsDef // statistics collected by ANALYZE TABLE, nil if never analyzed
 	Name   string                // table name
 	Schema string                // schema name
 	Attrs  map[string]*Attribute // table's column information
@@ -119,6 +130,9 @@ type ScopeSet struct {
 	JoinType int
 	Scopes   []*Scope
 	Conds    []*JoinCondition
+	// Sels are the estimated selectivities of the filters on Scopes,
+	// nil if they are unknown
+	Sels []float64
 }
 
 type Query struct {
@@ -147,10 +161,40 @@ type Join struct {
 type Dedup struct {
 }
 
+// SetOperation combines the results of its two children, whose attributes
+// are renamed and cast to the same name and type.
+type SetOperation struct {
+	Type int  // UNION, INTERSECT or EXCEPT
+	All  bool // if true, the duplicate rows are kept
+}
+
 type Order struct {
 	Fs []*Field
 }
 
+// Window evaluates the window functions, which are grouped by their partition and order.
+type Window struct {
+	Specs []*WindowSpec
+}
+
+type WindowSpec struct {
+	Partitions []string
+	Fs         []*Field
+	Funcs      []*WindowFunc
+}
+
+type WindowFunc struct {
+	Op     int           // opcode of window function
+	Agg    int           // opcode of aggregation function
+	Ref    int           // reference count
+	Name   string        // name of argument
+	Dflt   extend.Extend // default value of lag and lead
+	Offset int64         // offset of lag and lead
+	Alias  string
+	Type   types.Type
+	Frame  window.Frame
+}
+
 type Limit struct {
 	Limit int64
 }
@@ -165,6 +209,7 @@ type Restrict struct {
 
 type Untransform struct {
 	FreeVars []string
+	Aggs     []*Aggregation // aggregations without free variables, they are returned even if nothing is aggregated
 }
 
 type Rename struct {
@@ -226,6 +271,27 @@ type CreateIndex struct {
 	Defs           []engine.TableDef
 }
 
+// Analyze collects the statistics of Attrs from the rows read by Qry.
+type Analyze struct {
+	Id       string
+	Relation engine.Relation
+	Attrs    []engine.Attribute
+	Qry      *Query
+}
+
+// AlterTableDef is a change of the table definition, Def is removed
+// from the relation if Drop is true, otherwise it is added.
+type AlterTableDef struct {
+	Drop bool
+	Def  engine.TableDef
+}
+
+type AlterTable struct {
+	Id       string
+	Relation engine.Relation
+	Defs     []AlterTableDef
+}
+
 type DropDatabase struct {
 	IfExistFlag bool
 	Id          string
@@ -273,6 +339,13 @@ type ShowCreateDatabase struct {
 	E              engine.Engine
 }
 
+// Explain shows the physical plan of the query, the query is executed
+// and the statistics of its operators are shown if Analyze is true.
+type Explain struct {
+	Analyze bool
+	Qry     *Query
+}
+
 type Insert struct {
 	Id       string
 	Db       string
@@ -285,14 +358,51 @@ type Delete struct {
 }
 
 type Update struct {
-	Qry *Query
+	Qry         *Query
+	UpdateAttrs []string        // names of updated attributes
+	UpdateList  []extend.Extend // new values of updated attributes
+}
+
+type CreateUser struct {
+	IfNotExistFlag bool
+	Users          []privilege.Account
+	M              *privilege.Manager
+}
+
+type DropUser struct {
+	IfExistFlag bool
+	Users       []privilege.Account
+	M           *privilege.Manager
+}
+
+type AlterUser struct {
+	IfExistFlag bool
+	Users       []privilege.Account
+	M           *privilege.Manager
+}
+
+type Grant struct {
+	Users []privilege.Account
+	Grant privilege.Grant
+	M     *privilege.Manager
+}
+
+type Revoke struct {
+	Users []privilege.Account
+	Grant privilege.Grant
+	M     *privilege.Manager
 }
 
 type build struct {
-	flg bool   // use for having clause
-	db  string // name of schema
-	sql string
-	e   engine.Engine
+	flg     bool   // use for having clause
+	hideKey bool   // if true, the hidden key of relation is visible, use for delete and update
+	db      string // name of schema
+	sql     string
+	e       engine.Engine
+	pc      *privilege.Checker                     // privilege checker of current user, checking is disabled if it is nil
+	params  []tree.Expr                            // values bound to the placeholders of prepared statement
+	vars    func(*tree.VarExpr) (tree.Expr, error) // returns the value of system or user variable
+	derived int                                    // number of the generated derived tables, use for naming them
 }
 
 func (qry *Query) ResultColumns() []*Attribute {
@@ -394,6 +504,56 @@ func (c CreateIndex) ResultColumns() []*Attribute {
 	return nil
 }
 
+func (a Analyze) String() string {
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("analyze table %s(", a.Id))
+	for i, attr := range a.Attrs {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(attr.Name)
+	}
+	buf.WriteString(")")
+	return buf.String()
+}
+
+func (a Analyze) ResultColumns() []*Attribute {
+	return nil
+}
+
+func (a AlterTable) String() string {
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("alter table %s", a.Id))
+	for i, def := range a.Defs {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		switch d := def.Def.(type) {
+		case *engine.AttributeDef:
+			if def.Drop {
+				buf.WriteString(fmt.Sprintf(" drop column %s", d.Attr.Name))
+			} else {
+				buf.WriteString(fmt.Sprintf(" add column %s %s", d.Attr.Name, d.Attr.Type))
+			}
+		case *engine.RenameColumnDef:
+			buf.WriteString(fmt.Sprintf(" rename column %s to %s", d.Name, d.NewName))
+		case *engine.ColumnDefaultDef:
+			if d.Default.Exist {
+				buf.WriteString(fmt.Sprintf(" alter column %s set default", d.Name))
+			} else {
+				buf.WriteString(fmt.Sprintf(" alter column %s drop default", d.Name))
+			}
+		case *engine.RenameTableDef:
+			buf.WriteString(fmt.Sprintf(" rename to %s", d.Name))
+		}
+	}
+	return buf.String()
+}
+
+func (a AlterTable) ResultColumns() []*Attribute {
+	return nil
+}
+
 func (d DropDatabase) String() string {
 	var buf bytes.Buffer
 	buf.WriteString("drop database ")
@@ -460,6 +620,26 @@ func (s ShowDatabases) ResultColumns() []*Attribute {
 	}
 }
 
+func (e Explain) String() string {
+	if e.Analyze {
+		return "explain analyze " + e.Qry.String()
+	}
+	return "explain " + e.Qry.String()
+}
+
+func (e Explain) ResultColumns() []*Attribute {
+	return []*Attribute{
+		&Attribute{
+			Ref:  1,
+			Name: "QUERY PLAN",
+			Type: types.Type{
+				Oid:  types.T_varchar,
+				Size: 24,
+			},
+		},
+	}
+}
+
 func (s ShowTables) String() string {
 	var buf bytes.Buffer
 	buf.WriteString("show tables")
@@ -565,3 +745,87 @@ func (p Update) String() string {
 func (p Update) ResultColumns() []*Attribute {
 	return nil
 }
+
+func (c CreateUser) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("create user ")
+	if c.IfNotExistFlag {
+		buf.WriteString("if not exists ")
+	}
+	writeAccounts(&buf, c.Users)
+	return buf.String()
+}
+
+func (c CreateUser) ResultColumns() []*Attribute {
+	return nil
+}
+
+func (d DropUser) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("drop user ")
+	if d.IfExistFlag {
+		buf.WriteString("if exists ")
+	}
+	writeAccounts(&buf, d.Users)
+	return buf.String()
+}
+
+func (d DropUser) ResultColumns() []*Attribute {
+	return nil
+}
+
+func (a AlterUser) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("alter user ")
+	if a.IfExistFlag {
+		buf.WriteString("if exists ")
+	}
+	writeAccounts(&buf, a.Users)
+	return buf.String()
+}
+
+func (a AlterUser) ResultColumns() []*Attribute {
+	return nil
+}
+
+func (g Grant) String() string {
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("grant %s on %s to ", g.Grant.Privs, grantLevel(g.Grant)))
+	writeAccounts(&buf, g.Users)
+	return buf.String()
+}
+
+func (g Grant) ResultColumns() []*Attribute {
+	return nil
+}
+
+func (r Revoke) String() string {
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("revoke %s on %s from ", r.Grant.Privs, grantLevel(r.Grant)))
+	writeAccounts(&buf, r.Users)
+	return buf.String()
+}
+
+func (r Revoke) ResultColumns() []*Attribute {
+	return nil
+}
+
+func writeAccounts(buf *bytes.Buffer, users []privilege.Account) {
+	for i, u := range users {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(privilege.Key(u.Name, u.Host))
+	}
+}
+
+func grantLevel(g privilege.Grant) string {
+	switch g.Level {
+	case privilege.Global:
+		return "*.*"
+	case privilege.Database:
+		return g.Db + ".*"
+	default:
+		return g.Db + "." + g.Table
+	}
+}
@@ -0,0 +1,223 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"go/constant"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// buildUnion builds the set operation, the result attributes are named after
+// the left operand, and their types are unified over both operands.
+func (b *build) buildUnion(stmt *tree.UnionClause, orderBy tree.OrderBy, qry *Query) error {
+	left, right := &Query{}, &Query{}
+	if err := b.buildSelectStatement(stmt.Left, left); err != nil {
+		return err
+	}
+	if err := b.buildSelectStatement(stmt.Right, right); err != nil {
+		return err
+	}
+	if len(left.Result) != len(right.Result) {
+		return errors.New(errno.CardinalityViolation, "The used SELECT statements have a different number of columns")
+	}
+	typs := make([]types.Type, len(left.Result))
+	for i := range typs {
+		ltyp := left.Scope.Result.AttrsMap[left.Result[i]].Type
+		rtyp := right.Scope.Result.AttrsMap[right.Result[i]].Type
+		typ, ok := unifyType(ltyp, rtyp)
+		if !ok {
+			return errors.New(errno.DatatypeMismatch, fmt.Sprintf("%s types %s and %s cannot be matched", stmt.Type, ltyp, rtyp))
+		}
+		typs[i] = typ
+	}
+	s := &Scope{
+		Name:     left.Scope.Name,
+		Children: []*Scope{castResult(left, left.Result, typs), castResult(right, left.Result, typs)},
+	}
+	{ // construct result
+		s.Result.AttrsMap = make(map[string]*Attribute)
+		for i, attr := range left.Result {
+			s.Result.Attrs = append(s.Result.Attrs, attr)
+			s.Result.AttrsMap[attr] = &Attribute{
+				Name: attr,
+				Type: typs[i],
+			}
+		}
+	}
+	op := &SetOperation{All: stmt.All}
+	switch stmt.Type {
+	case tree.UNION:
+		op.Type = UNION
+	case tree.INTERSECT:
+		op.Type = INTERSECT
+	case tree.EXCEPT:
+		op.Type = EXCEPT
+	}
+	s.Op = op
+	qry.Scope = s
+	qry.Result = append(qry.Result, left.Result...)
+	if len(orderBy) > 0 {
+		fs, err := buildUnionOrderBy(orderBy, qry)
+		if err != nil {
+			return err
+		}
+		s := &Scope{
+			Name:     qry.Scope.Name,
+			Children: []*Scope{qry.Scope},
+		}
+		{ // construct result
+			s.Result.AttrsMap = make(map[string]*Attribute)
+			for _, attr := range qry.Scope.Result.Attrs {
+				s.Result.Attrs = append(s.Result.Attrs, attr)
+				s.Result.AttrsMap[attr] = &Attribute{
+					Name: attr,
+					Type: qry.Scope.Result.AttrsMap[attr].Type,
+				}
+			}
+		}
+		s.Op = &Order{fs}
+		qry.Scope = s
+	}
+	return nil
+}
+
+// buildUnionOrderBy returns the fields of order by clause over the result of
+// set operation, which are result attributes or their positions.
+func buildUnionOrderBy(orderBy tree.OrderBy, qry *Query) ([]*Field, error) {
+	fs := make([]*Field, 0, len(orderBy))
+	for _, order := range orderBy {
+		if err := checkNullsPosition(order); err != nil {
+			return nil, err
+		}
+		attr := tree.String(order.Expr, dialect.MYSQL)
+		if v, ok := order.Expr.(*tree.NumVal); ok && v.Value.Kind() == constant.Int {
+			n, ok := constant.Int64Val(v.Value)
+			if !ok || n < 1 || n > int64(len(qry.Result)) {
+				return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("Unknown column '%s' in 'order clause'", attr))
+			}
+			attr = qry.Result[n-1]
+		} else if _, ok := qry.Scope.Result.AttrsMap[attr]; !ok {
+			return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("Unknown column '%s' in 'order clause'", attr))
+		}
+		fs = append(fs, &Field{
+			Attr: attr,
+			Type: Direction(order.Direction),
+		})
+	}
+	return fs, nil
+}
+
+// castResult returns the scope of the operand of set operation, whose result
+// attributes are renamed to attrs and cast to typs if necessary.
+func castResult(qry *Query, attrs []string, typs []types.Type) *Scope {
+	flg := false
+	for i, attr := range qry.Result {
+		if attr != attrs[i] || qry.Scope.Result.AttrsMap[attr].Type.Oid != typs[i].Oid {
+			flg = true
+		}
+	}
+	if !flg {
+		return qry.Scope
+	}
+	op := new(ResultProjection)
+	s := &Scope{
+		Name:     qry.Scope.Name,
+		Children: []*Scope{qry.Scope},
+	}
+	s.Result.AttrsMap = make(map[string]*Attribute)
+	for i, attr := range qry.Result {
+		typ := qry.Scope.Result.AttrsMap[attr].Type
+		var e extend.Extend = &extend.Attribute{
+			Name: attr,
+			Type: typ.Oid,
+		}
+		if typ.Oid != typs[i].Oid {
+			e = &extend.BinaryExtend{
+				Op:    overload.Typecast,
+				Left:  e,
+				Right: &extend.ValueExtend{V: vector.New(typs[i])},
+			}
+		}
+		op.Rs = append(op.Rs, 1)
+		op.As = append(op.As, attrs[i])
+		op.Es = append(op.Es, e)
+		s.Result.Attrs = append(s.Result.Attrs, attrs[i])
+		s.Result.AttrsMap[attrs[i]] = &Attribute{
+			Name: attrs[i],
+			Type: typs[i],
+		}
+	}
+	s.Op = op
+	return s
+}
+
+// unifyType returns the type which both operands of set operation can be
+// cast to, the integers are widened to 64 bits and mixed with floats to double.
+func unifyType(l, r types.Type) (types.Type, bool) {
+	switch {
+	case l.Oid == r.Oid:
+		if r.Width > l.Width {
+			return r, true
+		}
+		return l, true
+	case isIntegerType(l.Oid) && isIntegerType(r.Oid):
+		if isUnsignedType(l.Oid) && isUnsignedType(r.Oid) {
+			return types.T_uint64.ToType(), true
+		}
+		return types.T_int64.ToType(), true
+	case isNumericType(l.Oid) && isNumericType(r.Oid):
+		return types.T_float64.ToType(), true
+	case isStringType(l.Oid) && isStringType(r.Oid):
+		typ := types.T_varchar.ToType()
+		if typ.Width = l.Width; r.Width > l.Width {
+			typ.Width = r.Width
+		}
+		return typ, true
+	}
+	return types.Type{}, false
+}
+
+func isIntegerType(t types.T) bool {
+	switch t {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64:
+		return true
+	}
+	return isUnsignedType(t)
+}
+
+func isUnsignedType(t types.T) bool {
+	switch t {
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		return true
+	}
+	return false
+}
+
+func isNumericType(t types.T) bool {
+	return isIntegerType(t) || t == types.T_float32 || t == types.T_float64
+}
+
+func isStringType(t types.T) bool {
+	return t == types.T_char || t == types.T_varchar
+}
@@ -14,8 +14,237 @@
 
 package plan
 
-import "github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/rewrite"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
 
 func (b *build) buildUpdatePlan(updateStmt *tree.Update, plan *Update) error {
+	tbl, ok := getUpdateTable(updateStmt.Table)
+	if !ok || updateStmt.From != nil {
+		return errors.New(errno.FeatureNotSupported, "Only single table update is supported")
+	}
+	_, _, r, err := b.tableName(tbl)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	if len(r.GetPrimaryKeys()) == 0 && r.GetHideKey() == nil {
+		return errors.New(errno.FeatureNotSupported, fmt.Sprintf("update table '%s' without primary key is not supported", tbl.ObjectName))
+	}
+	attrs := make(map[string]engine.Attribute)
+	for _, def := range r.TableDefs() {
+		if v, ok := def.(*engine.AttributeDef); ok {
+			attrs[v.Attr.Name] = v.Attr
+		}
+	}
+
+	// the updated rows are selected with all attributes of relation, include the hidden key
+	selectStmt := buildSelectStmtFromUpdate(updateStmt)
+	selectStmt = rewrite.Rewrite(selectStmt)
+	selectStmt = rewrite.AstRewrite(selectStmt)
+	b.hideKey = true
+	queryPlan, err := b.buildStatement(selectStmt)
+	if err != nil {
+		return err
+	}
+	plan.Qry, _ = queryPlan.(*Query)
+
+	// the new values are evaluated on the scope of updated table
+	qry := &Query{}
+	if err = b.buildFrom(tree.TableExprs{updateStmt.Table}, qry); err != nil {
+		return err
+	}
+	qry.Scope = qry.Pop().Scopes[0]
+	for _, expr := range updateStmt.Exprs {
+		if expr.Tuple || len(expr.Names) != 1 {
+			return errors.New(errno.FeatureNotSupported, fmt.Sprintf("'%s' is not support now", tree.String(expr, dialect.MYSQL)))
+		}
+		name := expr.Names[0].Parts[0]
+		attr, ok := attrs[name]
+		if !ok {
+			return errors.New(errno.UndefinedColumn, fmt.Sprintf("Unknown column '%s' in 'field list'", tree.String(expr.Names[0], dialect.MYSQL)))
+		}
+		for _, updateAttr := range plan.UpdateAttrs {
+			if updateAttr == name {
+				return errors.New(errno.DuplicateColumn, fmt.Sprintf("Column '%s' specified twice", name))
+			}
+		}
+		e, err := b.buildUpdateExpr(attr, expr.Expr, qry)
+		if err != nil {
+			return err
+		}
+		plan.UpdateAttrs = append(plan.UpdateAttrs, name)
+		plan.UpdateList = append(plan.UpdateList, e)
+	}
 	return nil
 }
+
+// buildUpdateExpr returns the extend which computes the new value of attribute,
+// the return type of extend is the same as the type of attribute.
+func (b *build) buildUpdateExpr(attr engine.Attribute, expr tree.Expr, qry *Query) (extend.Extend, error) {
+	if isDefaultExpr(expr) {
+		if !attr.HasDefaultExpr() {
+			expr = makeExprFromVal(attr.Type, nil, true)
+		} else {
+			value, null := attr.GetDefaultExpr()
+			expr = makeExprFromVal(attr.Type, value, null)
+		}
+	}
+	if p, ok := expr.(*tree.ParamExpr); ok {
+		v, err := b.bindParam(p)
+		if err != nil {
+			return nil, err
+		}
+		expr = v
+	}
+	v, cerr := buildConstant(attr.Type, expr)
+	if cerr == nil {
+		return buildUpdateValue(v, attr.Type, attr.Name)
+	}
+	e, err := b.buildProjectionExpr(expr, qry)
+	if err != nil {
+		return nil, err
+	}
+	if e, err = b.pruneExtend(e, true); err != nil {
+		return nil, err
+	}
+	if len(e.Attributes()) == 0 { // constant expression which cannot be converted to the type of attribute
+		return nil, cerr
+	}
+	if e.ReturnType() == attr.Type.Oid {
+		return e, nil
+	}
+	for _, op := range overload.BinOps[overload.Typecast] {
+		if op.LeftType == e.ReturnType() && op.RightType == attr.Type.Oid {
+			return &extend.BinaryExtend{
+				Op:    overload.Typecast,
+				Left:  e,
+				Right: &extend.ValueExtend{V: vector.New(attr.Type)},
+			}, nil
+		}
+	}
+	return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("cannot update column '%s' of type '%s' by value of type '%s'", attr.Name, attr.Type, e.ReturnType()))
+}
+
+// buildUpdateValue returns a constant extend holding the value with type of attribute
+func buildUpdateValue(v interface{}, typ types.Type, name string) (extend.Extend, error) {
+	var err error
+
+	vec := vector.New(typ)
+	vec.Ref = 1
+	if v == nil {
+		nulls.Add(vec.Nsp, 0)
+		switch typ.Oid {
+		case types.T_int8:
+			vec.Col = make([]int8, 1)
+		case types.T_int16:
+			vec.Col = make([]int16, 1)
+		case types.T_int32:
+			vec.Col = make([]int32, 1)
+		case types.T_int64:
+			vec.Col = make([]int64, 1)
+		case types.T_uint8:
+			vec.Col = make([]uint8, 1)
+		case types.T_uint16:
+			vec.Col = make([]uint16, 1)
+		case types.T_uint32:
+			vec.Col = make([]uint32, 1)
+		case types.T_uint64:
+			vec.Col = make([]uint64, 1)
+		case types.T_float32:
+			vec.Col = make([]float32, 1)
+		case types.T_float64:
+			vec.Col = make([]float64, 1)
+		case types.T_char, types.T_varchar:
+			vec.Col = &types.Bytes{Offsets: []uint32{0}, Lengths: []uint32{0}}
+		case types.T_date:
+			vec.Col = make([]types.Date, 1)
+		case types.T_datetime:
+			vec.Col = make([]types.Datetime, 1)
+		case types.T_decimal:
+			vec.Col = make([]types.Decimal, 1)
+		default:
+			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("update for type '%v' not implement now", typ))
+		}
+		return &extend.ValueExtend{V: vec}, nil
+	}
+	if v, err = rangeCheck(v, typ, name, 1); err != nil {
+		return nil, err
+	}
+	switch v := v.(type) {
+	case int8:
+		err = vector.Append(vec, []int8{v})
+	case int16:
+		err = vector.Append(vec, []int16{v})
+	case int32:
+		err = vector.Append(vec, []int32{v})
+	case int64:
+		err = vector.Append(vec, []int64{v})
+	case uint8:
+		err = vector.Append(vec, []uint8{v})
+	case uint16:
+		err = vector.Append(vec, []uint16{v})
+	case uint32:
+		err = vector.Append(vec, []uint32{v})
+	case uint64:
+		err = vector.Append(vec, []uint64{v})
+	case float32:
+		err = vector.Append(vec, []float32{v})
+	case float64:
+		err = vector.Append(vec, []float64{v})
+	case string:
+		err = vector.Append(vec, [][]byte{[]byte(v)})
+	case types.Date:
+		err = vector.Append(vec, []types.Date{v})
+	case types.Datetime:
+		err = vector.Append(vec, []types.Datetime{v})
+	case types.Decimal:
+		err = vector.Append(vec, []types.Decimal{v})
+	default:
+		return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("update for type '%v' not implement now", typ))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &extend.ValueExtend{V: vec}, nil
+}
+
+func getUpdateTable(tbl tree.TableExpr) (*tree.TableName, bool) {
+	switch tbl := tbl.(type) {
+	case *tree.TableName:
+		return tbl, true
+	case *tree.ParenTableExpr:
+		return getUpdateTable(tbl.Expr)
+	case *tree.AliasedTableExpr:
+		return getUpdateTable(tbl.Expr)
+	}
+	return nil, false
+}
+
+func buildSelectStmtFromUpdate(u *tree.Update) tree.Statement {
+	if len(u.OrderBy) > 0 && (u.Where == nil && u.Limit == nil) {
+		u.OrderBy = nil
+	}
+	s := &tree.SelectClause{
+		Exprs: buildStarProjection(),
+		From:  &tree.From{Tables: tree.TableExprs{u.Table}},
+		Where: u.Where,
+	}
+	return &tree.Select{
+		Select:  s,
+		OrderBy: u.OrderBy,
+		Limit:   u.Limit,
+	}
+}
@@ -0,0 +1,260 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
+)
+
+const nativePassword = "mysql_native_password"
+
+var errIllegalGrant = errors.New(errno.SyntaxErrororAccessRuleViolation, "Illegal GRANT/REVOKE command; please consult the manual to see which privileges can be used")
+
+func (b *build) BuildCreateUser(stmt *tree.CreateUser, plan *CreateUser) error {
+	if len(stmt.Roles) > 0 {
+		return errors.New(errno.FeatureNotSupported, "default role is not supported now")
+	}
+	users, err := buildAccounts(stmt.Users)
+	if err != nil {
+		return err
+	}
+	if plan.M = b.pc.Manager(); plan.M == nil {
+		return errors.New(errno.FeatureNotSupported, "account management is disabled")
+	}
+	plan.Users = users
+	plan.IfNotExistFlag = stmt.IfNotExists
+	return nil
+}
+
+func (b *build) BuildDropUser(stmt *tree.DropUser, plan *DropUser) error {
+	users, err := buildAccounts(stmt.Users)
+	if err != nil {
+		return err
+	}
+	if plan.M = b.pc.Manager(); plan.M == nil {
+		return errors.New(errno.FeatureNotSupported, "account management is disabled")
+	}
+	plan.Users = users
+	plan.IfExistFlag = stmt.IfExists
+	return nil
+}
+
+func (b *build) BuildAlterUser(stmt *tree.AlterUser, plan *AlterUser) error {
+	if len(stmt.Roles) > 0 {
+		return errors.New(errno.FeatureNotSupported, "default role is not supported now")
+	}
+	us := stmt.Users
+	if stmt.IsUserFunc {
+		// alter user user() identified by ...
+		u := *stmt.UserFunc
+		u.Username, u.Hostname = b.pc.Name(), b.pc.Host()
+		us = []*tree.User{&u}
+	}
+	users, err := buildAccounts(us)
+	if err != nil {
+		return err
+	}
+	if plan.M = b.pc.Manager(); plan.M == nil {
+		return errors.New(errno.FeatureNotSupported, "account management is disabled")
+	}
+	plan.Users = users
+	plan.IfExistFlag = stmt.IfExists
+	return nil
+}
+
+func (b *build) BuildGrant(stmt *tree.Grant, plan *Grant) error {
+	if stmt.IsGrantRole || stmt.IsProxy || len(stmt.Roles) > 0 {
+		return errors.New(errno.FeatureNotSupported, "role and proxy are not supported now")
+	}
+	g, err := b.buildGrant(stmt.ObjType, stmt.Privileges, stmt.Level)
+	if err != nil {
+		return err
+	}
+	if stmt.GrantOption {
+		g.Privs |= privilege.GrantOption
+	}
+	if err := b.pc.CheckGrant(g); err != nil {
+		return err
+	}
+	users, err := buildAccounts(stmt.Users)
+	if err != nil {
+		return err
+	}
+	for _, u := range users {
+		// grant cannot create user or change password
+		if u.Auth {
+			return errors.New(errno.SyntaxErrororAccessRuleViolation, "grant with identified by is not supported")
+		}
+	}
+	if plan.M = b.pc.Manager(); plan.M == nil {
+		return errors.New(errno.FeatureNotSupported, "account management is disabled")
+	}
+	plan.Users = users
+	plan.Grant = g
+	return nil
+}
+
+func (b *build) BuildRevoke(stmt *tree.Revoke, plan *Revoke) error {
+	if stmt.IsRevokeRole || len(stmt.Roles) > 0 {
+		return errors.New(errno.FeatureNotSupported, "role is not supported now")
+	}
+	g, err := b.buildGrant(stmt.ObjType, stmt.Privileges, stmt.Level)
+	if err != nil {
+		return err
+	}
+	if err := b.pc.CheckGrant(g); err != nil {
+		return err
+	}
+	users, err := buildAccounts(stmt.Users)
+	if err != nil {
+		return err
+	}
+	if plan.M = b.pc.Manager(); plan.M == nil {
+		return errors.New(errno.FeatureNotSupported, "account management is disabled")
+	}
+	plan.Users = users
+	plan.Grant = g
+	return nil
+}
+
+// buildAccounts converts users of statement to accounts, the password is hashed
+// and its SCRAM verifier is kept for the PostgreSQL clients if it is given by plain text.
+func buildAccounts(us []*tree.User) ([]privilege.Account, error) {
+	users := make([]privilege.Account, len(us))
+	for i, u := range us {
+		if len(u.AuthPlugin) > 0 && u.AuthPlugin != nativePassword {
+			return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("Plugin '%s' is not loaded", u.AuthPlugin))
+		}
+		users[i] = privilege.Account{Name: u.Username, Host: u.Hostname}
+		switch {
+		case u.ByAuth:
+			users[i].Auth = true
+			users[i].AuthString = privilege.EncodePassword(u.AuthString)
+			verifier, err := privilege.EncodeScramVerifier(u.AuthString)
+			if err != nil {
+				return nil, err
+			}
+			users[i].ScramVerifier = verifier
+		case len(u.HashString) > 0:
+			if !privilege.IsAuthString(u.HashString) {
+				return nil, errors.New(errno.InvalidAuthorizationSpecification, "The password hash doesn't have the expected format.")
+			}
+			users[i].Auth = true
+			users[i].AuthString = u.HashString
+		}
+	}
+	return users, nil
+}
+
+// buildGrant returns the privileges and the level which they are granted at.
+func (b *build) buildGrant(typ tree.ObjectType, privs []*tree.Privilege, level *tree.PrivilegeLevel) (privilege.Grant, error) {
+	var g privilege.Grant
+
+	if typ != tree.OBJECT_TYPE_NONE && typ != tree.OBJECT_TYPE_TABLE {
+		return g, errors.New(errno.FeatureNotSupported, "routine privileges are not supported now")
+	}
+	switch level.Level {
+	case tree.PRIVILEGE_LEVEL_TYPE_GLOBAL:
+		g.Level = privilege.Global
+	case tree.PRIVILEGE_LEVEL_TYPE_DATABASE:
+		g.Level = privilege.Database
+		g.Db = level.DbName
+	case tree.PRIVILEGE_LEVEL_TYPE_TABLE:
+		g.Level = privilege.Table
+		g.Db, g.Table = level.DbName, level.TabName
+	default:
+		return g, errors.New(errno.FeatureNotSupported, "privilege level is not supported now")
+	}
+	if g.Level != privilege.Global && len(g.Db) == 0 {
+		if len(b.db) == 0 {
+			return g, errors.New(errno.InvalidSchemaName, "No database selected")
+		}
+		g.Db = b.db
+	}
+	if g.Level == privilege.Table {
+		db, err := b.e.Database(g.Db)
+		if err != nil {
+			return g, errors.New(errno.InvalidSchemaName, err.Error())
+		}
+		r, err := db.Relation(g.Table)
+		if err != nil {
+			return g, errors.New(errno.UndefinedTable, err.Error())
+		}
+		r.Close()
+	}
+	for _, p := range privs {
+		if len(p.ColumnList) > 0 {
+			return g, errors.New(errno.FeatureNotSupported, "column privileges are not supported now")
+		}
+		t, err := privilegeType(p.Type, g.Level)
+		if err != nil {
+			return g, err
+		}
+		g.Privs |= t
+	}
+	return g, nil
+}
+
+// privilegeType returns the privileges of typ which can be granted at level.
+func privilegeType(typ tree.PrivilegeType, level privilege.Level) (privilege.Type, error) {
+	switch typ {
+	case tree.PRIVILEGE_TYPE_STATIC_ALL:
+		if level == privilege.Global {
+			return privilege.GlobalPrivileges, nil
+		}
+		return privilege.TablePrivileges, nil
+	case tree.PRIVILEGE_TYPE_STATIC_USAGE:
+		return 0, nil
+	case tree.PRIVILEGE_TYPE_STATIC_SELECT:
+		return privilege.Select, nil
+	case tree.PRIVILEGE_TYPE_STATIC_INSERT:
+		return privilege.Insert, nil
+	case tree.PRIVILEGE_TYPE_STATIC_UPDATE:
+		return privilege.Update, nil
+	case tree.PRIVILEGE_TYPE_STATIC_DELETE:
+		return privilege.Delete, nil
+	case tree.PRIVILEGE_TYPE_STATIC_CREATE:
+		return privilege.Create, nil
+	case tree.PRIVILEGE_TYPE_STATIC_DROP:
+		return privilege.Drop, nil
+	case tree.PRIVILEGE_TYPE_STATIC_ALTER:
+		return privilege.Alter, nil
+	case tree.PRIVILEGE_TYPE_STATIC_INDEX:
+		return privilege.Index, nil
+	case tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION:
+		return privilege.GrantOption, nil
+	case tree.PRIVILEGE_TYPE_STATIC_CREATE_USER:
+		if level == privilege.Global {
+			return privilege.CreateUser, nil
+		}
+		return 0, errIllegalGrant
+	case tree.PRIVILEGE_TYPE_STATIC_SUPER:
+		if level == privilege.Global {
+			return privilege.Super, nil
+		}
+		return 0, errIllegalGrant
+	case tree.PRIVILEGE_TYPE_STATIC_FILE:
+		if level == privilege.Global {
+			return privilege.File, nil
+		}
+		return 0, errIllegalGrant
+	}
+	return 0, errors.New(errno.FeatureNotSupported, fmt.Sprintf("privilege '%s' is not supported now", typ.ToString()))
+}
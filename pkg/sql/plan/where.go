@@ -23,19 +23,20 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 
-func (b *build) buildWhere(stmt *tree.Where, qry *Query) (extend.Extend, []*JoinCondition, error) {
+func (b *build) buildWhere(stmt *tree.Where, qry *Query) (extend.Extend, []*JoinCondition, []float64, error) {
+	var sels []float64
 	var conds []*JoinCondition
 
 	e, err := b.buildWhereExpr(stmt.Expr, qry)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 	if e, err = b.pruneExtend(e, false); err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 	/*
 		if !e.IsLogical() {
-			return nil, nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("argument of WHERE must be type boolean"))
+			return nil, nil, nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("argument of WHERE must be type boolean"))
 		}
 	*/
 	if jp, ok := qry.Scope.Op.(*Join); ok && jp.Type == CROSS { // push down join condition
@@ -46,11 +47,11 @@ func (b *build) buildWhere(stmt *tree.Where, qry *Query) (extend.Extend, []*Join
 				if left, right, ok := stripEqual(es[i]); ok {
 					li, lname, err := b.getJoinAttribute(getUnresolvedName(left), ss)
 					if err != nil {
-						return nil, nil, err
+						return nil, nil, nil, err
 					}
 					ri, rname, err := b.getJoinAttribute(getUnresolvedName(right), ss)
 					if err != nil {
-						return nil, nil, err
+						return nil, nil, nil, err
 					}
 					ss.Conds = append(ss.Conds, &JoinCondition{
 						R:     li,
@@ -64,25 +65,39 @@ func (b *build) buildWhere(stmt *tree.Where, qry *Query) (extend.Extend, []*Join
 			}
 			if len(ss.Conds) > 0 {
 				conds = ss.Conds
+				sels = b.estimateSelectivities(es, ss)
+				ss.Sels = sels
 				s, err := b.buildQualifiedJoin(INNER, ss)
 				if err != nil {
-					return nil, nil, err
+					return nil, nil, nil, err
 				}
 				qry.Scope = s
 				if len(es) == 0 {
-					return nil, conds, nil
+					return nil, conds, sels, nil
 				}
 				e = extendsToAndExtend(es)
 			}
 		}
 	}
-	return e, conds, nil
+	return e, conds, sels, nil
 }
 
 func (b *build) buildWhereExpr(n tree.Expr, qry *Query) (extend.Extend, error) {
 	switch e := n.(type) {
 	case *tree.NumVal:
 		return buildValue(e.Value)
+	case *tree.ParamExpr:
+		v, err := b.bindParam(e)
+		if err != nil {
+			return nil, err
+		}
+		return b.buildWhereExpr(v, qry)
+	case *tree.VarExpr:
+		v, err := b.bindVar(e)
+		if err != nil {
+			return nil, err
+		}
+		return b.buildWhereExpr(v, qry)
 	case *tree.ParenExpr:
 		return b.buildWhereExpr(e.Expr, qry)
 	case *tree.OrExpr:
@@ -103,6 +118,12 @@ func (b *build) buildWhereExpr(n tree.Expr, qry *Query) (extend.Extend, error) {
 		return b.buildCast(e, qry, b.buildWhereExpr)
 	case *tree.RangeCond:
 		return b.buildBetween(e, qry, b.buildWhereExpr)
+	case *tree.CaseExpr:
+		return b.buildCase(e, qry, b.buildWhereExpr)
+	case *tree.IsNullExpr:
+		return b.buildNullCheck("isnull", e.Expr, qry, b.buildWhereExpr)
+	case *tree.IsNotNullExpr:
+		return b.buildNullCheck("isnotnull", e.Expr, qry, b.buildWhereExpr)
 	case *tree.UnresolvedName:
 		return b.buildAttribute(e, qry)
 	}
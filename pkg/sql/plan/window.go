@@ -0,0 +1,453 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"go/constant"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/window"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transformer"
+)
+
+var windowFuncs = map[string]int{
+	"row_number": window.RowNumber,
+	"rank":       window.Rank,
+	"dense_rank": window.DenseRank,
+	"lag":        window.Lag,
+	"lead":       window.Lead,
+}
+
+// windows collects the window functions of the select list, the arguments of
+// window functions are evaluated by proj, and the select expressions which
+// contain window functions are evaluated by wproj over the results of ws.
+type windows struct {
+	ws    []*WindowSpec
+	proj  *Projection
+	wproj *Projection
+}
+
+// hasWindowFunc returns true if the expression contains any window function.
+func hasWindowFunc(n tree.Expr) bool {
+	switch e := n.(type) {
+	case *tree.ParenExpr:
+		return hasWindowFunc(e.Expr)
+	case *tree.OrExpr:
+		return hasWindowFunc(e.Left) || hasWindowFunc(e.Right)
+	case *tree.NotExpr:
+		return hasWindowFunc(e.Expr)
+	case *tree.AndExpr:
+		return hasWindowFunc(e.Left) || hasWindowFunc(e.Right)
+	case *tree.UnaryExpr:
+		return hasWindowFunc(e.Expr)
+	case *tree.BinaryExpr:
+		return hasWindowFunc(e.Left) || hasWindowFunc(e.Right)
+	case *tree.ComparisonExpr:
+		return hasWindowFunc(e.Left) || hasWindowFunc(e.Right)
+	case *tree.CastExpr:
+		return hasWindowFunc(e.Expr)
+	case *tree.RangeCond:
+		return hasWindowFunc(e.Left) || hasWindowFunc(e.From) || hasWindowFunc(e.To)
+	case *tree.CaseExpr:
+		if hasWindowFunc(e.Expr) || hasWindowFunc(e.Else) {
+			return true
+		}
+		for _, w := range e.Whens {
+			if hasWindowFunc(w.Cond) || hasWindowFunc(w.Val) {
+				return true
+			}
+		}
+	case *tree.FuncExpr:
+		if e.WindowSpec != nil {
+			return true
+		}
+		for _, expr := range e.Exprs {
+			if hasWindowFunc(expr) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (b *build) buildWindowProjectionExpr(n tree.Expr, ws *windows, qry *Query) (extend.Extend, error) {
+	if !hasWindowFunc(n) {
+		return b.buildWindowInput(n, ws, qry)
+	}
+	fn := func(n tree.Expr, qry *Query) (extend.Extend, error) {
+		return b.buildWindowProjectionExpr(n, ws, qry)
+	}
+	switch e := n.(type) {
+	case *tree.ParenExpr:
+		return fn(e.Expr, qry)
+	case *tree.OrExpr:
+		return b.buildOr(e, qry, fn)
+	case *tree.NotExpr:
+		return b.buildNot(e, qry, fn)
+	case *tree.AndExpr:
+		return b.buildAnd(e, qry, fn)
+	case *tree.UnaryExpr:
+		return b.buildUnary(e, qry, fn)
+	case *tree.BinaryExpr:
+		return b.buildBinary(e, qry, fn)
+	case *tree.ComparisonExpr:
+		return b.buildComparison(e, qry, fn)
+	case *tree.FuncExpr:
+		if e.WindowSpec != nil {
+			return b.buildWindowFunc(e, ws, qry)
+		}
+		return b.buildFunc(false, e, qry, fn)
+	case *tree.CastExpr:
+		return b.buildCast(e, qry, fn)
+	case *tree.RangeCond:
+		return b.buildBetween(e, qry, fn)
+	case *tree.CaseExpr:
+		return b.buildCase(e, qry, fn)
+	}
+	return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", n))
+}
+
+// buildWindowInput builds the expression without window functions, the
+// expression is evaluated by proj and replaced by its attribute.
+func (b *build) buildWindowInput(n tree.Expr, ws *windows, qry *Query) (extend.Extend, error) {
+	e, err := b.buildProjectionExpr(n, qry)
+	if err != nil {
+		return nil, err
+	}
+	if e, err = b.pruneExtend(e, true); err != nil {
+		return nil, err
+	}
+	if len(e.Attributes()) == 0 {
+		return e, nil
+	}
+	ws.proj.Rs = append(ws.proj.Rs, 0)
+	ws.proj.Es = append(ws.proj.Es, e)
+	ws.proj.As = append(ws.proj.As, e.String())
+	return &extend.Attribute{
+		Name: e.String(),
+		Type: e.ReturnType(),
+	}, nil
+}
+
+// buildWindowArgument builds the argument of window function, which must
+// not be constant.
+func (b *build) buildWindowArgument(name string, n tree.Expr, ws *windows, qry *Query) (*extend.Attribute, error) {
+	e, err := b.buildWindowInput(n, ws, qry)
+	if err != nil {
+		return nil, err
+	}
+	attr, ok := e.(*extend.Attribute)
+	if !ok {
+		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("constant argument of window function '%s' is not support now", name))
+	}
+	return attr, nil
+}
+
+func (b *build) buildWindowFunc(e *tree.FuncExpr, ws *windows, qry *Query) (extend.Extend, error) {
+	name, ok := e.Func.FunctionReference.(*tree.UnresolvedName)
+	if !ok {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", e))
+	}
+	funcName := strings.ToLower(name.Parts[0])
+	if e.Type == tree.FUNC_TYPE_DISTINCT {
+		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("distinct in window function '%s' is not support now", funcName))
+	}
+	f := &WindowFunc{Alias: tree.String(e, dialect.MYSQL)}
+	if op, ok := windowFuncs[funcName]; ok {
+		f.Op = op
+		switch op {
+		case window.Lag, window.Lead:
+			if len(e.Exprs) == 0 || len(e.Exprs) > 3 {
+				return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("Incorrect parameter count in the call to native function '%s'", funcName))
+			}
+			attr, err := b.buildWindowArgument(funcName, e.Exprs[0], ws, qry)
+			if err != nil {
+				return nil, err
+			}
+			f.Name = attr.Name
+			f.Type = attr.Type.ToType()
+			f.Offset = 1
+			if len(e.Exprs) > 1 {
+				v, err := buildConstant(types.Type{Oid: types.T_int64, Size: 8}, e.Exprs[1])
+				if err != nil {
+					return nil, err
+				}
+				off, ok := v.(int64)
+				if !ok || off < 0 {
+					return nil, errors.New(errno.WindowingError, fmt.Sprintf("Incorrect arguments to %s", funcName))
+				}
+				f.Offset = off
+			}
+			if len(e.Exprs) > 2 {
+				if f.Dflt, err = b.buildWindowDefault(funcName, f.Type, e.Exprs[2], qry); err != nil {
+					return nil, err
+				}
+			}
+		default:
+			if len(e.Exprs) > 0 {
+				return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("Incorrect parameter count in the call to native function '%s'", funcName))
+			}
+			f.Type = types.Type{Oid: types.T_int64, Size: 8}
+		}
+	} else if op, ok := transformer.TransformerNamesMap[funcName]; ok {
+		if len(e.Exprs) != 1 {
+			return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("Illegal function call '%s'", e))
+		}
+		f.Op = window.Aggregate
+		if _, ok := e.Exprs[0].(*tree.NumVal); ok && op == transformer.Count { // count(*)
+			f.Agg = transformer.StarCount
+			f.Type = transformer.ReturnType(op, types.T_any).ToType()
+		} else {
+			attr, err := b.buildWindowArgument(funcName, e.Exprs[0], ws, qry)
+			if err != nil {
+				return nil, err
+			}
+			f.Agg = op
+			f.Name = attr.Name
+			f.Type = transformer.ReturnType(op, attr.Type).ToType()
+		}
+		frame, err := buildWindowFrame(e.WindowSpec)
+		if err != nil {
+			return nil, err
+		}
+		f.Frame = frame
+	} else {
+		return nil, errors.New(errno.WindowingError, fmt.Sprintf("'%s' is not a window function", funcName))
+	}
+	var partitions []string
+	var fs []*Field
+	for _, expr := range e.WindowSpec.PartitionBy {
+		p, err := b.buildWindowInput(expr, ws, qry)
+		if err != nil {
+			return nil, err
+		}
+		if attr, ok := p.(*extend.Attribute); ok {
+			partitions = append(partitions, attr.Name)
+		}
+	}
+	for _, order := range e.WindowSpec.OrderBy {
+		o, err := b.buildWindowInput(order.Expr, ws, qry)
+		if err != nil {
+			return nil, err
+		}
+		if attr, ok := o.(*extend.Attribute); ok {
+			fs = append(fs, &Field{
+				Attr: attr.Name,
+				Type: Direction(order.Direction),
+			})
+		}
+	}
+	ws.push(partitions, fs, f)
+	return &extend.Attribute{
+		Name: f.Alias,
+		Type: f.Type.Oid,
+	}, nil
+}
+
+// buildWindowDefault builds the default value of lag and lead, which must be
+// a constant, it is cast to the type of argument if necessary.
+func (b *build) buildWindowDefault(name string, typ types.Type, n tree.Expr, qry *Query) (extend.Extend, error) {
+	if v, ok := n.(*tree.NumVal); ok && v.Value.Kind() == constant.Unknown { // null
+		return nil, nil
+	}
+	e, err := b.buildProjectionExpr(n, qry)
+	if err != nil {
+		return nil, err
+	}
+	if e, err = b.pruneExtend(e, true); err != nil {
+		return nil, err
+	}
+	if len(e.Attributes()) > 0 {
+		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("non-constant default value of window function '%s' is not support now", name))
+	}
+	if e.ReturnType() != typ.Oid {
+		e = &extend.BinaryExtend{
+			Op:    overload.Typecast,
+			Left:  e,
+			Right: &extend.ValueExtend{V: vector.New(typ)},
+		}
+	}
+	return e, nil
+}
+
+// buildWindowFrame returns the frame of windowed aggregation, the default
+// frame is from the first row of partition to the last peer of current row
+// if there is an order by clause, otherwise it is the whole partition.
+func buildWindowFrame(spec *tree.WindowSpec) (window.Frame, error) {
+	if spec.Frame == nil {
+		if len(spec.OrderBy) > 0 {
+			return window.Frame{
+				Type:  window.Range,
+				Start: window.Bound{Type: window.UnboundedPreceding},
+				End:   window.Bound{Type: window.CurrentRow},
+			}, nil
+		}
+		return window.Frame{
+			Type:  window.Rows,
+			Start: window.Bound{Type: window.UnboundedPreceding},
+			End:   window.Bound{Type: window.UnboundedFollowing},
+		}, nil
+	}
+	var err error
+	var frame window.Frame
+
+	if spec.Frame.Type == tree.FRAME_RANGE {
+		frame.Type = window.Range
+	}
+	if spec.Frame.Start.Type == tree.FRAME_UNBOUNDED_FOLLOWING {
+		return frame, errors.New(errno.WindowingError, "Window '<unnamed window>': frame start cannot be UNBOUNDED FOLLOWING.")
+	}
+	if spec.Frame.End.Type == tree.FRAME_UNBOUNDED_PRECEDING {
+		return frame, errors.New(errno.WindowingError, "Window '<unnamed window>': frame end cannot be UNBOUNDED PRECEDING.")
+	}
+	if frame.Start, err = buildWindowBound(frame.Type, spec.Frame.Start); err != nil {
+		return frame, err
+	}
+	if frame.End, err = buildWindowBound(frame.Type, spec.Frame.End); err != nil {
+		return frame, err
+	}
+	return frame, nil
+}
+
+func buildWindowBound(typ int, bound *tree.FrameBound) (window.Bound, error) {
+	switch bound.Type {
+	case tree.FRAME_UNBOUNDED_PRECEDING:
+		return window.Bound{Type: window.UnboundedPreceding}, nil
+	case tree.FRAME_CURRENT_ROW:
+		return window.Bound{Type: window.CurrentRow}, nil
+	case tree.FRAME_UNBOUNDED_FOLLOWING:
+		return window.Bound{Type: window.UnboundedFollowing}, nil
+	}
+	if typ == window.Range {
+		return window.Bound{}, errors.New(errno.FeatureNotSupported, "RANGE frame with offset is not support now")
+	}
+	v, err := buildConstant(types.Type{Oid: types.T_int64, Size: 8}, bound.Offset)
+	if err != nil {
+		return window.Bound{}, err
+	}
+	off, ok := v.(int64)
+	if !ok || off < 0 {
+		return window.Bound{}, errors.New(errno.WindowingError, "Window '<unnamed window>': frame start or end is negative, NULL or of non-integral type")
+	}
+	if bound.Type == tree.FRAME_PRECEDING {
+		return window.Bound{Type: window.Preceding, Offset: off}, nil
+	}
+	return window.Bound{Type: window.Following, Offset: off}, nil
+}
+
+// buildWindowOrderBy builds the order by clause of the query with window
+// functions, the attribute refers to the select expression with window
+// functions is evaluated by wproj.
+func (b *build) buildWindowOrderBy(orders tree.OrderBy, ws *windows, proj *Projection, qry *Query) ([]*Field, error) {
+	var fs []*Field
+
+	for _, order := range orders {
+		if err := checkNullsPosition(order); err != nil {
+			return nil, err
+		}
+		ovar := tree.String(order.Expr, dialect.MYSQL)
+		if ws.hasAttribute(ovar) {
+			fs = append(fs, &Field{
+				Attr: ovar,
+				Type: Direction(order.Direction),
+			})
+			continue
+		}
+		ofs, err := b.buildOrderBy(tree.OrderBy{order}, ws.proj, proj, qry)
+		if err != nil {
+			return nil, err
+		}
+		fs = append(fs, ofs...)
+	}
+	return fs, nil
+}
+
+func (ws *windows) hasAttribute(name string) bool {
+	for _, attr := range ws.wproj.As {
+		if attr == name {
+			return true
+		}
+	}
+	return false
+}
+
+// fillArgument sets the argument of window functions without argument, such as
+// count(*) and row_number(), so that the input of window is never empty.
+func (ws *windows) fillArgument(qry *Query) {
+	if len(ws.proj.As) == 0 {
+		attr := qry.Scope.Result.Attrs[0]
+		ws.proj.Rs = append(ws.proj.Rs, 0)
+		ws.proj.Es = append(ws.proj.Es, &extend.Attribute{
+			Name: attr,
+			Type: qry.Scope.Result.AttrsMap[attr].Type.Oid,
+		})
+		ws.proj.As = append(ws.proj.As, attr)
+	}
+	for _, w := range ws.ws {
+		for _, f := range w.Funcs {
+			if len(f.Name) == 0 {
+				f.Name = ws.proj.As[0]
+			}
+		}
+	}
+}
+
+// push adds the window function to the spec with the same partition and
+// order, the duplicate window function is evaluated only once.
+func (ws *windows) push(partitions []string, fs []*Field, f *WindowFunc) {
+	for _, w := range ws.ws {
+		if !isSameWindow(w, partitions, fs) {
+			continue
+		}
+		for _, wf := range w.Funcs {
+			if wf.Alias == f.Alias {
+				return
+			}
+		}
+		w.Funcs = append(w.Funcs, f)
+		return
+	}
+	ws.ws = append(ws.ws, &WindowSpec{
+		Partitions: partitions,
+		Fs:         fs,
+		Funcs:      []*WindowFunc{f},
+	})
+}
+
+func isSameWindow(w *WindowSpec, partitions []string, fs []*Field) bool {
+	if len(w.Partitions) != len(partitions) || len(w.Fs) != len(fs) {
+		return false
+	}
+	for i := range partitions {
+		if w.Partitions[i] != partitions[i] {
+			return false
+		}
+	}
+	for i := range fs {
+		if w.Fs[i].Attr != fs[i].Attr || w.Fs[i].Type != fs[i].Type {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,106 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// builder builds the plan of a statement, all nodes of the plan,
+// including the nodes of subqueries, are appended to the same query.
+type builder struct {
+	ctx CompilerContext
+	qry *plan.Query
+}
+
+// BuildPlan builds the plan of a statement from its ast.
+//
+// Expressions of a node refer to the output of its children by ColRef, rel_pos
+// is the index of the child and col_pos is the index in the project list of the
+// child, the table scan refers to the columns of its table def by rel_pos 0.
+// A subquery is a separate tree of nodes in the same query, and refers to the
+// columns of outer queries by CorrColRef.
+func BuildPlan(ctx CompilerContext, stmt tree.Statement) (*Query, error) {
+	switch stmt := stmt.(type) {
+	case *tree.Select:
+		return buildSelectQuery(ctx, stmt)
+	case *tree.ParenSelect:
+		return buildSelectQuery(ctx, stmt.Select)
+	default:
+		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("unsupported statement: '%v'", tree.String(stmt, dialect.MYSQL)))
+	}
+}
+
+func buildSelectQuery(ctx CompilerContext, stmt *tree.Select) (*Query, error) {
+	b := &builder{
+		ctx: ctx,
+		qry: &plan.Query{StmtType: plan.Query_SELECT},
+	}
+	s := newScope(nil)
+	id, err := b.buildSelect(stmt, s)
+	if err != nil {
+		return nil, err
+	}
+	b.qry.Steps = []int32{id}
+	b.qry.Headings = s.names
+	return (*Query)(b.qry), nil
+}
+
+func (b *builder) appendNode(n *plan.Node) int32 {
+	n.NodeId = int32(len(b.qry.Nodes))
+	b.qry.Nodes = append(b.qry.Nodes, n)
+	return n.NodeId
+}
+
+// passthrough returns the references to all output columns of node id,
+// names are the names of the columns, they are taken from the node if nil.
+func (b *builder) passthrough(id int32, names []string) []*plan.Expr {
+	projs := b.qry.Nodes[id].ProjectList
+	exprs := make([]*plan.Expr, len(projs))
+	for i, e := range projs {
+		var name string
+		if names != nil {
+			name = names[i]
+		} else if col, ok := e.Expr.(*plan.Expr_Col); ok {
+			name = col.Col.Name
+		}
+		exprs[i] = newColRef(name, 0, int32(i), e.Typ)
+	}
+	return exprs
+}
+
+// appendFilter filters the output of node id, the filter is pushed into the scan
+// if it is possible, otherwise a project node is appended.
+func (b *builder) appendFilter(id int32, filters []*plan.Expr) int32 {
+	n := b.qry.Nodes[id]
+	if n.NodeType == plan.Node_TABLE_SCAN && len(n.WhereList) == 0 {
+		n.WhereList = filters
+		c := b.ctx.Cost((*ObjectRef)(n.ObjRef), (*Expr)(andExprs(filters)))
+		n.Cost = (*plan.Cost)(&c)
+		return id
+	}
+	return b.appendNode(&plan.Node{
+		NodeType:    plan.Node_PROJECT,
+		Children:    []int32{id},
+		ProjectList: b.passthrough(id, nil),
+		WhereList:   filters,
+	})
+}
@@ -0,0 +1,543 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// buildConjuncts builds a condition and splits it by AND.
+func (b *builder) buildConjuncts(e tree.Expr, s *scope) ([]*plan.Expr, error) {
+	var exprs []*plan.Expr
+	for _, c := range splitAnd(e, nil) {
+		expr, err := b.buildExpr(c, s)
+		if err != nil {
+			return nil, err
+		}
+		if expr.Typ.Id != uint32(plan.Type_BOOL) && expr.Typ.Id != uint32(plan.Type_ANY) {
+			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("argument of condition must be type boolean, not '%s'", tree.String(c, dialect.MYSQL)))
+		}
+		exprs = append(exprs, expr)
+	}
+	return exprs, nil
+}
+
+func splitAnd(e tree.Expr, exprs []tree.Expr) []tree.Expr {
+	switch e := e.(type) {
+	case *tree.AndExpr:
+		return splitAnd(e.Right, splitAnd(e.Left, exprs))
+	case *tree.ParenExpr:
+		return splitAnd(e.Expr, exprs)
+	}
+	return append(exprs, e)
+}
+
+// buildExpr builds the expression in scope s. After the aggregation, an expression
+// must be an aggregate function, a group by expression or built from them.
+func (b *builder) buildExpr(e tree.Expr, s *scope) (*plan.Expr, error) {
+	if !s.aggregated {
+		return b.buildExprImpl(e, s)
+	}
+	if f, ok := e.(*tree.FuncExpr); ok && isAggregate(f) {
+		return b.buildAggregate(f, s)
+	}
+	if hasAggregate(e) || hasSubquery(e) {
+		return b.buildExprImpl(e, s)
+	}
+
+	s.aggregated = false
+	expr, err := b.buildExprImpl(e, s)
+	s.aggregated = true
+	if err != nil {
+		return nil, err
+	}
+	for i, g := range s.groupBy {
+		if proto.Equal(g, expr) {
+			var name string
+			if col, ok := g.Expr.(*plan.Expr_Col); ok {
+				name = col.Col.Name
+			}
+			return newColRef(name, 0, int32(i), g.Typ), nil
+		}
+	}
+	if !hasColRef(expr) {
+		return expr, nil
+	}
+	if _, ok := e.(*tree.UnresolvedName); ok {
+		return nil, errors.New(errno.GroupingError, fmt.Sprintf("column '%s' must appear in the GROUP BY clause or be used in an aggregate function", tree.String(e, dialect.MYSQL)))
+	}
+	return b.buildExprImpl(e, s)
+}
+
+// buildAggregate builds the aggregate function, which is computed by the agg node,
+// and returns the reference to its result.
+func (b *builder) buildAggregate(f *tree.FuncExpr, s *scope) (*plan.Expr, error) {
+	name := funcName(f)
+	s.aggregated = false
+	var args []*plan.Expr
+	for _, arg := range f.Exprs {
+		if num, ok := arg.(*tree.NumVal); ok && name == "count" && num.String() == "*" {
+			continue
+		}
+		expr, err := b.buildExpr(arg, s)
+		if err != nil {
+			s.aggregated = true
+			return nil, err
+		}
+		args = append(args, expr)
+	}
+	s.aggregated = true
+	if f.Type == tree.FUNC_TYPE_DISTINCT {
+		for i, arg := range args {
+			distinct, err := newFunction("distinct", arg)
+			if err != nil {
+				return nil, err
+			}
+			args[i] = distinct
+		}
+	}
+	agg, err := newFunction(name, args...)
+	if err != nil {
+		return nil, err
+	}
+	pos := -1
+	for i, a := range s.aggs {
+		if proto.Equal(a, agg) {
+			pos = i
+			break
+		}
+	}
+	if pos < 0 {
+		pos = len(s.aggs)
+		s.aggs = append(s.aggs, agg)
+	}
+	return newColRef("", 0, int32(len(s.groupBy)+pos), agg.Typ), nil
+}
+
+func (b *builder) buildExprImpl(e tree.Expr, s *scope) (*plan.Expr, error) {
+	switch e := e.(type) {
+	case *tree.ParenExpr:
+		return b.buildExpr(e.Expr, s)
+	case *tree.NumVal:
+		return buildNumVal(e)
+	case *tree.StrVal:
+		return newStringConst(tree.String(e, dialect.MYSQL)), nil
+	case *tree.ParamExpr:
+		return &plan.Expr{
+			Typ:  &plan.Type{Id: uint32(plan.Type_ANY), Nullable: true},
+			Expr: &plan.Expr_P{P: &plan.ParamRef{Pos: int32(e.Offset)}},
+		}, nil
+	case *tree.VarExpr:
+		return &plan.Expr{
+			Typ:  &plan.Type{Id: uint32(plan.Type_ANY), Nullable: true},
+			Expr: &plan.Expr_V{V: &plan.VarRef{Name: e.Name}},
+		}, nil
+	case *tree.UnresolvedName:
+		if e.Star {
+			return nil, errors.New(errno.SyntaxError, fmt.Sprintf("'%s' is not allowed here", tree.String(e, dialect.MYSQL)))
+		}
+		return s.resolveColumn(e)
+	case *tree.UnaryExpr:
+		return b.buildUnary(e, s)
+	case *tree.BinaryExpr:
+		return b.buildBinary(e, s)
+	case *tree.ComparisonExpr:
+		return b.buildComparison(e, s)
+	case *tree.AndExpr:
+		return b.buildFunction("and", s, e.Left, e.Right)
+	case *tree.OrExpr:
+		return b.buildFunction("or", s, e.Left, e.Right)
+	case *tree.XorExpr:
+		return b.buildFunction("xor", s, e.Left, e.Right)
+	case *tree.NotExpr:
+		return b.buildFunction("not", s, e.Expr)
+	case *tree.IsNullExpr:
+		return b.buildFunction("isnull", s, e.Expr)
+	case *tree.IsNotNullExpr:
+		return b.buildFunction("isnotnull", s, e.Expr)
+	case *tree.RangeCond:
+		if e.Not {
+			return b.buildFunction("or", s,
+				tree.NewComparisonExpr(tree.LESS_THAN, e.Left, e.From),
+				tree.NewComparisonExpr(tree.GREAT_THAN, e.Left, e.To))
+		}
+		return b.buildFunction("and", s,
+			tree.NewComparisonExpr(tree.GREAT_THAN_EQUAL, e.Left, e.From),
+			tree.NewComparisonExpr(tree.LESS_THAN_EQUAL, e.Left, e.To))
+	case *tree.CaseExpr:
+		return b.buildCase(e, s)
+	case *tree.IntervalExpr:
+		return b.buildInterval(e, s)
+	case *tree.CastExpr:
+		typ, err := getTypeFromAst(e.Type)
+		if err != nil {
+			return nil, err
+		}
+		expr, err := b.buildExpr(e.Expr, s)
+		if err != nil {
+			return nil, err
+		}
+		return &plan.Expr{
+			Typ: typ,
+			Expr: &plan.Expr_F{
+				F: &plan.Function{
+					Func: &plan.ObjectRef{ObjName: "cast"},
+					Args: []*plan.Expr{expr},
+				},
+			},
+		}, nil
+	case *tree.FuncExpr:
+		return b.buildFuncExpr(e, s)
+	case *tree.Subquery:
+		if e.Exists {
+			sub, err := b.buildSubquery(e, s, false)
+			if err != nil {
+				return nil, err
+			}
+			return newFunction("exists", sub)
+		}
+		return b.buildSubquery(e, s, true)
+	default:
+		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("unsupported expression: '%v'", tree.String(e, dialect.MYSQL)))
+	}
+}
+
+// buildFunction builds the arguments and the function of name.
+func (b *builder) buildFunction(name string, s *scope, args ...tree.Expr) (*plan.Expr, error) {
+	exprs := make([]*plan.Expr, len(args))
+	for i, arg := range args {
+		expr, err := b.buildExpr(arg, s)
+		if err != nil {
+			return nil, err
+		}
+		exprs[i] = expr
+	}
+	return newFunction(name, exprs...)
+}
+
+func (b *builder) buildUnary(e *tree.UnaryExpr, s *scope) (*plan.Expr, error) {
+	expr, err := b.buildExpr(e.Expr, s)
+	if err != nil {
+		return nil, err
+	}
+	if e.Op == tree.UNARY_PLUS {
+		return expr, nil
+	}
+	if c, ok := expr.Expr.(*plan.Expr_C); ok && e.Op == tree.UNARY_MINUS {
+		switch v := c.C.Value.(type) {
+		case *plan.Const_Ival:
+			v.Ival = -v.Ival
+			return expr, nil
+		case *plan.Const_Dval:
+			v.Dval = -v.Dval
+			return expr, nil
+		}
+	}
+	return newFunction(unaryFunctionNames[e.Op], expr)
+}
+
+func (b *builder) buildBinary(e *tree.BinaryExpr, s *scope) (*plan.Expr, error) {
+	left, err := b.buildExpr(e.Left, s)
+	if err != nil {
+		return nil, err
+	}
+	right, err := b.buildExpr(e.Right, s)
+	if err != nil {
+		return nil, err
+	}
+	if e.Op == tree.PLUS || e.Op == tree.MINUS {
+		if expr, ok := foldDateInterval(e.Op, left, right); ok {
+			return expr, nil
+		}
+	}
+	return newFunction(binaryFunctionNames[e.Op], left, right)
+}
+
+func (b *builder) buildComparison(e *tree.ComparisonExpr, s *scope) (*plan.Expr, error) {
+	if e.SubOp != tree.ComparisonOp(0) {
+		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("'%s' subquery is not supported now", e.SubOp.ToString()))
+	}
+	name := e.Op.ToString()
+	switch e.Op {
+	case tree.IN, tree.NOT_IN:
+		left, err := b.buildExpr(e.Left, s)
+		if err != nil {
+			return nil, err
+		}
+		args := []*plan.Expr{left}
+		switch right := e.Right.(type) {
+		case *tree.Tuple:
+			for _, v := range right.Exprs {
+				expr, err := b.buildExpr(v, s)
+				if err != nil {
+					return nil, err
+				}
+				args = append(args, expr)
+			}
+		case *tree.Subquery:
+			sub, err := b.buildSubquery(right, s, false)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, sub)
+		default:
+			return nil, errors.New(errno.SyntaxError, fmt.Sprintf("invalid right side of '%s': '%v'", name, tree.String(e.Right, dialect.MYSQL)))
+		}
+		return newFunction(name, args...)
+	case tree.NOT_EQUAL:
+		name = "<>"
+	}
+	if e.Escape != nil {
+		return b.buildFunction(name, s, e.Left, e.Right, e.Escape)
+	}
+	return b.buildFunction(name, s, e.Left, e.Right)
+}
+
+// buildCase builds the CASE expression as a function whose arguments are
+// (cond1, then1, cond2, then2, ..., else), the simple CASE is rewritten to the
+// searched one.
+func (b *builder) buildCase(e *tree.CaseExpr, s *scope) (*plan.Expr, error) {
+	var operand *plan.Expr
+	var err error
+	if e.Expr != nil {
+		if operand, err = b.buildExpr(e.Expr, s); err != nil {
+			return nil, err
+		}
+	}
+	var args []*plan.Expr
+	for _, w := range e.Whens {
+		cond, err := b.buildExpr(w.Cond, s)
+		if err != nil {
+			return nil, err
+		}
+		if operand != nil {
+			if cond, err = newFunction("=", proto.Clone(operand).(*plan.Expr), cond); err != nil {
+				return nil, err
+			}
+		}
+		val, err := b.buildExpr(w.Val, s)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, cond, val)
+	}
+	if e.Else != nil {
+		el, err := b.buildExpr(e.Else, s)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, el)
+	} else {
+		args = append(args, newNullConst())
+	}
+	return newFunction("case", args...)
+}
+
+// buildInterval builds the interval as function interval(n, unit).
+func (b *builder) buildInterval(e *tree.IntervalExpr, s *scope) (*plan.Expr, error) {
+	n, err := b.buildExpr(e.Expr, s)
+	if err != nil {
+		return nil, err
+	}
+	unit := e.Type.ToString()
+	// the number of interval is usually a string, such as interval '3' month,
+	// and the string holds the unit as well if there is no unit after it,
+	// such as interval '1 year'.
+	if c, ok := n.Expr.(*plan.Expr_C); ok {
+		if v, ok := c.C.Value.(*plan.Const_Sval); ok {
+			num := v.Sval
+			if e.Type == tree.INTERVAL_TYPE_INVALID {
+				var typ tree.IntervalType
+				if num, typ, ok = tree.SplitInterval(v.Sval); !ok {
+					return nil, errors.New(errno.DataException, fmt.Sprintf("incorrect interval value '%s'", v.Sval))
+				}
+				unit = typ.ToString()
+			}
+			if n, err = parseIntConst(num); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return newFunction("interval", n, newStringConst(unit))
+}
+
+func (b *builder) buildFuncExpr(e *tree.FuncExpr, s *scope) (*plan.Expr, error) {
+	name := funcName(e)
+	if isAggregate(e) {
+		return nil, errors.New(errno.GroupingError, fmt.Sprintf("invalid use of aggregate function '%s'", name))
+	}
+	if e.Type == tree.FUNC_TYPE_DISTINCT {
+		return nil, errors.New(errno.SyntaxError, fmt.Sprintf("DISTINCT specified, but '%s' is not an aggregate function", name))
+	}
+	args := make([]*plan.Expr, len(e.Exprs))
+	for i, arg := range e.Exprs {
+		expr, err := b.buildExpr(arg, s)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = expr
+	}
+	// date '1998-12-01' is parsed as function date.
+	if name == "date" && len(args) == 1 {
+		if c, ok := args[0].Expr.(*plan.Expr_C); ok {
+			if v, ok := c.C.Value.(*plan.Const_Sval); ok {
+				return newDateConst(v.Sval)
+			}
+		}
+	}
+	return newFunction(name, args...)
+}
+
+// buildSubquery builds the subquery in a new scope, whose parent is s.
+func (b *builder) buildSubquery(e *tree.Subquery, s *scope, scalar bool) (*plan.Expr, error) {
+	ss := newScope(s)
+	id, err := b.buildSelect(&tree.Select{Select: e.Select}, ss)
+	if err != nil {
+		return nil, err
+	}
+	typ := &plan.Type{Id: uint32(plan.Type_ANY)}
+	if !e.Exists {
+		projs := b.qry.Nodes[id].ProjectList
+		if len(projs) != 1 {
+			return nil, errors.New(errno.CardinalityViolation, "subquery must return only one column")
+		}
+		typ = proto.Clone(projs[0].Typ).(*plan.Type)
+		typ.Nullable = true
+	}
+	return &plan.Expr{
+		Typ: typ,
+		Expr: &plan.Expr_Sub{
+			Sub: &plan.SubQuery{
+				NodeId:       id,
+				IsCorrelated: ss.correlated,
+				IsScalar:     scalar,
+			},
+		},
+	}, nil
+}
+
+func funcName(e *tree.FuncExpr) string {
+	name := e.Func.FunctionReference.(*tree.UnresolvedName)
+	return strings.ToLower(name.Parts[0])
+}
+
+func isAggregate(e *tree.FuncExpr) bool {
+	def, ok := functions[funcName(e)]
+	return ok && def.aggregate
+}
+
+// walkExpr calls fn for e and its sub expressions in pre-order, the sub expressions
+// are skipped if fn returns false. Subqueries are not walked into.
+func walkExpr(e tree.Expr, fn func(tree.Expr) bool) {
+	if e == nil || !fn(e) {
+		return
+	}
+	switch e := e.(type) {
+	case *tree.ParenExpr:
+		walkExpr(e.Expr, fn)
+	case *tree.UnaryExpr:
+		walkExpr(e.Expr, fn)
+	case *tree.BinaryExpr:
+		walkExpr(e.Left, fn)
+		walkExpr(e.Right, fn)
+	case *tree.ComparisonExpr:
+		walkExpr(e.Left, fn)
+		walkExpr(e.Right, fn)
+		walkExpr(e.Escape, fn)
+	case *tree.AndExpr:
+		walkExpr(e.Left, fn)
+		walkExpr(e.Right, fn)
+	case *tree.OrExpr:
+		walkExpr(e.Left, fn)
+		walkExpr(e.Right, fn)
+	case *tree.XorExpr:
+		walkExpr(e.Left, fn)
+		walkExpr(e.Right, fn)
+	case *tree.NotExpr:
+		walkExpr(e.Expr, fn)
+	case *tree.IsNullExpr:
+		walkExpr(e.Expr, fn)
+	case *tree.IsNotNullExpr:
+		walkExpr(e.Expr, fn)
+	case *tree.RangeCond:
+		walkExpr(e.Left, fn)
+		walkExpr(e.From, fn)
+		walkExpr(e.To, fn)
+	case *tree.CaseExpr:
+		walkExpr(e.Expr, fn)
+		for _, w := range e.Whens {
+			walkExpr(w.Cond, fn)
+			walkExpr(w.Val, fn)
+		}
+		walkExpr(e.Else, fn)
+	case *tree.IntervalExpr:
+		walkExpr(e.Expr, fn)
+	case *tree.CastExpr:
+		walkExpr(e.Expr, fn)
+	case *tree.FuncExpr:
+		for _, arg := range e.Exprs {
+			walkExpr(arg, fn)
+		}
+	case *tree.Tuple:
+		for _, arg := range e.Exprs {
+			walkExpr(arg, fn)
+		}
+	}
+}
+
+func hasAggregate(e tree.Expr) bool {
+	found := false
+	walkExpr(e, func(e tree.Expr) bool {
+		if f, ok := e.(*tree.FuncExpr); ok && isAggregate(f) {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+func hasSubquery(e tree.Expr) bool {
+	found := false
+	walkExpr(e, func(e tree.Expr) bool {
+		if _, ok := e.(*tree.Subquery); ok {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// hasColRef returns true if the expression refers to a column of the current query.
+func hasColRef(e *plan.Expr) bool {
+	switch expr := e.Expr.(type) {
+	case *plan.Expr_Col:
+		return true
+	case *plan.Expr_F:
+		for _, arg := range expr.F.Args {
+			if hasColRef(arg) {
+				return true
+			}
+		}
+	}
+	return false
+}
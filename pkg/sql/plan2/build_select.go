@@ -0,0 +1,540 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"fmt"
+	"go/constant"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// buildSelect builds the query block in scope s, and returns the root node.
+// The plan of a query block is
+//
+//	FROM -> [filter] -> [AGG] -> PROJECT -> [DISTINCT] -> [SORT] -> [PROJECT]
+//
+// the last project removes the columns only used by ORDER BY.
+func (b *builder) buildSelect(stmt *tree.Select, s *scope) (int32, error) {
+	var clause *tree.SelectClause
+	switch sel := stmt.Select.(type) {
+	case *tree.SelectClause:
+		clause = sel
+	case *tree.ParenSelect:
+		if stmt.OrderBy != nil || stmt.Limit != nil {
+			return 0, errors.New(errno.FeatureNotSupported, "ORDER BY or LIMIT on a parenthesized query is not supported now")
+		}
+		return b.buildSelect(sel.Select, s)
+	default:
+		return 0, errors.New(errno.FeatureNotSupported, fmt.Sprintf("unsupported select statement: '%v'", tree.String(stmt, dialect.MYSQL)))
+	}
+
+	id, err := b.buildFrom(clause.From.Tables, s)
+	if err != nil {
+		return 0, err
+	}
+	if clause.Where != nil {
+		filters, err := b.buildConjuncts(clause.Where.Expr, s)
+		if err != nil {
+			return 0, err
+		}
+		id = b.appendFilter(id, filters)
+	}
+
+	if len(clause.GroupBy) > 0 || clause.Having != nil || selectHasAggregate(clause, stmt.OrderBy) {
+		for _, g := range clause.GroupBy {
+			expr, err := b.buildExpr(g, s)
+			if err != nil {
+				return 0, err
+			}
+			s.groupBy = append(s.groupBy, expr)
+		}
+		s.aggregated = true
+	}
+
+	var projs []*plan.Expr
+	for _, se := range clause.Exprs {
+		exprs, names, err := b.buildSelectExpr(se, s)
+		if err != nil {
+			return 0, err
+		}
+		projs = append(projs, exprs...)
+		s.names = append(s.names, names...)
+	}
+
+	var having []*plan.Expr
+	if clause.Having != nil {
+		if having, err = b.buildConjuncts(clause.Having.Expr, s); err != nil {
+			return 0, err
+		}
+	}
+
+	var orderBy *plan.OrderBySpec
+	if len(stmt.OrderBy) > 0 {
+		orderBy = &plan.OrderBySpec{}
+		for _, o := range stmt.OrderBy {
+			pos, err := b.resolveOrderBy(o.Expr, s, &projs)
+			if err != nil {
+				return 0, err
+			}
+			orderBy.OrderBy = append(orderBy.OrderBy, newColRef("", 0, pos, projs[pos].Typ))
+			flag := plan.OrderBySpec_ASC
+			if o.Direction == tree.Descending {
+				flag = plan.OrderBySpec_DESC
+			}
+			switch o.NullsPosition {
+			case tree.NullsFirst:
+				flag |= plan.OrderBySpec_NULLS_FIRST
+			case tree.NullsLast:
+				flag |= plan.OrderBySpec_NULLS_LAST
+			}
+			orderBy.OrderByFlags = append(orderBy.OrderByFlags, flag)
+		}
+	}
+	hidden := len(projs) - len(s.names)
+	names := make([]string, len(projs))
+	copy(names, s.names)
+
+	if s.aggregated {
+		id = b.appendNode(&plan.Node{
+			NodeType:    plan.Node_AGG,
+			Children:    []int32{id},
+			GroupBy:     s.groupBy,
+			ProjectList: append(append([]*plan.Expr{}, s.groupBy...), s.aggs...),
+		})
+		s.aggregated = false
+	}
+	id = b.appendNode(&plan.Node{
+		NodeType:    plan.Node_PROJECT,
+		Children:    []int32{id},
+		ProjectList: projs,
+		WhereList:   having,
+	})
+
+	if clause.Distinct {
+		if hidden > 0 {
+			return 0, errors.New(errno.InvalidColumnReference, "for SELECT DISTINCT, ORDER BY expressions must appear in select list")
+		}
+		groups := b.passthrough(id, s.names)
+		id = b.appendNode(&plan.Node{
+			NodeType:    plan.Node_AGG,
+			Children:    []int32{id},
+			GroupBy:     groups,
+			ProjectList: b.passthrough(id, s.names),
+		})
+	}
+
+	if orderBy != nil {
+		id = b.appendNode(&plan.Node{
+			NodeType:    plan.Node_SORT,
+			Children:    []int32{id},
+			ProjectList: b.passthrough(id, names),
+			OrderBy:     orderBy,
+		})
+	}
+
+	if stmt.Limit != nil {
+		n := b.qry.Nodes[id]
+		if stmt.Limit.Count != nil {
+			if n.Limit, err = b.buildLimit(stmt.Limit.Count); err != nil {
+				return 0, err
+			}
+		}
+		if stmt.Limit.Offset != nil {
+			if n.Offset, err = b.buildLimit(stmt.Limit.Offset); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if hidden > 0 {
+		id = b.appendNode(&plan.Node{
+			NodeType:    plan.Node_PROJECT,
+			Children:    []int32{id},
+			ProjectList: b.passthrough(id, names)[:len(s.names)],
+		})
+	}
+	return id, nil
+}
+
+// buildSelectExpr builds an item of the select list, a star is expanded to
+// all columns of the tables it refers to.
+func (b *builder) buildSelectExpr(se tree.SelectExpr, s *scope) ([]*plan.Expr, []string, error) {
+	var bindings []*binding
+	switch e := se.Expr.(type) {
+	case tree.UnqualifiedStar:
+		bindings = s.bindings
+	case *tree.UnresolvedName:
+		if !e.Star {
+			break
+		}
+		table := strings.ToLower(e.Parts[0])
+		if e.NumParts > 1 {
+			table = strings.ToLower(e.Parts[1])
+		}
+		bd := s.findBinding(table)
+		if bd == nil {
+			return nil, nil, errors.New(errno.UndefinedTable, fmt.Sprintf("unknown table '%s'", table))
+		}
+		bindings = []*binding{bd}
+	}
+	if bindings != nil {
+		var exprs []*plan.Expr
+		var names []string
+		for _, bd := range bindings {
+			for _, col := range bd.cols {
+				expr, err := b.buildExpr(tree.SetUnresolvedName(bd.table, col), s)
+				if err != nil {
+					return nil, nil, err
+				}
+				exprs = append(exprs, expr)
+				names = append(names, col)
+			}
+		}
+		if len(exprs) == 0 {
+			return nil, nil, errors.New(errno.UndefinedTable, "no tables used")
+		}
+		return exprs, names, nil
+	}
+
+	expr, err := b.buildExpr(se.Expr, s)
+	if err != nil {
+		return nil, nil, err
+	}
+	name := string(se.As)
+	if name == "" {
+		if col, ok := se.Expr.(*tree.UnresolvedName); ok {
+			name = col.Parts[0]
+		} else {
+			name = tree.String(se.Expr, dialect.MYSQL)
+		}
+	}
+	return []*plan.Expr{expr}, []string{name}, nil
+}
+
+func selectHasAggregate(clause *tree.SelectClause, orderBy tree.OrderBy) bool {
+	for _, se := range clause.Exprs {
+		if hasAggregate(se.Expr) {
+			return true
+		}
+	}
+	for _, o := range orderBy {
+		if hasAggregate(o.Expr) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveOrderBy returns the position of the ORDER BY item in the select list,
+// the item is appended to the select list if it is not there.
+func (b *builder) resolveOrderBy(e tree.Expr, s *scope, projs *[]*plan.Expr) (int32, error) {
+	switch e := e.(type) {
+	case *tree.NumVal:
+		if e.Value.Kind() == constant.Int {
+			pos, _ := constant.Int64Val(e.Value)
+			if pos < 1 || int(pos) > len(s.names) {
+				return 0, errors.New(errno.UndefinedColumn, fmt.Sprintf("unknown column '%d' in 'order clause'", pos))
+			}
+			return int32(pos - 1), nil
+		}
+	case *tree.UnresolvedName:
+		if e.NumParts == 1 && !e.Star {
+			for i, name := range s.names {
+				if strings.EqualFold(name, e.Parts[0]) {
+					return int32(i), nil
+				}
+			}
+		}
+	}
+	expr, err := b.buildExpr(e, s)
+	if err != nil {
+		return 0, err
+	}
+	for i, proj := range *projs {
+		if proto.Equal(proj, expr) {
+			return int32(i), nil
+		}
+	}
+	*projs = append(*projs, expr)
+	return int32(len(*projs) - 1), nil
+}
+
+func (b *builder) buildLimit(e tree.Expr) (*plan.Expr, error) {
+	expr, err := b.buildExpr(e, newScope(nil))
+	if err != nil {
+		return nil, err
+	}
+	if !isIntegerType(expr.Typ.Id) && expr.Typ.Id != uint32(plan.Type_ANY) {
+		return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("argument of LIMIT must be an integer, not '%s'", tree.String(e, dialect.MYSQL)))
+	}
+	return expr, nil
+}
+
+// buildFrom builds the tables of the FROM clause, tables separated by commas are
+// joined by inner joins without conditions.
+func (b *builder) buildFrom(tbls tree.TableExprs, s *scope) (int32, error) {
+	id, err := b.buildTable(tbls[0], s)
+	if err != nil {
+		return 0, err
+	}
+	for _, tbl := range tbls[1:] {
+		right, err := b.buildTable(tbl, s)
+		if err != nil {
+			return 0, err
+		}
+		id = b.appendJoin(plan.Node_INNER, id, right, nil)
+	}
+	return id, nil
+}
+
+func (b *builder) appendJoin(typ plan.Node_JoinFlag, left, right int32, conds []*plan.Expr) int32 {
+	var projs []*plan.Expr
+	for i, id := range []int32{left, right} {
+		for _, e := range b.passthrough(id, nil) {
+			e.Expr.(*plan.Expr_Col).Col.RelPos = int32(i)
+			projs = append(projs, e)
+		}
+	}
+	return b.appendNode(&plan.Node{
+		NodeType:    plan.Node_JOIN,
+		Children:    []int32{left, right},
+		JoinType:    typ,
+		OnList:      conds,
+		ProjectList: projs,
+	})
+}
+
+// buildTable builds a table of the FROM clause and adds its bindings to s.
+func (b *builder) buildTable(tbl tree.TableExpr, s *scope) (int32, error) {
+	switch t := tbl.(type) {
+	case *tree.AliasedTableExpr:
+		return b.buildAliasedTable(t.Expr, t.As, s)
+	case *tree.TableName:
+		return b.buildAliasedTable(t, tree.AliasClause{}, s)
+	case *tree.ParenTableExpr:
+		if derivedTable(t) != nil {
+			return b.buildAliasedTable(t, tree.AliasClause{}, s)
+		}
+		return b.buildTable(t.Expr, s)
+	case *tree.JoinTableExpr:
+		return b.buildJoinTable(t, s)
+	default:
+		return 0, errors.New(errno.FeatureNotSupported, fmt.Sprintf("unsupported table: '%v'", tree.String(tbl, dialect.MYSQL)))
+	}
+}
+
+func (b *builder) buildAliasedTable(tbl tree.TableExpr, as tree.AliasClause, s *scope) (int32, error) {
+	var id int32
+	var table string
+	var cols []string
+	switch t := tbl.(type) {
+	case *tree.TableName:
+		table = strings.ToLower(string(t.ObjectName))
+		obj, def := b.ctx.Resolve(table)
+		if obj == nil || def == nil {
+			return 0, errors.New(errno.UndefinedTable, fmt.Sprintf("table '%s' doesn't exist", table))
+		}
+		projs := make([]*plan.Expr, len(def.Cols))
+		cols = make([]string, len(def.Cols))
+		for i, col := range def.Cols {
+			cols[i] = strings.ToLower(col.Name)
+			projs[i] = newColRef(table+"."+cols[i], 0, int32(i), col.Typ)
+		}
+		c := b.ctx.Cost(obj, nil)
+		id = b.appendNode(&plan.Node{
+			NodeType:    plan.Node_TABLE_SCAN,
+			Cost:        (*plan.Cost)(&c),
+			ProjectList: projs,
+			TableDef:    (*plan.TableDef)(def),
+			ObjRef:      (*plan.ObjectRef)(obj),
+		})
+	default:
+		sel := derivedTable(tbl)
+		if sel == nil {
+			if as.Alias != "" {
+				return 0, errors.New(errno.FeatureNotSupported, fmt.Sprintf("alias of joined tables '%s' is not supported now", as.Alias))
+			}
+			return b.buildTable(tbl, s)
+		}
+		// a derived table can not refer to the other tables of the FROM clause.
+		ss := newScope(s.parent)
+		var err error
+		if id, err = b.buildSelect(sel, ss); err != nil {
+			return 0, err
+		}
+		if ss.correlated {
+			s.correlated = true
+		}
+		for _, name := range ss.names {
+			cols = append(cols, strings.ToLower(name))
+		}
+	}
+
+	if as.Alias != "" {
+		table = strings.ToLower(string(as.Alias))
+	}
+	if len(as.Cols) > 0 {
+		if len(as.Cols) != len(cols) {
+			return 0, errors.New(errno.InvalidColumnReference, fmt.Sprintf("table '%s' has %d columns available but %d columns specified", table, len(cols), len(as.Cols)))
+		}
+		for i, col := range as.Cols {
+			cols[i] = strings.ToLower(string(col))
+		}
+	}
+	if table != "" && s.findBinding(table) != nil {
+		return 0, errors.New(errno.DuplicateAlias, fmt.Sprintf("not unique table/alias: '%s'", table))
+	}
+	projs := b.qry.Nodes[id].ProjectList
+	types := make([]*plan.Type, len(projs))
+	for i, e := range projs {
+		types[i] = e.Typ
+	}
+	s.addBinding(table, cols, types)
+	return id, nil
+}
+
+// derivedTable returns the query of the derived table, it is nil if tbl is not a derived table.
+func derivedTable(tbl tree.TableExpr) *tree.Select {
+	switch t := tbl.(type) {
+	case *tree.Subquery:
+		return &tree.Select{Select: t.Select}
+	case *tree.ParenTableExpr:
+		if sel, ok := t.Expr.(*tree.Select); ok {
+			return sel
+		}
+	}
+	return nil
+}
+
+func (b *builder) buildJoinTable(tbl *tree.JoinTableExpr, s *scope) (int32, error) {
+	var typ plan.Node_JoinFlag
+	switch tbl.JoinType {
+	case "", tree.JOIN_TYPE_INNER, tree.JOIN_TYPE_CROSS, tree.JOIN_TYPE_STRAIGHT:
+		typ = plan.Node_INNER
+	case tree.JOIN_TYPE_LEFT, tree.JOIN_TYPE_RIGHT:
+		typ = plan.Node_OUTER
+	default:
+		return 0, errors.New(errno.FeatureNotSupported, fmt.Sprintf("%s join is not supported now", strings.ToLower(tbl.JoinType)))
+	}
+
+	// the join condition can only refer to the tables of the join, so the tables
+	// are built in a new scope and then added to s.
+	js := newScope(s.parent)
+	left, err := b.buildTable(tbl.Left, js)
+	if err != nil {
+		return 0, err
+	}
+	width := js.width
+	right, err := b.buildTable(tbl.Right, js)
+	if err != nil {
+		return 0, err
+	}
+	// the outer join preserves the rows of the left child, so the children of
+	// the right join are swapped.
+	if tbl.JoinType == tree.JOIN_TYPE_RIGHT {
+		left, right = right, left
+		var lbs, rbs []*binding
+		for _, bd := range js.bindings {
+			if bd.offset < width {
+				bd.offset += js.width - width
+				rbs = append(rbs, bd)
+			} else {
+				bd.offset -= width
+				lbs = append(lbs, bd)
+			}
+		}
+		js.bindings = append(lbs, rbs...)
+		width = js.width - width
+	}
+
+	var conds []*plan.Expr
+	switch cond := tbl.Cond.(type) {
+	case nil:
+	case *tree.OnJoinCond:
+		if hasSubquery(cond.Expr) {
+			return 0, errors.New(errno.FeatureNotSupported, "subquery in join condition is not supported now")
+		}
+		if conds, err = b.buildConjuncts(cond.Expr, js); err != nil {
+			return 0, err
+		}
+	case *tree.UsingJoinCond:
+		if conds, err = b.buildUsing(cond.Cols, js, width); err != nil {
+			return 0, err
+		}
+	default:
+		return 0, errors.New(errno.FeatureNotSupported, fmt.Sprintf("unsupported join condition: '%v'", tree.String(tbl, dialect.MYSQL)))
+	}
+	for _, cond := range conds {
+		splitColRefs(cond, int32(width))
+	}
+	if js.correlated {
+		s.correlated = true
+	}
+	for _, bd := range js.bindings {
+		s.addBinding(bd.table, bd.cols, bd.types)
+	}
+	return b.appendJoin(typ, left, right, conds), nil
+}
+
+// buildUsing builds the conditions of USING, the columns are equal in both sides.
+func (b *builder) buildUsing(cols tree.IdentifierList, js *scope, width int) ([]*plan.Expr, error) {
+	left, right := newScope(nil), newScope(nil)
+	for _, bd := range js.bindings {
+		if bd.offset < width {
+			left.bindings = append(left.bindings, bd)
+		} else {
+			right.bindings = append(right.bindings, bd)
+		}
+	}
+	conds := make([]*plan.Expr, len(cols))
+	for i, col := range cols {
+		name := tree.SetUnresolvedName(string(col))
+		l, err := left.resolveColumn(name)
+		if err != nil {
+			return nil, err
+		}
+		r, err := right.resolveColumn(name)
+		if err != nil {
+			return nil, err
+		}
+		if conds[i], err = newFunction("=", l, r); err != nil {
+			return nil, err
+		}
+	}
+	return conds, nil
+}
+
+// splitColRefs makes the references to the flat output of both children of a join
+// refer to the left or right child.
+func splitColRefs(e *plan.Expr, width int32) {
+	switch expr := e.Expr.(type) {
+	case *plan.Expr_Col:
+		if expr.Col.ColPos >= width {
+			expr.Col.RelPos = 1
+			expr.Col.ColPos -= width
+		}
+	case *plan.Expr_F:
+		for _, arg := range expr.F.Args {
+			splitColRefs(arg, width)
+		}
+	}
+}
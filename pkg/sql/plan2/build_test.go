@@ -0,0 +1,149 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/postgresql"
+)
+
+const testDDL = `create table t1 (a int not null, b varchar(10), c double, d date);
+create table t2 (a int not null, e bigint, f varchar(20));`
+
+func newTestOptimizer(t *testing.T) *MockOptimizer {
+	mock := NewMockOptimizer()
+	stmts, err := mysql.Parse(testDDL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, stmt := range stmts {
+		if _, err := mock.Optimize(stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return mock
+}
+
+func buildTestQuery(t *testing.T, mock *MockOptimizer, sql string) (*plan.Query, error) {
+	stmts, err := mysql.Parse(sql)
+	if err != nil {
+		t.Fatalf("parse '%s' failed: %v", sql, err)
+	}
+	q, err := mock.Optimize(stmts[0])
+	return (*plan.Query)(q), err
+}
+
+func TestBuildPlan(t *testing.T) {
+	mock := newTestOptimizer(t)
+	sqls := []string{
+		"select * from t1",
+		"select t1.*, t2.e from t1, t2 where t1.a = t2.a",
+		"select a, count(*) from t1 group by a having count(*) > 1 order by 2 desc limit 10",
+		"select distinct b from t1 order by b",
+		"select a + 1 as x from t1 order by x, c",
+		"select x.a, y.e from t1 x left join t2 y on x.a = y.a",
+		"select t1.b from t1 right join t2 using (a)",
+		"select a from t1 where d < date '2021-01-31' + interval '1' month",
+		"select a from t1 where a in (select a from t2 where t2.e = t1.c) and exists (select * from t2)",
+		"select case b when 'x' then 1 else c end, a between 1 and 3 from t1",
+		"select s.n from (select a, count(*) from t1 group by a) as s (a, n) where s.a > 1",
+	}
+	for _, sql := range sqls {
+		if _, err := buildTestQuery(t, mock, sql); err != nil {
+			t.Errorf("build '%s' failed: %v", sql, err)
+		}
+	}
+}
+
+func TestBuildPlanError(t *testing.T) {
+	mock := newTestOptimizer(t)
+	tests := []struct {
+		sql  string
+		code string
+	}{
+		{"select * from t3", errno.UndefinedTable},
+		{"select g from t1", errno.UndefinedColumn},
+		{"select a from t1, t2", errno.AmbiguousColumn},
+		{"select b, count(*) from t1 group by a", errno.GroupingError},
+		{"select a from t1 where sum(a) > 1", errno.GroupingError},
+		{"select foo(a) from t1", errno.UndefinedFunction},
+		{"select b + 1 from t1", errno.DatatypeMismatch},
+		{"select a from t1 where b", errno.DatatypeMismatch},
+		{"select a from t1 where a = (select a, e from t2)", errno.CardinalityViolation},
+		{"select * from t1 natural join t2", errno.FeatureNotSupported},
+	}
+	for _, test := range tests {
+		_, err := buildTestQuery(t, mock, test.sql)
+		if err == nil {
+			t.Errorf("build '%s' should fail", test.sql)
+			continue
+		}
+		if e, ok := err.(*errors.SqlError); !ok || e.Code() != test.code {
+			t.Errorf("build '%s' got error %v, expect code %s", test.sql, err, test.code)
+		}
+	}
+}
+
+func TestBuildSubquery(t *testing.T) {
+	mock := newTestOptimizer(t)
+	q, err := buildTestQuery(t, mock, "select a from t1 where c > (select avg(e) from t2 where t2.a = t1.a)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	scan := q.Nodes[0]
+	if scan.NodeType != plan.Node_TABLE_SCAN || len(scan.WhereList) != 1 {
+		t.Fatalf("the filter is not pushed into scan of t1")
+	}
+	sub := scan.WhereList[0].GetF().Args[1].GetSub()
+	if sub == nil || !sub.IsCorrelated || !sub.IsScalar {
+		t.Fatalf("expect a correlated scalar subquery, got %v", scan.WhereList[0])
+	}
+	// t2.a = t1.a in the subquery refers to t1.a of the outer query.
+	cond := q.Nodes[1].WhereList[0].GetF().Args[1].GetCorr()
+	if cond == nil || cond.Depth != 1 || cond.ColPos != 0 {
+		t.Fatalf("expect a reference to t1.a of outer query, got %v", q.Nodes[1].WhereList[0])
+	}
+}
+
+func TestBuildOrderByNulls(t *testing.T) {
+	mock := newTestOptimizer(t)
+	stmt, err := postgresql.ParseOne("select a, c from t1 order by a desc nulls first, c nulls last")
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := mock.Optimize(stmt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var spec *plan.OrderBySpec
+	for _, n := range (*plan.Query)(q).Nodes {
+		if n.NodeType == plan.Node_SORT {
+			spec = n.OrderBy
+		}
+	}
+	expect := []plan.OrderBySpec_OrderByFlag{
+		plan.OrderBySpec_DESC | plan.OrderBySpec_NULLS_FIRST,
+		plan.OrderBySpec_ASC | plan.OrderBySpec_NULLS_LAST,
+	}
+	if spec == nil || !reflect.DeepEqual(spec.OrderByFlags, expect) {
+		t.Fatalf("expect the order by flags %v, got %v", expect, spec)
+	}
+}
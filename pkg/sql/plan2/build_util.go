@@ -0,0 +1,239 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"fmt"
+	"go/constant"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+func newColRef(name string, relPos, colPos int32, typ *plan.Type) *plan.Expr {
+	return &plan.Expr{
+		Typ: typ,
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				Name:   name,
+				RelPos: relPos,
+				ColPos: colPos,
+			},
+		},
+	}
+}
+
+func newConst(id plan.Type_TypeId, c *plan.Const) *plan.Expr {
+	return &plan.Expr{
+		Typ:  &plan.Type{Id: uint32(id), Nullable: c.Isnull},
+		Expr: &plan.Expr_C{C: c},
+	}
+}
+
+func newNullConst() *plan.Expr {
+	return newConst(plan.Type_ANY, &plan.Const{Isnull: true})
+}
+
+func newIntConst(v int64) *plan.Expr {
+	return newConst(plan.Type_INT64, &plan.Const{Value: &plan.Const_Ival{Ival: v}})
+}
+
+func newStringConst(v string) *plan.Expr {
+	return newConst(plan.Type_VARCHAR, &plan.Const{Value: &plan.Const_Sval{Sval: v}})
+}
+
+// newDateConst returns the date constant, whose value is the days of types.Date.
+func newDateConst(v string) (*plan.Expr, error) {
+	d, err := types.ParseDate(v)
+	if err != nil {
+		return nil, errors.New(errno.DataException, fmt.Sprintf("incorrect date value '%s'", v))
+	}
+	return newConst(plan.Type_DATE, &plan.Const{Value: &plan.Const_Ival{Ival: int64(d)}}), nil
+}
+
+func parseIntConst(v string) (*plan.Expr, error) {
+	n, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+	if err != nil {
+		return nil, errors.New(errno.DataException, fmt.Sprintf("incorrect integer value '%s'", v))
+	}
+	return newIntConst(n), nil
+}
+
+func buildNumVal(e *tree.NumVal) (*plan.Expr, error) {
+	switch e.Value.Kind() {
+	case constant.Unknown:
+		return newNullConst(), nil
+	case constant.Bool:
+		var v int64
+		if constant.BoolVal(e.Value) {
+			v = 1
+		}
+		return newConst(plan.Type_BOOL, &plan.Const{Value: &plan.Const_Ival{Ival: v}}), nil
+	case constant.Int:
+		if v, ok := constant.Int64Val(e.Value); ok {
+			return newIntConst(v), nil
+		}
+		// out of range of int64
+		v, _ := constant.Float64Val(e.Value)
+		return newConst(plan.Type_FLOAT64, &plan.Const{Value: &plan.Const_Dval{Dval: v}}), nil
+	case constant.Float:
+		v, _ := constant.Float64Val(e.Value)
+		return newConst(plan.Type_FLOAT64, &plan.Const{Value: &plan.Const_Dval{Dval: v}}), nil
+	case constant.String:
+		return newStringConst(constant.StringVal(e.Value)), nil
+	}
+	return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("unsupported constant: '%s'", e.String()))
+}
+
+// foldDateInterval computes date +/- interval if both are constants.
+func foldDateInterval(op tree.BinaryOp, left, right *plan.Expr) (*plan.Expr, bool) {
+	if op == tree.PLUS && left.Typ.Id == uint32(plan.Type_INTERVAL) {
+		left, right = right, left
+	}
+	if left.Typ.Id != uint32(plan.Type_DATE) || right.Typ.Id != uint32(plan.Type_INTERVAL) {
+		return nil, false
+	}
+	date, ok := left.Expr.(*plan.Expr_C)
+	if !ok || date.C.Isnull {
+		return nil, false
+	}
+	args := right.Expr.(*plan.Expr_F).F.Args
+	n, ok := args[0].Expr.(*plan.Expr_C)
+	if !ok || n.C.Isnull {
+		return nil, false
+	}
+	num, ok := n.C.Value.(*plan.Const_Ival)
+	if !ok {
+		return nil, false
+	}
+	unit := args[1].Expr.(*plan.Expr_C).C.GetSval()
+	v := num.Ival
+	if op == tree.MINUS {
+		v = -v
+	}
+	d, ok := addInterval(types.Date(date.C.GetIval()), v, unit)
+	if !ok {
+		return nil, false
+	}
+	return newConst(plan.Type_DATE, &plan.Const{Value: &plan.Const_Ival{Ival: int64(d)}}), true
+}
+
+// addInterval adds n units to the date, the day is clipped to the last day of
+// the month as MySQL does, for example, 2021-01-31 + interval 1 month is 2021-02-28.
+func addInterval(d types.Date, n int64, unit string) (types.Date, bool) {
+	var months int64
+	switch unit {
+	case "day":
+		return d + types.Date(n), true
+	case "week":
+		return d + types.Date(7*n), true
+	case "month":
+		months = n
+	case "quarter":
+		months = 3 * n
+	case "year":
+		months = 12 * n
+	default:
+		return 0, false
+	}
+	year, month, day, _ := d.Calendar(true)
+	total := int64(year)*12 + int64(month) - 1 + months
+	year, month = int32(total/12), uint8(total%12+1)
+	last := uint8(time.Date(int(year), time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day())
+	if day > last {
+		day = last
+	}
+	return types.FromCalendar(year, month, day), true
+}
+
+// andExprs returns the conjunction of exprs, it is nil if exprs is empty.
+func andExprs(exprs []*plan.Expr) *plan.Expr {
+	if len(exprs) == 0 {
+		return nil
+	}
+	e := exprs[0]
+	for _, expr := range exprs[1:] {
+		e = &plan.Expr{
+			Typ: &plan.Type{Id: uint32(plan.Type_BOOL), Nullable: e.Typ.Nullable || expr.Typ.Nullable},
+			Expr: &plan.Expr_F{
+				F: &plan.Function{
+					Func: &plan.ObjectRef{ObjName: "and"},
+					Args: []*plan.Expr{e, expr},
+				},
+			},
+		}
+	}
+	return e
+}
+
+// getTypeFromAst returns the plan type of the column type in ast.
+func getTypeFromAst(typ tree.ResolvableTypeReference) (*plan.Type, error) {
+	if n, ok := typ.(*tree.T); ok {
+		switch uint8(n.InternalType.Oid) {
+		case defines.MYSQL_TYPE_TINY:
+			if n.InternalType.Unsigned {
+				return &plan.Type{Id: uint32(plan.Type_UINT8), Width: n.InternalType.Width}, nil
+			}
+			return &plan.Type{Id: uint32(plan.Type_INT8), Width: n.InternalType.Width}, nil
+		case defines.MYSQL_TYPE_SHORT:
+			if n.InternalType.Unsigned {
+				return &plan.Type{Id: uint32(plan.Type_UINT16), Width: n.InternalType.Width}, nil
+			}
+			return &plan.Type{Id: uint32(plan.Type_INT16), Width: n.InternalType.Width}, nil
+		case defines.MYSQL_TYPE_LONG:
+			if n.InternalType.Unsigned {
+				return &plan.Type{Id: uint32(plan.Type_UINT32), Width: n.InternalType.Width}, nil
+			}
+			return &plan.Type{Id: uint32(plan.Type_INT32), Width: n.InternalType.Width}, nil
+		case defines.MYSQL_TYPE_LONGLONG:
+			if n.InternalType.Unsigned {
+				return &plan.Type{Id: uint32(plan.Type_UINT64), Width: n.InternalType.Width}, nil
+			}
+			return &plan.Type{Id: uint32(plan.Type_INT64), Width: n.InternalType.Width}, nil
+		case defines.MYSQL_TYPE_FLOAT:
+			return &plan.Type{Id: uint32(plan.Type_FLOAT32), Width: n.InternalType.Width}, nil
+		case defines.MYSQL_TYPE_DOUBLE:
+			return &plan.Type{Id: uint32(plan.Type_FLOAT64), Width: n.InternalType.Width}, nil
+		case defines.MYSQL_TYPE_STRING:
+			return &plan.Type{Id: uint32(plan.Type_CHAR), Width: n.InternalType.DisplayWith}, nil
+		case defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_VARCHAR:
+			if n.InternalType.DisplayWith == -1 { // type char
+				return &plan.Type{Id: uint32(plan.Type_CHAR), Width: 1}, nil
+			}
+			return &plan.Type{Id: uint32(plan.Type_VARCHAR), Width: n.InternalType.DisplayWith}, nil
+		case defines.MYSQL_TYPE_DATE:
+			return &plan.Type{Id: uint32(plan.Type_DATE)}, nil
+		case defines.MYSQL_TYPE_DATETIME:
+			return &plan.Type{Id: uint32(plan.Type_DATETIME)}, nil
+		case defines.MYSQL_TYPE_DECIMAL:
+			precision, scale := n.InternalType.DisplayWith, n.InternalType.Precision
+			if precision <= 0 {
+				precision = types.DefaultDecimalPrecision
+			}
+			if scale < 0 {
+				scale = 0
+			}
+			return &plan.Type{Id: uint32(plan.Type_DECIMAL), Width: precision, Precision: scale}, nil
+		}
+	}
+	return nil, errors.New(errno.IndeterminateDatatype, fmt.Sprintf("unsupport type: '%v'", typ))
+}
@@ -0,0 +1,486 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// functionDef is the definition of a function or an operator.
+type functionDef struct {
+	aggregate bool
+	// notNull is set if the result is never null.
+	notNull bool
+	// returnType checks the arguments and returns the type of result.
+	returnType func(name string, args []*plan.Expr) (*plan.Type, error)
+}
+
+var functions = map[string]*functionDef{
+	// operators
+	"+":           {returnType: arithReturnType},
+	"-":           {returnType: arithReturnType},
+	"*":           {returnType: arithReturnType},
+	"%":           {returnType: arithReturnType},
+	"/":           {returnType: divReturnType},
+	"div":         {returnType: intDivReturnType},
+	"|":           {returnType: bitReturnType},
+	"&":           {returnType: bitReturnType},
+	"^":           {returnType: bitReturnType},
+	"<<":          {returnType: bitReturnType},
+	">>":          {returnType: bitReturnType},
+	"unary_minus": {returnType: negReturnType},
+	"unary_tilde": {returnType: bitReturnType},
+	"unary_mark":  {returnType: logicReturnType},
+	"=":           {returnType: compareReturnType},
+	"<":           {returnType: compareReturnType},
+	"<=":          {returnType: compareReturnType},
+	">":           {returnType: compareReturnType},
+	">=":          {returnType: compareReturnType},
+	"<>":          {returnType: compareReturnType},
+	"like":        {returnType: likeReturnType},
+	"not like":    {returnType: likeReturnType},
+	"in":          {returnType: compareReturnType},
+	"not in":      {returnType: compareReturnType},
+	"and":         {returnType: logicReturnType},
+	"or":          {returnType: logicReturnType},
+	"xor":         {returnType: logicReturnType},
+	"not":         {returnType: logicReturnType},
+	"isnull":      {notNull: true, returnType: boolReturnType},
+	"isnotnull":   {notNull: true, returnType: boolReturnType},
+	"exists":      {notNull: true, returnType: boolReturnType},
+	"case":        {returnType: caseReturnType},
+	"interval":    {returnType: intervalReturnType},
+
+	// scalar functions
+	"abs":       {returnType: negReturnType},
+	"date":      {returnType: dateReturnType},
+	"year":      {returnType: timePartReturnType},
+	"month":     {returnType: timePartReturnType},
+	"day":       {returnType: timePartReturnType},
+	"extract":   {returnType: extractReturnType},
+	"substring": {returnType: substringReturnType},
+	"length":    {returnType: lengthReturnType},
+	"lower":     {returnType: stringReturnType},
+	"upper":     {returnType: stringReturnType},
+	"concat":    {returnType: concatReturnType},
+
+	// aggregate functions, distinct is the internal function of count(distinct x)
+	"count":    {aggregate: true, notNull: true, returnType: countReturnType},
+	"sum":      {aggregate: true, returnType: sumReturnType},
+	"avg":      {aggregate: true, returnType: avgReturnType},
+	"min":      {aggregate: true, returnType: minMaxReturnType},
+	"max":      {aggregate: true, returnType: minMaxReturnType},
+	"distinct": {returnType: distinctReturnType},
+}
+
+var binaryFunctionNames = map[tree.BinaryOp]string{
+	tree.PLUS:        "+",
+	tree.MINUS:       "-",
+	tree.MULTI:       "*",
+	tree.DIV:         "/",
+	tree.INTEGER_DIV: "div",
+	tree.MOD:         "%",
+	tree.BIT_OR:      "|",
+	tree.BIT_AND:     "&",
+	tree.BIT_XOR:     "^",
+	tree.LEFT_SHIFT:  "<<",
+	tree.RIGHT_SHIFT: ">>",
+}
+
+var unaryFunctionNames = map[tree.UnaryOp]string{
+	tree.UNARY_MINUS: "unary_minus",
+	tree.UNARY_TILDE: "unary_tilde",
+	tree.UNARY_MARK:  "unary_mark",
+}
+
+// newFunction returns the call of function name, whose type is checked and
+// inferred from the arguments.
+func newFunction(name string, args ...*plan.Expr) (*plan.Expr, error) {
+	def, ok := functions[name]
+	if !ok {
+		return nil, errors.New(errno.UndefinedFunction, fmt.Sprintf("function '%s' doesn't exist", name))
+	}
+	typ, err := def.returnType(name, args)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case def.notNull:
+		typ.Nullable = false
+	case def.aggregate:
+		typ.Nullable = true
+	default:
+		for _, arg := range args {
+			typ.Nullable = typ.Nullable || arg.Typ.Nullable
+		}
+	}
+	return &plan.Expr{
+		Typ: typ,
+		Expr: &plan.Expr_F{
+			F: &plan.Function{
+				Func: &plan.ObjectRef{ObjName: name},
+				Args: args,
+			},
+		},
+	}, nil
+}
+
+func isIntegerType(id uint32) bool {
+	return (id >= uint32(plan.Type_INT8) && id <= uint32(plan.Type_UINT128)) || id == uint32(plan.Type_ANYINT)
+}
+
+func isUnsignedType(id uint32) bool {
+	return id >= uint32(plan.Type_UINT8) && id <= uint32(plan.Type_UINT128)
+}
+
+func isFloatType(id uint32) bool {
+	return id == uint32(plan.Type_FLOAT32) || id == uint32(plan.Type_FLOAT64) || id == uint32(plan.Type_ANYFLOAT)
+}
+
+func isDecimalType(id uint32) bool {
+	return id >= uint32(plan.Type_DECIMAL64) && id <= uint32(plan.Type_DECIMAL)
+}
+
+func isNumericType(id uint32) bool {
+	return isIntegerType(id) || isFloatType(id) || isDecimalType(id) || id == uint32(plan.Type_ANYNUMBER)
+}
+
+func isStringType(id uint32) bool {
+	return id == uint32(plan.Type_CHAR) || id == uint32(plan.Type_VARCHAR) || id == uint32(plan.Type_JSON)
+}
+
+func isTimeType(id uint32) bool {
+	return (id >= uint32(plan.Type_DATE) && id <= uint32(plan.Type_TIMESTAMP)) || id == uint32(plan.Type_ANYTIME)
+}
+
+func isAnyType(id uint32) bool {
+	return id == uint32(plan.Type_ANY)
+}
+
+func typeName(typ *plan.Type) string {
+	return strings.ToLower(plan.Type_TypeId(typ.Id).String())
+}
+
+func newType(id plan.Type_TypeId) *plan.Type {
+	return &plan.Type{Id: uint32(id)}
+}
+
+func errArgType(name string, args []*plan.Expr) error {
+	names := make([]string, len(args))
+	for i, arg := range args {
+		names[i] = typeName(arg.Typ)
+	}
+	return errors.New(errno.DatatypeMismatch, fmt.Sprintf("function '%s' is not supported for types (%s)", name, strings.Join(names, ", ")))
+}
+
+func errArgCount(name string, args []*plan.Expr) error {
+	return errors.New(errno.UndefinedFunction, fmt.Sprintf("function '%s' with %d arguments doesn't exist", name, len(args)))
+}
+
+// checkArgs checks the number of arguments and that each argument
+// is ANY or satisfies pred.
+func checkArgs(name string, args []*plan.Expr, min, max int, pred func(uint32) bool) error {
+	if len(args) < min || len(args) > max {
+		return errArgCount(name, args)
+	}
+	for _, arg := range args {
+		if !isAnyType(arg.Typ.Id) && !pred(arg.Typ.Id) {
+			return errArgType(name, args)
+		}
+	}
+	return nil
+}
+
+// numericType returns the common type of numeric types, float > decimal > integer.
+func numericType(typs ...*plan.Type) *plan.Type {
+	var dec *plan.Type
+	unsigned := true
+	ints := 0
+	for _, typ := range typs {
+		switch {
+		case isFloatType(typ.Id):
+			return newType(plan.Type_FLOAT64)
+		case isDecimalType(typ.Id):
+			if dec == nil || typ.Width > dec.Width || typ.Precision > dec.Precision {
+				dec = typ
+			}
+		case isIntegerType(typ.Id):
+			ints++
+			unsigned = unsigned && isUnsignedType(typ.Id)
+		}
+	}
+	switch {
+	case dec != nil:
+		return &plan.Type{Id: dec.Id, Width: dec.Width, Precision: dec.Precision}
+	case ints > 0 && unsigned:
+		return newType(plan.Type_UINT64)
+	case ints > 0:
+		return newType(plan.Type_INT64)
+	}
+	return newType(plan.Type_ANY)
+}
+
+func arithReturnType(name string, args []*plan.Expr) (*plan.Type, error) {
+	if len(args) != 2 {
+		return nil, errArgCount(name, args)
+	}
+	l, r := args[0].Typ, args[1].Typ
+	// date +/- interval and interval + date
+	if r.Id == uint32(plan.Type_INTERVAL) && isTimeType(l.Id) && (name == "+" || name == "-") {
+		return &plan.Type{Id: l.Id}, nil
+	}
+	if l.Id == uint32(plan.Type_INTERVAL) && isTimeType(r.Id) && name == "+" {
+		return &plan.Type{Id: r.Id}, nil
+	}
+	if err := checkArgs(name, args, 2, 2, isNumericType); err != nil {
+		return nil, err
+	}
+	return numericType(l, r), nil
+}
+
+func divReturnType(name string, args []*plan.Expr) (*plan.Type, error) {
+	if err := checkArgs(name, args, 2, 2, isNumericType); err != nil {
+		return nil, err
+	}
+	return newType(plan.Type_FLOAT64), nil
+}
+
+func intDivReturnType(name string, args []*plan.Expr) (*plan.Type, error) {
+	if err := checkArgs(name, args, 2, 2, isNumericType); err != nil {
+		return nil, err
+	}
+	return newType(plan.Type_INT64), nil
+}
+
+func bitReturnType(name string, args []*plan.Expr) (*plan.Type, error) {
+	if err := checkArgs(name, args, 1, 2, isIntegerType); err != nil {
+		return nil, err
+	}
+	return newType(plan.Type_UINT64), nil
+}
+
+func negReturnType(name string, args []*plan.Expr) (*plan.Type, error) {
+	if err := checkArgs(name, args, 1, 1, isNumericType); err != nil {
+		return nil, err
+	}
+	if isUnsignedType(args[0].Typ.Id) {
+		return newType(plan.Type_INT64), nil
+	}
+	return &plan.Type{Id: args[0].Typ.Id, Width: args[0].Typ.Width, Precision: args[0].Typ.Precision}, nil
+}
+
+// comparable returns true if the values of both types can be compared,
+// a time can be compared with a string.
+func comparable(l, r *plan.Type) bool {
+	switch {
+	case isAnyType(l.Id) || isAnyType(r.Id):
+		return true
+	case isNumericType(l.Id):
+		return isNumericType(r.Id) || r.Id == uint32(plan.Type_BOOL)
+	case isStringType(l.Id):
+		return isStringType(r.Id) || isTimeType(r.Id)
+	case isTimeType(l.Id):
+		return isTimeType(r.Id) || isStringType(r.Id)
+	case l.Id == uint32(plan.Type_BOOL):
+		return r.Id == uint32(plan.Type_BOOL) || isNumericType(r.Id)
+	}
+	return l.Id == r.Id
+}
+
+// compareReturnType checks that the first argument can be compared with the others.
+func compareReturnType(name string, args []*plan.Expr) (*plan.Type, error) {
+	if len(args) < 2 || (len(args) > 2 && name != "in" && name != "not in") {
+		return nil, errArgCount(name, args)
+	}
+	for _, arg := range args[1:] {
+		if !comparable(args[0].Typ, arg.Typ) {
+			return nil, errArgType(name, args)
+		}
+	}
+	return newType(plan.Type_BOOL), nil
+}
+
+func likeReturnType(name string, args []*plan.Expr) (*plan.Type, error) {
+	if err := checkArgs(name, args, 2, 3, isStringType); err != nil {
+		return nil, err
+	}
+	return newType(plan.Type_BOOL), nil
+}
+
+func logicReturnType(name string, args []*plan.Expr) (*plan.Type, error) {
+	if err := checkArgs(name, args, 1, 2, func(id uint32) bool {
+		return id == uint32(plan.Type_BOOL) || isNumericType(id)
+	}); err != nil {
+		return nil, err
+	}
+	return newType(plan.Type_BOOL), nil
+}
+
+func boolReturnType(name string, args []*plan.Expr) (*plan.Type, error) {
+	if len(args) != 1 {
+		return nil, errArgCount(name, args)
+	}
+	return newType(plan.Type_BOOL), nil
+}
+
+// caseReturnType checks the arguments (cond1, then1, ..., else), the results
+// must be of the same kind of types.
+func caseReturnType(name string, args []*plan.Expr) (*plan.Type, error) {
+	if len(args) < 3 || len(args)%2 == 0 {
+		return nil, errArgCount(name, args)
+	}
+	vals := []*plan.Type{args[len(args)-1].Typ}
+	for i := 0; i < len(args)-1; i += 2 {
+		if id := args[i].Typ.Id; id != uint32(plan.Type_BOOL) && !isAnyType(id) && !isNumericType(id) {
+			return nil, errArgType(name, args)
+		}
+		vals = append(vals, args[i+1].Typ)
+	}
+	var typ *plan.Type
+	numeric := true
+	for _, val := range vals {
+		if isAnyType(val.Id) {
+			continue
+		}
+		if typ != nil && !comparable(typ, val) {
+			return nil, errArgType(name, args)
+		}
+		if typ == nil {
+			typ = val
+		}
+		numeric = numeric && isNumericType(val.Id)
+	}
+	switch {
+	case typ == nil:
+		return newType(plan.Type_ANY), nil
+	case numeric:
+		return numericType(vals...), nil
+	}
+	return &plan.Type{Id: typ.Id, Width: typ.Width, Precision: typ.Precision}, nil
+}
+
+func intervalReturnType(name string, args []*plan.Expr) (*plan.Type, error) {
+	if len(args) != 2 || !isStringType(args[1].Typ.Id) {
+		return nil, errArgCount(name, args)
+	}
+	if !isAnyType(args[0].Typ.Id) && !isNumericType(args[0].Typ.Id) {
+		return nil, errArgType(name, args)
+	}
+	return newType(plan.Type_INTERVAL), nil
+}
+
+func dateReturnType(name string, args []*plan.Expr) (*plan.Type, error) {
+	if err := checkArgs(name, args, 1, 1, func(id uint32) bool {
+		return isTimeType(id) || isStringType(id)
+	}); err != nil {
+		return nil, err
+	}
+	return newType(plan.Type_DATE), nil
+}
+
+func timePartReturnType(name string, args []*plan.Expr) (*plan.Type, error) {
+	if err := checkArgs(name, args, 1, 1, func(id uint32) bool {
+		return isTimeType(id) || isStringType(id)
+	}); err != nil {
+		return nil, err
+	}
+	return newType(plan.Type_INT64), nil
+}
+
+// extractReturnType checks the arguments of extract(unit, time).
+func extractReturnType(name string, args []*plan.Expr) (*plan.Type, error) {
+	if len(args) != 2 {
+		return nil, errArgCount(name, args)
+	}
+	if !isStringType(args[0].Typ.Id) || !(isAnyType(args[1].Typ.Id) || isTimeType(args[1].Typ.Id) || isStringType(args[1].Typ.Id)) {
+		return nil, errArgType(name, args)
+	}
+	return newType(plan.Type_INT64), nil
+}
+
+func substringReturnType(name string, args []*plan.Expr) (*plan.Type, error) {
+	if len(args) < 2 || len(args) > 3 {
+		return nil, errArgCount(name, args)
+	}
+	if !isAnyType(args[0].Typ.Id) && !isStringType(args[0].Typ.Id) {
+		return nil, errArgType(name, args)
+	}
+	for _, arg := range args[1:] {
+		if !isAnyType(arg.Typ.Id) && !isIntegerType(arg.Typ.Id) {
+			return nil, errArgType(name, args)
+		}
+	}
+	return &plan.Type{Id: uint32(plan.Type_VARCHAR), Width: args[0].Typ.Width}, nil
+}
+
+func lengthReturnType(name string, args []*plan.Expr) (*plan.Type, error) {
+	if err := checkArgs(name, args, 1, 1, isStringType); err != nil {
+		return nil, err
+	}
+	return newType(plan.Type_INT64), nil
+}
+
+func stringReturnType(name string, args []*plan.Expr) (*plan.Type, error) {
+	if err := checkArgs(name, args, 1, 1, isStringType); err != nil {
+		return nil, err
+	}
+	return &plan.Type{Id: uint32(plan.Type_VARCHAR), Width: args[0].Typ.Width}, nil
+}
+
+func concatReturnType(name string, args []*plan.Expr) (*plan.Type, error) {
+	if len(args) == 0 {
+		return nil, errArgCount(name, args)
+	}
+	return newType(plan.Type_VARCHAR), nil
+}
+
+func countReturnType(name string, args []*plan.Expr) (*plan.Type, error) {
+	if len(args) > 1 {
+		return nil, errArgCount(name, args)
+	}
+	return newType(plan.Type_INT64), nil
+}
+
+func sumReturnType(name string, args []*plan.Expr) (*plan.Type, error) {
+	if err := checkArgs(name, args, 1, 1, isNumericType); err != nil {
+		return nil, err
+	}
+	return numericType(args[0].Typ), nil
+}
+
+func avgReturnType(name string, args []*plan.Expr) (*plan.Type, error) {
+	if err := checkArgs(name, args, 1, 1, isNumericType); err != nil {
+		return nil, err
+	}
+	return newType(plan.Type_FLOAT64), nil
+}
+
+func minMaxReturnType(name string, args []*plan.Expr) (*plan.Type, error) {
+	if len(args) != 1 {
+		return nil, errArgCount(name, args)
+	}
+	typ := args[0].Typ
+	return &plan.Type{Id: typ.Id, Width: typ.Width, Precision: typ.Precision}, nil
+}
+
+func distinctReturnType(name string, args []*plan.Expr) (*plan.Type, error) {
+	return minMaxReturnType(name, args)
+}
@@ -15,15 +15,28 @@
 package plan2
 
 import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 
 type MockCompilerContext struct {
 	objects map[string]*ObjectRef
+	tables  map[string]*TableDef
 }
 
-func (m *MockCompilerContext) Resolve(name string) *ObjectRef {
-	return m.objects[name]
+func NewMockCompilerContext() *MockCompilerContext {
+	return &MockCompilerContext{
+		objects: make(map[string]*ObjectRef),
+		tables:  make(map[string]*TableDef),
+	}
+}
+
+func (m *MockCompilerContext) Resolve(name string) (*ObjectRef, *TableDef) {
+	return m.objects[name], m.tables[name]
 }
 
 func (m *MockCompilerContext) Cost(obj *ObjectRef, e *Expr) Cost {
@@ -41,14 +54,100 @@ func (m *MockCompilerContext) Cost(obj *ObjectRef, e *Expr) Cost {
 	return c
 }
 
+// addTable adds a table or a view to the mock catalog.
+func (m *MockCompilerContext) addTable(def *TableDef) {
+	m.objects[def.Name] = &ObjectRef{
+		Obj:     int64(len(m.objects) + 1),
+		ObjName: def.Name,
+	}
+	m.tables[def.Name] = def
+}
+
 type MockOptimizer struct {
-	ctxt MockCompilerContext
+	ctxt *MockCompilerContext
 }
 
-func (moc *MockOptimizer) Optimize(stmt tree.Statement) *Query {
-	return nil
+func NewMockOptimizer() *MockOptimizer {
+	return &MockOptimizer{
+		ctxt: NewMockCompilerContext(),
+	}
+}
+
+// Optimize builds the plan of a query, CREATE TABLE and CREATE VIEW are
+// executed on the mock catalog and return no plan.
+func (moc *MockOptimizer) Optimize(stmt tree.Statement) (*Query, error) {
+	switch stmt := stmt.(type) {
+	case *tree.CreateTable:
+		def, err := buildMockTableDef(stmt)
+		if err != nil {
+			return nil, err
+		}
+		moc.ctxt.addTable(def)
+		return nil, nil
+	case *tree.CreateView:
+		def, err := moc.buildMockViewDef(stmt)
+		if err != nil {
+			return nil, err
+		}
+		moc.ctxt.addTable(def)
+		return nil, nil
+	}
+	return BuildPlan(moc.ctxt, stmt)
 }
 
 func (moc *MockOptimizer) CurrentContext() CompilerContext {
-	return &moc.ctxt
+	return moc.ctxt
+}
+
+func buildMockTableDef(stmt *tree.CreateTable) (*TableDef, error) {
+	def := &TableDef{Name: strings.ToLower(string(stmt.Table.ObjectName))}
+	for _, d := range stmt.Defs {
+		col, ok := d.(*tree.ColumnTableDef)
+		if !ok {
+			continue
+		}
+		typ, err := getTypeFromAst(col.Type)
+		if err != nil {
+			return nil, err
+		}
+		typ.Nullable = true
+		for _, attr := range col.Attributes {
+			if null, ok := attr.(*tree.AttributeNull); ok && !null.Is {
+				typ.Nullable = false
+			}
+		}
+		def.Cols = append(def.Cols, &plan.ColDef{
+			Name: strings.ToLower(col.Name.Parts[0]),
+			Typ:  typ,
+		})
+	}
+	return def, nil
+}
+
+// buildMockViewDef returns the definition of a view, whose columns are
+// the result of its query.
+func (moc *MockOptimizer) buildMockViewDef(stmt *tree.CreateView) (*TableDef, error) {
+	qry, err := BuildPlan(moc.ctxt, stmt.AsSource)
+	if err != nil {
+		return nil, err
+	}
+	names := qry.Headings
+	if len(stmt.ColNames) > 0 {
+		if len(stmt.ColNames) != len(names) {
+			return nil, errors.New(errno.InvalidColumnReference, "view's SELECT and view's field list have different column counts")
+		}
+		names = make([]string, len(stmt.ColNames))
+		for i, name := range stmt.ColNames {
+			names[i] = string(name)
+		}
+	}
+	root := qry.Nodes[qry.Steps[0]]
+	def := &TableDef{Name: strings.ToLower(string(stmt.Name.ObjectName))}
+	for i, e := range root.ProjectList {
+		def.Cols = append(def.Cols, &plan.ColDef{
+			Name: strings.ToLower(names[i]),
+			Typ:  e.Typ,
+		})
+	}
+	return def, nil
 }
@@ -19,11 +19,13 @@ import (
 )
 
 type CompilerContext interface {
-	Resolve(name string) *ObjectRef
+	// Resolve returns the reference and the definition of a table,
+	// both are nil if the table does not exist.
+	Resolve(name string) (*ObjectRef, *TableDef)
 	Cost(obj *ObjectRef, e *Expr) Cost
 }
 
 type Optimizer interface {
-	Optimize(stmt tree.Statement) *Query
+	Optimize(stmt tree.Statement) (*Query, error)
 	CurrentContext() CompilerContext
 }
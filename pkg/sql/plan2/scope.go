@@ -0,0 +1,143 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// binding is a table or a derived table in the FROM clause.
+type binding struct {
+	table string
+	cols  []string
+	types []*plan.Type
+	// offset is the position of the first column of the binding
+	// in the output of the FROM clause.
+	offset int
+}
+
+// scope is the name space of a query block.
+type scope struct {
+	parent   *scope
+	bindings []*binding
+	// width is the number of columns of the FROM clause.
+	width int
+
+	// aggregated is set while the expressions after the aggregation are built,
+	// they refer to the output of the agg node, which is groupBy ++ aggs.
+	aggregated bool
+	groupBy    []*plan.Expr
+	aggs       []*plan.Expr
+
+	// correlated is set if the query block refers to a column of an outer query.
+	correlated bool
+	// names are the names of the output columns of the query block.
+	names []string
+}
+
+func newScope(parent *scope) *scope {
+	return &scope{parent: parent}
+}
+
+func (s *scope) addBinding(table string, cols []string, types []*plan.Type) {
+	s.bindings = append(s.bindings, &binding{
+		table:  table,
+		cols:   cols,
+		types:  types,
+		offset: s.width,
+	})
+	s.width += len(cols)
+}
+
+func (s *scope) findBinding(table string) *binding {
+	for _, bd := range s.bindings {
+		if bd.table == table {
+			return bd
+		}
+	}
+	return nil
+}
+
+// findColumn returns the binding and the index of the column in it, the binding is
+// nil if there is no such column.
+func (s *scope) findColumn(table, col string) (*binding, int, error) {
+	var found *binding
+	var idx int
+	for _, bd := range s.bindings {
+		if table != "" && bd.table != table {
+			continue
+		}
+		for i, name := range bd.cols {
+			if name != col {
+				continue
+			}
+			if found != nil {
+				return nil, 0, errors.New(errno.AmbiguousColumn, fmt.Sprintf("column '%s' is ambiguous", col))
+			}
+			found, idx = bd, i
+		}
+	}
+	return found, idx, nil
+}
+
+// resolveColumn resolves the column name in the scope and its outer scopes,
+// the column of an outer query is returned as a CorrColRef.
+func (s *scope) resolveColumn(name *tree.UnresolvedName) (*plan.Expr, error) {
+	var table string
+	col := strings.ToLower(name.Parts[0])
+	if name.NumParts > 1 {
+		table = strings.ToLower(name.Parts[1])
+	}
+	for depth, cur := 0, s; cur != nil; depth, cur = depth+1, cur.parent {
+		bd, idx, err := cur.findColumn(table, col)
+		if err != nil {
+			return nil, err
+		}
+		if bd == nil {
+			continue
+		}
+		colName := bd.table + "." + col
+		pos := int32(bd.offset + idx)
+		if depth == 0 {
+			return newColRef(colName, 0, pos, bd.types[idx]), nil
+		}
+		if cur.aggregated {
+			return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("correlated column '%s' after aggregation is not supported now", colName))
+		}
+		for c := s; c != cur; c = c.parent {
+			c.correlated = true
+		}
+		return &plan.Expr{
+			Typ: bd.types[idx],
+			Expr: &plan.Expr_Corr{
+				Corr: &plan.CorrColRef{
+					Name:   colName,
+					ColPos: pos,
+					Depth:  int32(depth),
+				},
+			},
+		}, nil
+	}
+	if table != "" {
+		return nil, errors.New(errno.UndefinedColumn, fmt.Sprintf("unknown column '%s.%s'", table, col))
+	}
+	return nil, errors.New(errno.UndefinedColumn, fmt.Sprintf("unknown column '%s'", col))
+}
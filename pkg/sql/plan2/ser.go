@@ -23,3 +23,5 @@ type Expr plan.Expr
 type Node plan.Node
 type RowsetData plan.RowsetData
 type Query plan.Query
+type TableDef plan.TableDef
+type ColDef plan.ColDef
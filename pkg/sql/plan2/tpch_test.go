@@ -21,70 +21,92 @@ import (
 	"runtime"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 
 func Test_TPCH_Plan2(t *testing.T) {
-	var mock MockOptimizer
+	mock := NewMockOptimizer()
 	_, fn, _, _ := runtime.Caller(0)
 	dir := filepath.Dir(fn)
 
 	ddlf, err := os.ReadFile(dir + "/tpch/ddl.sql")
 	if err != nil {
-		t.Errorf("Cannot open ddl file, error %v", err)
+		t.Fatalf("Cannot open ddl file, error %v", err)
 	}
 
 	ddls, err := mysql.Parse(string(ddlf))
 	if err != nil {
-		t.Errorf("DDL Parser failed, error %v", err)
+		t.Fatalf("DDL Parser failed, error %v", err)
 	}
 
 	for _, ast := range ddls {
-		q := mock.Optimize(ast)
-		if q == nil {
-			t.Logf("Optimizer failed, NYI")
+		if _, err := mock.Optimize(ast); err != nil {
+			t.Fatalf("DDL failed, error %v", err)
 		}
 	}
 
 	qf, err := os.ReadFile(dir + "/tpch/simple.sql")
 	if err != nil {
-		t.Errorf("Cannot open queries file, error %v", err)
+		t.Fatalf("Cannot open queries file, error %v", err)
 	}
 
 	qs, err := mysql.Parse(string(qf))
 	if err != nil {
-		t.Errorf("DDL Parser failed, error %v", err)
+		t.Fatalf("Query Parser failed, error %v", err)
 	}
 
 	for _, ast := range qs {
-		q := mock.Optimize(ast)
-		if q == nil {
-			t.Logf("Optimizer failed, NYI")
-		}
+		checkPlan(t, mock, "simple", ast)
 	}
 
-	// Parse failed for query 1, 4, 5, 6, 7, 8, 9, 11, 12, 13, 14, 15, 20, 22
-	if false {
-		for qn := 1; qn <= 22; qn += 1 {
-			qnf, err := os.ReadFile(fmt.Sprintf("%s/tpch/q%d.sql", dir, qn))
-			if err != nil {
-				t.Errorf("Cannot open file of query %d, error %v", qn, err)
-			}
-			qns, err := mysql.Parse(string(qnf))
-			if err != nil {
-				t.Errorf("Query %d Parser failed, error %v", qn, err)
-			}
-			if qn == 15 {
-				if len(qns) != 2 {
-					t.Errorf("Query 15 has a view, expecting two stmt, got %d", len(qns))
-				}
-			}
-			for _, ast := range qns {
-				q := mock.Optimize(ast)
-				if q == nil {
-					t.Logf("Optimizer failed, NYI")
-				}
+	for qn := 1; qn <= 22; qn += 1 {
+		qnf, err := os.ReadFile(fmt.Sprintf("%s/tpch/q%d.sql", dir, qn))
+		if err != nil {
+			t.Fatalf("Cannot open file of query %d, error %v", qn, err)
+		}
+		qns, err := mysql.Parse(string(qnf))
+		if err != nil {
+			t.Fatalf("Query %d Parser failed, error %v", qn, err)
+		}
+		if qn == 15 {
+			if len(qns) != 2 {
+				t.Errorf("Query 15 has a view, expecting two stmt, got %d", len(qns))
 			}
 		}
+		for _, ast := range qns {
+			checkPlan(t, mock, fmt.Sprintf("query %d", qn), ast)
+		}
+	}
+}
+
+// checkPlan checks that the plan of a query is built and can be serialized.
+func checkPlan(t *testing.T, opt Optimizer, name string, ast tree.Statement) {
+	q, err := opt.Optimize(ast)
+	if err != nil {
+		t.Errorf("Optimizer failed for %s, error %v", name, err)
+		return
+	}
+	if _, ok := ast.(*tree.Select); !ok {
+		return
+	}
+	if q == nil || len(q.Steps) != 1 || len(q.Headings) == 0 {
+		t.Errorf("Optimizer returns invalid plan for %s", name)
+		return
+	}
+	data, err := proto.Marshal((*plan.Query)(q))
+	if err != nil {
+		t.Errorf("Plan of %s cannot be serialized, error %v", name, err)
+		return
+	}
+	var qry plan.Query
+	if err := proto.Unmarshal(data, &qry); err != nil {
+		t.Errorf("Plan of %s cannot be deserialized, error %v", name, err)
+		return
+	}
+	if !proto.Equal(&qry, (*plan.Query)(q)) {
+		t.Errorf("Plan of %s changes after serialization", name)
 	}
 }
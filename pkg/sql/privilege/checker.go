@@ -0,0 +1,164 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package privilege
+
+var names = []struct {
+	typ  Type
+	name string
+}{
+	{Select, "SELECT"},
+	{Insert, "INSERT"},
+	{Update, "UPDATE"},
+	{Delete, "DELETE"},
+	{Create, "CREATE"},
+	{Drop, "DROP"},
+	{Alter, "ALTER"},
+	{Index, "INDEX"},
+	{CreateUser, "CREATE USER"},
+	{GrantOption, "GRANT"},
+	{Super, "SUPER"},
+	{File, "FILE"},
+}
+
+func (t Type) String() string {
+	for _, n := range names {
+		if t&n.typ != 0 {
+			return n.name
+		}
+	}
+	return "USAGE"
+}
+
+// NewChecker returns the checker of account 'name'@'host', it returns nil if m is nil.
+func (m *Manager) NewChecker(name, host string) *Checker {
+	if m == nil {
+		return nil
+	}
+	return &Checker{
+		m:    m,
+		name: name,
+		host: host,
+	}
+}
+
+// Manager returns the manager of accounts.
+func (c *Checker) Manager() *Manager {
+	if c == nil {
+		return nil
+	}
+	return c.m
+}
+
+// Name returns the user name of the account.
+func (c *Checker) Name() string {
+	if c == nil {
+		return ""
+	}
+	return c.name
+}
+
+// Host returns the host pattern of the account.
+func (c *Checker) Host() string {
+	if c == nil {
+		return ""
+	}
+	return c.host
+}
+
+// CheckGlobal returns error if the account has not all the privileges globally.
+func (c *Checker) CheckGlobal(privs Type) error {
+	if c == nil {
+		return nil
+	}
+	if miss := privs &^ c.privileges(Global, "", ""); miss != 0 {
+		return newSpecificAccessDeniedError(miss.String())
+	}
+	return nil
+}
+
+// CheckDatabase returns error if the account has not all the privileges on database db.
+func (c *Checker) CheckDatabase(privs Type, db string) error {
+	if c == nil {
+		return nil
+	}
+	if privs&^c.privileges(Database, db, "") != 0 {
+		return newDBAccessDeniedError(c.name, c.host, db)
+	}
+	return nil
+}
+
+// CheckTable returns error if the account has not all the privileges on table db.tbl.
+func (c *Checker) CheckTable(privs Type, db, tbl string) error {
+	if c == nil {
+		return nil
+	}
+	if miss := privs &^ c.privileges(Table, db, tbl); miss != 0 {
+		return newTableAccessDeniedError(miss.String(), c.name, c.host, tbl)
+	}
+	return nil
+}
+
+// CheckAny returns error if the account has no privilege on database db or any table of it.
+func (c *Checker) CheckAny(db string) error {
+	if c == nil {
+		return nil
+	}
+	c.m.RLock()
+	defer c.m.RUnlock()
+	if u, ok := c.m.users[Key(c.name, c.host)]; ok {
+		for _, g := range u.Grants {
+			if g.Privs&^GrantOption != 0 && (g.Level == Global || g.Db == db) {
+				return nil
+			}
+		}
+	}
+	return newDBAccessDeniedError(c.name, c.host, db)
+}
+
+// CheckGrant returns error if the account cannot grant privs at the level of g,
+// the account must have GRANT OPTION and all the privileges granted.
+func (c *Checker) CheckGrant(g Grant) error {
+	switch g.Level {
+	case Global:
+		return c.CheckGlobal(g.Privs | GrantOption)
+	case Database:
+		return c.CheckDatabase(g.Privs|GrantOption, g.Db)
+	default:
+		return c.CheckTable(g.Privs|GrantOption, g.Db, g.Table)
+	}
+}
+
+// privileges returns the privileges of the account on the object of level,
+// which include the privileges granted at higher levels.
+func (c *Checker) privileges(level Level, db, tbl string) Type {
+	c.m.RLock()
+	defer c.m.RUnlock()
+	u, ok := c.m.users[Key(c.name, c.host)]
+	if !ok {
+		return 0
+	}
+	var privs Type
+	for _, g := range u.Grants {
+		switch {
+		case g.Level == Global:
+			privs |= g.Privs
+		case g.Level == Database && level != Global && g.Db == db:
+			privs |= g.Privs
+		case g.Level == Table && level == Table && g.Db == db && g.Table == tbl:
+			privs |= g.Privs
+		}
+	}
+	return privs
+}
@@ -0,0 +1,81 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package privilege
+
+import (
+	"fmt"
+	"strings"
+)
+
+// mysql error codes
+const (
+	ErrDBAccessDenied       = 1044
+	ErrAccessDenied         = 1045
+	ErrNonexistingGrant     = 1141
+	ErrTableAccessDenied    = 1142
+	ErrNonexistingTabGrant  = 1147
+	ErrSpecificAccessDenied = 1227
+	ErrCannotUser           = 1396
+	ErrCantCreateUserGrant  = 1410
+)
+
+func (e *Error) Error() string {
+	return e.Msg
+}
+
+func newError(code uint16, state string, format string, args ...interface{}) error {
+	return &Error{
+		Code:  code,
+		State: state,
+		Msg:   fmt.Sprintf(format, args...),
+	}
+}
+
+// NewAccessDeniedError returns the error of authentication failure.
+func NewAccessDeniedError(name, host string, usingPassword bool) error {
+	using := "NO"
+	if usingPassword {
+		using = "YES"
+	}
+	return newError(ErrAccessDenied, "28000", "Access denied for user '%s'@'%s' (using password: %s)", name, host, using)
+}
+
+func newDBAccessDeniedError(name, host, db string) error {
+	return newError(ErrDBAccessDenied, "42000", "Access denied for user '%s'@'%s' to database '%s'", name, host, db)
+}
+
+func newTableAccessDeniedError(cmd, name, host, tbl string) error {
+	return newError(ErrTableAccessDenied, "42000", "%s command denied to user '%s'@'%s' for table '%s'", cmd, name, host, tbl)
+}
+
+func newSpecificAccessDeniedError(priv string) error {
+	return newError(ErrSpecificAccessDenied, "42000", "Access denied; you need (at least one of) the %s privilege(s) for this operation", priv)
+}
+
+func newCannotUserError(op string, users []string) error {
+	return newError(ErrCannotUser, "HY000", "Operation %s failed for %s", op, strings.Join(users, ","))
+}
+
+func newNonexistingGrantError(name, host string) error {
+	return newError(ErrNonexistingGrant, "42000", "There is no such grant defined for user '%s' on host '%s'", name, host)
+}
+
+func newNonexistingTableGrantError(name, host, tbl string) error {
+	return newError(ErrNonexistingTabGrant, "42000", "There is no such grant defined for user '%s' on host '%s' on table '%s'", name, host, tbl)
+}
+
+func newCantCreateUserGrantError() error {
+	return newError(ErrCantCreateUserGrant, "42000", "You are not allowed to create a user with GRANT")
+}
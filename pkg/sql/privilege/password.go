@@ -0,0 +1,166 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package privilege
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	scramMechanism  = "SCRAM-SHA-256"
+	scramIterations = 4096
+	scramSaltLen    = 16
+)
+
+// EncodePassword returns the hashed password of mysql_native_password,
+// which is '*' followed by the upper hex of SHA1(SHA1(password)).
+func EncodePassword(password string) string {
+	if len(password) == 0 {
+		return ""
+	}
+	hash1 := sha1.Sum([]byte(password))
+	hash2 := sha1.Sum(hash1[:])
+	return "*" + strings.ToUpper(hex.EncodeToString(hash2[:]))
+}
+
+// IsAuthString returns true if s is a valid hashed password.
+func IsAuthString(s string) bool {
+	if len(s) == 0 {
+		return true
+	}
+	if len(s) != 2*sha1.Size+1 || s[0] != '*' {
+		return false
+	}
+	_, err := hex.DecodeString(s[1:])
+	return err == nil
+}
+
+// CheckAuth returns true if auth is the response of the client for salt with the
+// password of authString.
+// The client sends SHA1(password) XOR SHA1(salt + SHA1(SHA1(password))), so
+// SHA1(password) can be recovered from the response and checked against authString.
+func CheckAuth(authString string, salt, auth []byte) bool {
+	if len(authString) == 0 {
+		return len(auth) == 0
+	}
+	hash2, err := hex.DecodeString(authString[1:])
+	if err != nil || len(auth) != sha1.Size {
+		return false
+	}
+	hash1 := sha1.Sum(append(append([]byte{}, salt...), hash2...))
+	for i := range hash1 {
+		hash1[i] ^= auth[i]
+	}
+	hash := sha1.Sum(hash1[:])
+	return bytes.Equal(hash[:], hash2)
+}
+
+// CheckPassword returns true if password is the password of authString.
+func CheckPassword(authString, password string) bool {
+	return subtle.ConstantTimeCompare([]byte(EncodePassword(password)), []byte(authString)) == 1
+}
+
+// EncodeScramVerifier returns the SCRAM-SHA-256 verifier of password in the
+// format of PostgreSQL, SCRAM-SHA-256$<iterations>:<salt>$<StoredKey>:<ServerKey>.
+// The PostgreSQL clients authenticate with it, since the hash of
+// mysql_native_password can check the plain password only.
+func EncodeScramVerifier(password string) (string, error) {
+	salt := make([]byte, scramSaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	storedKey, serverKey := ScramKeys(password, salt, scramIterations)
+	return fmt.Sprintf("%s$%d:%s$%s:%s", scramMechanism, scramIterations,
+		base64.StdEncoding.EncodeToString(salt),
+		base64.StdEncoding.EncodeToString(storedKey),
+		base64.StdEncoding.EncodeToString(serverKey)), nil
+}
+
+// ScramVerifier is the parsed SCRAM-SHA-256 verifier.
+type ScramVerifier struct {
+	Iterations int
+	Salt       []byte
+	StoredKey  []byte
+	ServerKey  []byte
+}
+
+// ParseScramVerifier parses the verifier returned by EncodeScramVerifier.
+func ParseScramVerifier(s string) (*ScramVerifier, bool) {
+	parts := strings.Split(s, "$")
+	if len(parts) != 3 || parts[0] != scramMechanism {
+		return nil, false
+	}
+	i := strings.IndexByte(parts[1], ':')
+	j := strings.IndexByte(parts[2], ':')
+	if i < 0 || j < 0 {
+		return nil, false
+	}
+	var err error
+	v := new(ScramVerifier)
+	if v.Iterations, err = strconv.Atoi(parts[1][:i]); err != nil || v.Iterations <= 0 {
+		return nil, false
+	}
+	if v.Salt, err = base64.StdEncoding.DecodeString(parts[1][i+1:]); err != nil {
+		return nil, false
+	}
+	if v.StoredKey, err = base64.StdEncoding.DecodeString(parts[2][:j]); err != nil || len(v.StoredKey) != sha256.Size {
+		return nil, false
+	}
+	if v.ServerKey, err = base64.StdEncoding.DecodeString(parts[2][j+1:]); err != nil || len(v.ServerKey) != sha256.Size {
+		return nil, false
+	}
+	return v, true
+}
+
+// ScramKeys returns StoredKey and ServerKey of password by RFC 5802.
+func ScramKeys(password string, salt []byte, iterations int) ([]byte, []byte) {
+	saltedPassword := ScramSaltedPassword(password, salt, iterations)
+	storedKey := sha256.Sum256(scramHMAC(saltedPassword, "Client Key"))
+	return storedKey[:], scramHMAC(saltedPassword, "Server Key")
+}
+
+// ScramSaltedPassword is Hi(password, salt, i) which is PBKDF2 with HMAC-SHA-256.
+func ScramSaltedPassword(password string, salt []byte, iterations int) []byte {
+	mac := hmac.New(sha256.New, []byte(password))
+	mac.Write(salt)
+	mac.Write([]byte{0, 0, 0, 1})
+	u := mac.Sum(nil)
+	result := append([]byte{}, u...)
+	for i := 1; i < iterations; i++ {
+		mac.Reset()
+		mac.Write(u)
+		u = mac.Sum(nil)
+		for j := range result {
+			result[j] ^= u[j]
+		}
+	}
+	return result
+}
+
+func scramHMAC(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
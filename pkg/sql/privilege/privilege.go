@@ -0,0 +1,301 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package privilege
+
+import "fmt"
+
+// New returns a manager with the accounts stored in s,
+// s can be nil if the accounts are not persisted.
+func New(s Storage) (*Manager, error) {
+	m := &Manager{
+		s:     s,
+		users: make(map[string]*User),
+	}
+	if s != nil {
+		users, err := s.ListUsers()
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range users {
+			m.users[Key(u.Name, u.Host)] = u
+		}
+	}
+	return m, nil
+}
+
+// Key returns the identity 'name'@'host' of an account.
+func Key(name, host string) string {
+	return fmt.Sprintf("'%s'@'%s'", name, host)
+}
+
+// Bootstrap creates the super user 'name'@'%' which has all privileges if it does not exist.
+func (m *Manager) Bootstrap(name, password string) error {
+	m.Lock()
+	defer m.Unlock()
+	if _, ok := m.users[Key(name, "%")]; ok {
+		return nil
+	}
+	verifier, err := EncodeScramVerifier(password)
+	if err != nil {
+		return err
+	}
+	return m.setUser(&User{
+		Name:          name,
+		Host:          "%",
+		AuthString:    EncodePassword(password),
+		ScramVerifier: verifier,
+		Grants:        []Grant{{Level: Global, Privs: GlobalPrivileges | GrantOption}},
+	})
+}
+
+// Lookup returns the account of the user connected from host,
+// the account with the most specific host pattern is preferred.
+// The returned user must not be modified.
+func (m *Manager) Lookup(name, host string) (*User, bool) {
+	m.RLock()
+	defer m.RUnlock()
+	if u, ok := m.users[Key(name, host)]; ok {
+		return u, true
+	}
+	var r *User
+	for _, u := range m.users {
+		if u.Name != name || !matchHost(u.Host, host) {
+			continue
+		}
+		if r == nil || hostWeight(u.Host) > hostWeight(r.Host) {
+			r = u
+		}
+	}
+	return r, r != nil
+}
+
+// CreateUsers creates accounts, no account is created if any of them exists
+// unless ifNotExists is true.
+func (m *Manager) CreateUsers(accounts []Account, ifNotExists bool) error {
+	m.Lock()
+	defer m.Unlock()
+	var failed []string
+	seen := make(map[string]struct{})
+	for _, a := range accounts {
+		key := Key(a.Name, a.Host)
+		_, ok := m.users[key]
+		if _, dup := seen[key]; (ok || dup) && !ifNotExists {
+			failed = append(failed, key)
+		}
+		seen[key] = struct{}{}
+	}
+	if len(failed) > 0 {
+		return newCannotUserError("CREATE USER", failed)
+	}
+	for _, a := range accounts {
+		if _, ok := m.users[Key(a.Name, a.Host)]; ok {
+			continue
+		}
+		if err := m.setUser(&User{Name: a.Name, Host: a.Host, AuthString: a.AuthString, ScramVerifier: a.ScramVerifier}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DropUsers drops accounts, no account is dropped if any of them does not exist
+// unless ifExists is true.
+func (m *Manager) DropUsers(accounts []Account, ifExists bool) error {
+	m.Lock()
+	defer m.Unlock()
+	if err := m.checkExists("DROP USER", accounts, ifExists); err != nil {
+		return err
+	}
+	for _, a := range accounts {
+		if err := m.deleteUser(a.Name, a.Host); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AlterUsers changes the passwords of accounts, no account is changed if any of
+// them does not exist unless ifExists is true.
+func (m *Manager) AlterUsers(accounts []Account, ifExists bool) error {
+	m.Lock()
+	defer m.Unlock()
+	if err := m.checkExists("ALTER USER", accounts, ifExists); err != nil {
+		return err
+	}
+	for _, a := range accounts {
+		u, ok := m.users[Key(a.Name, a.Host)]
+		if !ok || !a.Auth {
+			continue
+		}
+		nu := *u
+		nu.AuthString, nu.ScramVerifier = a.AuthString, a.ScramVerifier
+		if err := m.setUser(&nu); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Grant grants the privileges of g to accounts.
+func (m *Manager) Grant(accounts []Account, g Grant) error {
+	m.Lock()
+	defer m.Unlock()
+	for _, a := range accounts {
+		if _, ok := m.users[Key(a.Name, a.Host)]; !ok {
+			return newCantCreateUserGrantError()
+		}
+	}
+	for _, a := range accounts {
+		u := m.users[Key(a.Name, a.Host)]
+		nu := *u
+		nu.Grants = make([]Grant, 0, len(u.Grants)+1)
+		found := false
+		for _, ug := range u.Grants {
+			if sameLevel(ug, g) {
+				ug.Privs |= g.Privs
+				found = true
+			}
+			nu.Grants = append(nu.Grants, ug)
+		}
+		if !found {
+			nu.Grants = append(nu.Grants, g)
+		}
+		if err := m.setUser(&nu); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Revoke revokes the privileges of g from accounts.
+func (m *Manager) Revoke(accounts []Account, g Grant) error {
+	m.Lock()
+	defer m.Unlock()
+	for _, a := range accounts {
+		if u, ok := m.users[Key(a.Name, a.Host)]; !ok || !hasGrant(u, g) {
+			if g.Level == Table {
+				return newNonexistingTableGrantError(a.Name, a.Host, g.Table)
+			}
+			return newNonexistingGrantError(a.Name, a.Host)
+		}
+	}
+	for _, a := range accounts {
+		u := m.users[Key(a.Name, a.Host)]
+		nu := *u
+		nu.Grants = make([]Grant, 0, len(u.Grants))
+		for _, ug := range u.Grants {
+			if sameLevel(ug, g) {
+				if ug.Privs &^= g.Privs; ug.Privs == 0 {
+					continue
+				}
+			}
+			nu.Grants = append(nu.Grants, ug)
+		}
+		if err := m.setUser(&nu); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// hasGrant returns true if u has grant at the same level as g.
+func hasGrant(u *User, g Grant) bool {
+	for _, ug := range u.Grants {
+		if sameLevel(ug, g) {
+			return true
+		}
+	}
+	return false
+}
+
+// sameLevel returns true if the privileges of a and b are granted on the same object.
+func sameLevel(a, b Grant) bool {
+	return a.Level == b.Level && a.Db == b.Db && a.Table == b.Table
+}
+
+func (m *Manager) checkExists(op string, accounts []Account, ifExists bool) error {
+	var failed []string
+	for _, a := range accounts {
+		key := Key(a.Name, a.Host)
+		if _, ok := m.users[key]; !ok && !ifExists {
+			failed = append(failed, key)
+		}
+	}
+	if len(failed) > 0 {
+		return newCannotUserError(op, failed)
+	}
+	return nil
+}
+
+// setUser stores the user and replaces the old one, users are never modified in place
+// because they may be used by Lookup.
+func (m *Manager) setUser(u *User) error {
+	if m.s != nil {
+		if err := m.s.SetUser(u); err != nil {
+			return err
+		}
+	}
+	m.users[Key(u.Name, u.Host)] = u
+	return nil
+}
+
+func (m *Manager) deleteUser(name, host string) error {
+	key := Key(name, host)
+	if _, ok := m.users[key]; !ok {
+		return nil
+	}
+	if m.s != nil {
+		if err := m.s.DeleteUser(name, host); err != nil {
+			return err
+		}
+	}
+	delete(m.users, key)
+	return nil
+}
+
+// matchHost returns true if host matches the pattern, '%' matches any
+// number of characters and '_' matches exactly one character.
+func matchHost(pattern, host string) bool {
+	if len(pattern) == 0 {
+		return len(host) == 0
+	}
+	switch pattern[0] {
+	case '%':
+		for i := 0; i <= len(host); i++ {
+			if matchHost(pattern[1:], host[i:]) {
+				return true
+			}
+		}
+		return false
+	case '_':
+		return len(host) > 0 && matchHost(pattern[1:], host[1:])
+	}
+	if pattern == "localhost" && (host == "127.0.0.1" || host == "::1") {
+		return true
+	}
+	return len(host) > 0 && pattern[0] == host[0] && matchHost(pattern[1:], host[1:])
+}
+
+// hostWeight returns the number of characters of pattern except wildcards,
+// the pattern with larger weight is more specific.
+func hostWeight(pattern string) int {
+	w := 0
+	for i := 0; i < len(pattern); i++ {
+		if pattern[i] != '%' && pattern[i] != '_' {
+			w++
+		}
+	}
+	return w
+}
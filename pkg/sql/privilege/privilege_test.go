@@ -0,0 +1,185 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package privilege
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testStorage struct {
+	users map[string]*User
+}
+
+func (s *testStorage) ListUsers() ([]*User, error) {
+	var users []*User
+	for _, u := range s.users {
+		users = append(users, u)
+	}
+	return users, nil
+}
+
+func (s *testStorage) SetUser(u *User) error {
+	s.users[Key(u.Name, u.Host)] = u
+	return nil
+}
+
+func (s *testStorage) DeleteUser(name, host string) error {
+	delete(s.users, Key(name, host))
+	return nil
+}
+
+func TestPassword(t *testing.T) {
+	// the result of PASSWORD('111') of mysql
+	require.Equal(t, "*832EB84CB764129D05D498ED9CA7E5CE9B8F83EB", EncodePassword("111"))
+	require.Equal(t, "", EncodePassword(""))
+	require.True(t, IsAuthString(EncodePassword("111")))
+	require.True(t, IsAuthString(""))
+	require.False(t, IsAuthString("832EB84CB764129D05D498ED9CA7E5CE9B8F83EB"))
+	require.False(t, IsAuthString("*832EB84CB764129D05D498ED9CA7E5CE9B8F83EZ"))
+
+	// compute the response as the client does
+	salt := []byte("12345678901234567890")
+	hash1 := sha1.Sum([]byte("111"))
+	hash2 := sha1.Sum(hash1[:])
+	hash3 := sha1.Sum(append(append([]byte{}, salt...), hash2[:]...))
+	auth := make([]byte, sha1.Size)
+	for i := range auth {
+		auth[i] = hash1[i] ^ hash3[i]
+	}
+	require.True(t, CheckAuth(EncodePassword("111"), salt, auth))
+	require.False(t, CheckAuth(EncodePassword("112"), salt, auth))
+	require.False(t, CheckAuth("", salt, auth))
+	require.True(t, CheckAuth("", salt, nil))
+
+	require.True(t, CheckPassword(EncodePassword("111"), "111"))
+	require.False(t, CheckPassword(EncodePassword("111"), "112"))
+	require.True(t, CheckPassword("", ""))
+}
+
+func TestScramVerifier(t *testing.T) {
+	// the keys are checked by the proof and the signature of the test vector of RFC 7677
+	salt, err := base64.StdEncoding.DecodeString("W22ZaJ0SNY7soEsUEjb6gQ==")
+	require.NoError(t, err)
+	authMessage := "n=user,r=rOprNGfwEbeRWgbNEkqO," +
+		"r=rOprNGfwEbeRWgbNEkqO%hvYDpWUa2RaTCAfuxFIlj)hNlF$k0,s=W22ZaJ0SNY7soEsUEjb6gQ==,i=4096," +
+		"c=biws,r=rOprNGfwEbeRWgbNEkqO%hvYDpWUa2RaTCAfuxFIlj)hNlF$k0"
+	proof, err := base64.StdEncoding.DecodeString("dHzbZapWIk4jUhN+Ute9ytag9zjfMHgsqmmiz7AndVQ=")
+	require.NoError(t, err)
+	storedKey, serverKey := ScramKeys("pencil", salt, 4096)
+	clientKey := scramHMAC(storedKey, authMessage)
+	for i := range clientKey {
+		clientKey[i] ^= proof[i]
+	}
+	hash := sha256.Sum256(clientKey)
+	require.Equal(t, storedKey, hash[:])
+	require.Equal(t, "6rriTRBi23WpRR/wtup+mMhUZUn/dB5nLTJRsjl95G4=", base64.StdEncoding.EncodeToString(scramHMAC(serverKey, authMessage)))
+
+	s, err := EncodeScramVerifier("pencil")
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(s, "SCRAM-SHA-256$4096:"))
+	v, ok := ParseScramVerifier(s)
+	require.True(t, ok)
+	require.Equal(t, 4096, v.Iterations)
+	storedKey, serverKey = ScramKeys("pencil", v.Salt, v.Iterations)
+	require.Equal(t, storedKey, v.StoredKey)
+	require.Equal(t, serverKey, v.ServerKey)
+
+	for _, s := range []string{"", EncodePassword("pencil"), "SCRAM-SHA-256$4096:c2FsdA==", "SCRAM-SHA-256$x:c2FsdA==$a2V5:a2V5"} {
+		_, ok = ParseScramVerifier(s)
+		require.False(t, ok, s)
+	}
+}
+
+func TestMatchHost(t *testing.T) {
+	testCases := []struct {
+		pattern string
+		host    string
+		ok      bool
+	}{
+		{"%", "10.0.0.1", true},
+		{"10.0.0.%", "10.0.0.1", true},
+		{"10.0.0.%", "10.0.1.1", false},
+		{"10.0.0._", "10.0.0.1", true},
+		{"10.0.0._", "10.0.0.12", false},
+		{"localhost", "127.0.0.1", true},
+		{"localhost", "::1", true},
+		{"localhost", "10.0.0.1", false},
+	}
+	for _, tc := range testCases {
+		require.Equal(t, tc.ok, matchHost(tc.pattern, tc.host), tc)
+	}
+}
+
+func TestManager(t *testing.T) {
+	s := &testStorage{users: make(map[string]*User)}
+	m, err := New(s)
+	require.NoError(t, err)
+	require.NoError(t, m.Bootstrap("root", "111"))
+	require.NoError(t, m.CreateUsers([]Account{{Name: "u1", Host: "%"}, {Name: "u1", Host: "10.0.0.%"}}, false))
+	require.Error(t, m.CreateUsers([]Account{{Name: "u2", Host: "%"}, {Name: "u1", Host: "%"}}, false))
+	_, ok := m.Lookup("u2", "10.0.0.1")
+	require.False(t, ok)
+
+	// the most specific host is preferred
+	u, ok := m.Lookup("u1", "10.0.0.1")
+	require.True(t, ok)
+	require.Equal(t, "10.0.0.%", u.Host)
+	u, ok = m.Lookup("u1", "10.0.1.1")
+	require.True(t, ok)
+	require.Equal(t, "%", u.Host)
+
+	c := m.NewChecker("u1", "%")
+	require.NoError(t, m.Grant([]Account{{Name: "u1", Host: "%"}}, Grant{Level: Database, Db: "db", Privs: Select}))
+	require.NoError(t, m.Grant([]Account{{Name: "u1", Host: "%"}}, Grant{Level: Table, Db: "db2", Table: "t", Privs: Insert}))
+	require.NoError(t, c.CheckTable(Select, "db", "t"))
+	require.NoError(t, c.CheckTable(Insert, "db2", "t"))
+	require.NoError(t, c.CheckAny("db2"))
+	require.Error(t, c.CheckTable(Select, "db2", "t"))
+	require.Error(t, c.CheckDatabase(Select, "db2"))
+	require.Error(t, c.CheckGlobal(Select))
+	require.Error(t, c.CheckAny("db3"))
+
+	err = c.CheckTable(Select|Insert, "db", "t")
+	require.Equal(t, uint16(ErrTableAccessDenied), err.(*Error).Code)
+	require.Equal(t, "INSERT command denied to user 'u1'@'%' for table 't'", err.Error())
+
+	require.NoError(t, m.Revoke([]Account{{Name: "u1", Host: "%"}}, Grant{Level: Database, Db: "db", Privs: Select}))
+	require.Error(t, c.CheckTable(Select, "db", "t"))
+	require.Error(t, m.Revoke([]Account{{Name: "u1", Host: "%"}}, Grant{Level: Database, Db: "db", Privs: Select}))
+
+	// the accounts are loaded from storage
+	m, err = New(s)
+	require.NoError(t, err)
+	u, ok = m.Lookup("root", "10.0.0.1")
+	require.True(t, ok)
+	require.Equal(t, EncodePassword("111"), u.AuthString)
+	require.NoError(t, m.NewChecker("root", "%").CheckGlobal(GlobalPrivileges|GrantOption))
+	require.NoError(t, m.NewChecker("u1", "%").CheckTable(Insert, "db2", "t"))
+
+	require.NoError(t, m.DropUsers([]Account{{Name: "u1", Host: "%"}}, false))
+	require.Error(t, m.DropUsers([]Account{{Name: "u1", Host: "%"}}, false))
+	require.Len(t, s.users, 2)
+
+	// privilege checking is disabled by nil checker
+	var nc *Checker
+	require.NoError(t, nc.CheckGlobal(CreateUser))
+	require.Nil(t, nc.Manager())
+}
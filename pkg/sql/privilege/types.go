@@ -0,0 +1,114 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package privilege
+
+import "sync"
+
+// Type is a set of privileges.
+type Type uint32
+
+const (
+	Select Type = 1 << iota
+	Insert
+	Update
+	Delete
+	Create
+	Drop
+	Alter
+	Index
+	CreateUser
+	GrantOption
+	Super
+	File
+)
+
+const (
+	// TablePrivileges is the privileges which can be granted at all levels.
+	TablePrivileges = Select | Insert | Update | Delete | Create | Drop | Alter | Index
+	// GlobalPrivileges is the privileges which can be granted at global level.
+	GlobalPrivileges = TablePrivileges | CreateUser | Super | File
+)
+
+// Level is the level which privileges are granted at.
+type Level int8
+
+const (
+	Global   Level = iota // *.*
+	Database              // db.*
+	Table                 // db.tbl
+)
+
+// Grant is the privileges granted to an account at a level.
+type Grant struct {
+	Level Level  `json:"level"`
+	Db    string `json:"db"`
+	Table string `json:"table"`
+	Privs Type   `json:"privs"`
+}
+
+// User is an account identified by 'Name'@'Host'.
+type User struct {
+	Name string `json:"name"`
+	// Host is the host pattern which the user can connect from, '%' and '_' are wildcards.
+	Host string `json:"host"`
+	// AuthString is the hashed password as mysql_native_password, it is empty if the user has no password.
+	AuthString string `json:"auth_string"`
+	// ScramVerifier is the SCRAM-SHA-256 verifier of the password for the PostgreSQL clients,
+	// it is empty if the password is given as a hash.
+	ScramVerifier string  `json:"scram_verifier,omitempty"`
+	Grants        []Grant `json:"grants"`
+}
+
+// Account is an account specified in statements.
+type Account struct {
+	Name string
+	Host string
+	// AuthString is the hashed password, it is used only if Auth is true.
+	AuthString string
+	// ScramVerifier is the SCRAM-SHA-256 verifier, it is used only if Auth is true.
+	ScramVerifier string
+	Auth          bool
+}
+
+// Storage persists the accounts.
+type Storage interface {
+	ListUsers() ([]*User, error)
+	SetUser(*User) error
+	DeleteUser(name, host string) error
+}
+
+// Manager manages accounts and their privileges,
+// all changes are written to storage before taking effect.
+type Manager struct {
+	sync.RWMutex
+	s     Storage
+	users map[string]*User // key is 'name'@'host'
+}
+
+// Checker checks the privileges of the account who runs statements,
+// a nil checker means that privilege checking is disabled.
+type Checker struct {
+	m    *Manager
+	name string
+	host string
+}
+
+// Error is the error returned by privilege checking and account management,
+// it carries the mysql error code.
+type Error struct {
+	Code  uint16
+	State string
+	Msg   string
+}
@@ -57,6 +57,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
 )
 
 func init() {
@@ -85,6 +86,9 @@ func init() {
 
 	gob.Register(types.Date(0))
 	gob.Register(types.Datetime(0))
+	gob.Register(types.Decimal{})
+
+	spill.Register(EncodeBatch, DecodeBatch)
 }
 
 func EncodeScope(s Scope, buf *bytes.Buffer) error {
@@ -221,7 +225,10 @@ func EncodeInstruction(in vm.Instruction, buf *bytes.Buffer) error {
 	case vm.Join:
 		arg := in.Arg.(*join.Argument)
 		data, err := encoding.Encode(JoinArgument{
+			Type:   arg.Type,
 			Vars:   arg.Vars,
+			Fact:   arg.Fact,
+			Views:  arg.Views,
 			Result: arg.Result,
 		})
 		if err != nil {
@@ -233,7 +240,9 @@ func EncodeInstruction(in vm.Instruction, buf *bytes.Buffer) error {
 	case vm.Times:
 		arg := in.Arg.(*times.Argument)
 		data, err := encoding.Encode(TimesArgument{
+			Type:   arg.Type,
 			Vars:   arg.Vars,
+			Views:  arg.Views,
 			Result: arg.Result,
 		})
 		if err != nil {
@@ -424,7 +433,10 @@ func DecodeInstruction(data []byte) (vm.Instruction, []byte, error) {
 			return in, nil, err
 		}
 		joinArg := &join.Argument{
+			Type:   arg.Type,
 			Vars:   arg.Vars,
+			Fact:   arg.Fact,
+			Views:  arg.Views,
 			Result: arg.Result,
 		}
 		data = data[n:]
@@ -439,7 +451,9 @@ func DecodeInstruction(data []byte) (vm.Instruction, []byte, error) {
 			return in, nil, err
 		}
 		timesArg := &times.Argument{
+			Type:   arg.Type,
 			Vars:   arg.Vars,
+			Views:  arg.Views,
 			Result: arg.Result,
 		}
 		data = data[n:]
@@ -658,6 +672,32 @@ func EncodeExtend(e extend.Extend, buf *bytes.Buffer) error {
 	case *extend.StarExtend:
 		buf.WriteByte(Star)
 		return nil
+	case *extend.CaseExtend:
+		buf.WriteByte(Case)
+		buf.Write(encoding.EncodeUint32(uint32(len(v.Conds))))
+		for i, cond := range v.Conds {
+			if err := EncodeExtend(cond, buf); err != nil {
+				return err
+			}
+			if err := EncodeExtend(v.Ress[i], buf); err != nil {
+				return err
+			}
+		}
+		return EncodeExtend(v.Else, buf)
+	case *extend.CoalesceExtend:
+		buf.WriteByte(Coalesce)
+		return encodeExtends(v.Args, buf)
+	case *extend.InExtend:
+		buf.WriteByte(In)
+		if v.Not {
+			buf.WriteByte(1)
+		} else {
+			buf.WriteByte(0)
+		}
+		if err := EncodeExtend(v.E, buf); err != nil {
+			return err
+		}
+		return encodeExtends(v.Vs, buf)
 	case *extend.ValueExtend:
 		buf.WriteByte(Value)
 		return EncodeVector(v.V, buf)
@@ -667,6 +707,9 @@ func EncodeExtend(e extend.Extend, buf *bytes.Buffer) error {
 		buf.Write(encoding.EncodeUint32(uint32(len(v.Name))))
 		buf.WriteString(v.Name)
 		return nil
+	case nil: // null result of conditional extends
+		buf.WriteByte(Null)
+		return nil
 	}
 	return fmt.Errorf("'%v' not yet support", e)
 }
@@ -754,6 +797,52 @@ func DecodeExtend(data []byte) (extend.Extend, []byte, error) {
 	case Star:
 		e := new(extend.StarExtend)
 		return e, data, nil
+	case Case:
+		e := new(extend.CaseExtend)
+		data = data[1:]
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		for i := uint32(0); i < n; i++ {
+			cond, d, err := DecodeExtend(data)
+			if err != nil {
+				return nil, nil, err
+			}
+			res, d, err := DecodeExtend(d)
+			if err != nil {
+				return nil, nil, err
+			}
+			e.Conds = append(e.Conds, cond)
+			e.Ress = append(e.Ress, res)
+			data = d
+		}
+		ext, data, err := DecodeExtend(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		e.Else = ext
+		return e, data, nil
+	case Coalesce:
+		e := new(extend.CoalesceExtend)
+		args, data, err := decodeExtends(data[1:])
+		if err != nil {
+			return nil, nil, err
+		}
+		e.Args = args
+		return e, data, nil
+	case In:
+		e := new(extend.InExtend)
+		e.Not = data[1] == 1
+		ext, data, err := DecodeExtend(data[2:])
+		if err != nil {
+			return nil, nil, err
+		}
+		e.E = ext
+		if e.Vs, data, err = decodeExtends(data); err != nil {
+			return nil, nil, err
+		}
+		return e, data, nil
+	case Null:
+		return nil, data[1:], nil
 	case Value:
 		e := new(extend.ValueExtend)
 		data = data[1:]
@@ -780,6 +869,31 @@ func DecodeExtend(data []byte) (extend.Extend, []byte, error) {
 	return nil, nil, fmt.Errorf("'%v' extend not yet support", data[0])
 }
 
+func encodeExtends(es []extend.Extend, buf *bytes.Buffer) error {
+	buf.Write(encoding.EncodeUint32(uint32(len(es))))
+	for _, e := range es {
+		if err := EncodeExtend(e, buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func decodeExtends(data []byte) ([]extend.Extend, []byte, error) {
+	n := encoding.DecodeUint32(data[:4])
+	data = data[4:]
+	es := make([]extend.Extend, 0, n)
+	for i := uint32(0); i < n; i++ {
+		e, d, err := DecodeExtend(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		es = append(es, e)
+		data = d
+	}
+	return es, data, nil
+}
+
 func EncodeBatch(bat *batch.Batch, buf *bytes.Buffer) error {
 	// SelsData
 	buf.Write(encoding.EncodeUint32(uint32(len(bat.SelsData))))
@@ -4143,6 +4257,23 @@ func EncodeVector(v *vector.Vector, buf *bytes.Buffer) error {
 		buf.Write(encoding.EncodeUint64(v.Link))
 		buf.Write(encoding.EncodeUint32(uint32(len(v.Data))))
 		buf.Write(v.Data)
+	case types.T_decimal:
+		buf.Write(encoding.EncodeType(v.Typ))
+		buf.Write(encoding.EncodeUint64(v.Ref))
+		nb, err := v.Nsp.Show()
+		if err != nil {
+			return err
+		}
+		buf.Write(encoding.EncodeUint32(uint32(len(nb))))
+		if len(nb) > 0 {
+			buf.Write(nb)
+		}
+		vs := v.Col.([]types.Decimal)
+		buf.Write(encoding.EncodeUint32(uint32(len(vs))))
+		buf.Write(encoding.EncodeDecimalSlice(vs))
+		buf.Write(encoding.EncodeUint64(v.Link))
+		buf.Write(encoding.EncodeUint32(uint32(len(v.Data))))
+		buf.Write(v.Data)
 	default:
 		return fmt.Errorf("unsupport vector type '%s'", v.Typ)
 	}
@@ -4563,6 +4694,34 @@ func DecodeVector(data []byte) (*vector.Vector, []byte, error) {
 		v.Data = data[:n]
 		data = data[n:]
 		return v, data, nil
+	case types.T_decimal:
+		v := vector.New(typ)
+		v.Or = true
+		v.Ref = encoding.DecodeUint64(data[:8])
+		data = data[8:]
+		if n := encoding.DecodeUint32(data[:4]); n > 0 {
+			data = data[4:]
+			if err := v.Nsp.Read(data[:n]); err != nil {
+				return nil, nil, err
+			}
+			data = data[n:]
+		} else {
+			data = data[4:]
+		}
+		if n := encoding.DecodeUint32(data[:4]); n > 0 {
+			data = data[4:]
+			v.Col = encoding.DecodeDecimalSlice(data[:n*16])
+			data = data[n*16:]
+		} else {
+			data = data[4:]
+		}
+		v.Link = encoding.DecodeUint64(data[:8])
+		data = data[8:]
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		v.Data = data[:n]
+		data = data[n:]
+		return v, data, nil
 	}
 	return nil, nil, fmt.Errorf("unsupport vector type '%s'", typ)
 }
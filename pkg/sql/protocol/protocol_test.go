@@ -393,6 +393,22 @@ func TestExtend(t *testing.T) {
 			Name: "attribute",
 			Type: types.T_varchar,
 		},
+		&extend.CaseExtend{
+			Conds: []extend.Extend{&extend.FuncExtend{Name: "Case Cond"}},
+			Ress:  []extend.Extend{nil},
+			Else:  &extend.FuncExtend{Name: "Case Else"},
+		},
+		&extend.CoalesceExtend{
+			Args: []extend.Extend{
+				&extend.FuncExtend{Name: "Coalesce Extend"},
+				&extend.ValueExtend{V: NewFloatVector(1.2)},
+			},
+		},
+		&extend.InExtend{
+			Not: true,
+			E:   &extend.FuncExtend{Name: "In Extend"},
+			Vs:  []extend.Extend{&extend.ValueExtend{V: NewFloatVector(1.2)}},
+		},
 	}
 	for _, e := range extendArray {
 		var buf bytes.Buffer
@@ -470,6 +486,28 @@ func TestExtend(t *testing.T) {
 				t.Error("Decode extend Type failed.")
 				return
 			}
+		case *extend.CaseExtend:
+			actualE := e.(*extend.CaseExtend)
+			if len(expectE.Conds) != len(actualE.Conds) || expectE.Ress[0] != nil {
+				t.Error("Decode extend Conds failed.")
+				return
+			}
+			if expectE.Else.(*extend.FuncExtend).Name != actualE.Else.(*extend.FuncExtend).Name {
+				t.Error("Decode extend Else failed.")
+				return
+			}
+		case *extend.CoalesceExtend:
+			actualE := e.(*extend.CoalesceExtend)
+			if expectE.Args[0].(*extend.FuncExtend).Name != actualE.Args[0].(*extend.FuncExtend).Name {
+				t.Error("Decode extend Args failed.")
+				return
+			}
+		case *extend.InExtend:
+			actualE := e.(*extend.InExtend)
+			if expectE.Not != actualE.Not || len(expectE.Vs) != len(actualE.Vs) {
+				t.Error("Decode extend Vs failed.")
+				return
+			}
 		}
 	}
 }
@@ -17,6 +17,8 @@ package protocol
 import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/projection"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/restrict"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/join"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/times"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transform"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transformer"
 	"github.com/matrixorigin/matrixone/pkg/vm"
@@ -31,6 +33,10 @@ const (
 	Func
 	Star
 	Value
+	Case
+	Coalesce
+	In
+	Null
 )
 
 const (
@@ -237,13 +243,18 @@ func UntransferTransformArg(arg TransformArgument) *transform.Argument {
 }
 
 type JoinArgument struct {
+	Type   int
 	Result []string
 	Vars   [][]string
+	Fact   join.Schema
+	Views  []join.Schema
 }
 
 type TimesArgument struct {
+	Type   int
 	Result []string
 	Vars   [][]string
+	Views  []times.Schema
 }
 
 type UntransformArgument struct {
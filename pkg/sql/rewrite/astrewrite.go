@@ -16,7 +16,11 @@ package rewrite
 
 import (
 	"go/constant"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 
@@ -26,6 +30,14 @@ var logicalBinaryOps = map[tree.BinaryOp]struct{}{
 	tree.BIT_XOR: {},
 }
 
+// conditionalFuncs are the functions whose results are values rather than logical results.
+var conditionalFuncs = map[string]struct{}{
+	"if":       {},
+	"ifnull":   {},
+	"coalesce": {},
+	"nullif":   {},
+}
+
 var logicalComparisonOps = map[tree.ComparisonOp]struct{}{
 	tree.EQUAL:            {},
 	tree.LESS_THAN:        {},
@@ -37,6 +49,8 @@ var logicalComparisonOps = map[tree.ComparisonOp]struct{}{
 	tree.NOT_IN:           {},
 	tree.LIKE:             {},
 	tree.NOT_LIKE:         {},
+	tree.ILIKE:            {},
+	tree.NOT_ILIKE:        {},
 }
 
 // AstRewrite do sql rewrite before plan build.
@@ -47,7 +61,19 @@ var logicalComparisonOps = map[tree.ComparisonOp]struct{}{
 // (1) rewrite `select ... where expr` to `select ... where expr != 0`
 // (2) rewrite `select ... where not expr`  to `select ... where expr == 0`
 // case 2:  normal view sql rewrite work ( not Materialized View)
+// case 3:  rewrite `date 'x' +/- interval 'n' unit` in filter condition to be a string constant.
 // Tips: expr contains castExpr, unresolvedName, constant
+// selectRewrite rewrites the operand of union clause.
+func selectRewrite(stmt tree.SelectStatement) tree.SelectStatement {
+	switch t := stmt.(type) {
+	case *tree.ParenSelect:
+		t.Select = AstRewrite(t.Select).(*tree.Select)
+	case *tree.SelectClause, *tree.UnionClause:
+		AstRewrite(&tree.Select{Select: t})
+	}
+	return stmt
+}
+
 func AstRewrite(stmt tree.Statement) tree.Statement {
 	// rewrite all filter condition inside AST.
 	// rewrite select statement.
@@ -55,7 +81,7 @@ func AstRewrite(stmt tree.Statement) tree.Statement {
 	case *tree.Select:
 		switch t := st.Select.(type) {
 		case *tree.UnionClause:
-			t.Left, t.Right = AstRewrite(t.Left), AstRewrite(t.Right)
+			t.Left, t.Right = selectRewrite(t.Left), selectRewrite(t.Right)
 		case *tree.SelectClause:
 			if t.Where != nil {
 				t.Where.Expr = rewriteFilterCondition(t.Where.Expr)
@@ -70,6 +96,13 @@ func AstRewrite(stmt tree.Statement) tree.Statement {
 		}
 		return st
 	}
+	// rewrite the statement of explain.
+	switch st := stmt.(type) {
+	case *tree.ExplainStmt:
+		st.Statement = AstRewrite(st.Statement)
+	case *tree.ExplainAnalyze:
+		st.Statement = AstRewrite(st.Statement)
+	}
 	// rewrite insert statement.
 	// rewrite update statement.
 	// rewrite delete statement.
@@ -105,10 +138,19 @@ func rewriteFilterCondition(expr tree.Expr) tree.Expr {
 		if notExpr, ok := t.Expr.(*tree.NotExpr); ok {
 			return tree.NewNotExpr(rewriteFilterCondition(notExpr))
 		}
+		if subquery, ok := t.Expr.(*tree.Subquery); ok {
+			return tree.NewNotExpr(subqueryRewrite(subquery))
+		}
 		return tree.NewComparisonExpr(tree.EQUAL, t.Expr, tree.NewNumVal(constant.MakeInt64(0), "0", false))
 	// rewrite to != 0
-	case *tree.UnresolvedName, *tree.NumVal, *tree.CastExpr, *tree.UnaryExpr:
+	case *tree.UnresolvedName, *tree.NumVal, *tree.CastExpr, *tree.UnaryExpr, *tree.CaseExpr:
 		return tree.NewComparisonExpr(tree.NOT_EQUAL, t, tree.NewNumVal(constant.MakeInt64(0), "0", false))
+	case *tree.FuncExpr:
+		if name, ok := t.Func.FunctionReference.(*tree.UnresolvedName); ok {
+			if _, ok := conditionalFuncs[strings.ToLower(name.Parts[0])]; ok {
+				return tree.NewComparisonExpr(tree.NOT_EQUAL, t, tree.NewNumVal(constant.MakeInt64(0), "0", false))
+			}
+		}
 	case *tree.BinaryExpr:
 		if !isLogicalBinaryOp(t.Op) {
 			return tree.NewComparisonExpr(tree.NOT_EQUAL, t, tree.NewNumVal(constant.MakeInt64(0), "0", false))
@@ -116,7 +158,18 @@ func rewriteFilterCondition(expr tree.Expr) tree.Expr {
 		// rewrite in operator
 		// where a in (1, 2)		----> where a = 1 or a = 2
 		// where a not in (1, 2)	----> where a != 1 and a != 2
+	case *tree.Subquery:
+		return subqueryRewrite(t)
+	case *tree.RangeCond:
+		t.From, t.To = dateRewrite(t.From), dateRewrite(t.To)
 	case *tree.ComparisonExpr:
+		t.Left, t.Right = dateRewrite(t.Left), dateRewrite(t.Right)
+		if subquery, ok := t.Left.(*tree.Subquery); ok {
+			t.Left = subqueryRewrite(subquery)
+		}
+		if subquery, ok := t.Right.(*tree.Subquery); ok {
+			t.Right = subqueryRewrite(subquery)
+		}
 		if t.Op == tree.IN {
 			if tuple, ok := t.Right.(*tree.Tuple); ok {
 				if len(tuple.Exprs) == 1 {
@@ -165,13 +218,138 @@ func subTableRewrite(t tree.TableExpr) tree.TableExpr {
 		}
 		if subQuery, okk := subTable.Left.(*tree.Subquery); okk {
 			subTable.Left = AstRewrite(subQuery)
+		} else {
+			subTable.Left = subTableRewrite(subTable.Left)
 		}
 		if subQuery, okk := subTable.Right.(*tree.Subquery); okk {
 			subTable.Right = AstRewrite(subQuery)
+		} else {
+			subTable.Right = subTableRewrite(subTable.Right)
 		}
+	case *tree.AliasedTableExpr:
+		subTable.Expr = subTableRewrite(subTable.Expr)
 	case *tree.ParenTableExpr:
-		subTable = tree.NewParenTableExpr(subTableRewrite(subTable.Expr))
+		if sel, ok := subTable.Expr.(*tree.Select); ok { // derived table
+			AstRewrite(sel)
+		} else {
+			subTable.Expr = subTableRewrite(subTable.Expr)
+		}
 	case *tree.UnresolvedName: // Do nothing.
 	}
 	return t
 }
+
+// subqueryRewrite rewrites the select statement of subquery in filter condition.
+func subqueryRewrite(t *tree.Subquery) *tree.Subquery {
+	switch sel := t.Select.(type) {
+	case *tree.ParenSelect:
+		AstRewrite(sel.Select)
+	case *tree.Select:
+		AstRewrite(sel)
+	}
+	return t
+}
+
+// dateRewrite rewrites `date 'x'` and `date 'x' +/- interval 'n' unit` to be
+// a string constant, the expression is returned if it cannot be folded.
+func dateRewrite(expr tree.Expr) tree.Expr {
+	if d, ok := foldDate(expr); ok {
+		s := d.String()
+		return tree.NewNumVal(constant.MakeString(s), s, false)
+	}
+	return expr
+}
+
+func foldDate(expr tree.Expr) (types.Date, bool) {
+	switch t := expr.(type) {
+	case *tree.ParenExpr:
+		return foldDate(t.Expr)
+	case *tree.FuncExpr:
+		name, ok := t.Func.FunctionReference.(*tree.UnresolvedName)
+		if !ok || strings.ToLower(name.Parts[0]) != "date" || len(t.Exprs) != 1 {
+			return 0, false
+		}
+		v, ok := t.Exprs[0].(*tree.NumVal)
+		if !ok || v.Value.Kind() != constant.String {
+			return 0, false
+		}
+		d, err := types.ParseDate(constant.StringVal(v.Value))
+		if err != nil {
+			return 0, false
+		}
+		return d, true
+	case *tree.BinaryExpr:
+		if t.Op != tree.PLUS && t.Op != tree.MINUS {
+			return 0, false
+		}
+		interval, ok := t.Right.(*tree.IntervalExpr)
+		if !ok {
+			return 0, false
+		}
+		d, ok := foldDate(t.Left)
+		if !ok {
+			return 0, false
+		}
+		n, typ, ok := intervalValue(interval)
+		if !ok {
+			return 0, false
+		}
+		if t.Op == tree.MINUS {
+			n = -n
+		}
+		return addInterval(d, n, typ)
+	}
+	return 0, false
+}
+
+// intervalValue returns the number and the unit of the interval, the unit
+// is in the string if the interval has no unit, such as interval '1 year'.
+func intervalValue(interval *tree.IntervalExpr) (int64, tree.IntervalType, bool) {
+	v, ok := interval.Expr.(*tree.NumVal)
+	if !ok {
+		return 0, 0, false
+	}
+	switch v.Value.Kind() {
+	case constant.Int:
+		n, ok := constant.Int64Val(v.Value)
+		return n, interval.Type, ok
+	case constant.String:
+		s, typ := constant.StringVal(v.Value), interval.Type
+		if typ == tree.INTERVAL_TYPE_INVALID {
+			if s, typ, ok = tree.SplitInterval(s); !ok {
+				return 0, 0, false
+			}
+		}
+		n, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+		return n, typ, err == nil
+	}
+	return 0, 0, false
+}
+
+// addInterval adds n units to the date, the day is clipped to the last day of
+// the month as MySQL does.
+func addInterval(d types.Date, n int64, typ tree.IntervalType) (types.Date, bool) {
+	var months int64
+
+	switch typ {
+	case tree.INTERVAL_TYPE_DAY:
+		return d + types.Date(n), true
+	case tree.INTERVAL_TYPE_WEEK:
+		return d + types.Date(7*n), true
+	case tree.INTERVAL_TYPE_MONTH:
+		months = n
+	case tree.INTERVAL_TYPE_QUARTER:
+		months = 3 * n
+	case tree.INTERVAL_TYPE_YEAR:
+		months = 12 * n
+	default:
+		return 0, false
+	}
+	year, month, day, _ := d.Calendar(true)
+	total := int64(year)*12 + int64(month) - 1 + months
+	year, month = int32(total/12), uint8(total%12+1)
+	if last := uint8(time.Date(int(year), time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day()); day > last {
+		day = last
+	}
+	return types.FromCalendar(year, month, day), true
+}
@@ -57,6 +57,18 @@ func rewriteExpr(n tree.Expr) tree.Expr {
 		e.Left = rewriteExpr(e.Left)
 		e.Right = rewriteExpr(e.Right)
 		return e
+	case *tree.CaseExpr:
+		if e.Expr != nil {
+			e.Expr = rewriteExpr(e.Expr)
+		}
+		for _, w := range e.Whens {
+			w.Cond = rewriteExpr(w.Cond)
+			w.Val = rewriteExpr(w.Val)
+		}
+		if e.Else != nil {
+			e.Else = rewriteExpr(e.Else)
+		}
+		return e
 	case *tree.Tuple:
 	case *tree.FuncExpr:
 		if name, ok := e.Func.FunctionReference.(*tree.UnresolvedName); ok {
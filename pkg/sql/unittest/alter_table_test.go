@@ -0,0 +1,73 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unittest
+
+import "testing"
+
+// TestAlterTable checks add, drop and rename of columns and tables
+func TestAlterTable(t *testing.T) {
+	testCases := []testCase{
+		{sql: "create table alt1 (a int, b int);"},
+		{sql: "insert into alt1 values (1, 2), (3, 4);"},
+		{sql: "alter table alt1 add column c int default 5;"},
+		{sql: "select * from alt1;", res: executeResult{
+			attr: []string{"a", "b", "c"},
+			data: [][]string{
+				{"1", "2", "5"},
+				{"3", "4", "5"},
+			},
+		}},
+		{sql: "insert into alt1 values (5, 6, 7);"},
+		{sql: "alter table alt1 rename column b to d, alter column c drop default;"},
+		{sql: "insert into alt1 (a, d) values (8, 9);", err: "[42611]Field 'c' doesn't have a default value"},
+		{sql: "insert into alt1 values (8, 9, null);"},
+		{sql: "select a, d, c from alt1;", res: executeResult{
+			attr: []string{"a", "d", "c"},
+			data: [][]string{
+				{"1", "2", "5"},
+				{"3", "4", "5"},
+				{"5", "6", "7"},
+				{"8", "9", "null"},
+			},
+		}},
+		{sql: "alter table alt1 drop column d;"},
+		{sql: "alter table alt1 rename to alt2;"},
+		{sql: "select * from alt2;", res: executeResult{
+			attr: []string{"a", "c"},
+			data: [][]string{
+				{"1", "5"},
+				{"3", "5"},
+				{"5", "7"},
+				{"8", "null"},
+			},
+		}},
+		{sql: "select * from alt1;", err: "[42000]not exist"},
+		{sql: "alter table alt2 add column a int;", err: "[42701]Duplicate column name 'a'"},
+		{sql: "alter table alt2 drop column e;", err: "[42703]Can't DROP 'e'; check that column/key exists"},
+		{sql: "alter table alt2 drop column a, drop column c;", err: "[42P16]You can't delete all columns with ALTER TABLE; use DROP TABLE instead"},
+		{sql: "alter table alt2 rename column e to f;", err: "[42703]Unknown column 'e' in 'alt2'"},
+		{sql: "alter table alt2 rename column c to e, add column e int;", err: "[42701]Duplicate column name 'e'"},
+		{sql: "select * from alt2;", res: executeResult{
+			attr: []string{"a", "c"},
+			data: [][]string{
+				{"1", "5"},
+				{"3", "5"},
+				{"5", "7"},
+				{"8", "null"},
+			},
+		}},
+	}
+	test(t, testCases)
+}
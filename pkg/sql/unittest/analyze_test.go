@@ -0,0 +1,41 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unittest
+
+import "testing"
+
+// TestAnalyze checks statistics collection and that joins over analyzed tables still work
+func TestAnalyze(t *testing.T) {
+	testCases := []testCase{
+		{sql: "create table ana1 (a int, b varchar(10));"},
+		{sql: "create table ana2 (a int, c double);"},
+		{sql: "insert into ana1 values (1, 'x'), (2, 'y'), (3, null), (3, 'z');"},
+		{sql: "insert into ana2 values (1, 1.5), (3, 2.5), (4, null);"},
+		{sql: "analyze table ana1(a, b);"},
+		{sql: "analyze table ana2(a, c);"},
+		{sql: "analyze table ana2(a);"},
+		{sql: "select ana1.a, ana2.c from ana1, ana2 where ana1.a = ana2.a and ana2.c > 2 order by ana1.a limit 10;", res: executeResult{
+			attr: []string{"ana1.a", "ana2.c"},
+			data: [][]string{
+				{"3", "2.500000"},
+				{"3", "2.500000"},
+			},
+		}},
+		{sql: "analyze table ana1(d);", err: "[42703]Unknown column 'd' in 'ana1'"},
+		{sql: "alter table ana1 rename column b to e;"},
+		{sql: "analyze table ana1(e);"},
+	}
+	test(t, testCases)
+}
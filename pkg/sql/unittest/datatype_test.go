@@ -333,3 +333,90 @@ func TestDatetimeComparison(t *testing.T) {
 	}
 	test(t, testCases)
 }
+
+// TestDecimalType will do test for Type decimal
+func TestDecimalType(t *testing.T) {
+	testCases := []testCase{
+		{sql: "create table tdec (a decimal(10, 2), b numeric(5));"},
+		{sql: "create table tdecdef (a decimal(6, 3) default 1.5);"},
+		{sql: "create table tdec2 (a decimal(10, 2));"},
+		{sql: "insert into tdec values (12.345, 7), (-0.005, -12.5), ('3.14159', '99999'), (100, null);"},
+		{sql: "insert into tdecdef values ();"},
+
+		{sql: "select * from tdec;", res: executeResult{
+			attr: []string{"a", "b"},
+			data: [][]string{
+				{"12.35", "7"}, {"-0.01", "-13"}, {"3.14", "99999"}, {"100.00", "null"},
+			},
+		}},
+		{sql: "select * from tdecdef;", res: executeResult{
+			attr: []string{"a"},
+			data: [][]string{
+				{"1.500"},
+			},
+		}},
+		{sql: "insert into tdec2 values (123456789.1);", err: "[22000]Decimal value is out of range"},
+		{sql: "insert into tdec2 values ('abc');", err: "[22000]Incorrect decimal value"},
+		{sql: "create table tdec3 (a decimal(40, 2));", err: "[0A000]Too-big precision 40 specified for decimal. Maximum is 38."},
+	}
+	test(t, testCases)
+}
+
+// TestDecimalOperator tests arithmetic, comparison and aggregation of decimal
+func TestDecimalOperator(t *testing.T) {
+	testCases := []testCase{
+		{sql: "create table tdec (a decimal(10, 2), b decimal(6, 3), c int);"},
+		{sql: "insert into tdec values (1.25, 0.5, 2), (-3.10, 2.125, 3), (10.00, -1, 4), (null, 1, 5);"},
+
+		{sql: "select a + b, a - b, a * b, a / b from tdec;", res: executeResult{
+			attr: []string{"a + b", "a - b", "a * b", "a / b"},
+			data: [][]string{
+				{"1.750", "0.750", "0.62500", "2.500000"},
+				{"-0.975", "-5.225", "-6.58750", "-1.458824"},
+				{"9.000", "11.000", "-10.00000", "-10.000000"},
+				{"null", "null", "null", "null"},
+			},
+		}},
+		{sql: "select a + c, c * a, a * 1.5 from tdec;", res: executeResult{
+			attr: []string{"a + c", "c * a", "a * 1.5"},
+			data: [][]string{
+				{"3.25", "2.50", "1.875"},
+				{"-0.10", "-9.30", "-4.650"},
+				{"14.00", "40.00", "15.000"},
+				{"null", "null", "null"},
+			},
+		}},
+		{sql: "select -a from tdec where a > 1.25;", res: executeResult{
+			attr: []string{"-a"},
+			data: [][]string{
+				{"-10.00"},
+			},
+		}},
+		{sql: "select a from tdec where a = b * -10;", res: executeResult{
+			attr: []string{"a"},
+			data: [][]string{
+				{"10.00"},
+			},
+		}},
+		{sql: "select a from tdec where a < c;", res: executeResult{
+			attr: []string{"a"},
+			data: [][]string{
+				{"1.25"}, {"-3.10"},
+			},
+		}},
+		{sql: "select sum(a), max(a), min(b), count(a) from tdec;", res: executeResult{
+			attr: []string{"sum(a)", "max(a)", "min(b)", "count(a)"},
+			data: [][]string{
+				{"8.15", "10.00", "-1.000", "3"},
+			},
+		}},
+		{sql: "select cast(a as signed), cast(b as char(10)), cast(c as decimal(8, 2)) from tdec limit 1;", res: executeResult{
+			attr: []string{"cast(a as signed)", "cast(b as char(10))", "cast(c as decimal(8, 2))"},
+			data: [][]string{
+				{"1", "0.500", "2.00"},
+			},
+		}},
+		{sql: "select a / 0 from tdec;", err: "[42000]division by zero"},
+	}
+	test(t, testCases)
+}
@@ -91,19 +91,27 @@ func TestIndexFunction(t *testing.T) {
 	test(t, testCases)
 }
 
-// Do not support this unit test now, because it for tpe engine which has supported deletion and other engine do not support.
 // TestDeleteFunction is only used to check if the whole process about deletion can be run through
-//func TestDeleteFunction(t *testing.T) {
-//	testCases := []testCase{
-//		{sql: "create table t1 (a int, b int);"},
-//		{sql: "insert into t1 values (1, 2), (3, 4), (5, 6);"},
-//		{sql: "delete from t1 where a > 1;"},
-//		{sql: "delete from t1 where a > 1 order by b;"},
-//		{sql: "delete from t1 where a > 1 limit 1;"},
-//		{sql: "delete from t1 where a > 1 order by a limit 1;"},
-//		{sql: "delete from t1 order by a;"},
-//		{sql: "delete from t1 order by a limit 1;"},
-//		{sql: "delete from t1 limit 1;"},
-//	}
-//	test(t, testCases)
-//}
\ No newline at end of file
+func TestDeleteFunction(t *testing.T) {
+	testCases := []testCase{
+		{sql: "create table t1 (a int, b int);"},
+		{sql: "insert into t1 values (1, 2), (3, 4), (5, 6);"},
+		{sql: "delete from t1 where a > 1;"},
+		{sql: "delete from t1 where a > 1 order by b;"},
+		{sql: "delete from t1 where a > 1 limit 1;"},
+		{sql: "delete from t1 where a > 1 order by a limit 1;"},
+		{sql: "delete from t1 order by a;"},
+		{sql: "delete from t1 order by a limit 1;"},
+		{sql: "delete from t1 limit 1;"},
+		{sql: "insert into t1 values (1, 2), (3, 4), (5, 6);"},
+		{sql: "delete from t1 where a = 3;"},
+		{sql: "select * from t1;", res: executeResult{
+			attr: []string{"a", "b"},
+			data: [][]string{
+				{"1", "2"},
+				{"5", "6"},
+			},
+		}},
+	}
+	test(t, testCases)
+}
@@ -14,7 +14,15 @@
 
 package unittest
 
-import "testing"
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/stretchr/testify/require"
+)
 
 // TestInsertFunction to make sure insert and select work can run correctly
 func TestInsertAndSelectFunction(t *testing.T) {
@@ -247,6 +255,72 @@ func TestInsertAndSelectFunction(t *testing.T) {
 	test(t, testCases)
 }
 
+// TestUpdateFunction to make sure update work can run correctly
+func TestUpdateFunction(t *testing.T) {
+	testCases := []testCase{
+		{sql: "create table upd (a int, b varchar(10), c double default 1.5);"},
+		{sql: "insert into upd values (1, 'a', 1.1), (2, 'b', 2.2), (3, 'c', 3.3);"},
+		{sql: "update upd set a = a + 10 where a > 1;"},
+		{sql: "select * from upd order by a limit 10;", res: executeResult{
+			attr: []string{"a", "b", "c"},
+			data: [][]string{
+				{"1", "a", "1.100000"},
+				{"12", "b", "2.200000"},
+				{"13", "c", "3.300000"},
+			},
+		}},
+		{sql: "update upd set b = 'x', c = default where a = 1;"},
+		{sql: "update upd as u set u.c = u.a order by a limit 1;"},
+		{sql: "update upd set b = null where b = 'c';"},
+		{sql: "select * from upd order by a limit 10;", res: executeResult{
+			attr: []string{"a", "b", "c"},
+			data: [][]string{
+				{"1", "x", "1.000000"},
+				{"12", "b", "2.200000"},
+				{"13", "null", "3.300000"},
+			},
+		}},
+		{sql: "update upd set a = 1, b = 'y';"},
+		{sql: "select * from upd order by c limit 10;", res: executeResult{
+			attr: []string{"a", "b", "c"},
+			data: [][]string{
+				{"1", "y", "1.000000"},
+				{"1", "y", "2.200000"},
+				{"1", "y", "3.300000"},
+			},
+		}},
+		{sql: "update upd set d = 1;", err: "[42703]Unknown column 'd' in 'field list'"},
+		{sql: "update upd set a = 1, a = 2;", err: "[42701]Column 'a' specified twice"},
+		{sql: "update upd set b = '12345678901';", err: "[22000]Data too long for column 'b' at row 1"},
+	}
+	test(t, testCases)
+}
+
+// TestUpdateAffectedRows to make sure only the changed rows are affected by update
+func TestUpdateAffectedRows(t *testing.T) {
+	testCases := []struct {
+		sql  string
+		rows uint64
+	}{
+		{sql: "create table upd (a int, b varchar(10));"},
+		{sql: "insert into upd values (1, 'a'), (2, 'b'), (3, null);", rows: 3},
+		{sql: "update upd set b = 'a';", rows: 2},
+		{sql: "update upd set b = 'a';", rows: 0},
+		{sql: "update upd set a = a, b = null where a > 1;", rows: 2},
+		{sql: "update upd set b = null where a > 1;", rows: 0},
+		{sql: "update upd set a = a + 1 where a < 3;", rows: 2},
+	}
+	e, proc := newTestEngine()
+	for _, tc := range testCases {
+		res, err := executeSQL(tc.sql, e, proc)
+		require.NoError(t, err, tc.sql)
+		require.Equal(t, tc.rows, res.rows, tc.sql)
+	}
+	res, err := executeSQL("select * from upd order by a;", e, proc)
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"2", "a"}, {"3", "null"}, {"3", "null"}}, res.data)
+}
+
 func TestCAQ(t *testing.T) {
 	testCases := []testCase{
 		// different table, equivalence-join, and the number of join-conditions is 1.
@@ -354,6 +428,644 @@ func TestCAQ(t *testing.T) {
 	test(t, testCases)
 }
 
+func TestOuterJoin(t *testing.T) {
+	testCases := []testCase{
+		{sql: "create table ta (x int, s varchar(10), y int);"},
+		{sql: "create table tb (x int, s varchar(10), z int);"},
+		{sql: "create table tc (x int, w int);"},
+		{sql: "create table td (x int, v int);"},
+		{sql: "insert into ta values (1, 'a', 10), (2, 'b', 20), (3, 'c', 30), (null, 'd', 40);"},
+		{sql: "insert into tb values (1, 'a', 100), (1, 'x', 101), (2, 'b', 200), (4, 'd', 400), (null, 'e', 500);"},
+		{sql: "insert into td values (1, 1000), (3, 3000);"},
+
+		{sql: "select y, z from ta left join tb on ta.x = tb.x;", res: executeResult{
+			attr: []string{"y", "z"},
+			data: [][]string{{"10", "100"}, {"10", "101"}, {"20", "200"}, {"30", "null"}, {"40", "null"}},
+		}},
+		{sql: "select y, z from ta right join tb on ta.x = tb.x;", res: executeResult{
+			attr: []string{"y", "z"},
+			data: [][]string{{"10", "100"}, {"10", "101"}, {"20", "200"}, {"null", "400"}, {"null", "500"}},
+		}},
+		{sql: "select y, z from ta full join tb on ta.x = tb.x;", res: executeResult{
+			attr: []string{"y", "z"},
+			data: [][]string{{"10", "100"}, {"10", "101"}, {"20", "200"}, {"30", "null"}, {"40", "null"}, {"null", "400"}, {"null", "500"}},
+		}},
+		{sql: "select y, z from ta left join tb using (x, s);", res: executeResult{
+			attr: []string{"y", "z"},
+			data: [][]string{{"10", "100"}, {"20", "200"}, {"30", "null"}, {"40", "null"}},
+		}},
+		{sql: "select * from ta natural full join tb;", res: executeResult{
+			attr: []string{"x", "s", "y", "z"},
+			data: [][]string{{"1", "a", "10", "100"}, {"2", "b", "20", "200"}, {"3", "c", "30", "null"}, {"null", "d", "40", "null"},
+				{"1", "x", "null", "101"}, {"4", "d", "null", "400"}, {"null", "e", "null", "500"}},
+		}},
+		{sql: "select y, w from ta left join tc on ta.x = tc.x;", res: executeResult{
+			attr: []string{"y", "w"},
+			data: [][]string{{"10", "null"}, {"20", "null"}, {"30", "null"}, {"40", "null"}},
+		}},
+		{sql: "select y, z, v from ta left join tb on ta.x = tb.x left join td on ta.x = td.x;", res: executeResult{
+			attr: []string{"y", "z", "v"},
+			data: [][]string{{"10", "100", "1000"}, {"10", "101", "1000"}, {"20", "200", "null"}, {"30", "null", "3000"}, {"40", "null", "null"}},
+		}},
+		{sql: "select y, z from ta left join tb on ta.x = tb.x where y > 15;", res: executeResult{
+			attr: []string{"y", "z"},
+			data: [][]string{{"20", "200"}, {"30", "null"}, {"40", "null"}},
+		}},
+
+		{sql: "select y, count(*), count(z), sum(z) from ta left join tb on ta.x = tb.x group by y;", res: executeResult{
+			attr: []string{"y", "count(*)", "count(z)", "sum(z)"},
+			data: [][]string{{"10", "2", "2", "201"}, {"20", "1", "1", "200"}, {"30", "1", "0", "null"}, {"40", "1", "0", "null"}},
+		}},
+		{sql: "select ta.s, count(*) from tb left join ta on ta.x = tb.x group by ta.s;", res: executeResult{
+			attr: []string{"ta.s", "count(*)"},
+			data: [][]string{{"a", "2"}, {"b", "1"}, {"null", "2"}},
+		}},
+		{sql: "select count(*), count(w), sum(w) from ta left join tc on ta.x = tc.x;", res: executeResult{
+			attr: []string{"count(*)", "count(w)", "sum(w)"},
+			data: [][]string{{"4", "0", "null"}},
+		}},
+
+		{sql: "select * from ta left join tb using (q);", err: "[42000]Unknown column 'q' in 'from clause'"},
+		{sql: "select y from ta full join tb on ta.x = tb.x group by y;", err: "[0A000]full join with aggregation is not supported now"},
+	}
+	test(t, testCases)
+}
+
+func TestCrossJoin(t *testing.T) {
+	testCases := []testCase{
+		{sql: "create table ta (x int, y int);"},
+		{sql: "create table tb (x int, z int);"},
+		{sql: "create table tc (y int, z int, w int);"},
+		{sql: "create table td (v int);"},
+		{sql: "insert into ta values (1, 10), (2, 20), (3, 30);"},
+		{sql: "insert into tb values (1, 100), (2, 200), (2, 300);"},
+		{sql: "insert into tc values (10, 100, 7), (20, 300, 8), (30, 100, 9);"},
+
+		{sql: "select ta.x, tb.x from ta, tb;", res: executeResult{
+			attr: []string{"ta.x", "tb.x"},
+			data: [][]string{{"1", "1"}, {"1", "2"}, {"1", "2"}, {"2", "1"}, {"2", "2"}, {"2", "2"}, {"3", "1"}, {"3", "2"}, {"3", "2"}},
+		}},
+		{sql: "select * from ta, td;", res: executeResult{null: true}},
+		{sql: "select ta.y, w from ta, tb, tc where ta.x = tb.x;", res: executeResult{
+			attr: []string{"ta.y", "w"},
+			data: [][]string{{"10", "7"}, {"10", "8"}, {"10", "9"}, {"20", "7"}, {"20", "7"}, {"20", "8"}, {"20", "8"}, {"20", "9"}, {"20", "9"}},
+		}},
+		{sql: "select ta.y, tb.z, w from ta cross join tb join tc on tb.z = tc.z;", res: executeResult{
+			attr: []string{"ta.y", "tb.z", "w"},
+			data: [][]string{{"10", "100", "7"}, {"10", "100", "9"}, {"20", "100", "7"}, {"20", "100", "9"}, {"30", "100", "7"}, {"30", "100", "9"},
+				{"10", "300", "8"}, {"20", "300", "8"}, {"30", "300", "8"}},
+		}},
+		{sql: "select w from ta, tb, tc where ta.x = tb.x and tb.z = tc.z and ta.y = tc.y;", res: executeResult{
+			attr: []string{"w"},
+			data: [][]string{{"7"}, {"8"}},
+		}},
+		{sql: "select w from ta join tb on ta.x = tb.x join tc on tb.z = tc.z and ta.y = tc.y;", res: executeResult{
+			attr: []string{"w"},
+			data: [][]string{{"7"}, {"8"}},
+		}},
+
+		{sql: "select count(*) from ta, tb, tc;", res: executeResult{
+			attr: []string{"count(*)"},
+			data: [][]string{{"27"}},
+		}},
+		{sql: "select ta.x, count(*) from ta, tb group by ta.x;", res: executeResult{
+			attr: []string{"ta.x", "count(*)"},
+			data: [][]string{{"1", "3"}, {"2", "3"}, {"3", "3"}},
+		}},
+
+		{sql: "select count(*) from ta, tb, tc where ta.x = tb.x and tb.z = tc.z and ta.y = tc.y;", res: executeResult{
+			attr: []string{"count(*)"},
+			data: [][]string{{"2"}},
+		}},
+	}
+	test(t, testCases)
+}
+
+func TestSubquery(t *testing.T) {
+	testCases := []testCase{
+		{sql: "create table ta (x int, y int);"},
+		{sql: "create table tb (x int, z int);"},
+		{sql: "insert into ta values (1, 10), (2, 20), (3, 30);"},
+		{sql: "insert into tb values (1, 100), (2, 200), (2, 300), (5, 500);"},
+
+		{sql: "select y from ta where x in (select x from tb);", res: executeResult{
+			attr: []string{"y"},
+			data: [][]string{{"10"}, {"20"}},
+		}},
+		{sql: "select y from ta where x not in (select x from tb);", res: executeResult{
+			attr: []string{"y"},
+			data: [][]string{{"30"}},
+		}},
+		{sql: "select y from ta where exists (select * from tb where tb.x = ta.x);", res: executeResult{
+			attr: []string{"y"},
+			data: [][]string{{"10"}, {"20"}},
+		}},
+		{sql: "select y from ta where not exists (select * from tb where tb.x = ta.x);", res: executeResult{
+			attr: []string{"y"},
+			data: [][]string{{"30"}},
+		}},
+		{sql: "select y from ta where exists (select * from tb where z > 1000);", res: executeResult{null: true}},
+		{sql: "select y from ta where not exists (select * from tb where z > 1000);", res: executeResult{
+			attr: []string{"y"},
+			data: [][]string{{"10"}, {"20"}, {"30"}},
+		}},
+		{sql: "select count(*) from ta where exists (select * from tb where tb.x = ta.x and tb.z <> ta.y * 10);", res: executeResult{
+			attr: []string{"count(*)"},
+			data: [][]string{{"1"}},
+		}},
+		{sql: "select x from ta where x in (select x from tb where z in (select z from tb where z > 200));", res: executeResult{
+			attr: []string{"x"},
+			data: [][]string{{"2"}},
+		}},
+		{sql: "select x, count(*) from tb where x in (select x from ta where y > 10) group by x;", res: executeResult{
+			attr: []string{"x", "count(*)"},
+			data: [][]string{{"2", "2"}},
+		}},
+		{sql: "select y from ta where y * 10 < (select max(z) from tb where tb.x = ta.x);", res: executeResult{
+			attr: []string{"y"},
+			data: [][]string{{"20"}},
+		}},
+		{sql: "select y from ta where x > (select avg(x) from tb);", res: executeResult{
+			attr: []string{"y"},
+			data: [][]string{{"30"}},
+		}},
+		{sql: "select x, (select sum(z) from tb where tb.x = ta.x) as s from ta;", res: executeResult{
+			attr: []string{"x", "s"},
+			data: [][]string{{"1", "100"}, {"2", "500"}, {"3", "null"}},
+		}},
+
+		{sql: "select x from ta where x in (select x, z from tb);", err: "[42P10]Operand should contain 1 column(s)"},
+		{sql: "select x from ta group by x having count(*) > (select count(*) from tb);", err: "[0A000]subquery in having clause is not supported now"},
+		{sql: "select y from ta where 0 = (select count(*) from tb where tb.x = ta.x);", err: "[0A000]correlated subquery with count is not supported now"},
+	}
+	test(t, testCases)
+}
+
+// TestTPCHSubquery runs the tpch queries with subqueries over a few rows.
+func TestTPCHSubquery(t *testing.T) {
+	testCases := []testCase{
+		{sql: "create table region (r_regionkey int, r_name varchar(25));"},
+		{sql: "create table nation (n_nationkey int, n_name varchar(25), n_regionkey int);"},
+		{sql: "create table part (p_partkey int, p_name varchar(55), p_mfgr varchar(25), p_brand varchar(10), p_type varchar(25), p_size int, p_container varchar(10));"},
+		{sql: "create table supplier (s_suppkey int, s_name varchar(25), s_address varchar(40), s_nationkey int, s_phone varchar(15), s_acctbal double, s_comment varchar(101));"},
+		{sql: "create table partsupp (ps_partkey int, ps_suppkey int, ps_availqty int, ps_supplycost double);"},
+		{sql: "create table customer (c_custkey int, c_phone varchar(15), c_acctbal double);"},
+		{sql: "create table orders (o_orderkey int, o_custkey int, o_orderstatus char(1), o_orderdate date, o_orderpriority varchar(15));"},
+		{sql: "create table lineitem (l_orderkey int, l_partkey int, l_suppkey int, l_quantity double, l_extendedprice double, l_shipdate date, l_commitdate date, l_receiptdate date);"},
+		{sql: "insert into region values (0, 'AFRICA'), (1, 'MIDDLE EAST'), (2, 'ASIA');"},
+		{sql: "insert into nation values (0, 'VIETNAM', 2), (1, 'BRAZIL', 0), (2, 'IRAN', 1), (3, 'JORDAN', 1);"},
+		{sql: "insert into part values (1, 'lime green', 'Manufacturer#1', 'Brand#54', 'ECONOMY TIN', 48, 'LG BAG'), (2, 'lime red', 'Manufacturer#2', 'Brand#54', 'SMALL TIN', 48, 'LG BAG'), " +
+			"(3, 'blue sky', 'Manufacturer#3', 'Brand#11', 'LARGE BRASS', 12, 'SM BOX'), (4, 'lime blue', 'Manufacturer#4', 'Brand#54', 'ECONOMY TIN', 48, 'LG BAG');"},
+		{sql: "insert into supplier values (1, 'Supplier#1', 'addr1', 2, '11-111', 100.5, 'c1'), (2, 'Supplier#2', 'addr2', 3, '11-222', 200.25, 'c2'), (3, 'Supplier#3', 'addr3', 1, '11-333', 300, 'c3'), " +
+			"(4, 'Supplier#4', 'addr4', 0, '11-444', 400, 'c4'), (5, 'Supplier#5', 'addr5', 0, '11-555', 500, 'c5');"},
+		{sql: "insert into partsupp values (1, 1, 100, 10), (1, 2, 200, 8), (1, 4, 300, 9), (2, 1, 50, 5), (2, 2, 60, 6), (2, 5, 10, 1), (3, 3, 70, 7), (4, 4, 500, 4), (4, 5, 1, 4);"},
+		{sql: "insert into customer values (1, '11-123', 500), (2, '19-456', 900), (3, '13-789', 700), (4, '11-000', 100), (5, '22-555', 800);"},
+		{sql: "insert into orders values (1, 1, 'F', '1997-07-05', '1-URGENT'), (2, 2, 'F', '1997-08-10', '2-HIGH'), (3, 3, 'O', '1997-09-20', '1-URGENT'), " +
+			"(4, 1, 'F', '1997-11-01', '3-MEDIUM'), (5, 2, 'F', '1997-07-15', '2-HIGH'), (6, 3, 'F', '1996-01-01', '5-LOW');"},
+		{sql: "insert into lineitem values (1, 1, 3, 10, 1000, '1993-03-01', '1997-07-10', '1997-07-20'), (1, 2, 1, 5, 500, '1993-04-01', '1997-07-10', '1997-07-05'), " +
+			"(2, 1, 3, 20, 2000, '1994-01-10', '1997-08-20', '1997-08-25'), (2, 1, 4, 20, 2000, '1993-06-01', '1997-08-20', '1997-08-30'), " +
+			"(3, 3, 3, 5, 300, '1995-01-01', '1997-09-25', '1997-09-21'), (4, 4, 3, 3, 300, '1997-11-02', '1997-11-05', '1997-11-10'), " +
+			"(5, 2, 5, 30, 3000, '1993-09-09', '1997-07-20', '1997-07-25'), (5, 2, 3, 4, 400, '1993-02-02', '1997-07-20', '1997-07-30'), " +
+			"(6, 3, 3, 1, 100, '1995-05-05', '1996-01-10', '1996-01-20'), (6, 3, 1, 2, 200, '1995-05-05', '1996-01-10', '1996-01-09'), (6, 1, 1, 1, 50, '1995-05-05', '1996-01-10', '1996-01-05');"},
+
+		{sql: "select s_acctbal, s_name, n_name, p_partkey, p_mfgr, s_address, s_phone, s_comment from part, supplier, partsupp, nation, region " +
+			"where p_partkey = ps_partkey and s_suppkey = ps_suppkey and p_size = 48 and p_type like '%TIN' and s_nationkey = n_nationkey and n_regionkey = r_regionkey and r_name = 'MIDDLE EAST' " +
+			"and ps_supplycost = (select min(ps_supplycost) from partsupp, supplier, nation, region " +
+			"where p_partkey = ps_partkey and s_suppkey = ps_suppkey and s_nationkey = n_nationkey and n_regionkey = r_regionkey and r_name = 'MIDDLE EAST') " +
+			"order by s_acctbal desc, n_name, s_name, p_partkey limit 100;", res: executeResult{
+			attr: []string{"s_acctbal", "s_name", "n_name", "p_partkey", "p_mfgr", "s_address", "s_phone", "s_comment"},
+			data: [][]string{
+				{"200.250000", "Supplier#2", "JORDAN", "1", "Manufacturer#1", "addr2", "11-222", "c2"},
+				{"100.500000", "Supplier#1", "IRAN", "2", "Manufacturer#2", "addr1", "11-111", "c1"},
+			},
+		}, com: "tpch q2"},
+		{sql: "select o_orderpriority, count(*) as order_count from orders " +
+			"where o_orderdate >= date '1997-07-01' and o_orderdate < date '1997-07-01' + interval '3' month " +
+			"and exists (select * from lineitem where l_orderkey = o_orderkey and l_commitdate < l_receiptdate) " +
+			"group by o_orderpriority order by o_orderpriority;", res: executeResult{
+			attr: []string{"o_orderpriority", "order_count"},
+			data: [][]string{{"1-URGENT", "1"}, {"2-HIGH", "2"}},
+		}, com: "tpch q4"},
+		{sql: "select sum(l_extendedprice) / 7.0 as avg_yearly from lineitem, part " +
+			"where p_partkey = l_partkey and p_brand = 'Brand#54' and p_container = 'LG BAG' " +
+			"and l_quantity < (select 0.2 * avg(l_quantity) from lineitem where l_partkey = p_partkey);", res: executeResult{
+			attr: []string{"avg_yearly"},
+			data: [][]string{{"7.142857"}},
+		}, com: "tpch q17"},
+		{sql: "select s_name, s_address from supplier, nation " +
+			"where s_suppkey in (select ps_suppkey from partsupp where ps_partkey in (select p_partkey from part where p_name like 'lime%') " +
+			"and ps_availqty > (select 0.5 * sum(l_quantity) from lineitem " +
+			"where l_partkey = ps_partkey and l_suppkey = ps_suppkey and l_shipdate >= date '1993-01-01' and l_shipdate < date '1993-01-01' + interval '1' year)) " +
+			"and s_nationkey = n_nationkey and n_name = 'VIETNAM' order by s_name;", res: executeResult{
+			attr: []string{"s_name", "s_address"},
+			data: [][]string{{"Supplier#4", "addr4"}},
+		}, com: "tpch q20"},
+		{sql: "select s_name, count(*) as numwait from supplier, lineitem l1, orders, nation " +
+			"where s_suppkey = l1.l_suppkey and o_orderkey = l1.l_orderkey and o_orderstatus = 'F' and l1.l_receiptdate > l1.l_commitdate " +
+			"and exists (select * from lineitem l2 where l2.l_orderkey = l1.l_orderkey and l2.l_suppkey <> l1.l_suppkey) " +
+			"and not exists (select * from lineitem l3 where l3.l_orderkey = l1.l_orderkey and l3.l_suppkey <> l1.l_suppkey and l3.l_receiptdate > l3.l_commitdate) " +
+			"and s_nationkey = n_nationkey and n_name = 'BRAZIL' group by s_name order by numwait desc, s_name limit 100;", res: executeResult{
+			attr: []string{"s_name", "numwait"},
+			data: [][]string{{"Supplier#3", "2"}},
+		}, com: "tpch q21"},
+		{sql: "select cntrycode, count(*) as numcust, sum(c_acctbal) as totacctbal from (select substring(c_phone from 1 for 2) as cntrycode, c_acctbal from customer " +
+			"where substring(c_phone from 1 for 2) in ('10', '11', '26', '22', '19', '20', '27') " +
+			"and c_acctbal > (select avg(c_acctbal) from customer where c_acctbal > 0.00 and substring(c_phone from 1 for 2) in ('10', '11', '26', '22', '19', '20', '27')) " +
+			"and not exists (select * from orders where o_custkey = c_custkey)) as custsale group by cntrycode order by cntrycode;", res: executeResult{
+			attr: []string{"cntrycode", "numcust", "totacctbal"},
+			data: [][]string{{"22", "1", "800.000000"}},
+		}, com: "tpch q22"},
+	}
+	test(t, testCases)
+}
+
+func TestSetOperation(t *testing.T) {
+	testCases := []testCase{
+		{sql: "create table ta (x int, s char(10));"},
+		{sql: "create table tb (y bigint, t varchar(20));"},
+		{sql: "create table tc (z double);"},
+		{sql: "insert into ta values (1, 'a'), (1, 'a'), (2, 'b'), (3, 'c');"},
+		{sql: "insert into tb values (1, 'a'), (2, 'b'), (2, 'b'), (4, 'd');"},
+		{sql: "insert into tc values (1.5), (2);"},
+
+		{sql: "select x from ta union select y from tb order by x;", res: executeResult{
+			attr: []string{"x"},
+			data: [][]string{{"1"}, {"2"}, {"3"}, {"4"}},
+		}},
+		{sql: "select x from ta union all select y from tb order by x;", res: executeResult{
+			attr: []string{"x"},
+			data: [][]string{{"1"}, {"1"}, {"1"}, {"2"}, {"2"}, {"2"}, {"3"}, {"4"}},
+		}},
+		{sql: "select x, s from ta intersect select y, t from tb order by x;", res: executeResult{
+			attr: []string{"x", "s"},
+			data: [][]string{{"1", "a"}, {"2", "b"}},
+		}},
+		{sql: "select x from ta intersect all select y from tb order by 1;", res: executeResult{
+			attr: []string{"x"},
+			data: [][]string{{"1"}, {"2"}},
+		}},
+		{sql: "select x from ta except select y from tb;", res: executeResult{
+			attr: []string{"x"},
+			data: [][]string{{"3"}},
+		}},
+		{sql: "select x from ta except all select y from tb order by x;", res: executeResult{
+			attr: []string{"x"},
+			data: [][]string{{"1"}, {"3"}},
+		}},
+		{sql: "select x from ta union select z from tc order by x desc limit 3;", res: executeResult{
+			attr: []string{"x"},
+			data: [][]string{{"3.000000"}, {"2.000000"}, {"1.500000"}},
+		}},
+		{sql: "select s from ta union select t from tb order by s limit 2, 2;", res: executeResult{
+			attr: []string{"s"},
+			data: [][]string{{"c"}, {"d"}},
+		}},
+		{sql: "select count(*) from (select x from ta union all select y from tb) as u where x > 1;", res: executeResult{
+			attr: []string{"count(*)"},
+			data: [][]string{{"5"}},
+		}},
+		{sql: "select x from ta where x > 5 except select y from tb;", res: executeResult{
+			null: true,
+		}},
+
+		{sql: "select x, s from ta union select y from tb;", err: "[21000]The used SELECT statements have a different number of columns"},
+		{sql: "select x from ta union select y from tb order by y;", err: "[42000]Unknown column 'y' in 'order clause'"},
+	}
+	test(t, testCases)
+}
+
+func TestWindowFunction(t *testing.T) {
+	testCases := []testCase{
+		{sql: "create table tw (a int, b varchar(10), c int);"},
+		{sql: "insert into tw values (1, 'x', 10), (2, 'x', 20), (3, 'x', 20), (4, 'y', 5), (5, 'y', null), (6, 'z', 7);"},
+
+		{sql: "select a, row_number() over (partition by b order by c desc, a) as rn from tw order by a;", res: executeResult{
+			attr: []string{"a", "rn"},
+			data: [][]string{{"1", "3"}, {"2", "1"}, {"3", "2"}, {"4", "1"}, {"5", "2"}, {"6", "1"}},
+		}},
+		{sql: "select a, rank() over (order by c) as r, dense_rank() over (order by c) as dr from tw where b = 'x' order by a;", res: executeResult{
+			attr: []string{"a", "r", "dr"},
+			data: [][]string{{"1", "1", "1"}, {"2", "2", "2"}, {"3", "2", "2"}},
+		}},
+		{sql: "select a, lag(c) over (partition by b order by a) as l, lead(c, 1, 0) over (partition by b order by a) as n from tw order by a;", res: executeResult{
+			attr: []string{"a", "l", "n"},
+			data: [][]string{{"1", "null", "20"}, {"2", "10", "20"}, {"3", "20", "0"}, {"4", "null", "null"}, {"5", "5", "0"}, {"6", "null", "0"}},
+		}},
+		{sql: "select a, sum(c) over (order by a rows between 1 preceding and current row) as s from tw order by a;", res: executeResult{
+			attr: []string{"a", "s"},
+			data: [][]string{{"1", "10"}, {"2", "30"}, {"3", "40"}, {"4", "25"}, {"5", "5"}, {"6", "7"}},
+		}},
+		{sql: "select a, sum(c) over (partition by b order by a) as s, count(*) over (partition by b) as n, max(c) over () as m from tw order by a;", res: executeResult{
+			attr: []string{"a", "s", "n", "m"},
+			data: [][]string{{"1", "10", "3", "20"}, {"2", "30", "3", "20"}, {"3", "50", "3", "20"}, {"4", "5", "2", "20"}, {"5", "5", "2", "20"}, {"6", "7", "1", "20"}},
+		}},
+		{sql: "select b, sum(c), rank() over (order by sum(c) desc) as r from tw group by b order by r;", res: executeResult{
+			attr: []string{"b", "sum(c)", "r"},
+			data: [][]string{{"x", "50", "1"}, {"z", "7", "2"}, {"y", "5", "3"}},
+		}},
+		{sql: "select a, a + row_number() over (order by a desc) as x from tw where a < 4 order by x, a;", res: executeResult{
+			attr: []string{"a", "x"},
+			data: [][]string{{"1", "4"}, {"2", "4"}, {"3", "4"}},
+		}},
+
+		{sql: "select * from (select a, lead(a, 2) over (order by a) as n from tw) as t where n > 3 order by a;", res: executeResult{
+			attr: []string{"a", "n"},
+			data: [][]string{{"2", "4"}, {"3", "5"}, {"4", "6"}},
+		}},
+		{sql: "select distinct b, count(*) over (partition by b) as n from tw order by b;", res: executeResult{
+			attr: []string{"b", "n"},
+			data: [][]string{{"x", "3"}, {"y", "2"}, {"z", "1"}},
+		}},
+
+		{sql: "select a from tw where row_number() over () > 1;", err: "[42P20]You cannot use the window function 'row_number' in this context."},
+		{sql: "select abs(a) over () from tw;", err: "[42P20]'abs' is not a window function"},
+		{sql: "select rank(a) over () from tw;", err: "[42000]Incorrect parameter count in the call to native function 'rank'"},
+		{sql: "select sum(a) over (order by a range between 1 preceding and current row) from tw;", err: "[0A000]RANGE frame with offset is not support now"},
+		{sql: "select sum(a) over (rows between unbounded following and current row) from tw;", err: "[42P20]Window '<unnamed window>': frame start cannot be UNBOUNDED FOLLOWING."},
+	}
+	test(t, testCases)
+}
+
+func TestConditionalExpression(t *testing.T) {
+	testCases := []testCase{
+		{sql: "create table tc (a int, b varchar(10), c double);"},
+		{sql: "insert into tc values (1, 'x', 1.5), (2, null, null), (3, 'z', 3.5);"},
+
+		{sql: "select a, case when a > 1 then 'big' else 'small' end as s from tc order by a;", res: executeResult{
+			attr: []string{"a", "s"},
+			data: [][]string{{"1", "small"}, {"2", "big"}, {"3", "big"}},
+		}},
+		{sql: "select a, case a when 1 then 'one' when 2 then 'two' end as s from tc order by a;", res: executeResult{
+			attr: []string{"a", "s"},
+			data: [][]string{{"1", "one"}, {"2", "two"}, {"3", "null"}},
+		}},
+		{sql: "select a, case when b is null then 0 else a end as x from tc order by a;", res: executeResult{
+			attr: []string{"a", "x"},
+			data: [][]string{{"1", "1"}, {"2", "0"}, {"3", "3"}},
+		}},
+		{sql: "select a, if(a > 1, c, 0) as x, ifnull(b, 'none') as y, coalesce(c, a) as z, nullif(a, 2) as n from tc order by a;", res: executeResult{
+			attr: []string{"a", "x", "y", "z", "n"},
+			data: [][]string{{"1", "0.000000", "x", "1.500000", "1"}, {"2", "null", "none", "2.000000", "null"}, {"3", "3.500000", "z", "3.500000", "3"}},
+		}},
+		{sql: "select a, case when a in (1, 3) then 1 else 0 end as x, case when a not in (1, 3) then 1 else 0 end as y from tc order by a;", res: executeResult{
+			attr: []string{"a", "x", "y"},
+			data: [][]string{{"1", "1", "0"}, {"2", "0", "1"}, {"3", "1", "0"}},
+		}},
+		{sql: "select a, case when a in (1, c) then 1 else 0 end as x from tc order by a;", res: executeResult{
+			attr: []string{"a", "x"},
+			data: [][]string{{"1", "1"}, {"2", "0"}, {"3", "0"}},
+		}},
+		{sql: "select a, case when 1 = 2 then a else c end as x from tc order by a;", res: executeResult{
+			attr: []string{"a", "x"},
+			data: [][]string{{"1", "1.500000"}, {"2", "null"}, {"3", "3.500000"}},
+		}},
+		{sql: "select a, case when a > 1 then cast(1.25 as decimal(10,2)) else a end as x from tc order by a;", res: executeResult{
+			attr: []string{"a", "x"},
+			data: [][]string{{"1", "1.00"}, {"2", "1.25"}, {"3", "1.25"}},
+		}},
+		{sql: "select * from tc where if(a = 2, 1, 0);", res: executeResult{
+			attr: []string{"a", "b", "c"},
+			data: [][]string{{"2", "null", "null"}},
+		}},
+		{sql: "select sum(case when a > 1 then a else 0 end) as s from tc;", res: executeResult{
+			attr: []string{"s"},
+			data: [][]string{{"5"}},
+		}},
+		{sql: "select a from tc order by case when a = 2 then 0 else 1 end, a;", res: executeResult{
+			attr: []string{"a"},
+			data: [][]string{{"2"}, {"1"}, {"3"}},
+		}},
+
+		{sql: "select a from tc where a in (1, null);", res: executeResult{
+			attr: []string{"a"},
+			data: [][]string{{"1"}},
+		}},
+		{sql: "select a from tc where a not in (1, null);", res: executeResult{null: true}},
+		{sql: "select a from tc where not (a in (1, null));", res: executeResult{null: true}},
+		{sql: "select a from tc where a = null or a > 2;", res: executeResult{
+			attr: []string{"a"},
+			data: [][]string{{"3"}},
+		}},
+		{sql: "select a from tc where not (a = null and a > 2);", res: executeResult{
+			attr: []string{"a"},
+			data: [][]string{{"1"}, {"2"}},
+		}},
+		{sql: "select a, if(null, a, c) as x, if(a in (2, null), 1, 0) as y, if(a not in (2, null), 0, a) as z from tc order by a;", res: executeResult{
+			attr: []string{"a", "x", "y", "z"},
+			data: [][]string{{"1", "1.500000", "0", "1"}, {"2", "null", "1", "2"}, {"3", "3.500000", "0", "3"}},
+		}},
+		{sql: "select a, case when null in (1, a) then a else c end as x, coalesce(a + null, c) as y from tc order by a;", res: executeResult{
+			attr: []string{"a", "x", "y"},
+			data: [][]string{{"1", "1.500000", "1.500000"}, {"2", "null", "null"}, {"3", "3.500000", "3.500000"}},
+		}},
+
+		{sql: "select a, case when a > 5 then null end from tc;", err: "[42P18]'case when a > 5 then null end' is always null"},
+		{sql: "select a, coalesce(null, null) from tc;", err: "[42P18]'coalesce(null, null)' is always null"},
+		{sql: "select a, if(a) from tc;", err: "[42000]Incorrect parameter count in the call to native function 'if'"},
+	}
+	test(t, testCases)
+}
+
+func TestPostgresqlExpression(t *testing.T) {
+	testCases := []testCase{
+		{sql: "create table td (a int, b int, c varchar(10));"},
+		{sql: "insert into td values (1, 1, 'x'), (2, null, 'y'), (3, 4, null), (4, null, null);"},
+
+		{sql: "select a from td where b is distinct from 1 order by a;", res: executeResult{
+			attr: []string{"a"},
+			data: [][]string{{"2"}, {"3"}, {"4"}},
+		}},
+		{sql: "select a from td where b is not distinct from null order by a;", res: executeResult{
+			attr: []string{"a"},
+			data: [][]string{{"2"}, {"4"}},
+		}},
+		{sql: "select a from td where b is distinct from a order by a;", res: executeResult{
+			attr: []string{"a"},
+			data: [][]string{{"2"}, {"3"}, {"4"}},
+		}},
+		{sql: "select a from td where c is not distinct from 'x' or b is not distinct from 4 order by a;", res: executeResult{
+			attr: []string{"a"},
+			data: [][]string{{"1"}, {"3"}},
+		}},
+		{sql: "select a from td order by a limit all offset 2;", res: executeResult{
+			attr: []string{"a"},
+			data: [][]string{{"3"}, {"4"}},
+		}},
+		{sql: "select a from td order by b nulls first;", err: "[0A000]'nulls first' is not supported now"},
+	}
+	testOfDialect(t, dialect.POSTGRESQL, testCases)
+}
+
+func TestSpill(t *testing.T) {
+	e, proc := newTestEngine()
+	sqls := []string{
+		"create table sa (a int, b varchar(20), c bigint);",
+		"create table sb (a int, d varchar(20));",
+	}
+	for i := 0; i < 20; i++ {
+		var values []string
+		for j := 0; j < 100; j++ {
+			k := i*100 + j
+			values = append(values, fmt.Sprintf("(%d, 'b%d', %d)", (k*7919)%2000, k%300, k))
+		}
+		sqls = append(sqls, "insert into sa values "+strings.Join(values, ", ")+";")
+	}
+	for i := 0; i < 4; i++ {
+		var values []string
+		for j := 0; j < 100; j++ {
+			k := i*100 + j
+			values = append(values, fmt.Sprintf("(%d, 'd%d')", k*5, k))
+		}
+		sqls = append(sqls, "insert into sb values "+strings.Join(values, ", ")+";")
+	}
+	for _, sql := range sqls {
+		_, err := executeSQL(sql, e, proc)
+		require.NoError(t, err, sql)
+	}
+
+	queries := []string{
+		"select a, b, c from sa order by a, c desc;",
+		"select b, count(*), sum(c) from sa group by b order by b;",
+		"select sa.a, sa.c, sb.d from sa join sb on sa.a = sb.a order by sa.c;",
+		"select sa.a, sa.c, sb.d from sa left join sb on sa.a = sb.a order by sa.c;",
+		"select sa.a, sa.c, sb.a, sb.d from sa full join sb on sa.a = sb.a order by sa.c, sb.a;",
+		"select sb.d, count(*), sum(sa.c) from sa join sb on sa.a = sb.a group by sb.d order by sb.d;",
+		"select sb.d, count(*), sum(sa.c) from sa left join sb on sa.a = sb.a group by sb.d order by sb.d;",
+	}
+	for _, sql := range queries {
+		expected, err := executeSQL(sql, e, proc)
+		require.NoError(t, err, sql)
+
+		files := proc.Spill.Files
+		proc.Spill.Dir = t.TempDir()
+		proc.Mp.Gm.Limit = 4 << 10
+		res, err := executeSQL(sql, e, proc)
+		proc.Mp.Gm.Limit = 1 << 30
+		proc.Spill.Dir = ""
+		require.NoError(t, err, sql)
+		require.Equal(t, expected, res, sql)
+		require.Greater(t, proc.Spill.Files, files, sql)
+	}
+}
+
+func TestExplain(t *testing.T) {
+	testCases := []testCase{
+		{sql: "create table ta (x int, y int);"},
+		{sql: "insert into ta values (1, 2), (3, 4), (5, 6);"},
+
+		{sql: "explain select x from ta where y > 2 order by x;", res: executeResult{
+			attr: []string{"QUERY PLAN"},
+			data: [][]string{
+				{"Scope (Magic: Merge, Receivers: 1)"},
+				{"  -> mergeOrder by {[x]}"},
+				{"  -> π(x -> x:1)"},
+				{"  PreScopes:"},
+				{"    Scope (Magic: Remote)"},
+				{"      Source: test.ta [x, y]"},
+				{"      Node: 0 (127.0.0.1)"},
+				{"      -> σ(y > 2) -> π(x -> x:1,y -> y:1) -> ∏([], [])"},
+				{"      -> π(x -> x:2)"},
+				{"      -> τ([x])"},
+				{"      -> pipe connector"},
+			},
+		}},
+		{sql: "explain ta;", res: executeResult{
+			attr: []string{"Field", "Type", "Null", "Key", "Default", "Extra"},
+		}},
+
+		{sql: "explain insert into ta values (1, 2);", err: "[0A000]explain 'insert into ta values (1, 2)' is not supported now"},
+		{sql: "explain format = 'json' select x from ta;", err: "[0A000]explain format 'json' is not supported now"},
+		{sql: "explain for connection 1;", err: "[0A000]explain for connection is not supported now"},
+	}
+	test(t, testCases)
+}
+
+// TestExplainAnalyze checks the statistics of the operators, the time consumed is masked
+func TestExplainAnalyze(t *testing.T) {
+	timeRegexp := regexp.MustCompile(`time: [^,)]+`)
+	e, proc := newTestEngine()
+	for _, sql := range []string{
+		"create table ta (x int, y int);",
+		"insert into ta values (1, 2), (3, 4), (5, 6);",
+	} {
+		_, err := executeSQL(sql, e, proc)
+		require.NoError(t, err, sql)
+	}
+
+	testCases := []struct {
+		sql  string
+		plan []string
+	}{
+		{
+			sql: "explain analyze select x, count(*) from ta group by x;",
+			plan: []string{
+				"Scope (Magic: Merge, Receivers: 1)",
+				"  -> ∐ ([x]) (rows: 3, batches: 1, time: *, memory: 0 bytes)",
+				"  -> π(count(*) -> count(*):1,x -> x:1) (rows: 3, batches: 1, time: *, memory: 0 bytes)",
+				"  -> π(x -> x:1,count(*) -> count(*):1) (rows: 3, batches: 1, time: *, memory: 0 bytes)",
+				"  -> sql output (rows: 3, batches: 1, time: *, memory: 0 bytes)",
+				"  PreScopes:",
+				"    Scope (Magic: Merge, Receivers: 1)",
+				"      ->  +  (rows: 3, batches: 1, time: *, memory: 0 bytes)",
+				"      -> pipe connector (rows: 3, batches: 1, time: *, memory: 0 bytes)",
+				"      PreScopes:",
+				"        Scope (Magic: Remote)",
+				"          Source: test.ta [x]",
+				"          Node: 0 (127.0.0.1)",
+				"          -> π(x -> x:3) -> ∏([x], [count(*) <- starcount(x)]) (rows: 3, batches: 1, time: *, memory: 56 bytes)",
+				"          -> pipe connector (rows: 3, batches: 1, time: *, memory: 0 bytes)",
+				"Execution Time: *",
+			},
+		},
+		{
+			sql: "explain analyze select x from ta union select y from ta;",
+			plan: []string{
+				"Scope (Magic: Merge, Receivers: 2)",
+				"  -> union (rows: 6, batches: 1, time: *, memory: 32 bytes)",
+				"  -> sql output (rows: 6, batches: 1, time: *, memory: 0 bytes)",
+				"  PreScopes:",
+				"    Scope (Magic: Merge, Receivers: 1)",
+				"      ->  +  (rows: 3, batches: 1, time: *, memory: 0 bytes)",
+				"      -> π(x -> x:1) (rows: 3, batches: 1, time: *, memory: 0 bytes)",
+				"      -> pipe connector (rows: 3, batches: 1, time: *, memory: 0 bytes)",
+				"      PreScopes:",
+				"        Scope (Magic: Remote)",
+				"          Source: test.ta [x]",
+				"          Node: 0 (127.0.0.1)",
+				"          -> π(x -> x:1) -> ∏([], []) (rows: 3, batches: 1, time: *, memory: 0 bytes)",
+				"          -> π(x -> x:1) (rows: 3, batches: 1, time: *, memory: 0 bytes)",
+				"          -> pipe connector (rows: 3, batches: 1, time: *, memory: 0 bytes)",
+				"    Scope (Magic: Merge, Receivers: 1)",
+				"      ->  +  (rows: 3, batches: 1, time: *, memory: 0 bytes)",
+				"      -> π(y -> y:1) (rows: 3, batches: 1, time: *, memory: 0 bytes)",
+				"      -> π(y -> x:1) (rows: 3, batches: 1, time: *, memory: 0 bytes)",
+				"      -> pipe connector (rows: 3, batches: 1, time: *, memory: 0 bytes)",
+				"      PreScopes:",
+				"        Scope (Magic: Remote)",
+				"          Source: test.ta [y]",
+				"          Node: 0 (127.0.0.1)",
+				"          -> π(y -> y:1) -> ∏([], []) (rows: 3, batches: 1, time: *, memory: 0 bytes)",
+				"          -> π(y -> y:1) (rows: 3, batches: 1, time: *, memory: 0 bytes)",
+				"          -> pipe connector (rows: 3, batches: 1, time: *, memory: 0 bytes)",
+				"Execution Time: *",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		res, err := executeSQL(tc.sql, e, proc)
+		require.NoError(t, err, tc.sql)
+		require.Equal(t, []string{"QUERY PLAN"}, res.attr, tc.sql)
+		plan := make([]string, len(res.data))
+		for i, row := range res.data {
+			plan[i] = timeRegexp.ReplaceAllString(row[0], "time: *")
+			if strings.HasPrefix(plan[i], "Execution Time: ") {
+				plan[i] = "Execution Time: *"
+			}
+		}
+		require.Equal(t, tc.plan, plan, tc.sql)
+	}
+}
+
 func TestAQ(t *testing.T) {
 	testCases := []testCase{
 		{sql: "create table in_out (name varchar(40), age int unsigned, incomes int, expenses int);"},
@@ -386,6 +1098,13 @@ func TestAQ(t *testing.T) {
 				{"0", "7000"},
 			},
 		}},
+
+		{sql: "select count(*), sum(incomes), max(age) from in_out where age > 100;", res: executeResult{
+			attr: []string{"count(*)", "sum(incomes)", "max(age)"},
+			data: [][]string{
+				{"0", "null", "null"},
+			},
+		}},
 	}
 	test(t, testCases)
 }
@@ -0,0 +1,72 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unittest
+
+import (
+	"go/constant"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPrepare checks the statements whose placeholders are bound to values
+func TestPrepare(t *testing.T) {
+	num := func(v int64) tree.Expr {
+		return tree.NewNumVal(constant.MakeInt64(v), "", false)
+	}
+	str := func(v string) tree.Expr {
+		return tree.NewNumVal(constant.MakeString(v), v, false)
+	}
+
+	testCases := []struct {
+		params []tree.Expr
+		testCase
+	}{
+		{nil, testCase{sql: "create table pre1 (a int, b varchar(10));"}},
+		{[]tree.Expr{num(1), str("x"), num(2)}, testCase{sql: "insert into pre1 values (?, ?), (?, 'y');"}},
+		{[]tree.Expr{num(3)}, testCase{sql: "insert into pre1 (a) values (?);"}},
+		{[]tree.Expr{num(1)}, testCase{sql: "select a, b from pre1 where a > ? order by a limit 10;", res: executeResult{
+			attr: []string{"a", "b"},
+			data: [][]string{{"2", "y"}, {"3", "null"}},
+		}}},
+		{[]tree.Expr{str("z"), num(2)}, testCase{sql: "update pre1 set b = ? where a = ?;"}},
+		{[]tree.Expr{num(3)}, testCase{sql: "delete from pre1 where a = ?;"}},
+		{[]tree.Expr{num(1)}, testCase{sql: "select a, b from pre1 order by a limit ?;", res: executeResult{
+			attr: []string{"a", "b"},
+			data: [][]string{{"1", "x"}},
+		}}},
+		{[]tree.Expr{num(10)}, testCase{sql: "select a + ?, b from pre1 where b = 'z';", res: executeResult{
+			attr: []string{"a + ?", "b"},
+			data: [][]string{{"12", "z"}},
+		}}},
+		{nil, testCase{sql: "select a from pre1 where a = ?;", err: "[42P02]no value is bound to parameter 1"}},
+	}
+
+	e, proc := newTestEngine()
+	for _, tc := range testCases {
+		res, err := executeSQLWithParams(tc.sql, e, proc, nil, tc.params, nil)
+		if len(tc.err) != 0 {
+			require.Error(t, err, tc.sql)
+			require.Equal(t, tc.err, err.Error(), tc.sql)
+			continue
+		}
+		require.NoError(t, err, tc.sql)
+		if tc.res.attr != nil {
+			require.Equal(t, tc.res.attr, res.attr, tc.sql)
+			require.Equal(t, tc.res.data, res.data, tc.sql)
+		}
+	}
+}
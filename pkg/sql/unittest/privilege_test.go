@@ -0,0 +1,103 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unittest
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPrivilege checks account management and the privilege checking of statements
+func TestPrivilege(t *testing.T) {
+	m, err := privilege.New(nil)
+	require.NoError(t, err)
+	require.NoError(t, m.Bootstrap("root", ""))
+	root := m.NewChecker("root", "%")
+	u1 := m.NewChecker("u1", "%")
+
+	testCases := []struct {
+		pc *privilege.Checker
+		testCase
+	}{
+		{root, testCase{sql: "create table pt1 (a int, b int);"}},
+		{root, testCase{sql: "insert into pt1 values (1, 2);"}},
+		{root, testCase{sql: "create table pt3 (a int);"}},
+		{root, testCase{sql: "create user u1 identified by '111', u2;"}},
+		{root, testCase{sql: "create user u2, u3;", err: "Operation CREATE USER failed for 'u2'@'%'"}},
+		{root, testCase{sql: "create user if not exists u2;"}},
+		{root, testCase{sql: "create user u4 identified with caching_sha2_password by '111';", err: "[0A000]Plugin 'caching_sha2_password' is not loaded"}},
+		{u1, testCase{sql: "select * from pt1;", err: "SELECT command denied to user 'u1'@'%' for table 'pt1'"}},
+		{u1, testCase{sql: "create user u3;", err: "Access denied; you need (at least one of) the CREATE USER privilege(s) for this operation"}},
+		{u1, testCase{sql: "create table pt2 (a int);", err: "CREATE command denied to user 'u1'@'%' for table 'pt2'"}},
+		{root, testCase{sql: "grant select on pt1 to u1;"}},
+		{u1, testCase{sql: "select a from pt1;", res: executeResult{
+			attr: []string{"a"},
+			data: [][]string{{"1"}},
+		}}},
+		{u1, testCase{sql: "select a from (select a from pt1) as x;", res: executeResult{
+			attr: []string{"a"},
+			data: [][]string{{"1"}},
+		}}},
+		{u1, testCase{sql: "select a from pt1 where a in (select a from pt3);", err: "SELECT command denied to user 'u1'@'%' for table 'pt3'"}},
+		{u1, testCase{sql: "select a from pt1 where not exists (select a from pt3 where pt3.a = pt1.a);", err: "SELECT command denied to user 'u1'@'%' for table 'pt3'"}},
+		{u1, testCase{sql: "select a, (select max(a) from pt3) from pt1;", err: "SELECT command denied to user 'u1'@'%' for table 'pt3'"}},
+		{u1, testCase{sql: "select a from pt1 group by a having a > (select min(a) from pt3);", err: "SELECT command denied to user 'u1'@'%' for table 'pt3'"}},
+		{u1, testCase{sql: "select a from (select a from pt1 where a = (select a from pt3)) as x;", err: "SELECT command denied to user 'u1'@'%' for table 'pt3'"}},
+		{u1, testCase{sql: "select pt1.a from pt1 join pt1 as p on pt1.a in (select a from pt3);", err: "SELECT command denied to user 'u1'@'%' for table 'pt3'"}},
+		{u1, testCase{sql: "insert into pt1 values (3, 4);", err: "INSERT command denied to user 'u1'@'%' for table 'pt1'"}},
+		{u1, testCase{sql: "delete from pt1 where a = 1;", err: "DELETE command denied to user 'u1'@'%' for table 'pt1'"}},
+		{u1, testCase{sql: "grant select on pt1 to u2;", err: "GRANT command denied to user 'u1'@'%' for table 'pt1'"}},
+		{root, testCase{sql: "grant insert on test.* to u1 with grant option;"}},
+		{u1, testCase{sql: "insert into pt1 values (3, 4);"}},
+		{u1, testCase{sql: "insert into pt1 select a, a from pt3;", err: "SELECT command denied to user 'u1'@'%' for table 'pt3'"}},
+		{u1, testCase{sql: "grant insert on test.* to u2;"}},
+		{u1, testCase{sql: "grant insert on *.* to u2;", err: "Access denied; you need (at least one of) the INSERT privilege(s) for this operation"}},
+		{root, testCase{sql: "revoke select on pt1 from u1;"}},
+		{u1, testCase{sql: "select a from pt1;", err: "SELECT command denied to user 'u1'@'%' for table 'pt1'"}},
+		{root, testCase{sql: "revoke select on pt1 from u1;", err: "There is no such grant defined for user 'u1' on host '%' on table 'pt1'"}},
+		{root, testCase{sql: "revoke all on *.* from u2;", err: "There is no such grant defined for user 'u2' on host '%'"}},
+		{root, testCase{sql: "grant all on test.* to u9;", err: "You are not allowed to create a user with GRANT"}},
+		{root, testCase{sql: "grant create user on test.* to u1;", err: "[42000]Illegal GRANT/REVOKE command; please consult the manual to see which privileges can be used"}},
+		{root, testCase{sql: "grant file on test.* to u1;", err: "[42000]Illegal GRANT/REVOKE command; please consult the manual to see which privileges can be used"}},
+		{root, testCase{sql: "grant select on pt9 to u1;", err: "[42P01]not exist", com: "this error is return by memory-engine"}},
+		{u1, testCase{sql: "alter user u1 identified by '222';"}},
+		{u1, testCase{sql: "alter user u2 identified by '222';", err: "Access denied; you need (at least one of) the CREATE USER privilege(s) for this operation"}},
+		{root, testCase{sql: "alter user u9 identified by '222';", err: "Operation ALTER USER failed for 'u9'@'%'"}},
+		{root, testCase{sql: "drop user u1, u2;"}},
+		{root, testCase{sql: "drop user u1;", err: "Operation DROP USER failed for 'u1'@'%'"}},
+		{root, testCase{sql: "drop user if exists u1;"}},
+		{u1, testCase{sql: "select a from pt1;", err: "SELECT command denied to user 'u1'@'%' for table 'pt1'"}},
+	}
+
+	e, proc := newTestEngine()
+	for _, tc := range testCases {
+		res, err := executeSQLWithChecker(tc.sql, e, proc, tc.pc)
+		if len(tc.err) != 0 {
+			require.Error(t, err, tc.sql)
+			require.Equal(t, tc.err, err.Error(), tc.sql)
+			continue
+		}
+		require.NoError(t, err, tc.sql)
+		if tc.res.attr != nil {
+			require.Equal(t, tc.res.attr, res.attr, tc.sql)
+			require.Equal(t, tc.res.data, res.data, tc.sql)
+		}
+	}
+
+	u, ok := m.Lookup("u1", "127.0.0.1")
+	require.False(t, ok, u)
+}
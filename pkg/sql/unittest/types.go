@@ -22,6 +22,9 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/sql/compile"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
@@ -84,6 +87,7 @@ type executeResult struct {
 	null bool		// null is true means expected an empty result
 	attr []string	// attr records the expected attribute names, if no care about that, just set it nil
 	data [][]string // data records the expected data, if no care about that, just set it nil
+	rows uint64     // rows records the affected rows of the statement which changes data
 }
 
 func newTestEngine() (engine.Engine, *process.Process) {
@@ -96,14 +100,34 @@ func newTestEngine() (engine.Engine, *process.Process) {
 }
 
 func executeSQL(sql string, e engine.Engine, proc *process.Process) (*executeResult, error) {
+	return executeSQLWithChecker(sql, e, proc, nil)
+}
+
+// executeSQLWithChecker runs sql as the user of pc, privileges are not checked if pc is nil.
+func executeSQLWithChecker(sql string, e engine.Engine, proc *process.Process, pc *privilege.Checker) (*executeResult, error) {
+	return executeSQLWithParams(sql, e, proc, pc, nil, nil)
+}
+
+// executeSQLWithParams runs sql whose placeholders are bound to params and variables are resolved by vars.
+func executeSQLWithParams(sql string, e engine.Engine, proc *process.Process, pc *privilege.Checker,
+	params []tree.Expr, vars func(*tree.VarExpr) (tree.Expr, error)) (*executeResult, error) {
+	return executeSQLOfDialect(dialect.MYSQL, sql, e, proc, pc, params, vars)
+}
+
+// executeSQLOfDialect runs sql written in the dialect dt.
+func executeSQLOfDialect(dt dialect.DialectType, sql string, e engine.Engine, proc *process.Process, pc *privilege.Checker,
+	params []tree.Expr, vars func(*tree.VarExpr) (tree.Expr, error)) (*executeResult, error) {
 	compile.InitAddress(testEngineIP)
-	c := compile.New("test", sql, "", e, proc)
+	c := compile.New("test", sql, "", e, proc, pc)
+	c.SetDialect(dt)
 	es, err := c.Build()
 	if err != nil {
 		return nil, err
 	}
 
 	exec := es[0]
+	exec.SetParams(params)
+	exec.SetVariables(vars)
 	res := &executeResult{}
 
 	err = exec.Compile(nil, func(_ interface{}, bat *batch.Batch) error {
@@ -116,13 +140,19 @@ func executeSQL(sql string, e engine.Engine, proc *process.Process) (*executeRes
 	if err != nil {
 		return nil, err
 	}
+	res.rows = exec.GetAffectedRows()
 	return res, nil
 }
 
 func test(t *testing.T, testCases []testCase) {
+	testOfDialect(t, dialect.MYSQL, testCases)
+}
+
+// testOfDialect runs the test cases written in the dialect dt.
+func testOfDialect(t *testing.T, dt dialect.DialectType, testCases []testCase) {
 	e, proc := newTestEngine()
 	for _, tc := range testCases {
-		res, err := executeSQL(tc.sql, e, proc)
+		res, err := executeSQLOfDialect(dt, tc.sql, e, proc, nil, nil, nil)
 		switch {
 		case len(tc.err) != 0: // we expect receive an error from running this SQL
 			require.Equal(t, tc.err, err.Error(), tc.sql)
@@ -169,11 +199,12 @@ func convertBatch(res *executeResult, b *batch.Batch) error {
 	}
 	cols := len(b.Attrs)
 
+	// a query may return several batches, and the rows of them are appended to the result
 	res.attr = make([]string, cols)
-	res.data = make([][]string, rows)
+	data := make([][]string, rows)
 
-	for i := range res.data {
-		res.data[i] = make([]string, cols)
+	for i := range data {
+		data[i] = make([]string, cols)
 	}
 
 	tempCol := make([]string, rows)
@@ -185,8 +216,9 @@ func convertBatch(res *executeResult, b *batch.Batch) error {
 			return err
 		}
 		for j := 0; j < rows; j++ {
-			res.data[j][i] = tempCol[j]
+			data[j][i] = tempCol[j]
 		}
 	}
+	res.data = append(res.data, data...)
 	return nil
 }
\ No newline at end of file
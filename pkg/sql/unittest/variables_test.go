@@ -0,0 +1,74 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unittest
+
+import (
+	"fmt"
+	"go/constant"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/stretchr/testify/require"
+)
+
+// TestVariables checks the statements whose variables are resolved to values
+func TestVariables(t *testing.T) {
+	vars := func(e *tree.VarExpr) (tree.Expr, error) {
+		switch {
+		case e.System && e.Name == "limit":
+			return tree.NewNumVal(constant.MakeInt64(2), "2", false), nil
+		case !e.System && e.Name == "a":
+			return tree.NewNumVal(constant.MakeInt64(1), "1", false), nil
+		case !e.System && e.Name == "b":
+			return tree.NewNumVal(constant.MakeString("y"), "y", false), nil
+		}
+		return nil, fmt.Errorf("Unknown system variable '%s'", e.Name)
+	}
+
+	testCases := []testCase{
+		{sql: "create table var1 (a int, b varchar(10));"},
+		{sql: "insert into var1 values (1, 'x'), (2, 'y'), (3, 'z');"},
+		{sql: "select a, b from var1 where a > @a order by a;", res: executeResult{
+			attr: []string{"a", "b"},
+			data: [][]string{{"2", "y"}, {"3", "z"}},
+		}},
+		{sql: "select a + @a, b from var1 where b = @b;", res: executeResult{
+			attr: []string{"a + @a", "b"},
+			data: [][]string{{"3", "y"}},
+		}},
+		{sql: "select a from var1 order by a limit @@limit;", res: executeResult{
+			attr: []string{"a"},
+			data: [][]string{{"1"}, {"2"}},
+		}},
+		{sql: "select a from var1 where a = @c;", err: "Unknown system variable 'c'"},
+	}
+
+	e, proc := newTestEngine()
+	for _, tc := range testCases {
+		res, err := executeSQLWithParams(tc.sql, e, proc, nil, nil, vars)
+		if len(tc.err) != 0 {
+			require.Error(t, err, tc.sql)
+			require.Equal(t, tc.err, err.Error(), tc.sql)
+			continue
+		}
+		require.NoError(t, err, tc.sql)
+		if tc.res.attr != nil {
+			require.Equal(t, tc.res.attr, res.attr, tc.sql)
+			require.Equal(t, tc.res.data, res.data, tc.sql)
+		}
+	}
+	_, err := executeSQLWithParams("select a from var1 where a = @a;", e, proc, nil, nil, nil)
+	require.EqualError(t, err, "[42704]Unknown system variable 'a'")
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
 )
 
 func init() {
@@ -69,35 +70,72 @@ func Prepare(proc *process.Process, arg interface{}) error {
 	n.ctr.strHashStates = make([][3]uint64, UnitLimit)
 	n.ctr.isPure = true
 	for i := 0; i < len(n.ctr.views); i++ {
-		n.ctr.views[i] = new(view)
-		n.ctr.views[i].attrs = n.Vars[i]
-		n.ctr.views[i].values = make([]uint64, UnitLimit)
-		n.ctr.views[i].vecs = make([]*vector.Vector, len(n.Vars[i]))
-
-		n.ctr.views[i].bat = n.Bats[i]
-		ht := n.Bats[i].Ht.(*HashTable)
-		n.ctr.views[i].sels = ht.Sels
-		n.ctr.views[i].isPure = true
-		for _, sel := range ht.Sels {
-			if len(sel) > 1 {
-				n.ctr.isPure = false
-				n.ctr.views[i].isPure = false
-				break
-			}
-			if n.Bats[i].Zs[sel[0]] > 1 {
-				n.ctr.views[i].isPure = false
-			}
+		if n.Spill != nil && i == n.Spill.Index { // built when the partition is joined
+			continue
 		}
-		n.ctr.views[i].intHashMap = ht.IntHashMap
-		n.ctr.views[i].strHashMap = ht.StrHashMap
+		n.ctr.newView(i, n.Bats[i], n)
 	}
 	return nil
 }
 
+func (ctr *Container) newView(i int, bat *batch.Batch, n *Argument) {
+	v := new(view)
+	if ov := ctr.views[i]; ov != nil { // view of the previous partition
+		v.is, v.ois = ov.is, ov.ois
+	}
+	ctr.views[i] = v
+	v.attrs = n.Vars[i]
+	v.values = make([]uint64, UnitLimit)
+	v.vecs = make([]*vector.Vector, len(n.Vars[i]))
+
+	v.bat = bat
+	ht := bat.Ht.(*HashTable)
+	v.sels = ht.Sels
+	v.isPure = true
+	for _, sel := range ht.Sels {
+		if len(sel) > 1 {
+			v.isPure = false
+			break
+		}
+		if bat.Zs[sel[0]] > 1 {
+			v.isPure = false
+		}
+	}
+	v.intHashMap = ht.IntHashMap
+	v.strHashMap = ht.StrHashMap
+	if n.Type != Inner { // the last group is the null row
+		v.nullValue = uint64(len(ht.Sels))
+	}
+	if n.Type == Full {
+		v.matched = make([]bool, len(ht.Sels))
+	}
+	ctr.isPure = true
+	for _, v := range ctr.views {
+		if v != nil && !v.isPure {
+			ctr.isPure = false
+		}
+	}
+}
+
 func Call(proc *process.Process, arg interface{}) (bool, error) {
 	n := arg.(*Argument)
 	bat := proc.Reg.InputBatch
+	if n.Spill != nil {
+		ok, err := n.ctr.processSpill(bat, n, proc)
+		if err != nil {
+			n.ctr.clean(n, proc)
+			proc.Reg.InputBatch = nil
+		}
+		return ok, err
+	}
 	if bat == nil {
+		if n.Type == Full && !n.ctr.done {
+			n.ctr.done = true
+			if err := n.ctr.probeUnmatched(n.Fact, proc); err != nil {
+				proc.Reg.InputBatch = nil
+				return true, err
+			}
+		}
 		return false, nil
 	}
 	if len(bat.Zs) == 0 {
@@ -111,26 +149,188 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	return false, nil
 }
 
-func (ctr *Container) probe(bat *batch.Batch, proc *process.Process) error {
-	defer batch.Clean(bat, proc.Mp)
-	if len(ctr.attrs) == 0 {
-		ctr.oattrs = append(ctr.oattrs, bat.Attrs...)
-		for i, attr := range bat.Attrs {
-			if _, ok := ctr.result[attr]; ok {
-				ctr.ois = append(ctr.ois, i)
-				ctr.is = append(ctr.is, len(ctr.attrs))
-				ctr.attrs = append(ctr.attrs, attr)
+// processSpill writes the rows of the fact into the partitions, and joins
+// all the partitions one by one when all the rows have been received.
+func (ctr *Container) processSpill(bat *batch.Batch, n *Argument, proc *process.Process) (bool, error) {
+	sv := n.Spill
+	if bat != nil {
+		if len(bat.Zs) > 0 {
+			if ctr.part == nil {
+				part, err := spill.NewPartition(len(sv.Part.Fs), n.Vars[sv.Index], proc)
+				if err != nil {
+					return true, err
+				}
+				ctr.part = part
+			}
+			err := ctr.part.Write(bat, proc.Mp)
+			batch.Clean(bat, proc.Mp)
+			if err != nil {
+				return true, err
 			}
 		}
-		for _, v := range ctr.views {
-			for i := len(v.attrs); i < len(v.bat.Attrs); i++ {
-				if _, ok := ctr.result[v.bat.Attrs[i]]; ok {
-					v.ois = append(v.ois, i)
-					v.is = append(v.is, len(ctr.attrs))
-					ctr.attrs = append(ctr.attrs, v.bat.Attrs[i])
-				}
+		proc.Reg.InputBatch = &batch.Batch{}
+		return false, nil
+	}
+	if ctr.done {
+		proc.Reg.InputBatch = nil
+		return false, nil
+	}
+	ctr.done = true
+	// the operators before join end at the first empty input, so the result
+	// of all the partitions is sent at once
+	rbat, err := ctr.joinPartitions(n, proc)
+	ctr.clean(n, proc)
+	if err != nil {
+		return true, err
+	}
+	proc.Reg.InputBatch = rbat
+	return false, nil
+}
+
+// joinPartitions joins the partitions of the fact and the view, and returns
+// the result of all of them.
+func (ctr *Container) joinPartitions(n *Argument, proc *process.Process) (*batch.Batch, error) {
+	var err error
+	var rbat *batch.Batch
+
+	defer func() {
+		if err != nil && rbat != nil {
+			batch.Clean(rbat, proc.Mp)
+		}
+	}()
+	sv := n.Spill
+	for i := range sv.Part.Fs {
+		factRows := ctr.part != nil && ctr.part.Fs[i].Rows() > 0
+		viewRows := sv.Part.Fs[i].Rows() > 0
+		switch {
+		case n.Type == Inner && (!factRows || !viewRows):
+			continue
+		case n.Type == Outer && !factRows: // rows of the fact are kept by outer join
+			continue
+		case !factRows && !viewRows:
+			continue
+		}
+		var vbat *batch.Batch
+		if viewRows {
+			if vbat, err = LoadPartition(sv.Part.Fs[i], proc); err != nil {
+				return nil, err
+			}
+		}
+		if vbat, err = sv.Construct(vbat, proc.Mp); err != nil {
+			return nil, err
+		}
+		ctr.newView(sv.Index, vbat, n)
+		if factRows {
+			if rbat, err = ctr.probePartition(ctr.part.Fs[i], rbat, proc); err != nil {
+				return nil, err
+			}
+			ctr.part.Fs[i].Remove()
+		}
+		if n.Type == Full {
+			proc.Reg.InputBatch = nil
+			if err = ctr.probeUnmatched(n.Fact, proc); err != nil {
+				return nil, err
+			}
+			if rbat, err = collect(rbat, proc); err != nil {
+				return nil, err
+			}
+		}
+		v := ctr.views[sv.Index]
+		batch.Clean(v.bat, proc.Mp)
+		v.bat = nil
+	}
+	return rbat, nil
+}
+
+// probePartition joins the rows of the fact in f, and appends the result
+// to rbat.
+func (ctr *Container) probePartition(f *spill.File, rbat *batch.Batch, proc *process.Process) (*batch.Batch, error) {
+	r, err := f.Reader()
+	if err != nil {
+		return rbat, err
+	}
+	defer r.Close()
+	for {
+		bat, err := r.Read()
+		if err != nil {
+			return rbat, err
+		}
+		if bat == nil {
+			return rbat, nil
+		}
+		if err := ctr.probe(bat, proc); err != nil {
+			return rbat, err
+		}
+		if rbat, err = collect(rbat, proc); err != nil {
+			return rbat, err
+		}
+	}
+}
+
+// collect appends the output of the operator to rbat.
+func collect(rbat *batch.Batch, proc *process.Process) (*batch.Batch, error) {
+	bat := proc.Reg.InputBatch
+	proc.Reg.InputBatch = nil
+	if bat == nil || len(bat.Zs) == 0 {
+		return rbat, nil
+	}
+	if rbat == nil {
+		return bat, nil
+	}
+	rbat, err := rbat.Append(proc.Mp, bat)
+	batch.Clean(bat, proc.Mp)
+	return rbat, err
+}
+
+// LoadPartition reads all the rows of a file into a batch.
+func LoadPartition(f *spill.File, proc *process.Process) (*batch.Batch, error) {
+	var rbat *batch.Batch
+
+	r, err := f.Reader()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	for {
+		bat, err := r.Read()
+		if err != nil {
+			if rbat != nil {
+				batch.Clean(rbat, proc.Mp)
 			}
+			return nil, err
+		}
+		if bat == nil {
+			return rbat, nil
 		}
+		if rbat == nil {
+			if rbat, err = spill.Rows(bat, nil, proc.Mp); err != nil {
+				return nil, err
+			}
+		}
+		rbat, err = rbat.Append(proc.Mp, bat)
+		batch.Clean(bat, proc.Mp)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// clean removes the temporary files of the fact.
+func (ctr *Container) clean(n *Argument, proc *process.Process) {
+	if ctr.part != nil {
+		ctr.part.Remove()
+		ctr.part = nil
+	}
+	if v := ctr.views[n.Spill.Index]; v != nil && v.bat != nil {
+		batch.Clean(v.bat, proc.Mp)
+		v.bat = nil
+	}
+}
+
+func (ctr *Container) probe(bat *batch.Batch, proc *process.Process) error {
+	defer batch.Clean(bat, proc.Mp)
+	if len(ctr.attrs) == 0 {
+		ctr.init(bat.Attrs)
 	} else {
 		batch.Reorder(bat, ctr.oattrs)
 	}
@@ -192,6 +392,148 @@ func (ctr *Container) probe(bat *batch.Batch, proc *process.Process) error {
 	return nil
 }
 
+func (ctr *Container) init(attrs []string) {
+	ctr.oattrs = append(ctr.oattrs, attrs...)
+	for i, attr := range attrs {
+		if _, ok := ctr.result[attr]; ok {
+			ctr.ois = append(ctr.ois, i)
+			ctr.is = append(ctr.is, len(ctr.attrs))
+			ctr.attrs = append(ctr.attrs, attr)
+		}
+	}
+	for _, v := range ctr.views {
+		for i := len(v.attrs); i < len(v.bat.Attrs); i++ {
+			if _, ok := ctr.result[v.bat.Attrs[i]]; ok {
+				v.ois = append(v.ois, i)
+				v.is = append(v.is, len(ctr.attrs))
+				ctr.attrs = append(ctr.attrs, v.bat.Attrs[i])
+			}
+		}
+	}
+}
+
+// probeUnmatched sends the rows of the view which have never been matched,
+// attributes of the fact are null for these rows except the join attributes.
+func (ctr *Container) probeUnmatched(fact Schema, proc *process.Process) error {
+	if len(ctr.attrs) == 0 {
+		ctr.init(fact.Attrs)
+	}
+	v := ctr.views[0]
+	flgs := make([]bool, len(v.bat.Zs))
+	for i, sels := range v.sels {
+		if v.matched[i] || uint64(i+1) == v.nullValue {
+			for _, sel := range sels {
+				flgs[sel] = true
+			}
+		}
+	}
+	ctr.sels = ctr.sels[:0]
+	for i, flg := range flgs {
+		if !flg {
+			ctr.sels = append(ctr.sels, int64(i))
+		}
+	}
+	if len(ctr.sels) == 0 {
+		return nil
+	}
+	rbat := batch.New(true, ctr.attrs)
+	nullSels := make([]int64, len(ctr.sels))
+	for _, j := range ctr.is {
+		typ := fact.Type(ctr.attrs[j])
+		rbat.Vecs[j] = vector.New(typ)
+		vec, sels := NullVector(typ), nullSels
+		for k, attr := range v.attrs { // join attributes are the same on both sides
+			if attr == ctr.attrs[j] {
+				vec, sels = v.bat.Vecs[k], ctr.sels
+				break
+			}
+		}
+		if err := vector.Union(rbat.Vecs[j], vec, sels, proc.Mp); err != nil {
+			batch.Clean(rbat, proc.Mp)
+			return err
+		}
+	}
+	for i, j := range v.is {
+		vec := v.bat.Vecs[v.ois[i]]
+		rbat.Vecs[j] = vector.New(vec.Typ)
+		if err := vector.Union(rbat.Vecs[j], vec, ctr.sels, proc.Mp); err != nil {
+			batch.Clean(rbat, proc.Mp)
+			return err
+		}
+	}
+	for _, sel := range ctr.sels {
+		rbat.Zs = append(rbat.Zs, v.bat.Zs[sel])
+	}
+	proc.Reg.InputBatch = rbat
+	return nil
+}
+
+// cross sets the values of a view without join attributes, all the rows of
+// the view are in the first group, and nothing matches if the view is empty.
+func (v *view) cross(n int) {
+	var val uint64
+
+	if len(v.sels) > 0 && v.nullValue != 1 {
+		val = 1
+	}
+	for k := range v.values[:n] {
+		v.values[k] = val
+	}
+}
+
+// extend replaces the missed values with the value of the null row for
+// outer join, and records the matched groups for full join.
+func (v *view) extend(n int) {
+	if v.nullValue == 0 {
+		return
+	}
+	for k, val := range v.values[:n] {
+		switch {
+		case val == 0:
+			v.values[k] = v.nullValue
+		case v.matched != nil:
+			v.matched[val-1] = true
+		}
+	}
+}
+
+// NullVector returns a vector of the type with only one row which is null.
+func NullVector(typ types.Type) *vector.Vector {
+	vec := vector.New(typ)
+	switch typ.Oid {
+	case types.T_int8:
+		vec.Col = []int8{0}
+	case types.T_int16:
+		vec.Col = []int16{0}
+	case types.T_int32:
+		vec.Col = []int32{0}
+	case types.T_int64:
+		vec.Col = []int64{0}
+	case types.T_uint8:
+		vec.Col = []uint8{0}
+	case types.T_uint16:
+		vec.Col = []uint16{0}
+	case types.T_uint32:
+		vec.Col = []uint32{0}
+	case types.T_uint64:
+		vec.Col = []uint64{0}
+	case types.T_float32:
+		vec.Col = []float32{0}
+	case types.T_float64:
+		vec.Col = []float64{0}
+	case types.T_date:
+		vec.Col = []types.Date{0}
+	case types.T_datetime:
+		vec.Col = []types.Datetime{0}
+	case types.T_decimal:
+		vec.Col = []types.Decimal{{}}
+	case types.T_char, types.T_varchar:
+		vec.Col.(*types.Bytes).Append([][]byte{{}})
+	}
+	nulls.Add(vec.Nsp, 0)
+	return vec
+}
+
 func (ctr *Container) processPureJoin(n, start int, bat, rbat *batch.Batch, proc *process.Process) error {
 	{
 		var flg bool
@@ -348,8 +690,18 @@ func (ctr *Container) dupMatrix(xs [][]int64) [][]int64 {
 }
 
 func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
-	if len(v.vecs) == 1 {
-		return ctr.probeViewWithOneVar(i, n, bat, v)
+	if len(v.vecs) == 0 { // cross product
+		v.cross(n)
+		v.extend(n)
+		return nil
+	}
+	// a decimal does not fit in the keys of the integer hash map
+	if len(v.vecs) == 1 && v.vecs[0].Typ.Oid != types.T_decimal {
+		if err := ctr.probeViewWithOneVar(i, n, bat, v); err != nil {
+			return err
+		}
+		v.extend(n)
+		return nil
 	}
 	copy(ctr.zValues[:n], OneInt64s[:n])
 	for _, vec := range v.vecs {
@@ -364,7 +716,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*1:(i+k+1)*1]...)
 					}
@@ -380,7 +732,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*2:(i+k+1)*2]...)
 					}
@@ -396,7 +748,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*4:(i+k+1)*4]...)
 					}
@@ -412,7 +764,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
 					}
@@ -428,7 +780,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*1:(i+k+1)*1]...)
 					}
@@ -444,7 +796,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*2:(i+k+1)*2]...)
 					}
@@ -460,7 +812,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*4:(i+k+1)*4]...)
 					}
@@ -476,7 +828,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
 					}
@@ -492,7 +844,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*4:(i+k+1)*4]...)
 					}
@@ -508,12 +860,28 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
 					}
 				}
 			}
+		case types.T_decimal:
+			vs := vec.Col.([]types.Decimal)
+			data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*16)[:len(vs)*16]
+			if !nulls.Any(vec.Nsp) {
+				for k := 0; k < n; k++ {
+					ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*16:(i+k+1)*16]...)
+				}
+			} else {
+				for k := 0; k < n; k++ {
+					if vec.Nsp.Np.Contains(uint64(i + k)) {
+						ctr.zValues[k] = 0
+					} else {
+						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*16:(i+k+1)*16]...)
+					}
+				}
+			}
 		case types.T_float32:
 			vs := vec.Col.([]float32)
 			data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*4)[:len(vs)*4]
@@ -524,7 +892,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*4:(i+k+1)*4]...)
 					}
@@ -540,7 +908,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
 					}
@@ -555,7 +923,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], vs.Get(int64(i+k))...)
 					}
@@ -568,10 +936,11 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			ctr.hstr.keys[k] = append(ctr.hstr.keys[k], hashtable.StrKeyPadding[l:]...)
 		}
 	}
-	v.strHashMap.FindStringBatch(ctr.strHashStates, ctr.hstr.keys[:n], v.values)
+	v.strHashMap.FindStringBatchWithRing(ctr.strHashStates, ctr.zValues, ctr.hstr.keys[:n], v.values)
 	for k := 0; k < n; k++ {
 		ctr.hstr.keys[k] = ctr.hstr.keys[k][:0]
 	}
+	v.extend(n)
 	return nil
 }
 
@@ -590,7 +959,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -609,7 +978,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -628,7 +997,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -647,7 +1016,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -666,7 +1035,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -685,7 +1054,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -704,7 +1073,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -723,7 +1092,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -742,7 +1111,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -761,7 +1130,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -780,7 +1149,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -799,7 +1168,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -825,7 +1194,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.hstr.keys[k] = append(ctr.hstr.keys[k], vs.Get(int64(i+k))...)
 			}
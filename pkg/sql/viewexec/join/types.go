@@ -17,18 +17,30 @@ package join
 import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
 )
 
 const (
 	UnitLimit = 256
 )
 
+const (
+	Inner = iota
+	Outer // rows of the fact without a match are joined with the null row of views
+	Full  // as Outer, and rows of views without a match are joined with a null fact
+)
+
 var OneInt64s []int64
 
 type view struct {
 	isPure bool // true: primary key join
 
+	nullValue uint64 // value of the null row, 0 for inner join
+	matched   []bool // groups which have been matched, used by full join
+
 	is  []int // subscript in the result attribute
 	ois []int // subscript in the origin batch
 
@@ -41,6 +53,23 @@ type view struct {
 	strHashMap *hashtable.StringHashMap
 }
 
+// Schema is the attributes and types of a batch, it is used to build the
+// null-extended rows when one side of an outer join is empty.
+type Schema struct {
+	Attrs []string
+	Types []types.Type
+}
+
+// Type returns the type of the attribute.
+func (s Schema) Type(attr string) types.Type {
+	for i, a := range s.Attrs {
+		if a == attr {
+			return s.Types[i]
+		}
+	}
+	return types.Type{}
+}
+
 type HashTable struct {
 	Sels       [][]int64
 	IntHashMap *hashtable.Int64HashMap
@@ -72,6 +101,12 @@ type Container struct {
 
 	views []*view
 
+	done bool // unmatched rows of views have been sent, or the spilled partitions have been joined
+
+	// part stores the rows of the fact when a view is spilled, the rows
+	// are joined partition by partition after all the rows are received.
+	part *spill.Partition
+
 	hstr struct {
 		keys [][]byte
 	}
@@ -82,8 +117,26 @@ type Container struct {
 }
 
 type Argument struct {
+	Type   int
 	Result []string
 	Vars   [][]string
+	Fact   Schema   // schema of the fact, used by full join
+	Views  []Schema // schemas of views, used by outer join
 	ctr    *Container
 	Bats   []*batch.Batch
+	// Spill is the view which is too large to be held in memory, nil if
+	// all the views are in memory.
+	Spill *SpilledView
+}
+
+// SpilledView is a view whose rows are written into partitions, the rows of
+// the fact are divided in the same way and joined partition by partition.
+type SpilledView struct {
+	// Index is the subscript of the view
+	Index int
+	// Part is the partitions of the rows of the view
+	Part *spill.Partition
+	// Construct builds the view from the rows of a partition, bat is nil
+	// if the partition is empty.
+	Construct func(bat *batch.Batch, m *mheap.Mheap) (*batch.Batch, error)
 }
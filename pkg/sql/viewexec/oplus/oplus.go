@@ -167,6 +167,8 @@ func (ctr *Container) fillBatch(bat *batch.Batch, proc *process.Process) error {
 				size += 4 + 1
 			case types.T_datetime:
 				size += 8 + 1
+			case types.T_decimal:
+				size += 16 + 1
 			case types.T_char:
 				if width := vec.Typ.Width; width > 0 {
 					size += int(width) + 1
@@ -1083,6 +1085,26 @@ func (ctr *Container) fillH24(bat *batch.Batch, proc *process.Process) error {
 							}
 						}
 					}
+				case types.T_decimal:
+					vs := vecs[j].Col.([]types.Decimal)
+					if !nulls.Any(vecs[j].Nsp) {
+						for k := int64(0); k < n; k++ {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+						}
+						add.Uint32AddScalar(17, ctr.keyOffs[:n], ctr.keyOffs[:n])
+					} else {
+						for k := int64(0); k < n; k++ {
+							if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 1
+								ctr.keyOffs[k]++
+							} else {
+								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 0
+								*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+								ctr.keyOffs[k] += 17
+							}
+						}
+					}
 				case types.T_char, types.T_varchar:
 					vs := vecs[j].Col.(*types.Bytes)
 					if !nulls.Any(vecs[j].Nsp) {
@@ -1363,6 +1385,26 @@ func (ctr *Container) fillH24(bat *batch.Batch, proc *process.Process) error {
 						}
 					}
 				}
+			case types.T_decimal:
+				vs := vecs[j].Col.([]types.Decimal)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 0
+						*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+					}
+					add.Uint32AddScalar(17, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+							ctr.keyOffs[k] += 17
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				if !nulls.Any(vecs[j].Nsp) {
@@ -1676,6 +1718,26 @@ func (ctr *Container) fillH32(bat *batch.Batch, proc *process.Process) error {
 							}
 						}
 					}
+				case types.T_decimal:
+					vs := vecs[j].Col.([]types.Decimal)
+					if !nulls.Any(vecs[j].Nsp) {
+						for k := int64(0); k < n; k++ {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+						}
+						add.Uint32AddScalar(17, ctr.keyOffs[:n], ctr.keyOffs[:n])
+					} else {
+						for k := int64(0); k < n; k++ {
+							if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 1
+								ctr.keyOffs[k]++
+							} else {
+								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 0
+								*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+								ctr.keyOffs[k] += 17
+							}
+						}
+					}
 				case types.T_char, types.T_varchar:
 					vs := vecs[j].Col.(*types.Bytes)
 					if !nulls.Any(vecs[j].Nsp) {
@@ -1956,6 +2018,26 @@ func (ctr *Container) fillH32(bat *batch.Batch, proc *process.Process) error {
 						}
 					}
 				}
+			case types.T_decimal:
+				vs := vecs[j].Col.([]types.Decimal)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 0
+						*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+					}
+					add.Uint32AddScalar(17, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+							ctr.keyOffs[k] += 17
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				if !nulls.Any(vecs[j].Nsp) {
@@ -2269,6 +2351,26 @@ func (ctr *Container) fillH40(bat *batch.Batch, proc *process.Process) error {
 							}
 						}
 					}
+				case types.T_decimal:
+					vs := vecs[j].Col.([]types.Decimal)
+					if !nulls.Any(vecs[j].Nsp) {
+						for k := int64(0); k < n; k++ {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+						}
+						add.Uint32AddScalar(17, ctr.keyOffs[:n], ctr.keyOffs[:n])
+					} else {
+						for k := int64(0); k < n; k++ {
+							if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 1
+								ctr.keyOffs[k]++
+							} else {
+								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 0
+								*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+								ctr.keyOffs[k] += 17
+							}
+						}
+					}
 				case types.T_char, types.T_varchar:
 					vs := vecs[j].Col.(*types.Bytes)
 					if !nulls.Any(vecs[j].Nsp) {
@@ -2549,6 +2651,26 @@ func (ctr *Container) fillH40(bat *batch.Batch, proc *process.Process) error {
 						}
 					}
 				}
+			case types.T_decimal:
+				vs := vecs[j].Col.([]types.Decimal)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 0
+						*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+					}
+					add.Uint32AddScalar(17, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+							ctr.keyOffs[k] += 17
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				if !nulls.Any(vecs[j].Nsp) {
@@ -2835,6 +2957,24 @@ func (ctr *Container) fillHStr(bat *batch.Batch, proc *process.Process) error {
 							}
 						}
 					}
+				case types.T_decimal:
+					vs := vecs[j].Col.([]types.Decimal)
+					data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*16)[:len(vs)*16]
+					if !nulls.Any(vecs[j].Nsp) {
+						for k := int64(0); k < n; k++ {
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(0))
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*16:(i+k+1)*16]...)
+						}
+					} else {
+						for k := int64(0); k < n; k++ {
+							if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+								ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(1))
+							} else {
+								ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(0))
+								ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*16:(i+k+1)*16]...)
+							}
+						}
+					}
 				case types.T_char, types.T_varchar:
 					vs := vecs[j].Col.(*types.Bytes)
 					if !nulls.Any(vecs[j].Nsp) {
@@ -3091,6 +3231,24 @@ func (ctr *Container) fillHStr(bat *batch.Batch, proc *process.Process) error {
 						}
 					}
 				}
+			case types.T_decimal:
+				vs := vecs[j].Col.([]types.Decimal)
+				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*16)[:len(vs)*16]
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(0))
+						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*16:(i+k+1)*16]...)
+					}
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(1))
+						} else {
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(0))
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*16:(i+k+1)*16]...)
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				if !nulls.Any(vecs[j].Nsp) {
@@ -24,7 +24,9 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vectorize/add"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
 )
 
 func String(_ interface{}, buf *bytes.Buffer) {
@@ -89,12 +91,26 @@ func (ctr *Container) processFreeVars(proc *process.Process) (bool, error) {
 	for {
 		switch ctr.state {
 		case Fill:
-			if err := ctr.fill(proc); err != nil {
-				batch.Clean(ctr.bat, proc.Mp)
+			err := ctr.fill(proc)
+			mheap.Release(proc.Mp, ctr.size)
+			ctr.size = 0
+			if err != nil {
+				if ctr.bat != nil {
+					batch.Clean(ctr.bat, proc.Mp)
+					ctr.bat = nil
+				}
+				ctr.clean(proc)
 				proc.Reg.InputBatch = nil
 				ctr.state = Eval
 				return true, err
 			}
+			if ctr.part != nil {
+				if err := ctr.eval(proc); err != nil {
+					proc.Reg.InputBatch = nil
+					ctr.state = Eval
+					return true, err
+				}
+			}
 			ctr.state = Eval
 		case Eval:
 			if ctr.bat != nil {
@@ -107,6 +123,143 @@ func (ctr *Container) processFreeVars(proc *process.Process) (bool, error) {
 	}
 }
 
+// eval aggregates the partitions one by one, and the results are merged into ctr.bat.
+func (ctr *Container) eval(proc *process.Process) error {
+	var rbat *batch.Batch
+
+	defer ctr.clean(proc)
+	for ctr.idx < len(ctr.part.Fs) {
+		err := ctr.fillPartition(proc)
+		if err == nil && rbat != nil {
+			err = appendBatch(rbat, ctr.bat, proc)
+		}
+		if err != nil {
+			if rbat != nil {
+				batch.Clean(rbat, proc.Mp)
+			}
+			if ctr.bat != nil {
+				batch.Clean(ctr.bat, proc.Mp)
+				ctr.bat = nil
+			}
+			return err
+		}
+		if rbat == nil {
+			rbat = ctr.bat
+		} else {
+			batch.Clean(ctr.bat, proc.Mp)
+		}
+		ctr.bat = nil
+	}
+	if rbat != nil && len(rbat.Zs) > 0 {
+		ctr.bat = rbat
+	} else if rbat != nil {
+		batch.Clean(rbat, proc.Mp)
+	}
+	return nil
+}
+
+// appendBatch appends the groups of bat to rbat.
+func appendBatch(rbat, bat *batch.Batch, proc *process.Process) error {
+	n := len(bat.Zs)
+	if n == 0 {
+		return nil
+	}
+	flags := make([]uint8, n)
+	for i := range flags {
+		flags[i] = 1
+	}
+	for i, vec := range rbat.Vecs {
+		if err := vector.UnionBatch(vec, bat.Vecs[i], 0, n, flags, proc.Mp); err != nil {
+			return err
+		}
+	}
+	start := int64(len(rbat.Zs))
+	for i, r := range rbat.Rs {
+		if err := r.Grows(n, proc.Mp); err != nil {
+			return err
+		}
+		for k := 0; k < n; k++ {
+			r.Add(bat.Rs[i], start+int64(k), int64(k))
+		}
+	}
+	rbat.Zs = append(rbat.Zs, bat.Zs...)
+	return nil
+}
+
+// fillPartition aggregates the next partition into ctr.bat.
+func (ctr *Container) fillPartition(proc *process.Process) error {
+	f := ctr.part.Fs[ctr.idx]
+	ctr.idx++
+	bat, err := spill.Rows(ctr.tmpl, nil, proc.Mp)
+	if err != nil {
+		return err
+	}
+	ctr.bat = bat
+	ctr.rows = 0
+	if ctr.typ == H8 {
+		ctr.intHashMap = &hashtable.Int64HashMap{}
+		ctr.intHashMap.Init()
+		ctr.bat.Ht = ctr.intHashMap
+	} else {
+		ctr.strHashMap = &hashtable.StringHashMap{}
+		ctr.strHashMap.Init()
+		ctr.bat.Ht = ctr.strHashMap
+	}
+	r, err := f.Reader()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	defer f.Remove()
+	for {
+		bat, err := r.Read()
+		if err != nil {
+			return err
+		}
+		if bat == nil {
+			return nil
+		}
+		if err := ctr.fillBatch(bat, proc); err != nil {
+			return err
+		}
+	}
+}
+
+// spill writes the result and the following batches into the partitions,
+// bat is the batch being received and is used as the template of results.
+func (ctr *Container) spill(bat *batch.Batch, proc *process.Process) error {
+	var err error
+
+	if ctr.tmpl, err = spill.Rows(bat, nil, proc.Mp); err != nil {
+		return err
+	}
+	if ctr.part, err = spill.NewPartition(spill.Partitions, ctr.vars, proc); err != nil {
+		return err
+	}
+	if ctr.bat != nil {
+		if err = ctr.part.Write(ctr.bat, proc.Mp); err != nil {
+			return err
+		}
+		batch.Clean(ctr.bat, proc.Mp)
+		ctr.bat = nil
+	}
+	mheap.Release(proc.Mp, ctr.size)
+	ctr.size = 0
+	return nil
+}
+
+// clean removes the temporary files.
+func (ctr *Container) clean(proc *process.Process) {
+	if ctr.part != nil {
+		ctr.part.Remove()
+		ctr.part = nil
+	}
+	if ctr.tmpl != nil {
+		batch.Clean(ctr.tmpl, proc.Mp)
+		ctr.tmpl = nil
+	}
+}
+
 func (ctr *Container) fill(proc *process.Process) error {
 	for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
 		bat := <-proc.Reg.MergeReceivers[i].Ch
@@ -117,7 +270,29 @@ func (ctr *Container) fill(proc *process.Process) error {
 			i--
 			continue
 		}
-		if err := ctr.fillBatch(bat, proc); err != nil {
+		if ctr.part == nil {
+			size := spill.Size(bat)
+			if err := mheap.Reserve(proc.Mp, size); err == nil {
+				ctr.size += size
+				if err := ctr.fillBatch(bat, proc); err != nil {
+					return err
+				}
+				continue
+			} else if !spill.Enabled(proc) {
+				batch.Clean(bat, proc.Mp)
+				return err
+			}
+			if len(ctr.vars) == 0 {
+				ctr.init(bat)
+			}
+			if err := ctr.spill(bat, proc); err != nil {
+				batch.Clean(bat, proc.Mp)
+				return err
+			}
+		}
+		err := ctr.part.Write(bat, proc.Mp)
+		batch.Clean(bat, proc.Mp)
+		if err != nil {
 			return err
 		}
 	}
@@ -126,83 +301,7 @@ func (ctr *Container) fill(proc *process.Process) error {
 
 func (ctr *Container) fillBatch(bat *batch.Batch, proc *process.Process) error {
 	if len(ctr.vars) == 0 {
-		ctr.vars = append(ctr.vars, bat.Attrs...)
-		size := 0
-		for _, vec := range bat.Vecs {
-			switch vec.Typ.Oid {
-			case types.T_int8:
-				size += 1 + 1
-			case types.T_int16:
-				size += 2 + 1
-			case types.T_int32:
-				size += 4 + 1
-			case types.T_int64:
-				size += 8 + 1
-			case types.T_uint8:
-				size += 1 + 1
-			case types.T_uint16:
-				size += 2 + 1
-			case types.T_uint32:
-				size += 4 + 1
-			case types.T_uint64:
-				size += 8 + 1
-			case types.T_float32:
-				size += 4 + 1
-			case types.T_float64:
-				size += 8 + 1
-			case types.T_date:
-				size += 4 + 1
-			case types.T_datetime:
-				size += 8 + 1
-			case types.T_char:
-				if width := vec.Typ.Width; width > 0 {
-					size += int(width) + 1
-				} else {
-					size = 128
-				}
-			case types.T_varchar:
-				if width := vec.Typ.Width; width > 0 {
-					size += int(width) + 1
-				} else {
-					size = 128
-				}
-			}
-		}
-		ctr.keyOffs = make([]uint32, UnitLimit)
-		ctr.zKeyOffs = make([]uint32, UnitLimit)
-		ctr.inserted = make([]uint8, UnitLimit)
-		ctr.zInserted = make([]uint8, UnitLimit)
-		ctr.hashes = make([]uint64, UnitLimit)
-		ctr.strHashStates = make([][3]uint64, UnitLimit)
-		ctr.values = make([]uint64, UnitLimit)
-		ctr.intHashMap = &hashtable.Int64HashMap{}
-		ctr.strHashMap = &hashtable.StringHashMap{}
-		switch {
-		case size <= 8:
-			ctr.typ = H8
-			ctr.h8.keys = make([]uint64, UnitLimit)
-			ctr.h8.zKeys = make([]uint64, UnitLimit)
-			ctr.intHashMap.Init()
-		case size <= 24:
-			ctr.typ = H24
-			ctr.h24.keys = make([][3]uint64, UnitLimit)
-			ctr.h24.zKeys = make([][3]uint64, UnitLimit)
-			ctr.strHashMap.Init()
-		case size <= 32:
-			ctr.typ = H32
-			ctr.h32.keys = make([][4]uint64, UnitLimit)
-			ctr.h32.zKeys = make([][4]uint64, UnitLimit)
-			ctr.strHashMap.Init()
-		case size <= 40:
-			ctr.typ = H40
-			ctr.h40.keys = make([][5]uint64, UnitLimit)
-			ctr.h40.zKeys = make([][5]uint64, UnitLimit)
-			ctr.strHashMap.Init()
-		default:
-			ctr.typ = HStr
-			ctr.hstr.keys = make([][]byte, UnitLimit)
-			ctr.strHashMap.Init()
-		}
+		ctr.init(bat)
 	} else {
 		batch.Reorder(bat, ctr.vars)
 	}
@@ -220,6 +319,89 @@ func (ctr *Container) fillBatch(bat *batch.Batch, proc *process.Process) error {
 	}
 }
 
+// init chooses the hash table by the sizes of the attributes of the batch.
+func (ctr *Container) init(bat *batch.Batch) {
+	ctr.vars = append(ctr.vars, bat.Attrs...)
+	size := 0
+	for _, vec := range bat.Vecs {
+		switch vec.Typ.Oid {
+		case types.T_int8:
+			size += 1 + 1
+		case types.T_int16:
+			size += 2 + 1
+		case types.T_int32:
+			size += 4 + 1
+		case types.T_int64:
+			size += 8 + 1
+		case types.T_uint8:
+			size += 1 + 1
+		case types.T_uint16:
+			size += 2 + 1
+		case types.T_uint32:
+			size += 4 + 1
+		case types.T_uint64:
+			size += 8 + 1
+		case types.T_float32:
+			size += 4 + 1
+		case types.T_float64:
+			size += 8 + 1
+		case types.T_date:
+			size += 4 + 1
+		case types.T_datetime:
+			size += 8 + 1
+		case types.T_decimal:
+			size += 16 + 1
+		case types.T_char:
+			if width := vec.Typ.Width; width > 0 {
+				size += int(width) + 1
+			} else {
+				size = 128
+			}
+		case types.T_varchar:
+			if width := vec.Typ.Width; width > 0 {
+				size += int(width) + 1
+			} else {
+				size = 128
+			}
+		}
+	}
+	ctr.keyOffs = make([]uint32, UnitLimit)
+	ctr.zKeyOffs = make([]uint32, UnitLimit)
+	ctr.inserted = make([]uint8, UnitLimit)
+	ctr.zInserted = make([]uint8, UnitLimit)
+	ctr.hashes = make([]uint64, UnitLimit)
+	ctr.strHashStates = make([][3]uint64, UnitLimit)
+	ctr.values = make([]uint64, UnitLimit)
+	ctr.intHashMap = &hashtable.Int64HashMap{}
+	ctr.strHashMap = &hashtable.StringHashMap{}
+	switch {
+	case size <= 8:
+		ctr.typ = H8
+		ctr.h8.keys = make([]uint64, UnitLimit)
+		ctr.h8.zKeys = make([]uint64, UnitLimit)
+		ctr.intHashMap.Init()
+	case size <= 24:
+		ctr.typ = H24
+		ctr.h24.keys = make([][3]uint64, UnitLimit)
+		ctr.h24.zKeys = make([][3]uint64, UnitLimit)
+		ctr.strHashMap.Init()
+	case size <= 32:
+		ctr.typ = H32
+		ctr.h32.keys = make([][4]uint64, UnitLimit)
+		ctr.h32.zKeys = make([][4]uint64, UnitLimit)
+		ctr.strHashMap.Init()
+	case size <= 40:
+		ctr.typ = H40
+		ctr.h40.keys = make([][5]uint64, UnitLimit)
+		ctr.h40.zKeys = make([][5]uint64, UnitLimit)
+		ctr.strHashMap.Init()
+	default:
+		ctr.typ = HStr
+		ctr.hstr.keys = make([][]byte, UnitLimit)
+		ctr.strHashMap.Init()
+	}
+}
+
 func (ctr *Container) fillH8(bat *batch.Batch, proc *process.Process) error {
 	if ctr.bat == nil {
 		ctr.bat = bat
@@ -799,6 +981,26 @@ func (ctr *Container) fillH24(bat *batch.Batch, proc *process.Process) error {
 						}
 					}
 				}
+			case types.T_decimal:
+				vs := vecs[j].Col.([]types.Decimal)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 0
+						*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+					}
+					add.Uint32AddScalar(17, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+							ctr.keyOffs[k] += 17
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				if !nulls.Any(vecs[j].Nsp) {
@@ -1117,6 +1319,26 @@ func (ctr *Container) fillH32(bat *batch.Batch, proc *process.Process) error {
 						}
 					}
 				}
+			case types.T_decimal:
+				vs := vecs[j].Col.([]types.Decimal)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 0
+						*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+					}
+					add.Uint32AddScalar(17, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+							ctr.keyOffs[k] += 17
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				if !nulls.Any(vecs[j].Nsp) {
@@ -1435,6 +1657,26 @@ func (ctr *Container) fillH40(bat *batch.Batch, proc *process.Process) error {
 						}
 					}
 				}
+			case types.T_decimal:
+				vs := vecs[j].Col.([]types.Decimal)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 0
+						*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+					}
+					add.Uint32AddScalar(17, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+							ctr.keyOffs[k] += 17
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				if !nulls.Any(vecs[j].Nsp) {
@@ -1726,6 +1968,24 @@ func (ctr *Container) fillHStr(bat *batch.Batch, proc *process.Process) error {
 						}
 					}
 				}
+			case types.T_decimal:
+				vs := vecs[j].Col.([]types.Decimal)
+				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*16)[:len(vs)*16]
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(0))
+						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*16:(i+k+1)*16]...)
+					}
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(1))
+						} else {
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(0))
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*16:(i+k+1)*16]...)
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				if !nulls.Any(vecs[j].Nsp) {
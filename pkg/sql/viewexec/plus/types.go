@@ -17,6 +17,7 @@ package plus
 import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
 )
 
 const (
@@ -77,6 +78,16 @@ type Container struct {
 		keys [][]byte
 	}
 	bat *batch.Batch
+	// size is the bytes reserved from the guest mmu
+	size int64
+
+	// part stores the rows when the memory limitation is exceeded,
+	// the partitions are aggregated one by one.
+	part *spill.Partition
+	// idx is the next partition to be aggregated
+	idx int
+	// tmpl is an empty batch which is the initial result of a partition
+	tmpl *batch.Batch
 }
 
 type Argument struct {
@@ -21,11 +21,13 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/join"
 	"github.com/matrixorigin/matrixone/pkg/vectorize/add"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
 )
 
 func init() {
@@ -76,34 +78,61 @@ func Prepare(proc *process.Process, arg interface{}) error {
 	n.ctr.strHashStates = make([][3]uint64, UnitLimit)
 	n.ctr.isPure = true
 	for i := 0; i < len(n.ctr.views); i++ {
-		n.ctr.views[i] = new(view)
-		n.ctr.views[i].attrs = n.Vars[i]
-		n.ctr.views[i].values = make([]uint64, UnitLimit)
-		n.ctr.views[i].vecs = make([]*vector.Vector, len(n.Vars[i]))
-
-		n.ctr.views[i].bat = n.Bats[i]
-		ht := n.Bats[i].Ht.(*join.HashTable)
-		n.ctr.views[i].sels = ht.Sels
-		n.ctr.views[i].isPure = true
-		for _, sel := range ht.Sels {
-			if len(sel) > 1 {
-				n.ctr.isPure = false
-				n.ctr.views[i].isPure = false
-				break
-			}
-			if n.Bats[i].Zs[sel[0]] > 1 {
-				n.ctr.views[i].isPure = false
-			}
-		}
-		n.ctr.views[i].intHashMap = ht.IntHashMap
-		n.ctr.views[i].strHashMap = ht.StrHashMap
+		if n.Spill != nil && i == n.Spill.Index { // built when the partition is joined
+			continue
+		}
+		n.ctr.newView(i, n.Bats[i], n)
 	}
 	return nil
 }
 
+func (ctr *Container) newView(i int, bat *batch.Batch, n *Argument) {
+	v := new(view)
+	if ov := ctr.views[i]; ov != nil { // view of the previous partition
+		v.is, v.ris, v.ois = ov.is, ov.ris, ov.ois
+	}
+	ctr.views[i] = v
+	v.attrs = n.Vars[i]
+	v.values = make([]uint64, UnitLimit)
+	v.vecs = make([]*vector.Vector, len(n.Vars[i]))
+
+	v.bat = bat
+	ht := bat.Ht.(*join.HashTable)
+	v.sels = ht.Sels
+	v.isPure = true
+	for _, sel := range ht.Sels {
+		if len(sel) > 1 {
+			v.isPure = false
+			break
+		}
+		if bat.Zs[sel[0]] > 1 {
+			v.isPure = false
+		}
+	}
+	v.intHashMap = ht.IntHashMap
+	v.strHashMap = ht.StrHashMap
+	if n.Type != join.Inner { // the last group is the null row
+		v.nullValue = uint64(len(ht.Sels))
+	}
+	ctr.isPure = true
+	for _, v := range ctr.views {
+		if v != nil && !v.isPure {
+			ctr.isPure = false
+		}
+	}
+}
+
 func Call(proc *process.Process, arg interface{}) (bool, error) {
 	n := arg.(*Argument)
 	bat := proc.Reg.InputBatch
+	if n.Spill != nil {
+		ok, err := n.ctr.processSpill(bat, n, proc)
+		if err != nil {
+			n.ctr.clean(n, proc)
+			proc.Reg.InputBatch = nil
+		}
+		return ok, err
+	}
 	if bat == nil {
 		if n.ctr.pctr.bat != nil {
 			proc.Reg.InputBatch = n.ctr.pctr.bat
@@ -122,6 +151,150 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	return false, nil
 }
 
+// processSpill writes the rows of the fact into the partitions, and joins
+// all the partitions one by one when all the rows have been received.
+func (ctr *Container) processSpill(bat *batch.Batch, n *Argument, proc *process.Process) (bool, error) {
+	sv := n.Spill
+	if bat != nil {
+		if len(bat.Zs) > 0 {
+			if ctr.part == nil {
+				part, err := spill.NewPartition(len(sv.Part.Fs), n.Vars[sv.Index], proc)
+				if err != nil {
+					return true, err
+				}
+				ctr.part = part
+				ctr.bounds(bat)
+			}
+			// the vectors of the bound variables are written as the last
+			// attributes of the batch
+			attrs, vecs, rs := bat.Attrs, bat.Vecs, bat.Rs
+			bat.Attrs = append(attrs[:len(attrs):len(attrs)], bat.As...)
+			bat.Vecs = append(vecs[:len(vecs):len(vecs)], bat.Ht.([]*vector.Vector)...)
+			bat.Rs = nil
+			err := ctr.part.Write(bat, proc.Mp)
+			bat.Attrs, bat.Vecs, bat.Rs = attrs, vecs, rs
+			batch.Clean(bat, proc.Mp)
+			if err != nil {
+				return true, err
+			}
+		}
+		proc.Reg.InputBatch = &batch.Batch{}
+		return false, nil
+	}
+	if ctr.done {
+		proc.Reg.InputBatch = nil
+		return false, nil
+	}
+	ctr.done = true
+	// the operators before times end at the first empty input, so all the
+	// partitions are joined at once
+	err := ctr.joinPartitions(n, proc)
+	ctr.clean(n, proc)
+	if err != nil {
+		return true, err
+	}
+	proc.Reg.InputBatch = ctr.pctr.bat
+	ctr.pctr.bat = nil
+	return false, nil
+}
+
+// joinPartitions joins the partitions of the fact and the view.
+func (ctr *Container) joinPartitions(n *Argument, proc *process.Process) error {
+	if ctr.part == nil {
+		return nil
+	}
+	sv := n.Spill
+	for i := range sv.Part.Fs {
+		if ctr.part.Fs[i].Rows() == 0 {
+			continue
+		}
+		var err error
+		var vbat *batch.Batch
+		if sv.Part.Fs[i].Rows() > 0 {
+			if vbat, err = join.LoadPartition(sv.Part.Fs[i], proc); err != nil {
+				return err
+			}
+		} else if n.Type == join.Inner {
+			continue
+		}
+		if vbat, err = sv.Construct(vbat, proc.Mp); err != nil {
+			return err
+		}
+		ctr.newView(sv.Index, vbat, n)
+		err = ctr.probePartition(ctr.part.Fs[i], proc)
+		v := ctr.views[sv.Index]
+		batch.Clean(v.bat, proc.Mp)
+		v.bat = nil
+		if err != nil {
+			return err
+		}
+		ctr.part.Fs[i].Remove()
+	}
+	return nil
+}
+
+// probePartition joins the rows of the fact in f.
+func (ctr *Container) probePartition(f *spill.File, proc *process.Process) error {
+	r, err := f.Reader()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	for {
+		bat, err := r.Read()
+		if err != nil {
+			return err
+		}
+		if bat == nil {
+			return nil
+		}
+		vecs := ctr.restoreBounds(bat)
+		err = ctr.probe(bat, proc)
+		for _, vec := range vecs {
+			vector.Clean(vec, proc.Mp)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// bounds records the rings of the bound variables of the fact, which are
+// not written into the partitions.
+func (ctr *Container) bounds(bat *batch.Batch) {
+	ctr.rs = make([]ring.Ring, len(bat.Rs))
+	for i, r := range bat.Rs {
+		ctr.rs[i] = r.Dup()
+	}
+	ctr.as, ctr.refs = bat.As, bat.Refs
+}
+
+// restoreBounds sets the rings and the vectors of the bound variables of
+// the fact read from a partition.
+func (ctr *Container) restoreBounds(bat *batch.Batch) []*vector.Vector {
+	n := len(bat.Vecs) - len(ctr.as)
+	vecs := bat.Vecs[n:]
+	bat.Attrs, bat.Vecs, bat.Ht = bat.Attrs[:n], bat.Vecs[:n], vecs
+	bat.Rs = make([]ring.Ring, len(ctr.rs))
+	for i, r := range ctr.rs {
+		bat.Rs[i] = r.Dup()
+	}
+	bat.As, bat.Refs = ctr.as, ctr.refs
+	return vecs
+}
+
+// clean removes the temporary files of the fact.
+func (ctr *Container) clean(n *Argument, proc *process.Process) {
+	if ctr.part != nil {
+		ctr.part.Remove()
+		ctr.part = nil
+	}
+	if v := ctr.views[n.Spill.Index]; v != nil && v.bat != nil {
+		batch.Clean(v.bat, proc.Mp)
+		v.bat = nil
+	}
+}
+
 func (ctr *Container) probe(bat *batch.Batch, proc *process.Process) error {
 	defer batch.Clean(bat, proc.Mp)
 	if ctr.attrs == nil {
@@ -180,6 +353,8 @@ func (ctr *Container) probe(bat *batch.Batch, proc *process.Process) error {
 				size += 4 + 1
 			case types.T_int64, types.T_uint64, types.T_float64, types.T_datetime:
 				size += 8 + 1
+			case types.T_decimal:
+				size += 16 + 1
 			case types.T_char, types.T_varchar:
 				if width := vec.Typ.Width; width > 0 {
 					size += int(width) + 1
@@ -597,8 +772,8 @@ func (ctr *Container) processJoinH0(n, start int, bat *batch.Batch, proc *proces
 		} else {
 			v := ctr.views[j-1]
 			for k, r := range v.bat.Rs {
-				for _, row := range rows {
-					ctr.pctr.bat.Rs[k].Add(r, 0, row)
+				for x, row := range rows {
+					ctr.pctr.bat.Rs[v.ris[k]].Mul(r, 0, row, ctr.zs[x]/v.bat.Zs[row])
 				}
 			}
 		}
@@ -1649,7 +1824,7 @@ func (ctr *Container) processPureJoinH8(n, start int, bat *batch.Batch, proc *pr
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -1674,7 +1849,7 @@ func (ctr *Container) processPureJoinH8(n, start int, bat *batch.Batch, proc *pr
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -1699,7 +1874,7 @@ func (ctr *Container) processPureJoinH8(n, start int, bat *batch.Batch, proc *pr
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -1724,7 +1899,7 @@ func (ctr *Container) processPureJoinH8(n, start int, bat *batch.Batch, proc *pr
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -1749,7 +1924,7 @@ func (ctr *Container) processPureJoinH8(n, start int, bat *batch.Batch, proc *pr
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -1774,7 +1949,7 @@ func (ctr *Container) processPureJoinH8(n, start int, bat *batch.Batch, proc *pr
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -1799,7 +1974,7 @@ func (ctr *Container) processPureJoinH8(n, start int, bat *batch.Batch, proc *pr
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -1824,7 +1999,7 @@ func (ctr *Container) processPureJoinH8(n, start int, bat *batch.Batch, proc *pr
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -1849,7 +2024,7 @@ func (ctr *Container) processPureJoinH8(n, start int, bat *batch.Batch, proc *pr
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -1874,7 +2049,7 @@ func (ctr *Container) processPureJoinH8(n, start int, bat *batch.Batch, proc *pr
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -1899,7 +2074,7 @@ func (ctr *Container) processPureJoinH8(n, start int, bat *batch.Batch, proc *pr
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -1924,7 +2099,7 @@ func (ctr *Container) processPureJoinH8(n, start int, bat *batch.Batch, proc *pr
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -1953,7 +2128,7 @@ func (ctr *Container) processPureJoinH8(n, start int, bat *batch.Batch, proc *pr
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -2339,6 +2514,26 @@ func (ctr *Container) processJoinH24(n, start int, bat *batch.Batch, proc *proce
 						}
 					}
 				}
+			case types.T_decimal:
+				vs := vec.Col.([]types.Decimal)
+				if !nulls.Any(vec.Nsp) {
+					for k, row := range rows {
+						*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 0
+						*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k]+1)) = vs[row]
+					}
+					add.Uint32AddScalar(17, ctr.keyOffs[:len(rows)], ctr.keyOffs[:len(rows)])
+				} else {
+					for k, row := range rows {
+						if vec.Nsp.Np.Contains(uint64(row)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k]+1)) = vs[row]
+							ctr.keyOffs[k] += 17
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vec.Col.(*types.Bytes)
 				if !nulls.Any(vec.Nsp) {
@@ -2608,6 +2803,26 @@ func (ctr *Container) processJoinH24(n, start int, bat *batch.Batch, proc *proce
 							}
 						}
 					}
+				case types.T_decimal:
+					vs := vec.Col.([]types.Decimal)
+					if !nulls.Any(vec.Nsp) {
+						for k, row := range rows {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k]+1)) = vs[row]
+						}
+						add.Uint32AddScalar(17, ctr.keyOffs[:len(rows)], ctr.keyOffs[:len(rows)])
+					} else {
+						for k, row := range rows {
+							if vec.Nsp.Np.Contains(uint64(row)) {
+								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 1
+								ctr.keyOffs[k]++
+							} else {
+								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 0
+								*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k]+1)) = vs[row]
+								ctr.keyOffs[k] += 17
+							}
+						}
+					}
 				case types.T_char, types.T_varchar:
 					vs := vec.Col.(*types.Bytes)
 					if !nulls.Any(vec.Nsp) {
@@ -2970,6 +3185,26 @@ func (ctr *Container) processPureJoinH24(n, start int, bat *batch.Batch, proc *p
 						}
 					}
 				}
+			case types.T_decimal:
+				vs := vec.Col.([]types.Decimal)
+				if !nulls.Any(vec.Nsp) {
+					for k := 0; k < n; k++ {
+						*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 0
+						*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k]+1)) = vs[k+start]
+					}
+					add.Uint32AddScalar(17, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := 0; k < n; k++ {
+						if vec.Nsp.Np.Contains(uint64(k + start)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k]+1)) = vs[k+start]
+							ctr.keyOffs[k] += 17
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vec.Col.(*types.Bytes)
 				if !nulls.Any(vec.Nsp) {
@@ -3013,7 +3248,7 @@ func (ctr *Container) processPureJoinH24(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -3038,7 +3273,7 @@ func (ctr *Container) processPureJoinH24(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -3063,7 +3298,7 @@ func (ctr *Container) processPureJoinH24(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -3088,7 +3323,7 @@ func (ctr *Container) processPureJoinH24(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -3113,7 +3348,7 @@ func (ctr *Container) processPureJoinH24(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -3138,7 +3373,7 @@ func (ctr *Container) processPureJoinH24(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -3163,7 +3398,7 @@ func (ctr *Container) processPureJoinH24(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -3188,7 +3423,7 @@ func (ctr *Container) processPureJoinH24(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -3213,7 +3448,7 @@ func (ctr *Container) processPureJoinH24(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -3238,7 +3473,7 @@ func (ctr *Container) processPureJoinH24(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -3263,7 +3498,7 @@ func (ctr *Container) processPureJoinH24(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -3288,7 +3523,7 @@ func (ctr *Container) processPureJoinH24(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -3298,6 +3533,31 @@ func (ctr *Container) processPureJoinH24(n, start int, bat *batch.Batch, proc *p
 							}
 						}
 					}
+				case types.T_decimal:
+					vs := vec.Col.([]types.Decimal)
+					if !nulls.Any(vec.Nsp) {
+						for k := 0; k < n; k++ {
+							if vp := v.values[k]; vp == 0 {
+								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 1
+								ctr.keyOffs[k]++
+							} else {
+								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 0
+								*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k]+1)) = vs[v.sels[vp-1][0]]
+								ctr.keyOffs[k] += 17
+							}
+						}
+					} else {
+						for k := 0; k < n; k++ {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
+								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 1
+								ctr.keyOffs[k]++
+							} else {
+								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 0
+								*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k]+1)) = vs[v.sels[vp-1][0]]
+								ctr.keyOffs[k] += 17
+							}
+						}
+					}
 				case types.T_char, types.T_varchar:
 					vs := vec.Col.(*types.Bytes)
 					if !nulls.Any(vec.Nsp) {
@@ -3315,7 +3575,7 @@ func (ctr *Container) processPureJoinH24(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								data[k*24+int(ctr.keyOffs[k])] = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -3702,6 +3962,26 @@ func (ctr *Container) processJoinH32(n, start int, bat *batch.Batch, proc *proce
 						}
 					}
 				}
+			case types.T_decimal:
+				vs := vec.Col.([]types.Decimal)
+				if !nulls.Any(vec.Nsp) {
+					for k, row := range rows {
+						*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 0
+						*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k]+1)) = vs[row]
+					}
+					add.Uint32AddScalar(17, ctr.keyOffs[:len(rows)], ctr.keyOffs[:len(rows)])
+				} else {
+					for k, row := range rows {
+						if vec.Nsp.Np.Contains(uint64(row)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k]+1)) = vs[row]
+							ctr.keyOffs[k] += 17
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vec.Col.(*types.Bytes)
 				if !nulls.Any(vec.Nsp) {
@@ -3971,6 +4251,26 @@ func (ctr *Container) processJoinH32(n, start int, bat *batch.Batch, proc *proce
 							}
 						}
 					}
+				case types.T_decimal:
+					vs := vec.Col.([]types.Decimal)
+					if !nulls.Any(vec.Nsp) {
+						for k, row := range rows {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k]+1)) = vs[row]
+						}
+						add.Uint32AddScalar(17, ctr.keyOffs[:len(rows)], ctr.keyOffs[:len(rows)])
+					} else {
+						for k, row := range rows {
+							if vec.Nsp.Np.Contains(uint64(row)) {
+								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 1
+								ctr.keyOffs[k]++
+							} else {
+								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 0
+								*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k]+1)) = vs[row]
+								ctr.keyOffs[k] += 17
+							}
+						}
+					}
 				case types.T_char, types.T_varchar:
 					vs := vec.Col.(*types.Bytes)
 					if !nulls.Any(vec.Nsp) {
@@ -4333,6 +4633,26 @@ func (ctr *Container) processPureJoinH32(n, start int, bat *batch.Batch, proc *p
 						}
 					}
 				}
+			case types.T_decimal:
+				vs := vec.Col.([]types.Decimal)
+				if !nulls.Any(vec.Nsp) {
+					for k := 0; k < n; k++ {
+						*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 0
+						*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k]+1)) = vs[k+start]
+					}
+					add.Uint32AddScalar(17, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := 0; k < n; k++ {
+						if vec.Nsp.Np.Contains(uint64(k + start)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k]+1)) = vs[k+start]
+							ctr.keyOffs[k] += 17
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vec.Col.(*types.Bytes)
 				if !nulls.Any(vec.Nsp) {
@@ -4376,7 +4696,7 @@ func (ctr *Container) processPureJoinH32(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -4401,7 +4721,7 @@ func (ctr *Container) processPureJoinH32(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -4426,7 +4746,7 @@ func (ctr *Container) processPureJoinH32(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -4451,7 +4771,7 @@ func (ctr *Container) processPureJoinH32(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -4476,7 +4796,7 @@ func (ctr *Container) processPureJoinH32(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -4501,7 +4821,7 @@ func (ctr *Container) processPureJoinH32(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -4526,7 +4846,7 @@ func (ctr *Container) processPureJoinH32(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -4551,7 +4871,7 @@ func (ctr *Container) processPureJoinH32(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -4576,7 +4896,7 @@ func (ctr *Container) processPureJoinH32(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -4601,7 +4921,7 @@ func (ctr *Container) processPureJoinH32(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -4626,7 +4946,7 @@ func (ctr *Container) processPureJoinH32(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -4651,7 +4971,7 @@ func (ctr *Container) processPureJoinH32(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -4661,6 +4981,31 @@ func (ctr *Container) processPureJoinH32(n, start int, bat *batch.Batch, proc *p
 							}
 						}
 					}
+				case types.T_decimal:
+					vs := vec.Col.([]types.Decimal)
+					if !nulls.Any(vec.Nsp) {
+						for k := 0; k < n; k++ {
+							if vp := v.values[k]; vp == 0 {
+								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 1
+								ctr.keyOffs[k]++
+							} else {
+								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 0
+								*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k]+1)) = vs[v.sels[vp-1][0]]
+								ctr.keyOffs[k] += 17
+							}
+						}
+					} else {
+						for k := 0; k < n; k++ {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
+								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 1
+								ctr.keyOffs[k]++
+							} else {
+								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 0
+								*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k]+1)) = vs[v.sels[vp-1][0]]
+								ctr.keyOffs[k] += 17
+							}
+						}
+					}
 				case types.T_char, types.T_varchar:
 					vs := vec.Col.(*types.Bytes)
 					if !nulls.Any(vec.Nsp) {
@@ -4678,7 +5023,7 @@ func (ctr *Container) processPureJoinH32(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								data[k*32+int(ctr.keyOffs[k])] = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -5065,6 +5410,26 @@ func (ctr *Container) processJoinH40(n, start int, bat *batch.Batch, proc *proce
 						}
 					}
 				}
+			case types.T_decimal:
+				vs := vec.Col.([]types.Decimal)
+				if !nulls.Any(vec.Nsp) {
+					for k, row := range rows {
+						*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 0
+						*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k]+1)) = vs[row]
+					}
+					add.Uint32AddScalar(17, ctr.keyOffs[:len(rows)], ctr.keyOffs[:len(rows)])
+				} else {
+					for k, row := range rows {
+						if vec.Nsp.Np.Contains(uint64(row)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k]+1)) = vs[row]
+							ctr.keyOffs[k] += 17
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vec.Col.(*types.Bytes)
 				if !nulls.Any(vec.Nsp) {
@@ -5334,6 +5699,26 @@ func (ctr *Container) processJoinH40(n, start int, bat *batch.Batch, proc *proce
 							}
 						}
 					}
+				case types.T_decimal:
+					vs := vec.Col.([]types.Decimal)
+					if !nulls.Any(vec.Nsp) {
+						for k, row := range rows {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k]+1)) = vs[row]
+						}
+						add.Uint32AddScalar(17, ctr.keyOffs[:len(rows)], ctr.keyOffs[:len(rows)])
+					} else {
+						for k, row := range rows {
+							if vec.Nsp.Np.Contains(uint64(row)) {
+								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 1
+								ctr.keyOffs[k]++
+							} else {
+								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 0
+								*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k]+1)) = vs[row]
+								ctr.keyOffs[k] += 17
+							}
+						}
+					}
 				case types.T_char, types.T_varchar:
 					vs := vec.Col.(*types.Bytes)
 					if !nulls.Any(vec.Nsp) {
@@ -5696,6 +6081,26 @@ func (ctr *Container) processPureJoinH40(n, start int, bat *batch.Batch, proc *p
 						}
 					}
 				}
+			case types.T_decimal:
+				vs := vec.Col.([]types.Decimal)
+				if !nulls.Any(vec.Nsp) {
+					for k := 0; k < n; k++ {
+						*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 0
+						*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k]+1)) = vs[k+start]
+					}
+					add.Uint32AddScalar(17, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := 0; k < n; k++ {
+						if vec.Nsp.Np.Contains(uint64(k + start)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k]+1)) = vs[k+start]
+							ctr.keyOffs[k] += 17
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vec.Col.(*types.Bytes)
 				if !nulls.Any(vec.Nsp) {
@@ -5739,7 +6144,7 @@ func (ctr *Container) processPureJoinH40(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -5764,7 +6169,7 @@ func (ctr *Container) processPureJoinH40(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -5789,7 +6194,7 @@ func (ctr *Container) processPureJoinH40(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -5814,7 +6219,7 @@ func (ctr *Container) processPureJoinH40(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -5839,7 +6244,7 @@ func (ctr *Container) processPureJoinH40(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -5864,7 +6269,7 @@ func (ctr *Container) processPureJoinH40(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -5889,7 +6294,7 @@ func (ctr *Container) processPureJoinH40(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -5914,7 +6319,7 @@ func (ctr *Container) processPureJoinH40(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -5939,7 +6344,7 @@ func (ctr *Container) processPureJoinH40(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -5964,7 +6369,7 @@ func (ctr *Container) processPureJoinH40(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -5989,7 +6394,7 @@ func (ctr *Container) processPureJoinH40(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -6014,7 +6419,7 @@ func (ctr *Container) processPureJoinH40(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -6024,6 +6429,31 @@ func (ctr *Container) processPureJoinH40(n, start int, bat *batch.Batch, proc *p
 							}
 						}
 					}
+				case types.T_decimal:
+					vs := vec.Col.([]types.Decimal)
+					if !nulls.Any(vec.Nsp) {
+						for k := 0; k < n; k++ {
+							if vp := v.values[k]; vp == 0 {
+								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 1
+								ctr.keyOffs[k]++
+							} else {
+								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 0
+								*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k]+1)) = vs[v.sels[vp-1][0]]
+								ctr.keyOffs[k] += 17
+							}
+						}
+					} else {
+						for k := 0; k < n; k++ {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
+								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 1
+								ctr.keyOffs[k]++
+							} else {
+								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 0
+								*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k]+1)) = vs[v.sels[vp-1][0]]
+								ctr.keyOffs[k] += 17
+							}
+						}
+					}
 				case types.T_char, types.T_varchar:
 					vs := vec.Col.(*types.Bytes)
 					if !nulls.Any(vec.Nsp) {
@@ -6041,7 +6471,7 @@ func (ctr *Container) processPureJoinH40(n, start int, bat *batch.Batch, proc *p
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								data[k*40+int(ctr.keyOffs[k])] = 1
 								ctr.keyOffs[k]++
 							} else {
@@ -6397,6 +6827,24 @@ func (ctr *Container) processJoinHStr(n, start int, bat *batch.Batch, proc *proc
 						}
 					}
 				}
+			case types.T_decimal:
+				vs := vec.Col.([]types.Decimal)
+				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*16)[:len(vs)*16]
+				if !nulls.Any(vec.Nsp) {
+					for k, row := range rows {
+						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(0))
+						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[row*16:(row+1)*16]...)
+					}
+				} else {
+					for k, row := range rows {
+						if vec.Nsp.Np.Contains(uint64(row)) {
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(1))
+						} else {
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(0))
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[row*16:(row+1)*16]...)
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vec.Col.(*types.Bytes)
 				if !nulls.Any(vec.Nsp) {
@@ -6637,6 +7085,24 @@ func (ctr *Container) processJoinHStr(n, start int, bat *batch.Batch, proc *proc
 							}
 						}
 					}
+				case types.T_decimal:
+					vs := vec.Col.([]types.Decimal)
+					data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*16)[:len(vs)*16]
+					if !nulls.Any(vec.Nsp) {
+						for k, row := range rows {
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(0))
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[row*16:(row+1)*16]...)
+						}
+					} else {
+						for k, row := range rows {
+							if vec.Nsp.Np.Contains(uint64(row)) {
+								ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(1))
+							} else {
+								ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(0))
+								ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[row*16:(row+1)*16]...)
+							}
+						}
+					}
 				case types.T_char, types.T_varchar:
 					vs := vec.Col.(*types.Bytes)
 					if !nulls.Any(vec.Nsp) {
@@ -6973,6 +7439,24 @@ func (ctr *Container) processPureJoinHStr(n, start int, bat *batch.Batch, proc *
 						}
 					}
 				}
+			case types.T_decimal:
+				vs := vec.Col.([]types.Decimal)
+				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*16)[:len(vs)*16]
+				if !nulls.Any(vec.Nsp) {
+					for k := 0; k < n; k++ {
+						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(0))
+						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(k+start)*16:(k+start+1)*16]...)
+					}
+				} else {
+					for k := 0; k < n; k++ {
+						if vec.Nsp.Np.Contains(uint64(k + start)) {
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(1))
+						} else {
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(0))
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(k+start)*16:(k+start+1)*16]...)
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vec.Col.(*types.Bytes)
 				if !nulls.Any(vec.Nsp) {
@@ -7011,7 +7495,7 @@ func (ctr *Container) processPureJoinHStr(n, start int, bat *batch.Batch, proc *
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(1))
 							} else {
 								row := v.sels[vp-1][0]
@@ -7035,7 +7519,7 @@ func (ctr *Container) processPureJoinHStr(n, start int, bat *batch.Batch, proc *
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(1))
 							} else {
 								row := v.sels[vp-1][0]
@@ -7059,7 +7543,7 @@ func (ctr *Container) processPureJoinHStr(n, start int, bat *batch.Batch, proc *
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(1))
 							} else {
 								row := v.sels[vp-1][0]
@@ -7083,7 +7567,7 @@ func (ctr *Container) processPureJoinHStr(n, start int, bat *batch.Batch, proc *
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(1))
 							} else {
 								row := v.sels[vp-1][0]
@@ -7107,7 +7591,7 @@ func (ctr *Container) processPureJoinHStr(n, start int, bat *batch.Batch, proc *
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(1))
 							} else {
 								row := v.sels[vp-1][0]
@@ -7131,7 +7615,7 @@ func (ctr *Container) processPureJoinHStr(n, start int, bat *batch.Batch, proc *
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(1))
 							} else {
 								row := v.sels[vp-1][0]
@@ -7155,7 +7639,7 @@ func (ctr *Container) processPureJoinHStr(n, start int, bat *batch.Batch, proc *
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(1))
 							} else {
 								row := v.sels[vp-1][0]
@@ -7179,7 +7663,7 @@ func (ctr *Container) processPureJoinHStr(n, start int, bat *batch.Batch, proc *
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(1))
 							} else {
 								row := v.sels[vp-1][0]
@@ -7203,7 +7687,7 @@ func (ctr *Container) processPureJoinHStr(n, start int, bat *batch.Batch, proc *
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(1))
 							} else {
 								row := v.sels[vp-1][0]
@@ -7227,7 +7711,7 @@ func (ctr *Container) processPureJoinHStr(n, start int, bat *batch.Batch, proc *
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(1))
 							} else {
 								row := v.sels[vp-1][0]
@@ -7251,7 +7735,7 @@ func (ctr *Container) processPureJoinHStr(n, start int, bat *batch.Batch, proc *
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(1))
 							} else {
 								row := v.sels[vp-1][0]
@@ -7275,7 +7759,7 @@ func (ctr *Container) processPureJoinHStr(n, start int, bat *batch.Batch, proc *
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(1))
 							} else {
 								row := v.sels[vp-1][0]
@@ -7285,6 +7769,31 @@ func (ctr *Container) processPureJoinHStr(n, start int, bat *batch.Batch, proc *
 							}
 						}
 					}
+				case types.T_decimal:
+					vs := vec.Col.([]types.Decimal)
+					data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*16)[:len(vs)*16]
+					if !nulls.Any(vec.Nsp) {
+						for k := 0; k < n; k++ {
+							if vp := v.values[k]; vp == 0 {
+								ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(1))
+							} else {
+								row := v.sels[vp-1][0]
+								ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(0))
+								ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(row)*16:(row+1)*16]...)
+							}
+						}
+					} else {
+						for k := 0; k < n; k++ {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
+								ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(1))
+							} else {
+								row := v.sels[vp-1][0]
+								ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(0))
+								ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(row)*16:(row+1)*16]...)
+								*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 0
+							}
+						}
+					}
 				case types.T_char, types.T_varchar:
 					vs := vec.Col.(*types.Bytes)
 					if !nulls.Any(vec.Nsp) {
@@ -7299,7 +7808,7 @@ func (ctr *Container) processPureJoinHStr(n, start int, bat *batch.Batch, proc *
 						}
 					} else {
 						for k := 0; k < n; k++ {
-							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(k+start)) {
+							if vp := v.values[k]; vp == 0 || vec.Nsp.Np.Contains(uint64(v.sels[vp-1][0])) {
 								ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(1))
 							} else {
 								row := v.sels[vp-1][0]
@@ -7375,6 +7884,31 @@ func (ctr *Container) processPureJoinHStr(n, start int, bat *batch.Batch, proc *
 	return nil
 }
 
+// cross sets the values of a view without join attributes, all the rows of
+// the view are in the first group, and nothing matches if the view is empty.
+func (v *view) cross(n int) {
+	var val uint64
+
+	if len(v.sels) > 0 && v.nullValue != 1 {
+		val = 1
+	}
+	for k := range v.values[:n] {
+		v.values[k] = val
+	}
+}
+
+// extend replaces the missed values with the value of the null row for outer join.
+func (v *view) extend(n int) {
+	if v.nullValue == 0 {
+		return
+	}
+	for k, val := range v.values[:n] {
+		if val == 0 {
+			v.values[k] = v.nullValue
+		}
+	}
+}
+
 func (ctr *Container) product(xs [][]int64, ys []int64) [][]int64 {
 	rs := ctr.mx1[:len(xs)+1]
 	{ // reset
@@ -7402,8 +7936,17 @@ func (ctr *Container) dupMatrix(xs [][]int64) [][]int64 {
 }
 
 func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
+	if len(v.vecs) == 0 { // cross product
+		v.cross(n)
+		v.extend(n)
+		return nil
+	}
 	if len(v.vecs) == 1 {
-		return ctr.probeViewWithOneVar(i, n, bat, v)
+		if err := ctr.probeViewWithOneVar(i, n, bat, v); err != nil {
+			return err
+		}
+		v.extend(n)
+		return nil
 	}
 	ctr.hstr.keys = ctr.hstr.keys[:UnitLimit]
 	copy(ctr.zValues[:n], OneInt64s[:n])
@@ -7419,7 +7962,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*1:(i+k+1)*1]...)
 					}
@@ -7435,7 +7978,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*2:(i+k+1)*2]...)
 					}
@@ -7451,7 +7994,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*4:(i+k+1)*4]...)
 					}
@@ -7467,7 +8010,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
 					}
@@ -7483,7 +8026,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*1:(i+k+1)*1]...)
 					}
@@ -7499,7 +8042,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*2:(i+k+1)*2]...)
 					}
@@ -7515,7 +8058,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*4:(i+k+1)*4]...)
 					}
@@ -7531,7 +8074,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
 					}
@@ -7547,7 +8090,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*4:(i+k+1)*4]...)
 					}
@@ -7563,12 +8106,28 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
 					}
 				}
 			}
+		case types.T_decimal:
+			vs := vec.Col.([]types.Decimal)
+			data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*16)[:len(vs)*16]
+			if !nulls.Any(vec.Nsp) {
+				for k := 0; k < n; k++ {
+					ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*16:(i+k+1)*16]...)
+				}
+			} else {
+				for k := 0; k < n; k++ {
+					if vec.Nsp.Np.Contains(uint64(i + k)) {
+						ctr.zValues[k] = 0
+					} else {
+						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*16:(i+k+1)*16]...)
+					}
+				}
+			}
 		case types.T_float32:
 			vs := vec.Col.([]float32)
 			data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*4)[:len(vs)*4]
@@ -7579,7 +8138,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*4:(i+k+1)*4]...)
 					}
@@ -7595,7 +8154,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
 					}
@@ -7610,7 +8169,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], vs.Get(int64(i+k))...)
 					}
@@ -7623,10 +8182,11 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			ctr.hstr.keys[k] = append(ctr.hstr.keys[k], hashtable.StrKeyPadding[l:]...)
 		}
 	}
-	v.strHashMap.FindStringBatch(ctr.strHashStates, ctr.hstr.keys[:n], v.values)
+	v.strHashMap.FindStringBatchWithRing(ctr.strHashStates, ctr.zValues, ctr.hstr.keys[:n], v.values)
 	for k := 0; k < n; k++ {
 		ctr.hstr.keys[k] = ctr.hstr.keys[k][:0]
 	}
+	v.extend(n)
 	return nil
 }
 
@@ -7647,7 +8207,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -7666,7 +8226,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -7685,7 +8245,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -7704,7 +8264,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -7723,7 +8283,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -7742,7 +8302,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -7761,7 +8321,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -7780,7 +8340,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -7799,7 +8359,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -7818,7 +8378,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -7837,7 +8397,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -7856,7 +8416,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -7882,7 +8442,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.hstr.keys[k] = append(ctr.hstr.keys[k], vs.Get(int64(i+k))...)
 			}
@@ -17,7 +17,11 @@ package times
 import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/join"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
 )
 
 const (
@@ -38,6 +42,8 @@ var OneInt64s []int64
 type view struct {
 	isPure bool // true: primary key join
 
+	nullValue uint64 // value of the null row, 0 for inner join
+
 	is  []int // subscript in the result attribute
 	ris []int // subscript in the result ring
 	ois []int // subscript in the origin batch
@@ -112,11 +118,41 @@ type Container struct {
 		zKeys [][5]uint64
 	}
 	pctr *probeContainer
+
+	// part stores the rows of the fact when a view is spilled, the rows
+	// are joined partition by partition after all the rows are received.
+	part *spill.Partition
+	// done is true if the partitions have been joined
+	done bool
+	// rs, as and refs are the rings of the bound variables of the fact
+	rs   []ring.Ring
+	as   []string
+	refs []uint64
+}
+
+// Ring is an aggregation of a view, Type is the type of its argument.
+type Ring struct {
+	Op    int
+	Ref   int
+	Alias string
+	Type  types.Type
+}
+
+// Schema is the layout of a view, it is used to build the null-extended
+// row when the view of an outer join is empty.
+type Schema struct {
+	join.Schema
+	Rings []Ring
 }
 
 type Argument struct {
+	Type   int // join.Inner or join.Outer
 	Result []string
 	Vars   [][]string
+	Views  []Schema // schemas of views, used by outer join
 	ctr    *Container
 	Bats   []*batch.Batch
+	// Spill is the view which is too large to be held in memory, nil if
+	// all the views are in memory.
+	Spill *join.SpilledView
 }
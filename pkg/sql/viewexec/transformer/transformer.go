@@ -53,6 +53,7 @@ var sumReturnTypes = map[types.T]types.T{
 	types.T_uint64:  types.T_uint64,
 	types.T_float32: types.T_float64,
 	types.T_float64: types.T_float64,
+	types.T_decimal: types.T_decimal,
 }
 
 func ReturnType(op int, typ types.T) types.T {
@@ -145,6 +146,8 @@ func NewSum(typ types.Type) (ring.Ring, error) {
 		return sum.NewInt(typ), nil
 	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
 		return sum.NewUint(typ), nil
+	case types.T_decimal:
+		return sum.NewDecimal(typ), nil
 	}
 	return nil, errors.New(fmt.Sprintf("'%v' not support Sum", typ))
 }
@@ -177,6 +180,8 @@ func NewMax(typ types.Type) (ring.Ring, error) {
 		return max.NewDate(typ), nil
 	case types.T_datetime:
 		return max.NewDatetime(typ), nil
+	case types.T_decimal:
+		return max.NewDecimal(typ), nil
 	}
 	return nil, errors.New(fmt.Sprintf("'%v' not support Max", typ))
 }
@@ -209,6 +214,8 @@ func NewMin(typ types.Type) (ring.Ring, error) {
 		return min.NewDate(typ), nil
 	case types.T_datetime:
 		return min.NewDatetime(typ), nil
+	case types.T_decimal:
+		return min.NewDecimal(typ), nil
 	}
 	return nil, errors.New(fmt.Sprintf("'%v' not support Min", typ))
 }
@@ -19,6 +19,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 )
 
 const (
@@ -33,6 +34,7 @@ const (
 const (
 	Fill = iota
 	Eval
+	End
 )
 
 const (
@@ -81,8 +83,18 @@ type Container struct {
 	bat *batch.Batch
 }
 
+// BoundVar is an aggregation of the query without free variables, its
+// result is returned even if nothing is aggregated.
+type BoundVar struct {
+	Op    int
+	Ref   uint64
+	Alias string
+	Type  types.Type // return type of the aggregation
+}
+
 type Argument struct {
-	Type     int
-	FreeVars []string // free variables
-	ctr      *Container
+	Type      int
+	FreeVars  []string   // free variables
+	BoundVars []BoundVar // bound variables, only used if there is no free variable
+	ctr       *Container
 }
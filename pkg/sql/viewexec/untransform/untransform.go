@@ -23,6 +23,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/join"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transformer"
 	"github.com/matrixorigin/matrixone/pkg/vectorize/add"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -48,7 +50,7 @@ func Prepare(_ *process.Process, arg interface{}) error {
 func Call(proc *process.Process, arg interface{}) (bool, error) {
 	n := arg.(*Argument)
 	if len(n.FreeVars) == 0 {
-		return n.ctr.processBoundVars(proc)
+		return n.ctr.processBoundVars(n.BoundVars, proc)
 	}
 	return n.ctr.processFreeVars(n.FreeVars, proc)
 	/*
@@ -65,7 +67,7 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	*/
 }
 
-func (ctr *Container) processBoundVars(proc *process.Process) (bool, error) {
+func (ctr *Container) processBoundVars(bvars []BoundVar, proc *process.Process) (bool, error) {
 	for {
 		switch ctr.state {
 		case Fill:
@@ -115,12 +117,55 @@ func (ctr *Container) processBoundVars(proc *process.Process) (bool, error) {
 				}
 				proc.Reg.InputBatch = ctr.bat
 				ctr.bat = nil
+				ctr.state = End
+				return true, nil
 			}
+			if len(bvars) > 0 {
+				bat, err := emptyResult(bvars, proc)
+				if err != nil {
+					proc.Reg.InputBatch = nil
+					return true, err
+				}
+				proc.Reg.InputBatch = bat
+			}
+			ctr.state = End
+			return true, nil
+		case End:
 			return true, nil
 		}
 	}
 }
 
+// emptyResult returns the result of aggregations if nothing is aggregated,
+// the count is zero and the others are null.
+func emptyResult(bvars []BoundVar, proc *process.Process) (*batch.Batch, error) {
+	bat := batch.New(true, nil)
+	for _, bvar := range bvars {
+		var src *vector.Vector
+
+		switch bvar.Op {
+		case transformer.Count, transformer.StarCount:
+			src = vector.New(bvar.Type)
+			src.Col = []int64{0}
+		case transformer.ApproxCountDistinct:
+			src = vector.New(bvar.Type)
+			src.Col = []uint64{0}
+		default:
+			src = join.NullVector(bvar.Type)
+		}
+		vec := vector.New(bvar.Type)
+		if err := vector.UnionOne(vec, src, 0, proc.Mp); err != nil {
+			batch.Clean(bat, proc.Mp)
+			return nil, err
+		}
+		vec.Ref = bvar.Ref
+		bat.Attrs = append(bat.Attrs, bvar.Alias)
+		bat.Vecs = append(bat.Vecs, vec)
+	}
+	bat.Zs = append(bat.Zs, 1)
+	return bat, nil
+}
+
 func (ctr *Container) processBoundVarsWithCAQ(proc *process.Process) (bool, error) {
 	for {
 		switch ctr.state {
@@ -307,6 +352,8 @@ func (ctr *Container) fillBatch(fvars []string, bat *batch.Batch, proc *process.
 				size += 4 + 1
 			case types.T_int64, types.T_uint64, types.T_float64, types.T_datetime:
 				size += 8 + 1
+			case types.T_decimal:
+				size += 16 + 1
 			case types.T_char, types.T_varchar:
 				if width := vec.Typ.Width; width > 0 {
 					size += int(width) + 1
@@ -939,6 +986,26 @@ func (ctr *Container) processH24(fvars []string, bat *batch.Batch, proc *process
 						}
 					}
 				}
+			case types.T_decimal:
+				vs := vecs[j].Col.([]types.Decimal)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 0
+						*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+					}
+					add.Uint32AddScalar(17, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+							ctr.keyOffs[k] += 17
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				if !nulls.Any(vecs[j].Nsp) {
@@ -1251,6 +1318,26 @@ func (ctr *Container) processH32(fvars []string, bat *batch.Batch, proc *process
 						}
 					}
 				}
+			case types.T_decimal:
+				vs := vecs[j].Col.([]types.Decimal)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 0
+						*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+					}
+					add.Uint32AddScalar(17, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+							ctr.keyOffs[k] += 17
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				if !nulls.Any(vecs[j].Nsp) {
@@ -1564,6 +1651,26 @@ func (ctr *Container) processH40(fvars []string, bat *batch.Batch, proc *process
 						}
 					}
 				}
+			case types.T_decimal:
+				vs := vecs[j].Col.([]types.Decimal)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 0
+						*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+					}
+					add.Uint32AddScalar(17, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+							ctr.keyOffs[k] += 17
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				if !nulls.Any(vecs[j].Nsp) {
@@ -1850,6 +1957,24 @@ func (ctr *Container) processHStr(fvars []string, bat *batch.Batch, proc *proces
 						}
 					}
 				}
+			case types.T_decimal:
+				vs := vecs[j].Col.([]types.Decimal)
+				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*16)[:len(vs)*16]
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(0))
+						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*16:(i+k+1)*16]...)
+					}
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(1))
+						} else {
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(0))
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*16:(i+k+1)*16]...)
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				if !nulls.Any(vecs[j].Nsp) {
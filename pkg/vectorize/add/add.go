@@ -14,6 +14,10 @@
 
 package add
 
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
 var (
 	Int8Add              func([]int8, []int8, []int8) []int8
 	Int8AddSels          func([]int8, []int8, []int8, []int64) []int8
@@ -108,6 +112,11 @@ var (
 	Uint8Uint16AddScalar	func(uint8, []uint16, []uint16) []uint16
 	Uint8Uint16AddSels	func([]uint8, []uint16, []uint16, []int64) []uint16
 	Uint8Uint16AddScalarSels	func(uint8, []uint16, []uint16, []int64) []uint16
+
+	DecimalAdd           func([]types.Decimal, []types.Decimal, []types.Decimal) ([]types.Decimal, error)
+	DecimalAddSels       func([]types.Decimal, []types.Decimal, []types.Decimal, []int64) ([]types.Decimal, error)
+	DecimalAddScalar     func(types.Decimal, []types.Decimal, []types.Decimal) ([]types.Decimal, error)
+	DecimalAddScalarSels func(types.Decimal, []types.Decimal, []types.Decimal, []int64) ([]types.Decimal, error)
 )
 
 func int8Add(xs, ys, rs []int8) []int8 {
@@ -752,4 +761,48 @@ func uint8Uint16AddScalarSels(x uint8, ys, rs []uint16, sels []int64) []uint16 {
 		rs[sel] = uint16(x) + ys[sel]
 	}
 	return rs
-}
\ No newline at end of file
+}
+
+func decimalAdd(xs, ys, rs []types.Decimal) ([]types.Decimal, error) {
+	for i := range ys {
+		r, err := types.DecimalAdd(xs[i], ys[i])
+		if err != nil {
+			return nil, err
+		}
+		rs[i] = r
+	}
+	return rs, nil
+}
+
+func decimalAddSels(xs, ys, rs []types.Decimal, sels []int64) ([]types.Decimal, error) {
+	for _, sel := range sels {
+		r, err := types.DecimalAdd(xs[sel], ys[sel])
+		if err != nil {
+			return nil, err
+		}
+		rs[sel] = r
+	}
+	return rs, nil
+}
+
+func decimalAddScalar(x types.Decimal, ys, rs []types.Decimal) ([]types.Decimal, error) {
+	for i := range ys {
+		r, err := types.DecimalAdd(x, ys[i])
+		if err != nil {
+			return nil, err
+		}
+		rs[i] = r
+	}
+	return rs, nil
+}
+
+func decimalAddScalarSels(x types.Decimal, ys, rs []types.Decimal, sels []int64) ([]types.Decimal, error) {
+	for _, sel := range sels {
+		r, err := types.DecimalAdd(x, ys[sel])
+		if err != nil {
+			return nil, err
+		}
+		rs[sel] = r
+	}
+	return rs, nil
+}
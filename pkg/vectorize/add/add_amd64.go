@@ -200,6 +200,11 @@ func init() {
 	Uint8Uint16AddScalar = uint8Uint16AddScalar
 	Uint8Uint16AddSels = uint8Uint16AddSels
 	Uint8Uint16AddScalarSels = uint8Uint16AddScalarSels
+
+	DecimalAdd = decimalAdd
+	DecimalAddSels = decimalAddSels
+	DecimalAddScalar = decimalAddScalar
+	DecimalAddScalarSels = decimalAddScalarSels
 }
 
 func int8AddAvx2(xs, ys, rs []int8) []int8 {
@@ -111,4 +111,9 @@ func init() {
 	Uint8Uint16AddScalar = uint8Uint16AddScalar
 	Uint8Uint16AddSels = uint8Uint16AddSels
 	Uint8Uint16AddScalarSels = uint8Uint16AddScalarSels
+
+	DecimalAdd = decimalAdd
+	DecimalAddSels = decimalAddSels
+	DecimalAddScalar = decimalAddScalar
+	DecimalAddScalarSels = decimalAddScalarSels
 }
\ No newline at end of file
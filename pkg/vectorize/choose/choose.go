@@ -0,0 +1,301 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package choose
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+var (
+	i8Choose       func([][]int8, []bool, []int64, []int8) []int8
+	i16Choose      func([][]int16, []bool, []int64, []int16) []int16
+	i32Choose      func([][]int32, []bool, []int64, []int32) []int32
+	i64Choose      func([][]int64, []bool, []int64, []int64) []int64
+	ui8Choose      func([][]uint8, []bool, []int64, []uint8) []uint8
+	ui16Choose     func([][]uint16, []bool, []int64, []uint16) []uint16
+	ui32Choose     func([][]uint32, []bool, []int64, []uint32) []uint32
+	ui64Choose     func([][]uint64, []bool, []int64, []uint64) []uint64
+	float32Choose  func([][]float32, []bool, []int64, []float32) []float32
+	float64Choose  func([][]float64, []bool, []int64, []float64) []float64
+	decimalChoose  func([][]types.Decimal, []bool, []int64, []types.Decimal) []types.Decimal
+	dateChoose     func([][]types.Date, []bool, []int64, []types.Date) []types.Date
+	datetimeChoose func([][]types.Datetime, []bool, []int64, []types.Datetime) []types.Datetime
+
+	sChoose func([]*types.Bytes, []bool, []int64, *types.Bytes) *types.Bytes
+)
+
+func init() {
+	i8Choose = i8ChoosePure
+	i16Choose = i16ChoosePure
+	i32Choose = i32ChoosePure
+	i64Choose = i64ChoosePure
+	ui8Choose = ui8ChoosePure
+	ui16Choose = ui16ChoosePure
+	ui32Choose = ui32ChoosePure
+	ui64Choose = ui64ChoosePure
+	float32Choose = float32ChoosePure
+	float64Choose = float64ChoosePure
+	decimalChoose = decimalChoosePure
+	dateChoose = dateChoosePure
+	datetimeChoose = datetimeChoosePure
+
+	sChoose = sChoosePure
+}
+
+func I8Choose(vs [][]int8, cs []bool, idx []int64, rs []int8) []int8 {
+	return i8Choose(vs, cs, idx, rs)
+}
+
+func I16Choose(vs [][]int16, cs []bool, idx []int64, rs []int16) []int16 {
+	return i16Choose(vs, cs, idx, rs)
+}
+
+func I32Choose(vs [][]int32, cs []bool, idx []int64, rs []int32) []int32 {
+	return i32Choose(vs, cs, idx, rs)
+}
+
+func I64Choose(vs [][]int64, cs []bool, idx []int64, rs []int64) []int64 {
+	return i64Choose(vs, cs, idx, rs)
+}
+
+func Ui8Choose(vs [][]uint8, cs []bool, idx []int64, rs []uint8) []uint8 {
+	return ui8Choose(vs, cs, idx, rs)
+}
+
+func Ui16Choose(vs [][]uint16, cs []bool, idx []int64, rs []uint16) []uint16 {
+	return ui16Choose(vs, cs, idx, rs)
+}
+
+func Ui32Choose(vs [][]uint32, cs []bool, idx []int64, rs []uint32) []uint32 {
+	return ui32Choose(vs, cs, idx, rs)
+}
+
+func Ui64Choose(vs [][]uint64, cs []bool, idx []int64, rs []uint64) []uint64 {
+	return ui64Choose(vs, cs, idx, rs)
+}
+
+func Float32Choose(vs [][]float32, cs []bool, idx []int64, rs []float32) []float32 {
+	return float32Choose(vs, cs, idx, rs)
+}
+
+func Float64Choose(vs [][]float64, cs []bool, idx []int64, rs []float64) []float64 {
+	return float64Choose(vs, cs, idx, rs)
+}
+
+func DecimalChoose(vs [][]types.Decimal, cs []bool, idx []int64, rs []types.Decimal) []types.Decimal {
+	return decimalChoose(vs, cs, idx, rs)
+}
+
+func DateChoose(vs [][]types.Date, cs []bool, idx []int64, rs []types.Date) []types.Date {
+	return dateChoose(vs, cs, idx, rs)
+}
+
+func DatetimeChoose(vs [][]types.Datetime, cs []bool, idx []int64, rs []types.Datetime) []types.Datetime {
+	return datetimeChoose(vs, cs, idx, rs)
+}
+
+func SChoose(vs []*types.Bytes, cs []bool, idx []int64, rs *types.Bytes) *types.Bytes {
+	return sChoose(vs, cs, idx, rs)
+}
+
+func i8ChoosePure(vs [][]int8, cs []bool, idx []int64, rs []int8) []int8 {
+	for i, k := range idx {
+		switch {
+		case k < 0:
+		case cs[k]:
+			rs[i] = vs[k][0]
+		default:
+			rs[i] = vs[k][i]
+		}
+	}
+	return rs
+}
+
+func i16ChoosePure(vs [][]int16, cs []bool, idx []int64, rs []int16) []int16 {
+	for i, k := range idx {
+		switch {
+		case k < 0:
+		case cs[k]:
+			rs[i] = vs[k][0]
+		default:
+			rs[i] = vs[k][i]
+		}
+	}
+	return rs
+}
+
+func i32ChoosePure(vs [][]int32, cs []bool, idx []int64, rs []int32) []int32 {
+	for i, k := range idx {
+		switch {
+		case k < 0:
+		case cs[k]:
+			rs[i] = vs[k][0]
+		default:
+			rs[i] = vs[k][i]
+		}
+	}
+	return rs
+}
+
+func i64ChoosePure(vs [][]int64, cs []bool, idx []int64, rs []int64) []int64 {
+	for i, k := range idx {
+		switch {
+		case k < 0:
+		case cs[k]:
+			rs[i] = vs[k][0]
+		default:
+			rs[i] = vs[k][i]
+		}
+	}
+	return rs
+}
+
+func ui8ChoosePure(vs [][]uint8, cs []bool, idx []int64, rs []uint8) []uint8 {
+	for i, k := range idx {
+		switch {
+		case k < 0:
+		case cs[k]:
+			rs[i] = vs[k][0]
+		default:
+			rs[i] = vs[k][i]
+		}
+	}
+	return rs
+}
+
+func ui16ChoosePure(vs [][]uint16, cs []bool, idx []int64, rs []uint16) []uint16 {
+	for i, k := range idx {
+		switch {
+		case k < 0:
+		case cs[k]:
+			rs[i] = vs[k][0]
+		default:
+			rs[i] = vs[k][i]
+		}
+	}
+	return rs
+}
+
+func ui32ChoosePure(vs [][]uint32, cs []bool, idx []int64, rs []uint32) []uint32 {
+	for i, k := range idx {
+		switch {
+		case k < 0:
+		case cs[k]:
+			rs[i] = vs[k][0]
+		default:
+			rs[i] = vs[k][i]
+		}
+	}
+	return rs
+}
+
+func ui64ChoosePure(vs [][]uint64, cs []bool, idx []int64, rs []uint64) []uint64 {
+	for i, k := range idx {
+		switch {
+		case k < 0:
+		case cs[k]:
+			rs[i] = vs[k][0]
+		default:
+			rs[i] = vs[k][i]
+		}
+	}
+	return rs
+}
+
+func float32ChoosePure(vs [][]float32, cs []bool, idx []int64, rs []float32) []float32 {
+	for i, k := range idx {
+		switch {
+		case k < 0:
+		case cs[k]:
+			rs[i] = vs[k][0]
+		default:
+			rs[i] = vs[k][i]
+		}
+	}
+	return rs
+}
+
+func float64ChoosePure(vs [][]float64, cs []bool, idx []int64, rs []float64) []float64 {
+	for i, k := range idx {
+		switch {
+		case k < 0:
+		case cs[k]:
+			rs[i] = vs[k][0]
+		default:
+			rs[i] = vs[k][i]
+		}
+	}
+	return rs
+}
+
+func decimalChoosePure(vs [][]types.Decimal, cs []bool, idx []int64, rs []types.Decimal) []types.Decimal {
+	for i, k := range idx {
+		switch {
+		case k < 0:
+		case cs[k]:
+			rs[i] = vs[k][0]
+		default:
+			rs[i] = vs[k][i]
+		}
+	}
+	return rs
+}
+
+func dateChoosePure(vs [][]types.Date, cs []bool, idx []int64, rs []types.Date) []types.Date {
+	for i, k := range idx {
+		switch {
+		case k < 0:
+		case cs[k]:
+			rs[i] = vs[k][0]
+		default:
+			rs[i] = vs[k][i]
+		}
+	}
+	return rs
+}
+
+func datetimeChoosePure(vs [][]types.Datetime, cs []bool, idx []int64, rs []types.Datetime) []types.Datetime {
+	for i, k := range idx {
+		switch {
+		case k < 0:
+		case cs[k]:
+			rs[i] = vs[k][0]
+		default:
+			rs[i] = vs[k][i]
+		}
+	}
+	return rs
+}
+
+func sChoosePure(vs []*types.Bytes, cs []bool, idx []int64, rs *types.Bytes) *types.Bytes {
+	var o uint32
+
+	for i, k := range idx {
+		var v []byte
+
+		switch {
+		case k < 0:
+		case cs[k]:
+			v = vs[k].Get(0)
+		default:
+			v = vs[k].Get(int64(i))
+		}
+		rs.Data = append(rs.Data, v...)
+		rs.Offsets = append(rs.Offsets, o)
+		rs.Lengths = append(rs.Lengths, uint32(len(v)))
+		o += uint32(len(v))
+	}
+	return rs
+}
@@ -14,6 +14,10 @@
 
 package div
 
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
 var (
 	Int8Div                func([]int8, []int8, []int8) []int8
 	Int8DivSels            func([]int8, []int8, []int8, []int64) []int8
@@ -89,6 +93,13 @@ var (
 	Float64IntegerDivScalarSels   func(float64, []float64, []int64, []int64) []int64
 	Float64IntegerDivByScalar     func(float64, []float64, []int64) []int64
 	Float64IntegerDivByScalarSels func(float64, []float64, []int64, []int64) []int64
+
+	DecimalDiv             func([]types.Decimal, []types.Decimal, []types.Decimal, int32) ([]types.Decimal, error)
+	DecimalDivSels         func([]types.Decimal, []types.Decimal, []types.Decimal, []int64, int32) ([]types.Decimal, error)
+	DecimalDivScalar       func(types.Decimal, []types.Decimal, []types.Decimal, int32) ([]types.Decimal, error)
+	DecimalDivScalarSels   func(types.Decimal, []types.Decimal, []types.Decimal, []int64, int32) ([]types.Decimal, error)
+	DecimalDivByScalar     func(types.Decimal, []types.Decimal, []types.Decimal, int32) ([]types.Decimal, error)
+	DecimalDivByScalarSels func(types.Decimal, []types.Decimal, []types.Decimal, []int64, int32) ([]types.Decimal, error)
 )
 
 func init() {
@@ -166,6 +177,13 @@ func init() {
 	Float64IntegerDivScalarSels = float64IntegerDivScalarSels
 	Float64IntegerDivByScalar = float64IntegerDivByScalar
 	Float64IntegerDivByScalarSels = float64IntegerDivByScalarSels
+
+	DecimalDiv = decimalDiv
+	DecimalDivSels = decimalDivSels
+	DecimalDivScalar = decimalDivScalar
+	DecimalDivScalarSels = decimalDivScalarSels
+	DecimalDivByScalar = decimalDivByScalar
+	DecimalDivByScalarSels = decimalDivByScalarSels
 }
 
 func int8Div(xs, ys, rs []int8) []int8 {
@@ -671,3 +689,69 @@ func float64IntegerDivByScalarSels(x float64, ys []float64, rs []int64, sels []i
 	}
 	return rs
 }
+
+func decimalDiv(xs, ys, rs []types.Decimal, shift int32) ([]types.Decimal, error) {
+	for i := range ys {
+		r, err := types.DecimalDiv(xs[i], ys[i], shift)
+		if err != nil {
+			return nil, err
+		}
+		rs[i] = r
+	}
+	return rs, nil
+}
+
+func decimalDivSels(xs, ys, rs []types.Decimal, sels []int64, shift int32) ([]types.Decimal, error) {
+	for _, sel := range sels {
+		r, err := types.DecimalDiv(xs[sel], ys[sel], shift)
+		if err != nil {
+			return nil, err
+		}
+		rs[sel] = r
+	}
+	return rs, nil
+}
+
+func decimalDivScalar(x types.Decimal, ys, rs []types.Decimal, shift int32) ([]types.Decimal, error) {
+	for i := range ys {
+		r, err := types.DecimalDiv(x, ys[i], shift)
+		if err != nil {
+			return nil, err
+		}
+		rs[i] = r
+	}
+	return rs, nil
+}
+
+func decimalDivScalarSels(x types.Decimal, ys, rs []types.Decimal, sels []int64, shift int32) ([]types.Decimal, error) {
+	for _, sel := range sels {
+		r, err := types.DecimalDiv(x, ys[sel], shift)
+		if err != nil {
+			return nil, err
+		}
+		rs[sel] = r
+	}
+	return rs, nil
+}
+
+func decimalDivByScalar(x types.Decimal, ys, rs []types.Decimal, shift int32) ([]types.Decimal, error) {
+	for i := range ys {
+		r, err := types.DecimalDiv(ys[i], x, shift)
+		if err != nil {
+			return nil, err
+		}
+		rs[i] = r
+	}
+	return rs, nil
+}
+
+func decimalDivByScalarSels(x types.Decimal, ys, rs []types.Decimal, sels []int64, shift int32) ([]types.Decimal, error) {
+	for _, sel := range sels {
+		r, err := types.DecimalDiv(ys[sel], x, shift)
+		if err != nil {
+			return nil, err
+		}
+		rs[sel] = r
+	}
+	return rs, nil
+}
@@ -111,6 +111,11 @@ var (
 	StrEqNullableScalar         func([]byte, *types.Bytes, *roaring.Bitmap, []int64) []int64
 	StrEqScalarSels             func([]byte, *types.Bytes, []int64, []int64) []int64
 	StrEqNullableScalarSels     func([]byte, *types.Bytes, *roaring.Bitmap, []int64, []int64) []int64
+
+	DecimalEq               func([]types.Decimal, []types.Decimal, []int64) []int64
+	DecimalEqNullable       func([]types.Decimal, []types.Decimal, *roaring.Bitmap, []int64) []int64
+	DecimalEqScalar         func(types.Decimal, []types.Decimal, []int64) []int64
+	DecimalEqNullableScalar func(types.Decimal, []types.Decimal, *roaring.Bitmap, []int64) []int64
 )
 
 func init() {
@@ -202,6 +207,11 @@ func init() {
 	StrEqNullableScalar = strEqNullableScalar
 	StrEqScalarSels = strEqScalarSels
 	StrEqNullableScalarSels = strEqNullableScalarSels
+
+	DecimalEq = decimalEq
+	DecimalEqNullable = decimalEqNullable
+	DecimalEqScalar = decimalEqScalar
+	DecimalEqNullableScalar = decimalEqNullableScalar
 }
 
 func int8Eq(xs, ys []int8, rs []int64) []int64 {
@@ -1512,3 +1522,77 @@ func strEqNullableScalarSels(x []byte, ys *types.Bytes, nulls *roaring.Bitmap, r
 	}
 	return rs[:rsi]
 }
+
+func decimalEq(xs, ys []types.Decimal, rs []int64) []int64 {
+	rsi := 0
+	for i, x := range xs {
+		if x == ys[i] {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimalEqNullable(xs, ys []types.Decimal, nulls *roaring.Bitmap, rs []int64) []int64 {
+	rsi := 0
+	nullsIter := nulls.Iterator()
+	nextNull := 0
+
+	if nullsIter.HasNext() {
+		nextNull = int(nullsIter.Next())
+	} else {
+		nextNull = -1
+	}
+
+	for i, x := range xs {
+		if i == nextNull {
+			if nullsIter.HasNext() {
+				nextNull = int(nullsIter.Next())
+			} else {
+				nextNull = -1
+			}
+		} else if x == ys[i] {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimalEqScalar(x types.Decimal, ys []types.Decimal, rs []int64) []int64 {
+	rsi := 0
+	for i, y := range ys {
+		if x == y {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimalEqNullableScalar(x types.Decimal, ys []types.Decimal, nulls *roaring.Bitmap, rs []int64) []int64 {
+	rsi := 0
+	nullsIter := nulls.Iterator()
+	nextNull := 0
+
+	if nullsIter.HasNext() {
+		nextNull = int(nullsIter.Next())
+	} else {
+		nextNull = -1
+	}
+
+	for i, y := range ys {
+		if i == nextNull {
+			if nullsIter.HasNext() {
+				nextNull = int(nullsIter.Next())
+			} else {
+				nextNull = -1
+			}
+		} else if x == y {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
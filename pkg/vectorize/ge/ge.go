@@ -110,6 +110,11 @@ var (
 	StrGeNullableScalar         func([]byte, *types.Bytes, *roaring.Bitmap, []int64) []int64
 	StrGeScalarSels             func([]byte, *types.Bytes, []int64, []int64) []int64
 	StrGeNullableScalarSels     func([]byte, *types.Bytes, *roaring.Bitmap, []int64, []int64) []int64
+
+	DecimalGe               func([]types.Decimal, []types.Decimal, []int64) []int64
+	DecimalGeNullable       func([]types.Decimal, []types.Decimal, *roaring.Bitmap, []int64) []int64
+	DecimalGeScalar         func(types.Decimal, []types.Decimal, []int64) []int64
+	DecimalGeNullableScalar func(types.Decimal, []types.Decimal, *roaring.Bitmap, []int64) []int64
 )
 
 func init() {
@@ -201,6 +206,11 @@ func init() {
 	StrGeNullableScalar = strGeNullableScalar
 	StrGeScalarSels = strGeScalarSels
 	StrGeNullableScalarSels = strGeNullableScalarSels
+
+	DecimalGe = decimalGe
+	DecimalGeNullable = decimalGeNullable
+	DecimalGeScalar = decimalGeScalar
+	DecimalGeNullableScalar = decimalGeNullableScalar
 }
 
 func int8Ge(xs, ys []int8, rs []int64) []int64 {
@@ -1500,3 +1510,77 @@ func strGeNullableScalarSels(x []byte, ys *types.Bytes, nulls *roaring.Bitmap, r
 	}
 	return rs[:rsi]
 }
+
+func decimalGe(xs, ys []types.Decimal, rs []int64) []int64 {
+	rsi := 0
+	for i, x := range xs {
+		if x.Compare(ys[i]) >= 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimalGeNullable(xs, ys []types.Decimal, nulls *roaring.Bitmap, rs []int64) []int64 {
+	rsi := 0
+	nullsIter := nulls.Iterator()
+	nextNull := 0
+
+	if nullsIter.HasNext() {
+		nextNull = int(nullsIter.Next())
+	} else {
+		nextNull = -1
+	}
+
+	for i, x := range xs {
+		if i == nextNull {
+			if nullsIter.HasNext() {
+				nextNull = int(nullsIter.Next())
+			} else {
+				nextNull = -1
+			}
+		} else if x.Compare(ys[i]) >= 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimalGeScalar(x types.Decimal, ys []types.Decimal, rs []int64) []int64 {
+	rsi := 0
+	for i, y := range ys {
+		if x.Compare(y) >= 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimalGeNullableScalar(x types.Decimal, ys []types.Decimal, nulls *roaring.Bitmap, rs []int64) []int64 {
+	rsi := 0
+	nullsIter := nulls.Iterator()
+	nextNull := 0
+
+	if nullsIter.HasNext() {
+		nextNull = int(nullsIter.Next())
+	} else {
+		nextNull = -1
+	}
+
+	for i, y := range ys {
+		if i == nextNull {
+			if nullsIter.HasNext() {
+				nextNull = int(nullsIter.Next())
+			} else {
+				nextNull = -1
+			}
+		} else if x.Compare(y) >= 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
@@ -110,6 +110,11 @@ var (
 	StrGtNullableScalar         func([]byte, *types.Bytes, *roaring.Bitmap, []int64) []int64
 	StrGtScalarSels             func([]byte, *types.Bytes, []int64, []int64) []int64
 	StrGtNullableScalarSels     func([]byte, *types.Bytes, *roaring.Bitmap, []int64, []int64) []int64
+
+	DecimalGt               func([]types.Decimal, []types.Decimal, []int64) []int64
+	DecimalGtNullable       func([]types.Decimal, []types.Decimal, *roaring.Bitmap, []int64) []int64
+	DecimalGtScalar         func(types.Decimal, []types.Decimal, []int64) []int64
+	DecimalGtNullableScalar func(types.Decimal, []types.Decimal, *roaring.Bitmap, []int64) []int64
 )
 
 func init() {
@@ -201,6 +206,11 @@ func init() {
 	StrGtNullableScalar = strGtNullableScalar
 	StrGtScalarSels = strGtScalarSels
 	StrGtNullableScalarSels = strGtNullableScalarSels
+
+	DecimalGt = decimalGt
+	DecimalGtNullable = decimalGtNullable
+	DecimalGtScalar = decimalGtScalar
+	DecimalGtNullableScalar = decimalGtNullableScalar
 }
 
 func int8Gt(xs, ys []int8, rs []int64) []int64 {
@@ -1500,3 +1510,77 @@ func strGtNullableScalarSels(x []byte, ys *types.Bytes, nulls *roaring.Bitmap, r
 	}
 	return rs[:rsi]
 }
+
+func decimalGt(xs, ys []types.Decimal, rs []int64) []int64 {
+	rsi := 0
+	for i, x := range xs {
+		if x.Compare(ys[i]) > 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimalGtNullable(xs, ys []types.Decimal, nulls *roaring.Bitmap, rs []int64) []int64 {
+	rsi := 0
+	nullsIter := nulls.Iterator()
+	nextNull := 0
+
+	if nullsIter.HasNext() {
+		nextNull = int(nullsIter.Next())
+	} else {
+		nextNull = -1
+	}
+
+	for i, x := range xs {
+		if i == nextNull {
+			if nullsIter.HasNext() {
+				nextNull = int(nullsIter.Next())
+			} else {
+				nextNull = -1
+			}
+		} else if x.Compare(ys[i]) > 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimalGtScalar(x types.Decimal, ys []types.Decimal, rs []int64) []int64 {
+	rsi := 0
+	for i, y := range ys {
+		if x.Compare(y) > 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimalGtNullableScalar(x types.Decimal, ys []types.Decimal, nulls *roaring.Bitmap, rs []int64) []int64 {
+	rsi := 0
+	nullsIter := nulls.Iterator()
+	nextNull := 0
+
+	if nullsIter.HasNext() {
+		nextNull = int(nullsIter.Next())
+	} else {
+		nextNull = -1
+	}
+
+	for i, y := range ys {
+		if i == nextNull {
+			if nullsIter.HasNext() {
+				nextNull = int(nullsIter.Next())
+			} else {
+				nextNull = -1
+			}
+		} else if x.Compare(y) > 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
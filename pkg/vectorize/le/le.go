@@ -110,6 +110,11 @@ var (
 	StrLeNullableScalar         func([]byte, *types.Bytes, *roaring.Bitmap, []int64) []int64
 	StrLeScalarSels             func([]byte, *types.Bytes, []int64, []int64) []int64
 	StrLeNullableScalarSels     func([]byte, *types.Bytes, *roaring.Bitmap, []int64, []int64) []int64
+
+	DecimalLe               func([]types.Decimal, []types.Decimal, []int64) []int64
+	DecimalLeNullable       func([]types.Decimal, []types.Decimal, *roaring.Bitmap, []int64) []int64
+	DecimalLeScalar         func(types.Decimal, []types.Decimal, []int64) []int64
+	DecimalLeNullableScalar func(types.Decimal, []types.Decimal, *roaring.Bitmap, []int64) []int64
 )
 
 func init() {
@@ -201,6 +206,11 @@ func init() {
 	StrLeNullableScalar = strLeNullableScalar
 	StrLeScalarSels = strLeScalarSels
 	StrLeNullableScalarSels = strLeNullableScalarSels
+
+	DecimalLe = decimalLe
+	DecimalLeNullable = decimalLeNullable
+	DecimalLeScalar = decimalLeScalar
+	DecimalLeNullableScalar = decimalLeNullableScalar
 }
 
 func int8Le(xs, ys []int8, rs []int64) []int64 {
@@ -1500,3 +1510,77 @@ func strLeNullableScalarSels(x []byte, ys *types.Bytes, nulls *roaring.Bitmap, r
 	}
 	return rs[:rsi]
 }
+
+func decimalLe(xs, ys []types.Decimal, rs []int64) []int64 {
+	rsi := 0
+	for i, x := range xs {
+		if x.Compare(ys[i]) <= 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimalLeNullable(xs, ys []types.Decimal, nulls *roaring.Bitmap, rs []int64) []int64 {
+	rsi := 0
+	nullsIter := nulls.Iterator()
+	nextNull := 0
+
+	if nullsIter.HasNext() {
+		nextNull = int(nullsIter.Next())
+	} else {
+		nextNull = -1
+	}
+
+	for i, x := range xs {
+		if i == nextNull {
+			if nullsIter.HasNext() {
+				nextNull = int(nullsIter.Next())
+			} else {
+				nextNull = -1
+			}
+		} else if x.Compare(ys[i]) <= 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimalLeScalar(x types.Decimal, ys []types.Decimal, rs []int64) []int64 {
+	rsi := 0
+	for i, y := range ys {
+		if x.Compare(y) <= 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimalLeNullableScalar(x types.Decimal, ys []types.Decimal, nulls *roaring.Bitmap, rs []int64) []int64 {
+	rsi := 0
+	nullsIter := nulls.Iterator()
+	nextNull := 0
+
+	if nullsIter.HasNext() {
+		nextNull = int(nullsIter.Next())
+	} else {
+		nextNull = -1
+	}
+
+	for i, y := range ys {
+		if i == nextNull {
+			if nullsIter.HasNext() {
+				nextNull = int(nullsIter.Next())
+			} else {
+				nextNull = -1
+			}
+		} else if x.Compare(y) <= 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
@@ -110,6 +110,11 @@ var (
 	StrLtNullableScalar         func([]byte, *types.Bytes, *roaring.Bitmap, []int64) []int64
 	StrLtScalarSels             func([]byte, *types.Bytes, []int64, []int64) []int64
 	StrLtNullableScalarSels     func([]byte, *types.Bytes, *roaring.Bitmap, []int64, []int64) []int64
+
+	DecimalLt               func([]types.Decimal, []types.Decimal, []int64) []int64
+	DecimalLtNullable       func([]types.Decimal, []types.Decimal, *roaring.Bitmap, []int64) []int64
+	DecimalLtScalar         func(types.Decimal, []types.Decimal, []int64) []int64
+	DecimalLtNullableScalar func(types.Decimal, []types.Decimal, *roaring.Bitmap, []int64) []int64
 )
 
 func init() {
@@ -201,6 +206,11 @@ func init() {
 	StrLtNullableScalar = strLtNullableScalar
 	StrLtScalarSels = strLtScalarSels
 	StrLtNullableScalarSels = strLtNullableScalarSels
+
+	DecimalLt = decimalLt
+	DecimalLtNullable = decimalLtNullable
+	DecimalLtScalar = decimalLtScalar
+	DecimalLtNullableScalar = decimalLtNullableScalar
 }
 
 func int8Lt(xs, ys []int8, rs []int64) []int64 {
@@ -1500,3 +1510,77 @@ func strLtNullableScalarSels(x []byte, ys *types.Bytes, nulls *roaring.Bitmap, r
 	}
 	return rs[:rsi]
 }
+
+func decimalLt(xs, ys []types.Decimal, rs []int64) []int64 {
+	rsi := 0
+	for i, x := range xs {
+		if x.Compare(ys[i]) < 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimalLtNullable(xs, ys []types.Decimal, nulls *roaring.Bitmap, rs []int64) []int64 {
+	rsi := 0
+	nullsIter := nulls.Iterator()
+	nextNull := 0
+
+	if nullsIter.HasNext() {
+		nextNull = int(nullsIter.Next())
+	} else {
+		nextNull = -1
+	}
+
+	for i, x := range xs {
+		if i == nextNull {
+			if nullsIter.HasNext() {
+				nextNull = int(nullsIter.Next())
+			} else {
+				nextNull = -1
+			}
+		} else if x.Compare(ys[i]) < 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimalLtScalar(x types.Decimal, ys []types.Decimal, rs []int64) []int64 {
+	rsi := 0
+	for i, y := range ys {
+		if x.Compare(y) < 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimalLtNullableScalar(x types.Decimal, ys []types.Decimal, nulls *roaring.Bitmap, rs []int64) []int64 {
+	rsi := 0
+	nullsIter := nulls.Iterator()
+	nextNull := 0
+
+	if nullsIter.HasNext() {
+		nextNull = int(nullsIter.Next())
+	} else {
+		nextNull = -1
+	}
+
+	for i, y := range ys {
+		if i == nextNull {
+			if nullsIter.HasNext() {
+				nextNull = int(nullsIter.Next())
+			} else {
+				nextNull = -1
+			}
+		} else if x.Compare(y) < 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
@@ -44,6 +44,9 @@ var (
 	Float64MaxSels func([]float64, []int64) float64
 	StrMax         func(*types.Bytes) []byte
 	StrMaxSels     func(*types.Bytes, []int64) []byte
+
+	DecimalMax     func([]types.Decimal) types.Decimal
+	DecimalMaxSels func([]types.Decimal, []int64) types.Decimal
 )
 
 func boolMax(xs []bool) bool {
@@ -295,3 +298,24 @@ func strMaxSels(xs *types.Bytes, sels []int64) []byte {
 	}
 	return res
 }
+
+func decimalMax(xs []types.Decimal) types.Decimal {
+	res := xs[0]
+	for _, x := range xs {
+		if x.Compare(res) > 0 {
+			res = x
+		}
+	}
+	return res
+}
+
+func decimalMaxSels(xs []types.Decimal, sels []int64) types.Decimal {
+	res := xs[sels[0]]
+	for _, sel := range sels {
+		x := xs[sel]
+		if x.Compare(res) > 0 {
+			res = x
+		}
+	}
+	return res
+}
@@ -91,6 +91,9 @@ func init() {
 	Float32MaxSels = float32MaxSels
 	Float64MaxSels = float64MaxSels
 	StrMaxSels = strMaxSels
+
+	DecimalMax = decimalMax
+	DecimalMaxSels = decimalMaxSels
 }
 
 func int8MaxAvx2(xs []int8) int8 {
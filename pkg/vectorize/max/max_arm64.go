@@ -43,4 +43,7 @@ func init() {
 	Float32MaxSels = float32MaxSels
 	Float64MaxSels = float64MaxSels
 	StrMaxSels = strMaxSels
+
+	DecimalMax = decimalMax
+	DecimalMaxSels = decimalMaxSels
 }
@@ -44,6 +44,9 @@ var (
 	Float64MinSels func([]float64, []int64) float64
 	StrMin func(*types.Bytes) []byte
 	StrMinSels func(*types.Bytes, []int64) []byte
+
+	DecimalMin     func([]types.Decimal) types.Decimal
+	DecimalMinSels func([]types.Decimal, []int64) types.Decimal
 )
 
 func boolMin(xs []bool) bool {
@@ -295,3 +298,24 @@ func strMinSels(xs *types.Bytes, sels []int64) []byte {
 	}
 	return res
 }
+
+func decimalMin(xs []types.Decimal) types.Decimal {
+	res := xs[0]
+	for _, x := range xs {
+		if x.Lt(res) {
+			res = x
+		}
+	}
+	return res
+}
+
+func decimalMinSels(xs []types.Decimal, sels []int64) types.Decimal {
+	res := xs[sels[0]]
+	for _, sel := range sels {
+		x := xs[sel]
+		if x.Lt(res) {
+			res = x
+		}
+	}
+	return res
+}
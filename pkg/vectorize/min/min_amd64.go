@@ -91,6 +91,9 @@ func init() {
 	Float32MinSels = float32MinSels
 	Float64MinSels = float64MinSels
 	StrMinSels = strMinSels
+
+	DecimalMin = decimalMin
+	DecimalMinSels = decimalMinSels
 }
 
 func int8MinAvx2(xs []int8) int8 {
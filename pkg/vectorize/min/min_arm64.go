@@ -43,4 +43,7 @@ func init() {
 	Float32MinSels = float32MinSels
 	Float64MinSels = float64MinSels
 	StrMinSels = strMinSels
+
+	DecimalMin = decimalMin
+	DecimalMinSels = decimalMinSels
 }
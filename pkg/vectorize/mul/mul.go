@@ -14,6 +14,10 @@
 
 package mul
 
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
 var (
 	Int8Mul              func([]int8, []int8, []int8) []int8
 	Int8MulSels          func([]int8, []int8, []int8, []int64) []int8
@@ -82,6 +86,11 @@ var (
 	Uint8Uint32MulSels func([]uint8, []uint32, []uint32, []int64) []uint32
 	Uint8Uint16Mul func([]uint8, []uint16, []uint16) []uint16
 	Uint8Uint16MulSels func([]uint8, []uint16, []uint16, []int64) []uint16
+
+	DecimalMul           func([]types.Decimal, []types.Decimal, []types.Decimal, int32) ([]types.Decimal, error)
+	DecimalMulSels       func([]types.Decimal, []types.Decimal, []types.Decimal, []int64, int32) ([]types.Decimal, error)
+	DecimalMulScalar     func(types.Decimal, []types.Decimal, []types.Decimal, int32) ([]types.Decimal, error)
+	DecimalMulScalarSels func(types.Decimal, []types.Decimal, []types.Decimal, []int64, int32) ([]types.Decimal, error)
 )
 
 func init() {
@@ -152,6 +161,11 @@ func init() {
 	Uint8Uint32MulSels = uint8Uint32MulSels
 	Uint8Uint16Mul = uint8Uint16Mul
 	Uint8Uint16MulSels = uint8Uint16MulSels
+
+	DecimalMul = decimalMul
+	DecimalMulSels = decimalMulSels
+	DecimalMulScalar = decimalMulScalar
+	DecimalMulScalarSels = decimalMulScalarSels
 }
 
 func int8Mul(xs, ys, rs []int8) []int8 {
@@ -614,4 +628,48 @@ func uint8Uint16MulSels(xs []uint8, ys, rs []uint16, sels []int64) []uint16 {
 		rs[sel] = ys[sel] * uint16(xs[sel])
 	}
 	return rs
-}
\ No newline at end of file
+}
+
+func decimalMul(xs, ys, rs []types.Decimal, shift int32) ([]types.Decimal, error) {
+	for i := range ys {
+		r, err := types.DecimalMul(xs[i], ys[i], shift)
+		if err != nil {
+			return nil, err
+		}
+		rs[i] = r
+	}
+	return rs, nil
+}
+
+func decimalMulSels(xs, ys, rs []types.Decimal, sels []int64, shift int32) ([]types.Decimal, error) {
+	for _, sel := range sels {
+		r, err := types.DecimalMul(xs[sel], ys[sel], shift)
+		if err != nil {
+			return nil, err
+		}
+		rs[sel] = r
+	}
+	return rs, nil
+}
+
+func decimalMulScalar(x types.Decimal, ys, rs []types.Decimal, shift int32) ([]types.Decimal, error) {
+	for i := range ys {
+		r, err := types.DecimalMul(x, ys[i], shift)
+		if err != nil {
+			return nil, err
+		}
+		rs[i] = r
+	}
+	return rs, nil
+}
+
+func decimalMulScalarSels(x types.Decimal, ys, rs []types.Decimal, sels []int64, shift int32) ([]types.Decimal, error) {
+	for _, sel := range sels {
+		r, err := types.DecimalMul(x, ys[sel], shift)
+		if err != nil {
+			return nil, err
+		}
+		rs[sel] = r
+	}
+	return rs, nil
+}
@@ -32,7 +32,7 @@ var (
 	float32Shuffle func([]float32, []float32, []int64) []float32
 	float64Shuffle func([]float64, []float64, []int64) []float64
 
-	decimalShuffle func([]types.Decimal, []int64) []types.Decimal
+	decimalShuffle func([]types.Decimal, []types.Decimal, []int64) []types.Decimal
 
 	dateShuffle     func([]types.Date, []types.Date, []int64) []types.Date
 	datetimeShuffle func([]types.Datetime, []types.Datetime, []int64) []types.Datetime
@@ -106,8 +106,8 @@ func Float64Shuffle(vs, ws []float64, sels []int64) []float64 {
 	return float64Shuffle(vs, ws, sels)
 }
 
-func DecimalShuffle(vs []types.Decimal, sels []int64) []types.Decimal {
-	return decimalShuffle(vs, sels)
+func DecimalShuffle(vs []types.Decimal, ws []types.Decimal, sels []int64) []types.Decimal {
+	return decimalShuffle(vs, ws, sels)
 }
 
 func DateShuffle(vs []types.Date, ws []types.Date, sels []int64) []types.Date {
@@ -206,10 +206,11 @@ func float64ShufflePure(vs, ws []float64, sels []int64) []float64 {
 	return vs[:len(sels)]
 }
 
-func decimalShufflePure(vs []types.Decimal, sels []int64) []types.Decimal {
+func decimalShufflePure(vs []types.Decimal, ws []types.Decimal, sels []int64) []types.Decimal {
 	for i, sel := range sels {
-		vs[i] = vs[sel]
+		ws[i] = vs[sel]
 	}
+	copy(vs, ws)
 	return vs[:len(sels)]
 }
 
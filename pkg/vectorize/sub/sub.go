@@ -14,6 +14,10 @@
 
 package sub
 
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
 var (
 	Int8Sub                func([]int8, []int8, []int8) []int8
 	Int8SubSels            func([]int8, []int8, []int8, []int64) []int8
@@ -102,6 +106,13 @@ var (
 	Uint8Uint32SubSels func([]uint32, []uint8, []uint32, []int64) []uint32
 	Uint8Uint16Sub func([]uint16, []uint8, []uint16) []uint16
 	Uint8Uint16SubSels func([]uint16, []uint8, []uint16, []int64) []uint16
+
+	DecimalSub             func([]types.Decimal, []types.Decimal, []types.Decimal) ([]types.Decimal, error)
+	DecimalSubSels         func([]types.Decimal, []types.Decimal, []types.Decimal, []int64) ([]types.Decimal, error)
+	DecimalSubScalar       func(types.Decimal, []types.Decimal, []types.Decimal) ([]types.Decimal, error)
+	DecimalSubScalarSels   func(types.Decimal, []types.Decimal, []types.Decimal, []int64) ([]types.Decimal, error)
+	DecimalSubByScalar     func(types.Decimal, []types.Decimal, []types.Decimal) ([]types.Decimal, error)
+	DecimalSubByScalarSels func(types.Decimal, []types.Decimal, []types.Decimal, []int64) ([]types.Decimal, error)
 )
 
 func int8Sub(xs, ys, rs []int8) []int8 {
@@ -704,4 +715,70 @@ func uint8Uint16SubSels(xs []uint16, ys []uint8, rs []uint16, sels []int64) []ui
 		rs[sel] = xs[sel] - uint16(ys[sel])
 	}
 	return rs
-}
\ No newline at end of file
+}
+
+func decimalSub(xs, ys, rs []types.Decimal) ([]types.Decimal, error) {
+	for i := range ys {
+		r, err := types.DecimalSub(xs[i], ys[i])
+		if err != nil {
+			return nil, err
+		}
+		rs[i] = r
+	}
+	return rs, nil
+}
+
+func decimalSubSels(xs, ys, rs []types.Decimal, sels []int64) ([]types.Decimal, error) {
+	for _, sel := range sels {
+		r, err := types.DecimalSub(xs[sel], ys[sel])
+		if err != nil {
+			return nil, err
+		}
+		rs[sel] = r
+	}
+	return rs, nil
+}
+
+func decimalSubScalar(x types.Decimal, ys, rs []types.Decimal) ([]types.Decimal, error) {
+	for i := range ys {
+		r, err := types.DecimalSub(x, ys[i])
+		if err != nil {
+			return nil, err
+		}
+		rs[i] = r
+	}
+	return rs, nil
+}
+
+func decimalSubScalarSels(x types.Decimal, ys, rs []types.Decimal, sels []int64) ([]types.Decimal, error) {
+	for _, sel := range sels {
+		r, err := types.DecimalSub(x, ys[sel])
+		if err != nil {
+			return nil, err
+		}
+		rs[sel] = r
+	}
+	return rs, nil
+}
+
+func decimalSubByScalar(x types.Decimal, ys, rs []types.Decimal) ([]types.Decimal, error) {
+	for i := range ys {
+		r, err := types.DecimalSub(ys[i], x)
+		if err != nil {
+			return nil, err
+		}
+		rs[i] = r
+	}
+	return rs, nil
+}
+
+func decimalSubByScalarSels(x types.Decimal, ys, rs []types.Decimal, sels []int64) ([]types.Decimal, error) {
+	for _, sel := range sels {
+		r, err := types.DecimalSub(ys[sel], x)
+		if err != nil {
+			return nil, err
+		}
+		rs[sel] = r
+	}
+	return rs, nil
+}
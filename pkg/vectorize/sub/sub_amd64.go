@@ -234,6 +234,13 @@ func init() {
 	Uint8Uint32SubSels = uint8Uint32SubSels
 	Uint8Uint16Sub = uint8Uint16Sub
 	Uint8Uint16SubSels = uint8Uint16SubSels
+
+	DecimalSub = decimalSub
+	DecimalSubSels = decimalSubSels
+	DecimalSubScalar = decimalSubScalar
+	DecimalSubScalarSels = decimalSubScalarSels
+	DecimalSubByScalar = decimalSubByScalar
+	DecimalSubByScalarSels = decimalSubByScalarSels
 }
 
 func int8SubAvx2(xs, ys, rs []int8) []int8 {
@@ -105,4 +105,11 @@ func init() {
 	Uint8Uint32SubSels = uint8Uint32SubSels
 	Uint8Uint16Sub = uint8Uint16Sub
 	Uint8Uint16SubSels = uint8Uint16SubSels
+
+	DecimalSub = decimalSub
+	DecimalSubSels = decimalSubSels
+	DecimalSubScalar = decimalSubScalar
+	DecimalSubScalarSels = decimalSubScalarSels
+	DecimalSubByScalar = decimalSubByScalar
+	DecimalSubByScalarSels = decimalSubByScalarSels
 }
\ No newline at end of file
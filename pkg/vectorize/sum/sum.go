@@ -14,6 +14,10 @@
 
 package sum
 
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
 var (
 	Int8Sum        func([]int8) int64
 	Int8SumSels    func([]int8, []int64) int64
@@ -35,6 +39,9 @@ var (
 	Float32SumSels func([]float32, []int64) float32
 	Float64Sum     func([]float64) float64
 	Float64SumSels func([]float64, []int64) float64
+
+	DecimalSum     func([]types.Decimal) types.Decimal
+	DecimalSumSels func([]types.Decimal, []int64) types.Decimal
 )
 
 func int8Sum(xs []int8) int64 {
@@ -216,3 +223,21 @@ func float64SumSels(xs []float64, sels []int64) float64 {
 	}
 	return res
 }
+
+func decimalSum(xs []types.Decimal) types.Decimal {
+	var res types.Decimal
+
+	for _, x := range xs {
+		res = res.Add(x)
+	}
+	return res
+}
+
+func decimalSumSels(xs []types.Decimal, sels []int64) types.Decimal {
+	var res types.Decimal
+
+	for _, sel := range sels {
+		res = res.Add(xs[sel])
+	}
+	return res
+}
@@ -87,6 +87,9 @@ func init() {
 	Uint64SumSels = uint64SumSels
 	Float32SumSels = float32SumSels
 	Float64SumSels = float64SumSels
+
+	DecimalSum = decimalSum
+	DecimalSumSels = decimalSumSels
 }
 
 func int8SumAvx2(xs []int8) int64 {
@@ -38,4 +38,7 @@ func init() {
 	Uint64SumSels = uint64SumSels
 	Float32SumSels = float32SumSels
 	Float64SumSels = float64SumSels
+
+	DecimalSum = decimalSum
+	DecimalSumSels = decimalSumSels
 }
@@ -243,6 +243,47 @@ func (s *Storage) Append(index uint64, offset int, batchSize int, shardId uint64
 	return writtenBytes, changedBytes, nil
 }
 
+//deleteRows deletes the rows of the batch from the table
+func (s *Storage) deleteRows(index uint64, offset int, batchSize int, shardId uint64, cmd []byte, key []byte) (uint64, int64, []byte) {
+	if err := s.DB.Closed.Load(); err != nil {
+		panic(err)
+	}
+	if offset >= batchSize {
+		panic(fmt.Sprintf("bad index %d: offset %d, size %d", index, offset, batchSize))
+	}
+	t0 := time.Now()
+	defer func() {
+		logutil.Debugf("[S-%d|logIndex:%d,%d]deleteRows handler cost %d ms", shardId, index, offset, time.Since(t0).Milliseconds())
+	}()
+	customReq := &pb.AppendRequest{}
+	protoc.MustUnmarshal(customReq, cmd)
+	bat, _, err := protocol.DecodeBatch(customReq.Data)
+	if err != nil {
+		resp := errDriver.ErrorResp(err)
+		return 0, 0, resp
+	}
+	ctx := aoedb.DeleteCtx{
+		TableMutationCtx: aoedb.TableMutationCtx{
+			DBMutationCtx: aoedb.DBMutationCtx{
+				Id:     index,
+				Offset: offset,
+				Size:   batchSize,
+				DB:     aoedb.IdToNameFactory.Encode(shardId),
+			},
+			Table: customReq.TabletName,
+		},
+		Data: bat,
+	}
+	err = s.DB.Delete(&ctx)
+	if err != nil {
+		resp := errDriver.ErrorResp(err)
+		return 0, 0, resp
+	}
+	writtenBytes := uint64(len(key) + len(customReq.Data))
+	changedBytes := int64(writtenBytes)
+	return writtenBytes, changedBytes, nil
+}
+
 //Relation  returns a relation of the db and the table
 func (s *Storage) Relation(dbname, tabletName string) (*aoedb.Relation, error) {
 	return s.DB.Relation(dbname, tabletName)
@@ -322,6 +363,48 @@ func (s *Storage) createTable(index uint64, offset int, batchsize int, shardId u
 	return writtenBytes, changedBytes, buf
 }
 
+//alterTable replaces the columns of the table in the storage.
+//If the storage is closed, it panics.
+func (s *Storage) alterTable(index uint64, offset int, batchsize int, shardId uint64, cmd []byte, key []byte) (uint64, int64, []byte) {
+	if err := s.DB.Closed.Load(); err != nil {
+		panic(err)
+	}
+	if offset >= batchsize {
+		panic(fmt.Sprintf("bad index %d: offset %d, size %d", index, offset, batchsize))
+	}
+	t0 := time.Now()
+	defer func() {
+		logutil.Debugf("[S-%d|logIndex:%d,%d]alterTable handler cost %d ms", shardId, index, offset, time.Since(t0).Milliseconds())
+	}()
+	customReq := &pb.CreateTabletRequest{}
+	protoc.MustUnmarshal(customReq, cmd)
+
+	tblInfo, err := helper.DecodeTable(customReq.TableInfo)
+	if err != nil {
+		return 0, 0, errDriver.ErrorResp(err)
+	}
+	cols, err := adaptor.TableInfoToColDefs(&tblInfo)
+	if err != nil {
+		return 0, 0, errDriver.ErrorResp(err)
+	}
+	ctx := aoedb.AlterTableCtx{
+		DBMutationCtx: aoedb.DBMutationCtx{
+			Id:     index,
+			Offset: offset,
+			Size:   batchsize,
+			DB:     aoedb.IdToNameFactory.Encode(shardId),
+		},
+		Table: customReq.Name,
+		Cols:  cols,
+	}
+	if err = s.DB.AlterTable(&ctx); err != nil {
+		return 0, 0, errDriver.ErrorResp(err)
+	}
+	writtenBytes := uint64(len(key) + len(customReq.TableInfo))
+	changedBytes := int64(writtenBytes)
+	return writtenBytes, changedBytes, nil
+}
+
 //DropTable drops the table in the storage.
 //If the storage is closed, it panics.
 func (s *Storage) dropTable(index uint64, offset, batchsize int, shardId uint64, cmd []byte, key []byte) (uint64, int64, []byte) {
@@ -533,6 +616,8 @@ func (s *Storage) Write(ctx storage.WriteContext) error {
 		switch CmdType {
 		case uint64(pb.CreateTablet):
 			writtenBytes, changedBytes, rep = s.createTable(batch.Index, idx, batchSize, shard.ID, cmd, key)
+		case uint64(pb.AlterTablet):
+			writtenBytes, changedBytes, rep = s.alterTable(batch.Index, idx, batchSize, shard.ID, cmd, key)
 		case uint64(pb.DropTablet):
 			writtenBytes, changedBytes, rep = s.dropTable(batch.Index, idx, batchSize, shard.ID, cmd, key)
 		case uint64(pb.Append):
@@ -541,6 +626,8 @@ func (s *Storage) Write(ctx storage.WriteContext) error {
 			writtenBytes, changedBytes, rep = s.createIndex(batch.Index, idx, batchSize, shard.ID, cmd, key)
 		case uint64(pb.DropIndex):
 			writtenBytes, changedBytes, rep = s.dropIndex(batch.Index, idx, batchSize, shard.ID, cmd, key)
+		case uint64(pb.DeleteRows):
+			writtenBytes, changedBytes, rep = s.deleteRows(batch.Index, idx, batchSize, shard.ID, cmd, key)
 		}
 		ctx.AppendResponse(rep)
 		totalWrittenBytes += writtenBytes
@@ -160,6 +160,8 @@ type CubeDriver interface {
 	AsyncAllocID([]byte, uint64, func(CustomRequest, []byte, error), interface{})
 	// Append appends the data in the table
 	Append(string, uint64, []byte) error
+	// DeleteRows deletes the rows of the data from the table
+	DeleteRows(string, uint64, []byte) error
 	//GetSnapshot gets the snapshot from the table.
 	//If there's no segment, it returns an empty snapshot.
 	GetSnapshot(dbi.GetSnapshotCtx) (*handle.Snapshot, error)
@@ -169,6 +171,8 @@ type CubeDriver interface {
 	GetSegmentedId(uint64) (uint64, error)
 	//CreateTablet creates a table in the storage.
 	CreateTablet(name string, shardId uint64, tbl *aoe.TableInfo) error
+	//AlterTablet replaces the columns of the table in the storage.
+	AlterTablet(name string, shardId uint64, tbl *aoe.TableInfo) error
 	//DropTablet drops the table in the storage.
 	DropTablet(string, uint64) (uint64, error)
 	//CreateIndex creates an index
@@ -779,6 +783,23 @@ func (h *driver) Append(name string, shardId uint64, data []byte) error {
 	return err
 }
 
+func (h *driver) DeleteRows(name string, shardId uint64, data []byte) error {
+	req := pb.Request{
+		Type:  pb.DeleteRows,
+		Group: pb.AOEGroup,
+		Shard: shardId,
+		Append: pb.AppendRequest{
+			Data:       data,
+			TabletName: name,
+		},
+	}
+	rsp, err := h.ExecWithGroup(req, pb.AOEGroup)
+	if rsp != nil || len(rsp) != 0 {
+		err = errors.New(string(rsp))
+	}
+	return err
+}
+
 func (h *driver) GetSnapshot(ctx dbi.GetSnapshotCtx) (*handle.Snapshot, error) {
 	ctxStr, err := json.Marshal(ctx)
 	req := pb.Request{
@@ -858,6 +879,24 @@ func (h *driver) CreateTablet(name string, toShard uint64, tbl *aoe.TableInfo) (
 	return err
 }
 
+func (h *driver) AlterTablet(name string, toShard uint64, tbl *aoe.TableInfo) error {
+	info, _ := helper.EncodeTable(*tbl)
+	req := pb.Request{
+		Shard: toShard,
+		Group: pb.AOEGroup,
+		Type:  pb.AlterTablet,
+		CreateTablet: pb.CreateTabletRequest{
+			Name:      name,
+			TableInfo: info,
+		},
+	}
+	rsp, err := h.ExecWithGroup(req, pb.AOEGroup)
+	if rsp != nil || len(rsp) != 0 {
+		err = errors.New(string(rsp))
+	}
+	return err
+}
+
 func (h *driver) DropTablet(name string, toShard uint64) (id uint64, err error) {
 	req := pb.Request{
 		Shard: toShard,
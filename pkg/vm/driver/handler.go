@@ -125,6 +125,12 @@ func (h *driver) BuildRequest(req *CustomRequest, cmd interface{}) error {
 		req.CustomType = uint64(pb.CreateTablet)
 		req.Write = true
 		req.Cmd = protoc.MustMarshal(&msg)
+	case pb.AlterTablet:
+		msg := customReq.CreateTablet
+		req.Group = uint64(customReq.Group)
+		req.CustomType = uint64(pb.AlterTablet)
+		req.Write = true
+		req.Cmd = protoc.MustMarshal(&msg)
 	case pb.DropTablet:
 		msg := customReq.DropTablet
 		req.Group = uint64(customReq.Group)
@@ -149,6 +155,12 @@ func (h *driver) BuildRequest(req *CustomRequest, cmd interface{}) error {
 		req.CustomType = uint64(pb.DropIndex)
 		req.Write = true
 		req.Cmd = protoc.MustMarshal(&msg)
+	case pb.DeleteRows:
+		msg := customReq.Append
+		req.Group = uint64(customReq.Group)
+		req.CustomType = uint64(pb.DeleteRows)
+		req.Write = true
+		req.Cmd = protoc.MustMarshal(&msg)
 	case pb.TabletNames:
 		msg := customReq.TabletIds
 		req.Group = uint64(customReq.Group)
@@ -50,6 +50,8 @@ const (
 	GetSegmentedId           Type = 108
 	CreateIndex              Type = 109
 	DropIndex                Type = 110
+	DeleteRows               Type = 111
+	AlterTablet              Type = 112
 )
 
 var Type_name = map[int32]string{
@@ -77,6 +79,8 @@ var Type_name = map[int32]string{
 	108: "GetSegmentedId",
 	109: "CreateIndex",
 	110: "DropIndex",
+	111: "DeleteRows",
+	112: "AlterTablet",
 }
 
 var Type_value = map[string]int32{
@@ -104,6 +108,8 @@ var Type_value = map[string]int32{
 	"GetSegmentedId":           108,
 	"CreateIndex":              109,
 	"DropIndex":                110,
+	"DeleteRows":               111,
+	"AlterTablet":              112,
 }
 
 func (x Type) String() string {
@@ -105,7 +105,10 @@ func main() {
 			blkIt := segment.NewIt()
 			for blkIt.Valid() {
 				block := blkIt.GetHandle()
-				hh := block.Prefetch()
+				hh, err := block.Prefetch()
+				if err != nil {
+					panic(err)
+				}
 				vec, err := hh.GetReaderByAttr(1)
 				if err != nil {
 					panic(err)
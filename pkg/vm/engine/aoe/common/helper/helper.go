@@ -17,6 +17,7 @@ package helper
 import (
 	"bytes"
 	"encoding/gob"
+	"fmt"
 
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -45,6 +46,7 @@ func Transfer(sid, tid, typ uint64, name string,
 	tbl.Type = typ
 	tbl.Comment = []byte(CommentDefs(defs))
 	tbl.Columns = ColumnDefs(sid, tid, defs)
+	tbl.NextColumnId = uint64(len(tbl.Columns))
 	mp := make(map[string]uint64)
 	{
 		for _, col := range tbl.Columns {
@@ -63,6 +65,39 @@ func Transfer(sid, tid, typ uint64, name string,
 	return tbl, nil
 }
 
+// Versioned returns tbl with the hidden column which versions the rows,
+// see aoe.TimestampKey.
+func Versioned(tbl aoe.TableInfo) aoe.TableInfo {
+	if IsVersioned(tbl) {
+		return tbl
+	}
+	next := tbl.NextColumnId
+	for _, col := range tbl.Columns {
+		if next <= col.Id {
+			next = col.Id + 1
+		}
+	}
+	tbl.Columns = append(append([]aoe.ColumnInfo{}, tbl.Columns...), aoe.ColumnInfo{
+		SchemaId: tbl.SchemaId,
+		TableID:  tbl.Id,
+		Id:       next,
+		Name:     aoe.TimestampKey,
+		Type:     types.Type{Oid: types.T_uint64, Size: 8},
+	})
+	tbl.NextColumnId = next + 1
+	return tbl
+}
+
+// IsVersioned checks whether the rows of the table are versioned.
+func IsVersioned(tbl aoe.TableInfo) bool {
+	for _, col := range tbl.Columns {
+		if col.Name == aoe.TimestampKey {
+			return true
+		}
+	}
+	return false
+}
+
 func UnTransfer(tbl aoe.TableInfo) (uint64, uint64, uint64, string, []engine.TableDef, error) {
 	var err error
 	var defs []engine.TableDef
@@ -76,6 +111,9 @@ func UnTransfer(tbl aoe.TableInfo) (uint64, uint64, uint64, string, []engine.Tab
 		defs = append(defs, pdef)
 	}
 	for _, col := range tbl.Columns {
+		if col.Name == aoe.TimestampKey {
+			continue
+		}
 		defs = append(defs, &engine.AttributeDef{
 			Attr: engine.Attribute{
 				Alg:     compress.T(col.Alg),
@@ -110,9 +148,136 @@ func UnTransfer(tbl aoe.TableInfo) (uint64, uint64, uint64, string, []engine.Tab
 		comment.Comment = string(tbl.Comment)
 		defs = append(defs, comment)
 	}
+	if len(tbl.Statistics) > 0 {
+		stats := new(engine.StatisticsDef)
+		if err = encoding.Decode(tbl.Statistics, stats); err != nil {
+			return 0, 0, 0, "", nil, err
+		}
+		defs = append(defs, stats)
+	}
 	return tbl.SchemaId, tbl.Id, tbl.Type, tbl.Name, defs, nil
 }
 
+// AlterTable returns a copy of tbl changed by the changes of one ALTER TABLE,
+// tbl is not modified if any of the changes is invalid.
+func AlterTable(tbl aoe.TableInfo, changes []engine.SchemaChange) (aoe.TableInfo, error) {
+	tbl.Columns = append([]aoe.ColumnInfo{}, tbl.Columns...)
+	tbl.Indices = append([]aoe.IndexInfo{}, tbl.Indices...)
+	next := tbl.NextColumnId
+	if n := uint64(len(tbl.Columns)); next < n {
+		next = n
+	}
+	for _, col := range tbl.Columns {
+		if next <= col.Id {
+			next = col.Id + 1
+		}
+	}
+	for _, change := range changes {
+		if change.Drop {
+			d, ok := change.Def.(*engine.AttributeDef)
+			if !ok {
+				return tbl, fmt.Errorf("unsupported table definition '%T'", change.Def)
+			}
+			i := columnIndex(tbl.Columns, d.Attr.Name)
+			if i < 0 {
+				return tbl, fmt.Errorf("unknown column '%s'", d.Attr.Name)
+			}
+			if len(Attribute(tbl)) == 1 {
+				return tbl, fmt.Errorf("can not drop the only column '%s'", d.Attr.Name)
+			}
+			tbl.Columns = append(tbl.Columns[:i], tbl.Columns[i+1:]...)
+			tbl.Indices = dropIndexColumn(tbl.Indices, d.Attr.Name)
+			continue
+		}
+		switch d := change.Def.(type) {
+		case *engine.AttributeDef:
+			if d.Attr.Name == aoe.TimestampKey || columnIndex(tbl.Columns, d.Attr.Name) >= 0 {
+				return tbl, fmt.Errorf("column '%s' already exists", d.Attr.Name)
+			}
+			tbl.Columns = append(tbl.Columns, aoe.ColumnInfo{
+				SchemaId: tbl.SchemaId,
+				TableID:  tbl.Id,
+				Id:       next,
+				Name:     d.Attr.Name,
+				Alg:      int(d.Attr.Alg),
+				Type:     d.Attr.Type,
+				Default:  d.Attr.Default,
+			})
+			next++
+		case *engine.RenameColumnDef:
+			i := columnIndex(tbl.Columns, d.Name)
+			if i < 0 {
+				return tbl, fmt.Errorf("unknown column '%s'", d.Name)
+			}
+			if d.NewName == aoe.TimestampKey || columnIndex(tbl.Columns, d.NewName) >= 0 {
+				return tbl, fmt.Errorf("column '%s' already exists", d.NewName)
+			}
+			tbl.Columns[i].Name = d.NewName
+			tbl.Indices = renameIndexColumn(tbl.Indices, d.Name, d.NewName)
+		case *engine.ColumnDefaultDef:
+			i := columnIndex(tbl.Columns, d.Name)
+			if i < 0 {
+				return tbl, fmt.Errorf("unknown column '%s'", d.Name)
+			}
+			tbl.Columns[i].Default = d.Default
+		case *engine.RenameTableDef:
+			tbl.Name = d.Name
+		default:
+			return tbl, fmt.Errorf("unsupported table definition '%T'", change.Def)
+		}
+	}
+	tbl.NextColumnId = next
+	return tbl, nil
+}
+
+// columnIndex returns the index of the column, the hidden column is not found.
+func columnIndex(cols []aoe.ColumnInfo, name string) int {
+	for i, col := range cols {
+		if col.Name == name && name != aoe.TimestampKey {
+			return i
+		}
+	}
+	return -1
+}
+
+// dropIndexColumn removes the column from the indices, the indices left
+// without columns are dropped
+func dropIndexColumn(idxs []aoe.IndexInfo, name string) []aoe.IndexInfo {
+	rs := make([]aoe.IndexInfo, 0, len(idxs))
+	for _, idx := range idxs {
+		var cols []uint64
+		var names []string
+		for i, colName := range idx.ColumnNames {
+			if colName == name {
+				continue
+			}
+			names = append(names, colName)
+			if i < len(idx.Columns) {
+				cols = append(cols, idx.Columns[i])
+			}
+		}
+		if len(names) == 0 {
+			continue
+		}
+		idx.Columns, idx.ColumnNames = cols, names
+		rs = append(rs, idx)
+	}
+	return rs
+}
+
+func renameIndexColumn(idxs []aoe.IndexInfo, name, newName string) []aoe.IndexInfo {
+	for i := range idxs {
+		names := append([]string{}, idxs[i].ColumnNames...)
+		for j, colName := range names {
+			if colName == name {
+				names[j] = newName
+			}
+		}
+		idxs[i].ColumnNames = names
+	}
+	return idxs
+}
+
 func EncodeTable(tbl aoe.TableInfo) ([]byte, error) {
 	return encoding.Encode(tbl)
 }
@@ -255,14 +420,17 @@ func Index(tbl aoe.TableInfo) []*engine.IndexTableDef {
 }
 
 func Attribute(tbl aoe.TableInfo) []engine.Attribute {
-	attrs := make([]engine.Attribute, len(tbl.Columns))
-	for i, col := range tbl.Columns {
-		attrs[i] = engine.Attribute{
+	attrs := make([]engine.Attribute, 0, len(tbl.Columns))
+	for _, col := range tbl.Columns {
+		if col.Name == aoe.TimestampKey {
+			continue
+		}
+		attrs = append(attrs, engine.Attribute{
 			Alg:     compress.T(col.Alg),
 			Name:    col.Name,
 			Type:    col.Type,
 			Default: col.Default,
-		}
+		})
 	}
 	return attrs
 }
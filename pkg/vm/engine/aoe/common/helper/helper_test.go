@@ -28,6 +28,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAoe(t *testing.T) {
@@ -57,6 +58,64 @@ func TestAoe(t *testing.T) {
 
 }
 
+func TestAlterTable(t *testing.T) {
+	tbl, err := Transfer(1, 2, 0, "t", NewTableDefs())
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), tbl.NextColumnId)
+
+	altered, err := AlterTable(tbl, []engine.SchemaChange{
+		{Def: &engine.RenameColumnDef{Name: "a", NewName: "c"}},
+		{Drop: true, Def: &engine.AttributeDef{Attr: engine.Attribute{Name: "b"}}},
+		{Def: &engine.AttributeDef{Attr: engine.Attribute{
+			Name:    "b",
+			Type:    types.Type{Oid: types.T_int64, Size: 8},
+			Default: engine.MakeDefaultExpr(true, int64(1), false),
+		}}},
+		{Def: &engine.RenameTableDef{Name: "u"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "u", altered.Name)
+	require.Equal(t, 2, len(altered.Columns))
+	require.Equal(t, "c", altered.Columns[0].Name)
+	// the id of the dropped column is not reused
+	require.Equal(t, uint64(2), altered.Columns[1].Id)
+	require.Equal(t, uint64(3), altered.NextColumnId)
+	require.Equal(t, []string{"c"}, altered.Indices[0].ColumnNames)
+
+	// none of the changes is applied if one of them fails
+	_, err = AlterTable(tbl, []engine.SchemaChange{
+		{Def: &engine.RenameColumnDef{Name: "a", NewName: "c"}},
+		{Def: &engine.ColumnDefaultDef{Name: "d"}},
+	})
+	require.Error(t, err)
+	require.Equal(t, "a", tbl.Columns[0].Name)
+}
+
+func TestVersioned(t *testing.T) {
+	tbl, err := Transfer(1, 2, 0, "t", NewTableDefs())
+	require.NoError(t, err)
+	require.False(t, IsVersioned(tbl))
+	tbl = Versioned(tbl)
+	require.True(t, IsVersioned(tbl))
+	require.Equal(t, 3, len(tbl.Columns))
+	require.Equal(t, uint64(3), tbl.NextColumnId)
+
+	// the hidden column is not an attribute of the table
+	for _, attr := range Attribute(tbl) {
+		require.NotEqual(t, aoe.TimestampKey, attr.Name)
+	}
+
+	// and it can not be changed
+	_, err = AlterTable(tbl, []engine.SchemaChange{{Drop: true, Def: &engine.AttributeDef{Attr: engine.Attribute{Name: aoe.TimestampKey}}}})
+	require.Error(t, err)
+	_, err = AlterTable(tbl, []engine.SchemaChange{{Def: &engine.RenameColumnDef{Name: "a", NewName: aoe.TimestampKey}}})
+	require.Error(t, err)
+	altered, err := AlterTable(tbl, []engine.SchemaChange{{Drop: true, Def: &engine.AttributeDef{Attr: engine.Attribute{Name: "b"}}}})
+	require.NoError(t, err)
+	_, err = AlterTable(altered, []engine.SchemaChange{{Drop: true, Def: &engine.AttributeDef{Attr: engine.Attribute{Name: "a"}}}})
+	require.Error(t, err)
+}
+
 func NewTableDefs() []engine.TableDef {
 	var defs []engine.TableDef
 
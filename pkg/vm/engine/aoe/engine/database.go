@@ -55,7 +55,7 @@ func (db *database) Create(epoch uint64, name string, defs []engine.TableDef) er
 	if err != nil {
 		return err
 	}
-	_, err = db.catalog.CreateTable(epoch, db.id, tbl)
+	_, err = db.catalog.CreateTable(epoch, db.id, helper.Versioned(tbl))
 	return err
 }
 
@@ -33,6 +33,10 @@ func (a *aoeReader) Read(refCount []uint64, attrs []string) (*batch.Batch, error
 		a.enqueue += time.Since(enqueue).Milliseconds()
 	}
 	a.prv = bat
+	if bat != nil && bat.err != nil {
+		a.prv = nil
+		return nil, bat.err
+	}
 	if bat == nil {
 		logutil.Infof("readerid: %d, dequeue latency: %d, enqueue latency: %d , workerid: %d",
 			a.id, a.dequeue, a.enqueue, a.workerid)
@@ -18,11 +18,16 @@ import (
 	"bytes"
 	"encoding/binary"
 	"errors"
+	"fmt"
 	"math/rand"
 
 	"github.com/matrixorigin/matrixcube/raftstore"
 	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
@@ -86,6 +91,16 @@ func (r *relation) Write(_ uint64, bat *batch.Batch) error {
 	if len(r.tablets) == 0 {
 		return errors.New("no tablets exists")
 	}
+	if len(bat.Zs) == 2 && bat.Zs[0] == -1 && bat.Zs[1] == -1 {
+		return r.deleteRows(r.versioned(bat))
+	}
+	if i := batch.GetVectorIndex(bat, aoe.HideKey); i >= 0 {
+		// the updated rows are written with the hidden key they are read with
+		attrs := append(append([]string{}, bat.Attrs[:i]...), bat.Attrs[i+1:]...)
+		vecs := append(append([]*vector.Vector{}, bat.Vecs[:i]...), bat.Vecs[i+1:]...)
+		bat = &batch.Batch{Ro: bat.Ro, Sels: bat.Sels, SelsData: bat.SelsData, Attrs: attrs, Vecs: vecs, Zs: bat.Zs}
+	}
+	bat = r.versioned(bat)
 	var buf bytes.Buffer
 	if err := protocol.EncodeBatch(bat, &buf); err != nil {
 		return err
@@ -114,6 +129,86 @@ func (r *relation) Write(_ uint64, bat *batch.Batch) error {
 	return err
 }
 
+// versioned returns the batch with the timestamp of now as the hidden
+// timestamp of its rows, if the rows of the table are versioned.
+func (r *relation) versioned(bat *batch.Batch) *batch.Batch {
+	if !helper.IsVersioned(*r.tbl) || len(bat.Vecs) == 0 {
+		return bat
+	}
+	ts := engine.Now()
+	vec := vector.New(types.Type{Oid: types.T_uint64, Size: 8})
+	col := make([]uint64, vector.Length(bat.Vecs[0]))
+	for i := range col {
+		col[i] = ts
+	}
+	vec.Col = col
+	attrs, vecs := bat.Attrs, bat.Vecs
+	if i := batch.GetVectorIndex(bat, aoe.TimestampKey); i >= 0 {
+		attrs = append(append([]string{}, attrs[:i]...), attrs[i+1:]...)
+		vecs = append(append([]*vector.Vector{}, vecs[:i]...), vecs[i+1:]...)
+	}
+	attrs = append(append([]string{}, attrs...), aoe.TimestampKey)
+	vecs = append(append([]*vector.Vector{}, vecs...), vec)
+	return &batch.Batch{Ro: bat.Ro, Sels: bat.Sels, SelsData: bat.SelsData, Attrs: attrs, Vecs: vecs, Zs: bat.Zs}
+}
+
+// deleteRows sends the deleted rows to the tablets they are read from,
+// which are known by the hidden key of the rows.
+func (r *relation) deleteRows(bat *batch.Batch) error {
+	vec := batch.GetVector(bat, aoe.HideKey)
+	if vec == nil {
+		return fmt.Errorf("delete from '%s' without column '%s'", r.tbl.Name, aoe.HideKey)
+	}
+	col := vec.Col.(*types.Bytes)
+	sels := bat.Sels
+	if len(sels) == 0 {
+		sels = make([]int64, len(col.Offsets))
+		for i := range sels {
+			sels[i] = int64(i)
+		}
+	}
+	var shards []uint64
+	rows := make(map[uint64][]int64)
+	for _, sel := range sels {
+		shardId, _, _, err := aoe.DecodeRowId(col.Get(sel))
+		if err != nil {
+			return err
+		}
+		if _, ok := rows[shardId]; !ok {
+			shards = append(shards, shardId)
+		}
+		rows[shardId] = append(rows[shardId], sel)
+	}
+	r.mu.Lock()
+	tablets := make(map[uint64]aoe.TabletInfo, len(r.tablets))
+	for _, tbl := range r.tablets {
+		tablets[tbl.ShardId] = tbl
+	}
+	r.mu.Unlock()
+	for _, shardId := range shards {
+		tbl, ok := tablets[shardId]
+		if !ok {
+			return fmt.Errorf("tablet of shard %d not found in table '%s'", shardId, r.tbl.Name)
+		}
+		var buf bytes.Buffer
+		rbat := &batch.Batch{Ro: bat.Ro, Sels: rows[shardId], Attrs: bat.Attrs, Vecs: bat.Vecs, Zs: bat.Zs}
+		if err := protocol.EncodeBatch(rbat, &buf); err != nil {
+			return err
+		}
+		var err error
+		for i := 0; i < defaultRetryTimes; i++ {
+			err = r.catalog.Driver.DeleteRows(tbl.Name, tbl.ShardId, buf.Bytes())
+			if err == nil || !raftstore.IsShardUnavailableErr(err) {
+				break
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (r *relation) update() error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
@@ -237,15 +332,85 @@ func (r *relation) TableDefs() []engine.TableDef {
 	return defs
 }
 
-func (r *relation) AddTableDef(u uint64, def engine.TableDef) error {
+//GetPrimaryKeys returns nil, the aoe table has no primary key.
+func (r *relation) GetPrimaryKeys() []*engine.Attribute {
 	return nil
 }
 
+//GetHideKey returns the row id generated by the reader, see aoe.EncodeRowId.
+func (r *relation) GetHideKey() *engine.Attribute {
+	return &engine.Attribute{
+		Name: aoe.HideKey,
+		Alg:  compress.None,
+		Type: types.Type{Oid: types.T_char, Size: aoe.RowIdSize},
+	}
+}
+
+// AddTableDef replaces the statistics of table, or changes the schema of the
+// table in the catalog and all of its tablets.
+func (r *relation) AddTableDef(u uint64, def engine.TableDef) error {
+	switch d := def.(type) {
+	case *engine.StatisticsDef:
+		data, err := encoding.Encode(d)
+		if err != nil {
+			return err
+		}
+		if err := r.catalog.SetStatistics(u, r.pid, r.tbl.Name, data); err != nil {
+			return err
+		}
+		r.tbl.Statistics = data
+		return nil
+	case *engine.SchemaChangeDef:
+		return r.changeSchema(u, d.Changes)
+	}
+	return r.changeSchema(u, []engine.SchemaChange{{Def: def}})
+}
+
+// DelTableDef drops the column of the table
 func (r *relation) DelTableDef(u uint64, def engine.TableDef) error {
+	return r.changeSchema(u, []engine.SchemaChange{{Drop: true, Def: def}})
+}
+
+func (r *relation) changeSchema(u uint64, changes []engine.SchemaChange) error {
+	tbl, err := helper.AlterTable(*r.tbl, changes)
+	if err != nil {
+		return err
+	}
+	if err = r.catalog.AlterTable(u, r.pid, r.tbl.Name, &tbl); err != nil {
+		return err
+	}
+	*r.tbl = tbl
 	return nil
 }
 
 func (r *relation) NewReader(num int, _ extend.Extend, _ []byte) []engine.Reader {
+	return r.newReaders(num, 0)
+}
+
+// NewSnapshotReader makes the readers of the rows visible at ts by their
+// hidden timestamps and the versions of the deletes.
+func (r *relation) NewSnapshotReader(ts uint64, num int, _ extend.Extend, _ []byte) []engine.Reader {
+	return r.newReaders(num, ts)
+}
+
+// ChangedSince asks the tablets of the table when their rows were changed last.
+func (r *relation) ChangedSince(ts uint64) (bool, error) {
+	r.mu.Lock()
+	tablets := append([]aoe.TabletInfo{}, r.tablets...)
+	r.mu.Unlock()
+	for _, tbl := range tablets {
+		ids, err := r.catalog.Driver.GetSegmentIds(tbl.Name, tbl.ShardId)
+		if err != nil {
+			return false, err
+		}
+		if ids.Ts > ts {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *relation) newReaders(num int, ts uint64) []engine.Reader {
 	iodepth := num / int(r.cfg.QueueMaxReaderCount)
 	if num%int(r.cfg.QueueMaxReaderCount) > 0 {
 		iodepth++
@@ -255,6 +420,7 @@ func (r *relation) NewReader(num int, _ extend.Extend, _ []byte) []engine.Reader
 		start:   false,
 		readers: make([]engine.Reader, num),
 		rel:     r,
+		ts:      ts,
 	}
 	readStore.rhs = make([]chan *batData, readStore.iodepth)
 	readStore.chs = make([]chan *batData, readStore.iodepth)
@@ -70,6 +70,7 @@ type store struct {
 	start   bool
 	mutex   sync.RWMutex
 	iodepth int
+	ts      uint64 //the timestamp the rows are read at, 0 for now
 }
 
 type batData struct {
@@ -79,6 +80,7 @@ type batData struct {
 	use bool
 	id  int8
 	zs  []int64
+	err error
 }
 
 type worker struct {
@@ -104,6 +106,8 @@ type database struct {
 	cfg     *EngineConfig
 }
 
+var _ engine.SnapshotRelation = &relation{}
+
 type relation struct {
 	mu       sync.Mutex
 	pid      uint64            //database id
@@ -0,0 +1,169 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	cConfig "github.com/matrixorigin/matrixcube/config"
+	"github.com/matrixorigin/matrixcube/raftstore"
+	cstorage "github.com/matrixorigin/matrixcube/storage"
+	catalog2 "github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/sql/compile"
+	aoe3 "github.com/matrixorigin/matrixone/pkg/vm/driver/aoe"
+	"github.com/matrixorigin/matrixone/pkg/vm/driver/config"
+	"github.com/matrixorigin/matrixone/pkg/vm/driver/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+// TestAOEEngineUpdate runs UPDATE and DELETE on an aoe table, the old rows
+// are deleted from their tablets by the hidden key.
+func TestAOEEngineUpdate(t *testing.T) {
+	c := testutil.NewTestAOECluster(t,
+		func(node int) *config.Config {
+			c := &config.Config{}
+			c.ClusterConfig.PreAllocatedGroupNum = 20
+			return c
+		},
+		testutil.WithTestAOEClusterAOEStorageFunc(func(path string, feature cstorage.Feature) (*aoe3.Storage, error) {
+			opts := &storage.Options{}
+			opts.CacheCfg = &storage.CacheCfg{
+				IndexCapacity:  blockRows * blockCntPerSegment * 80,
+				InsertCapacity: blockRows * uint64(colCnt) * 2000,
+				DataCapacity:   blockRows * uint64(colCnt) * 2000,
+			}
+			opts.Meta.Conf = &storage.MetaCfg{
+				SegmentMaxBlocks: blockCntPerSegment,
+				BlockMaxRows:     blockRows,
+			}
+			return aoe3.NewStorageWithOptions(path, feature, opts)
+		}),
+		testutil.WithTestAOEClusterUsePebble(),
+		testutil.WithTestAOEClusterRaftClusterOptions(
+			raftstore.WithTestClusterNodeCount(1),
+			raftstore.WithTestClusterRecreate(true),
+			raftstore.WithTestClusterLogLevel(zapcore.InfoLevel),
+			raftstore.WithTestClusterDataPath("./test"),
+			raftstore.WithAppendTestClusterAdjustConfigFunc(func(node int, cfg *cConfig.Config) {
+				cfg.Worker.RaftEventWorkers = uint64(32)
+			})))
+	c.Start()
+	defer c.Stop()
+	c.RaftCluster.WaitLeadersByCount(21, time.Second*30)
+	time.Sleep(3 * time.Second)
+
+	e := New(catalog2.NewCatalog(c.CubeDrivers[0]), &EngineConfig{})
+	require.NoError(t, e.Create(0, testDBName, 0))
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	// run returns the rows of result and the affected rows
+	run := func(sql string) ([]string, uint64) {
+		es, err := compile.New(testDBName, sql, "", e, proc, nil).Build()
+		require.NoError(t, err, sql)
+		var rows []string
+		err = es[0].Compile(nil, func(_ interface{}, bat *batch.Batch) error {
+			if len(bat.Zs) == 0 {
+				return nil
+			}
+			n := len(bat.Zs)
+			cols := make([][]string, len(bat.Vecs))
+			for i, vec := range bat.Vecs {
+				cols[i] = make([]string, n)
+				if err := vec.GetColumnData(bat.Sels, bat.Zs, cols[i]); err != nil {
+					return err
+				}
+			}
+			for j := 0; j < n; j++ {
+				row := make([]string, len(cols))
+				for i := range cols {
+					row[i] = cols[i][j]
+				}
+				rows = append(rows, strings.Join(row, ","))
+			}
+			return nil
+		})
+		require.NoError(t, err, sql)
+		require.NoError(t, es[0].Run(0), sql)
+		sort.Strings(rows)
+		return rows, es[0].GetAffectedRows()
+	}
+
+	run("create table upd (a int, b varchar(10));")
+	_, n := run("insert into upd values (1, 'a'), (2, 'b'), (3, 'c'), (2, 'b');")
+	require.Equal(t, uint64(4), n)
+	db, err := e.Database(testDBName)
+	require.NoError(t, err)
+	rel, err := db.Relation("upd")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rel.Nodes()))
+	compile.InitAddress(rel.Nodes()[0].Addr)
+	rel.Close()
+
+	_, n = run("update upd set b = 'x' where a = 2;")
+	require.Equal(t, uint64(2), n)
+	rows, _ := run("select a, b from upd;")
+	require.Equal(t, []string{"1,a", "2,x", "2,x", "3,c"}, rows)
+
+	_, n = run("update upd set a = a + 10 where b = 'x';")
+	require.Equal(t, uint64(2), n)
+	_, n = run("delete from upd where a = 1;")
+	require.Equal(t, uint64(1), n)
+	rows, _ = run("select a, b from upd;")
+	require.Equal(t, []string{"12,x", "12,x", "3,c"}, rows)
+
+	// the snapshot reads the rows as they were before the later writes
+	ts := engine.Now()
+	run("insert into upd values (4, 'd');")
+	run("delete from upd where a = 3;")
+	rel, err = db.Relation("upd")
+	require.NoError(t, err)
+	defer rel.Close()
+	snapshot := rel.(engine.SnapshotRelation)
+	changed, err := snapshot.ChangedSince(ts)
+	require.NoError(t, err)
+	require.True(t, changed)
+	changed, err = snapshot.ChangedSince(engine.Now())
+	require.NoError(t, err)
+	require.False(t, changed)
+	read := func(rds []engine.Reader) []int {
+		var as []int
+		for _, rd := range rds {
+			for {
+				bat, err := rd.Read([]uint64{1}, []string{"a"})
+				require.NoError(t, err)
+				if bat == nil {
+					break
+				}
+				for _, a := range bat.Vecs[0].Col.([]int32) {
+					as = append(as, int(a))
+				}
+			}
+		}
+		sort.Ints(as)
+		return as
+	}
+	require.Equal(t, []int{3, 12, 12}, read(snapshot.NewSnapshotReader(ts, 1, nil, nil)))
+	require.Equal(t, []int{4, 12, 12}, read(rel.NewReader(1, nil, nil)))
+}
@@ -67,10 +67,12 @@ func (w *worker) Start(refCount []uint64, attrs []string) {
 		data := w.alloc(attrs)
 		w.allocLatency += time.Since(t).Milliseconds()
 		now := time.Now()
-		bat, err := w.blocks[i].Read(refCount, attrs, data.cds, data.dds)
+		bat, err := w.blocks[i].ReadAt(w.storeReader.ts, refCount, attrs, data.cds, data.dds)
 		w.readLatency += time.Since(now).Milliseconds()
 		if err != nil {
-			panic("error")
+			data.bat, data.err = nil, err
+			w.storeReader.SetBatch(data, w.id)
+			break
 		}
 		n := vector.Length(bat.Vecs[0])
 		if n > cap(data.zs) {
@@ -39,6 +39,14 @@ func (r *localRoRelation) TableDefs() []engine.TableDef {
 	panic("implement me")
 }
 
+func (r *localRoRelation) GetPrimaryKeys() []*engine.Attribute {
+	panic("implement me")
+}
+
+func (r *localRoRelation) GetHideKey() *engine.Attribute {
+	panic("implement me")
+}
+
 func (r *localRoRelation) AddTableDef(u uint64, def engine.TableDef) error {
 	panic("implement me")
 }
@@ -0,0 +1,94 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mergesort
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+// CompactSels returns the positions of the rows kept in a column of
+// n rows, in the order they were, followed by the last of them repeated
+// up to n so that the blocks keep their length and their order.
+func CompactSels(n int, deleted func(int) bool) ([]int64, int) {
+	sels := make([]int64, 0, n)
+	for i := 0; i < n; i++ {
+		if !deleted(i) {
+			sels = append(sels, int64(i))
+		}
+	}
+	kept := len(sels)
+	var last int64
+	if kept > 0 {
+		last = sels[kept-1]
+	}
+	for len(sels) < n {
+		sels = append(sels, last)
+	}
+	return sels, kept
+}
+
+// CompactColumn moves the rows of the column picked by sels to the front,
+// every block keeps its length. The positions of sels are over the whole
+// column, i.e. block idx * block rows + row offset, as CompactSels returns.
+func CompactColumn(column []*vector.Vector, sels []int64) error {
+	n := vector.Length(column[0])
+	all := vector.New(column[0].Typ)
+	for i, vec := range column {
+		if err := appendBlock(all, vec, uint64(i*n)); err != nil {
+			return err
+		}
+	}
+	vector.Shrink(all, sels)
+	for i, vec := range column {
+		start, end := i*n, (i+1)*n
+		vec.Col = vector.Window(all, start, end, vector.New(all.Typ)).Col
+		vec.Nsp = &nulls.Nulls{}
+		if nulls.Any(all.Nsp) {
+			for row := start; row < end; row++ {
+				if nulls.Contains(all.Nsp, uint64(row)) {
+					nulls.Add(vec.Nsp, uint64(row-start))
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func appendBlock(all, vec *vector.Vector, offset uint64) error {
+	var err error
+	switch vec.Typ.Oid {
+	case types.T_char, types.T_json, types.T_varchar:
+		col := vec.Col.(*types.Bytes)
+		data := make([][]byte, len(col.Offsets))
+		for i := range data {
+			data[i] = col.Get(int64(i))
+		}
+		err = vector.Append(all, data)
+	default:
+		err = vector.Append(all, vec.Col)
+	}
+	if err != nil {
+		return err
+	}
+	if nulls.Any(vec.Nsp) {
+		it := vec.Nsp.Np.Iterator()
+		for it.HasNext() {
+			nulls.Add(all.Nsp, offset+it.Next())
+		}
+	}
+	return nil
+}
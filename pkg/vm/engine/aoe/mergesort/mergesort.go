@@ -19,6 +19,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/dates"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/datetimes"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/decimals"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/float32s"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/float64s"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/int16s"
@@ -34,71 +35,100 @@ import (
 
 func SortBlockColumns(cols []*vector.Vector, pk int) error {
 	sortedIdx := make([]uint32, vector.Length(cols[pk]))
+	sortBlock(cols[pk], sortedIdx)
+	for i := 0; i < len(cols); i++ {
+		if i == pk {
+			continue
+		}
+		shuffleBlock(cols[i], sortedIdx)
+	}
+	return nil
+}
+
+// SortColumn sorts each block of the column, it returns the positions
+// the rows of each block were moved from.
+func SortColumn(column []*vector.Vector) [][]uint32 {
+	sortedIdx := make([][]uint32, len(column))
+	for i, vec := range column {
+		sortedIdx[i] = make([]uint32, vector.Length(vec))
+		sortBlock(vec, sortedIdx[i])
+	}
+	return sortedIdx
+}
+
+// ShuffleBlocks moves the rows of each block of the column as SortColumn
+// moved the rows of the sorted column.
+func ShuffleBlocks(column []*vector.Vector, sortedIdx [][]uint32) error {
+	for i, vec := range column {
+		shuffleBlock(vec, sortedIdx[i])
+	}
+	return nil
+}
 
-	switch cols[pk].Typ.Oid {
+func sortBlock(col *vector.Vector, sortedIdx []uint32) {
+	switch col.Typ.Oid {
 	case types.T_int8:
-		int8s.Sort(cols[pk], sortedIdx)
+		int8s.Sort(col, sortedIdx)
 	case types.T_int16:
-		int16s.Sort(cols[pk], sortedIdx)
+		int16s.Sort(col, sortedIdx)
 	case types.T_int32:
-		int32s.Sort(cols[pk], sortedIdx)
+		int32s.Sort(col, sortedIdx)
 	case types.T_int64:
-		int64s.Sort(cols[pk], sortedIdx)
+		int64s.Sort(col, sortedIdx)
 	case types.T_uint8:
-		uint8s.Sort(cols[pk], sortedIdx)
+		uint8s.Sort(col, sortedIdx)
 	case types.T_uint16:
-		uint16s.Sort(cols[pk], sortedIdx)
+		uint16s.Sort(col, sortedIdx)
 	case types.T_uint32:
-		uint32s.Sort(cols[pk], sortedIdx)
+		uint32s.Sort(col, sortedIdx)
 	case types.T_uint64:
-		uint64s.Sort(cols[pk], sortedIdx)
+		uint64s.Sort(col, sortedIdx)
 	case types.T_float32:
-		float32s.Sort(cols[pk], sortedIdx)
+		float32s.Sort(col, sortedIdx)
 	case types.T_float64:
-		float64s.Sort(cols[pk], sortedIdx)
+		float64s.Sort(col, sortedIdx)
 	case types.T_date:
-		dates.Sort(cols[pk], sortedIdx)
+		dates.Sort(col, sortedIdx)
 	case types.T_datetime:
-		datetimes.Sort(cols[pk], sortedIdx)
+		datetimes.Sort(col, sortedIdx)
+	case types.T_decimal:
+		decimals.Sort(col, sortedIdx)
 	case types.T_char, types.T_json, types.T_varchar:
-		varchar.Sort(cols[pk], sortedIdx)
+		varchar.Sort(col, sortedIdx)
 	}
+}
 
-	for i := 0; i < len(cols); i++ {
-		if i == pk {
-			continue
-		}
-		switch cols[i].Typ.Oid {
-		case types.T_int8:
-			int8s.Shuffle(cols[i], sortedIdx)
-		case types.T_int16:
-			int16s.Shuffle(cols[i], sortedIdx)
-		case types.T_int32:
-			int32s.Shuffle(cols[i], sortedIdx)
-		case types.T_int64:
-			int64s.Shuffle(cols[i], sortedIdx)
-		case types.T_uint8:
-			uint8s.Shuffle(cols[i], sortedIdx)
-		case types.T_uint16:
-			uint16s.Shuffle(cols[i], sortedIdx)
-		case types.T_uint32:
-			uint32s.Shuffle(cols[i], sortedIdx)
-		case types.T_uint64:
-			uint64s.Shuffle(cols[i], sortedIdx)
-		case types.T_float32:
-			float32s.Shuffle(cols[i], sortedIdx)
-		case types.T_float64:
-			float64s.Shuffle(cols[i], sortedIdx)
-		case types.T_date:
-			dates.Shuffle(cols[i], sortedIdx)
-		case types.T_datetime:
-			datetimes.Shuffle(cols[i], sortedIdx)
-		case types.T_char, types.T_json, types.T_varchar:
-			varchar.Shuffle(cols[i], sortedIdx)
-		}
+func shuffleBlock(col *vector.Vector, sortedIdx []uint32) {
+	switch col.Typ.Oid {
+	case types.T_int8:
+		int8s.Shuffle(col, sortedIdx)
+	case types.T_int16:
+		int16s.Shuffle(col, sortedIdx)
+	case types.T_int32:
+		int32s.Shuffle(col, sortedIdx)
+	case types.T_int64:
+		int64s.Shuffle(col, sortedIdx)
+	case types.T_uint8:
+		uint8s.Shuffle(col, sortedIdx)
+	case types.T_uint16:
+		uint16s.Shuffle(col, sortedIdx)
+	case types.T_uint32:
+		uint32s.Shuffle(col, sortedIdx)
+	case types.T_uint64:
+		uint64s.Shuffle(col, sortedIdx)
+	case types.T_float32:
+		float32s.Shuffle(col, sortedIdx)
+	case types.T_float64:
+		float64s.Shuffle(col, sortedIdx)
+	case types.T_date:
+		dates.Shuffle(col, sortedIdx)
+	case types.T_datetime:
+		datetimes.Shuffle(col, sortedIdx)
+	case types.T_decimal:
+		decimals.Shuffle(col, sortedIdx)
+	case types.T_char, types.T_json, types.T_varchar:
+		varchar.Shuffle(col, sortedIdx)
 	}
-
-	return nil
 }
 
 func MergeSortedColumn(column []*vector.Vector, sortedIdx *[]uint16) error {
@@ -127,6 +157,8 @@ func MergeSortedColumn(column []*vector.Vector, sortedIdx *[]uint16) error {
 		dates.Merge(column, sortedIdx)
 	case types.T_datetime:
 		datetimes.Merge(column, sortedIdx)
+	case types.T_decimal:
+		decimals.Merge(column, sortedIdx)
 	case types.T_char, types.T_json, types.T_varchar:
 		varchar.Merge(column, sortedIdx)
 	}
@@ -159,6 +191,8 @@ func ShuffleColumn(column []*vector.Vector, sortedIdx []uint16) error {
 		dates.Multiplex(column, sortedIdx)
 	case types.T_datetime:
 		datetimes.Multiplex(column, sortedIdx)
+	case types.T_decimal:
+		decimals.Multiplex(column, sortedIdx)
 	case types.T_char, types.T_json, types.T_varchar:
 		varchar.Multiplex(column, sortedIdx)
 	}
@@ -63,6 +63,10 @@ type TableInfo struct {
 	Partition  []byte       `json:"partition"`
 	Properties []Property
 	Epoch      uint64 `json:"epoch"`
+	Statistics []byte `json:"statistics"` // encoded engine.StatisticsDef collected by ANALYZE TABLE
+	// NextColumnId is the id of the next added column, the ids of the dropped
+	// columns are not reused because the tablets still keep their data.
+	NextColumnId uint64 `json:"next_column_id"`
 }
 
 type Property struct {
@@ -19,6 +19,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/db"
@@ -98,6 +99,25 @@ func TableInfoToSchema(catalog *metadata.Catalog, info *aoe.TableInfo) (*db.Tabl
 	return schema, indice
 }
 
+// TableInfoToColDefs returns the columns of the altered table, a column of
+// the tablet is identified by the id of the column in the catalog
+func TableInfoToColDefs(info *aoe.TableInfo) ([]*metadata.ColDef, error) {
+	colDefs := make([]*metadata.ColDef, 0, len(info.Columns))
+	for _, colInfo := range info.Columns {
+		fill, err := encoding.Encode(colInfo.Default)
+		if err != nil {
+			return nil, err
+		}
+		colDefs = append(colDefs, &metadata.ColDef{
+			Name: colInfo.Name,
+			Idx:  int(colInfo.Id),
+			Type: colInfo.Type,
+			Fill: fill,
+		})
+	}
+	return colDefs, nil
+}
+
 func IndiceInfoToIndiceSchema(info *aoe.IndexInfo) *db.IndexSchema {
 	columns := make([]int, len(info.Columns))
 	for _, col := range info.Columns {
@@ -0,0 +1,153 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aoedb
+
+import (
+	"bytes"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/mock"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/wal"
+	"github.com/stretchr/testify/assert"
+)
+
+// readColumn returns the values of attr read from the blocks of table
+func readColumn(t *testing.T, inst *DB, database *metadata.Database, meta *metadata.Table, attr string) []int32 {
+	tblData, err := inst.Store.DataTables.WeakRefTable(meta.Id)
+	assert.Nil(t, err)
+	var vs []int32
+	for _, segId := range inst.GetSegmentIds(database.Name, meta.Schema.Name).Ids {
+		segment := &db.Segment{
+			Data: tblData.WeakRefSegment(segId),
+			Ids:  new(atomic.Value),
+		}
+		for _, id := range segment.Blocks() {
+			bat, err := segment.Block(id).Read([]uint64{1}, []string{attr},
+				[]*bytes.Buffer{bytes.NewBuffer(nil)}, []*bytes.Buffer{bytes.NewBuffer(nil)})
+			assert.Nil(t, err)
+			vs = append(vs, bat.Vecs[0].Col.([]int32)...)
+		}
+	}
+	return vs
+}
+
+func countValue(vs []int32, v int32) int {
+	cnt := 0
+	for _, x := range vs {
+		if x == v {
+			cnt++
+		}
+	}
+	return cnt
+}
+
+func TestAlterTable(t *testing.T) {
+	initTestEnv(t)
+	inst, gen, database := initTestDBWithOptions(t, defaultDBPath, defaultDBName, 10, 2, nil, wal.BrokerRole)
+	schema := metadata.MockSchema(2)
+	createCtx := &CreateTableCtx{
+		DBMutationCtx: *CreateDBMutationCtx(database, gen),
+		Schema:        schema,
+		Indice:        metadata.NewIndexSchema(),
+	}
+	meta, err := inst.CreateTable(createCtx)
+	assert.Nil(t, err)
+
+	// A persistent block and a transient one are written before altering
+	rows := inst.Store.Catalog.Cfg.BlockMaxRows
+	assert.Nil(t, inst.Append(CreateAppendCtx(database, gen, schema.Name, mock.MockBatch(schema.Types(), rows))))
+	assert.Nil(t, inst.Append(CreateAppendCtx(database, gen, schema.Name, mock.MockBatch(schema.Types(), rows/2))))
+	time.Sleep(100 * time.Millisecond)
+
+	typ := schema.ColDefs[0].Type
+	fill, err := encoding.Encode(engine.MakeDefaultExpr(true, int64(7), false))
+	assert.Nil(t, err)
+	alterCtx := &AlterTableCtx{
+		DBMutationCtx: *CreateDBMutationCtx(database, gen),
+		Table:         schema.Name,
+		Cols: []*metadata.ColDef{
+			{Name: "mock_0", Idx: 0, Type: typ},
+			{Name: "renamed", Idx: 1, Type: typ},
+			{Name: "added", Idx: 2, Type: typ, Fill: fill},
+		},
+	}
+	assert.Nil(t, inst.AlterTable(alterCtx))
+	assert.Equal(t, uint64(1), meta.Schema.Version)
+	assert.Equal(t, -1, meta.Schema.GetColIdx("mock_1"))
+
+	// The rows written before read the fill value
+	vs := readColumn(t, inst, database, meta, "added")
+	assert.Equal(t, 15, len(vs))
+	assert.Equal(t, 15, countValue(vs, 7))
+	assert.Equal(t, 15, len(readColumn(t, inst, database, meta, "renamed")))
+
+	// The columns can not change their types
+	_, err = meta.Schema.Alter([]*metadata.ColDef{
+		{Name: "mock_0", Idx: 0, Type: types.Type{Oid: types.T_int64, Size: 8, Width: 64}},
+	})
+	assert.Equal(t, metadata.ErrInvalidSchema, err)
+
+	bat := mock.MockBatch([]types.Type{typ, typ, typ}, rows)
+	bat.Attrs = []string{"mock_0", "renamed", "added"}
+	assert.Nil(t, inst.Append(CreateAppendCtx(database, gen, schema.Name, bat)))
+	time.Sleep(100 * time.Millisecond)
+	vs = readColumn(t, inst, database, meta, "added")
+	assert.Equal(t, 25, len(vs))
+	assert.Equal(t, 16, countValue(vs, 7))
+
+	// The dropped column is hidden, the new rows do not have it
+	alterCtx = &AlterTableCtx{
+		DBMutationCtx: *CreateDBMutationCtx(database, gen),
+		Table:         schema.Name,
+		Cols: []*metadata.ColDef{
+			{Name: "mock_0", Idx: 0, Type: typ},
+			{Name: "added", Idx: 2, Type: typ},
+		},
+	}
+	assert.Nil(t, inst.AlterTable(alterCtx))
+	rel, err := inst.Relation(database.Name, schema.Name)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(rel.Attribute()))
+	rel.Close()
+	bat = mock.MockBatch([]types.Type{typ, typ}, rows/2)
+	bat.Attrs = []string{"mock_0", "added"}
+	assert.Nil(t, inst.Append(CreateAppendCtx(database, gen, schema.Name, bat)))
+	assert.Nil(t, inst.FlushTable(database.Name, schema.Name))
+	time.Sleep(100 * time.Millisecond)
+	vs = readColumn(t, inst, database, meta, "added")
+	assert.Equal(t, 30, len(vs))
+	assert.Equal(t, 16, countValue(vs, 7))
+	inst.Close()
+
+	// The schema and the rows are the same after replaying
+	inst, _, _ = initTestDBWithOptions(t, defaultDBPath, emptyDBName, 10, 2, nil, wal.BrokerRole)
+	defer inst.Close()
+	database, err = inst.Store.Catalog.SimpleGetDatabaseByName(database.Name)
+	assert.Nil(t, err)
+	meta = database.SimpleGetTableByName(schema.Name)
+	assert.Equal(t, uint64(2), meta.Schema.Version)
+	assert.True(t, meta.Schema.ColDefs[1].Dropped)
+	time.Sleep(100 * time.Millisecond)
+	vs = readColumn(t, inst, database, meta, "added")
+	assert.Equal(t, 30, len(vs))
+	assert.Equal(t, 16, countValue(vs, 7))
+}
@@ -80,11 +80,23 @@ type DropIndexCtx struct {
 	IndexNames []string
 }
 
+type AlterTableCtx struct {
+	DBMutationCtx
+	Table string
+	// Cols are the columns of the table after it is altered, see Schema.Alter
+	Cols []*metadata.ColDef
+}
+
 type AppendCtx struct {
 	TableMutationCtx
 	Data *batch.Batch
 }
 
+type DeleteCtx struct {
+	TableMutationCtx
+	Data *batch.Batch
+}
+
 func (ctx *DBMutationCtx) ToLogIndex(database *metadata.Database) *db.LogIndex {
 	return &db.LogIndex{
 		ShardId: database.GetShardId(),
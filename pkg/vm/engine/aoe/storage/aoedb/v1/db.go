@@ -305,6 +305,45 @@ func (d *DB) DropIndex(ctx *DropIndexCtx) error {
 	return nil
 }
 
+// AlterTable replaces the columns of the table with ctx.Cols. The blocks are
+// not rewritten, their rows read the fill values of the columns added later.
+func (d *DB) AlterTable(ctx *AlterTableCtx) error {
+	if err := d.Closed.Load(); err != nil {
+		panic(err)
+	}
+	database, err := d.Store.Catalog.SimpleGetDatabaseByName(ctx.DB)
+	if err != nil {
+		return err
+	}
+	index := ctx.ToLogIndex(database)
+	if err = d.Wal.SyncLog(index); err != nil {
+		return err
+	}
+	defer d.Wal.Checkpoint(index)
+
+	if database.InReplaying(index) {
+		if idx, ok := database.ConsumeIdempotentIndex(index); !ok {
+			err = db.ErrIdempotence
+			return err
+		} else if idx != nil {
+			if idx.IsApplied() {
+				err = db.ErrIdempotence
+				return err
+			}
+		}
+	}
+	meta := database.SimpleGetTableByName(ctx.Table)
+	if meta == nil {
+		err = metadata.ErrTableNotFound
+		return err
+	}
+	schema, err := meta.Schema.Alter(ctx.Cols)
+	if err != nil {
+		return err
+	}
+	return meta.SimpleAlterSchema(schema, index)
+}
+
 func (d *DB) Append(ctx *AppendCtx) (err error) {
 	if err := d.Closed.Load(); err != nil {
 		panic(err)
@@ -346,6 +385,32 @@ func (d *DB) Append(ctx *AppendCtx) (err error) {
 	return err
 }
 
+// Delete deletes the rows of ctx.Data from the table. Each row deletes
+// one matching row of the table that was not deleted yet.
+func (d *DB) Delete(ctx *DeleteCtx) (err error) {
+	if err := d.Closed.Load(); err != nil {
+		panic(err)
+	}
+	database, err := d.Store.Catalog.SimpleGetDatabaseByName(ctx.DB)
+	if err != nil {
+		return err
+	}
+	index := ctx.ToLogIndex(database)
+	if err = d.Wal.SyncLog(index); err != nil {
+		return err
+	}
+	defer d.Wal.Checkpoint(index)
+
+	meta := database.SimpleGetTableByName(ctx.Table)
+	if meta == nil {
+		return metadata.ErrTableNotFound
+	}
+	if meta.GetDeletes().IsApplied(index) {
+		return metadata.ErrIdempotence
+	}
+	return d.DoDelete(meta, ctx.Data, index)
+}
+
 func (d *DB) CreateSnapshot(ctx *CreateSnapshotCtx) (uint64, error) {
 	return d.Impl.CreateSnapshot(ctx.DB, ctx.Path, ctx.Sync)
 }
@@ -367,7 +367,8 @@ func TestConcurrency(t *testing.T) {
 							for blkIt.Valid() {
 								blkCnt++
 								blkHandle := blkIt.GetHandle()
-								hh := blkHandle.Prefetch()
+								hh, err := blkHandle.Prefetch()
+								assert.Nil(t, err)
 								hh.Close()
 								// blkHandle.Close()
 								blkIt.Next()
@@ -477,7 +478,8 @@ func TestConcurrency(t *testing.T) {
 			// if col0.GetBlockType() > base.PERSISTENT_BLK {
 			// 	assert.True(t, ctx.BoolRes)
 			// }
-			hh := blkHandle.Prefetch()
+			hh, err := blkHandle.Prefetch()
+			assert.Nil(t, err)
 			vec0, err := hh.GetReaderByAttr(1)
 			assert.Nil(t, err)
 			val, err := vec0.GetValue(22)
@@ -1145,7 +1147,8 @@ func TestEngine(t *testing.T) {
 				blkIt := sh.NewIt()
 				for blkIt.Valid() {
 					blkHandle := blkIt.GetHandle()
-					hh := blkHandle.Prefetch()
+					hh, err := blkHandle.Prefetch()
+					assert.Nil(t, err)
 					for idx, _ := range attrs {
 						hh.GetReaderByAttr(idx)
 						atomic.AddUint32(&loadCnt, uint32(1))
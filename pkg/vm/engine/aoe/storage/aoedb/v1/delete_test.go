@@ -0,0 +1,285 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aoedb
+
+import (
+	"bytes"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/dbi"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/base"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/mock"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/testutils"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/wal"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/wal/shard"
+	"github.com/stretchr/testify/assert"
+)
+
+func CreateDeleteCtx(database *metadata.Database, gen *shard.MockIndexAllocator, name string, data *batch.Batch) *DeleteCtx {
+	return &DeleteCtx{
+		TableMutationCtx: *CreateTableMutationCtx(database, gen, name),
+		Data:             data,
+	}
+}
+
+// readRows returns the rows read from the blocks, the rows counted by the
+// summarizers, the rows scanned from a snapshot and the rows filtered
+// by mock_0 < 3.
+func readRows(t *testing.T, inst *DB, database *metadata.Database, meta *metadata.Table) (int, uint64, int, uint64) {
+	tblData, err := inst.Store.DataTables.WeakRefTable(meta.Id)
+	assert.Nil(t, err)
+	attr := meta.Schema.ColDefs[0].Name
+	read, counted, filtered := 0, uint64(0), uint64(0)
+	for _, segId := range inst.GetSegmentIds(database.Name, meta.Schema.Name).Ids {
+		segment := &db.Segment{
+			Data: tblData.WeakRefSegment(segId),
+			Ids:  new(atomic.Value),
+		}
+		cnt, err := segment.NewSummarizer().Count(attr, nil)
+		assert.Nil(t, err)
+		counted += cnt
+		bm, err := segment.NewFilter().Lt(attr, int8(3))
+		assert.Nil(t, err)
+		filtered += bm.GetCardinality()
+		for _, id := range segment.Blocks() {
+			bat, err := segment.Block(id).Read([]uint64{1}, []string{attr},
+				[]*bytes.Buffer{bytes.NewBuffer(nil)}, []*bytes.Buffer{bytes.NewBuffer(nil)})
+			assert.Nil(t, err)
+			read += vector.Length(bat.Vecs[0])
+		}
+	}
+	ss, err := inst.GetSnapshot(&dbi.GetSnapshotCtx{
+		DBName:    database.Name,
+		TableName: meta.Schema.Name,
+		Cols:      []int{0},
+		ScanAll:   true,
+	})
+	assert.Nil(t, err)
+	defer ss.Close()
+	scanned := 0
+	segIt := ss.NewIt()
+	for segIt.Valid() {
+		blkIt := segIt.GetHandle().NewIt()
+		for blkIt.Valid() {
+			h, err := blkIt.GetHandle().Prefetch()
+			assert.Nil(t, err)
+			scanned += h.Length()
+			h.Close()
+			blkIt.Next()
+		}
+		blkIt.Close()
+		segIt.Next()
+	}
+	segIt.Close()
+	return read, counted, scanned, filtered
+}
+
+func TestDelete(t *testing.T) {
+	initTestEnv(t)
+	inst, gen, database := initTestDBWithOptions(t, defaultDBPath, defaultDBName, 10, 2, nil, wal.BrokerRole)
+	schema := metadata.MockSchemaAll(14)
+	indice := metadata.NewIndexSchema()
+	_, err := indice.MakeIndex("idx-0", metadata.NumBsi, 0)
+	assert.Nil(t, err)
+	createCtx := &CreateTableCtx{
+		DBMutationCtx: *CreateDBMutationCtx(database, gen),
+		Schema:        schema,
+		Indice:        indice,
+	}
+	meta, err := inst.CreateTable(createCtx)
+	assert.Nil(t, err)
+
+	// Three copies of the same ten rows, the last one in a transient block
+	rows := inst.Store.Catalog.Cfg.BlockMaxRows
+	for i := 0; i < 2; i++ {
+		assert.Nil(t, inst.Append(CreateAppendCtx(database, gen, schema.Name, mock.MockBatch(schema.Types(), rows))))
+	}
+	assert.Nil(t, inst.Append(CreateAppendCtx(database, gen, schema.Name, mock.MockBatch(schema.Types(), rows/2))))
+	time.Sleep(100 * time.Millisecond)
+
+	// Each deleted row removes one of its copies
+	deleteCtx := CreateDeleteCtx(database, gen, schema.Name, mock.MockBatch(schema.Types(), 3))
+	assert.Nil(t, inst.Delete(deleteCtx))
+	assert.Equal(t, metadata.ErrIdempotence, inst.Delete(deleteCtx))
+	read, counted, scanned, _ := readRows(t, inst, database, meta)
+	assert.Equal(t, 22, read)
+	assert.Equal(t, uint64(22), counted)
+	assert.Equal(t, 22, scanned)
+
+	// Deleting the same rows again removes the next copies
+	assert.Nil(t, inst.Delete(CreateDeleteCtx(database, gen, schema.Name, mock.MockBatch(schema.Types(), 3))))
+	read, counted, scanned, _ = readRows(t, inst, database, meta)
+	assert.Equal(t, 19, read)
+	assert.Equal(t, uint64(19), counted)
+	assert.Equal(t, 19, scanned)
+
+	// Flushing the transient block keeps its rows, and the deletes, in place
+	assert.Nil(t, inst.FlushTable(database.Name, schema.Name))
+	assert.Nil(t, inst.Append(CreateAppendCtx(database, gen, schema.Name, mock.MockBatch(schema.Types(), rows))))
+	time.Sleep(100 * time.Millisecond)
+	read, counted, scanned, filtered := readRows(t, inst, database, meta)
+	assert.Equal(t, 29, read)
+	assert.Equal(t, uint64(29), counted)
+	assert.Equal(t, 29, scanned)
+	assert.Equal(t, uint64(6), filtered)
+
+	deleteCtx = CreateDeleteCtx(database, gen, schema.Name, mock.MockBatch(schema.Types(), 1))
+	assert.Nil(t, inst.Delete(deleteCtx))
+	assert.Nil(t, inst.FlushTable(database.Name, schema.Name))
+	inst.Close()
+
+	inst, _, _ = initTestDBWithOptions(t, defaultDBPath, emptyDBName, 10, 2, nil, wal.BrokerRole)
+	defer inst.Close()
+	database, err = inst.Store.Catalog.SimpleGetDatabaseByName(database.Name)
+	assert.Nil(t, err)
+	meta = database.SimpleGetTableByName(schema.Name)
+	time.Sleep(300 * time.Millisecond)
+	assert.Equal(t, metadata.ErrIdempotence, inst.Delete(deleteCtx))
+	read, counted, scanned, filtered = readRows(t, inst, database, meta)
+	assert.Equal(t, 28, read)
+	assert.Equal(t, uint64(28), counted)
+	assert.Equal(t, 28, scanned)
+	assert.Equal(t, uint64(5), filtered)
+}
+
+func TestDeleteCompact(t *testing.T) {
+	initTestEnv(t)
+	inst, gen, database := initTestDBWithOptions(t, defaultDBPath, defaultDBName, 10, 2, nil, wal.BrokerRole)
+	schema := metadata.MockSchemaAll(14)
+	indice := metadata.NewIndexSchema()
+	_, err := indice.MakeIndex("idx-0", metadata.NumBsi, 0)
+	assert.Nil(t, err)
+	createCtx := &CreateTableCtx{
+		DBMutationCtx: *CreateDBMutationCtx(database, gen),
+		Schema:        schema,
+		Indice:        indice,
+	}
+	meta, err := inst.CreateTable(createCtx)
+	assert.Nil(t, err)
+
+	// Delete from the first block before the segment is flushed
+	rows := inst.Store.Catalog.Cfg.BlockMaxRows
+	assert.Nil(t, inst.Append(CreateAppendCtx(database, gen, schema.Name, mock.MockBatch(schema.Types(), rows))))
+	tblData, err := inst.Store.DataTables.WeakRefTable(meta.Id)
+	assert.Nil(t, err)
+	time.Sleep(100 * time.Millisecond)
+	assert.Nil(t, inst.Delete(CreateDeleteCtx(database, gen, schema.Name, mock.MockBatch(schema.Types(), 3))))
+	assert.Nil(t, inst.Append(CreateAppendCtx(database, gen, schema.Name, mock.MockBatch(schema.Types(), rows))))
+	assert.Nil(t, inst.Append(CreateAppendCtx(database, gen, schema.Name, mock.MockBatch(schema.Types(), rows+rows/2))))
+
+	// Wait for the first segment to be sorted and the next block to be
+	// flushed
+	segId := tblData.SegmentIds()[0]
+	flushed := func() bool {
+		ids := tblData.SegmentIds()
+		if len(ids) < 2 || tblData.WeakRefSegment(ids[0]).GetType() != base.SORTED_SEG {
+			return false
+		}
+		seg := tblData.WeakRefSegment(ids[1])
+		return seg.WeakRefBlock(seg.BlockIds()[0]).GetType() == base.PERSISTENT_BLK
+	}
+	testutils.WaitExpect(500, flushed)
+	assert.True(t, flushed())
+
+	// The deleted rows are dropped and the tail of the segment is deleted
+	check := func() {
+		deletes, err := meta.GetDeletes().Positions(segId, true)
+		assert.Nil(t, err)
+		assert.Equal(t, []uint64{2*rows - 3, 2*rows - 2, 2*rows - 1}, deletes.ToArray())
+		read, counted, scanned, filtered := readRows(t, inst, database, meta)
+		assert.Equal(t, 32, read)
+		assert.Equal(t, uint64(32), counted)
+		assert.Equal(t, 32, scanned)
+		assert.Equal(t, uint64(6), filtered)
+	}
+	check()
+	assert.Nil(t, inst.FlushTable(database.Name, schema.Name))
+	inst.Close()
+
+	inst, _, _ = initTestDBWithOptions(t, defaultDBPath, emptyDBName, 10, 2, nil, wal.BrokerRole)
+	defer inst.Close()
+	database, err = inst.Store.Catalog.SimpleGetDatabaseByName(database.Name)
+	assert.Nil(t, err)
+	meta = database.SimpleGetTableByName(schema.Name)
+	time.Sleep(100 * time.Millisecond)
+	check()
+}
+
+func TestDeleteByRowId(t *testing.T) {
+	initTestEnv(t)
+	inst, gen, database := initTestDBWithOptions(t, defaultDBPath, defaultDBName, 10, 2, nil, wal.BrokerRole)
+	defer inst.Close()
+	schema := metadata.MockSchemaAll(14)
+	createCtx := &CreateTableCtx{
+		DBMutationCtx: *CreateDBMutationCtx(database, gen),
+		Schema:        schema,
+	}
+	meta, err := inst.CreateTable(createCtx)
+	assert.Nil(t, err)
+
+	// Two copies of the same rows in two blocks
+	rows := inst.Store.Catalog.Cfg.BlockMaxRows
+	for i := 0; i < 2; i++ {
+		assert.Nil(t, inst.Append(CreateAppendCtx(database, gen, schema.Name, mock.MockBatch(schema.Types(), rows))))
+	}
+	time.Sleep(100 * time.Millisecond)
+
+	// Read the rows of the second block with their row ids
+	tblData, err := inst.Store.DataTables.WeakRefTable(meta.Id)
+	assert.Nil(t, err)
+	segId := tblData.SegmentIds()[0]
+	segment := &db.Segment{
+		Data:    tblData.WeakRefSegment(segId),
+		Ids:     new(atomic.Value),
+		ShardId: 1,
+	}
+	var attrs []string
+	for _, def := range schema.ColDefs {
+		attrs = append(attrs, def.Name)
+	}
+	attrs = append(attrs, aoe.HideKey)
+	cs := make([]uint64, len(attrs))
+	cds := make([]*bytes.Buffer, len(attrs))
+	dds := make([]*bytes.Buffer, len(attrs))
+	for i := range attrs {
+		cs[i], cds[i], dds[i] = 1, bytes.NewBuffer(nil), bytes.NewBuffer(nil)
+	}
+	bat, err := segment.Block(segment.Blocks()[1]).Read(cs, attrs, cds, dds)
+	assert.Nil(t, err)
+	assert.Equal(t, int(rows), vector.Length(bat.Vecs[0]))
+	rids := bat.Vecs[len(attrs)-1].Col.(*types.Bytes)
+	shardId, sid, pos, err := aoe.DecodeRowId(rids.Get(1))
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), shardId)
+	assert.Equal(t, segId, sid)
+	assert.Equal(t, rows+1, pos)
+
+	// The rows are deleted at their positions, except the one whose position
+	// holds other values, it deletes the first copy of its values
+	copy(rids.Get(3), aoe.EncodeRowId(nil, shardId, segId, rows+4))
+	bat.Sels = []int64{1, 2, 3}
+	assert.Nil(t, inst.Delete(CreateDeleteCtx(database, gen, schema.Name, bat)))
+	deletes, err := meta.GetDeletes().Positions(segId, false)
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{3, rows + 1, rows + 2}, deletes.ToArray())
+}
@@ -49,6 +49,15 @@ func NewRelation(impl *DB, data iface.ITableData, meta *md.Table) *Relation {
 	return r
 }
 
+// shardId returns the id of the shard which the database of relation is
+// named after, 0 if it is not.
+func (r *Relation) shardId() uint64 {
+	if id, err := IdToNameFactory.Decode(r.Meta.Database.Name); err == nil {
+		return id.(uint64)
+	}
+	return 0
+}
+
 func (r *Relation) Rows() int64 {
 	return int64(r.Data.GetRowCount())
 }
@@ -81,11 +90,15 @@ func (r *Relation) Index() []*engine.IndexTableDef {
 
 func (r *Relation) Attribute() []engine.Attribute {
 	meta := r.Data.GetMeta()
-	attrs := make([]engine.Attribute, len(meta.Schema.ColDefs))
-	for idx, attr := range attrs {
-		attr.Name = meta.Schema.ColDefs[idx].Name
-		attr.Type = meta.Schema.ColDefs[idx].Type
-		attrs[idx] = attr
+	attrs := make([]engine.Attribute, 0, len(meta.Schema.ColDefs))
+	for _, colDef := range meta.Schema.ColDefs {
+		if colDef.Dropped {
+			continue
+		}
+		attrs = append(attrs, engine.Attribute{
+			Name: colDef.Name,
+			Type: colDef.Type,
+		})
 	}
 	return attrs
 }
@@ -105,8 +118,9 @@ func (r *Relation) Segment(id uint64) aoe.Segment {
 		return seg
 	}
 	seg = &db.Segment{
-		Ids:  new(atomic.Value),
-		Data: r.Data.StrongRefSegment(id),
+		Ids:     new(atomic.Value),
+		Data:    r.Data.StrongRefSegment(id),
+		ShardId: r.shardId(),
 	}
 	r.tree.Segments[id] = seg
 	r.tree.Unlock()
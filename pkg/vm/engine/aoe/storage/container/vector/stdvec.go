@@ -162,7 +162,8 @@ func (v *StdVector) GetValue(idx int) (interface{}, error) {
 		return encoding.DecodeFloat32(data), nil
 	case types.T_float64:
 		return encoding.DecodeFloat64(data), nil
-	// case types.T_decimal:
+	case types.T_decimal:
+		return encoding.DecodeDecimal(data), nil
 	case types.T_date:
 		return encoding.DecodeDate(data), nil
 	case types.T_datetime:
@@ -18,9 +18,13 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"github.com/RoaringBitmap/roaring/roaring64"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/table/v1/iface"
 )
 
 // Block is a high-level wrapper of the block type in memory. It
@@ -75,6 +79,9 @@ func (blk *Block) Prefetch(attrs []string) {
 	}
 	defer data.Unref()
 	for _, attr := range attrs {
+		if attr == aoe.HideKey {
+			continue
+		}
 		if err := data.Prefetch(attr); err != nil {
 			// TODO
 			panic(err)
@@ -86,7 +93,15 @@ func (blk *Block) Prefetch(attrs []string) {
 // with the given reference count used by computation layer.
 // For memory reuse, we pass two buffer array down and all the temp
 // usage of memory would be taken in those buffers. (e.g. decompress)
+// The hidden key is generated from the position of each row, see aoe.EncodeRowId.
 func (blk *Block) Read(cs []uint64, attrs []string, compressed []*bytes.Buffer, deCompressed []*bytes.Buffer) (*batch.Batch, error) {
+	return blk.ReadAt(0, cs, attrs, compressed, deCompressed)
+}
+
+// ReadAt is Read of the rows visible at ts, which are the rows written at or
+// before ts by their hidden timestamps and not deleted at or before ts.
+// The rows of now are read if ts is 0.
+func (blk *Block) ReadAt(ts uint64, cs []uint64, attrs []string, compressed []*bytes.Buffer, deCompressed []*bytes.Buffer) (*batch.Batch, error) {
 	data := blk.Host.Data.StrongRefBlock(blk.Id)
 	if data == nil {
 		return nil, errors.New(fmt.Sprintf("specified blk %d not found", blk.Id))
@@ -94,14 +109,96 @@ func (blk *Block) Read(cs []uint64, attrs []string, compressed []*bytes.Buffer,
 	defer data.Unref()
 	bat := batch.New(true, attrs)
 	bat.Vecs = make([]*vector.Vector, len(attrs))
+	hidden := -1
+	rows := -1
 	for i, attr := range attrs {
+		if attr == aoe.HideKey {
+			hidden = i
+			continue
+		}
 		vec, err := data.GetVectorCopy(attr, compressed[i], deCompressed[i])
 		if err != nil {
 			return nil, err
 		}
 		vec.Ref = cs[i]
 		bat.Vecs[i] = vec
+		rows = vector.Length(vec)
+	}
+	if len(attrs) == 0 {
+		return bat, nil
+	}
+	meta := data.GetMeta()
+	start := uint64(meta.Idx) * meta.Segment.Table.Schema.BlockMaxRows
+	if hidden >= 0 {
+		if rows < 0 {
+			rows = int(data.GetRowCount())
+		}
+		shardId := blk.Host.ShardId
+		col := &types.Bytes{
+			Data:    make([]byte, 0, rows*aoe.RowIdSize),
+			Offsets: make([]uint32, rows),
+			Lengths: make([]uint32, rows),
+		}
+		for row := 0; row < rows; row++ {
+			col.Offsets[row] = uint32(row * aoe.RowIdSize)
+			col.Lengths[row] = aoe.RowIdSize
+			col.Data = aoe.EncodeRowId(col.Data, shardId, meta.Segment.Id, start+uint64(row))
+		}
+		vec := vector.New(types.Type{Oid: types.T_char, Size: aoe.RowIdSize})
+		vec.Col = col
+		vec.Ref = cs[hidden]
+		bat.Vecs[hidden] = vec
+	}
+	var deleted *roaring64.Bitmap
+	var written []uint64
+	var err error
+	if ts == 0 {
+		deleted, err = blk.Host.Data.GetDeletes()
+	} else {
+		if deleted, err = blk.Host.Data.GetDeletesAt(ts); err == nil {
+			written, err = blk.timestamps(data, bat, attrs)
+		}
+	}
+	if err != nil || (deleted == nil && written == nil) {
+		return bat, err
+	}
+	if rows < 0 {
+		rows = int(data.GetRowCount())
+	}
+	sels := make([]int64, 0, rows)
+	for row := 0; row < rows; row++ {
+		if deleted != nil && deleted.Contains(start+uint64(row)) {
+			continue
+		}
+		if row < len(written) && written[row] > ts {
+			continue
+		}
+		sels = append(sels, int64(row))
+	}
+	if len(sels) == rows {
+		return bat, nil
+	}
+	for _, vec := range bat.Vecs {
+		vector.Shrink(vec, sels)
 	}
 	return bat, nil
 }
 
+// timestamps returns the hidden timestamps of the rows of the block, or nil
+// if the rows of the table are not versioned.
+func (blk *Block) timestamps(data iface.IBlock, bat *batch.Batch, attrs []string) ([]uint64, error) {
+	for i, attr := range attrs {
+		if attr == aoe.TimestampKey {
+			return bat.Vecs[i].Col.([]uint64), nil
+		}
+	}
+	if data.GetMeta().Segment.Table.Schema.GetColIdx(aoe.TimestampKey) < 0 {
+		return nil, nil
+	}
+	vec, err := data.GetVectorCopy(aoe.TimestampKey, bytes.NewBuffer(nil), bytes.NewBuffer(nil))
+	if err != nil {
+		return nil, err
+	}
+	return vec.Col.([]uint64), nil
+}
+
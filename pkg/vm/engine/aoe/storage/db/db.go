@@ -146,6 +146,7 @@ func (d *DB) GetSegmentIds(dbName string, tableName string) (ids dbi.IDS) {
 	if meta == nil {
 		return
 	}
+	ids.Ts = meta.GetChangedTs()
 	data, err := d.GetTableData(meta)
 	if err != nil {
 		return
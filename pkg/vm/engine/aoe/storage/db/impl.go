@@ -15,11 +15,21 @@
 package db
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
 	"time"
 
+	"github.com/RoaringBitmap/roaring/roaring64"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/db/gcreqs"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/base"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/table/v1"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/table/v1/iface"
 	tiface "github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/table/v1/iface"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
@@ -139,7 +149,298 @@ func (d *DB) DoAppend(meta *metadata.Table, data *batch.Batch, index *shard.Slic
 		return err
 	}
 	defer handle.Close()
-	return handle.Append(data, index)
+	if err = handle.Append(data, index); err != nil {
+		return err
+	}
+	meta.SetChanged(engine.Now())
+	return nil
+}
+
+// DoDelete deletes the rows of data from the table. A row of data deletes
+// one matching row of the table, matched by the columns of data. If data
+// has the hidden key, the row at the position of its hidden key is deleted
+// if it matches, the other ones are matched in all the segments. The rows
+// are deleted at the hidden timestamp of data if it has one.
+func (d *DB) DoDelete(meta *metadata.Table, data *batch.Batch, index *LogIndex) error {
+	hidden := -1
+	var ts uint64
+	cols := make([]int, 0, len(data.Attrs))
+	vecs := make([]*vector.Vector, 0, len(data.Attrs))
+	for i, attr := range data.Attrs {
+		if attr == aoe.HideKey {
+			hidden = i
+			continue
+		}
+		if attr == aoe.TimestampKey {
+			if col, ok := data.Vecs[i].Col.([]uint64); ok && len(col) > 0 {
+				ts = col[0]
+			}
+			continue
+		}
+		col := meta.Schema.GetColIdx(attr)
+		if col == -1 {
+			return errors.New(fmt.Sprintf("column %s not found", attr))
+		}
+		cols = append(cols, col)
+		vecs = append(vecs, data.Vecs[i])
+	}
+	if len(cols) == 0 {
+		return errors.New("no column of the deleted rows")
+	}
+	sels := data.Sels
+	if len(sels) == 0 {
+		sels = make([]int64, vector.Length(vecs[0]))
+		for i := range sels {
+			sels[i] = int64(i)
+		}
+	}
+	keys := make([]string, len(sels))
+	for i, sel := range sels {
+		key, err := table.EncodeRow(vecs, int(sel))
+		if err != nil {
+			return err
+		}
+		keys[i] = string(key)
+	}
+	tableData, err := d.GetTableData(meta)
+	if err != nil {
+		return err
+	}
+	defer tableData.Unref()
+
+	found := make(map[uint64]*roaring64.Bitmap)
+	missed := make([]int, len(sels))
+	for i := range missed {
+		missed[i] = i
+	}
+	if hidden >= 0 {
+		if missed, err = d.deleteHintedRows(tableData, cols, data.Vecs[hidden], sels, keys, found); err != nil {
+			return err
+		}
+	}
+	wanted := make(map[string]int)
+	firsts := make(map[string]struct{})
+	for _, i := range missed {
+		wanted[keys[i]]++
+		key, err := table.EncodeRow(vecs[:1], int(sels[i]))
+		if err != nil {
+			return err
+		}
+		firsts[string(key)] = struct{}{}
+	}
+
+	var deletes []metadata.SegmentDeletes
+	for _, segId := range tableData.SegmentIds() {
+		rows, ok := found[segId]
+		if !ok && len(wanted) == 0 {
+			continue
+		}
+		seg := tableData.StrongRefSegment(segId)
+		if seg == nil {
+			continue
+		}
+		if rows == nil {
+			rows = roaring64.NewBitmap()
+		}
+		sorted := seg.GetType() == base.SORTED_SEG
+		if len(wanted) > 0 {
+			err = d.deleteSegmentRows(seg, cols, wanted, firsts, rows)
+		}
+		seg.Unref()
+		if err != nil {
+			return err
+		}
+		if !rows.IsEmpty() {
+			deletes = append(deletes, metadata.SegmentDeletes{SegmentId: segId, Sorted: sorted, Rows: rows})
+		}
+	}
+	if len(deletes) == 0 {
+		return nil
+	}
+	if err = meta.SimpleDeleteRows(deletes, index, ts); err != nil {
+		return err
+	}
+	meta.SetChanged(engine.Now())
+	return nil
+}
+
+// rowHint is a deleted row and the position of the row it is read from
+type rowHint struct {
+	idx int
+	pos uint64
+}
+
+// deleteHintedRows adds the positions of the rows which match the deleted
+// rows at the positions of their hidden keys to found by segment. It returns
+// the deleted rows not found, because the position is of another replica or
+// the row has been moved by sorting the segment.
+func (d *DB) deleteHintedRows(tableData tiface.ITableData, cols []int, rids *vector.Vector, sels []int64,
+	keys []string, found map[uint64]*roaring64.Bitmap) ([]int, error) {
+	schema := tableData.GetMeta().Schema
+	col := rids.Col.(*types.Bytes)
+	var blks [][2]uint64 // the segment id and the index of block
+	hints := make(map[[2]uint64][]rowHint)
+	for i, sel := range sels {
+		_, segId, pos, err := aoe.DecodeRowId(col.Get(sel))
+		if err != nil {
+			return nil, err
+		}
+		blk := [2]uint64{segId, pos / schema.BlockMaxRows}
+		if _, ok := hints[blk]; !ok {
+			blks = append(blks, blk)
+		}
+		hints[blk] = append(hints[blk], rowHint{idx: i, pos: pos})
+	}
+	var missed []int
+	for _, blk := range blks {
+		seg := tableData.StrongRefSegment(blk[0])
+		if seg == nil {
+			for _, hint := range hints[blk] {
+				missed = append(missed, hint.idx)
+			}
+			continue
+		}
+		rows, ok := found[blk[0]]
+		if !ok {
+			rows = roaring64.NewBitmap()
+		}
+		idxs, err := d.matchBlockRows(seg, schema, cols, blk[1], hints[blk], keys, rows)
+		seg.Unref()
+		if err != nil {
+			return nil, err
+		}
+		if !rows.IsEmpty() {
+			found[blk[0]] = rows
+		}
+		missed = append(missed, idxs...)
+	}
+	return missed, nil
+}
+
+// matchBlockRows adds the positions of the hinted rows of the block which are
+// not deleted and match their keys to rows. It returns the rows not matched.
+func (d *DB) matchBlockRows(seg tiface.ISegment, schema *metadata.Schema, cols []int, blkIdx uint64,
+	hints []rowHint, keys []string, rows *roaring64.Bitmap) ([]int, error) {
+	missed := func() []int {
+		idxs := make([]int, len(hints))
+		for i, hint := range hints {
+			idxs[i] = hint.idx
+		}
+		return idxs
+	}
+	ids := seg.BlockIds()
+	if blkIdx >= uint64(len(ids)) {
+		return missed(), nil
+	}
+	blk := seg.StrongRefBlock(ids[blkIdx])
+	if blk == nil {
+		return missed(), nil
+	}
+	defer blk.Unref()
+	if uint64(blk.GetMeta().Idx) != blkIdx {
+		return missed(), nil
+	}
+	deleted, err := seg.GetDeletes()
+	if err != nil {
+		return nil, err
+	}
+	vecs := make([]*vector.Vector, len(cols))
+	for i, col := range cols {
+		if vecs[i], err = blk.GetVectorCopy(schema.ColDefs[col].Name, bytes.NewBuffer(nil), bytes.NewBuffer(nil)); err != nil {
+			return nil, err
+		}
+	}
+	start := blkIdx * schema.BlockMaxRows
+	var idxs []int
+	for _, hint := range hints {
+		row := int(hint.pos - start)
+		if row >= vector.Length(vecs[0]) || rows.Contains(hint.pos) || (deleted != nil && deleted.Contains(hint.pos)) {
+			idxs = append(idxs, hint.idx)
+			continue
+		}
+		key, err := table.EncodeRow(vecs, row)
+		if err != nil {
+			return nil, err
+		}
+		if string(key) != keys[hint.idx] {
+			idxs = append(idxs, hint.idx)
+			continue
+		}
+		rows.Add(hint.pos)
+	}
+	return idxs, nil
+}
+
+// deleteSegmentRows adds the positions of the rows of the segment that
+// match the wanted rows to rows, the rows already in it are skipped. The
+// first column of the deleted rows is read first, the other ones only for
+// the blocks it matches.
+func (d *DB) deleteSegmentRows(seg tiface.ISegment, cols []int, wanted map[string]int, firsts map[string]struct{}, rows *roaring64.Bitmap) error {
+	deleted, err := seg.GetDeletes()
+	if err != nil {
+		return err
+	}
+	schema := seg.GetMeta().Table.Schema
+	for _, blkId := range seg.BlockIds() {
+		if len(wanted) == 0 {
+			break
+		}
+		blk := seg.StrongRefBlock(blkId)
+		if blk == nil {
+			continue
+		}
+		err = d.deleteBlockRows(blk, schema, cols, deleted, wanted, firsts, rows)
+		blk.Unref()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *DB) deleteBlockRows(blk tiface.IBlock, schema *metadata.Schema, cols []int, deleted *roaring64.Bitmap,
+	wanted map[string]int, firsts map[string]struct{}, rows *roaring64.Bitmap) error {
+	vecs := make([]*vector.Vector, len(cols))
+	var err error
+	if vecs[0], err = blk.GetVectorCopy(schema.ColDefs[cols[0]].Name, bytes.NewBuffer(nil), bytes.NewBuffer(nil)); err != nil {
+		return err
+	}
+	start := uint64(blk.GetMeta().Idx) * schema.BlockMaxRows
+	var candidates []int
+	for row := 0; row < vector.Length(vecs[0]); row++ {
+		if rows.Contains(start+uint64(row)) || (deleted != nil && deleted.Contains(start+uint64(row))) {
+			continue
+		}
+		key, err := table.EncodeRow(vecs[:1], row)
+		if err != nil {
+			return err
+		}
+		if _, ok := firsts[string(key)]; ok {
+			candidates = append(candidates, row)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	for i := 1; i < len(cols); i++ {
+		if vecs[i], err = blk.GetVectorCopy(schema.ColDefs[cols[i]].Name, bytes.NewBuffer(nil), bytes.NewBuffer(nil)); err != nil {
+			return err
+		}
+	}
+	for _, row := range candidates {
+		key, err := table.EncodeRow(vecs, row)
+		if err != nil {
+			return err
+		}
+		if wanted[string(key)] == 0 {
+			continue
+		}
+		if wanted[string(key)]--; wanted[string(key)] == 0 {
+			delete(wanted, string(key))
+		}
+		rows.Add(start + uint64(row))
+	}
+	return nil
 }
 
 func (d *DB) MakeMutationHandle(meta *metadata.Table) (iface.MutationHandle, error) {
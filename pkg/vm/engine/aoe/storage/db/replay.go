@@ -671,6 +671,13 @@ func (h *replayHandle) rebuildTable(meta *metadata.Table) error {
 			break
 		}
 		file := tablesFiles.sortedfiles[*segment.AsCommonID()]
+		if file != nil && meta.GetDeletes().CanUpgrade(segment.Id) != nil {
+			// The deleted rows dropped from the sorted segment file are not known
+			// without the remap of its flush, flush the unsorted segment again
+			h.addCleanable(file)
+			delete(tablesFiles.sortedfiles, *segment.AsCommonID())
+			continue
+		}
 		if file != nil {
 			// There exists segments with sorted segment files but their metadata were not committed
 			// as SORTED. For example, a crash happened after creating a sorted segment file and
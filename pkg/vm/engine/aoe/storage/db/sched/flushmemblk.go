@@ -60,6 +60,9 @@ func (e *flushMemblockEvent) Execute() error {
 		}
 
 		bw := dataio.NewBlockWriter(vecs, meta, meta.Segment.Table.Database.Catalog.Cfg.Dir)
+		// The deletes of the block are positions of the appended rows, the
+		// rows are sorted when the segment is upgraded and the deletes remapped
+		bw.KeepOrder()
 		bw.SetPreExecutor(func() {
 			logutil.Infof(" %s | Memtable | Flushing", bw.GetFileName())
 		})
@@ -48,6 +48,15 @@ func (e *flushSegEvent) Execute() error {
 		blk := e.Segment.StrongRefBlock(id)
 		blks = append(blks, blk)
 	}
+	// The rows deleted so far are dropped from the sorted segment, the
+	// ones deleted later are moved by the remap when it is upgraded
+	deletes, err := meta.Table.GetDeletes().Positions(meta.Id, false)
+	if err != nil {
+		for _, blk := range blks {
+			blk.Unref()
+		}
+		return err
+	}
 	iter := table.NewBacktrackingBlockIterator(blks, 0)
 	fn := func() {
 		for _, blk := range blks {
@@ -55,9 +64,11 @@ func (e *flushSegEvent) Execute() error {
 		}
 	}
 	w := dataio.NewSegmentWriter(iter, meta, meta.Table.Database.Catalog.Cfg.Dir, fn)
+	w.SetDeletes(deletes)
 	if err := w.Execute(); err != nil {
 		return err
 	}
 	e.Destroyer = w.GetDestoryer()
+	meta.Table.GetDeletes().SetRemap(meta.Id, w.GetRemap())
 	return nil
 }
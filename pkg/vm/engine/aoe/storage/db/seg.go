@@ -28,6 +28,9 @@ import (
 type Segment struct {
 	Data iface.ISegment
 	Ids  *atomic.Value
+	// ShardId is the shard id of the tablet, it is a part of the row ids
+	// read from the segment
+	ShardId uint64
 }
 
 // ID returns the string representation of this segment's id.
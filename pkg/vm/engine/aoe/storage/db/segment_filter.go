@@ -72,7 +72,7 @@ func (f *SegmentFilter) Eq(attr string, val interface{}) (*roaring64.Bitmap, err
 	}
 	ret := roaring64.NewBitmap()
 	_, err = ret.FromBase64(buf)
-	return ret, err
+	return f.excludeDeleted(ret, err)
 }
 
 func (f *SegmentFilter) Ne(attr string, val interface{}) (*roaring64.Bitmap, error) {
@@ -107,7 +107,7 @@ func (f *SegmentFilter) Ne(attr string, val interface{}) (*roaring64.Bitmap, err
 	}
 	ret := roaring64.NewBitmap()
 	_, err = ret.FromBase64(buf)
-	return ret, err
+	return f.excludeDeleted(ret, err)
 }
 
 func (f *SegmentFilter) Lt(attr string, val interface{}) (*roaring64.Bitmap, error) {
@@ -145,7 +145,7 @@ func (f *SegmentFilter) Lt(attr string, val interface{}) (*roaring64.Bitmap, err
 	}
 	ret := roaring64.NewBitmap()
 	_, err = ret.FromBase64(buf)
-	return ret, err
+	return f.excludeDeleted(ret, err)
 }
 
 func (f *SegmentFilter) Le(attr string, val interface{}) (*roaring64.Bitmap, error) {
@@ -183,7 +183,7 @@ func (f *SegmentFilter) Le(attr string, val interface{}) (*roaring64.Bitmap, err
 	}
 	ret := roaring64.NewBitmap()
 	_, err = ret.FromBase64(buf)
-	return ret, err
+	return f.excludeDeleted(ret, err)
 }
 
 func (f *SegmentFilter) Gt(attr string, val interface{}) (*roaring64.Bitmap, error) {
@@ -221,7 +221,7 @@ func (f *SegmentFilter) Gt(attr string, val interface{}) (*roaring64.Bitmap, err
 	}
 	ret := roaring64.NewBitmap()
 	_, err = ret.FromBase64(buf)
-	return ret, err
+	return f.excludeDeleted(ret, err)
 }
 
 func (f *SegmentFilter) Ge(attr string, val interface{}) (*roaring64.Bitmap, error) {
@@ -259,7 +259,7 @@ func (f *SegmentFilter) Ge(attr string, val interface{}) (*roaring64.Bitmap, err
 	}
 	ret := roaring64.NewBitmap()
 	_, err = ret.FromBase64(buf)
-	return ret, err
+	return f.excludeDeleted(ret, err)
 }
 
 func (f *SegmentFilter) Btw(attr string, minv interface{}, maxv interface{}) (*roaring64.Bitmap, error) {
@@ -298,5 +298,20 @@ func (f *SegmentFilter) Btw(attr string, minv interface{}, maxv interface{}) (*r
 	}
 	ret := roaring64.NewBitmap()
 	_, err = ret.FromBase64(buf)
-	return ret, err
+	return f.excludeDeleted(ret, err)
+}
+
+// excludeDeleted removes the deleted rows from the filter result.
+func (f *SegmentFilter) excludeDeleted(bm *roaring64.Bitmap, err error) (*roaring64.Bitmap, error) {
+	if err != nil {
+		return bm, err
+	}
+	deleted, err := f.segment.Data.GetDeletes()
+	if err != nil {
+		return nil, err
+	}
+	if deleted != nil {
+		bm.AndNot(deleted)
+	}
+	return bm, nil
 }
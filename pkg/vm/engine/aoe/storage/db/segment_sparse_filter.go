@@ -443,6 +443,8 @@ func compare(val1, val2 interface{}, typ types.Type) int {
 		return bytes.Compare(val1.([]byte), val2.([]byte))
 	case types.T_datetime:
 		return int(val1.(types.Datetime) - val2.(types.Datetime))
+	case types.T_decimal:
+		return val1.(types.Decimal).Compare(val2.(types.Decimal))
 	case types.T_date:
 		return int(val1.(types.Date) - val2.(types.Date))
 	}
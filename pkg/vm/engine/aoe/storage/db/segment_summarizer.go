@@ -43,6 +43,10 @@ func (s *SegmentSummarizer) Count(attr string, filter *roaring.Bitmap) (uint64,
 	if colIdx == -1 {
 		return 0, errors.New(fmt.Sprintf("column %s not found", attr))
 	}
+	filter, err := s.excludeDeleted(filter)
+	if err != nil {
+		return 0, err
+	}
 	if s.segment.Data.GetType() == base.SORTED_SEG {
 		return s.segment.Data.GetIndexHolder().Count(colIdx, filter)
 	} else {
@@ -83,6 +87,10 @@ func (s *SegmentSummarizer) NullCount(attr string, filter *roaring.Bitmap) (uint
 	if colIdx == -1 {
 		return 0, errors.New(fmt.Sprintf("column %s not found", attr))
 	}
+	filter, err := s.excludeDeleted(filter)
+	if err != nil {
+		return 0, err
+	}
 	if s.segment.Data.GetType() == base.SORTED_SEG {
 		return s.segment.Data.GetIndexHolder().NullCount(colIdx, 0, filter)
 	} else {
@@ -127,6 +135,10 @@ func (s *SegmentSummarizer) Max(attr string, filter *roaring.Bitmap) (interface{
 	if colIdx == -1 {
 		return 0, errors.New(fmt.Sprintf("column %s not found", attr))
 	}
+	filter, err := s.excludeDeleted(filter)
+	if err != nil {
+		return 0, err
+	}
 	if s.segment.Data.GetType() == base.SORTED_SEG {
 		return s.segment.Data.GetIndexHolder().Max(colIdx, filter)
 	} else {
@@ -163,6 +175,10 @@ func (s *SegmentSummarizer) Min(attr string, filter *roaring.Bitmap) (interface{
 	if colIdx == -1 {
 		return 0, errors.New(fmt.Sprintf("column %s not found", attr))
 	}
+	filter, err := s.excludeDeleted(filter)
+	if err != nil {
+		return 0, err
+	}
 	if s.segment.Data.GetType() == base.SORTED_SEG {
 		return s.segment.Data.GetIndexHolder().Min(colIdx, filter)
 	} else {
@@ -199,6 +215,10 @@ func (s *SegmentSummarizer) Sum(attr string, filter *roaring.Bitmap) (int64, uin
 	if colIdx == -1 {
 		return 0, 0, errors.New(fmt.Sprintf("column %s not found", attr))
 	}
+	filter, err := s.excludeDeleted(filter)
+	if err != nil {
+		return 0, 0, err
+	}
 	if s.segment.Data.GetType() == base.SORTED_SEG {
 		return s.segment.Data.GetIndexHolder().Sum(colIdx, filter)
 	} else {
@@ -230,6 +250,23 @@ func (s *SegmentSummarizer) Sum(attr string, filter *roaring.Bitmap) (int64, uin
 	}
 }
 
-
-
-
+// excludeDeleted returns a copy of the filter without the deleted rows,
+// or the filter itself if no row of the segment was deleted.
+func (s *SegmentSummarizer) excludeDeleted(filter *roaring.Bitmap) (*roaring.Bitmap, error) {
+	deleted, err := s.segment.Data.GetDeletes()
+	if err != nil || deleted == nil {
+		return filter, err
+	}
+	if filter != nil {
+		filter = filter.Clone()
+	} else {
+		filter = roaring.NewBitmap()
+		for _, blkId := range s.segment.Data.BlockIds() {
+			blk := s.segment.Data.WeakRefBlock(blkId)
+			startPos := uint64(blk.GetMeta().Idx) * blk.GetMeta().Segment.Table.Schema.BlockMaxRows
+			filter.AddRange(startPos, startPos+blk.GetRowCount())
+		}
+	}
+	filter.AndNot(deleted)
+	return filter, nil
+}
@@ -64,6 +64,16 @@ type AppendCtx struct {
 	Data      *batch.Batch
 }
 
+type DeleteCtx struct {
+	ShardId   uint64
+	OpIndex   uint64
+	OpOffset  int
+	OpSize    int
+	DBName    string
+	TableName string
+	Data      *batch.Batch
+}
+
 type MatchType uint8
 
 const (
@@ -80,4 +90,6 @@ type StringMatcher struct {
 type IDS struct {
 	Version uint64
 	Ids     []uint64
+	// Ts is the timestamp of the last change of the rows of the table
+	Ts uint64
 }
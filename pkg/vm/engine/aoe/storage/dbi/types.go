@@ -29,7 +29,7 @@ type IBlock interface {
 	GetID() uint64
 	GetSegmentID() uint64
 	GetTableID() uint64
-	Prefetch() IBatchReader
+	Prefetch() (IBatchReader, error)
 }
 
 type ISegment interface {
@@ -121,6 +121,9 @@ type IBaseFile interface {
 	// PartSize returns a Pointer Len or OriginLen
 	PartSize(colIdx uint64, id common.ID, isOrigin bool) int64
 
+	// HasPart returns false if the column is added after the part is written
+	HasPart(colIdx uint64, id common.ID) bool
+
 	// DataCompressAlgo returns the compress type of the BaseFIle
 	DataCompressAlgo(common.ID) int
 
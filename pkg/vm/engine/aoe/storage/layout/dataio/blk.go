@@ -232,6 +232,15 @@ func (bf *BlockFile) PartSize(colIdx uint64, id common.ID, isOrigin bool) int64
 	return int64(pointer.Len)
 }
 
+func (bf *BlockFile) HasPart(colIdx uint64, id common.ID) bool {
+	key := base.Key{
+		Col: colIdx,
+		ID:  id.AsBlockID(),
+	}
+	_, ok := bf.Parts[key]
+	return ok
+}
+
 func (bf *BlockFile) GetFileType() common.FileType {
 	return common.DiskFile
 }
@@ -359,3 +359,13 @@ func TestTransientBlock(t *testing.T) {
 	// ok = tblk.PreSync(uint32(bat2.Vecs[0].Length()))
 	// assert.False(t, ok)
 }
+
+func TestBuildRemap(t *testing.T) {
+	sw := &SegmentWriter{}
+	// the blocks have 1, 2 and 1 rows
+	blkIdx := [][]uint32{{0}, {1, 0}, {0}}
+	sels := sw.buildRemap(blkIdx, []uint16{1, 0, 1, 2})
+	assert.Nil(t, sels)
+	assert.Equal(t, uint64(4), sw.remap.Rows)
+	assert.Equal(t, []uint32{1, 2, 0, 3}, sw.remap.Positions)
+}
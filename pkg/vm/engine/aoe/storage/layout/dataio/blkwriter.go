@@ -40,7 +40,6 @@ type ivecsSerializer func(*os.File, []vector.IVectorNode, *metadata.Block) error
 type blockFileGetter func(string, *metadata.Block) (*os.File, error)
 
 var (
-	defaultVecsSerializer  = lz4CompressionVecs
 	defaultIVecsSerializer = lz4CompressionIVecs
 	// defaultVecsSerializer = noCompressionVecs
 )
@@ -67,6 +66,9 @@ type BlockWriter struct {
 	// preprocessor preprocess data before writing, such as SORT
 	preprocessor func([]*gvector.Vector, *metadata.Block) error
 
+	// sorted is true if the rows are sorted by the primary key before writing
+	sorted bool
+
 	// indexSerializer flush indices that pre-defined in meta
 	indexSerializer vecsIndexSerializer
 
@@ -86,9 +88,9 @@ func NewBlockWriter(data []*gvector.Vector, meta *metadata.Block, dir string) *B
 		dir:  dir,
 	}
 	w.fileGetter, w.fileCommiter = w.createIOWriter, w.commitFile
-	w.preprocessor = w.defaultPreprocessor
+	w.preprocessor, w.sorted = w.defaultPreprocessor, true
 	w.indexSerializer = w.flushIndices
-	w.vecsSerializer = defaultVecsSerializer
+	w.vecsSerializer = w.lz4CompressionVecs
 	w.ivecsSerializer = defaultIVecsSerializer
 	return w
 }
@@ -118,6 +120,12 @@ func (bw *BlockWriter) SetFileGetter(f func(string, *metadata.Block) (*os.File,
 	bw.fileGetter = f
 }
 
+// KeepOrder writes the rows in the order of data instead of sorting them by
+// the primary key.
+func (bw *BlockWriter) KeepOrder() {
+	bw.preprocessor, bw.sorted = nil, false
+}
+
 func (bw *BlockWriter) SetIndexFlusher(f vecsIndexSerializer) {
 	bw.indexSerializer = f
 }
@@ -159,11 +167,10 @@ func (bw *BlockWriter) defaultPreprocessor(data []*gvector.Vector, meta *metadat
 }
 
 func (bw *BlockWriter) flushIndices(w *os.File, data []*gvector.Vector, meta *metadata.Block) error {
-	indices := make([]index.Index, len(meta.Segment.Table.Schema.ColDefs))
-	for idx, colDef := range meta.Segment.Table.Schema.ColDefs {
-		typ := colDef.Type
-		isPrimary := idx == meta.Segment.Table.Schema.PrimaryKey
-		zmi, err := index.BuildBlockZoneMapIndex(data[idx], typ, int16(idx), isPrimary)
+	indices := make([]index.Index, len(data))
+	for idx, vec := range data {
+		isPrimary := bw.sorted && idx == meta.Segment.Table.Schema.PrimaryKey
+		zmi, err := index.BuildBlockZoneMapIndex(vec, vec.Typ, int16(idx), isPrimary)
 		if err != nil {
 			return err
 		}
@@ -207,7 +214,8 @@ func (bw *BlockWriter) executeIVecs() error {
 	if bw.preExecutor != nil {
 		bw.preExecutor()
 	}
-	data := make([]vector.IVectorNode, len(bw.meta.Segment.Table.Schema.ColDefs))
+	// the columns added after the block is created are not in the batch
+	data := make([]vector.IVectorNode, len(bw.idata.GetAttrs()))
 	for i := 0; i < len(data); i++ {
 		ivec, err := bw.idata.GetVectorByAttr(i)
 		if err != nil {
@@ -271,7 +279,7 @@ func (bw *BlockWriter) executeVecs() error {
 	return bw.fileCommiter(filename)
 }
 
-func lz4CompressionVecs(w *os.File, data []*gvector.Vector, meta *metadata.Block) error {
+func (bw *BlockWriter) lz4CompressionVecs(w *os.File, data []*gvector.Vector, meta *metadata.Block) error {
 	var (
 		err error
 		buf bytes.Buffer
@@ -280,7 +288,7 @@ func lz4CompressionVecs(w *os.File, data []*gvector.Vector, meta *metadata.Block
 	if err = binary.Write(&buf, binary.BigEndian, uint8(algo)); err != nil {
 		return err
 	}
-	colCnt := len(meta.Segment.Table.Schema.ColDefs)
+	colCnt := len(data)
 	if err = binary.Write(&buf, binary.BigEndian, uint16(colCnt)); err != nil {
 		return err
 	}
@@ -348,11 +356,10 @@ func lz4CompressionVecs(w *os.File, data []*gvector.Vector, meta *metadata.Block
 		}
 	}
 	// flush indices
-	indices := make([]index.Index, len(meta.Segment.Table.Schema.ColDefs))
-	for idx, colDef := range meta.Segment.Table.Schema.ColDefs {
-		typ := colDef.Type
-		isPrimary := idx == meta.Segment.Table.Schema.PrimaryKey
-		zmi, err := index.BuildBlockZoneMapIndex(data[idx], typ, int16(idx), isPrimary)
+	indices := make([]index.Index, len(data))
+	for idx, vec := range data {
+		isPrimary := bw.sorted && idx == meta.Segment.Table.Schema.PrimaryKey
+		zmi, err := index.BuildBlockZoneMapIndex(vec, vec.Typ, int16(idx), isPrimary)
 		if err != nil {
 			return err
 		}
@@ -420,7 +427,7 @@ func lz4CompressionIVecs(w *os.File, data []vector.IVectorNode, meta *metadata.B
 	if err = binary.Write(&buf, binary.BigEndian, uint8(algo)); err != nil {
 		return err
 	}
-	colCnt := len(meta.Segment.Table.Schema.ColDefs)
+	colCnt := len(data)
 	if err = binary.Write(&buf, binary.BigEndian, uint16(colCnt)); err != nil {
 		return err
 	}
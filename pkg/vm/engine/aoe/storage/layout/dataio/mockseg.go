@@ -93,6 +93,10 @@ func (msf *MockSegmentFile) PartSize(colIdx uint64, id common.ID, _ bool) int64
 	return 0
 }
 
+func (msf *MockSegmentFile) HasPart(colIdx uint64, id common.ID) bool {
+	return true
+}
+
 func (msf *MockSegmentFile) ReadPart(colIdx uint64, id common.ID, buf []byte) {
 	logutil.Debugf("(%s:%s) | ReadPart %d %s size: %d cap: %d", msf.TypeName, msf.FileName, colIdx, id.SegmentString(), len(buf), cap(buf))
 }
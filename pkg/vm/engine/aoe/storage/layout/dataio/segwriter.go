@@ -21,6 +21,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/RoaringBitmap/roaring/roaring64"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
@@ -68,6 +69,11 @@ type SegmentWriter struct {
 	size       int64
 	fileHandle *os.File
 	destoryer  FileDestoryer
+	// deletes are the positions of the rows deleted from the segment,
+	// they are dropped from the segment written
+	deletes *roaring64.Bitmap
+	// remap records where the rows of the segment are written
+	remap *metadata.SegmentRemap
 	//preprocessor func([]*batch.Batch, *metadata.Segment) error
 
 	// fileGetter is createFile()，use dir&TableID&SegmentID to
@@ -93,7 +99,7 @@ func NewSegmentWriter(data iface.BlockIterator, meta *metadata.Segment, dir stri
 	}
 	// w.preprocessor = w.defaultPreprocessor
 	w.fileGetter, w.fileCommiter = w.createFile, w.commitFile
-	w.flusher = flush
+	w.flusher = w.flush
 	w.SetPostExecutor(post)
 	//w.indexFlusher = w.flushIndices
 	return w
@@ -111,6 +117,16 @@ func (sw *SegmentWriter) SetFileGetter(f func(string, *metadata.Segment) (*os.Fi
 	sw.fileGetter = f
 }
 
+// SetDeletes sets the positions of the rows to drop from the segment.
+func (sw *SegmentWriter) SetDeletes(deletes *roaring64.Bitmap) {
+	sw.deletes = deletes
+}
+
+// GetRemap returns where the rows of the segment were written.
+func (sw *SegmentWriter) GetRemap() *metadata.SegmentRemap {
+	return sw.remap
+}
+
 func (sw *SegmentWriter) GetDestoryer() FileDestoryer {
 	return sw.destoryer
 }
@@ -225,7 +241,7 @@ func (sw *SegmentWriter) GetSize() int64 {
 
 // flush metadata, columns data, indices, and other related infos
 // for the segment.
-func flush(w *os.File, iter iface.BlockIterator, meta *metadata.Segment) error {
+func (sw *SegmentWriter) flush(w *os.File, iter iface.BlockIterator, meta *metadata.Segment) error {
 	var metaBuf bytes.Buffer
 	blkCnt := iter.BlockCount()
 	header := make([]byte, 32)
@@ -308,7 +324,7 @@ func flush(w *os.File, iter iface.BlockIterator, meta *metadata.Segment) error {
 	var outputBuffer bytes.Buffer
 	var indices []index.Index
 	typs := make([]types.Type, 0)
-	for _, def := range meta.Table.Schema.ColDefs {
+	for _, def := range colDefs {
 		typs = append(typs, def.Type)
 	}
 
@@ -318,9 +334,17 @@ func flush(w *os.File, iter iface.BlockIterator, meta *metadata.Segment) error {
 	if err != nil {
 		return err
 	}
+	// the blocks keep the rows in the order they were appended
+	blkIdx := mergesort.SortColumn(pkColumn)
 	if err = preprocessColumn(pkColumn, &sortedIdx, true); err != nil {
 		return err
 	}
+	sels := sw.buildRemap(blkIdx, sortedIdx)
+	if sels != nil {
+		if err = mergesort.CompactColumn(pkColumn, sels); err != nil {
+			return err
+		}
+	}
 	// could safely release vectors' mem nodes here
 	iter.Reset(0)
 
@@ -350,9 +374,17 @@ func flush(w *os.File, iter iface.BlockIterator, meta *metadata.Segment) error {
 		if err != nil {
 			return err
 		}
+		if err = mergesort.ShuffleBlocks(column, blkIdx); err != nil {
+			return err
+		}
 		if err = preprocessColumn(column, &sortedIdx, false); err != nil {
 			return err
 		}
+		if sels != nil {
+			if err = mergesort.CompactColumn(column, sels); err != nil {
+				return err
+			}
+		}
 		zmi, err := index.BuildSegmentZoneMapIndex(column, typs[i], int16(i), false)
 		if err != nil {
 			return err
@@ -415,6 +447,43 @@ func flush(w *os.File, iter iface.BlockIterator, meta *metadata.Segment) error {
 	return nil
 }
 
+// buildRemap records where the rows appended to the segment are written,
+// given how the blocks were sorted and merged. It returns the rows to
+// keep, or nil if no row is dropped.
+func (sw *SegmentWriter) buildRemap(blkIdx [][]uint32, src []uint16) []int64 {
+	starts := make([]uint32, len(blkIdx))
+	for i := 1; i < len(blkIdx); i++ {
+		starts[i] = starts[i-1] + uint32(len(blkIdx[i-1]))
+	}
+	merged := make([]uint32, len(src))
+	cursors := make([]int, len(blkIdx))
+	for k, blk := range src {
+		merged[k] = starts[blk] + blkIdx[blk][cursors[blk]]
+		cursors[blk]++
+	}
+	sw.remap = &metadata.SegmentRemap{
+		Positions: make([]uint32, len(merged)),
+		Rows:      uint64(len(merged)),
+	}
+	if sw.deletes == nil || sw.deletes.IsEmpty() {
+		for k, pos := range merged {
+			sw.remap.Positions[pos] = uint32(k)
+		}
+		return nil
+	}
+	sels, kept := mergesort.CompactSels(len(merged), func(k int) bool {
+		return sw.deletes.Contains(uint64(merged[k]))
+	})
+	for pos := range sw.remap.Positions {
+		sw.remap.Positions[pos] = metadata.DroppedRow
+	}
+	for k := 0; k < kept; k++ {
+		sw.remap.Positions[merged[sels[k]]] = uint32(k)
+	}
+	sw.remap.Rows = uint64(kept)
+	return sels
+}
+
 func preprocessColumn(column []*vector.Vector, sortedIdx *[]uint16, isPrimary bool) error {
 	if isPrimary {
 		*sortedIdx = make([]uint16, vector.Length(column[0])*len(column))
@@ -345,6 +345,15 @@ func (sf *SortedSegmentFile) PartSize(colIdx uint64, id common.ID, isOrigin bool
 	return int64(pointer.Len)
 }
 
+func (sf *SortedSegmentFile) HasPart(colIdx uint64, id common.ID) bool {
+	key := base.Key{
+		Col: colIdx,
+		ID:  id,
+	}
+	_, ok := sf.Parts[key]
+	return ok
+}
+
 func (sf *SortedSegmentFile) ReadPart(colIdx uint64, id common.ID, buf []byte) {
 	key := base.Key{
 		Col: colIdx,
@@ -218,11 +218,25 @@ func (f *TransientBlockFile) LoadBatch(meta *metadata.Block) batch.IBatch {
 	f.mu.RUnlock()
 	defer file.Unref()
 	id := *meta.AsCommonID()
-	colcnt := len(meta.Segment.Table.Schema.ColDefs)
+	schema := meta.Segment.Table.Schema
+	colcnt := len(schema.ColDefs)
 	vecs := make([]vector.IVector, colcnt)
 	cols := make([]int, colcnt)
-	for i, colDef := range meta.Segment.Table.Schema.ColDefs {
+	for i, colDef := range schema.ColDefs {
 		cols[i] = i
+		if !file.HasPart(uint64(i), id) {
+			// the column is added after the file is written
+			fill, err := colDef.FillVector(int(file.count))
+			if err != nil {
+				panic(err)
+			}
+			vec := vector.NewVector(colDef.Type, schema.BlockMaxRows)
+			if _, err = vec.AppendVector(fill, 0); err != nil {
+				panic(err)
+			}
+			vecs[i] = vec
+			continue
+		}
 		sz := file.PartSize(uint64(i), id, false)
 		osz := file.PartSize(uint64(i), id, true)
 		node := common.GPool.Alloc(uint64(sz))
@@ -236,7 +250,7 @@ func (f *TransientBlockFile) LoadBatch(meta *metadata.Block) batch.IBatch {
 		}
 		switch colDef.Type.Oid {
 		case types.T_char, types.T_varchar, types.T_json:
-			vec := vector.NewStrVector(colDef.Type, meta.Segment.Table.Schema.BlockMaxRows)
+			vec := vector.NewStrVector(colDef.Type, schema.BlockMaxRows)
 			err = vec.Unmarshal(obuf)
 			if err != nil {
 				panic(err)
@@ -244,7 +258,7 @@ func (f *TransientBlockFile) LoadBatch(meta *metadata.Block) batch.IBatch {
 			vec.ResetReadonly()
 			vecs[i] = vec
 		default:
-			vec := vector.NewStdVector(colDef.Type, meta.Segment.Table.Schema.BlockMaxRows)
+			vec := vector.NewStdVector(colDef.Type, schema.BlockMaxRows)
 			err = vec.Unmarshal(obuf)
 			if err != nil {
 				panic(err)
@@ -333,6 +347,16 @@ func (f *TransientBlockFile) PartSize(colIdx uint64, id common.ID, isOrigin bool
 	return file.PartSize(colIdx, id, isOrigin)
 }
 
+func (f *TransientBlockFile) HasPart(colIdx uint64, id common.ID) bool {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	if len(f.files) == 0 {
+		return false
+	}
+	file := f.files[len(f.files)-1]
+	return file.HasPart(colIdx, id)
+}
+
 func (f *TransientBlockFile) DataCompressAlgo(common.ID) int {
 	return compress.Lz4
 }
@@ -216,6 +216,16 @@ func (sf *UnsortedSegmentFile) PartSize(colIdx uint64, id common.ID, isOrigin bo
 	return blk.PartSize(colIdx, id, isOrigin)
 }
 
+func (sf *UnsortedSegmentFile) HasPart(colIdx uint64, id common.ID) bool {
+	sf.RLock()
+	blk, ok := sf.Blocks[id.AsBlockID()]
+	if !ok {
+		panic("logic error")
+	}
+	sf.RUnlock()
+	return blk.HasPart(colIdx, id)
+}
+
 func (sf *UnsortedSegmentFile) ReadPart(colIdx uint64, id common.ID, buf []byte) {
 	sf.RLock()
 	blk, ok := sf.Blocks[id.AsBlockID()]
@@ -198,6 +198,12 @@ func (i *BlockZoneMapIndex) Unmarshal(data []byte) error {
 		i.MaxV = encoding.DecodeDatetime(buf[:8])
 		// buf = buf[8:] // unused
 		return nil
+	case types.T_decimal:
+		i.MinV = encoding.DecodeDecimal(buf[:encoding.DecimalSize])
+		buf = buf[encoding.DecimalSize:]
+		i.MaxV = encoding.DecodeDecimal(buf[:encoding.DecimalSize])
+		// buf = buf[8:] // unused
+		return nil
 	case types.T_char, types.T_varchar, types.T_json:
 		lenminv := encoding.DecodeInt16(buf[:2])
 		buf = buf[2:]
@@ -280,6 +286,11 @@ func (i *BlockZoneMapIndex) Marshal() ([]byte, error) {
 		buf.Write(encoding.EncodeDatetime(i.MinV.(types.Datetime)))
 		buf.Write(encoding.EncodeDatetime(i.MaxV.(types.Datetime)))
 		return buf.Bytes(), nil
+	case types.T_decimal:
+		buf.Write(encoding.EncodeType(i.T))
+		buf.Write(encoding.EncodeDecimal(i.MinV.(types.Decimal)))
+		buf.Write(encoding.EncodeDecimal(i.MaxV.(types.Decimal)))
+		return buf.Bytes(), nil
 	case types.T_char, types.T_varchar, types.T_json:
 		buf.Write(encoding.EncodeType(i.T))
 		minv := i.MinV.([]byte)
@@ -316,7 +327,7 @@ func (i *BlockZoneMapIndex) Eq(v interface{}) bool {
 	case types.T_uint64:
 		return v.(uint64) >= i.MinV.(uint64) && v.(uint64) <= i.MaxV.(uint64)
 	case types.T_decimal:
-		panic("not supported")
+		return v.(types.Decimal).Compare(i.MinV.(types.Decimal)) >= 0 && v.(types.Decimal).Compare(i.MaxV.(types.Decimal)) <= 0
 	case types.T_float32:
 		return v.(float32) >= i.MinV.(float32) && v.(float32) <= i.MaxV.(float32)
 	case types.T_float64:
@@ -364,7 +375,7 @@ func (i *BlockZoneMapIndex) Lt(v interface{}) bool {
 	case types.T_uint64:
 		return v.(uint64) > i.MinV.(uint64)
 	case types.T_decimal:
-		panic("not supported")
+		return v.(types.Decimal).Compare(i.MinV.(types.Decimal)) > 0
 	case types.T_float32:
 		return v.(float32) > i.MinV.(float32)
 	case types.T_float64:
@@ -402,7 +413,7 @@ func (i *BlockZoneMapIndex) Le(v interface{}) bool {
 	case types.T_uint64:
 		return v.(uint64) >= i.MinV.(uint64)
 	case types.T_decimal:
-		panic("not supported")
+		return v.(types.Decimal).Compare(i.MinV.(types.Decimal)) >= 0
 	case types.T_float32:
 		return v.(float32) >= i.MinV.(float32)
 	case types.T_float64:
@@ -440,7 +451,7 @@ func (i *BlockZoneMapIndex) Gt(v interface{}) bool {
 	case types.T_uint64:
 		return v.(uint64) < i.MaxV.(uint64)
 	case types.T_decimal:
-		panic("not supported")
+		return v.(types.Decimal).Compare(i.MaxV.(types.Decimal)) < 0
 	case types.T_float32:
 		return v.(float32) < i.MaxV.(float32)
 	case types.T_float64:
@@ -478,7 +489,7 @@ func (i *BlockZoneMapIndex) Ge(v interface{}) bool {
 	case types.T_uint64:
 		return v.(uint64) <= i.MaxV.(uint64)
 	case types.T_decimal:
-		panic("not supported")
+		return v.(types.Decimal).Compare(i.MaxV.(types.Decimal)) <= 0
 	case types.T_float32:
 		return v.(float32) <= i.MaxV.(float32)
 	case types.T_float64:
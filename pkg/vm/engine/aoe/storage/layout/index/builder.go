@@ -770,6 +770,53 @@ func BuildSegmentZoneMapIndex(data []*vector.Vector, t types.Type, colIdx int16,
 		}
 		zmi := NewSegmentZoneMap(t, globalMin, globalMax, colIdx, partMins, partMaxs)
 		return zmi, nil
+	case types.T_decimal:
+		var globalMin, globalMax types.Decimal
+		var partMins, partMaxs []interface{}
+		if isSorted {
+			for i, vec := range data {
+				part := vec.Col.([]types.Decimal)
+				partMins = append(partMins, part[0])
+				partMaxs = append(partMaxs, part[len(part) - 1])
+				if i == 0 {
+					globalMin = part[0]
+				}
+				if i == len(data) - 1 {
+					globalMax = part[len(part) - 1]
+				}
+			}
+		} else {
+			var minv, maxv types.Decimal
+			for i, vec := range data {
+				part := vec.Col.([]types.Decimal)
+				if i == 0 {
+					globalMin = part[i]
+					globalMax = part[i]
+				}
+				for j, v := range part {
+					if j == 0 {
+						minv = v
+						maxv = v
+					}
+					if v.Compare(globalMax) > 0 {
+						globalMax = v
+					}
+					if v.Compare(globalMin) < 0 {
+						globalMin = v
+					}
+					if v.Compare(maxv) > 0 {
+						maxv = v
+					}
+					if v.Compare(minv) < 0 {
+						minv = v
+					}
+				}
+				partMaxs = append(partMaxs, maxv)
+				partMins = append(partMins, minv)
+			}
+		}
+		zmi := NewSegmentZoneMap(t, globalMin, globalMax, colIdx, partMins, partMaxs)
+		return zmi, nil
 	case types.T_date:
 		var globalMin, globalMax types.Date
 		var partMins, partMaxs []interface{}
@@ -1148,6 +1195,29 @@ func BuildBlockZoneMapIndex(data *vector.Vector, t types.Type, colIdx int16, isS
 		}
 		zmi := NewBlockZoneMap(t, min, max, colIdx)
 		return zmi, nil
+	case types.T_decimal:
+		vec := data.Col.([]types.Decimal)
+		var min, max types.Decimal
+		if isSorted {
+			min = vec[0]
+			max = vec[len(vec)-1]
+		} else {
+			min = vec[0]
+			max = min
+			for i, e := range vec {
+				if nulls.Contains(data.Nsp, uint64(i)) {
+					continue
+				}
+				if e.Compare(max) > 0 {
+					max = e
+				}
+				if e.Compare(min) < 0 {
+					min = e
+				}
+			}
+		}
+		zmi := NewBlockZoneMap(t, min, max, colIdx)
+		return zmi, nil
 	case types.T_char, types.T_varchar, types.T_json:
 		vec := data.Col.(*types.Bytes)
 		var min, max []byte
@@ -350,6 +350,22 @@ func (i *SegmentZoneMapIndex) Unmarshal(data []byte) error {
 			buf = buf[8:]
 		}
 		return nil
+	case types.T_decimal:
+		i.MinV = encoding.DecodeDecimal(buf[:encoding.DecimalSize])
+		buf = buf[encoding.DecimalSize:]
+		i.MaxV = encoding.DecodeDecimal(buf[:encoding.DecimalSize])
+		buf = buf[encoding.DecimalSize:]
+		len := encoding.DecodeInt32(buf[:4])
+		buf = buf[4:]
+		i.BlkMax = make([]interface{}, len)
+		i.BlkMin = make([]interface{}, len)
+		for j := 0; j < int(len); j++ {
+			i.BlkMin[j] = encoding.DecodeDecimal(buf[:encoding.DecimalSize])
+			buf = buf[encoding.DecimalSize:]
+			i.BlkMax[j] = encoding.DecodeDecimal(buf[:encoding.DecimalSize])
+			buf = buf[encoding.DecimalSize:]
+		}
+		return nil
 	case types.T_date:
 		i.MinV = encoding.DecodeDate(buf[:4])
 		buf = buf[4:]
@@ -500,6 +516,16 @@ func (i *SegmentZoneMapIndex) Marshal() ([]byte, error) {
 			buf.Write(encoding.EncodeDatetime(i.BlkMax[j].(types.Datetime)))
 		}
 		return buf.Bytes(), nil
+	case types.T_decimal:
+		buf.Write(encoding.EncodeType(i.T))
+		buf.Write(encoding.EncodeDecimal(i.MinV.(types.Decimal)))
+		buf.Write(encoding.EncodeDecimal(i.MaxV.(types.Decimal)))
+		buf.Write(encoding.EncodeInt32(int32(len(i.BlkMin))))
+		for j := 0; j < len(i.BlkMin); j++ {
+			buf.Write(encoding.EncodeDecimal(i.BlkMin[j].(types.Decimal)))
+			buf.Write(encoding.EncodeDecimal(i.BlkMax[j].(types.Decimal)))
+		}
+		return buf.Bytes(), nil
 	case types.T_date:
 		buf.Write(encoding.EncodeType(i.T))
 		buf.Write(encoding.EncodeDate(i.MinV.(types.Date)))
@@ -537,7 +563,7 @@ func (i *SegmentZoneMapIndex) Eq(v interface{}) bool {
 	case types.T_uint64:
 		return v.(uint64) >= i.MinV.(uint64) && v.(uint64) <= i.MaxV.(uint64)
 	case types.T_decimal:
-		panic("not supported")
+		return v.(types.Decimal).Compare(i.MinV.(types.Decimal)) >= 0 && v.(types.Decimal).Compare(i.MaxV.(types.Decimal)) <= 0
 	case types.T_float32:
 		return v.(float32) >= i.MinV.(float32) && v.(float32) <= i.MaxV.(float32)
 	case types.T_float64:
@@ -585,7 +611,7 @@ func (i *SegmentZoneMapIndex) Lt(v interface{}) bool {
 	case types.T_uint64:
 		return v.(uint64) > i.MinV.(uint64)
 	case types.T_decimal:
-		panic("not supported")
+		return v.(types.Decimal).Compare(i.MinV.(types.Decimal)) > 0
 	case types.T_float32:
 		return v.(float32) > i.MinV.(float32)
 	case types.T_float64:
@@ -623,7 +649,7 @@ func (i *SegmentZoneMapIndex) Le(v interface{}) bool {
 	case types.T_uint64:
 		return v.(uint64) >= i.MinV.(uint64)
 	case types.T_decimal:
-		panic("not supported")
+		return v.(types.Decimal).Compare(i.MinV.(types.Decimal)) >= 0
 	case types.T_float32:
 		return v.(float32) >= i.MinV.(float32)
 	case types.T_float64:
@@ -661,7 +687,7 @@ func (i *SegmentZoneMapIndex) Gt(v interface{}) bool {
 	case types.T_uint64:
 		return v.(uint64) < i.MaxV.(uint64)
 	case types.T_decimal:
-		panic("not supported")
+		return v.(types.Decimal).Compare(i.MaxV.(types.Decimal)) < 0
 	case types.T_float32:
 		return v.(float32) < i.MaxV.(float32)
 	case types.T_float64:
@@ -699,7 +725,7 @@ func (i *SegmentZoneMapIndex) Ge(v interface{}) bool {
 	case types.T_uint64:
 		return v.(uint64) <= i.MaxV.(uint64)
 	case types.T_decimal:
-		panic("not supported")
+		return v.(types.Decimal).Compare(i.MaxV.(types.Decimal)) <= 0
 	case types.T_float32:
 		return v.(float32) <= i.MaxV.(float32)
 	case types.T_float64:
@@ -60,8 +60,11 @@ func (c *tableAppender) Flush() error {
 		c.mu.RUnlock()
 		return nil
 	}
-	blkHandle := c.blkAppender.MakeHandle()
+	blkHandle, err := c.blkAppender.MakeHandle()
 	c.mu.RUnlock()
+	if err != nil {
+		return err
+	}
 	defer blkHandle.Close()
 	blk := blkHandle.GetNode().(mb.IMutableBlock)
 	return blk.Flush()
@@ -101,18 +104,35 @@ func (c *tableAppender) doAppend(mutblk mb.IMutableBlock, bat *batch.Batch, offs
 	var na int
 	meta := mutblk.GetMeta()
 	data := mutblk.GetData()
+	schema := meta.Segment.Table.Schema
 	for idx, attr := range data.GetAttrs() {
+		colDef := schema.ColDefs[idx]
+		src := -1
 		for i, a := range bat.Attrs {
-			if a == meta.Segment.Table.Schema.ColDefs[idx].Name {
-				vec, err := data.GetVectorByAttr(attr)
-				if err != nil {
-					return 0, err
-				}
-				if na, err = vec.AppendVector(bat.Vecs[i], int(offset)); err != nil {
-					return n, err
-				}
+			if !colDef.Dropped && a == colDef.Name {
+				src = i
+				break
 			}
 		}
+		vec, err := data.GetVectorByAttr(attr)
+		if err != nil {
+			return 0, err
+		}
+		if src < 0 {
+			// the dropped columns and the ones the batch does not have
+			// read the fill values, the blocks keep all columns
+			fill, err := colDef.FillVector(vector.Length(bat.Vecs[0]))
+			if err != nil {
+				return n, err
+			}
+			if _, err = vec.AppendVector(fill, int(offset)); err != nil {
+				return n, err
+			}
+			continue
+		}
+		if na, err = vec.AppendVector(bat.Vecs[src], int(offset)); err != nil {
+			return n, err
+		}
 	}
 	n = uint64(na)
 	index.Count = n
@@ -152,12 +172,17 @@ func (c *tableAppender) Append(bat *batch.Batch, index *shard.SliceIndex) (err e
 	}
 
 	offset := index.Start
-	blkHandle := c.blkAppender.MakeHandle()
+	blkHandle, err := c.blkAppender.MakeHandle()
+	if err != nil {
+		return err
+	}
 	for {
 		if c.blkAppender.GetMeta().HasMaxRowsLocked() {
 			c.onImmut()
 			blkHandle.Close()
-			blkHandle = c.blkAppender.MakeHandle()
+			if blkHandle, err = c.blkAppender.MakeHandle(); err != nil {
+				return err
+			}
 		}
 		blk := blkHandle.GetNode().(mb.IMutableBlock)
 		n, err := c.doAppend(blk, bat, offset, index)
@@ -67,7 +67,18 @@ func newBlock(host iface.ISegment, meta *metadata.Block) (iface.IBlock, error) {
 
 func (blk *block) initColumns() error {
 	blk.data.sizes = make([]uint64, 0)
-	for idx, _ := range blk.meta.Segment.Table.Schema.ColDefs {
+	blk.appendColumns()
+	return nil
+}
+
+// appendColumns opens the columns of the block file after the ones the block
+// already has, the columns added after the block is written are filled on read
+func (blk *block) appendColumns() {
+	colDefs := blk.meta.Segment.Table.Schema.ColDefs
+	for idx := len(blk.data.cols); idx < len(colDefs); idx++ {
+		if !blk.hasColumn(idx) {
+			break
+		}
 		blk.Ref()
 		colBlk := col.NewStdColumnBlock(blk, idx)
 		blk.data.cols = append(blk.data.cols, colBlk)
@@ -75,7 +86,44 @@ func (blk *block) initColumns() error {
 			blk.data.sizes = append(blk.data.sizes, colBlk.Size())
 		}
 	}
-	return nil
+}
+
+// hasColumn returns false if the column is added after the block is written
+func (blk *block) hasColumn(colIdx int) bool {
+	// the blocks of a table never altered have all the columns
+	if blk.meta.Segment.Table.Schema.Version == 0 {
+		return true
+	}
+	var id common.ID
+	if blk.typ == base.PERSISTENT_SORTED_BLK {
+		id = blk.meta.DescId()
+	} else {
+		id = blk.meta.AsCommonID().AsBlockID()
+	}
+	id.Idx = uint16(colIdx)
+	segFile := blk.GetSegmentFile()
+	segFile.RefBlock(id.AsBlockID())
+	defer segFile.UnrefBlock(id.AsBlockID())
+	return segFile.HasPart(uint64(colIdx), id)
+}
+
+// fillVector returns the column added after the block is written
+func (blk *block) fillVector(colIdx int) (*ro.Vector, error) {
+	return blk.meta.Segment.Table.Schema.ColDefs[colIdx].FillVector(int(blk.GetRowCount()))
+}
+
+// fillIVector returns the column added after the block is written as a
+// vector of batch
+func (blk *block) fillIVector(colIdx int) (vector.IVector, error) {
+	fill, err := blk.fillVector(colIdx)
+	if err != nil {
+		return nil, err
+	}
+	vec := vector.NewVector(fill.Typ, blk.GetRowCount())
+	if _, err = vec.AppendVector(fill, 0); err != nil {
+		return nil, err
+	}
+	return vec, nil
 }
 
 func (blk *block) close() {
@@ -91,6 +139,9 @@ func (blk *block) Size(attr string) uint64 {
 	if idx < 0 {
 		panic(fmt.Sprintf("Specified attr %s not found", attr))
 	}
+	if idx >= len(blk.data.cols) {
+		return 0
+	}
 	if !blk.IsMutable() {
 		return blk.data.sizes[idx]
 	}
@@ -119,6 +170,8 @@ func (blk *block) CloneWithUpgrade(host iface.ISegment, meta *metadata.Block) (i
 
 	upgraded.data.cols = make([]col.IColumnBlock, len(blk.data.cols))
 	blk.cloneWithUpgradeColumns(upgraded)
+	// the upgraded file may have the columns added since the block is loaded
+	upgraded.appendColumns()
 
 	upgraded.OnZeroCB = upgraded.close
 
@@ -144,6 +197,13 @@ func (blk *block) String() string {
 }
 
 func (blk *block) GetVectorWrapper(attrid int) (*vector.VectorWrapper, error) {
+	if attrid >= len(blk.data.cols) {
+		fill, err := blk.fillVector(attrid)
+		if err != nil {
+			return nil, err
+		}
+		return vector.NewVectorWrapper(fill), nil
+	}
 	vec, err := blk.data.cols[attrid].LoadVectorWrapper()
 	if err != nil {
 		return nil, err
@@ -156,6 +216,9 @@ func (blk *block) GetVectorCopy(attr string, compressed, deCompressed *bytes.Buf
 	if colIdx == -1 {
 		return nil, errors.New(fmt.Sprintf("column %s not found", attr))
 	}
+	if colIdx >= len(blk.data.cols) {
+		return blk.fillVector(colIdx)
+	}
 	vec, err := blk.data.cols[colIdx].ForceLoad(compressed, deCompressed)
 	if err != nil {
 		return nil, err
@@ -168,6 +231,9 @@ func (blk *block) Prefetch(attr string) error {
 	if colIdx == -1 {
 		return errors.New(fmt.Sprintf("column %s not found", attr))
 	}
+	if colIdx >= len(blk.data.cols) {
+		return nil
+	}
 	return blk.data.cols[colIdx].Prefetch()
 }
 
@@ -187,21 +253,28 @@ func (blk *block) GetFullBatch() batch.IBatch {
 	return bat
 }
 
-func (blk *block) GetBatch(attrs []int) dbi.IBatchReader {
+func (blk *block) GetBatch(attrs []int) (dbi.IBatchReader, error) {
 	// TODO: check attrs validity
 	vecs := make([]vector.IVector, len(attrs))
 	clonedAttrs := make([]int, len(attrs))
+	var err error
 	for idx, attr := range attrs {
 		clonedAttrs[idx] = attr
+		if attr >= len(blk.data.cols) {
+			if vecs[idx], err = blk.fillIVector(attr); err != nil {
+				return nil, err
+			}
+			continue
+		}
 		vecs[idx] = blk.data.cols[attr].GetVector()
 	}
 	blk.Ref()
 	bat, err := wrapper.NewBatch(blk, attrs, vecs)
 	if err != nil {
-		// TODO: returns error
-		panic(err)
+		blk.Unref()
+		return nil, err
 	}
-	return bat.(dbi.IBatchReader)
+	return bat.(dbi.IBatchReader), nil
 }
 
 func (blk *block) Sum(colIdx int, filter *roaring64.Bitmap) (int64, uint64) {
@@ -247,6 +320,8 @@ func (blk *block) Sum(colIdx int, filter *roaring64.Bitmap) (int64, uint64) {
 			sum += int64(val.(types.Date))
 		case types.T_datetime:
 			sum += int64(val.(types.Datetime))
+		case types.T_decimal:
+			sum += int64(val.(types.Decimal).Lo)
 		}
 	}
 	return sum, cnt
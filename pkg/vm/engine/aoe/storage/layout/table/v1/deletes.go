@@ -0,0 +1,87 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/base"
+)
+
+// EncodeRow encodes the row of the given columns as the key used to
+// match a deleted row.
+func EncodeRow(vecs []*vector.Vector, row int) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, vec := range vecs {
+		if nulls.Contains(vec.Nsp, uint64(row)) {
+			buf.WriteByte(0)
+			continue
+		}
+		buf.WriteByte(1)
+		switch vec.Typ.Oid {
+		case types.T_int8:
+			buf.Write(encoding.EncodeInt8(vec.Col.([]int8)[row]))
+		case types.T_int16:
+			buf.Write(encoding.EncodeInt16(vec.Col.([]int16)[row]))
+		case types.T_int32:
+			buf.Write(encoding.EncodeInt32(vec.Col.([]int32)[row]))
+		case types.T_int64:
+			buf.Write(encoding.EncodeInt64(vec.Col.([]int64)[row]))
+		case types.T_uint8:
+			buf.Write(encoding.EncodeUint8(vec.Col.([]uint8)[row]))
+		case types.T_uint16:
+			buf.Write(encoding.EncodeUint16(vec.Col.([]uint16)[row]))
+		case types.T_uint32:
+			buf.Write(encoding.EncodeUint32(vec.Col.([]uint32)[row]))
+		case types.T_uint64:
+			buf.Write(encoding.EncodeUint64(vec.Col.([]uint64)[row]))
+		case types.T_float32:
+			buf.Write(encoding.EncodeFloat32(vec.Col.([]float32)[row]))
+		case types.T_float64:
+			buf.Write(encoding.EncodeFloat64(vec.Col.([]float64)[row]))
+		case types.T_date:
+			buf.Write(encoding.EncodeDate(vec.Col.([]types.Date)[row]))
+		case types.T_datetime:
+			buf.Write(encoding.EncodeDatetime(vec.Col.([]types.Datetime)[row]))
+		case types.T_decimal:
+			buf.Write(encoding.EncodeDecimal(vec.Col.([]types.Decimal)[row]))
+		case types.T_char, types.T_varchar, types.T_json:
+			data := vec.Col.(*types.Bytes).Get(int64(row))
+			buf.Write(encoding.EncodeUint32(uint32(len(data))))
+			buf.Write(data)
+		default:
+			return nil, fmt.Errorf("unsupported type %s", vec.Typ)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// GetDeletes returns the positions of the deleted rows of the segment,
+// or nil if no row of the segment was deleted. The positions follow the
+// layout of the filters, i.e. block idx * BlockMaxRows + row offset.
+func (seg *segment) GetDeletes() (*roaring64.Bitmap, error) {
+	return seg.meta.Table.GetDeletes().Positions(seg.meta.Id, seg.typ == base.SORTED_SEG)
+}
+
+// GetDeletesAt is GetDeletes of the rows deleted at or before ts.
+func (seg *segment) GetDeletesAt(ts uint64) (*roaring64.Bitmap, error) {
+	return seg.meta.Table.GetDeletes().PositionsAt(seg.meta.Id, seg.typ == base.SORTED_SEG, ts)
+}
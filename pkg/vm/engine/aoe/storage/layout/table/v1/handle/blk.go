@@ -15,7 +15,14 @@
 package handle
 
 import (
+	"bytes"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/dbi"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/table/v1/iface"
 )
 
 var (
@@ -27,10 +34,50 @@ type Block struct {
 	Id   uint64
 }
 
-func (blk *Block) Prefetch() dbi.IBatchReader {
+func (blk *Block) Prefetch() (dbi.IBatchReader, error) {
 	realBlk := blk.Host.Data.StrongRefBlock(blk.Id)
 	defer realBlk.Unref()
-	return realBlk.GetBatch(blk.Host.Attr)
+	deleted, err := blk.Host.Data.GetDeletes()
+	if err != nil {
+		return nil, err
+	}
+	if deleted == nil {
+		return realBlk.GetBatch(blk.Host.Attr)
+	}
+	return blk.prefetchWithDeletes(realBlk, deleted)
+}
+
+// prefetchWithDeletes copies the block into a batch without the deleted rows.
+func (blk *Block) prefetchWithDeletes(realBlk iface.IBlock, deleted *roaring64.Bitmap) (dbi.IBatchReader, error) {
+	schema := realBlk.GetMeta().Segment.Table.Schema
+	start := uint64(realBlk.GetMeta().Idx) * schema.BlockMaxRows
+	attrs := make([]int, len(blk.Host.Attr))
+	vecs := make([]vector.IVector, len(blk.Host.Attr))
+	var sels []int64
+	for i, attr := range blk.Host.Attr {
+		ro, err := realBlk.GetVectorCopy(schema.ColDefs[attr].Name, bytes.NewBuffer(nil), bytes.NewBuffer(nil))
+		if err != nil {
+			return nil, err
+		}
+		if sels == nil {
+			sels = make([]int64, 0, gvec.Length(ro))
+			for row := 0; row < gvec.Length(ro); row++ {
+				if !deleted.Contains(start + uint64(row)) {
+					sels = append(sels, int64(row))
+				}
+			}
+		}
+		gvec.Shrink(ro, sels)
+		attrs[i] = attr
+		vecs[i] = vector.NewVector(ro.Typ, uint64(len(sels)))
+		if len(sels) == 0 {
+			continue
+		}
+		if _, err = vecs[i].AppendVector(ro, 0); err != nil {
+			return nil, err
+		}
+	}
+	return batch.NewBatch(attrs, vecs)
 }
 
 func (blk *Block) GetID() uint64 {
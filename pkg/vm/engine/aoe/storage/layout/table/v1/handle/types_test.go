@@ -87,7 +87,8 @@ func TestSnapshot(t *testing.T) {
 		for blkIt.Valid() {
 			actualBlkCnt++
 			blk := blkIt.GetHandle()
-			h := blk.Prefetch()
+			h, err := blk.Prefetch()
+			assert.Nil(t, err)
 			h.Close()
 			// blk.Close()
 			blkIt.Next()
@@ -118,7 +119,8 @@ func TestSnapshot(t *testing.T) {
 		for blkIt.Valid() {
 			actualBlkCnt++
 			blk := blkIt.GetHandle()
-			h := blk.Prefetch()
+			h, err := blk.Prefetch()
+			assert.Nil(t, err)
 			h.Close()
 			// blk.Close()
 			blkIt.Next()
@@ -138,7 +140,8 @@ func TestSnapshot(t *testing.T) {
 		for _, id := range ids {
 			blk := segment.GetBlock(id)
 			assert.NotNil(t, blk)
-			blkH := blk.Prefetch()
+			blkH, err := blk.Prefetch()
+			assert.Nil(t, err)
 			blkH.Close()
 		}
 		linkSegIt.Next()
@@ -196,6 +196,13 @@ type ISegment interface {
 
 	//  StrongRefLastBlock Ref to the last Block in segment
 	StrongRefLastBlock() IBlock
+
+	// GetDeletes gets the positions of the deleted rows in the segment,
+	// nil if no row was deleted
+	GetDeletes() (*roaring64.Bitmap, error)
+
+	// GetDeletesAt gets the positions of the rows deleted at or before ts
+	GetDeletesAt(ts uint64) (*roaring64.Bitmap, error)
 }
 
 type IBlock interface {
@@ -231,7 +238,7 @@ type IBlock interface {
 	GetFullBatch() batch.IBatch
 
 	// GetBatch gets attrs's Batch data of the Block
-	GetBatch(attrs []int) dbi.IBatchReader
+	GetBatch(attrs []int) (dbi.IBatchReader, error)
 
 	// GetVectorWrapper gets col's vector data of the Block
 	GetVectorWrapper(col int) (*svec.VectorWrapper, error)
@@ -274,7 +281,7 @@ type IBlockFactory interface {
 type IMutBlock interface {
 	IBlock
 	WithPinedContext(func(mb.IMutableBlock) error) error
-	MakeHandle() bb.INodeHandle
+	MakeHandle() (bb.INodeHandle, error)
 }
 
 type IColBlockHandle interface {
@@ -186,6 +186,7 @@ func (seg *segment) close() {
 	}
 	if seg.typ == base.UNSORTED_SEG {
 		seg.host.GetFsManager().UnregisterUnsortedFile(segId)
+		seg.meta.Table.GetDeletes().ReleaseRemap(seg.meta.Id)
 	} else {
 		seg.host.GetFsManager().UnregisterSortedFile(segId)
 	}
@@ -72,30 +72,41 @@ func (blk *tblock) close() {
 	blk.OnVersionStale()
 }
 
-func (blk *tblock) getHandle() bb.INodeHandle {
+func (blk *tblock) getHandle() (bb.INodeHandle, error) {
 	h := blk.nodeMgr.Pin(blk.node)
 	for h == nil {
 		runtime.Gosched()
 		h = blk.nodeMgr.Pin(blk.node)
 	}
-	return h
+	// the data may be loaded before some columns are added
+	if err := blk.node.AddColumns(); err != nil {
+		h.Close()
+		return nil, err
+	}
+	return h, nil
 }
 
 func (blk *tblock) WithPinedContext(fn func(mb.IMutableBlock) error) error {
-	h := blk.getHandle()
-	err := fn(blk.node)
+	h, err := blk.getHandle()
+	if err != nil {
+		return err
+	}
+	err = fn(blk.node)
 	h.Close()
 	return err
 }
 
-func (blk *tblock) MakeHandle() bb.INodeHandle {
+func (blk *tblock) MakeHandle() (bb.INodeHandle, error) {
 	return blk.getHandle()
 }
 
 func (blk *tblock) ProcessData(fn func(batch.IBatch) error) error {
-	h := blk.getHandle()
+	h, err := blk.getHandle()
+	if err != nil {
+		return err
+	}
 	data := blk.node.GetData()
-	err := fn(data)
+	err = fn(data)
 	h.Close()
 	return err
 }
@@ -132,7 +143,10 @@ func (blk *tblock) getVectorCopyFactory(attr string, compressed, deCompressed *b
 
 func (blk *tblock) GetVectorCopy(attr string, compressed, deCompressed *bytes.Buffer) (*gvec.Vector, error) {
 	fn := blk.getVectorCopyFactory(attr, compressed, deCompressed)
-	h := blk.getHandle()
+	h, err := blk.getHandle()
+	if err != nil {
+		return nil, err
+	}
 	data := blk.node.GetData()
 	v, err := fn(data)
 	h.Close()
@@ -147,29 +161,36 @@ func (blk *tblock) GetFullBatch() batch.IBatch {
 	panic("not supported")
 }
 
-func (blk *tblock) GetBatch(attrids []int) dbi.IBatchReader {
-	h := blk.getHandle()
+func (blk *tblock) GetBatch(attrids []int) (dbi.IBatchReader, error) {
+	h, err := blk.getHandle()
+	if err != nil {
+		return nil, err
+	}
 	data := blk.node.GetData()
 	attrs := make([]int, len(attrids))
 	vecs := make([]vector.IVector, len(attrids))
-	var err error
 	for idx, attr := range attrids {
 		attrs[idx] = attr
 		vecs[idx], err = data.GetVectorByAttr(attr)
 		if err != nil {
-			// TODO: returns error
-			panic(err)
+			h.Close()
+			return nil, err
 		}
 	}
 	wrapped, err := batch.NewBatch(attrs, vecs)
 	if err != nil {
-		// TODO: returns error
-		panic(err)
+		h.Close()
+		return nil, err
 	}
-	return wrapper.NewBatch2(h, wrapped)
+	return wrapper.NewBatch2(h, wrapped), nil
 }
 
 func (blk *tblock) Sum(colIdx int, filter *roaring64.Bitmap) (int64, uint64) {
+	h, err := blk.getHandle()
+	if err != nil {
+		panic(err)
+	}
+	defer h.Close()
 	vec, err := blk.node.GetData().GetVectorByAttr(colIdx)
 	if err != nil {
 		panic(err)
@@ -219,12 +240,19 @@ func (blk *tblock) Sum(colIdx int, filter *roaring64.Bitmap) (int64, uint64) {
 			sum += int64(val.(types.Date))
 		case types.T_datetime:
 			sum += int64(val.(types.Datetime))
+		case types.T_decimal:
+			sum += int64(val.(types.Decimal).Lo)
 		}
 	}
 	return sum, cnt
 }
 
 func (blk *tblock) Max(colIdx int, filter *roaring64.Bitmap) interface{} {
+	h, err := blk.getHandle()
+	if err != nil {
+		panic(err)
+	}
+	defer h.Close()
 	vec, err := blk.node.GetData().GetVectorByAttr(colIdx)
 	if err != nil {
 		panic(err)
@@ -263,6 +291,11 @@ func (blk *tblock) Max(colIdx int, filter *roaring64.Bitmap) interface{} {
 }
 
 func (blk *tblock) Min(colIdx int, filter *roaring64.Bitmap) interface{} {
+	h, err := blk.getHandle()
+	if err != nil {
+		panic(err)
+	}
+	defer h.Close()
 	vec, err := blk.node.GetData().GetVectorByAttr(colIdx)
 	if err != nil {
 		panic(err)
@@ -301,6 +334,11 @@ func (blk *tblock) Min(colIdx int, filter *roaring64.Bitmap) interface{} {
 }
 
 func (blk *tblock) Count(colIdx int, filter *roaring64.Bitmap) uint64 {
+	h, err := blk.getHandle()
+	if err != nil {
+		panic(err)
+	}
+	defer h.Close()
 	vec, err := blk.node.GetData().GetVectorByAttr(colIdx)
 	if err != nil {
 		panic(err)
@@ -325,6 +363,11 @@ func (blk *tblock) Count(colIdx int, filter *roaring64.Bitmap) uint64 {
 }
 
 func (blk *tblock) NullCount(colIdx int, filter *roaring64.Bitmap) uint64 {
+	h, err := blk.getHandle()
+	if err != nil {
+		panic(err)
+	}
+	defer h.Close()
 	vec, err := blk.node.GetData().GetVectorByAttr(colIdx)
 	if err != nil {
 		panic(err)
@@ -349,6 +392,11 @@ func (blk *tblock) NullCount(colIdx int, filter *roaring64.Bitmap) uint64 {
 }
 
 func (blk *tblock) Eq(colIdx int, offset uint64, val interface{}) *roaring.Bitmap {
+	h, err := blk.getHandle()
+	if err != nil {
+		panic(err)
+	}
+	defer h.Close()
 	vec, err := blk.node.GetData().GetVectorByAttr(colIdx)
 	if err != nil {
 		panic(err)
@@ -375,6 +423,11 @@ func (blk *tblock) Eq(colIdx int, offset uint64, val interface{}) *roaring.Bitma
 }
 
 func (blk *tblock) Ne(colIdx int, offset uint64, val interface{}) *roaring.Bitmap {
+	h, err := blk.getHandle()
+	if err != nil {
+		panic(err)
+	}
+	defer h.Close()
 	vec, err := blk.node.GetData().GetVectorByAttr(colIdx)
 	if err != nil {
 		panic(err)
@@ -401,6 +454,11 @@ func (blk *tblock) Ne(colIdx int, offset uint64, val interface{}) *roaring.Bitma
 }
 
 func (blk *tblock) Ge(colIdx int, offset uint64, val interface{}) *roaring.Bitmap {
+	h, err := blk.getHandle()
+	if err != nil {
+		panic(err)
+	}
+	defer h.Close()
 	vec, err := blk.node.GetData().GetVectorByAttr(colIdx)
 	if err != nil {
 		panic(err)
@@ -427,6 +485,11 @@ func (blk *tblock) Ge(colIdx int, offset uint64, val interface{}) *roaring.Bitma
 }
 
 func (blk *tblock) Le(colIdx int, offset uint64, val interface{}) *roaring.Bitmap {
+	h, err := blk.getHandle()
+	if err != nil {
+		panic(err)
+	}
+	defer h.Close()
 	vec, err := blk.node.GetData().GetVectorByAttr(colIdx)
 	if err != nil {
 		panic(err)
@@ -453,6 +516,11 @@ func (blk *tblock) Le(colIdx int, offset uint64, val interface{}) *roaring.Bitma
 }
 
 func (blk *tblock) Gt(colIdx int, offset uint64, val interface{}) *roaring.Bitmap {
+	h, err := blk.getHandle()
+	if err != nil {
+		panic(err)
+	}
+	defer h.Close()
 	vec, err := blk.node.GetData().GetVectorByAttr(colIdx)
 	if err != nil {
 		panic(err)
@@ -479,6 +547,11 @@ func (blk *tblock) Gt(colIdx int, offset uint64, val interface{}) *roaring.Bitma
 }
 
 func (blk *tblock) Lt(colIdx int, offset uint64, val interface{}) *roaring.Bitmap {
+	h, err := blk.getHandle()
+	if err != nil {
+		panic(err)
+	}
+	defer h.Close()
 	vec, err := blk.node.GetData().GetVectorByAttr(colIdx)
 	if err != nil {
 		panic(err)
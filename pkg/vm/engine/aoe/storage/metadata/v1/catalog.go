@@ -474,6 +474,9 @@ func (catalog *Catalog) onReplayTableEntry(entry *tableCheckpoint) error {
 			}
 		}
 	}
+	if entry.Deletes != nil {
+		catalog.onReplayDeletesCheckpoint(entry.Deletes)
+	}
 	return nil
 }
 
@@ -635,6 +638,7 @@ func (catalog *Catalog) ToCatalogLogEntry() *catalogLogEntry {
 			tableCkp.LogEntry = tb.ToTableLogEntry(info)
 		}
 		tb.RUnlock()
+		tableCkp.Deletes = tb.toDeleteRowsLogEntry()
 		catalogCkp.Databases[tb.Database.Id].
 			Tables[tb.Id] = tableCkp
 		return nil
@@ -960,10 +964,19 @@ func (catalog *Catalog) onReplayTableOperation(entry *tableLogEntry) error {
 	return tbl.onCommit(entry.CommitInfo)
 }
 
+func (catalog *Catalog) onReplayAlterSchema(entry *tableLogEntry) error {
+	db := catalog.Databases[entry.DatabaseId]
+	tbl := db.TableSet[entry.Id]
+	tbl.Schema = entry.Table.Schema
+	return tbl.onCommit(entry.CommitInfo)
+}
+
 func (catalog *Catalog) onReplayTableCheckpoint(entry *tableLogEntry) error {
 	db := catalog.Databases[entry.DatabaseId]
 	tbl, ok := db.TableSet[entry.Table.Id]
 	if ok {
+		// the schema may be altered after the table is created
+		tbl.Schema = entry.Table.Schema
 		return tbl.onCommit(entry.Table.CommitInfo)
 	}
 	tbl = NewEmptyTableEntry(db)
@@ -986,7 +999,11 @@ func (catalog *Catalog) onReplayUpgradeSegment(entry *segmentLogEntry) error {
 	tbl := db.TableSet[entry.TableId]
 	pos := tbl.IdIndex[entry.Id]
 	seg := tbl.SegmentSet[pos]
-	return seg.onCommit(entry.CommitInfo)
+	if err := seg.onCommit(entry.CommitInfo); err != nil {
+		return err
+	}
+	tbl.GetDeletes().onReplayUpgradeSegment(entry.Id, entry.Deletes)
+	return nil
 }
 
 func (catalog *Catalog) onReplaySegmentCheckpoint(entry *segmentLogEntry) error {
@@ -1041,6 +1058,31 @@ func (catalog *Catalog) onReplayBlockCheckpoint(entry *blockLogEntry) error {
 	return nil
 }
 
+func (catalog *Catalog) onReplayDeleteRows(entry *deleteRowsLogEntry) error {
+	db, ok := catalog.Databases[entry.DatabaseId]
+	if !ok {
+		return nil
+	}
+	tbl, ok := db.TableSet[entry.TableId]
+	if !ok {
+		return nil
+	}
+	tbl.GetDeletes().apply(entry.Segments, entry.LogIndex, entry.Ts)
+	return nil
+}
+
+func (catalog *Catalog) onReplayDeletesCheckpoint(entry *deleteRowsLogEntry) {
+	db, ok := catalog.Databases[entry.DatabaseId]
+	if !ok {
+		return
+	}
+	tbl, ok := db.TableSet[entry.TableId]
+	if !ok {
+		return
+	}
+	tbl.GetDeletes().reset(entry.Segments, entry.LogIndex)
+}
+
 func (catalog *Catalog) onReplayCreateDatabase(entry *Database) error {
 	db := NewEmptyDatabase(catalog)
 	db.BaseEntry = entry.BaseEntry
@@ -55,6 +55,8 @@ func (p *commitPipeline) prepare(ctx interface{}) (LogEntry, error) {
 		return v.table.prepareAddIndice(v)
 	case *dropIndiceCtx:
 		return v.table.prepareDropIndice(v)
+	case *alterSchemaCtx:
+		return v.table.prepareAlterSchema(v)
 	case *createSegmentCtx:
 		return v.table.prepareCreateSegment(v)
 	case *upgradeSegmentCtx:
@@ -63,6 +65,8 @@ func (p *commitPipeline) prepare(ctx interface{}) (LogEntry, error) {
 		return v.segment.prepareCreateBlock(v)
 	case *upgradeBlockCtx:
 		return v.block.prepareUpgrade(v)
+	case *deleteRowsCtx:
+		return v.table.prepareDeleteRows(v)
 	case *TxnCtx:
 		return p.catalog.prepareCommitTxn(v)
 	default:
@@ -103,6 +103,19 @@ type upgradeBlockCtx struct {
 	exIndice []*LogIndex
 }
 
+type alterSchemaCtx struct {
+	writeCtx
+	table  *Table
+	schema *Schema
+}
+
+type deleteRowsCtx struct {
+	writeCtx
+	table   *Table
+	deletes []SegmentDeletes
+	ts      uint64
+}
+
 // Unused
 // type replaceTableCtx struct {
 // 	writeCtx
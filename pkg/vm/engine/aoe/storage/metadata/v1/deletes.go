@@ -0,0 +1,522 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/logstore"
+)
+
+var (
+	ErrRemapNotFound = errors.New("aoe: remap of segment not found")
+)
+
+// DroppedRow is the position in a SegmentRemap of a row that was
+// dropped from the sorted segment.
+const DroppedRow = math.MaxUint32
+
+// SegmentDeletes lists the positions of the rows deleted from a segment,
+// i.e. block idx * BlockMaxRows + row offset. Sorted tells whether the
+// positions are in the layout of the sorted segment.
+type SegmentDeletes struct {
+	SegmentId uint64
+	Sorted    bool
+	Rows      *roaring64.Bitmap
+}
+
+type segmentDeletesJSON struct {
+	SegmentId uint64 `json:"sid"`
+	Sorted    bool   `json:"sorted,omitempty"`
+	Rows      []byte `json:"rows"`
+}
+
+func (s SegmentDeletes) MarshalJSON() ([]byte, error) {
+	rows := s.Rows
+	if rows == nil {
+		rows = roaring64.NewBitmap()
+	}
+	buf, err := rows.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&segmentDeletesJSON{
+		SegmentId: s.SegmentId,
+		Sorted:    s.Sorted,
+		Rows:      buf,
+	})
+}
+
+func (s *SegmentDeletes) UnmarshalJSON(buf []byte) error {
+	v := new(segmentDeletesJSON)
+	if err := json.Unmarshal(buf, v); err != nil {
+		return err
+	}
+	s.SegmentId, s.Sorted = v.SegmentId, v.Sorted
+	s.Rows = roaring64.NewBitmap()
+	return s.Rows.UnmarshalBinary(v.Rows)
+}
+
+// SegmentRemap maps the position of each row of an unsorted segment to
+// its position in the sorted segment, or DroppedRow if the row was
+// deleted when the sorted segment was written. The rows kept are moved
+// to the front, the positions from Rows on hold no row.
+type SegmentRemap struct {
+	Positions []uint32
+	Rows      uint64
+}
+
+// forward maps the positions deleted from the unsorted segment to the
+// sorted segment.
+func (m *SegmentRemap) forward(rows *roaring64.Bitmap) *roaring64.Bitmap {
+	sorted, _ := m.move(rows)
+	if total := uint64(len(m.Positions)); m.Rows < total {
+		sorted.AddRange(m.Rows, total)
+	}
+	return sorted
+}
+
+// move maps the positions of the unsorted segment to the sorted segment,
+// it also tells whether some of the rows were dropped.
+func (m *SegmentRemap) move(rows *roaring64.Bitmap) (*roaring64.Bitmap, bool) {
+	sorted := roaring64.NewBitmap()
+	if rows == nil {
+		return sorted, false
+	}
+	dropped := false
+	it := rows.Iterator()
+	for it.HasNext() {
+		pos := it.Next()
+		if pos >= uint64(len(m.Positions)) {
+			continue
+		}
+		if m.Positions[pos] == DroppedRow {
+			dropped = true
+			continue
+		}
+		sorted.Add(uint64(m.Positions[pos]))
+	}
+	return sorted, dropped
+}
+
+// backward maps the positions deleted from the sorted segment to the
+// unsorted segment.
+func (m *SegmentRemap) backward(rows *roaring64.Bitmap) *roaring64.Bitmap {
+	unsorted := roaring64.NewBitmap()
+	for pos, to := range m.Positions {
+		if to == DroppedRow || (rows != nil && rows.Contains(uint64(to))) {
+			unsorted.Add(uint64(pos))
+		}
+	}
+	return unsorted
+}
+
+type segmentDeletes struct {
+	sorted bool
+	// rows is never modified once set, it is replaced by a copy instead
+	rows *roaring64.Bitmap
+	// versions are the rows deleted in the last SnapshotRetention, in the
+	// layout of the segment and the order of the deletions
+	versions []deleteVersion
+	// lost is the latest timestamp of the deletions whose rows can not be
+	// told apart from the other deleted rows anymore
+	lost uint64
+}
+
+// deleteVersion is the rows deleted from a segment at ts.
+type deleteVersion struct {
+	ts   uint64
+	rows *roaring64.Bitmap
+}
+
+// at returns the rows deleted from the segment at or before ts.
+func (seg *segmentDeletes) at(ts uint64) (*roaring64.Bitmap, error) {
+	if ts < seg.lost {
+		return nil, engine.ErrSnapshotTooOld
+	}
+	rows := seg.rows
+	for _, v := range seg.versions {
+		if v.ts <= ts || rows == nil {
+			continue
+		}
+		if rows == seg.rows {
+			rows = rows.Clone()
+		}
+		rows.AndNot(v.rows)
+	}
+	return rows, nil
+}
+
+// TableDeletes keeps the positions of the rows deleted from the segments
+// of a table. Flushing a block keeps the rows in the order they were
+// appended, only upgrading a segment moves them, which moves the
+// positions with the remap recorded by the flush of the segment.
+// The deletions with a timestamp are also kept by version for a while,
+// so that the segments can be read as they were at a timestamp.
+type TableDeletes struct {
+	mu       sync.RWMutex
+	logIndex *LogIndex
+	segments map[uint64]*segmentDeletes
+	remaps   map[uint64]*SegmentRemap
+}
+
+func newTableDeletes() *TableDeletes {
+	return &TableDeletes{
+		segments: make(map[uint64]*segmentDeletes),
+		remaps:   make(map[uint64]*SegmentRemap),
+	}
+}
+
+// Positions returns the positions of the rows deleted from the segment in
+// the layout of the sorted segment if sorted is true, or of the unsorted
+// one otherwise. It returns nil if nothing was deleted. The bitmap
+// returned must not be modified.
+func (d *TableDeletes) Positions(id uint64, sorted bool) (*roaring64.Bitmap, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	seg := d.segmentLocked(id)
+	return d.positionsLocked(id, seg.sorted, seg.rows, sorted)
+}
+
+// PositionsAt is Positions of the rows deleted at or before ts. It returns
+// engine.ErrSnapshotTooOld if the rows deleted after ts are not known.
+func (d *TableDeletes) PositionsAt(id uint64, sorted bool, ts uint64) (*roaring64.Bitmap, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	seg := d.segmentLocked(id)
+	rows, err := seg.at(ts)
+	if err != nil {
+		return nil, err
+	}
+	return d.positionsLocked(id, seg.sorted, rows, sorted)
+}
+
+func (d *TableDeletes) positionsLocked(id uint64, from bool, rows *roaring64.Bitmap, sorted bool) (*roaring64.Bitmap, error) {
+	if from == sorted {
+		if rows == nil || rows.IsEmpty() {
+			return nil, nil
+		}
+		return rows, nil
+	}
+	remap, ok := d.remaps[id]
+	if !ok {
+		if rows == nil {
+			return nil, nil
+		}
+		return nil, ErrRemapNotFound
+	}
+	if sorted {
+		return remap.forward(rows), nil
+	}
+	return remap.backward(rows), nil
+}
+
+// latest returns the timestamp of the latest deletion from the segment.
+func (seg *segmentDeletes) latest() uint64 {
+	ts := seg.lost
+	for _, v := range seg.versions {
+		if ts < v.ts {
+			ts = v.ts
+		}
+	}
+	return ts
+}
+
+// segmentLocked returns the deletes of the segment. A segment without
+// deletes has not been upgraded, an upgrade always records them.
+func (d *TableDeletes) segmentLocked(id uint64) *segmentDeletes {
+	if seg, ok := d.segments[id]; ok {
+		return seg
+	}
+	return &segmentDeletes{}
+}
+
+// SetRemap records how the rows of the segment are moved by its upgrade.
+// It is kept until ReleaseRemap, so that the unsorted segment can still
+// be read after the upgrade.
+func (d *TableDeletes) SetRemap(id uint64, remap *SegmentRemap) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.remaps[id] = remap
+}
+
+func (d *TableDeletes) ReleaseRemap(id uint64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.remaps, id)
+}
+
+// IsApplied checks whether the deletion with the given index was applied.
+func (d *TableDeletes) IsApplied(index *LogIndex) bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.isAppliedLocked(index)
+}
+
+func (d *TableDeletes) isAppliedLocked(index *LogIndex) bool {
+	if d.logIndex == nil || index == nil || d.logIndex.ShardId != index.ShardId {
+		return false
+	}
+	return d.logIndex.CompareID(index) >= 0
+}
+
+// apply adds the positions deleted at ts. The positions of a segment
+// upgraded since they were found are moved in place to its current layout,
+// so that they are logged in the same layout as they are applied. The
+// deletions without timestamp are not versioned, they are seen by all the
+// snapshots.
+func (d *TableDeletes) apply(deletes []SegmentDeletes, index *LogIndex, ts uint64) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.isAppliedLocked(index) {
+		return false
+	}
+	for i := range deletes {
+		del := &deletes[i]
+		if del.Rows == nil || del.Rows.IsEmpty() {
+			continue
+		}
+		seg := d.segmentLocked(del.SegmentId)
+		if seg.sorted != del.Sorted {
+			remap, ok := d.remaps[del.SegmentId]
+			if !ok {
+				// Replaying the deletes found before the upgrade of the
+				// segment, they are already in the checkpoint
+				logutil.Warnf("%s: segment %d", ErrRemapNotFound, del.SegmentId)
+				del.Rows = roaring64.NewBitmap()
+				continue
+			}
+			if seg.sorted {
+				del.Rows = remap.forward(del.Rows)
+			} else {
+				del.Rows = remap.backward(del.Rows)
+			}
+			del.Sorted = seg.sorted
+		}
+		rows := del.Rows.Clone()
+		if seg.rows != nil {
+			rows.Or(seg.rows)
+		}
+		next := &segmentDeletes{sorted: seg.sorted, rows: rows, lost: seg.lost}
+		if ts != 0 {
+			for _, v := range seg.versions {
+				if v.ts+uint64(engine.SnapshotRetention) < ts {
+					if next.lost < v.ts {
+						next.lost = v.ts
+					}
+					continue
+				}
+				next.versions = append(next.versions, v)
+			}
+			next.versions = append(next.versions, deleteVersion{ts: ts, rows: del.Rows.Clone()})
+		} else {
+			next.versions = seg.versions
+		}
+		d.segments[del.SegmentId] = next
+	}
+	if index != nil {
+		d.logIndex = index
+	}
+	return true
+}
+
+// CanUpgrade checks whether the positions deleted from the segment can
+// be moved by its upgrade.
+func (d *TableDeletes) CanUpgrade(id uint64) error {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	seg := d.segmentLocked(id)
+	if seg.sorted || seg.rows == nil {
+		return nil
+	}
+	if _, ok := d.remaps[id]; !ok {
+		return ErrRemapNotFound
+	}
+	return nil
+}
+
+// upgradeSegment moves the positions deleted from the segment to the
+// layout of the sorted segment and returns them.
+func (d *TableDeletes) upgradeSegment(id uint64) *SegmentDeletes {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	seg := d.segmentLocked(id)
+	if !seg.sorted {
+		rows := roaring64.NewBitmap()
+		remap, ok := d.remaps[id]
+		if ok {
+			rows = remap.forward(seg.rows)
+		} else if seg.rows != nil {
+			logutil.Errorf("%s: segment %d", ErrRemapNotFound, id)
+		}
+		next := &segmentDeletes{sorted: true, rows: rows, lost: seg.lost}
+		// the rows dropped by the upgrade are lost for the older snapshots
+		for _, v := range seg.versions {
+			var moved *roaring64.Bitmap
+			dropped := true
+			if ok {
+				moved, dropped = remap.move(v.rows)
+			}
+			if dropped && next.lost < v.ts {
+				next.lost = v.ts
+			}
+			if moved != nil && !moved.IsEmpty() {
+				next.versions = append(next.versions, deleteVersion{ts: v.ts, rows: moved})
+			}
+		}
+		seg = next
+		d.segments[id] = seg
+	}
+	return &SegmentDeletes{SegmentId: id, Sorted: true, Rows: seg.rows}
+}
+
+// onReplayUpgradeSegment sets the positions logged by the upgrade of the
+// segment, unless they were loaded from a later checkpoint.
+func (d *TableDeletes) onReplayUpgradeSegment(id uint64, deletes *SegmentDeletes) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	seg := d.segmentLocked(id)
+	if seg.sorted {
+		return
+	}
+	rows := roaring64.NewBitmap()
+	if deletes != nil && deletes.Rows != nil {
+		rows = deletes.Rows
+	}
+	d.segments[id] = &segmentDeletes{sorted: true, rows: rows, lost: seg.latest()}
+}
+
+// reset replaces the deletes by a checkpoint, which has no versions. The
+// rows deleted are seen by the snapshots until now.
+func (d *TableDeletes) reset(deletes []SegmentDeletes, index *LogIndex) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.segments = make(map[uint64]*segmentDeletes)
+	lost := engine.Now()
+	for _, del := range deletes {
+		rows := del.Rows
+		if rows == nil {
+			rows = roaring64.NewBitmap()
+		}
+		d.segments[del.SegmentId] = &segmentDeletes{sorted: del.Sorted, rows: rows, lost: lost}
+	}
+	d.logIndex = index
+}
+
+func (d *TableDeletes) isEmpty() bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return len(d.segments) == 0
+}
+
+func (d *TableDeletes) toSegmentDeletes() ([]SegmentDeletes, *LogIndex) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	ids := make([]uint64, 0, len(d.segments))
+	for id := range d.segments {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	deletes := make([]SegmentDeletes, 0, len(ids))
+	for _, id := range ids {
+		seg := d.segments[id]
+		deletes = append(deletes, SegmentDeletes{SegmentId: id, Sorted: seg.sorted, Rows: seg.rows})
+	}
+	return deletes, d.logIndex
+}
+
+// moveSegment moves the positions deleted from a segment to the segment
+// it was moved to by a split.
+func (d *TableDeletes) moveSegment(id uint64, to *TableDeletes, newId uint64) {
+	d.mu.Lock()
+	seg, ok := d.segments[id]
+	delete(d.segments, id)
+	delete(d.remaps, id)
+	d.mu.Unlock()
+	if !ok {
+		return
+	}
+	to.mu.Lock()
+	to.segments[newId] = seg
+	to.mu.Unlock()
+}
+
+func (d *TableDeletes) MarshalJSON() ([]byte, error) {
+	segments, index := d.toSegmentDeletes()
+	return json.Marshal(&deleteRowsLogEntry{
+		LogIndex: index,
+		Segments: segments,
+	})
+}
+
+func (d *TableDeletes) UnmarshalJSON(buf []byte) error {
+	entry := new(deleteRowsLogEntry)
+	if err := json.Unmarshal(buf, entry); err != nil {
+		return err
+	}
+	if d.segments == nil {
+		d.segments = make(map[uint64]*segmentDeletes)
+	}
+	if d.remaps == nil {
+		d.remaps = make(map[uint64]*SegmentRemap)
+	}
+	d.reset(entry.Segments, entry.LogIndex)
+	return nil
+}
+
+type deleteRowsLogEntry struct {
+	sync.Mutex `json:"-"`
+	DatabaseId uint64
+	TableId    uint64
+	LogIndex   *LogIndex
+	Segments   []SegmentDeletes
+	// Ts is the timestamp of the deletion, 0 if it is not versioned
+	Ts      uint64 `json:",omitempty"`
+	deletes *TableDeletes
+}
+
+func (e *deleteRowsLogEntry) Marshal() ([]byte, error) {
+	return json.Marshal(e)
+}
+
+func (e *deleteRowsLogEntry) Unmarshal(buf []byte) error {
+	return json.Unmarshal(buf, e)
+}
+
+// CommitLocked applies the deletes in the order of the commits, so that
+// an upgrade of the segment is logged either before or after them.
+func (e *deleteRowsLogEntry) CommitLocked(uint64) {
+	if e.deletes != nil {
+		e.deletes.apply(e.Segments, e.LogIndex, e.Ts)
+	}
+}
+
+func (e *deleteRowsLogEntry) ToLogEntry(eType LogEntryType) LogEntry {
+	if eType != ETDeleteRows {
+		panic("not supported")
+	}
+	buf, _ := e.Marshal()
+	logEntry := logstore.NewAsyncBaseEntry()
+	logEntry.Meta.SetType(eType)
+	logEntry.Unmarshal(buf)
+	return logEntry
+}
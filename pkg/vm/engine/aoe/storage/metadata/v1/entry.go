@@ -46,6 +46,7 @@ type tableCheckpoint struct {
 	Segments   []*segmentCheckpoint
 	NeedReplay bool
 	LogEntry   tableLogEntry
+	Deletes    *deleteRowsLogEntry `json:",omitempty"`
 }
 
 type databaseCheckpoint struct {
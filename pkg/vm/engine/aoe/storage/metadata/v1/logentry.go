@@ -44,6 +44,8 @@ const (
 	ETDatabaseSnapshot
 	ETDatabaseReplaced
 	ETTransaction
+	ETDeleteRows
+	ETAlterSchema
 )
 
 type IEntry interface {
@@ -25,7 +25,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/RoaringBitmap/roaring/roaring64"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/internal/invariants"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/logstore"
@@ -1616,3 +1618,175 @@ func TestIndice(t *testing.T) {
 
 	catalog.Close()
 }
+
+func TestDeleteRows(t *testing.T) {
+	dir := initTestEnv(t)
+	cfg := new(CatalogCfg)
+	cfg.Dir = dir
+	cfg.BlockMaxRows, cfg.SegmentMaxBlocks = uint64(100), uint64(2)
+	cfg.RotationFileMaxSize = 20 * int(common.K)
+	catalog, _ := OpenCatalog(new(sync.RWMutex), cfg)
+	catalog.Start()
+
+	schema := MockSchema(2)
+	database, err := catalog.SimpleCreateDatabase("db1", nil)
+	assert.Nil(t, err)
+	gen := shard.NewMockIndexAllocator()
+	table, err := database.SimpleCreateTable(schema, nil, gen.Next(database.ShardId))
+	assert.Nil(t, err)
+
+	var seg *Segment
+	for i := 0; i < int(cfg.SegmentMaxBlocks); i++ {
+		blk, _ := table.SimpleCreateBlock()
+		blk.SetCount(cfg.BlockMaxRows)
+		assert.Nil(t, blk.SimpleUpgrade(nil))
+		seg = blk.Segment
+	}
+	rows := cfg.BlockMaxRows * cfg.SegmentMaxBlocks
+
+	idx := gen.Next(database.ShardId)
+	deletes := []SegmentDeletes{{SegmentId: seg.Id, Rows: roaring64.BitmapOf(1, 2)}}
+	err = table.SimpleDeleteRows(deletes, idx, 0)
+	assert.Nil(t, err)
+	err = table.SimpleDeleteRows(deletes, idx, 0)
+	assert.Equal(t, ErrIdempotence, err)
+
+	// The sorted segment is written in the reverse order without the
+	// rows deleted so far
+	remap := &SegmentRemap{Positions: make([]uint32, rows), Rows: rows - 2}
+	for pos, to := int(rows)-1, uint32(0); pos >= 0; pos-- {
+		if pos == 1 || pos == 2 {
+			remap.Positions[pos] = DroppedRow
+			continue
+		}
+		remap.Positions[pos] = to
+		to++
+	}
+	table.GetDeletes().SetRemap(seg.Id, remap)
+
+	idx = gen.Next(database.ShardId)
+	deletes = []SegmentDeletes{
+		{SegmentId: seg.Id, Rows: roaring64.BitmapOf(2, 150)},
+		{SegmentId: seg.Id + 1, Rows: roaring64.BitmapOf(3)},
+	}
+	err = table.SimpleDeleteRows(deletes, idx, 0)
+	assert.Nil(t, err)
+	sorted, err := table.GetDeletes().Positions(seg.Id, true)
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{uint64(remap.Positions[150]), rows - 2, rows - 1}, sorted.ToArray())
+
+	assert.Nil(t, seg.SimpleUpgrade(mockSegmentSize, nil))
+	// The rows found in the unsorted segment are moved to the sorted one
+	idx = gen.Next(database.ShardId)
+	deletes = []SegmentDeletes{{SegmentId: seg.Id, Rows: roaring64.BitmapOf(3)}}
+	err = table.SimpleDeleteRows(deletes, idx, 0)
+	assert.Nil(t, err)
+	unsorted, err := table.GetDeletes().Positions(seg.Id, false)
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{1, 2, 3, 150}, unsorted.ToArray())
+	table.GetDeletes().ReleaseRemap(seg.Id)
+	_, err = table.GetDeletes().Positions(seg.Id, false)
+	assert.Equal(t, ErrRemapNotFound, err)
+
+	check := func(table *Table) {
+		sorted, err := table.GetDeletes().Positions(seg.Id, true)
+		assert.Nil(t, err)
+		expected := roaring64.BitmapOf(uint64(remap.Positions[3]), uint64(remap.Positions[150]), rows-2, rows-1)
+		assert.Equal(t, expected.ToArray(), sorted.ToArray())
+		unsorted, err := table.GetDeletes().Positions(seg.Id+1, false)
+		assert.Nil(t, err)
+		assert.Equal(t, []uint64{3}, unsorted.ToArray())
+		unsorted, err = table.GetDeletes().Positions(seg.Id+2, false)
+		assert.Nil(t, err)
+		assert.Nil(t, unsorted)
+		assert.True(t, table.GetDeletes().IsApplied(idx))
+	}
+	check(table)
+	catalog.Close()
+
+	// Replay from the log entries
+	catalog, err = OpenCatalog(new(sync.RWMutex), cfg)
+	assert.Nil(t, err)
+	catalog.Start()
+	table, err = catalog.SimpleGetTableByName("db1", schema.Name)
+	assert.Nil(t, err)
+	check(table)
+	entry, err := catalog.Checkpoint()
+	assert.Nil(t, err)
+	entry.WaitDone()
+	catalog.Close()
+
+	// Replay from the checkpoint
+	catalog, err = OpenCatalog(new(sync.RWMutex), cfg)
+	assert.Nil(t, err)
+	catalog.Start()
+	table, err = catalog.SimpleGetTableByName("db1", schema.Name)
+	assert.Nil(t, err)
+	check(table)
+	catalog.Close()
+}
+
+func TestDeleteRowsAt(t *testing.T) {
+	dir := initTestEnv(t)
+	cfg := new(CatalogCfg)
+	cfg.Dir = dir
+	cfg.BlockMaxRows, cfg.SegmentMaxBlocks = uint64(100), uint64(2)
+	cfg.RotationFileMaxSize = 20 * int(common.K)
+	catalog, _ := OpenCatalog(new(sync.RWMutex), cfg)
+	catalog.Start()
+	defer catalog.Close()
+
+	schema := MockSchema(2)
+	database, err := catalog.SimpleCreateDatabase("db1", nil)
+	assert.Nil(t, err)
+	gen := shard.NewMockIndexAllocator()
+	table, err := database.SimpleCreateTable(schema, nil, gen.Next(database.ShardId))
+	assert.Nil(t, err)
+
+	var seg *Segment
+	for i := 0; i < int(cfg.SegmentMaxBlocks); i++ {
+		blk, _ := table.SimpleCreateBlock()
+		blk.SetCount(cfg.BlockMaxRows)
+		assert.Nil(t, blk.SimpleUpgrade(nil))
+		seg = blk.Segment
+	}
+	rows := cfg.BlockMaxRows * cfg.SegmentMaxBlocks
+
+	err = table.SimpleDeleteRows([]SegmentDeletes{{SegmentId: seg.Id, Rows: roaring64.BitmapOf(1)}}, gen.Next(database.ShardId), 100)
+	assert.Nil(t, err)
+	err = table.SimpleDeleteRows([]SegmentDeletes{{SegmentId: seg.Id, Rows: roaring64.BitmapOf(2)}}, gen.Next(database.ShardId), 200)
+	assert.Nil(t, err)
+
+	positions := func(sorted bool, ts uint64) []uint64 {
+		deleted, err := table.GetDeletes().PositionsAt(seg.Id, sorted, ts)
+		assert.Nil(t, err)
+		if deleted == nil {
+			return nil
+		}
+		return deleted.ToArray()
+	}
+	assert.Nil(t, positions(false, 50))
+	assert.Equal(t, []uint64{1}, positions(false, 150))
+	assert.Equal(t, []uint64{1, 2}, positions(false, 200))
+
+	// The sorted segment is written in the reverse order without the
+	// row deleted at 100
+	remap := &SegmentRemap{Positions: make([]uint32, rows), Rows: rows - 1}
+	for pos, to := int(rows)-1, uint32(0); pos >= 0; pos-- {
+		if pos == 1 {
+			remap.Positions[pos] = DroppedRow
+			continue
+		}
+		remap.Positions[pos] = to
+		to++
+	}
+	table.GetDeletes().SetRemap(seg.Id, remap)
+	assert.Nil(t, seg.SimpleUpgrade(mockSegmentSize, nil))
+
+	// The snapshots before the deletion of the dropped row are lost
+	_, err = table.GetDeletes().PositionsAt(seg.Id, true, 50)
+	assert.Equal(t, engine.ErrSnapshotTooOld, err)
+	assert.Equal(t, []uint64{rows - 1}, positions(true, 150))
+	assert.Equal(t, []uint64{uint64(remap.Positions[2]), rows - 1}, positions(true, 200))
+	assert.Equal(t, []uint64{1, 2}, positions(false, 200))
+}
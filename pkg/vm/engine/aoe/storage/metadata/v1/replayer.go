@@ -46,6 +46,7 @@ type replayEntry struct {
 	segEntry     *segmentLogEntry
 	catalogEntry *catalogLogEntry
 	blkEntry     *blockLogEntry
+	delEntry     *deleteRowsLogEntry
 	replaceEntry *dbReplaceLogEntry
 	txnStore     *TxnStore
 }
@@ -176,6 +177,10 @@ func (cache *replayCache) applyReplayEntry(entry *replayEntry, catalog *Catalog,
 		err = catalog.onReplayUpgradeSegment(entry.segEntry)
 	case ETTransaction:
 		err = cache.onReplayTxn(entry.txnStore)
+	case ETDeleteRows:
+		err = catalog.onReplayDeleteRows(entry.delEntry)
+	case ETAlterSchema:
+		err = catalog.onReplayAlterSchema(entry.tblEntry)
 	default:
 		panic(fmt.Sprintf("unknown entry type: %d", entry.typ))
 	}
@@ -402,6 +407,14 @@ func (replayer *catalogReplayer) onReplayEntry(entry LogEntry, observer logstore
 			blkEntry: blk,
 			commitId: GetCommitIdFromLogEntry(entry),
 		})
+	case ETDeleteRows:
+		del := &deleteRowsLogEntry{}
+		del.Unmarshal(entry.GetPayload())
+		replayer.cache.Append(&replayEntry{
+			typ:      ETDeleteRows,
+			delEntry: del,
+			commitId: GetCommitIdFromLogEntry(entry),
+		})
 	case ETCreateDatabase:
 		db := &Database{}
 		db.Unmarshal(entry.GetPayload())
@@ -458,7 +471,7 @@ func (replayer *catalogReplayer) onReplayEntry(entry LogEntry, observer logstore
 			tblEntry: tbl,
 			commitId: GetCommitIdFromLogEntry(entry),
 		})
-	case ETAddIndice, ETDropIndice, ETSoftDeleteTable, ETHardDeleteTable:
+	case ETAddIndice, ETDropIndice, ETSoftDeleteTable, ETHardDeleteTable, ETAlterSchema:
 		tbl := &tableLogEntry{}
 		tbl.Unmarshal(entry.GetPayload())
 		replayer.cache.Append(&replayEntry{
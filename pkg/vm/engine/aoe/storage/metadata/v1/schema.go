@@ -20,6 +20,9 @@ import (
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	ro "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
 
 type IndexT uint16
@@ -53,6 +56,23 @@ type ColDef struct {
 	Name string     `json:"name"`
 	Idx  int        `json:"idx"`
 	Type types.Type `json:"type"`
+	// Fill is the encoded engine.DefaultExpr of the rows written before
+	// the column is added, it is empty if the column is created with table
+	Fill []byte `json:"fill,omitempty"`
+	// Dropped is true if the column is dropped. The column keeps its Idx
+	// because the blocks written before are not rewritten
+	Dropped bool `json:"dropped,omitempty"`
+}
+
+// FillVector returns the column of n rows written before the column is added
+func (def *ColDef) FillVector(n int) (*ro.Vector, error) {
+	var fill engine.DefaultExpr
+	if len(def.Fill) > 0 {
+		if err := encoding.Decode(def.Fill, &fill); err != nil {
+			return nil, err
+		}
+	}
+	return engine.FillVector(def.Type, fill, n)
 }
 
 type IndexSchema struct {
@@ -166,6 +186,8 @@ type Schema struct {
 	BlockMaxRows     uint64         `json:"blkrows"`
 	PrimaryKey       int            `json:"primarykey"`
 	SegmentMaxBlocks uint64         `json:"segblocks"`
+	// Version increases every time the columns are altered
+	Version uint64 `json:"version,omitempty"`
 }
 
 func NewEmptySchema(name string) *Schema {
@@ -212,6 +234,9 @@ func (s *Schema) Valid() bool {
 		if idx != colDef.Idx {
 			return false
 		}
+		if colDef.Dropped {
+			continue
+		}
 		_, ok := names[colDef.Name]
 		if ok {
 			return false
@@ -221,6 +246,57 @@ func (s *Schema) Valid() bool {
 	return true
 }
 
+// Alter returns the next version of schema whose columns are cols. The
+// columns are matched by Idx: the existing columns missing in cols are
+// dropped and the columns whose Idx is not used yet are appended, in order.
+// The existing columns can be renamed but their types can not be changed.
+func (s *Schema) Alter(cols []*ColDef) (*Schema, error) {
+	if len(cols) == 0 {
+		return nil, ErrInvalidSchema
+	}
+	next := *s
+	next.Version++
+	next.ColDefs = make([]*ColDef, len(s.ColDefs))
+	next.NameIndex = make(map[string]int)
+	kept := make([]bool, len(s.ColDefs))
+	for i, def := range s.ColDefs {
+		colDef := *def
+		next.ColDefs[i] = &colDef
+	}
+	for _, col := range cols {
+		switch {
+		case col.Idx < len(s.ColDefs):
+			colDef := next.ColDefs[col.Idx]
+			if colDef.Dropped || kept[col.Idx] || colDef.Type != col.Type {
+				return nil, ErrInvalidSchema
+			}
+			colDef.Name = col.Name
+			kept[col.Idx] = true
+		case col.Idx == len(next.ColDefs):
+			colDef := *col
+			colDef.Dropped = false
+			next.ColDefs = append(next.ColDefs, &colDef)
+		default:
+			return nil, ErrInvalidSchema
+		}
+	}
+	for i, ok := range kept {
+		if !ok {
+			next.ColDefs[i].Dropped = true
+		}
+	}
+	for _, colDef := range next.ColDefs {
+		if colDef.Dropped {
+			continue
+		}
+		if _, ok := next.NameIndex[colDef.Name]; ok {
+			return nil, ErrInvalidSchema
+		}
+		next.NameIndex[colDef.Name] = colDef.Idx
+	}
+	return &next, nil
+}
+
 // GetColIdx returns column index for the given column name
 // if found, otherwise returns -1.
 func (s *Schema) GetColIdx(attr string) int {
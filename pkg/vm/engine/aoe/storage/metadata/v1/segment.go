@@ -32,7 +32,8 @@ type segmentLogEntry struct {
 	*BaseEntry
 	DatabaseId uint64
 	TableId    uint64
-	Catalog    *Catalog `json:"-"`
+	Catalog    *Catalog        `json:"-"`
+	Deletes    *SegmentDeletes `json:",omitempty"`
 }
 
 func (e *segmentLogEntry) Marshal() ([]byte, error) {
@@ -373,6 +374,7 @@ func (e *Segment) GetCoarseSizeLocked() int64 {
 }
 
 func (e *Segment) prepareUpgrade(ctx *upgradeSegmentCtx) (LogEntry, error) {
+	deletes := e.Table.GetDeletes()
 	e.RLock()
 	if !e.HasMaxBlocks() {
 		e.RUnlock()
@@ -405,13 +407,34 @@ func (e *Segment) prepareUpgrade(ctx *upgradeSegmentCtx) (LogEntry, error) {
 	if ctx.exIndice != nil {
 		cInfo.LogIndex = ctx.exIndice[0]
 	}
+	if err := deletes.CanUpgrade(e.Id); err != nil {
+		return nil, err
+	}
 	if err := e.onCommit(cInfo); err != nil {
 		return nil, err
 	}
-	logEntry := e.Table.Database.Catalog.prepareCommitEntry(e, ETUpgradeSegment, e)
+	entry := &upgradeSegmentEntry{Segment: e, deletes: deletes}
+	logEntry := e.Table.Database.Catalog.prepareCommitEntry(entry, ETUpgradeSegment, e)
 	return logEntry, nil
 }
 
+// upgradeSegmentEntry moves the deleted rows of the segment to the layout
+// of the sorted segment in the commit of its upgrade.
+type upgradeSegmentEntry struct {
+	*Segment
+	deletes *TableDeletes
+}
+
+func (e *upgradeSegmentEntry) ToLogEntry(eType LogEntryType) LogEntry {
+	entry := e.toLogEntry(nil)
+	entry.Deletes = e.deletes.upgradeSegment(e.Id)
+	buf, _ := entry.Marshal()
+	logEntry := logstore.NewAsyncBaseEntry()
+	logEntry.Meta.SetType(eType)
+	logEntry.Unmarshal(buf)
+	return logEntry
+}
+
 func (e *Segment) DryUpgrade(size int64) {
 	e.CommitInfo.Op = OpUpgradeSorted
 	e.CommitInfo.Size = size
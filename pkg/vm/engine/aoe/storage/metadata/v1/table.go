@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/logstore"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/wal/shard"
@@ -48,9 +49,14 @@ type Table struct {
 	IdIndex           map[uint64]int `json:"-"`
 	Database          *Database      `json:"-"`
 	FlushTS           int64          `json:"-"`
+	Deletes           *TableDeletes  `json:"deletes,omitempty"`
 	rowCount          uint64
+	changedTs         uint64
 }
 
+// startTs bounds the changes of the tables made before the process started
+var startTs = engine.Now()
+
 func NewTableEntry(db *Database, schema *Schema, indice *IndexSchema, tranId uint64, exIndex *LogIndex) *Table {
 	schema.BlockMaxRows = db.Catalog.Cfg.BlockMaxRows
 	schema.SegmentMaxBlocks = db.Catalog.Cfg.SegmentMaxBlocks
@@ -113,6 +119,26 @@ func (e *Table) GetRowCount() uint64 {
 	return atomic.LoadUint64(&e.rowCount)
 }
 
+// SetChanged records that the rows of the table are changed at ts.
+func (e *Table) SetChanged(ts uint64) {
+	for {
+		last := atomic.LoadUint64(&e.changedTs)
+		if ts <= last || atomic.CompareAndSwapUint64(&e.changedTs, last, ts) {
+			return
+		}
+	}
+}
+
+// GetChangedTs returns the timestamp of the last change of the rows of the
+// table. The changes made before the process started are not tracked, they
+// are taken to be made when it started.
+func (e *Table) GetChangedTs() uint64 {
+	if ts := atomic.LoadUint64(&e.changedTs); ts > startTs {
+		return ts
+	}
+	return startTs
+}
+
 func (e *Table) GetIndexSchemaLocked() *IndexSchema {
 	return e.CommitInfo.Indice
 }
@@ -433,6 +459,121 @@ func (e *Table) prepareDropIndice(ctx *dropIndiceCtx) (LogEntry, error) {
 	return logEntry, nil
 }
 
+// SimpleAlterSchema replaces the schema of table with schema, which is the
+// next version of it returned by Schema.Alter
+func (e *Table) SimpleAlterSchema(schema *Schema, index *LogIndex) error {
+	tranId := e.Database.Catalog.NextUncommitId()
+	ctx := new(alterSchemaCtx)
+	ctx.tranId = tranId
+	ctx.table = e
+	ctx.schema = schema
+	ctx.exIndex = index
+	return e.Database.Catalog.onCommitRequest(ctx, true)
+}
+
+func (e *Table) prepareAlterSchema(ctx *alterSchemaCtx) (LogEntry, error) {
+	cInfo := &CommitInfo{
+		TranId:   ctx.tranId,
+		CommitId: ctx.tranId,
+		LogIndex: ctx.exIndex,
+		Op:       OpAlterSchema,
+		SSLLNode: *common.NewSSLLNode(),
+	}
+	e.Lock()
+	if e.IsDeletedLocked() {
+		e.Unlock()
+		return nil, ErrTableNotFound
+	}
+	// the schema is altered by another one since it is built
+	if ctx.schema.Version != e.Schema.Version+1 {
+		e.Unlock()
+		return nil, ErrCommitStale
+	}
+	cInfo.Indice = e.CommitInfo.Indice
+	err := e.onCommit(cInfo)
+	if err == nil {
+		e.Schema = ctx.schema
+	}
+	e.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	logEntry := e.Database.Catalog.prepareCommitEntry(e, ETAlterSchema, nil)
+	return logEntry, nil
+}
+
+// Safe
+func (e *Table) GetDeletes() *TableDeletes {
+	e.RLock()
+	deletes := e.Deletes
+	e.RUnlock()
+	if deletes != nil {
+		return deletes
+	}
+	e.Lock()
+	defer e.Unlock()
+	return e.getDeletesLocked()
+}
+
+func (e *Table) getDeletesLocked() *TableDeletes {
+	if e.Deletes == nil {
+		e.Deletes = newTableDeletes()
+	}
+	return e.Deletes
+}
+
+// SimpleDeleteRows deletes the rows at ts, which versions the deletion
+// unless it is 0.
+func (e *Table) SimpleDeleteRows(deletes []SegmentDeletes, index *LogIndex, ts uint64) error {
+	tranId := e.Database.Catalog.NextUncommitId()
+	ctx := new(deleteRowsCtx)
+	ctx.tranId = tranId
+	ctx.table = e
+	ctx.deletes = deletes
+	ctx.exIndex = index
+	ctx.ts = ts
+	return e.Database.Catalog.onCommitRequest(ctx, true)
+}
+
+func (e *Table) prepareDeleteRows(ctx *deleteRowsCtx) (LogEntry, error) {
+	e.Lock()
+	if e.IsDeletedLocked() {
+		e.Unlock()
+		return nil, ErrTableNotFound
+	}
+	deletes := e.getDeletesLocked()
+	e.Unlock()
+	if deletes.IsApplied(ctx.exIndex) {
+		return nil, ErrIdempotence
+	}
+	entry := &deleteRowsLogEntry{
+		DatabaseId: e.Database.Id,
+		TableId:    e.Id,
+		LogIndex:   ctx.exIndex,
+		Segments:   ctx.deletes,
+		Ts:         ctx.ts,
+		deletes:    deletes,
+	}
+	logEntry := e.Database.Catalog.prepareCommitEntry(entry, ETDeleteRows, nil)
+	return logEntry, nil
+}
+
+func (e *Table) toDeleteRowsLogEntry() *deleteRowsLogEntry {
+	e.RLock()
+	deletes := e.Deletes
+	e.RUnlock()
+	if deletes == nil || deletes.isEmpty() {
+		return nil
+	}
+	segments, index := deletes.toSegmentDeletes()
+	return &deleteRowsLogEntry{
+		DatabaseId: e.Database.Id,
+		TableId:    e.Id,
+		LogIndex:   index,
+		Segments:   segments,
+	}
+}
+
 // Not safe
 func (e *Table) Marshal() ([]byte, error) {
 	return json.Marshal(e)
@@ -479,6 +620,13 @@ func (e *Table) ToLogEntry(eType LogEntryType) LogEntry {
 			DatabaseId: e.Database.Id,
 		}
 		buf, _ = entry.Marshal()
+	case ETAlterSchema:
+		entry := tableLogEntry{
+			Table:      &Table{Schema: e.Schema},
+			BaseEntry:  e.BaseEntry,
+			DatabaseId: e.Database.Id,
+		}
+		buf, _ = entry.Marshal()
 	case ETSoftDeleteTable:
 		if !e.IsSoftDeletedLocked() {
 			panic("logic error")
@@ -724,6 +872,9 @@ func (e *Table) Splite(catalog *Catalog, tranId uint64, splitSpec *TableSplitSpe
 		}
 		splitSpec.SegmentTrace[*osid] = nsid
 		logutil.Infof("[Trace] %s -> %s", osid.SegmentString(), nsid.SegmentString())
+		if e.Deletes != nil {
+			e.Deletes.moveSegment(osid.SegmentID, table.getDeletesLocked(), segment.Id)
+		}
 	}
 }
 
@@ -60,6 +60,7 @@ const (
 	OpUpgradeSorted
 	OpAddIndice
 	OpDropIndice
+	OpAlterSchema
 	OpSoftDelete
 	OpReplaced
 	OpHardDelete
@@ -75,6 +76,7 @@ var OpNames = map[OpT]string{
 	OpHardDelete:    "HardDelete",
 	OpAddIndice:     "AddIndice",
 	OpDropIndice:    "DropIndice",
+	OpAlterSchema:   "AlterSchema",
 }
 
 func OpName(op OpT) string {
@@ -129,11 +129,12 @@ func (n *MutableBlockNode) Flush() error {
 		return nil
 	}
 	defer n.flushCond.Release(currSize)
-	cols := len(n.Meta.Segment.Table.Schema.ColDefs)
+	// the columns added after the block is loaded are not in the data yet
+	cols := len(n.Data.GetAttrs())
 	attrs := make([]int, cols)
 	vecs := make([]vector.IVector, cols)
 	var err error
-	for i, _ := range n.Meta.Segment.Table.Schema.ColDefs {
+	for i := 0; i < cols; i++ {
 		attrs[i] = i
 		vec, err := n.Data.GetVectorByAttr(i)
 		if err != nil {
@@ -198,6 +199,49 @@ func (n *MutableBlockNode) unload() {
 	}
 }
 
+// AddColumns adds the columns added to the schema after the data is loaded,
+// the rows of data read the fill values of them.
+func (n *MutableBlockNode) AddColumns() error {
+	n.Lock()
+	defer n.Unlock()
+	if !n.IsLoaded() {
+		return nil
+	}
+	schema := n.Meta.Segment.Table.Schema
+	cols := len(n.Data.GetAttrs())
+	if cols >= len(schema.ColDefs) {
+		return nil
+	}
+	attrs := make([]int, len(schema.ColDefs))
+	vecs := make([]vector.IVector, len(schema.ColDefs))
+	for i, colDef := range schema.ColDefs {
+		attrs[i] = i
+		if i < cols {
+			vec, err := n.Data.GetVectorByAttr(i)
+			if err != nil {
+				return err
+			}
+			vecs[i] = vec
+			continue
+		}
+		fill, err := colDef.FillVector(n.Data.Length())
+		if err != nil {
+			return err
+		}
+		vec := vector.NewVector(colDef.Type, schema.BlockMaxRows)
+		if _, err = vec.AppendVector(fill, 0); err != nil {
+			return err
+		}
+		vecs[i] = vec
+	}
+	data, err := batch.NewBatch(attrs, vecs)
+	if err != nil {
+		return err
+	}
+	n.Data = data
+	return nil
+}
+
 func (n *MutableBlockNode) GetData() batch.IBatch {
 	return n.Data
 }
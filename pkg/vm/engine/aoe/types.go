@@ -2,10 +2,47 @@ package aoe
 
 import (
 	"bytes"
+	"encoding/binary"
+	"errors"
+
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
 
+const (
+	// HideKey is the name of hidden attribute which identifies a row of table,
+	// its value is generated by the block reader, see EncodeRowId.
+	HideKey = "__mo_rowid"
+	// RowIdSize is the size of the value of hidden key
+	RowIdSize = 24
+	// TimestampKey is the name of hidden uint64 attribute which versions the rows
+	// of table, its value is the timestamp when the row is written. The batch of
+	// deleted rows has it as the timestamp of the deletion.
+	TimestampKey = "__mo_ts"
+)
+
+var ErrInvalidRowId = errors.New("invalid row id")
+
+// EncodeRowId appends the row id to buf, it is made of the shard id of the
+// tablet, the id of the segment and the position of the row in the segment.
+// The segment id is only known by the replica which reads it, the replicas
+// of the tablet find the row by values if it does not match.
+func EncodeRowId(buf []byte, shardId, segmentId, pos uint64) []byte {
+	var rid [RowIdSize]byte
+	binary.BigEndian.PutUint64(rid[0:], shardId)
+	binary.BigEndian.PutUint64(rid[8:], segmentId)
+	binary.BigEndian.PutUint64(rid[16:], pos)
+	return append(buf, rid[:]...)
+}
+
+// DecodeRowId returns the shard id, the segment id and the position of row.
+func DecodeRowId(rid []byte) (uint64, uint64, uint64, error) {
+	if len(rid) != RowIdSize {
+		return 0, 0, 0, ErrInvalidRowId
+	}
+	return binary.BigEndian.Uint64(rid[0:]), binary.BigEndian.Uint64(rid[8:]), binary.BigEndian.Uint64(rid[16:]), nil
+}
+
 type Segment interface {
 	engine.Statistics
 
@@ -21,6 +58,7 @@ type Block interface {
 	ID() string
 	Prefetch([]string)
 	Read([]uint64, []string, []*bytes.Buffer, []*bytes.Buffer) (*batch.Batch, error) // read only arguments
+	ReadAt(uint64, []uint64, []string, []*bytes.Buffer, []*bytes.Buffer) (*batch.Batch, error) // read the rows visible at the timestamp
 }
 
 type Store interface {
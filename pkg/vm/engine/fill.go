@@ -0,0 +1,170 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"encoding/gob"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+func init() {
+	// types of the default values, they are encoded with the table definitions
+	gob.Register(types.Date(0))
+	gob.Register(types.Datetime(0))
+	gob.Register(types.Decimal{})
+}
+
+// FillVector returns a vector of n rows whose values are all fill, it is the
+// column of the rows written before the attribute is added.
+func FillVector(typ types.Type, fill DefaultExpr, n int) (*vector.Vector, error) {
+	vec := vector.New(typ)
+	var v interface{}
+	if fill.Exist {
+		if fill.IsNull {
+			for i := 0; i < n; i++ {
+				nulls.Add(vec.Nsp, uint64(i))
+			}
+		} else {
+			v = fill.Value
+		}
+	}
+	switch typ.Oid {
+	case types.T_int8:
+		vs := make([]int8, n)
+		for i := range vs {
+			vs[i] = int8(fillInt(v))
+		}
+		vec.Col = vs
+	case types.T_int16:
+		vs := make([]int16, n)
+		for i := range vs {
+			vs[i] = int16(fillInt(v))
+		}
+		vec.Col = vs
+	case types.T_int32:
+		vs := make([]int32, n)
+		for i := range vs {
+			vs[i] = int32(fillInt(v))
+		}
+		vec.Col = vs
+	case types.T_int64:
+		vs := make([]int64, n)
+		for i := range vs {
+			vs[i] = fillInt(v)
+		}
+		vec.Col = vs
+	case types.T_uint8:
+		vs := make([]uint8, n)
+		for i := range vs {
+			vs[i] = uint8(fillInt(v))
+		}
+		vec.Col = vs
+	case types.T_uint16:
+		vs := make([]uint16, n)
+		for i := range vs {
+			vs[i] = uint16(fillInt(v))
+		}
+		vec.Col = vs
+	case types.T_uint32:
+		vs := make([]uint32, n)
+		for i := range vs {
+			vs[i] = uint32(fillInt(v))
+		}
+		vec.Col = vs
+	case types.T_uint64:
+		vs := make([]uint64, n)
+		for i := range vs {
+			vs[i] = uint64(fillInt(v))
+		}
+		vec.Col = vs
+	case types.T_float32:
+		vs := make([]float32, n)
+		for i := range vs {
+			vs[i] = float32(fillFloat(v))
+		}
+		vec.Col = vs
+	case types.T_float64:
+		vs := make([]float64, n)
+		for i := range vs {
+			vs[i] = fillFloat(v)
+		}
+		vec.Col = vs
+	case types.T_date:
+		d, _ := v.(types.Date)
+		vs := make([]types.Date, n)
+		for i := range vs {
+			vs[i] = d
+		}
+		vec.Col = vs
+	case types.T_datetime:
+		d, _ := v.(types.Datetime)
+		vs := make([]types.Datetime, n)
+		for i := range vs {
+			vs[i] = d
+		}
+		vec.Col = vs
+	case types.T_decimal:
+		d, _ := v.(types.Decimal)
+		vs := make([]types.Decimal, n)
+		for i := range vs {
+			vs[i] = d
+		}
+		vec.Col = vs
+	case types.T_char, types.T_varchar:
+		s, _ := v.(string)
+		vs := make([][]byte, n)
+		for i := range vs {
+			vs[i] = []byte(s)
+		}
+		if err := vec.Col.(*types.Bytes).Append(vs); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupport type %s for default value", typ)
+	}
+	return vec, nil
+}
+
+// fillInt converts the default value of integer column
+func fillInt(v interface{}) int64 {
+	switch x := v.(type) {
+	case int64:
+		return x
+	case uint64:
+		return int64(x)
+	case float64:
+		return int64(x)
+	}
+	return 0
+}
+
+// fillFloat converts the default value of float column
+func fillFloat(v interface{}) float64 {
+	switch x := v.(type) {
+	case float32:
+		return float64(x)
+	case float64:
+		return x
+	case int64:
+		return float64(x)
+	case uint64:
+		return float64(x)
+	}
+	return 0
+}
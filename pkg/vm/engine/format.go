@@ -33,12 +33,13 @@ func (node *Attribute) Format(buf *bytes.Buffer) {
 	buf.WriteByte(' ')
 	buf.WriteString(node.Type.String())
 
-	if node.Type.Width > 0 && node.Type.Precision > 0 {
+	if node.Type.Width > 0 && (node.Type.Precision > 0 || node.Type.Oid == types.T_decimal) {
 		buf.WriteString("(")
 		str := fmt.Sprintf("%d", node.Type.Width)
 		buf.WriteString(str)
 		buf.WriteString(", ")
 		str = fmt.Sprintf("%d", node.Type.Precision)
+		buf.WriteString(str)
 		buf.WriteByte(')')
 	} else if node.Type.Width > 0 {
 		buf.WriteString("(")
@@ -110,6 +111,9 @@ func makeVal2Str(typ types.Type, value interface{}, isNull bool) string {
 	case types.T_date:
 		res := value.(types.Date).String()
 		return res
+	case types.T_decimal:
+		res := value.(types.Decimal).Format(types.DecimalScale(typ))
+		return res
 	}
 	return "NULL"
 }
\ No newline at end of file
@@ -37,6 +37,7 @@ func (d *database) Create(_ uint64, name string, defs []engine.TableDef) error {
 		switch d := def.(type) {
 		case *engine.AttributeDef:
 			md.Attrs = append(md.Attrs, d.Attr)
+			md.Cols = append(md.Cols, meta.Column{Key: d.Attr.Name})
 		case *engine.IndexTableDef:
 			md.Index = append(md.Index, engine.IndexTableDef{Typ: d.Typ, ColNames: d.ColNames, Name: d.Name})
 		}
@@ -8,4 +8,28 @@ type Metadata struct {
 	Name  string
 	Attrs []engine.Attribute
 	Index []engine.IndexTableDef
+	// Dels records the row ids of deleted rows
+	Dels []uint64
+	// Vers records the timestamps of writes, Vers[i] is the timestamp of the i-th segment.
+	// The segments written before the timestamps are recorded have none, which are
+	// visible at any time.
+	Vers []uint64
+	// DelVers records the timestamps of deletes, DelVers[i] is the timestamp of Dels[i]
+	DelVers []uint64
+	// Ts is the timestamp of the last change of relation
+	Ts uint64
+	// Version is the version of schema, it increases when the attributes are changed
+	Version uint64
+	// Cols records the storage of attributes, Cols[i] is the storage of Attrs[i]
+	Cols []Column
+	// Stats is the statistics collected by the last ANALYZE TABLE, nil if never analyzed
+	Stats *engine.StatisticsDef
+}
+
+// Column is the storage of an attribute, the segments before Seg are written
+// before the attribute is added and the attribute of their rows is Fill.
+type Column struct {
+	Key  string // key of the attribute in segment, it does not change when the attribute is renamed
+	Seg  int64
+	Fill engine.DefaultExpr
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
 
 func (r *reader) Read(cs []uint64, attrs []string) (*batch.Batch, error) {
@@ -16,54 +17,86 @@ func (r *reader) Read(cs []uint64, attrs []string) (*batch.Batch, error) {
 	{
 		if len(r.cds) == 0 {
 			r.cds = make([]*bytes.Buffer, len(attrs))
-			r.dds = make([]*bytes.Buffer, len(attrs))
 			for i := range attrs {
 				r.cds[i] = bytes.NewBuffer(make([]byte, 0, 1024))
-				r.dds[i] = bytes.NewBuffer(make([]byte, 0, 1024))
 			}
 		}
 	}
+	for len(r.segs) > 0 {
+		bat, err := r.readSegment(cs, attrs)
+		if err != nil {
+			return nil, err
+		}
+		if len(bat.Zs) > 0 {
+			return bat, nil
+		}
+	}
+	return nil, nil
+}
+
+// readSegment reads the next segment, the deleted rows of segment are removed
+// and the hidden key is generated from segment number and offset of each row.
+func (r *reader) readSegment(cs []uint64, attrs []string) (*batch.Batch, error) {
 	bat := batch.New(true, attrs)
-	id := r.segs[0]
-	r.segs = r.segs[1:]
+	id, seg := r.segs[0], r.ids[0]
+	r.segs, r.ids = r.segs[1:], r.ids[1:]
+	hidden := -1
+	var fills []int // attributes added after the segment is written
 	for i, attr := range attrs {
-		md := r.attrs[attr]
-		if md.Alg == compress.None {
-			data, err := r.db.Get(id+"."+attr, r.dds[i])
-			if err != nil {
-				return nil, err
-			}
-			bat.Vecs[i] = vector.New(md.Type)
-			if err := bat.Vecs[i].Read(data); err != nil {
-				return nil, err
-			}
-			bat.Vecs[i].Or = true
-			bat.Vecs[i].Ref = cs[i]
-		} else {
-			data, err := r.db.Get(id+"."+attr, r.cds[i])
-			if err != nil {
-				return nil, err
-			}
-			bat.Vecs[i] = vector.New(md.Type)
-			n := int(encoding.DecodeInt32(data[len(data)-4:]))
-			r.dds[i].Reset()
-			if n > r.dds[i].Cap() {
-				r.dds[i].Grow(n)
-			}
-			buf := r.dds[i].Bytes()[:n]
-			_, err = compress.Decompress(data[:len(data)-4], buf, int(md.Alg))
-			if err != nil {
-				return nil, err
+		if attr == HideKey {
+			hidden = i
+			continue
+		}
+		if seg < r.cols[attr].Seg {
+			fills = append(fills, i)
+			continue
+		}
+		vec, err := r.readVector(id+"."+r.cols[attr].Key, r.attrs[attr], r.cds[i])
+		if err != nil {
+			return nil, err
+		}
+		bat.Vecs[i] = vec
+		bat.Vecs[i].Or = true
+		bat.Vecs[i].Ref = cs[i]
+	}
+	n, err := r.length(id, seg, bat)
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range fills {
+		col := r.cols[attrs[i]]
+		vec, err := engine.FillVector(r.attrs[attrs[i]].Type, col.Fill, n)
+		if err != nil {
+			return nil, err
+		}
+		bat.Vecs[i] = vec
+		bat.Vecs[i].Or = true
+		bat.Vecs[i].Ref = cs[i]
+	}
+	if hidden >= 0 {
+		rows := make([]uint64, n)
+		for i := range rows {
+			rows[i] = rowId(seg, i)
+		}
+		bat.Vecs[hidden] = vector.New(r.attrs[HideKey].Type)
+		bat.Vecs[hidden].Col = rows
+		bat.Vecs[hidden].Or = true
+		bat.Vecs[hidden].Ref = cs[hidden]
+	}
+	if len(r.dels) > 0 {
+		sels := make([]int64, 0, n)
+		for i := 0; i < n; i++ {
+			if _, ok := r.dels[rowId(seg, i)]; !ok {
+				sels = append(sels, int64(i))
 			}
-			data = buf[:n]
-			if err := bat.Vecs[i].Read(data); err != nil {
-				return nil, err
+		}
+		if len(sels) < n {
+			for _, vec := range bat.Vecs {
+				vector.Shrink(vec, sels)
 			}
-			bat.Vecs[i].Or = true
-			bat.Vecs[i].Ref = cs[i]
+			n = len(sels)
 		}
 	}
-	n := vector.Length(bat.Vecs[0])
 	if n > cap(r.zs) {
 		r.zs = make([]int64, n*2)
 	}
@@ -73,3 +106,56 @@ func (r *reader) Read(cs []uint64, attrs []string) (*batch.Batch, error) {
 	}
 	return bat, nil
 }
+
+// readVector reads the column of segment, the data of vector is not reused
+// because the batches returned before may still be held by the operators.
+func (r *reader) readVector(key string, md engine.Attribute, cd *bytes.Buffer) (*vector.Vector, error) {
+	vec := vector.New(md.Type)
+	if md.Alg == compress.None {
+		data, err := r.db.Get(key, bytes.NewBuffer(nil))
+		if err != nil {
+			return nil, err
+		}
+		if err := vec.Read(data); err != nil {
+			return nil, err
+		}
+		return vec, nil
+	}
+	data, err := r.db.Get(key, cd)
+	if err != nil {
+		return nil, err
+	}
+	n := int(encoding.DecodeInt32(data[len(data)-4:]))
+	buf := make([]byte, n)
+	if _, err = compress.Decompress(data[:len(data)-4], buf, int(md.Alg)); err != nil {
+		return nil, err
+	}
+	if err := vec.Read(buf); err != nil {
+		return nil, err
+	}
+	return vec, nil
+}
+
+// length returns the number of rows of segment
+func (r *reader) length(id string, seg int64, bat *batch.Batch) (int, error) {
+	for _, vec := range bat.Vecs {
+		if vec != nil {
+			return vector.Length(vec), nil
+		}
+	}
+	for name, md := range r.attrs {
+		if name == HideKey || seg < r.cols[name].Seg {
+			continue
+		}
+		vec, err := r.readVector(id+"."+r.cols[name].Key, md, bytes.NewBuffer(nil))
+		if err != nil {
+			return 0, err
+		}
+		return vector.Length(vec), nil
+	}
+	return 0, nil
+}
+
+func rowId(seg int64, row int) uint64 {
+	return uint64(seg)<<32 | uint64(row)
+}
@@ -1,13 +1,16 @@
 package memEngine
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine/meta"
 
 	"github.com/pierrec/lz4"
 )
@@ -44,34 +47,106 @@ func (r *relation) TableDefs() []engine.TableDef {
 		defs[j] = &index
 		j++
 	}
+	if r.md.Stats != nil {
+		defs = append(defs, r.md.Stats)
+	}
 	return defs
 }
 
+func (r *relation) GetPrimaryKeys() []*engine.Attribute {
+	var attrs []*engine.Attribute
+	for i, attr := range r.md.Attrs {
+		if attr.Primary {
+			attrs = append(attrs, &r.md.Attrs[i])
+		}
+	}
+	return attrs
+}
+
+func (r *relation) GetHideKey() *engine.Attribute {
+	return &engine.Attribute{
+		Name: HideKey,
+		Alg:  compress.None,
+		Type: types.Type{Oid: types.T_uint64, Size: 8},
+	}
+}
+
 func (r *relation) NewReader(n int, _ extend.Extend, _ []byte) []engine.Reader {
-	segs := make([]string, r.md.Segs)
+	dels := make(map[uint64]struct{}, len(r.md.Dels))
+	for _, id := range r.md.Dels {
+		dels[id] = struct{}{}
+	}
+	return r.newReaders(n, r.md.Segs, dels)
+}
+
+// NewSnapshotReader returns the readers of the segments written before ts, and the
+// rows deleted after ts are not removed.
+func (r *relation) NewSnapshotReader(ts uint64, n int, _ extend.Extend, _ []byte) []engine.Reader {
+	var segs int64
+	for segs < r.md.Segs && (segs >= int64(len(r.md.Vers)) || r.md.Vers[segs] <= ts) {
+		segs++
+	}
+	dels := make(map[uint64]struct{}, len(r.md.Dels))
+	for i, id := range r.md.Dels {
+		if i >= len(r.md.DelVers) || r.md.DelVers[i] <= ts {
+			dels[id] = struct{}{}
+		}
+	}
+	return r.newReaders(n, segs, dels)
+}
+
+// ChangedSince checks the metadata stored, which may have been changed after the
+// relation is opened.
+func (r *relation) ChangedSince(ts uint64) (bool, error) {
+	var md meta.Metadata
+
+	data, err := r.db.Get(r.id, bytes.NewBuffer(nil))
+	if err != nil {
+		return false, err
+	}
+	if err := encoding.Decode(data, &md); err != nil {
+		return false, err
+	}
+	return md.Ts > ts, nil
+}
+
+// newReaders returns n readers of the first cnt segments without the rows of dels.
+func (r *relation) newReaders(n int, cnt int64, dels map[uint64]struct{}) []engine.Reader {
+	segs := make([]string, cnt)
+	ids := make([]int64, cnt)
 	for i := range segs {
 		segs[i] = sKey(i, r.id)
+		ids[i] = int64(i)
 	}
 	attrs := make(map[string]engine.Attribute)
+	cols := make(map[string]meta.Column)
 	{
 		for i, attr := range r.md.Attrs {
 			attrs[attr.Name] = r.md.Attrs[i]
+			cols[attr.Name] = r.md.Cols[i]
 		}
+		attrs[HideKey] = *r.GetHideKey()
 	}
 	rs := make([]engine.Reader, n)
-	if int64(n) < r.md.Segs {
-		step := int(r.md.Segs) / n
+	if int64(n) < cnt {
+		step := int(cnt) / n
 		for i := 0; i < n; i++ {
 			if i == n-1 {
 				rs[i] = &reader{
 					db:    r.db,
+					dels:  dels,
 					attrs: attrs,
+					cols:  cols,
+					ids:   ids[i*step:],
 					segs:  segs[i*step:],
 				}
 			} else {
 				rs[i] = &reader{
 					db:    r.db,
+					dels:  dels,
 					attrs: attrs,
+					cols:  cols,
+					ids:   ids[i*step : (i+1)*step],
 					segs:  segs[i*step : (i+1)*step],
 				}
 			}
@@ -80,7 +155,10 @@ func (r *relation) NewReader(n int, _ extend.Extend, _ []byte) []engine.Reader {
 		for i := range segs {
 			rs[i] = &reader{
 				db:    r.db,
+				dels:  dels,
 				attrs: attrs,
+				cols:  cols,
+				ids:   ids[i : i+1],
 				segs:  segs[i : i+1],
 			}
 		}
@@ -91,14 +169,27 @@ func (r *relation) NewReader(n int, _ extend.Extend, _ []byte) []engine.Reader {
 	return rs
 }
 
+// Write appends the batch as a new segment of the relation,
+// the batch marked by Zs = {-1, -1} is the set of rows to be deleted.
 func (r *relation) Write(_ uint64, bat *batch.Batch) error {
+	if len(bat.Zs) == 2 && bat.Zs[0] == -1 && bat.Zs[1] == -1 {
+		return r.delete(bat)
+	}
 	key := sKey(int(r.md.Segs), r.id)
 	for i, attr := range bat.Attrs {
+		if attr == HideKey {
+			continue
+		}
+		j := getAttribute(&r.md, attr)
+		if j < 0 {
+			return fmt.Errorf("unknown column '%s'", attr)
+		}
+		md := r.md.Attrs[j]
 		v, err := bat.Vecs[i].Show()
 		if err != nil {
 			return err
 		}
-		if r.md.Attrs[i].Alg == compress.Lz4 {
+		if md.Alg == compress.Lz4 {
 			data := make([]byte, lz4.CompressBlockBound(len(v)))
 			if data, err = compress.Compress(v, data, compress.Lz4); err != nil {
 				return err
@@ -106,21 +197,58 @@ func (r *relation) Write(_ uint64, bat *batch.Batch) error {
 			data = append(data, encoding.EncodeInt32(int32(len(v)))...)
 			v = data
 		}
-		if err := r.db.Set(key+"."+attr, v); err != nil {
+		if err := r.db.Set(key+"."+r.md.Cols[j].Key, v); err != nil {
 			return err
 		}
 	}
-	{
-		r.md.Segs++
-		data, err := encoding.Encode(r.md)
-		if err != nil {
-			return err
-		}
-		if err := r.db.Set(r.id, data); err != nil {
-			return err
+	ts := engine.Now()
+	r.md.Vers = append(pad(r.md.Vers, int(r.md.Segs)), ts)
+	r.md.Ts = ts
+	r.md.Segs++
+	return r.save()
+}
+
+// delete records the row ids of the batch as deleted rows
+func (r *relation) delete(bat *batch.Batch) error {
+	vec := batch.GetVector(bat, HideKey)
+	if vec == nil {
+		return fmt.Errorf("delete from '%s' without column '%s'", r.id, HideKey)
+	}
+	ts := engine.Now()
+	r.md.DelVers = pad(r.md.DelVers, len(r.md.Dels))
+	for _, id := range vec.Col.([]uint64) {
+		r.md.Dels = append(r.md.Dels, id)
+		r.md.DelVers = append(r.md.DelVers, ts)
+	}
+	r.md.Ts = ts
+	return r.save()
+}
+
+// pad appends zeros to the timestamps vs until it has n timestamps.
+func pad(vs []uint64, n int) []uint64 {
+	for len(vs) < n {
+		vs = append(vs, 0)
+	}
+	return vs
+}
+
+// save writes the metadata of relation
+func (r *relation) save() error {
+	data, err := encoding.Encode(r.md)
+	if err != nil {
+		return err
+	}
+	return r.db.Set(r.id, data)
+}
+
+// getAttribute returns the index of attribute, -1 if it does not exist
+func getAttribute(md *meta.Metadata, name string) int {
+	for i, attr := range md.Attrs {
+		if attr.Name == name {
+			return i
 		}
 	}
-	return nil
+	return -1
 }
 
 func (r *relation) CreateIndex(_ uint64, _ []engine.TableDef) error {
@@ -131,12 +259,147 @@ func (r *relation) DropIndex(epoch uint64, name string) error {
 	return nil
 }
 
-func (r *relation) AddTableDef(_ uint64, _ engine.TableDef) error {
-	return nil
+// AddTableDef changes the schema online, the segments are not rewritten when an
+// attribute is added, the rows of segments written before it read the default value.
+func (r *relation) AddTableDef(_ uint64, def engine.TableDef) error {
+	switch d := def.(type) {
+	case *engine.SchemaChangeDef:
+		return r.changeSchema(d.Changes)
+	case *engine.StatisticsDef:
+		r.md.Stats = d
+		return r.save()
+	}
+	return r.changeSchema([]engine.SchemaChange{{Def: def}})
 }
 
-func (r *relation) DelTableDef(_ uint64, _ engine.TableDef) error {
-	return nil
+// DelTableDef drops the attribute and its data in segments
+func (r *relation) DelTableDef(_ uint64, def engine.TableDef) error {
+	return r.changeSchema([]engine.SchemaChange{{Drop: true, Def: def}})
+}
+
+// changeSchema applies the changes to a copy of the metadata, the relation
+// is changed only if all of them are valid.
+func (r *relation) changeSchema(changes []engine.SchemaChange) error {
+	md := r.md
+	md.Attrs = append([]engine.Attribute{}, r.md.Attrs...)
+	md.Cols = append([]meta.Column{}, r.md.Cols...)
+	if r.md.Stats != nil {
+		stats := *r.md.Stats
+		stats.Columns = append([]engine.ColumnStatistics{}, r.md.Stats.Columns...)
+		md.Stats = &stats
+	}
+	name := r.id
+	var drops []meta.Column
+	for _, change := range changes {
+		if change.Drop {
+			d, ok := change.Def.(*engine.AttributeDef)
+			if !ok {
+				return fmt.Errorf("unsupported table definition '%T'", change.Def)
+			}
+			i := getAttribute(&md, d.Attr.Name)
+			if i < 0 {
+				return fmt.Errorf("unknown column '%s'", d.Attr.Name)
+			}
+			drops = append(drops, md.Cols[i])
+			md.Version++
+			md.Attrs = append(md.Attrs[:i], md.Attrs[i+1:]...)
+			md.Cols = append(md.Cols[:i], md.Cols[i+1:]...)
+			if md.Stats != nil {
+				cols := md.Stats.Columns[:0]
+				for _, col := range md.Stats.Columns {
+					if col.Name != d.Attr.Name {
+						cols = append(cols, col)
+					}
+				}
+				md.Stats.Columns = cols
+			}
+			continue
+		}
+		switch d := change.Def.(type) {
+		case *engine.AttributeDef:
+			if getAttribute(&md, d.Attr.Name) >= 0 {
+				return fmt.Errorf("column '%s' already exists", d.Attr.Name)
+			}
+			md.Version++
+			md.Attrs = append(md.Attrs, d.Attr)
+			md.Cols = append(md.Cols, meta.Column{
+				Key:  fmt.Sprintf("%s@%v", d.Attr.Name, md.Version),
+				Seg:  md.Segs,
+				Fill: d.Attr.Default,
+			})
+		case *engine.RenameColumnDef:
+			i := getAttribute(&md, d.Name)
+			if i < 0 {
+				return fmt.Errorf("unknown column '%s'", d.Name)
+			}
+			if getAttribute(&md, d.NewName) >= 0 {
+				return fmt.Errorf("column '%s' already exists", d.NewName)
+			}
+			md.Version++
+			md.Attrs[i].Name = d.NewName
+			if md.Stats != nil {
+				if col := md.Stats.Column(d.Name); col != nil {
+					col.Name = d.NewName
+				}
+			}
+		case *engine.ColumnDefaultDef:
+			i := getAttribute(&md, d.Name)
+			if i < 0 {
+				return fmt.Errorf("unknown column '%s'", d.Name)
+			}
+			md.Attrs[i].Default = d.Default
+		case *engine.RenameTableDef:
+			if _, err := r.db.Get(d.Name, bytes.NewBuffer(nil)); err == nil {
+				return fmt.Errorf("table '%s' already exists", d.Name)
+			}
+			name = d.Name
+		default:
+			return fmt.Errorf("unsupported table definition '%T'", change.Def)
+		}
+	}
+	md.Ts = engine.Now()
+	r.md = md
+	for _, col := range drops {
+		for seg := col.Seg; seg < r.md.Segs; seg++ {
+			if err := r.db.Del(sKey(int(seg), r.id) + "." + col.Key); err != nil {
+				return err
+			}
+		}
+	}
+	if name != r.id {
+		return r.rename(name)
+	}
+	return r.save()
+}
+
+// rename moves the metadata and segments of relation to the new name
+func (r *relation) rename(name string) error {
+	if _, err := r.db.Get(name, bytes.NewBuffer(nil)); err == nil {
+		return fmt.Errorf("table '%s' already exists", name)
+	}
+	for seg := int64(0); seg < r.md.Segs; seg++ {
+		for _, col := range r.md.Cols {
+			if seg < col.Seg {
+				continue
+			}
+			key := sKey(int(seg), r.id) + "." + col.Key
+			v, err := r.db.Get(key, bytes.NewBuffer(nil))
+			if err != nil {
+				return err
+			}
+			if err := r.db.Set(sKey(int(seg), name)+"."+col.Key, v); err != nil {
+				return err
+			}
+			if err := r.db.Del(key); err != nil {
+				return err
+			}
+		}
+	}
+	if err := r.db.Del(r.id); err != nil {
+		return err
+	}
+	r.id = name
+	return r.save()
 }
 
 func sKey(num int, id string) string {
@@ -8,6 +8,10 @@ import (
 )
 
 // standalone memory engine
+// HideKey is the name of hidden attribute which identifies a row,
+// its value is generated by reader from the segment number and the offset of row.
+const HideKey = "__mo_rowid"
+
 type memEngine struct {
 	db *kv.KV
 	n  engine.Node
@@ -29,7 +33,9 @@ type reader struct {
 	zs    []int64
 	db    *kv.KV
 	segs  []string
+	ids   []int64             // number of segments, used to generate row id
+	dels  map[uint64]struct{} // row ids of deleted rows
 	cds   []*bytes.Buffer
-	dds   []*bytes.Buffer
 	attrs map[string]engine.Attribute
+	cols  map[string]meta.Column
 }
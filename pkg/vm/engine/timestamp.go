@@ -0,0 +1,48 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrSnapshotTooOld is returned by the snapshot readers if the versions of rows
+// visible at the timestamp have been discarded.
+var ErrSnapshotTooOld = errors.New("snapshot is too old, try restarting transaction")
+
+// SnapshotRetention is how long the versions of rows are kept by the snapshot
+// relations, the snapshots older than it can not be read.
+var SnapshotRetention = time.Hour
+
+var clock struct {
+	sync.Mutex
+	last uint64
+}
+
+// Now returns the timestamp of now in nanoseconds, which versions the writes of
+// the snapshot relations. The timestamps are strictly increasing in the process,
+// and follow the physical clock so that the timestamps of nodes are comparable.
+func Now() uint64 {
+	clock.Lock()
+	defer clock.Unlock()
+	ts := uint64(time.Now().UnixNano())
+	if ts <= clock.last {
+		ts = clock.last + 1
+	}
+	clock.last = ts
+	return ts
+}
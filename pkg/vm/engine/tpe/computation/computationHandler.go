@@ -39,6 +39,12 @@ type ComputationHandler interface {
 
 	GetTable(dbId uint64, name string) (*descriptor.RelationDesc, error)
 
+	CreateIndex(epoch, dbId uint64, tableDesc *descriptor.RelationDesc, indexDesc *descriptor.IndexDesc) error
+
+	DropIndex(epoch, dbId uint64, tableDesc *descriptor.RelationDesc, name string) error
+
+	AlterTable(epoch, dbId uint64, tableDesc *descriptor.RelationDesc) error
+
 	Read(readCtx interface{}) (*batch.Batch, error)
 
 	Write(writeCtx interface{}, bat *batch.Batch) error
@@ -47,6 +53,11 @@ type ComputationHandler interface {
 
 	GetNodesHoldTheTable(dbId uint64, desc *descriptor.RelationDesc) (engine.Nodes, interface{}, error)
 
+	EncodeIndexRange(dbId uint64, desc *descriptor.RelationDesc, indexDesc *descriptor.IndexDesc, indexRange interface{}) ([]byte, []byte)
+
+	// ChangedSince checks if the table has been written after ts.
+	ChangedSince(dbId uint64, desc *descriptor.RelationDesc, ts uint64) (bool, error)
+
 	ParallelReader() bool
 
 	MultiNode() bool
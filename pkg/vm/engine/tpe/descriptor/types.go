@@ -49,6 +49,9 @@ type RelationDesc struct {
 
 	Next_attribute_id uint32 `json:"next_attribute_id,string"`
 
+	//Version increases every time the attributes are altered
+	Version uint64 `json:"version,string"`
+
 	Attributes []AttributeDesc `json:"attributes"`
 
 	IDependsOnRelations []uint32 `json:"i_depends_on_relations,string"`
@@ -124,6 +127,10 @@ type AttributeDesc struct {
 
 	Default_value string `json:"default_value"`
 
+	//Fill is the serialized value of the rows written before
+	//the attribute is added. It is empty if the attribute is created with the table.
+	Fill []byte `json:"fill,omitempty"`
+
 	Is_hidden bool `json:"is_hidden,string"`
 
 	Is_auto_increment bool `json:"is_auto_increment,string"`
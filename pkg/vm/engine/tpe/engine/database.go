@@ -223,6 +223,22 @@ func (td *TpeDatabase) Create(epoch uint64, name string, defs []engine.TableDef)
 		}
 	}
 
+	tableDesc.Next_attribute_id = uint32(columnIdx)
+
+	//secondary indexes
+	tableDesc.Next_index_id = tuplecodec.PrimaryIndexID + 1
+	for _, def := range defs {
+		if indexDef, ok := def.(*engine.IndexTableDef); ok {
+			indexDesc, err := makeIndexDesc(tableDesc, indexDef)
+			if err != nil {
+				return err
+			}
+			indexDesc.ID = tableDesc.Next_index_id
+			tableDesc.Next_index_id++
+			tableDesc.Indexes = append(tableDesc.Indexes, *indexDesc)
+		}
+	}
+
 	//create table
 	_, err := td.computeHandler.CreateTable(epoch, td.id, tableDesc)
 	if err != nil {
@@ -0,0 +1,360 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"bytes"
+	"errors"
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tpe/descriptor"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tpe/tuplecodec"
+)
+
+var (
+	errorIndexAttributeDoesNotExist = errors.New("index attribute does not exist in the relation")
+	errorIndexWithoutAttributes     = errors.New("index without attributes")
+)
+
+// makeIndexDesc makes the secondary index on the columns of the def.
+// The attributes of the primary key are the implicit attributes of the index.
+func makeIndexDesc(tableDesc *descriptor.RelationDesc, def *engine.IndexTableDef) (*descriptor.IndexDesc, error) {
+	if len(def.ColNames) == 0 {
+		return nil, errorIndexWithoutAttributes
+	}
+	indexDesc := &descriptor.IndexDesc{
+		Name:                def.Name,
+		Impilict_attributes: tableDesc.Primary_index.Attributes,
+	}
+	//the index is named after its first column by default
+	if len(indexDesc.Name) == 0 {
+		indexDesc.Name = def.ColNames[0]
+	}
+	for _, name := range def.ColNames {
+		attrDesc := findAttributeDesc(tableDesc, name)
+		if attrDesc == nil {
+			return nil, errorIndexAttributeDoesNotExist
+		}
+		indexDesc.Attributes = append(indexDesc.Attributes, descriptor.IndexDesc_Attribute{
+			Name:      attrDesc.Name,
+			Direction: 0,
+			ID:        attrDesc.ID,
+			Type:      attrDesc.Ttype,
+			TypesType: attrDesc.TypesType,
+		})
+	}
+	return indexDesc, nil
+}
+
+func findAttributeDesc(tableDesc *descriptor.RelationDesc, name string) *descriptor.AttributeDesc {
+	for i, attr := range tableDesc.Attributes {
+		if attr.Name == name {
+			return &tableDesc.Attributes[i]
+		}
+	}
+	return nil
+}
+
+// chooseRange picks the index to be scanned and its range.
+// The primary index with the point range is the best. The secondary index
+// with the equality is preferred to the range of the primary index.
+func (trel *TpeRelation) chooseRange(conds []tuplecodec.Condition) (*descriptor.IndexDesc, *tuplecodec.IndexRange) {
+	if len(conds) == 0 {
+		return nil, nil
+	}
+	ranges := collectRanges(conds)
+
+	primary := &trel.desc.Primary_index
+	primaryRange := rangeOfIndex(primary.Attributes, ranges)
+	if primaryRange != nil && len(primaryRange.Prefix) == len(primary.Attributes) {
+		return primary, primaryRange
+	}
+
+	var indexDesc *descriptor.IndexDesc
+	var indexRange *tuplecodec.IndexRange
+	for i, index := range trel.desc.Indexes {
+		r := rangeOfIndex(index.Attributes, ranges)
+		if r == nil {
+			continue
+		}
+		if indexRange == nil || len(r.Prefix) > len(indexRange.Prefix) {
+			indexDesc, indexRange = &trel.desc.Indexes[i], r
+		}
+	}
+	if primaryRange != nil && (indexRange == nil || len(indexRange.Prefix) == 0) {
+		return primary, primaryRange
+	}
+	return indexDesc, indexRange
+}
+
+// rangeOfIndex makes the range on the attributes of the index.
+// The leading attributes with the equality make the prefix and
+// the range of the attribute after them bounds the rest.
+func rangeOfIndex(attrs []descriptor.IndexDesc_Attribute, ranges map[uint32]*tuplecodec.IndexRange) *tuplecodec.IndexRange {
+	indexRange := &tuplecodec.IndexRange{}
+	for _, attr := range attrs {
+		r, ok := ranges[attr.ID]
+		if !ok {
+			break
+		}
+		if isPointRange(r) {
+			indexRange.Prefix = append(indexRange.Prefix, r.Low)
+			continue
+		}
+		indexRange.Low, indexRange.High = r.Low, r.High
+		indexRange.LowInclusive, indexRange.HighInclusive = r.LowInclusive, r.HighInclusive
+		indexRange.BytesPrefix = r.BytesPrefix
+		break
+	}
+	if len(indexRange.Prefix) == 0 && indexRange.Low == nil &&
+		indexRange.High == nil && indexRange.BytesPrefix == nil {
+		return nil
+	}
+	return indexRange
+}
+
+func isPointRange(r *tuplecodec.IndexRange) bool {
+	return r.Low != nil && r.High != nil && r.LowInclusive && r.HighInclusive
+}
+
+// collectRanges gets the ranges of the attributes from the conditions.
+// Every condition is a superset of the result, so the range from any of them is right.
+func collectRanges(conds []tuplecodec.Condition) map[uint32]*tuplecodec.IndexRange {
+	ranges := make(map[uint32]*tuplecodec.IndexRange)
+	for _, cond := range conds {
+		r, ok := ranges[cond.AttributeID]
+		if !ok {
+			r = &tuplecodec.IndexRange{}
+		}
+		switch cond.Op {
+		case tuplecodec.CompareEQ:
+			r.Low, r.High = cond.Value, cond.Value
+			r.LowInclusive, r.HighInclusive = true, true
+		case tuplecodec.CompareLT, tuplecodec.CompareLE:
+			if r.High == nil {
+				r.High, r.HighInclusive = cond.Value, cond.Op == tuplecodec.CompareLE
+			}
+		case tuplecodec.CompareGT, tuplecodec.CompareGE:
+			if r.Low == nil {
+				r.Low, r.LowInclusive = cond.Value, cond.Op == tuplecodec.CompareGE
+			}
+		case tuplecodec.CompareHasPrefix:
+			if r.BytesPrefix == nil {
+				r.BytesPrefix = cond.Value.([]byte)
+			}
+		default:
+			continue
+		}
+		ranges[cond.AttributeID] = r
+	}
+	return ranges
+}
+
+// collectConditions gets the comparisons between the attribute and the constant
+// from the conjunction. The other parts of the filter are left to the computation.
+func collectConditions(tableDesc *descriptor.RelationDesc, ext extend.Extend, conds []tuplecodec.Condition) []tuplecodec.Condition {
+	switch e := ext.(type) {
+	case *extend.ParenExtend:
+		return collectConditions(tableDesc, e.E, conds)
+	case *extend.BinaryExtend:
+		if e.Op == overload.And {
+			conds = collectConditions(tableDesc, e.Left, conds)
+			return collectConditions(tableDesc, e.Right, conds)
+		}
+		op := e.Op
+		attr, ok := e.Left.(*extend.Attribute)
+		val, ok2 := e.Right.(*extend.ValueExtend)
+		if !ok || !ok2 {
+			attr, ok = e.Right.(*extend.Attribute)
+			val, ok2 = e.Left.(*extend.ValueExtend)
+			if !ok || !ok2 || op == overload.Like {
+				return conds
+			}
+			op = flipComparison(op)
+		}
+		attrDesc := findAttributeDesc(tableDesc, attr.Name)
+		if attrDesc == nil {
+			return conds
+		}
+		if op == overload.Like {
+			prefix, ok := prefixOfPattern(val.V, attrDesc.TypesType)
+			if !ok {
+				return conds
+			}
+			return append(conds, tuplecodec.Condition{
+				AttributeID: attrDesc.ID,
+				Op:          tuplecodec.CompareHasPrefix,
+				Value:       prefix,
+			})
+		}
+		compareOp, ok := compareOps[op]
+		if !ok {
+			return conds
+		}
+		value, ok := constantOfAttribute(val.V, attrDesc.TypesType)
+		if !ok {
+			return conds
+		}
+		return append(conds, tuplecodec.Condition{
+			AttributeID: attrDesc.ID,
+			Op:          compareOp,
+			Value:       value,
+		})
+	}
+	return conds
+}
+
+var compareOps = map[int]tuplecodec.CompareOp{
+	overload.EQ: tuplecodec.CompareEQ,
+	overload.NE: tuplecodec.CompareNE,
+	overload.LT: tuplecodec.CompareLT,
+	overload.LE: tuplecodec.CompareLE,
+	overload.GT: tuplecodec.CompareGT,
+	overload.GE: tuplecodec.CompareGE,
+}
+
+// prefixOfPattern gets the literal prefix of the like pattern.
+func prefixOfPattern(vec *vector.Vector, typ types.Type) ([]byte, bool) {
+	if typ.Oid != types.T_char && typ.Oid != types.T_varchar {
+		return nil, false
+	}
+	if vec.Typ.Oid != types.T_char && vec.Typ.Oid != types.T_varchar {
+		return nil, false
+	}
+	if vector.Length(vec) != 1 || nulls.Any(vec.Nsp) {
+		return nil, false
+	}
+	pattern := vec.Col.(*types.Bytes).Get(0)
+	n := bytes.IndexAny(pattern, "%_\\")
+	if n == -1 {
+		n = len(pattern)
+	}
+	if n == 0 {
+		return nil, false
+	}
+	prefix := make([]byte, n)
+	copy(prefix, pattern[:n])
+	return prefix, true
+}
+
+func flipComparison(op int) int {
+	switch op {
+	case overload.LT:
+		return overload.GT
+	case overload.LE:
+		return overload.GE
+	case overload.GT:
+		return overload.LT
+	case overload.GE:
+		return overload.LE
+	}
+	return op
+}
+
+// constantOfAttribute converts the constant into the value of the attribute type
+// which is encoded in the key.
+func constantOfAttribute(vec *vector.Vector, typ types.Type) (interface{}, bool) {
+	if vector.Length(vec) != 1 || nulls.Any(vec.Nsp) {
+		return nil, false
+	}
+	var i int64
+	var u uint64
+	var f float64
+	var isInt, isUint, isFloat bool
+	switch vec.Typ.Oid {
+	case types.T_int8:
+		i, isInt = int64(vec.Col.([]int8)[0]), true
+	case types.T_int16:
+		i, isInt = int64(vec.Col.([]int16)[0]), true
+	case types.T_int32:
+		i, isInt = int64(vec.Col.([]int32)[0]), true
+	case types.T_int64:
+		i, isInt = vec.Col.([]int64)[0], true
+	case types.T_uint8:
+		u, isUint = uint64(vec.Col.([]uint8)[0]), true
+	case types.T_uint16:
+		u, isUint = uint64(vec.Col.([]uint16)[0]), true
+	case types.T_uint32:
+		u, isUint = uint64(vec.Col.([]uint32)[0]), true
+	case types.T_uint64:
+		u, isUint = vec.Col.([]uint64)[0], true
+	case types.T_float32:
+		f, isFloat = float64(vec.Col.([]float32)[0]), true
+	case types.T_float64:
+		f, isFloat = vec.Col.([]float64)[0], true
+	case types.T_char, types.T_varchar:
+		if typ.Oid != types.T_char && typ.Oid != types.T_varchar {
+			return nil, false
+		}
+		return string(vec.Col.(*types.Bytes).Get(0)), true
+	case types.T_date:
+		if typ.Oid != types.T_date {
+			return nil, false
+		}
+		return vec.Col.([]types.Date)[0], true
+	case types.T_datetime:
+		if typ.Oid != types.T_datetime {
+			return nil, false
+		}
+		return vec.Col.([]types.Datetime)[0], true
+	default:
+		return nil, false
+	}
+
+	if isUint {
+		if u > math.MaxInt64 {
+			if typ.Oid == types.T_uint64 {
+				return u, true
+			}
+			return nil, false
+		}
+		i, isInt = int64(u), true
+	}
+	switch typ.Oid {
+	case types.T_int8:
+		return int8(i), isInt && i >= math.MinInt8 && i <= math.MaxInt8
+	case types.T_int16:
+		return int16(i), isInt && i >= math.MinInt16 && i <= math.MaxInt16
+	case types.T_int32:
+		return int32(i), isInt && i >= math.MinInt32 && i <= math.MaxInt32
+	case types.T_int64:
+		return i, isInt
+	case types.T_uint8:
+		return uint8(i), isInt && i >= 0 && i <= math.MaxUint8
+	case types.T_uint16:
+		return uint16(i), isInt && i >= 0 && i <= math.MaxUint16
+	case types.T_uint32:
+		return uint32(i), isInt && i >= 0 && i <= math.MaxUint32
+	case types.T_uint64:
+		return uint64(i), isInt && i >= 0
+	case types.T_float32:
+		if isInt {
+			return float32(i), true
+		}
+		return float32(f), isFloat
+	case types.T_float64:
+		if isInt {
+			return float64(i), true
+		}
+		return f, isFloat
+	}
+	return nil, false
+}
@@ -62,16 +62,16 @@ func (tr *TpeReader) Read(refCnts []uint64, attrs []string) (*batch.Batch, error
 		return nil, errorMismatchRefcntWithAttributeCnt
 	}
 
-	attrSet := make(map[string]uint32)
-	for _, tableAttr := range tr.tableDesc.Attributes {
-		attrSet[tableAttr.Name] = tableAttr.ID
+	attrSet := make(map[string]int)
+	for i, tableAttr := range tr.tableDesc.Attributes {
+		attrSet[tableAttr.Name] = i
 	}
 
 	//check if the attribute is in the relation
 	var readAttrs []*descriptor.AttributeDesc
 	for _, attr := range attrs {
-		if attrID, exist := attrSet[attr]; exist {
-			readAttrs = append(readAttrs, &tr.tableDesc.Attributes[attrID])
+		if attrIdx, exist := attrSet[attr]; exist {
+			readAttrs = append(readAttrs, &tr.tableDesc.Attributes[attrIdx])
 		} else {
 			return nil, errorSomeAttributeNamesAreNotInAttributeDesc
 		}
@@ -81,18 +81,31 @@ func (tr *TpeReader) Read(refCnts []uint64, attrs []string) (*batch.Batch, error
 	var err error
 
 	if tr.readCtx == nil {
+		indexDesc := &tr.tableDesc.Primary_index
+		if tr.indexDesc != nil {
+			indexDesc = tr.indexDesc
+		}
 		tr.readCtx = &tuplecodec.ReadContext{
 			DbDesc:              tr.dbDesc,
 			TableDesc:           tr.tableDesc,
-			IndexDesc:           &tr.tableDesc.Primary_index,
+			IndexDesc:           indexDesc,
+			IndexRange:          tr.indexRange,
+			Filter:              tr.filter,
 			ReadAttributesNames: attrs,
 			ReadAttributeDescs:  readAttrs,
 			ParallelReader:      tr.parallelReader,
 			MultiNode:           tr.multiNode,
 			ReadCount:           0,
+			Snapshot:            tr.snapshot,
 		}
 
 		if tr.readCtx.ParallelReader || tr.readCtx.MultiNode {
+			if tr.snapshot != 0 {
+				for _, info := range tr.shardInfos {
+					tr.readCtx.SnapshotRanges = append(tr.readCtx.SnapshotRanges,
+						tuplecodec.KeyRange{Start: info.startKey, End: info.endKey})
+				}
+			}
 			tr.readCtx.ParallelReaderContext = tuplecodec.ParallelReaderContext{
 				ID:                   tr.id,
 				ShardIndex:           0,
@@ -135,7 +148,7 @@ func (tr *TpeReader) Read(refCnts []uint64, attrs []string) (*batch.Batch, error
 				tr.readCtx.ParallelReaderContext,
 			)
 			//update new shard if needed
-			if tr.readCtx.CompleteInShard {
+			if tr.readCtx.CompleteInShard && !tr.readCtx.ReadingDeletedRows {
 				tr.shardInfos[tr.readCtx.ShardIndex].completeInShard = true
 				shardIdx := tr.readCtx.ShardIndex
 				shardIdx++
@@ -153,6 +166,9 @@ func (tr *TpeReader) Read(refCnts []uint64, attrs []string) (*batch.Batch, error
 						tr.shardInfos[tr.readCtx.ShardIndex],
 						tr.readCtx.ParallelReaderContext,
 					)
+				} else if tr.snapshot != 0 {
+					//the rows deleted after the snapshot are read after all shards
+					tr.readCtx.ReadingDeletedRows = true
 				} else {
 					return nil, nil
 				}
@@ -164,6 +180,13 @@ func (tr *TpeReader) Read(refCnts []uint64, attrs []string) (*batch.Batch, error
 	if err != nil {
 		return nil, err
 	}
+	if bat == nil && tr.snapshot != 0 && !tr.readCtx.ReadingDeletedRows {
+		tr.readCtx.ReadingDeletedRows = true
+		bat, err = tr.computeHandler.Read(tr.readCtx)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	//for test
 	if tr.readCtx.ParallelReader && tr.multiNode && tr.printBatch {
@@ -368,6 +391,18 @@ func (tr *TpeReader) Read(refCnts []uint64, attrs []string) (*batch.Batch, error
 								row[i] = vs[rowIndex]
 							}
 						}
+					case types.T_decimal:
+						if !nulls.Any(vec.Nsp) { //all data in this column are not null
+							vs := vec.Col.([]types.Decimal)
+							row[i] = vs[rowIndex]
+						} else {
+							if nulls.Contains(vec.Nsp, uint64(rowIndex)) { //is null
+								row[i] = nil
+							} else {
+								vs := vec.Col.([]types.Decimal)
+								row[i] = vs[rowIndex]
+							}
+						}
 					default:
 						logutil.Errorf("reader.Read : unsupported type %d \n", vec.Typ.Oid)
 						return nil, fmt.Errorf("reader.Read : unsupported type %d \n", vec.Typ.Oid)
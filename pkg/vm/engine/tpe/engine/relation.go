@@ -15,6 +15,7 @@
 package engine
 
 import (
+	"bytes"
 	"errors"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
@@ -30,6 +31,9 @@ var (
 	errorNotHiddenPrimaryKey                   = errors.New("it is not hidden primary key")
 	errorDuplicateAttributeNameInBatch         = errors.New("duplicate attribute name in the batch")
 	errorDoNotGetValidValueForTheAttribute     = errors.New("can not get the value for the attribute")
+	errorAttributeDoesNotExist                 = errors.New("attribute does not exist in the relation")
+	errorCanNotDropThePrimaryKey               = errors.New("can not drop the attribute of the primary key")
+	errorCanNotDropTheOnlyAttribute            = errors.New("can not drop the only attribute of the relation")
 )
 
 func (trel *TpeRelation) Rows() int64 {
@@ -67,14 +71,28 @@ func (trel *TpeRelation) Nodes() engine.Nodes {
 }
 
 func (trel *TpeRelation) CreateIndex(epoch uint64, defs []engine.TableDef) error {
-	panic("implement me")
+	for _, def := range defs {
+		indexDef, ok := def.(*engine.IndexTableDef)
+		if !ok {
+			return errorUnsupportedTableDef
+		}
+		indexDesc, err := makeIndexDesc(trel.desc, indexDef)
+		if err != nil {
+			return err
+		}
+		err = trel.computeHandler.CreateIndex(epoch, uint64(trel.dbDesc.ID), trel.desc, indexDesc)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (trel *TpeRelation) DropIndex(epoch uint64, name string) error {
-	panic("implement me")
+	return trel.computeHandler.DropIndex(epoch, uint64(trel.dbDesc.ID), trel.desc, name)
 }
 
-func (trel *TpeRelation) GetHideColDef() *engine.Attribute {
+func (trel *TpeRelation) GetHideKey() *engine.Attribute {
 	for _, attr := range trel.desc.Attributes {
 		if attr.Is_hidden {
 			return &engine.Attribute{
@@ -88,6 +106,22 @@ func (trel *TpeRelation) GetHideColDef() *engine.Attribute {
 	return nil
 }
 
+func (trel *TpeRelation) GetPrimaryKeys() []*engine.Attribute {
+	var attrs []*engine.Attribute
+	for _, attr := range trel.desc.Attributes {
+		if !attr.Is_hidden && attr.Is_primarykey {
+			attrs = append(attrs, &engine.Attribute{
+				Name:    attr.Name,
+				Alg:     0,
+				Type:    attr.TypesType,
+				Default: attr.Default,
+				Primary: true,
+			})
+		}
+	}
+	return attrs
+}
+
 func (trel *TpeRelation) TableDefs() []engine.TableDef {
 	var defs []engine.TableDef
 	var pkNames []string
@@ -114,6 +148,19 @@ func (trel *TpeRelation) TableDefs() []engine.TableDef {
 		})
 	}
 
+	for _, index := range trel.desc.Indexes {
+		var colNames []string
+		for _, attr := range index.Attributes {
+			colNames = append(colNames, attr.Name)
+		}
+		//the plain index is planned as the zonemap
+		defs = append(defs, &engine.IndexTableDef{
+			Typ:      engine.ZoneMap,
+			ColNames: colNames,
+			Name:     index.Name,
+		})
+	}
+
 	if len(trel.desc.Comment) != 0 {
 		defs = append(defs, &engine.CommentDef{Comment: trel.desc.Comment})
 	}
@@ -122,9 +169,9 @@ func (trel *TpeRelation) TableDefs() []engine.TableDef {
 
 func (trel *TpeRelation) Write(_ uint64, batch *batch.Batch) error {
 	//attribute set
-	attrSet := make(map[string]uint32)
-	for _, attr := range trel.desc.Attributes {
-		attrSet[attr.Name] = attr.ID
+	attrSet := make(map[string]int)
+	for i, attr := range trel.desc.Attributes {
+		attrSet[attr.Name] = i
 	}
 
 	//check if the attribute in the batch exists in the relation or not.
@@ -137,8 +184,8 @@ func (trel *TpeRelation) Write(_ uint64, batch *batch.Batch) error {
 			batchAttrSet[batchAttrName] = posInBatch
 		}
 
-		if _, ok := attrSet[batchAttrName]; ok {
-			attrDescs = append(attrDescs, trel.desc.Attributes[posInBatch])
+		if attrIdx, ok := attrSet[batchAttrName]; ok {
+			attrDescs = append(attrDescs, trel.desc.Attributes[attrIdx])
 		} else {
 			return errorBatchAttributeDoNotExistInTheRelation
 		}
@@ -190,23 +237,173 @@ func (trel *TpeRelation) Write(_ uint64, batch *batch.Batch) error {
 	return nil
 }
 
+// AddTableDef changes the attributes of the relation.
+// The changes of the SchemaChangeDef are applied as one.
 func (trel *TpeRelation) AddTableDef(u uint64, def engine.TableDef) error {
-	panic("implement me")
+	if d, ok := def.(*engine.SchemaChangeDef); ok {
+		return trel.alterTable(u, d.Changes)
+	}
+	return trel.alterTable(u, []engine.SchemaChange{{Def: def}})
 }
 
+// DelTableDef drops the attribute of the relation.
 func (trel *TpeRelation) DelTableDef(u uint64, def engine.TableDef) error {
-	panic("implement me")
+	return trel.alterTable(u, []engine.SchemaChange{{Drop: true, Def: def}})
+}
+
+// alterTable checks all the changes on a copy of the descriptor,
+// then saves it once. The descriptor is not changed if any change is invalid.
+func (trel *TpeRelation) alterTable(epoch uint64, changes []engine.SchemaChange) error {
+	desc, err := alterRelationDesc(trel.desc, changes)
+	if err != nil {
+		return err
+	}
+	err = trel.computeHandler.AlterTable(epoch, uint64(trel.dbDesc.ID), desc)
+	if err != nil {
+		return err
+	}
+	trel.desc = desc
+	return nil
+}
+
+// alterRelationDesc returns a copy of the descriptor changed by the changes.
+// The added attributes get new ids. The ids of the dropped attributes
+// are not reused, because the rows written before still have them.
+func alterRelationDesc(tableDesc *descriptor.RelationDesc, changes []engine.SchemaChange) (*descriptor.RelationDesc, error) {
+	desc := *tableDesc
+	desc.Attributes = append([]descriptor.AttributeDesc{}, tableDesc.Attributes...)
+	desc.Indexes = append([]descriptor.IndexDesc{}, tableDesc.Indexes...)
+	for _, attr := range desc.Attributes {
+		if desc.Next_attribute_id <= attr.ID {
+			desc.Next_attribute_id = attr.ID + 1
+		}
+	}
+
+	for _, change := range changes {
+		if change.Drop {
+			d, ok := change.Def.(*engine.AttributeDef)
+			if !ok {
+				return nil, errorUnsupportedTableDef
+			}
+			attrDesc := findAttributeDesc(&desc, d.Attr.Name)
+			if attrDesc == nil {
+				return nil, errorAttributeDoesNotExist
+			}
+			if attrDesc.Is_primarykey {
+				return nil, errorCanNotDropThePrimaryKey
+			}
+			if len(desc.Attributes) == 1 {
+				return nil, errorCanNotDropTheOnlyAttribute
+			}
+			id := attrDesc.ID
+			var attrs []descriptor.AttributeDesc
+			for _, attr := range desc.Attributes {
+				if attr.ID != id {
+					attrs = append(attrs, attr)
+				}
+			}
+			desc.Attributes = attrs
+			//the indexes on the attribute are dropped with it
+			var indexes []descriptor.IndexDesc
+			for _, index := range desc.Indexes {
+				if _, exist := descriptor.ExtractIndexAttributeIDs(index.Attributes)[id]; !exist {
+					indexes = append(indexes, index)
+				}
+			}
+			desc.Indexes = indexes
+			continue
+		}
+
+		switch d := change.Def.(type) {
+		case *engine.AttributeDef:
+			if findAttributeDesc(&desc, d.Attr.Name) != nil {
+				return nil, errorDuplicateAttributeName
+			}
+			if d.Attr.Primary {
+				return nil, errorUnsupportedTableDef
+			}
+			desc.Attributes = append(desc.Attributes, descriptor.AttributeDesc{
+				ID:            desc.Next_attribute_id,
+				Name:          d.Attr.Name,
+				Ttype:         tuplecodec.EngineTypeToTpeType(&d.Attr.Type),
+				TypesType:     d.Attr.Type,
+				Default:       d.Attr.Default,
+				Is_null:       true,
+				Default_value: "",
+			})
+			desc.Next_attribute_id++
+		case *engine.RenameColumnDef:
+			attrDesc := findAttributeDesc(&desc, d.Name)
+			if attrDesc == nil {
+				return nil, errorAttributeDoesNotExist
+			}
+			if findAttributeDesc(&desc, d.NewName) != nil {
+				return nil, errorDuplicateAttributeName
+			}
+			attrDesc.Name = d.NewName
+			desc.Primary_index.Attributes = renameIndexAttributes(desc.Primary_index.Attributes, d.Name, d.NewName)
+			for i := range desc.Indexes {
+				index := &desc.Indexes[i]
+				index.Attributes = renameIndexAttributes(index.Attributes, d.Name, d.NewName)
+				index.Impilict_attributes = renameIndexAttributes(index.Impilict_attributes, d.Name, d.NewName)
+			}
+		case *engine.ColumnDefaultDef:
+			attrDesc := findAttributeDesc(&desc, d.Name)
+			if attrDesc == nil {
+				return nil, errorAttributeDoesNotExist
+			}
+			attrDesc.Default = d.Default
+		case *engine.RenameTableDef:
+			desc.Name = d.Name
+		default:
+			return nil, errorUnsupportedTableDef
+		}
+	}
+	return &desc, nil
+}
+
+// renameIndexAttributes returns a copy of the attributes with the attribute renamed.
+func renameIndexAttributes(attrs []descriptor.IndexDesc_Attribute, name, newName string) []descriptor.IndexDesc_Attribute {
+	renamed := append([]descriptor.IndexDesc_Attribute{}, attrs...)
+	for i := range renamed {
+		if renamed[i].Name == name {
+			renamed[i].Name = newName
+		}
+	}
+	return renamed
 }
 
-func (trel *TpeRelation) parallelReader(cnt int) []engine.Reader {
+// parallelReader splits the shards into multiple readers.
+// The shards are cut to the key range [startKey,endKey),
+// the nil startKey and endKey mean the whole table.
+func (trel *TpeRelation) parallelReader(cnt int, conds []tuplecodec.Condition, startKey, endKey []byte) []engine.Reader {
 	tcnt := cnt
 	if cnt <= 0 {
 		tcnt = 1
 	}
 	var retReaders []engine.Reader = make([]engine.Reader, cnt)
 	var tpeReaders []*TpeReader = make([]*TpeReader, tcnt)
+	var shardInfos []ShardInfo
+	for _, info := range trel.shardsInThisNode.GetShardInfos() {
+		start, end, ok := intersectKeyRange(info.GetStartKey(), info.GetEndKey(), startKey, endKey)
+		if !ok {
+			continue
+		}
+		shardInfos = append(shardInfos, ShardInfo{
+			startKey:        start,
+			endKey:          end,
+			nextScanKey:     nil,
+			completeInShard: false,
+			shardID:         info.GetShardID(),
+			node: ShardNode{
+				Addr:         info.GetShardNode().Addr,
+				StoreID:      info.GetShardNode().StoreID,
+				StoreIDbytes: info.GetShardNode().StoreIDbytes,
+				Statistics:   info.GetStatistics(),
+			},
+		})
+	}
 	//split shards into multiple readers
-	shardInfos := trel.shardsInThisNode.GetShardInfos()
 	shardInfosCount := len(shardInfos)
 
 	shardCountPerReader := shardInfosCount / tcnt
@@ -225,22 +422,8 @@ func (trel *TpeRelation) parallelReader(cnt int) []engine.Reader {
 	for i := 0; i < len(tpeReaders); i++ {
 		endIndex := tuplecodec.Min(startIndex+shardCountPerReader, shardInfosCount)
 		var infos []ShardInfo
-		for j := startIndex; j < endIndex; j++ {
-			info := shardInfos[j]
-			newInfo := ShardInfo{
-				startKey:        info.GetStartKey(),
-				endKey:          info.GetEndKey(),
-				nextScanKey:     nil,
-				completeInShard: false,
-				shardID:         info.GetShardID(),
-				node: ShardNode{
-					Addr:         info.GetShardNode().Addr,
-					StoreID:      info.GetShardNode().StoreID,
-					StoreIDbytes: info.GetShardNode().StoreIDbytes,
-					Statistics:   info.GetStatistics(),
-				},
-			}
-			infos = append(infos, newInfo)
+		if startIndex < endIndex {
+			infos = shardInfos[startIndex:endIndex]
 		}
 
 		if len(infos) != 0 {
@@ -253,6 +436,7 @@ func (trel *TpeRelation) parallelReader(cnt int) []engine.Reader {
 				isDumpReader:   false,
 				id:             i,
 				storeID:        trel.storeID,
+				filter:         conds,
 			}
 		} else {
 			tpeReaders[i] = &TpeReader{isDumpReader: true, id: i}
@@ -275,11 +459,58 @@ func (trel *TpeRelation) parallelReader(cnt int) []engine.Reader {
 	return retReaders
 }
 
-func (trel *TpeRelation) NewReader(cnt int, _ extend.Extend, _ []byte) []engine.Reader {
+func (trel *TpeRelation) NewReader(cnt int, ext extend.Extend, _ []byte) []engine.Reader {
 	logutil.Infof("newreader cnt %d", cnt)
+	conds := collectConditions(trel.desc, ext, nil)
+	indexDesc, indexRange := trel.chooseRange(conds)
+	//the range is scanned on all shards by one reader.
+	//so it is not used when every node reads its own shards.
+	if indexDesc != nil && !trel.computeHandler.MultiNode() {
+		return trel.rangeReader(cnt, indexDesc, indexRange, conds)
+	}
 	if trel.computeHandler.ParallelReader() || trel.computeHandler.MultiNode() {
-		return trel.parallelReader(cnt)
+		//every node reads the part of the range of the primary index in its own shards
+		var startKey, endKey []byte
+		if indexDesc == &trel.desc.Primary_index {
+			startKey, endKey = trel.computeHandler.EncodeIndexRange(uint64(trel.dbDesc.ID), trel.desc, indexDesc, indexRange)
+			//the point is got from the shard holding it
+			if len(indexRange.Prefix) == len(indexDesc.Attributes) {
+				if trel.holdsKey(startKey) {
+					return trel.rangeReader(cnt, indexDesc, indexRange, conds)
+				}
+				return trel.dumpReaders(cnt)
+			}
+		}
+		return trel.parallelReader(cnt, conds, startKey, endKey)
+	}
+	return trel.singleReader(cnt, conds)
+}
+
+// NewSnapshotReader makes the readers scanning the primary index as it was at ts,
+// the rows deleted after ts are read from the changelog after the primary index.
+func (trel *TpeRelation) NewSnapshotReader(ts uint64, cnt int, ext extend.Extend, _ []byte) []engine.Reader {
+	conds := collectConditions(trel.desc, ext, nil)
+	var readers []engine.Reader
+	if trel.computeHandler.ParallelReader() || trel.computeHandler.MultiNode() {
+		readers = trel.parallelReader(cnt, conds, nil, nil)
+	} else {
+		readers = trel.singleReader(cnt, conds)
+	}
+	for _, reader := range readers {
+		if tr, ok := reader.(*TpeReader); ok && !tr.isDumpReader {
+			tr.snapshot = ts
+		}
 	}
+	return readers
+}
+
+// ChangedSince checks the changelog of the table.
+func (trel *TpeRelation) ChangedSince(ts uint64) (bool, error) {
+	return trel.computeHandler.ChangedSince(uint64(trel.dbDesc.ID), trel.desc, ts)
+}
+
+// singleReader makes the reader that scans all shards.
+func (trel *TpeRelation) singleReader(cnt int, conds []tuplecodec.Condition) []engine.Reader {
 	var readers []engine.Reader = make([]engine.Reader, cnt)
 	tr := &TpeReader{
 		dbDesc:         trel.dbDesc,
@@ -289,6 +520,7 @@ func (trel *TpeRelation) NewReader(cnt int, _ extend.Extend, _ []byte) []engine.
 		isDumpReader:   false,
 		multiNode:      trel.computeHandler.MultiNode(),
 		storeID:        trel.storeID,
+		filter:         conds,
 	}
 	shardInfos := trel.shardsInThisNode.GetShardInfos()
 	for _, info := range shardInfos {
@@ -312,3 +544,57 @@ func (trel *TpeRelation) NewReader(cnt int, _ extend.Extend, _ []byte) []engine.
 	}
 	return readers
 }
+
+// intersectKeyRange cuts the shard [start,end) to the key range [startKey,endKey).
+// The empty start and end mean the shard is unbounded on that side,
+// and the nil startKey and endKey mean the whole table.
+func intersectKeyRange(start, end, startKey, endKey []byte) ([]byte, []byte, bool) {
+	if startKey == nil && endKey == nil {
+		return start, end, true
+	}
+	if bytes.Compare(start, startKey) < 0 {
+		start = startKey
+	}
+	if len(end) == 0 || bytes.Compare(endKey, end) < 0 {
+		end = endKey
+	}
+	return start, end, bytes.Compare(start, end) < 0
+}
+
+// holdsKey checks the key is in the shards of this node.
+func (trel *TpeRelation) holdsKey(key []byte) bool {
+	for _, info := range trel.shardsInThisNode.GetShardInfos() {
+		if bytes.Compare(info.GetStartKey(), key) <= 0 &&
+			(len(info.GetEndKey()) == 0 || bytes.Compare(key, info.GetEndKey()) < 0) {
+			return true
+		}
+	}
+	return false
+}
+
+// dumpReaders makes the readers that read nothing.
+func (trel *TpeRelation) dumpReaders(cnt int) []engine.Reader {
+	var readers []engine.Reader = make([]engine.Reader, cnt)
+	for i := 0; i < cnt; i++ {
+		readers[i] = &TpeReader{isDumpReader: true}
+	}
+	return readers
+}
+
+// rangeReader makes the reader that scans the range of the index.
+func (trel *TpeRelation) rangeReader(cnt int, indexDesc *descriptor.IndexDesc, indexRange *tuplecodec.IndexRange, conds []tuplecodec.Condition) []engine.Reader {
+	var readers []engine.Reader = make([]engine.Reader, cnt)
+	readers[0] = &TpeReader{
+		dbDesc:         trel.dbDesc,
+		tableDesc:      trel.desc,
+		computeHandler: trel.computeHandler,
+		indexDesc:      indexDesc,
+		indexRange:     indexRange,
+		storeID:        trel.storeID,
+		filter:         conds,
+	}
+	for i := 1; i < cnt; i++ {
+		readers[i] = &TpeReader{isDumpReader: true}
+	}
+	return readers
+}
@@ -15,7 +15,11 @@
 package engine
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tpe/tuplecodec"
 	"github.com/smartystreets/goconvey/convey"
@@ -151,3 +155,531 @@ func TestTpeRelation_Write(t *testing.T) {
 		convey.So(err, convey.ShouldBeNil)
 	})
 }
+
+func TestTpeRelation_CreateIndex(t *testing.T) {
+	convey.Convey("create index/index scan/drop index", t, func() {
+		tpe, err := NewTpeEngine(&TpeConfig{
+			KvType:                    tuplecodec.KV_MEMORY,
+			SerialType:                tuplecodec.ST_JSON,
+			ValueLayoutSerializerType: "default",
+			KVLimit:                   10000})
+		convey.So(err, convey.ShouldBeNil)
+		err = tpe.Create(0, "test", 0)
+		convey.So(err, convey.ShouldBeNil)
+
+		dbDesc, err := tpe.Database("test")
+		convey.So(err, convey.ShouldBeNil)
+
+		//(a,b,c)
+		//(uint64,uint64,uint64)
+		//primary key (a)
+		_, attrDefs := tuplecodec.MakeAttributes(types.T_uint64, types.T_uint64, types.T_uint64)
+
+		attrNames := []string{
+			"a", "b", "c",
+		}
+		var defs []engine.TableDef
+		var rawDefs []*engine.AttributeDef
+		for i, def := range attrDefs {
+			def.Attr.Name = attrNames[i]
+			defs = append(defs, def)
+			rawDefs = append(rawDefs, def)
+		}
+		defs[0].(*engine.AttributeDef).Attr.Primary = true
+		defs = append(defs, &engine.PrimaryIndexDef{Names: []string{"a"}})
+
+		err = dbDesc.Create(0, "A", defs)
+		convey.So(err, convey.ShouldBeNil)
+
+		makeBatch := func(start, cnt int) *batch.Batch {
+			bat := tuplecodec.MakeBatch(cnt, attrNames, rawDefs)
+			vec0 := bat.Vecs[0].Col.([]uint64)
+			vec1 := bat.Vecs[1].Col.([]uint64)
+			vec2 := bat.Vecs[2].Col.([]uint64)
+			for i := 0; i < cnt; i++ {
+				vec0[i] = uint64(start + i)
+				vec1[i] = uint64((start + i) % 3)
+				vec2[i] = uint64((start + i) * 10)
+			}
+			return bat
+		}
+
+		relation, err := dbDesc.Relation("A")
+		convey.So(err, convey.ShouldBeNil)
+		err = relation.Write(0, makeBatch(0, 10))
+		convey.So(err, convey.ShouldBeNil)
+
+		//build the index on the existing rows
+		err = relation.CreateIndex(0, []engine.TableDef{&engine.IndexTableDef{
+			Typ:      engine.ZoneMap,
+			ColNames: []string{"b"},
+			Name:     "idx_b",
+		}})
+		convey.So(err, convey.ShouldBeNil)
+
+		relation, err = dbDesc.Relation("A")
+		convey.So(err, convey.ShouldBeNil)
+
+		found := false
+		for _, def := range relation.TableDefs() {
+			if indexDef, ok := def.(*engine.IndexTableDef); ok {
+				convey.So(indexDef.Name, convey.ShouldEqual, "idx_b")
+				convey.So(indexDef.ColNames, convey.ShouldResemble, []string{"b"})
+				found = true
+			}
+		}
+		convey.So(found, convey.ShouldBeTrue)
+
+		//maintain the index on writing
+		err = relation.Write(0, makeBatch(10, 10))
+		convey.So(err, convey.ShouldBeNil)
+
+		constant := func(v int64) extend.Extend {
+			vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+			vec.Col = []int64{v}
+			return &extend.ValueExtend{V: vec}
+		}
+		attrB := &extend.Attribute{Name: "b", Type: types.T_uint64}
+
+		scan := func(ext extend.Extend, attrs []string) [][]uint64 {
+			readers := relation.NewReader(2, ext, nil)
+			rd := readers[0].(*TpeReader)
+			convey.So(rd.indexDesc, convey.ShouldNotBeNil)
+			convey.So(readers[1].(*TpeReader).isDumpReader, convey.ShouldBeTrue)
+
+			refCnts := make([]uint64, len(attrs))
+			rows := make([][]uint64, len(attrs))
+			for {
+				bat, err := rd.Read(refCnts, attrs)
+				convey.So(err, convey.ShouldBeNil)
+				if bat == nil {
+					break
+				}
+				for i, vec := range bat.Vecs {
+					rows[i] = append(rows[i], vec.Col.([]uint64)...)
+				}
+			}
+			return rows
+		}
+
+		//index-only scan
+		rows := scan(&extend.BinaryExtend{Op: overload.EQ, Left: attrB, Right: constant(1)}, []string{"a", "b"})
+		convey.So(rows[0], convey.ShouldResemble, []uint64{1, 4, 7, 10, 13, 16, 19})
+		for _, b := range rows[1] {
+			convey.So(b, convey.ShouldEqual, 1)
+		}
+
+		//index range scan with the lookup of the primary index.
+		//the condition on c is evaluated in decoding.
+		rows = scan(&extend.BinaryExtend{
+			Op:    overload.And,
+			Left:  &extend.BinaryExtend{Op: overload.LE, Left: constant(2), Right: attrB},
+			Right: &extend.BinaryExtend{Op: overload.GT, Left: &extend.Attribute{Name: "c", Type: types.T_uint64}, Right: constant(100)},
+		}, []string{"c", "a"})
+		convey.So(rows[1], convey.ShouldResemble, []uint64{11, 14, 17})
+		for i, a := range rows[1] {
+			convey.So(rows[0][i], convey.ShouldEqual, a*10)
+		}
+
+		//maintain the index on deleting
+		bat := makeBatch(0, 5)
+		bat.Zs = []int64{-1, -1}
+		err = relation.Write(0, bat)
+		convey.So(err, convey.ShouldBeNil)
+
+		rows = scan(&extend.BinaryExtend{Op: overload.EQ, Left: attrB, Right: constant(1)}, []string{"a"})
+		convey.So(rows[0], convey.ShouldResemble, []uint64{7, 10, 13, 16, 19})
+
+		//drop the index
+		err = relation.DropIndex(0, "idx_b")
+		convey.So(err, convey.ShouldBeNil)
+
+		relation, err = dbDesc.Relation("A")
+		convey.So(err, convey.ShouldBeNil)
+		for _, def := range relation.TableDefs() {
+			_, ok := def.(*engine.IndexTableDef)
+			convey.So(ok, convey.ShouldBeFalse)
+		}
+		readers := relation.NewReader(2, &extend.BinaryExtend{Op: overload.EQ, Left: attrB, Right: constant(1)}, nil)
+		convey.So(readers[0].(*TpeReader).indexDesc, convey.ShouldBeNil)
+
+		err = relation.DropIndex(0, "idx_b")
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func TestTpeRelation_NewReaderWithFilter(t *testing.T) {
+	convey.Convey("primary key range/point get/filter in decoding", t, func() {
+		tpe, err := NewTpeEngine(&TpeConfig{
+			KvType:                    tuplecodec.KV_MEMORY,
+			SerialType:                tuplecodec.ST_JSON,
+			ValueLayoutSerializerType: "default",
+			KVLimit:                   10000})
+		convey.So(err, convey.ShouldBeNil)
+		err = tpe.Create(0, "test", 0)
+		convey.So(err, convey.ShouldBeNil)
+
+		dbDesc, err := tpe.Database("test")
+		convey.So(err, convey.ShouldBeNil)
+
+		//(a,c)
+		//(uint64,uint64)
+		//primary key (a)
+		_, attrDefs := tuplecodec.MakeAttributes(types.T_uint64, types.T_uint64)
+
+		attrNames := []string{
+			"a", "c",
+		}
+		var defs []engine.TableDef
+		var rawDefs []*engine.AttributeDef
+		for i, def := range attrDefs {
+			def.Attr.Name = attrNames[i]
+			defs = append(defs, def)
+			rawDefs = append(rawDefs, def)
+		}
+		defs[0].(*engine.AttributeDef).Attr.Primary = true
+		defs = append(defs, &engine.PrimaryIndexDef{Names: []string{"a"}})
+
+		err = dbDesc.Create(0, "A", defs)
+		convey.So(err, convey.ShouldBeNil)
+
+		bat := tuplecodec.MakeBatch(20, attrNames, rawDefs)
+		vec0 := bat.Vecs[0].Col.([]uint64)
+		vec1 := bat.Vecs[1].Col.([]uint64)
+		for i := 0; i < 20; i++ {
+			vec0[i] = uint64(i)
+			vec1[i] = uint64(i * 10)
+		}
+
+		relation, err := dbDesc.Relation("A")
+		convey.So(err, convey.ShouldBeNil)
+		err = relation.Write(0, bat)
+		convey.So(err, convey.ShouldBeNil)
+
+		constant := func(v int64) extend.Extend {
+			vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+			vec.Col = []int64{v}
+			return &extend.ValueExtend{V: vec}
+		}
+		attrA := &extend.Attribute{Name: "a", Type: types.T_uint64}
+		attrC := &extend.Attribute{Name: "c", Type: types.T_uint64}
+		and := func(l, r extend.Extend) extend.Extend {
+			return &extend.BinaryExtend{Op: overload.And, Left: l, Right: r}
+		}
+
+		read := func(rd *TpeReader) []uint64 {
+			var rows []uint64
+			for {
+				bat, err := rd.Read([]uint64{0, 0}, []string{"c", "a"})
+				convey.So(err, convey.ShouldBeNil)
+				if bat == nil {
+					break
+				}
+				cs := bat.Vecs[0].Col.([]uint64)
+				for i, a := range bat.Vecs[1].Col.([]uint64) {
+					convey.So(cs[i], convey.ShouldEqual, a*10)
+					rows = append(rows, a)
+				}
+			}
+			return rows
+		}
+
+		//point get by the primary key
+		readers := relation.NewReader(2, &extend.BinaryExtend{Op: overload.EQ, Left: constant(7), Right: attrA}, nil)
+		rd := readers[0].(*TpeReader)
+		convey.So(rd.indexDesc.ID, convey.ShouldEqual, tuplecodec.PrimaryIndexID)
+		convey.So(rd.indexRange.Prefix, convey.ShouldResemble, []interface{}{uint64(7)})
+		convey.So(read(rd), convey.ShouldResemble, []uint64{7})
+
+		readers = relation.NewReader(2, &extend.BinaryExtend{Op: overload.EQ, Left: attrA, Right: constant(100)}, nil)
+		convey.So(read(readers[0].(*TpeReader)), convey.ShouldBeEmpty)
+
+		//the point does not satisfy the other condition
+		readers = relation.NewReader(2, and(
+			&extend.BinaryExtend{Op: overload.EQ, Left: attrA, Right: constant(7)},
+			&extend.BinaryExtend{Op: overload.GT, Left: attrC, Right: constant(70)}), nil)
+		convey.So(read(readers[0].(*TpeReader)), convey.ShouldBeEmpty)
+
+		//range of the primary key
+		readers = relation.NewReader(2, and(
+			&extend.BinaryExtend{Op: overload.GE, Left: attrA, Right: constant(5)},
+			&extend.BinaryExtend{Op: overload.LT, Left: attrA, Right: constant(9)}), nil)
+		rd = readers[0].(*TpeReader)
+		convey.So(rd.indexRange.Prefix, convey.ShouldBeEmpty)
+		convey.So(read(rd), convey.ShouldResemble, []uint64{5, 6, 7, 8})
+
+		//range of the primary key and the filter in decoding
+		readers = relation.NewReader(2, and(
+			&extend.BinaryExtend{Op: overload.GT, Left: attrA, Right: constant(3)},
+			&extend.BinaryExtend{Op: overload.LT, Left: attrC, Right: constant(80)}), nil)
+		convey.So(read(readers[0].(*TpeReader)), convey.ShouldResemble, []uint64{4, 5, 6, 7})
+
+		//the filter in decoding only
+		readers = relation.NewReader(2, and(
+			&extend.BinaryExtend{Op: overload.GE, Left: attrC, Right: constant(160)},
+			&extend.BinaryExtend{Op: overload.NE, Left: attrC, Right: constant(180)}), nil)
+		rd = readers[0].(*TpeReader)
+		convey.So(rd.indexRange, convey.ShouldBeNil)
+		convey.So(read(rd), convey.ShouldResemble, []uint64{16, 17, 19})
+	})
+}
+
+func TestTpeRelation_AlterTable(t *testing.T) {
+	for _, layout := range []string{"default", "compact"} {
+		convey.Convey("alter table with the "+layout+" value layout", t, func() {
+			tpe, err := NewTpeEngine(&TpeConfig{
+				KvType:                    tuplecodec.KV_MEMORY,
+				SerialType:                tuplecodec.ST_JSON,
+				ValueLayoutSerializerType: layout,
+				KVLimit:                   10000})
+			convey.So(err, convey.ShouldBeNil)
+			err = tpe.Create(0, "test", 0)
+			convey.So(err, convey.ShouldBeNil)
+
+			dbDesc, err := tpe.Database("test")
+			convey.So(err, convey.ShouldBeNil)
+
+			//(a,b,c)
+			//(uint64,uint64,uint64)
+			//primary key (a)
+			_, attrDefs := tuplecodec.MakeAttributes(types.T_uint64, types.T_uint64, types.T_uint64)
+
+			attrNames := []string{
+				"a", "b", "c",
+			}
+			var defs []engine.TableDef
+			var rawDefs []*engine.AttributeDef
+			for i, def := range attrDefs {
+				def.Attr.Name = attrNames[i]
+				defs = append(defs, def)
+				rawDefs = append(rawDefs, def)
+			}
+			defs[0].(*engine.AttributeDef).Attr.Primary = true
+			defs = append(defs, &engine.PrimaryIndexDef{Names: []string{"a"}})
+
+			err = dbDesc.Create(0, "A", defs)
+			convey.So(err, convey.ShouldBeNil)
+
+			makeBatch := func(start, cnt int, names []string) *batch.Batch {
+				bat := tuplecodec.MakeBatch(cnt, names, rawDefs)
+				for i := 0; i < cnt; i++ {
+					bat.Vecs[0].Col.([]uint64)[i] = uint64(start + i)
+					bat.Vecs[1].Col.([]uint64)[i] = uint64(start + i + 1)
+					bat.Vecs[2].Col.([]uint64)[i] = uint64((start + i) * 10)
+				}
+				return bat
+			}
+
+			relation, err := dbDesc.Relation("A")
+			convey.So(err, convey.ShouldBeNil)
+			err = relation.Write(0, makeBatch(0, 10, attrNames))
+			convey.So(err, convey.ShouldBeNil)
+
+			read := func(relation engine.Relation, attrs []string) [][]uint64 {
+				rd := relation.NewReader(1, nil, nil)[0]
+				rows := make([][]uint64, len(attrs))
+				for {
+					bat, err := rd.Read(make([]uint64, len(attrs)), attrs)
+					convey.So(err, convey.ShouldBeNil)
+					if bat == nil {
+						break
+					}
+					for i, vec := range bat.Vecs {
+						rows[i] = append(rows[i], vec.Col.([]uint64)...)
+					}
+				}
+				return rows
+			}
+
+			_, added := tuplecodec.MakeAttributes(types.T_uint64)
+			added[0].Attr.Name = "d"
+			added[0].Attr.Default = engine.MakeDefaultExpr(true, uint64(7), false)
+
+			//nothing is changed if one of the changes is invalid
+			err = relation.AddTableDef(0, &engine.SchemaChangeDef{Changes: []engine.SchemaChange{
+				{Def: added[0]},
+				{Drop: true, Def: &engine.AttributeDef{Attr: engine.Attribute{Name: "e"}}},
+			}})
+			convey.So(err, convey.ShouldNotBeNil)
+			convey.So(len(relation.TableDefs()), convey.ShouldEqual, 4)
+
+			//the primary key can not be dropped
+			err = relation.DelTableDef(0, &engine.AttributeDef{Attr: engine.Attribute{Name: "a"}})
+			convey.So(err, convey.ShouldNotBeNil)
+
+			err = relation.AddTableDef(0, &engine.SchemaChangeDef{Changes: []engine.SchemaChange{
+				{Def: &engine.RenameColumnDef{Name: "b", NewName: "bb"}},
+				{Def: added[0]},
+				{Drop: true, Def: &engine.AttributeDef{Attr: engine.Attribute{Name: "c"}}},
+				{Def: &engine.RenameTableDef{Name: "B"}},
+			}})
+			convey.So(err, convey.ShouldBeNil)
+
+			_, err = dbDesc.Relation("A")
+			convey.So(err, convey.ShouldNotBeNil)
+			relation, err = dbDesc.Relation("B")
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(relation.(*TpeRelation).desc.Version, convey.ShouldEqual, 1)
+
+			//the rows written before read the default value of d
+			rows := read(relation, []string{"d", "a", "bb"})
+			convey.So(len(rows[0]), convey.ShouldEqual, 10)
+			for i, a := range rows[1] {
+				convey.So(rows[0][i], convey.ShouldEqual, 7)
+				convey.So(rows[2][i], convey.ShouldEqual, a+1)
+			}
+
+			//the rows written after have d
+			err = relation.Write(0, makeBatch(10, 5, []string{"a", "bb", "d"}))
+			convey.So(err, convey.ShouldBeNil)
+			rows = read(relation, []string{"a", "bb", "d"})
+			convey.So(len(rows[0]), convey.ShouldEqual, 15)
+			for i, a := range rows[0] {
+				convey.So(rows[1][i], convey.ShouldEqual, a+1)
+				if a < 10 {
+					convey.So(rows[2][i], convey.ShouldEqual, 7)
+				} else {
+					convey.So(rows[2][i], convey.ShouldEqual, a*10)
+				}
+			}
+
+			//the new default value is not used by the rows written before
+			err = relation.AddTableDef(0, &engine.ColumnDefaultDef{Name: "d", Default: engine.MakeDefaultExpr(true, uint64(8), false)})
+			convey.So(err, convey.ShouldBeNil)
+			rows = read(relation, []string{"d"})
+			convey.So(rows[0][0], convey.ShouldEqual, 7)
+		})
+	}
+}
+
+func TestTpeRelation_NewSnapshotReader(t *testing.T) {
+	convey.Convey("read the rows as they were at a timestamp", t, func() {
+		tpe, err := NewTpeEngine(&TpeConfig{
+			KvType:                    tuplecodec.KV_MEMORY,
+			SerialType:                tuplecodec.ST_JSON,
+			ValueLayoutSerializerType: "default",
+			KVLimit:                   10000})
+		convey.So(err, convey.ShouldBeNil)
+		err = tpe.Create(0, "test", 0)
+		convey.So(err, convey.ShouldBeNil)
+
+		dbDesc, err := tpe.Database("test")
+		convey.So(err, convey.ShouldBeNil)
+
+		//(a,b)
+		//(uint64,uint64)
+		//primary key (a)
+		_, attrDefs := tuplecodec.MakeAttributes(types.T_uint64, types.T_uint64)
+
+		attrNames := []string{
+			"a", "b",
+		}
+		var defs []engine.TableDef
+		var rawDefs []*engine.AttributeDef
+		for i, def := range attrDefs {
+			def.Attr.Name = attrNames[i]
+			defs = append(defs, def)
+			rawDefs = append(rawDefs, def)
+		}
+		defs[0].(*engine.AttributeDef).Attr.Primary = true
+		defs = append(defs, &engine.PrimaryIndexDef{Names: []string{"a"}})
+
+		err = dbDesc.Create(0, "A", defs)
+		convey.So(err, convey.ShouldBeNil)
+
+		makeBatch := func(start, cnt int) *batch.Batch {
+			bat := tuplecodec.MakeBatch(cnt, attrNames, rawDefs)
+			for i := 0; i < cnt; i++ {
+				bat.Vecs[0].Col.([]uint64)[i] = uint64(start + i)
+				bat.Vecs[1].Col.([]uint64)[i] = uint64((start + i) * 10)
+			}
+			return bat
+		}
+
+		relation, err := dbDesc.Relation("A")
+		convey.So(err, convey.ShouldBeNil)
+		err = relation.Write(0, makeBatch(0, 10))
+		convey.So(err, convey.ShouldBeNil)
+
+		read := func(readers []engine.Reader) map[uint64]uint64 {
+			rows := make(map[uint64]uint64)
+			for _, rd := range readers {
+				for {
+					bat, err := rd.Read([]uint64{1, 1}, attrNames)
+					convey.So(err, convey.ShouldBeNil)
+					if bat == nil {
+						break
+					}
+					for i, a := range bat.Vecs[0].Col.([]uint64) {
+						rows[a] = bat.Vecs[1].Col.([]uint64)[i]
+					}
+				}
+			}
+			return rows
+		}
+
+		snapshot := relation.(engine.SnapshotRelation)
+		ts := engine.Now()
+		changed, err := snapshot.ChangedSince(ts)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(changed, convey.ShouldBeFalse)
+
+		//insert 10..14, delete 0..4
+		err = relation.Write(0, makeBatch(10, 5))
+		convey.So(err, convey.ShouldBeNil)
+		bat := makeBatch(0, 5)
+		bat.Zs = []int64{-1, -1}
+		err = relation.Write(0, bat)
+		convey.So(err, convey.ShouldBeNil)
+
+		changed, err = snapshot.ChangedSince(ts)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(changed, convey.ShouldBeTrue)
+
+		rows := read(relation.NewReader(1, nil, nil))
+		convey.So(len(rows), convey.ShouldEqual, 10)
+		for a := uint64(5); a < 15; a++ {
+			convey.So(rows[a], convey.ShouldEqual, a*10)
+		}
+
+		//the snapshot still has 0..9
+		rows = read(snapshot.NewSnapshotReader(ts, 1, nil, nil))
+		convey.So(len(rows), convey.ShouldEqual, 10)
+		for a := uint64(0); a < 10; a++ {
+			convey.So(rows[a], convey.ShouldEqual, a*10)
+		}
+
+		//a newer snapshot sees the changes
+		rows = read(snapshot.NewSnapshotReader(engine.Now(), 1, nil, nil))
+		convey.So(len(rows), convey.ShouldEqual, 10)
+		convey.So(rows[14], convey.ShouldEqual, 140)
+	})
+}
+
+func Test_intersectKeyRange(t *testing.T) {
+	convey.Convey("cut the shard to the key range", t, func() {
+		type args struct {
+			start, end, startKey, endKey []byte
+		}
+		kases := []struct {
+			args       args
+			start, end []byte
+			ok         bool
+		}{
+			{args{[]byte("b"), []byte("d"), nil, nil}, []byte("b"), []byte("d"), true},
+			{args{[]byte("b"), []byte("d"), []byte("a"), []byte("c")}, []byte("b"), []byte("c"), true},
+			{args{[]byte("b"), []byte("d"), []byte("c"), []byte("e")}, []byte("c"), []byte("d"), true},
+			{args{nil, nil, []byte("c"), []byte("e")}, []byte("c"), []byte("e"), true},
+			{args{[]byte("b"), []byte("d"), []byte("d"), []byte("e")}, nil, nil, false},
+			{args{[]byte("b"), []byte("d"), []byte("a"), []byte("b")}, nil, nil, false},
+		}
+		for _, kase := range kases {
+			start, end, ok := intersectKeyRange(kase.args.start, kase.args.end, kase.args.startKey, kase.args.endKey)
+			convey.So(ok, convey.ShouldEqual, kase.ok)
+			if ok {
+				convey.So(start, convey.ShouldResemble, kase.start)
+				convey.So(end, convey.ShouldResemble, kase.end)
+			}
+		}
+	})
+}
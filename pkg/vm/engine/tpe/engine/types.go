@@ -30,7 +30,7 @@ import (
 
 var _ engine.Engine = &TpeEngine{}
 var _ engine.Database = &TpeDatabase{}
-var _ engine.Relation = &TpeRelation{}
+var _ engine.SnapshotRelation = &TpeRelation{}
 var _ engine.Reader = &TpeReader{}
 
 type TpeConfig struct {
@@ -123,6 +123,10 @@ type TpeReader struct {
 	tableDesc      *descriptor.RelationDesc
 	computeHandler computation.ComputationHandler
 	readCtx        *tuplecodec.ReadContext
+	//the index to be scanned, its range and the conditions for skipping rows
+	indexDesc      *descriptor.IndexDesc
+	indexRange     *tuplecodec.IndexRange
+	filter         []tuplecodec.Condition
 	shardInfos     []ShardInfo
 	parallelReader bool
 	multiNode      bool
@@ -133,6 +137,8 @@ type TpeReader struct {
 	storeID      uint64
 	dumpData     bool
 	opt          *batch.DumpOption
+	//the rows are read as they were at the timestamp if it is not zero
+	snapshot uint64
 }
 
 func GetTpeReaderInfo(r *TpeRelation, eng *TpeEngine, opt *batch.DumpOption) *TpeReader {
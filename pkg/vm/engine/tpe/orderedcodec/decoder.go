@@ -98,6 +98,15 @@ func (od *OrderedDecoder) DecodeKey(data []byte, valueType ValueType) ([]byte, *
 		d.Value = types.Date(d.Value.(uint64))
 	case VALUE_TYPE_DATETIME:
 		d.Value = types.Datetime(d.Value.(uint64))
+	case VALUE_TYPE_DECIMAL:
+		v, ok := d.Value.([]byte)
+		if !ok || len(v) != 16 {
+			return nil, nil, errorUnmatchedValueType
+		}
+		d.Value = types.Decimal{
+			Lo: binary.BigEndian.Uint64(v[8:]),
+			Hi: int64(binary.BigEndian.Uint64(v) ^ (1 << 63)),
+		}
 	}
 
 	d.ValueType = valueType
@@ -426,3 +435,14 @@ func (di *DecodedItem) GetDatetime() (types.Datetime, error) {
 		return v, nil
 	}
 }
+
+func (di *DecodedItem) GetDecimal() (types.Decimal, error) {
+	if di.ValueType != VALUE_TYPE_DECIMAL {
+		return types.Decimal{}, errorUnmatchedValueType
+	}
+	if v, ok := di.Value.(types.Decimal); !ok {
+		return types.Decimal{}, errorUnmatchedValueType
+	} else {
+		return v, nil
+	}
+}
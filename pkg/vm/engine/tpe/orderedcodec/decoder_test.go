@@ -19,6 +19,7 @@ import (
 	"math"
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/smartystreets/assertions/should"
 	"github.com/smartystreets/goconvey/convey"
 )
@@ -298,4 +299,34 @@ func TestOrderedDecoder_DecodeFloat(t *testing.T) {
 			}
 		}
 	})
-}
\ No newline at end of file
+}
+
+func TestOrderedDecoder_DecodeDecimal(t *testing.T) {
+	convey.Convey("decodeDecimal",t, func() {
+		oe := &OrderedEncoder{}
+		od := &OrderedDecoder{}
+
+		kases := []string{
+			"-99999999999999999999999999999999999999",
+			"-18446744073709551616",
+			"-1",
+			"0",
+			"1",
+			"18446744073709551616",
+			"99999999999999999999999999999999999999",
+		}
+		var last []byte
+		for _, kase := range kases {
+			v, err := types.ParseDecimal(kase, types.MaxDecimalPrecision, 0)
+			convey.So(err,convey.ShouldBeNil)
+			d,_ := oe.EncodeDecimal(nil, v)
+			convey.So(bytes.Compare(last, d),convey.ShouldBeLessThan,0)
+			last = d
+
+			rest,di,err := od.DecodeKey(d, VALUE_TYPE_DECIMAL)
+			convey.So(err,convey.ShouldBeNil)
+			convey.So(rest,convey.ShouldBeEmpty)
+			convey.So(di.Value,convey.ShouldResemble,v)
+		}
+	})
+}
@@ -16,6 +16,7 @@ package orderedcodec
 
 import (
 	"bytes"
+	"encoding/binary"
 	"errors"
 	"math"
 
@@ -57,6 +58,8 @@ func (oe *OrderedEncoder) EncodeKey(data []byte,value interface{})([]byte,*Encod
 		return oe.EncodeDate(data, v)
 	case types.Datetime:
 		return oe.EncodeDatetime(data, v)
+	case types.Decimal:
+		return oe.EncodeDecimal(data, v)
 	case uint8:
 		return oe.EncodeUint8(data, v)
 	case uint16:
@@ -141,6 +144,15 @@ func (oe *OrderedEncoder) EncodeDatetime(data []byte,value types.Datetime)([]byt
 	return oe.EncodeInt64(data, int64(value))
 }
 
+//EncodeDecimal encodes the 128-bit integer of the decimal as 16 bytes in big-endian
+//with the sign bit flipped, so that the negative ones are ahead of the positive ones.
+func (oe *OrderedEncoder) EncodeDecimal(data []byte,value types.Decimal)([]byte,*EncodedItem) {
+	var buf [16]byte
+	binary.BigEndian.PutUint64(buf[:], uint64(value.Hi) ^ (1 << 63))
+	binary.BigEndian.PutUint64(buf[8:], value.Lo)
+	return oe.EncodeBytes(data, buf[:])
+}
+
 func (oe *OrderedEncoder) EncodeUint8(data []byte,value uint8)([]byte,*EncodedItem) {
 	return oe.EncodeUint64(data, uint64(value))
 }
@@ -254,6 +266,13 @@ func (oe *OrderedEncoder) encodeBytes(data []byte,value []byte)([]byte,*EncodedI
 	return append(data, value...), nil
 }
 
+//EncodeBytesPrefix encodes the bytes with escaping but without the suffix.
+//The result is the prefix of the encoded bytes which begin with the value.
+func (oe *OrderedEncoder) EncodeBytesPrefix(data []byte,value []byte)([]byte,*EncodedItem) {
+	data = append(data,encodingPrefixForBytes)
+	return oe.encodeBytes(data,value)
+}
+
 // EncodeString encods the string into bytes with escaping and appends them to the buffer.
 func (oe *OrderedEncoder) EncodeString(data []byte,value string)([]byte,*EncodedItem) {
 	return oe.EncodeBytes(data,[]byte(value))
@@ -15,6 +15,7 @@
 package orderedcodec
 
 import (
+	"bytes"
 	"math"
 	"testing"
 
@@ -143,6 +144,31 @@ func TestOrderedEncoder_EncodeString(t *testing.T) {
 	})
 }
 
+func TestOrderedEncoder_EncodeBytesPrefix(t *testing.T) {
+	convey.Convey("encodeBytesPrefix",t, func() {
+		oe := &OrderedEncoder{}
+
+		kases := [][]byte{
+			[]byte("matrix"),
+			[]byte{0,1},
+			[]byte{0xff,0,1},
+		}
+		for _, kase := range kases {
+			prefix,_ := oe.EncodeBytesPrefix(nil,kase[:len(kase)-1])
+			d,_ := oe.EncodeBytes(nil,kase)
+			convey.So(bytes.HasPrefix(d,prefix),convey.ShouldBeTrue)
+
+			d,_ = oe.EncodeBytes(nil,kase[:len(kase)-1])
+			convey.So(bytes.HasPrefix(d,prefix),convey.ShouldBeTrue)
+		}
+
+		//the shorter string is not in the range of the prefix
+		prefix,_ := oe.EncodeBytesPrefix(nil,[]byte("matrix"))
+		d,_ := oe.EncodeBytes(nil,[]byte("matri"))
+		convey.So(bytes.HasPrefix(d,prefix),convey.ShouldBeFalse)
+	})
+}
+
 func TestOrderedEncoder_EncodeBool(t *testing.T) {
 	type args struct {
 		value bool
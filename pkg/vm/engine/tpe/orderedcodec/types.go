@@ -45,6 +45,7 @@ const (
 	VALUE_TYPE_FLOAT64  ValueType = 0xe
 	VALUE_TYPE_DATE     ValueType = 0xf
 	VALUE_TYPE_DATETIME ValueType = 0x10
+	VALUE_TYPE_DECIMAL  ValueType = 0x11
 )
 
 type SectionType int
@@ -0,0 +1,355 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tuplecodec
+
+import (
+	"bytes"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tpe/descriptor"
+)
+
+//The changelog of a table records the changes of its rows with the timestamps,
+//which are used to read the rows as they were at a snapshot.
+//The key of a change is the prefix of the changelog, the timestamp and the
+//primary key of the row. The value is the kind of the change, followed by
+//the value of the row before it is deleted.
+//The changes are written before the rows, so that the reader which reads
+//the changelog after the rows never misses the changes of the rows it has read.
+
+//the kinds of the changes
+const (
+	changeInsert byte = 'i'
+	changeDelete byte = 'd'
+)
+
+var (
+	//the changelog of a table is collected at most once in the interval by the process
+	changelogGCInterval = time.Minute
+	changelogGC         = struct {
+		sync.Mutex
+		last map[string]uint64 //the timestamp of the last collection of the changelog
+	}{last: make(map[string]uint64)}
+)
+
+//KeyRange is the range [Start,End) of keys, the empty End means +infinity.
+type KeyRange struct {
+	Start TupleKey
+	End   TupleKey
+}
+
+//snapshotChanges are the earliest changes of the rows after a snapshot,
+//they are loaded from the changelog as the rows are read.
+type snapshotChanges struct {
+	prefix        TupleKey //the prefix of the changelog
+	primaryPrefix TupleKey //the prefix of the primary index
+	next          TupleKey //the key to load the changes from
+	//the primary keys without the prefix of the rows changed
+	keys map[string]struct{}
+	//the keys and values of the rows deleted, they are read after the rows of the table
+	deletedKeys   []TupleKey
+	deletedValues []TupleValue
+	restored      int //the count of the deleted rows read
+}
+
+func (kr KeyRange) contains(key TupleKey) bool {
+	return bytes.Compare(kr.Start, key) <= 0 &&
+		(len(kr.End) == 0 || bytes.Compare(key, kr.End) < 0)
+}
+
+//encodeChangelogPrefix encodes the prefix of the changelog of the table.
+func encodeChangelogPrefix(tke *TupleKeyEncoder, dbID, tableID uint64) TupleKey {
+	prefix, _ := tke.EncodeIndexPrefix(nil, dbID, tableID, uint64(ChangelogIndexID))
+	return prefix
+}
+
+//encodeChangelogKey encodes the key of the changes at the timestamp ts.
+func encodeChangelogKey(tke *TupleKeyEncoder, prefix TupleKey, ts uint64) TupleKey {
+	key := make(TupleKey, len(prefix))
+	copy(key, prefix)
+	key, _ = tke.oe.EncodeUint64(key, ts)
+	return key
+}
+
+//snapshotTooOld checks if the changes after ts may have been collected.
+func snapshotTooOld(ts uint64) bool {
+	return ts+uint64(engine.SnapshotRetention) < engine.Now()
+}
+
+//changedSince checks if there are changes after ts in the changelog.
+func changedSince(kv KVHandler, tke *TupleKeyEncoder, dbID, tableID uint64, ts uint64) (bool, error) {
+	if snapshotTooOld(ts) {
+		return false, engine.ErrSnapshotTooOld
+	}
+	prefix := encodeChangelogPrefix(tke, dbID, tableID)
+	keys, _, _, _, err := kv.GetRangeWithLimit(encodeChangelogKey(tke, prefix, ts+1), SuccessorOfPrefix(prefix), 1)
+	if err != nil {
+		return false, err
+	}
+	return len(keys) != 0, nil
+}
+
+//logChanges writes the changes of the rows into the changelog before the rows
+//are written. The keys are the keys of the primary index, the values are the
+//rows to be deleted or nil if the rows are to be inserted. The rows which do not
+//exist are not deleted, so they are skipped.
+//It returns the keys of the changes written.
+func (ihi *IndexHandlerImpl) logChanges(writeCtx *WriteContext, keys []TupleKey, values []TupleValue) ([]TupleKey, error) {
+	tke := ihi.tch.GetEncoder()
+	prefix := encodeChangelogPrefix(tke, uint64(writeCtx.DbDesc.ID), uint64(writeCtx.TableDesc.ID))
+	ts := engine.Now()
+	err := ihi.collectChanges(prefix, ts)
+	if err != nil {
+		return nil, err
+	}
+	tsKey := encodeChangelogKey(tke, prefix, ts)
+	n := len(writeCtx.callback.prefix)
+	var changeKeys []TupleKey
+	var changeValues []TupleValue
+	for i, key := range keys {
+		var value TupleValue
+		if values == nil {
+			value = TupleValue{changeInsert}
+		} else if len(values[i]) != 0 {
+			value = append(TupleValue{changeDelete}, values[i]...)
+		} else {
+			continue
+		}
+		changeKey := make(TupleKey, 0, len(tsKey)+len(key)-n)
+		changeKey = append(changeKey, tsKey...)
+		changeKeys = append(changeKeys, append(changeKey, key[n:]...))
+		changeValues = append(changeValues, value)
+	}
+	if len(changeKeys) == 0 {
+		return nil, nil
+	}
+	err = ihi.kv.SetBatch(changeKeys, changeValues)
+	if err != nil {
+		return nil, err
+	}
+	return changeKeys, nil
+}
+
+//discardChanges removes the changes of the rows which are not written.
+func (ihi *IndexHandlerImpl) discardChanges(keys []TupleKey) error {
+	for _, key := range keys {
+		err := ihi.kv.Delete(key)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//collectChanges removes the changes older than the retention from the changelog.
+func (ihi *IndexHandlerImpl) collectChanges(prefix TupleKey, ts uint64) error {
+	changelogGC.Lock()
+	if ts < changelogGC.last[string(prefix)]+uint64(changelogGCInterval) {
+		changelogGC.Unlock()
+		return nil
+	}
+	changelogGC.last[string(prefix)] = ts
+	changelogGC.Unlock()
+
+	end := encodeChangelogKey(ihi.tch.GetEncoder(), prefix, ts-uint64(engine.SnapshotRetention))
+	for {
+		keys, _, complete, _, err := ihi.kv.GetRangeWithLimit(prefix, end, ihi.kvLimit)
+		if err != nil {
+			return err
+		}
+		for _, key := range keys {
+			err = ihi.kv.Delete(key)
+			if err != nil {
+				return err
+			}
+		}
+		if complete || len(keys) == 0 {
+			return nil
+		}
+	}
+}
+
+//loadChanges loads the changes after the snapshot of the read context,
+//which have not been loaded.
+func (ihi *IndexHandlerImpl) loadChanges(readCtx *ReadContext) error {
+	c := readCtx.changes
+	if c == nil {
+		if snapshotTooOld(readCtx.Snapshot) {
+			return engine.ErrSnapshotTooOld
+		}
+		tke := ihi.tch.GetEncoder()
+		dbID, tableID := uint64(readCtx.DbDesc.ID), uint64(readCtx.TableDesc.ID)
+		c = &snapshotChanges{
+			prefix: encodeChangelogPrefix(tke, dbID, tableID),
+			keys:   make(map[string]struct{}),
+		}
+		c.primaryPrefix, _ = tke.EncodeIndexPrefix(nil, dbID, tableID, uint64(PrimaryIndexID))
+		c.next = encodeChangelogKey(tke, c.prefix, readCtx.Snapshot+1)
+		readCtx.changes = c
+	}
+	tkd := ihi.tch.GetDecoder()
+	end := SuccessorOfPrefix(c.prefix)
+	for {
+		keys, values, complete, nextScanKey, err := ihi.kv.GetRangeWithLimit(c.next, end, ihi.kvLimit)
+		if err != nil {
+			return err
+		}
+		for i, key := range keys {
+			primaryKey, _, err := tkd.od.DecodeUint64(key[len(c.prefix):])
+			if err != nil {
+				return err
+			}
+			//only the earliest change of the row matters
+			if _, ok := c.keys[string(primaryKey)]; ok {
+				continue
+			}
+			c.keys[string(primaryKey)] = struct{}{}
+			if len(values[i]) != 0 && values[i][0] == changeDelete {
+				deletedKey := make(TupleKey, 0, len(c.primaryPrefix)+len(primaryKey))
+				deletedKey = append(deletedKey, c.primaryPrefix...)
+				c.deletedKeys = append(c.deletedKeys, append(deletedKey, primaryKey...))
+				c.deletedValues = append(c.deletedValues, values[i][1:])
+			}
+		}
+		if len(keys) != 0 {
+			last := keys[len(keys)-1]
+			c.next = SuccessorOfKey(append(make(TupleKey, 0, len(last)+1), last...))
+		} else if len(nextScanKey) != 0 {
+			c.next = nextScanKey
+		}
+		if complete || (len(keys) == 0 && len(nextScanKey) == 0) {
+			return nil
+		}
+	}
+}
+
+//skipChangedRows removes the rows changed after the snapshot from the rows
+//read from the primary index. The rows are kept if it is not a snapshot read.
+func (ihi *IndexHandlerImpl) skipChangedRows(readCtx *ReadContext, keys []TupleKey, values []TupleValue) ([]TupleKey, []TupleValue, error) {
+	if readCtx.Snapshot == 0 {
+		return keys, values, nil
+	}
+	err := ihi.loadChanges(readCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+	c := readCtx.changes
+	var retKeys []TupleKey
+	var retValues []TupleValue
+	for i, key := range keys {
+		if _, ok := c.keys[string(key[len(c.primaryPrefix):])]; ok {
+			continue
+		}
+		retKeys = append(retKeys, key)
+		if i < len(values) {
+			retValues = append(retValues, values[i])
+		}
+	}
+	return retKeys, retValues, nil
+}
+
+//readDeletedRows reads the rows deleted after the snapshot in the ranges
+//of the read context.
+func (ihi *IndexHandlerImpl) readDeletedRows(readCtx *ReadContext) (*batch.Batch, int, error) {
+	err := ihi.loadChanges(readCtx)
+	if err != nil {
+		return nil, 0, err
+	}
+	c := readCtx.changes
+	readCtx.LengthOfPrefixForScanKey = len(c.primaryPrefix)
+
+	amForKey, amForValue, needKeyOnly, err := ihi.attributeMapsOfPrimaryIndex(readCtx)
+	if err != nil {
+		return nil, 0, err
+	}
+	rf := newRowFilter(readCtx.Filter, readCtx.ReadAttributeDescs)
+
+	names, attrdefs := ConvertAttributeDescIntoTypesType(readCtx.ReadAttributeDescs)
+	bat := MakeBatch(int(ihi.kvLimit), names, attrdefs)
+	rowRead := 0
+	for rowRead < int(ihi.kvLimit) && c.restored < len(c.deletedKeys) {
+		key, value := c.deletedKeys[c.restored], c.deletedValues[c.restored]
+		c.restored++
+		if !readCtx.inSnapshotRanges(key) {
+			continue
+		}
+		filled, err := ihi.fillBatchFromPrimaryIndex(readCtx, []TupleKey{key}, []TupleValue{value},
+			amForKey, amForValue, needKeyOnly, rf, bat, rowRead)
+		if err != nil {
+			return nil, 0, err
+		}
+		rowRead += filled
+	}
+	if rowRead == 0 {
+		return nil, 0, nil
+	}
+
+	TruncateBatch(bat, int(ihi.kvLimit), rowRead)
+
+	err = SerializeVectorForBatch(bat)
+	if err != nil {
+		return nil, 0, err
+	}
+	return bat, rowRead, nil
+}
+
+//inSnapshotRanges checks if the key of the primary index is read by the read context.
+func (rc *ReadContext) inSnapshotRanges(key TupleKey) bool {
+	if rc.SnapshotRanges == nil {
+		return true
+	}
+	for _, kr := range rc.SnapshotRanges {
+		if kr.contains(key) {
+			return true
+		}
+	}
+	return false
+}
+
+//attributeMapsOfPrimaryIndex maps the attributes to be read to the positions
+//in the key and the value of the primary index.
+//needKeyOnly is true if all the attributes are in the key.
+func (ihi *IndexHandlerImpl) attributeMapsOfPrimaryIndex(readCtx *ReadContext) (*AttributeMap, *AttributeMap, bool, error) {
+	primary := &readCtx.TableDesc.Primary_index
+	indexAttrIDs := descriptor.ExtractIndexAttributeIDs(primary.Attributes)
+	amForKey := &AttributeMap{}
+	amForValue := &AttributeMap{}
+	needKeyOnly := true
+	var positionsInValue map[uint32]int
+	if ihi.useLayout {
+		positionsInValue = ihi.layoutSerializer.GetPositionsOfAttributesInTheValue(readCtx.TableDesc, primary)
+	}
+	for i, attr := range readCtx.ReadAttributeDescs {
+		if positionInIndex, exist := indexAttrIDs[attr.ID]; exist {
+			amForKey.Append(int(attr.ID), positionInIndex, i)
+		} else {
+			needKeyOnly = false
+			positionInValue := i
+			if ihi.useLayout {
+				var exist2 bool
+				if positionInValue, exist2 = positionsInValue[attr.ID]; !exist2 {
+					return nil, nil, false, errorInvalidAttributePosition
+				}
+			}
+			amForValue.Append(int(attr.ID), positionInValue, i)
+		}
+	}
+	amForKey.BuildPositionInDecodedItemArray()
+	amForValue.BuildPositionInDecodedItemArray()
+	return amForKey, amForValue, needKeyOnly, nil
+}
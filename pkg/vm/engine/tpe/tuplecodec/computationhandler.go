@@ -45,6 +45,8 @@ var (
 	errorThereAreNotNodesHoldTheTable            = errors.New("there are not nodes hold the table")
 	errorCanNotDropTheInternalDatabase           = errors.New("you can not drop the internal database")
 	errorCanNotDropTheTableInTheInternalDatabase = errors.New("you can not drop the table in the internal database")
+	errorIndexExists                             = errors.New("index has exists")
+	errorIndexDoesNotExist                       = errors.New("index does not exist")
 )
 
 var _ computation.ComputationHandler = &ComputationHandlerImpl{}
@@ -75,11 +77,16 @@ func (chi *ComputationHandlerImpl) Write(writeCtx interface{}, bat *batch.Batch)
 		return nil
 	}
 
+	indexWriteCtx, ok := writeCtx.(*WriteContext)
+	if !ok {
+		return errorWriteContextIsInvalid
+	}
+
 	var err error
 	if len(bat.Zs) == 2 && bat.Zs[0] == -1 && bat.Zs[1] == -1 {
-		err = chi.indexHandler.DeleteFromIndex(writeCtx, bat)
+		err = chi.indexHandler.DeleteFromTable(writeCtx, bat)
 	} else {
-		err = chi.indexHandler.WriteIntoIndex(writeCtx, bat)
+		err = chi.indexHandler.WriteIntoTable(indexWriteCtx.TableDesc, writeCtx, bat)
 	}
 
 	if err != nil {
@@ -401,6 +408,182 @@ func (chi *ComputationHandlerImpl) GetTable(dbId uint64, name string) (*descript
 	return tableDesc, nil
 }
 
+func (chi *ComputationHandlerImpl) CreateIndex(epoch, dbId uint64, tableDesc *descriptor.RelationDesc, indexDesc *descriptor.IndexDesc) error {
+	//1. check database exists
+	dbDesc, err := chi.dh.LoadDatabaseDescByID(dbId)
+	if err != nil {
+		return err
+	}
+
+	//2. check index exists
+	for _, index := range tableDesc.Indexes {
+		if index.Name == indexDesc.Name {
+			return errorIndexExists
+		}
+	}
+
+	//3. get the next id for the index
+	indexDesc.ID = tableDesc.Next_index_id
+	if indexDesc.ID <= PrimaryIndexID {
+		indexDesc.ID = PrimaryIndexID + 1
+	}
+	tableDesc.Next_index_id = indexDesc.ID + 1
+	tableDesc.Indexes = append(tableDesc.Indexes, *indexDesc)
+	tableDesc.Update_time = time.Now().Unix()
+	tableDesc.Max_access_epoch = epoch
+
+	//4. save the descriptor. The rows written after that maintain the index.
+	err = chi.dh.StoreRelationDescByID(dbId, uint64(tableDesc.ID), tableDesc)
+	if err != nil {
+		return err
+	}
+
+	//5. write the index for the existing rows
+	return chi.buildIndex(dbDesc, tableDesc, indexDesc)
+}
+
+//buildIndex reads all rows from the primary index and writes them into
+//the secondary index.
+func (chi *ComputationHandlerImpl) buildIndex(dbDesc *descriptor.DatabaseDesc, tableDesc *descriptor.RelationDesc, indexDesc *descriptor.IndexDesc) error {
+	names := make([]string, len(tableDesc.Attributes))
+	readAttrs := make([]*descriptor.AttributeDesc, len(tableDesc.Attributes))
+	writeStates := make([]AttributeStateForWrite, len(tableDesc.Attributes))
+	for i, attr := range tableDesc.Attributes {
+		names[i] = attr.Name
+		readAttrs[i] = &tableDesc.Attributes[i]
+		writeStates[i].AttrDesc = attr
+		writeStates[i].PositionInBatch = i
+		writeStates[i].NeedGenerated = false
+	}
+
+	readCtx := &ReadContext{
+		DbDesc:              dbDesc,
+		TableDesc:           tableDesc,
+		IndexDesc:           &tableDesc.Primary_index,
+		ReadAttributesNames: names,
+		ReadAttributeDescs:  readAttrs,
+	}
+
+	writeCtx := &WriteContext{
+		DbDesc:          dbDesc,
+		TableDesc:       tableDesc,
+		IndexDesc:       indexDesc,
+		AttributeStates: writeStates,
+		BatchAttrs:      tableDesc.Attributes,
+	}
+
+	for {
+		bat, _, err := chi.indexHandler.ReadFromIndex(readCtx)
+		if err != nil {
+			return err
+		}
+		if bat == nil {
+			return nil
+		}
+		err = chi.indexHandler.WriteIntoIndex(writeCtx, bat)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (chi *ComputationHandlerImpl) DropIndex(epoch, dbId uint64, tableDesc *descriptor.RelationDesc, name string) error {
+	//1. check index exists
+	pos := -1
+	for i, index := range tableDesc.Indexes {
+		if index.Name == name {
+			pos = i
+			break
+		}
+	}
+	if pos == -1 {
+		return errorIndexDoesNotExist
+	}
+	indexID := tableDesc.Indexes[pos].ID
+
+	//2. save the descriptor without the index
+	tableDesc.Indexes = append(tableDesc.Indexes[:pos], tableDesc.Indexes[pos+1:]...)
+	tableDesc.Update_time = time.Now().Unix()
+	tableDesc.Max_access_epoch = epoch
+	err := chi.dh.StoreRelationDescByID(dbId, uint64(tableDesc.ID), tableDesc)
+	if err != nil {
+		return err
+	}
+
+	//3. delete the keys of the index
+	tce := chi.tch.GetEncoder()
+	prefix, _ := tce.EncodeIndexPrefix(nil, dbId, uint64(tableDesc.ID), uint64(indexID))
+	return chi.kv.DeleteWithPrefix(prefix)
+}
+
+//AlterTable saves the descriptor changed by one ALTER TABLE.
+//The keys of the indexes dropped by the change are deleted.
+func (chi *ComputationHandlerImpl) AlterTable(epoch, dbId uint64, tableDesc *descriptor.RelationDesc) error {
+	//1. check table exists
+	oldDesc, err := chi.dh.LoadRelationDescByID(dbId, uint64(tableDesc.ID))
+	if err != nil {
+		return err
+	}
+
+	//2. check the new name is not used
+	if oldDesc.Name != tableDesc.Name {
+		_, err = chi.dh.LoadRelationDescByName(dbId, tableDesc.Name)
+		if err == nil {
+			return errorTableExists
+		} else if err != errorDoNotFindTheDesc {
+			return err
+		}
+	}
+
+	//3. the rows written before read the default value of the added attributes
+	nextID := oldDesc.Next_attribute_id
+	for _, attr := range oldDesc.Attributes {
+		if nextID <= attr.ID {
+			nextID = attr.ID + 1
+		}
+	}
+	for i := range tableDesc.Attributes {
+		attr := &tableDesc.Attributes[i]
+		if attr.ID < nextID || len(attr.Fill) != 0 {
+			continue
+		}
+		var value interface{}
+		if attr.Default.Exist && !attr.Default.IsNull {
+			value = attr.Default.Value
+		}
+		attr.Fill, _, err = chi.serializer.SerializeValue(nil, value)
+		if err != nil {
+			return err
+		}
+	}
+
+	//4. save the descriptor
+	tableDesc.Version = oldDesc.Version + 1
+	tableDesc.Update_time = time.Now().Unix()
+	tableDesc.Max_access_epoch = epoch
+	err = chi.dh.StoreRelationDescByID(dbId, uint64(tableDesc.ID), tableDesc)
+	if err != nil {
+		return err
+	}
+
+	//5. delete the keys of the dropped indexes
+	kept := make(map[uint32]bool)
+	for _, index := range tableDesc.Indexes {
+		kept[index.ID] = true
+	}
+	tce := chi.tch.GetEncoder()
+	for _, index := range oldDesc.Indexes {
+		if kept[index.ID] {
+			continue
+		}
+		prefix, _ := tce.EncodeIndexPrefix(nil, dbId, uint64(tableDesc.ID), uint64(index.ID))
+		if err = chi.kv.DeleteWithPrefix(prefix); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (chi *ComputationHandlerImpl) RemoveDeletedTable(epoch uint64) (int, error) {
 	return chi.epochHandler.RemoveDeletedTable(epoch)
 }
@@ -447,6 +630,20 @@ func (chi *ComputationHandlerImpl) GetNodesHoldTheTable(dbId uint64, desc *descr
 	return nodes, ret, nil
 }
 
+//EncodeIndexRange encodes the range on the attributes of the index
+//into the key range [startKey,endKey).
+func (chi *ComputationHandlerImpl) EncodeIndexRange(dbId uint64, desc *descriptor.RelationDesc, indexDesc *descriptor.IndexDesc, indexRange interface{}) ([]byte, []byte) {
+	tke := chi.tch.GetEncoder()
+	prefix, _ := tke.EncodeIndexPrefix(nil, dbId, uint64(desc.ID), uint64(indexDesc.ID))
+	ir, _ := indexRange.(*IndexRange)
+	return encodeIndexRange(tke, prefix, ir)
+}
+
+//ChangedSince checks if the table has been written after ts.
+func (chi *ComputationHandlerImpl) ChangedSince(dbId uint64, desc *descriptor.RelationDesc, ts uint64) (bool, error) {
+	return changedSince(chi.kv, chi.tch.GetEncoder(), dbId, uint64(desc.ID), ts)
+}
+
 func (chi *ComputationHandlerImpl) ParallelReader() bool {
 	return chi.parallelReader
 }
@@ -489,11 +686,41 @@ type WriteContext struct {
 	keys   []TupleKey
 	values []TupleValue
 	t0     time.Duration
+
+	//to set into the secondary indexes
+	indexKeys   []TupleKey
+	indexValues []TupleValue
+}
+
+//attributeState returns the write state of the attribute.
+//The states are in the order of the attributes of the table, so the
+//attribute ID is not the position after some attributes are dropped.
+func (wc *WriteContext) attributeState(attrID uint32) (*AttributeStateForWrite, error) {
+	if int(attrID) < len(wc.AttributeStates) && wc.AttributeStates[attrID].AttrDesc.ID == attrID {
+		return &wc.AttributeStates[attrID], nil
+	}
+	for i := range wc.AttributeStates {
+		if wc.AttributeStates[i].AttrDesc.ID == attrID {
+			return &wc.AttributeStates[i], nil
+		}
+	}
+	return nil, errorAttributeDoesNotHaveThePosition
 }
 
 func (wc *WriteContext) resetWriteCache() {
 	wc.keys = nil
 	wc.values = nil
+	wc.indexKeys = nil
+	wc.indexValues = nil
+}
+
+//primaryIndex returns the primary index of the table.
+//The IndexDesc is the secondary index when the index is built.
+func (wc *WriteContext) primaryIndex() *descriptor.IndexDesc {
+	if wc.IndexDesc.ID == PrimaryIndexID {
+		return wc.IndexDesc
+	}
+	return &wc.TableDesc.Primary_index
 }
 
 //for parallel readers
@@ -590,12 +817,41 @@ type SingleReaderContext struct {
 	PrefixEnd []byte
 }
 
+//IndexRange bounds the attributes of the index.
+//The leading attributes equal to the values in the Prefix.
+//The attribute after them is bounded by the Low and the High,
+//or begins with the BytesPrefix when it is not nil.
+//The nil Low or High means that side is unbounded.
+type IndexRange struct {
+	Prefix []interface{}
+
+	Low, High interface{}
+
+	LowInclusive, HighInclusive bool
+
+	BytesPrefix []byte
+}
+
+//isPointOf checks the range has only one key of the index.
+func (ir *IndexRange) isPointOf(index *descriptor.IndexDesc) bool {
+	return len(ir.Prefix) == len(index.Attributes) &&
+		ir.Low == nil && ir.High == nil && ir.BytesPrefix == nil
+}
+
 type ReadContext struct {
 	//target database,table and index
 	DbDesc    *descriptor.DatabaseDesc
 	TableDesc *descriptor.RelationDesc
 	IndexDesc *descriptor.IndexDesc
 
+	//the range for scanning the index.
+	//nil means the whole index.
+	IndexRange *IndexRange
+
+	//the rows which do not satisfy the conjunction of
+	//the conditions are skipped in decoding.
+	Filter []Condition
+
 	//the attributes to be read
 	ReadAttributesNames []string
 
@@ -616,6 +872,20 @@ type ReadContext struct {
 	DumpData 	bool // dumpData flag
 
 	Opt	*batch.DumpOption
+
+	//the rows are read as they were at the timestamp if it is not zero.
+	//the primary index is scanned without the rows changed after the snapshot,
+	//then the rows deleted after the snapshot are read.
+	Snapshot uint64
+
+	//the ranges of the primary index read by the snapshot read.
+	//nil means the whole table.
+	SnapshotRanges []KeyRange
+
+	//true if the rows deleted after the snapshot are being read
+	ReadingDeletedRows bool
+
+	changes *snapshotChanges
 }
 
 func (rc *ReadContext) AddReadCount() int {
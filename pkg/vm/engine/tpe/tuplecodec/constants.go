@@ -36,6 +36,8 @@ const (
 	InternalDescriptorTable_name_ID            = 2
 	InternalDescriptorTable_desc_ID            = 3
 	PrimaryIndexID                      uint32 = 1
+	//the changelog of the table is stored as an index which is never read by the queries
+	ChangelogIndexID uint32 = math.MaxUint32
 
 	//holding the epochgced table
 	InternalAsyncGCTableID uint64 = 1
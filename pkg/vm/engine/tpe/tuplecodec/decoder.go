@@ -105,22 +105,46 @@ func (tkd *TupleKeyDecoder) DecodePrimaryIndexKey(key TupleKey, index *descripto
 		return nil, nil, errorPrimaryIndexIDIsNotOne
 	}
 
-	var retDis []*orderedcodec.DecodedItem
+	return tkd.decodeIndexAttributes(key, index.Attributes, nil)
+}
+
+// DecodeSecondaryIndexKey decodes fields of the secondary index and the fields
+// of the primary key behind them. It returns the encoded primary key also.
+// The dbID,tableID and Index ID have been decoded.
+func (tkd *TupleKeyDecoder) DecodeSecondaryIndexKey(key TupleKey, index, primary *descriptor.IndexDesc) (TupleKey, []*orderedcodec.DecodedItem, error) {
+	if index.ID == PrimaryIndexID {
+		return nil, nil, errorSecondaryIndexIDIsOne
+	}
+	rest, dis, err := tkd.decodeIndexAttributes(key, index.Attributes, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	pk := rest
+	_, dis, err = tkd.decodeIndexAttributes(rest, primary.Attributes, dis)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pk, dis, nil
+}
+
+// decodeIndexAttributes decodes the attributes in order, appends them to the dis
+// and returns the rest.
+func (tkd *TupleKeyDecoder) decodeIndexAttributes(key TupleKey, attrs []descriptor.IndexDesc_Attribute, dis []*orderedcodec.DecodedItem) (TupleKey, []*orderedcodec.DecodedItem, error) {
 	//needs attribute type
 	rest := key
-	for _, attr := range index.Attributes {
+	for _, attr := range attrs {
 		rest2, di, err := tkd.od.DecodeKey(rest, attr.Type)
 		if err != nil {
 			return nil, nil, err
 		}
-		if attr.Type == orderedcodec.VALUE_TYPE_STRING {
+		if attr.Type == orderedcodec.VALUE_TYPE_STRING && di.Value != nil {
 			di.Value = string(di.Value.([]byte))
 			di.ValueType = attr.Type
 		}
-		retDis = append(retDis, di)
+		dis = append(dis, di)
 		rest = rest2
 	}
-	return rest, retDis, nil
+	return rest, dis, nil
 }
 
 // DecodePrimaryIndexValue decodes the values of the primary index and return the rest.
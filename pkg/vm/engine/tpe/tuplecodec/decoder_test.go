@@ -246,4 +246,40 @@ func TestTupleKeyDecoder_DecodePrimaryIndexValue(t *testing.T) {
 			convey.So(reflect.DeepEqual(dis[i].Value,kase.value),convey.ShouldBeTrue)
 		}
 	})
-}
\ No newline at end of file
+}
+func TestTupleKeyDecoder_DecodeSecondaryIndexKey(t *testing.T) {
+	convey.Convey("decode secondary index key 1",t, func() {
+		tch := NewTupleCodecHandler(SystemTenantID)
+		tke := tch.GetEncoder()
+		tkd := tch.GetDecoder()
+
+		primary := descriptor.IndexDesc{ID: PrimaryIndexID,
+			Attributes: []descriptor.IndexDesc_Attribute{
+				{ID: 0,Type: orderedcodec.VALUE_TYPE_UINT64},
+			},
+		}
+		index := descriptor.IndexDesc{ID: PrimaryIndexID + 1,
+			Attributes: []descriptor.IndexDesc_Attribute{
+				{ID: 1,Type: orderedcodec.VALUE_TYPE_STRING},
+				{ID: 2,Type: orderedcodec.VALUE_TYPE_INT64},
+			},
+		}
+
+		var key TupleKey
+		key, _ = tke.oe.EncodeKey(key,"abc")
+		key, _ = tke.oe.EncodeKey(key,nil)
+		pk, _ := tke.oe.EncodeKey(nil,uint64(10))
+		key = append(key,pk...)
+
+		rest, dis, err := tkd.DecodeSecondaryIndexKey(key,&index,&primary)
+		convey.So(err,convey.ShouldBeNil)
+		convey.So(bytes.Equal(rest,pk),convey.ShouldBeTrue)
+		convey.So(len(dis),convey.ShouldEqual,3)
+		convey.So(dis[0].Value,convey.ShouldEqual,"abc")
+		convey.So(dis[1].ValueType,convey.ShouldEqual,orderedcodec.VALUE_TYPE_NULL)
+		convey.So(dis[2].Value,convey.ShouldEqual,uint64(10))
+
+		_, _, err = tkd.DecodeSecondaryIndexKey(key,&primary,&primary)
+		convey.So(err,convey.ShouldNotBeNil)
+	})
+}
@@ -31,6 +31,7 @@ const (
 	STRING        = "string"
 	TYPE_DATE     = "types.Date"
 	TYPE_DATETIME = "types.Datetime"
+	TYPE_DECIMAL  = "types.Decimal"
 )
 
 var (
@@ -736,6 +737,8 @@ func MarshalRelationDesc(desc *descriptor.RelationDesc) ([]byte, error) {
 			desc.Attributes[i].DefaultVal.ValueType = TYPE_DATE
 		case types.Datetime:
 			desc.Attributes[i].DefaultVal.ValueType = TYPE_DATETIME
+		case types.Decimal:
+			desc.Attributes[i].DefaultVal.ValueType = TYPE_DECIMAL
 		}
 	}
 	marshal, err := json.Marshal(*desc)
@@ -771,6 +774,13 @@ func UnmarshalRelationDesc(data []byte) (*descriptor.RelationDesc, error) {
 			tableDesc.Attributes[i].Default.Value = types.Date(tableDesc.Attributes[i].Default.Value.(float64))
 		case TYPE_DATETIME:
 			tableDesc.Attributes[i].Default.Value = types.Datetime(tableDesc.Attributes[i].Default.Value.(float64))
+		case TYPE_DECIMAL:
+			// decimals are marshaled as strings to keep all the digits
+			v, err := types.ParseDecimal(tableDesc.Attributes[i].Default.Value.(string), types.MaxDecimalPrecision, 0)
+			if err != nil {
+				return nil, errorDecodeDescriptorFailed
+			}
+			tableDesc.Attributes[i].Default.Value = v
 		}
 	}
 	return tableDesc, nil
@@ -23,6 +23,7 @@ import (
 var (
 	errorWrongTenantID                  = errors.New("wrong tenant id")
 	errorPrimaryIndexIDIsNotOne         = errors.New("primary index id is not one")
+	errorSecondaryIndexIDIsOne          = errors.New("secondary index id is one")
 	errorPrimaryIndexAttributesHaveNull = errors.New("primary index attributes have null")
 	errorUnknownValueType               = errors.New("unknown value type")
 	errorWrongValueType                 = errors.New("wrong value type")
@@ -82,6 +82,12 @@ func (eh *EpochHandler) RemoveDeletedTable(epoch uint64) (int, error) {
 			return 0, err
 		}
 
+		//delete the changelog of the table
+		err = eh.kv.DeleteWithPrefix(encodeChangelogPrefix(tke, item.DbID, item.TableID))
+		if err != nil {
+			return 0, err
+		}
+
 		//3.delete gc item in asyncGC table
 		epochItemKeyDeleted,_ := dhi.MakePrefixWithEpochAndDBIDAndTableID(
 			InternalDatabaseID,InternalAsyncGCTableID,uint64(PrimaryIndexID),
@@ -0,0 +1,250 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tuplecodec
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tpe/descriptor"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tpe/orderedcodec"
+)
+
+// CompareOp is the comparison between the attribute and the constant.
+type CompareOp int
+
+const (
+	CompareEQ CompareOp = iota
+	CompareNE
+	CompareLT
+	CompareLE
+	CompareGT
+	CompareGE
+	//CompareHasPrefix checks the attribute begins with the constant bytes.
+	CompareHasPrefix
+)
+
+// Condition compares the attribute with the constant.
+// The constant has the type of the attribute.
+type Condition struct {
+	AttributeID uint32
+	Op          CompareOp
+	Value       interface{}
+}
+
+// match checks the decoded attribute satisfies the condition.
+// The null never does. The value that can not be compared is kept.
+func (cond *Condition) match(di *orderedcodec.DecodedItem) bool {
+	if di.ValueType == orderedcodec.VALUE_TYPE_NULL || di.Value == nil {
+		return false
+	}
+	if cond.Op == CompareHasPrefix {
+		prefix, _ := cond.Value.([]byte)
+		switch v := di.Value.(type) {
+		case string:
+			return strings.HasPrefix(v, string(prefix))
+		case []byte:
+			return bytes.HasPrefix(v, prefix)
+		}
+		return true
+	}
+	r, ok := compareValue(di.Value, cond.Value)
+	if !ok {
+		return true
+	}
+	switch cond.Op {
+	case CompareEQ:
+		return r == 0
+	case CompareNE:
+		return r != 0
+	case CompareLT:
+		return r < 0
+	case CompareLE:
+		return r <= 0
+	case CompareGT:
+		return r > 0
+	case CompareGE:
+		return r >= 0
+	}
+	return true
+}
+
+// compareValue compares the decoded value with the constant.
+func compareValue(v, c interface{}) (int, bool) {
+	switch c := c.(type) {
+	case string:
+		switch x := v.(type) {
+		case string:
+			return strings.Compare(x, c), true
+		case []byte:
+			return bytes.Compare(x, []byte(c)), true
+		}
+		return 0, false
+	}
+	if ci, ok := asInt64(c); ok {
+		vi, ok := asInt64(v)
+		if !ok {
+			return 0, false
+		}
+		return compareOrdered(vi < ci, vi > ci), true
+	}
+	if cu, ok := asUint64(c); ok {
+		vu, ok := asUint64(v)
+		if !ok {
+			return 0, false
+		}
+		return compareOrdered(vu < cu, vu > cu), true
+	}
+	if cf, ok := asFloat64(c); ok {
+		vf, ok := asFloat64(v)
+		if !ok {
+			return 0, false
+		}
+		return compareOrdered(vf < cf, vf > cf), true
+	}
+	return 0, false
+}
+
+func compareOrdered(less, greater bool) int {
+	if less {
+		return -1
+	} else if greater {
+		return 1
+	}
+	return 0
+}
+
+func asInt64(v interface{}) (int64, bool) {
+	switch x := v.(type) {
+	case int8:
+		return int64(x), true
+	case int16:
+		return int64(x), true
+	case int32:
+		return int64(x), true
+	case int64:
+		return x, true
+	case types.Date:
+		return int64(x), true
+	case types.Datetime:
+		return int64(x), true
+	}
+	return 0, false
+}
+
+func asUint64(v interface{}) (uint64, bool) {
+	switch x := v.(type) {
+	case uint8:
+		return uint64(x), true
+	case uint16:
+		return uint64(x), true
+	case uint32:
+		return uint64(x), true
+	case uint64:
+		return x, true
+	}
+	return 0, false
+}
+
+func asFloat64(v interface{}) (float64, bool) {
+	switch x := v.(type) {
+	case float32:
+		return float64(x), true
+	case float64:
+		return x, true
+	}
+	return 0, false
+}
+
+// rowFilter evaluates the conjunction of the conditions on the decoded row.
+// The conditions on the attributes which are not read are dropped.
+type rowFilter struct {
+	conditions []Condition
+
+	//the positions of the attributes of the conditions in the batch
+	positions []int
+
+	//the decoded attributes of the row at their positions in the batch
+	row []*orderedcodec.DecodedItem
+
+	//the positions needed by the conditions
+	needed []bool
+}
+
+func newRowFilter(conditions []Condition, readAttrs []*descriptor.AttributeDesc) *rowFilter {
+	rf := &rowFilter{
+		row:    make([]*orderedcodec.DecodedItem, len(readAttrs)),
+		needed: make([]bool, len(readAttrs)),
+	}
+	for _, cond := range conditions {
+		for i, attr := range readAttrs {
+			if attr.ID == cond.AttributeID {
+				rf.conditions = append(rf.conditions, cond)
+				rf.positions = append(rf.positions, i)
+				rf.needed[i] = true
+				break
+			}
+		}
+	}
+	if len(rf.conditions) == 0 {
+		return nil
+	}
+	return rf
+}
+
+// collect puts the decoded attributes needed into the row.
+func (rf *rowFilter) collect(dis []*orderedcodec.DecodedItem, am *AttributeMap) error {
+	for i := 0; i < am.Length(); i++ {
+		_, positionInDis, positionInBatch, _ := am.Get(i)
+		if !rf.needed[positionInBatch] {
+			continue
+		}
+		if positionInDis < 0 || positionInDis >= len(dis) {
+			return errorInvalidAttributeId
+		}
+		rf.row[positionInBatch] = dis[positionInDis]
+	}
+	return nil
+}
+
+// collectValue decodes the attributes needed from the value in the layout.
+func (rf *rowFilter) collectValue(vdis []*ValueDecodedItem, am *AttributeMap) error {
+	for i := 0; i < am.Length(); i++ {
+		_, _, positionInBatch, positionInDis := am.Get(i)
+		if !rf.needed[positionInBatch] {
+			continue
+		}
+		if positionInDis < 0 || positionInDis >= len(vdis) {
+			return errorInvalidAttributePosition
+		}
+		di, err := vdis[positionInDis].DecodeValue()
+		if err != nil {
+			return err
+		}
+		rf.row[positionInBatch] = di
+	}
+	return nil
+}
+
+func (rf *rowFilter) match() bool {
+	for i := range rf.conditions {
+		di := rf.row[rf.positions[i]]
+		if di != nil && !rf.conditions[i].match(di) {
+			return false
+		}
+	}
+	return true
+}
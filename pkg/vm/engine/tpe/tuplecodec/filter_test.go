@@ -0,0 +1,139 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tuplecodec
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tpe/descriptor"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tpe/orderedcodec"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestCondition_match(t *testing.T) {
+	convey.Convey("condition match", t, func() {
+		type args struct {
+			cond  Condition
+			value interface{}
+			want  bool
+		}
+
+		kases := []args{
+			{Condition{Op: CompareEQ, Value: uint64(1)}, uint64(1), true},
+			{Condition{Op: CompareNE, Value: uint64(1)}, uint64(1), false},
+			{Condition{Op: CompareLT, Value: int8(-1)}, int8(-2), true},
+			{Condition{Op: CompareLE, Value: int32(3)}, int32(4), false},
+			{Condition{Op: CompareGT, Value: float32(1.5)}, float32(2), true},
+			{Condition{Op: CompareGE, Value: float64(1.5)}, float64(1), false},
+			{Condition{Op: CompareEQ, Value: "abc"}, "abc", true},
+			{Condition{Op: CompareLT, Value: "abc"}, []byte("abb"), true},
+			{Condition{Op: CompareGT, Value: types.Date(10)}, types.Date(11), true},
+			{Condition{Op: CompareHasPrefix, Value: []byte("ab")}, "abc", true},
+			{Condition{Op: CompareHasPrefix, Value: []byte("ab")}, []byte("ba"), false},
+			//the values which can not be compared are kept
+			{Condition{Op: CompareEQ, Value: uint64(1)}, "1", true},
+		}
+
+		for _, kase := range kases {
+			di := &orderedcodec.DecodedItem{Value: kase.value}
+			convey.So(kase.cond.match(di), convey.ShouldEqual, kase.want)
+		}
+
+		//the null satisfies nothing
+		di := &orderedcodec.DecodedItem{ValueType: orderedcodec.VALUE_TYPE_NULL}
+		convey.So((&Condition{Op: CompareNE, Value: uint64(1)}).match(di), convey.ShouldBeFalse)
+	})
+}
+
+func TestRowFilter(t *testing.T) {
+	convey.Convey("row filter", t, func() {
+		readAttrs := []*descriptor.AttributeDesc{
+			{ID: 2}, {ID: 0},
+		}
+		rf := newRowFilter([]Condition{{AttributeID: 1, Op: CompareEQ, Value: uint64(1)}}, readAttrs)
+		convey.So(rf, convey.ShouldBeNil)
+
+		rf = newRowFilter([]Condition{
+			{AttributeID: 1, Op: CompareEQ, Value: uint64(1)},
+			{AttributeID: 0, Op: CompareGT, Value: uint64(1)},
+		}, readAttrs)
+		convey.So(rf, convey.ShouldNotBeNil)
+		convey.So(len(rf.conditions), convey.ShouldEqual, 1)
+
+		am := &AttributeMap{}
+		am.Append(2, 0, 0)
+		am.Append(0, 1, 1)
+		am.BuildPositionInDecodedItemArray()
+
+		dis := []*orderedcodec.DecodedItem{
+			{Value: uint64(10)}, {Value: uint64(1)},
+		}
+		convey.So(rf.collect(dis, am), convey.ShouldBeNil)
+		convey.So(rf.match(), convey.ShouldBeFalse)
+
+		dis[1].Value = uint64(2)
+		convey.So(rf.collect(dis, am), convey.ShouldBeNil)
+		convey.So(rf.match(), convey.ShouldBeTrue)
+	})
+}
+
+func TestIndexHandlerImpl_encodeIndexRange(t *testing.T) {
+	convey.Convey("encode index range", t, func() {
+		ihi := &IndexHandlerImpl{tch: NewTupleCodecHandler(SystemTenantID)}
+		oe := orderedcodec.NewOrderedEncoder()
+		prefix := TupleKey("prefix")
+		encode := func(values ...interface{}) TupleKey {
+			key := append(TupleKey{}, prefix...)
+			for _, value := range values {
+				key, _ = oe.EncodeKey(key, value)
+			}
+			return key
+		}
+		in := func(key, start, end TupleKey) bool {
+			return bytes.Compare(start, key) <= 0 && key.Less(end)
+		}
+
+		start, end := ihi.encodeIndexRange(prefix, nil)
+		convey.So(in(encode(uint64(1)), start, end), convey.ShouldBeTrue)
+
+		//the point
+		start, end = ihi.encodeIndexRange(prefix, &IndexRange{Prefix: []interface{}{uint64(1), "a"}})
+		convey.So(bytes.Equal(start, encode(uint64(1), "a")), convey.ShouldBeTrue)
+		convey.So(in(encode(uint64(1), "a"), start, end), convey.ShouldBeTrue)
+		convey.So(in(encode(uint64(1), "b"), start, end), convey.ShouldBeFalse)
+
+		//the prefix and the range
+		start, end = ihi.encodeIndexRange(prefix, &IndexRange{
+			Prefix:        []interface{}{uint64(1)},
+			Low:           uint64(5),
+			High:          uint64(9),
+			HighInclusive: true,
+		})
+		convey.So(in(encode(uint64(1), uint64(5)), start, end), convey.ShouldBeFalse)
+		convey.So(in(encode(uint64(1), uint64(6), uint64(0)), start, end), convey.ShouldBeTrue)
+		convey.So(in(encode(uint64(1), uint64(9), uint64(0)), start, end), convey.ShouldBeTrue)
+		convey.So(in(encode(uint64(1), uint64(10)), start, end), convey.ShouldBeFalse)
+		convey.So(in(encode(uint64(2), uint64(6)), start, end), convey.ShouldBeFalse)
+
+		//the bytes prefix
+		start, end = ihi.encodeIndexRange(prefix, &IndexRange{BytesPrefix: []byte("ab")})
+		convey.So(in(encode("ab"), start, end), convey.ShouldBeTrue)
+		convey.So(in(encode("abc"), start, end), convey.ShouldBeTrue)
+		convey.So(in(encode("a"), start, end), convey.ShouldBeFalse)
+		convey.So(in(encode("b"), start, end), convey.ShouldBeFalse)
+	})
+}
@@ -42,6 +42,10 @@ var _ index.IndexHandler = &IndexHandlerImpl{}
 
 type callbackPackage struct {
 	prefix TupleKey
+
+	//the secondary indexes to be maintained and their prefixes
+	indexes       []*descriptor.IndexDesc
+	indexPrefixes []TupleKey
 }
 
 type IndexHandlerImpl struct {
@@ -108,7 +112,6 @@ func (ihi *IndexHandlerImpl) parallelReader(indexReadCtx *ReadContext) (*batch.B
 
 	//1.encode prefix (tenantID,dbID,tableID,indexID)
 	tke := ihi.tch.GetEncoder()
-	tkd := ihi.tch.GetDecoder()
 
 	//need table prefix also in parallel read
 	if len(indexReadCtx.PrefixForScanKey) == 0 {
@@ -151,6 +154,8 @@ func (ihi *IndexHandlerImpl) parallelReader(indexReadCtx *ReadContext) (*batch.B
 	//	return nil, 0, nil
 	//}
 
+	rf := newRowFilter(indexReadCtx.Filter, indexReadCtx.ReadAttributeDescs)
+
 	//prepare the batch
 	names, attrdefs := ConvertAttributeDescIntoTypesType(indexReadCtx.ReadAttributeDescs)
 	bat := MakeBatch(int(ihi.kvLimit), names, attrdefs)
@@ -174,73 +179,21 @@ func (ihi *IndexHandlerImpl) parallelReader(indexReadCtx *ReadContext) (*batch.B
 			return nil, 0, err
 		}
 
-		rowRead += len(keys)
 		indexReadCtx.addReadCount(len(keys))
 
-		//1.decode index key
-		//2.get fields wanted
-		for i := 0; i < len(keys); i++ {
-			//!!!Note: Reserve the logic for the regression test
-			//if !keys[i].Less(indexReadCtx.ShardScanEndKey) {
-			//	break
-			//}
-			//for test
-			//logutil.Infof("keyvalue %v %v", keys[i], values[i])
-			//rowRead++
-			//indexReadCtx.addReadCount(1)
-			indexKey := keys[i][indexReadCtx.LengthOfPrefixForScanKey:]
-			_, dis, err := tkd.DecodePrimaryIndexKey(indexKey, indexReadCtx.IndexDesc)
-			if err != nil {
-				return nil, 0, err
-			}
-
-			//pick wanted fields and save them in the batch
-			err = ihi.rcc.FillBatchFromDecodedIndexKey(indexReadCtx.IndexDesc,
-				0, dis, amForKey, bat, i)
-			if err != nil {
-				return nil, 0, err
-			}
+		visibleKeys, visibleValues, err := ihi.skipChangedRows(indexReadCtx, keys, values)
+		if err != nil {
+			return nil, 0, err
 		}
 
-		//skip decoding the value
-		if !needKeyOnly {
-			//need to update prefix
-			//decode index value
-			for i := 0; i < len(keys); i++ {
-				//!!!Note: Reserve the logic for the regression test
-				//if !keys[i].Less(indexReadCtx.ShardScanEndKey) {
-				//	break
-				//}
-				//decode the name which is in the value
-				data := values[i]
-				if ihi.useLayout {
-					vdis, err := ihi.decodePrimaryIndexValue(data, indexReadCtx, amForValue)
-					if err != nil {
-						return nil, 0, err
-					}
-
-					//fill the batch
-					err = ihi.rcc.FillBatchFromDecodedIndexValue2(indexReadCtx.IndexDesc,
-						0, vdis, amForValue, bat, i)
-					if err != nil {
-						return nil, 0, err
-					}
-				} else {
-					_, dis, err := tkd.DecodePrimaryIndexValue(data,
-						indexReadCtx.IndexDesc, 0, ihi.serializer)
-					if err != nil {
-						return nil, 0, err
-					}
-
-					//pick wanted fields and save them in the batch
-					err = ihi.rcc.FillBatchFromDecodedIndexValue(indexReadCtx.IndexDesc,
-						0, dis, amForValue, bat, i)
-					if err != nil {
-						return nil, 0, err
-					}
-				}
-			}
+		//1.decode index key and value
+		//2.get fields wanted
+		filled, err := ihi.fillBatchFromPrimaryIndex(indexReadCtx, visibleKeys, visibleValues,
+			amForKey, amForValue, needKeyOnly, rf, bat, rowRead)
+		if err != nil {
+			return nil, 0, err
 		}
+		rowRead += filled
 
 		//get the next prefix
 		//logutil.Infof("readCtx after complete %v prefix %v nextScanKey %v ParallelReaderContext %v",
@@ -319,6 +272,28 @@ func (ihi *IndexHandlerImpl) decodePrimaryIndexValue(data []byte, ctx *ReadConte
 	if err != nil {
 		return nil, err
 	}
+	//the rows written before some attributes are added do not have them.
+	//the added attributes have the largest ids, so they are the last ones.
+	for i := len(vdis); i < amForValue.Length(); i++ {
+		id := uint32(amForValue.GetAttributeAtSortedIndex(i))
+		var fill []byte
+		for _, attr := range ctx.TableDesc.Attributes {
+			if attr.ID == id {
+				fill = attr.Fill
+				break
+			}
+		}
+		if len(fill) == 0 {
+			return nil, errorAttributeDoesNotHaveThePosition
+		}
+		vdis = append(vdis, &ValueDecodedItem{
+			OffsetInUndecodedKey:     -1,
+			RawBytes:                 fill,
+			BytesCountInUndecodedKey: -1,
+			ID:                       id,
+			serializer:               ihi.serializer,
+		})
+	}
 	return vdis, nil
 }
 
@@ -362,6 +337,8 @@ func (ihi *IndexHandlerImpl) getPrimaryIndexKeyOfValue(readCtx interface{}, opt
 			value = types.Date(v)
 		case types.T_datetime:
 			value = types.Datetime(v)
+		case types.T_decimal:
+			value = types.NewDecimal(v)
 		default:
 			logutil.Errorf("getPrimaryIndexKeyOfValue : unsupported type %d \n", indexReadCtx.IndexDesc.Attributes[i].Type)
 			return nil, fmt.Errorf("getPrimaryIndexKeyOfValue : unsupported type %d \n", indexReadCtx.IndexDesc.Attributes[i].Type)
@@ -527,6 +504,14 @@ func (ihi *IndexHandlerImpl) ReadFromIndex(readCtx interface{}) (*batch.Batch, i
 		return ihi.DumpReadFromIndex(readCtx)
 	}
 
+	if indexReadCtx.ReadingDeletedRows {
+		return ihi.readDeletedRows(indexReadCtx)
+	}
+
+	if indexReadCtx.IndexDesc.ID != PrimaryIndexID {
+		return ihi.readFromSecondaryIndex(indexReadCtx)
+	}
+
 	if indexReadCtx.ParallelReader || indexReadCtx.MultiNode {
 		return ihi.parallelReader(indexReadCtx)
 	}
@@ -565,17 +550,26 @@ func (ihi *IndexHandlerImpl) ReadFromIndex(readCtx interface{}) (*batch.Batch, i
 
 	//1.encode prefix (tenantID,dbID,tableID,indexID)
 	tke := ihi.tch.GetEncoder()
-	tkd := ihi.tch.GetDecoder()
 
 	if indexReadCtx.CompleteInAllShards {
 		return nil, 0, nil
 	} else if !indexReadCtx.CompleteInAllShards &&
 		indexReadCtx.PrefixForScanKey == nil {
-		indexReadCtx.PrefixForScanKey, _ = tke.EncodeIndexPrefix(indexReadCtx.PrefixForScanKey, uint64(indexReadCtx.DbDesc.ID),
+		prefix, _ := tke.EncodeIndexPrefix(nil, uint64(indexReadCtx.DbDesc.ID),
 			uint64(indexReadCtx.TableDesc.ID),
 			uint64(indexReadCtx.IndexDesc.ID))
-		indexReadCtx.LengthOfPrefixForScanKey = len(indexReadCtx.PrefixForScanKey)
-		indexReadCtx.PrefixEnd = SuccessorOfPrefix(indexReadCtx.PrefixForScanKey)
+		indexReadCtx.LengthOfPrefixForScanKey = len(prefix)
+		indexReadCtx.PrefixForScanKey, indexReadCtx.PrefixEnd = ihi.encodeIndexRange(prefix, indexReadCtx.IndexRange)
+	}
+
+	rf := newRowFilter(indexReadCtx.Filter, indexReadCtx.ReadAttributeDescs)
+
+	//the value of the row which has attributes out of the key is not empty.
+	//so the empty value from the Get means there is no such row.
+	indexRange := indexReadCtx.IndexRange
+	if indexRange != nil && indexRange.isPointOf(indexReadCtx.IndexDesc) &&
+		len(indexReadCtx.TableDesc.Attributes) > len(indexAttrIDs) {
+		return ihi.getFromPrimaryIndex(indexReadCtx, amForKey, amForValue, rf)
 	}
 
 	//prepare the batch
@@ -589,68 +583,382 @@ func (ihi *IndexHandlerImpl) ReadFromIndex(readCtx interface{}) (*batch.Batch, i
 	//get keys with the prefix
 	for rowRead < int(ihi.kvLimit) {
 		needRead := int(ihi.kvLimit) - rowRead
-		keys, values, complete, nextScanKey, err := ihi.kv.GetWithPrefix(indexReadCtx.PrefixForScanKey, indexReadCtx.LengthOfPrefixForScanKey, indexReadCtx.PrefixEnd, needKeyOnly, uint64(needRead))
+		var keys []TupleKey
+		var values []TupleValue
+		var complete bool
+		var nextScanKey TupleKey
+		var err error
+		if indexRange != nil {
+			keys, values, complete, nextScanKey, err = ihi.kv.GetRangeWithLimit(indexReadCtx.PrefixForScanKey, indexReadCtx.PrefixEnd, uint64(needRead))
+		} else {
+			keys, values, complete, nextScanKey, err = ihi.kv.GetWithPrefix(indexReadCtx.PrefixForScanKey, indexReadCtx.LengthOfPrefixForScanKey, indexReadCtx.PrefixEnd, needKeyOnly, uint64(needRead))
+		}
 		if err != nil {
 			return nil, 0, err
 		}
 
-		rowRead += len(keys)
+		visibleKeys, visibleValues, err := ihi.skipChangedRows(indexReadCtx, keys, values)
+		if err != nil {
+			return nil, 0, err
+		}
 
-		//1.decode index key
+		//1.decode index key and value
 		//2.get fields wanted
+		filled, err := ihi.fillBatchFromPrimaryIndex(indexReadCtx, visibleKeys, visibleValues,
+			amForKey, amForValue, needKeyOnly, rf, bat, rowRead)
+		if err != nil {
+			return nil, 0, err
+		}
+		rowRead += filled
+
+		//get the next prefix
+		indexReadCtx.PrefixForScanKey = nextScanKey
+		if complete {
+			indexReadCtx.CompleteInAllShards = true
+			readFinished = true
+			break
+		}
+	}
+
+	TruncateBatch(bat, int(ihi.kvLimit), rowRead)
+
+	err := SerializeVectorForBatch(bat)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if readFinished {
+		if rowRead == 0 {
+			//there are no data read in this call.
+			//it means there is no data any more.
+			//reset the batch to the null to notify the
+			//computation engine will not read data again.
+			bat = nil
+		}
+	}
+
+	return bat, rowRead, nil
+}
+
+//getFromPrimaryIndex gets the row of the point range with one Get.
+func (ihi *IndexHandlerImpl) getFromPrimaryIndex(indexReadCtx *ReadContext, amForKey, amForValue *AttributeMap, rf *rowFilter) (*batch.Batch, int, error) {
+	indexReadCtx.CompleteInAllShards = true
+	key := indexReadCtx.PrefixForScanKey
+	value, err := ihi.kv.Get(key)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(value) == 0 {
+		return nil, 0, nil
+	}
+
+	names, attrdefs := ConvertAttributeDescIntoTypesType(indexReadCtx.ReadAttributeDescs)
+	bat := MakeBatch(1, names, attrdefs)
+	rowRead, err := ihi.fillBatchFromPrimaryIndex(indexReadCtx, []TupleKey{key}, []TupleValue{value},
+		amForKey, amForValue, amForValue.Length() == 0, rf, bat, 0)
+	if err != nil {
+		return nil, 0, err
+	}
+	if rowRead == 0 {
+		return nil, 0, nil
+	}
+
+	err = SerializeVectorForBatch(bat)
+	if err != nil {
+		return nil, 0, err
+	}
+	return bat, rowRead, nil
+}
+
+//fillBatchFromPrimaryIndex decodes the rows of the primary index and puts the ones
+//satisfying the filter into the batch from the row rowIndex.
+//It returns the count of the rows put into the batch.
+func (ihi *IndexHandlerImpl) fillBatchFromPrimaryIndex(indexReadCtx *ReadContext,
+	keys []TupleKey, values []TupleValue,
+	amForKey, amForValue *AttributeMap, needKeyOnly bool,
+	rf *rowFilter, bat *batch.Batch, rowIndex int) (int, error) {
+	tkd := ihi.tch.GetDecoder()
+	filled := 0
+	for i := 0; i < len(keys); i++ {
+		indexKey := keys[i][indexReadCtx.LengthOfPrefixForScanKey:]
+		_, dis, err := tkd.DecodePrimaryIndexKey(indexKey, indexReadCtx.IndexDesc)
+		if err != nil {
+			return 0, err
+		}
+
+		//decode the attributes which are in the value
+		var vdis []*ValueDecodedItem
+		var valueDis []*orderedcodec.DecodedItem
+		if !needKeyOnly {
+			if ihi.useLayout {
+				vdis, err = ihi.decodePrimaryIndexValue(values[i], indexReadCtx, amForValue)
+			} else {
+				_, valueDis, err = tkd.DecodePrimaryIndexValue(values[i],
+					indexReadCtx.IndexDesc, 0, ihi.serializer)
+			}
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		//skip the row before it is put into the batch
+		if rf != nil {
+			err = rf.collect(dis, amForKey)
+			if err == nil && !needKeyOnly {
+				if ihi.useLayout {
+					err = rf.collectValue(vdis, amForValue)
+				} else {
+					err = rf.collect(valueDis, amForValue)
+				}
+			}
+			if err != nil {
+				return 0, err
+			}
+			if !rf.match() {
+				continue
+			}
+		}
+
+		//pick wanted fields and save them in the batch
+		row := rowIndex + filled
+		err = ihi.rcc.FillBatchFromDecodedIndexKey(indexReadCtx.IndexDesc,
+			0, dis, amForKey, bat, row)
+		if err != nil {
+			return 0, err
+		}
+
+		if !needKeyOnly {
+			if ihi.useLayout {
+				err = ihi.rcc.FillBatchFromDecodedIndexValue2(indexReadCtx.IndexDesc,
+					0, vdis, amForValue, bat, row)
+			} else {
+				err = ihi.rcc.FillBatchFromDecodedIndexValue(indexReadCtx.IndexDesc,
+					0, valueDis, amForValue, bat, row)
+			}
+			if err != nil {
+				return 0, err
+			}
+		}
+		filled++
+	}
+	return filled, nil
+}
+
+//encodeIndexRange encodes the range on the attributes of the index
+//into the key range [startKey,endKey).
+func (ihi *IndexHandlerImpl) encodeIndexRange(prefix TupleKey, indexRange *IndexRange) (TupleKey, TupleKey) {
+	return encodeIndexRange(ihi.tch.GetEncoder(), prefix, indexRange)
+}
+
+func encodeIndexRange(tke *TupleKeyEncoder, prefix TupleKey, indexRange *IndexRange) (TupleKey, TupleKey) {
+	if indexRange == nil {
+		return prefix, SuccessorOfPrefix(prefix)
+	}
+	encode := func(value interface{}) TupleKey {
+		key := make(TupleKey, len(prefix))
+		copy(key, prefix)
+		key, _ = tke.oe.EncodeKey(key, value)
+		return key
+	}
+	for _, value := range indexRange.Prefix {
+		prefix = encode(value)
+	}
+	startKey, endKey := prefix, SuccessorOfPrefix(prefix)
+	if indexRange.BytesPrefix != nil {
+		key := make(TupleKey, len(prefix))
+		copy(key, prefix)
+		startKey, _ = tke.oe.EncodeBytesPrefix(key, indexRange.BytesPrefix)
+		return startKey, SuccessorOfPrefix(startKey)
+	}
+	if indexRange.Low != nil {
+		startKey = encode(indexRange.Low)
+		if !indexRange.LowInclusive {
+			startKey = SuccessorOfPrefix(startKey)
+		}
+	} else if indexRange.High != nil {
+		//the null is less than any value and does not satisfy the predicate
+		startKey = SuccessorOfPrefix(encode(nil))
+	}
+	if indexRange.High != nil {
+		endKey = encode(indexRange.High)
+		if indexRange.HighInclusive {
+			endKey = SuccessorOfPrefix(endKey)
+		}
+	}
+	return startKey, endKey
+}
+
+//readFromSecondaryIndex scans the range of the secondary index.
+//When the attributes wanted are in the index key or stored in the index value,
+//it is an index-only scan. Otherwise, the rows are got from the primary index.
+func (ihi *IndexHandlerImpl) readFromSecondaryIndex(indexReadCtx *ReadContext) (*batch.Batch, int, error) {
+	if indexReadCtx.CompleteInAllShards {
+		return nil, 0, nil
+	}
+
+	index := indexReadCtx.IndexDesc
+	primary := &indexReadCtx.TableDesc.Primary_index
+
+	//the index key has the attributes of the index and the primary key
+	keyAttrIDs := descriptor.ExtractIndexAttributeIDs(primary.Attributes)
+	for id, pos := range keyAttrIDs {
+		keyAttrIDs[id] = pos + len(index.Attributes)
+	}
+	for pos, attr := range index.Attributes {
+		keyAttrIDs[attr.ID] = pos
+	}
+	storeAttrIDs := descriptor.ExtractIndexAttributeIDs(index.Store_attributes)
+
+	indexOnly := true
+	for _, attr := range indexReadCtx.ReadAttributeDescs {
+		_, inKey := keyAttrIDs[attr.ID]
+		_, inStore := storeAttrIDs[attr.ID]
+		if !inKey && !inStore {
+			indexOnly = false
+			break
+		}
+	}
+
+	amForKey := &AttributeMap{}
+	amForValue := &AttributeMap{}
+	var positionsInValue map[uint32]int
+	if !indexOnly && ihi.useLayout {
+		positionsInValue = ihi.layoutSerializer.GetPositionsOfAttributesInTheValue(indexReadCtx.TableDesc, primary)
+	}
+	for i, attr := range indexReadCtx.ReadAttributeDescs {
+		if positionInKey, exist := keyAttrIDs[attr.ID]; exist {
+			amForKey.Append(int(attr.ID), positionInKey, i)
+		} else if indexOnly {
+			amForValue.Append(int(attr.ID), storeAttrIDs[attr.ID], i)
+		} else {
+			//the value of the primary index has all attributes
+			positionInValue := int(attr.ID)
+			if ihi.useLayout {
+				var exist2 bool
+				if positionInValue, exist2 = positionsInValue[attr.ID]; !exist2 {
+					return nil, 0, errorInvalidAttributePosition
+				}
+			}
+			amForValue.Append(int(attr.ID), positionInValue, i)
+		}
+	}
+	amForKey.BuildPositionInDecodedItemArray()
+	amForValue.BuildPositionInDecodedItemArray()
+
+	tke := ihi.tch.GetEncoder()
+	tkd := ihi.tch.GetDecoder()
+
+	if indexReadCtx.PrefixForScanKey == nil {
+		prefix, _ := tke.EncodeIndexPrefix(nil, uint64(indexReadCtx.DbDesc.ID),
+			uint64(indexReadCtx.TableDesc.ID),
+			uint64(index.ID))
+		indexReadCtx.LengthOfPrefixForScanKey = len(prefix)
+		indexReadCtx.PrefixForScanKey, indexReadCtx.PrefixEnd = ihi.encodeIndexRange(prefix, indexReadCtx.IndexRange)
+	}
+	primaryPrefix, _ := tke.EncodeIndexPrefix(nil, uint64(indexReadCtx.DbDesc.ID),
+		uint64(indexReadCtx.TableDesc.ID),
+		uint64(primary.ID))
+
+	rf := newRowFilter(indexReadCtx.Filter, indexReadCtx.ReadAttributeDescs)
+
+	//prepare the batch
+	names, attrdefs := ConvertAttributeDescIntoTypesType(indexReadCtx.ReadAttributeDescs)
+	bat := MakeBatch(int(ihi.kvLimit), names, attrdefs)
+
+	rowRead := 0
+	readFinished := false
+
+	for rowRead < int(ihi.kvLimit) {
+		needRead := int(ihi.kvLimit) - rowRead
+		keys, values, complete, nextScanKey, err := ihi.kv.GetRangeWithLimit(indexReadCtx.PrefixForScanKey, indexReadCtx.PrefixEnd, uint64(needRead))
+		if err != nil {
+			return nil, 0, err
+		}
+
+		keyDis := make([][]*orderedcodec.DecodedItem, len(keys))
+		var primaryKeys []TupleKey
 		for i := 0; i < len(keys); i++ {
 			indexKey := keys[i][indexReadCtx.LengthOfPrefixForScanKey:]
-			_, dis, err := tkd.DecodePrimaryIndexKey(indexKey, indexReadCtx.IndexDesc)
+			pk, dis, err := tkd.DecodeSecondaryIndexKey(indexKey, index, primary)
 			if err != nil {
 				return nil, 0, err
 			}
+			keyDis[i] = dis
+
+			if !indexOnly {
+				primaryKey := make(TupleKey, 0, len(primaryPrefix)+len(pk))
+				primaryKey = append(primaryKey, primaryPrefix...)
+				primaryKeys = append(primaryKeys, append(primaryKey, pk...))
+			}
+		}
 
-			//pick wanted fields and save them in the batch
-			err = ihi.rcc.FillBatchFromDecodedIndexKey(indexReadCtx.IndexDesc,
-				0, dis, amForKey, bat, i)
+		if amForValue.Length() != 0 && !indexOnly {
+			values, err = ihi.kv.GetBatch(primaryKeys)
 			if err != nil {
 				return nil, 0, err
 			}
 		}
 
-		//skip decoding the value
-		if !needKeyOnly {
-			//need to update prefix
-			//decode index value
-			for i := 0; i < len(keys); i++ {
-				//decode the name which is in the value
-				data := values[i]
-
-				if ihi.useLayout {
-					vdis, err := ihi.decodePrimaryIndexValue(data, indexReadCtx, amForValue)
-					if err != nil {
-						return nil, 0, err
-					}
-
-					//fill the batch
-					err = ihi.rcc.FillBatchFromDecodedIndexValue2(indexReadCtx.IndexDesc,
-						0, vdis, amForValue, bat, i)
-					if err != nil {
-						return nil, 0, err
-					}
+		filled := 0
+		for i := 0; i < len(keys); i++ {
+			//decode the attributes which are in the value
+			var vdis []*ValueDecodedItem
+			var valueDis []*orderedcodec.DecodedItem
+			if amForValue.Length() != 0 {
+				//the row has been deleted after the index is scanned
+				if len(values[i]) == 0 {
+					continue
+				}
+				if !indexOnly && ihi.useLayout {
+					vdis, err = ihi.decodePrimaryIndexValue(values[i], indexReadCtx, amForValue)
 				} else {
-					_, dis, err := tkd.DecodePrimaryIndexValue(data,
-						indexReadCtx.IndexDesc, 0, ihi.serializer)
-					if err != nil {
-						return nil, 0, err
-					}
-
-					//pick wanted fields and save them in the batch
-					err = ihi.rcc.FillBatchFromDecodedIndexValue(indexReadCtx.IndexDesc,
-						0, dis, amForValue, bat, i)
-					if err != nil {
-						return nil, 0, err
-					}
+					_, valueDis, err = tkd.DecodePrimaryIndexValue(values[i],
+						index, 0, ihi.serializer)
 				}
+				if err != nil {
+					return nil, 0, err
+				}
+			}
+
+			//skip the row before it is put into the batch
+			if rf != nil {
+				err = rf.collect(keyDis[i], amForKey)
+				if err == nil && vdis != nil {
+					err = rf.collectValue(vdis, amForValue)
+				} else if err == nil && valueDis != nil {
+					err = rf.collect(valueDis, amForValue)
+				}
+				if err != nil {
+					return nil, 0, err
+				}
+				if !rf.match() {
+					continue
+				}
+			}
+
+			row := rowRead + filled
+			err = ihi.rcc.FillBatchFromDecodedIndexKey(index,
+				0, keyDis[i], amForKey, bat, row)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			if vdis != nil {
+				err = ihi.rcc.FillBatchFromDecodedIndexValue2(primary,
+					0, vdis, amForValue, bat, row)
+			} else if valueDis != nil {
+				err = ihi.rcc.FillBatchFromDecodedIndexValue(index,
+					0, valueDis, amForValue, bat, row)
+			}
+			if err != nil {
+				return nil, 0, err
 			}
+			filled++
 		}
 
-		//get the next prefix
+		rowRead += filled
+
+		//get the next key
 		indexReadCtx.PrefixForScanKey = nextScanKey
 		if complete {
 			indexReadCtx.CompleteInAllShards = true
@@ -666,27 +974,26 @@ func (ihi *IndexHandlerImpl) ReadFromIndex(readCtx interface{}) (*batch.Batch, i
 		return nil, 0, err
 	}
 
-	if readFinished {
-		if rowRead == 0 {
-			//there are no data read in this call.
-			//it means there is no data any more.
-			//reset the batch to the null to notify the
-			//computation engine will not read data again.
-			bat = nil
-		}
+	if readFinished && rowRead == 0 {
+		bat = nil
 	}
 
 	return bat, rowRead, nil
 }
 
 func (ihi *IndexHandlerImpl) WriteIntoTable(table *descriptor.RelationDesc, writeCtx interface{}, bat *batch.Batch) error {
-	return ihi.WriteIntoIndex(writeCtx, bat)
+	indexWriteCtx, ok := writeCtx.(*WriteContext)
+	if !ok {
+		return errorWriteContextIsInvalid
+	}
+	return ihi.writeIndexes(indexWriteCtx, bat, table.Indexes)
 }
 
 //encodePrimaryIndexKey encodes the tuple into bytes.
 //The prefix has the tenantID,dbID,tableID,IndexID.
 func (ihi *IndexHandlerImpl) encodePrimaryIndexKey(columnGroupID uint64, writeCtx *WriteContext, tuple Tuple) (TupleKey, *orderedcodec.EncodedItem, error) {
-	if writeCtx.IndexDesc.ID != PrimaryIndexID {
+	index := writeCtx.primaryIndex()
+	if index.ID != PrimaryIndexID {
 		return nil, nil, errorPrimaryIndexIDIsNotOne
 	}
 	tke := ihi.tch.GetEncoder()
@@ -700,9 +1007,11 @@ func (ihi *IndexHandlerImpl) encodePrimaryIndexKey(columnGroupID uint64, writeCt
 	key := make(TupleKey, len(writeCtx.callback.prefix))
 	copy(key, writeCtx.callback.prefix)
 	var value interface{}
-	var err error
-	for _, attr := range writeCtx.IndexDesc.Attributes {
-		writeState := &writeCtx.AttributeStates[attr.ID]
+	for _, attr := range index.Attributes {
+		writeState, err := writeCtx.attributeState(attr.ID)
+		if err != nil {
+			return nil, nil, err
+		}
 		//the logic for implicit primary key or default expr
 		if writeState.NeedGenerated {
 			if writeState.AttrDesc.Default.Exist { //default expr
@@ -783,6 +1092,61 @@ func (ihi *IndexHandlerImpl) encodePrimaryIndexValue(columnGroupID uint64, write
 	return out, nil, nil
 }
 
+//getAttributeValue gets the value of the attribute from the tuple.
+//The implicit primary key has been generated by the primary index.
+func (ihi *IndexHandlerImpl) getAttributeValue(writeCtx *WriteContext, attrID uint32, tuple Tuple) (interface{}, error) {
+	writeState, err := writeCtx.attributeState(attrID)
+	if err != nil {
+		return nil, err
+	}
+	if writeState.NeedGenerated {
+		if writeState.AttrDesc.Default.Exist { //default expr
+			if writeState.AttrDesc.Default.IsNull {
+				return nil, nil
+			}
+			return writeState.AttrDesc.Default.Value, nil
+		}
+		return writeState.ImplicitPrimaryKey, nil
+	}
+	return tuple.GetValue(uint32(writeState.PositionInBatch))
+}
+
+//encodeSecondaryIndexKey encodes the attributes of the i-th secondary index
+//in the callback. The primary key is appended to make the key unique.
+func (ihi *IndexHandlerImpl) encodeSecondaryIndexKey(writeCtx *WriteContext, i int, tuple Tuple, primaryKey TupleKey) (TupleKey, error) {
+	tke := ihi.tch.GetEncoder()
+	prefix := writeCtx.callback.indexPrefixes[i]
+	key := make(TupleKey, len(prefix))
+	copy(key, prefix)
+	for _, attr := range writeCtx.callback.indexes[i].Attributes {
+		value, err := ihi.getAttributeValue(writeCtx, attr.ID, tuple)
+		if err != nil {
+			return nil, err
+		}
+		//the null is allowed in the secondary index
+		key, _ = tke.oe.EncodeKey(key, value)
+	}
+	key = append(key, primaryKey[len(writeCtx.callback.prefix):]...)
+	return key, nil
+}
+
+//encodeSecondaryIndexValue encodes the stored attributes of the i-th secondary
+//index in the callback. They are used by the index-only scan.
+func (ihi *IndexHandlerImpl) encodeSecondaryIndexValue(writeCtx *WriteContext, i int, tuple Tuple) (TupleValue, error) {
+	out := TupleValue{}
+	for _, attr := range writeCtx.callback.indexes[i].Store_attributes {
+		value, err := ihi.getAttributeValue(writeCtx, attr.ID, tuple)
+		if err != nil {
+			return nil, err
+		}
+		out, _, err = ihi.serializer.SerializeValue(out, value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
 func (ihi *IndexHandlerImpl) callbackForEncodeTupleInBatch(callbackCtx interface{}, tuple Tuple) error {
 	writeCtx := callbackCtx.(*WriteContext)
 
@@ -791,38 +1155,65 @@ func (ihi *IndexHandlerImpl) callbackForEncodeTupleInBatch(callbackCtx interface
 		return err
 	}
 
-	value, _, err := ihi.encodePrimaryIndexValue(0, writeCtx, tuple, ihi.serializer)
-	if err != nil {
-		return err
+	if writeCtx.IndexDesc.ID == PrimaryIndexID {
+		value, _, err := ihi.encodePrimaryIndexValue(0, writeCtx, tuple, ihi.serializer)
+		if err != nil {
+			return err
+		}
+
+		writeCtx.keys = append(writeCtx.keys, key)
+		writeCtx.values = append(writeCtx.values, value)
 	}
 
-	writeCtx.keys = append(writeCtx.keys, key)
-	writeCtx.values = append(writeCtx.values, value)
+	for i := range writeCtx.callback.indexes {
+		indexKey, err := ihi.encodeSecondaryIndexKey(writeCtx, i, tuple, key)
+		if err != nil {
+			return err
+		}
+		indexValue, err := ihi.encodeSecondaryIndexValue(writeCtx, i, tuple)
+		if err != nil {
+			return err
+		}
+		writeCtx.indexKeys = append(writeCtx.indexKeys, indexKey)
+		writeCtx.indexValues = append(writeCtx.indexValues, indexValue)
+	}
 	return nil
 }
 
-func (ihi *IndexHandlerImpl) WriteIntoIndex(writeCtx interface{}, bat *batch.Batch) error {
-	indexWriteCtx, ok := writeCtx.(*WriteContext)
-	if !ok {
-		return errorWriteContextIsInvalid
-	}
-
-	defer func() {
-		indexWriteCtx.resetWriteCache()
-	}()
-
+//prepareCallback encodes the prefix of the primary index and the prefixes of
+//the secondary indexes to be maintained.
+func (ihi *IndexHandlerImpl) prepareCallback(writeCtx *WriteContext, indexes []descriptor.IndexDesc) {
 	//1.encode prefix (tenantID,dbID,tableID,indexID)
 	tke := ihi.tch.GetEncoder()
 	var prefix TupleKey
 	prefix, _ = tke.EncodeIndexPrefix(prefix,
-		uint64(indexWriteCtx.DbDesc.ID),
-		uint64(indexWriteCtx.TableDesc.ID),
-		uint64(indexWriteCtx.IndexDesc.ID))
+		uint64(writeCtx.DbDesc.ID),
+		uint64(writeCtx.TableDesc.ID),
+		uint64(writeCtx.primaryIndex().ID))
 
-	indexWriteCtx.callback = callbackPackage{
+	writeCtx.callback = callbackPackage{
 		prefix: prefix,
 	}
 
+	for i := range indexes {
+		indexPrefix, _ := tke.EncodeIndexPrefix(nil,
+			uint64(writeCtx.DbDesc.ID),
+			uint64(writeCtx.TableDesc.ID),
+			uint64(indexes[i].ID))
+		writeCtx.callback.indexes = append(writeCtx.callback.indexes, &indexes[i])
+		writeCtx.callback.indexPrefixes = append(writeCtx.callback.indexPrefixes, indexPrefix)
+	}
+}
+
+//writeIndexes writes the batch into the index of the write context
+//and the secondary indexes.
+func (ihi *IndexHandlerImpl) writeIndexes(indexWriteCtx *WriteContext, bat *batch.Batch, indexes []descriptor.IndexDesc) error {
+	defer func() {
+		indexWriteCtx.resetWriteCache()
+	}()
+
+	ihi.prepareCallback(indexWriteCtx, indexes)
+
 	//2.encode every row in the batch
 	ba := NewBatchAdapter(bat)
 	err := ba.ForEachTuple(indexWriteCtx, ihi.callbackForEncodeTupleInBatch)
@@ -830,15 +1221,51 @@ func (ihi *IndexHandlerImpl) WriteIntoIndex(writeCtx interface{}, bat *batch.Bat
 		return err
 	}
 
-	err = ihi.kv.DedupSetBatch(indexWriteCtx.keys, indexWriteCtx.values)
-	if err != nil {
-		return err
+	if indexWriteCtx.IndexDesc.ID == PrimaryIndexID {
+		changes, err := ihi.logChanges(indexWriteCtx, indexWriteCtx.keys, nil)
+		if err != nil {
+			return err
+		}
+		err = ihi.kv.DedupSetBatch(indexWriteCtx.keys, indexWriteCtx.values)
+		if err != nil {
+			//the rows are not inserted
+			if err2 := ihi.discardChanges(changes); err2 != nil {
+				logutil.Errorf("discard the changes of the rows not inserted failed. error: %v", err2)
+			}
+			return err
+		}
+	}
+
+	//the keys of the secondary index are unique with the primary key
+	if len(indexWriteCtx.indexKeys) != 0 {
+		err = ihi.kv.SetBatch(indexWriteCtx.indexKeys, indexWriteCtx.indexValues)
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
+func (ihi *IndexHandlerImpl) WriteIntoIndex(writeCtx interface{}, bat *batch.Batch) error {
+	indexWriteCtx, ok := writeCtx.(*WriteContext)
+	if !ok {
+		return errorWriteContextIsInvalid
+	}
+
+	//only the secondary index is written when building it on the existing rows
+	var indexes []descriptor.IndexDesc
+	if indexWriteCtx.IndexDesc.ID != PrimaryIndexID {
+		indexes = []descriptor.IndexDesc{*indexWriteCtx.IndexDesc}
+	}
+	return ihi.writeIndexes(indexWriteCtx, bat, indexes)
+}
+
 func (ihi *IndexHandlerImpl) DeleteFromTable(writeCtx interface{}, bat *batch.Batch) error {
-	return ihi.DeleteFromIndex(writeCtx, bat)
+	indexWriteCtx, ok := writeCtx.(*WriteContext)
+	if !ok {
+		return errorWriteContextIsInvalid
+	}
+	return ihi.deleteIndexes(indexWriteCtx, bat, indexWriteCtx.TableDesc.Indexes)
 }
 
 func (ihi *IndexHandlerImpl) DeleteFromIndex(writeCtx interface{}, bat *batch.Batch) error {
@@ -847,25 +1274,27 @@ func (ihi *IndexHandlerImpl) DeleteFromIndex(writeCtx interface{}, bat *batch.Ba
 		return errorWriteContextIsInvalid
 	}
 
+	var indexes []descriptor.IndexDesc
+	if indexWriteCtx.IndexDesc.ID != PrimaryIndexID {
+		indexes = []descriptor.IndexDesc{*indexWriteCtx.IndexDesc}
+	}
+	return ihi.deleteIndexes(indexWriteCtx, bat, indexes)
+}
+
+//deleteIndexes deletes the rows of the batch from the index of the write context
+//and the secondary indexes.
+func (ihi *IndexHandlerImpl) deleteIndexes(indexWriteCtx *WriteContext, bat *batch.Batch, indexes []descriptor.IndexDesc) error {
 	if bat == nil {
 		return nil
 	}
-	//1.encode prefix (tenantID,dbID,tableID,indexID)
-	tke := ihi.tch.GetEncoder()
-	var prefix TupleKey
-	prefix, _ = tke.EncodeIndexPrefix(prefix,
-		uint64(indexWriteCtx.DbDesc.ID),
-		uint64(indexWriteCtx.TableDesc.ID),
-		uint64(indexWriteCtx.IndexDesc.ID))
 
-	indexWriteCtx.callback = callbackPackage{
-		prefix: prefix,
-	}
+	ihi.prepareCallback(indexWriteCtx, indexes)
 
 	// get every row of the delete set
 	n := vector.Length(bat.Vecs[0])
 	row := make([]interface{}, len(bat.Vecs))
 	tuple := NewTupleBatchImpl(bat, row)
+	var keys, indexKeys []TupleKey
 	for j := 0; j < n; j++ { //row index
 		err := GetRow(indexWriteCtx, bat, row, j)
 		if err != nil {
@@ -875,13 +1304,41 @@ func (ihi *IndexHandlerImpl) DeleteFromIndex(writeCtx interface{}, bat *batch.Ba
 		if err != nil {
 			return err
 		}
+		keys = append(keys, key)
+
+		for i := range indexWriteCtx.callback.indexes {
+			indexKey, err := ihi.encodeSecondaryIndexKey(indexWriteCtx, i, tuple, key)
+			if err != nil {
+				return err
+			}
+			indexKeys = append(indexKeys, indexKey)
+		}
+	}
 
-		//delete key in the kv storage
-		err = ihi.kv.Delete(key)
+	//delete key in the kv storage
+	if indexWriteCtx.IndexDesc.ID == PrimaryIndexID {
+		//the rows are kept in the changelog for the snapshot reads
+		values, err := ihi.kv.GetBatch(keys)
 		if err != nil {
 			return err
 		}
+		_, err = ihi.logChanges(indexWriteCtx, keys, values)
+		if err != nil {
+			return err
+		}
+		for _, key := range keys {
+			err = ihi.kv.Delete(key)
+			if err != nil {
+				return err
+			}
+		}
+	}
 
+	for _, indexKey := range indexKeys {
+		err := ihi.kv.Delete(indexKey)
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
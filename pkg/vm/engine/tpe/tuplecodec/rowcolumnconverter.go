@@ -389,6 +389,17 @@ func (tbi *RowColumnConverterImpl) FillBatchFromDecodedIndexValue2(index *descri
 				}
 				cols[rowIdx] = d
 			}
+		case types.T_decimal:
+			cols := vec.Col.([]types.Decimal)
+			if isNullOrEmpty {
+				nulls.Add(vec.Nsp, uint64(rowIdx))
+			} else {
+				d, err := attr.GetDecimal()
+				if err != nil {
+					return err
+				}
+				cols[rowIdx] = d
+			}
 		default:
 			return errorUnsupportedType
 		}
@@ -569,6 +580,17 @@ func (tbi *RowColumnConverterImpl) FillBatchFromDecodedIndexKey(
 				}
 				cols[rowIdx] = d
 			}
+		case types.T_decimal:
+			cols := vec.Col.([]types.Decimal)
+			if isNullOrEmpty {
+				nulls.Add(vec.Nsp, uint64(rowIdx))
+			} else {
+				d, err := attr.GetDecimal()
+				if err != nil {
+					return err
+				}
+				cols[rowIdx] = d
+			}
 		default:
 			return errorUnsupportedType
 		}
@@ -635,19 +657,24 @@ func GetRow(writeCtx interface{}, bat *batch.Batch, row []interface{}, j int) er
 		rowIndex = bat.Sels[j]
 	}
 
-	offset := 0
-	if len(bat.Zs) == 2 && bat.Zs[0] == -1 && bat.Zs[1] == 1 {
-		offset = 0
-	} else if len(indexWriteCtx.TableDesc.Attributes) > 0 && indexWriteCtx.TableDesc.Attributes[0].Is_hidden {
-		offset = 1
+	//the attributes in the batch may be in any order and may contain
+	//the hidden primary key. BatchAttrs records the attribute for every
+	//vector in the batch.
+	attrs := indexWriteCtx.BatchAttrs
+	if len(attrs) != len(bat.Vecs) {
+		offset := 0
+		if len(indexWriteCtx.TableDesc.Attributes) > 0 && indexWriteCtx.TableDesc.Attributes[0].Is_hidden {
+			offset = 1
+		}
+		attrs = indexWriteCtx.TableDesc.Attributes[offset:]
 	}
 	//get the row
 	for i, vec := range bat.Vecs { //col index
-		if vec.Typ.Oid != indexWriteCtx.TableDesc.Attributes[i+offset].TypesType.Oid {
+		if vec.Typ.Oid != attrs[i].TypesType.Oid {
 			logutil.Errorf("the input dataType is not consistent, the defined datatype is %d, the actual input dataType is %d\n",
-				indexWriteCtx.TableDesc.Attributes[i+offset].TypesType.Oid, vec.Typ.Oid)
+				attrs[i].TypesType.Oid, vec.Typ.Oid)
 			return fmt.Errorf("the input dataType is not consistent, the defined datatype is %d, the actual input dataType is %d\n",
-				indexWriteCtx.TableDesc.Attributes[i+offset].TypesType.Oid, vec.Typ.Oid)
+				attrs[i].TypesType.Oid, vec.Typ.Oid)
 		}
 		switch vec.Typ.Oid { //get col
 		case types.T_int8:
@@ -818,6 +845,18 @@ func GetRow(writeCtx interface{}, bat *batch.Batch, row []interface{}, j int) er
 					row[i] = vs[rowIndex]
 				}
 			}
+		case types.T_decimal:
+			if !nulls.Any(vec.Nsp) { //all data in this column are not null
+				vs := vec.Col.([]types.Decimal)
+				row[i] = vs[rowIndex]
+			} else {
+				if nulls.Contains(vec.Nsp, uint64(rowIndex)) { //is null
+					row[i] = nil
+				} else {
+					vs := vec.Col.([]types.Decimal)
+					row[i] = vs[rowIndex]
+				}
+			}
 		default:
 			logutil.Errorf("getDataFromPipeline : unsupported type %d \n", vec.Typ.Oid)
 			return fmt.Errorf("getDataFromPipeline : unsupported type %d \n", vec.Typ.Oid)
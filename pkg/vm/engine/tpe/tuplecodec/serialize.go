@@ -240,6 +240,13 @@ func (dvs *DefaultValueSerializer) DeserializeValue(data []byte) ([]byte, *order
 			return nil, nil, err
 		}
 		value = types.Datetime(parseNum.(int64))
+	case orderedcodec.VALUE_TYPE_DECIMAL:
+		var x types.Decimal
+		err := json.Unmarshal(actualData, &x)
+		if err != nil {
+			return nil, nil, err
+		}
+		value = x
 	case orderedcodec.VALUE_TYPE_BYTES:
 		var sliceValue []byte
 		err := json.Unmarshal(actualData, &sliceValue)
@@ -403,6 +410,13 @@ func (cs *ConciseSerializer) DeserializeValue(data []byte) ([]byte, *orderedcode
 			return nil, nil, err
 		}
 		value = types.Datetime(x)
+	case orderedcodec.VALUE_TYPE_DECIMAL:
+		var x types.Decimal
+		err := binary.Read(bufReader, binary.BigEndian, &x)
+		if err != nil {
+			return nil, nil, err
+		}
+		value = x
 	case orderedcodec.VALUE_TYPE_BYTES:
 		value = actualData
 	case orderedcodec.VALUE_TYPE_STRING:
@@ -484,6 +498,11 @@ func (fs *FlatSerializer) SerializeValue(data []byte, value interface{}) ([]byte
 			valueType = SERIAL_TYPE_DATETIME
 			marshal = []byte{0, 0, 0, 0}
 			binary.BigEndian.PutUint32(marshal, uint32(v))
+		case types.Decimal:
+			valueType = SERIAL_TYPE_DECIMAL
+			marshal = make([]byte, 16)
+			binary.BigEndian.PutUint64(marshal, uint64(v.Hi))
+			binary.BigEndian.PutUint64(marshal[8:], v.Lo)
 		case []byte:
 			valueType = SERIAL_TYPE_BYTES
 			marshal = v
@@ -590,6 +609,14 @@ func (fs *FlatSerializer) DeserializeValue(data []byte) ([]byte, *orderedcodec.D
 		}
 		x := binary.BigEndian.Uint32(actualData)
 		value = types.Datetime(x)
+	case orderedcodec.VALUE_TYPE_DECIMAL:
+		if dataLen != 16 {
+			return nil, nil, errorWrongCountOfBytes
+		}
+		value = types.Decimal{
+			Lo: binary.BigEndian.Uint64(actualData[8:]),
+			Hi: int64(binary.BigEndian.Uint64(actualData)),
+		}
 	case orderedcodec.VALUE_TYPE_BYTES:
 		value = actualData
 	case orderedcodec.VALUE_TYPE_STRING:
@@ -638,6 +665,8 @@ func appendValueType(data []byte, value interface{}) ([]byte, byte, error) {
 			valueType = SERIAL_TYPE_DATE
 		case types.Datetime:
 			valueType = SERIAL_TYPE_DATETIME
+		case types.Decimal:
+			valueType = SERIAL_TYPE_DECIMAL
 		case []byte:
 			valueType = SERIAL_TYPE_BYTES
 		case string:
@@ -702,6 +731,8 @@ func extractActualData(data []byte) ([]byte, int64, int, orderedcodec.ValueType,
 		vt = orderedcodec.VALUE_TYPE_DATE
 	case SERIAL_TYPE_DATETIME:
 		vt = orderedcodec.VALUE_TYPE_DATETIME
+	case SERIAL_TYPE_DECIMAL:
+		vt = orderedcodec.VALUE_TYPE_DECIMAL
 	case SERIAL_TYPE_STRING:
 		vt = orderedcodec.VALUE_TYPE_STRING
 	case SERIAL_TYPE_BYTES:
@@ -1115,6 +1146,7 @@ func (cvls *CompactValueLayoutSerializer) GetPositionsOfAttributesInTheValue(tab
 The Layout of the value:
 
 The attributes in the value keep the same order as they defined in the relation.
+The attribute ID is the position in the value, the dropped attributes are null.
 
 | Attribute1,Attribute2,... |
 
@@ -1126,8 +1158,17 @@ type DefaultValueLayoutSerializer struct {
 func (dvls *DefaultValueLayoutSerializer) Serialize(out []byte, ctx *ValueLayoutContext) ([]byte, error) {
 	var value interface{}
 	var err error
+	id := uint32(0)
 	//fill value into the row from the tuple
 	for _, state := range ctx.AttributeStates {
+		//the dropped attributes keep their positions
+		for ; id < state.AttrDesc.ID; id++ {
+			out, _, err = dvls.Serializer.SerializeValue(out, nil)
+			if err != nil {
+				return nil, err
+			}
+		}
+		id++
 		//the logic for implicit primary key or default expr
 		if state.NeedGenerated {
 			if state.AttrDesc.Default.Exist { //default expr
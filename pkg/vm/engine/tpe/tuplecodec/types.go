@@ -94,5 +94,6 @@ const (
 	SERIAL_TYPE_FLOAT64 byte = SERIAL_TYPE_FLOAT32 + 1
 	SERIAL_TYPE_DATE byte = SERIAL_TYPE_FLOAT64 + 1
 	SERIAL_TYPE_DATETIME byte = SERIAL_TYPE_DATE + 1
+	SERIAL_TYPE_DECIMAL byte = SERIAL_TYPE_DATETIME + 1
 	SERIAL_TYPE_UNKNOWN byte = 255
 )
\ No newline at end of file
@@ -83,6 +83,8 @@ func MakeAttributes(ts ...types.T) ([]string, []*engine.AttributeDef) {
 			name = "T_date"
 		case types.T_datetime:
 			name = "T_datetime"
+		case types.T_decimal:
+			name = "T_decimal"
 		default:
 			panic("unsupported vector type")
 		}
@@ -151,6 +153,9 @@ func MakeBatch(batchSize int, attrName []string, cols []*engine.AttributeDef) *b
 		case types.T_datetime:
 			vec.Data = make([]byte, batchSize*int(toTypesType(types.T_datetime).Size))
 			vec.Col = encoding.DecodeDatetimeSlice(vec.Data)
+		case types.T_decimal:
+			vec.Data = make([]byte, batchSize*int(toTypesType(types.T_decimal).Size))
+			vec.Col = encoding.DecodeDecimalSlice(vec.Data)
 		default:
 			panic("unsupported vector type")
 		}
@@ -455,6 +460,10 @@ func TruncateBatch(bat *batch.Batch, batchSize, needLen int) {
 			needBytes := needLen * int(toTypesType(types.T_datetime).Size)
 			vec.Data = vec.Data[:needBytes]
 			vec.Col = encoding.DecodeDatetimeSlice(vec.Data)
+		case types.T_decimal:
+			needBytes := needLen * int(toTypesType(types.T_decimal).Size)
+			vec.Data = vec.Data[:needBytes]
+			vec.Col = encoding.DecodeDecimalSlice(vec.Data)
 		}
 	}
 	bat.Zs = bat.Zs[:needLen]
@@ -520,6 +529,8 @@ func TpeTypeToEngineType(Ttype orderedcodec.ValueType) types.Type {
 		t.Oid = types.T_date
 	case orderedcodec.VALUE_TYPE_DATETIME:
 		t.Oid = types.T_datetime
+	case orderedcodec.VALUE_TYPE_DECIMAL:
+		t.Oid = types.T_decimal
 	default:
 		panic("unsupported tpe type")
 	}
@@ -558,6 +569,8 @@ func EngineTypeToTpeType(t *types.Type) orderedcodec.ValueType {
 		vt = orderedcodec.VALUE_TYPE_DATE
 	case types.T_datetime:
 		vt = orderedcodec.VALUE_TYPE_DATETIME
+	case types.T_decimal:
+		vt = orderedcodec.VALUE_TYPE_DECIMAL
 	default:
 		panic("unsupported types.Type")
 	}
@@ -0,0 +1,294 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txn
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+func (e *txnEngine) Delete(_ uint64, _ string) error {
+	return ErrDDL
+}
+
+func (e *txnEngine) Create(_ uint64, _ string, _ int) error {
+	return ErrDDL
+}
+
+func (e *txnEngine) Databases() []string {
+	return e.txn.e.Databases()
+}
+
+func (e *txnEngine) Database(name string) (engine.Database, error) {
+	db, err := e.txn.e.Database(name)
+	if err != nil {
+		return nil, err
+	}
+	return &database{txn: e.txn, name: name, db: db}, nil
+}
+
+func (e *txnEngine) Node(ip string) *engine.NodeInfo {
+	return e.txn.e.Node(ip)
+}
+
+func (db *database) Relations() []string {
+	return db.db.Relations()
+}
+
+func (db *database) Relation(name string) (engine.Relation, error) {
+	r, err := db.db.Relation(name)
+	if err != nil {
+		return nil, err
+	}
+	tbl, err := db.txn.table(db.name, name, r)
+	if err != nil {
+		return nil, err
+	}
+	if tbl.r != r {
+		r.Close()
+	}
+	return &relation{txn: db.txn, tbl: tbl}, nil
+}
+
+func (db *database) Delete(_ uint64, _ string) error {
+	return ErrDDL
+}
+
+func (db *database) Create(_ uint64, _ string, _ []engine.TableDef) error {
+	return ErrDDL
+}
+
+// Close does nothing, the relation is closed when the transaction finishes.
+func (r *relation) Close() {}
+
+func (r *relation) ID() string {
+	return r.tbl.name
+}
+
+func (r *relation) Rows() int64 {
+	r.txn.Lock()
+	defer r.txn.Unlock()
+	rows := r.tbl.r.Rows()
+	for _, seg := range r.tbl.rdels {
+		rows -= int64(len(seg.rids))
+	}
+	for _, seg := range r.tbl.segs {
+		rows += int64(len(seg.sels(r.tbl.dels)))
+	}
+	return rows
+}
+
+func (r *relation) Size(attr string) int64 {
+	return r.tbl.r.Size(attr)
+}
+
+// Nodes returns the node of transaction, the buffered writes can be read only by it.
+func (r *relation) Nodes() engine.Nodes {
+	return engine.Nodes{r.txn.n}
+}
+
+func (r *relation) CreateIndex(_ uint64, _ []engine.TableDef) error {
+	return ErrDDL
+}
+
+func (r *relation) DropIndex(_ uint64, _ string) error {
+	return ErrDDL
+}
+
+func (r *relation) TableDefs() []engine.TableDef {
+	return r.tbl.r.TableDefs()
+}
+
+func (r *relation) GetPrimaryKeys() []*engine.Attribute {
+	return r.tbl.r.GetPrimaryKeys()
+}
+
+// GetHideKey returns the row id of transaction, which identifies the rows
+// of both the snapshot and the buffered writes.
+func (r *relation) GetHideKey() *engine.Attribute {
+	return &engine.Attribute{
+		Name: RowId,
+		Type: rowIdType,
+	}
+}
+
+func (r *relation) Write(_ uint64, bat *batch.Batch) error {
+	return r.txn.write(r.tbl, bat)
+}
+
+func (r *relation) AddTableDef(_ uint64, _ engine.TableDef) error {
+	return ErrDDL
+}
+
+func (r *relation) DelTableDef(_ uint64, _ engine.TableDef) error {
+	return ErrDDL
+}
+
+// NewReader returns readers of the rows visible to the transaction, which are the rows
+// of relation at the beginning of transaction and the rows inserted by it. The inserted
+// segments are distributed among the readers. The rows written after it are not visible
+// to the readers, so that a statement never reads its own writes. The relation which
+// keeps no versions is read as it is now.
+func (r *relation) NewReader(n int, ext extend.Extend, payload []byte) []engine.Reader {
+	if n <= 0 {
+		return nil
+	}
+	r.txn.Lock()
+	defer r.txn.Unlock()
+	dels := make(map[string]struct{}, len(r.tbl.dels))
+	for rid := range r.tbl.dels {
+		dels[rid] = struct{}{}
+	}
+	rds := make([]*reader, n)
+	for i := range rds {
+		rds[i] = &reader{txn: r.txn, tbl: r.tbl, dels: dels}
+	}
+	var rs []engine.Reader
+	if sr, ok := r.tbl.r.(engine.SnapshotRelation); ok {
+		rs = sr.NewSnapshotReader(r.txn.ts, n, ext, payload)
+	} else {
+		rs = r.tbl.r.NewReader(n, ext, payload)
+	}
+	for i, rd := range rs {
+		rds[i%n].rd = rd
+	}
+	for i, seg := range r.tbl.segs {
+		rds[i%n].segs = append(rds[i%n].segs, seg)
+	}
+	rs = make([]engine.Reader, n)
+	for i, rd := range rds {
+		rs[i] = rd
+	}
+	return rs
+}
+
+// Read reads the relation first, then the rows inserted by the transaction.
+func (r *reader) Read(cs []uint64, attrs []string) (*batch.Batch, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	for r.rd != nil {
+		bat, err := r.readRelation(cs, attrs)
+		if err != nil {
+			r.err = err
+			return nil, err
+		}
+		if bat != nil && len(bat.Zs) > 0 {
+			return bat, nil
+		}
+	}
+	for len(r.segs) > 0 {
+		seg := r.segs[0]
+		r.segs = r.segs[1:]
+		sels := seg.sels(r.dels)
+		if len(sels) == 0 {
+			continue
+		}
+		bat, err := seg.batch(attrs, sels, len(sels) != len(seg.rids))
+		if err != nil {
+			return nil, err
+		}
+		for i, vec := range bat.Vecs {
+			vec.Or = true
+			vec.Ref = cs[i]
+		}
+		bat.Zs = make([]int64, len(sels))
+		for i := range bat.Zs {
+			bat.Zs[i] = 1
+		}
+		return bat, nil
+	}
+	return nil, nil
+}
+
+// readRelation reads a batch of the relation without the rows deleted by the transaction,
+// the reader of relation is dropped when it is drained.
+func (r *reader) readRelation(cs []uint64, attrs []string) (*batch.Batch, error) {
+	rid := -1
+	names := make([]string, 0, len(attrs))
+	refs := make([]uint64, 0, len(attrs))
+	for i, attr := range attrs {
+		if attr == RowId {
+			rid = i
+			continue
+		}
+		names = append(names, attr)
+		refs = append(refs, cs[i])
+	}
+	identify := rid >= 0 || len(r.dels) > 0
+	if identify {
+		for _, name := range r.tbl.identities() {
+			found := false
+			for _, n := range names {
+				if n == name {
+					found = true
+					break
+				}
+			}
+			if !found {
+				names = append(names, name)
+				refs = append(refs, 1)
+			}
+		}
+	}
+	bat, err := r.rd.Read(refs, names)
+	if err != nil {
+		return nil, err
+	}
+	if bat == nil || len(bat.Zs) == 0 {
+		r.rd = nil
+		return nil, nil
+	}
+	rbat := batch.New(true, attrs)
+	rbat.Zs = bat.Zs
+	for i, attr := range attrs {
+		if i != rid {
+			rbat.Vecs[i] = batch.GetVector(bat, attr)
+		}
+	}
+	if !identify {
+		return rbat, nil
+	}
+	rids, err := r.tbl.rowIds(bat)
+	if err != nil {
+		return nil, err
+	}
+	sels := make([]int64, 0, len(rids))
+	for i, id := range rids {
+		if _, ok := r.dels[id]; !ok {
+			sels = append(sels, int64(i))
+		}
+	}
+	if rid >= 0 {
+		if rbat.Vecs[rid], err = rowIdVector(rids); err != nil {
+			return nil, err
+		}
+		rbat.Vecs[rid].Or = true
+		rbat.Vecs[rid].Ref = cs[rid]
+	}
+	if len(sels) != len(rids) {
+		for _, vec := range rbat.Vecs {
+			vector.Shrink(vec, sels)
+		}
+		zs := make([]int64, len(sels))
+		for i, sel := range sels {
+			zs[i] = rbat.Zs[sel]
+		}
+		rbat.Zs = zs
+	}
+	return rbat, nil
+}
@@ -0,0 +1,551 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txn
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+func NewManager() *Manager {
+	return &Manager{}
+}
+
+// Begin starts a transaction on e, the statements of transaction are executed on node n.
+// The transaction reads the relations as they are now. The commits of transaction are
+// not serialized with the others of the process if m is nil.
+func (m *Manager) Begin(e engine.Engine, n engine.Node) *Txn {
+	return &Txn{
+		m:    m,
+		e:    e,
+		n:    n,
+		ts:   engine.Now(),
+		tbls: make(map[string]*table),
+	}
+}
+
+func (m *Manager) lock() {
+	if m != nil {
+		m.Lock()
+	}
+}
+
+func (m *Manager) unlock() {
+	if m != nil {
+		m.Unlock()
+	}
+}
+
+// Engine returns the engine which reads and writes in the transaction.
+func (t *Txn) Engine() engine.Engine {
+	return &txnEngine{txn: t}
+}
+
+// Savepoint returns the current position of the writes, which can be used to roll
+// back the writes after it.
+func (t *Txn) Savepoint() int {
+	t.Lock()
+	defer t.Unlock()
+	return len(t.undo)
+}
+
+// RollbackTo discards the writes after savepoint sp.
+func (t *Txn) RollbackTo(sp int) {
+	t.Lock()
+	defer t.Unlock()
+	for i := len(t.undo) - 1; i >= sp; i-- {
+		u := t.undo[i]
+		for _, rid := range u.dels {
+			delete(u.tbl.dels, rid)
+		}
+		u.tbl.segs = u.tbl.segs[:u.segs]
+		u.tbl.rdels = u.tbl.rdels[:u.rdels]
+	}
+	if sp < len(t.undo) {
+		t.undo = t.undo[:sp]
+	}
+}
+
+// Rollback discards all the writes of the transaction.
+func (t *Txn) Rollback() {
+	t.Lock()
+	defer t.Unlock()
+	t.done = true
+	t.close()
+}
+
+// Commit applies the writes of the transaction to the engine with timestamp ts,
+// it fails with ErrConflict if any table written by the transaction has been
+// written by others since it began. All the batches are built and all the tables
+// are checked before the first write. The transaction is finished whether the
+// commit succeeds or not.
+func (t *Txn) Commit(ts uint64) error {
+	t.Lock()
+	defer t.Unlock()
+	if t.done {
+		return ErrFinished
+	}
+	t.done = true
+	defer t.close()
+	var ps []pending
+	var rs []engine.Relation
+	defer func() {
+		for _, r := range rs {
+			r.Close()
+		}
+	}()
+	for _, tbl := range t.ord {
+		if !tbl.written() {
+			continue
+		}
+		r, tps, err := t.prepare(tbl)
+		if err != nil {
+			return err
+		}
+		if len(tps) == 0 {
+			r.Close()
+			continue
+		}
+		rs = append(rs, r)
+		ps = append(ps, tps...)
+	}
+	t.m.lock()
+	defer t.m.unlock()
+	for _, r := range rs {
+		if err := t.check(r); err != nil {
+			return err
+		}
+	}
+	for _, p := range ps {
+		if err := p.r.Write(ts, p.bat); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// check fails with ErrConflict if the relation has been written since the transaction
+// began, the relation which keeps no versions is never checked.
+func (t *Txn) check(r engine.Relation) error {
+	sr, ok := r.(engine.SnapshotRelation)
+	if !ok {
+		return nil
+	}
+	changed, err := sr.ChangedSince(t.ts)
+	if err != nil {
+		return err
+	}
+	if changed {
+		return ErrConflict
+	}
+	return nil
+}
+
+// close releases the relations and the buffered writes.
+func (t *Txn) close() {
+	for _, tbl := range t.ord {
+		tbl.r.Close()
+	}
+	t.tbls = nil
+	t.ord = nil
+	t.undo = nil
+}
+
+// table returns the table of transaction, rel is kept by the table if it is accessed
+// for the first time.
+func (t *Txn) table(db string, name string, rel engine.Relation) (*table, error) {
+	t.Lock()
+	defer t.Unlock()
+	if t.done {
+		return nil, ErrFinished
+	}
+	key := db + "." + name
+	if tbl, ok := t.tbls[key]; ok {
+		return tbl, nil
+	}
+	tbl := &table{
+		db:   db,
+		name: name,
+		r:    rel,
+		dels: make(map[string]struct{}),
+	}
+	for _, def := range rel.TableDefs() {
+		if attr, ok := def.(*engine.AttributeDef); ok {
+			tbl.attrs = append(tbl.attrs, attr.Attr)
+		}
+	}
+	if key := rel.GetHideKey(); key != nil && (key.Type.Oid == types.T_uint64 || key.Type.Oid == types.T_char) {
+		tbl.hideKey = key
+	} else if pks := rel.GetPrimaryKeys(); len(pks) > 0 {
+		for _, pk := range pks {
+			tbl.idents = append(tbl.idents, pk.Name)
+		}
+	} else {
+		for _, attr := range tbl.attrs {
+			tbl.idents = append(tbl.idents, attr.Name)
+		}
+	}
+	t.tbls[key] = tbl
+	t.ord = append(t.ord, tbl)
+	return tbl, nil
+}
+
+// write inserts or deletes the rows of bat, the batch marked by Zs = {-1, -1}
+// is the set of rows to be deleted which are identified by the hidden key.
+func (t *Txn) write(tbl *table, bat *batch.Batch) error {
+	t.Lock()
+	defer t.Unlock()
+	if t.done {
+		return ErrFinished
+	}
+	u := undoEntry{tbl: tbl, segs: len(tbl.segs), rdels: len(tbl.rdels)}
+	if len(bat.Zs) == 2 && bat.Zs[0] == -1 && bat.Zs[1] == -1 {
+		vec := batch.GetVector(bat, RowId)
+		if vec == nil {
+			return fmt.Errorf("delete from '%s' without column '%s'", tbl.name, RowId)
+		}
+		col := vec.Col.(*types.Bytes)
+		sels := bat.Sels
+		if sels == nil {
+			sels = make([]int64, len(col.Offsets))
+			for i := range sels {
+				sels[i] = int64(i)
+			}
+		}
+		var rids []string
+		var rsels []int64 // rows of relation
+		for _, sel := range sels {
+			rid := string(col.Get(sel))
+			if _, ok := tbl.dels[rid]; ok {
+				continue
+			}
+			tbl.dels[rid] = struct{}{}
+			u.dels = append(u.dels, rid)
+			if rid[0] != insertRowId {
+				rids = append(rids, rid)
+				rsels = append(rsels, sel)
+			}
+		}
+		if len(rsels) > 0 {
+			// the values are kept, because the relation may delete rows by them
+			var attrs []engine.Attribute
+			for _, attr := range tbl.attrs {
+				if batch.GetVector(bat, attr.Name) != nil {
+					attrs = append(attrs, attr)
+				}
+			}
+			seg, err := newSegment(attrs, bat, rsels)
+			if err != nil {
+				t.undoEntry(u)
+				return err
+			}
+			seg.rids = rids
+			tbl.rdels = append(tbl.rdels, seg)
+		}
+		t.undo = append(t.undo, u)
+		return nil
+	}
+	seg, err := newSegment(tbl.attrs, bat, bat.Sels)
+	if err != nil {
+		return err
+	}
+	for i := range seg.rids {
+		t.seq++
+		seg.rids[i] = string(append([]byte{insertRowId}, encoding.EncodeUint64(t.seq)...))
+	}
+	tbl.segs = append(tbl.segs, seg)
+	t.undo = append(t.undo, u)
+	return nil
+}
+
+// undoEntry discards the write of u.
+func (t *Txn) undoEntry(u undoEntry) {
+	for _, rid := range u.dels {
+		delete(u.tbl.dels, rid)
+	}
+	u.tbl.segs = u.tbl.segs[:u.segs]
+	u.tbl.rdels = u.tbl.rdels[:u.rdels]
+}
+
+// prepare opens the relation of table and builds the batches of its writes.
+func (t *Txn) prepare(tbl *table) (engine.Relation, []pending, error) {
+	db, err := t.e.Database(tbl.db)
+	if err != nil {
+		return nil, nil, err
+	}
+	r, err := db.Relation(tbl.name)
+	if err != nil {
+		return nil, nil, err
+	}
+	var ps []pending
+	for _, seg := range tbl.rdels {
+		bat, err := seg.deleteBatch(tbl)
+		if err != nil {
+			r.Close()
+			return nil, nil, err
+		}
+		ps = append(ps, pending{r: r, bat: bat})
+	}
+	attrs := make([]string, len(tbl.attrs))
+	for i, attr := range tbl.attrs {
+		attrs[i] = attr.Name
+	}
+	for _, seg := range tbl.segs {
+		sels := seg.sels(tbl.dels)
+		if len(sels) == 0 {
+			continue
+		}
+		bat, err := seg.batch(attrs, sels, len(sels) != len(seg.rids))
+		if err != nil {
+			r.Close()
+			return nil, nil, err
+		}
+		bat.Zs = make([]int64, len(sels))
+		for i := range bat.Zs {
+			bat.Zs[i] = 1
+		}
+		ps = append(ps, pending{r: r, bat: bat})
+	}
+	return r, ps, nil
+}
+
+func (tbl *table) written() bool {
+	return len(tbl.segs) > 0 || len(tbl.rdels) > 0
+}
+
+// rowIds returns the row ids of the rows read from the relation.
+func (tbl *table) rowIds(bat *batch.Batch) ([]string, error) {
+	rids := make([]string, len(bat.Zs))
+	if tbl.hideKey != nil {
+		switch keys := batch.GetVector(bat, tbl.hideKey.Name).Col.(type) {
+		case []uint64:
+			for i := range rids {
+				rids[i] = string(append([]byte{keyRowId}, encoding.EncodeUint64(keys[i])...))
+			}
+		case *types.Bytes:
+			for i := range rids {
+				rids[i] = string(append([]byte{keyRowId}, keys.Get(int64(i))...))
+			}
+		}
+		return rids, nil
+	}
+	vecs := make([]*vector.Vector, len(tbl.idents))
+	for i, name := range tbl.idents {
+		vecs[i] = batch.GetVector(bat, name)
+	}
+	var err error
+	var buf []byte
+	for i := range rids {
+		buf = append(buf[:0], valueRowId)
+		for _, vec := range vecs {
+			if buf, err = appendValue(buf, vec, i); err != nil {
+				return nil, err
+			}
+		}
+		rids[i] = string(buf)
+	}
+	return rids, nil
+}
+
+// identities returns the attributes needed to generate row ids.
+func (tbl *table) identities() []string {
+	if tbl.hideKey != nil {
+		return []string{tbl.hideKey.Name}
+	}
+	return tbl.idents
+}
+
+// appendValue appends the i-th value of vector to buf.
+func appendValue(buf []byte, vec *vector.Vector, i int) ([]byte, error) {
+	if nulls.Contains(vec.Nsp, uint64(i)) {
+		return append(buf, 0), nil
+	}
+	buf = append(buf, 1)
+	switch col := vec.Col.(type) {
+	case []int8:
+		return append(buf, encoding.EncodeInt8(col[i])...), nil
+	case []int16:
+		return append(buf, encoding.EncodeInt16(col[i])...), nil
+	case []int32:
+		return append(buf, encoding.EncodeInt32(col[i])...), nil
+	case []int64:
+		return append(buf, encoding.EncodeInt64(col[i])...), nil
+	case []uint8:
+		return append(buf, encoding.EncodeUint8(col[i])...), nil
+	case []uint16:
+		return append(buf, encoding.EncodeUint16(col[i])...), nil
+	case []uint32:
+		return append(buf, encoding.EncodeUint32(col[i])...), nil
+	case []uint64:
+		return append(buf, encoding.EncodeUint64(col[i])...), nil
+	case []float32:
+		return append(buf, encoding.EncodeFloat32(col[i])...), nil
+	case []float64:
+		return append(buf, encoding.EncodeFloat64(col[i])...), nil
+	case []types.Date:
+		return append(buf, encoding.EncodeDate(col[i])...), nil
+	case []types.Datetime:
+		return append(buf, encoding.EncodeDatetime(col[i])...), nil
+	case []types.Decimal:
+		return append(buf, encoding.EncodeDecimal(col[i])...), nil
+	case *types.Bytes:
+		v := col.Get(int64(i))
+		buf = append(buf, encoding.EncodeUint32(uint32(len(v)))...)
+		return append(buf, v...), nil
+	}
+	return nil, fmt.Errorf("unsupported type %s of row in transaction", vec.Typ)
+}
+
+// newSegment copies the attributes of the rows sels of bat to a segment, all rows
+// are copied if sels is nil.
+func newSegment(attrs []engine.Attribute, bat *batch.Batch, sels []int64) (*segment, error) {
+	seg := &segment{vecs: make(map[string]*vector.Vector, len(attrs))}
+	n := 0
+	for _, attr := range attrs {
+		vec := batch.GetVector(bat, attr.Name)
+		if vec == nil {
+			return nil, fmt.Errorf("column '%s' is required in transaction", attr.Name)
+		}
+		nv, err := dup(vec)
+		if err != nil {
+			return nil, err
+		}
+		if sels != nil {
+			vector.Shrink(nv, sels)
+		}
+		seg.vecs[attr.Name] = nv
+		n = vector.Length(nv)
+	}
+	seg.rids = make([]string, n)
+	return seg, nil
+}
+
+// deleteBatch returns the batch which deletes the rows of segment from the relation
+// of table, the hidden key is restored from the row ids.
+func (seg *segment) deleteBatch(tbl *table) (*batch.Batch, error) {
+	var attrs []string
+	for _, attr := range tbl.attrs {
+		if _, ok := seg.vecs[attr.Name]; ok {
+			attrs = append(attrs, attr.Name)
+		}
+	}
+	bat, err := seg.batch(attrs, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	if tbl.hideKey != nil {
+		vec := vector.New(tbl.hideKey.Type)
+		if tbl.hideKey.Type.Oid == types.T_uint64 {
+			keys := make([]uint64, len(seg.rids))
+			for i, rid := range seg.rids {
+				keys[i] = encoding.DecodeUint64([]byte(rid[1:]))
+			}
+			vec.Col = keys
+		} else {
+			keys := make([][]byte, len(seg.rids))
+			for i, rid := range seg.rids {
+				keys[i] = []byte(rid[1:])
+			}
+			if err := vector.Append(vec, keys); err != nil {
+				return nil, err
+			}
+		}
+		bat.Attrs = append(bat.Attrs, tbl.hideKey.Name)
+		bat.Vecs = append(bat.Vecs, vec)
+	}
+	bat.Zs = []int64{-1, -1}
+	return bat, nil
+}
+
+// sels returns the rows of segment which are not deleted.
+func (seg *segment) sels(dels map[string]struct{}) []int64 {
+	sels := make([]int64, 0, len(seg.rids))
+	for i, rid := range seg.rids {
+		if _, ok := dels[rid]; !ok {
+			sels = append(sels, int64(i))
+		}
+	}
+	return sels
+}
+
+// batch returns a batch of the rows sels, the row id is generated if it is required.
+// The vectors are copied because the batch may be modified by its consumer.
+func (seg *segment) batch(attrs []string, sels []int64, shrink bool) (*batch.Batch, error) {
+	bat := batch.New(true, attrs)
+	for i, attr := range attrs {
+		if attr == RowId {
+			var rids []string
+			if shrink {
+				rids = make([]string, len(sels))
+				for j, sel := range sels {
+					rids[j] = seg.rids[sel]
+				}
+			} else {
+				rids = seg.rids
+			}
+			vec, err := rowIdVector(rids)
+			if err != nil {
+				return nil, err
+			}
+			bat.Vecs[i] = vec
+			continue
+		}
+		vec, ok := seg.vecs[attr]
+		if !ok {
+			return nil, fmt.Errorf("unknown column '%s'", attr)
+		}
+		nv, err := dup(vec)
+		if err != nil {
+			return nil, err
+		}
+		if shrink {
+			vector.Shrink(nv, sels)
+		}
+		bat.Vecs[i] = nv
+	}
+	return bat, nil
+}
+
+// rowIdVector returns the vector of row ids.
+func rowIdVector(rids []string) (*vector.Vector, error) {
+	vs := make([][]byte, len(rids))
+	for i, rid := range rids {
+		vs[i] = []byte(rid)
+	}
+	vec := vector.New(rowIdType)
+	if err := vector.Append(vec, vs); err != nil {
+		return nil, err
+	}
+	return vec, nil
+}
+
+// dup returns a copy of vector which does not share memory with it.
+func dup(vec *vector.Vector) (*vector.Vector, error) {
+	data, err := vec.Show()
+	if err != nil {
+		return nil, err
+	}
+	nv := vector.New(vec.Typ)
+	if err := nv.Read(append([]byte{}, data...)); err != nil {
+		return nil, err
+	}
+	return nv, nil
+}
@@ -0,0 +1,263 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txn
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine/kv"
+	"github.com/stretchr/testify/require"
+)
+
+var testNode = engine.Node{Id: "0", Addr: "127.0.0.1"}
+
+func newTestEngine(t *testing.T) engine.Engine {
+	e := memEngine.New(kv.New(), testNode)
+	db, err := e.Database("test")
+	require.NoError(t, err)
+	require.NoError(t, db.Create(0, "t", []engine.TableDef{
+		&engine.AttributeDef{Attr: engine.Attribute{Name: "a", Type: types.Type{Oid: types.T_int64, Size: 8}}},
+	}))
+	insert(t, e, 1, 2, 3)
+	return e
+}
+
+func getRelation(t *testing.T, e engine.Engine) engine.Relation {
+	db, err := e.Database("test")
+	require.NoError(t, err)
+	r, err := db.Relation("t")
+	require.NoError(t, err)
+	return r
+}
+
+func insert(t *testing.T, e engine.Engine, vs ...int64) {
+	bat := batch.New(true, []string{"a"})
+	bat.Vecs[0] = vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	require.NoError(t, vector.Append(bat.Vecs[0], vs))
+	bat.Zs = make([]int64, len(vs))
+	for i := range bat.Zs {
+		bat.Zs[i] = 1
+	}
+	require.NoError(t, getRelation(t, e).Write(0, bat))
+}
+
+// remove deletes the rows whose value of a is in vs.
+func remove(t *testing.T, e engine.Engine, vs ...int64) {
+	r := getRelation(t, e)
+	key := r.GetHideKey().Name
+	var bats []*batch.Batch
+	for _, rd := range r.NewReader(1, nil, nil) {
+		for {
+			bat, err := rd.Read([]uint64{1, 1}, []string{"a", key})
+			require.NoError(t, err)
+			if bat == nil {
+				break
+			}
+			var sels []int64
+			for i, a := range bat.Vecs[0].Col.([]int64) {
+				for _, v := range vs {
+					if a == v {
+						sels = append(sels, int64(i))
+					}
+				}
+			}
+			if len(sels) > 0 {
+				for _, vec := range bat.Vecs {
+					vector.Shrink(vec, sels)
+				}
+				bat.Zs = []int64{-1, -1}
+				bats = append(bats, bat)
+			}
+		}
+	}
+	for _, bat := range bats {
+		require.NoError(t, r.Write(0, bat))
+	}
+}
+
+func rows(t *testing.T, e engine.Engine) []int64 {
+	vs, err := read(e)
+	require.NoError(t, err)
+	return vs
+}
+
+// read returns the sorted values of a.
+func read(e engine.Engine) ([]int64, error) {
+	db, err := e.Database("test")
+	if err != nil {
+		return nil, err
+	}
+	r, err := db.Relation("t")
+	if err != nil {
+		return nil, err
+	}
+	var vs []int64
+	for _, rd := range r.NewReader(2, nil, nil) {
+		for {
+			bat, err := rd.Read([]uint64{1}, []string{"a"})
+			if err != nil {
+				return nil, err
+			}
+			if bat == nil {
+				break
+			}
+			vs = append(vs, bat.Vecs[0].Col.([]int64)...)
+		}
+	}
+	sort.Slice(vs, func(i, j int) bool { return vs[i] < vs[j] })
+	return vs, nil
+}
+
+func TestCommit(t *testing.T) {
+	e := newTestEngine(t)
+	txn := NewManager().Begin(e, testNode)
+	te := txn.Engine()
+	require.Equal(t, []int64{1, 2, 3}, rows(t, te))
+
+	insert(t, te, 4, 5)
+	remove(t, te, 2, 5)
+	require.Equal(t, []int64{1, 3, 4}, rows(t, te))
+	// the rows of relation are counted with the rows inserted and deleted by the transaction
+	require.Equal(t, getRelation(t, e).Rows(), getRelation(t, te).Rows())
+	// the writes are invisible outside of the transaction until commit
+	require.Equal(t, []int64{1, 2, 3}, rows(t, e))
+
+	require.NoError(t, txn.Commit(1))
+	require.Equal(t, []int64{1, 3, 4}, rows(t, e))
+	require.Equal(t, ErrFinished, txn.Commit(2))
+	_, err := te.Database("test")
+	require.NoError(t, err)
+	db, _ := te.Database("test")
+	_, err = db.Relation("t")
+	require.Equal(t, ErrFinished, err)
+}
+
+func TestRollback(t *testing.T) {
+	e := newTestEngine(t)
+	txn := NewManager().Begin(e, testNode)
+	te := txn.Engine()
+
+	insert(t, te, 4)
+	sp := txn.Savepoint()
+	insert(t, te, 5)
+	remove(t, te, 1, 4)
+	require.Equal(t, []int64{2, 3, 5}, rows(t, te))
+	txn.RollbackTo(sp)
+	require.Equal(t, []int64{1, 2, 3, 4}, rows(t, te))
+
+	txn.Rollback()
+	require.Equal(t, []int64{1, 2, 3}, rows(t, e))
+	require.Equal(t, ErrDDL, te.Create(0, "db", 0))
+}
+
+func TestConflict(t *testing.T) {
+	e := newTestEngine(t)
+	m := NewManager()
+	t1 := m.Begin(e, testNode)
+	t2 := m.Begin(e, testNode)
+	t3 := m.Begin(e, testNode)
+	insert(t, t1.Engine(), 4)
+	insert(t, t2.Engine(), 5)
+	// a read-only transaction never conflicts
+	require.Equal(t, []int64{1, 2, 3}, rows(t, t3.Engine()))
+
+	require.NoError(t, t1.Commit(1))
+	require.Equal(t, ErrConflict, t2.Commit(2))
+	require.NoError(t, t3.Commit(3))
+	require.Equal(t, []int64{1, 2, 3, 4}, rows(t, e))
+
+	// the writes out of transactions are detected too
+	t4 := m.Begin(e, testNode)
+	insert(t, t4.Engine(), 5)
+	insert(t, e, 6)
+	require.Equal(t, ErrConflict, t4.Commit(4))
+	require.Equal(t, []int64{1, 2, 3, 4, 6}, rows(t, e))
+
+	// the transactions of other managers are detected too
+	t5 := m.Begin(e, testNode)
+	t6 := NewManager().Begin(e, testNode)
+	remove(t, t5.Engine(), 1)
+	remove(t, t6.Engine(), 2)
+	require.NoError(t, t6.Commit(5))
+	require.Equal(t, ErrConflict, t5.Commit(6))
+	require.Equal(t, []int64{1, 3, 4, 6}, rows(t, e))
+}
+
+func TestSnapshot(t *testing.T) {
+	e := newTestEngine(t)
+	m := NewManager()
+
+	// the rows written after the transaction began are invisible,
+	// even if the transaction has not read the table before
+	t1 := m.Begin(e, testNode)
+	insert(t, e, 4)
+	require.Equal(t, []int64{1, 2, 3}, rows(t, t1.Engine()))
+	remove(t, e, 1)
+	require.Equal(t, []int64{1, 2, 3}, rows(t, t1.Engine()))
+	require.Equal(t, []int64{2, 3, 4}, rows(t, e))
+	// a read-only transaction commits
+	require.NoError(t, t1.Commit(1))
+
+	// the rows deleted after the transaction began are still visible to it,
+	// together with its own writes
+	t2 := m.Begin(e, testNode)
+	insert(t, t2.Engine(), 5)
+	remove(t, t2.Engine(), 2)
+	remove(t, e, 3)
+	require.Equal(t, []int64{3, 4, 5}, rows(t, t2.Engine()))
+	require.Equal(t, ErrConflict, t2.Commit(2))
+	require.Equal(t, []int64{2, 4}, rows(t, e))
+
+	// the tables not written by others are committed
+	db, err := e.Database("test")
+	require.NoError(t, err)
+	require.NoError(t, db.Create(0, "t2", []engine.TableDef{
+		&engine.AttributeDef{Attr: engine.Attribute{Name: "a", Type: types.Type{Oid: types.T_int64, Size: 8}}},
+	}))
+	t3 := m.Begin(e, testNode)
+	remove(t, t3.Engine(), 4)
+	r, err := db.Relation("t2")
+	require.NoError(t, err)
+	bat := batch.New(true, []string{"a"})
+	bat.Vecs[0] = vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	require.NoError(t, vector.Append(bat.Vecs[0], []int64{1}))
+	bat.Zs = []int64{1}
+	require.NoError(t, r.Write(0, bat))
+	require.NoError(t, t3.Commit(3))
+	require.Equal(t, []int64{2}, rows(t, e))
+}
+
+func TestRowIds(t *testing.T) {
+	tbl := &table{idents: []string{"a", "b"}}
+	bat := batch.New(true, []string{"a", "b"})
+	bat.Vecs[0] = vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	require.NoError(t, vector.Append(bat.Vecs[0], []int64{1, 1, 1, 0}))
+	bat.Vecs[1] = vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+	require.NoError(t, vector.Append(bat.Vecs[1], [][]byte{[]byte("x"), []byte("xy"), []byte("x"), nil}))
+	nulls.Add(bat.Vecs[1].Nsp, 3)
+	bat.Zs = []int64{1, 1, 1, 1}
+	rids, err := tbl.rowIds(bat)
+	require.NoError(t, err)
+	require.Equal(t, rids[0], rids[2])
+	require.NotEqual(t, rids[0], rids[1])
+	require.NotEqual(t, rids[0], rids[3])
+}
@@ -0,0 +1,132 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txn
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// RowId is the name of the hidden key which identifies a row in a transaction.
+const RowId = "__mo_txn_rowid"
+
+var rowIdType = types.Type{Oid: types.T_char, Size: 24}
+
+// the prefixes of row ids, a row read from the relation is identified by its hidden
+// key if the relation has one, or by the values of its primary key or of all its
+// attributes. A row inserted by the transaction is identified by a sequence number.
+const (
+	keyRowId    = 'k'
+	valueRowId  = 'v'
+	insertRowId = 'i'
+)
+
+var (
+	// ErrConflict is returned if the tables written by the transaction have been
+	// written by others since it began.
+	ErrConflict = errors.New("tables written by the transaction have been changed by others, try restarting transaction")
+	// ErrDDL is returned if a schema change is executed in a transaction.
+	ErrDDL = errors.New("schema change is not allowed in transaction")
+	// ErrFinished is returned if the transaction has been committed or rolled back.
+	ErrFinished = errors.New("transaction has been finished")
+)
+
+// Manager serializes the commits of transactions in the process, so that a transaction
+// never misses the writes of another committed at the same time.
+type Manager struct {
+	sync.Mutex
+}
+
+// Txn is an explicit transaction with snapshot isolation.
+// The rows are read from the relations as they were when it began, together with
+// its own writes, which are buffered in memory until it commits. It commits only
+// if none of the tables it writes have been written by others since it began.
+type Txn struct {
+	sync.Mutex
+	m    *Manager
+	e    engine.Engine
+	n    engine.Node // node which executes the statements of the transaction
+	ts   uint64      // timestamp when the transaction began
+	seq  uint64      // the last sequence number of inserted rows
+	done bool
+	tbls map[string]*table
+	// ord is the tables in the order of first access, writes are applied in this order
+	ord  []*table
+	undo []undoEntry
+}
+
+// table is the relation and buffered writes of a table.
+type table struct {
+	db    string
+	name  string
+	r     engine.Relation
+	attrs []engine.Attribute // visible attributes
+	// hideKey is the hidden key of relation, nil if it does not exist or is neither an uint64 nor a char
+	hideKey *engine.Attribute
+	// idents are the attributes which identify a row if there is no hidden key
+	idents []string
+	segs   []*segment          // rows inserted by the transaction
+	rdels  []*segment          // rows of relation deleted by the transaction
+	dels   map[string]struct{} // row ids of deleted rows
+}
+
+// segment is a set of rows with their row ids.
+type segment struct {
+	rids []string
+	vecs map[string]*vector.Vector
+}
+
+// undoEntry records a write of a statement, which is used to roll back a failed statement.
+type undoEntry struct {
+	tbl   *table
+	segs  int      // number of inserted segments before the write
+	rdels int      // number of deleted segments before the write
+	dels  []string // rows deleted
+}
+
+type txnEngine struct {
+	txn *Txn
+}
+
+type database struct {
+	txn  *Txn
+	name string
+	db   engine.Database
+}
+
+type relation struct {
+	txn *Txn
+	tbl *table
+}
+
+type reader struct {
+	txn  *Txn
+	tbl  *table
+	rd   engine.Reader // reader of relation, nil if it has been drained
+	segs []*segment
+	dels map[string]struct{}
+	err  error
+}
+
+// pending is a batch to be written to a relation when the transaction commits.
+type pending struct {
+	r   engine.Relation
+	bat *batch.Batch
+}
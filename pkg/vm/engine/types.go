@@ -121,6 +121,66 @@ type CommentDef struct {
 	Comment string
 }
 
+// RenameColumnDef renames the attribute Name to NewName
+type RenameColumnDef struct {
+	Name    string
+	NewName string
+}
+
+// ColumnDefaultDef replaces the default value of the attribute Name
+type ColumnDefaultDef struct {
+	Name    string
+	Default DefaultExpr
+}
+
+// RenameTableDef renames the relation to Name
+type RenameTableDef struct {
+	Name string
+}
+
+// SchemaChangeDef is a set of changes of the relation's schema made by one
+// ALTER TABLE, they are applied as one, either all of them or none.
+type SchemaChangeDef struct {
+	Changes []SchemaChange
+}
+
+// SchemaChange adds Def to the relation, or drops it if Drop is true
+type SchemaChange struct {
+	Drop bool
+	Def  TableDef
+}
+
+// StatisticsDef is the statistics of relation collected by ANALYZE TABLE,
+// it replaces the statistics collected before.
+type StatisticsDef struct {
+	Rows    int64
+	Columns []ColumnStatistics
+}
+
+// ColumnStatistics is the statistics of an attribute, Min, Max and
+// Histogram are only collected for the numeric attributes.
+type ColumnStatistics struct {
+	Name     string
+	Ndv      int64 // approximate number of distinct values
+	Nulls    int64
+	HasRange bool // if true, Min and Max are valid
+	Min      float64
+	Max      float64
+	// Histogram is the bounds of an equi-depth histogram, each bucket
+	// holds the same number of values between two adjacent bounds.
+	Histogram []float64
+}
+
+// Column returns the statistics of attribute, nil if it is not collected.
+func (s *StatisticsDef) Column(name string) *ColumnStatistics {
+	for i := range s.Columns {
+		if s.Columns[i].Name == name {
+			return &s.Columns[i]
+		}
+	}
+	return nil
+}
+
 type TableDef interface {
 	tableDef()
 }
@@ -131,6 +191,12 @@ func (*IndexTableDef) tableDef()  {}
 func (*PartitionByDef) tableDef() {}
 func (*PropertiesDef) tableDef()  {}
 
+func (*RenameColumnDef) tableDef()  {}
+func (*ColumnDefaultDef) tableDef() {}
+func (*RenameTableDef) tableDef()   {}
+func (*SchemaChangeDef) tableDef()  {}
+func (*StatisticsDef) tableDef()    {}
+
 type Relation interface {
 	Statistics
 
@@ -143,15 +209,38 @@ type Relation interface {
 	DropIndex(epoch uint64, name string) error
 	TableDefs() []TableDef
 
+	// GetPrimaryKeys returns the attributes of primary key, nil if the relation has no primary key
+	GetPrimaryKeys() []*Attribute
+	// GetHideKey returns the hidden attribute which identifies a row if the relation has no primary key
+	GetHideKey() *Attribute
+
 	Write(uint64, *batch.Batch) error
 
+	// AddTableDef adds an attribute by AttributeDef, or renames the attribute, changes
+	// the default value of attribute and renames the relation by the other definitions.
+	// The rows written before an attribute is added read the default value of it.
 	AddTableDef(uint64, TableDef) error
+	// DelTableDef drops the attribute of AttributeDef
 	DelTableDef(uint64, TableDef) error
 
 	// first argument is the number of reader, second argument is the filter extend,  third parameter is the payload required by the engine
 	NewReader(int, extend.Extend, []byte) []Reader
 }
 
+// SnapshotRelation is a relation which keeps the versions of its rows, so that the
+// rows can be read as they were at a timestamp given by Now.
+type SnapshotRelation interface {
+	Relation
+
+	// NewSnapshotReader is the same as NewReader, except that the readers read the rows
+	// visible at ts, the rows written after ts are invisible and the rows deleted after
+	// ts are still visible. The readers fail with ErrSnapshotTooOld if the versions
+	// needed have been discarded.
+	NewSnapshotReader(ts uint64, n int, ext extend.Extend, payload []byte) []Reader
+	// ChangedSince checks if the relation has been written after ts.
+	ChangedSince(ts uint64) (bool, error)
+}
+
 type Reader interface {
 	Read([]uint64, []string) (*batch.Batch, error)
 }
@@ -1,6 +1,8 @@
 package mheap
 
 import (
+	"sync/atomic"
+
 	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 )
@@ -20,12 +22,18 @@ func HostSize(m *Mheap) int64 {
 	return m.Gm.HostSize()
 }
 
+// Allocated returns the total bytes allocated from m.
+func Allocated(m *Mheap) int64 {
+	return atomic.LoadInt64(&m.allocated)
+}
+
 func Free(m *Mheap, data []byte) {
 	//m.Gm.Free(int64(cap(data)))
 }
 
 func Alloc(m *Mheap, size int64) ([]byte, error) {
 	data := mempool.Alloc(m.Mp, int(size))
+	atomic.AddInt64(&m.allocated, int64(cap(data)))
 	/*
 		if err := m.Gm.Alloc(int64(cap(data))); err != nil {
 			return nil, err
@@ -34,6 +42,17 @@ func Alloc(m *Mheap, size int64) ([]byte, error) {
 	return data[:size], nil
 }
 
+// Reserve accounts size bytes held by an operator against the guest mmu,
+// mmu.OutOfMemory is returned if the limitation is exceeded.
+func Reserve(m *Mheap, size int64) error {
+	return m.Gm.Alloc(size)
+}
+
+// Release gives back the bytes accounted by Reserve.
+func Release(m *Mheap, size int64) {
+	m.Gm.Free(size)
+}
+
 func Grow(m *Mheap, old []byte, size int64) ([]byte, error) {
 	data, err := Alloc(m, mempool.Realloc(old, size))
 	if err != nil {
@@ -18,4 +18,6 @@ type Mheap interface {
 type Mheap struct {
 	Gm *guest.Mmu
 	Mp *mempool.Mempool
+	// allocated, total bytes allocated from the heap.
+	allocated int64
 }
@@ -32,7 +32,10 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/output"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/projection"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/restrict"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/setop"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/top"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/updateTag"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/window"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/join"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/oplus"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/plus"
@@ -65,8 +68,11 @@ var stringFunc = [...]func(interface{}, *bytes.Buffer){
 	MergeOffset: mergeoffset.String,
 	MergeOrder:  mergeorder.String,
 	MergeTop:    mergetop.String,
+	SetOp:       setop.String,
+	Window:      window.String,
 
 	DeleteTag: deleteTag.String,
+	UpdateTag: updateTag.String,
 }
 
 var prepareFunc = [...]func(*process.Process, interface{}) error{
@@ -92,8 +98,11 @@ var prepareFunc = [...]func(*process.Process, interface{}) error{
 	MergeOffset: mergeoffset.Prepare,
 	MergeOrder:  mergeorder.Prepare,
 	MergeTop:    mergetop.Prepare,
+	SetOp:       setop.Prepare,
+	Window:      window.Prepare,
 
 	DeleteTag: deleteTag.Prepare,
+	UpdateTag: updateTag.Prepare,
 }
 
 var execFunc = [...]func(*process.Process, interface{}) (bool, error){
@@ -119,6 +128,9 @@ var execFunc = [...]func(*process.Process, interface{}) (bool, error){
 	MergeOffset: mergeoffset.Call,
 	MergeOrder:  mergeorder.Call,
 	MergeTop:    mergetop.Call,
+	SetOp:       setop.Call,
+	Window:      window.Call,
 
 	DeleteTag: deleteTag.Call,
+	UpdateTag: updateTag.Call,
 }
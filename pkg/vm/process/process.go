@@ -15,20 +15,37 @@
 package process
 
 import (
+	"sync/atomic"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 )
 
 // New creates a new Process.
 // A process stores the execution context.
 func New(m *mheap.Mheap) *Process {
 	return &Process{
-		Mp: m,
+		Mp:    m,
+		Spill: new(SpillInfo),
 	}
 }
 
+// NewFromProc creates a process for another pipeline of the same query, it has
+// its own guest mmu with the same limitation, and shares the id, limitation and
+// spill statistics of p.
+func NewFromProc(p *Process) *Process {
+	proc := New(mheap.New(guest.New(p.Mp.Gm.Limit, p.Mp.Gm.Mmu)))
+	proc.Id = p.Id
+	proc.Lim = p.Lim
+	proc.Spill = p.Spill
+	return proc
+}
+
 func GetSels(proc *Process) []int64 {
 	if len(proc.Reg.Ss) == 0 {
 		return make([]int64, 0, 16)
@@ -75,3 +92,29 @@ func FreeRegisters(proc *Process) {
 	}
 	proc.Reg.Vecs = proc.Reg.Vecs[:0]
 }
+
+// AddWrite records rows and bytes written to a temporary file.
+func (s *SpillInfo) AddWrite(rows, size int64) {
+	atomic.AddInt64(&s.Rows, rows)
+	atomic.AddInt64(&s.Bytes, size)
+}
+
+// AddRead records rows read back from a temporary file.
+func (s *SpillInfo) AddRead(rows int64) {
+	atomic.AddInt64(&s.ReadRows, rows)
+}
+
+// AddBatch records a batch produced by the operator.
+func (a *AnalyzeInfo) AddBatch(bat *batch.Batch) {
+	if bat == nil || len(bat.Zs) == 0 {
+		return
+	}
+	atomic.AddInt64(&a.Batches, 1)
+	atomic.AddInt64(&a.Rows, int64(len(bat.Zs)))
+}
+
+// Add records the time spent in the operator and the bytes allocated by it.
+func (a *AnalyzeInfo) Add(t time.Duration, size int64) {
+	atomic.AddInt64(&a.TimeConsumed, int64(t))
+	atomic.AddInt64(&a.MemorySize, size)
+}
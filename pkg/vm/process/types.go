@@ -54,6 +54,35 @@ type Limitation struct {
 	PartitionRows int64
 }
 
+// AnalyzeInfo records the statistics of an operator for explain analyze,
+// it may be updated by several pipelines at the same time.
+type AnalyzeInfo struct {
+	// Rows, number of rows produced by the operator.
+	Rows int64
+	// Batches, number of non-empty batches produced by the operator.
+	Batches int64
+	// TimeConsumed, nanoseconds spent in the operator.
+	TimeConsumed int64
+	// MemorySize, bytes allocated by the operator.
+	MemorySize int64
+}
+
+// SpillInfo records the data written to the temporary files by the operators
+// which exceed the memory limitation, it is shared by all the processes of
+// a query.
+type SpillInfo struct {
+	// Dir, directory of the temporary files, nothing is spilled if it is empty.
+	Dir string
+	// Files, number of temporary files created.
+	Files int64
+	// Rows, number of rows written.
+	Rows int64
+	// Bytes, number of bytes written.
+	Bytes int64
+	// ReadRows, number of rows read back.
+	ReadRows int64
+}
+
 // Process contains context used in query execution
 // one or more pipeline will be generated for one query,
 // and one pipeline has one process instance.
@@ -63,6 +92,8 @@ type Process struct {
 	Reg Register
 	Lim Limitation
 	Mp  *mheap.Mheap
+	// Spill, statistics of the spilled data of the query.
+	Spill *SpillInfo
 
 	Cancel context.CancelFunc
 }
@@ -0,0 +1,125 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spill
+
+import (
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+const (
+	offset64 = 14695981039346656037
+	prime64  = 1099511628211
+)
+
+// Hash computes the hash values of the rows of vectors, integers of
+// different widths which are equal have the same hash value, and so do
+// all the nulls.
+func Hash(vecs []*vector.Vector, hs []uint64) {
+	vs := make([]uint64, len(hs))
+	for i := range hs {
+		hs[i] = offset64
+	}
+	for _, vec := range vecs {
+		if col, ok := vec.Col.(*types.Bytes); ok {
+			for i := range vs {
+				v := uint64(offset64)
+				for _, c := range col.Get(int64(i)) {
+					v = mix(v, uint64(c))
+				}
+				vs[i] = mix(v, uint64(col.Lengths[i]))
+			}
+		} else {
+			values(vec, vs)
+		}
+		if nulls.Any(vec.Nsp) {
+			for i := range vs {
+				if nulls.Contains(vec.Nsp, uint64(i)) {
+					vs[i] = prime64
+				}
+			}
+		}
+		for i, v := range vs {
+			hs[i] = mix(hs[i], v)
+		}
+	}
+	for i, h := range hs { // spreads the bits for the modulo
+		h ^= h >> 33
+		h *= 0xff51afd7ed558ccd
+		hs[i] = h ^ h>>33
+	}
+}
+
+func values(vec *vector.Vector, rs []uint64) {
+	switch vs := vec.Col.(type) {
+	case []int8:
+		for i := range rs {
+			rs[i] = uint64(vs[i])
+		}
+	case []int16:
+		for i := range rs {
+			rs[i] = uint64(vs[i])
+		}
+	case []int32:
+		for i := range rs {
+			rs[i] = uint64(vs[i])
+		}
+	case []int64:
+		for i := range rs {
+			rs[i] = uint64(vs[i])
+		}
+	case []uint8:
+		for i := range rs {
+			rs[i] = uint64(vs[i])
+		}
+	case []uint16:
+		for i := range rs {
+			rs[i] = uint64(vs[i])
+		}
+	case []uint32:
+		for i := range rs {
+			rs[i] = uint64(vs[i])
+		}
+	case []uint64:
+		copy(rs, vs)
+	case []float32:
+		for i := range rs {
+			rs[i] = math.Float64bits(float64(vs[i]))
+		}
+	case []float64:
+		for i := range rs {
+			rs[i] = math.Float64bits(vs[i])
+		}
+	case []types.Date:
+		for i := range rs {
+			rs[i] = uint64(vs[i])
+		}
+	case []types.Datetime:
+		for i := range rs {
+			rs[i] = uint64(vs[i])
+		}
+	case []types.Decimal:
+		for i := range rs {
+			rs[i] = mix(vs[i].Lo, uint64(vs[i].Hi))
+		}
+	}
+}
+
+func mix(h, v uint64) uint64 {
+	return (h ^ v) * prime64
+}
@@ -0,0 +1,268 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spill
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"sync/atomic"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+var (
+	encodeBatch func(*batch.Batch, *bytes.Buffer) error
+	decodeBatch func([]byte) (*batch.Batch, []byte, error)
+)
+
+// Register sets the functions to encode and decode the batches of files.
+func Register(enc func(*batch.Batch, *bytes.Buffer) error, dec func([]byte) (*batch.Batch, []byte, error)) {
+	encodeBatch, decodeBatch = enc, dec
+}
+
+// Enabled returns true if the operators of proc can write temporary files.
+func Enabled(proc *process.Process) bool {
+	return encodeBatch != nil && proc.Spill != nil && len(proc.Spill.Dir) > 0
+}
+
+// Size returns the bytes held by the batch.
+func Size(bat *batch.Batch) int64 {
+	var size int64
+
+	for _, vec := range bat.Vecs {
+		size += int64(cap(vec.Data))
+	}
+	for _, r := range bat.Rs {
+		size += int64(r.Size())
+	}
+	return size + int64(cap(bat.Zs))*8
+}
+
+// Create creates a new file in the spill directory of proc.
+func Create(proc *process.Process) (*File, error) {
+	if !Enabled(proc) {
+		return nil, errors.New("spill directory is not set")
+	}
+	if err := os.MkdirAll(proc.Spill.Dir, os.FileMode(0755)); err != nil {
+		return nil, err
+	}
+	fp, err := os.CreateTemp(proc.Spill.Dir, "spill-*")
+	if err != nil {
+		return nil, err
+	}
+	atomic.AddInt64(&proc.Spill.Files, 1)
+	return &File{
+		fp:   fp,
+		name: fp.Name(),
+		info: proc.Spill,
+		w:    bufio.NewWriter(fp),
+	}, nil
+}
+
+// Rows returns the number of rows written.
+func (f *File) Rows() int64 {
+	return f.rows
+}
+
+// Write appends the batch to the file, the batch is written in pieces of
+// at most BatchRows rows.
+func (f *File) Write(bat *batch.Batch, m *mheap.Mheap) error {
+	if n := len(bat.Zs); n > BatchRows {
+		sels := make([]int64, 0, BatchRows)
+		for i := 0; i < n; i += BatchRows {
+			sels = sels[:0]
+			for j := i; j < n && j < i+BatchRows; j++ {
+				sels = append(sels, int64(j))
+			}
+			b, err := Rows(bat, sels, m)
+			if err != nil {
+				return err
+			}
+			err = f.write(b)
+			batch.Clean(b, m)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return f.write(bat)
+}
+
+func (f *File) write(bat *batch.Batch) error {
+	var buf bytes.Buffer
+
+	if err := encodeBatch(bat, &buf); err != nil {
+		return err
+	}
+	if _, err := f.w.Write(encoding.EncodeUint32(uint32(buf.Len()))); err != nil {
+		return err
+	}
+	if _, err := f.w.Write(buf.Bytes()); err != nil {
+		return err
+	}
+	f.rows += int64(len(bat.Zs))
+	f.info.AddWrite(int64(len(bat.Zs)), int64(buf.Len()+4))
+	return nil
+}
+
+// Reader returns a reader of the batches which have been written.
+func (f *File) Reader() (*Reader, error) {
+	if err := f.w.Flush(); err != nil {
+		return nil, err
+	}
+	fp, err := os.Open(f.name)
+	if err != nil {
+		return nil, err
+	}
+	return &Reader{
+		fp:   fp,
+		info: f.info,
+		r:    bufio.NewReader(fp),
+	}, nil
+}
+
+// Remove closes and deletes the file.
+func (f *File) Remove() error {
+	f.fp.Close()
+	return os.Remove(f.name)
+}
+
+// Read returns the next batch, nil is returned at the end of the file.
+func (r *Reader) Read() (*batch.Batch, error) {
+	head := make([]byte, 4)
+	if _, err := io.ReadFull(r.r, head); err != nil {
+		if err == io.EOF {
+			return nil, nil
+		}
+		return nil, err
+	}
+	data := make([]byte, encoding.DecodeUint32(head))
+	if _, err := io.ReadFull(r.r, data); err != nil {
+		return nil, err
+	}
+	bat, _, err := decodeBatch(data)
+	if err != nil {
+		return nil, err
+	}
+	r.info.AddRead(int64(len(bat.Zs)))
+	return bat, nil
+}
+
+// Close closes the reader.
+func (r *Reader) Close() error {
+	return r.fp.Close()
+}
+
+// NewPartition creates n files, the rows are divided by the attributes.
+func NewPartition(n int, attrs []string, proc *process.Process) (*Partition, error) {
+	p := &Partition{
+		attrs: attrs,
+		Fs:    make([]*File, 0, n),
+		sels:  make([][]int64, n),
+	}
+	for i := 0; i < n; i++ {
+		f, err := Create(proc)
+		if err != nil {
+			p.Remove()
+			return nil, err
+		}
+		p.Fs = append(p.Fs, f)
+	}
+	return p, nil
+}
+
+// Write divides the rows of the batch into the files.
+func (p *Partition) Write(bat *batch.Batch, m *mheap.Mheap) error {
+	n := len(bat.Zs)
+	if cap(p.hs) < n {
+		p.hs = make([]uint64, n)
+	}
+	p.hs = p.hs[:n]
+	vecs := make([]*vector.Vector, len(p.attrs))
+	for i, attr := range p.attrs {
+		vecs[i] = batch.GetVector(bat, attr)
+	}
+	Hash(vecs, p.hs)
+	for i := range p.sels {
+		p.sels[i] = p.sels[i][:0]
+	}
+	for i, h := range p.hs {
+		k := h % uint64(len(p.Fs))
+		p.sels[k] = append(p.sels[k], int64(i))
+	}
+	for i, sels := range p.sels {
+		if len(sels) == 0 {
+			continue
+		}
+		if len(sels) == n {
+			return p.Fs[i].Write(bat, m)
+		}
+		b, err := Rows(bat, sels, m)
+		if err != nil {
+			return err
+		}
+		err = p.Fs[i].Write(b, m)
+		batch.Clean(b, m)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Remove deletes all the files.
+func (p *Partition) Remove() {
+	for _, f := range p.Fs {
+		f.Remove()
+	}
+	p.Fs = nil
+}
+
+// Rows returns a new batch of the selected rows.
+func Rows(bat *batch.Batch, sels []int64, m *mheap.Mheap) (*batch.Batch, error) {
+	rbat := batch.New(true, bat.Attrs)
+	for i, vec := range bat.Vecs {
+		rbat.Vecs[i] = vector.New(vec.Typ)
+		if err := vector.Union(rbat.Vecs[i], vec, sels, m); err != nil {
+			batch.Clean(rbat, m)
+			return nil, err
+		}
+	}
+	for _, r := range bat.Rs {
+		rr := r.Dup()
+		rbat.Rs = append(rbat.Rs, rr)
+		if err := rr.Grows(len(sels), m); err != nil {
+			batch.Clean(rbat, m)
+			return nil, err
+		}
+		for i, sel := range sels {
+			rr.Add(r, int64(i), sel)
+		}
+	}
+	rbat.As, rbat.Refs = bat.As, bat.Refs
+	rbat.Zs = make([]int64, len(sels))
+	for i, sel := range sels {
+		rbat.Zs[i] = bat.Zs[sel]
+	}
+	return rbat, nil
+}
@@ -0,0 +1,54 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spill
+
+import (
+	"bufio"
+	"os"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	// Partitions, number of partitions of the partitioned operators.
+	Partitions = 16
+	// BatchRows, max rows of a batch written to or merged from the files.
+	BatchRows = 8192
+)
+
+// File is a temporary file of batches.
+type File struct {
+	name string
+	rows int64
+	fp   *os.File
+	w    *bufio.Writer
+	info *process.SpillInfo
+}
+
+// Reader reads the batches of a file in the order they were written.
+type Reader struct {
+	fp   *os.File
+	r    *bufio.Reader
+	info *process.SpillInfo
+}
+
+// Partition divides the rows into files by the hash of some attributes,
+// rows with the same values of the attributes are in the same file.
+type Partition struct {
+	attrs []string
+	hs    []uint64
+	sels  [][]int64
+	Fs    []*File
+}
@@ -14,6 +14,8 @@
 
 package vm
 
+import "github.com/matrixorigin/matrixone/pkg/vm/process"
+
 const (
 	Top = iota
 	Join
@@ -37,6 +39,8 @@ const (
 	MergeOffset
 	MergeOrder
 	MergeTop
+	SetOp
+	Window
 
 	DeleteTag
 	UpdateTag
@@ -48,6 +52,9 @@ type Instruction struct {
 	Op int
 	// Arg contains the operand of this instruction.
 	Arg interface{}
+	// Anal records the statistics of this instruction for explain analyze,
+	// it is nil if the statistics are not required.
+	Anal *process.AnalyzeInfo
 }
 
 type Instructions []Instruction
@@ -16,8 +16,10 @@ package vm
 
 import (
 	"bytes"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -51,8 +53,23 @@ func Run(ins Instructions, proc *process.Process) (bool, error) {
 			err = moerr.NewPanicError(e)
 		}
 	}()
-	for _, in := range ins {
-		if ok, err = execFunc[in.Op](proc, in.Arg); err != nil {
+	for i, in := range ins {
+		if in.Anal != nil {
+			// the last operator is a sink which consumes the batch, so its
+			// input batch is recorded before it runs
+			if i == len(ins)-1 {
+				in.Anal.AddBatch(proc.Reg.InputBatch)
+			}
+			t, size := time.Now(), mheap.Allocated(proc.Mp)
+			ok, err = execFunc[in.Op](proc, in.Arg)
+			in.Anal.Add(time.Since(t), mheap.Allocated(proc.Mp)-size)
+			if err == nil && i < len(ins)-1 {
+				in.Anal.AddBatch(proc.Reg.InputBatch)
+			}
+		} else {
+			ok, err = execFunc[in.Op](proc, in.Arg)
+		}
+		if err != nil {
 			return ok || end, err
 		}
 		if ok { // ok is true shows that at least one operator has done its work